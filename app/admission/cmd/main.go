@@ -0,0 +1,219 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"buf.build/go/protovalidate"
+	"github.com/chainloop-dev/chainloop/app/admission/internal/conf"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/verifier"
+	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/env"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"github.com/google/uuid"
+	sigs "github.com/sigstore/cosign/v3/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+var (
+	// Name is the name of the compiled software.
+	Name string
+	// flagconf is the config flag.
+	flagconf string
+
+	id, _ = os.Hostname()
+)
+
+// Version is the version of the compiled software.
+// go build ldflags "-X main.Version=x.y.z"
+var Version = servicelogger.Dev
+
+func init() {
+	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
+}
+
+func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+	return kratos.New(
+		kratos.ID(id),
+		kratos.Name(Name),
+		kratos.Version(Version),
+		kratos.Metadata(map[string]string{}),
+		kratos.Logger(logger),
+		kratos.Server(hs),
+	)
+}
+
+func main() {
+	flag.Parse()
+	c := config.New(
+		config.WithSource(
+			// -conf [config directory or path to file]
+			file.NewSource(flagconf),
+			// Load environments variables prefixed with ADMISSION_
+			// NOTE: They get resolved withouth the prefix, i.e ADMISSION_DB_HOST -> DB_HOST
+			env.NewSource("ADMISSION_"),
+		),
+	)
+	defer c.Close()
+
+	if err := c.Load(); err != nil {
+		panic(err)
+	}
+
+	var bc conf.Bootstrap
+	if err := c.Scan(&bc); err != nil {
+		panic(err)
+	}
+
+	// validate configuration
+	validator, err := protovalidate.New()
+	if err != nil {
+		panic(err)
+	}
+
+	if err := validator.Validate(&bc); err != nil {
+		panic(err)
+	}
+
+	logger, err := servicelogger.InitZapLogger(Version)
+	if err != nil {
+		panic(err)
+	}
+
+	_ = logger.Log(log.LevelInfo, "msg", "starting admission webhook", "version", Version)
+
+	app, cleanup, err := wireApp(bc.Server, bc.Data.Database, bc.Policy, logger)
+	if err != nil {
+		panic(err)
+	}
+	defer cleanup()
+
+	// start and wait for stop signal
+	if err := app.Run(); err != nil {
+		panic(err)
+	}
+}
+
+func newTracerProvider() trace.TracerProvider {
+	return otel.GetTracerProvider()
+}
+
+// newReferrerUseCase only resolves referrers from a known set of organizations,
+// so it doesn't need the membership lookups done for user requests
+func newReferrerUseCase(repo biz.ReferrerRepo, wfRepo biz.WorkflowRepo, logger log.Logger) (*biz.ReferrerUseCase, error) {
+	return biz.NewReferrerUseCase(repo, wfRepo, nil, logger)
+}
+
+// newTrustedOrgIDs resolves the organizations whose attestations are trusted
+func newTrustedOrgIDs(repo biz.OrganizationRepo, policy *conf.Policy) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(policy.GetOrganizations()))
+	for _, name := range policy.GetOrganizations() {
+		org, err := repo.FindByName(context.Background(), name)
+		if err != nil {
+			return nil, fmt.Errorf("finding organization %q: %w", name, err)
+		} else if org == nil {
+			return nil, fmt.Errorf("organization %q not found", name)
+		}
+
+		id, err := uuid.Parse(org.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing organization ID: %w", err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// newTrustedRoot loads the material the attestation signatures are verified against
+func newTrustedRoot(policy *conf.Policy) (*verifier.TrustedRoot, error) {
+	v := policy.GetVerification()
+	if v.GetTrustedRoot() == "" && v.GetCertificateChain() == "" && v.GetPublicKey() == "" {
+		return nil, errors.New("no verification material configured, set a trusted root, a certificate chain or a public key")
+	}
+
+	tr := verifier.NewTrustedRoot()
+	if v.GetTrustedRoot() != "" {
+		var err error
+		if tr, err = verifier.LoadSigstoreTrustedRoot(v.GetTrustedRoot()); err != nil {
+			return nil, err
+		}
+	}
+
+	if v.GetPublicKey() != "" {
+		sv, err := sigs.PublicKeyFromKeyRef(context.Background(), v.GetPublicKey())
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+
+		pk, err := sv.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+
+		tr.PublicKeys = append(tr.PublicKeys, pk)
+	}
+
+	if v.GetCertificateChain() != "" {
+		chain, err := loadCertificates(v.GetCertificateChain())
+		if err != nil {
+			return nil, fmt.Errorf("loading certificate chain: %w", err)
+		}
+
+		if err := tr.AddCertificateChain(chain); err != nil {
+			return nil, fmt.Errorf("loading certificate chain: %w", err)
+		}
+	}
+
+	if v.GetTimestampAuthorityChain() != "" {
+		chain, err := loadCertificates(v.GetTimestampAuthorityChain())
+		if err != nil {
+			return nil, fmt.Errorf("loading timestamp authority chain: %w", err)
+		}
+
+		if err := tr.AddTimestampAuthorityChain(chain); err != nil {
+			return nil, fmt.Errorf("loading timestamp authority chain: %w", err)
+		}
+	}
+
+	return tr, nil
+}
+
+func loadCertificates(path string) ([]*x509.Certificate, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return cryptoutils.LoadCertificatesFromPEM(bytes.NewReader(raw))
+}
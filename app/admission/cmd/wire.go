@@ -0,0 +1,58 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build wireinject
+// +build wireinject
+
+// The build tag makes sure the stub is not built in the final build.
+
+package main
+
+import (
+	"github.com/chainloop-dev/chainloop/app/admission/internal/conf"
+	"github.com/chainloop-dev/chainloop/app/admission/internal/enforcer"
+	"github.com/chainloop-dev/chainloop/app/admission/internal/server"
+	"github.com/chainloop-dev/chainloop/app/admission/internal/webhook"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	config "github.com/chainloop-dev/chainloop/app/controlplane/pkg/conf/controlplane/config/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data"
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/wire"
+)
+
+// wireApp init kratos application.
+func wireApp(*conf.Server, *config.DatabaseConfig, *conf.Policy, log.Logger) (*kratos.App, func(), error) {
+	panic(
+		wire.Build(
+			server.ProviderSet,
+			data.NewData,
+			data.NewReferrerRepo,
+			data.NewWorkflowRepo,
+			data.NewWorkflowRunRepo,
+			data.NewOrganizationRepo,
+			newTracerProvider,
+			newReferrerUseCase,
+			newTrustedOrgIDs,
+			newTrustedRoot,
+			enforcer.New,
+			wire.Bind(new(enforcer.ReferrerResolver), new(*biz.ReferrerUseCase)),
+			wire.Bind(new(enforcer.RunFinder), new(biz.WorkflowRunRepo)),
+			webhook.NewHandler,
+			wire.Bind(new(webhook.Checker), new(*enforcer.Enforcer)),
+			newApp,
+		),
+	)
+}
@@ -0,0 +1,634 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: conf.proto
+
+package conf
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/pkg/conf/controlplane/config/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What to do with a pod whose images are not backed by a compliant attestation
+type Policy_Mode int32
+
+const (
+	Policy_MODE_UNSPECIFIED Policy_Mode = 0
+	// reject the pod
+	Policy_MODE_DENY Policy_Mode = 1
+	// admit the pod and return the failures as admission warnings
+	Policy_MODE_WARN Policy_Mode = 2
+	// do not check the pod
+	Policy_MODE_IGNORE Policy_Mode = 3
+)
+
+// Enum value maps for Policy_Mode.
+var (
+	Policy_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_DENY",
+		2: "MODE_WARN",
+		3: "MODE_IGNORE",
+	}
+	Policy_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_DENY":        1,
+		"MODE_WARN":        2,
+		"MODE_IGNORE":      3,
+	}
+)
+
+func (x Policy_Mode) Enum() *Policy_Mode {
+	p := new(Policy_Mode)
+	*p = x
+	return p
+}
+
+func (x Policy_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Policy_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_conf_proto_enumTypes[0].Descriptor()
+}
+
+func (Policy_Mode) Type() protoreflect.EnumType {
+	return &file_conf_proto_enumTypes[0]
+}
+
+func (x Policy_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Policy_Mode.Descriptor instead.
+func (Policy_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type Bootstrap struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Data          *Data                  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Policy        *Policy                `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bootstrap) Reset() {
+	*x = Bootstrap{}
+	mi := &file_conf_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bootstrap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bootstrap) ProtoMessage() {}
+
+func (x *Bootstrap) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bootstrap.ProtoReflect.Descriptor instead.
+func (*Bootstrap) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Bootstrap) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetData() *Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type Server struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Admission webhook endpoint
+	Http          *Server_HTTP `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+// The webhook reads the attestation graph straight from the control plane database
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *v1.DatabaseConfig     `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Data) GetDatabase() *v1.DatabaseConfig {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+type Policy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names of the organizations whose attestations are trusted
+	Organizations []string `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	// Mode applied to namespaces without an explicit entry, defaults to warn.
+	// kube-system, kube-public, kube-node-lease and the namespace the webhook runs in
+	// are not checked unless they have an explicit entry
+	DefaultMode Policy_Mode `protobuf:"varint,2,opt,name=default_mode,json=defaultMode,proto3,enum=admission.config.v1.Policy_Mode" json:"default_mode,omitempty"`
+	// Mode per namespace name
+	Namespaces map[string]Policy_Mode `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=admission.config.v1.Policy_Mode"`
+	// Material the attestation signatures are verified against. The control plane also stores
+	// attestations it can't verify, so only the ones signed by this material are taken into account
+	Verification  *Verification `protobuf:"bytes,4,opt,name=verification,proto3" json:"verification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Policy) GetOrganizations() []string {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *Policy) GetDefaultMode() Policy_Mode {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return Policy_MODE_UNSPECIFIED
+}
+
+func (x *Policy) GetNamespaces() map[string]Policy_Mode {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *Policy) GetVerification() *Verification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+// At least one of the fields must be set
+type Verification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path to a sigstore trusted_root.json file
+	TrustedRoot string `protobuf:"bytes,1,opt,name=trusted_root,json=trustedRoot,proto3" json:"trusted_root,omitempty"`
+	// Path to the PEM encoded certificate chain of the CA issuing the signing certificates, i.e the control plane signer
+	CertificateChain string `protobuf:"bytes,2,opt,name=certificate_chain,json=certificateChain,proto3" json:"certificate_chain,omitempty"`
+	// Path to the PEM encoded certificate chain of the timestamp authority
+	TimestampAuthorityChain string `protobuf:"bytes,3,opt,name=timestamp_authority_chain,json=timestampAuthorityChain,proto3" json:"timestamp_authority_chain,omitempty"`
+	// Public key used to sign the attestations, i.e a cosign key. A path or a KMS reference
+	PublicKey     string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Verification) Reset() {
+	*x = Verification{}
+	mi := &file_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Verification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Verification) ProtoMessage() {}
+
+func (x *Verification) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Verification.ProtoReflect.Descriptor instead.
+func (*Verification) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Verification) GetTrustedRoot() string {
+	if x != nil {
+		return x.TrustedRoot
+	}
+	return ""
+}
+
+func (x *Verification) GetCertificateChain() string {
+	if x != nil {
+		return x.CertificateChain
+	}
+	return ""
+}
+
+func (x *Verification) GetTimestampAuthorityChain() string {
+	if x != nil {
+		return x.TimestampAuthorityChain
+	}
+	return ""
+}
+
+func (x *Verification) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type Server_HTTP struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr    string                 `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Timeout *durationpb.Duration   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// The Kubernetes API server only talks to admission webhooks over TLS
+	TlsConfig     *Server_TLS `protobuf:"bytes,4,opt,name=tls_config,json=tlsConfig,proto3" json:"tls_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_HTTP) Reset() {
+	*x = Server_HTTP{}
+	mi := &file_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_HTTP) ProtoMessage() {}
+
+func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_HTTP.ProtoReflect.Descriptor instead.
+func (*Server_HTTP) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Server_HTTP) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetAddr() string {
+	if x != nil {
+		return x.Addr
+	}
+	return ""
+}
+
+func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Server_HTTP) GetTlsConfig() *Server_TLS {
+	if x != nil {
+		return x.TlsConfig
+	}
+	return nil
+}
+
+type Server_TLS struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// path to certificate and private key
+	Certificate   string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	PrivateKey    string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Server_TLS) Reset() {
+	*x = Server_TLS{}
+	mi := &file_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server_TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_TLS) ProtoMessage() {}
+
+func (x *Server_TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_TLS.ProtoReflect.Descriptor instead.
+func (*Server_TLS) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Server_TLS) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *Server_TLS) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+var File_conf_proto protoreflect.FileDescriptor
+
+const file_conf_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"conf.proto\x12\x13admission.config.v1\x1a\x1bbuf/validate/validate.proto\x1a#controlplane/config/v1/config.proto\x1a\x1egoogle/protobuf/duration.proto\"\xbc\x01\n" +
+	"\tBootstrap\x12;\n" +
+	"\x06server\x18\x01 \x01(\v2\x1b.admission.config.v1.ServerB\x06\xbaH\x03\xc8\x01\x01R\x06server\x125\n" +
+	"\x04data\x18\x02 \x01(\v2\x19.admission.config.v1.DataB\x06\xbaH\x03\xc8\x01\x01R\x04data\x12;\n" +
+	"\x06policy\x18\x03 \x01(\v2\x1b.admission.config.v1.PolicyB\x06\xbaH\x03\xc8\x01\x01R\x06policy\"\xbc\x02\n" +
+	"\x06Server\x12<\n" +
+	"\x04http\x18\x01 \x01(\v2 .admission.config.v1.Server.HTTPB\x06\xbaH\x03\xc8\x01\x01R\x04http\x1a\xa9\x01\n" +
+	"\x04HTTP\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04addr\x18\x02 \x01(\tR\x04addr\x123\n" +
+	"\atimeout\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12>\n" +
+	"\n" +
+	"tls_config\x18\x04 \x01(\v2\x1f.admission.config.v1.Server.TLSR\ttlsConfig\x1aH\n" +
+	"\x03TLS\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\tR\vcertificate\x12\x1f\n" +
+	"\vprivate_key\x18\x02 \x01(\tR\n" +
+	"privateKey\"R\n" +
+	"\x04Data\x12J\n" +
+	"\bdatabase\x18\x01 \x01(\v2&.controlplane.config.v1.DatabaseConfigB\x06\xbaH\x03\xc8\x01\x01R\bdatabase\"\xe0\x03\n" +
+	"\x06Policy\x12.\n" +
+	"\rorganizations\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\b\x01R\rorganizations\x12M\n" +
+	"\fdefault_mode\x18\x02 \x01(\x0e2 .admission.config.v1.Policy.ModeB\b\xbaH\x05\x82\x01\x02\x10\x01R\vdefaultMode\x12Z\n" +
+	"\n" +
+	"namespaces\x18\x03 \x03(\v2+.admission.config.v1.Policy.NamespacesEntryB\r\xbaH\n" +
+	"\x9a\x01\a*\x05\x82\x01\x02\x10\x01R\n" +
+	"namespaces\x12M\n" +
+	"\fverification\x18\x04 \x01(\v2!.admission.config.v1.VerificationB\x06\xbaH\x03\xc8\x01\x01R\fverification\x1a_\n" +
+	"\x0fNamespacesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\x0e2 .admission.config.v1.Policy.ModeR\x05value:\x028\x01\"K\n" +
+	"\x04Mode\x12\x14\n" +
+	"\x10MODE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tMODE_DENY\x10\x01\x12\r\n" +
+	"\tMODE_WARN\x10\x02\x12\x0f\n" +
+	"\vMODE_IGNORE\x10\x03\"\xb9\x01\n" +
+	"\fVerification\x12!\n" +
+	"\ftrusted_root\x18\x01 \x01(\tR\vtrustedRoot\x12+\n" +
+	"\x11certificate_chain\x18\x02 \x01(\tR\x10certificateChain\x12:\n" +
+	"\x19timestamp_authority_chain\x18\x03 \x01(\tR\x17timestampAuthorityChain\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x04 \x01(\tR\tpublicKeyBEZCgithub.com/chainloop-dev/chainloop/app/admission/internal/conf;confb\x06proto3"
+
+var (
+	file_conf_proto_rawDescOnce sync.Once
+	file_conf_proto_rawDescData []byte
+)
+
+func file_conf_proto_rawDescGZIP() []byte {
+	file_conf_proto_rawDescOnce.Do(func() {
+		file_conf_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)))
+	})
+	return file_conf_proto_rawDescData
+}
+
+var file_conf_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_conf_proto_goTypes = []any{
+	(Policy_Mode)(0),            // 0: admission.config.v1.Policy.Mode
+	(*Bootstrap)(nil),           // 1: admission.config.v1.Bootstrap
+	(*Server)(nil),              // 2: admission.config.v1.Server
+	(*Data)(nil),                // 3: admission.config.v1.Data
+	(*Policy)(nil),              // 4: admission.config.v1.Policy
+	(*Verification)(nil),        // 5: admission.config.v1.Verification
+	(*Server_HTTP)(nil),         // 6: admission.config.v1.Server.HTTP
+	(*Server_TLS)(nil),          // 7: admission.config.v1.Server.TLS
+	nil,                         // 8: admission.config.v1.Policy.NamespacesEntry
+	(*v1.DatabaseConfig)(nil),   // 9: controlplane.config.v1.DatabaseConfig
+	(*durationpb.Duration)(nil), // 10: google.protobuf.Duration
+}
+var file_conf_proto_depIdxs = []int32{
+	2,  // 0: admission.config.v1.Bootstrap.server:type_name -> admission.config.v1.Server
+	3,  // 1: admission.config.v1.Bootstrap.data:type_name -> admission.config.v1.Data
+	4,  // 2: admission.config.v1.Bootstrap.policy:type_name -> admission.config.v1.Policy
+	6,  // 3: admission.config.v1.Server.http:type_name -> admission.config.v1.Server.HTTP
+	9,  // 4: admission.config.v1.Data.database:type_name -> controlplane.config.v1.DatabaseConfig
+	0,  // 5: admission.config.v1.Policy.default_mode:type_name -> admission.config.v1.Policy.Mode
+	8,  // 6: admission.config.v1.Policy.namespaces:type_name -> admission.config.v1.Policy.NamespacesEntry
+	5,  // 7: admission.config.v1.Policy.verification:type_name -> admission.config.v1.Verification
+	10, // 8: admission.config.v1.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	7,  // 9: admission.config.v1.Server.HTTP.tls_config:type_name -> admission.config.v1.Server.TLS
+	0,  // 10: admission.config.v1.Policy.NamespacesEntry.value:type_name -> admission.config.v1.Policy.Mode
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_conf_proto_init() }
+func file_conf_proto_init() {
+	if File_conf_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_conf_proto_goTypes,
+		DependencyIndexes: file_conf_proto_depIdxs,
+		EnumInfos:         file_conf_proto_enumTypes,
+		MessageInfos:      file_conf_proto_msgTypes,
+	}.Build()
+	File_conf_proto = out.File
+	file_conf_proto_goTypes = nil
+	file_conf_proto_depIdxs = nil
+}
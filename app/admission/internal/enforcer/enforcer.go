@@ -0,0 +1,226 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package enforcer decides whether a container image is backed by a compliant Chainloop attestation
+package enforcer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/verifier"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/uuid"
+)
+
+// referencesPageSize is the number of attestations fetched per referrer graph query
+const referencesPageSize = 50
+
+// ReferrerResolver walks the referrer graph, implemented by biz.ReferrerUseCase
+type ReferrerResolver interface {
+	GetFromRoot(ctx context.Context, digest, rootKind string, orgIDs []uuid.UUID, projectIDs map[biz.OrgID][]biz.ProjectID, p *pagination.CursorOptions, extraFilters ...biz.GetFromRootFilter) (*biz.StoredReferrer, string, error)
+}
+
+// RunFinder finds the workflow run that produced an attestation and its bundle, implemented by biz.WorkflowRunRepo
+type RunFinder interface {
+	FindByAttestationDigest(ctx context.Context, digest string) (*biz.WorkflowRun, error)
+	GetBundle(ctx context.Context, wrID uuid.UUID) ([]byte, error)
+}
+
+type Enforcer struct {
+	referrers ReferrerResolver
+	runs      RunFinder
+	// organizations whose attestations are trusted
+	orgIDs []uuid.UUID
+	// material the attestation signatures are verified against
+	trustedRoot *verifier.TrustedRoot
+	logger      *log.Helper
+}
+
+func New(referrers ReferrerResolver, runs RunFinder, orgIDs []uuid.UUID, trustedRoot *verifier.TrustedRoot, logger log.Logger) *Enforcer {
+	return &Enforcer{
+		referrers:   referrers,
+		runs:        runs,
+		orgIDs:      orgIDs,
+		trustedRoot: trustedRoot,
+		logger:      log.NewHelper(log.With(logger, "component", "admission/enforcer")),
+	}
+}
+
+// Result is the outcome of checking a single image
+type Result struct {
+	Image string
+	// Digest the image was resolved to, empty if it is not pinned by digest
+	Digest    string
+	Compliant bool
+	// Why the image is not compliant
+	Reason string
+}
+
+// Check verifies that the image is referenced by at least one attestation stored in the trusted
+// organizations and signed by the trusted material, and that none of the verified attestations of
+// that image recorded gated policy violations. Lookup errors make the image non compliant.
+func (e *Enforcer) Check(ctx context.Context, image string) *Result {
+	res := &Result{Image: image}
+
+	digest, err := imageDigest(image)
+	if err != nil {
+		res.Reason = err.Error()
+		return res
+	}
+	res.Digest = digest
+
+	runs, casOnly, err := e.attestationRuns(ctx, digest)
+	if err != nil {
+		if biz.IsNotFound(err) {
+			res.Reason = fmt.Sprintf("no attestation found for %s", digest)
+			return res
+		}
+
+		e.logger.Errorw("msg", "resolving attestations", "image", image, "error", err)
+		res.Reason = fmt.Sprintf("could not resolve the attestations of %s", digest)
+		return res
+	}
+
+	if len(runs) == 0 {
+		// told apart so the images attested by organizations that only store the bundles in CAS can be audited
+		if casOnly > 0 {
+			res.Reason = fmt.Sprintf("the attestations of %s are only stored in CAS and can't be verified", digest)
+			return res
+		}
+
+		res.Reason = fmt.Sprintf("no verified attestation found for %s", digest)
+		return res
+	}
+
+	for _, run := range runs {
+		if hasGatedViolations(run) {
+			res.Reason = fmt.Sprintf("attestation %s has gated policy violations", run.Attestation.Digest)
+			return res
+		}
+	}
+
+	res.Compliant = true
+	return res
+}
+
+// attestationRuns returns the successful workflow runs whose attestations refer to the image digest
+// and can be verified against the trusted material, and the number of them skipped because their bundle
+// is only stored in CAS
+func (e *Enforcer) attestationRuns(ctx context.Context, digest string) (runs []*biz.WorkflowRun, casOnly int, err error) {
+	var cursor string
+	for {
+		p, err := pagination.NewCursor(cursor, referencesPageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		ref, next, err := e.referrers.GetFromRoot(ctx, digest, schemaapi.CraftingSchema_Material_CONTAINER_IMAGE.String(), e.orgIDs, nil, p)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, r := range ref.References {
+			if r.Kind != biz.ReferrerAttestationType {
+				continue
+			}
+
+			run, err := e.runs.FindByAttestationDigest(ctx, r.Digest)
+			if err != nil {
+				return nil, 0, fmt.Errorf("finding workflow run: %w", err)
+			}
+
+			if run == nil || run.State != string(biz.WorkflowRunSuccess) {
+				continue
+			}
+
+			// The control plane also stores attestations it can't verify, i.e signed with keys it doesn't know about,
+			// so the signature is checked here. Attestations whose bundle is only stored in CAS can't be verified.
+			bundle, err := e.runs.GetBundle(ctx, run.ID)
+			if err != nil && !biz.IsNotFound(err) {
+				return nil, 0, fmt.Errorf("retrieving attestation bundle: %w", err)
+			}
+
+			if len(bundle) == 0 {
+				e.logger.Warnw("msg", "skipping attestation only stored in CAS", "digest", r.Digest)
+				casOnly++
+				continue
+			}
+
+			if err := e.verifyBundle(ctx, r.Digest, bundle); err != nil {
+				e.logger.Warnw("msg", "skipping unverified attestation", "digest", r.Digest, "error", err)
+				continue
+			}
+
+			runs = append(runs, run)
+		}
+
+		if next == "" {
+			return runs, casOnly, nil
+		}
+		cursor = next
+	}
+}
+
+// verifyBundle checks that the bundle is the attestation with the given digest and that it's signed by the trusted material
+func (e *Enforcer) verifyBundle(ctx context.Context, digest string, bundle []byte) error {
+	if len(bundle) == 0 {
+		return verifier.ErrMissingVerificationMaterial
+	}
+
+	h, _, err := v1.SHA256(bytes.NewReader(bundle))
+	if err != nil {
+		return fmt.Errorf("hashing bundle: %w", err)
+	}
+
+	if h.String() != digest {
+		return fmt.Errorf("bundle digest %s doesn't match the attestation", h)
+	}
+
+	return verifier.VerifyBundle(ctx, bundle, e.trustedRoot)
+}
+
+// hasGatedViolations reports whether any gated policy recorded violations in the run
+func hasGatedViolations(run *biz.WorkflowRun) bool {
+	s := run.PolicyStatus
+	if s == nil {
+		return false
+	}
+
+	return s.HasGates && s.Violated > 0
+}
+
+// imageDigest extracts the digest of an image reference, which must be pinned by digest
+// since tags are mutable and can't be tied to an attestation
+func imageDigest(image string) (string, error) {
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference %q: %w", image, err)
+	}
+
+	d, ok := ref.(name.Digest)
+	if !ok {
+		return "", fmt.Errorf("image %s is not pinned by digest", image)
+	}
+
+	return strings.ToLower(d.DigestStr()), nil
+}
@@ -0,0 +1,249 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enforcer
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/pkg/attestation"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/verifier"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/uuid"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/sigstore/sigstore/pkg/signature"
+	sigdsee "github.com/sigstore/sigstore/pkg/signature/dsse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const imageDigestStr = "sha256:4a5573037f358b6cdfa2f3e8a9c33a5cf11bcd1675ca3b2be36cd5fa9e7c0d4e"
+
+type stubReferrers struct {
+	// references of the image, returned one per page
+	refs  []*biz.StoredReferrer
+	err   error
+	calls int
+}
+
+func (s *stubReferrers) GetFromRoot(_ context.Context, digest, _ string, _ []uuid.UUID, _ map[biz.OrgID][]biz.ProjectID, _ *pagination.CursorOptions, _ ...biz.GetFromRootFilter) (*biz.StoredReferrer, string, error) {
+	if s.err != nil {
+		return nil, "", s.err
+	}
+
+	root := &biz.StoredReferrer{Referrer: &biz.Referrer{Digest: digest}}
+	if len(s.refs) == 0 {
+		return root, "", nil
+	}
+
+	page := s.calls
+	s.calls++
+	root.References = []*biz.StoredReferrer{s.refs[page]}
+	if page+1 < len(s.refs) {
+		return root, pagination.EncodeCursor(time.Now(), uuid.New()), nil
+	}
+
+	return root, "", nil
+}
+
+type stubRuns map[string]*biz.WorkflowRun
+
+func (s stubRuns) FindByAttestationDigest(_ context.Context, digest string) (*biz.WorkflowRun, error) {
+	return s[digest], nil
+}
+
+func (s stubRuns) GetBundle(_ context.Context, wrID uuid.UUID) ([]byte, error) {
+	for _, r := range s {
+		if r.ID == wrID && r.Attestation.Bundle != nil {
+			return r.Attestation.Bundle, nil
+		}
+	}
+
+	return nil, biz.NewErrNotFound("attestation")
+}
+
+// signedBundle returns an attestation bundle with the given payload signed by the key, and its digest
+func signedBundle(t *testing.T, key *ecdsa.PrivateKey, payload string) ([]byte, string) {
+	t.Helper()
+
+	sv, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+	require.NoError(t, err)
+	signer, err := dsse.NewEnvelopeSigner(&sigdsee.SignerAdapter{SignatureSigner: sv})
+	require.NoError(t, err)
+	envelope, err := signer.SignPayload(context.TODO(), "application/vnd.in-toto+json", []byte(payload))
+	require.NoError(t, err)
+
+	bundle, err := attestation.BundleFromDSSEEnvelope(envelope)
+	require.NoError(t, err)
+	bundleBytes, err := protojson.Marshal(bundle)
+	require.NoError(t, err)
+
+	h, _, err := v1.SHA256(bytes.NewReader(bundleBytes))
+	require.NoError(t, err)
+
+	return bundleBytes, h.String()
+}
+
+func attestationRef(digest string) *biz.StoredReferrer {
+	return &biz.StoredReferrer{Referrer: &biz.Referrer{Digest: digest, Kind: biz.ReferrerAttestationType}}
+}
+
+func run(digest string, bundle []byte, state biz.WorkflowRunStatus, summary *chainloop.PolicyStatusSummary) *biz.WorkflowRun {
+	return &biz.WorkflowRun{ID: uuid.New(), State: string(state), Attestation: &biz.Attestation{Digest: digest, Bundle: bundle}, PolicyStatus: summary}
+}
+
+func TestCheck(t *testing.T) {
+	image := "ghcr.io/chainloop-dev/chainloop/control-plane@" + imageDigestStr
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	trustedRoot := &verifier.TrustedRoot{PublicKeys: []crypto.PublicKey{&key.PublicKey}}
+
+	att1, att1Digest := signedBundle(t, key, `{"_type":"https://in-toto.io/Statement/v1","predicateType":"one"}`)
+	att2, att2Digest := signedBundle(t, key, `{"_type":"https://in-toto.io/Statement/v1","predicateType":"two"}`)
+	untrusted, untrustedDigest := signedBundle(t, otherKey, `{"_type":"https://in-toto.io/Statement/v1"}`)
+
+	testCases := []struct {
+		name       string
+		image      string
+		referrers  *stubReferrers
+		runs       stubRuns
+		compliant  bool
+		wantReason string
+	}{
+		{
+			name:       "not pinned by digest",
+			image:      "ghcr.io/chainloop-dev/chainloop/control-plane:latest",
+			referrers:  &stubReferrers{},
+			wantReason: "not pinned by digest",
+		},
+		{
+			name:       "unknown image",
+			image:      image,
+			referrers:  &stubReferrers{err: biz.NewErrNotFound("artifact")},
+			wantReason: "no attestation found",
+		},
+		{
+			name:       "lookup failure",
+			image:      image,
+			referrers:  &stubReferrers{err: errors.New("connection refused")},
+			wantReason: "could not resolve the attestations",
+		},
+		{
+			name:       "only referenced by a failed run",
+			image:      image,
+			referrers:  &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest)}},
+			runs:       stubRuns{att1Digest: run(att1Digest, att1, biz.WorkflowRunError, nil)},
+			wantReason: "no verified attestation found",
+		},
+		{
+			name:       "signed by an untrusted key",
+			image:      image,
+			referrers:  &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(untrustedDigest)}},
+			runs:       stubRuns{untrustedDigest: run(untrustedDigest, untrusted, biz.WorkflowRunSuccess, nil)},
+			wantReason: "no verified attestation found",
+		},
+		{
+			name:       "bundle not stored in the database",
+			image:      image,
+			referrers:  &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest)}},
+			runs:       stubRuns{att1Digest: run(att1Digest, nil, biz.WorkflowRunSuccess, nil)},
+			wantReason: "only stored in CAS and can't be verified",
+		},
+		{
+			name:       "bundle not matching the attestation digest",
+			image:      image,
+			referrers:  &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest)}},
+			runs:       stubRuns{att1Digest: run(att1Digest, att2, biz.WorkflowRunSuccess, nil)},
+			wantReason: "no verified attestation found",
+		},
+		{
+			name:      "unverified gated violations are ignored",
+			image:     image,
+			referrers: &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest), attestationRef(untrustedDigest)}},
+			runs: stubRuns{
+				att1Digest: run(att1Digest, att1, biz.WorkflowRunSuccess, nil),
+				untrustedDigest: run(untrustedDigest, untrusted, biz.WorkflowRunSuccess, &chainloop.PolicyStatusSummary{
+					Status: chainloop.PolicyStatusBypassed, Total: 1, Violated: 1, HasGates: true,
+				}),
+			},
+			compliant: true,
+		},
+		{
+			name:      "attestation without policies",
+			image:     image,
+			referrers: &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest)}},
+			runs:      stubRuns{att1Digest: run(att1Digest, att1, biz.WorkflowRunSuccess, nil)},
+			compliant: true,
+		},
+		{
+			name:      "advisory violations",
+			image:     image,
+			referrers: &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest)}},
+			runs: stubRuns{att1Digest: run(att1Digest, att1, biz.WorkflowRunSuccess, &chainloop.PolicyStatusSummary{
+				Status: chainloop.PolicyStatusWarning, Total: 2, Violated: 1,
+			})},
+			compliant: true,
+		},
+		{
+			name:      "gated violations in a later page",
+			image:     image,
+			referrers: &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest), attestationRef(att2Digest)}},
+			runs: stubRuns{
+				att1Digest: run(att1Digest, att1, biz.WorkflowRunSuccess, nil),
+				att2Digest: run(att2Digest, att2, biz.WorkflowRunSuccess, &chainloop.PolicyStatusSummary{
+					Status: chainloop.PolicyStatusBypassed, Total: 2, Violated: 1, HasGates: true,
+				}),
+			},
+			wantReason: "attestation " + att2Digest + " has gated policy violations",
+		},
+		{
+			name:      "gates without violations",
+			image:     image,
+			referrers: &stubReferrers{refs: []*biz.StoredReferrer{attestationRef(att1Digest)}},
+			runs: stubRuns{att1Digest: run(att1Digest, att1, biz.WorkflowRunSuccess, &chainloop.PolicyStatusSummary{
+				Status: chainloop.PolicyStatusPassed, Total: 2, Passed: 2, HasGates: true,
+			})},
+			compliant: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := New(tc.referrers, tc.runs, []uuid.UUID{uuid.New()}, trustedRoot, log.DefaultLogger)
+			res := e.Check(context.Background(), tc.image)
+			assert.Equal(t, tc.compliant, res.Compliant)
+			assert.Contains(t, res.Reason, tc.wantReason)
+			if tc.image == image {
+				assert.Equal(t, imageDigestStr, res.Digest)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook implements the Kubernetes validating admission webhook for pods
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/chainloop-dev/chainloop/app/admission/internal/conf"
+	"github.com/chainloop-dev/chainloop/app/admission/internal/enforcer"
+	"github.com/go-kratos/kratos/v2/log"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidatePath is where the ValidatingWebhookConfiguration must send the pod admission reviews
+const ValidatePath = "/validate"
+
+// maxReviewSize bounds the size of the admission review we are willing to read
+const maxReviewSize = 3 << 20
+
+// systemNamespaces are not checked unless they have an explicit entry, so a misconfigured policy
+// can't prevent the cluster components from running
+var systemNamespaces = []string{"kube-system", "kube-public", "kube-node-lease"}
+
+// serviceAccountNamespaceFile holds the namespace the webhook runs in, which is not checked either
+// so the webhook can always be restarted
+var serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// Checker checks a single image, implemented by enforcer.Enforcer
+type Checker interface {
+	Check(ctx context.Context, image string) *enforcer.Result
+}
+
+type Handler struct {
+	checker Checker
+	policy  *conf.Policy
+	// namespaces not checked unless they have an explicit entry
+	exempt map[string]bool
+	logger *log.Helper
+}
+
+func NewHandler(checker Checker, policy *conf.Policy, logger log.Logger) *Handler {
+	exempt := make(map[string]bool)
+	for _, ns := range systemNamespaces {
+		exempt[ns] = true
+	}
+
+	// only available when running in the cluster
+	if ns, err := os.ReadFile(serviceAccountNamespaceFile); err == nil && len(bytes.TrimSpace(ns)) > 0 {
+		exempt[string(bytes.TrimSpace(ns))] = true
+	}
+
+	return &Handler{
+		checker: checker,
+		policy:  policy,
+		exempt:  exempt,
+		logger:  log.NewHelper(log.With(logger, "component", "admission/webhook")),
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxReviewSize))
+	if err != nil {
+		http.Error(w, "reading request", http.StatusBadRequest)
+		return
+	}
+
+	review := &admissionv1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, "invalid admission review", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.review(r.Context(), review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		h.logger.Errorw("msg", "writing admission response", "error", err)
+	}
+}
+
+// review decides on a single admission request
+func (h *Handler) review(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	allowed := &admissionv1.AdmissionResponse{Allowed: true}
+
+	if !reviewable(req) {
+		return allowed
+	}
+
+	mode := h.modeFor(req.Namespace)
+	if mode == conf.Policy_MODE_IGNORE {
+		return allowed
+	}
+
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(req.Object.Raw, pod); err != nil {
+		return deny(fmt.Sprintf("decoding pod: %v", err))
+	}
+
+	var failures []string
+	for _, image := range podImages(pod) {
+		res := h.checker.Check(ctx, image)
+		if !res.Compliant {
+			failures = append(failures, fmt.Sprintf("%s: %s", image, res.Reason))
+		}
+	}
+
+	if len(failures) == 0 {
+		return allowed
+	}
+
+	h.logger.Infow("msg", "pod images not backed by compliant attestations", "namespace", req.Namespace, "name", req.Name, "mode", mode.String(), "failures", failures)
+
+	if mode == conf.Policy_MODE_WARN {
+		warnings := make([]string, 0, len(failures))
+		for _, f := range failures {
+			warnings = append(warnings, "chainloop: "+f)
+		}
+
+		return &admissionv1.AdmissionResponse{Allowed: true, Warnings: warnings}
+	}
+
+	return deny("chainloop: " + strings.Join(failures, "; "))
+}
+
+// reviewable reports whether the request can change the images a pod runs
+func reviewable(req *admissionv1.AdmissionRequest) bool {
+	if req.Kind.Kind != "Pod" {
+		return false
+	}
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return false
+	}
+
+	return req.SubResource == "" || req.SubResource == "ephemeralcontainers"
+}
+
+// modeFor returns the enforcement mode of a namespace. Namespaces without an explicit entry fall back
+// to the default mode, or warn if it's not set, except the exempt ones which are not checked
+func (h *Handler) modeFor(namespace string) conf.Policy_Mode {
+	if m, ok := h.policy.GetNamespaces()[namespace]; ok && m != conf.Policy_MODE_UNSPECIFIED {
+		return m
+	}
+
+	if h.exempt[namespace] {
+		return conf.Policy_MODE_IGNORE
+	}
+
+	if m := h.policy.GetDefaultMode(); m != conf.Policy_MODE_UNSPECIFIED {
+		return m
+	}
+
+	return conf.Policy_MODE_WARN
+}
+
+// podImages returns the unique images of every container in the pod
+func podImages(pod *corev1.Pod) []string {
+	var images []string
+	seen := make(map[string]bool)
+	add := func(image string) {
+		if image != "" && !seen[image] {
+			seen[image] = true
+			images = append(images, image)
+		}
+	}
+
+	for _, c := range pod.Spec.InitContainers {
+		add(c.Image)
+	}
+	for _, c := range pod.Spec.Containers {
+		add(c.Image)
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		add(c.Image)
+	}
+
+	return images
+}
+
+func deny(msg string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: msg,
+		},
+	}
+}
@@ -0,0 +1,206 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/app/admission/internal/conf"
+	"github.com/chainloop-dev/chainloop/app/admission/internal/enforcer"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	goodImage = "ghcr.io/acme/good@sha256:4a5573037f358b6cdfa2f3e8a9c33a5cf11bcd1675ca3b2be36cd5fa9e7c0d4e"
+	badImage  = "ghcr.io/acme/bad:latest"
+)
+
+type stubChecker struct{}
+
+func (stubChecker) Check(_ context.Context, image string) *enforcer.Result {
+	if image == goodImage {
+		return &enforcer.Result{Image: image, Compliant: true}
+	}
+
+	return &enforcer.Result{Image: image, Reason: "not pinned by digest"}
+}
+
+func reviewFor(t *testing.T, namespace string, images ...string) *admissionv1.AdmissionReview {
+	pod := &corev1.Pod{}
+	for _, i := range images {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Image: i})
+	}
+	raw, err := json.Marshal(pod)
+	require.NoError(t, err)
+
+	return &admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("1234"),
+			Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+			Namespace: namespace,
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	policy := &conf.Policy{
+		Organizations: []string{"acme"},
+		DefaultMode:   conf.Policy_MODE_DENY,
+		Namespaces: map[string]conf.Policy_Mode{
+			"staging":     conf.Policy_MODE_WARN,
+			"kube-system": conf.Policy_MODE_IGNORE,
+		},
+	}
+	h := NewHandler(stubChecker{}, policy, log.DefaultLogger)
+
+	testCases := []struct {
+		name         string
+		review       *admissionv1.AdmissionReview
+		allowed      bool
+		wantWarnings int
+		wantMessage  string
+	}{
+		{
+			name:    "compliant images",
+			review:  reviewFor(t, "default", goodImage),
+			allowed: true,
+		},
+		{
+			name:        "denied by the default mode",
+			review:      reviewFor(t, "default", goodImage, badImage),
+			wantMessage: "chainloop: ghcr.io/acme/bad:latest: not pinned by digest",
+		},
+		{
+			name:         "warned in a warn namespace",
+			review:       reviewFor(t, "staging", badImage),
+			allowed:      true,
+			wantWarnings: 1,
+		},
+		{
+			name:    "ignored namespace",
+			review:  reviewFor(t, "kube-system", badImage),
+			allowed: true,
+		},
+		{
+			name: "other resources are not checked",
+			review: func() *admissionv1.AdmissionReview {
+				r := reviewFor(t, "default", badImage)
+				r.Request.Kind.Kind = "ConfigMap"
+				return r
+			}(),
+			allowed: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			body, err := json.Marshal(tc.review)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader(body)))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			got := &admissionv1.AdmissionReview{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), got))
+			require.NotNil(t, got.Response)
+			assert.Nil(t, got.Request)
+			assert.Equal(t, types.UID("1234"), got.Response.UID)
+			assert.Equal(t, tc.allowed, got.Response.Allowed)
+			assert.Len(t, got.Response.Warnings, tc.wantWarnings)
+			if tc.wantMessage != "" {
+				assert.Equal(t, tc.wantMessage, got.Response.Result.Message)
+			}
+		})
+	}
+
+	t.Run("invalid review", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ValidatePath, bytes.NewReader([]byte("{}"))))
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestModeFor(t *testing.T) {
+	nsFile := filepath.Join(t.TempDir(), "namespace")
+	require.NoError(t, os.WriteFile(nsFile, []byte("chainloop\n"), 0o600))
+
+	orig := serviceAccountNamespaceFile
+	serviceAccountNamespaceFile = nsFile
+	t.Cleanup(func() { serviceAccountNamespaceFile = orig })
+
+	testCases := []struct {
+		name      string
+		policy    *conf.Policy
+		namespace string
+		want      conf.Policy_Mode
+	}{
+		{
+			name:      "warns without a default mode",
+			policy:    &conf.Policy{},
+			namespace: "default",
+			want:      conf.Policy_MODE_WARN,
+		},
+		{
+			name:      "default mode",
+			policy:    &conf.Policy{DefaultMode: conf.Policy_MODE_DENY},
+			namespace: "default",
+			want:      conf.Policy_MODE_DENY,
+		},
+		{
+			name:      "system namespace",
+			policy:    &conf.Policy{DefaultMode: conf.Policy_MODE_DENY},
+			namespace: "kube-system",
+			want:      conf.Policy_MODE_IGNORE,
+		},
+		{
+			name:      "namespace of the webhook",
+			policy:    &conf.Policy{DefaultMode: conf.Policy_MODE_DENY},
+			namespace: "chainloop",
+			want:      conf.Policy_MODE_IGNORE,
+		},
+		{
+			name:      "system namespace with an explicit entry",
+			policy:    &conf.Policy{DefaultMode: conf.Policy_MODE_DENY, Namespaces: map[string]conf.Policy_Mode{"kube-system": conf.Policy_MODE_WARN}},
+			namespace: "kube-system",
+			want:      conf.Policy_MODE_WARN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := NewHandler(stubChecker{}, tc.policy, log.DefaultLogger)
+			assert.Equal(t, tc.want, h.modeFor(tc.namespace))
+		})
+	}
+}
@@ -39,6 +39,7 @@ func wireApp(*conf.Bootstrap, *conf.Server, *conf.Auth, credentials.Reader, log.
 			server.ProviderSet,
 			service.ProviderSet,
 			loader.LoadProviders,
+			newCASProvidersConfig,
 			newApp,
 			serviceOpts,
 			newProtoValidator,
@@ -76,3 +77,11 @@ func newNatsConfig(bc *conf.Bootstrap) *natsconn.Config {
 
 	return cfg
 }
+
+// newCASProvidersConfig enables the CAS backend providers that reach resources of the server, if configured
+func newCASProvidersConfig(bc *conf.Bootstrap) *loader.Config {
+	return &loader.Config{
+		FilesystemBaseDir:        bc.GetCasBackends().GetFilesystemBaseDir(),
+		GCSAllowWorkloadIdentity: bc.GetCasBackends().GetGcsAllowWorkloadIdentity(),
+	}
+}
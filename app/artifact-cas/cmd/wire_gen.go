@@ -25,9 +25,10 @@ import (
 
 // wireApp init kratos application.
 func wireApp(bootstrap *conf.Bootstrap, confServer *conf.Server, auth *conf.Auth, reader credentials.Reader, logger log.Logger) (*app, func(), error) {
-	providers := loader.LoadProviders(reader)
-	config := newNatsConfig(bootstrap)
-	reloadableConnection, cleanup, err := natsconn.New(config, logger)
+	config := newCASProvidersConfig(bootstrap)
+	providers := loader.LoadProviders(reader, config)
+	natsconnConfig := newNatsConfig(bootstrap)
+	reloadableConnection, cleanup, err := natsconn.New(natsconnConfig, logger)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -97,3 +98,11 @@ func newNatsConfig(bc *conf.Bootstrap) *natsconn.Config {
 
 	return cfg
 }
+
+// newCASProvidersConfig enables the CAS backend providers that reach resources of the server, if configured
+func newCASProvidersConfig(bc *conf.Bootstrap) *loader.Config {
+	return &loader.Config{
+		FilesystemBaseDir:        bc.GetCasBackends().GetFilesystemBaseDir(),
+		GCSAllowWorkloadIdentity: bc.GetCasBackends().GetGcsAllowWorkloadIdentity(),
+	}
+}
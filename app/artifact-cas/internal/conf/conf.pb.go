@@ -46,7 +46,9 @@ type Bootstrap struct {
 	CredentialsService *v1.Credentials          `protobuf:"bytes,4,opt,name=credentials_service,json=credentialsService,proto3" json:"credentials_service,omitempty"`
 	// Optional NATS server configuration to publish audit events to the
 	// control-plane-owned stream. When unset, event publishing is disabled.
-	NatsServer    *Bootstrap_NatsServer `protobuf:"bytes,5,opt,name=nats_server,json=natsServer,proto3" json:"nats_server,omitempty"`
+	NatsServer *Bootstrap_NatsServer `protobuf:"bytes,5,opt,name=nats_server,json=natsServer,proto3" json:"nats_server,omitempty"`
+	// Deployment-level settings of the CAS backend providers
+	CasBackends   *Bootstrap_CASBackends `protobuf:"bytes,6,opt,name=cas_backends,json=casBackends,proto3" json:"cas_backends,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -116,6 +118,13 @@ func (x *Bootstrap) GetNatsServer() *Bootstrap_NatsServer {
 	return nil
 }
 
+func (x *Bootstrap) GetCasBackends() *Bootstrap_CASBackends {
+	if x != nil {
+		return x.CasBackends
+	}
+	return nil
+}
+
 type Server struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Regular HTTP endpoint
@@ -237,6 +246,62 @@ func (x *Auth) GetPublicKeyPath() string {
 	return ""
 }
 
+type Bootstrap_CASBackends struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Directory the FILESYSTEM CAS backends must be located in, the provider is disabled unless it's set.
+	// It must match the controlplane configuration since both services access the same directories
+	FilesystemBaseDir string `protobuf:"bytes,1,opt,name=filesystem_base_dir,json=filesystemBaseDir,proto3" json:"filesystem_base_dir,omitempty"`
+	// Let GCS CAS backends without a service account key use the workload identity of the server.
+	// Any organization could reach the buckets granted to that identity, so only enable it in single-tenant deployments
+	GcsAllowWorkloadIdentity bool `protobuf:"varint,2,opt,name=gcs_allow_workload_identity,json=gcsAllowWorkloadIdentity,proto3" json:"gcs_allow_workload_identity,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Bootstrap_CASBackends) Reset() {
+	*x = Bootstrap_CASBackends{}
+	mi := &file_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bootstrap_CASBackends) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bootstrap_CASBackends) ProtoMessage() {}
+
+func (x *Bootstrap_CASBackends) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bootstrap_CASBackends.ProtoReflect.Descriptor instead.
+func (*Bootstrap_CASBackends) Descriptor() ([]byte, []int) {
+	return file_conf_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Bootstrap_CASBackends) GetFilesystemBaseDir() string {
+	if x != nil {
+		return x.FilesystemBaseDir
+	}
+	return ""
+}
+
+func (x *Bootstrap_CASBackends) GetGcsAllowWorkloadIdentity() bool {
+	if x != nil {
+		return x.GcsAllowWorkloadIdentity
+	}
+	return false
+}
+
 type Bootstrap_NatsServer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// NATS server URI, e.g. "nats://localhost:4222"
@@ -251,7 +316,7 @@ type Bootstrap_NatsServer struct {
 
 func (x *Bootstrap_NatsServer) Reset() {
 	*x = Bootstrap_NatsServer{}
-	mi := &file_conf_proto_msgTypes[3]
+	mi := &file_conf_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -263,7 +328,7 @@ func (x *Bootstrap_NatsServer) String() string {
 func (*Bootstrap_NatsServer) ProtoMessage() {}
 
 func (x *Bootstrap_NatsServer) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[3]
+	mi := &file_conf_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +341,7 @@ func (x *Bootstrap_NatsServer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bootstrap_NatsServer.ProtoReflect.Descriptor instead.
 func (*Bootstrap_NatsServer) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{0, 0}
+	return file_conf_proto_rawDescGZIP(), []int{0, 1}
 }
 
 func (x *Bootstrap_NatsServer) GetUri() string {
@@ -322,7 +387,7 @@ type Bootstrap_Observability struct {
 
 func (x *Bootstrap_Observability) Reset() {
 	*x = Bootstrap_Observability{}
-	mi := &file_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +399,7 @@ func (x *Bootstrap_Observability) String() string {
 func (*Bootstrap_Observability) ProtoMessage() {}
 
 func (x *Bootstrap_Observability) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[4]
+	mi := &file_conf_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +412,7 @@ func (x *Bootstrap_Observability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bootstrap_Observability.ProtoReflect.Descriptor instead.
 func (*Bootstrap_Observability) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{0, 1}
+	return file_conf_proto_rawDescGZIP(), []int{0, 2}
 }
 
 func (x *Bootstrap_Observability) GetSentry() *Bootstrap_Observability_Sentry {
@@ -375,7 +440,7 @@ type Bootstrap_Observability_Sentry struct {
 
 func (x *Bootstrap_Observability_Sentry) Reset() {
 	*x = Bootstrap_Observability_Sentry{}
-	mi := &file_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +452,7 @@ func (x *Bootstrap_Observability_Sentry) String() string {
 func (*Bootstrap_Observability_Sentry) ProtoMessage() {}
 
 func (x *Bootstrap_Observability_Sentry) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[5]
+	mi := &file_conf_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +465,7 @@ func (x *Bootstrap_Observability_Sentry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bootstrap_Observability_Sentry.ProtoReflect.Descriptor instead.
 func (*Bootstrap_Observability_Sentry) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{0, 1, 0}
+	return file_conf_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
 func (x *Bootstrap_Observability_Sentry) GetDsn() string {
@@ -434,7 +499,7 @@ type Bootstrap_Observability_Tracing struct {
 
 func (x *Bootstrap_Observability_Tracing) Reset() {
 	*x = Bootstrap_Observability_Tracing{}
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +511,7 @@ func (x *Bootstrap_Observability_Tracing) String() string {
 func (*Bootstrap_Observability_Tracing) ProtoMessage() {}
 
 func (x *Bootstrap_Observability_Tracing) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[6]
+	mi := &file_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +524,7 @@ func (x *Bootstrap_Observability_Tracing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bootstrap_Observability_Tracing.ProtoReflect.Descriptor instead.
 func (*Bootstrap_Observability_Tracing) Descriptor() ([]byte, []int) {
-	return file_conf_proto_rawDescGZIP(), []int{0, 1, 1}
+	return file_conf_proto_rawDescGZIP(), []int{0, 2, 1}
 }
 
 func (x *Bootstrap_Observability_Tracing) GetEnabled() bool {
@@ -499,7 +564,7 @@ type Server_CORS struct {
 
 func (x *Server_CORS) Reset() {
 	*x = Server_CORS{}
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -511,7 +576,7 @@ func (x *Server_CORS) String() string {
 func (*Server_CORS) ProtoMessage() {}
 
 func (x *Server_CORS) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[7]
+	mi := &file_conf_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +612,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -559,7 +624,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[8]
+	mi := &file_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +679,7 @@ type Server_TLS struct {
 
 func (x *Server_TLS) Reset() {
 	*x = Server_TLS{}
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -626,7 +691,7 @@ func (x *Server_TLS) String() string {
 func (*Server_TLS) ProtoMessage() {}
 
 func (x *Server_TLS) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[9]
+	mi := &file_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +733,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +745,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_proto_msgTypes[10]
+	mi := &file_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -729,14 +794,18 @@ var File_conf_proto protoreflect.FileDescriptor
 const file_conf_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"conf.proto\x1a\x1bcredentials/v1/config.proto\x1a\x1egoogle/protobuf/duration.proto\"\xb9\x05\n" +
+	"conf.proto\x1a\x1bcredentials/v1/config.proto\x1a\x1egoogle/protobuf/duration.proto\"\xf2\x06\n" +
 	"\tBootstrap\x12\x1f\n" +
 	"\x06server\x18\x01 \x01(\v2\a.ServerR\x06server\x12\x19\n" +
 	"\x04auth\x18\x02 \x01(\v2\x05.AuthR\x04auth\x12>\n" +
 	"\robservability\x18\x03 \x01(\v2\x18.Bootstrap.ObservabilityR\robservability\x12L\n" +
 	"\x13credentials_service\x18\x04 \x01(\v2\x1b.credentials.v1.CredentialsR\x12credentialsService\x126\n" +
 	"\vnats_server\x18\x05 \x01(\v2\x15.Bootstrap.NatsServerR\n" +
-	"natsServer\x1aH\n" +
+	"natsServer\x129\n" +
+	"\fcas_backends\x18\x06 \x01(\v2\x16.Bootstrap.CASBackendsR\vcasBackends\x1a|\n" +
+	"\vCASBackends\x12.\n" +
+	"\x13filesystem_base_dir\x18\x01 \x01(\tR\x11filesystemBaseDir\x12=\n" +
+	"\x1bgcs_allow_workload_identity\x18\x02 \x01(\bR\x18gcsAllowWorkloadIdentity\x1aH\n" +
 	"\n" +
 	"NatsServer\x12\x10\n" +
 	"\x03uri\x18\x01 \x01(\tR\x03uri\x12\x16\n" +
@@ -791,42 +860,44 @@ func file_conf_proto_rawDescGZIP() []byte {
 	return file_conf_proto_rawDescData
 }
 
-var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),                       // 0: Bootstrap
 	(*Server)(nil),                          // 1: Server
 	(*Auth)(nil),                            // 2: Auth
-	(*Bootstrap_NatsServer)(nil),            // 3: Bootstrap.NatsServer
-	(*Bootstrap_Observability)(nil),         // 4: Bootstrap.Observability
-	(*Bootstrap_Observability_Sentry)(nil),  // 5: Bootstrap.Observability.Sentry
-	(*Bootstrap_Observability_Tracing)(nil), // 6: Bootstrap.Observability.Tracing
-	(*Server_CORS)(nil),                     // 7: Server.CORS
-	(*Server_HTTP)(nil),                     // 8: Server.HTTP
-	(*Server_TLS)(nil),                      // 9: Server.TLS
-	(*Server_GRPC)(nil),                     // 10: Server.GRPC
-	(*v1.Credentials)(nil),                  // 11: credentials.v1.Credentials
-	(*durationpb.Duration)(nil),             // 12: google.protobuf.Duration
+	(*Bootstrap_CASBackends)(nil),           // 3: Bootstrap.CASBackends
+	(*Bootstrap_NatsServer)(nil),            // 4: Bootstrap.NatsServer
+	(*Bootstrap_Observability)(nil),         // 5: Bootstrap.Observability
+	(*Bootstrap_Observability_Sentry)(nil),  // 6: Bootstrap.Observability.Sentry
+	(*Bootstrap_Observability_Tracing)(nil), // 7: Bootstrap.Observability.Tracing
+	(*Server_CORS)(nil),                     // 8: Server.CORS
+	(*Server_HTTP)(nil),                     // 9: Server.HTTP
+	(*Server_TLS)(nil),                      // 10: Server.TLS
+	(*Server_GRPC)(nil),                     // 11: Server.GRPC
+	(*v1.Credentials)(nil),                  // 12: credentials.v1.Credentials
+	(*durationpb.Duration)(nil),             // 13: google.protobuf.Duration
 }
 var file_conf_proto_depIdxs = []int32{
 	1,  // 0: Bootstrap.server:type_name -> Server
 	2,  // 1: Bootstrap.auth:type_name -> Auth
-	4,  // 2: Bootstrap.observability:type_name -> Bootstrap.Observability
-	11, // 3: Bootstrap.credentials_service:type_name -> credentials.v1.Credentials
-	3,  // 4: Bootstrap.nats_server:type_name -> Bootstrap.NatsServer
-	8,  // 5: Server.http:type_name -> Server.HTTP
-	10, // 6: Server.grpc:type_name -> Server.GRPC
-	8,  // 7: Server.http_metrics:type_name -> Server.HTTP
-	5,  // 8: Bootstrap.Observability.sentry:type_name -> Bootstrap.Observability.Sentry
-	6,  // 9: Bootstrap.Observability.tracing:type_name -> Bootstrap.Observability.Tracing
-	12, // 10: Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	7,  // 11: Server.HTTP.cors:type_name -> Server.CORS
-	12, // 12: Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	9,  // 13: Server.GRPC.tls_config:type_name -> Server.TLS
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	5,  // 2: Bootstrap.observability:type_name -> Bootstrap.Observability
+	12, // 3: Bootstrap.credentials_service:type_name -> credentials.v1.Credentials
+	4,  // 4: Bootstrap.nats_server:type_name -> Bootstrap.NatsServer
+	3,  // 5: Bootstrap.cas_backends:type_name -> Bootstrap.CASBackends
+	9,  // 6: Server.http:type_name -> Server.HTTP
+	11, // 7: Server.grpc:type_name -> Server.GRPC
+	9,  // 8: Server.http_metrics:type_name -> Server.HTTP
+	6,  // 9: Bootstrap.Observability.sentry:type_name -> Bootstrap.Observability.Sentry
+	7,  // 10: Bootstrap.Observability.tracing:type_name -> Bootstrap.Observability.Tracing
+	13, // 11: Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	8,  // 12: Server.HTTP.cors:type_name -> Server.CORS
+	13, // 13: Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	10, // 14: Server.GRPC.tls_config:type_name -> Server.TLS
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_conf_proto_init() }
@@ -834,17 +905,17 @@ func file_conf_proto_init() {
 	if File_conf_proto != nil {
 		return
 	}
-	file_conf_proto_msgTypes[3].OneofWrappers = []any{
+	file_conf_proto_msgTypes[4].OneofWrappers = []any{
 		(*Bootstrap_NatsServer_Token)(nil),
 	}
-	file_conf_proto_msgTypes[6].OneofWrappers = []any{}
+	file_conf_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_proto_rawDesc), len(file_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
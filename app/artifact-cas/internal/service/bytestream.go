@@ -92,7 +92,7 @@ func (s *ByteStreamService) Write(stream bytestream.ByteStream_WriteServer) erro
 	// We check if the file already exists even before we wait for the whole buffer to be filled
 	if exists, err := storageBackend.Exists(ctx, req.resource.Digest); err != nil {
 		return sl.LogAndMaskErr(err, s.log)
-	} else if exists {
+	} else if exists && s.reuse(ctx, storageBackend, req.resource.Digest) {
 		s.log.Infow("msg", "artifact already exists", "digest", req.resource.Digest)
 		if s.audit.shouldEmit(info) {
 			// the stored size is not known at the dedup point, look it up best-effort
@@ -111,6 +111,9 @@ func (s *ByteStreamService) Write(stream bytestream.ByteStream_WriteServer) erro
 				Skipped: true,
 			}, info)
 		}
+
+		// the replicas might have missed it, i.e they were attached after the first upload
+		s.mirrorInBackground(info, storageBackend, req.resource)
 		return stream.SendAndClose(&bytestream.WriteResponse{})
 	}
 
@@ -162,6 +165,7 @@ func (s *ByteStreamService) Write(stream bytestream.ByteStream_WriteServer) erro
 		},
 	}, info)
 
+	s.mirrorInBackground(info, storageBackend, req.resource)
 	return stream.SendAndClose(&bytestream.WriteResponse{CommittedSize: committedSize})
 }
 
@@ -360,7 +364,7 @@ func (s *ByteStreamService) Read(req *bytestream.ReadRequest, stream bytestream.
 		return kerrors.BadRequest("resource name", "empty resource name")
 	}
 
-	backend, err := s.loadBackend(ctx, info.BackendType, info.StoredSecretID)
+	backend, err := s.loadReadBackend(ctx, info, req.ResourceName)
 	if err != nil && kerrors.IsNotFound(err) {
 		return err
 	} else if err != nil {
@@ -78,10 +78,14 @@ func (s *DownloadService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	b, err := s.loadBackend(ctx, auth.BackendType, auth.StoredSecretID)
+	b, err := s.loadReadBackend(ctx, auth, wantChecksum.Hex)
 	if err != nil && kerrors.IsNotFound(err) {
 		http.Error(w, "backend not found", http.StatusNotFound)
 		return
+	} else if err != nil && backend.IsNotFound(err) {
+		// none of the replicas have it either
+		http.Error(w, "artifact not found", http.StatusNotFound)
+		return
 	} else if err != nil {
 		http.Error(w, sl.LogAndMaskErr(err, s.log).Error(), http.StatusInternalServerError)
 		return
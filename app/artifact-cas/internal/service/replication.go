@@ -0,0 +1,157 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	v1 "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
+	casJWT "github.com/chainloop-dev/chainloop/internal/robotaccount/cas"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+)
+
+const (
+	// mirrorTimeout bounds the time spent copying an artifact to the replicas
+	mirrorTimeout = 30 * time.Minute
+	// maxConcurrentMirrors bounds the artifacts being copied to the replicas at the same time
+	maxConcurrentMirrors = 20
+	// mirrorMaxRetries bounds the attempts to copy an artifact to a replica after the first one fails
+	mirrorMaxRetries = 5
+)
+
+// newMirrorBackOff returns the retry policy of the copy to a replica, overridden in tests
+var newMirrorBackOff = func() backoff.BackOff {
+	return backoff.WithMaxRetries(backoff.NewExponentialBackOff(), mirrorMaxRetries)
+}
+
+// locations returns the backends referenced by the token, the primary goes first
+func locations(info *casJWT.Claims) []*casJWT.Replica {
+	res := []*casJWT.Replica{{BackendType: info.BackendType, StoredSecretID: info.StoredSecretID}}
+	return append(res, info.Replicas...)
+}
+
+// loadReadBackend returns the backend the artifact should be read from.
+// When the token references replicas, the first location able to describe the artifact is selected,
+// that way downloads keep working while the primary backend is unavailable.
+// If none of them can serve it, the error returned by the primary is returned.
+func (s *commonService) loadReadBackend(ctx context.Context, info *casJWT.Claims, digest string) (backend.UploaderDownloader, error) {
+	if len(info.Replicas) == 0 {
+		return s.loadBackend(ctx, info.BackendType, info.StoredSecretID)
+	}
+
+	var primaryErr error
+	for i, l := range locations(info) {
+		b, err := s.loadBackend(ctx, l.BackendType, l.StoredSecretID)
+		if err == nil {
+			_, err = b.Describe(ctx, digest)
+		}
+
+		if err == nil {
+			if i > 0 {
+				s.log.Infow("msg", "artifact served from replica", "digest", digest, "provider", l.BackendType)
+			}
+
+			return b, nil
+		}
+
+		s.log.Warnw("msg", "artifact location unavailable", "digest", digest, "provider", l.BackendType, "err", err)
+		if i == 0 {
+			primaryErr = err
+		}
+	}
+
+	return nil, primaryErr
+}
+
+// mirror copies a stored artifact from the primary backend to the replicas referenced in the token.
+// Each copy is retried, if it still fails the replica will be missing the artifact until it gets uploaded again.
+// That's safe since replicas only serve the artifacts they hold, downloads fall back to the primary otherwise.
+func (s *commonService) mirror(ctx context.Context, info *casJWT.Claims, primary backend.Downloader, resource *v1.CASResource) {
+	for _, r := range info.Replicas {
+		err := backoff.Retry(func() error {
+			return s.mirrorTo(ctx, primary, r, resource)
+		}, backoff.WithContext(newMirrorBackOff(), ctx))
+		if err != nil {
+			s.log.Errorw("msg", "failed to mirror artifact", "digest", resource.Digest, "provider", r.BackendType, "err", err)
+			continue
+		}
+
+		s.log.Infow("msg", "artifact mirrored", "digest", resource.Digest, "provider", r.BackendType)
+	}
+}
+
+// mirrorInBackground runs the mirroring detached from the request so the client doesn't wait for it.
+// When too many artifacts are already being mirrored, the artifact waits for a slot to be released.
+func (s *commonService) mirrorInBackground(info *casJWT.Claims, primary backend.Downloader, resource *v1.CASResource) {
+	if len(info.Replicas) == 0 {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mirrorTimeout)
+		defer cancel()
+
+		select {
+		case s.mirrors <- struct{}{}:
+			defer func() { <-s.mirrors }()
+		case <-ctx.Done():
+			s.log.Errorw("msg", "timed out waiting to mirror artifact", "digest", resource.Digest)
+			return
+		}
+
+		s.mirror(ctx, info, primary, resource)
+	}()
+}
+
+func (s *commonService) mirrorTo(ctx context.Context, primary backend.Downloader, replica *casJWT.Replica, resource *v1.CASResource) error {
+	b, err := s.loadBackend(ctx, replica.BackendType, replica.StoredSecretID)
+	if err != nil {
+		return err
+	}
+
+	if exists, err := b.Exists(ctx, resource.Digest); err != nil {
+		return fmt.Errorf("checking if the artifact exists: %w", err)
+	} else if exists && s.reuse(ctx, b, resource.Digest) {
+		return nil
+	}
+
+	// stream the content from the primary straight into the replica
+	pr, pw := io.Pipe()
+	go func() {
+		_ = pw.CloseWithError(primary.Download(ctx, mirroringWriter{pw}, resource.Digest))
+	}()
+
+	err = b.Upload(ctx, streamingReader{pr}, resource)
+	// unblock the download if the upload didn't consume the whole content
+	_ = pr.CloseWithError(err)
+	if err != nil {
+		return fmt.Errorf("uploading artifact: %w", err)
+	}
+
+	return nil
+}
+
+// mirroringWriter wraps the mirroring pipe writer with a stable string form,
+// for the same reasons as streamingReader.
+type mirroringWriter struct {
+	io.Writer
+}
+
+func (mirroringWriter) String() string { return "cas-mirroring-download" }
@@ -0,0 +1,281 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	v1 "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
+	casJWT "github.com/chainloop-dev/chainloop/internal/robotaccount/cas"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/mocks"
+	jwtMiddleware "github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	replicationBackendType = "backend-type"
+	// sha256 of "hello world"
+	replicationDigest = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+)
+
+func replicatedClaims(role casJWT.Role) *casJWT.Claims {
+	return &casJWT.Claims{
+		Role:           role,
+		StoredSecretID: "primary",
+		BackendType:    replicationBackendType,
+		OrgID:          testOrgID,
+		Replicas: []*casJWT.Replica{
+			{StoredSecretID: "replica-1", BackendType: replicationBackendType},
+			{StoredSecretID: "replica-2", BackendType: replicationBackendType},
+		},
+	}
+}
+
+func TestLoadReadBackend(t *testing.T) {
+	testCases := []struct {
+		name string
+		// describe error per location, nil means the location holds the artifact
+		describeErrs map[string]error
+		want         string
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{
+			name:         "primary serves the artifact",
+			describeErrs: map[string]error{"primary": nil},
+			want:         "primary",
+		},
+		{
+			name:         "falls back to the first healthy replica",
+			describeErrs: map[string]error{"primary": errors.New("region down"), "replica-1": nil},
+			want:         "replica-1",
+		},
+		{
+			name: "skips replicas missing the artifact",
+			describeErrs: map[string]error{
+				"primary": errors.New("region down"), "replica-1": backend.NewErrNotFound("artifact"), "replica-2": nil,
+			},
+			want: "replica-2",
+		},
+		{
+			name: "returns the primary error if no location has it",
+			describeErrs: map[string]error{
+				"primary": backend.NewErrNotFound("artifact"), "replica-1": errors.New("region down"), "replica-2": backend.NewErrNotFound("artifact"),
+			},
+			wantErr:      true,
+			wantNotFound: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			provider := mocks.NewProvider(t)
+			locations := make(map[string]*mocks.UploaderDownloader)
+			for secret, err := range tc.describeErrs {
+				b := mocks.NewUploaderDownloader(t)
+				var res *v1.CASResource
+				if err == nil {
+					res = &v1.CASResource{Digest: replicationDigest}
+				}
+				b.On("Describe", mock.Anything, replicationDigest).Return(res, err)
+				provider.On("FromCredentials", mock.Anything, secret).Return(b, nil)
+				locations[secret] = b
+			}
+
+			s := newCommonService(backend.Providers{replicationBackendType: provider})
+			got, err := s.loadReadBackend(context.Background(), replicatedClaims(casJWT.Downloader), replicationDigest)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tc.wantNotFound, backend.IsNotFound(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Same(t, locations[tc.want], got)
+		})
+	}
+}
+
+func TestLoadReadBackendWithoutReplicas(t *testing.T) {
+	provider := mocks.NewProvider(t)
+	b := mocks.NewUploaderDownloader(t)
+	// the artifact is not described upfront, the regular download path takes care of it
+	provider.On("FromCredentials", mock.Anything, "primary").Return(b, nil)
+
+	claims := replicatedClaims(casJWT.Downloader)
+	claims.Replicas = nil
+
+	s := newCommonService(backend.Providers{replicationBackendType: provider})
+	got, err := s.loadReadBackend(context.Background(), claims, replicationDigest)
+	require.NoError(t, err)
+	assert.Same(t, b, got)
+}
+
+func TestDownloadServiceFailover(t *testing.T) {
+	const content = "hello world"
+
+	provider := mocks.NewProvider(t)
+	primary := mocks.NewUploaderDownloader(t)
+	replica := mocks.NewUploaderDownloader(t)
+	provider.On("FromCredentials", mock.Anything, "primary").Return(primary, nil)
+	provider.On("FromCredentials", mock.Anything, "replica-1").Return(replica, nil)
+
+	primary.On("Describe", mock.Anything, replicationDigest).Return(nil, errors.New("region down"))
+	replica.On("Describe", mock.Anything, replicationDigest).Return(&v1.CASResource{
+		FileName: "test.txt", Digest: replicationDigest, Size: int64(len(content)),
+	}, nil)
+	replica.On("Download", mock.Anything, mock.Anything, replicationDigest).Return(nil).
+		Run(func(args mock.Arguments) {
+			_, err := io.WriteString(args.Get(1).(io.Writer), content)
+			require.NoError(t, err)
+		})
+
+	svc := NewDownloadService(backend.Providers{replicationBackendType: provider})
+
+	req := httptest.NewRequest(http.MethodGet, "/download/sha256:"+replicationDigest, nil)
+	req = mux.SetURLVars(req, map[string]string{"digest": "sha256:" + replicationDigest})
+	req = req.WithContext(jwtMiddleware.NewContext(req.Context(), replicatedClaims(casJWT.Downloader)))
+
+	w := httptest.NewRecorder()
+	svc.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, content, w.Body.String())
+}
+
+func TestMirror(t *testing.T) {
+	const content = "hello world"
+	resource := &v1.CASResource{FileName: "test.txt", Digest: replicationDigest}
+
+	provider := mocks.NewProvider(t)
+	primary := mocks.NewUploaderDownloader(t)
+	missing := mocks.NewUploaderDownloader(t)
+	existing := mocks.NewUploaderDownloader(t)
+	provider.On("FromCredentials", mock.Anything, "replica-1").Return(missing, nil)
+	provider.On("FromCredentials", mock.Anything, "replica-2").Return(existing, nil)
+
+	// the replica already holding the artifact is skipped
+	existing.On("Exists", mock.Anything, replicationDigest).Return(true, nil)
+
+	missing.On("Exists", mock.Anything, replicationDigest).Return(false, nil)
+	primary.On("Download", mock.Anything, mock.Anything, replicationDigest).Return(nil).
+		Run(func(args mock.Arguments) {
+			_, err := io.WriteString(args.Get(1).(io.Writer), content)
+			require.NoError(t, err)
+		})
+
+	var mirrored string
+	missing.On("Upload", mock.Anything, mock.Anything, resource).Return(nil).
+		Run(func(args mock.Arguments) {
+			got, err := io.ReadAll(args.Get(1).(io.Reader))
+			require.NoError(t, err)
+			mirrored = string(got)
+		})
+
+	s := newCommonService(backend.Providers{replicationBackendType: provider})
+	s.mirror(context.Background(), replicatedClaims(casJWT.Uploader), primary, resource)
+
+	assert.Equal(t, content, mirrored)
+}
+
+func TestMirrorRetriesAndContinuesOnFailure(t *testing.T) {
+	const content = "hello world"
+	resource := &v1.CASResource{FileName: "test.txt", Digest: replicationDigest}
+	withoutMirrorDelay(t)
+
+	provider := mocks.NewProvider(t)
+	primary := mocks.NewUploaderDownloader(t)
+	healthy := mocks.NewUploaderDownloader(t)
+	provider.On("FromCredentials", mock.Anything, "replica-1").Return(nil, errors.New("invalid credentials"))
+	provider.On("FromCredentials", mock.Anything, "replica-2").Return(healthy, nil)
+
+	healthy.On("Exists", mock.Anything, replicationDigest).Return(false, nil)
+	// the first copy fails, the retry succeeds
+	primary.On("Download", mock.Anything, mock.Anything, replicationDigest).Return(errors.New("download failed")).Once()
+	primary.On("Download", mock.Anything, mock.Anything, replicationDigest).Return(nil).
+		Run(func(args mock.Arguments) {
+			_, err := io.WriteString(args.Get(1).(io.Writer), content)
+			require.NoError(t, err)
+		}).Once()
+	healthy.On("Upload", mock.Anything, mock.Anything, resource).Return(errors.New("upload failed")).
+		Run(func(args mock.Arguments) {
+			// the download failure reaches the replica through the pipe
+			_, err := io.ReadAll(args.Get(1).(io.Reader))
+			assert.Error(t, err)
+		}).Once()
+
+	var mirrored string
+	healthy.On("Upload", mock.Anything, mock.Anything, resource).Return(nil).
+		Run(func(args mock.Arguments) {
+			got, err := io.ReadAll(args.Get(1).(io.Reader))
+			require.NoError(t, err)
+			mirrored = string(got)
+		}).Once()
+
+	s := newCommonService(backend.Providers{replicationBackendType: provider})
+	s.mirror(context.Background(), replicatedClaims(casJWT.Uploader), primary, resource)
+
+	// the failing replica is retried until it gives up, it doesn't prevent mirroring to the other one
+	provider.AssertNumberOfCalls(t, "FromCredentials", mirrorMaxRetries+1+2)
+	assert.Equal(t, content, mirrored)
+}
+
+func TestMirrorInBackgroundWaitsForASlot(t *testing.T) {
+	resource := &v1.CASResource{FileName: "test.txt", Digest: replicationDigest}
+	withoutMirrorDelay(t)
+
+	provider := mocks.NewProvider(t)
+	primary := mocks.NewUploaderDownloader(t)
+	s := newCommonService(backend.Providers{replicationBackendType: provider})
+
+	var attempts atomic.Int32
+	provider.On("FromCredentials", mock.Anything, mock.Anything).Return(nil, errors.New("invalid credentials")).
+		Run(func(_ mock.Arguments) { attempts.Add(1) })
+
+	// all the slots are taken, so the artifact waits
+	for range maxConcurrentMirrors {
+		s.mirrors <- struct{}{}
+	}
+	s.mirrorInBackground(replicatedClaims(casJWT.Uploader), primary, resource)
+	assert.Never(t, func() bool { return attempts.Load() > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+	// and gets mirrored once a slot is released, releasing it when done
+	<-s.mirrors
+	assert.Eventually(t, func() bool {
+		return attempts.Load() == 2*(mirrorMaxRetries+1) && len(s.mirrors) == maxConcurrentMirrors-1
+	}, time.Second, 10*time.Millisecond)
+}
+
+// withoutMirrorDelay retries the copies to the replicas right away
+func withoutMirrorDelay(t *testing.T) {
+	t.Helper()
+
+	original := newMirrorBackOff
+	newMirrorBackOff = func() backoff.BackOff { return backoff.WithMaxRetries(&backoff.ZeroBackOff{}, mirrorMaxRetries) }
+	t.Cleanup(func() { newMirrorBackOff = original })
+}
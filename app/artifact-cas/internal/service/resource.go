@@ -49,9 +49,12 @@ func (s *ResourceService) Describe(ctx context.Context, req *v1.ResourceServiceD
 		return nil, err
 	}
 
-	b, err := s.loadBackend(ctx, info.BackendType, info.StoredSecretID)
+	b, err := s.loadReadBackend(ctx, info, req.Digest)
 	if err != nil && errors.IsNotFound(err) {
 		return nil, err
+	} else if err != nil && backend.IsNotFound(err) {
+		// none of the replicas have it either
+		return nil, errors.NotFound("not found", err.Error())
 	} else if err != nil {
 		return nil, sl.LogAndMaskErr(err, s.log)
 	}
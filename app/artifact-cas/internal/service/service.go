@@ -38,6 +38,8 @@ type commonService struct {
 	backends backend.Providers
 	// best-effort audit events publisher, nil-safe
 	audit *AuditDispatcher
+	// slots for the artifacts being mirrored in background, see mirrorInBackground
+	mirrors chan struct{}
 }
 
 func (s *commonService) loadBackend(ctx context.Context, providerType, secretID string) (backend.UploaderDownloader, error) {
@@ -58,6 +60,23 @@ func (s *commonService) loadBackend(ctx context.Context, providerType, secretID
 	return backend, nil
 }
 
+// reuse reports whether an artifact that already exists can be reused instead of being uploaded again.
+// Its modification time is refreshed so it's not garbage collected, see backend.Toucher,
+// if that's not possible it needs to be uploaded again.
+func (s *commonService) reuse(ctx context.Context, b backend.Uploader, digest string) bool {
+	t, ok := b.(backend.Toucher)
+	if !ok {
+		return true
+	}
+
+	if err := t.Touch(ctx, digest); err != nil {
+		s.log.Warnw("msg", "failed to refresh existing artifact, uploading it again", "digest", digest, "err", err)
+		return false
+	}
+
+	return true
+}
+
 type NewOpt func(s *commonService)
 
 func WithLogger(logger log.Logger) NewOpt {
@@ -76,6 +95,7 @@ func newCommonService(backends backend.Providers, opts ...NewOpt) *commonService
 	s := &commonService{
 		log:      servicelogger.EmptyLogger(),
 		backends: backends,
+		mirrors:  make(chan struct{}, maxConcurrentMirrors),
 	}
 
 	for _, opt := range opts {
@@ -87,6 +87,32 @@ func TestLoadBackend(t *testing.T) {
 	}
 }
 
+// touchableUploader is a backend that can refresh the modification time of its artifacts
+type touchableUploader struct {
+	*mocks.UploaderDownloader
+	*mocks.Toucher
+}
+
+func TestReuse(t *testing.T) {
+	s := newCommonService(nil)
+
+	t.Run("backend without modification times", func(t *testing.T) {
+		assert.True(t, s.reuse(context.Background(), mocks.NewUploaderDownloader(t), "deadbeef"))
+	})
+
+	t.Run("refreshed", func(t *testing.T) {
+		toucher := mocks.NewToucher(t)
+		toucher.On("Touch", mock.Anything, "deadbeef").Return(nil)
+		assert.True(t, s.reuse(context.Background(), &touchableUploader{mocks.NewUploaderDownloader(t), toucher}, "deadbeef"))
+	})
+
+	t.Run("it needs to be uploaded again if it can't be refreshed", func(t *testing.T) {
+		toucher := mocks.NewToucher(t)
+		toucher.On("Touch", mock.Anything, "deadbeef").Return(errors.New("boom"))
+		assert.False(t, s.reuse(context.Background(), &touchableUploader{mocks.NewUploaderDownloader(t), toucher}, "deadbeef"))
+	})
+}
+
 func TestIsClientDisconnect(t *testing.T) {
 	testCases := []struct {
 		name string
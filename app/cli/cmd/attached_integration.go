@@ -25,6 +25,7 @@ func newAttachedIntegrationCmd() *cobra.Command {
 		Short: "Integrations attached to workflows",
 	}
 
-	cmd.AddCommand(newAttachedIntegrationAttachCmd(), newAttachedIntegrationDeleteCmd(), newAttachedIntegrationListCmd())
+	cmd.AddCommand(newAttachedIntegrationAttachCmd(), newAttachedIntegrationDeleteCmd(), newAttachedIntegrationListCmd(),
+		newAttachedIntegrationDeliveriesCmd(), newAttachedIntegrationFailedDeliveriesCmd(), newAttachedIntegrationReplayCmd())
 	return cmd
 }
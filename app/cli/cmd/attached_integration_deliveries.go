@@ -0,0 +1,73 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/options"
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newAttachedIntegrationDeliveriesCmd() *cobra.Command {
+	opts := &action.AttachedIntegrationDeliveriesOpts{}
+	var paginationOpts = &options.OffsetPaginationOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "deliveries",
+		Short: "List the delivery history of an attached integration",
+		Example: `  # List the deliveries of an attachment, most recent first
+  chainloop integration attached deliveries --id deadbeef
+
+  # Only the deliveries of a workflow run that are still pending
+  chainloop integration attached deliveries --id deadbeef --workflow-run cafebabe --status pending`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if paginationOpts.Page < 1 {
+				return fmt.Errorf("--page must be greater or equal than 1")
+			}
+			if paginationOpts.Limit < 1 {
+				return fmt.Errorf("--limit must be greater or equal than 1")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts.Page, opts.PageSize = paginationOpts.Page, paginationOpts.Limit
+			res, err := action.NewAttachedIntegrationDeliveries(ActionOpts).Run(cmd.Context(), opts)
+			if err != nil {
+				return err
+			}
+
+			if err := output.EncodeOutput(flagOutputFormat, res, integrationDeliveryListTableOutput); err != nil {
+				return err
+			}
+
+			logPaginationInfo(res.Pagination, paginationOpts, len(res.Deliveries))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.AttachmentID, "id", "", "ID of the existing attachment")
+	cobra.CheckErr(cmd.MarkFlagRequired("id"))
+	cmd.Flags().StringVar(&opts.WorkflowRunID, "workflow-run", "", "only the deliveries of this workflow run")
+	cmd.Flags().StringVar(&opts.Status, "status", "", fmt.Sprintf("only the deliveries in this status, one of: %s", strings.Join(action.IntegrationDeliveryStatuses, ", ")))
+	paginationOpts.AddFlags(cmd)
+
+	return cmd
+}
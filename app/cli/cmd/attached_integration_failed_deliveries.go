@@ -0,0 +1,86 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/options"
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newAttachedIntegrationFailedDeliveriesCmd() *cobra.Command {
+	var attachmentID string
+	var paginationOpts = &options.OffsetPaginationOpts{}
+
+	cmd := &cobra.Command{
+		Use:   "failed-deliveries",
+		Short: "List the deliveries to an attached integration that ran out of retries",
+		Example: `  # List the failed deliveries of an attachment
+  chainloop integration attached failed-deliveries --id deadbeef`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if paginationOpts.Page < 1 {
+				return fmt.Errorf("--page must be greater or equal than 1")
+			}
+			if paginationOpts.Limit < 1 {
+				return fmt.Errorf("--limit must be greater or equal than 1")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewAttachedIntegrationFailedDeliveries(ActionOpts).Run(cmd.Context(), attachmentID, paginationOpts.Page, paginationOpts.Limit)
+			if err != nil {
+				return err
+			}
+
+			if err := output.EncodeOutput(flagOutputFormat, res, integrationDeliveryListTableOutput); err != nil {
+				return err
+			}
+
+			logPaginationInfo(res.Pagination, paginationOpts, len(res.Deliveries))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&attachmentID, "id", "", "ID of the existing attachment")
+	cobra.CheckErr(cmd.MarkFlagRequired("id"))
+	paginationOpts.AddFlags(cmd)
+
+	return cmd
+}
+
+func integrationDeliveryListTableOutput(res *action.IntegrationDeliveryListResult) error {
+	if len(res.Deliveries) == 0 {
+		fmt.Println("there are no deliveries")
+		return nil
+	}
+
+	t := output.NewTableWriter()
+	t.AppendHeader(table.Row{"ID", "Workflow Run", "Status", "Attempts", "Duration", "Last Error", "Created At"})
+
+	for _, d := range res.Deliveries {
+		t.AppendRow(table.Row{d.ID, d.WorkflowRunID, d.Status, d.Attempts, d.Duration, d.LastError, d.CreatedAt.Format(time.RFC822)})
+		t.AppendSeparator()
+	}
+	t.Render()
+
+	return nil
+}
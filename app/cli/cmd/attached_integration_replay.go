@@ -0,0 +1,52 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newAttachedIntegrationReplayCmd() *cobra.Command {
+	var attachmentID, deliveryID string
+
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Retry the failed deliveries to an attached integration",
+		Example: `  # Replay all the failed deliveries of an attachment
+  chainloop integration attached replay --id deadbeef
+
+  # Replay a single delivery
+  chainloop integration attached replay --id deadbeef --delivery-id cafebabe`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			n, err := action.NewAttachedIntegrationReplay(ActionOpts).Run(cmd.Context(), attachmentID, deliveryID)
+			if err != nil {
+				return err
+			}
+
+			logger.Info().Msg(fmt.Sprintf("%d deliveries queued to be retried", n))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&attachmentID, "id", "", "ID of the existing attachment")
+	cobra.CheckErr(cmd.MarkFlagRequired("id"))
+	cmd.Flags().StringVar(&deliveryID, "delivery-id", "", "replay only this delivery, all the failed deliveries are replayed otherwise")
+
+	return cmd
+}
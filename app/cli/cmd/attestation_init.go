@@ -202,6 +202,8 @@ func newAttestationInitCmd() *cobra.Command {
 	cmd.Flags().StringSliceVar(&collectors, "collectors", nil, "comma-separated list of additional collectors to enable (e.g. aiconfig)")
 	cmd.Flags().BoolVar(&markAsLatest, "mark-latest", true, "explicitly mark the project version as latest (default: automatic for new versions; use =false to skip promotion)")
 	cmd.Flags().BoolVar(&prMode, "pr", false, "mark this attestation as a pull/merge request build (sets the chainloop.dev/is-pull-request annotation; auto-detected from CI env if not set)")
+	flagPolicyVerificationKeys(cmd)
+	flagVulnerabilityDB(cmd)
 
 	return cmd
 }
@@ -164,6 +164,8 @@ func newAttestationPushCmd() *cobra.Command {
 	cmd.Flags().StringVar(&signServerAuthCertPass, "signserver-client-pass", "", "certificate passphrase for authenticated SignServer TLS connection")
 	cmd.Flags().BoolVar(&bypassPolicyCheck, exceptionFlagName, false, "do not fail this command on policy violations enforcement")
 	cmd.Flags().BoolVar(&deactivateCIReport, "deactivate-ci-report", false, "deactivate automatic attestation report to CI/CD platform")
+	flagPolicyVerificationKeys(cmd)
+	flagVulnerabilityDB(cmd)
 
 	return cmd
 }
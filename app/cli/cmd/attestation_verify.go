@@ -1,5 +1,5 @@
 //
-// Copyright 2025 The Chainloop Authors.
+// Copyright 2025-2026 The Chainloop Authors.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -24,27 +24,37 @@ import (
 
 func newAttestationVerifyCmd() *cobra.Command {
 	var fileOrURL string
+	opts := &action.AttestationVerifyOpts{}
+
 	cmd := &cobra.Command{
-		Use:                   "verify file-or-url",
-		Short:                 "verify an attestation",
-		Long:                  "Verify an attestation by validating its validation material against the configured trusted root",
+		Use:   "verify file-or-url",
+		Short: "verify an attestation",
+		Long: `Verify an attestation by validating its validation material against the configured trusted root.
+
+By default the trusted root is retrieved from the control plane. Providing a trusted root file,
+a public key or certificate chains verifies the attestation offline against that material instead.
+The command exits with a non-zero code if the attestation or any of the requested checks fail.`,
 		DisableFlagsInUseLine: true,
 		Example: `  # verify local attestation
   chainloop attestation verify --bundle attestation.json
 
   # verify an attestation stored in an https endpoint
-  chainloop attestation verify -b https://myrepository/attestation.json`,
+  chainloop attestation verify -b https://myrepository/attestation.json
+
+  # verify offline with a sigstore trusted root and check that it refers to a given container image
+  chainloop attestation verify -b attestation.json --trusted-root trusted_root.json --subject-digest sha256:deadbeef
+
+  # verify offline an attestation signed with a cosign key and fail on policy violations
+  chainloop attestation verify -b attestation.json --key cosign.pub --check-policies
+
+  # verify offline against the signing CA and timestamp authority chains
+  chainloop attestation verify -b attestation.json --cert-chain fulcio-chain.pem --tsa-cert-chain tsa-chain.pem`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			res, err := action.NewAttestationVerifyAction(ActionOpts).Run(cmd.Context(), fileOrURL)
-			if err != nil {
+			if err := action.NewAttestationVerifyAction(ActionOpts).Run(cmd.Context(), fileOrURL, opts); err != nil {
 				return fmt.Errorf("verifying attestation: %w", err)
 			}
-			if res {
-				ActionOpts.Logger.Info().Msg("attestation verified successfully")
-			} else {
-				ActionOpts.Logger.Warn().Msg("attestation couldn't be verified")
-			}
 
+			ActionOpts.Logger.Info().Msg("attestation verified successfully")
 			return nil
 		},
 	}
@@ -52,5 +62,12 @@ func newAttestationVerifyCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&fileOrURL, "bundle", "b", "", "bundle path or URL")
 	cobra.CheckErr(cmd.MarkFlagRequired("bundle"))
 
+	cmd.Flags().StringVar(&opts.TrustedRootPath, "trusted-root", "", "path to a sigstore trusted_root.json file to verify the attestation offline")
+	cmd.Flags().StringVar(&opts.PublicKeyRef, "key", "", "public key used to verify the attestation offline, i.e a cosign public key")
+	cmd.Flags().StringVar(&opts.CertChainPath, "cert-chain", "", "certificate chain (intermediates, root) in PEM format of the signing CA to verify the attestation offline")
+	cmd.Flags().StringVar(&opts.TSACertChainPath, "tsa-cert-chain", "", "certificate chain (leaf, intermediates, root) in PEM format of the timestamp authority")
+	cmd.Flags().StringVar(&opts.SubjectDigest, "subject-digest", "", "digest, in the form algorithm:hex, that must match one of the attestation subjects")
+	cmd.Flags().BoolVar(&opts.CheckPolicies, "check-policies", false, "fail if the attestation policy evaluations reported violations")
+
 	return cmd
 }
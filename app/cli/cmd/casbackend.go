@@ -27,6 +27,7 @@ var (
 	isDefaultCASBackendUpdateOption   *bool
 	isFallbackCASBackendUpdateOption  *bool
 	descriptionCASBackendUpdateOption *string
+	replicaOfCASBackendUpdateOption   *string
 	maxBytesCASBackendOption          string
 	parsedMaxBytes                    *int64
 )
@@ -37,7 +38,7 @@ func newCASBackendCmd() *cobra.Command {
 		Short: "Operations on Artifact CAS backends",
 	}
 
-	cmd.AddCommand(newCASBackendListCmd(), newCASBackendAddCmd(), newCASBackendUpdateCmd(), newCASBackendDeleteCmd())
+	cmd.AddCommand(newCASBackendListCmd(), newCASBackendAddCmd(), newCASBackendUpdateCmd(), newCASBackendDeleteCmd(), newCASBackendRetentionCmd())
 	return cmd
 }
 
@@ -55,14 +56,14 @@ func newCASBackendAddCmd() *cobra.Command {
 	err := cmd.MarkPersistentFlagRequired("name")
 	cobra.CheckErr(err)
 
-	cmd.AddCommand(newCASBackendAddOCICmd(), newCASBackendAddAzureBlobStorageCmd(), newCASBackendAddAWSS3Cmd())
+	cmd.AddCommand(newCASBackendAddOCICmd(), newCASBackendAddAzureBlobStorageCmd(), newCASBackendAddAWSS3Cmd(), newCASBackendAddFilesystemCmd(), newCASBackendAddGCSCmd())
 	return cmd
 }
 
 func newCASBackendUpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
-		Short: "Update a CAS backend description, credentials, default status, fallback status, or max bytes",
+		Short: "Update a CAS backend description, credentials, default status, fallback status, max bytes or replication",
 	}
 
 	cmd.PersistentFlags().Bool("default", false, "set the backend as default in your organization")
@@ -70,8 +71,9 @@ func newCASBackendUpdateCmd() *cobra.Command {
 	cmd.PersistentFlags().String("description", "", "descriptive information for this registration")
 	cmd.PersistentFlags().String("name", "", "CAS backend name")
 	cmd.PersistentFlags().StringVar(&maxBytesCASBackendOption, "max-bytes", "", "Maximum size for each blob stored in this backend (e.g., 100MB, 1GB). Note: not supported for inline backends.")
+	cmd.PersistentFlags().String("replica-of", "", "name of the backend to mirror, making this one a replica of it. Use an empty value to detach it from its primary")
 
-	cmd.AddCommand(newCASBackendUpdateOCICmd(), newCASBackendUpdateInlineCmd(), newCASBackendUpdateAzureBlobCmd(), newCASBackendUpdateAWSS3Cmd())
+	cmd.AddCommand(newCASBackendUpdateOCICmd(), newCASBackendUpdateInlineCmd(), newCASBackendUpdateAzureBlobCmd(), newCASBackendUpdateAWSS3Cmd(), newCASBackendUpdateGCSCmd())
 	return cmd
 }
 
@@ -151,7 +153,7 @@ func parseMaxBytesOption() error {
 	return nil
 }
 
-// captureUpdateFlags reads the --default, --fallback, and --description flags only when explicitly set and
+// captureUpdateFlags reads the --default, --fallback, --description and --replica-of flags only when explicitly set and
 // stores their values in the package-level pointer options. This avoids treating their zero
 // values as an intention to update.
 func captureUpdateFlags(cmd *cobra.Command) error {
@@ -179,6 +181,14 @@ func captureUpdateFlags(cmd *cobra.Command) error {
 		descriptionCASBackendUpdateOption = &v
 	}
 
+	if f := cmd.Flags().Lookup("replica-of"); f != nil && f.Changed {
+		v, err := cmd.Flags().GetString("replica-of")
+		if err != nil {
+			return err
+		}
+		replicaOfCASBackendUpdateOption = &v
+	}
+
 	return nil
 }
 
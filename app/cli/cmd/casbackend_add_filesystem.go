@@ -0,0 +1,92 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/filesystem"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+)
+
+func newCASBackendAddFilesystemCmd() *cobra.Command {
+	var path string
+	cmd := &cobra.Command{
+		Use:   "filesystem",
+		Short: "Register a local filesystem CAS Backend",
+		Long: `Register a directory as CAS Backend. It's meant for on-prem and development setups,
+the directory must be accessible at the same path by both the Control Plane and the Artifact CAS,
+for example a shared volume or NFS mount.
+
+The provider must be enabled by the server operator, and the directory must exist within the directory of
+your organization in the configured base directory. Relative paths are relative to that directory.`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return parseMaxBytesOption()
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// If we are setting the default, we list existing CAS backends
+			// and ask the user to confirm the rewrite
+			isDefault, err := cmd.Flags().GetBool("default")
+			cobra.CheckErr(err)
+
+			isFallback, err := cmd.Flags().GetBool("fallback")
+			cobra.CheckErr(err)
+
+			name, err := cmd.Flags().GetString("name")
+			cobra.CheckErr(err)
+
+			description, err := cmd.Flags().GetString("description")
+			cobra.CheckErr(err)
+
+			if isDefault {
+				if confirmed, err := confirmDefaultCASBackendOverride(ActionOpts, ""); err != nil {
+					return err
+				} else if !confirmed {
+					log.Info("Aborting...")
+					return nil
+				}
+			}
+
+			opts := &action.NewCASBackendAddOpts{
+				Name:        name,
+				Location:    path,
+				Provider:    filesystem.ProviderID,
+				Description: description,
+				// The directory is the only setting, access control is delegated to the OS
+				Credentials: map[string]any{},
+				Default:     isDefault,
+				Fallback:    isFallback,
+				MaxBytes:    parsedMaxBytes,
+			}
+
+			res, err := action.NewCASBackendAdd(ActionOpts).Run(opts)
+			if err != nil {
+				return err
+			} else if res == nil {
+				return nil
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, casBackendItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&path, "path", "", "path to the directory where the artifacts will be stored, relative to the directory of the organization if not absolute")
+	err := cmd.MarkFlagRequired("path")
+	cobra.CheckErr(err)
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/gcs"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+)
+
+func newCASBackendAddGCSCmd() *cobra.Command {
+	var bucketName, serviceAccountKeyPath string
+	cmd := &cobra.Command{
+		Use:   "gcs",
+		Short: "Register a Google Cloud Storage bucket",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return parseMaxBytesOption()
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			isDefault, err := cmd.Flags().GetBool("default")
+			cobra.CheckErr(err)
+
+			isFallback, err := cmd.Flags().GetBool("fallback")
+			cobra.CheckErr(err)
+
+			name, err := cmd.Flags().GetString("name")
+			cobra.CheckErr(err)
+
+			description, err := cmd.Flags().GetString("description")
+			cobra.CheckErr(err)
+
+			serviceAccountKey, err := readGCSServiceAccountKey(serviceAccountKeyPath)
+			if err != nil {
+				return err
+			}
+
+			if isDefault {
+				if confirmed, err := confirmDefaultCASBackendOverride(ActionOpts, ""); err != nil {
+					return err
+				} else if !confirmed {
+					log.Info("Aborting...")
+					return nil
+				}
+			}
+
+			opts := &action.NewCASBackendAddOpts{
+				Name:        name,
+				Location:    bucketName,
+				Provider:    gcs.ProviderID,
+				Description: description,
+				// an empty key means that the workload identity of the Chainloop services will be used
+				Credentials: map[string]any{
+					"serviceAccountKey": serviceAccountKey,
+				},
+				Default:  isDefault,
+				Fallback: isFallback,
+				MaxBytes: parsedMaxBytes,
+			}
+
+			res, err := action.NewCASBackendAdd(ActionOpts).Run(opts)
+			if err != nil {
+				return err
+			} else if res == nil {
+				return nil
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, casBackendItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&bucketName, "bucket", "", "GCS bucket name")
+	err := cmd.MarkFlagRequired("bucket")
+	cobra.CheckErr(err)
+
+	cmd.Flags().StringVar(&serviceAccountKeyPath, "service-account-key", "", "path to a service account JSON key, if not provided, the workload identity of the Chainloop services will be used, if enabled by the server operator")
+
+	return cmd
+}
+
+// readGCSServiceAccountKey returns the content of the service account key file, if any
+func readGCSServiceAccountKey(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading service account key: %w", err)
+	}
+
+	return string(key), nil
+}
@@ -58,11 +58,17 @@ func casBackendListTableOutput(backends []*action.CASBackendItem) error {
 	}
 
 	t := output.NewTableWriter()
-	header := table.Row{"Name", "Location", "Provider", "Description", "Limits", "Default", "Fallback", "Status"}
+	header := table.Row{"Name", "Location", "Provider", "Description", "Limits", "Default", "Fallback", "Replica Of", "Status"}
 	if full {
 		header = append(header, "Created At", "Validated At")
 	}
 
+	// used to display the name of the primary of each replica
+	names := make(map[string]string, len(backends))
+	for _, b := range backends {
+		names[b.ID] = b.Name
+	}
+
 	t.AppendHeader(header)
 	for _, b := range backends {
 		limits := "no limits"
@@ -75,7 +81,16 @@ func casBackendListTableOutput(backends []*action.CASBackendItem) error {
 			validationStatus = strings.Join([]string{validationStatus, wrap.String(*b.ValidationError, 50)}, "\n")
 		}
 
-		r := table.Row{b.Name, wrap.String(b.Location, 35), b.Provider, wrap.String(b.Description, 35), limits, b.Default, b.Fallback, validationStatus}
+		var replicaOf string
+		if b.PrimaryID != nil {
+			// fallback to the ID if the primary is not part of the output, i.e after an update
+			replicaOf = *b.PrimaryID
+			if name, ok := names[*b.PrimaryID]; ok {
+				replicaOf = name
+			}
+		}
+
+		r := table.Row{b.Name, wrap.String(b.Location, 35), b.Provider, wrap.String(b.Description, 35), limits, b.Default, b.Fallback, replicaOf, validationStatus}
 		if full {
 			r = append(r, b.CreatedAt.Format(time.RFC822), b.ValidatedAt.Format(time.RFC822))
 		}
@@ -0,0 +1,150 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newCASBackendRetentionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retention",
+		Short: "Manage the retention rules that garbage collect the artifacts stored in your CAS backends",
+		Long: `Manage the retention rules that garbage collect the artifacts stored in your CAS backends.
+
+Artifacts not referenced by any attestation in the given number of days are periodically deleted from the backend.
+A rule can be set for a specific CAS backend or as the organization default, which applies to every backend without a rule of its own.`,
+	}
+
+	cmd.AddCommand(newCASBackendRetentionListCmd(), newCASBackendRetentionSetCmd(), newCASBackendRetentionDeleteCmd())
+	return cmd
+}
+
+func newCASBackendRetentionListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the retention rules of your organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := action.NewCASRetentionList(ActionOpts).Run()
+			if err != nil {
+				return err
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, casRetentionListTableOutput)
+		},
+	}
+
+	return cmd
+}
+
+func newCASBackendRetentionSetCmd() *cobra.Command {
+	var (
+		name                 string
+		maxAgeDays           int32
+		keepReleasedVersions bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the retention rule of a CAS backend, or the organization default one",
+		Example: `  # Delete the artifacts not referenced in 90 days from any backend without a rule of its own
+  chainloop cas-backend retention set --max-age-days 90
+
+  # Keep the artifacts of released project versions stored in the given backend
+  chainloop cas-backend retention set --name my-bucket --max-age-days 30 --keep-released-versions`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := action.NewCASRetentionSet(ActionOpts).Run(&action.NewCASRetentionSetOpts{
+				CASBackendName:       name,
+				MaxAgeDays:           maxAgeDays,
+				KeepReleasedVersions: keepReleasedVersions,
+			})
+			if err != nil {
+				return err
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, casRetentionItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "CAS backend name, leave it empty to set the organization default rule")
+	cmd.Flags().Int32Var(&maxAgeDays, "max-age-days", 0, "delete the artifacts not referenced in this number of days")
+	cmd.Flags().BoolVar(&keepReleasedVersions, "keep-released-versions", false, "keep the artifacts referenced by released project versions regardless of their age")
+	cobra.CheckErr(cmd.MarkFlagRequired("max-age-days"))
+
+	return cmd
+}
+
+func newCASBackendRetentionDeleteCmd() *cobra.Command {
+	var id string
+
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Aliases: []string{"rm"},
+		Short:   "Delete a retention rule, the artifacts it covers are kept from then on",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := action.NewCASRetentionDelete(ActionOpts).Run(id); err != nil {
+				return err
+			}
+
+			logger.Info().Msg("Retention rule deleted")
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "retention rule ID")
+	cobra.CheckErr(cmd.MarkFlagRequired("id"))
+	return cmd
+}
+
+func casRetentionItemTableOutput(rule *action.CASRetentionRuleItem) error {
+	return casRetentionListTableOutput([]*action.CASRetentionRuleItem{rule})
+}
+
+func casRetentionListTableOutput(rules []*action.CASRetentionRuleItem) error {
+	if len(rules) == 0 {
+		fmt.Println("there are no retention rules, artifacts are kept forever")
+		return nil
+	}
+
+	t := output.NewTableWriter()
+	t.AppendHeader(table.Row{"ID", "CAS Backend", "Max Age (days)", "Keep Released Versions", "Last Swept At"})
+	for _, r := range rules {
+		backend := r.CASBackendName
+		if backend == "" {
+			backend = "(organization default)"
+		}
+
+		var lastSwept string
+		if r.LastSweptAt != nil {
+			lastSwept = r.LastSweptAt.Format(time.RFC822)
+		}
+
+		t.AppendRow(table.Row{r.ID, backend, r.MaxAgeDays, r.KeepReleasedVersions, lastSwept})
+		t.AppendSeparator()
+	}
+
+	t.Render()
+
+	return nil
+}
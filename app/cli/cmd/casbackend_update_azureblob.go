@@ -52,9 +52,10 @@ func newCASBackendUpdateAzureBlobCmd() *cobra.Command {
 					"clientID":     clientID,
 					"clientSecret": clientSecret,
 				},
-				Default:  isDefaultCASBackendUpdateOption,
-				Fallback: isFallbackCASBackendUpdateOption,
-				MaxBytes: parsedMaxBytes,
+				Default:   isDefaultCASBackendUpdateOption,
+				Fallback:  isFallbackCASBackendUpdateOption,
+				MaxBytes:  parsedMaxBytes,
+				ReplicaOf: replicaOfCASBackendUpdateOption,
 			}
 
 			// this means that we are not updating credentials
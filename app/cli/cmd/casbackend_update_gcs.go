@@ -0,0 +1,90 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/spf13/cobra"
+)
+
+func newCASBackendUpdateGCSCmd() *cobra.Command {
+	var backendName, serviceAccountKeyPath string
+	var useWorkloadIdentity bool
+	cmd := &cobra.Command{
+		Use:   "gcs",
+		Short: "Update a Google Cloud Storage CAS Backend description, credentials, default status, or max bytes",
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return parseMaxBytesOption()
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// capture flags only when explicitly set
+			if err := captureUpdateFlags(cmd); err != nil {
+				return err
+			}
+
+			serviceAccountKey, err := readGCSServiceAccountKey(serviceAccountKeyPath)
+			if err != nil {
+				return err
+			}
+
+			// If we are overriding/unsetting the default we ask for confirmation
+			if ok, err := handleDefaultUpdateConfirmation(ActionOpts, backendName); err != nil {
+				return err
+			} else if !ok {
+				log.Info("Aborting...")
+				return nil
+			}
+
+			opts := &action.NewCASBackendUpdateOpts{
+				Name:        backendName,
+				Description: descriptionCASBackendUpdateOption,
+				Credentials: map[string]any{
+					"serviceAccountKey": serviceAccountKey,
+				},
+				Default:   isDefaultCASBackendUpdateOption,
+				Fallback:  isFallbackCASBackendUpdateOption,
+				MaxBytes:  parsedMaxBytes,
+				ReplicaOf: replicaOfCASBackendUpdateOption,
+			}
+
+			// this means that we are not updating credentials
+			if serviceAccountKey == "" && !useWorkloadIdentity {
+				opts.Credentials = nil
+			}
+
+			res, err := action.NewCASBackendUpdate(ActionOpts).Run(opts)
+			if err != nil {
+				return err
+			} else if res == nil {
+				return nil
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, casBackendItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&backendName, "name", "", "CAS Backend name")
+	err := cmd.MarkFlagRequired("name")
+	cobra.CheckErr(err)
+
+	cmd.Flags().StringVar(&serviceAccountKeyPath, "service-account-key", "", "path to a new service account JSON key")
+	cmd.Flags().BoolVar(&useWorkloadIdentity, "workload-identity", false, "stop using a service account key and rely on the workload identity of the Chainloop services instead, if enabled by the server operator")
+	cmd.MarkFlagsMutuallyExclusive("service-account-key", "workload-identity")
+
+	return cmd
+}
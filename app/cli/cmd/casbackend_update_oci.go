@@ -51,9 +51,10 @@ func newCASBackendUpdateOCICmd() *cobra.Command {
 					"username": username,
 					"password": password,
 				},
-				Default:  isDefaultCASBackendUpdateOption,
-				Fallback: isFallbackCASBackendUpdateOption,
-				MaxBytes: parsedMaxBytes,
+				Default:   isDefaultCASBackendUpdateOption,
+				Fallback:  isFallbackCASBackendUpdateOption,
+				MaxBytes:  parsedMaxBytes,
+				ReplicaOf: replicaOfCASBackendUpdateOption,
 			}
 
 			if username == "" && password == "" {
@@ -52,9 +52,10 @@ func newCASBackendUpdateAWSS3Cmd() *cobra.Command {
 					"secretAccessKey": secretAccessKey,
 					"region":          region,
 				},
-				Default:  isDefaultCASBackendUpdateOption,
-				Fallback: isFallbackCASBackendUpdateOption,
-				MaxBytes: parsedMaxBytes,
+				Default:   isDefaultCASBackendUpdateOption,
+				Fallback:  isFallbackCASBackendUpdateOption,
+				MaxBytes:  parsedMaxBytes,
+				ReplicaOf: replicaOfCASBackendUpdateOption,
 			}
 
 			// this means that we are not updating credentials
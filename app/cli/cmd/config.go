@@ -26,7 +26,7 @@ import (
 
 // Map of all the possible configuration options that we expect viper to handle
 var confOptions = struct {
-	authToken, controlplaneAPI, CASAPI, controlplaneCA, CASCA, insecure, organization, platformAPI, maxRecvMsgSize *confOpt
+	authToken, controlplaneAPI, CASAPI, controlplaneCA, CASCA, insecure, organization, platformAPI, maxRecvMsgSize, policyVerificationKeys, vulnerabilityDB *confOpt
 }{
 	insecure: &confOpt{
 		viperKey: "api-insecure",
@@ -58,6 +58,14 @@ var confOptions = struct {
 		viperKey: "api-max-recv-msg-size",
 		flagName: "max-recv-msg-size",
 	},
+	policyVerificationKeys: &confOpt{
+		viperKey: "policies.verification-keys",
+		flagName: "policy-verification-key",
+	},
+	vulnerabilityDB: &confOpt{
+		viperKey: "policies.vulnerability-db",
+		flagName: "vulnerability-db",
+	},
 }
 
 type confOpt struct {
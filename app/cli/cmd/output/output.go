@@ -49,16 +49,26 @@ type tabulatedData interface {
 		*action.RegisteredIntegrationItem |
 		[]*action.AvailableIntegrationItem |
 		[]*action.AttachedIntegrationItem |
+		*action.IntegrationDeliveryListResult |
 		[]*action.MembershipItem |
 		*action.CASBackendItem |
 		[]*action.CASBackendItem |
+		*action.CASRetentionRuleItem |
+		[]*action.CASRetentionRuleItem |
 		[]*action.OrgInvitationItem |
 		*action.APITokenItem |
 		[]*action.APITokenItem |
 		*action.AttestationStatusMaterial |
 		[]*action.AttestationStatusMaterial |
 		*action.ListMembershipResult |
-		*action.PolicyLintResult
+		*action.PolicyLintResult |
+		*action.PolicyTestResult |
+		*action.PolicyImpactReport |
+		*action.ProjectItem |
+		*action.ProjectListResult |
+		*action.ProjectDescribeResult |
+		*action.ProjectVersionItem |
+		*action.ProjectVersionListResult
 }
 
 // returns either json or table representation of the result
@@ -28,6 +28,6 @@ func newPolicyDevelopCmd() *cobra.Command {
 Refer to https://docs.chainloop.dev/guides/custom-policies for more information.`,
 	}
 
-	cmd.AddCommand(newPolicyDevelopInitCmd(), newPolicyDevelopLintCmd(), newPolicyDevelopEvalCmd())
+	cmd.AddCommand(newPolicyDevelopInitCmd(), newPolicyDevelopLintCmd(), newPolicyDevelopEvalCmd(), newPolicyDevelopTestCmd())
 	return cmd
 }
@@ -82,6 +82,8 @@ evaluates the policy against the provided material or attestation.`,
 	cmd.Flags().StringSliceVar(&allowedHostnames, "allowed-hostnames", []string{}, "Additional hostnames allowed for http.send requests in policies")
 	cmd.Flags().StringVar(&projectName, "project", "", "Project name to use as engine context for chainloop.* built-ins")
 	cmd.Flags().StringVar(&projectVersionName, "project-version", "", "Project version to use as engine context for chainloop.* built-ins")
+	flagPolicyVerificationKeys(cmd)
+	flagVulnerabilityDB(cmd)
 
 	return cmd
 }
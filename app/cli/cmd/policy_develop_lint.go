@@ -37,7 +37,8 @@ func newPolicyDevelopLintCmd() *cobra.Command {
 		Short: "Lint chainloop policy structure and content",
 		Long: `Performs comprehensive validation of:
 - *.yaml files (schema validation)
-- *.rego (formatting, linting, structure)`,
+- *.rego (formatting, linting, structure)
+- CEL policy documents (structure, expressions)`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			a, err := action.NewPolicyLint(ActionOpts)
 			if err != nil {
@@ -0,0 +1,126 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newPolicyDevelopTestCmd() *cobra.Command {
+	var (
+		run              string
+		junitOutput      string
+		allowedHostnames []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "test [paths...]",
+		Short: "Run the test suites of policies",
+		Long: `Run the test cases defined in *_test.yaml files against the policy next to them,
+i.e policy_test.yaml contains the tests of policy.yaml.
+
+Each test case evaluates the policy against a material fixture and checks the
+expected violations, structured findings, and skipped or ignored status:
+
+  tests:
+    - name: sbom without components
+      material: testdata/empty-sbom.json
+      kind: SBOM_CYCLONEDX_JSON
+      args:
+        min_components: "2"
+      expect:
+        violations:
+          - SBOM must have at least 2 components
+
+Directories are searched recursively for test suites, the current directory by default.`,
+		Example: `  # Run all the test suites in the current directory
+  chainloop policy develop test
+
+  # Run the tests of a single policy and write a JUnit report for CI
+  chainloop policy develop test policy_test.yaml --junit-output report.xml`,
+		RunE: func(_ *cobra.Command, args []string) error {
+			result, err := action.NewPolicyTest(&action.PolicyTestOpts{
+				Paths:            args,
+				Run:              run,
+				AllowedHostnames: allowedHostnames,
+			}, ActionOpts).Run()
+			if err != nil {
+				return err
+			}
+
+			if junitOutput != "" {
+				f, err := os.Create(junitOutput)
+				if err != nil {
+					return fmt.Errorf("creating JUnit report: %w", err)
+				}
+				defer f.Close()
+
+				if err := result.WriteJUnit(f); err != nil {
+					return err
+				}
+			}
+
+			if err := output.EncodeOutput(flagOutputFormat, result, policyTestTable); err != nil {
+				return err
+			}
+
+			if result.Failed > 0 {
+				return fmt.Errorf("%d of %d tests failed", result.Failed, result.Failed+result.Passed)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&run, "run", "", "only run the tests whose name matches this regular expression")
+	cmd.Flags().StringVar(&junitOutput, "junit-output", "", "path to write the results in JUnit XML format")
+	cmd.Flags().StringSliceVar(&allowedHostnames, "allowed-hostnames", []string{}, "Additional hostnames allowed for http.send requests in policies")
+	flagVulnerabilityDB(cmd)
+
+	return cmd
+}
+
+func policyTestTable(result *action.PolicyTestResult) error {
+	tw := output.NewTableWriter()
+	tw.AppendHeader(table.Row{"Suite", "Test", "Result", "Details"})
+
+	for _, s := range result.Suites {
+		for _, c := range s.Cases {
+			status, details := "PASS", ""
+			switch {
+			case c.Error != "":
+				status, details = "ERROR", c.Error
+			case !c.Passed:
+				status, details = "FAIL", strings.Join(c.Diff, "\n")
+			}
+
+			tw.AppendRow(table.Row{s.Path, c.Name, status, details})
+		}
+		tw.AppendSeparator()
+	}
+
+	tw.AppendFooter(table.Row{"", "", fmt.Sprintf("%d passed", result.Passed), fmt.Sprintf("%d failed", result.Failed)})
+	tw.Render()
+
+	return nil
+}
@@ -0,0 +1,142 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newPolicyImpactCmd() *cobra.Command {
+	var policyRef, groupRef, projectName, versionRange, from, to string
+	var inputs []string
+	var limit int
+	var createdAfter, createdBefore *time.Time
+
+	cmd := &cobra.Command{
+		Use:   "impact",
+		Short: "Find the existing releases that would fail a policy",
+		Long: `Evaluate a policy or policy group against the attestations already stored,
+i.e before publishing a new version of a policy, to know which releases would fail it.
+
+The most recent successful runs matching the selector are evaluated with the exceptions
+active today, and the ones getting new violations are reported. The original runs and
+their policy evaluations are left untouched.
+
+The analysis runs in the controlplane and the command waits for it to finish. It's
+restricted to organization admins, who can run one analysis at a time.`,
+		Example: `  # Releases of a project that would fail the new version of a policy
+  chainloop policy impact --policy chainloop://my-policy@2 --project my-project --version-range ">= 1.2.0"
+
+  # Runs attested during the last month, evaluated against a policy group
+  chainloop policy impact --group chainloop://my-group --input severity=HIGH --from 2026-09-17`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if createdAfter, err = parseSearchDate(from); err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+
+			if createdBefore, err = parseSearchDate(to); err != nil {
+				return fmt.Errorf("invalid --to: %w", err)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := action.NewPolicyImpact(ActionOpts).Run(&action.PolicyImpactOpts{
+				PolicyRef:     policyRef,
+				GroupRef:      groupRef,
+				With:          parseKeyValue(inputs),
+				ProjectName:   projectName,
+				VersionRange:  versionRange,
+				CreatedAfter:  createdAfter,
+				CreatedBefore: createdBefore,
+				Limit:         limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, policyImpactTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&policyRef, "policy", "", "policy to evaluate, i.e chainloop://my-policy@2")
+	cmd.Flags().StringVar(&groupRef, "group", "", "policy group to evaluate, i.e chainloop://my-group")
+	cmd.MarkFlagsOneRequired("policy", "group")
+	cmd.MarkFlagsMutuallyExclusive("policy", "group")
+	cmd.Flags().StringArrayVar(&inputs, "input", []string{}, "Key-value pairs of policy inputs (key=value)")
+	cmd.Flags().StringVar(&projectName, "project", "", "project name")
+	cmd.Flags().StringVar(&versionRange, "version-range", "", "semver constraint on the project version, i.e \">= 1.2.0, < 2.0.0\"")
+	cmd.Flags().StringVar(&from, "from", "", "runs created at or after this date, in YYYY-MM-DD or RFC3339 format")
+	cmd.Flags().StringVar(&to, "to", "", "runs created before this date, in YYYY-MM-DD or RFC3339 format")
+	cmd.Flags().IntVar(&limit, "limit", 100, "maximum number of runs to evaluate, most recent first")
+
+	return cmd
+}
+
+func policyImpactTableOutput(report *action.PolicyImpactReport) error {
+	if len(report.NewlyFailing) == 0 {
+		fmt.Printf("none of the %d runs evaluated would fail\n", report.EvaluatedCount)
+	} else {
+		fmt.Printf("%d of the %d runs evaluated would fail\n", len(report.NewlyFailing), report.EvaluatedCount)
+
+		t := output.NewTableWriter()
+		t.AppendHeader(table.Row{"Run ID", "Workflow", "Version", "Created At", "Policy", "Material", "Violation"})
+		for _, i := range report.NewlyFailing {
+			for _, v := range i.Violations {
+				t.AppendRow(table.Row{i.WorkflowRunID, policyImpactWorkflowName(i), i.ProjectVersion, policyImpactCreatedAt(i), v.PolicyName, v.MaterialName, v.Message})
+			}
+		}
+		t.Render()
+	}
+
+	if len(report.Errored) == 0 {
+		return nil
+	}
+
+	fmt.Printf("%d runs couldn't be evaluated\n", len(report.Errored))
+
+	t := output.NewTableWriter()
+	t.AppendHeader(table.Row{"Run ID", "Workflow", "Version", "Created At", "Error"})
+	for _, i := range report.Errored {
+		t.AppendRow(table.Row{i.WorkflowRunID, policyImpactWorkflowName(i), i.ProjectVersion, policyImpactCreatedAt(i), i.Error})
+	}
+	t.Render()
+
+	return nil
+}
+
+func policyImpactWorkflowName(i *action.PolicyImpactItem) string {
+	if i.Workflow == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s", i.Workflow.ProjectName, i.Workflow.Name)
+}
+
+func policyImpactCreatedAt(i *action.PolicyImpactItem) string {
+	if i.CreatedAt == nil {
+		return ""
+	}
+
+	return i.CreatedAt.Format(time.RFC822)
+}
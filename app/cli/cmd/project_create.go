@@ -0,0 +1,47 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newProjectCreateCmd() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new project",
+		Example: `  # Create a project, you will become its admin
+  chainloop project create --name my-project`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectCreate(ActionOpts).Run(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+
+			logger.Info().Msg("Project created!")
+			return output.EncodeOutput(flagOutputFormat, res, projectItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("name"))
+
+	return cmd
+}
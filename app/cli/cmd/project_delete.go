@@ -0,0 +1,54 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newProjectDeleteCmd() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete an existing project",
+		Long:  "Delete an existing project along with its versions. The project must not contain any workflow.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !flagYes {
+				fmt.Printf("You are about to delete the project %q\n", name)
+
+				if err := confirmDeletion(); err != nil {
+					return err
+				}
+			}
+
+			if err := action.NewProjectDelete(ActionOpts).Run(cmd.Context(), name); err != nil {
+				return fmt.Errorf("deleting project: %w", err)
+			}
+
+			logger.Info().Str("project", name).Msg("Project deleted")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("name"))
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newProjectDescribeCmd() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "describe",
+		Short: "Describe an existing project",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectDescribe(ActionOpts).Run(cmd.Context(), name)
+			if err != nil {
+				return err
+			}
+
+			return output.EncodeOutput(flagOutputFormat, res, projectDescribeTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("name"))
+
+	return cmd
+}
+
+func projectDescribeTableOutput(res *action.ProjectDescribeResult) error {
+	t := output.NewTableWriter()
+	t.SetTitle("Project")
+	t.AppendRow(table.Row{"ID", res.ID})
+	t.AppendRow(table.Row{"Name", res.Name})
+	t.AppendRow(table.Row{"Created At", res.CreatedAt.Format(time.RFC822)})
+
+	latest := "none"
+	if v := res.LatestVersion; v != nil {
+		latest = v.Version
+		if v.Prerelease {
+			latest += " (prerelease)"
+		}
+	}
+	t.AppendRow(table.Row{"Latest Version", latest})
+	t.Render()
+
+	return nil
+}
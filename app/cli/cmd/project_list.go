@@ -0,0 +1,103 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/options"
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newProjectListCmd() *cobra.Command {
+	var paginationOpts = &options.OffsetPaginationOpts{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the projects you have access to",
+		Example: `  # Let the default pagination apply
+  chainloop project list
+
+  # Specify the page and page size
+  chainloop project list --page 2 --limit 10`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if paginationOpts.Page < 1 {
+				return fmt.Errorf("--page must be greater or equal than 1")
+			}
+			if paginationOpts.Limit < 1 {
+				return fmt.Errorf("--limit must be greater or equal than 1")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectList(ActionOpts).Run(cmd.Context(), paginationOpts.Page, paginationOpts.Limit)
+			if err != nil {
+				return err
+			}
+
+			if err := output.EncodeOutput(flagOutputFormat, res, projectListTableOutput); err != nil {
+				return err
+			}
+
+			logPaginationInfo(res.Pagination, paginationOpts, len(res.Projects))
+			return nil
+		},
+	}
+
+	paginationOpts.AddFlags(cmd)
+
+	return cmd
+}
+
+// logPaginationInfo prints the range of items being shown and whether there are more pages
+func logPaginationInfo(pgResponse *action.OffsetPagination, paginationOpts *options.OffsetPaginationOpts, itemsInPage int) {
+	if pgResponse.TotalPages >= paginationOpts.Page {
+		inPage := min(paginationOpts.Limit, itemsInPage)
+		lowerBound := (paginationOpts.Page - 1) * paginationOpts.Limit
+		logger.Info().Msg(fmt.Sprintf("Showing [%d-%d] out of %d", lowerBound+1, lowerBound+inPage, pgResponse.TotalCount))
+	}
+
+	if pgResponse.TotalCount > pgResponse.Page*pgResponse.PageSize {
+		logger.Info().Msg(fmt.Sprintf("Next page available: %d", pgResponse.Page+1))
+	}
+}
+
+func projectItemTableOutput(p *action.ProjectItem) error {
+	return projectListTableOutput(&action.ProjectListResult{Projects: []*action.ProjectItem{p}})
+}
+
+func projectListTableOutput(res *action.ProjectListResult) error {
+	if len(res.Projects) == 0 {
+		fmt.Println("there are no projects yet")
+		return nil
+	}
+
+	t := output.NewTableWriter()
+	t.AppendHeader(table.Row{"ID", "Name", "Created At"})
+
+	for _, p := range res.Projects {
+		t.AppendRow(table.Row{p.ID, p.Name, p.CreatedAt.Format(time.RFC822)})
+	}
+	t.Render()
+
+	return nil
+}
@@ -0,0 +1,57 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newProjectVersionCreateCmd() *cobra.Command {
+	var projectName, version string
+	var released bool
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a new version in a project",
+		Long:  "Create a new version in a project. The new version becomes the latest version of the project.",
+		Example: `  # Create a pre-release version
+  chainloop project version create --project my-project --version v1.2.0
+
+  # Create a version that is already released
+  chainloop project version create --project my-project --version v1.2.0 --released`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectVersionCreate(ActionOpts).Run(cmd.Context(), projectName, version, released)
+			if err != nil {
+				return err
+			}
+
+			logger.Info().Msg("Project version created!")
+			return output.EncodeOutput(flagOutputFormat, res, projectVersionItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectName, "project", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("project"))
+
+	cmd.Flags().StringVar(&version, "version", "", "version name, i.e v1.2.0")
+	cobra.CheckErr(cmd.MarkFlagRequired("version"))
+
+	cmd.Flags().BoolVar(&released, "released", false, "create the version as released instead of pre-release")
+
+	return cmd
+}
@@ -0,0 +1,95 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/options"
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+func newProjectVersionListCmd() *cobra.Command {
+	var projectName string
+	var paginationOpts = &options.OffsetPaginationOpts{}
+
+	cmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List the versions of a project",
+		Example: `  # List the versions of a project, most recent first
+  chainloop project version list --project my-project`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if paginationOpts.Page < 1 {
+				return fmt.Errorf("--page must be greater or equal than 1")
+			}
+			if paginationOpts.Limit < 1 {
+				return fmt.Errorf("--limit must be greater or equal than 1")
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectVersionList(ActionOpts).Run(cmd.Context(), projectName, paginationOpts.Page, paginationOpts.Limit)
+			if err != nil {
+				return err
+			}
+
+			if err := output.EncodeOutput(flagOutputFormat, res, projectVersionListTableOutput); err != nil {
+				return err
+			}
+
+			logPaginationInfo(res.Pagination, paginationOpts, len(res.Versions))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&projectName, "project", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("project"))
+	paginationOpts.AddFlags(cmd)
+
+	return cmd
+}
+
+func projectVersionItemTableOutput(v *action.ProjectVersionItem) error {
+	return projectVersionListTableOutput(&action.ProjectVersionListResult{Versions: []*action.ProjectVersionItem{v}})
+}
+
+func projectVersionListTableOutput(res *action.ProjectVersionListResult) error {
+	if len(res.Versions) == 0 {
+		fmt.Println("there are no versions yet")
+		return nil
+	}
+
+	t := output.NewTableWriter()
+	t.AppendHeader(table.Row{"Version", "Prerelease", "Latest", "Created At", "Released At"})
+
+	for _, v := range res.Versions {
+		var releasedAt string
+		if v.ReleasedAt != nil {
+			releasedAt = v.ReleasedAt.Format(time.RFC822)
+		}
+
+		t.AppendRow(table.Row{v.Version, v.Prerelease, v.Latest, v.CreatedAt.Format(time.RFC822), releasedAt})
+	}
+	t.Render()
+
+	return nil
+}
@@ -0,0 +1,74 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newProjectVersionPromoteCmd() *cobra.Command {
+	var projectName, version string
+
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Mark a pre-release version as released",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectVersionPromote(ActionOpts).Run(cmd.Context(), projectName, version)
+			if err != nil {
+				return err
+			}
+
+			logger.Info().Msg("Project version released!")
+			return output.EncodeOutput(flagOutputFormat, res, projectVersionItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectName, "project", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("project"))
+
+	cmd.Flags().StringVar(&version, "version", "", "version name, i.e v1.2.0")
+	cobra.CheckErr(cmd.MarkFlagRequired("version"))
+
+	return cmd
+}
+
+func newProjectVersionUnreleaseCmd() *cobra.Command {
+	var projectName, version string
+
+	cmd := &cobra.Command{
+		Use:   "unrelease",
+		Short: "Move a released version back to pre-release",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			res, err := action.NewProjectVersionUnrelease(ActionOpts).Run(cmd.Context(), projectName, version)
+			if err != nil {
+				return err
+			}
+
+			logger.Info().Msg("Project version moved back to pre-release")
+			return output.EncodeOutput(flagOutputFormat, res, projectVersionItemTableOutput)
+		},
+	}
+
+	cmd.Flags().StringVar(&projectName, "project", "", "project name")
+	cobra.CheckErr(cmd.MarkFlagRequired("project"))
+
+	cmd.Flags().StringVar(&version, "version", "", "version name, i.e v1.2.0")
+	cobra.CheckErr(cmd.MarkFlagRequired("version"))
+
+	return cmd
+}
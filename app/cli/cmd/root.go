@@ -107,6 +107,10 @@ func NewRootCmd(l zerolog.Logger) *cobra.Command {
 
 			logger.Debug().Str("path", viper.ConfigFileUsed()).Msg("using config file")
 
+			if err := bindCommandFlags(cmd); err != nil {
+				return err
+			}
+
 			// Commands annotated with skipActionOptsInit don't need ActionOpts initialization
 			// These are local-only commands that don't interact with the control plane
 			if cmd.Annotations[skipActionOptsInit] == trueString {
@@ -274,6 +278,12 @@ func NewRootCmd(l zerolog.Logger) *cobra.Command {
 	cobra.CheckErr(viper.BindPFlag(confOptions.maxRecvMsgSize.viperKey, rootCmd.PersistentFlags().Lookup(confOptions.maxRecvMsgSize.flagName)))
 	cobra.CheckErr(viper.BindEnv(confOptions.maxRecvMsgSize.viperKey, CalculateEnvVarName(confOptions.maxRecvMsgSize.viperKey)))
 
+	// Public keys the policies and groups pulled from OCI registries must be signed with, and local OSV
+	// database backing the chainloop.osv_lookup policy builtin. Their flags are only registered by
+	// the commands evaluating policies, see flagPolicyVerificationKeys and flagVulnerabilityDB
+	cobra.CheckErr(viper.BindEnv(confOptions.policyVerificationKeys.viperKey, CalculateEnvVarName(confOptions.policyVerificationKeys.viperKey)))
+	cobra.CheckErr(viper.BindEnv(confOptions.vulnerabilityDB.viperKey, CalculateEnvVarName(confOptions.vulnerabilityDB.viperKey)))
+
 	rootCmd.PersistentFlags().BoolVar(&flagDebug, "debug", false, "Enable debug/verbose logging mode")
 	rootCmd.PersistentFlags().StringVarP(&flagOutputFormat, "output", "o", "table", "Output format, valid options are json and table")
 
@@ -286,7 +296,7 @@ func NewRootCmd(l zerolog.Logger) *cobra.Command {
 	// Do not ask for confirmation
 	rootCmd.PersistentFlags().BoolVarP(&flagYes, "yes", "y", false, "Skip confirmation")
 
-	rootCmd.AddCommand(newWorkflowCmd(), newAuthCmd(), NewVersionCmd(),
+	rootCmd.AddCommand(newWorkflowCmd(), newProjectCmd(), newAuthCmd(), NewVersionCmd(),
 		newAttestationCmd(), newArtifactCmd(), newConfigCmd(),
 		newIntegrationCmd(), newOrganizationCmd(), newCASBackendCmd(),
 		newReferrerDiscoverCmd(), newPolicyCmd(), newApplyCmd(),
@@ -379,8 +389,33 @@ func initConfigFile() {
 	cobra.CheckErr(viper.ReadInConfig())
 }
 
+// flagPolicyVerificationKeys adds the flag to configure the keys policies loaded from OCI registries must be signed with
+func flagPolicyVerificationKeys(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(confOptions.policyVerificationKeys.flagName, nil, fmt.Sprintf("Path to a public key that policies loaded from OCI registries must be signed with, can be repeated (optional) ($%s)", CalculateEnvVarName(confOptions.policyVerificationKeys.viperKey)))
+}
+
+// flagVulnerabilityDB adds the flag to configure the local OSV database to a command evaluating policies
+func flagVulnerabilityDB(cmd *cobra.Command) {
+	cmd.Flags().String(confOptions.vulnerabilityDB.flagName, "", fmt.Sprintf("Path to a local mirror of the OSV vulnerability database, a directory or zip archive, that policies can query offline (optional) ($%s)", CalculateEnvVarName(confOptions.vulnerabilityDB.viperKey)))
+}
+
+// bindCommandFlags binds the configuration flags only registered by some commands, once one of them runs
+func bindCommandFlags(cmd *cobra.Command) error {
+	for _, opt := range []*confOpt{confOptions.policyVerificationKeys, confOptions.vulnerabilityDB} {
+		if f := cmd.Flags().Lookup(opt.flagName); f != nil {
+			if err := viper.BindPFlag(opt.viperKey, f); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func newActionOpts(logger zerolog.Logger, conn *grpc.ClientConn, token string) *action.ActionsOpts {
-	return &action.ActionsOpts{CPConnection: conn, Logger: logger, AuthTokenRaw: token, OutputFormat: flagOutputFormat, CLIVersion: fullVersion()}
+	return &action.ActionsOpts{CPConnection: conn, Logger: logger, AuthTokenRaw: token, OutputFormat: flagOutputFormat, CLIVersion: fullVersion(),
+		PolicyVerificationKeys: viper.GetStringSlice(confOptions.policyVerificationKeys.viperKey),
+		VulnerabilityDB:        viper.GetString(confOptions.vulnerabilityDB.viperKey)}
 }
 
 func cleanup(conn *grpc.ClientConn) error {
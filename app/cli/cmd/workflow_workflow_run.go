@@ -26,6 +26,6 @@ func newWorkflowWorkflowRunCmd() *cobra.Command {
 		Short:   "Workflow Runs related operations",
 	}
 
-	cmd.AddCommand(newWorkflowWorkflowRunListCmd(), newWorkflowWorkflowRunDescribeCmd())
+	cmd.AddCommand(newWorkflowWorkflowRunListCmd(), newWorkflowWorkflowRunDescribeCmd(), newWorkflowWorkflowRunSearchCmd())
 	return cmd
 }
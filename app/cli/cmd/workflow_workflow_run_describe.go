@@ -303,7 +303,8 @@ func policiesTable(evs []*action.PolicyEvaluation, mt table.Writer, debugMode bo
 
 // violationSummary builds a single-line description of a violation using the
 // structured finding when present (CVE id + severity + package + fix info,
-// or SAST rule + location, or license + component). Falls back to the first
+// SAST, secret or misconfiguration rule + location, license + component, or
+// malware signature + package or location). Falls back to the first
 // line of Message — vuln policies emit a multi-line markdown report there
 // which would otherwise break the row layout. The resolved assessment
 // status, if any, is appended inside the same severity-parens regardless
@@ -335,14 +336,34 @@ func violationSummary(v *action.PolicyViolation) string {
 		if tag := joinTag(f.GetSeverity(), statusTag); tag != "" {
 			out = fmt.Sprintf("%s (%s)", out, tag)
 		}
-		if loc := f.GetLocation(); loc != "" {
-			if ln := f.GetLineNumber(); ln > 0 {
-				out = fmt.Sprintf("%s at %s:%d", out, loc, ln)
-			} else {
-				out = fmt.Sprintf("%s at %s", out, loc)
-			}
+		return withLocation(out, f.GetLocation(), f.GetLineNumber())
+	case v.Secret != nil:
+		f := v.Secret
+		out := f.GetRuleId()
+		if tag := joinTag(f.GetSeverity(), statusTag); tag != "" {
+			out = fmt.Sprintf("%s (%s)", out, tag)
 		}
-		return out
+		return withLocation(out, f.GetLocation(), f.GetLineNumber())
+	case v.Misconfiguration != nil:
+		f := v.Misconfiguration
+		out := f.GetCheckId()
+		if tag := joinTag(f.GetSeverity(), statusTag); tag != "" {
+			out = fmt.Sprintf("%s (%s)", out, tag)
+		}
+		if r := f.GetResource(); r != "" {
+			out += " " + r
+		}
+		return withLocation(out, f.GetLocation(), f.GetLineNumber())
+	case v.Malware != nil:
+		f := v.Malware
+		out := f.GetSignature()
+		if tag := joinTag(f.GetSeverity(), statusTag); tag != "" {
+			out = fmt.Sprintf("%s (%s)", out, tag)
+		}
+		if pkg := prettyPurl(f.GetPackagePurl()); pkg != "" {
+			return out + " " + pkg
+		}
+		return withLocation(out, f.GetLocation(), 0)
 	case v.LicenseViolation != nil:
 		f := v.LicenseViolation
 		out := f.GetLicenseId()
@@ -368,6 +389,18 @@ func violationSummary(v *action.PolicyViolation) string {
 	return head
 }
 
+// withLocation appends the file, and line when known, the finding was found at.
+func withLocation(out, loc string, line int32) string {
+	switch {
+	case loc == "":
+		return out
+	case line > 0:
+		return fmt.Sprintf("%s at %s:%d", out, loc, line)
+	default:
+		return fmt.Sprintf("%s at %s", out, loc)
+	}
+}
+
 // joinTag combines severity and the assessment status into the
 // comma-separated parenthetical shown after the finding id.
 func joinTag(severity, status string) string {
@@ -392,6 +425,12 @@ func violationAssessment(v *action.PolicyViolation) *attv1.PolicyAssessmentResul
 		return v.Sast.GetAssessment()
 	case v.LicenseViolation != nil:
 		return v.LicenseViolation.GetAssessment()
+	case v.Secret != nil:
+		return v.Secret.GetAssessment()
+	case v.Misconfiguration != nil:
+		return v.Misconfiguration.GetAssessment()
+	case v.Malware != nil:
+		return v.Malware.GetAssessment()
 	}
 	return nil
 }
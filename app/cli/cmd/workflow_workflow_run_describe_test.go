@@ -144,6 +144,41 @@ func TestViolationSummary(t *testing.T) {
 			},
 			want: "GPL-3.0 — lodash@4.17.21",
 		},
+		{
+			name: "secret with location and line number",
+			violation: &action.PolicyViolation{
+				Secret: &attv1.PolicySecretFinding{
+					RuleId:     "aws-access-token",
+					Severity:   "high",
+					Location:   "deploy/config.env",
+					LineNumber: 3,
+				},
+			},
+			want: "aws-access-token (HIGH) at deploy/config.env:3",
+		},
+		{
+			name: "misconfiguration with resource",
+			violation: &action.PolicyViolation{
+				Misconfiguration: &attv1.PolicyMisconfigurationFinding{
+					CheckId:  "AVD-KSV-0017",
+					Severity: "HIGH",
+					Resource: "Deployment/api",
+					Location: "k8s/api.yaml",
+				},
+			},
+			want: "AVD-KSV-0017 (HIGH) Deployment/api at k8s/api.yaml",
+		},
+		{
+			name: "malware in a package",
+			violation: &action.PolicyViolation{
+				Malware: &attv1.PolicyMalwareFinding{
+					Signature:   "MAL-2025-1234",
+					Location:    "node_modules/evil/index.js",
+					PackagePurl: "pkg:npm/evil@1.0.0",
+				},
+			},
+			want: "MAL-2025-1234 evil@1.0.0",
+		},
 		{
 			name: "unstructured policy uses first line of message",
 			violation: &action.PolicyViolation{
@@ -0,0 +1,160 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/options"
+	"github.com/chainloop-dev/chainloop/app/cli/cmd/output"
+	"github.com/chainloop-dev/chainloop/app/cli/pkg/action"
+	"github.com/spf13/cobra"
+)
+
+func newWorkflowWorkflowRunSearchCmd() *cobra.Command {
+	var paginationOpts = &options.PaginationOpts{
+		DefaultLimit: 50,
+	}
+
+	var workflowName, projectName, materialDigest, materialType, subjectName, annotation, runnerType, policyStatus, from, to string
+	var hasViolations bool
+	var createdAfter, createdBefore *time.Time
+
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search workflow runs by the content of their attestations",
+		Example: `  # Runs that included a given SBOM
+  chainloop workflow run search --material-digest sha256:deadbeef
+
+  # Runs that had their gates failing during the last week
+  chainloop workflow run search --policy-status blocked --from 2026-10-10
+
+  # Runs of a project built from the main branch by GitHub Actions
+  chainloop workflow run search --project my-project --annotation branch=main --runner-type GITHUB_ACTION`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if workflowName != "" && projectName == "" {
+				return fmt.Errorf("--project is required when --workflow is set")
+			}
+
+			if policyStatus != "" {
+				if _, ok := action.WorkflowRunPolicyStatus()[policyStatus]; !ok {
+					return fmt.Errorf("invalid policy-status %q, please chose one of: %v", policyStatus, listAvailablePolicyStatusFlag())
+				}
+			}
+
+			var err error
+			if createdAfter, err = parseSearchDate(from); err != nil {
+				return fmt.Errorf("invalid --from: %w", err)
+			}
+
+			if createdBefore, err = parseSearchDate(to); err != nil {
+				return fmt.Errorf("invalid --to: %w", err)
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := &action.WorkflowRunSearchOpts{
+				WorkflowName:   workflowName,
+				ProjectName:    projectName,
+				MaterialDigest: materialDigest,
+				MaterialType:   materialType,
+				SubjectName:    subjectName,
+				RunnerType:     runnerType,
+				PolicyStatus:   policyStatus,
+				CreatedAfter:   createdAfter,
+				CreatedBefore:  createdBefore,
+				Pagination: &action.PaginationOpts{
+					Limit:      paginationOpts.Limit,
+					NextCursor: paginationOpts.NextCursor,
+				},
+			}
+
+			// the value of the annotation is optional
+			if annotation != "" {
+				opts.AnnotationName, opts.AnnotationValue, _ = strings.Cut(annotation, "=")
+			}
+
+			if cmd.Flags().Changed("has-violations") {
+				opts.HasViolations = &hasViolations
+			}
+
+			res, err := action.NewWorkflowRunSearch(ActionOpts).Run(opts)
+			if err != nil {
+				return err
+			}
+
+			if err := output.EncodeOutput(flagOutputFormat, res.Result, workflowRunListTableOutput); err != nil {
+				return err
+			}
+
+			if next := res.PaginationMeta.NextCursor; next != "" {
+				logger.Info().Msg("Pagination options \n")
+				logger.Info().Msgf("--next %s\n", next)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&workflowName, "workflow", "", "workflow name (requires --project)")
+	cmd.Flags().StringVar(&projectName, "project", "", "project name")
+	cmd.Flags().StringVar(&materialDigest, "material-digest", "", "digest of any of the materials, sha256 is assumed if no algorithm is provided")
+	cmd.Flags().StringVar(&materialType, "material-type", "", "type of any of the materials, i.e SBOM_CYCLONEDX_JSON")
+	cmd.Flags().StringVar(&subjectName, "subject", "", "name of any of the subjects of the attestation")
+	cmd.Flags().StringVar(&annotation, "annotation", "", "attestation annotation in the format of key or key=value")
+	cmd.Flags().StringVar(&runnerType, "runner-type", "", "runner that executed the workflow, i.e GITHUB_ACTION")
+	cmd.Flags().StringVar(&policyStatus, "policy-status", "", fmt.Sprintf("filter by policy status: %v", listAvailablePolicyStatusFlag()))
+	cmd.Flags().BoolVar(&hasViolations, "has-violations", false, "filter by whether policy violations were found")
+	cmd.Flags().StringVar(&from, "from", "", "runs created at or after this date, in YYYY-MM-DD or RFC3339 format")
+	cmd.Flags().StringVar(&to, "to", "", "runs created before this date, in YYYY-MM-DD or RFC3339 format")
+	cmd.Flags().BoolVar(&full, "full", false, "full report")
+	// Add pagination flags
+	paginationOpts.AddFlags(cmd)
+
+	return cmd
+}
+
+// parseSearchDate parses either a date or a full RFC3339 timestamp
+func parseSearchDate(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.DateOnly, time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%q is not a date in YYYY-MM-DD or RFC3339 format", s)
+}
+
+// listAvailablePolicyStatusFlag returns the sorted list of policy status runs can be searched by
+func listAvailablePolicyStatusFlag() []string {
+	m := action.WorkflowRunPolicyStatus()
+	r := make([]string, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+
+	sort.Strings(r)
+
+	return r
+}
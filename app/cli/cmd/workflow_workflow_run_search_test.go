@@ -0,0 +1,71 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowRunSearchPreRunValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		flags   map[string]string
+		wantErr string
+	}{
+		{
+			name:  "no filters",
+			flags: map[string]string{},
+		},
+		{
+			name:    "workflow without project is rejected",
+			flags:   map[string]string{"workflow": "build"},
+			wantErr: "--project is required when --workflow is set",
+		},
+		{
+			name:    "unknown policy status",
+			flags:   map[string]string{"policy-status": "failed"},
+			wantErr: "invalid policy-status",
+		},
+		{
+			name:  "date range",
+			flags: map[string]string{"policy-status": "blocked", "from": "2026-10-10", "to": "2026-10-17T10:00:00Z"},
+		},
+		{
+			name:    "invalid date",
+			flags:   map[string]string{"from": "last week"},
+			wantErr: "invalid --from",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := newWorkflowWorkflowRunSearchCmd()
+			for k, v := range tc.flags {
+				require.NoError(t, cmd.Flags().Set(k, v))
+			}
+
+			err := cmd.PreRunE(cmd, nil)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
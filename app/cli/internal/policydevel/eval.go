@@ -17,13 +17,16 @@ package policydevel
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	controlplanev1 "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
 	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
 	"github.com/chainloop-dev/chainloop/pkg/casclient"
 	"github.com/chainloop-dev/chainloop/pkg/policies"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"github.com/rs/zerolog"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -36,6 +39,9 @@ const (
 	enablePrint = true
 )
 
+// ErrPolicyNotEvaluated is returned when the policy had no execution branch for the material, or it was ignored
+var ErrPolicyNotEvaluated = errors.New("no execution branch matched, or all of them were ignored")
+
 type EvalOptions struct {
 	PolicyPath         string
 	MaterialKind       string
@@ -48,6 +54,10 @@ type EvalOptions struct {
 	ControlPlaneConn   *grpc.ClientConn
 	ProjectName        string
 	ProjectVersionName string
+	// Local OSV database backing chainloop.osv_lookup, optional
+	VulnerabilityDB *osv.Database
+	// Keys the policies loaded from OCI registries must be signed with, optional
+	OCIVerifiers []signature.Verifier
 }
 
 type EvalResult struct {
@@ -82,7 +92,7 @@ func Evaluate(opts *EvalOptions, logger zerolog.Logger) (*EvalSummary, error) {
 	material.Annotations = opts.Annotations
 
 	// 3. Verify material against policy
-	summary, err := verifyMaterial(policies, material, opts.MaterialPath, opts.Debug, opts.AllowedHostnames, opts.AttestationClient, opts.ControlPlaneConn, opts.ProjectName, opts.ProjectVersionName, &logger)
+	summary, err := verifyMaterial(policies, material, opts.MaterialPath, opts.Debug, opts.AllowedHostnames, opts.AttestationClient, opts.ControlPlaneConn, opts.VulnerabilityDB, opts.OCIVerifiers, opts.ProjectName, opts.ProjectVersionName, &logger)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +120,7 @@ func createPolicies(policyPath string, inputs map[string]string) (*v1.Policies,
 	}, nil
 }
 
-func verifyMaterial(pol *v1.Policies, material *v12.Attestation_Material, materialPath string, debug bool, allowedHostnames []string, attestationClient controlplanev1.AttestationServiceClient, grpcConn *grpc.ClientConn, projectName, projectVersion string, logger *zerolog.Logger) (*EvalSummary, error) {
+func verifyMaterial(pol *v1.Policies, material *v12.Attestation_Material, materialPath string, debug bool, allowedHostnames []string, attestationClient controlplanev1.AttestationServiceClient, grpcConn *grpc.ClientConn, vulnDB *osv.Database, verifiers []signature.Verifier, projectName, projectVersion string, logger *zerolog.Logger) (*EvalSummary, error) {
 	var opts []policies.PolicyVerifierOption
 	if len(allowedHostnames) > 0 {
 		opts = append(opts, policies.WithAllowedHostnames(allowedHostnames...))
@@ -119,6 +129,11 @@ func verifyMaterial(pol *v1.Policies, material *v12.Attestation_Material, materi
 	opts = append(opts, policies.WithIncludeRawData(debug))
 	opts = append(opts, policies.WithEnablePrint(enablePrint))
 	opts = append(opts, policies.WithGRPCConn(grpcConn))
+	opts = append(opts, policies.WithLocalGitRefs(true))
+	opts = append(opts, policies.WithVulnerabilityDB(vulnDB))
+	if len(verifiers) > 0 {
+		opts = append(opts, policies.WithOCIVerifiers(verifiers...))
+	}
 	if projectName != "" || projectVersion != "" {
 		opts = append(opts, policies.WithProjectContext(projectName, projectVersion))
 	}
@@ -130,7 +145,7 @@ func verifyMaterial(pol *v1.Policies, material *v12.Attestation_Material, materi
 	}
 
 	if len(policyEvs) == 0 || policyEvs[0] == nil {
-		return nil, fmt.Errorf("no execution branch matched, or all of them were ignored, for kind %s", material.MaterialType.String())
+		return nil, fmt.Errorf("%w, for kind %s", ErrPolicyNotEvaluated, material.MaterialType.String())
 	}
 
 	// Only one evaluation expected for a single policy attachment
@@ -27,6 +27,7 @@ import (
 	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/unmarshal"
 	"github.com/chainloop-dev/chainloop/pkg/policies/engine"
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine/cel"
 	"github.com/chainloop-dev/chainloop/pkg/resourceloader"
 	extism "github.com/extism/go-sdk"
 	opaAst "github.com/open-policy-agent/opa/v1/ast"
@@ -46,6 +47,7 @@ type PolicyToLint struct {
 	YAMLFiles []*File
 	RegoFiles []*File
 	WASMFiles []*File
+	CELFiles  []*File
 	Format    bool
 	Config    string
 	Errors    []ValidationError
@@ -111,20 +113,20 @@ func Lookup(absPath, config string, format bool) (*PolicyToLint, error) {
 		return nil, err
 	}
 
-	// Load referenced policy files (rego or wasm) from all YAML files
+	// Load referenced policy files (rego, wasm or cel) from all YAML files
 	if err := policy.loadReferencedPolicyFiles(filepath.Dir(resolvedPath)); err != nil {
 		return nil, err
 	}
 
 	// Verify we found at least one valid file
-	if len(policy.YAMLFiles) == 0 && len(policy.RegoFiles) == 0 && len(policy.WASMFiles) == 0 {
+	if len(policy.YAMLFiles) == 0 && len(policy.RegoFiles) == 0 && len(policy.WASMFiles) == 0 && len(policy.CELFiles) == 0 {
 		return nil, fmt.Errorf("no valid .yaml/.yml, .rego, or .wasm files found")
 	}
 
 	return policy, nil
 }
 
-// Loads referenced policy files (rego or wasm) from YAML files in the policy
+// Loads referenced policy files (rego, wasm or cel) from YAML files in the policy
 func (p *PolicyToLint) loadReferencedPolicyFiles(baseDir string) error {
 	seen := make(map[string]struct{})
 	for _, yamlFile := range p.YAMLFiles {
@@ -175,6 +177,12 @@ func (p *PolicyToLint) processFile(filePath string) error {
 		}
 		p.WASMFiles = append(p.WASMFiles, &File{Path: filePath, Content: content})
 	case ".yaml", ".yml":
+		// CEL policies are YAML documents too, but not Chainloop policy resources
+		if engine.DetectPolicyType(content) == engine.PolicyTypeCEL {
+			p.CELFiles = append(p.CELFiles, &File{Path: filePath, Content: content})
+			break
+		}
+
 		p.YAMLFiles = append(p.YAMLFiles, &File{
 			Path:    filePath,
 			Content: content,
@@ -201,6 +209,11 @@ func (p *PolicyToLint) Validate() {
 	for _, wasmFile := range p.WASMFiles {
 		p.validateWasmFile(wasmFile)
 	}
+
+	// Validate CEL files
+	for _, celFile := range p.CELFiles {
+		p.validateCELFile(celFile)
+	}
 }
 
 func (p *PolicyToLint) validateRegoFile(file *File) {
@@ -245,6 +258,13 @@ func (p *PolicyToLint) validateWasmFile(file *File) {
 	}
 }
 
+// validateCELFile validates a CEL policy document and compiles its expressions
+func (p *PolicyToLint) validateCELFile(file *File) {
+	if err := cel.Compile(file.Content); err != nil {
+		p.AddError(file.Path, err.Error(), 0)
+	}
+}
+
 func (p *PolicyToLint) validateAndFormatRego(content, path string) string {
 	// 1. Optionally format
 	if p.Format {
@@ -78,6 +78,24 @@ func TestLookup(t *testing.T) {
 		assert.Len(t, policy.YAMLFiles, 1)
 		assert.Len(t, policy.RegoFiles, 1)
 	})
+
+	t.Run("yaml with referenced cel file", func(t *testing.T) {
+		policy, err := Lookup("testdata/cel-policy.yaml", "", false)
+		require.NoError(t, err)
+		assert.NotNil(t, policy)
+		assert.Len(t, policy.YAMLFiles, 1)
+		assert.Len(t, policy.CELFiles, 1)
+		assert.False(t, policy.HasErrors())
+	})
+
+	t.Run("valid cel file", func(t *testing.T) {
+		policy, err := Lookup("testdata/valid.cel.yaml", "", false)
+		require.NoError(t, err)
+		assert.NotNil(t, policy)
+		assert.Len(t, policy.YAMLFiles, 0)
+		assert.Len(t, policy.CELFiles, 1)
+		assert.False(t, policy.HasErrors())
+	})
 }
 
 func TestPolicyToLint_processFile(t *testing.T) {
@@ -97,6 +115,19 @@ func TestPolicyToLint_processFile(t *testing.T) {
 		assert.Equal(t, []byte(content), policy.YAMLFiles[0].Content)
 	})
 
+	t.Run("process cel file", func(t *testing.T) {
+		content := "engine: cel\n"
+		celFile := filepath.Join(tempDir, "test.cel.yaml")
+		err := os.WriteFile(celFile, []byte(content), 0600)
+		require.NoError(t, err)
+
+		err = policy.processFile(celFile)
+		require.NoError(t, err)
+		assert.Len(t, policy.YAMLFiles, 1)
+		assert.Len(t, policy.CELFiles, 1)
+		assert.Equal(t, celFile, policy.CELFiles[0].Path)
+	})
+
 	t.Run("process rego file", func(t *testing.T) {
 		content := "package main"
 		regoFile := filepath.Join(tempDir, "test.rego")
@@ -287,6 +318,39 @@ func TestPolicyToLint_Validate(t *testing.T) {
 		assert.False(t, policy.HasErrors())
 	})
 
+	t.Run("validate cel files", func(t *testing.T) {
+		content, err := os.ReadFile("testdata/valid.cel.yaml")
+		require.NoError(t, err)
+
+		policy := &PolicyToLint{
+			CELFiles: []*File{
+				{
+					Path:    "valid.cel.yaml",
+					Content: content,
+				},
+			},
+		}
+
+		policy.Validate()
+		assert.False(t, policy.HasErrors())
+	})
+
+	t.Run("invalid cel files", func(t *testing.T) {
+		policy := &PolicyToLint{
+			CELFiles: []*File{
+				{
+					Path:    "invalid.cel.yaml",
+					Content: []byte("engine: cel\nviolations:\n  - when: size(input.\n    message: wrong\n"),
+				},
+			},
+		}
+
+		policy.Validate()
+		require.Len(t, policy.Errors, 1)
+		assert.Equal(t, "invalid.cel.yaml", policy.Errors[0].Path)
+		assert.Contains(t, policy.Errors[0].Message, "failed to compile expression")
+	})
+
 	t.Run("validate and format rego files", func(t *testing.T) {
 		content, err := os.ReadFile("testdata/unformatted.rego")
 		require.NoError(t, err)
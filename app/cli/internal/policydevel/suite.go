@@ -0,0 +1,506 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policydevel
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	controlplanev1 "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"github.com/chainloop-dev/chainloop/pkg/policies"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v3"
+)
+
+// testSuiteSuffix identifies the test suites, i.e. policy_test.yaml contains the test cases of policy.yaml
+const testSuiteSuffix = "_test"
+
+// TestSuite is the content of a *_test.yaml file
+type TestSuite struct {
+	// Policy under test, either a reference or a path relative to the suite file.
+	// Defaults to the suite file without the _test suffix
+	Policy string      `yaml:"policy"`
+	Tests  []*TestCase `yaml:"tests"`
+}
+
+type TestCase struct {
+	Name string `yaml:"name"`
+	// Material fixture, relative to the suite file
+	Material string `yaml:"material"`
+	// Material kind, auto-detected if empty
+	Kind        string            `yaml:"kind"`
+	Annotations map[string]string `yaml:"annotations"`
+	// Policy arguments
+	Args   map[string]string `yaml:"args"`
+	Expect *TestExpectation  `yaml:"expect"`
+}
+
+type TestExpectation struct {
+	// Expected violation messages, in any order. No violations are expected if empty
+	Violations []string `yaml:"violations"`
+	// Expected structured findings, in any order. Each of them must be contained in one of the actual findings
+	Findings []map[string]any `yaml:"findings"`
+	Skipped  bool             `yaml:"skipped"`
+	// Expected skip reasons, only checked if set
+	SkipReasons []string `yaml:"skip_reasons"`
+	// Whether the policy is expected to be ignored, or to have no execution branch for the material
+	Ignored bool `yaml:"ignored"`
+}
+
+type TestOptions struct {
+	// Test suite files, or directories to look for them
+	Paths []string
+	// Only run the test cases whose name matches this regular expression
+	Run               string
+	AllowedHostnames  []string
+	AttestationClient controlplanev1.AttestationServiceClient
+	ControlPlaneConn  *grpc.ClientConn
+	VulnerabilityDB   *osv.Database
+}
+
+type TestReport struct {
+	Suites []*TestSuiteResult `json:"suites"`
+	Passed int                `json:"passed"`
+	Failed int                `json:"failed"`
+}
+
+type TestSuiteResult struct {
+	Path   string            `json:"path"`
+	Policy string            `json:"policy"`
+	Cases  []*TestCaseResult `json:"cases"`
+}
+
+type TestCaseResult struct {
+	Name   string `json:"name"`
+	Passed bool   `json:"passed"`
+	// Differences between the expected and the actual outcome, in diff format
+	Diff []string `json:"diff,omitempty"`
+	// Error preventing the test case from running
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration"`
+}
+
+// RunTests runs the test suites found in the provided paths
+func RunTests(opts *TestOptions, logger zerolog.Logger) (*TestReport, error) {
+	var filter *regexp.Regexp
+	if opts.Run != "" {
+		var err error
+		if filter, err = regexp.Compile(opts.Run); err != nil {
+			return nil, fmt.Errorf("invalid test filter: %w", err)
+		}
+	}
+
+	suitePaths, err := findTestSuites(opts.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(suitePaths) == 0 {
+		return nil, fmt.Errorf("no *%s.yaml test suites found", testSuiteSuffix)
+	}
+
+	report := &TestReport{Suites: make([]*TestSuiteResult, 0, len(suitePaths))}
+	for _, p := range suitePaths {
+		suite, err := loadTestSuite(p)
+		if err != nil {
+			return nil, err
+		}
+
+		result := &TestSuiteResult{Path: p, Policy: suite.Policy}
+		for _, tc := range suite.Tests {
+			if filter != nil && !filter.MatchString(tc.Name) {
+				continue
+			}
+
+			cr := runTestCase(suite, tc, opts, logger)
+			if cr.Passed {
+				report.Passed++
+			} else {
+				report.Failed++
+			}
+
+			result.Cases = append(result.Cases, cr)
+		}
+
+		report.Suites = append(report.Suites, result)
+	}
+
+	return report, nil
+}
+
+// findTestSuites returns the suite files, looking for them recursively in the directories
+func findTestSuites(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	var suites []string
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, fmt.Errorf("reading test suites: %w", err)
+		}
+
+		if !info.IsDir() {
+			suites = append(suites, p)
+			continue
+		}
+
+		err = filepath.WalkDir(p, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() && isTestSuite(path) {
+				suites = append(suites, path)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading test suites: %w", err)
+		}
+	}
+
+	return suites, nil
+}
+
+func isTestSuite(path string) bool {
+	ext := filepath.Ext(path)
+	if ext != ".yaml" && ext != ".yml" {
+		return false
+	}
+
+	return strings.HasSuffix(strings.TrimSuffix(path, ext), testSuiteSuffix)
+}
+
+func loadTestSuite(path string) (*TestSuite, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading test suite: %w", err)
+	}
+
+	var suite TestSuite
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	// catch typos in the expectations
+	dec.KnownFields(true)
+	if err := dec.Decode(&suite); err != nil {
+		return nil, fmt.Errorf("parsing test suite %s: %w", path, err)
+	}
+
+	dir := filepath.Dir(path)
+	if suite.Policy == "" {
+		ext := filepath.Ext(path)
+		suite.Policy = strings.TrimSuffix(strings.TrimSuffix(path, ext), testSuiteSuffix) + ext
+	} else if scheme, _ := policies.RefParts(suite.Policy); scheme == "" && !filepath.IsAbs(suite.Policy) {
+		suite.Policy = filepath.Join(dir, suite.Policy)
+	}
+
+	for i, tc := range suite.Tests {
+		if tc.Name == "" {
+			return nil, fmt.Errorf("test suite %s: test #%d has no name", path, i+1)
+		}
+
+		if tc.Material == "" {
+			return nil, fmt.Errorf("test suite %s: test %q has no material", path, tc.Name)
+		}
+
+		if !filepath.IsAbs(tc.Material) {
+			tc.Material = filepath.Join(dir, tc.Material)
+		}
+
+		if tc.Expect == nil {
+			tc.Expect = &TestExpectation{}
+		}
+	}
+
+	return &suite, nil
+}
+
+func runTestCase(suite *TestSuite, tc *TestCase, opts *TestOptions, logger zerolog.Logger) *TestCaseResult {
+	start := time.Now()
+	result := &TestCaseResult{Name: tc.Name}
+
+	summary, err := Evaluate(&EvalOptions{
+		PolicyPath:        suite.Policy,
+		MaterialKind:      tc.Kind,
+		Annotations:       tc.Annotations,
+		MaterialPath:      tc.Material,
+		Inputs:            tc.Args,
+		AllowedHostnames:  opts.AllowedHostnames,
+		AttestationClient: opts.AttestationClient,
+		ControlPlaneConn:  opts.ControlPlaneConn,
+		VulnerabilityDB:   opts.VulnerabilityDB,
+	}, logger)
+	result.Duration = time.Since(start)
+
+	ignored := errors.Is(err, ErrPolicyNotEvaluated)
+	if err != nil && !ignored {
+		result.Error = err.Error()
+		return result
+	}
+
+	if ignored || tc.Expect.Ignored {
+		if ignored != tc.Expect.Ignored {
+			result.Diff = []string{fmt.Sprintf("- ignored: %t", tc.Expect.Ignored), fmt.Sprintf("+ ignored: %t", ignored)}
+		}
+
+		result.Passed = len(result.Diff) == 0
+		return result
+	}
+
+	result.Diff, err = compareEvalResult(tc.Expect, summary.Result)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Passed = len(result.Diff) == 0
+	return result
+}
+
+// compareEvalResult returns the differences between the expected and the actual results.
+// Lines starting with - are expected but missing, and lines starting with + are unexpected.
+func compareEvalResult(want *TestExpectation, got *EvalResult) ([]string, error) {
+	var diff []string
+
+	if want.Skipped != got.Skipped {
+		diff = append(diff, fmt.Sprintf("- skipped: %t", want.Skipped), fmt.Sprintf("+ skipped: %t", got.Skipped))
+	}
+
+	if want.SkipReasons != nil {
+		diff = append(diff, diffStrings("skip reason", want.SkipReasons, got.SkipReasons)...)
+	}
+
+	diff = append(diff, diffStrings("violation", want.Violations, got.Violations)...)
+
+	if want.Findings != nil {
+		findingsDiff, err := diffFindings(want.Findings, got.Findings)
+		if err != nil {
+			return nil, err
+		}
+
+		diff = append(diff, findingsDiff...)
+	}
+
+	return diff, nil
+}
+
+// diffStrings compares both lists regardless of their order
+func diffStrings(kind string, want, got []string) []string {
+	pending := make(map[string]int, len(got))
+	for _, g := range got {
+		pending[g]++
+	}
+
+	var missing []string
+	for _, w := range want {
+		if pending[w] > 0 {
+			pending[w]--
+			continue
+		}
+
+		missing = append(missing, w)
+	}
+
+	var unexpected []string
+	for _, g := range got {
+		if pending[g] > 0 {
+			pending[g]--
+			unexpected = append(unexpected, g)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+
+	diff := make([]string, 0, len(missing)+len(unexpected))
+	for _, m := range missing {
+		diff = append(diff, fmt.Sprintf("- %s: %s", kind, m))
+	}
+
+	for _, u := range unexpected {
+		diff = append(diff, fmt.Sprintf("+ %s: %s", kind, u))
+	}
+
+	return diff
+}
+
+// diffFindings matches every expected finding with a different actual finding containing it
+func diffFindings(want []map[string]any, got []json.RawMessage) ([]string, error) {
+	actual := make([]any, 0, len(got))
+	for _, g := range got {
+		var f any
+		if err := json.Unmarshal(g, &f); err != nil {
+			return nil, fmt.Errorf("parsing finding: %w", err)
+		}
+
+		actual = append(actual, f)
+	}
+
+	matched := make([]bool, len(actual))
+	var diff []string
+	for _, w := range want {
+		// go through JSON so the expected values have the same types as the actual ones
+		raw, err := json.Marshal(w)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expected finding: %w", err)
+		}
+
+		var expected any
+		if err := json.Unmarshal(raw, &expected); err != nil {
+			return nil, fmt.Errorf("invalid expected finding: %w", err)
+		}
+
+		found := false
+		for i, a := range actual {
+			if !matched[i] && containsValue(a, expected) {
+				matched[i], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			diff = append(diff, fmt.Sprintf("- finding: %s", raw))
+		}
+	}
+
+	for i, m := range matched {
+		if m {
+			continue
+		}
+
+		// protojson output is not stable
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, got[i]); err != nil {
+			return nil, fmt.Errorf("parsing finding: %w", err)
+		}
+
+		diff = append(diff, fmt.Sprintf("+ finding: %s", compact.String()))
+	}
+
+	return diff, nil
+}
+
+// containsValue returns whether all the fields of the expected value are present in the actual one
+func containsValue(actual, expected any) bool {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for k, v := range e {
+			if !containsValue(a[k], v) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return reflect.DeepEqual(actual, expected)
+	}
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name          `xml:"testsuites"`
+	Tests    int               `xml:"tests,attr"`
+	Failures int               `xml:"failures,attr"`
+	Suites   []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Time     string           `xml:"time,attr"`
+	Cases    []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",chardata"`
+}
+
+// WriteJUnit writes the report in JUnit XML format, so it can be consumed by CI systems
+func (r *TestReport) WriteJUnit(w io.Writer) error {
+	out := &junitTestSuites{Tests: r.Passed + r.Failed}
+	for _, s := range r.Suites {
+		suite := &junitTestSuite{Name: s.Path, Tests: len(s.Cases)}
+
+		var elapsed time.Duration
+		for _, c := range s.Cases {
+			elapsed += c.Duration
+
+			tc := &junitTestCase{Name: c.Name, ClassName: s.Policy, Time: junitSeconds(c.Duration)}
+			switch {
+			case c.Error != "":
+				suite.Errors++
+				tc.Error = &junitMessage{Message: "test could not run", Contents: c.Error}
+			case !c.Passed:
+				suite.Failures++
+				tc.Failure = &junitMessage{Message: "unexpected policy evaluation result", Contents: strings.Join(c.Diff, "\n")}
+			}
+
+			suite.Cases = append(suite.Cases, tc)
+		}
+
+		suite.Time = junitSeconds(elapsed)
+		out.Failures += suite.Failures + suite.Errors
+		out.Suites = append(out.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		return fmt.Errorf("encoding JUnit report: %w", err)
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
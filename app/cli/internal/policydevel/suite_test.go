@@ -0,0 +1,150 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policydevel
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/joshdk/go-junit"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTests(t *testing.T) {
+	logger := zerolog.Nop()
+
+	t.Run("passing suites", func(t *testing.T) {
+		report, err := RunTests(&TestOptions{Paths: []string{"testdata"}}, logger)
+		require.NoError(t, err)
+
+		require.Len(t, report.Suites, 2)
+		assert.Equal(t, 4, report.Passed)
+		assert.Equal(t, 0, report.Failed)
+		assert.Equal(t, filepath.Join("testdata", "sbom-min-components-policy.yaml"), report.Suites[0].Policy)
+
+		for _, s := range report.Suites {
+			for _, c := range s.Cases {
+				assert.True(t, c.Passed, "%s: %v %s", c.Name, c.Diff, c.Error)
+			}
+		}
+	})
+
+	t.Run("filter by name", func(t *testing.T) {
+		report, err := RunTests(&TestOptions{Paths: []string{"testdata/sbom-min-components-policy_test.yaml"}, Run: "^single"}, logger)
+		require.NoError(t, err)
+		require.Len(t, report.Suites, 1)
+		require.Len(t, report.Suites[0].Cases, 1)
+		assert.Equal(t, "single component", report.Suites[0].Cases[0].Name)
+	})
+
+	t.Run("failing cases", func(t *testing.T) {
+		suite := writeTestSuite(t, `policy: `+absPath(t, "testdata/sbom-structured-vuln-policy.yaml")+`
+tests:
+  - name: wrong expectations
+    material: `+absPath(t, "testdata/test-sbom.json")+`
+    expect:
+      skipped: true
+      violations:
+        - Something else
+      findings:
+        - vulnerability:
+            external_id: CVE-2024-0000
+  - name: not ignored
+    material: `+absPath(t, "testdata/test-sbom.json")+`
+    expect:
+      ignored: true
+  - name: missing material
+    material: missing.json
+    kind: SBOM_CYCLONEDX_JSON
+`)
+
+		report, err := RunTests(&TestOptions{Paths: []string{suite}}, logger)
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.Passed)
+		assert.Equal(t, 3, report.Failed)
+
+		cases := report.Suites[0].Cases
+		assert.Equal(t, []string{
+			"- skipped: true",
+			"+ skipped: false",
+			"- violation: Something else",
+			"+ violation: Vulnerability found in test-component@1.0.0",
+			`- finding: {"vulnerability":{"external_id":"CVE-2024-0000"}}`,
+			`+ finding: {"vulnerability":{"message":"Vulnerability found in test-component@1.0.0","external_id":"CVE-2024-1234","package_purl":"pkg:generic/test-component@1.0.0","severity":"HIGH","cvss_v3_score":7.5}}`,
+		}, cases[0].Diff)
+		assert.Equal(t, []string{"- ignored: true", "+ ignored: false"}, cases[1].Diff)
+		assert.Contains(t, cases[2].Error, "missing.json")
+
+		var buf bytes.Buffer
+		require.NoError(t, report.WriteJUnit(&buf))
+
+		suites, err := junit.Ingest(buf.Bytes())
+		require.NoError(t, err)
+		require.Len(t, suites, 1)
+		assert.Equal(t, 3, suites[0].Totals.Tests)
+		assert.Equal(t, 2, suites[0].Totals.Failed)
+		assert.Equal(t, 1, suites[0].Totals.Error)
+	})
+
+	t.Run("unknown expectation fields are rejected", func(t *testing.T) {
+		suite := writeTestSuite(t, `tests:
+  - name: typo
+    material: sbom.json
+    expect:
+      violation: []
+`)
+		_, err := RunTests(&TestOptions{Paths: []string{suite}}, logger)
+		assert.ErrorContains(t, err, "violation")
+	})
+
+	t.Run("no suites", func(t *testing.T) {
+		_, err := RunTests(&TestOptions{Paths: []string{t.TempDir()}}, logger)
+		assert.ErrorContains(t, err, "no *_test.yaml test suites found")
+	})
+}
+
+func TestContainsValue(t *testing.T) {
+	var actual any
+	require.NoError(t, json.Unmarshal([]byte(`{"vulnerability":{"external_id":"CVE-1","severity":"HIGH","cvss_v3_score":7.5}}`), &actual))
+
+	assert.True(t, containsValue(actual, map[string]any{"vulnerability": map[string]any{"severity": "HIGH"}}))
+	assert.True(t, containsValue(actual, map[string]any{"vulnerability": map[string]any{"cvss_v3_score": 7.5}}))
+	assert.False(t, containsValue(actual, map[string]any{"vulnerability": map[string]any{"severity": "LOW"}}))
+	assert.False(t, containsValue(actual, map[string]any{"sast": map[string]any{}}))
+}
+
+func writeTestSuite(t *testing.T, content string) string {
+	t.Helper()
+
+	p := filepath.Join(t.TempDir(), "policy_test.yaml")
+	require.NoError(t, os.WriteFile(p, []byte(content), 0600))
+
+	return p
+}
+
+func absPath(t *testing.T, p string) string {
+	t.Helper()
+
+	abs, err := filepath.Abs(p)
+	require.NoError(t, err)
+
+	return abs
+}
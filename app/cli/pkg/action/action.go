@@ -30,8 +30,11 @@ import (
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/statemanager/remote"
 	"github.com/chainloop-dev/chainloop/pkg/casclient"
 	"github.com/chainloop-dev/chainloop/pkg/grpcconn"
+	"github.com/chainloop-dev/chainloop/pkg/policies"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 
 	"github.com/rs/zerolog"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"google.golang.org/grpc"
 )
 
@@ -60,6 +63,10 @@ type ActionsOpts struct {
 	AuthTokenRaw string
 	OutputFormat string
 	CLIVersion   string
+	// PolicyVerificationKeys are the public keys the policies loaded from OCI registries must be signed with
+	PolicyVerificationKeys []string
+	// VulnerabilityDB is the path to the local OSV database the policies can query, optional
+	VulnerabilityDB string
 }
 
 type OffsetPagination struct {
@@ -80,6 +87,42 @@ type newCrafterStateOpts struct {
 	localStatePath    string
 }
 
+// policyCrafterOpts returns the crafter options that configure how policies are loaded
+func policyCrafterOpts(cfg *ActionsOpts) []crafter.NewOpt {
+	if cfg == nil {
+		return nil
+	}
+
+	var opts []crafter.NewOpt
+	if len(cfg.PolicyVerificationKeys) > 0 {
+		opts = append(opts, crafter.WithPolicyVerificationKeys(cfg.PolicyVerificationKeys...))
+	}
+
+	if cfg.VulnerabilityDB != "" {
+		opts = append(opts, crafter.WithVulnerabilityDB(cfg.VulnerabilityDB))
+	}
+
+	return opts
+}
+
+// loadPolicyVerifiers loads the keys the policies loaded from OCI registries must be signed with, if configured
+func loadPolicyVerifiers(cfg *ActionsOpts) ([]signature.Verifier, error) {
+	if cfg == nil || len(cfg.PolicyVerificationKeys) == 0 {
+		return nil, nil
+	}
+
+	return policies.LoadOCIVerifiers(cfg.PolicyVerificationKeys...)
+}
+
+// loadVulnerabilityDB opens the local OSV database, if configured
+func loadVulnerabilityDB(cfg *ActionsOpts) (*osv.Database, error) {
+	if cfg == nil || cfg.VulnerabilityDB == "" {
+		return nil, nil
+	}
+
+	return osv.Open(cfg.VulnerabilityDB)
+}
+
 func newCrafter(stateOpts *newCrafterStateOpts, conn *grpc.ClientConn, opts ...crafter.NewOpt) (*crafter.Crafter, error) {
 	var stateManager crafter.StateManager
 	var err error
@@ -0,0 +1,138 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type AttachedIntegrationDeliveries struct{ cfg *ActionsOpts }
+
+type IntegrationDeliveryItem struct {
+	ID            string     `json:"id"`
+	AttachmentID  string     `json:"attachmentID"`
+	WorkflowRunID string     `json:"workflowRunID"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	LastError     string     `json:"lastError,omitempty"`
+	Duration      string     `json:"duration,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+	FinishedAt    *time.Time `json:"finishedAt,omitempty"`
+}
+
+// IntegrationDeliveryListResult holds the output of the deliveries list actions
+type IntegrationDeliveryListResult struct {
+	Deliveries []*IntegrationDeliveryItem `json:"deliveries"`
+	Pagination *OffsetPagination          `json:"pagination"`
+}
+
+func NewAttachedIntegrationDeliveries(cfg *ActionsOpts) *AttachedIntegrationDeliveries {
+	return &AttachedIntegrationDeliveries{cfg}
+}
+
+// IntegrationDeliveryStatuses are the values accepted to filter the deliveries by status
+var IntegrationDeliveryStatuses = []string{"pending", "succeeded", "dead_letter"}
+
+type AttachedIntegrationDeliveriesOpts struct {
+	AttachmentID string
+	// Optional filters
+	WorkflowRunID, Status string
+	Page, PageSize        int
+}
+
+func (action *AttachedIntegrationDeliveries) Run(ctx context.Context, opts *AttachedIntegrationDeliveriesOpts) (*IntegrationDeliveryListResult, error) {
+	if opts.Page < 1 {
+		return nil, fmt.Errorf("page must be greater or equal to 1")
+	}
+	if opts.PageSize < 1 {
+		return nil, fmt.Errorf("page-size must be greater or equal to 1")
+	}
+
+	req := &pb.IntegrationsServiceListDeliveriesRequest{
+		AttachmentId:  opts.AttachmentID,
+		WorkflowRunId: opts.WorkflowRunID,
+		Pagination: &pb.OffsetPaginationRequest{
+			Page:     int32(opts.Page),
+			PageSize: int32(opts.PageSize),
+		},
+	}
+
+	if opts.Status != "" {
+		// dead_letter => STATUS_DEAD_LETTER
+		status, ok := pb.IntegrationDeliveryItem_Status_value["STATUS_"+strings.ToUpper(opts.Status)]
+		if !ok {
+			return nil, fmt.Errorf("invalid status %q, valid values are %s", opts.Status, strings.Join(IntegrationDeliveryStatuses, ", "))
+		}
+		req.Status = pb.IntegrationDeliveryItem_Status(status)
+	}
+
+	client := pb.NewIntegrationsServiceClient(action.cfg.CPConnection)
+	resp, err := client.ListDeliveries(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return pbIntegrationDeliveryListToAction(resp.GetResult(), resp.GetPagination()), nil
+}
+
+func pbIntegrationDeliveryListToAction(items []*pb.IntegrationDeliveryItem, pagination *pb.OffsetPaginationResponse) *IntegrationDeliveryListResult {
+	res := &IntegrationDeliveryListResult{Deliveries: make([]*IntegrationDeliveryItem, 0, len(items))}
+	for _, d := range items {
+		res.Deliveries = append(res.Deliveries, pbIntegrationDeliveryItemToAction(d))
+	}
+
+	res.Pagination = &OffsetPagination{
+		Page:       int(pagination.GetPage()),
+		PageSize:   int(pagination.GetPageSize()),
+		TotalPages: int(pagination.GetTotalPages()),
+		TotalCount: int(pagination.GetTotalCount()),
+	}
+
+	return res
+}
+
+func pbIntegrationDeliveryItemToAction(in *pb.IntegrationDeliveryItem) *IntegrationDeliveryItem {
+	d := &IntegrationDeliveryItem{
+		ID:            in.GetId(),
+		AttachmentID:  in.GetAttachmentId(),
+		WorkflowRunID: in.GetWorkflowRunId(),
+		// STATUS_DEAD_LETTER => dead_letter
+		Status:    strings.ToLower(strings.TrimPrefix(in.GetStatus().String(), "STATUS_")),
+		Attempts:  int(in.GetAttempts()),
+		LastError: in.GetLastError(),
+		CreatedAt: toTimePtr(in.GetCreatedAt().AsTime()),
+	}
+
+	if in.GetDuration() != nil {
+		d.Duration = in.GetDuration().AsDuration().String()
+	}
+
+	if in.GetUpdatedAt() != nil {
+		d.UpdatedAt = toTimePtr(in.GetUpdatedAt().AsTime())
+	}
+
+	if in.GetFinishedAt() != nil {
+		d.FinishedAt = toTimePtr(in.GetFinishedAt().AsTime())
+	}
+
+	return d
+}
@@ -0,0 +1,52 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type AttachedIntegrationFailedDeliveries struct{ cfg *ActionsOpts }
+
+func NewAttachedIntegrationFailedDeliveries(cfg *ActionsOpts) *AttachedIntegrationFailedDeliveries {
+	return &AttachedIntegrationFailedDeliveries{cfg}
+}
+
+func (action *AttachedIntegrationFailedDeliveries) Run(ctx context.Context, attachmentID string, page, pageSize int) (*IntegrationDeliveryListResult, error) {
+	if page < 1 {
+		return nil, fmt.Errorf("page must be greater or equal to 1")
+	}
+	if pageSize < 1 {
+		return nil, fmt.Errorf("page-size must be greater or equal to 1")
+	}
+
+	client := pb.NewIntegrationsServiceClient(action.cfg.CPConnection)
+	resp, err := client.ListFailedDeliveries(ctx, &pb.IntegrationsServiceListFailedDeliveriesRequest{
+		AttachmentId: attachmentID,
+		Pagination: &pb.OffsetPaginationRequest{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pbIntegrationDeliveryListToAction(resp.GetResult(), resp.GetPagination()), nil
+}
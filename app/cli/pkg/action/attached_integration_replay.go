@@ -0,0 +1,44 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type AttachedIntegrationReplay struct{ cfg *ActionsOpts }
+
+func NewAttachedIntegrationReplay(cfg *ActionsOpts) *AttachedIntegrationReplay {
+	return &AttachedIntegrationReplay{cfg}
+}
+
+// Run queues the failed deliveries of the attachment to be retried, optionally only the given one.
+// It returns the number of replayed deliveries
+func (action *AttachedIntegrationReplay) Run(ctx context.Context, attachmentID, deliveryID string) (int, error) {
+	client := pb.NewIntegrationsServiceClient(action.cfg.CPConnection)
+
+	resp, err := client.ReplayDeliveries(ctx, &pb.IntegrationsServiceReplayDeliveriesRequest{
+		AttachmentId: attachmentID,
+		DeliveryId:   deliveryID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return int(resp.GetReplayed()), nil
+}
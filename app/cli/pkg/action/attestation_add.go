@@ -76,6 +76,7 @@ func NewAttestationAdd(cfg *AttestationAddOpts) (*AttestationAdd, error) {
 	if cfg.NoStrictValidation {
 		opts = append(opts, crafter.WithNoStrictValidation(cfg.NoStrictValidation))
 	}
+	opts = append(opts, policyCrafterOpts(cfg.ActionsOpts)...)
 
 	defaults := materials.DefaultArchiveLimits()
 	maxEntries := cfg.MaxExtractEntries
@@ -75,7 +75,8 @@ func (e ErrRunnerContextNotFound) Error() string {
 }
 
 func NewAttestationInit(cfg *AttestationInitOpts) (*AttestationInit, error) {
-	c, err := newCrafter(&newCrafterStateOpts{enableRemoteState: cfg.UseRemoteState, localStatePath: cfg.LocalStatePath}, cfg.CPConnection, crafter.WithLogger(&cfg.Logger), crafter.WithAuthRawToken(cfg.AuthTokenRaw))
+	opts := append([]crafter.NewOpt{crafter.WithLogger(&cfg.Logger), crafter.WithAuthRawToken(cfg.AuthTokenRaw)}, policyCrafterOpts(cfg.ActionsOpts)...)
+	c, err := newCrafter(&newCrafterStateOpts{enableRemoteState: cfg.UseRemoteState, localStatePath: cfg.LocalStatePath}, cfg.CPConnection, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load crafter: %w", err)
 	}
@@ -215,10 +216,10 @@ func (action *AttestationInit) Run(ctx context.Context, opts *AttestationInitRun
 	// Done before the control-plane Init below so a policy-group load failure
 	// fails fast, before a workflow run is created.
 	if schemaV2 != nil {
-		err = enrichContractMaterialsV2(ctx, schemaV2, client, &action.Logger)
+		err = enrichContractMaterialsV2(ctx, schemaV2, client, action.c.PolicyOCIOptions(), &action.Logger)
 	} else {
 		//nolint:staticcheck // TODO: Migrate to new contract version API
-		err = enrichContractMaterials(ctx, contractVersion.GetV1(), client, &action.Logger)
+		err = enrichContractMaterials(ctx, contractVersion.GetV1(), client, action.c.PolicyOCIOptions(), &action.Logger)
 	}
 	if err != nil {
 		return "", fmt.Errorf("failed to apply materials from policy groups: %w", err)
@@ -384,8 +385,8 @@ func (action *AttestationInit) Run(ctx context.Context, opts *AttestationInitRun
 
 // enrichContractMaterials augments a V1 contract schema with the materials
 // declared by its attached policy groups.
-func enrichContractMaterials(ctx context.Context, schema *v1.CraftingSchema, client pb.AttestationServiceClient, logger *zerolog.Logger) error {
-	merged, err := mergePolicyGroupMaterials(ctx, schema.GetPolicyGroups(), schema.GetMaterials(), client, logger)
+func enrichContractMaterials(ctx context.Context, schema *v1.CraftingSchema, client pb.AttestationServiceClient, ociOpts *policies.OCIOptions, logger *zerolog.Logger) error {
+	merged, err := mergePolicyGroupMaterials(ctx, schema.GetPolicyGroups(), schema.GetMaterials(), client, ociOpts, logger)
 	if err != nil {
 		return err
 	}
@@ -399,13 +400,13 @@ func enrichContractMaterials(ctx context.Context, schema *v1.CraftingSchema, cli
 // declared by its attached policy groups. The V2 schema is the one stored in
 // the crafting state (and thus surfaced during `attestation status`/`add`)
 // whenever it is present, so it must be enriched too. See issue #3222.
-func enrichContractMaterialsV2(ctx context.Context, schema *v1.CraftingSchemaV2, client pb.AttestationServiceClient, logger *zerolog.Logger) error {
+func enrichContractMaterialsV2(ctx context.Context, schema *v1.CraftingSchemaV2, client pb.AttestationServiceClient, ociOpts *policies.OCIOptions, logger *zerolog.Logger) error {
 	spec := schema.GetSpec()
 	if spec == nil {
 		return nil
 	}
 
-	merged, err := mergePolicyGroupMaterials(ctx, spec.GetPolicyGroups(), spec.GetMaterials(), client, logger)
+	merged, err := mergePolicyGroupMaterials(ctx, spec.GetPolicyGroups(), spec.GetMaterials(), client, ociOpts, logger)
 	if err != nil {
 		return err
 	}
@@ -418,11 +419,14 @@ func enrichContractMaterialsV2(ctx context.Context, schema *v1.CraftingSchemaV2,
 // mergePolicyGroupMaterials returns the contract materials augmented with the
 // materials contributed by the attached policy groups. Materials already
 // declared in the contract take precedence and are not duplicated.
-func mergePolicyGroupMaterials(ctx context.Context, policyGroups []*v1.PolicyGroupAttachment, materials []*v1.CraftingSchema_Material, client pb.AttestationServiceClient, logger *zerolog.Logger) ([]*v1.CraftingSchema_Material, error) {
+func mergePolicyGroupMaterials(ctx context.Context, policyGroups []*v1.PolicyGroupAttachment, materials []*v1.CraftingSchema_Material, client pb.AttestationServiceClient, ociOpts *policies.OCIOptions, logger *zerolog.Logger) ([]*v1.CraftingSchema_Material, error) {
 	for _, pgAtt := range policyGroups {
 		group, _, err := policies.LoadPolicyGroup(ctx, pgAtt, &policies.LoadPolicyGroupOptions{
 			Client: client,
 			Logger: logger,
+			OCI:    ociOpts,
+			// the CLI runs locally, so local git repositories can be referenced
+			Git: &policies.GitOptions{AllowLocal: true},
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to load policy group: %w", err)
@@ -94,7 +94,7 @@ func TestEnrichMaterials(t *testing.T) {
 					},
 				},
 			}
-			err := enrichContractMaterials(context.TODO(), &schema, nil, &l)
+			err := enrichContractMaterials(context.TODO(), &schema, nil, nil, &l)
 			if tc.expectErr {
 				assert.Error(t, err)
 				return
@@ -168,7 +168,7 @@ func TestEnrichMaterialsV2Schema(t *testing.T) {
 
 			// Mirror what Initialize does: enrich the V2 schema, which is the one
 			// stored in the crafting state.
-			require.NoError(t, enrichContractMaterialsV2(context.TODO(), schemaV2, nil, &l))
+			require.NoError(t, enrichContractMaterialsV2(context.TODO(), schemaV2, nil, nil, &l))
 
 			// The crafter stores the V2 schema (it takes precedence when present),
 			// and `attestation status` reads expected materials from the stored
@@ -247,7 +247,7 @@ func TestTemplatedGroups(t *testing.T) {
 					},
 				},
 			}
-			err := enrichContractMaterials(context.TODO(), &schema, nil, &l)
+			err := enrichContractMaterials(context.TODO(), &schema, nil, nil, &l)
 			assert.NoError(t, err)
 			assert.Len(t, schema.Materials, tc.nMaterials)
 			if tc.nMaterials > 0 {
@@ -78,6 +78,7 @@ type AttestationPush struct {
 
 func NewAttestationPush(cfg *AttestationPushOpts) (*AttestationPush, error) {
 	opts := []crafter.NewOpt{crafter.WithLogger(&cfg.Logger), crafter.WithAuthRawToken(cfg.AuthTokenRaw)}
+	opts = append(opts, policyCrafterOpts(cfg.ActionsOpts)...)
 	return &AttestationPush{
 		ActionsOpts:        cfg.ActionsOpts,
 		keyPath:            cfg.KeyPath,
@@ -373,9 +373,10 @@ func policyEvaluationStateToActionForStatus(in *v1.PolicyEvaluation) *PolicyEval
 	violations := make([]*PolicyViolation, 0, len(in.Violations))
 	for _, v := range in.Violations {
 		out := &PolicyViolation{
-			Subject:  v.Subject,
-			Message:  v.Message,
-			Suppress: v.GetSuppress(),
+			Subject:     v.Subject,
+			Message:     v.Message,
+			Suppress:    v.GetSuppress(),
+			ExceptionID: v.GetExceptionId(),
 		}
 		switch f := v.GetFinding().(type) {
 		case *v1.PolicyEvaluation_Violation_Vulnerability:
@@ -384,6 +385,12 @@ func policyEvaluationStateToActionForStatus(in *v1.PolicyEvaluation) *PolicyEval
 			out.Sast = f.Sast
 		case *v1.PolicyEvaluation_Violation_LicenseViolation:
 			out.LicenseViolation = f.LicenseViolation
+		case *v1.PolicyEvaluation_Violation_Secret:
+			out.Secret = f.Secret
+		case *v1.PolicyEvaluation_Violation_Misconfiguration:
+			out.Misconfiguration = f.Misconfiguration
+		case *v1.PolicyEvaluation_Violation_Malware:
+			out.Malware = f.Malware
 		}
 		violations = append(violations, out)
 	}
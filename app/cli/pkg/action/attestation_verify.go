@@ -1,5 +1,5 @@
 //
-// Copyright 2025 The Chainloop Authors.
+// Copyright 2025-2026 The Chainloop Authors.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -19,10 +19,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/verifier"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
 	"github.com/sigstore/cosign/v3/pkg/blob"
+	sigs "github.com/sigstore/cosign/v3/pkg/signature"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -31,17 +37,185 @@ type AttestationVerifyAction struct {
 	cfg *ActionsOpts
 }
 
+// AttestationVerifyOpts configures how an attestation bundle is verified.
+// When any of the trusted material paths is set, verification is done offline
+// against that material instead of the trusted root served by the control plane.
+type AttestationVerifyOpts struct {
+	// Path to a sigstore trusted_root.json document
+	TrustedRootPath string
+	// Public key reference, i.e a cosign public key file
+	PublicKeyRef string
+	// Certificate chain (intermediates, root) in PEM format of the signing CA, i.e Fulcio
+	CertChainPath string
+	// Certificate chain (leaf, intermediates, root) in PEM format of the timestamp authority
+	TSACertChainPath string
+	// Digest, in the form algorithm:hex, that must match one of the attestation subjects
+	SubjectDigest string
+	// Fail if the policy evaluations embedded in the attestation reported violations
+	CheckPolicies bool
+}
+
 func NewAttestationVerifyAction(cfg *ActionsOpts) *AttestationVerifyAction {
 	return &AttestationVerifyAction{cfg}
 }
 
-func (action *AttestationVerifyAction) Run(ctx context.Context, fileOrURL string) (bool, error) {
+func (o *AttestationVerifyOpts) offline() bool {
+	return o.TrustedRootPath != "" || o.PublicKeyRef != "" || o.CertChainPath != "" || o.TSACertChainPath != ""
+}
+
+// Run verifies the attestation bundle and the requested checks. Any failure is returned as an error.
+func (action *AttestationVerifyAction) Run(ctx context.Context, fileOrURL string, opts *AttestationVerifyOpts) error {
+	if opts == nil {
+		opts = &AttestationVerifyOpts{}
+	}
+
 	content, err := blob.LoadFileOrURL(fileOrURL)
 	if err != nil {
-		return false, fmt.Errorf("loading attestation: %w", err)
+		return fmt.Errorf("loading attestation: %w", err)
+	}
+
+	if opts.offline() {
+		tr, err := loadLocalTrustedRoot(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		if err := verifier.VerifyBundle(ctx, content, tr); err != nil {
+			return fmt.Errorf("bundle verification failed: %w", err)
+		}
+	} else {
+		verified, err := verifyBundle(ctx, content, action.cfg)
+		if err != nil {
+			return err
+		}
+
+		if !verified {
+			return errors.New("attestation couldn't be verified, the control plane didn't provide a trusted root. Provide the trusted material to verify it offline")
+		}
+	}
+
+	if opts.SubjectDigest == "" && !opts.CheckPolicies {
+		return nil
+	}
+
+	envelope, err := attestation.DSSEEnvelopeFromBundleBytes(content)
+	if err != nil {
+		return fmt.Errorf("extracting envelope: %w", err)
+	}
+
+	if opts.SubjectDigest != "" {
+		if err := verifySubjectDigest(envelope, opts.SubjectDigest); err != nil {
+			return err
+		}
+	}
+
+	if opts.CheckPolicies {
+		if err := verifyPolicyEvaluations(envelope); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadLocalTrustedRoot builds the trusted root from the material provided on the command line
+func loadLocalTrustedRoot(ctx context.Context, opts *AttestationVerifyOpts) (*verifier.TrustedRoot, error) {
+	tr := verifier.NewTrustedRoot()
+	if opts.TrustedRootPath != "" {
+		var err error
+		if tr, err = verifier.LoadSigstoreTrustedRoot(opts.TrustedRootPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.PublicKeyRef != "" {
+		v, err := sigs.PublicKeyFromKeyRef(ctx, opts.PublicKeyRef)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+
+		pk, err := v.PublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+
+		tr.PublicKeys = append(tr.PublicKeys, pk)
+	}
+
+	if opts.CertChainPath != "" {
+		chain, err := loadCertificates(opts.CertChainPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading certificate chain: %w", err)
+		}
+
+		if err := tr.AddCertificateChain(chain); err != nil {
+			return nil, fmt.Errorf("loading certificate chain: %w", err)
+		}
+	}
+
+	if opts.TSACertChainPath != "" {
+		chain, err := loadCertificates(opts.TSACertChainPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading TSA certificate chain: %w", err)
+		}
+
+		if err := tr.AddTimestampAuthorityChain(chain); err != nil {
+			return nil, fmt.Errorf("loading TSA certificate chain: %w", err)
+		}
+	}
+
+	return tr, nil
+}
+
+// verifySubjectDigest checks that the expected digest is one of the attestation subjects
+func verifySubjectDigest(envelope *dsse.Envelope, digest string) error {
+	algorithm, value, ok := strings.Cut(digest, ":")
+	if !ok || algorithm == "" || value == "" {
+		return fmt.Errorf("invalid digest %q, expected the form algorithm:hex", digest)
+	}
+
+	statement, err := chainloop.ExtractStatement(envelope)
+	if err != nil {
+		return fmt.Errorf("extracting statement: %w", err)
+	}
+
+	for _, s := range statement.GetSubject() {
+		if strings.EqualFold(s.GetDigest()[algorithm], value) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("digest %s doesn't match any of the attestation subjects", digest)
+}
+
+// verifyPolicyEvaluations fails if the policy evaluations recorded in the attestation reported violations
+func verifyPolicyEvaluations(envelope *dsse.Envelope) error {
+	predicate, err := chainloop.ExtractPredicate(envelope)
+	if err != nil {
+		return fmt.Errorf("extracting predicate: %w", err)
+	}
+
+	status := predicate.GetPolicyEvaluationStatus()
+	if !status.HasViolations && status.ViolationsCount == 0 && !status.Blocked {
+		return nil
+	}
+
+	// name the offending policies when the evaluations are embedded in the predicate
+	var failed []string
+	for _, evs := range predicate.GetPolicyEvaluations() {
+		for _, ev := range evs {
+			if len(ev.Violations) > 0 && !slices.Contains(failed, ev.Name) {
+				failed = append(failed, ev.Name)
+			}
+		}
+	}
+
+	if len(failed) == 0 {
+		return fmt.Errorf("attestation has %d policy violations", status.ViolationsCount)
 	}
 
-	return verifyBundle(ctx, content, action.cfg)
+	slices.Sort(failed)
+	return fmt.Errorf("attestation has %d policy violations in policies: %s", status.ViolationsCount, strings.Join(failed, ", "))
 }
 
 func verifyBundle(ctx context.Context, content []byte, opts *ActionsOpts) (bool, error) {
@@ -0,0 +1,147 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestAttestationVerifyOffline(t *testing.T) {
+	envelope, err := readEnvelope("testdata/cosign-attestation.json")
+	require.NoError(t, err)
+	bundle, err := attestation.BundleFromDSSEEnvelope(envelope)
+	require.NoError(t, err)
+	raw, err := protojson.Marshal(bundle)
+	require.NoError(t, err)
+	bundlePath := filepath.Join(t.TempDir(), "bundle.json")
+	require.NoError(t, os.WriteFile(bundlePath, raw, 0o600))
+
+	testCases := []struct {
+		name    string
+		opts    *AttestationVerifyOpts
+		wantErr string
+	}{
+		{
+			name: "verified with the cosign key",
+			opts: &AttestationVerifyOpts{PublicKeyRef: "testdata/cosign.pub"},
+		},
+		{
+			name: "matching subject digest",
+			opts: &AttestationVerifyOpts{PublicKeyRef: "testdata/cosign.pub", SubjectDigest: "sha256:b81726b02d6ee3b2d6cf4caaa6d9846743e882a54f7594f2e1ecfc710cfe3a3c"},
+		},
+		{
+			name:    "non matching subject digest",
+			opts:    &AttestationVerifyOpts{PublicKeyRef: "testdata/cosign.pub", SubjectDigest: "sha256:deadbeef"},
+			wantErr: "doesn't match any of the attestation subjects",
+		},
+		{
+			name:    "invalid subject digest",
+			opts:    &AttestationVerifyOpts{PublicKeyRef: "testdata/cosign.pub", SubjectDigest: "deadbeef"},
+			wantErr: "invalid digest",
+		},
+		{
+			name:    "signed by another authority",
+			opts:    &AttestationVerifyOpts{CertChainPath: "testdata/ca.pub"},
+			wantErr: "bundle verification failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewAttestationVerifyAction(&ActionsOpts{}).Run(context.TODO(), bundlePath, tc.opts)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyPolicyEvaluations(t *testing.T) {
+	testCases := []struct {
+		name      string
+		predicate map[string]any
+		wantErr   string
+	}{
+		{
+			name:      "no evaluations",
+			predicate: map[string]any{},
+		},
+		{
+			name: "passed evaluations",
+			predicate: map[string]any{
+				"policyEvaluationsCount": 1,
+				"policyEvaluations":      map[string]any{"sbom": []any{map[string]any{"name": "sbom-present"}}},
+			},
+		},
+		{
+			name: "violations",
+			predicate: map[string]any{
+				"policyHasViolations":    true,
+				"policyViolationsCount":  1,
+				"policyEvaluationsCount": 2,
+				"policyEvaluations": map[string]any{"sbom": []any{
+					map[string]any{"name": "sbom-present"},
+					map[string]any{"name": "no-vulnerabilities", "violations": []any{map[string]any{"subject": "sbom", "message": "CVE-2024-1234"}}},
+				}},
+			},
+			wantErr: "1 policy violations in policies: no-vulnerabilities",
+		},
+		{
+			name: "violations not embedded in the predicate",
+			predicate: map[string]any{
+				"policyHasViolations":   true,
+				"policyViolationsCount": 3,
+			},
+			wantErr: "attestation has 3 policy violations",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statement, err := json.Marshal(map[string]any{
+				"_type":         "https://in-toto.io/Statement/v1",
+				"subject":       []any{map[string]any{"name": "test", "digest": map[string]string{"sha256": "deadbeef"}}},
+				"predicateType": chainloop.PredicateTypeV02,
+				"predicate":     tc.predicate,
+			})
+			require.NoError(t, err)
+
+			err = verifyPolicyEvaluations(&dsse.Envelope{
+				PayloadType: "application/vnd.in-toto+json",
+				Payload:     base64.StdEncoding.EncodeToString(statement),
+			})
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
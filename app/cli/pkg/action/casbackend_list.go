@@ -38,6 +38,8 @@ type CASBackendItem struct {
 	Limits           *CASBackendLimits `json:"limits"`
 	ValidationStatus ValidationStatus  `json:"validationStatus"`
 	ValidationError  *string           `json:"validationError,omitempty"`
+	// ID of the backend this one is a replica of
+	PrimaryID *string `json:"primaryID,omitempty"`
 
 	CreatedAt   *time.Time `json:"createdAt"`
 	ValidatedAt *time.Time `json:"validatedAt"`
@@ -109,5 +111,9 @@ func pbCASBackendItemToAction(in *pb.CASBackendItem) *CASBackendItem {
 		b.ValidationError = in.ValidationError
 	}
 
+	if in.PrimaryId != nil {
+		b.PrimaryID = in.PrimaryId
+	}
+
 	return b
 }
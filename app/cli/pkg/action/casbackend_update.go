@@ -34,6 +34,8 @@ type NewCASBackendUpdateOpts struct {
 	Fallback    *bool
 	Credentials map[string]any
 	MaxBytes    *int64
+	// Name of the backend to mirror, empty to detach it from its primary
+	ReplicaOf *string
 }
 
 func NewCASBackendUpdate(cfg *ActionsOpts) *CASBackendUpdate {
@@ -59,6 +61,7 @@ func (action *CASBackendUpdate) Run(opts *NewCASBackendUpdateOpts) (*CASBackendI
 		Fallback:    opts.Fallback,
 		Credentials: credentials,
 		MaxBytes:    opts.MaxBytes,
+		ReplicaOf:   opts.ReplicaOf,
 	})
 	if err != nil {
 		return nil, err
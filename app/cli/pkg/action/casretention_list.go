@@ -0,0 +1,79 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type CASRetentionList struct {
+	cfg *ActionsOpts
+}
+
+type CASRetentionRuleItem struct {
+	ID string `json:"id"`
+	// Name of the CAS backend, empty for the organization default rule
+	CASBackendName       string `json:"casBackendName,omitempty"`
+	MaxAgeDays           int32  `json:"maxAgeDays"`
+	KeepReleasedVersions bool   `json:"keepReleasedVersions"`
+
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+	LastSweptAt *time.Time `json:"lastSweptAt,omitempty"`
+}
+
+func NewCASRetentionList(cfg *ActionsOpts) *CASRetentionList {
+	return &CASRetentionList{cfg}
+}
+
+func (action *CASRetentionList) Run() ([]*CASRetentionRuleItem, error) {
+	client := pb.NewCASRetentionServiceClient(action.cfg.CPConnection)
+	resp, err := client.List(context.Background(), &pb.CASRetentionServiceListRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*CASRetentionRuleItem, 0, len(resp.Result))
+	for _, r := range resp.Result {
+		result = append(result, pbCASRetentionRuleItemToAction(r))
+	}
+
+	return result, nil
+}
+
+func pbCASRetentionRuleItemToAction(in *pb.CASRetentionRuleItem) *CASRetentionRuleItem {
+	if in == nil {
+		return nil
+	}
+
+	r := &CASRetentionRuleItem{
+		ID:                   in.Id,
+		CASBackendName:       in.CasBackendName,
+		MaxAgeDays:           in.MaxAgeDays,
+		KeepReleasedVersions: in.KeepReleasedVersions,
+		CreatedAt:            toTimePtr(in.CreatedAt.AsTime()),
+		UpdatedAt:            toTimePtr(in.UpdatedAt.AsTime()),
+	}
+
+	if in.LastSweptAt != nil {
+		r.LastSweptAt = toTimePtr(in.LastSweptAt.AsTime())
+	}
+
+	return r
+}
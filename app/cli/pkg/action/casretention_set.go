@@ -0,0 +1,51 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type CASRetentionSet struct {
+	cfg *ActionsOpts
+}
+
+type NewCASRetentionSetOpts struct {
+	// Name of the CAS backend, empty to set the organization default rule
+	CASBackendName       string
+	MaxAgeDays           int32
+	KeepReleasedVersions bool
+}
+
+func NewCASRetentionSet(cfg *ActionsOpts) *CASRetentionSet {
+	return &CASRetentionSet{cfg}
+}
+
+func (action *CASRetentionSet) Run(opts *NewCASRetentionSetOpts) (*CASRetentionRuleItem, error) {
+	client := pb.NewCASRetentionServiceClient(action.cfg.CPConnection)
+	resp, err := client.Set(context.Background(), &pb.CASRetentionServiceSetRequest{
+		CasBackendName:       opts.CASBackendName,
+		MaxAgeDays:           opts.MaxAgeDays,
+		KeepReleasedVersions: opts.KeepReleasedVersions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pbCASRetentionRuleItemToAction(resp.Result), nil
+}
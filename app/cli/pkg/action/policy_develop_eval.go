@@ -51,6 +51,16 @@ func (action *PolicyEval) Run() (*policydevel.EvalSummary, error) {
 		attClient = pb.NewAttestationServiceClient(action.CPConnection)
 	}
 
+	verifiers, err := loadPolicyVerifiers(action.ActionsOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	vulnDB, err := loadVulnerabilityDB(action.ActionsOpts)
+	if err != nil {
+		return nil, err
+	}
+
 	evalOpts := &policydevel.EvalOptions{
 		PolicyPath:         action.opts.PolicyPath,
 		MaterialKind:       action.opts.Kind,
@@ -61,6 +71,8 @@ func (action *PolicyEval) Run() (*policydevel.EvalSummary, error) {
 		Debug:              action.opts.Debug,
 		AttestationClient:  attClient,
 		ControlPlaneConn:   action.CPConnection,
+		VulnerabilityDB:    vulnDB,
+		OCIVerifiers:       verifiers,
 		ProjectName:        action.opts.ProjectName,
 		ProjectVersionName: action.opts.ProjectVersionName,
 	}
@@ -0,0 +1,64 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+
+	"github.com/chainloop-dev/chainloop/app/cli/internal/policydevel"
+)
+
+type PolicyTestOpts struct {
+	// Test suite files or directories containing them
+	Paths            []string
+	Run              string
+	AllowedHostnames []string
+}
+
+type PolicyTestResult = policydevel.TestReport
+
+type PolicyTest struct {
+	*ActionsOpts
+	opts *PolicyTestOpts
+}
+
+func NewPolicyTest(opts *PolicyTestOpts, actionOpts *ActionsOpts) *PolicyTest {
+	return &PolicyTest{
+		ActionsOpts: actionOpts,
+		opts:        opts,
+	}
+}
+
+func (action *PolicyTest) Run() (*PolicyTestResult, error) {
+	var attClient pb.AttestationServiceClient
+	if action.CPConnection != nil {
+		attClient = pb.NewAttestationServiceClient(action.CPConnection)
+	}
+
+	vulnDB, err := loadVulnerabilityDB(action.ActionsOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return policydevel.RunTests(&policydevel.TestOptions{
+		Paths:             action.opts.Paths,
+		Run:               action.opts.Run,
+		AllowedHostnames:  action.opts.AllowedHostnames,
+		AttestationClient: attClient,
+		ControlPlaneConn:  action.CPConnection,
+		VulnerabilityDB:   vulnDB,
+	}, action.Logger)
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// How often the status of the analysis running in the controlplane is checked
+const policyImpactPollInterval = 5 * time.Second
+
+type PolicyImpact struct {
+	cfg *ActionsOpts
+}
+
+type PolicyImpactOpts struct {
+	// Either a policy or a policy group from a provider, and their arguments
+	PolicyRef, GroupRef string
+	With                map[string]string
+	ProjectName         string
+	// semver constraint on the project version, i.e ">= 1.2.0, < 2.0.0"
+	VersionRange string
+	// both ends of the date range are optional
+	CreatedAfter, CreatedBefore *time.Time
+	Limit                       int
+}
+
+type PolicyImpactReport struct {
+	EvaluatedCount int                 `json:"evaluatedCount"`
+	NewlyFailing   []*PolicyImpactItem `json:"newlyFailing"`
+	Errored        []*PolicyImpactItem `json:"errored"`
+}
+
+type PolicyImpactItem struct {
+	WorkflowRunID  string                   `json:"workflowRunId"`
+	CreatedAt      *time.Time               `json:"createdAt,omitempty"`
+	Workflow       *WorkflowRef             `json:"workflow,omitempty"`
+	ProjectVersion string                   `json:"projectVersion,omitempty"`
+	Violations     []*PolicyImpactViolation `json:"violations,omitempty"`
+	Error          string                   `json:"error,omitempty"`
+}
+
+type PolicyImpactViolation struct {
+	PolicyName   string `json:"policyName"`
+	MaterialName string `json:"materialName,omitempty"`
+	Message      string `json:"message"`
+}
+
+func NewPolicyImpact(cfg *ActionsOpts) *PolicyImpact {
+	return &PolicyImpact{cfg}
+}
+
+func (action *PolicyImpact) Run(opts *PolicyImpactOpts) (*PolicyImpactReport, error) {
+	req := &pb.PolicyEvaluationServiceImpactRequest{
+		PolicyRef:    opts.PolicyRef,
+		GroupRef:     opts.GroupRef,
+		With:         opts.With,
+		ProjectName:  opts.ProjectName,
+		VersionRange: opts.VersionRange,
+		Limit:        int32(opts.Limit),
+	}
+
+	if opts.CreatedAfter != nil {
+		req.CreatedAfter = timestamppb.New(*opts.CreatedAfter)
+	}
+
+	if opts.CreatedBefore != nil {
+		req.CreatedBefore = timestamppb.New(*opts.CreatedBefore)
+	}
+
+	ctx := context.Background()
+	client := pb.NewPolicyEvaluationServiceClient(action.cfg.CPConnection)
+	resp, err := client.Impact(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the analysis runs in the background, wait for it to finish
+	analysis := resp.GetResult()
+	for analysis.GetStatus() == pb.PolicyImpactAnalysis_STATUS_RUNNING {
+		time.Sleep(policyImpactPollInterval)
+
+		resp, err := client.ImpactReport(ctx, &pb.PolicyEvaluationServiceImpactReportRequest{Id: analysis.GetId()})
+		if err != nil {
+			return nil, fmt.Errorf("retrieving analysis %s: %w", analysis.GetId(), err)
+		}
+
+		analysis = resp.GetResult()
+	}
+
+	if analysis.GetStatus() != pb.PolicyImpactAnalysis_STATUS_SUCCEEDED {
+		return nil, fmt.Errorf("analysis %s failed: %s", analysis.GetId(), analysis.GetError())
+	}
+
+	report := &PolicyImpactReport{
+		EvaluatedCount: int(analysis.GetEvaluatedCount()),
+		NewlyFailing:   make([]*PolicyImpactItem, 0, len(analysis.GetNewlyFailing())),
+		Errored:        make([]*PolicyImpactItem, 0, len(analysis.GetErrored())),
+	}
+
+	for _, i := range analysis.GetNewlyFailing() {
+		report.NewlyFailing = append(report.NewlyFailing, pbPolicyImpactItemToAction(i))
+	}
+
+	for _, i := range analysis.GetErrored() {
+		report.Errored = append(report.Errored, pbPolicyImpactItemToAction(i))
+	}
+
+	return report, nil
+}
+
+func pbPolicyImpactItemToAction(in *pb.PolicyImpactItem) *PolicyImpactItem {
+	item := &PolicyImpactItem{
+		WorkflowRunID:  in.GetWorkflowRunId(),
+		ProjectVersion: in.GetProjectVersion(),
+		Error:          in.GetError(),
+	}
+
+	if in.GetCreatedAt() != nil {
+		item.CreatedAt = toTimePtr(in.GetCreatedAt().AsTime())
+	}
+
+	if in.GetWorkflow() != nil {
+		item.Workflow = pbWorkflowRefToAction(in.GetWorkflow())
+	}
+
+	for _, v := range in.GetViolations() {
+		item.Violations = append(item.Violations, &PolicyImpactViolation{
+			PolicyName:   v.GetPolicyName(),
+			MaterialName: v.GetMaterialName(),
+			Message:      v.GetMessage(),
+		})
+	}
+
+	return item
+}
@@ -0,0 +1,67 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type ProjectCreate struct {
+	cfg *ActionsOpts
+}
+
+type ProjectItem struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+func NewProjectCreate(cfg *ActionsOpts) *ProjectCreate {
+	return &ProjectCreate{cfg}
+}
+
+func (action *ProjectCreate) Run(ctx context.Context, name string) (*ProjectItem, error) {
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.Create(ctx, &pb.ProjectServiceCreateRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return pbProjectItemToAction(resp.Result), nil
+}
+
+// pbProjectItemToAction converts API response to ProjectItem
+func pbProjectItemToAction(in *pb.ProjectItem) *ProjectItem {
+	if in == nil {
+		return nil
+	}
+
+	p := &ProjectItem{
+		ID:        in.GetId(),
+		Name:      in.GetName(),
+		CreatedAt: toTimePtr(in.GetCreatedAt().AsTime()),
+	}
+
+	if in.GetUpdatedAt() != nil {
+		p.UpdatedAt = toTimePtr(in.GetUpdatedAt().AsTime())
+	}
+
+	return p
+}
@@ -0,0 +1,41 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type ProjectDelete struct {
+	cfg *ActionsOpts
+}
+
+func NewProjectDelete(cfg *ActionsOpts) *ProjectDelete {
+	return &ProjectDelete{cfg}
+}
+
+func (action *ProjectDelete) Run(ctx context.Context, name string) error {
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	if _, err := client.Delete(ctx, &pb.ProjectServiceDeleteRequest{
+		ProjectReference: &pb.IdentityReference{Name: &name},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type ProjectDescribe struct {
+	cfg *ActionsOpts
+}
+
+// ProjectDescribeResult holds the project along with its latest version, if any
+type ProjectDescribeResult struct {
+	*ProjectItem
+	LatestVersion *ProjectVersionItem `json:"latestVersion,omitempty"`
+}
+
+func NewProjectDescribe(cfg *ActionsOpts) *ProjectDescribe {
+	return &ProjectDescribe{cfg}
+}
+
+func (action *ProjectDescribe) Run(ctx context.Context, name string) (*ProjectDescribeResult, error) {
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.Describe(ctx, &pb.ProjectServiceDescribeRequest{
+		ProjectReference: &pb.IdentityReference{Name: &name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectDescribeResult{
+		ProjectItem:   pbProjectItemToAction(resp.GetResult()),
+		LatestVersion: pbProjectVersionItemToAction(resp.GetLatestVersion()),
+	}, nil
+}
@@ -0,0 +1,71 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type ProjectList struct {
+	cfg *ActionsOpts
+}
+
+// ProjectListResult holds the output of the project list action
+type ProjectListResult struct {
+	Projects   []*ProjectItem    `json:"projects"`
+	Pagination *OffsetPagination `json:"pagination"`
+}
+
+func NewProjectList(cfg *ActionsOpts) *ProjectList {
+	return &ProjectList{cfg}
+}
+
+func (action *ProjectList) Run(ctx context.Context, page int, pageSize int) (*ProjectListResult, error) {
+	if page < 1 {
+		return nil, fmt.Errorf("page must be greater or equal to 1")
+	}
+	if pageSize < 1 {
+		return nil, fmt.Errorf("page-size must be greater or equal to 1")
+	}
+
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.List(ctx, &pb.ProjectServiceListRequest{
+		Pagination: &pb.OffsetPaginationRequest{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ProjectListResult{}
+	for _, p := range resp.GetResult() {
+		res.Projects = append(res.Projects, pbProjectItemToAction(p))
+	}
+
+	res.Pagination = &OffsetPagination{
+		Page:       int(resp.GetPagination().GetPage()),
+		PageSize:   int(resp.GetPagination().GetPageSize()),
+		TotalPages: int(resp.GetPagination().GetTotalPages()),
+		TotalCount: int(resp.GetPagination().GetTotalCount()),
+	}
+
+	return res, nil
+}
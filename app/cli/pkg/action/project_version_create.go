@@ -0,0 +1,44 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type ProjectVersionCreate struct {
+	cfg *ActionsOpts
+}
+
+func NewProjectVersionCreate(cfg *ActionsOpts) *ProjectVersionCreate {
+	return &ProjectVersionCreate{cfg}
+}
+
+func (action *ProjectVersionCreate) Run(ctx context.Context, projectName, version string, released bool) (*ProjectVersionItem, error) {
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.CreateVersion(ctx, &pb.ProjectServiceCreateVersionRequest{
+		ProjectReference: &pb.IdentityReference{Name: &projectName},
+		Version:          version,
+		Released:         released,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pbProjectVersionItemToAction(resp.GetResult()), nil
+}
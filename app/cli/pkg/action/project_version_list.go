@@ -0,0 +1,103 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+type ProjectVersionList struct {
+	cfg *ActionsOpts
+}
+
+type ProjectVersionItem struct {
+	ID         string     `json:"id"`
+	Version    string     `json:"version"`
+	Prerelease bool       `json:"prerelease"`
+	Latest     bool       `json:"latest"`
+	CreatedAt  *time.Time `json:"createdAt"`
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+}
+
+// ProjectVersionListResult holds the output of the project version list action
+type ProjectVersionListResult struct {
+	Versions   []*ProjectVersionItem `json:"versions"`
+	Pagination *OffsetPagination     `json:"pagination"`
+}
+
+func NewProjectVersionList(cfg *ActionsOpts) *ProjectVersionList {
+	return &ProjectVersionList{cfg}
+}
+
+func (action *ProjectVersionList) Run(ctx context.Context, projectName string, page int, pageSize int) (*ProjectVersionListResult, error) {
+	if page < 1 {
+		return nil, fmt.Errorf("page must be greater or equal to 1")
+	}
+	if pageSize < 1 {
+		return nil, fmt.Errorf("page-size must be greater or equal to 1")
+	}
+
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.ListVersions(ctx, &pb.ProjectServiceListVersionsRequest{
+		ProjectReference: &pb.IdentityReference{Name: &projectName},
+		Pagination: &pb.OffsetPaginationRequest{
+			Page:     int32(page),
+			PageSize: int32(pageSize),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ProjectVersionListResult{}
+	for _, v := range resp.GetResult() {
+		res.Versions = append(res.Versions, pbProjectVersionItemToAction(v))
+	}
+
+	res.Pagination = &OffsetPagination{
+		Page:       int(resp.GetPagination().GetPage()),
+		PageSize:   int(resp.GetPagination().GetPageSize()),
+		TotalPages: int(resp.GetPagination().GetTotalPages()),
+		TotalCount: int(resp.GetPagination().GetTotalCount()),
+	}
+
+	return res, nil
+}
+
+// pbProjectVersionItemToAction converts API response to ProjectVersionItem
+func pbProjectVersionItemToAction(in *pb.ProjectVersion) *ProjectVersionItem {
+	if in == nil {
+		return nil
+	}
+
+	v := &ProjectVersionItem{
+		ID:         in.GetId(),
+		Version:    in.GetVersion(),
+		Prerelease: in.GetPrerelease(),
+		Latest:     in.GetLatest(),
+		CreatedAt:  toTimePtr(in.GetCreatedAt().AsTime()),
+	}
+
+	if in.GetReleasedAt() != nil {
+		v.ReleasedAt = toTimePtr(in.GetReleasedAt().AsTime())
+	}
+
+	return v
+}
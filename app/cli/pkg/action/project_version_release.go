@@ -0,0 +1,66 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+)
+
+// ProjectVersionPromote marks a pre-release version as released
+type ProjectVersionPromote struct {
+	cfg *ActionsOpts
+}
+
+func NewProjectVersionPromote(cfg *ActionsOpts) *ProjectVersionPromote {
+	return &ProjectVersionPromote{cfg}
+}
+
+func (action *ProjectVersionPromote) Run(ctx context.Context, projectName, version string) (*ProjectVersionItem, error) {
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.PromoteVersion(ctx, &pb.ProjectServicePromoteVersionRequest{
+		ProjectReference: &pb.IdentityReference{Name: &projectName},
+		Version:          version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pbProjectVersionItemToAction(resp.GetResult()), nil
+}
+
+// ProjectVersionUnrelease moves a released version back to pre-release
+type ProjectVersionUnrelease struct {
+	cfg *ActionsOpts
+}
+
+func NewProjectVersionUnrelease(cfg *ActionsOpts) *ProjectVersionUnrelease {
+	return &ProjectVersionUnrelease{cfg}
+}
+
+func (action *ProjectVersionUnrelease) Run(ctx context.Context, projectName, version string) (*ProjectVersionItem, error) {
+	client := pb.NewProjectServiceClient(action.cfg.CPConnection)
+	resp, err := client.UnreleaseVersion(ctx, &pb.ProjectServiceUnreleaseVersionRequest{
+		ProjectReference: &pb.IdentityReference{Name: &projectName},
+		Version:          version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pbProjectVersionItemToAction(resp.GetResult()), nil
+}
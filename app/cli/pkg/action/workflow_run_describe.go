@@ -117,11 +117,16 @@ type PolicyViolation struct {
 	Subject  string `json:"subject"`
 	Message  string `json:"message"`
 	Suppress bool   `json:"suppress,omitempty"`
+	// Policy exception that suppressed the violation, if any
+	ExceptionID string `json:"exception_id,omitempty"`
 	// Mirrors the oneof on the wire — exactly one pointer is set per
 	// violation, or none for unstructured policies.
 	Vulnerability    *attv1.PolicyVulnerabilityFinding    `json:"vulnerability,omitempty"`
 	Sast             *attv1.PolicySASTFinding             `json:"sast,omitempty"`
 	LicenseViolation *attv1.PolicyLicenseViolationFinding `json:"license_violation,omitempty"`
+	Secret           *attv1.PolicySecretFinding           `json:"secret,omitempty"`
+	Misconfiguration *attv1.PolicyMisconfigurationFinding `json:"misconfiguration,omitempty"`
+	Malware          *attv1.PolicyMalwareFinding          `json:"malware,omitempty"`
 }
 
 type PolicyReference struct {
@@ -300,9 +305,10 @@ func policyEvaluationPBToAction(in *pb.PolicyEvaluation) *PolicyEvaluation {
 	violations := make([]*PolicyViolation, 0, len(in.Violations))
 	for _, v := range in.Violations {
 		out := &PolicyViolation{
-			Subject:  v.Subject,
-			Message:  v.Message,
-			Suppress: v.GetSuppress(),
+			Subject:     v.Subject,
+			Message:     v.Message,
+			Suppress:    v.GetSuppress(),
+			ExceptionID: v.GetExceptionId(),
 		}
 		switch f := v.GetFinding().(type) {
 		case *pb.PolicyViolation_Vulnerability:
@@ -311,6 +317,12 @@ func policyEvaluationPBToAction(in *pb.PolicyEvaluation) *PolicyEvaluation {
 			out.Sast = f.Sast
 		case *pb.PolicyViolation_LicenseViolation:
 			out.LicenseViolation = f.LicenseViolation
+		case *pb.PolicyViolation_Secret:
+			out.Secret = f.Secret
+		case *pb.PolicyViolation_Misconfiguration:
+			out.Misconfiguration = f.Misconfiguration
+		case *pb.PolicyViolation_Malware:
+			out.Malware = f.Malware
 		}
 		violations = append(violations, out)
 	}
@@ -199,6 +199,8 @@ func humanizedRunnerType(in v1.CraftingSchema_Runner_RunnerType) string {
 		*v1.CraftingSchema_Runner_TEAMCITY_PIPELINE.Enum():       "TeamCity Pipeline",
 		*v1.CraftingSchema_Runner_TEKTON_PIPELINE.Enum():         "Tekton Pipeline",
 		*v1.CraftingSchema_Runner_CHAINLOOP_SANDBOX.Enum():       "Chainloop Sandbox",
+		*v1.CraftingSchema_Runner_BITBUCKET_PIPELINE.Enum():      "Bitbucket Pipeline",
+		*v1.CraftingSchema_Runner_BUILDKITE_PIPELINE.Enum():      "Buildkite Pipeline",
 	}
 
 	hrt, ok := mapping[in]
@@ -72,6 +72,14 @@ func (s *workflowRunListSuite) TestHumanizedRunnerType() {
 			name:           "chainloop sandbox runner",
 			testInput:      v1.CraftingSchema_Runner_CHAINLOOP_SANDBOX,
 			expectedOutput: "Chainloop Sandbox",
+		}, {
+			name:           "bitbucket runner",
+			testInput:      v1.CraftingSchema_Runner_BITBUCKET_PIPELINE,
+			expectedOutput: "Bitbucket Pipeline",
+		}, {
+			name:           "buildkite runner",
+			testInput:      v1.CraftingSchema_Runner_BUILDKITE_PIPELINE,
+			expectedOutput: "Buildkite Pipeline",
 		}, {
 			name:           "unknown runner",
 			testInput:      -34,
@@ -0,0 +1,143 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WorkflowRunPolicyStatus represents the policy status a workflow run can be searched by
+var WorkflowRunPolicyStatus = func() map[string]pb.PolicyStatusFilter {
+	res := make(map[string]pb.PolicyStatusFilter)
+	for k, v := range pb.PolicyStatusFilter_value {
+		if k != "POLICY_STATUS_FILTER_UNSPECIFIED" {
+			res[strings.ToLower(strings.Replace(k, "POLICY_STATUS_FILTER_", "", 1))] = pb.PolicyStatusFilter(v)
+		}
+	}
+	return res
+}
+
+type WorkflowRunSearch struct {
+	cfg *ActionsOpts
+}
+
+type WorkflowRunSearchOpts struct {
+	WorkflowName, ProjectName string
+	// digest of any of the materials, i.e sha256:deadbeef
+	MaterialDigest string
+	// material type, i.e SBOM_CYCLONEDX_JSON
+	MaterialType string
+	SubjectName  string
+	// AnnotationValue is optional, it requires AnnotationName
+	AnnotationName, AnnotationValue string
+	// runner type, i.e GITHUB_ACTION
+	RunnerType string
+	// one of the keys of WorkflowRunPolicyStatus
+	PolicyStatus  string
+	HasViolations *bool
+	// both ends of the date range are optional
+	CreatedAfter, CreatedBefore *time.Time
+	Pagination                  *PaginationOpts
+}
+
+func NewWorkflowRunSearch(cfg *ActionsOpts) *WorkflowRunSearch {
+	return &WorkflowRunSearch{cfg}
+}
+
+func (action *WorkflowRunSearch) Run(opts *WorkflowRunSearchOpts) (*PaginatedWorkflowRunItem, error) {
+	req, err := opts.toRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	client := pb.NewWorkflowRunServiceClient(action.cfg.CPConnection)
+	resp, err := client.Search(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*WorkflowRunItem, 0, len(resp.Result))
+	for _, p := range resp.Result {
+		result = append(result, pbWorkflowRunItemToAction(p))
+	}
+
+	return &PaginatedWorkflowRunItem{
+		Result: result,
+		PaginationMeta: &PaginationOpts{
+			NextCursor: resp.GetPagination().GetNextCursor(),
+		},
+	}, nil
+}
+
+func (opts *WorkflowRunSearchOpts) toRequest() (*pb.WorkflowRunServiceSearchRequest, error) {
+	req := &pb.WorkflowRunServiceSearchRequest{
+		WorkflowName:        opts.WorkflowName,
+		ProjectName:         opts.ProjectName,
+		MaterialDigest:      opts.MaterialDigest,
+		SubjectName:         opts.SubjectName,
+		AnnotationName:      opts.AnnotationName,
+		AnnotationValue:     opts.AnnotationValue,
+		HasPolicyViolations: opts.HasViolations,
+	}
+
+	if opts.Pagination != nil {
+		req.Pagination = &pb.CursorPaginationRequest{
+			Limit:  int32(opts.Pagination.Limit),
+			Cursor: opts.Pagination.NextCursor,
+		}
+	}
+
+	if opts.MaterialType != "" {
+		v, ok := v1.CraftingSchema_Material_MaterialType_value[strings.ToUpper(opts.MaterialType)]
+		if !ok {
+			return nil, fmt.Errorf("invalid material type %q", opts.MaterialType)
+		}
+		req.MaterialType = v1.CraftingSchema_Material_MaterialType(v)
+	}
+
+	if opts.RunnerType != "" {
+		v, ok := v1.CraftingSchema_Runner_RunnerType_value[strings.ToUpper(opts.RunnerType)]
+		if !ok {
+			return nil, fmt.Errorf("invalid runner type %q", opts.RunnerType)
+		}
+		req.RunnerType = v1.CraftingSchema_Runner_RunnerType(v)
+	}
+
+	if opts.PolicyStatus != "" {
+		v, ok := WorkflowRunPolicyStatus()[opts.PolicyStatus]
+		if !ok {
+			return nil, fmt.Errorf("invalid policy status %q", opts.PolicyStatus)
+		}
+		req.PolicyStatus = v
+	}
+
+	if opts.CreatedAfter != nil {
+		req.CreatedAfter = timestamppb.New(*opts.CreatedAfter)
+	}
+
+	if opts.CreatedBefore != nil {
+		req.CreatedBefore = timestamppb.New(*opts.CreatedBefore)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package action
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWorkflowRunSearchRequest(t *testing.T) {
+	lastWeek := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+	hasViolations := true
+
+	testCases := []struct {
+		name    string
+		opts    *WorkflowRunSearchOpts
+		want    *pb.WorkflowRunServiceSearchRequest
+		wantErr string
+	}{
+		{
+			name: "all filters",
+			opts: &WorkflowRunSearchOpts{
+				ProjectName:    "my-project",
+				MaterialDigest: "sha256:deadbeef",
+				MaterialType:   "sbom_cyclonedx_json",
+				SubjectName:    "my-image",
+				AnnotationName: "branch",
+				RunnerType:     "GITHUB_ACTION",
+				PolicyStatus:   "blocked",
+				HasViolations:  &hasViolations,
+				CreatedAfter:   &lastWeek,
+				Pagination:     &PaginationOpts{Limit: 10, NextCursor: "next"},
+			},
+			want: &pb.WorkflowRunServiceSearchRequest{
+				ProjectName:         "my-project",
+				MaterialDigest:      "sha256:deadbeef",
+				MaterialType:        v1.CraftingSchema_Material_SBOM_CYCLONEDX_JSON,
+				SubjectName:         "my-image",
+				AnnotationName:      "branch",
+				RunnerType:          v1.CraftingSchema_Runner_GITHUB_ACTION,
+				PolicyStatus:        pb.PolicyStatusFilter_POLICY_STATUS_FILTER_BLOCKED,
+				HasPolicyViolations: &hasViolations,
+				Pagination:          &pb.CursorPaginationRequest{Limit: 10, Cursor: "next"},
+			},
+		},
+		{
+			name:    "invalid material type",
+			opts:    &WorkflowRunSearchOpts{MaterialType: "FOO"},
+			wantErr: "invalid material type",
+		},
+		{
+			name:    "invalid runner type",
+			opts:    &WorkflowRunSearchOpts{RunnerType: "FOO"},
+			wantErr: "invalid runner type",
+		},
+		{
+			name:    "invalid policy status",
+			opts:    &WorkflowRunSearchOpts{PolicyStatus: "failed"},
+			wantErr: "invalid policy status",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.opts.toRequest()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, lastWeek, got.GetCreatedAfter().AsTime())
+			// timestamps are compared above
+			got.CreatedAfter = nil
+			assert.True(t, proto.Equal(tc.want, got), "got %v", got)
+		})
+	}
+}
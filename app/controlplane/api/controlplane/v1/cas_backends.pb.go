@@ -339,7 +339,10 @@ type CASBackendServiceUpdateRequest struct {
 	// Credentials, useful for rotation
 	Credentials *structpb.Struct `protobuf:"bytes,4,opt,name=credentials,proto3" json:"credentials,omitempty"`
 	// Maximum size in bytes for each blob stored in this backend.
-	MaxBytes      *int64 `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	MaxBytes *int64 `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3,oneof" json:"max_bytes,omitempty"`
+	// Name of the backend to mirror, making this one a replica of it.
+	// An empty value detaches it from its current primary.
+	ReplicaOf     *string `protobuf:"bytes,7,opt,name=replica_of,json=replicaOf,proto3,oneof" json:"replica_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -416,6 +419,13 @@ func (x *CASBackendServiceUpdateRequest) GetMaxBytes() int64 {
 	return 0
 }
 
+func (x *CASBackendServiceUpdateRequest) GetReplicaOf() string {
+	if x != nil && x.ReplicaOf != nil {
+		return *x.ReplicaOf
+	}
+	return ""
+}
+
 type CASBackendServiceUpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        *CASBackendItem        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
@@ -640,7 +650,7 @@ const file_controlplane_v1_cas_backends_proto_rawDesc = "" +
 	"\n" +
 	"_max_bytes\"Z\n" +
 	"\x1fCASBackendServiceCreateResponse\x127\n" +
-	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.CASBackendItemR\x06result\"\xb5\x03\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.CASBackendItemR\x06result\"\xe8\x03\n" +
 	"\x1eCASBackendServiceUpdateRequest\x12\x97\x01\n" +
 	"\x04name\x18\x01 \x01(\tB\x82\x01\xbaH\x7f\xba\x01|\n" +
 	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x04name\x12%\n" +
@@ -648,13 +658,16 @@ const file_controlplane_v1_cas_backends_proto_rawDesc = "" +
 	"\adefault\x18\x03 \x01(\bH\x01R\adefault\x88\x01\x01\x12\x1f\n" +
 	"\bfallback\x18\x06 \x01(\bH\x02R\bfallback\x88\x01\x01\x129\n" +
 	"\vcredentials\x18\x04 \x01(\v2\x17.google.protobuf.StructR\vcredentials\x12 \n" +
-	"\tmax_bytes\x18\x05 \x01(\x03H\x03R\bmaxBytes\x88\x01\x01B\x0e\n" +
+	"\tmax_bytes\x18\x05 \x01(\x03H\x03R\bmaxBytes\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"replica_of\x18\a \x01(\tH\x04R\treplicaOf\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\n" +
 	"\n" +
 	"\b_defaultB\v\n" +
 	"\t_fallbackB\f\n" +
 	"\n" +
-	"_max_bytes\"Z\n" +
+	"_max_bytesB\r\n" +
+	"\v_replica_of\"Z\n" +
 	"\x1fCASBackendServiceUpdateResponse\x127\n" +
 	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.CASBackendItemR\x06result\"\xba\x01\n" +
 	"\x1eCASBackendServiceDeleteRequest\x12\x97\x01\n" +
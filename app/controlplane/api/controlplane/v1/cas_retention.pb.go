@@ -0,0 +1,500 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: controlplane/v1/cas_retention.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CASRetentionServiceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CASRetentionServiceListRequest) Reset() {
+	*x = CASRetentionServiceListRequest{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionServiceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionServiceListRequest) ProtoMessage() {}
+
+func (x *CASRetentionServiceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionServiceListRequest.ProtoReflect.Descriptor instead.
+func (*CASRetentionServiceListRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{0}
+}
+
+type CASRetentionServiceListResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Result        []*CASRetentionRuleItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CASRetentionServiceListResponse) Reset() {
+	*x = CASRetentionServiceListResponse{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionServiceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionServiceListResponse) ProtoMessage() {}
+
+func (x *CASRetentionServiceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionServiceListResponse.ProtoReflect.Descriptor instead.
+func (*CASRetentionServiceListResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CASRetentionServiceListResponse) GetResult() []*CASRetentionRuleItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CASRetentionServiceSetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the CAS backend the rule applies to, empty for the organization default rule
+	CasBackendName string `protobuf:"bytes,1,opt,name=cas_backend_name,json=casBackendName,proto3" json:"cas_backend_name,omitempty"`
+	// Artifacts not referenced in this number of days are deleted
+	MaxAgeDays int32 `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	// Keep the artifacts referenced by the workflow runs of released project versions regardless of their age
+	KeepReleasedVersions bool `protobuf:"varint,3,opt,name=keep_released_versions,json=keepReleasedVersions,proto3" json:"keep_released_versions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CASRetentionServiceSetRequest) Reset() {
+	*x = CASRetentionServiceSetRequest{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionServiceSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionServiceSetRequest) ProtoMessage() {}
+
+func (x *CASRetentionServiceSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionServiceSetRequest.ProtoReflect.Descriptor instead.
+func (*CASRetentionServiceSetRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CASRetentionServiceSetRequest) GetCasBackendName() string {
+	if x != nil {
+		return x.CasBackendName
+	}
+	return ""
+}
+
+func (x *CASRetentionServiceSetRequest) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *CASRetentionServiceSetRequest) GetKeepReleasedVersions() bool {
+	if x != nil {
+		return x.KeepReleasedVersions
+	}
+	return false
+}
+
+type CASRetentionServiceSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *CASRetentionRuleItem  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CASRetentionServiceSetResponse) Reset() {
+	*x = CASRetentionServiceSetResponse{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionServiceSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionServiceSetResponse) ProtoMessage() {}
+
+func (x *CASRetentionServiceSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionServiceSetResponse.ProtoReflect.Descriptor instead.
+func (*CASRetentionServiceSetResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CASRetentionServiceSetResponse) GetResult() *CASRetentionRuleItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CASRetentionServiceDeleteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CASRetentionServiceDeleteRequest) Reset() {
+	*x = CASRetentionServiceDeleteRequest{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionServiceDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionServiceDeleteRequest) ProtoMessage() {}
+
+func (x *CASRetentionServiceDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionServiceDeleteRequest.ProtoReflect.Descriptor instead.
+func (*CASRetentionServiceDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CASRetentionServiceDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CASRetentionServiceDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CASRetentionServiceDeleteResponse) Reset() {
+	*x = CASRetentionServiceDeleteResponse{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionServiceDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionServiceDeleteResponse) ProtoMessage() {}
+
+func (x *CASRetentionServiceDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionServiceDeleteResponse.ProtoReflect.Descriptor instead.
+func (*CASRetentionServiceDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{5}
+}
+
+type CASRetentionRuleItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the CAS backend, empty for the organization default rule
+	CasBackendName       string                 `protobuf:"bytes,2,opt,name=cas_backend_name,json=casBackendName,proto3" json:"cas_backend_name,omitempty"`
+	MaxAgeDays           int32                  `protobuf:"varint,3,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
+	KeepReleasedVersions bool                   `protobuf:"varint,4,opt,name=keep_released_versions,json=keepReleasedVersions,proto3" json:"keep_released_versions,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Last time the rule was applied, empty if never
+	LastSweptAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_swept_at,json=lastSweptAt,proto3" json:"last_swept_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CASRetentionRuleItem) Reset() {
+	*x = CASRetentionRuleItem{}
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CASRetentionRuleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CASRetentionRuleItem) ProtoMessage() {}
+
+func (x *CASRetentionRuleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_cas_retention_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CASRetentionRuleItem.ProtoReflect.Descriptor instead.
+func (*CASRetentionRuleItem) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_cas_retention_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CASRetentionRuleItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CASRetentionRuleItem) GetCasBackendName() string {
+	if x != nil {
+		return x.CasBackendName
+	}
+	return ""
+}
+
+func (x *CASRetentionRuleItem) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *CASRetentionRuleItem) GetKeepReleasedVersions() bool {
+	if x != nil {
+		return x.KeepReleasedVersions
+	}
+	return false
+}
+
+func (x *CASRetentionRuleItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CASRetentionRuleItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CASRetentionRuleItem) GetLastSweptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSweptAt
+	}
+	return nil
+}
+
+var File_controlplane_v1_cas_retention_proto protoreflect.FileDescriptor
+
+const file_controlplane_v1_cas_retention_proto_rawDesc = "" +
+	"\n" +
+	"#controlplane/v1/cas_retention.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\" \n" +
+	"\x1eCASRetentionServiceListRequest\"`\n" +
+	"\x1fCASRetentionServiceListResponse\x12=\n" +
+	"\x06result\x18\x01 \x03(\v2%.controlplane.v1.CASRetentionRuleItemR\x06result\"\xaa\x01\n" +
+	"\x1dCASRetentionServiceSetRequest\x12(\n" +
+	"\x10cas_backend_name\x18\x01 \x01(\tR\x0ecasBackendName\x12)\n" +
+	"\fmax_age_days\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\n" +
+	"maxAgeDays\x124\n" +
+	"\x16keep_released_versions\x18\x03 \x01(\bR\x14keepReleasedVersions\"_\n" +
+	"\x1eCASRetentionServiceSetResponse\x12=\n" +
+	"\x06result\x18\x01 \x01(\v2%.controlplane.v1.CASRetentionRuleItemR\x06result\"<\n" +
+	" CASRetentionServiceDeleteRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"#\n" +
+	"!CASRetentionServiceDeleteResponse\"\xde\x02\n" +
+	"\x14CASRetentionRuleItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x10cas_backend_name\x18\x02 \x01(\tR\x0ecasBackendName\x12 \n" +
+	"\fmax_age_days\x18\x03 \x01(\x05R\n" +
+	"maxAgeDays\x124\n" +
+	"\x16keep_released_versions\x18\x04 \x01(\bR\x14keepReleasedVersions\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12>\n" +
+	"\rlast_swept_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vlastSweptAt2\xd9\x02\n" +
+	"\x13CASRetentionService\x12i\n" +
+	"\x04List\x12/.controlplane.v1.CASRetentionServiceListRequest\x1a0.controlplane.v1.CASRetentionServiceListResponse\x12f\n" +
+	"\x03Set\x12..controlplane.v1.CASRetentionServiceSetRequest\x1a/.controlplane.v1.CASRetentionServiceSetResponse\x12o\n" +
+	"\x06Delete\x121.controlplane.v1.CASRetentionServiceDeleteRequest\x1a2.controlplane.v1.CASRetentionServiceDeleteResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
+
+var (
+	file_controlplane_v1_cas_retention_proto_rawDescOnce sync.Once
+	file_controlplane_v1_cas_retention_proto_rawDescData []byte
+)
+
+func file_controlplane_v1_cas_retention_proto_rawDescGZIP() []byte {
+	file_controlplane_v1_cas_retention_proto_rawDescOnce.Do(func() {
+		file_controlplane_v1_cas_retention_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_controlplane_v1_cas_retention_proto_rawDesc), len(file_controlplane_v1_cas_retention_proto_rawDesc)))
+	})
+	return file_controlplane_v1_cas_retention_proto_rawDescData
+}
+
+var file_controlplane_v1_cas_retention_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_controlplane_v1_cas_retention_proto_goTypes = []any{
+	(*CASRetentionServiceListRequest)(nil),    // 0: controlplane.v1.CASRetentionServiceListRequest
+	(*CASRetentionServiceListResponse)(nil),   // 1: controlplane.v1.CASRetentionServiceListResponse
+	(*CASRetentionServiceSetRequest)(nil),     // 2: controlplane.v1.CASRetentionServiceSetRequest
+	(*CASRetentionServiceSetResponse)(nil),    // 3: controlplane.v1.CASRetentionServiceSetResponse
+	(*CASRetentionServiceDeleteRequest)(nil),  // 4: controlplane.v1.CASRetentionServiceDeleteRequest
+	(*CASRetentionServiceDeleteResponse)(nil), // 5: controlplane.v1.CASRetentionServiceDeleteResponse
+	(*CASRetentionRuleItem)(nil),              // 6: controlplane.v1.CASRetentionRuleItem
+	(*timestamppb.Timestamp)(nil),             // 7: google.protobuf.Timestamp
+}
+var file_controlplane_v1_cas_retention_proto_depIdxs = []int32{
+	6, // 0: controlplane.v1.CASRetentionServiceListResponse.result:type_name -> controlplane.v1.CASRetentionRuleItem
+	6, // 1: controlplane.v1.CASRetentionServiceSetResponse.result:type_name -> controlplane.v1.CASRetentionRuleItem
+	7, // 2: controlplane.v1.CASRetentionRuleItem.created_at:type_name -> google.protobuf.Timestamp
+	7, // 3: controlplane.v1.CASRetentionRuleItem.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 4: controlplane.v1.CASRetentionRuleItem.last_swept_at:type_name -> google.protobuf.Timestamp
+	0, // 5: controlplane.v1.CASRetentionService.List:input_type -> controlplane.v1.CASRetentionServiceListRequest
+	2, // 6: controlplane.v1.CASRetentionService.Set:input_type -> controlplane.v1.CASRetentionServiceSetRequest
+	4, // 7: controlplane.v1.CASRetentionService.Delete:input_type -> controlplane.v1.CASRetentionServiceDeleteRequest
+	1, // 8: controlplane.v1.CASRetentionService.List:output_type -> controlplane.v1.CASRetentionServiceListResponse
+	3, // 9: controlplane.v1.CASRetentionService.Set:output_type -> controlplane.v1.CASRetentionServiceSetResponse
+	5, // 10: controlplane.v1.CASRetentionService.Delete:output_type -> controlplane.v1.CASRetentionServiceDeleteResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_controlplane_v1_cas_retention_proto_init() }
+func file_controlplane_v1_cas_retention_proto_init() {
+	if File_controlplane_v1_cas_retention_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_v1_cas_retention_proto_rawDesc), len(file_controlplane_v1_cas_retention_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_controlplane_v1_cas_retention_proto_goTypes,
+		DependencyIndexes: file_controlplane_v1_cas_retention_proto_depIdxs,
+		MessageInfos:      file_controlplane_v1_cas_retention_proto_msgTypes,
+	}.Build()
+	File_controlplane_v1_cas_retention_proto = out.File
+	file_controlplane_v1_cas_retention_proto_goTypes = nil
+	file_controlplane_v1_cas_retention_proto_depIdxs = nil
+}
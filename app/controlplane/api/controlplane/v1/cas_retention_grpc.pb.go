@@ -0,0 +1,204 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controlplane/v1/cas_retention.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CASRetentionService_List_FullMethodName   = "/controlplane.v1.CASRetentionService/List"
+	CASRetentionService_Set_FullMethodName    = "/controlplane.v1.CASRetentionService/Set"
+	CASRetentionService_Delete_FullMethodName = "/controlplane.v1.CASRetentionService/Delete"
+)
+
+// CASRetentionServiceClient is the client API for CASRetentionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CASRetentionServiceClient interface {
+	// List the retention rules of the organization
+	List(ctx context.Context, in *CASRetentionServiceListRequest, opts ...grpc.CallOption) (*CASRetentionServiceListResponse, error)
+	// Set the retention rule of a CAS backend, or the organization default one, replacing the existing one if any
+	Set(ctx context.Context, in *CASRetentionServiceSetRequest, opts ...grpc.CallOption) (*CASRetentionServiceSetResponse, error)
+	// Delete a retention rule, the artifacts it covers are kept forever
+	Delete(ctx context.Context, in *CASRetentionServiceDeleteRequest, opts ...grpc.CallOption) (*CASRetentionServiceDeleteResponse, error)
+}
+
+type cASRetentionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCASRetentionServiceClient(cc grpc.ClientConnInterface) CASRetentionServiceClient {
+	return &cASRetentionServiceClient{cc}
+}
+
+func (c *cASRetentionServiceClient) List(ctx context.Context, in *CASRetentionServiceListRequest, opts ...grpc.CallOption) (*CASRetentionServiceListResponse, error) {
+	out := new(CASRetentionServiceListResponse)
+	err := c.cc.Invoke(ctx, CASRetentionService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cASRetentionServiceClient) Set(ctx context.Context, in *CASRetentionServiceSetRequest, opts ...grpc.CallOption) (*CASRetentionServiceSetResponse, error) {
+	out := new(CASRetentionServiceSetResponse)
+	err := c.cc.Invoke(ctx, CASRetentionService_Set_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cASRetentionServiceClient) Delete(ctx context.Context, in *CASRetentionServiceDeleteRequest, opts ...grpc.CallOption) (*CASRetentionServiceDeleteResponse, error) {
+	out := new(CASRetentionServiceDeleteResponse)
+	err := c.cc.Invoke(ctx, CASRetentionService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CASRetentionServiceServer is the server API for CASRetentionService service.
+// All implementations must embed UnimplementedCASRetentionServiceServer
+// for forward compatibility
+type CASRetentionServiceServer interface {
+	// List the retention rules of the organization
+	List(context.Context, *CASRetentionServiceListRequest) (*CASRetentionServiceListResponse, error)
+	// Set the retention rule of a CAS backend, or the organization default one, replacing the existing one if any
+	Set(context.Context, *CASRetentionServiceSetRequest) (*CASRetentionServiceSetResponse, error)
+	// Delete a retention rule, the artifacts it covers are kept forever
+	Delete(context.Context, *CASRetentionServiceDeleteRequest) (*CASRetentionServiceDeleteResponse, error)
+	mustEmbedUnimplementedCASRetentionServiceServer()
+}
+
+// UnimplementedCASRetentionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCASRetentionServiceServer struct {
+}
+
+func (UnimplementedCASRetentionServiceServer) List(context.Context, *CASRetentionServiceListRequest) (*CASRetentionServiceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedCASRetentionServiceServer) Set(context.Context, *CASRetentionServiceSetRequest) (*CASRetentionServiceSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedCASRetentionServiceServer) Delete(context.Context, *CASRetentionServiceDeleteRequest) (*CASRetentionServiceDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCASRetentionServiceServer) mustEmbedUnimplementedCASRetentionServiceServer() {}
+
+// UnsafeCASRetentionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CASRetentionServiceServer will
+// result in compilation errors.
+type UnsafeCASRetentionServiceServer interface {
+	mustEmbedUnimplementedCASRetentionServiceServer()
+}
+
+func RegisterCASRetentionServiceServer(s grpc.ServiceRegistrar, srv CASRetentionServiceServer) {
+	s.RegisterService(&CASRetentionService_ServiceDesc, srv)
+}
+
+func _CASRetentionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CASRetentionServiceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CASRetentionServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CASRetentionService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CASRetentionServiceServer).List(ctx, req.(*CASRetentionServiceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CASRetentionService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CASRetentionServiceSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CASRetentionServiceServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CASRetentionService_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CASRetentionServiceServer).Set(ctx, req.(*CASRetentionServiceSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CASRetentionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CASRetentionServiceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CASRetentionServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CASRetentionService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CASRetentionServiceServer).Delete(ctx, req.(*CASRetentionServiceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CASRetentionService_ServiceDesc is the grpc.ServiceDesc for CASRetentionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CASRetentionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controlplane.v1.CASRetentionService",
+	HandlerType: (*CASRetentionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _CASRetentionService_List_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _CASRetentionService_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CASRetentionService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controlplane/v1/cas_retention.proto",
+}
@@ -25,6 +25,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -39,6 +40,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type IntegrationDeliveryItem_Status int32
+
+const (
+	IntegrationDeliveryItem_STATUS_UNSPECIFIED IntegrationDeliveryItem_Status = 0
+	// Waiting to be delivered or retried
+	IntegrationDeliveryItem_STATUS_PENDING   IntegrationDeliveryItem_Status = 1
+	IntegrationDeliveryItem_STATUS_SUCCEEDED IntegrationDeliveryItem_Status = 2
+	// Ran out of retries, it needs to be replayed
+	IntegrationDeliveryItem_STATUS_DEAD_LETTER IntegrationDeliveryItem_Status = 3
+)
+
+// Enum value maps for IntegrationDeliveryItem_Status.
+var (
+	IntegrationDeliveryItem_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_SUCCEEDED",
+		3: "STATUS_DEAD_LETTER",
+	}
+	IntegrationDeliveryItem_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_SUCCEEDED":   2,
+		"STATUS_DEAD_LETTER": 3,
+	}
+)
+
+func (x IntegrationDeliveryItem_Status) Enum() *IntegrationDeliveryItem_Status {
+	p := new(IntegrationDeliveryItem_Status)
+	*p = x
+	return p
+}
+
+func (x IntegrationDeliveryItem_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IntegrationDeliveryItem_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_controlplane_v1_integrations_proto_enumTypes[0].Descriptor()
+}
+
+func (IntegrationDeliveryItem_Status) Type() protoreflect.EnumType {
+	return &file_controlplane_v1_integrations_proto_enumTypes[0]
+}
+
+func (x IntegrationDeliveryItem_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IntegrationDeliveryItem_Status.Descriptor instead.
+func (IntegrationDeliveryItem_Status) EnumDescriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{26, 0}
+}
+
 type IntegrationsServiceRegisterRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// unique, DNS-like name for the registration
@@ -1096,11 +1151,458 @@ func (*IntegrationsServiceDeregisterResponse) Descriptor() ([]byte, []int) {
 	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{19}
 }
 
+type IntegrationsServiceListDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the integration attachment
+	AttachmentId string `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	// Optional, only the deliveries of this workflow run
+	WorkflowRunId string `protobuf:"bytes,2,opt,name=workflow_run_id,json=workflowRunId,proto3" json:"workflow_run_id,omitempty"`
+	// Optional, only the deliveries in this status
+	Status        IntegrationDeliveryItem_Status `protobuf:"varint,3,opt,name=status,proto3,enum=controlplane.v1.IntegrationDeliveryItem_Status" json:"status,omitempty"`
+	Pagination    *OffsetPaginationRequest       `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsServiceListDeliveriesRequest) Reset() {
+	*x = IntegrationsServiceListDeliveriesRequest{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsServiceListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsServiceListDeliveriesRequest) ProtoMessage() {}
+
+func (x *IntegrationsServiceListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsServiceListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*IntegrationsServiceListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IntegrationsServiceListDeliveriesRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *IntegrationsServiceListDeliveriesRequest) GetWorkflowRunId() string {
+	if x != nil {
+		return x.WorkflowRunId
+	}
+	return ""
+}
+
+func (x *IntegrationsServiceListDeliveriesRequest) GetStatus() IntegrationDeliveryItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return IntegrationDeliveryItem_STATUS_UNSPECIFIED
+}
+
+func (x *IntegrationsServiceListDeliveriesRequest) GetPagination() *OffsetPaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type IntegrationsServiceListDeliveriesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Result        []*IntegrationDeliveryItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Pagination    *OffsetPaginationResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsServiceListDeliveriesResponse) Reset() {
+	*x = IntegrationsServiceListDeliveriesResponse{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsServiceListDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsServiceListDeliveriesResponse) ProtoMessage() {}
+
+func (x *IntegrationsServiceListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsServiceListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*IntegrationsServiceListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *IntegrationsServiceListDeliveriesResponse) GetResult() []*IntegrationDeliveryItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *IntegrationsServiceListDeliveriesResponse) GetPagination() *OffsetPaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type IntegrationsServiceListFailedDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the integration attachment
+	AttachmentId  string                   `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	Pagination    *OffsetPaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesRequest) Reset() {
+	*x = IntegrationsServiceListFailedDeliveriesRequest{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsServiceListFailedDeliveriesRequest) ProtoMessage() {}
+
+func (x *IntegrationsServiceListFailedDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsServiceListFailedDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*IntegrationsServiceListFailedDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesRequest) GetPagination() *OffsetPaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type IntegrationsServiceListFailedDeliveriesResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Result        []*IntegrationDeliveryItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Pagination    *OffsetPaginationResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesResponse) Reset() {
+	*x = IntegrationsServiceListFailedDeliveriesResponse{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsServiceListFailedDeliveriesResponse) ProtoMessage() {}
+
+func (x *IntegrationsServiceListFailedDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsServiceListFailedDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*IntegrationsServiceListFailedDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesResponse) GetResult() []*IntegrationDeliveryItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *IntegrationsServiceListFailedDeliveriesResponse) GetPagination() *OffsetPaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type IntegrationsServiceReplayDeliveriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the integration attachment
+	AttachmentId string `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	// Optional, replay only this delivery. All the failed deliveries of the attachment are replayed otherwise
+	DeliveryId    string `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsServiceReplayDeliveriesRequest) Reset() {
+	*x = IntegrationsServiceReplayDeliveriesRequest{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsServiceReplayDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsServiceReplayDeliveriesRequest) ProtoMessage() {}
+
+func (x *IntegrationsServiceReplayDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsServiceReplayDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*IntegrationsServiceReplayDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *IntegrationsServiceReplayDeliveriesRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *IntegrationsServiceReplayDeliveriesRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type IntegrationsServiceReplayDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of deliveries queued to be retried
+	Replayed      int32 `protobuf:"varint,1,opt,name=replayed,proto3" json:"replayed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationsServiceReplayDeliveriesResponse) Reset() {
+	*x = IntegrationsServiceReplayDeliveriesResponse{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationsServiceReplayDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationsServiceReplayDeliveriesResponse) ProtoMessage() {}
+
+func (x *IntegrationsServiceReplayDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationsServiceReplayDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*IntegrationsServiceReplayDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IntegrationsServiceReplayDeliveriesResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+// IntegrationDeliveryItem is the attempt to send the attestation of a workflow run to an attached integration
+type IntegrationDeliveryItem struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Id            string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttachmentId  string                         `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	WorkflowRunId string                         `protobuf:"bytes,3,opt,name=workflow_run_id,json=workflowRunId,proto3" json:"workflow_run_id,omitempty"`
+	Status        IntegrationDeliveryItem_Status `protobuf:"varint,4,opt,name=status,proto3,enum=controlplane.v1.IntegrationDeliveryItem_Status" json:"status,omitempty"`
+	// Number of times the delivery has been attempted
+	Attempts int32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error returned by the integration in the last attempt
+	LastError  string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// How long the last attempt took
+	Duration      *durationpb.Duration `protobuf:"bytes,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationDeliveryItem) Reset() {
+	*x = IntegrationDeliveryItem{}
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationDeliveryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationDeliveryItem) ProtoMessage() {}
+
+func (x *IntegrationDeliveryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_integrations_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationDeliveryItem.ProtoReflect.Descriptor instead.
+func (*IntegrationDeliveryItem) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_integrations_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *IntegrationDeliveryItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IntegrationDeliveryItem) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *IntegrationDeliveryItem) GetWorkflowRunId() string {
+	if x != nil {
+		return x.WorkflowRunId
+	}
+	return ""
+}
+
+func (x *IntegrationDeliveryItem) GetStatus() IntegrationDeliveryItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return IntegrationDeliveryItem_STATUS_UNSPECIFIED
+}
+
+func (x *IntegrationDeliveryItem) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *IntegrationDeliveryItem) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *IntegrationDeliveryItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IntegrationDeliveryItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *IntegrationDeliveryItem) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *IntegrationDeliveryItem) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
 var File_controlplane_v1_integrations_proto protoreflect.FileDescriptor
 
 const file_controlplane_v1_integrations_proto_rawDesc = "" +
 	"\n" +
-	"\"controlplane/v1/integrations.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a'controlplane/v1/response_messages.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x01\n" +
+	"\"controlplane/v1/integrations.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a controlplane/v1/pagination.proto\x1a'controlplane/v1/response_messages.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc2\x01\n" +
 	"\"IntegrationsServiceRegisterRequest\x12\x1b\n" +
 	"\x04name\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12$\n" +
 	"\tplugin_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bpluginId\x127\n" +
@@ -1165,7 +1667,56 @@ const file_controlplane_v1_integrations_proto_rawDesc = "" +
 	"$IntegrationsServiceDeregisterRequest\x12\x97\x01\n" +
 	"\x04name\x18\x01 \x01(\tB\x82\x01\xbaH\x7f\xba\x01|\n" +
 	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x04name\"'\n" +
-	"%IntegrationsServiceDeregisterResponse2\x87\b\n" +
+	"%IntegrationsServiceDeregisterResponse\"\xab\x02\n" +
+	"(IntegrationsServiceListDeliveriesRequest\x12-\n" +
+	"\rattachment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fattachmentId\x123\n" +
+	"\x0fworkflow_run_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\rworkflowRunId\x12Q\n" +
+	"\x06status\x18\x03 \x01(\x0e2/.controlplane.v1.IntegrationDeliveryItem.StatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12H\n" +
+	"\n" +
+	"pagination\x18\x04 \x01(\v2(.controlplane.v1.OffsetPaginationRequestR\n" +
+	"pagination\"\xb8\x01\n" +
+	")IntegrationsServiceListDeliveriesResponse\x12@\n" +
+	"\x06result\x18\x01 \x03(\v2(.controlplane.v1.IntegrationDeliveryItemR\x06result\x12I\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2).controlplane.v1.OffsetPaginationResponseR\n" +
+	"pagination\"\xa9\x01\n" +
+	".IntegrationsServiceListFailedDeliveriesRequest\x12-\n" +
+	"\rattachment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fattachmentId\x12H\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2(.controlplane.v1.OffsetPaginationRequestR\n" +
+	"pagination\"\xbe\x01\n" +
+	"/IntegrationsServiceListFailedDeliveriesResponse\x12@\n" +
+	"\x06result\x18\x01 \x03(\v2(.controlplane.v1.IntegrationDeliveryItemR\x06result\x12I\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2).controlplane.v1.OffsetPaginationResponseR\n" +
+	"pagination\"\x89\x01\n" +
+	"*IntegrationsServiceReplayDeliveriesRequest\x12-\n" +
+	"\rattachment_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fattachmentId\x12,\n" +
+	"\vdelivery_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\n" +
+	"deliveryId\"I\n" +
+	"+IntegrationsServiceReplayDeliveriesResponse\x12\x1a\n" +
+	"\breplayed\x18\x01 \x01(\x05R\breplayed\"\xc8\x04\n" +
+	"\x17IntegrationDeliveryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rattachment_id\x18\x02 \x01(\tR\fattachmentId\x12&\n" +
+	"\x0fworkflow_run_id\x18\x03 \x01(\tR\rworkflowRunId\x12G\n" +
+	"\x06status\x18\x04 \x01(\x0e2/.controlplane.v1.IntegrationDeliveryItem.StatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x05 \x01(\x05R\battempts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x125\n" +
+	"\bduration\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\bduration\"b\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_PENDING\x10\x01\x12\x14\n" +
+	"\x10STATUS_SUCCEEDED\x10\x02\x12\x16\n" +
+	"\x12STATUS_DEAD_LETTER\x10\x032\xbd\v\n" +
 	"\x13IntegrationsService\x12\x84\x01\n" +
 	"\rListAvailable\x128.controlplane.v1.IntegrationsServiceListAvailableRequest\x1a9.controlplane.v1.IntegrationsServiceListAvailableResponse\x12u\n" +
 	"\bRegister\x123.controlplane.v1.IntegrationsServiceRegisterRequest\x1a4.controlplane.v1.IntegrationsServiceRegisterResponse\x12{\n" +
@@ -1175,7 +1726,10 @@ const file_controlplane_v1_integrations_proto_rawDesc = "" +
 	"\x14DescribeRegistration\x12?.controlplane.v1.IntegrationsServiceDescribeRegistrationRequest\x1a@.controlplane.v1.IntegrationsServiceDescribeRegistrationResponse\x12o\n" +
 	"\x06Attach\x121.controlplane.v1.IntegrationsServiceAttachRequest\x1a2.controlplane.v1.IntegrationsServiceAttachResponse\x12o\n" +
 	"\x06Detach\x121.controlplane.v1.IntegrationsServiceDetachRequest\x1a2.controlplane.v1.IntegrationsServiceDetachResponse\x12d\n" +
-	"\x0fListAttachments\x12'.controlplane.v1.ListAttachmentsRequest\x1a(.controlplane.v1.ListAttachmentsResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
+	"\x0fListAttachments\x12'.controlplane.v1.ListAttachmentsRequest\x1a(.controlplane.v1.ListAttachmentsResponse\x12\x87\x01\n" +
+	"\x0eListDeliveries\x129.controlplane.v1.IntegrationsServiceListDeliveriesRequest\x1a:.controlplane.v1.IntegrationsServiceListDeliveriesResponse\x12\x99\x01\n" +
+	"\x14ListFailedDeliveries\x12?.controlplane.v1.IntegrationsServiceListFailedDeliveriesRequest\x1a@.controlplane.v1.IntegrationsServiceListFailedDeliveriesResponse\x12\x8d\x01\n" +
+	"\x10ReplayDeliveries\x12;.controlplane.v1.IntegrationsServiceReplayDeliveriesRequest\x1a<.controlplane.v1.IntegrationsServiceReplayDeliveriesResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
 
 var (
 	file_controlplane_v1_integrations_proto_rawDescOnce sync.Once
@@ -1189,67 +1743,97 @@ func file_controlplane_v1_integrations_proto_rawDescGZIP() []byte {
 	return file_controlplane_v1_integrations_proto_rawDescData
 }
 
-var file_controlplane_v1_integrations_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_controlplane_v1_integrations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_controlplane_v1_integrations_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_controlplane_v1_integrations_proto_goTypes = []any{
-	(*IntegrationsServiceRegisterRequest)(nil),              // 0: controlplane.v1.IntegrationsServiceRegisterRequest
-	(*IntegrationsServiceRegisterResponse)(nil),             // 1: controlplane.v1.IntegrationsServiceRegisterResponse
-	(*IntegrationsServiceAttachRequest)(nil),                // 2: controlplane.v1.IntegrationsServiceAttachRequest
-	(*IntegrationsServiceAttachResponse)(nil),               // 3: controlplane.v1.IntegrationsServiceAttachResponse
-	(*IntegrationsServiceListAvailableRequest)(nil),         // 4: controlplane.v1.IntegrationsServiceListAvailableRequest
-	(*IntegrationsServiceListAvailableResponse)(nil),        // 5: controlplane.v1.IntegrationsServiceListAvailableResponse
-	(*IntegrationAvailableItem)(nil),                        // 6: controlplane.v1.IntegrationAvailableItem
-	(*PluginFanout)(nil),                                    // 7: controlplane.v1.PluginFanout
-	(*IntegrationsServiceListRegistrationsRequest)(nil),     // 8: controlplane.v1.IntegrationsServiceListRegistrationsRequest
-	(*IntegrationsServiceListRegistrationsResponse)(nil),    // 9: controlplane.v1.IntegrationsServiceListRegistrationsResponse
-	(*IntegrationsServiceDescribeRegistrationRequest)(nil),  // 10: controlplane.v1.IntegrationsServiceDescribeRegistrationRequest
-	(*IntegrationsServiceDescribeRegistrationResponse)(nil), // 11: controlplane.v1.IntegrationsServiceDescribeRegistrationResponse
-	(*IntegrationsServiceDetachRequest)(nil),                // 12: controlplane.v1.IntegrationsServiceDetachRequest
-	(*IntegrationsServiceDetachResponse)(nil),               // 13: controlplane.v1.IntegrationsServiceDetachResponse
-	(*ListAttachmentsRequest)(nil),                          // 14: controlplane.v1.ListAttachmentsRequest
-	(*ListAttachmentsResponse)(nil),                         // 15: controlplane.v1.ListAttachmentsResponse
-	(*RegisteredIntegrationItem)(nil),                       // 16: controlplane.v1.RegisteredIntegrationItem
-	(*IntegrationAttachmentItem)(nil),                       // 17: controlplane.v1.IntegrationAttachmentItem
-	(*IntegrationsServiceDeregisterRequest)(nil),            // 18: controlplane.v1.IntegrationsServiceDeregisterRequest
-	(*IntegrationsServiceDeregisterResponse)(nil),           // 19: controlplane.v1.IntegrationsServiceDeregisterResponse
-	(*structpb.Struct)(nil),                                 // 20: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),                           // 21: google.protobuf.Timestamp
-	(*WorkflowItem)(nil),                                    // 22: controlplane.v1.WorkflowItem
+	(IntegrationDeliveryItem_Status)(0),                     // 0: controlplane.v1.IntegrationDeliveryItem.Status
+	(*IntegrationsServiceRegisterRequest)(nil),              // 1: controlplane.v1.IntegrationsServiceRegisterRequest
+	(*IntegrationsServiceRegisterResponse)(nil),             // 2: controlplane.v1.IntegrationsServiceRegisterResponse
+	(*IntegrationsServiceAttachRequest)(nil),                // 3: controlplane.v1.IntegrationsServiceAttachRequest
+	(*IntegrationsServiceAttachResponse)(nil),               // 4: controlplane.v1.IntegrationsServiceAttachResponse
+	(*IntegrationsServiceListAvailableRequest)(nil),         // 5: controlplane.v1.IntegrationsServiceListAvailableRequest
+	(*IntegrationsServiceListAvailableResponse)(nil),        // 6: controlplane.v1.IntegrationsServiceListAvailableResponse
+	(*IntegrationAvailableItem)(nil),                        // 7: controlplane.v1.IntegrationAvailableItem
+	(*PluginFanout)(nil),                                    // 8: controlplane.v1.PluginFanout
+	(*IntegrationsServiceListRegistrationsRequest)(nil),     // 9: controlplane.v1.IntegrationsServiceListRegistrationsRequest
+	(*IntegrationsServiceListRegistrationsResponse)(nil),    // 10: controlplane.v1.IntegrationsServiceListRegistrationsResponse
+	(*IntegrationsServiceDescribeRegistrationRequest)(nil),  // 11: controlplane.v1.IntegrationsServiceDescribeRegistrationRequest
+	(*IntegrationsServiceDescribeRegistrationResponse)(nil), // 12: controlplane.v1.IntegrationsServiceDescribeRegistrationResponse
+	(*IntegrationsServiceDetachRequest)(nil),                // 13: controlplane.v1.IntegrationsServiceDetachRequest
+	(*IntegrationsServiceDetachResponse)(nil),               // 14: controlplane.v1.IntegrationsServiceDetachResponse
+	(*ListAttachmentsRequest)(nil),                          // 15: controlplane.v1.ListAttachmentsRequest
+	(*ListAttachmentsResponse)(nil),                         // 16: controlplane.v1.ListAttachmentsResponse
+	(*RegisteredIntegrationItem)(nil),                       // 17: controlplane.v1.RegisteredIntegrationItem
+	(*IntegrationAttachmentItem)(nil),                       // 18: controlplane.v1.IntegrationAttachmentItem
+	(*IntegrationsServiceDeregisterRequest)(nil),            // 19: controlplane.v1.IntegrationsServiceDeregisterRequest
+	(*IntegrationsServiceDeregisterResponse)(nil),           // 20: controlplane.v1.IntegrationsServiceDeregisterResponse
+	(*IntegrationsServiceListDeliveriesRequest)(nil),        // 21: controlplane.v1.IntegrationsServiceListDeliveriesRequest
+	(*IntegrationsServiceListDeliveriesResponse)(nil),       // 22: controlplane.v1.IntegrationsServiceListDeliveriesResponse
+	(*IntegrationsServiceListFailedDeliveriesRequest)(nil),  // 23: controlplane.v1.IntegrationsServiceListFailedDeliveriesRequest
+	(*IntegrationsServiceListFailedDeliveriesResponse)(nil), // 24: controlplane.v1.IntegrationsServiceListFailedDeliveriesResponse
+	(*IntegrationsServiceReplayDeliveriesRequest)(nil),      // 25: controlplane.v1.IntegrationsServiceReplayDeliveriesRequest
+	(*IntegrationsServiceReplayDeliveriesResponse)(nil),     // 26: controlplane.v1.IntegrationsServiceReplayDeliveriesResponse
+	(*IntegrationDeliveryItem)(nil),                         // 27: controlplane.v1.IntegrationDeliveryItem
+	(*structpb.Struct)(nil),                                 // 28: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                           // 29: google.protobuf.Timestamp
+	(*WorkflowItem)(nil),                                    // 30: controlplane.v1.WorkflowItem
+	(*OffsetPaginationRequest)(nil),                         // 31: controlplane.v1.OffsetPaginationRequest
+	(*OffsetPaginationResponse)(nil),                        // 32: controlplane.v1.OffsetPaginationResponse
+	(*durationpb.Duration)(nil),                             // 33: google.protobuf.Duration
 }
 var file_controlplane_v1_integrations_proto_depIdxs = []int32{
-	20, // 0: controlplane.v1.IntegrationsServiceRegisterRequest.config:type_name -> google.protobuf.Struct
-	16, // 1: controlplane.v1.IntegrationsServiceRegisterResponse.result:type_name -> controlplane.v1.RegisteredIntegrationItem
-	20, // 2: controlplane.v1.IntegrationsServiceAttachRequest.config:type_name -> google.protobuf.Struct
-	17, // 3: controlplane.v1.IntegrationsServiceAttachResponse.result:type_name -> controlplane.v1.IntegrationAttachmentItem
-	6,  // 4: controlplane.v1.IntegrationsServiceListAvailableResponse.result:type_name -> controlplane.v1.IntegrationAvailableItem
-	7,  // 5: controlplane.v1.IntegrationAvailableItem.fanout:type_name -> controlplane.v1.PluginFanout
-	16, // 6: controlplane.v1.IntegrationsServiceListRegistrationsResponse.result:type_name -> controlplane.v1.RegisteredIntegrationItem
-	16, // 7: controlplane.v1.IntegrationsServiceDescribeRegistrationResponse.result:type_name -> controlplane.v1.RegisteredIntegrationItem
-	17, // 8: controlplane.v1.ListAttachmentsResponse.result:type_name -> controlplane.v1.IntegrationAttachmentItem
-	21, // 9: controlplane.v1.RegisteredIntegrationItem.created_at:type_name -> google.protobuf.Timestamp
-	21, // 10: controlplane.v1.IntegrationAttachmentItem.created_at:type_name -> google.protobuf.Timestamp
-	16, // 11: controlplane.v1.IntegrationAttachmentItem.integration:type_name -> controlplane.v1.RegisteredIntegrationItem
-	22, // 12: controlplane.v1.IntegrationAttachmentItem.workflow:type_name -> controlplane.v1.WorkflowItem
-	4,  // 13: controlplane.v1.IntegrationsService.ListAvailable:input_type -> controlplane.v1.IntegrationsServiceListAvailableRequest
-	0,  // 14: controlplane.v1.IntegrationsService.Register:input_type -> controlplane.v1.IntegrationsServiceRegisterRequest
-	18, // 15: controlplane.v1.IntegrationsService.Deregister:input_type -> controlplane.v1.IntegrationsServiceDeregisterRequest
-	8,  // 16: controlplane.v1.IntegrationsService.ListRegistrations:input_type -> controlplane.v1.IntegrationsServiceListRegistrationsRequest
-	10, // 17: controlplane.v1.IntegrationsService.DescribeRegistration:input_type -> controlplane.v1.IntegrationsServiceDescribeRegistrationRequest
-	2,  // 18: controlplane.v1.IntegrationsService.Attach:input_type -> controlplane.v1.IntegrationsServiceAttachRequest
-	12, // 19: controlplane.v1.IntegrationsService.Detach:input_type -> controlplane.v1.IntegrationsServiceDetachRequest
-	14, // 20: controlplane.v1.IntegrationsService.ListAttachments:input_type -> controlplane.v1.ListAttachmentsRequest
-	5,  // 21: controlplane.v1.IntegrationsService.ListAvailable:output_type -> controlplane.v1.IntegrationsServiceListAvailableResponse
-	1,  // 22: controlplane.v1.IntegrationsService.Register:output_type -> controlplane.v1.IntegrationsServiceRegisterResponse
-	19, // 23: controlplane.v1.IntegrationsService.Deregister:output_type -> controlplane.v1.IntegrationsServiceDeregisterResponse
-	9,  // 24: controlplane.v1.IntegrationsService.ListRegistrations:output_type -> controlplane.v1.IntegrationsServiceListRegistrationsResponse
-	11, // 25: controlplane.v1.IntegrationsService.DescribeRegistration:output_type -> controlplane.v1.IntegrationsServiceDescribeRegistrationResponse
-	3,  // 26: controlplane.v1.IntegrationsService.Attach:output_type -> controlplane.v1.IntegrationsServiceAttachResponse
-	13, // 27: controlplane.v1.IntegrationsService.Detach:output_type -> controlplane.v1.IntegrationsServiceDetachResponse
-	15, // 28: controlplane.v1.IntegrationsService.ListAttachments:output_type -> controlplane.v1.ListAttachmentsResponse
-	21, // [21:29] is the sub-list for method output_type
-	13, // [13:21] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	28, // 0: controlplane.v1.IntegrationsServiceRegisterRequest.config:type_name -> google.protobuf.Struct
+	17, // 1: controlplane.v1.IntegrationsServiceRegisterResponse.result:type_name -> controlplane.v1.RegisteredIntegrationItem
+	28, // 2: controlplane.v1.IntegrationsServiceAttachRequest.config:type_name -> google.protobuf.Struct
+	18, // 3: controlplane.v1.IntegrationsServiceAttachResponse.result:type_name -> controlplane.v1.IntegrationAttachmentItem
+	7,  // 4: controlplane.v1.IntegrationsServiceListAvailableResponse.result:type_name -> controlplane.v1.IntegrationAvailableItem
+	8,  // 5: controlplane.v1.IntegrationAvailableItem.fanout:type_name -> controlplane.v1.PluginFanout
+	17, // 6: controlplane.v1.IntegrationsServiceListRegistrationsResponse.result:type_name -> controlplane.v1.RegisteredIntegrationItem
+	17, // 7: controlplane.v1.IntegrationsServiceDescribeRegistrationResponse.result:type_name -> controlplane.v1.RegisteredIntegrationItem
+	18, // 8: controlplane.v1.ListAttachmentsResponse.result:type_name -> controlplane.v1.IntegrationAttachmentItem
+	29, // 9: controlplane.v1.RegisteredIntegrationItem.created_at:type_name -> google.protobuf.Timestamp
+	29, // 10: controlplane.v1.IntegrationAttachmentItem.created_at:type_name -> google.protobuf.Timestamp
+	17, // 11: controlplane.v1.IntegrationAttachmentItem.integration:type_name -> controlplane.v1.RegisteredIntegrationItem
+	30, // 12: controlplane.v1.IntegrationAttachmentItem.workflow:type_name -> controlplane.v1.WorkflowItem
+	0,  // 13: controlplane.v1.IntegrationsServiceListDeliveriesRequest.status:type_name -> controlplane.v1.IntegrationDeliveryItem.Status
+	31, // 14: controlplane.v1.IntegrationsServiceListDeliveriesRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
+	27, // 15: controlplane.v1.IntegrationsServiceListDeliveriesResponse.result:type_name -> controlplane.v1.IntegrationDeliveryItem
+	32, // 16: controlplane.v1.IntegrationsServiceListDeliveriesResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
+	31, // 17: controlplane.v1.IntegrationsServiceListFailedDeliveriesRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
+	27, // 18: controlplane.v1.IntegrationsServiceListFailedDeliveriesResponse.result:type_name -> controlplane.v1.IntegrationDeliveryItem
+	32, // 19: controlplane.v1.IntegrationsServiceListFailedDeliveriesResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
+	0,  // 20: controlplane.v1.IntegrationDeliveryItem.status:type_name -> controlplane.v1.IntegrationDeliveryItem.Status
+	29, // 21: controlplane.v1.IntegrationDeliveryItem.created_at:type_name -> google.protobuf.Timestamp
+	29, // 22: controlplane.v1.IntegrationDeliveryItem.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 23: controlplane.v1.IntegrationDeliveryItem.finished_at:type_name -> google.protobuf.Timestamp
+	33, // 24: controlplane.v1.IntegrationDeliveryItem.duration:type_name -> google.protobuf.Duration
+	5,  // 25: controlplane.v1.IntegrationsService.ListAvailable:input_type -> controlplane.v1.IntegrationsServiceListAvailableRequest
+	1,  // 26: controlplane.v1.IntegrationsService.Register:input_type -> controlplane.v1.IntegrationsServiceRegisterRequest
+	19, // 27: controlplane.v1.IntegrationsService.Deregister:input_type -> controlplane.v1.IntegrationsServiceDeregisterRequest
+	9,  // 28: controlplane.v1.IntegrationsService.ListRegistrations:input_type -> controlplane.v1.IntegrationsServiceListRegistrationsRequest
+	11, // 29: controlplane.v1.IntegrationsService.DescribeRegistration:input_type -> controlplane.v1.IntegrationsServiceDescribeRegistrationRequest
+	3,  // 30: controlplane.v1.IntegrationsService.Attach:input_type -> controlplane.v1.IntegrationsServiceAttachRequest
+	13, // 31: controlplane.v1.IntegrationsService.Detach:input_type -> controlplane.v1.IntegrationsServiceDetachRequest
+	15, // 32: controlplane.v1.IntegrationsService.ListAttachments:input_type -> controlplane.v1.ListAttachmentsRequest
+	21, // 33: controlplane.v1.IntegrationsService.ListDeliveries:input_type -> controlplane.v1.IntegrationsServiceListDeliveriesRequest
+	23, // 34: controlplane.v1.IntegrationsService.ListFailedDeliveries:input_type -> controlplane.v1.IntegrationsServiceListFailedDeliveriesRequest
+	25, // 35: controlplane.v1.IntegrationsService.ReplayDeliveries:input_type -> controlplane.v1.IntegrationsServiceReplayDeliveriesRequest
+	6,  // 36: controlplane.v1.IntegrationsService.ListAvailable:output_type -> controlplane.v1.IntegrationsServiceListAvailableResponse
+	2,  // 37: controlplane.v1.IntegrationsService.Register:output_type -> controlplane.v1.IntegrationsServiceRegisterResponse
+	20, // 38: controlplane.v1.IntegrationsService.Deregister:output_type -> controlplane.v1.IntegrationsServiceDeregisterResponse
+	10, // 39: controlplane.v1.IntegrationsService.ListRegistrations:output_type -> controlplane.v1.IntegrationsServiceListRegistrationsResponse
+	12, // 40: controlplane.v1.IntegrationsService.DescribeRegistration:output_type -> controlplane.v1.IntegrationsServiceDescribeRegistrationResponse
+	4,  // 41: controlplane.v1.IntegrationsService.Attach:output_type -> controlplane.v1.IntegrationsServiceAttachResponse
+	14, // 42: controlplane.v1.IntegrationsService.Detach:output_type -> controlplane.v1.IntegrationsServiceDetachResponse
+	16, // 43: controlplane.v1.IntegrationsService.ListAttachments:output_type -> controlplane.v1.ListAttachmentsResponse
+	22, // 44: controlplane.v1.IntegrationsService.ListDeliveries:output_type -> controlplane.v1.IntegrationsServiceListDeliveriesResponse
+	24, // 45: controlplane.v1.IntegrationsService.ListFailedDeliveries:output_type -> controlplane.v1.IntegrationsServiceListFailedDeliveriesResponse
+	26, // 46: controlplane.v1.IntegrationsService.ReplayDeliveries:output_type -> controlplane.v1.IntegrationsServiceReplayDeliveriesResponse
+	36, // [36:47] is the sub-list for method output_type
+	25, // [25:36] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_controlplane_v1_integrations_proto_init() }
@@ -1257,6 +1841,7 @@ func file_controlplane_v1_integrations_proto_init() {
 	if File_controlplane_v1_integrations_proto != nil {
 		return
 	}
+	file_controlplane_v1_pagination_proto_init()
 	file_controlplane_v1_response_messages_proto_init()
 	file_controlplane_v1_integrations_proto_msgTypes[6].OneofWrappers = []any{
 		(*IntegrationAvailableItem_Fanout)(nil),
@@ -1266,13 +1851,14 @@ func file_controlplane_v1_integrations_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_v1_integrations_proto_rawDesc), len(file_controlplane_v1_integrations_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_controlplane_v1_integrations_proto_goTypes,
 		DependencyIndexes: file_controlplane_v1_integrations_proto_depIdxs,
+		EnumInfos:         file_controlplane_v1_integrations_proto_enumTypes,
 		MessageInfos:      file_controlplane_v1_integrations_proto_msgTypes,
 	}.Build()
 	File_controlplane_v1_integrations_proto = out.File
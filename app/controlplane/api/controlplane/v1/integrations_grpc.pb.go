@@ -42,6 +42,9 @@ const (
 	IntegrationsService_Attach_FullMethodName               = "/controlplane.v1.IntegrationsService/Attach"
 	IntegrationsService_Detach_FullMethodName               = "/controlplane.v1.IntegrationsService/Detach"
 	IntegrationsService_ListAttachments_FullMethodName      = "/controlplane.v1.IntegrationsService/ListAttachments"
+	IntegrationsService_ListDeliveries_FullMethodName       = "/controlplane.v1.IntegrationsService/ListDeliveries"
+	IntegrationsService_ListFailedDeliveries_FullMethodName = "/controlplane.v1.IntegrationsService/ListFailedDeliveries"
+	IntegrationsService_ReplayDeliveries_FullMethodName     = "/controlplane.v1.IntegrationsService/ReplayDeliveries"
 )
 
 // IntegrationsServiceClient is the client API for IntegrationsService service.
@@ -66,6 +69,13 @@ type IntegrationsServiceClient interface {
 	Detach(ctx context.Context, in *IntegrationsServiceDetachRequest, opts ...grpc.CallOption) (*IntegrationsServiceDetachResponse, error)
 	// List attachments
 	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Delivery Related operations
+	// List the delivery history of an attached integration
+	ListDeliveries(ctx context.Context, in *IntegrationsServiceListDeliveriesRequest, opts ...grpc.CallOption) (*IntegrationsServiceListDeliveriesResponse, error)
+	// List the deliveries to an attached integration that ran out of retries
+	ListFailedDeliveries(ctx context.Context, in *IntegrationsServiceListFailedDeliveriesRequest, opts ...grpc.CallOption) (*IntegrationsServiceListFailedDeliveriesResponse, error)
+	// Queue failed deliveries to an attached integration to be retried
+	ReplayDeliveries(ctx context.Context, in *IntegrationsServiceReplayDeliveriesRequest, opts ...grpc.CallOption) (*IntegrationsServiceReplayDeliveriesResponse, error)
 }
 
 type integrationsServiceClient struct {
@@ -148,6 +158,33 @@ func (c *integrationsServiceClient) ListAttachments(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *integrationsServiceClient) ListDeliveries(ctx context.Context, in *IntegrationsServiceListDeliveriesRequest, opts ...grpc.CallOption) (*IntegrationsServiceListDeliveriesResponse, error) {
+	out := new(IntegrationsServiceListDeliveriesResponse)
+	err := c.cc.Invoke(ctx, IntegrationsService_ListDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationsServiceClient) ListFailedDeliveries(ctx context.Context, in *IntegrationsServiceListFailedDeliveriesRequest, opts ...grpc.CallOption) (*IntegrationsServiceListFailedDeliveriesResponse, error) {
+	out := new(IntegrationsServiceListFailedDeliveriesResponse)
+	err := c.cc.Invoke(ctx, IntegrationsService_ListFailedDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *integrationsServiceClient) ReplayDeliveries(ctx context.Context, in *IntegrationsServiceReplayDeliveriesRequest, opts ...grpc.CallOption) (*IntegrationsServiceReplayDeliveriesResponse, error) {
+	out := new(IntegrationsServiceReplayDeliveriesResponse)
+	err := c.cc.Invoke(ctx, IntegrationsService_ReplayDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IntegrationsServiceServer is the server API for IntegrationsService service.
 // All implementations must embed UnimplementedIntegrationsServiceServer
 // for forward compatibility
@@ -170,6 +207,13 @@ type IntegrationsServiceServer interface {
 	Detach(context.Context, *IntegrationsServiceDetachRequest) (*IntegrationsServiceDetachResponse, error)
 	// List attachments
 	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Delivery Related operations
+	// List the delivery history of an attached integration
+	ListDeliveries(context.Context, *IntegrationsServiceListDeliveriesRequest) (*IntegrationsServiceListDeliveriesResponse, error)
+	// List the deliveries to an attached integration that ran out of retries
+	ListFailedDeliveries(context.Context, *IntegrationsServiceListFailedDeliveriesRequest) (*IntegrationsServiceListFailedDeliveriesResponse, error)
+	// Queue failed deliveries to an attached integration to be retried
+	ReplayDeliveries(context.Context, *IntegrationsServiceReplayDeliveriesRequest) (*IntegrationsServiceReplayDeliveriesResponse, error)
 	mustEmbedUnimplementedIntegrationsServiceServer()
 }
 
@@ -201,6 +245,15 @@ func (UnimplementedIntegrationsServiceServer) Detach(context.Context, *Integrati
 func (UnimplementedIntegrationsServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
 }
+func (UnimplementedIntegrationsServiceServer) ListDeliveries(context.Context, *IntegrationsServiceListDeliveriesRequest) (*IntegrationsServiceListDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (UnimplementedIntegrationsServiceServer) ListFailedDeliveries(context.Context, *IntegrationsServiceListFailedDeliveriesRequest) (*IntegrationsServiceListFailedDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFailedDeliveries not implemented")
+}
+func (UnimplementedIntegrationsServiceServer) ReplayDeliveries(context.Context, *IntegrationsServiceReplayDeliveriesRequest) (*IntegrationsServiceReplayDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeliveries not implemented")
+}
 func (UnimplementedIntegrationsServiceServer) mustEmbedUnimplementedIntegrationsServiceServer() {}
 
 // UnsafeIntegrationsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -358,6 +411,60 @@ func _IntegrationsService_ListAttachments_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntegrationsService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegrationsServiceListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).ListDeliveries(ctx, req.(*IntegrationsServiceListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationsService_ListFailedDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegrationsServiceListFailedDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).ListFailedDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_ListFailedDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).ListFailedDeliveries(ctx, req.(*IntegrationsServiceListFailedDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntegrationsService_ReplayDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntegrationsServiceReplayDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntegrationsServiceServer).ReplayDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IntegrationsService_ReplayDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntegrationsServiceServer).ReplayDeliveries(ctx, req.(*IntegrationsServiceReplayDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IntegrationsService_ServiceDesc is the grpc.ServiceDesc for IntegrationsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,6 +504,18 @@ var IntegrationsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAttachments",
 			Handler:    _IntegrationsService_ListAttachments_Handler,
 		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _IntegrationsService_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "ListFailedDeliveries",
+			Handler:    _IntegrationsService_ListFailedDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayDeliveries",
+			Handler:    _IntegrationsService_ReplayDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controlplane/v1/integrations.proto",
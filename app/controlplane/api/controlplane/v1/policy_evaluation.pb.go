@@ -0,0 +1,1214 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: controlplane/v1/policy_evaluation.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PolicyEvaluationItem_Status int32
+
+const (
+	PolicyEvaluationItem_STATUS_UNSPECIFIED PolicyEvaluationItem_Status = 0
+	PolicyEvaluationItem_STATUS_PASSED      PolicyEvaluationItem_Status = 1
+	PolicyEvaluationItem_STATUS_FAILED      PolicyEvaluationItem_Status = 2
+	PolicyEvaluationItem_STATUS_SKIPPED     PolicyEvaluationItem_Status = 3
+)
+
+// Enum value maps for PolicyEvaluationItem_Status.
+var (
+	PolicyEvaluationItem_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PASSED",
+		2: "STATUS_FAILED",
+		3: "STATUS_SKIPPED",
+	}
+	PolicyEvaluationItem_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PASSED":      1,
+		"STATUS_FAILED":      2,
+		"STATUS_SKIPPED":     3,
+	}
+)
+
+func (x PolicyEvaluationItem_Status) Enum() *PolicyEvaluationItem_Status {
+	p := new(PolicyEvaluationItem_Status)
+	*p = x
+	return p
+}
+
+func (x PolicyEvaluationItem_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyEvaluationItem_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_controlplane_v1_policy_evaluation_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyEvaluationItem_Status) Type() protoreflect.EnumType {
+	return &file_controlplane_v1_policy_evaluation_proto_enumTypes[0]
+}
+
+func (x PolicyEvaluationItem_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyEvaluationItem_Status.Descriptor instead.
+func (PolicyEvaluationItem_Status) EnumDescriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type PolicyImpactAnalysis_Status int32
+
+const (
+	PolicyImpactAnalysis_STATUS_UNSPECIFIED PolicyImpactAnalysis_Status = 0
+	PolicyImpactAnalysis_STATUS_RUNNING     PolicyImpactAnalysis_Status = 1
+	PolicyImpactAnalysis_STATUS_SUCCEEDED   PolicyImpactAnalysis_Status = 2
+	PolicyImpactAnalysis_STATUS_FAILED      PolicyImpactAnalysis_Status = 3
+)
+
+// Enum value maps for PolicyImpactAnalysis_Status.
+var (
+	PolicyImpactAnalysis_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_RUNNING",
+		2: "STATUS_SUCCEEDED",
+		3: "STATUS_FAILED",
+	}
+	PolicyImpactAnalysis_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_RUNNING":     1,
+		"STATUS_SUCCEEDED":   2,
+		"STATUS_FAILED":      3,
+	}
+)
+
+func (x PolicyImpactAnalysis_Status) Enum() *PolicyImpactAnalysis_Status {
+	p := new(PolicyImpactAnalysis_Status)
+	*p = x
+	return p
+}
+
+func (x PolicyImpactAnalysis_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyImpactAnalysis_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_controlplane_v1_policy_evaluation_proto_enumTypes[1].Descriptor()
+}
+
+func (PolicyImpactAnalysis_Status) Type() protoreflect.EnumType {
+	return &file_controlplane_v1_policy_evaluation_proto_enumTypes[1]
+}
+
+func (x PolicyImpactAnalysis_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyImpactAnalysis_Status.Descriptor instead.
+func (PolicyImpactAnalysis_Status) EnumDescriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type PolicyEvaluationServiceListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scope to a project and optionally to one of its workflows
+	ProjectName  string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	WorkflowName string `protobuf:"bytes,2,opt,name=workflow_name,json=workflowName,proto3" json:"workflow_name,omitempty"`
+	// by policy name
+	PolicyName string `protobuf:"bytes,3,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	// by name of the material the policy was evaluated against
+	MaterialName string `protobuf:"bytes,4,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	// by result of the evaluation
+	Status PolicyEvaluationItem_Status `protobuf:"varint,5,opt,name=status,proto3,enum=controlplane.v1.PolicyEvaluationItem_Status" json:"status,omitempty"`
+	// only the evaluations of the most recent run of each workflow,
+	// i.e to know which projects are currently failing a policy
+	LatestOnly bool `protobuf:"varint,6,opt,name=latest_only,json=latestOnly,proto3" json:"latest_only,omitempty"`
+	// by the findings of the violations. When set, only the matching violations are returned
+	FindingType string `protobuf:"bytes,7,opt,name=finding_type,json=findingType,proto3" json:"finding_type,omitempty"`
+	// CRITICAL, HIGH, MEDIUM, LOW
+	Severity string `protobuf:"bytes,8,opt,name=severity,proto3" json:"severity,omitempty"`
+	// identifier of the finding, i.e CVE-2024-1234
+	ExternalId string `protobuf:"bytes,9,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// by creation date, both ends of the range are optional
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// pagination options
+	Pagination    *CursorPaginationRequest `protobuf:"bytes,12,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationServiceListRequest) Reset() {
+	*x = PolicyEvaluationServiceListRequest{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationServiceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationServiceListRequest) ProtoMessage() {}
+
+func (x *PolicyEvaluationServiceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationServiceListRequest.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationServiceListRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetWorkflowName() string {
+	if x != nil {
+		return x.WorkflowName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetStatus() PolicyEvaluationItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PolicyEvaluationItem_STATUS_UNSPECIFIED
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetLatestOnly() bool {
+	if x != nil {
+		return x.LatestOnly
+	}
+	return false
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetFindingType() string {
+	if x != nil {
+		return x.FindingType
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationServiceListRequest) GetPagination() *CursorPaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type PolicyEvaluationServiceListResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Result        []*PolicyEvaluationItem   `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Pagination    *CursorPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationServiceListResponse) Reset() {
+	*x = PolicyEvaluationServiceListResponse{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationServiceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationServiceListResponse) ProtoMessage() {}
+
+func (x *PolicyEvaluationServiceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationServiceListResponse.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationServiceListResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PolicyEvaluationServiceListResponse) GetResult() []*PolicyEvaluationItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationServiceListResponse) GetPagination() *CursorPaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type PolicyEvaluationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WorkflowRunId string                 `protobuf:"bytes,3,opt,name=workflow_run_id,json=workflowRunId,proto3" json:"workflow_run_id,omitempty"`
+	// workflow of the run, and the project it belongs to
+	Workflow *WorkflowRef `protobuf:"bytes,4,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	// policy name
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// material the policy was evaluated against, empty for attestation policies
+	MaterialName string `protobuf:"bytes,6,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	MaterialType string `protobuf:"bytes,7,opt,name=material_type,json=materialType,proto3" json:"material_type,omitempty"`
+	// where the policy was loaded from and its digest
+	PolicyUri    string `protobuf:"bytes,8,opt,name=policy_uri,json=policyUri,proto3" json:"policy_uri,omitempty"`
+	PolicyDigest string `protobuf:"bytes,9,opt,name=policy_digest,json=policyDigest,proto3" json:"policy_digest,omitempty"`
+	// group the policy belongs to, if any
+	GroupName   string                      `protobuf:"bytes,10,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	Status      PolicyEvaluationItem_Status `protobuf:"varint,11,opt,name=status,proto3,enum=controlplane.v1.PolicyEvaluationItem_Status" json:"status,omitempty"`
+	SkipReasons []string                    `protobuf:"bytes,12,rep,name=skip_reasons,json=skipReasons,proto3" json:"skip_reasons,omitempty"`
+	Gate        bool                        `protobuf:"varint,13,opt,name=gate,proto3" json:"gate,omitempty"`
+	// number of violations, not counting the suppressed ones
+	ViolationsCount int32 `protobuf:"varint,14,opt,name=violations_count,json=violationsCount,proto3" json:"violations_count,omitempty"`
+	// whether the evaluation belongs to the most recent run of the workflow
+	Latest        bool               `protobuf:"varint,15,opt,name=latest,proto3" json:"latest,omitempty"`
+	Violations    []*PolicyViolation `protobuf:"bytes,16,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationItem) Reset() {
+	*x = PolicyEvaluationItem{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationItem) ProtoMessage() {}
+
+func (x *PolicyEvaluationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationItem.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationItem) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PolicyEvaluationItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationItem) GetWorkflowRunId() string {
+	if x != nil {
+		return x.WorkflowRunId
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetWorkflow() *WorkflowRef {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetMaterialType() string {
+	if x != nil {
+		return x.MaterialType
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetPolicyUri() string {
+	if x != nil {
+		return x.PolicyUri
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetPolicyDigest() string {
+	if x != nil {
+		return x.PolicyDigest
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationItem) GetStatus() PolicyEvaluationItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PolicyEvaluationItem_STATUS_UNSPECIFIED
+}
+
+func (x *PolicyEvaluationItem) GetSkipReasons() []string {
+	if x != nil {
+		return x.SkipReasons
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationItem) GetGate() bool {
+	if x != nil {
+		return x.Gate
+	}
+	return false
+}
+
+func (x *PolicyEvaluationItem) GetViolationsCount() int32 {
+	if x != nil {
+		return x.ViolationsCount
+	}
+	return 0
+}
+
+func (x *PolicyEvaluationItem) GetLatest() bool {
+	if x != nil {
+		return x.Latest
+	}
+	return false
+}
+
+func (x *PolicyEvaluationItem) GetViolations() []*PolicyViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+type PolicyEvaluationServiceImpactRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// policy to evaluate from a policy provider, i.e chainloop://my-policy@2
+	PolicyRef string `protobuf:"bytes,1,opt,name=policy_ref,json=policyRef,proto3" json:"policy_ref,omitempty"`
+	// or policy group to evaluate
+	GroupRef string `protobuf:"bytes,2,opt,name=group_ref,json=groupRef,proto3" json:"group_ref,omitempty"`
+	// arguments of the policy or group
+	With map[string]string `protobuf:"bytes,3,rep,name=with,proto3" json:"with,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Runs to evaluate, scoped to a project
+	ProjectName string `protobuf:"bytes,4,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	// semver constraint on the version of the project, i.e ">= 1.2.0, < 2.0.0"
+	VersionRange string `protobuf:"bytes,5,opt,name=version_range,json=versionRange,proto3" json:"version_range,omitempty"`
+	// by creation date, both ends of the range are optional
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// maximum number of runs to evaluate, most recent first
+	Limit         int32 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) Reset() {
+	*x = PolicyEvaluationServiceImpactRequest{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationServiceImpactRequest) ProtoMessage() {}
+
+func (x *PolicyEvaluationServiceImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationServiceImpactRequest.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationServiceImpactRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetPolicyRef() string {
+	if x != nil {
+		return x.PolicyRef
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetGroupRef() string {
+	if x != nil {
+		return x.GroupRef
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetWith() map[string]string {
+	if x != nil {
+		return x.With
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetVersionRange() string {
+	if x != nil {
+		return x.VersionRange
+	}
+	return ""
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *PolicyEvaluationServiceImpactRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PolicyEvaluationServiceImpactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *PolicyImpactAnalysis  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationServiceImpactResponse) Reset() {
+	*x = PolicyEvaluationServiceImpactResponse{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationServiceImpactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationServiceImpactResponse) ProtoMessage() {}
+
+func (x *PolicyEvaluationServiceImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationServiceImpactResponse.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationServiceImpactResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PolicyEvaluationServiceImpactResponse) GetResult() *PolicyImpactAnalysis {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type PolicyEvaluationServiceImpactReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationServiceImpactReportRequest) Reset() {
+	*x = PolicyEvaluationServiceImpactReportRequest{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationServiceImpactReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationServiceImpactReportRequest) ProtoMessage() {}
+
+func (x *PolicyEvaluationServiceImpactReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationServiceImpactReportRequest.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationServiceImpactReportRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PolicyEvaluationServiceImpactReportRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PolicyEvaluationServiceImpactReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *PolicyImpactAnalysis  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyEvaluationServiceImpactReportResponse) Reset() {
+	*x = PolicyEvaluationServiceImpactReportResponse{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyEvaluationServiceImpactReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyEvaluationServiceImpactReportResponse) ProtoMessage() {}
+
+func (x *PolicyEvaluationServiceImpactReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyEvaluationServiceImpactReportResponse.ProtoReflect.Descriptor instead.
+func (*PolicyEvaluationServiceImpactReportResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PolicyEvaluationServiceImpactReportResponse) GetResult() *PolicyImpactAnalysis {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type PolicyImpactAnalysis struct {
+	state      protoimpl.MessageState      `protogen:"open.v1"`
+	Id         string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status     PolicyImpactAnalysis_Status `protobuf:"varint,2,opt,name=status,proto3,enum=controlplane.v1.PolicyImpactAnalysis_Status" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp      `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	FinishedAt *timestamppb.Timestamp      `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// number of runs evaluated
+	EvaluatedCount int32 `protobuf:"varint,5,opt,name=evaluated_count,json=evaluatedCount,proto3" json:"evaluated_count,omitempty"`
+	// runs that fail the policy and didn't fail it when they were attested
+	NewlyFailing []*PolicyImpactItem `protobuf:"bytes,6,rep,name=newly_failing,json=newlyFailing,proto3" json:"newly_failing,omitempty"`
+	// runs that couldn't be evaluated, i.e their materials are no longer available
+	Errored []*PolicyImpactItem `protobuf:"bytes,7,rep,name=errored,proto3" json:"errored,omitempty"`
+	// why the analysis failed
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyImpactAnalysis) Reset() {
+	*x = PolicyImpactAnalysis{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyImpactAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyImpactAnalysis) ProtoMessage() {}
+
+func (x *PolicyImpactAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyImpactAnalysis.ProtoReflect.Descriptor instead.
+func (*PolicyImpactAnalysis) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PolicyImpactAnalysis) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PolicyImpactAnalysis) GetStatus() PolicyImpactAnalysis_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PolicyImpactAnalysis_STATUS_UNSPECIFIED
+}
+
+func (x *PolicyImpactAnalysis) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PolicyImpactAnalysis) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *PolicyImpactAnalysis) GetEvaluatedCount() int32 {
+	if x != nil {
+		return x.EvaluatedCount
+	}
+	return 0
+}
+
+func (x *PolicyImpactAnalysis) GetNewlyFailing() []*PolicyImpactItem {
+	if x != nil {
+		return x.NewlyFailing
+	}
+	return nil
+}
+
+func (x *PolicyImpactAnalysis) GetErrored() []*PolicyImpactItem {
+	if x != nil {
+		return x.Errored
+	}
+	return nil
+}
+
+func (x *PolicyImpactAnalysis) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PolicyImpactItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowRunId string                 `protobuf:"bytes,1,opt,name=workflow_run_id,json=workflowRunId,proto3" json:"workflow_run_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// workflow of the run, and the project it belongs to
+	Workflow       *WorkflowRef `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	ProjectVersion string       `protobuf:"bytes,4,opt,name=project_version,json=projectVersion,proto3" json:"project_version,omitempty"`
+	// new violations, not counting the suppressed ones
+	Violations []*PolicyImpactViolation `protobuf:"bytes,5,rep,name=violations,proto3" json:"violations,omitempty"`
+	// why the run couldn't be evaluated
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyImpactItem) Reset() {
+	*x = PolicyImpactItem{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyImpactItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyImpactItem) ProtoMessage() {}
+
+func (x *PolicyImpactItem) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyImpactItem.ProtoReflect.Descriptor instead.
+func (*PolicyImpactItem) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PolicyImpactItem) GetWorkflowRunId() string {
+	if x != nil {
+		return x.WorkflowRunId
+	}
+	return ""
+}
+
+func (x *PolicyImpactItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PolicyImpactItem) GetWorkflow() *WorkflowRef {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+func (x *PolicyImpactItem) GetProjectVersion() string {
+	if x != nil {
+		return x.ProjectVersion
+	}
+	return ""
+}
+
+func (x *PolicyImpactItem) GetViolations() []*PolicyImpactViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *PolicyImpactItem) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PolicyImpactViolation struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PolicyName string                 `protobuf:"bytes,1,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	// material the policy was evaluated against, empty for attestation policies
+	MaterialName  string `protobuf:"bytes,2,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyImpactViolation) Reset() {
+	*x = PolicyImpactViolation{}
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyImpactViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyImpactViolation) ProtoMessage() {}
+
+func (x *PolicyImpactViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_evaluation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyImpactViolation.ProtoReflect.Descriptor instead.
+func (*PolicyImpactViolation) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_evaluation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PolicyImpactViolation) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *PolicyImpactViolation) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *PolicyImpactViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_controlplane_v1_policy_evaluation_proto protoreflect.FileDescriptor
+
+const file_controlplane_v1_policy_evaluation_proto_rawDesc = "" +
+	"\n" +
+	"'controlplane/v1/policy_evaluation.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a controlplane/v1/pagination.proto\x1a'controlplane/v1/response_messages.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\a\n" +
+	"\"PolicyEvaluationServiceListRequest\x12!\n" +
+	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12\xac\x01\n" +
+	"\rworkflow_name\x18\x02 \x01(\tB\x86\x01\xbaH\x82\x01\xba\x01|\n" +
+	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')\xd8\x01\x01R\fworkflowName\x12\x1f\n" +
+	"\vpolicy_name\x18\x03 \x01(\tR\n" +
+	"policyName\x12#\n" +
+	"\rmaterial_name\x18\x04 \x01(\tR\fmaterialName\x12N\n" +
+	"\x06status\x18\x05 \x01(\x0e2,.controlplane.v1.PolicyEvaluationItem.StatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12\x1f\n" +
+	"\vlatest_only\x18\x06 \x01(\bR\n" +
+	"latestOnly\x12u\n" +
+	"\ffinding_type\x18\a \x01(\tBR\xbaHOrMR\x00R\rVULNERABILITYR\x04SASTR\x11LICENSE_VIOLATIONR\x06SECRETR\x10MISCONFIGURATIONR\aMALWARER\vfindingType\x12\x1a\n" +
+	"\bseverity\x18\b \x01(\tR\bseverity\x12\x1f\n" +
+	"\vexternal_id\x18\t \x01(\tR\n" +
+	"externalId\x12?\n" +
+	"\rcreated_after\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12H\n" +
+	"\n" +
+	"pagination\x18\f \x01(\v2(.controlplane.v1.CursorPaginationRequestR\n" +
+	"pagination:\xa0\x01\xbaH\x9c\x01\x1a\x99\x01\n" +
+	"-policy_evaluation_workflow_project_dependency\x120project_name must be set if workflow_name is set\x1a6!(this.workflow_name != '' && this.project_name == '')\"\xaf\x01\n" +
+	"#PolicyEvaluationServiceListResponse\x12=\n" +
+	"\x06result\x18\x01 \x03(\v2%.controlplane.v1.PolicyEvaluationItemR\x06result\x12I\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2).controlplane.v1.CursorPaginationResponseR\n" +
+	"pagination\"\xe2\x05\n" +
+	"\x14PolicyEvaluationItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12&\n" +
+	"\x0fworkflow_run_id\x18\x03 \x01(\tR\rworkflowRunId\x128\n" +
+	"\bworkflow\x18\x04 \x01(\v2\x1c.controlplane.v1.WorkflowRefR\bworkflow\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12#\n" +
+	"\rmaterial_name\x18\x06 \x01(\tR\fmaterialName\x12#\n" +
+	"\rmaterial_type\x18\a \x01(\tR\fmaterialType\x12\x1d\n" +
+	"\n" +
+	"policy_uri\x18\b \x01(\tR\tpolicyUri\x12#\n" +
+	"\rpolicy_digest\x18\t \x01(\tR\fpolicyDigest\x12\x1d\n" +
+	"\n" +
+	"group_name\x18\n" +
+	" \x01(\tR\tgroupName\x12D\n" +
+	"\x06status\x18\v \x01(\x0e2,.controlplane.v1.PolicyEvaluationItem.StatusR\x06status\x12!\n" +
+	"\fskip_reasons\x18\f \x03(\tR\vskipReasons\x12\x12\n" +
+	"\x04gate\x18\r \x01(\bR\x04gate\x12)\n" +
+	"\x10violations_count\x18\x0e \x01(\x05R\x0fviolationsCount\x12\x16\n" +
+	"\x06latest\x18\x0f \x01(\bR\x06latest\x12@\n" +
+	"\n" +
+	"violations\x18\x10 \x03(\v2 .controlplane.v1.PolicyViolationR\n" +
+	"violations\"Z\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rSTATUS_PASSED\x10\x01\x12\x11\n" +
+	"\rSTATUS_FAILED\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_SKIPPED\x10\x03\"\xd7\x04\n" +
+	"$PolicyEvaluationServiceImpactRequest\x12\x1d\n" +
+	"\n" +
+	"policy_ref\x18\x01 \x01(\tR\tpolicyRef\x12\x1b\n" +
+	"\tgroup_ref\x18\x02 \x01(\tR\bgroupRef\x12S\n" +
+	"\x04with\x18\x03 \x03(\v2?.controlplane.v1.PolicyEvaluationServiceImpactRequest.WithEntryR\x04with\x12!\n" +
+	"\fproject_name\x18\x04 \x01(\tR\vprojectName\x12#\n" +
+	"\rversion_range\x18\x05 \x01(\tR\fversionRange\x12?\n" +
+	"\rcreated_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12 \n" +
+	"\x05limit\x18\b \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xf4\x03(\x00R\x05limit\x1a7\n" +
+	"\tWithEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01:w\xbaHt\x1ar\n" +
+	"\x11policy_impact_ref\x12*either policy_ref or group_ref must be set\x1a1(this.policy_ref != '') != (this.group_ref != '')\"f\n" +
+	"%PolicyEvaluationServiceImpactResponse\x12=\n" +
+	"\x06result\x18\x01 \x01(\v2%.controlplane.v1.PolicyImpactAnalysisR\x06result\"F\n" +
+	"*PolicyEvaluationServiceImpactReportRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"l\n" +
+	"+PolicyEvaluationServiceImpactReportResponse\x12=\n" +
+	"\x06result\x18\x01 \x01(\v2%.controlplane.v1.PolicyImpactAnalysisR\x06result\"\x87\x04\n" +
+	"\x14PolicyImpactAnalysis\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12D\n" +
+	"\x06status\x18\x02 \x01(\x0e2,.controlplane.v1.PolicyImpactAnalysis.StatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12;\n" +
+	"\vfinished_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12'\n" +
+	"\x0fevaluated_count\x18\x05 \x01(\x05R\x0eevaluatedCount\x12F\n" +
+	"\rnewly_failing\x18\x06 \x03(\v2!.controlplane.v1.PolicyImpactItemR\fnewlyFailing\x12;\n" +
+	"\aerrored\x18\a \x03(\v2!.controlplane.v1.PolicyImpactItemR\aerrored\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\"]\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_RUNNING\x10\x01\x12\x14\n" +
+	"\x10STATUS_SUCCEEDED\x10\x02\x12\x11\n" +
+	"\rSTATUS_FAILED\x10\x03\"\xb6\x02\n" +
+	"\x10PolicyImpactItem\x12&\n" +
+	"\x0fworkflow_run_id\x18\x01 \x01(\tR\rworkflowRunId\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\bworkflow\x18\x03 \x01(\v2\x1c.controlplane.v1.WorkflowRefR\bworkflow\x12'\n" +
+	"\x0fproject_version\x18\x04 \x01(\tR\x0eprojectVersion\x12F\n" +
+	"\n" +
+	"violations\x18\x05 \x03(\v2&.controlplane.v1.PolicyImpactViolationR\n" +
+	"violations\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"w\n" +
+	"\x15PolicyImpactViolation\x12\x1f\n" +
+	"\vpolicy_name\x18\x01 \x01(\tR\n" +
+	"policyName\x12#\n" +
+	"\rmaterial_name\x18\x02 \x01(\tR\fmaterialName\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage2\x91\x03\n" +
+	"\x17PolicyEvaluationService\x12q\n" +
+	"\x04List\x123.controlplane.v1.PolicyEvaluationServiceListRequest\x1a4.controlplane.v1.PolicyEvaluationServiceListResponse\x12w\n" +
+	"\x06Impact\x125.controlplane.v1.PolicyEvaluationServiceImpactRequest\x1a6.controlplane.v1.PolicyEvaluationServiceImpactResponse\x12\x89\x01\n" +
+	"\fImpactReport\x12;.controlplane.v1.PolicyEvaluationServiceImpactReportRequest\x1a<.controlplane.v1.PolicyEvaluationServiceImpactReportResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
+
+var (
+	file_controlplane_v1_policy_evaluation_proto_rawDescOnce sync.Once
+	file_controlplane_v1_policy_evaluation_proto_rawDescData []byte
+)
+
+func file_controlplane_v1_policy_evaluation_proto_rawDescGZIP() []byte {
+	file_controlplane_v1_policy_evaluation_proto_rawDescOnce.Do(func() {
+		file_controlplane_v1_policy_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_controlplane_v1_policy_evaluation_proto_rawDesc), len(file_controlplane_v1_policy_evaluation_proto_rawDesc)))
+	})
+	return file_controlplane_v1_policy_evaluation_proto_rawDescData
+}
+
+var file_controlplane_v1_policy_evaluation_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_controlplane_v1_policy_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_controlplane_v1_policy_evaluation_proto_goTypes = []any{
+	(PolicyEvaluationItem_Status)(0),                    // 0: controlplane.v1.PolicyEvaluationItem.Status
+	(PolicyImpactAnalysis_Status)(0),                    // 1: controlplane.v1.PolicyImpactAnalysis.Status
+	(*PolicyEvaluationServiceListRequest)(nil),          // 2: controlplane.v1.PolicyEvaluationServiceListRequest
+	(*PolicyEvaluationServiceListResponse)(nil),         // 3: controlplane.v1.PolicyEvaluationServiceListResponse
+	(*PolicyEvaluationItem)(nil),                        // 4: controlplane.v1.PolicyEvaluationItem
+	(*PolicyEvaluationServiceImpactRequest)(nil),        // 5: controlplane.v1.PolicyEvaluationServiceImpactRequest
+	(*PolicyEvaluationServiceImpactResponse)(nil),       // 6: controlplane.v1.PolicyEvaluationServiceImpactResponse
+	(*PolicyEvaluationServiceImpactReportRequest)(nil),  // 7: controlplane.v1.PolicyEvaluationServiceImpactReportRequest
+	(*PolicyEvaluationServiceImpactReportResponse)(nil), // 8: controlplane.v1.PolicyEvaluationServiceImpactReportResponse
+	(*PolicyImpactAnalysis)(nil),                        // 9: controlplane.v1.PolicyImpactAnalysis
+	(*PolicyImpactItem)(nil),                            // 10: controlplane.v1.PolicyImpactItem
+	(*PolicyImpactViolation)(nil),                       // 11: controlplane.v1.PolicyImpactViolation
+	nil,                                                 // 12: controlplane.v1.PolicyEvaluationServiceImpactRequest.WithEntry
+	(*timestamppb.Timestamp)(nil),                       // 13: google.protobuf.Timestamp
+	(*CursorPaginationRequest)(nil),                     // 14: controlplane.v1.CursorPaginationRequest
+	(*CursorPaginationResponse)(nil),                    // 15: controlplane.v1.CursorPaginationResponse
+	(*WorkflowRef)(nil),                                 // 16: controlplane.v1.WorkflowRef
+	(*PolicyViolation)(nil),                             // 17: controlplane.v1.PolicyViolation
+}
+var file_controlplane_v1_policy_evaluation_proto_depIdxs = []int32{
+	0,  // 0: controlplane.v1.PolicyEvaluationServiceListRequest.status:type_name -> controlplane.v1.PolicyEvaluationItem.Status
+	13, // 1: controlplane.v1.PolicyEvaluationServiceListRequest.created_after:type_name -> google.protobuf.Timestamp
+	13, // 2: controlplane.v1.PolicyEvaluationServiceListRequest.created_before:type_name -> google.protobuf.Timestamp
+	14, // 3: controlplane.v1.PolicyEvaluationServiceListRequest.pagination:type_name -> controlplane.v1.CursorPaginationRequest
+	4,  // 4: controlplane.v1.PolicyEvaluationServiceListResponse.result:type_name -> controlplane.v1.PolicyEvaluationItem
+	15, // 5: controlplane.v1.PolicyEvaluationServiceListResponse.pagination:type_name -> controlplane.v1.CursorPaginationResponse
+	13, // 6: controlplane.v1.PolicyEvaluationItem.created_at:type_name -> google.protobuf.Timestamp
+	16, // 7: controlplane.v1.PolicyEvaluationItem.workflow:type_name -> controlplane.v1.WorkflowRef
+	0,  // 8: controlplane.v1.PolicyEvaluationItem.status:type_name -> controlplane.v1.PolicyEvaluationItem.Status
+	17, // 9: controlplane.v1.PolicyEvaluationItem.violations:type_name -> controlplane.v1.PolicyViolation
+	12, // 10: controlplane.v1.PolicyEvaluationServiceImpactRequest.with:type_name -> controlplane.v1.PolicyEvaluationServiceImpactRequest.WithEntry
+	13, // 11: controlplane.v1.PolicyEvaluationServiceImpactRequest.created_after:type_name -> google.protobuf.Timestamp
+	13, // 12: controlplane.v1.PolicyEvaluationServiceImpactRequest.created_before:type_name -> google.protobuf.Timestamp
+	9,  // 13: controlplane.v1.PolicyEvaluationServiceImpactResponse.result:type_name -> controlplane.v1.PolicyImpactAnalysis
+	9,  // 14: controlplane.v1.PolicyEvaluationServiceImpactReportResponse.result:type_name -> controlplane.v1.PolicyImpactAnalysis
+	1,  // 15: controlplane.v1.PolicyImpactAnalysis.status:type_name -> controlplane.v1.PolicyImpactAnalysis.Status
+	13, // 16: controlplane.v1.PolicyImpactAnalysis.created_at:type_name -> google.protobuf.Timestamp
+	13, // 17: controlplane.v1.PolicyImpactAnalysis.finished_at:type_name -> google.protobuf.Timestamp
+	10, // 18: controlplane.v1.PolicyImpactAnalysis.newly_failing:type_name -> controlplane.v1.PolicyImpactItem
+	10, // 19: controlplane.v1.PolicyImpactAnalysis.errored:type_name -> controlplane.v1.PolicyImpactItem
+	13, // 20: controlplane.v1.PolicyImpactItem.created_at:type_name -> google.protobuf.Timestamp
+	16, // 21: controlplane.v1.PolicyImpactItem.workflow:type_name -> controlplane.v1.WorkflowRef
+	11, // 22: controlplane.v1.PolicyImpactItem.violations:type_name -> controlplane.v1.PolicyImpactViolation
+	2,  // 23: controlplane.v1.PolicyEvaluationService.List:input_type -> controlplane.v1.PolicyEvaluationServiceListRequest
+	5,  // 24: controlplane.v1.PolicyEvaluationService.Impact:input_type -> controlplane.v1.PolicyEvaluationServiceImpactRequest
+	7,  // 25: controlplane.v1.PolicyEvaluationService.ImpactReport:input_type -> controlplane.v1.PolicyEvaluationServiceImpactReportRequest
+	3,  // 26: controlplane.v1.PolicyEvaluationService.List:output_type -> controlplane.v1.PolicyEvaluationServiceListResponse
+	6,  // 27: controlplane.v1.PolicyEvaluationService.Impact:output_type -> controlplane.v1.PolicyEvaluationServiceImpactResponse
+	8,  // 28: controlplane.v1.PolicyEvaluationService.ImpactReport:output_type -> controlplane.v1.PolicyEvaluationServiceImpactReportResponse
+	26, // [26:29] is the sub-list for method output_type
+	23, // [23:26] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
+}
+
+func init() { file_controlplane_v1_policy_evaluation_proto_init() }
+func file_controlplane_v1_policy_evaluation_proto_init() {
+	if File_controlplane_v1_policy_evaluation_proto != nil {
+		return
+	}
+	file_controlplane_v1_pagination_proto_init()
+	file_controlplane_v1_response_messages_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_v1_policy_evaluation_proto_rawDesc), len(file_controlplane_v1_policy_evaluation_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_controlplane_v1_policy_evaluation_proto_goTypes,
+		DependencyIndexes: file_controlplane_v1_policy_evaluation_proto_depIdxs,
+		EnumInfos:         file_controlplane_v1_policy_evaluation_proto_enumTypes,
+		MessageInfos:      file_controlplane_v1_policy_evaluation_proto_msgTypes,
+	}.Build()
+	File_controlplane_v1_policy_evaluation_proto = out.File
+	file_controlplane_v1_policy_evaluation_proto_goTypes = nil
+	file_controlplane_v1_policy_evaluation_proto_depIdxs = nil
+}
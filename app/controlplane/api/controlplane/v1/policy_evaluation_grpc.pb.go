@@ -0,0 +1,211 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controlplane/v1/policy_evaluation.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PolicyEvaluationService_List_FullMethodName         = "/controlplane.v1.PolicyEvaluationService/List"
+	PolicyEvaluationService_Impact_FullMethodName       = "/controlplane.v1.PolicyEvaluationService/Impact"
+	PolicyEvaluationService_ImpactReport_FullMethodName = "/controlplane.v1.PolicyEvaluationService/ImpactReport"
+)
+
+// PolicyEvaluationServiceClient is the client API for PolicyEvaluationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PolicyEvaluationServiceClient interface {
+	// List the policy evaluations, most recent first
+	List(ctx context.Context, in *PolicyEvaluationServiceListRequest, opts ...grpc.CallOption) (*PolicyEvaluationServiceListResponse, error)
+	// Re-evaluate a policy or policy group against the attestations already stored,
+	// i.e to know which releases would fail a new version of a policy.
+	// The original runs and their evaluations are left untouched.
+	// The analysis runs in the background, its report is retrieved with ImpactReport
+	Impact(ctx context.Context, in *PolicyEvaluationServiceImpactRequest, opts ...grpc.CallOption) (*PolicyEvaluationServiceImpactResponse, error)
+	// Status of an analysis started with Impact, and its report once it finishes
+	ImpactReport(ctx context.Context, in *PolicyEvaluationServiceImpactReportRequest, opts ...grpc.CallOption) (*PolicyEvaluationServiceImpactReportResponse, error)
+}
+
+type policyEvaluationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPolicyEvaluationServiceClient(cc grpc.ClientConnInterface) PolicyEvaluationServiceClient {
+	return &policyEvaluationServiceClient{cc}
+}
+
+func (c *policyEvaluationServiceClient) List(ctx context.Context, in *PolicyEvaluationServiceListRequest, opts ...grpc.CallOption) (*PolicyEvaluationServiceListResponse, error) {
+	out := new(PolicyEvaluationServiceListResponse)
+	err := c.cc.Invoke(ctx, PolicyEvaluationService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyEvaluationServiceClient) Impact(ctx context.Context, in *PolicyEvaluationServiceImpactRequest, opts ...grpc.CallOption) (*PolicyEvaluationServiceImpactResponse, error) {
+	out := new(PolicyEvaluationServiceImpactResponse)
+	err := c.cc.Invoke(ctx, PolicyEvaluationService_Impact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyEvaluationServiceClient) ImpactReport(ctx context.Context, in *PolicyEvaluationServiceImpactReportRequest, opts ...grpc.CallOption) (*PolicyEvaluationServiceImpactReportResponse, error) {
+	out := new(PolicyEvaluationServiceImpactReportResponse)
+	err := c.cc.Invoke(ctx, PolicyEvaluationService_ImpactReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PolicyEvaluationServiceServer is the server API for PolicyEvaluationService service.
+// All implementations must embed UnimplementedPolicyEvaluationServiceServer
+// for forward compatibility
+type PolicyEvaluationServiceServer interface {
+	// List the policy evaluations, most recent first
+	List(context.Context, *PolicyEvaluationServiceListRequest) (*PolicyEvaluationServiceListResponse, error)
+	// Re-evaluate a policy or policy group against the attestations already stored,
+	// i.e to know which releases would fail a new version of a policy.
+	// The original runs and their evaluations are left untouched.
+	// The analysis runs in the background, its report is retrieved with ImpactReport
+	Impact(context.Context, *PolicyEvaluationServiceImpactRequest) (*PolicyEvaluationServiceImpactResponse, error)
+	// Status of an analysis started with Impact, and its report once it finishes
+	ImpactReport(context.Context, *PolicyEvaluationServiceImpactReportRequest) (*PolicyEvaluationServiceImpactReportResponse, error)
+	mustEmbedUnimplementedPolicyEvaluationServiceServer()
+}
+
+// UnimplementedPolicyEvaluationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPolicyEvaluationServiceServer struct {
+}
+
+func (UnimplementedPolicyEvaluationServiceServer) List(context.Context, *PolicyEvaluationServiceListRequest) (*PolicyEvaluationServiceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPolicyEvaluationServiceServer) Impact(context.Context, *PolicyEvaluationServiceImpactRequest) (*PolicyEvaluationServiceImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impact not implemented")
+}
+func (UnimplementedPolicyEvaluationServiceServer) ImpactReport(context.Context, *PolicyEvaluationServiceImpactReportRequest) (*PolicyEvaluationServiceImpactReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImpactReport not implemented")
+}
+func (UnimplementedPolicyEvaluationServiceServer) mustEmbedUnimplementedPolicyEvaluationServiceServer() {
+}
+
+// UnsafePolicyEvaluationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PolicyEvaluationServiceServer will
+// result in compilation errors.
+type UnsafePolicyEvaluationServiceServer interface {
+	mustEmbedUnimplementedPolicyEvaluationServiceServer()
+}
+
+func RegisterPolicyEvaluationServiceServer(s grpc.ServiceRegistrar, srv PolicyEvaluationServiceServer) {
+	s.RegisterService(&PolicyEvaluationService_ServiceDesc, srv)
+}
+
+func _PolicyEvaluationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyEvaluationServiceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyEvaluationServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyEvaluationService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyEvaluationServiceServer).List(ctx, req.(*PolicyEvaluationServiceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyEvaluationService_Impact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyEvaluationServiceImpactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyEvaluationServiceServer).Impact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyEvaluationService_Impact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyEvaluationServiceServer).Impact(ctx, req.(*PolicyEvaluationServiceImpactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyEvaluationService_ImpactReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyEvaluationServiceImpactReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyEvaluationServiceServer).ImpactReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyEvaluationService_ImpactReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyEvaluationServiceServer).ImpactReport(ctx, req.(*PolicyEvaluationServiceImpactReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PolicyEvaluationService_ServiceDesc is the grpc.ServiceDesc for PolicyEvaluationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PolicyEvaluationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controlplane.v1.PolicyEvaluationService",
+	HandlerType: (*PolicyEvaluationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _PolicyEvaluationService_List_Handler,
+		},
+		{
+			MethodName: "Impact",
+			Handler:    _PolicyEvaluationService_Impact_Handler,
+		},
+		{
+			MethodName: "ImpactReport",
+			Handler:    _PolicyEvaluationService_ImpactReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controlplane/v1/policy_evaluation.proto",
+}
@@ -0,0 +1,797 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: controlplane/v1/policy_exception.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PolicyExceptionItem_Status int32
+
+const (
+	PolicyExceptionItem_STATUS_UNSPECIFIED PolicyExceptionItem_Status = 0
+	// waiting for approval
+	PolicyExceptionItem_STATUS_PENDING PolicyExceptionItem_Status = 1
+	// approved, it suppresses the matching violations
+	PolicyExceptionItem_STATUS_ACTIVE  PolicyExceptionItem_Status = 2
+	PolicyExceptionItem_STATUS_EXPIRED PolicyExceptionItem_Status = 3
+	PolicyExceptionItem_STATUS_REVOKED PolicyExceptionItem_Status = 4
+)
+
+// Enum value maps for PolicyExceptionItem_Status.
+var (
+	PolicyExceptionItem_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_PENDING",
+		2: "STATUS_ACTIVE",
+		3: "STATUS_EXPIRED",
+		4: "STATUS_REVOKED",
+	}
+	PolicyExceptionItem_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_PENDING":     1,
+		"STATUS_ACTIVE":      2,
+		"STATUS_EXPIRED":     3,
+		"STATUS_REVOKED":     4,
+	}
+)
+
+func (x PolicyExceptionItem_Status) Enum() *PolicyExceptionItem_Status {
+	p := new(PolicyExceptionItem_Status)
+	*p = x
+	return p
+}
+
+func (x PolicyExceptionItem_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyExceptionItem_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_controlplane_v1_policy_exception_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyExceptionItem_Status) Type() protoreflect.EnumType {
+	return &file_controlplane_v1_policy_exception_proto_enumTypes[0]
+}
+
+func (x PolicyExceptionItem_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyExceptionItem_Status.Descriptor instead.
+func (PolicyExceptionItem_Status) EnumDescriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type PolicyExceptionServiceCreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scope of the exception, empty values match everything.
+	// At least one of policy_name, material_name or external_id must be set
+	ProjectName  string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	WorkflowName string `protobuf:"bytes,2,opt,name=workflow_name,json=workflowName,proto3" json:"workflow_name,omitempty"`
+	PolicyName   string `protobuf:"bytes,3,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	MaterialName string `protobuf:"bytes,4,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	// identifier of the finding, i.e CVE-2024-1234
+	ExternalId string `protobuf:"bytes,5,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// why the violations can be waived
+	Justification string                 `protobuf:"bytes,6,opt,name=justification,proto3" json:"justification,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceCreateRequest) Reset() {
+	*x = PolicyExceptionServiceCreateRequest{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceCreateRequest) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceCreateRequest.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceCreateRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetWorkflowName() string {
+	if x != nil {
+		return x.WorkflowName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceCreateRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type PolicyExceptionServiceCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *PolicyExceptionItem   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceCreateResponse) Reset() {
+	*x = PolicyExceptionServiceCreateResponse{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceCreateResponse) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceCreateResponse.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceCreateResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PolicyExceptionServiceCreateResponse) GetResult() *PolicyExceptionItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type PolicyExceptionServiceApproveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceApproveRequest) Reset() {
+	*x = PolicyExceptionServiceApproveRequest{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceApproveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceApproveRequest) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceApproveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceApproveRequest.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceApproveRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PolicyExceptionServiceApproveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PolicyExceptionServiceApproveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *PolicyExceptionItem   `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceApproveResponse) Reset() {
+	*x = PolicyExceptionServiceApproveResponse{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceApproveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceApproveResponse) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceApproveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceApproveResponse.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceApproveResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PolicyExceptionServiceApproveResponse) GetResult() *PolicyExceptionItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type PolicyExceptionServiceRevokeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceRevokeRequest) Reset() {
+	*x = PolicyExceptionServiceRevokeRequest{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceRevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceRevokeRequest) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceRevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceRevokeRequest.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceRevokeRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PolicyExceptionServiceRevokeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PolicyExceptionServiceRevokeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceRevokeResponse) Reset() {
+	*x = PolicyExceptionServiceRevokeResponse{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceRevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceRevokeResponse) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceRevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceRevokeResponse.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceRevokeResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{5}
+}
+
+type PolicyExceptionServiceListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the exceptions scoped to the project
+	ProjectName   string                     `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	Status        PolicyExceptionItem_Status `protobuf:"varint,2,opt,name=status,proto3,enum=controlplane.v1.PolicyExceptionItem_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceListRequest) Reset() {
+	*x = PolicyExceptionServiceListRequest{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceListRequest) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceListRequest.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceListRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PolicyExceptionServiceListRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionServiceListRequest) GetStatus() PolicyExceptionItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PolicyExceptionItem_STATUS_UNSPECIFIED
+}
+
+type PolicyExceptionServiceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        []*PolicyExceptionItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionServiceListResponse) Reset() {
+	*x = PolicyExceptionServiceListResponse{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionServiceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionServiceListResponse) ProtoMessage() {}
+
+func (x *PolicyExceptionServiceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionServiceListResponse.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionServiceListResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PolicyExceptionServiceListResponse) GetResult() []*PolicyExceptionItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type PolicyExceptionItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Scope of the exception, empty values match everything
+	ProjectName   string `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	WorkflowName  string `protobuf:"bytes,4,opt,name=workflow_name,json=workflowName,proto3" json:"workflow_name,omitempty"`
+	PolicyName    string `protobuf:"bytes,5,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	MaterialName  string `protobuf:"bytes,6,opt,name=material_name,json=materialName,proto3" json:"material_name,omitempty"`
+	ExternalId    string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Justification string `protobuf:"bytes,8,opt,name=justification,proto3" json:"justification,omitempty"`
+	// emails of the users that requested and approved the exception
+	CreatedBy     string                     `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ApprovedBy    string                     `protobuf:"bytes,10,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt    *timestamppb.Timestamp     `protobuf:"bytes,11,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp     `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	RevokedAt     *timestamppb.Timestamp     `protobuf:"bytes,13,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	Status        PolicyExceptionItem_Status `protobuf:"varint,14,opt,name=status,proto3,enum=controlplane.v1.PolicyExceptionItem_Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyExceptionItem) Reset() {
+	*x = PolicyExceptionItem{}
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyExceptionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyExceptionItem) ProtoMessage() {}
+
+func (x *PolicyExceptionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_policy_exception_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyExceptionItem.ProtoReflect.Descriptor instead.
+func (*PolicyExceptionItem) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_policy_exception_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PolicyExceptionItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PolicyExceptionItem) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetWorkflowName() string {
+	if x != nil {
+		return x.WorkflowName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetMaterialName() string {
+	if x != nil {
+		return x.MaterialName
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *PolicyExceptionItem) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *PolicyExceptionItem) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PolicyExceptionItem) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *PolicyExceptionItem) GetStatus() PolicyExceptionItem_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PolicyExceptionItem_STATUS_UNSPECIFIED
+}
+
+var File_controlplane_v1_policy_exception_proto protoreflect.FileDescriptor
+
+const file_controlplane_v1_policy_exception_proto_rawDesc = "" +
+	"\n" +
+	"&controlplane/v1/policy_exception.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x04\n" +
+	"#PolicyExceptionServiceCreateRequest\x12!\n" +
+	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12\xac\x01\n" +
+	"\rworkflow_name\x18\x02 \x01(\tB\x86\x01\xbaH\x82\x01\xba\x01|\n" +
+	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')\xd8\x01\x01R\fworkflowName\x12\x1f\n" +
+	"\vpolicy_name\x18\x03 \x01(\tR\n" +
+	"policyName\x12#\n" +
+	"\rmaterial_name\x18\x04 \x01(\tR\fmaterialName\x12\x1f\n" +
+	"\vexternal_id\x18\x05 \x01(\tR\n" +
+	"externalId\x12-\n" +
+	"\rjustification\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\rjustification\x12A\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\texpiresAt:\x9f\x01\xbaH\x9b\x01\x1a\x98\x01\n" +
+	",policy_exception_workflow_project_dependency\x120project_name must be set if workflow_name is set\x1a6!(this.workflow_name != '' && this.project_name == '')\"d\n" +
+	"$PolicyExceptionServiceCreateResponse\x12<\n" +
+	"\x06result\x18\x01 \x01(\v2$.controlplane.v1.PolicyExceptionItemR\x06result\"@\n" +
+	"$PolicyExceptionServiceApproveRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"e\n" +
+	"%PolicyExceptionServiceApproveResponse\x12<\n" +
+	"\x06result\x18\x01 \x01(\v2$.controlplane.v1.PolicyExceptionItemR\x06result\"?\n" +
+	"#PolicyExceptionServiceRevokeRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"&\n" +
+	"$PolicyExceptionServiceRevokeResponse\"\x95\x01\n" +
+	"!PolicyExceptionServiceListRequest\x12!\n" +
+	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12M\n" +
+	"\x06status\x18\x02 \x01(\x0e2+.controlplane.v1.PolicyExceptionItem.StatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\"b\n" +
+	"\"PolicyExceptionServiceListResponse\x12<\n" +
+	"\x06result\x18\x01 \x03(\v2$.controlplane.v1.PolicyExceptionItemR\x06result\"\xde\x05\n" +
+	"\x13PolicyExceptionItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
+	"\fproject_name\x18\x03 \x01(\tR\vprojectName\x12#\n" +
+	"\rworkflow_name\x18\x04 \x01(\tR\fworkflowName\x12\x1f\n" +
+	"\vpolicy_name\x18\x05 \x01(\tR\n" +
+	"policyName\x12#\n" +
+	"\rmaterial_name\x18\x06 \x01(\tR\fmaterialName\x12\x1f\n" +
+	"\vexternal_id\x18\a \x01(\tR\n" +
+	"externalId\x12$\n" +
+	"\rjustification\x18\b \x01(\tR\rjustification\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\t \x01(\tR\tcreatedBy\x12\x1f\n" +
+	"\vapproved_by\x18\n" +
+	" \x01(\tR\n" +
+	"approvedBy\x12;\n" +
+	"\vapproved_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12C\n" +
+	"\x06status\x18\x0e \x01(\x0e2+.controlplane.v1.PolicyExceptionItem.StatusR\x06status\"o\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_PENDING\x10\x01\x12\x11\n" +
+	"\rSTATUS_ACTIVE\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_EXPIRED\x10\x03\x12\x12\n" +
+	"\x0eSTATUS_REVOKED\x10\x042\xf1\x03\n" +
+	"\x16PolicyExceptionService\x12u\n" +
+	"\x06Create\x124.controlplane.v1.PolicyExceptionServiceCreateRequest\x1a5.controlplane.v1.PolicyExceptionServiceCreateResponse\x12x\n" +
+	"\aApprove\x125.controlplane.v1.PolicyExceptionServiceApproveRequest\x1a6.controlplane.v1.PolicyExceptionServiceApproveResponse\x12u\n" +
+	"\x06Revoke\x124.controlplane.v1.PolicyExceptionServiceRevokeRequest\x1a5.controlplane.v1.PolicyExceptionServiceRevokeResponse\x12o\n" +
+	"\x04List\x122.controlplane.v1.PolicyExceptionServiceListRequest\x1a3.controlplane.v1.PolicyExceptionServiceListResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
+
+var (
+	file_controlplane_v1_policy_exception_proto_rawDescOnce sync.Once
+	file_controlplane_v1_policy_exception_proto_rawDescData []byte
+)
+
+func file_controlplane_v1_policy_exception_proto_rawDescGZIP() []byte {
+	file_controlplane_v1_policy_exception_proto_rawDescOnce.Do(func() {
+		file_controlplane_v1_policy_exception_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_controlplane_v1_policy_exception_proto_rawDesc), len(file_controlplane_v1_policy_exception_proto_rawDesc)))
+	})
+	return file_controlplane_v1_policy_exception_proto_rawDescData
+}
+
+var file_controlplane_v1_policy_exception_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_controlplane_v1_policy_exception_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_controlplane_v1_policy_exception_proto_goTypes = []any{
+	(PolicyExceptionItem_Status)(0),               // 0: controlplane.v1.PolicyExceptionItem.Status
+	(*PolicyExceptionServiceCreateRequest)(nil),   // 1: controlplane.v1.PolicyExceptionServiceCreateRequest
+	(*PolicyExceptionServiceCreateResponse)(nil),  // 2: controlplane.v1.PolicyExceptionServiceCreateResponse
+	(*PolicyExceptionServiceApproveRequest)(nil),  // 3: controlplane.v1.PolicyExceptionServiceApproveRequest
+	(*PolicyExceptionServiceApproveResponse)(nil), // 4: controlplane.v1.PolicyExceptionServiceApproveResponse
+	(*PolicyExceptionServiceRevokeRequest)(nil),   // 5: controlplane.v1.PolicyExceptionServiceRevokeRequest
+	(*PolicyExceptionServiceRevokeResponse)(nil),  // 6: controlplane.v1.PolicyExceptionServiceRevokeResponse
+	(*PolicyExceptionServiceListRequest)(nil),     // 7: controlplane.v1.PolicyExceptionServiceListRequest
+	(*PolicyExceptionServiceListResponse)(nil),    // 8: controlplane.v1.PolicyExceptionServiceListResponse
+	(*PolicyExceptionItem)(nil),                   // 9: controlplane.v1.PolicyExceptionItem
+	(*timestamppb.Timestamp)(nil),                 // 10: google.protobuf.Timestamp
+}
+var file_controlplane_v1_policy_exception_proto_depIdxs = []int32{
+	10, // 0: controlplane.v1.PolicyExceptionServiceCreateRequest.expires_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: controlplane.v1.PolicyExceptionServiceCreateResponse.result:type_name -> controlplane.v1.PolicyExceptionItem
+	9,  // 2: controlplane.v1.PolicyExceptionServiceApproveResponse.result:type_name -> controlplane.v1.PolicyExceptionItem
+	0,  // 3: controlplane.v1.PolicyExceptionServiceListRequest.status:type_name -> controlplane.v1.PolicyExceptionItem.Status
+	9,  // 4: controlplane.v1.PolicyExceptionServiceListResponse.result:type_name -> controlplane.v1.PolicyExceptionItem
+	10, // 5: controlplane.v1.PolicyExceptionItem.created_at:type_name -> google.protobuf.Timestamp
+	10, // 6: controlplane.v1.PolicyExceptionItem.approved_at:type_name -> google.protobuf.Timestamp
+	10, // 7: controlplane.v1.PolicyExceptionItem.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 8: controlplane.v1.PolicyExceptionItem.revoked_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: controlplane.v1.PolicyExceptionItem.status:type_name -> controlplane.v1.PolicyExceptionItem.Status
+	1,  // 10: controlplane.v1.PolicyExceptionService.Create:input_type -> controlplane.v1.PolicyExceptionServiceCreateRequest
+	3,  // 11: controlplane.v1.PolicyExceptionService.Approve:input_type -> controlplane.v1.PolicyExceptionServiceApproveRequest
+	5,  // 12: controlplane.v1.PolicyExceptionService.Revoke:input_type -> controlplane.v1.PolicyExceptionServiceRevokeRequest
+	7,  // 13: controlplane.v1.PolicyExceptionService.List:input_type -> controlplane.v1.PolicyExceptionServiceListRequest
+	2,  // 14: controlplane.v1.PolicyExceptionService.Create:output_type -> controlplane.v1.PolicyExceptionServiceCreateResponse
+	4,  // 15: controlplane.v1.PolicyExceptionService.Approve:output_type -> controlplane.v1.PolicyExceptionServiceApproveResponse
+	6,  // 16: controlplane.v1.PolicyExceptionService.Revoke:output_type -> controlplane.v1.PolicyExceptionServiceRevokeResponse
+	8,  // 17: controlplane.v1.PolicyExceptionService.List:output_type -> controlplane.v1.PolicyExceptionServiceListResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_controlplane_v1_policy_exception_proto_init() }
+func file_controlplane_v1_policy_exception_proto_init() {
+	if File_controlplane_v1_policy_exception_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_v1_policy_exception_proto_rawDesc), len(file_controlplane_v1_policy_exception_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_controlplane_v1_policy_exception_proto_goTypes,
+		DependencyIndexes: file_controlplane_v1_policy_exception_proto_depIdxs,
+		EnumInfos:         file_controlplane_v1_policy_exception_proto_enumTypes,
+		MessageInfos:      file_controlplane_v1_policy_exception_proto_msgTypes,
+	}.Build()
+	File_controlplane_v1_policy_exception_proto = out.File
+	file_controlplane_v1_policy_exception_proto_goTypes = nil
+	file_controlplane_v1_policy_exception_proto_depIdxs = nil
+}
@@ -0,0 +1,244 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: controlplane/v1/policy_exception.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	PolicyExceptionService_Create_FullMethodName  = "/controlplane.v1.PolicyExceptionService/Create"
+	PolicyExceptionService_Approve_FullMethodName = "/controlplane.v1.PolicyExceptionService/Approve"
+	PolicyExceptionService_Revoke_FullMethodName  = "/controlplane.v1.PolicyExceptionService/Revoke"
+	PolicyExceptionService_List_FullMethodName    = "/controlplane.v1.PolicyExceptionService/List"
+)
+
+// PolicyExceptionServiceClient is the client API for PolicyExceptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PolicyExceptionServiceClient interface {
+	// Request an exception, it takes effect once approved
+	Create(ctx context.Context, in *PolicyExceptionServiceCreateRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceCreateResponse, error)
+	// Approve a pending exception, it can't be approved by the user that requested it
+	Approve(ctx context.Context, in *PolicyExceptionServiceApproveRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceApproveResponse, error)
+	// Revoke an exception before it expires
+	Revoke(ctx context.Context, in *PolicyExceptionServiceRevokeRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceRevokeResponse, error)
+	// List the exceptions, most recent first
+	List(ctx context.Context, in *PolicyExceptionServiceListRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceListResponse, error)
+}
+
+type policyExceptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPolicyExceptionServiceClient(cc grpc.ClientConnInterface) PolicyExceptionServiceClient {
+	return &policyExceptionServiceClient{cc}
+}
+
+func (c *policyExceptionServiceClient) Create(ctx context.Context, in *PolicyExceptionServiceCreateRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceCreateResponse, error) {
+	out := new(PolicyExceptionServiceCreateResponse)
+	err := c.cc.Invoke(ctx, PolicyExceptionService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyExceptionServiceClient) Approve(ctx context.Context, in *PolicyExceptionServiceApproveRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceApproveResponse, error) {
+	out := new(PolicyExceptionServiceApproveResponse)
+	err := c.cc.Invoke(ctx, PolicyExceptionService_Approve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyExceptionServiceClient) Revoke(ctx context.Context, in *PolicyExceptionServiceRevokeRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceRevokeResponse, error) {
+	out := new(PolicyExceptionServiceRevokeResponse)
+	err := c.cc.Invoke(ctx, PolicyExceptionService_Revoke_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyExceptionServiceClient) List(ctx context.Context, in *PolicyExceptionServiceListRequest, opts ...grpc.CallOption) (*PolicyExceptionServiceListResponse, error) {
+	out := new(PolicyExceptionServiceListResponse)
+	err := c.cc.Invoke(ctx, PolicyExceptionService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PolicyExceptionServiceServer is the server API for PolicyExceptionService service.
+// All implementations must embed UnimplementedPolicyExceptionServiceServer
+// for forward compatibility
+type PolicyExceptionServiceServer interface {
+	// Request an exception, it takes effect once approved
+	Create(context.Context, *PolicyExceptionServiceCreateRequest) (*PolicyExceptionServiceCreateResponse, error)
+	// Approve a pending exception, it can't be approved by the user that requested it
+	Approve(context.Context, *PolicyExceptionServiceApproveRequest) (*PolicyExceptionServiceApproveResponse, error)
+	// Revoke an exception before it expires
+	Revoke(context.Context, *PolicyExceptionServiceRevokeRequest) (*PolicyExceptionServiceRevokeResponse, error)
+	// List the exceptions, most recent first
+	List(context.Context, *PolicyExceptionServiceListRequest) (*PolicyExceptionServiceListResponse, error)
+	mustEmbedUnimplementedPolicyExceptionServiceServer()
+}
+
+// UnimplementedPolicyExceptionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPolicyExceptionServiceServer struct {
+}
+
+func (UnimplementedPolicyExceptionServiceServer) Create(context.Context, *PolicyExceptionServiceCreateRequest) (*PolicyExceptionServiceCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPolicyExceptionServiceServer) Approve(context.Context, *PolicyExceptionServiceApproveRequest) (*PolicyExceptionServiceApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedPolicyExceptionServiceServer) Revoke(context.Context, *PolicyExceptionServiceRevokeRequest) (*PolicyExceptionServiceRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
+}
+func (UnimplementedPolicyExceptionServiceServer) List(context.Context, *PolicyExceptionServiceListRequest) (*PolicyExceptionServiceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPolicyExceptionServiceServer) mustEmbedUnimplementedPolicyExceptionServiceServer() {
+}
+
+// UnsafePolicyExceptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PolicyExceptionServiceServer will
+// result in compilation errors.
+type UnsafePolicyExceptionServiceServer interface {
+	mustEmbedUnimplementedPolicyExceptionServiceServer()
+}
+
+func RegisterPolicyExceptionServiceServer(s grpc.ServiceRegistrar, srv PolicyExceptionServiceServer) {
+	s.RegisterService(&PolicyExceptionService_ServiceDesc, srv)
+}
+
+func _PolicyExceptionService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyExceptionServiceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyExceptionServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyExceptionService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyExceptionServiceServer).Create(ctx, req.(*PolicyExceptionServiceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyExceptionService_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyExceptionServiceApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyExceptionServiceServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyExceptionService_Approve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyExceptionServiceServer).Approve(ctx, req.(*PolicyExceptionServiceApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyExceptionService_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyExceptionServiceRevokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyExceptionServiceServer).Revoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyExceptionService_Revoke_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyExceptionServiceServer).Revoke(ctx, req.(*PolicyExceptionServiceRevokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyExceptionService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyExceptionServiceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyExceptionServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyExceptionService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyExceptionServiceServer).List(ctx, req.(*PolicyExceptionServiceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PolicyExceptionService_ServiceDesc is the grpc.ServiceDesc for PolicyExceptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PolicyExceptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "controlplane.v1.PolicyExceptionService",
+	HandlerType: (*PolicyExceptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PolicyExceptionService_Create_Handler,
+		},
+		{
+			MethodName: "Approve",
+			Handler:    _PolicyExceptionService_Approve_Handler,
+		},
+		{
+			MethodName: "Revoke",
+			Handler:    _PolicyExceptionService_Revoke_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PolicyExceptionService_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "controlplane/v1/policy_exception.proto",
+}
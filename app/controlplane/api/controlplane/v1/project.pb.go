@@ -38,6 +38,949 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ProjectItem represents a project in the organization
+type ProjectItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectItem) Reset() {
+	*x = ProjectItem{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectItem) ProtoMessage() {}
+
+func (x *ProjectItem) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectItem.ProtoReflect.Descriptor instead.
+func (*ProjectItem) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProjectItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProjectItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// ProjectServiceCreateRequest contains the information needed to create a project
+type ProjectServiceCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceCreateRequest) Reset() {
+	*x = ProjectServiceCreateRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceCreateRequest) ProtoMessage() {}
+
+func (x *ProjectServiceCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceCreateRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceCreateRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProjectServiceCreateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ProjectServiceCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *ProjectItem           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceCreateResponse) Reset() {
+	*x = ProjectServiceCreateResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceCreateResponse) ProtoMessage() {}
+
+func (x *ProjectServiceCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceCreateResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceCreateResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProjectServiceCreateResponse) GetResult() *ProjectItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ProjectServiceListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pagination parameters to limit and offset results
+	Pagination    *OffsetPaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceListRequest) Reset() {
+	*x = ProjectServiceListRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceListRequest) ProtoMessage() {}
+
+func (x *ProjectServiceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceListRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceListRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectServiceListRequest) GetPagination() *OffsetPaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ProjectServiceListResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Result []*ProjectItem         `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// Pagination information for the response
+	Pagination    *OffsetPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceListResponse) Reset() {
+	*x = ProjectServiceListResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceListResponse) ProtoMessage() {}
+
+func (x *ProjectServiceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceListResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceListResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProjectServiceListResponse) GetResult() []*ProjectItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ProjectServiceListResponse) GetPagination() *OffsetPaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ProjectServiceDescribeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProjectServiceDescribeRequest) Reset() {
+	*x = ProjectServiceDescribeRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceDescribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceDescribeRequest) ProtoMessage() {}
+
+func (x *ProjectServiceDescribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceDescribeRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceDescribeRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProjectServiceDescribeRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+type ProjectServiceDescribeResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Result *ProjectItem           `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// The version currently marked as the latest one, if any
+	LatestVersion *ProjectVersion `protobuf:"bytes,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceDescribeResponse) Reset() {
+	*x = ProjectServiceDescribeResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceDescribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceDescribeResponse) ProtoMessage() {}
+
+func (x *ProjectServiceDescribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceDescribeResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceDescribeResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProjectServiceDescribeResponse) GetResult() *ProjectItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ProjectServiceDescribeResponse) GetLatestVersion() *ProjectVersion {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return nil
+}
+
+type ProjectServiceDeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProjectServiceDeleteRequest) Reset() {
+	*x = ProjectServiceDeleteRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceDeleteRequest) ProtoMessage() {}
+
+func (x *ProjectServiceDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProjectServiceDeleteRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+type ProjectServiceDeleteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceDeleteResponse) Reset() {
+	*x = ProjectServiceDeleteResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceDeleteResponse) ProtoMessage() {}
+
+func (x *ProjectServiceDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{8}
+}
+
+type ProjectServiceListVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	// Pagination parameters to limit and offset results
+	Pagination    *OffsetPaginationRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceListVersionsRequest) Reset() {
+	*x = ProjectServiceListVersionsRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceListVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceListVersionsRequest) ProtoMessage() {}
+
+func (x *ProjectServiceListVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceListVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceListVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProjectServiceListVersionsRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+func (x *ProjectServiceListVersionsRequest) GetPagination() *OffsetPaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ProjectServiceListVersionsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Result []*ProjectVersion      `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	// Pagination information for the response
+	Pagination    *OffsetPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceListVersionsResponse) Reset() {
+	*x = ProjectServiceListVersionsResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceListVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceListVersionsResponse) ProtoMessage() {}
+
+func (x *ProjectServiceListVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceListVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceListVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProjectServiceListVersionsResponse) GetResult() []*ProjectVersion {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ProjectServiceListVersionsResponse) GetPagination() *OffsetPaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ProjectServiceDescribeVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	// The name of the version, i.e v1.2.0
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceDescribeVersionRequest) Reset() {
+	*x = ProjectServiceDescribeVersionRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceDescribeVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceDescribeVersionRequest) ProtoMessage() {}
+
+func (x *ProjectServiceDescribeVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceDescribeVersionRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceDescribeVersionRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProjectServiceDescribeVersionRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+func (x *ProjectServiceDescribeVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ProjectServiceDescribeVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *ProjectVersion        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceDescribeVersionResponse) Reset() {
+	*x = ProjectServiceDescribeVersionResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceDescribeVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceDescribeVersionResponse) ProtoMessage() {}
+
+func (x *ProjectServiceDescribeVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceDescribeVersionResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceDescribeVersionResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProjectServiceDescribeVersionResponse) GetResult() *ProjectVersion {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ProjectServiceCreateVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	// The name of the version, i.e v1.2.0
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Create the version directly as released instead of as a pre-release
+	Released      bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceCreateVersionRequest) Reset() {
+	*x = ProjectServiceCreateVersionRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceCreateVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceCreateVersionRequest) ProtoMessage() {}
+
+func (x *ProjectServiceCreateVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceCreateVersionRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceCreateVersionRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProjectServiceCreateVersionRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+func (x *ProjectServiceCreateVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ProjectServiceCreateVersionRequest) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+type ProjectServiceCreateVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *ProjectVersion        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceCreateVersionResponse) Reset() {
+	*x = ProjectServiceCreateVersionResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceCreateVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceCreateVersionResponse) ProtoMessage() {}
+
+func (x *ProjectServiceCreateVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceCreateVersionResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceCreateVersionResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProjectServiceCreateVersionResponse) GetResult() *ProjectVersion {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ProjectServicePromoteVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	// The name of the version, i.e v1.2.0
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServicePromoteVersionRequest) Reset() {
+	*x = ProjectServicePromoteVersionRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServicePromoteVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServicePromoteVersionRequest) ProtoMessage() {}
+
+func (x *ProjectServicePromoteVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServicePromoteVersionRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServicePromoteVersionRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProjectServicePromoteVersionRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+func (x *ProjectServicePromoteVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ProjectServicePromoteVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *ProjectVersion        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServicePromoteVersionResponse) Reset() {
+	*x = ProjectServicePromoteVersionResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServicePromoteVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServicePromoteVersionResponse) ProtoMessage() {}
+
+func (x *ProjectServicePromoteVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServicePromoteVersionResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServicePromoteVersionResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProjectServicePromoteVersionResponse) GetResult() *ProjectVersion {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type ProjectServiceUnreleaseVersionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IdentityReference is used to specify the project by either its ID or name
+	ProjectReference *IdentityReference `protobuf:"bytes,1,opt,name=project_reference,json=projectReference,proto3" json:"project_reference,omitempty"`
+	// The name of the version, i.e v1.2.0
+	Version       string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceUnreleaseVersionRequest) Reset() {
+	*x = ProjectServiceUnreleaseVersionRequest{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceUnreleaseVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceUnreleaseVersionRequest) ProtoMessage() {}
+
+func (x *ProjectServiceUnreleaseVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceUnreleaseVersionRequest.ProtoReflect.Descriptor instead.
+func (*ProjectServiceUnreleaseVersionRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProjectServiceUnreleaseVersionRequest) GetProjectReference() *IdentityReference {
+	if x != nil {
+		return x.ProjectReference
+	}
+	return nil
+}
+
+func (x *ProjectServiceUnreleaseVersionRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ProjectServiceUnreleaseVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *ProjectVersion        `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectServiceUnreleaseVersionResponse) Reset() {
+	*x = ProjectServiceUnreleaseVersionResponse{}
+	mi := &file_controlplane_v1_project_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectServiceUnreleaseVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectServiceUnreleaseVersionResponse) ProtoMessage() {}
+
+func (x *ProjectServiceUnreleaseVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_project_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectServiceUnreleaseVersionResponse.ProtoReflect.Descriptor instead.
+func (*ProjectServiceUnreleaseVersionResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ProjectServiceUnreleaseVersionResponse) GetResult() *ProjectVersion {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 // ProjectServiceListMembersRequest contains the information needed to list members of a project
 type ProjectServiceListMembersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -51,7 +994,7 @@ type ProjectServiceListMembersRequest struct {
 
 func (x *ProjectServiceListMembersRequest) Reset() {
 	*x = ProjectServiceListMembersRequest{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[0]
+	mi := &file_controlplane_v1_project_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -63,7 +1006,7 @@ func (x *ProjectServiceListMembersRequest) String() string {
 func (*ProjectServiceListMembersRequest) ProtoMessage() {}
 
 func (x *ProjectServiceListMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[0]
+	mi := &file_controlplane_v1_project_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -76,7 +1019,7 @@ func (x *ProjectServiceListMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectServiceListMembersRequest.ProtoReflect.Descriptor instead.
 func (*ProjectServiceListMembersRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{0}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ProjectServiceListMembersRequest) GetProjectReference() *IdentityReference {
@@ -106,7 +1049,7 @@ type ProjectServiceListMembersResponse struct {
 
 func (x *ProjectServiceListMembersResponse) Reset() {
 	*x = ProjectServiceListMembersResponse{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[1]
+	mi := &file_controlplane_v1_project_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -118,7 +1061,7 @@ func (x *ProjectServiceListMembersResponse) String() string {
 func (*ProjectServiceListMembersResponse) ProtoMessage() {}
 
 func (x *ProjectServiceListMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[1]
+	mi := &file_controlplane_v1_project_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -131,7 +1074,7 @@ func (x *ProjectServiceListMembersResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProjectServiceListMembersResponse.ProtoReflect.Descriptor instead.
 func (*ProjectServiceListMembersResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{1}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ProjectServiceListMembersResponse) GetMembers() []*ProjectMember {
@@ -174,7 +1117,7 @@ type ProjectMember struct {
 
 func (x *ProjectMember) Reset() {
 	*x = ProjectMember{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[2]
+	mi := &file_controlplane_v1_project_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -186,7 +1129,7 @@ func (x *ProjectMember) String() string {
 func (*ProjectMember) ProtoMessage() {}
 
 func (x *ProjectMember) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[2]
+	mi := &file_controlplane_v1_project_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,7 +1142,7 @@ func (x *ProjectMember) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectMember.ProtoReflect.Descriptor instead.
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{2}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ProjectMember) GetSubject() isProjectMember_Subject {
@@ -295,7 +1238,7 @@ type ProjectServiceAddMemberRequest struct {
 
 func (x *ProjectServiceAddMemberRequest) Reset() {
 	*x = ProjectServiceAddMemberRequest{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[3]
+	mi := &file_controlplane_v1_project_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -307,7 +1250,7 @@ func (x *ProjectServiceAddMemberRequest) String() string {
 func (*ProjectServiceAddMemberRequest) ProtoMessage() {}
 
 func (x *ProjectServiceAddMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[3]
+	mi := &file_controlplane_v1_project_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +1263,7 @@ func (x *ProjectServiceAddMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectServiceAddMemberRequest.ProtoReflect.Descriptor instead.
 func (*ProjectServiceAddMemberRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{3}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ProjectServiceAddMemberRequest) GetProjectReference() *IdentityReference {
@@ -353,7 +1296,7 @@ type ProjectServiceAddMemberResponse struct {
 
 func (x *ProjectServiceAddMemberResponse) Reset() {
 	*x = ProjectServiceAddMemberResponse{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[4]
+	mi := &file_controlplane_v1_project_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +1308,7 @@ func (x *ProjectServiceAddMemberResponse) String() string {
 func (*ProjectServiceAddMemberResponse) ProtoMessage() {}
 
 func (x *ProjectServiceAddMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[4]
+	mi := &file_controlplane_v1_project_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +1321,7 @@ func (x *ProjectServiceAddMemberResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectServiceAddMemberResponse.ProtoReflect.Descriptor instead.
 func (*ProjectServiceAddMemberResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{4}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{23}
 }
 
 type ProjectServiceRemoveMemberRequest struct {
@@ -393,7 +1336,7 @@ type ProjectServiceRemoveMemberRequest struct {
 
 func (x *ProjectServiceRemoveMemberRequest) Reset() {
 	*x = ProjectServiceRemoveMemberRequest{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[5]
+	mi := &file_controlplane_v1_project_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -405,7 +1348,7 @@ func (x *ProjectServiceRemoveMemberRequest) String() string {
 func (*ProjectServiceRemoveMemberRequest) ProtoMessage() {}
 
 func (x *ProjectServiceRemoveMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[5]
+	mi := &file_controlplane_v1_project_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -418,7 +1361,7 @@ func (x *ProjectServiceRemoveMemberRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProjectServiceRemoveMemberRequest.ProtoReflect.Descriptor instead.
 func (*ProjectServiceRemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{5}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ProjectServiceRemoveMemberRequest) GetProjectReference() *IdentityReference {
@@ -444,7 +1387,7 @@ type ProjectServiceRemoveMemberResponse struct {
 
 func (x *ProjectServiceRemoveMemberResponse) Reset() {
 	*x = ProjectServiceRemoveMemberResponse{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[6]
+	mi := &file_controlplane_v1_project_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -456,7 +1399,7 @@ func (x *ProjectServiceRemoveMemberResponse) String() string {
 func (*ProjectServiceRemoveMemberResponse) ProtoMessage() {}
 
 func (x *ProjectServiceRemoveMemberResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[6]
+	mi := &file_controlplane_v1_project_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +1412,7 @@ func (x *ProjectServiceRemoveMemberResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ProjectServiceRemoveMemberResponse.ProtoReflect.Descriptor instead.
 func (*ProjectServiceRemoveMemberResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{6}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{25}
 }
 
 // ProjectMembershipReference is used to reference a user or group in the context of project membership
@@ -488,7 +1431,7 @@ type ProjectMembershipReference struct {
 
 func (x *ProjectMembershipReference) Reset() {
 	*x = ProjectMembershipReference{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[7]
+	mi := &file_controlplane_v1_project_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +1443,7 @@ func (x *ProjectMembershipReference) String() string {
 func (*ProjectMembershipReference) ProtoMessage() {}
 
 func (x *ProjectMembershipReference) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[7]
+	mi := &file_controlplane_v1_project_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +1456,7 @@ func (x *ProjectMembershipReference) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectMembershipReference.ProtoReflect.Descriptor instead.
 func (*ProjectMembershipReference) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{7}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ProjectMembershipReference) GetMembershipReference() isProjectMembershipReference_MembershipReference {
@@ -575,7 +1518,7 @@ type ProjectServiceUpdateMemberRoleRequest struct {
 
 func (x *ProjectServiceUpdateMemberRoleRequest) Reset() {
 	*x = ProjectServiceUpdateMemberRoleRequest{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[8]
+	mi := &file_controlplane_v1_project_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -587,7 +1530,7 @@ func (x *ProjectServiceUpdateMemberRoleRequest) String() string {
 func (*ProjectServiceUpdateMemberRoleRequest) ProtoMessage() {}
 
 func (x *ProjectServiceUpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[8]
+	mi := &file_controlplane_v1_project_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +1543,7 @@ func (x *ProjectServiceUpdateMemberRoleRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use ProjectServiceUpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
 func (*ProjectServiceUpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{8}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ProjectServiceUpdateMemberRoleRequest) GetProjectReference() *IdentityReference {
@@ -633,7 +1576,7 @@ type ProjectServiceUpdateMemberRoleResponse struct {
 
 func (x *ProjectServiceUpdateMemberRoleResponse) Reset() {
 	*x = ProjectServiceUpdateMemberRoleResponse{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[9]
+	mi := &file_controlplane_v1_project_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +1588,7 @@ func (x *ProjectServiceUpdateMemberRoleResponse) String() string {
 func (*ProjectServiceUpdateMemberRoleResponse) ProtoMessage() {}
 
 func (x *ProjectServiceUpdateMemberRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[9]
+	mi := &file_controlplane_v1_project_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +1601,7 @@ func (x *ProjectServiceUpdateMemberRoleResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ProjectServiceUpdateMemberRoleResponse.ProtoReflect.Descriptor instead.
 func (*ProjectServiceUpdateMemberRoleResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{9}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{28}
 }
 
 type ProjectServiceListPendingInvitationsRequest struct {
@@ -673,7 +1616,7 @@ type ProjectServiceListPendingInvitationsRequest struct {
 
 func (x *ProjectServiceListPendingInvitationsRequest) Reset() {
 	*x = ProjectServiceListPendingInvitationsRequest{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[10]
+	mi := &file_controlplane_v1_project_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +1628,7 @@ func (x *ProjectServiceListPendingInvitationsRequest) String() string {
 func (*ProjectServiceListPendingInvitationsRequest) ProtoMessage() {}
 
 func (x *ProjectServiceListPendingInvitationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[10]
+	mi := &file_controlplane_v1_project_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +1641,7 @@ func (x *ProjectServiceListPendingInvitationsRequest) ProtoReflect() protoreflec
 
 // Deprecated: Use ProjectServiceListPendingInvitationsRequest.ProtoReflect.Descriptor instead.
 func (*ProjectServiceListPendingInvitationsRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{10}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ProjectServiceListPendingInvitationsRequest) GetProjectReference() *IdentityReference {
@@ -728,7 +1671,7 @@ type ProjectServiceListPendingInvitationsResponse struct {
 
 func (x *ProjectServiceListPendingInvitationsResponse) Reset() {
 	*x = ProjectServiceListPendingInvitationsResponse{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[11]
+	mi := &file_controlplane_v1_project_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +1683,7 @@ func (x *ProjectServiceListPendingInvitationsResponse) String() string {
 func (*ProjectServiceListPendingInvitationsResponse) ProtoMessage() {}
 
 func (x *ProjectServiceListPendingInvitationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[11]
+	mi := &file_controlplane_v1_project_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1696,7 @@ func (x *ProjectServiceListPendingInvitationsResponse) ProtoReflect() protorefle
 
 // Deprecated: Use ProjectServiceListPendingInvitationsResponse.ProtoReflect.Descriptor instead.
 func (*ProjectServiceListPendingInvitationsResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{11}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ProjectServiceListPendingInvitationsResponse) GetInvitations() []*PendingProjectInvitation {
@@ -787,7 +1730,7 @@ type PendingProjectInvitation struct {
 
 func (x *PendingProjectInvitation) Reset() {
 	*x = PendingProjectInvitation{}
-	mi := &file_controlplane_v1_project_proto_msgTypes[12]
+	mi := &file_controlplane_v1_project_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -799,7 +1742,7 @@ func (x *PendingProjectInvitation) String() string {
 func (*PendingProjectInvitation) ProtoMessage() {}
 
 func (x *PendingProjectInvitation) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_project_proto_msgTypes[12]
+	mi := &file_controlplane_v1_project_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1755,7 @@ func (x *PendingProjectInvitation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PendingProjectInvitation.ProtoReflect.Descriptor instead.
 func (*PendingProjectInvitation) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{12}
+	return file_controlplane_v1_project_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *PendingProjectInvitation) GetUserEmail() string {
@@ -847,7 +1790,67 @@ var File_controlplane_v1_project_proto protoreflect.FileDescriptor
 
 const file_controlplane_v1_project_proto_rawDesc = "" +
 	"\n" +
-	"\x1dcontrolplane/v1/project.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bcontrolplane/v1/group.proto\x1a controlplane/v1/pagination.proto\x1a'controlplane/v1/response_messages.proto\x1a$controlplane/v1/shared_message.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x01\n" +
+	"\x1dcontrolplane/v1/project.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1bcontrolplane/v1/group.proto\x1a controlplane/v1/pagination.proto\x1a'controlplane/v1/response_messages.proto\x1a$controlplane/v1/shared_message.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa7\x01\n" +
+	"\vProjectItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb7\x01\n" +
+	"\x1bProjectServiceCreateRequest\x12\x97\x01\n" +
+	"\x04name\x18\x01 \x01(\tB\x82\x01\xbaH\x7f\xba\x01|\n" +
+	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x04name\"T\n" +
+	"\x1cProjectServiceCreateResponse\x124\n" +
+	"\x06result\x18\x01 \x01(\v2\x1c.controlplane.v1.ProjectItemR\x06result\"e\n" +
+	"\x19ProjectServiceListRequest\x12H\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2(.controlplane.v1.OffsetPaginationRequestR\n" +
+	"pagination\"\x9d\x01\n" +
+	"\x1aProjectServiceListResponse\x124\n" +
+	"\x06result\x18\x01 \x03(\v2\x1c.controlplane.v1.ProjectItemR\x06result\x12I\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2).controlplane.v1.OffsetPaginationResponseR\n" +
+	"pagination\"x\n" +
+	"\x1dProjectServiceDescribeRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\"\x9e\x01\n" +
+	"\x1eProjectServiceDescribeResponse\x124\n" +
+	"\x06result\x18\x01 \x01(\v2\x1c.controlplane.v1.ProjectItemR\x06result\x12F\n" +
+	"\x0elatest_version\x18\x02 \x01(\v2\x1f.controlplane.v1.ProjectVersionR\rlatestVersion\"v\n" +
+	"\x1bProjectServiceDeleteRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\"\x1e\n" +
+	"\x1cProjectServiceDeleteResponse\"\xc6\x01\n" +
+	"!ProjectServiceListVersionsRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\x12H\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2(.controlplane.v1.OffsetPaginationRequestR\n" +
+	"pagination\"\xa8\x01\n" +
+	"\"ProjectServiceListVersionsResponse\x127\n" +
+	"\x06result\x18\x01 \x03(\v2\x1f.controlplane.v1.ProjectVersionR\x06result\x12I\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2).controlplane.v1.OffsetPaginationResponseR\n" +
+	"pagination\"\xa2\x01\n" +
+	"$ProjectServiceDescribeVersionRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\x12!\n" +
+	"\aversion\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aversion\"`\n" +
+	"%ProjectServiceDescribeVersionResponse\x127\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.ProjectVersionR\x06result\"\xbc\x01\n" +
+	"\"ProjectServiceCreateVersionRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\x12!\n" +
+	"\aversion\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aversion\x12\x1a\n" +
+	"\breleased\x18\x03 \x01(\bR\breleased\"^\n" +
+	"#ProjectServiceCreateVersionResponse\x127\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.ProjectVersionR\x06result\"\xa1\x01\n" +
+	"#ProjectServicePromoteVersionRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\x12!\n" +
+	"\aversion\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aversion\"_\n" +
+	"$ProjectServicePromoteVersionResponse\x127\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.ProjectVersionR\x06result\"\xa3\x01\n" +
+	"%ProjectServiceUnreleaseVersionRequest\x12W\n" +
+	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\x12!\n" +
+	"\aversion\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aversion\"a\n" +
+	"&ProjectServiceUnreleaseVersionResponse\x127\n" +
+	"\x06result\x18\x01 \x01(\v2\x1f.controlplane.v1.ProjectVersionR\x06result\"\xc5\x01\n" +
 	" ProjectServiceListMembersRequest\x12W\n" +
 	"\x11project_reference\x18\x01 \x01(\v2\".controlplane.v1.IdentityReferenceB\x06\xbaH\x03\xc8\x01\x01R\x10projectReference\x12H\n" +
 	"\n" +
@@ -909,8 +1912,17 @@ const file_controlplane_v1_project_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
 	"\rinvitation_id\x18\x04 \x01(\tR\finvitationIdB\r\n" +
-	"\v_invited_by2\x8f\x05\n" +
-	"\x0eProjectService\x12t\n" +
+	"\v_invited_by2\xa8\r\n" +
+	"\x0eProjectService\x12e\n" +
+	"\x06Create\x12,.controlplane.v1.ProjectServiceCreateRequest\x1a-.controlplane.v1.ProjectServiceCreateResponse\x12_\n" +
+	"\x04List\x12*.controlplane.v1.ProjectServiceListRequest\x1a+.controlplane.v1.ProjectServiceListResponse\x12k\n" +
+	"\bDescribe\x12..controlplane.v1.ProjectServiceDescribeRequest\x1a/.controlplane.v1.ProjectServiceDescribeResponse\x12e\n" +
+	"\x06Delete\x12,.controlplane.v1.ProjectServiceDeleteRequest\x1a-.controlplane.v1.ProjectServiceDeleteResponse\x12w\n" +
+	"\fListVersions\x122.controlplane.v1.ProjectServiceListVersionsRequest\x1a3.controlplane.v1.ProjectServiceListVersionsResponse\x12\x80\x01\n" +
+	"\x0fDescribeVersion\x125.controlplane.v1.ProjectServiceDescribeVersionRequest\x1a6.controlplane.v1.ProjectServiceDescribeVersionResponse\x12z\n" +
+	"\rCreateVersion\x123.controlplane.v1.ProjectServiceCreateVersionRequest\x1a4.controlplane.v1.ProjectServiceCreateVersionResponse\x12}\n" +
+	"\x0ePromoteVersion\x124.controlplane.v1.ProjectServicePromoteVersionRequest\x1a5.controlplane.v1.ProjectServicePromoteVersionResponse\x12\x83\x01\n" +
+	"\x10UnreleaseVersion\x126.controlplane.v1.ProjectServiceUnreleaseVersionRequest\x1a7.controlplane.v1.ProjectServiceUnreleaseVersionResponse\x12t\n" +
 	"\vListMembers\x121.controlplane.v1.ProjectServiceListMembersRequest\x1a2.controlplane.v1.ProjectServiceListMembersResponse\x12n\n" +
 	"\tAddMember\x12/.controlplane.v1.ProjectServiceAddMemberRequest\x1a0.controlplane.v1.ProjectServiceAddMemberResponse\x12w\n" +
 	"\fRemoveMember\x122.controlplane.v1.ProjectServiceRemoveMemberRequest\x1a3.controlplane.v1.ProjectServiceRemoveMemberResponse\x12\x83\x01\n" +
@@ -929,69 +1941,129 @@ func file_controlplane_v1_project_proto_rawDescGZIP() []byte {
 	return file_controlplane_v1_project_proto_rawDescData
 }
 
-var file_controlplane_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_controlplane_v1_project_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_controlplane_v1_project_proto_goTypes = []any{
-	(*ProjectServiceListMembersRequest)(nil),             // 0: controlplane.v1.ProjectServiceListMembersRequest
-	(*ProjectServiceListMembersResponse)(nil),            // 1: controlplane.v1.ProjectServiceListMembersResponse
-	(*ProjectMember)(nil),                                // 2: controlplane.v1.ProjectMember
-	(*ProjectServiceAddMemberRequest)(nil),               // 3: controlplane.v1.ProjectServiceAddMemberRequest
-	(*ProjectServiceAddMemberResponse)(nil),              // 4: controlplane.v1.ProjectServiceAddMemberResponse
-	(*ProjectServiceRemoveMemberRequest)(nil),            // 5: controlplane.v1.ProjectServiceRemoveMemberRequest
-	(*ProjectServiceRemoveMemberResponse)(nil),           // 6: controlplane.v1.ProjectServiceRemoveMemberResponse
-	(*ProjectMembershipReference)(nil),                   // 7: controlplane.v1.ProjectMembershipReference
-	(*ProjectServiceUpdateMemberRoleRequest)(nil),        // 8: controlplane.v1.ProjectServiceUpdateMemberRoleRequest
-	(*ProjectServiceUpdateMemberRoleResponse)(nil),       // 9: controlplane.v1.ProjectServiceUpdateMemberRoleResponse
-	(*ProjectServiceListPendingInvitationsRequest)(nil),  // 10: controlplane.v1.ProjectServiceListPendingInvitationsRequest
-	(*ProjectServiceListPendingInvitationsResponse)(nil), // 11: controlplane.v1.ProjectServiceListPendingInvitationsResponse
-	(*PendingProjectInvitation)(nil),                     // 12: controlplane.v1.PendingProjectInvitation
-	(*IdentityReference)(nil),                            // 13: controlplane.v1.IdentityReference
-	(*OffsetPaginationRequest)(nil),                      // 14: controlplane.v1.OffsetPaginationRequest
-	(*OffsetPaginationResponse)(nil),                     // 15: controlplane.v1.OffsetPaginationResponse
-	(*User)(nil),                                         // 16: controlplane.v1.User
-	(*Group)(nil),                                        // 17: controlplane.v1.Group
-	(ProjectMemberRole)(0),                               // 18: controlplane.v1.ProjectMemberRole
-	(*timestamppb.Timestamp)(nil),                        // 19: google.protobuf.Timestamp
+	(*ProjectItem)(nil),                                  // 0: controlplane.v1.ProjectItem
+	(*ProjectServiceCreateRequest)(nil),                  // 1: controlplane.v1.ProjectServiceCreateRequest
+	(*ProjectServiceCreateResponse)(nil),                 // 2: controlplane.v1.ProjectServiceCreateResponse
+	(*ProjectServiceListRequest)(nil),                    // 3: controlplane.v1.ProjectServiceListRequest
+	(*ProjectServiceListResponse)(nil),                   // 4: controlplane.v1.ProjectServiceListResponse
+	(*ProjectServiceDescribeRequest)(nil),                // 5: controlplane.v1.ProjectServiceDescribeRequest
+	(*ProjectServiceDescribeResponse)(nil),               // 6: controlplane.v1.ProjectServiceDescribeResponse
+	(*ProjectServiceDeleteRequest)(nil),                  // 7: controlplane.v1.ProjectServiceDeleteRequest
+	(*ProjectServiceDeleteResponse)(nil),                 // 8: controlplane.v1.ProjectServiceDeleteResponse
+	(*ProjectServiceListVersionsRequest)(nil),            // 9: controlplane.v1.ProjectServiceListVersionsRequest
+	(*ProjectServiceListVersionsResponse)(nil),           // 10: controlplane.v1.ProjectServiceListVersionsResponse
+	(*ProjectServiceDescribeVersionRequest)(nil),         // 11: controlplane.v1.ProjectServiceDescribeVersionRequest
+	(*ProjectServiceDescribeVersionResponse)(nil),        // 12: controlplane.v1.ProjectServiceDescribeVersionResponse
+	(*ProjectServiceCreateVersionRequest)(nil),           // 13: controlplane.v1.ProjectServiceCreateVersionRequest
+	(*ProjectServiceCreateVersionResponse)(nil),          // 14: controlplane.v1.ProjectServiceCreateVersionResponse
+	(*ProjectServicePromoteVersionRequest)(nil),          // 15: controlplane.v1.ProjectServicePromoteVersionRequest
+	(*ProjectServicePromoteVersionResponse)(nil),         // 16: controlplane.v1.ProjectServicePromoteVersionResponse
+	(*ProjectServiceUnreleaseVersionRequest)(nil),        // 17: controlplane.v1.ProjectServiceUnreleaseVersionRequest
+	(*ProjectServiceUnreleaseVersionResponse)(nil),       // 18: controlplane.v1.ProjectServiceUnreleaseVersionResponse
+	(*ProjectServiceListMembersRequest)(nil),             // 19: controlplane.v1.ProjectServiceListMembersRequest
+	(*ProjectServiceListMembersResponse)(nil),            // 20: controlplane.v1.ProjectServiceListMembersResponse
+	(*ProjectMember)(nil),                                // 21: controlplane.v1.ProjectMember
+	(*ProjectServiceAddMemberRequest)(nil),               // 22: controlplane.v1.ProjectServiceAddMemberRequest
+	(*ProjectServiceAddMemberResponse)(nil),              // 23: controlplane.v1.ProjectServiceAddMemberResponse
+	(*ProjectServiceRemoveMemberRequest)(nil),            // 24: controlplane.v1.ProjectServiceRemoveMemberRequest
+	(*ProjectServiceRemoveMemberResponse)(nil),           // 25: controlplane.v1.ProjectServiceRemoveMemberResponse
+	(*ProjectMembershipReference)(nil),                   // 26: controlplane.v1.ProjectMembershipReference
+	(*ProjectServiceUpdateMemberRoleRequest)(nil),        // 27: controlplane.v1.ProjectServiceUpdateMemberRoleRequest
+	(*ProjectServiceUpdateMemberRoleResponse)(nil),       // 28: controlplane.v1.ProjectServiceUpdateMemberRoleResponse
+	(*ProjectServiceListPendingInvitationsRequest)(nil),  // 29: controlplane.v1.ProjectServiceListPendingInvitationsRequest
+	(*ProjectServiceListPendingInvitationsResponse)(nil), // 30: controlplane.v1.ProjectServiceListPendingInvitationsResponse
+	(*PendingProjectInvitation)(nil),                     // 31: controlplane.v1.PendingProjectInvitation
+	(*timestamppb.Timestamp)(nil),                        // 32: google.protobuf.Timestamp
+	(*OffsetPaginationRequest)(nil),                      // 33: controlplane.v1.OffsetPaginationRequest
+	(*OffsetPaginationResponse)(nil),                     // 34: controlplane.v1.OffsetPaginationResponse
+	(*IdentityReference)(nil),                            // 35: controlplane.v1.IdentityReference
+	(*ProjectVersion)(nil),                               // 36: controlplane.v1.ProjectVersion
+	(*User)(nil),                                         // 37: controlplane.v1.User
+	(*Group)(nil),                                        // 38: controlplane.v1.Group
+	(ProjectMemberRole)(0),                               // 39: controlplane.v1.ProjectMemberRole
 }
 var file_controlplane_v1_project_proto_depIdxs = []int32{
-	13, // 0: controlplane.v1.ProjectServiceListMembersRequest.project_reference:type_name -> controlplane.v1.IdentityReference
-	14, // 1: controlplane.v1.ProjectServiceListMembersRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
-	2,  // 2: controlplane.v1.ProjectServiceListMembersResponse.members:type_name -> controlplane.v1.ProjectMember
-	15, // 3: controlplane.v1.ProjectServiceListMembersResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
-	16, // 4: controlplane.v1.ProjectMember.user:type_name -> controlplane.v1.User
-	17, // 5: controlplane.v1.ProjectMember.group:type_name -> controlplane.v1.Group
-	18, // 6: controlplane.v1.ProjectMember.role:type_name -> controlplane.v1.ProjectMemberRole
-	19, // 7: controlplane.v1.ProjectMember.created_at:type_name -> google.protobuf.Timestamp
-	19, // 8: controlplane.v1.ProjectMember.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 9: controlplane.v1.ProjectServiceAddMemberRequest.project_reference:type_name -> controlplane.v1.IdentityReference
-	7,  // 10: controlplane.v1.ProjectServiceAddMemberRequest.member_reference:type_name -> controlplane.v1.ProjectMembershipReference
-	18, // 11: controlplane.v1.ProjectServiceAddMemberRequest.role:type_name -> controlplane.v1.ProjectMemberRole
-	13, // 12: controlplane.v1.ProjectServiceRemoveMemberRequest.project_reference:type_name -> controlplane.v1.IdentityReference
-	7,  // 13: controlplane.v1.ProjectServiceRemoveMemberRequest.member_reference:type_name -> controlplane.v1.ProjectMembershipReference
-	13, // 14: controlplane.v1.ProjectMembershipReference.group_reference:type_name -> controlplane.v1.IdentityReference
-	13, // 15: controlplane.v1.ProjectServiceUpdateMemberRoleRequest.project_reference:type_name -> controlplane.v1.IdentityReference
-	7,  // 16: controlplane.v1.ProjectServiceUpdateMemberRoleRequest.member_reference:type_name -> controlplane.v1.ProjectMembershipReference
-	18, // 17: controlplane.v1.ProjectServiceUpdateMemberRoleRequest.new_role:type_name -> controlplane.v1.ProjectMemberRole
-	13, // 18: controlplane.v1.ProjectServiceListPendingInvitationsRequest.project_reference:type_name -> controlplane.v1.IdentityReference
-	14, // 19: controlplane.v1.ProjectServiceListPendingInvitationsRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
-	12, // 20: controlplane.v1.ProjectServiceListPendingInvitationsResponse.invitations:type_name -> controlplane.v1.PendingProjectInvitation
-	15, // 21: controlplane.v1.ProjectServiceListPendingInvitationsResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
-	16, // 22: controlplane.v1.PendingProjectInvitation.invited_by:type_name -> controlplane.v1.User
-	19, // 23: controlplane.v1.PendingProjectInvitation.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 24: controlplane.v1.ProjectService.ListMembers:input_type -> controlplane.v1.ProjectServiceListMembersRequest
-	3,  // 25: controlplane.v1.ProjectService.AddMember:input_type -> controlplane.v1.ProjectServiceAddMemberRequest
-	5,  // 26: controlplane.v1.ProjectService.RemoveMember:input_type -> controlplane.v1.ProjectServiceRemoveMemberRequest
-	8,  // 27: controlplane.v1.ProjectService.UpdateMemberRole:input_type -> controlplane.v1.ProjectServiceUpdateMemberRoleRequest
-	10, // 28: controlplane.v1.ProjectService.ListPendingInvitations:input_type -> controlplane.v1.ProjectServiceListPendingInvitationsRequest
-	1,  // 29: controlplane.v1.ProjectService.ListMembers:output_type -> controlplane.v1.ProjectServiceListMembersResponse
-	4,  // 30: controlplane.v1.ProjectService.AddMember:output_type -> controlplane.v1.ProjectServiceAddMemberResponse
-	6,  // 31: controlplane.v1.ProjectService.RemoveMember:output_type -> controlplane.v1.ProjectServiceRemoveMemberResponse
-	9,  // 32: controlplane.v1.ProjectService.UpdateMemberRole:output_type -> controlplane.v1.ProjectServiceUpdateMemberRoleResponse
-	11, // 33: controlplane.v1.ProjectService.ListPendingInvitations:output_type -> controlplane.v1.ProjectServiceListPendingInvitationsResponse
-	29, // [29:34] is the sub-list for method output_type
-	24, // [24:29] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	32, // 0: controlplane.v1.ProjectItem.created_at:type_name -> google.protobuf.Timestamp
+	32, // 1: controlplane.v1.ProjectItem.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 2: controlplane.v1.ProjectServiceCreateResponse.result:type_name -> controlplane.v1.ProjectItem
+	33, // 3: controlplane.v1.ProjectServiceListRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
+	0,  // 4: controlplane.v1.ProjectServiceListResponse.result:type_name -> controlplane.v1.ProjectItem
+	34, // 5: controlplane.v1.ProjectServiceListResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
+	35, // 6: controlplane.v1.ProjectServiceDescribeRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	0,  // 7: controlplane.v1.ProjectServiceDescribeResponse.result:type_name -> controlplane.v1.ProjectItem
+	36, // 8: controlplane.v1.ProjectServiceDescribeResponse.latest_version:type_name -> controlplane.v1.ProjectVersion
+	35, // 9: controlplane.v1.ProjectServiceDeleteRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	35, // 10: controlplane.v1.ProjectServiceListVersionsRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	33, // 11: controlplane.v1.ProjectServiceListVersionsRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
+	36, // 12: controlplane.v1.ProjectServiceListVersionsResponse.result:type_name -> controlplane.v1.ProjectVersion
+	34, // 13: controlplane.v1.ProjectServiceListVersionsResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
+	35, // 14: controlplane.v1.ProjectServiceDescribeVersionRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	36, // 15: controlplane.v1.ProjectServiceDescribeVersionResponse.result:type_name -> controlplane.v1.ProjectVersion
+	35, // 16: controlplane.v1.ProjectServiceCreateVersionRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	36, // 17: controlplane.v1.ProjectServiceCreateVersionResponse.result:type_name -> controlplane.v1.ProjectVersion
+	35, // 18: controlplane.v1.ProjectServicePromoteVersionRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	36, // 19: controlplane.v1.ProjectServicePromoteVersionResponse.result:type_name -> controlplane.v1.ProjectVersion
+	35, // 20: controlplane.v1.ProjectServiceUnreleaseVersionRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	36, // 21: controlplane.v1.ProjectServiceUnreleaseVersionResponse.result:type_name -> controlplane.v1.ProjectVersion
+	35, // 22: controlplane.v1.ProjectServiceListMembersRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	33, // 23: controlplane.v1.ProjectServiceListMembersRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
+	21, // 24: controlplane.v1.ProjectServiceListMembersResponse.members:type_name -> controlplane.v1.ProjectMember
+	34, // 25: controlplane.v1.ProjectServiceListMembersResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
+	37, // 26: controlplane.v1.ProjectMember.user:type_name -> controlplane.v1.User
+	38, // 27: controlplane.v1.ProjectMember.group:type_name -> controlplane.v1.Group
+	39, // 28: controlplane.v1.ProjectMember.role:type_name -> controlplane.v1.ProjectMemberRole
+	32, // 29: controlplane.v1.ProjectMember.created_at:type_name -> google.protobuf.Timestamp
+	32, // 30: controlplane.v1.ProjectMember.updated_at:type_name -> google.protobuf.Timestamp
+	35, // 31: controlplane.v1.ProjectServiceAddMemberRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	26, // 32: controlplane.v1.ProjectServiceAddMemberRequest.member_reference:type_name -> controlplane.v1.ProjectMembershipReference
+	39, // 33: controlplane.v1.ProjectServiceAddMemberRequest.role:type_name -> controlplane.v1.ProjectMemberRole
+	35, // 34: controlplane.v1.ProjectServiceRemoveMemberRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	26, // 35: controlplane.v1.ProjectServiceRemoveMemberRequest.member_reference:type_name -> controlplane.v1.ProjectMembershipReference
+	35, // 36: controlplane.v1.ProjectMembershipReference.group_reference:type_name -> controlplane.v1.IdentityReference
+	35, // 37: controlplane.v1.ProjectServiceUpdateMemberRoleRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	26, // 38: controlplane.v1.ProjectServiceUpdateMemberRoleRequest.member_reference:type_name -> controlplane.v1.ProjectMembershipReference
+	39, // 39: controlplane.v1.ProjectServiceUpdateMemberRoleRequest.new_role:type_name -> controlplane.v1.ProjectMemberRole
+	35, // 40: controlplane.v1.ProjectServiceListPendingInvitationsRequest.project_reference:type_name -> controlplane.v1.IdentityReference
+	33, // 41: controlplane.v1.ProjectServiceListPendingInvitationsRequest.pagination:type_name -> controlplane.v1.OffsetPaginationRequest
+	31, // 42: controlplane.v1.ProjectServiceListPendingInvitationsResponse.invitations:type_name -> controlplane.v1.PendingProjectInvitation
+	34, // 43: controlplane.v1.ProjectServiceListPendingInvitationsResponse.pagination:type_name -> controlplane.v1.OffsetPaginationResponse
+	37, // 44: controlplane.v1.PendingProjectInvitation.invited_by:type_name -> controlplane.v1.User
+	32, // 45: controlplane.v1.PendingProjectInvitation.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 46: controlplane.v1.ProjectService.Create:input_type -> controlplane.v1.ProjectServiceCreateRequest
+	3,  // 47: controlplane.v1.ProjectService.List:input_type -> controlplane.v1.ProjectServiceListRequest
+	5,  // 48: controlplane.v1.ProjectService.Describe:input_type -> controlplane.v1.ProjectServiceDescribeRequest
+	7,  // 49: controlplane.v1.ProjectService.Delete:input_type -> controlplane.v1.ProjectServiceDeleteRequest
+	9,  // 50: controlplane.v1.ProjectService.ListVersions:input_type -> controlplane.v1.ProjectServiceListVersionsRequest
+	11, // 51: controlplane.v1.ProjectService.DescribeVersion:input_type -> controlplane.v1.ProjectServiceDescribeVersionRequest
+	13, // 52: controlplane.v1.ProjectService.CreateVersion:input_type -> controlplane.v1.ProjectServiceCreateVersionRequest
+	15, // 53: controlplane.v1.ProjectService.PromoteVersion:input_type -> controlplane.v1.ProjectServicePromoteVersionRequest
+	17, // 54: controlplane.v1.ProjectService.UnreleaseVersion:input_type -> controlplane.v1.ProjectServiceUnreleaseVersionRequest
+	19, // 55: controlplane.v1.ProjectService.ListMembers:input_type -> controlplane.v1.ProjectServiceListMembersRequest
+	22, // 56: controlplane.v1.ProjectService.AddMember:input_type -> controlplane.v1.ProjectServiceAddMemberRequest
+	24, // 57: controlplane.v1.ProjectService.RemoveMember:input_type -> controlplane.v1.ProjectServiceRemoveMemberRequest
+	27, // 58: controlplane.v1.ProjectService.UpdateMemberRole:input_type -> controlplane.v1.ProjectServiceUpdateMemberRoleRequest
+	29, // 59: controlplane.v1.ProjectService.ListPendingInvitations:input_type -> controlplane.v1.ProjectServiceListPendingInvitationsRequest
+	2,  // 60: controlplane.v1.ProjectService.Create:output_type -> controlplane.v1.ProjectServiceCreateResponse
+	4,  // 61: controlplane.v1.ProjectService.List:output_type -> controlplane.v1.ProjectServiceListResponse
+	6,  // 62: controlplane.v1.ProjectService.Describe:output_type -> controlplane.v1.ProjectServiceDescribeResponse
+	8,  // 63: controlplane.v1.ProjectService.Delete:output_type -> controlplane.v1.ProjectServiceDeleteResponse
+	10, // 64: controlplane.v1.ProjectService.ListVersions:output_type -> controlplane.v1.ProjectServiceListVersionsResponse
+	12, // 65: controlplane.v1.ProjectService.DescribeVersion:output_type -> controlplane.v1.ProjectServiceDescribeVersionResponse
+	14, // 66: controlplane.v1.ProjectService.CreateVersion:output_type -> controlplane.v1.ProjectServiceCreateVersionResponse
+	16, // 67: controlplane.v1.ProjectService.PromoteVersion:output_type -> controlplane.v1.ProjectServicePromoteVersionResponse
+	18, // 68: controlplane.v1.ProjectService.UnreleaseVersion:output_type -> controlplane.v1.ProjectServiceUnreleaseVersionResponse
+	20, // 69: controlplane.v1.ProjectService.ListMembers:output_type -> controlplane.v1.ProjectServiceListMembersResponse
+	23, // 70: controlplane.v1.ProjectService.AddMember:output_type -> controlplane.v1.ProjectServiceAddMemberResponse
+	25, // 71: controlplane.v1.ProjectService.RemoveMember:output_type -> controlplane.v1.ProjectServiceRemoveMemberResponse
+	28, // 72: controlplane.v1.ProjectService.UpdateMemberRole:output_type -> controlplane.v1.ProjectServiceUpdateMemberRoleResponse
+	30, // 73: controlplane.v1.ProjectService.ListPendingInvitations:output_type -> controlplane.v1.ProjectServiceListPendingInvitationsResponse
+	60, // [60:74] is the sub-list for method output_type
+	46, // [46:60] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_controlplane_v1_project_proto_init() }
@@ -1003,22 +2075,22 @@ func file_controlplane_v1_project_proto_init() {
 	file_controlplane_v1_pagination_proto_init()
 	file_controlplane_v1_response_messages_proto_init()
 	file_controlplane_v1_shared_message_proto_init()
-	file_controlplane_v1_project_proto_msgTypes[2].OneofWrappers = []any{
+	file_controlplane_v1_project_proto_msgTypes[21].OneofWrappers = []any{
 		(*ProjectMember_User)(nil),
 		(*ProjectMember_Group)(nil),
 	}
-	file_controlplane_v1_project_proto_msgTypes[7].OneofWrappers = []any{
+	file_controlplane_v1_project_proto_msgTypes[26].OneofWrappers = []any{
 		(*ProjectMembershipReference_UserEmail)(nil),
 		(*ProjectMembershipReference_GroupReference)(nil),
 	}
-	file_controlplane_v1_project_proto_msgTypes[12].OneofWrappers = []any{}
+	file_controlplane_v1_project_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_v1_project_proto_rawDesc), len(file_controlplane_v1_project_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,6 +34,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
+	ProjectService_Create_FullMethodName                 = "/controlplane.v1.ProjectService/Create"
+	ProjectService_List_FullMethodName                   = "/controlplane.v1.ProjectService/List"
+	ProjectService_Describe_FullMethodName               = "/controlplane.v1.ProjectService/Describe"
+	ProjectService_Delete_FullMethodName                 = "/controlplane.v1.ProjectService/Delete"
+	ProjectService_ListVersions_FullMethodName           = "/controlplane.v1.ProjectService/ListVersions"
+	ProjectService_DescribeVersion_FullMethodName        = "/controlplane.v1.ProjectService/DescribeVersion"
+	ProjectService_CreateVersion_FullMethodName          = "/controlplane.v1.ProjectService/CreateVersion"
+	ProjectService_PromoteVersion_FullMethodName         = "/controlplane.v1.ProjectService/PromoteVersion"
+	ProjectService_UnreleaseVersion_FullMethodName       = "/controlplane.v1.ProjectService/UnreleaseVersion"
 	ProjectService_ListMembers_FullMethodName            = "/controlplane.v1.ProjectService/ListMembers"
 	ProjectService_AddMember_FullMethodName              = "/controlplane.v1.ProjectService/AddMember"
 	ProjectService_RemoveMember_FullMethodName           = "/controlplane.v1.ProjectService/RemoveMember"
@@ -45,6 +54,19 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProjectServiceClient interface {
+	// Project management
+	Create(ctx context.Context, in *ProjectServiceCreateRequest, opts ...grpc.CallOption) (*ProjectServiceCreateResponse, error)
+	List(ctx context.Context, in *ProjectServiceListRequest, opts ...grpc.CallOption) (*ProjectServiceListResponse, error)
+	Describe(ctx context.Context, in *ProjectServiceDescribeRequest, opts ...grpc.CallOption) (*ProjectServiceDescribeResponse, error)
+	Delete(ctx context.Context, in *ProjectServiceDeleteRequest, opts ...grpc.CallOption) (*ProjectServiceDeleteResponse, error)
+	// Project version management
+	ListVersions(ctx context.Context, in *ProjectServiceListVersionsRequest, opts ...grpc.CallOption) (*ProjectServiceListVersionsResponse, error)
+	DescribeVersion(ctx context.Context, in *ProjectServiceDescribeVersionRequest, opts ...grpc.CallOption) (*ProjectServiceDescribeVersionResponse, error)
+	CreateVersion(ctx context.Context, in *ProjectServiceCreateVersionRequest, opts ...grpc.CallOption) (*ProjectServiceCreateVersionResponse, error)
+	// PromoteVersion marks a pre-release version as released
+	PromoteVersion(ctx context.Context, in *ProjectServicePromoteVersionRequest, opts ...grpc.CallOption) (*ProjectServicePromoteVersionResponse, error)
+	// UnreleaseVersion moves a released version back to pre-release
+	UnreleaseVersion(ctx context.Context, in *ProjectServiceUnreleaseVersionRequest, opts ...grpc.CallOption) (*ProjectServiceUnreleaseVersionResponse, error)
 	// Project membership management
 	ListMembers(ctx context.Context, in *ProjectServiceListMembersRequest, opts ...grpc.CallOption) (*ProjectServiceListMembersResponse, error)
 	AddMember(ctx context.Context, in *ProjectServiceAddMemberRequest, opts ...grpc.CallOption) (*ProjectServiceAddMemberResponse, error)
@@ -61,6 +83,87 @@ func NewProjectServiceClient(cc grpc.ClientConnInterface) ProjectServiceClient {
 	return &projectServiceClient{cc}
 }
 
+func (c *projectServiceClient) Create(ctx context.Context, in *ProjectServiceCreateRequest, opts ...grpc.CallOption) (*ProjectServiceCreateResponse, error) {
+	out := new(ProjectServiceCreateResponse)
+	err := c.cc.Invoke(ctx, ProjectService_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) List(ctx context.Context, in *ProjectServiceListRequest, opts ...grpc.CallOption) (*ProjectServiceListResponse, error) {
+	out := new(ProjectServiceListResponse)
+	err := c.cc.Invoke(ctx, ProjectService_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Describe(ctx context.Context, in *ProjectServiceDescribeRequest, opts ...grpc.CallOption) (*ProjectServiceDescribeResponse, error) {
+	out := new(ProjectServiceDescribeResponse)
+	err := c.cc.Invoke(ctx, ProjectService_Describe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) Delete(ctx context.Context, in *ProjectServiceDeleteRequest, opts ...grpc.CallOption) (*ProjectServiceDeleteResponse, error) {
+	out := new(ProjectServiceDeleteResponse)
+	err := c.cc.Invoke(ctx, ProjectService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) ListVersions(ctx context.Context, in *ProjectServiceListVersionsRequest, opts ...grpc.CallOption) (*ProjectServiceListVersionsResponse, error) {
+	out := new(ProjectServiceListVersionsResponse)
+	err := c.cc.Invoke(ctx, ProjectService_ListVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) DescribeVersion(ctx context.Context, in *ProjectServiceDescribeVersionRequest, opts ...grpc.CallOption) (*ProjectServiceDescribeVersionResponse, error) {
+	out := new(ProjectServiceDescribeVersionResponse)
+	err := c.cc.Invoke(ctx, ProjectService_DescribeVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) CreateVersion(ctx context.Context, in *ProjectServiceCreateVersionRequest, opts ...grpc.CallOption) (*ProjectServiceCreateVersionResponse, error) {
+	out := new(ProjectServiceCreateVersionResponse)
+	err := c.cc.Invoke(ctx, ProjectService_CreateVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) PromoteVersion(ctx context.Context, in *ProjectServicePromoteVersionRequest, opts ...grpc.CallOption) (*ProjectServicePromoteVersionResponse, error) {
+	out := new(ProjectServicePromoteVersionResponse)
+	err := c.cc.Invoke(ctx, ProjectService_PromoteVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectServiceClient) UnreleaseVersion(ctx context.Context, in *ProjectServiceUnreleaseVersionRequest, opts ...grpc.CallOption) (*ProjectServiceUnreleaseVersionResponse, error) {
+	out := new(ProjectServiceUnreleaseVersionResponse)
+	err := c.cc.Invoke(ctx, ProjectService_UnreleaseVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListMembers(ctx context.Context, in *ProjectServiceListMembersRequest, opts ...grpc.CallOption) (*ProjectServiceListMembersResponse, error) {
 	out := new(ProjectServiceListMembersResponse)
 	err := c.cc.Invoke(ctx, ProjectService_ListMembers_FullMethodName, in, out, opts...)
@@ -110,6 +213,19 @@ func (c *projectServiceClient) ListPendingInvitations(ctx context.Context, in *P
 // All implementations must embed UnimplementedProjectServiceServer
 // for forward compatibility
 type ProjectServiceServer interface {
+	// Project management
+	Create(context.Context, *ProjectServiceCreateRequest) (*ProjectServiceCreateResponse, error)
+	List(context.Context, *ProjectServiceListRequest) (*ProjectServiceListResponse, error)
+	Describe(context.Context, *ProjectServiceDescribeRequest) (*ProjectServiceDescribeResponse, error)
+	Delete(context.Context, *ProjectServiceDeleteRequest) (*ProjectServiceDeleteResponse, error)
+	// Project version management
+	ListVersions(context.Context, *ProjectServiceListVersionsRequest) (*ProjectServiceListVersionsResponse, error)
+	DescribeVersion(context.Context, *ProjectServiceDescribeVersionRequest) (*ProjectServiceDescribeVersionResponse, error)
+	CreateVersion(context.Context, *ProjectServiceCreateVersionRequest) (*ProjectServiceCreateVersionResponse, error)
+	// PromoteVersion marks a pre-release version as released
+	PromoteVersion(context.Context, *ProjectServicePromoteVersionRequest) (*ProjectServicePromoteVersionResponse, error)
+	// UnreleaseVersion moves a released version back to pre-release
+	UnreleaseVersion(context.Context, *ProjectServiceUnreleaseVersionRequest) (*ProjectServiceUnreleaseVersionResponse, error)
 	// Project membership management
 	ListMembers(context.Context, *ProjectServiceListMembersRequest) (*ProjectServiceListMembersResponse, error)
 	AddMember(context.Context, *ProjectServiceAddMemberRequest) (*ProjectServiceAddMemberResponse, error)
@@ -123,6 +239,33 @@ type ProjectServiceServer interface {
 type UnimplementedProjectServiceServer struct {
 }
 
+func (UnimplementedProjectServiceServer) Create(context.Context, *ProjectServiceCreateRequest) (*ProjectServiceCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedProjectServiceServer) List(context.Context, *ProjectServiceListRequest) (*ProjectServiceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedProjectServiceServer) Describe(context.Context, *ProjectServiceDescribeRequest) (*ProjectServiceDescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedProjectServiceServer) Delete(context.Context, *ProjectServiceDeleteRequest) (*ProjectServiceDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedProjectServiceServer) ListVersions(context.Context, *ProjectServiceListVersionsRequest) (*ProjectServiceListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedProjectServiceServer) DescribeVersion(context.Context, *ProjectServiceDescribeVersionRequest) (*ProjectServiceDescribeVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVersion not implemented")
+}
+func (UnimplementedProjectServiceServer) CreateVersion(context.Context, *ProjectServiceCreateVersionRequest) (*ProjectServiceCreateVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVersion not implemented")
+}
+func (UnimplementedProjectServiceServer) PromoteVersion(context.Context, *ProjectServicePromoteVersionRequest) (*ProjectServicePromoteVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteVersion not implemented")
+}
+func (UnimplementedProjectServiceServer) UnreleaseVersion(context.Context, *ProjectServiceUnreleaseVersionRequest) (*ProjectServiceUnreleaseVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnreleaseVersion not implemented")
+}
 func (UnimplementedProjectServiceServer) ListMembers(context.Context, *ProjectServiceListMembersRequest) (*ProjectServiceListMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
 }
@@ -151,6 +294,168 @@ func RegisterProjectServiceServer(s grpc.ServiceRegistrar, srv ProjectServiceSer
 	s.RegisterService(&ProjectService_ServiceDesc, srv)
 }
 
+func _ProjectService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Create(ctx, req.(*ProjectServiceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).List(ctx, req.(*ProjectServiceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceDescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Describe(ctx, req.(*ProjectServiceDescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).Delete(ctx, req.(*ProjectServiceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListVersions(ctx, req.(*ProjectServiceListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_DescribeVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceDescribeVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).DescribeVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_DescribeVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).DescribeVersion(ctx, req.(*ProjectServiceDescribeVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_CreateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceCreateVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).CreateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_CreateVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).CreateVersion(ctx, req.(*ProjectServiceCreateVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_PromoteVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServicePromoteVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).PromoteVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_PromoteVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).PromoteVersion(ctx, req.(*ProjectServicePromoteVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProjectService_UnreleaseVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectServiceUnreleaseVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UnreleaseVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProjectService_UnreleaseVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UnreleaseVersion(ctx, req.(*ProjectServiceUnreleaseVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProjectServiceListMembersRequest)
 	if err := dec(in); err != nil {
@@ -248,6 +553,42 @@ var ProjectService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "controlplane.v1.ProjectService",
 	HandlerType: (*ProjectServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ProjectService_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ProjectService_List_Handler,
+		},
+		{
+			MethodName: "Describe",
+			Handler:    _ProjectService_Describe_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ProjectService_Delete_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _ProjectService_ListVersions_Handler,
+		},
+		{
+			MethodName: "DescribeVersion",
+			Handler:    _ProjectService_DescribeVersion_Handler,
+		},
+		{
+			MethodName: "CreateVersion",
+			Handler:    _ProjectService_CreateVersion_Handler,
+		},
+		{
+			MethodName: "PromoteVersion",
+			Handler:    _ProjectService_PromoteVersion_Handler,
+		},
+		{
+			MethodName: "UnreleaseVersion",
+			Handler:    _ProjectService_UnreleaseVersion_Handler,
+		},
 		{
 			MethodName: "ListMembers",
 			Handler:    _ProjectService_ListMembers_Handler,
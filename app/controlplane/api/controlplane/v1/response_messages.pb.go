@@ -1557,7 +1557,12 @@ type PolicyViolation struct {
 	//	*PolicyViolation_Vulnerability
 	//	*PolicyViolation_Sast
 	//	*PolicyViolation_LicenseViolation
-	Finding       isPolicyViolation_Finding `protobuf_oneof:"finding"`
+	//	*PolicyViolation_Secret
+	//	*PolicyViolation_Misconfiguration
+	//	*PolicyViolation_Malware
+	Finding isPolicyViolation_Finding `protobuf_oneof:"finding"`
+	// ID of the policy exception that suppressed the violation, if any
+	ExceptionId   string `protobuf:"bytes,7,opt,name=exception_id,json=exceptionId,proto3" json:"exception_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1647,6 +1652,40 @@ func (x *PolicyViolation) GetLicenseViolation() *v11.PolicyLicenseViolationFindi
 	return nil
 }
 
+func (x *PolicyViolation) GetSecret() *v11.PolicySecretFinding {
+	if x != nil {
+		if x, ok := x.Finding.(*PolicyViolation_Secret); ok {
+			return x.Secret
+		}
+	}
+	return nil
+}
+
+func (x *PolicyViolation) GetMisconfiguration() *v11.PolicyMisconfigurationFinding {
+	if x != nil {
+		if x, ok := x.Finding.(*PolicyViolation_Misconfiguration); ok {
+			return x.Misconfiguration
+		}
+	}
+	return nil
+}
+
+func (x *PolicyViolation) GetMalware() *v11.PolicyMalwareFinding {
+	if x != nil {
+		if x, ok := x.Finding.(*PolicyViolation_Malware); ok {
+			return x.Malware
+		}
+	}
+	return nil
+}
+
+func (x *PolicyViolation) GetExceptionId() string {
+	if x != nil {
+		return x.ExceptionId
+	}
+	return ""
+}
+
 type isPolicyViolation_Finding interface {
 	isPolicyViolation_Finding()
 }
@@ -1663,12 +1702,30 @@ type PolicyViolation_LicenseViolation struct {
 	LicenseViolation *v11.PolicyLicenseViolationFinding `protobuf:"bytes,6,opt,name=license_violation,json=licenseViolation,proto3,oneof"`
 }
 
+type PolicyViolation_Secret struct {
+	Secret *v11.PolicySecretFinding `protobuf:"bytes,8,opt,name=secret,proto3,oneof"`
+}
+
+type PolicyViolation_Misconfiguration struct {
+	Misconfiguration *v11.PolicyMisconfigurationFinding `protobuf:"bytes,9,opt,name=misconfiguration,proto3,oneof"`
+}
+
+type PolicyViolation_Malware struct {
+	Malware *v11.PolicyMalwareFinding `protobuf:"bytes,10,opt,name=malware,proto3,oneof"`
+}
+
 func (*PolicyViolation_Vulnerability) isPolicyViolation_Finding() {}
 
 func (*PolicyViolation_Sast) isPolicyViolation_Finding() {}
 
 func (*PolicyViolation_LicenseViolation) isPolicyViolation_Finding() {}
 
+func (*PolicyViolation_Secret) isPolicyViolation_Finding() {}
+
+func (*PolicyViolation_Misconfiguration) isPolicyViolation_Finding() {}
+
+func (*PolicyViolation_Malware) isPolicyViolation_Finding() {}
+
 type PolicyReference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2452,7 +2509,9 @@ type CASBackendItem struct {
 	// Wether it's the fallback backend in the organization
 	Fallback bool `protobuf:"varint,14,opt,name=fallback,proto3" json:"fallback,omitempty"`
 	// Whether this backend is provisioned and operated by Chainloop using
-	IsManaged     bool `protobuf:"varint,15,opt,name=is_managed,json=isManaged,proto3" json:"is_managed,omitempty"`
+	IsManaged bool `protobuf:"varint,15,opt,name=is_managed,json=isManaged,proto3" json:"is_managed,omitempty"`
+	// ID of the backend this one is a replica of, if any
+	PrimaryId     *string `protobuf:"bytes,16,opt,name=primary_id,json=primaryId,proto3,oneof" json:"primary_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2592,6 +2651,13 @@ func (x *CASBackendItem) GetIsManaged() bool {
 	return false
 }
 
+func (x *CASBackendItem) GetPrimaryId() string {
+	if x != nil && x.PrimaryId != nil {
+		return *x.PrimaryId
+	}
+	return ""
+}
+
 type APITokenItem struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3232,14 +3298,19 @@ const file_controlplane_v1_response_messages_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
 	"\tWithEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd7\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd8\x04\n" +
 	"\x0fPolicyViolation\x12\x18\n" +
 	"\asubject\x18\x01 \x01(\tR\asubject\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
 	"\bsuppress\x18\x03 \x01(\bR\bsuppress\x12R\n" +
 	"\rvulnerability\x18\x04 \x01(\v2*.attestation.v1.PolicyVulnerabilityFindingH\x00R\rvulnerability\x127\n" +
 	"\x04sast\x18\x05 \x01(\v2!.attestation.v1.PolicySASTFindingH\x00R\x04sast\x12\\\n" +
-	"\x11license_violation\x18\x06 \x01(\v2-.attestation.v1.PolicyLicenseViolationFindingH\x00R\x10licenseViolationB\t\n" +
+	"\x11license_violation\x18\x06 \x01(\v2-.attestation.v1.PolicyLicenseViolationFindingH\x00R\x10licenseViolation\x12=\n" +
+	"\x06secret\x18\b \x01(\v2#.attestation.v1.PolicySecretFindingH\x00R\x06secret\x12[\n" +
+	"\x10misconfiguration\x18\t \x01(\v2-.attestation.v1.PolicyMisconfigurationFindingH\x00R\x10misconfiguration\x12@\n" +
+	"\amalware\x18\n" +
+	" \x01(\v2$.attestation.v1.PolicyMalwareFindingH\x00R\amalware\x12!\n" +
+	"\fexception_id\x18\a \x01(\tR\vexceptionIdB\t\n" +
 	"\afinding\"\xdc\x01\n" +
 	"\x0fPolicyReference\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
@@ -3333,7 +3404,7 @@ const file_controlplane_v1_response_messages_proto_rawDesc = "" +
 	".POLICY_VIOLATION_BLOCKING_STRATEGY_UNSPECIFIED\x10\x00\x12,\n" +
 	"(POLICY_VIOLATION_BLOCKING_STRATEGY_BLOCK\x10\x01\x12/\n" +
 	"+POLICY_VIOLATION_BLOCKING_STRATEGY_ADVISORY\x10\x02B\x1e\n" +
-	"\x1c_api_token_max_days_inactive\"\xb0\x06\n" +
+	"\x1c_api_token_max_days_inactive\"\xe3\x06\n" +
 	"\x0eCASBackendItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\v \x01(\tR\x04name\x12\x1a\n" +
@@ -3353,14 +3424,17 @@ const file_controlplane_v1_response_messages_proto_rawDesc = "" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
 	"\bfallback\x18\x0e \x01(\bR\bfallback\x12\x1d\n" +
 	"\n" +
-	"is_managed\x18\x0f \x01(\bR\tisManaged\x1a%\n" +
+	"is_managed\x18\x0f \x01(\bR\tisManaged\x12\"\n" +
+	"\n" +
+	"primary_id\x18\x10 \x01(\tH\x01R\tprimaryId\x88\x01\x01\x1a%\n" +
 	"\x06Limits\x12\x1b\n" +
 	"\tmax_bytes\x18\x01 \x01(\x03R\bmaxBytes\"n\n" +
 	"\x10ValidationStatus\x12!\n" +
 	"\x1dVALIDATION_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14VALIDATION_STATUS_OK\x10\x01\x12\x1d\n" +
 	"\x19VALIDATION_STATUS_INVALID\x10\x02B\x13\n" +
-	"\x11_validation_error\"\xdd\x03\n" +
+	"\x11_validation_errorB\r\n" +
+	"\v_primary_id\"\xdd\x03\n" +
 	"\fAPITokenItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\a \x01(\tR\x04name\x12 \n" +
@@ -3490,7 +3564,10 @@ var file_controlplane_v1_response_messages_proto_goTypes = []any{
 	(*v11.PolicyVulnerabilityFinding)(nil),          // 44: attestation.v1.PolicyVulnerabilityFinding
 	(*v11.PolicySASTFinding)(nil),                   // 45: attestation.v1.PolicySASTFinding
 	(*v11.PolicyLicenseViolationFinding)(nil),       // 46: attestation.v1.PolicyLicenseViolationFinding
-	(*v1.CraftingSchema)(nil),                       // 47: workflowcontract.v1.CraftingSchema
+	(*v11.PolicySecretFinding)(nil),                 // 47: attestation.v1.PolicySecretFinding
+	(*v11.PolicyMisconfigurationFinding)(nil),       // 48: attestation.v1.PolicyMisconfigurationFinding
+	(*v11.PolicyMalwareFinding)(nil),                // 49: attestation.v1.PolicyMalwareFinding
+	(*v1.CraftingSchema)(nil),                       // 50: workflowcontract.v1.CraftingSchema
 }
 var file_controlplane_v1_response_messages_proto_depIdxs = []int32{
 	42, // 0: controlplane.v1.WorkflowItem.created_at:type_name -> google.protobuf.Timestamp
@@ -3520,44 +3597,47 @@ var file_controlplane_v1_response_messages_proto_depIdxs = []int32{
 	44, // 24: controlplane.v1.PolicyViolation.vulnerability:type_name -> attestation.v1.PolicyVulnerabilityFinding
 	45, // 25: controlplane.v1.PolicyViolation.sast:type_name -> attestation.v1.PolicySASTFinding
 	46, // 26: controlplane.v1.PolicyViolation.license_violation:type_name -> attestation.v1.PolicyLicenseViolationFinding
-	39, // 27: controlplane.v1.PolicyReference.digest:type_name -> controlplane.v1.PolicyReference.DigestEntry
-	42, // 28: controlplane.v1.WorkflowContractItem.created_at:type_name -> google.protobuf.Timestamp
-	42, // 29: controlplane.v1.WorkflowContractItem.updated_at:type_name -> google.protobuf.Timestamp
-	42, // 30: controlplane.v1.WorkflowContractItem.latest_revision_created_at:type_name -> google.protobuf.Timestamp
-	24, // 31: controlplane.v1.WorkflowContractItem.workflow_refs:type_name -> controlplane.v1.WorkflowRef
-	23, // 32: controlplane.v1.WorkflowContractItem.scoped_entity:type_name -> controlplane.v1.ScopedEntity
-	42, // 33: controlplane.v1.WorkflowContractVersionItem.created_at:type_name -> google.protobuf.Timestamp
-	47, // 34: controlplane.v1.WorkflowContractVersionItem.v1:type_name -> workflowcontract.v1.CraftingSchema
-	40, // 35: controlplane.v1.WorkflowContractVersionItem.raw_contract:type_name -> controlplane.v1.WorkflowContractVersionItem.RawBody
-	42, // 36: controlplane.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	42, // 37: controlplane.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	28, // 38: controlplane.v1.OrgMembershipItem.org:type_name -> controlplane.v1.OrgItem
-	26, // 39: controlplane.v1.OrgMembershipItem.user:type_name -> controlplane.v1.User
-	42, // 40: controlplane.v1.OrgMembershipItem.created_at:type_name -> google.protobuf.Timestamp
-	42, // 41: controlplane.v1.OrgMembershipItem.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 42: controlplane.v1.OrgMembershipItem.role:type_name -> controlplane.v1.MembershipRole
-	42, // 43: controlplane.v1.OrgItem.created_at:type_name -> google.protobuf.Timestamp
-	42, // 44: controlplane.v1.OrgItem.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 45: controlplane.v1.OrgItem.default_policy_violation_strategy:type_name -> controlplane.v1.OrgItem.PolicyViolationBlockingStrategy
-	42, // 46: controlplane.v1.CASBackendItem.created_at:type_name -> google.protobuf.Timestamp
-	42, // 47: controlplane.v1.CASBackendItem.validated_at:type_name -> google.protobuf.Timestamp
-	12, // 48: controlplane.v1.CASBackendItem.validation_status:type_name -> controlplane.v1.CASBackendItem.ValidationStatus
-	41, // 49: controlplane.v1.CASBackendItem.limits:type_name -> controlplane.v1.CASBackendItem.Limits
-	42, // 50: controlplane.v1.CASBackendItem.updated_at:type_name -> google.protobuf.Timestamp
-	23, // 51: controlplane.v1.APITokenItem.scoped_entity:type_name -> controlplane.v1.ScopedEntity
-	42, // 52: controlplane.v1.APITokenItem.created_at:type_name -> google.protobuf.Timestamp
-	42, // 53: controlplane.v1.APITokenItem.revoked_at:type_name -> google.protobuf.Timestamp
-	42, // 54: controlplane.v1.APITokenItem.expires_at:type_name -> google.protobuf.Timestamp
-	42, // 55: controlplane.v1.APITokenItem.last_used_at:type_name -> google.protobuf.Timestamp
-	18, // 56: controlplane.v1.AttestationItem.PolicyEvaluationsEntry.value:type_name -> controlplane.v1.PolicyEvaluations
-	16, // 57: controlplane.v1.AttestationItem.PolicyEvaluationStatus.summary:type_name -> controlplane.v1.PolicyStatusSummary
-	36, // 58: controlplane.v1.AttestationItem.Material.annotations:type_name -> controlplane.v1.AttestationItem.Material.AnnotationsEntry
-	10, // 59: controlplane.v1.WorkflowContractVersionItem.RawBody.format:type_name -> controlplane.v1.WorkflowContractVersionItem.RawBody.Format
-	60, // [60:60] is the sub-list for method output_type
-	60, // [60:60] is the sub-list for method input_type
-	60, // [60:60] is the sub-list for extension type_name
-	60, // [60:60] is the sub-list for extension extendee
-	0,  // [0:60] is the sub-list for field type_name
+	47, // 27: controlplane.v1.PolicyViolation.secret:type_name -> attestation.v1.PolicySecretFinding
+	48, // 28: controlplane.v1.PolicyViolation.misconfiguration:type_name -> attestation.v1.PolicyMisconfigurationFinding
+	49, // 29: controlplane.v1.PolicyViolation.malware:type_name -> attestation.v1.PolicyMalwareFinding
+	39, // 30: controlplane.v1.PolicyReference.digest:type_name -> controlplane.v1.PolicyReference.DigestEntry
+	42, // 31: controlplane.v1.WorkflowContractItem.created_at:type_name -> google.protobuf.Timestamp
+	42, // 32: controlplane.v1.WorkflowContractItem.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 33: controlplane.v1.WorkflowContractItem.latest_revision_created_at:type_name -> google.protobuf.Timestamp
+	24, // 34: controlplane.v1.WorkflowContractItem.workflow_refs:type_name -> controlplane.v1.WorkflowRef
+	23, // 35: controlplane.v1.WorkflowContractItem.scoped_entity:type_name -> controlplane.v1.ScopedEntity
+	42, // 36: controlplane.v1.WorkflowContractVersionItem.created_at:type_name -> google.protobuf.Timestamp
+	50, // 37: controlplane.v1.WorkflowContractVersionItem.v1:type_name -> workflowcontract.v1.CraftingSchema
+	40, // 38: controlplane.v1.WorkflowContractVersionItem.raw_contract:type_name -> controlplane.v1.WorkflowContractVersionItem.RawBody
+	42, // 39: controlplane.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	42, // 40: controlplane.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 41: controlplane.v1.OrgMembershipItem.org:type_name -> controlplane.v1.OrgItem
+	26, // 42: controlplane.v1.OrgMembershipItem.user:type_name -> controlplane.v1.User
+	42, // 43: controlplane.v1.OrgMembershipItem.created_at:type_name -> google.protobuf.Timestamp
+	42, // 44: controlplane.v1.OrgMembershipItem.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 45: controlplane.v1.OrgMembershipItem.role:type_name -> controlplane.v1.MembershipRole
+	42, // 46: controlplane.v1.OrgItem.created_at:type_name -> google.protobuf.Timestamp
+	42, // 47: controlplane.v1.OrgItem.updated_at:type_name -> google.protobuf.Timestamp
+	11, // 48: controlplane.v1.OrgItem.default_policy_violation_strategy:type_name -> controlplane.v1.OrgItem.PolicyViolationBlockingStrategy
+	42, // 49: controlplane.v1.CASBackendItem.created_at:type_name -> google.protobuf.Timestamp
+	42, // 50: controlplane.v1.CASBackendItem.validated_at:type_name -> google.protobuf.Timestamp
+	12, // 51: controlplane.v1.CASBackendItem.validation_status:type_name -> controlplane.v1.CASBackendItem.ValidationStatus
+	41, // 52: controlplane.v1.CASBackendItem.limits:type_name -> controlplane.v1.CASBackendItem.Limits
+	42, // 53: controlplane.v1.CASBackendItem.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 54: controlplane.v1.APITokenItem.scoped_entity:type_name -> controlplane.v1.ScopedEntity
+	42, // 55: controlplane.v1.APITokenItem.created_at:type_name -> google.protobuf.Timestamp
+	42, // 56: controlplane.v1.APITokenItem.revoked_at:type_name -> google.protobuf.Timestamp
+	42, // 57: controlplane.v1.APITokenItem.expires_at:type_name -> google.protobuf.Timestamp
+	42, // 58: controlplane.v1.APITokenItem.last_used_at:type_name -> google.protobuf.Timestamp
+	18, // 59: controlplane.v1.AttestationItem.PolicyEvaluationsEntry.value:type_name -> controlplane.v1.PolicyEvaluations
+	16, // 60: controlplane.v1.AttestationItem.PolicyEvaluationStatus.summary:type_name -> controlplane.v1.PolicyStatusSummary
+	36, // 61: controlplane.v1.AttestationItem.Material.annotations:type_name -> controlplane.v1.AttestationItem.Material.AnnotationsEntry
+	10, // 62: controlplane.v1.WorkflowContractVersionItem.RawBody.format:type_name -> controlplane.v1.WorkflowContractVersionItem.RawBody.Format
+	63, // [63:63] is the sub-list for method output_type
+	63, // [63:63] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_controlplane_v1_response_messages_proto_init() }
@@ -3570,6 +3650,9 @@ func file_controlplane_v1_response_messages_proto_init() {
 		(*PolicyViolation_Vulnerability)(nil),
 		(*PolicyViolation_Sast)(nil),
 		(*PolicyViolation_LicenseViolation)(nil),
+		(*PolicyViolation_Secret)(nil),
+		(*PolicyViolation_Misconfiguration)(nil),
+		(*PolicyViolation_Malware)(nil),
 	}
 	file_controlplane_v1_response_messages_proto_msgTypes[12].OneofWrappers = []any{
 		(*WorkflowContractVersionItem_V1)(nil),
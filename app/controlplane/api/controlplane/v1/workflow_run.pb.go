@@ -26,6 +26,7 @@ import (
 	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -84,7 +85,7 @@ func (x AttestationServiceCancelRequest_TriggerType) Number() protoreflect.EnumN
 
 // Deprecated: Use AttestationServiceCancelRequest_TriggerType.Descriptor instead.
 func (AttestationServiceCancelRequest_TriggerType) EnumDescriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{13, 0}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type FindOrCreateWorkflowRequest struct {
@@ -485,6 +486,94 @@ func (x *AttestationServiceGetPolicyGroupResponse) GetReference() *RemotePolicyR
 	return nil
 }
 
+type AttestationServiceListPolicyExceptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowRunId string                 `protobuf:"bytes,1,opt,name=workflow_run_id,json=workflowRunId,proto3" json:"workflow_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestationServiceListPolicyExceptionsRequest) Reset() {
+	*x = AttestationServiceListPolicyExceptionsRequest{}
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationServiceListPolicyExceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationServiceListPolicyExceptionsRequest) ProtoMessage() {}
+
+func (x *AttestationServiceListPolicyExceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationServiceListPolicyExceptionsRequest.ProtoReflect.Descriptor instead.
+func (*AttestationServiceListPolicyExceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AttestationServiceListPolicyExceptionsRequest) GetWorkflowRunId() string {
+	if x != nil {
+		return x.WorkflowRunId
+	}
+	return ""
+}
+
+type AttestationServiceListPolicyExceptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        []*PolicyExceptionItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttestationServiceListPolicyExceptionsResponse) Reset() {
+	*x = AttestationServiceListPolicyExceptionsResponse{}
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttestationServiceListPolicyExceptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttestationServiceListPolicyExceptionsResponse) ProtoMessage() {}
+
+func (x *AttestationServiceListPolicyExceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttestationServiceListPolicyExceptionsResponse.ProtoReflect.Descriptor instead.
+func (*AttestationServiceListPolicyExceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AttestationServiceListPolicyExceptionsResponse) GetResult() []*PolicyExceptionItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
 type AttestationServiceGetContractRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ContractRevision int32                  `protobuf:"varint,1,opt,name=contract_revision,json=contractRevision,proto3" json:"contract_revision,omitempty"`
@@ -496,7 +585,7 @@ type AttestationServiceGetContractRequest struct {
 
 func (x *AttestationServiceGetContractRequest) Reset() {
 	*x = AttestationServiceGetContractRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[7]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +597,7 @@ func (x *AttestationServiceGetContractRequest) String() string {
 func (*AttestationServiceGetContractRequest) ProtoMessage() {}
 
 func (x *AttestationServiceGetContractRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[7]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +610,7 @@ func (x *AttestationServiceGetContractRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use AttestationServiceGetContractRequest.ProtoReflect.Descriptor instead.
 func (*AttestationServiceGetContractRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{7}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AttestationServiceGetContractRequest) GetContractRevision() int32 {
@@ -554,7 +643,7 @@ type AttestationServiceGetContractResponse struct {
 
 func (x *AttestationServiceGetContractResponse) Reset() {
 	*x = AttestationServiceGetContractResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[8]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +655,7 @@ func (x *AttestationServiceGetContractResponse) String() string {
 func (*AttestationServiceGetContractResponse) ProtoMessage() {}
 
 func (x *AttestationServiceGetContractResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[8]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +668,7 @@ func (x *AttestationServiceGetContractResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AttestationServiceGetContractResponse.ProtoReflect.Descriptor instead.
 func (*AttestationServiceGetContractResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{8}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AttestationServiceGetContractResponse) GetResult() *AttestationServiceGetContractResponse_Result {
@@ -615,7 +704,7 @@ type AttestationServiceInitRequest struct {
 
 func (x *AttestationServiceInitRequest) Reset() {
 	*x = AttestationServiceInitRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[9]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +716,7 @@ func (x *AttestationServiceInitRequest) String() string {
 func (*AttestationServiceInitRequest) ProtoMessage() {}
 
 func (x *AttestationServiceInitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[9]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +729,7 @@ func (x *AttestationServiceInitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationServiceInitRequest.ProtoReflect.Descriptor instead.
 func (*AttestationServiceInitRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{9}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AttestationServiceInitRequest) GetContractRevision() int32 {
@@ -715,7 +804,7 @@ type AttestationServiceInitResponse struct {
 
 func (x *AttestationServiceInitResponse) Reset() {
 	*x = AttestationServiceInitResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[10]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -727,7 +816,7 @@ func (x *AttestationServiceInitResponse) String() string {
 func (*AttestationServiceInitResponse) ProtoMessage() {}
 
 func (x *AttestationServiceInitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[10]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +829,7 @@ func (x *AttestationServiceInitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationServiceInitResponse.ProtoReflect.Descriptor instead.
 func (*AttestationServiceInitResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{10}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AttestationServiceInitResponse) GetResult() *AttestationServiceInitResponse_Result {
@@ -763,7 +852,7 @@ type AttestationServiceStoreRequest struct {
 
 func (x *AttestationServiceStoreRequest) Reset() {
 	*x = AttestationServiceStoreRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[11]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +864,7 @@ func (x *AttestationServiceStoreRequest) String() string {
 func (*AttestationServiceStoreRequest) ProtoMessage() {}
 
 func (x *AttestationServiceStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[11]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +877,7 @@ func (x *AttestationServiceStoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationServiceStoreRequest.ProtoReflect.Descriptor instead.
 func (*AttestationServiceStoreRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{11}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AttestationServiceStoreRequest) GetAttestationBundle() []byte {
@@ -821,7 +910,7 @@ type AttestationServiceStoreResponse struct {
 
 func (x *AttestationServiceStoreResponse) Reset() {
 	*x = AttestationServiceStoreResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[12]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -833,7 +922,7 @@ func (x *AttestationServiceStoreResponse) String() string {
 func (*AttestationServiceStoreResponse) ProtoMessage() {}
 
 func (x *AttestationServiceStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[12]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +935,7 @@ func (x *AttestationServiceStoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationServiceStoreResponse.ProtoReflect.Descriptor instead.
 func (*AttestationServiceStoreResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{12}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AttestationServiceStoreResponse) GetResult() *AttestationServiceStoreResponse_Result {
@@ -867,7 +956,7 @@ type AttestationServiceCancelRequest struct {
 
 func (x *AttestationServiceCancelRequest) Reset() {
 	*x = AttestationServiceCancelRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[13]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -879,7 +968,7 @@ func (x *AttestationServiceCancelRequest) String() string {
 func (*AttestationServiceCancelRequest) ProtoMessage() {}
 
 func (x *AttestationServiceCancelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[13]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,7 +981,7 @@ func (x *AttestationServiceCancelRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationServiceCancelRequest.ProtoReflect.Descriptor instead.
 func (*AttestationServiceCancelRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{13}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AttestationServiceCancelRequest) GetWorkflowRunId() string {
@@ -924,7 +1013,7 @@ type AttestationServiceCancelResponse struct {
 
 func (x *AttestationServiceCancelResponse) Reset() {
 	*x = AttestationServiceCancelResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[14]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -936,7 +1025,7 @@ func (x *AttestationServiceCancelResponse) String() string {
 func (*AttestationServiceCancelResponse) ProtoMessage() {}
 
 func (x *AttestationServiceCancelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[14]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -949,7 +1038,7 @@ func (x *AttestationServiceCancelResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AttestationServiceCancelResponse.ProtoReflect.Descriptor instead.
 func (*AttestationServiceCancelResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{14}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{16}
 }
 
 type WorkflowRunServiceListRequest struct {
@@ -990,7 +1079,7 @@ type WorkflowRunServiceListRequest struct {
 
 func (x *WorkflowRunServiceListRequest) Reset() {
 	*x = WorkflowRunServiceListRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[15]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1002,7 +1091,7 @@ func (x *WorkflowRunServiceListRequest) String() string {
 func (*WorkflowRunServiceListRequest) ProtoMessage() {}
 
 func (x *WorkflowRunServiceListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[15]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1104,7 @@ func (x *WorkflowRunServiceListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowRunServiceListRequest.ProtoReflect.Descriptor instead.
 func (*WorkflowRunServiceListRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{15}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WorkflowRunServiceListRequest) GetWorkflowName() string {
@@ -1093,7 +1182,7 @@ type WorkflowRunServiceListResponse struct {
 
 func (x *WorkflowRunServiceListResponse) Reset() {
 	*x = WorkflowRunServiceListResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[16]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1105,7 +1194,7 @@ func (x *WorkflowRunServiceListResponse) String() string {
 func (*WorkflowRunServiceListResponse) ProtoMessage() {}
 
 func (x *WorkflowRunServiceListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[16]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1118,7 +1207,7 @@ func (x *WorkflowRunServiceListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowRunServiceListResponse.ProtoReflect.Descriptor instead.
 func (*WorkflowRunServiceListResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{16}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WorkflowRunServiceListResponse) GetResult() []*WorkflowRunItem {
@@ -1135,6 +1224,209 @@ func (x *WorkflowRunServiceListResponse) GetPagination() *CursorPaginationRespon
 	return nil
 }
 
+// All the provided filters must match
+type WorkflowRunServiceSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scope the search to a project and optionally to one of its workflows
+	ProjectName  string `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	WorkflowName string `protobuf:"bytes,2,opt,name=workflow_name,json=workflowName,proto3" json:"workflow_name,omitempty"`
+	// by digest of any of the materials, i.e sha256:deadbeef. sha256 is assumed if no algorithm is provided
+	MaterialDigest string `protobuf:"bytes,3,opt,name=material_digest,json=materialDigest,proto3" json:"material_digest,omitempty"`
+	// by type of any of the materials
+	MaterialType v1.CraftingSchema_Material_MaterialType `protobuf:"varint,4,opt,name=material_type,json=materialType,proto3,enum=workflowcontract.v1.CraftingSchema_Material_MaterialType" json:"material_type,omitempty"`
+	// by name of any of the subjects of the attestation
+	SubjectName string `protobuf:"bytes,5,opt,name=subject_name,json=subjectName,proto3" json:"subject_name,omitempty"`
+	// by attestation annotation, the value is optional
+	AnnotationName  string `protobuf:"bytes,6,opt,name=annotation_name,json=annotationName,proto3" json:"annotation_name,omitempty"`
+	AnnotationValue string `protobuf:"bytes,7,opt,name=annotation_value,json=annotationValue,proto3" json:"annotation_value,omitempty"`
+	// by the runner that executed the workflow
+	RunnerType v1.CraftingSchema_Runner_RunnerType `protobuf:"varint,8,opt,name=runner_type,json=runnerType,proto3,enum=workflowcontract.v1.CraftingSchema_Runner_RunnerType" json:"runner_type,omitempty"`
+	// by canonical policy status
+	PolicyStatus PolicyStatusFilter `protobuf:"varint,9,opt,name=policy_status,json=policyStatus,proto3,enum=controlplane.v1.PolicyStatusFilter" json:"policy_status,omitempty"`
+	// by whether policy violations were found
+	HasPolicyViolations *bool `protobuf:"varint,10,opt,name=has_policy_violations,json=hasPolicyViolations,proto3,oneof" json:"has_policy_violations,omitempty"`
+	// by creation date, both ends of the range are optional
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// pagination options
+	Pagination    *CursorPaginationRequest `protobuf:"bytes,13,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRunServiceSearchRequest) Reset() {
+	*x = WorkflowRunServiceSearchRequest{}
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRunServiceSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRunServiceSearchRequest) ProtoMessage() {}
+
+func (x *WorkflowRunServiceSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRunServiceSearchRequest.ProtoReflect.Descriptor instead.
+func (*WorkflowRunServiceSearchRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetWorkflowName() string {
+	if x != nil {
+		return x.WorkflowName
+	}
+	return ""
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetMaterialDigest() string {
+	if x != nil {
+		return x.MaterialDigest
+	}
+	return ""
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetMaterialType() v1.CraftingSchema_Material_MaterialType {
+	if x != nil {
+		return x.MaterialType
+	}
+	return v1.CraftingSchema_Material_MaterialType(0)
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetSubjectName() string {
+	if x != nil {
+		return x.SubjectName
+	}
+	return ""
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetAnnotationName() string {
+	if x != nil {
+		return x.AnnotationName
+	}
+	return ""
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetAnnotationValue() string {
+	if x != nil {
+		return x.AnnotationValue
+	}
+	return ""
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetRunnerType() v1.CraftingSchema_Runner_RunnerType {
+	if x != nil {
+		return x.RunnerType
+	}
+	return v1.CraftingSchema_Runner_RunnerType(0)
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetPolicyStatus() PolicyStatusFilter {
+	if x != nil {
+		return x.PolicyStatus
+	}
+	return PolicyStatusFilter_POLICY_STATUS_FILTER_UNSPECIFIED
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetHasPolicyViolations() bool {
+	if x != nil && x.HasPolicyViolations != nil {
+		return *x.HasPolicyViolations
+	}
+	return false
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *WorkflowRunServiceSearchRequest) GetPagination() *CursorPaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type WorkflowRunServiceSearchResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Result        []*WorkflowRunItem        `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Pagination    *CursorPaginationResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowRunServiceSearchResponse) Reset() {
+	*x = WorkflowRunServiceSearchResponse{}
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowRunServiceSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowRunServiceSearchResponse) ProtoMessage() {}
+
+func (x *WorkflowRunServiceSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowRunServiceSearchResponse.ProtoReflect.Descriptor instead.
+func (*WorkflowRunServiceSearchResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *WorkflowRunServiceSearchResponse) GetResult() []*WorkflowRunItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *WorkflowRunServiceSearchResponse) GetPagination() *CursorPaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 type WorkflowRunServiceViewRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// It can search by either ID or digest
@@ -1152,7 +1444,7 @@ type WorkflowRunServiceViewRequest struct {
 
 func (x *WorkflowRunServiceViewRequest) Reset() {
 	*x = WorkflowRunServiceViewRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[17]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1164,7 +1456,7 @@ func (x *WorkflowRunServiceViewRequest) String() string {
 func (*WorkflowRunServiceViewRequest) ProtoMessage() {}
 
 func (x *WorkflowRunServiceViewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[17]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,7 +1469,7 @@ func (x *WorkflowRunServiceViewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowRunServiceViewRequest.ProtoReflect.Descriptor instead.
 func (*WorkflowRunServiceViewRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{17}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *WorkflowRunServiceViewRequest) GetRef() isWorkflowRunServiceViewRequest_Ref {
@@ -1237,7 +1529,7 @@ type WorkflowRunServiceViewResponse struct {
 
 func (x *WorkflowRunServiceViewResponse) Reset() {
 	*x = WorkflowRunServiceViewResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[18]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1249,7 +1541,7 @@ func (x *WorkflowRunServiceViewResponse) String() string {
 func (*WorkflowRunServiceViewResponse) ProtoMessage() {}
 
 func (x *WorkflowRunServiceViewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[18]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +1554,7 @@ func (x *WorkflowRunServiceViewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowRunServiceViewResponse.ProtoReflect.Descriptor instead.
 func (*WorkflowRunServiceViewResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{18}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *WorkflowRunServiceViewResponse) GetResult() *WorkflowRunServiceViewResponse_Result {
@@ -1281,7 +1573,7 @@ type AttestationServiceGetUploadCredsRequest struct {
 
 func (x *AttestationServiceGetUploadCredsRequest) Reset() {
 	*x = AttestationServiceGetUploadCredsRequest{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[19]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1293,7 +1585,7 @@ func (x *AttestationServiceGetUploadCredsRequest) String() string {
 func (*AttestationServiceGetUploadCredsRequest) ProtoMessage() {}
 
 func (x *AttestationServiceGetUploadCredsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[19]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1306,7 +1598,7 @@ func (x *AttestationServiceGetUploadCredsRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use AttestationServiceGetUploadCredsRequest.ProtoReflect.Descriptor instead.
 func (*AttestationServiceGetUploadCredsRequest) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{19}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *AttestationServiceGetUploadCredsRequest) GetWorkflowRunId() string {
@@ -1325,7 +1617,7 @@ type AttestationServiceGetUploadCredsResponse struct {
 
 func (x *AttestationServiceGetUploadCredsResponse) Reset() {
 	*x = AttestationServiceGetUploadCredsResponse{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[20]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1337,7 +1629,7 @@ func (x *AttestationServiceGetUploadCredsResponse) String() string {
 func (*AttestationServiceGetUploadCredsResponse) ProtoMessage() {}
 
 func (x *AttestationServiceGetUploadCredsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[20]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,7 +1642,7 @@ func (x *AttestationServiceGetUploadCredsResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use AttestationServiceGetUploadCredsResponse.ProtoReflect.Descriptor instead.
 func (*AttestationServiceGetUploadCredsResponse) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{20}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *AttestationServiceGetUploadCredsResponse) GetResult() *AttestationServiceGetUploadCredsResponse_Result {
@@ -1370,7 +1662,7 @@ type AttestationServiceGetContractResponse_Result struct {
 
 func (x *AttestationServiceGetContractResponse_Result) Reset() {
 	*x = AttestationServiceGetContractResponse_Result{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[21]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1382,7 +1674,7 @@ func (x *AttestationServiceGetContractResponse_Result) String() string {
 func (*AttestationServiceGetContractResponse_Result) ProtoMessage() {}
 
 func (x *AttestationServiceGetContractResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[21]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,7 +1687,7 @@ func (x *AttestationServiceGetContractResponse_Result) ProtoReflect() protorefle
 
 // Deprecated: Use AttestationServiceGetContractResponse_Result.ProtoReflect.Descriptor instead.
 func (*AttestationServiceGetContractResponse_Result) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{8, 0}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *AttestationServiceGetContractResponse_Result) GetWorkflow() *WorkflowItem {
@@ -1435,7 +1727,7 @@ type AttestationServiceInitResponse_Result struct {
 
 func (x *AttestationServiceInitResponse_Result) Reset() {
 	*x = AttestationServiceInitResponse_Result{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[22]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1447,7 +1739,7 @@ func (x *AttestationServiceInitResponse_Result) String() string {
 func (*AttestationServiceInitResponse_Result) ProtoMessage() {}
 
 func (x *AttestationServiceInitResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[22]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,7 +1752,7 @@ func (x *AttestationServiceInitResponse_Result) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AttestationServiceInitResponse_Result.ProtoReflect.Descriptor instead.
 func (*AttestationServiceInitResponse_Result) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{10, 0}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *AttestationServiceInitResponse_Result) GetWorkflowRun() *WorkflowRunItem {
@@ -1531,7 +1823,7 @@ type AttestationServiceInitResponse_SigningOptions struct {
 
 func (x *AttestationServiceInitResponse_SigningOptions) Reset() {
 	*x = AttestationServiceInitResponse_SigningOptions{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[23]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1543,7 +1835,7 @@ func (x *AttestationServiceInitResponse_SigningOptions) String() string {
 func (*AttestationServiceInitResponse_SigningOptions) ProtoMessage() {}
 
 func (x *AttestationServiceInitResponse_SigningOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[23]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +1848,7 @@ func (x *AttestationServiceInitResponse_SigningOptions) ProtoReflect() protorefl
 
 // Deprecated: Use AttestationServiceInitResponse_SigningOptions.ProtoReflect.Descriptor instead.
 func (*AttestationServiceInitResponse_SigningOptions) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{10, 1}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *AttestationServiceInitResponse_SigningOptions) GetTimestampAuthorityUrl() string {
@@ -1583,7 +1875,7 @@ type AttestationServiceStoreResponse_Result struct {
 
 func (x *AttestationServiceStoreResponse_Result) Reset() {
 	*x = AttestationServiceStoreResponse_Result{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[24]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +1887,7 @@ func (x *AttestationServiceStoreResponse_Result) String() string {
 func (*AttestationServiceStoreResponse_Result) ProtoMessage() {}
 
 func (x *AttestationServiceStoreResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[24]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +1900,7 @@ func (x *AttestationServiceStoreResponse_Result) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttestationServiceStoreResponse_Result.ProtoReflect.Descriptor instead.
 func (*AttestationServiceStoreResponse_Result) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{12, 0}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *AttestationServiceStoreResponse_Result) GetDigest() string {
@@ -1631,7 +1923,7 @@ type WorkflowRunServiceViewResponse_Result struct {
 
 func (x *WorkflowRunServiceViewResponse_Result) Reset() {
 	*x = WorkflowRunServiceViewResponse_Result{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[25]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1643,7 +1935,7 @@ func (x *WorkflowRunServiceViewResponse_Result) String() string {
 func (*WorkflowRunServiceViewResponse_Result) ProtoMessage() {}
 
 func (x *WorkflowRunServiceViewResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[25]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1656,7 +1948,7 @@ func (x *WorkflowRunServiceViewResponse_Result) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use WorkflowRunServiceViewResponse_Result.ProtoReflect.Descriptor instead.
 func (*WorkflowRunServiceViewResponse_Result) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{18, 0}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *WorkflowRunServiceViewResponse_Result) GetOrgName() string {
@@ -1699,7 +1991,7 @@ type WorkflowRunServiceViewResponse_VerificationResult struct {
 
 func (x *WorkflowRunServiceViewResponse_VerificationResult) Reset() {
 	*x = WorkflowRunServiceViewResponse_VerificationResult{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[26]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1711,7 +2003,7 @@ func (x *WorkflowRunServiceViewResponse_VerificationResult) String() string {
 func (*WorkflowRunServiceViewResponse_VerificationResult) ProtoMessage() {}
 
 func (x *WorkflowRunServiceViewResponse_VerificationResult) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[26]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1724,7 +2016,7 @@ func (x *WorkflowRunServiceViewResponse_VerificationResult) ProtoReflect() proto
 
 // Deprecated: Use WorkflowRunServiceViewResponse_VerificationResult.ProtoReflect.Descriptor instead.
 func (*WorkflowRunServiceViewResponse_VerificationResult) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{18, 1}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{22, 1}
 }
 
 func (x *WorkflowRunServiceViewResponse_VerificationResult) GetVerified() bool {
@@ -1751,7 +2043,7 @@ type AttestationServiceGetUploadCredsResponse_Result struct {
 
 func (x *AttestationServiceGetUploadCredsResponse_Result) Reset() {
 	*x = AttestationServiceGetUploadCredsResponse_Result{}
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[27]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1763,7 +2055,7 @@ func (x *AttestationServiceGetUploadCredsResponse_Result) String() string {
 func (*AttestationServiceGetUploadCredsResponse_Result) ProtoMessage() {}
 
 func (x *AttestationServiceGetUploadCredsResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[27]
+	mi := &file_controlplane_v1_workflow_run_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1776,7 +2068,7 @@ func (x *AttestationServiceGetUploadCredsResponse_Result) ProtoReflect() protore
 
 // Deprecated: Use AttestationServiceGetUploadCredsResponse_Result.ProtoReflect.Descriptor instead.
 func (*AttestationServiceGetUploadCredsResponse_Result) Descriptor() ([]byte, []int) {
-	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{20, 0}
+	return file_controlplane_v1_workflow_run_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *AttestationServiceGetUploadCredsResponse_Result) GetToken() string {
@@ -1797,7 +2089,7 @@ var File_controlplane_v1_workflow_run_proto protoreflect.FileDescriptor
 
 const file_controlplane_v1_workflow_run_proto_rawDesc = "" +
 	"\n" +
-	"\"controlplane/v1/workflow_run.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a controlplane/v1/pagination.proto\x1a'controlplane/v1/response_messages.proto\x1a)workflowcontract/v1/crafting_schema.proto\"\xc3\x01\n" +
+	"\"controlplane/v1/workflow_run.proto\x12\x0fcontrolplane.v1\x1a\x1bbuf/validate/validate.proto\x1a controlplane/v1/pagination.proto\x1a&controlplane/v1/policy_exception.proto\x1a'controlplane/v1/response_messages.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a)workflowcontract/v1/crafting_schema.proto\"\xc3\x01\n" +
 	"\x1bFindOrCreateWorkflowRequest\x12,\n" +
 	"\rworkflow_name\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fworkflowName\x12*\n" +
 	"\fproject_name\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vprojectName\x12#\n" +
@@ -1823,7 +2115,11 @@ const file_controlplane_v1_workflow_run_proto_rawDesc = "" +
 	"\borg_name\x18\x03 \x01(\tR\aorgName\"\xa8\x01\n" +
 	"(AttestationServiceGetPolicyGroupResponse\x126\n" +
 	"\x05group\x18\x01 \x01(\v2 .workflowcontract.v1.PolicyGroupR\x05group\x12D\n" +
-	"\treference\x18\x02 \x01(\v2&.controlplane.v1.RemotePolicyReferenceR\treference\"\xad\x01\n" +
+	"\treference\x18\x02 \x01(\v2&.controlplane.v1.RemotePolicyReferenceR\treference\"a\n" +
+	"-AttestationServiceListPolicyExceptionsRequest\x120\n" +
+	"\x0fworkflow_run_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\rworkflowRunId\"n\n" +
+	".AttestationServiceListPolicyExceptionsResponse\x12<\n" +
+	"\x06result\x18\x01 \x03(\v2$.controlplane.v1.PolicyExceptionItemR\x06result\"\xad\x01\n" +
 	"$AttestationServiceGetContractRequest\x12+\n" +
 	"\x11contract_revision\x18\x01 \x01(\x05R\x10contractRevision\x12,\n" +
 	"\rworkflow_name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fworkflowName\x12*\n" +
@@ -1896,6 +2192,34 @@ const file_controlplane_v1_workflow_run_proto_rawDesc = "" +
 	"\x06result\x18\x01 \x03(\v2 .controlplane.v1.WorkflowRunItemR\x06result\x12I\n" +
 	"\n" +
 	"pagination\x18\x02 \x01(\v2).controlplane.v1.CursorPaginationResponseR\n" +
+	"pagination\"\x82\n" +
+	"\n" +
+	"\x1fWorkflowRunServiceSearchRequest\x12!\n" +
+	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12\xac\x01\n" +
+	"\rworkflow_name\x18\x02 \x01(\tB\x86\x01\xbaH\x82\x01\xba\x01|\n" +
+	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')\xd8\x01\x01R\fworkflowName\x12'\n" +
+	"\x0fmaterial_digest\x18\x03 \x01(\tR\x0ematerialDigest\x12h\n" +
+	"\rmaterial_type\x18\x04 \x01(\x0e29.workflowcontract.v1.CraftingSchema.Material.MaterialTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\fmaterialType\x12!\n" +
+	"\fsubject_name\x18\x05 \x01(\tR\vsubjectName\x12'\n" +
+	"\x0fannotation_name\x18\x06 \x01(\tR\x0eannotationName\x12)\n" +
+	"\x10annotation_value\x18\a \x01(\tR\x0fannotationValue\x12`\n" +
+	"\vrunner_type\x18\b \x01(\x0e25.workflowcontract.v1.CraftingSchema.Runner.RunnerTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"runnerType\x12H\n" +
+	"\rpolicy_status\x18\t \x01(\x0e2#.controlplane.v1.PolicyStatusFilterR\fpolicyStatus\x127\n" +
+	"\x15has_policy_violations\x18\n" +
+	" \x01(\bH\x00R\x13hasPolicyViolations\x88\x01\x01\x12?\n" +
+	"\rcreated_after\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12H\n" +
+	"\n" +
+	"pagination\x18\r \x01(\v2(.controlplane.v1.CursorPaginationRequestR\n" +
+	"pagination:\xb5\x02\xbaH\xb1\x02\x1a\x8e\x01\n" +
+	"\"search_workflow_project_dependency\x120project_name must be set if workflow_name is set\x1a6!(this.workflow_name != '' && this.project_name == '')\x1a\x9d\x01\n" +
+	"%search_annotation_value_requires_name\x126annotation_name must be set if annotation_value is set\x1a<!(this.annotation_value != '' && this.annotation_name == '')B\x18\n" +
+	"\x16_has_policy_violations\"\xa7\x01\n" +
+	" WorkflowRunServiceSearchResponse\x128\n" +
+	"\x06result\x18\x01 \x03(\v2 .controlplane.v1.WorkflowRunItemR\x06result\x12I\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2).controlplane.v1.CursorPaginationResponseR\n" +
 	"pagination\"\x84\x01\n" +
 	"\x1dWorkflowRunServiceViewRequest\x12\x1a\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x02id\x12!\n" +
@@ -1918,7 +2242,7 @@ const file_controlplane_v1_workflow_run_proto_rawDesc = "" +
 	"\x06result\x18\x01 \x01(\v2@.controlplane.v1.AttestationServiceGetUploadCredsResponse.ResultR\x06result\x1aY\n" +
 	"\x06Result\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
-	"\abackend\x18\x03 \x01(\v2\x1f.controlplane.v1.CASBackendItemR\abackend2\xd3\a\n" +
+	"\abackend\x18\x03 \x01(\v2\x1f.controlplane.v1.CASBackendItemR\abackend2\xed\b\n" +
 	"\x12AttestationService\x12s\n" +
 	"\x14FindOrCreateWorkflow\x12,.controlplane.v1.FindOrCreateWorkflowRequest\x1a-.controlplane.v1.FindOrCreateWorkflowResponse\x12|\n" +
 	"\vGetContract\x125.controlplane.v1.AttestationServiceGetContractRequest\x1a6.controlplane.v1.AttestationServiceGetContractResponse\x12g\n" +
@@ -1927,10 +2251,12 @@ const file_controlplane_v1_workflow_run_proto_rawDesc = "" +
 	"\x0eGetUploadCreds\x128.controlplane.v1.AttestationServiceGetUploadCredsRequest\x1a9.controlplane.v1.AttestationServiceGetUploadCredsResponse\x12m\n" +
 	"\x06Cancel\x120.controlplane.v1.AttestationServiceCancelRequest\x1a1.controlplane.v1.AttestationServiceCancelResponse\x12v\n" +
 	"\tGetPolicy\x123.controlplane.v1.AttestationServiceGetPolicyRequest\x1a4.controlplane.v1.AttestationServiceGetPolicyResponse\x12\x85\x01\n" +
-	"\x0eGetPolicyGroup\x128.controlplane.v1.AttestationServiceGetPolicyGroupRequest\x1a9.controlplane.v1.AttestationServiceGetPolicyGroupResponse2\xe6\x01\n" +
+	"\x0eGetPolicyGroup\x128.controlplane.v1.AttestationServiceGetPolicyGroupRequest\x1a9.controlplane.v1.AttestationServiceGetPolicyGroupResponse\x12\x97\x01\n" +
+	"\x14ListPolicyExceptions\x12>.controlplane.v1.AttestationServiceListPolicyExceptionsRequest\x1a?.controlplane.v1.AttestationServiceListPolicyExceptionsResponse2\xd5\x02\n" +
 	"\x12WorkflowRunService\x12g\n" +
 	"\x04List\x12..controlplane.v1.WorkflowRunServiceListRequest\x1a/.controlplane.v1.WorkflowRunServiceListResponse\x12g\n" +
-	"\x04View\x12..controlplane.v1.WorkflowRunServiceViewRequest\x1a/.controlplane.v1.WorkflowRunServiceViewResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
+	"\x04View\x12..controlplane.v1.WorkflowRunServiceViewRequest\x1a/.controlplane.v1.WorkflowRunServiceViewResponse\x12m\n" +
+	"\x06Search\x120.controlplane.v1.WorkflowRunServiceSearchRequest\x1a1.controlplane.v1.WorkflowRunServiceSearchResponseBLZJgithub.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1;v1b\x06proto3"
 
 var (
 	file_controlplane_v1_workflow_run_proto_rawDescOnce sync.Once
@@ -1945,7 +2271,7 @@ func file_controlplane_v1_workflow_run_proto_rawDescGZIP() []byte {
 }
 
 var file_controlplane_v1_workflow_run_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_controlplane_v1_workflow_run_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_controlplane_v1_workflow_run_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_controlplane_v1_workflow_run_proto_goTypes = []any{
 	(AttestationServiceCancelRequest_TriggerType)(0),          // 0: controlplane.v1.AttestationServiceCancelRequest.TriggerType
 	(*FindOrCreateWorkflowRequest)(nil),                       // 1: controlplane.v1.FindOrCreateWorkflowRequest
@@ -1955,95 +2281,115 @@ var file_controlplane_v1_workflow_run_proto_goTypes = []any{
 	(*RemotePolicyReference)(nil),                             // 5: controlplane.v1.RemotePolicyReference
 	(*AttestationServiceGetPolicyGroupRequest)(nil),           // 6: controlplane.v1.AttestationServiceGetPolicyGroupRequest
 	(*AttestationServiceGetPolicyGroupResponse)(nil),          // 7: controlplane.v1.AttestationServiceGetPolicyGroupResponse
-	(*AttestationServiceGetContractRequest)(nil),              // 8: controlplane.v1.AttestationServiceGetContractRequest
-	(*AttestationServiceGetContractResponse)(nil),             // 9: controlplane.v1.AttestationServiceGetContractResponse
-	(*AttestationServiceInitRequest)(nil),                     // 10: controlplane.v1.AttestationServiceInitRequest
-	(*AttestationServiceInitResponse)(nil),                    // 11: controlplane.v1.AttestationServiceInitResponse
-	(*AttestationServiceStoreRequest)(nil),                    // 12: controlplane.v1.AttestationServiceStoreRequest
-	(*AttestationServiceStoreResponse)(nil),                   // 13: controlplane.v1.AttestationServiceStoreResponse
-	(*AttestationServiceCancelRequest)(nil),                   // 14: controlplane.v1.AttestationServiceCancelRequest
-	(*AttestationServiceCancelResponse)(nil),                  // 15: controlplane.v1.AttestationServiceCancelResponse
-	(*WorkflowRunServiceListRequest)(nil),                     // 16: controlplane.v1.WorkflowRunServiceListRequest
-	(*WorkflowRunServiceListResponse)(nil),                    // 17: controlplane.v1.WorkflowRunServiceListResponse
-	(*WorkflowRunServiceViewRequest)(nil),                     // 18: controlplane.v1.WorkflowRunServiceViewRequest
-	(*WorkflowRunServiceViewResponse)(nil),                    // 19: controlplane.v1.WorkflowRunServiceViewResponse
-	(*AttestationServiceGetUploadCredsRequest)(nil),           // 20: controlplane.v1.AttestationServiceGetUploadCredsRequest
-	(*AttestationServiceGetUploadCredsResponse)(nil),          // 21: controlplane.v1.AttestationServiceGetUploadCredsResponse
-	(*AttestationServiceGetContractResponse_Result)(nil),      // 22: controlplane.v1.AttestationServiceGetContractResponse.Result
-	(*AttestationServiceInitResponse_Result)(nil),             // 23: controlplane.v1.AttestationServiceInitResponse.Result
-	(*AttestationServiceInitResponse_SigningOptions)(nil),     // 24: controlplane.v1.AttestationServiceInitResponse.SigningOptions
-	(*AttestationServiceStoreResponse_Result)(nil),            // 25: controlplane.v1.AttestationServiceStoreResponse.Result
-	(*WorkflowRunServiceViewResponse_Result)(nil),             // 26: controlplane.v1.WorkflowRunServiceViewResponse.Result
-	(*WorkflowRunServiceViewResponse_VerificationResult)(nil), // 27: controlplane.v1.WorkflowRunServiceViewResponse.VerificationResult
-	(*AttestationServiceGetUploadCredsResponse_Result)(nil),   // 28: controlplane.v1.AttestationServiceGetUploadCredsResponse.Result
-	(*WorkflowItem)(nil),                                      // 29: controlplane.v1.WorkflowItem
-	(*v1.Policy)(nil),                                         // 30: workflowcontract.v1.Policy
-	(*v1.PolicyGroup)(nil),                                    // 31: workflowcontract.v1.PolicyGroup
-	(v1.CraftingSchema_Runner_RunnerType)(0),                  // 32: workflowcontract.v1.CraftingSchema.Runner.RunnerType
-	(RunStatus)(0),                                            // 33: controlplane.v1.RunStatus
-	(PolicyViolationsFilter)(0),                               // 34: controlplane.v1.PolicyViolationsFilter
-	(PolicyStatusFilter)(0),                                   // 35: controlplane.v1.PolicyStatusFilter
-	(PolicyGatesFilter)(0),                                    // 36: controlplane.v1.PolicyGatesFilter
-	(*CursorPaginationRequest)(nil),                           // 37: controlplane.v1.CursorPaginationRequest
-	(*WorkflowRunItem)(nil),                                   // 38: controlplane.v1.WorkflowRunItem
-	(*CursorPaginationResponse)(nil),                          // 39: controlplane.v1.CursorPaginationResponse
-	(*WorkflowContractVersionItem)(nil),                       // 40: controlplane.v1.WorkflowContractVersionItem
-	(*AttestationItem)(nil),                                   // 41: controlplane.v1.AttestationItem
-	(*CASBackendItem)(nil),                                    // 42: controlplane.v1.CASBackendItem
+	(*AttestationServiceListPolicyExceptionsRequest)(nil),     // 8: controlplane.v1.AttestationServiceListPolicyExceptionsRequest
+	(*AttestationServiceListPolicyExceptionsResponse)(nil),    // 9: controlplane.v1.AttestationServiceListPolicyExceptionsResponse
+	(*AttestationServiceGetContractRequest)(nil),              // 10: controlplane.v1.AttestationServiceGetContractRequest
+	(*AttestationServiceGetContractResponse)(nil),             // 11: controlplane.v1.AttestationServiceGetContractResponse
+	(*AttestationServiceInitRequest)(nil),                     // 12: controlplane.v1.AttestationServiceInitRequest
+	(*AttestationServiceInitResponse)(nil),                    // 13: controlplane.v1.AttestationServiceInitResponse
+	(*AttestationServiceStoreRequest)(nil),                    // 14: controlplane.v1.AttestationServiceStoreRequest
+	(*AttestationServiceStoreResponse)(nil),                   // 15: controlplane.v1.AttestationServiceStoreResponse
+	(*AttestationServiceCancelRequest)(nil),                   // 16: controlplane.v1.AttestationServiceCancelRequest
+	(*AttestationServiceCancelResponse)(nil),                  // 17: controlplane.v1.AttestationServiceCancelResponse
+	(*WorkflowRunServiceListRequest)(nil),                     // 18: controlplane.v1.WorkflowRunServiceListRequest
+	(*WorkflowRunServiceListResponse)(nil),                    // 19: controlplane.v1.WorkflowRunServiceListResponse
+	(*WorkflowRunServiceSearchRequest)(nil),                   // 20: controlplane.v1.WorkflowRunServiceSearchRequest
+	(*WorkflowRunServiceSearchResponse)(nil),                  // 21: controlplane.v1.WorkflowRunServiceSearchResponse
+	(*WorkflowRunServiceViewRequest)(nil),                     // 22: controlplane.v1.WorkflowRunServiceViewRequest
+	(*WorkflowRunServiceViewResponse)(nil),                    // 23: controlplane.v1.WorkflowRunServiceViewResponse
+	(*AttestationServiceGetUploadCredsRequest)(nil),           // 24: controlplane.v1.AttestationServiceGetUploadCredsRequest
+	(*AttestationServiceGetUploadCredsResponse)(nil),          // 25: controlplane.v1.AttestationServiceGetUploadCredsResponse
+	(*AttestationServiceGetContractResponse_Result)(nil),      // 26: controlplane.v1.AttestationServiceGetContractResponse.Result
+	(*AttestationServiceInitResponse_Result)(nil),             // 27: controlplane.v1.AttestationServiceInitResponse.Result
+	(*AttestationServiceInitResponse_SigningOptions)(nil),     // 28: controlplane.v1.AttestationServiceInitResponse.SigningOptions
+	(*AttestationServiceStoreResponse_Result)(nil),            // 29: controlplane.v1.AttestationServiceStoreResponse.Result
+	(*WorkflowRunServiceViewResponse_Result)(nil),             // 30: controlplane.v1.WorkflowRunServiceViewResponse.Result
+	(*WorkflowRunServiceViewResponse_VerificationResult)(nil), // 31: controlplane.v1.WorkflowRunServiceViewResponse.VerificationResult
+	(*AttestationServiceGetUploadCredsResponse_Result)(nil),   // 32: controlplane.v1.AttestationServiceGetUploadCredsResponse.Result
+	(*WorkflowItem)(nil),                                      // 33: controlplane.v1.WorkflowItem
+	(*v1.Policy)(nil),                                         // 34: workflowcontract.v1.Policy
+	(*v1.PolicyGroup)(nil),                                    // 35: workflowcontract.v1.PolicyGroup
+	(*PolicyExceptionItem)(nil),                               // 36: controlplane.v1.PolicyExceptionItem
+	(v1.CraftingSchema_Runner_RunnerType)(0),                  // 37: workflowcontract.v1.CraftingSchema.Runner.RunnerType
+	(RunStatus)(0),                                            // 38: controlplane.v1.RunStatus
+	(PolicyViolationsFilter)(0),                               // 39: controlplane.v1.PolicyViolationsFilter
+	(PolicyStatusFilter)(0),                                   // 40: controlplane.v1.PolicyStatusFilter
+	(PolicyGatesFilter)(0),                                    // 41: controlplane.v1.PolicyGatesFilter
+	(*CursorPaginationRequest)(nil),                           // 42: controlplane.v1.CursorPaginationRequest
+	(*WorkflowRunItem)(nil),                                   // 43: controlplane.v1.WorkflowRunItem
+	(*CursorPaginationResponse)(nil),                          // 44: controlplane.v1.CursorPaginationResponse
+	(v1.CraftingSchema_Material_MaterialType)(0),              // 45: workflowcontract.v1.CraftingSchema.Material.MaterialType
+	(*timestamppb.Timestamp)(nil),                             // 46: google.protobuf.Timestamp
+	(*WorkflowContractVersionItem)(nil),                       // 47: controlplane.v1.WorkflowContractVersionItem
+	(*AttestationItem)(nil),                                   // 48: controlplane.v1.AttestationItem
+	(*CASBackendItem)(nil),                                    // 49: controlplane.v1.CASBackendItem
 }
 var file_controlplane_v1_workflow_run_proto_depIdxs = []int32{
-	29, // 0: controlplane.v1.FindOrCreateWorkflowResponse.result:type_name -> controlplane.v1.WorkflowItem
-	30, // 1: controlplane.v1.AttestationServiceGetPolicyResponse.policy:type_name -> workflowcontract.v1.Policy
+	33, // 0: controlplane.v1.FindOrCreateWorkflowResponse.result:type_name -> controlplane.v1.WorkflowItem
+	34, // 1: controlplane.v1.AttestationServiceGetPolicyResponse.policy:type_name -> workflowcontract.v1.Policy
 	5,  // 2: controlplane.v1.AttestationServiceGetPolicyResponse.reference:type_name -> controlplane.v1.RemotePolicyReference
-	31, // 3: controlplane.v1.AttestationServiceGetPolicyGroupResponse.group:type_name -> workflowcontract.v1.PolicyGroup
+	35, // 3: controlplane.v1.AttestationServiceGetPolicyGroupResponse.group:type_name -> workflowcontract.v1.PolicyGroup
 	5,  // 4: controlplane.v1.AttestationServiceGetPolicyGroupResponse.reference:type_name -> controlplane.v1.RemotePolicyReference
-	22, // 5: controlplane.v1.AttestationServiceGetContractResponse.result:type_name -> controlplane.v1.AttestationServiceGetContractResponse.Result
-	32, // 6: controlplane.v1.AttestationServiceInitRequest.runner:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
-	23, // 7: controlplane.v1.AttestationServiceInitResponse.result:type_name -> controlplane.v1.AttestationServiceInitResponse.Result
-	25, // 8: controlplane.v1.AttestationServiceStoreResponse.result:type_name -> controlplane.v1.AttestationServiceStoreResponse.Result
-	0,  // 9: controlplane.v1.AttestationServiceCancelRequest.trigger:type_name -> controlplane.v1.AttestationServiceCancelRequest.TriggerType
-	33, // 10: controlplane.v1.WorkflowRunServiceListRequest.status:type_name -> controlplane.v1.RunStatus
-	34, // 11: controlplane.v1.WorkflowRunServiceListRequest.policy_violations:type_name -> controlplane.v1.PolicyViolationsFilter
-	35, // 12: controlplane.v1.WorkflowRunServiceListRequest.policy_status:type_name -> controlplane.v1.PolicyStatusFilter
-	36, // 13: controlplane.v1.WorkflowRunServiceListRequest.policy_gates:type_name -> controlplane.v1.PolicyGatesFilter
-	37, // 14: controlplane.v1.WorkflowRunServiceListRequest.pagination:type_name -> controlplane.v1.CursorPaginationRequest
-	38, // 15: controlplane.v1.WorkflowRunServiceListResponse.result:type_name -> controlplane.v1.WorkflowRunItem
-	39, // 16: controlplane.v1.WorkflowRunServiceListResponse.pagination:type_name -> controlplane.v1.CursorPaginationResponse
-	26, // 17: controlplane.v1.WorkflowRunServiceViewResponse.result:type_name -> controlplane.v1.WorkflowRunServiceViewResponse.Result
-	28, // 18: controlplane.v1.AttestationServiceGetUploadCredsResponse.result:type_name -> controlplane.v1.AttestationServiceGetUploadCredsResponse.Result
-	29, // 19: controlplane.v1.AttestationServiceGetContractResponse.Result.workflow:type_name -> controlplane.v1.WorkflowItem
-	40, // 20: controlplane.v1.AttestationServiceGetContractResponse.Result.contract:type_name -> controlplane.v1.WorkflowContractVersionItem
-	38, // 21: controlplane.v1.AttestationServiceInitResponse.Result.workflow_run:type_name -> controlplane.v1.WorkflowRunItem
-	24, // 22: controlplane.v1.AttestationServiceInitResponse.Result.signing_options:type_name -> controlplane.v1.AttestationServiceInitResponse.SigningOptions
-	38, // 23: controlplane.v1.WorkflowRunServiceViewResponse.Result.workflow_run:type_name -> controlplane.v1.WorkflowRunItem
-	41, // 24: controlplane.v1.WorkflowRunServiceViewResponse.Result.attestation:type_name -> controlplane.v1.AttestationItem
-	27, // 25: controlplane.v1.WorkflowRunServiceViewResponse.Result.verification:type_name -> controlplane.v1.WorkflowRunServiceViewResponse.VerificationResult
-	42, // 26: controlplane.v1.AttestationServiceGetUploadCredsResponse.Result.backend:type_name -> controlplane.v1.CASBackendItem
-	1,  // 27: controlplane.v1.AttestationService.FindOrCreateWorkflow:input_type -> controlplane.v1.FindOrCreateWorkflowRequest
-	8,  // 28: controlplane.v1.AttestationService.GetContract:input_type -> controlplane.v1.AttestationServiceGetContractRequest
-	10, // 29: controlplane.v1.AttestationService.Init:input_type -> controlplane.v1.AttestationServiceInitRequest
-	12, // 30: controlplane.v1.AttestationService.Store:input_type -> controlplane.v1.AttestationServiceStoreRequest
-	20, // 31: controlplane.v1.AttestationService.GetUploadCreds:input_type -> controlplane.v1.AttestationServiceGetUploadCredsRequest
-	14, // 32: controlplane.v1.AttestationService.Cancel:input_type -> controlplane.v1.AttestationServiceCancelRequest
-	3,  // 33: controlplane.v1.AttestationService.GetPolicy:input_type -> controlplane.v1.AttestationServiceGetPolicyRequest
-	6,  // 34: controlplane.v1.AttestationService.GetPolicyGroup:input_type -> controlplane.v1.AttestationServiceGetPolicyGroupRequest
-	16, // 35: controlplane.v1.WorkflowRunService.List:input_type -> controlplane.v1.WorkflowRunServiceListRequest
-	18, // 36: controlplane.v1.WorkflowRunService.View:input_type -> controlplane.v1.WorkflowRunServiceViewRequest
-	2,  // 37: controlplane.v1.AttestationService.FindOrCreateWorkflow:output_type -> controlplane.v1.FindOrCreateWorkflowResponse
-	9,  // 38: controlplane.v1.AttestationService.GetContract:output_type -> controlplane.v1.AttestationServiceGetContractResponse
-	11, // 39: controlplane.v1.AttestationService.Init:output_type -> controlplane.v1.AttestationServiceInitResponse
-	13, // 40: controlplane.v1.AttestationService.Store:output_type -> controlplane.v1.AttestationServiceStoreResponse
-	21, // 41: controlplane.v1.AttestationService.GetUploadCreds:output_type -> controlplane.v1.AttestationServiceGetUploadCredsResponse
-	15, // 42: controlplane.v1.AttestationService.Cancel:output_type -> controlplane.v1.AttestationServiceCancelResponse
-	4,  // 43: controlplane.v1.AttestationService.GetPolicy:output_type -> controlplane.v1.AttestationServiceGetPolicyResponse
-	7,  // 44: controlplane.v1.AttestationService.GetPolicyGroup:output_type -> controlplane.v1.AttestationServiceGetPolicyGroupResponse
-	17, // 45: controlplane.v1.WorkflowRunService.List:output_type -> controlplane.v1.WorkflowRunServiceListResponse
-	19, // 46: controlplane.v1.WorkflowRunService.View:output_type -> controlplane.v1.WorkflowRunServiceViewResponse
-	37, // [37:47] is the sub-list for method output_type
-	27, // [27:37] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	36, // 5: controlplane.v1.AttestationServiceListPolicyExceptionsResponse.result:type_name -> controlplane.v1.PolicyExceptionItem
+	26, // 6: controlplane.v1.AttestationServiceGetContractResponse.result:type_name -> controlplane.v1.AttestationServiceGetContractResponse.Result
+	37, // 7: controlplane.v1.AttestationServiceInitRequest.runner:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
+	27, // 8: controlplane.v1.AttestationServiceInitResponse.result:type_name -> controlplane.v1.AttestationServiceInitResponse.Result
+	29, // 9: controlplane.v1.AttestationServiceStoreResponse.result:type_name -> controlplane.v1.AttestationServiceStoreResponse.Result
+	0,  // 10: controlplane.v1.AttestationServiceCancelRequest.trigger:type_name -> controlplane.v1.AttestationServiceCancelRequest.TriggerType
+	38, // 11: controlplane.v1.WorkflowRunServiceListRequest.status:type_name -> controlplane.v1.RunStatus
+	39, // 12: controlplane.v1.WorkflowRunServiceListRequest.policy_violations:type_name -> controlplane.v1.PolicyViolationsFilter
+	40, // 13: controlplane.v1.WorkflowRunServiceListRequest.policy_status:type_name -> controlplane.v1.PolicyStatusFilter
+	41, // 14: controlplane.v1.WorkflowRunServiceListRequest.policy_gates:type_name -> controlplane.v1.PolicyGatesFilter
+	42, // 15: controlplane.v1.WorkflowRunServiceListRequest.pagination:type_name -> controlplane.v1.CursorPaginationRequest
+	43, // 16: controlplane.v1.WorkflowRunServiceListResponse.result:type_name -> controlplane.v1.WorkflowRunItem
+	44, // 17: controlplane.v1.WorkflowRunServiceListResponse.pagination:type_name -> controlplane.v1.CursorPaginationResponse
+	45, // 18: controlplane.v1.WorkflowRunServiceSearchRequest.material_type:type_name -> workflowcontract.v1.CraftingSchema.Material.MaterialType
+	37, // 19: controlplane.v1.WorkflowRunServiceSearchRequest.runner_type:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
+	40, // 20: controlplane.v1.WorkflowRunServiceSearchRequest.policy_status:type_name -> controlplane.v1.PolicyStatusFilter
+	46, // 21: controlplane.v1.WorkflowRunServiceSearchRequest.created_after:type_name -> google.protobuf.Timestamp
+	46, // 22: controlplane.v1.WorkflowRunServiceSearchRequest.created_before:type_name -> google.protobuf.Timestamp
+	42, // 23: controlplane.v1.WorkflowRunServiceSearchRequest.pagination:type_name -> controlplane.v1.CursorPaginationRequest
+	43, // 24: controlplane.v1.WorkflowRunServiceSearchResponse.result:type_name -> controlplane.v1.WorkflowRunItem
+	44, // 25: controlplane.v1.WorkflowRunServiceSearchResponse.pagination:type_name -> controlplane.v1.CursorPaginationResponse
+	30, // 26: controlplane.v1.WorkflowRunServiceViewResponse.result:type_name -> controlplane.v1.WorkflowRunServiceViewResponse.Result
+	32, // 27: controlplane.v1.AttestationServiceGetUploadCredsResponse.result:type_name -> controlplane.v1.AttestationServiceGetUploadCredsResponse.Result
+	33, // 28: controlplane.v1.AttestationServiceGetContractResponse.Result.workflow:type_name -> controlplane.v1.WorkflowItem
+	47, // 29: controlplane.v1.AttestationServiceGetContractResponse.Result.contract:type_name -> controlplane.v1.WorkflowContractVersionItem
+	43, // 30: controlplane.v1.AttestationServiceInitResponse.Result.workflow_run:type_name -> controlplane.v1.WorkflowRunItem
+	28, // 31: controlplane.v1.AttestationServiceInitResponse.Result.signing_options:type_name -> controlplane.v1.AttestationServiceInitResponse.SigningOptions
+	43, // 32: controlplane.v1.WorkflowRunServiceViewResponse.Result.workflow_run:type_name -> controlplane.v1.WorkflowRunItem
+	48, // 33: controlplane.v1.WorkflowRunServiceViewResponse.Result.attestation:type_name -> controlplane.v1.AttestationItem
+	31, // 34: controlplane.v1.WorkflowRunServiceViewResponse.Result.verification:type_name -> controlplane.v1.WorkflowRunServiceViewResponse.VerificationResult
+	49, // 35: controlplane.v1.AttestationServiceGetUploadCredsResponse.Result.backend:type_name -> controlplane.v1.CASBackendItem
+	1,  // 36: controlplane.v1.AttestationService.FindOrCreateWorkflow:input_type -> controlplane.v1.FindOrCreateWorkflowRequest
+	10, // 37: controlplane.v1.AttestationService.GetContract:input_type -> controlplane.v1.AttestationServiceGetContractRequest
+	12, // 38: controlplane.v1.AttestationService.Init:input_type -> controlplane.v1.AttestationServiceInitRequest
+	14, // 39: controlplane.v1.AttestationService.Store:input_type -> controlplane.v1.AttestationServiceStoreRequest
+	24, // 40: controlplane.v1.AttestationService.GetUploadCreds:input_type -> controlplane.v1.AttestationServiceGetUploadCredsRequest
+	16, // 41: controlplane.v1.AttestationService.Cancel:input_type -> controlplane.v1.AttestationServiceCancelRequest
+	3,  // 42: controlplane.v1.AttestationService.GetPolicy:input_type -> controlplane.v1.AttestationServiceGetPolicyRequest
+	6,  // 43: controlplane.v1.AttestationService.GetPolicyGroup:input_type -> controlplane.v1.AttestationServiceGetPolicyGroupRequest
+	8,  // 44: controlplane.v1.AttestationService.ListPolicyExceptions:input_type -> controlplane.v1.AttestationServiceListPolicyExceptionsRequest
+	18, // 45: controlplane.v1.WorkflowRunService.List:input_type -> controlplane.v1.WorkflowRunServiceListRequest
+	22, // 46: controlplane.v1.WorkflowRunService.View:input_type -> controlplane.v1.WorkflowRunServiceViewRequest
+	20, // 47: controlplane.v1.WorkflowRunService.Search:input_type -> controlplane.v1.WorkflowRunServiceSearchRequest
+	2,  // 48: controlplane.v1.AttestationService.FindOrCreateWorkflow:output_type -> controlplane.v1.FindOrCreateWorkflowResponse
+	11, // 49: controlplane.v1.AttestationService.GetContract:output_type -> controlplane.v1.AttestationServiceGetContractResponse
+	13, // 50: controlplane.v1.AttestationService.Init:output_type -> controlplane.v1.AttestationServiceInitResponse
+	15, // 51: controlplane.v1.AttestationService.Store:output_type -> controlplane.v1.AttestationServiceStoreResponse
+	25, // 52: controlplane.v1.AttestationService.GetUploadCreds:output_type -> controlplane.v1.AttestationServiceGetUploadCredsResponse
+	17, // 53: controlplane.v1.AttestationService.Cancel:output_type -> controlplane.v1.AttestationServiceCancelResponse
+	4,  // 54: controlplane.v1.AttestationService.GetPolicy:output_type -> controlplane.v1.AttestationServiceGetPolicyResponse
+	7,  // 55: controlplane.v1.AttestationService.GetPolicyGroup:output_type -> controlplane.v1.AttestationServiceGetPolicyGroupResponse
+	9,  // 56: controlplane.v1.AttestationService.ListPolicyExceptions:output_type -> controlplane.v1.AttestationServiceListPolicyExceptionsResponse
+	19, // 57: controlplane.v1.WorkflowRunService.List:output_type -> controlplane.v1.WorkflowRunServiceListResponse
+	23, // 58: controlplane.v1.WorkflowRunService.View:output_type -> controlplane.v1.WorkflowRunServiceViewResponse
+	21, // 59: controlplane.v1.WorkflowRunService.Search:output_type -> controlplane.v1.WorkflowRunServiceSearchResponse
+	48, // [48:60] is the sub-list for method output_type
+	36, // [36:48] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_controlplane_v1_workflow_run_proto_init() }
@@ -2052,10 +2398,12 @@ func file_controlplane_v1_workflow_run_proto_init() {
 		return
 	}
 	file_controlplane_v1_pagination_proto_init()
+	file_controlplane_v1_policy_exception_proto_init()
 	file_controlplane_v1_response_messages_proto_init()
-	file_controlplane_v1_workflow_run_proto_msgTypes[9].OneofWrappers = []any{}
 	file_controlplane_v1_workflow_run_proto_msgTypes[11].OneofWrappers = []any{}
-	file_controlplane_v1_workflow_run_proto_msgTypes[17].OneofWrappers = []any{
+	file_controlplane_v1_workflow_run_proto_msgTypes[13].OneofWrappers = []any{}
+	file_controlplane_v1_workflow_run_proto_msgTypes[19].OneofWrappers = []any{}
+	file_controlplane_v1_workflow_run_proto_msgTypes[21].OneofWrappers = []any{
 		(*WorkflowRunServiceViewRequest_Id)(nil),
 		(*WorkflowRunServiceViewRequest_Digest)(nil),
 	}
@@ -2065,7 +2413,7 @@ func file_controlplane_v1_workflow_run_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_v1_workflow_run_proto_rawDesc), len(file_controlplane_v1_workflow_run_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
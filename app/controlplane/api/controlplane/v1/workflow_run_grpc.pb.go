@@ -42,6 +42,7 @@ const (
 	AttestationService_Cancel_FullMethodName               = "/controlplane.v1.AttestationService/Cancel"
 	AttestationService_GetPolicy_FullMethodName            = "/controlplane.v1.AttestationService/GetPolicy"
 	AttestationService_GetPolicyGroup_FullMethodName       = "/controlplane.v1.AttestationService/GetPolicyGroup"
+	AttestationService_ListPolicyExceptions_FullMethodName = "/controlplane.v1.AttestationService/ListPolicyExceptions"
 )
 
 // AttestationServiceClient is the client API for AttestationService service.
@@ -59,6 +60,8 @@ type AttestationServiceClient interface {
 	// Get policies from remote providers
 	GetPolicy(ctx context.Context, in *AttestationServiceGetPolicyRequest, opts ...grpc.CallOption) (*AttestationServiceGetPolicyResponse, error)
 	GetPolicyGroup(ctx context.Context, in *AttestationServiceGetPolicyGroupRequest, opts ...grpc.CallOption) (*AttestationServiceGetPolicyGroupResponse, error)
+	// Get the active policy exceptions that apply to the workflow run
+	ListPolicyExceptions(ctx context.Context, in *AttestationServiceListPolicyExceptionsRequest, opts ...grpc.CallOption) (*AttestationServiceListPolicyExceptionsResponse, error)
 }
 
 type attestationServiceClient struct {
@@ -141,6 +144,15 @@ func (c *attestationServiceClient) GetPolicyGroup(ctx context.Context, in *Attes
 	return out, nil
 }
 
+func (c *attestationServiceClient) ListPolicyExceptions(ctx context.Context, in *AttestationServiceListPolicyExceptionsRequest, opts ...grpc.CallOption) (*AttestationServiceListPolicyExceptionsResponse, error) {
+	out := new(AttestationServiceListPolicyExceptionsResponse)
+	err := c.cc.Invoke(ctx, AttestationService_ListPolicyExceptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttestationServiceServer is the server API for AttestationService service.
 // All implementations must embed UnimplementedAttestationServiceServer
 // for forward compatibility
@@ -156,6 +168,8 @@ type AttestationServiceServer interface {
 	// Get policies from remote providers
 	GetPolicy(context.Context, *AttestationServiceGetPolicyRequest) (*AttestationServiceGetPolicyResponse, error)
 	GetPolicyGroup(context.Context, *AttestationServiceGetPolicyGroupRequest) (*AttestationServiceGetPolicyGroupResponse, error)
+	// Get the active policy exceptions that apply to the workflow run
+	ListPolicyExceptions(context.Context, *AttestationServiceListPolicyExceptionsRequest) (*AttestationServiceListPolicyExceptionsResponse, error)
 	mustEmbedUnimplementedAttestationServiceServer()
 }
 
@@ -187,6 +201,9 @@ func (UnimplementedAttestationServiceServer) GetPolicy(context.Context, *Attesta
 func (UnimplementedAttestationServiceServer) GetPolicyGroup(context.Context, *AttestationServiceGetPolicyGroupRequest) (*AttestationServiceGetPolicyGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyGroup not implemented")
 }
+func (UnimplementedAttestationServiceServer) ListPolicyExceptions(context.Context, *AttestationServiceListPolicyExceptionsRequest) (*AttestationServiceListPolicyExceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyExceptions not implemented")
+}
 func (UnimplementedAttestationServiceServer) mustEmbedUnimplementedAttestationServiceServer() {}
 
 // UnsafeAttestationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -344,6 +361,24 @@ func _AttestationService_GetPolicyGroup_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttestationService_ListPolicyExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationServiceListPolicyExceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttestationServiceServer).ListPolicyExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttestationService_ListPolicyExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttestationServiceServer).ListPolicyExceptions(ctx, req.(*AttestationServiceListPolicyExceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AttestationService_ServiceDesc is the grpc.ServiceDesc for AttestationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -383,14 +418,19 @@ var AttestationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPolicyGroup",
 			Handler:    _AttestationService_GetPolicyGroup_Handler,
 		},
+		{
+			MethodName: "ListPolicyExceptions",
+			Handler:    _AttestationService_ListPolicyExceptions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controlplane/v1/workflow_run.proto",
 }
 
 const (
-	WorkflowRunService_List_FullMethodName = "/controlplane.v1.WorkflowRunService/List"
-	WorkflowRunService_View_FullMethodName = "/controlplane.v1.WorkflowRunService/View"
+	WorkflowRunService_List_FullMethodName   = "/controlplane.v1.WorkflowRunService/List"
+	WorkflowRunService_View_FullMethodName   = "/controlplane.v1.WorkflowRunService/View"
+	WorkflowRunService_Search_FullMethodName = "/controlplane.v1.WorkflowRunService/Search"
 )
 
 // WorkflowRunServiceClient is the client API for WorkflowRunService service.
@@ -399,6 +439,8 @@ const (
 type WorkflowRunServiceClient interface {
 	List(ctx context.Context, in *WorkflowRunServiceListRequest, opts ...grpc.CallOption) (*WorkflowRunServiceListResponse, error)
 	View(ctx context.Context, in *WorkflowRunServiceViewRequest, opts ...grpc.CallOption) (*WorkflowRunServiceViewResponse, error)
+	// Search runs by the content of their attestations, i.e the runs that included a given SBOM
+	Search(ctx context.Context, in *WorkflowRunServiceSearchRequest, opts ...grpc.CallOption) (*WorkflowRunServiceSearchResponse, error)
 }
 
 type workflowRunServiceClient struct {
@@ -427,12 +469,23 @@ func (c *workflowRunServiceClient) View(ctx context.Context, in *WorkflowRunServ
 	return out, nil
 }
 
+func (c *workflowRunServiceClient) Search(ctx context.Context, in *WorkflowRunServiceSearchRequest, opts ...grpc.CallOption) (*WorkflowRunServiceSearchResponse, error) {
+	out := new(WorkflowRunServiceSearchResponse)
+	err := c.cc.Invoke(ctx, WorkflowRunService_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowRunServiceServer is the server API for WorkflowRunService service.
 // All implementations must embed UnimplementedWorkflowRunServiceServer
 // for forward compatibility
 type WorkflowRunServiceServer interface {
 	List(context.Context, *WorkflowRunServiceListRequest) (*WorkflowRunServiceListResponse, error)
 	View(context.Context, *WorkflowRunServiceViewRequest) (*WorkflowRunServiceViewResponse, error)
+	// Search runs by the content of their attestations, i.e the runs that included a given SBOM
+	Search(context.Context, *WorkflowRunServiceSearchRequest) (*WorkflowRunServiceSearchResponse, error)
 	mustEmbedUnimplementedWorkflowRunServiceServer()
 }
 
@@ -446,6 +499,9 @@ func (UnimplementedWorkflowRunServiceServer) List(context.Context, *WorkflowRunS
 func (UnimplementedWorkflowRunServiceServer) View(context.Context, *WorkflowRunServiceViewRequest) (*WorkflowRunServiceViewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method View not implemented")
 }
+func (UnimplementedWorkflowRunServiceServer) Search(context.Context, *WorkflowRunServiceSearchRequest) (*WorkflowRunServiceSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 func (UnimplementedWorkflowRunServiceServer) mustEmbedUnimplementedWorkflowRunServiceServer() {}
 
 // UnsafeWorkflowRunServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -495,6 +551,24 @@ func _WorkflowRunService_View_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowRunService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkflowRunServiceSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowRunServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkflowRunService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowRunServiceServer).Search(ctx, req.(*WorkflowRunServiceSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorkflowRunService_ServiceDesc is the grpc.ServiceDesc for WorkflowRunService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -510,6 +584,10 @@ var WorkflowRunService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "View",
 			Handler:    _WorkflowRunService_View_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _WorkflowRunService_Search_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controlplane/v1/workflow_run.proto",
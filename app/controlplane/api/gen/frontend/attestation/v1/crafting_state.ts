@@ -325,8 +325,11 @@ export interface PolicyEvaluation_Violation {
   message: string;
   vulnerability?: PolicyVulnerabilityFinding | undefined;
   sast?: PolicySASTFinding | undefined;
-  licenseViolation?:
-    | PolicyLicenseViolationFinding
+  licenseViolation?: PolicyLicenseViolationFinding | undefined;
+  secret?: PolicySecretFinding | undefined;
+  misconfiguration?: PolicyMisconfigurationFinding | undefined;
+  malware?:
+    | PolicyMalwareFinding
     | undefined;
   /**
    * Suppression hint set by the policy. When true the gate count
@@ -336,6 +339,11 @@ export interface PolicyEvaluation_Violation {
    * without interpreting why.
    */
   suppress: boolean;
+  /**
+   * ID of the policy exception that suppressed the violation, set by the
+   * policy verifier when an approved exception of the organization matches it.
+   */
+  exceptionId: string;
 }
 
 export interface PolicyEvaluation_Reference {
@@ -434,6 +442,84 @@ export interface PolicyLicenseViolationFinding {
   assessment?: PolicyAssessmentResult | undefined;
 }
 
+/**
+ * Output schema for leaked secret findings from policy evaluation, i.e gitleaks or trufflehog.
+ * Used when a policy declares finding_type: SECRET.
+ * The secret itself must never be included.
+ */
+export interface PolicySecretFinding {
+  /** Human-readable violation description */
+  message: string;
+  /** Tool-specific rule that detected the secret (e.g., aws-access-token, generic-api-key) */
+  ruleId: string;
+  /** File path where the secret was found */
+  location: string;
+  /** Line number in the file */
+  lineNumber: number;
+  /** Severity level (CRITICAL, HIGH, MEDIUM, LOW) */
+  severity: string;
+  /** Commit that introduced the secret, when the git history was scanned */
+  commit: string;
+  /** Whether the scanner verified that the secret is live */
+  verified: boolean;
+  /** Stable identifier of the leak reported by the scanner (e.g., gitleaks fingerprint) */
+  fingerprint: string;
+  /** Suggested fix, i.e rotating the credential */
+  recommendation: string;
+  /** Optional assessment context. See PolicyAssessmentResult. */
+  assessment?: PolicyAssessmentResult | undefined;
+}
+
+/**
+ * Output schema for misconfiguration findings from policy evaluation, i.e IaC scanners.
+ * Used when a policy declares finding_type: MISCONFIGURATION.
+ */
+export interface PolicyMisconfigurationFinding {
+  /** Human-readable violation description */
+  message: string;
+  /** Tool-specific check identifier (e.g., CKV_AWS_20, AVD-AWS-0086) */
+  checkId: string;
+  /** Severity level (CRITICAL, HIGH, MEDIUM, LOW) */
+  severity: string;
+  /** File path of the offending configuration */
+  location: string;
+  /** Misconfigured resource (e.g., aws_s3_bucket.logs, Deployment/default/web) */
+  resource: string;
+  /** Line number in the file */
+  lineNumber: number;
+  /** Suggested fix */
+  recommendation: string;
+  /** Links to the documentation of the check */
+  references: string[];
+  /** Optional assessment context. See PolicyAssessmentResult. */
+  assessment?: PolicyAssessmentResult | undefined;
+}
+
+/**
+ * Output schema for malware findings from policy evaluation, i.e antivirus scanners.
+ * Used when a policy declares finding_type: MALWARE.
+ */
+export interface PolicyMalwareFinding {
+  /** Human-readable violation description */
+  message: string;
+  /** Signature or rule that matched (e.g., Win.Test.EICAR_HDB-1) */
+  signature: string;
+  /** Path of the infected file */
+  location: string;
+  /** Digest of the infected file (e.g., sha256:deadbeef) */
+  digest: string;
+  /** Package URL of the component containing the file, if any */
+  packagePurl: string;
+  /** Scanner that detected it (e.g., clamav) */
+  engine: string;
+  /** Severity level (CRITICAL, HIGH, MEDIUM, LOW) */
+  severity: string;
+  /** Suggested fix */
+  recommendation: string;
+  /** Optional assessment context. See PolicyAssessmentResult. */
+  assessment?: PolicyAssessmentResult | undefined;
+}
+
 /**
  * Assessment context attached to a policy finding by the policy engine via
  * the chainloop.effective_assessments builtin. Sent to CAS as part of the
@@ -2978,7 +3064,11 @@ function createBasePolicyEvaluation_Violation(): PolicyEvaluation_Violation {
     vulnerability: undefined,
     sast: undefined,
     licenseViolation: undefined,
+    secret: undefined,
+    misconfiguration: undefined,
+    malware: undefined,
     suppress: false,
+    exceptionId: "",
   };
 }
 
@@ -2999,9 +3089,21 @@ export const PolicyEvaluation_Violation = {
     if (message.licenseViolation !== undefined) {
       PolicyLicenseViolationFinding.encode(message.licenseViolation, writer.uint32(42).fork()).ldelim();
     }
+    if (message.secret !== undefined) {
+      PolicySecretFinding.encode(message.secret, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.misconfiguration !== undefined) {
+      PolicyMisconfigurationFinding.encode(message.misconfiguration, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.malware !== undefined) {
+      PolicyMalwareFinding.encode(message.malware, writer.uint32(82).fork()).ldelim();
+    }
     if (message.suppress === true) {
       writer.uint32(48).bool(message.suppress);
     }
+    if (message.exceptionId !== "") {
+      writer.uint32(58).string(message.exceptionId);
+    }
     return writer;
   },
 
@@ -3047,6 +3149,27 @@ export const PolicyEvaluation_Violation = {
 
           message.licenseViolation = PolicyLicenseViolationFinding.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.secret = PolicySecretFinding.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.misconfiguration = PolicyMisconfigurationFinding.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.malware = PolicyMalwareFinding.decode(reader, reader.uint32());
+          continue;
         case 6:
           if (tag !== 48) {
             break;
@@ -3054,6 +3177,13 @@ export const PolicyEvaluation_Violation = {
 
           message.suppress = reader.bool();
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.exceptionId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3074,7 +3204,13 @@ export const PolicyEvaluation_Violation = {
       licenseViolation: isSet(object.licenseViolation)
         ? PolicyLicenseViolationFinding.fromJSON(object.licenseViolation)
         : undefined,
+      secret: isSet(object.secret) ? PolicySecretFinding.fromJSON(object.secret) : undefined,
+      misconfiguration: isSet(object.misconfiguration)
+        ? PolicyMisconfigurationFinding.fromJSON(object.misconfiguration)
+        : undefined,
+      malware: isSet(object.malware) ? PolicyMalwareFinding.fromJSON(object.malware) : undefined,
       suppress: isSet(object.suppress) ? Boolean(object.suppress) : false,
+      exceptionId: isSet(object.exceptionId) ? String(object.exceptionId) : "",
     };
   },
 
@@ -3090,7 +3226,15 @@ export const PolicyEvaluation_Violation = {
     message.licenseViolation !== undefined && (obj.licenseViolation = message.licenseViolation
       ? PolicyLicenseViolationFinding.toJSON(message.licenseViolation)
       : undefined);
+    message.secret !== undefined &&
+      (obj.secret = message.secret ? PolicySecretFinding.toJSON(message.secret) : undefined);
+    message.misconfiguration !== undefined && (obj.misconfiguration = message.misconfiguration
+      ? PolicyMisconfigurationFinding.toJSON(message.misconfiguration)
+      : undefined);
+    message.malware !== undefined &&
+      (obj.malware = message.malware ? PolicyMalwareFinding.toJSON(message.malware) : undefined);
     message.suppress !== undefined && (obj.suppress = message.suppress);
+    message.exceptionId !== undefined && (obj.exceptionId = message.exceptionId);
     return obj;
   },
 
@@ -3111,7 +3255,17 @@ export const PolicyEvaluation_Violation = {
     message.licenseViolation = (object.licenseViolation !== undefined && object.licenseViolation !== null)
       ? PolicyLicenseViolationFinding.fromPartial(object.licenseViolation)
       : undefined;
+    message.secret = (object.secret !== undefined && object.secret !== null)
+      ? PolicySecretFinding.fromPartial(object.secret)
+      : undefined;
+    message.misconfiguration = (object.misconfiguration !== undefined && object.misconfiguration !== null)
+      ? PolicyMisconfigurationFinding.fromPartial(object.misconfiguration)
+      : undefined;
+    message.malware = (object.malware !== undefined && object.malware !== null)
+      ? PolicyMalwareFinding.fromPartial(object.malware)
+      : undefined;
     message.suppress = object.suppress ?? false;
+    message.exceptionId = object.exceptionId ?? "";
     return message;
   },
 };
@@ -3881,6 +4035,551 @@ export const PolicyLicenseViolationFinding = {
   },
 };
 
+function createBasePolicySecretFinding(): PolicySecretFinding {
+  return {
+    message: "",
+    ruleId: "",
+    location: "",
+    lineNumber: 0,
+    severity: "",
+    commit: "",
+    verified: false,
+    fingerprint: "",
+    recommendation: "",
+    assessment: undefined,
+  };
+}
+
+export const PolicySecretFinding = {
+  encode(message: PolicySecretFinding, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.message !== "") {
+      writer.uint32(10).string(message.message);
+    }
+    if (message.ruleId !== "") {
+      writer.uint32(18).string(message.ruleId);
+    }
+    if (message.location !== "") {
+      writer.uint32(26).string(message.location);
+    }
+    if (message.lineNumber !== 0) {
+      writer.uint32(32).int32(message.lineNumber);
+    }
+    if (message.severity !== "") {
+      writer.uint32(42).string(message.severity);
+    }
+    if (message.commit !== "") {
+      writer.uint32(50).string(message.commit);
+    }
+    if (message.verified === true) {
+      writer.uint32(56).bool(message.verified);
+    }
+    if (message.fingerprint !== "") {
+      writer.uint32(66).string(message.fingerprint);
+    }
+    if (message.recommendation !== "") {
+      writer.uint32(74).string(message.recommendation);
+    }
+    if (message.assessment !== undefined) {
+      PolicyAssessmentResult.encode(message.assessment, writer.uint32(82).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicySecretFinding {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicySecretFinding();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ruleId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.location = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.lineNumber = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.severity = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.commit = reader.string();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.verified = reader.bool();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.fingerprint = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.recommendation = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.assessment = PolicyAssessmentResult.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicySecretFinding {
+    return {
+      message: isSet(object.message) ? String(object.message) : "",
+      ruleId: isSet(object.ruleId) ? String(object.ruleId) : "",
+      location: isSet(object.location) ? String(object.location) : "",
+      lineNumber: isSet(object.lineNumber) ? Number(object.lineNumber) : 0,
+      severity: isSet(object.severity) ? String(object.severity) : "",
+      commit: isSet(object.commit) ? String(object.commit) : "",
+      verified: isSet(object.verified) ? Boolean(object.verified) : false,
+      fingerprint: isSet(object.fingerprint) ? String(object.fingerprint) : "",
+      recommendation: isSet(object.recommendation) ? String(object.recommendation) : "",
+      assessment: isSet(object.assessment) ? PolicyAssessmentResult.fromJSON(object.assessment) : undefined,
+    };
+  },
+
+  toJSON(message: PolicySecretFinding): unknown {
+    const obj: any = {};
+    message.message !== undefined && (obj.message = message.message);
+    message.ruleId !== undefined && (obj.ruleId = message.ruleId);
+    message.location !== undefined && (obj.location = message.location);
+    message.lineNumber !== undefined && (obj.lineNumber = Math.round(message.lineNumber));
+    message.severity !== undefined && (obj.severity = message.severity);
+    message.commit !== undefined && (obj.commit = message.commit);
+    message.verified !== undefined && (obj.verified = message.verified);
+    message.fingerprint !== undefined && (obj.fingerprint = message.fingerprint);
+    message.recommendation !== undefined && (obj.recommendation = message.recommendation);
+    message.assessment !== undefined &&
+      (obj.assessment = message.assessment ? PolicyAssessmentResult.toJSON(message.assessment) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicySecretFinding>, I>>(base?: I): PolicySecretFinding {
+    return PolicySecretFinding.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicySecretFinding>, I>>(object: I): PolicySecretFinding {
+    const message = createBasePolicySecretFinding();
+    message.message = object.message ?? "";
+    message.ruleId = object.ruleId ?? "";
+    message.location = object.location ?? "";
+    message.lineNumber = object.lineNumber ?? 0;
+    message.severity = object.severity ?? "";
+    message.commit = object.commit ?? "";
+    message.verified = object.verified ?? false;
+    message.fingerprint = object.fingerprint ?? "";
+    message.recommendation = object.recommendation ?? "";
+    message.assessment = (object.assessment !== undefined && object.assessment !== null)
+      ? PolicyAssessmentResult.fromPartial(object.assessment)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyMisconfigurationFinding(): PolicyMisconfigurationFinding {
+  return {
+    message: "",
+    checkId: "",
+    severity: "",
+    location: "",
+    resource: "",
+    lineNumber: 0,
+    recommendation: "",
+    references: [],
+    assessment: undefined,
+  };
+}
+
+export const PolicyMisconfigurationFinding = {
+  encode(message: PolicyMisconfigurationFinding, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.message !== "") {
+      writer.uint32(10).string(message.message);
+    }
+    if (message.checkId !== "") {
+      writer.uint32(18).string(message.checkId);
+    }
+    if (message.severity !== "") {
+      writer.uint32(26).string(message.severity);
+    }
+    if (message.location !== "") {
+      writer.uint32(34).string(message.location);
+    }
+    if (message.resource !== "") {
+      writer.uint32(42).string(message.resource);
+    }
+    if (message.lineNumber !== 0) {
+      writer.uint32(48).int32(message.lineNumber);
+    }
+    if (message.recommendation !== "") {
+      writer.uint32(58).string(message.recommendation);
+    }
+    for (const v of message.references) {
+      writer.uint32(66).string(v!);
+    }
+    if (message.assessment !== undefined) {
+      PolicyAssessmentResult.encode(message.assessment, writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyMisconfigurationFinding {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyMisconfigurationFinding();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.checkId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.severity = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.location = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.resource = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.lineNumber = reader.int32();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.recommendation = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.references.push(reader.string());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.assessment = PolicyAssessmentResult.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyMisconfigurationFinding {
+    return {
+      message: isSet(object.message) ? String(object.message) : "",
+      checkId: isSet(object.checkId) ? String(object.checkId) : "",
+      severity: isSet(object.severity) ? String(object.severity) : "",
+      location: isSet(object.location) ? String(object.location) : "",
+      resource: isSet(object.resource) ? String(object.resource) : "",
+      lineNumber: isSet(object.lineNumber) ? Number(object.lineNumber) : 0,
+      recommendation: isSet(object.recommendation) ? String(object.recommendation) : "",
+      references: Array.isArray(object?.references) ? object.references.map((e: any) => String(e)) : [],
+      assessment: isSet(object.assessment) ? PolicyAssessmentResult.fromJSON(object.assessment) : undefined,
+    };
+  },
+
+  toJSON(message: PolicyMisconfigurationFinding): unknown {
+    const obj: any = {};
+    message.message !== undefined && (obj.message = message.message);
+    message.checkId !== undefined && (obj.checkId = message.checkId);
+    message.severity !== undefined && (obj.severity = message.severity);
+    message.location !== undefined && (obj.location = message.location);
+    message.resource !== undefined && (obj.resource = message.resource);
+    message.lineNumber !== undefined && (obj.lineNumber = Math.round(message.lineNumber));
+    message.recommendation !== undefined && (obj.recommendation = message.recommendation);
+    if (message.references) {
+      obj.references = message.references.map((e) => e);
+    } else {
+      obj.references = [];
+    }
+    message.assessment !== undefined &&
+      (obj.assessment = message.assessment ? PolicyAssessmentResult.toJSON(message.assessment) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyMisconfigurationFinding>, I>>(base?: I): PolicyMisconfigurationFinding {
+    return PolicyMisconfigurationFinding.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyMisconfigurationFinding>, I>>(
+    object: I,
+  ): PolicyMisconfigurationFinding {
+    const message = createBasePolicyMisconfigurationFinding();
+    message.message = object.message ?? "";
+    message.checkId = object.checkId ?? "";
+    message.severity = object.severity ?? "";
+    message.location = object.location ?? "";
+    message.resource = object.resource ?? "";
+    message.lineNumber = object.lineNumber ?? 0;
+    message.recommendation = object.recommendation ?? "";
+    message.references = object.references?.map((e) => e) || [];
+    message.assessment = (object.assessment !== undefined && object.assessment !== null)
+      ? PolicyAssessmentResult.fromPartial(object.assessment)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyMalwareFinding(): PolicyMalwareFinding {
+  return {
+    message: "",
+    signature: "",
+    location: "",
+    digest: "",
+    packagePurl: "",
+    engine: "",
+    severity: "",
+    recommendation: "",
+    assessment: undefined,
+  };
+}
+
+export const PolicyMalwareFinding = {
+  encode(message: PolicyMalwareFinding, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.message !== "") {
+      writer.uint32(10).string(message.message);
+    }
+    if (message.signature !== "") {
+      writer.uint32(18).string(message.signature);
+    }
+    if (message.location !== "") {
+      writer.uint32(26).string(message.location);
+    }
+    if (message.digest !== "") {
+      writer.uint32(34).string(message.digest);
+    }
+    if (message.packagePurl !== "") {
+      writer.uint32(42).string(message.packagePurl);
+    }
+    if (message.engine !== "") {
+      writer.uint32(50).string(message.engine);
+    }
+    if (message.severity !== "") {
+      writer.uint32(58).string(message.severity);
+    }
+    if (message.recommendation !== "") {
+      writer.uint32(66).string(message.recommendation);
+    }
+    if (message.assessment !== undefined) {
+      PolicyAssessmentResult.encode(message.assessment, writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyMalwareFinding {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyMalwareFinding();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.signature = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.location = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.digest = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.packagePurl = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.engine = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.severity = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.recommendation = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.assessment = PolicyAssessmentResult.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyMalwareFinding {
+    return {
+      message: isSet(object.message) ? String(object.message) : "",
+      signature: isSet(object.signature) ? String(object.signature) : "",
+      location: isSet(object.location) ? String(object.location) : "",
+      digest: isSet(object.digest) ? String(object.digest) : "",
+      packagePurl: isSet(object.packagePurl) ? String(object.packagePurl) : "",
+      engine: isSet(object.engine) ? String(object.engine) : "",
+      severity: isSet(object.severity) ? String(object.severity) : "",
+      recommendation: isSet(object.recommendation) ? String(object.recommendation) : "",
+      assessment: isSet(object.assessment) ? PolicyAssessmentResult.fromJSON(object.assessment) : undefined,
+    };
+  },
+
+  toJSON(message: PolicyMalwareFinding): unknown {
+    const obj: any = {};
+    message.message !== undefined && (obj.message = message.message);
+    message.signature !== undefined && (obj.signature = message.signature);
+    message.location !== undefined && (obj.location = message.location);
+    message.digest !== undefined && (obj.digest = message.digest);
+    message.packagePurl !== undefined && (obj.packagePurl = message.packagePurl);
+    message.engine !== undefined && (obj.engine = message.engine);
+    message.severity !== undefined && (obj.severity = message.severity);
+    message.recommendation !== undefined && (obj.recommendation = message.recommendation);
+    message.assessment !== undefined &&
+      (obj.assessment = message.assessment ? PolicyAssessmentResult.toJSON(message.assessment) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyMalwareFinding>, I>>(base?: I): PolicyMalwareFinding {
+    return PolicyMalwareFinding.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyMalwareFinding>, I>>(object: I): PolicyMalwareFinding {
+    const message = createBasePolicyMalwareFinding();
+    message.message = object.message ?? "";
+    message.signature = object.signature ?? "";
+    message.location = object.location ?? "";
+    message.digest = object.digest ?? "";
+    message.packagePurl = object.packagePurl ?? "";
+    message.engine = object.engine ?? "";
+    message.severity = object.severity ?? "";
+    message.recommendation = object.recommendation ?? "";
+    message.assessment = (object.assessment !== undefined && object.assessment !== null)
+      ? PolicyAssessmentResult.fromPartial(object.assessment)
+      : undefined;
+    return message;
+  },
+};
+
 function createBasePolicyAssessmentResult(): PolicyAssessmentResult {
   return { effectiveStatus: "", assessments: [] };
 }
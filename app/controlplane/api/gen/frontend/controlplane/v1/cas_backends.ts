@@ -107,7 +107,14 @@ export interface CASBackendServiceUpdateRequest {
   /** Credentials, useful for rotation */
   credentials?: { [key: string]: any };
   /** Maximum size in bytes for each blob stored in this backend. */
-  maxBytes?: number | undefined;
+  maxBytes?:
+    | number
+    | undefined;
+  /**
+   * Name of the backend to mirror, making this one a replica of it.
+   * An empty value detaches it from its current primary.
+   */
+  replicaOf?: string | undefined;
 }
 
 export interface CASBackendServiceUpdateResponse {
@@ -462,6 +469,7 @@ function createBaseCASBackendServiceUpdateRequest(): CASBackendServiceUpdateRequ
     fallback: undefined,
     credentials: undefined,
     maxBytes: undefined,
+    replicaOf: undefined,
   };
 }
 
@@ -485,6 +493,9 @@ export const CASBackendServiceUpdateRequest = {
     if (message.maxBytes !== undefined) {
       writer.uint32(40).int64(message.maxBytes);
     }
+    if (message.replicaOf !== undefined) {
+      writer.uint32(58).string(message.replicaOf);
+    }
     return writer;
   },
 
@@ -537,6 +548,13 @@ export const CASBackendServiceUpdateRequest = {
 
           message.maxBytes = longToNumber(reader.int64() as Long);
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.replicaOf = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -554,6 +572,7 @@ export const CASBackendServiceUpdateRequest = {
       fallback: isSet(object.fallback) ? Boolean(object.fallback) : undefined,
       credentials: isObject(object.credentials) ? object.credentials : undefined,
       maxBytes: isSet(object.maxBytes) ? Number(object.maxBytes) : undefined,
+      replicaOf: isSet(object.replicaOf) ? String(object.replicaOf) : undefined,
     };
   },
 
@@ -565,6 +584,7 @@ export const CASBackendServiceUpdateRequest = {
     message.fallback !== undefined && (obj.fallback = message.fallback);
     message.credentials !== undefined && (obj.credentials = message.credentials);
     message.maxBytes !== undefined && (obj.maxBytes = Math.round(message.maxBytes));
+    message.replicaOf !== undefined && (obj.replicaOf = message.replicaOf);
     return obj;
   },
 
@@ -582,6 +602,7 @@ export const CASBackendServiceUpdateRequest = {
     message.fallback = object.fallback ?? undefined;
     message.credentials = object.credentials ?? undefined;
     message.maxBytes = object.maxBytes ?? undefined;
+    message.replicaOf = object.replicaOf ?? undefined;
     return message;
   },
 };
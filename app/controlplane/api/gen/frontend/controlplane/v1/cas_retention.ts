@@ -0,0 +1,808 @@
+/* eslint-disable */
+import { grpc } from "@improbable-eng/grpc-web";
+import { BrowserHeaders } from "browser-headers";
+import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../../google/protobuf/timestamp";
+
+export const protobufPackage = "controlplane.v1";
+
+export interface CASRetentionServiceListRequest {
+}
+
+export interface CASRetentionServiceListResponse {
+  result: CASRetentionRuleItem[];
+}
+
+export interface CASRetentionServiceSetRequest {
+  /** Name of the CAS backend the rule applies to, empty for the organization default rule */
+  casBackendName: string;
+  /** Artifacts not referenced in this number of days are deleted */
+  maxAgeDays: number;
+  /** Keep the artifacts referenced by the workflow runs of released project versions regardless of their age */
+  keepReleasedVersions: boolean;
+}
+
+export interface CASRetentionServiceSetResponse {
+  result?: CASRetentionRuleItem;
+}
+
+export interface CASRetentionServiceDeleteRequest {
+  id: string;
+}
+
+export interface CASRetentionServiceDeleteResponse {
+}
+
+export interface CASRetentionRuleItem {
+  id: string;
+  /** Name of the CAS backend, empty for the organization default rule */
+  casBackendName: string;
+  maxAgeDays: number;
+  keepReleasedVersions: boolean;
+  createdAt?: Date;
+  updatedAt?: Date;
+  /** Last time the rule was applied, empty if never */
+  lastSweptAt?: Date;
+}
+
+function createBaseCASRetentionServiceListRequest(): CASRetentionServiceListRequest {
+  return {};
+}
+
+export const CASRetentionServiceListRequest = {
+  encode(_: CASRetentionServiceListRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionServiceListRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionServiceListRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): CASRetentionServiceListRequest {
+    return {};
+  },
+
+  toJSON(_: CASRetentionServiceListRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionServiceListRequest>, I>>(base?: I): CASRetentionServiceListRequest {
+    return CASRetentionServiceListRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionServiceListRequest>, I>>(_: I): CASRetentionServiceListRequest {
+    const message = createBaseCASRetentionServiceListRequest();
+    return message;
+  },
+};
+
+function createBaseCASRetentionServiceListResponse(): CASRetentionServiceListResponse {
+  return { result: [] };
+}
+
+export const CASRetentionServiceListResponse = {
+  encode(message: CASRetentionServiceListResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      CASRetentionRuleItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionServiceListResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionServiceListResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(CASRetentionRuleItem.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CASRetentionServiceListResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => CASRetentionRuleItem.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: CASRetentionServiceListResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? CASRetentionRuleItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionServiceListResponse>, I>>(base?: I): CASRetentionServiceListResponse {
+    return CASRetentionServiceListResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionServiceListResponse>, I>>(
+    object: I,
+  ): CASRetentionServiceListResponse {
+    const message = createBaseCASRetentionServiceListResponse();
+    message.result = object.result?.map((e) => CASRetentionRuleItem.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseCASRetentionServiceSetRequest(): CASRetentionServiceSetRequest {
+  return { casBackendName: "", maxAgeDays: 0, keepReleasedVersions: false };
+}
+
+export const CASRetentionServiceSetRequest = {
+  encode(message: CASRetentionServiceSetRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.casBackendName !== "") {
+      writer.uint32(10).string(message.casBackendName);
+    }
+    if (message.maxAgeDays !== 0) {
+      writer.uint32(16).int32(message.maxAgeDays);
+    }
+    if (message.keepReleasedVersions === true) {
+      writer.uint32(24).bool(message.keepReleasedVersions);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionServiceSetRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionServiceSetRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.casBackendName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxAgeDays = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.keepReleasedVersions = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CASRetentionServiceSetRequest {
+    return {
+      casBackendName: isSet(object.casBackendName) ? String(object.casBackendName) : "",
+      maxAgeDays: isSet(object.maxAgeDays) ? Number(object.maxAgeDays) : 0,
+      keepReleasedVersions: isSet(object.keepReleasedVersions) ? Boolean(object.keepReleasedVersions) : false,
+    };
+  },
+
+  toJSON(message: CASRetentionServiceSetRequest): unknown {
+    const obj: any = {};
+    message.casBackendName !== undefined && (obj.casBackendName = message.casBackendName);
+    message.maxAgeDays !== undefined && (obj.maxAgeDays = Math.round(message.maxAgeDays));
+    message.keepReleasedVersions !== undefined && (obj.keepReleasedVersions = message.keepReleasedVersions);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionServiceSetRequest>, I>>(base?: I): CASRetentionServiceSetRequest {
+    return CASRetentionServiceSetRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionServiceSetRequest>, I>>(
+    object: I,
+  ): CASRetentionServiceSetRequest {
+    const message = createBaseCASRetentionServiceSetRequest();
+    message.casBackendName = object.casBackendName ?? "";
+    message.maxAgeDays = object.maxAgeDays ?? 0;
+    message.keepReleasedVersions = object.keepReleasedVersions ?? false;
+    return message;
+  },
+};
+
+function createBaseCASRetentionServiceSetResponse(): CASRetentionServiceSetResponse {
+  return { result: undefined };
+}
+
+export const CASRetentionServiceSetResponse = {
+  encode(message: CASRetentionServiceSetResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      CASRetentionRuleItem.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionServiceSetResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionServiceSetResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = CASRetentionRuleItem.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CASRetentionServiceSetResponse {
+    return { result: isSet(object.result) ? CASRetentionRuleItem.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: CASRetentionServiceSetResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? CASRetentionRuleItem.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionServiceSetResponse>, I>>(base?: I): CASRetentionServiceSetResponse {
+    return CASRetentionServiceSetResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionServiceSetResponse>, I>>(
+    object: I,
+  ): CASRetentionServiceSetResponse {
+    const message = createBaseCASRetentionServiceSetResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? CASRetentionRuleItem.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseCASRetentionServiceDeleteRequest(): CASRetentionServiceDeleteRequest {
+  return { id: "" };
+}
+
+export const CASRetentionServiceDeleteRequest = {
+  encode(message: CASRetentionServiceDeleteRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionServiceDeleteRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionServiceDeleteRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CASRetentionServiceDeleteRequest {
+    return { id: isSet(object.id) ? String(object.id) : "" };
+  },
+
+  toJSON(message: CASRetentionServiceDeleteRequest): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionServiceDeleteRequest>, I>>(
+    base?: I,
+  ): CASRetentionServiceDeleteRequest {
+    return CASRetentionServiceDeleteRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionServiceDeleteRequest>, I>>(
+    object: I,
+  ): CASRetentionServiceDeleteRequest {
+    const message = createBaseCASRetentionServiceDeleteRequest();
+    message.id = object.id ?? "";
+    return message;
+  },
+};
+
+function createBaseCASRetentionServiceDeleteResponse(): CASRetentionServiceDeleteResponse {
+  return {};
+}
+
+export const CASRetentionServiceDeleteResponse = {
+  encode(_: CASRetentionServiceDeleteResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionServiceDeleteResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionServiceDeleteResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): CASRetentionServiceDeleteResponse {
+    return {};
+  },
+
+  toJSON(_: CASRetentionServiceDeleteResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionServiceDeleteResponse>, I>>(
+    base?: I,
+  ): CASRetentionServiceDeleteResponse {
+    return CASRetentionServiceDeleteResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionServiceDeleteResponse>, I>>(
+    _: I,
+  ): CASRetentionServiceDeleteResponse {
+    const message = createBaseCASRetentionServiceDeleteResponse();
+    return message;
+  },
+};
+
+function createBaseCASRetentionRuleItem(): CASRetentionRuleItem {
+  return {
+    id: "",
+    casBackendName: "",
+    maxAgeDays: 0,
+    keepReleasedVersions: false,
+    createdAt: undefined,
+    updatedAt: undefined,
+    lastSweptAt: undefined,
+  };
+}
+
+export const CASRetentionRuleItem = {
+  encode(message: CASRetentionRuleItem, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.casBackendName !== "") {
+      writer.uint32(18).string(message.casBackendName);
+    }
+    if (message.maxAgeDays !== 0) {
+      writer.uint32(24).int32(message.maxAgeDays);
+    }
+    if (message.keepReleasedVersions === true) {
+      writer.uint32(32).bool(message.keepReleasedVersions);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.updatedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedAt), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.lastSweptAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSweptAt), writer.uint32(58).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CASRetentionRuleItem {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCASRetentionRuleItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.casBackendName = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.maxAgeDays = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.keepReleasedVersions = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.updatedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.lastSweptAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CASRetentionRuleItem {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      casBackendName: isSet(object.casBackendName) ? String(object.casBackendName) : "",
+      maxAgeDays: isSet(object.maxAgeDays) ? Number(object.maxAgeDays) : 0,
+      keepReleasedVersions: isSet(object.keepReleasedVersions) ? Boolean(object.keepReleasedVersions) : false,
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      updatedAt: isSet(object.updatedAt) ? fromJsonTimestamp(object.updatedAt) : undefined,
+      lastSweptAt: isSet(object.lastSweptAt) ? fromJsonTimestamp(object.lastSweptAt) : undefined,
+    };
+  },
+
+  toJSON(message: CASRetentionRuleItem): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.casBackendName !== undefined && (obj.casBackendName = message.casBackendName);
+    message.maxAgeDays !== undefined && (obj.maxAgeDays = Math.round(message.maxAgeDays));
+    message.keepReleasedVersions !== undefined && (obj.keepReleasedVersions = message.keepReleasedVersions);
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.updatedAt !== undefined && (obj.updatedAt = message.updatedAt.toISOString());
+    message.lastSweptAt !== undefined && (obj.lastSweptAt = message.lastSweptAt.toISOString());
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CASRetentionRuleItem>, I>>(base?: I): CASRetentionRuleItem {
+    return CASRetentionRuleItem.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CASRetentionRuleItem>, I>>(object: I): CASRetentionRuleItem {
+    const message = createBaseCASRetentionRuleItem();
+    message.id = object.id ?? "";
+    message.casBackendName = object.casBackendName ?? "";
+    message.maxAgeDays = object.maxAgeDays ?? 0;
+    message.keepReleasedVersions = object.keepReleasedVersions ?? false;
+    message.createdAt = object.createdAt ?? undefined;
+    message.updatedAt = object.updatedAt ?? undefined;
+    message.lastSweptAt = object.lastSweptAt ?? undefined;
+    return message;
+  },
+};
+
+/** Manage the retention rules used to garbage collect the artifacts stored in the CAS backends */
+export interface CASRetentionService {
+  /** List the retention rules of the organization */
+  List(
+    request: DeepPartial<CASRetentionServiceListRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CASRetentionServiceListResponse>;
+  /** Set the retention rule of a CAS backend, or the organization default one, replacing the existing one if any */
+  Set(
+    request: DeepPartial<CASRetentionServiceSetRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CASRetentionServiceSetResponse>;
+  /** Delete a retention rule, the artifacts it covers are kept forever */
+  Delete(
+    request: DeepPartial<CASRetentionServiceDeleteRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CASRetentionServiceDeleteResponse>;
+}
+
+export class CASRetentionServiceClientImpl implements CASRetentionService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.List = this.List.bind(this);
+    this.Set = this.Set.bind(this);
+    this.Delete = this.Delete.bind(this);
+  }
+
+  List(
+    request: DeepPartial<CASRetentionServiceListRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CASRetentionServiceListResponse> {
+    return this.rpc.unary(CASRetentionServiceListDesc, CASRetentionServiceListRequest.fromPartial(request), metadata);
+  }
+
+  Set(
+    request: DeepPartial<CASRetentionServiceSetRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CASRetentionServiceSetResponse> {
+    return this.rpc.unary(CASRetentionServiceSetDesc, CASRetentionServiceSetRequest.fromPartial(request), metadata);
+  }
+
+  Delete(
+    request: DeepPartial<CASRetentionServiceDeleteRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<CASRetentionServiceDeleteResponse> {
+    return this.rpc.unary(
+      CASRetentionServiceDeleteDesc,
+      CASRetentionServiceDeleteRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const CASRetentionServiceDesc = { serviceName: "controlplane.v1.CASRetentionService" };
+
+export const CASRetentionServiceListDesc: UnaryMethodDefinitionish = {
+  methodName: "List",
+  service: CASRetentionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CASRetentionServiceListRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = CASRetentionServiceListResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const CASRetentionServiceSetDesc: UnaryMethodDefinitionish = {
+  methodName: "Set",
+  service: CASRetentionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CASRetentionServiceSetRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = CASRetentionServiceSetResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const CASRetentionServiceDeleteDesc: UnaryMethodDefinitionish = {
+  methodName: "Delete",
+  service: CASRetentionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return CASRetentionServiceDeleteRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = CASRetentionServiceDeleteResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
+  requestStream: any;
+  responseStream: any;
+}
+
+type UnaryMethodDefinitionish = UnaryMethodDefinitionishR;
+
+interface Rpc {
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any>;
+}
+
+export class GrpcWebImpl {
+  private host: string;
+  private options: {
+    transport?: grpc.TransportFactory;
+
+    debug?: boolean;
+    metadata?: grpc.Metadata;
+    upStreamRetryCodes?: number[];
+  };
+
+  constructor(
+    host: string,
+    options: {
+      transport?: grpc.TransportFactory;
+
+      debug?: boolean;
+      metadata?: grpc.Metadata;
+      upStreamRetryCodes?: number[];
+    },
+  ) {
+    this.host = host;
+    this.options = options;
+  }
+
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any> {
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata = metadata && this.options.metadata
+      ? new BrowserHeaders({ ...this.options?.metadata.headersMap, ...metadata?.headersMap })
+      : metadata || this.options.metadata;
+    return new Promise((resolve, reject) => {
+      grpc.unary(methodDesc, {
+        request,
+        host: this.host,
+        metadata: maybeCombinedMetadata,
+        transport: this.options.transport,
+        debug: this.options.debug,
+        onEnd: function (response) {
+          if (response.status === grpc.Code.OK) {
+            resolve(response.message!.toObject());
+          } else {
+            const err = new GrpcWebError(response.statusMessage, response.status, response.trailers);
+            reject(err);
+          }
+        },
+      });
+    });
+  }
+}
+
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
+
+export class GrpcWebError extends tsProtoGlobalThis.Error {
+  constructor(message: string, public code: grpc.Code, public metadata: grpc.Metadata) {
+    super(message);
+  }
+}
@@ -2,8 +2,10 @@
 import { grpc } from "@improbable-eng/grpc-web";
 import { BrowserHeaders } from "browser-headers";
 import _m0 from "protobufjs/minimal";
+import { Duration } from "../../google/protobuf/duration";
 import { Struct } from "../../google/protobuf/struct";
 import { Timestamp } from "../../google/protobuf/timestamp";
+import { OffsetPaginationRequest, OffsetPaginationResponse } from "./pagination";
 import { WorkflowItem } from "./response_messages";
 
 export const protobufPackage = "controlplane.v1";
@@ -126,6 +128,108 @@ export interface IntegrationsServiceDeregisterRequest {
 export interface IntegrationsServiceDeregisterResponse {
 }
 
+export interface IntegrationsServiceListDeliveriesRequest {
+  /** ID of the integration attachment */
+  attachmentId: string;
+  /** Optional, only the deliveries of this workflow run */
+  workflowRunId: string;
+  /** Optional, only the deliveries in this status */
+  status: IntegrationDeliveryItem_Status;
+  pagination?: OffsetPaginationRequest;
+}
+
+export interface IntegrationsServiceListDeliveriesResponse {
+  result: IntegrationDeliveryItem[];
+  pagination?: OffsetPaginationResponse;
+}
+
+export interface IntegrationsServiceListFailedDeliveriesRequest {
+  /** ID of the integration attachment */
+  attachmentId: string;
+  pagination?: OffsetPaginationRequest;
+}
+
+export interface IntegrationsServiceListFailedDeliveriesResponse {
+  result: IntegrationDeliveryItem[];
+  pagination?: OffsetPaginationResponse;
+}
+
+export interface IntegrationsServiceReplayDeliveriesRequest {
+  /** ID of the integration attachment */
+  attachmentId: string;
+  /** Optional, replay only this delivery. All the failed deliveries of the attachment are replayed otherwise */
+  deliveryId: string;
+}
+
+export interface IntegrationsServiceReplayDeliveriesResponse {
+  /** Number of deliveries queued to be retried */
+  replayed: number;
+}
+
+/** IntegrationDeliveryItem is the attempt to send the attestation of a workflow run to an attached integration */
+export interface IntegrationDeliveryItem {
+  id: string;
+  attachmentId: string;
+  workflowRunId: string;
+  status: IntegrationDeliveryItem_Status;
+  /** Number of times the delivery has been attempted */
+  attempts: number;
+  /** Error returned by the integration in the last attempt */
+  lastError: string;
+  createdAt?: Date;
+  updatedAt?: Date;
+  finishedAt?: Date;
+  /** How long the last attempt took */
+  duration?: Duration;
+}
+
+export enum IntegrationDeliveryItem_Status {
+  STATUS_UNSPECIFIED = 0,
+  /** STATUS_PENDING - Waiting to be delivered or retried */
+  STATUS_PENDING = 1,
+  STATUS_SUCCEEDED = 2,
+  /** STATUS_DEAD_LETTER - Ran out of retries, it needs to be replayed */
+  STATUS_DEAD_LETTER = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function integrationDeliveryItem_StatusFromJSON(object: any): IntegrationDeliveryItem_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return IntegrationDeliveryItem_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "STATUS_PENDING":
+      return IntegrationDeliveryItem_Status.STATUS_PENDING;
+    case 2:
+    case "STATUS_SUCCEEDED":
+      return IntegrationDeliveryItem_Status.STATUS_SUCCEEDED;
+    case 3:
+    case "STATUS_DEAD_LETTER":
+      return IntegrationDeliveryItem_Status.STATUS_DEAD_LETTER;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return IntegrationDeliveryItem_Status.UNRECOGNIZED;
+  }
+}
+
+export function integrationDeliveryItem_StatusToJSON(object: IntegrationDeliveryItem_Status): string {
+  switch (object) {
+    case IntegrationDeliveryItem_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case IntegrationDeliveryItem_Status.STATUS_PENDING:
+      return "STATUS_PENDING";
+    case IntegrationDeliveryItem_Status.STATUS_SUCCEEDED:
+      return "STATUS_SUCCEEDED";
+    case IntegrationDeliveryItem_Status.STATUS_DEAD_LETTER:
+      return "STATUS_DEAD_LETTER";
+    case IntegrationDeliveryItem_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 function createBaseIntegrationsServiceRegisterRequest(): IntegrationsServiceRegisterRequest {
   return { name: "", pluginId: "", config: undefined, description: "" };
 }
@@ -1598,187 +1702,916 @@ export const IntegrationsServiceDeregisterResponse = {
   },
 };
 
-export interface IntegrationsService {
-  /** Integrations available and loaded in the controlplane ready to be used during registration */
-  ListAvailable(
-    request: DeepPartial<IntegrationsServiceListAvailableRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceListAvailableResponse>;
-  /**
-   * Registration Related operations
-   * Register a new integration in the organization
-   */
-  Register(
-    request: DeepPartial<IntegrationsServiceRegisterRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceRegisterResponse>;
-  /** Delete registered integrations */
-  Deregister(
-    request: DeepPartial<IntegrationsServiceDeregisterRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceDeregisterResponse>;
-  /** List registered integrations */
-  ListRegistrations(
-    request: DeepPartial<IntegrationsServiceListRegistrationsRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceListRegistrationsResponse>;
-  /** View registered integration */
-  DescribeRegistration(
-    request: DeepPartial<IntegrationsServiceDescribeRegistrationRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceDescribeRegistrationResponse>;
-  /**
-   * Attachment Related operations
-   * Attach an integration to a workflow
-   */
-  Attach(
-    request: DeepPartial<IntegrationsServiceAttachRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceAttachResponse>;
-  /** Detach integration from a workflow */
-  Detach(
-    request: DeepPartial<IntegrationsServiceDetachRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceDetachResponse>;
-  /** List attachments */
-  ListAttachments(
-    request: DeepPartial<ListAttachmentsRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<ListAttachmentsResponse>;
+function createBaseIntegrationsServiceListDeliveriesRequest(): IntegrationsServiceListDeliveriesRequest {
+  return { attachmentId: "", workflowRunId: "", status: 0, pagination: undefined };
 }
 
-export class IntegrationsServiceClientImpl implements IntegrationsService {
-  private readonly rpc: Rpc;
+export const IntegrationsServiceListDeliveriesRequest = {
+  encode(message: IntegrationsServiceListDeliveriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.attachmentId !== "") {
+      writer.uint32(10).string(message.attachmentId);
+    }
+    if (message.workflowRunId !== "") {
+      writer.uint32(18).string(message.workflowRunId);
+    }
+    if (message.status !== 0) {
+      writer.uint32(24).int32(message.status);
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationRequest.encode(message.pagination, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
 
-  constructor(rpc: Rpc) {
-    this.rpc = rpc;
-    this.ListAvailable = this.ListAvailable.bind(this);
-    this.Register = this.Register.bind(this);
-    this.Deregister = this.Deregister.bind(this);
-    this.ListRegistrations = this.ListRegistrations.bind(this);
-    this.DescribeRegistration = this.DescribeRegistration.bind(this);
-    this.Attach = this.Attach.bind(this);
-    this.Detach = this.Detach.bind(this);
-    this.ListAttachments = this.ListAttachments.bind(this);
-  }
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationsServiceListDeliveriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationsServiceListDeliveriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
 
-  ListAvailable(
-    request: DeepPartial<IntegrationsServiceListAvailableRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceListAvailableResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceListAvailableDesc,
-      IntegrationsServiceListAvailableRequest.fromPartial(request),
-      metadata,
-    );
-  }
+          message.attachmentId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
 
-  Register(
-    request: DeepPartial<IntegrationsServiceRegisterRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceRegisterResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceRegisterDesc,
-      IntegrationsServiceRegisterRequest.fromPartial(request),
-      metadata,
-    );
-  }
+          message.workflowRunId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
 
-  Deregister(
-    request: DeepPartial<IntegrationsServiceDeregisterRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceDeregisterResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceDeregisterDesc,
-      IntegrationsServiceDeregisterRequest.fromPartial(request),
-      metadata,
-    );
-  }
+          message.status = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
 
-  ListRegistrations(
-    request: DeepPartial<IntegrationsServiceListRegistrationsRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceListRegistrationsResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceListRegistrationsDesc,
-      IntegrationsServiceListRegistrationsRequest.fromPartial(request),
-      metadata,
-    );
-  }
+          message.pagination = OffsetPaginationRequest.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-  DescribeRegistration(
-    request: DeepPartial<IntegrationsServiceDescribeRegistrationRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceDescribeRegistrationResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceDescribeRegistrationDesc,
-      IntegrationsServiceDescribeRegistrationRequest.fromPartial(request),
-      metadata,
-    );
-  }
+  fromJSON(object: any): IntegrationsServiceListDeliveriesRequest {
+    return {
+      attachmentId: isSet(object.attachmentId) ? String(object.attachmentId) : "",
+      workflowRunId: isSet(object.workflowRunId) ? String(object.workflowRunId) : "",
+      status: isSet(object.status) ? integrationDeliveryItem_StatusFromJSON(object.status) : 0,
+      pagination: isSet(object.pagination) ? OffsetPaginationRequest.fromJSON(object.pagination) : undefined,
+    };
+  },
 
-  Attach(
-    request: DeepPartial<IntegrationsServiceAttachRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceAttachResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceAttachDesc,
-      IntegrationsServiceAttachRequest.fromPartial(request),
-      metadata,
-    );
-  }
+  toJSON(message: IntegrationsServiceListDeliveriesRequest): unknown {
+    const obj: any = {};
+    message.attachmentId !== undefined && (obj.attachmentId = message.attachmentId);
+    message.workflowRunId !== undefined && (obj.workflowRunId = message.workflowRunId);
+    message.status !== undefined && (obj.status = integrationDeliveryItem_StatusToJSON(message.status));
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationRequest.toJSON(message.pagination) : undefined);
+    return obj;
+  },
 
-  Detach(
-    request: DeepPartial<IntegrationsServiceDetachRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<IntegrationsServiceDetachResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceDetachDesc,
-      IntegrationsServiceDetachRequest.fromPartial(request),
-      metadata,
-    );
-  }
+  create<I extends Exact<DeepPartial<IntegrationsServiceListDeliveriesRequest>, I>>(
+    base?: I,
+  ): IntegrationsServiceListDeliveriesRequest {
+    return IntegrationsServiceListDeliveriesRequest.fromPartial(base ?? {});
+  },
 
-  ListAttachments(
-    request: DeepPartial<ListAttachmentsRequest>,
-    metadata?: grpc.Metadata,
-  ): Promise<ListAttachmentsResponse> {
-    return this.rpc.unary(
-      IntegrationsServiceListAttachmentsDesc,
-      ListAttachmentsRequest.fromPartial(request),
-      metadata,
-    );
-  }
+  fromPartial<I extends Exact<DeepPartial<IntegrationsServiceListDeliveriesRequest>, I>>(
+    object: I,
+  ): IntegrationsServiceListDeliveriesRequest {
+    const message = createBaseIntegrationsServiceListDeliveriesRequest();
+    message.attachmentId = object.attachmentId ?? "";
+    message.workflowRunId = object.workflowRunId ?? "";
+    message.status = object.status ?? 0;
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationRequest.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIntegrationsServiceListDeliveriesResponse(): IntegrationsServiceListDeliveriesResponse {
+  return { result: [], pagination: undefined };
 }
 
-export const IntegrationsServiceDesc = { serviceName: "controlplane.v1.IntegrationsService" };
+export const IntegrationsServiceListDeliveriesResponse = {
+  encode(message: IntegrationsServiceListDeliveriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      IntegrationDeliveryItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
 
-export const IntegrationsServiceListAvailableDesc: UnaryMethodDefinitionish = {
-  methodName: "ListAvailable",
-  service: IntegrationsServiceDesc,
-  requestStream: false,
-  responseStream: false,
-  requestType: {
-    serializeBinary() {
-      return IntegrationsServiceListAvailableRequest.encode(this).finish();
-    },
-  } as any,
-  responseType: {
-    deserializeBinary(data: Uint8Array) {
-      const value = IntegrationsServiceListAvailableResponse.decode(data);
-      return {
-        ...value,
-        toObject() {
-          return value;
-        },
-      };
-    },
-  } as any,
-};
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationsServiceListDeliveriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationsServiceListDeliveriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
 
-export const IntegrationsServiceRegisterDesc: UnaryMethodDefinitionish = {
-  methodName: "Register",
-  service: IntegrationsServiceDesc,
+          message.result.push(IntegrationDeliveryItem.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationResponse.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IntegrationsServiceListDeliveriesResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => IntegrationDeliveryItem.fromJSON(e)) : [],
+      pagination: isSet(object.pagination) ? OffsetPaginationResponse.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: IntegrationsServiceListDeliveriesResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? IntegrationDeliveryItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationResponse.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IntegrationsServiceListDeliveriesResponse>, I>>(
+    base?: I,
+  ): IntegrationsServiceListDeliveriesResponse {
+    return IntegrationsServiceListDeliveriesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<IntegrationsServiceListDeliveriesResponse>, I>>(
+    object: I,
+  ): IntegrationsServiceListDeliveriesResponse {
+    const message = createBaseIntegrationsServiceListDeliveriesResponse();
+    message.result = object.result?.map((e) => IntegrationDeliveryItem.fromPartial(e)) || [];
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationResponse.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIntegrationsServiceListFailedDeliveriesRequest(): IntegrationsServiceListFailedDeliveriesRequest {
+  return { attachmentId: "", pagination: undefined };
+}
+
+export const IntegrationsServiceListFailedDeliveriesRequest = {
+  encode(
+    message: IntegrationsServiceListFailedDeliveriesRequest,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.attachmentId !== "") {
+      writer.uint32(10).string(message.attachmentId);
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationRequest.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationsServiceListFailedDeliveriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationsServiceListFailedDeliveriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.attachmentId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationRequest.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IntegrationsServiceListFailedDeliveriesRequest {
+    return {
+      attachmentId: isSet(object.attachmentId) ? String(object.attachmentId) : "",
+      pagination: isSet(object.pagination) ? OffsetPaginationRequest.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: IntegrationsServiceListFailedDeliveriesRequest): unknown {
+    const obj: any = {};
+    message.attachmentId !== undefined && (obj.attachmentId = message.attachmentId);
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationRequest.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IntegrationsServiceListFailedDeliveriesRequest>, I>>(
+    base?: I,
+  ): IntegrationsServiceListFailedDeliveriesRequest {
+    return IntegrationsServiceListFailedDeliveriesRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<IntegrationsServiceListFailedDeliveriesRequest>, I>>(
+    object: I,
+  ): IntegrationsServiceListFailedDeliveriesRequest {
+    const message = createBaseIntegrationsServiceListFailedDeliveriesRequest();
+    message.attachmentId = object.attachmentId ?? "";
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationRequest.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIntegrationsServiceListFailedDeliveriesResponse(): IntegrationsServiceListFailedDeliveriesResponse {
+  return { result: [], pagination: undefined };
+}
+
+export const IntegrationsServiceListFailedDeliveriesResponse = {
+  encode(
+    message: IntegrationsServiceListFailedDeliveriesResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.result) {
+      IntegrationDeliveryItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationsServiceListFailedDeliveriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationsServiceListFailedDeliveriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(IntegrationDeliveryItem.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationResponse.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IntegrationsServiceListFailedDeliveriesResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => IntegrationDeliveryItem.fromJSON(e)) : [],
+      pagination: isSet(object.pagination) ? OffsetPaginationResponse.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: IntegrationsServiceListFailedDeliveriesResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? IntegrationDeliveryItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationResponse.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IntegrationsServiceListFailedDeliveriesResponse>, I>>(
+    base?: I,
+  ): IntegrationsServiceListFailedDeliveriesResponse {
+    return IntegrationsServiceListFailedDeliveriesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<IntegrationsServiceListFailedDeliveriesResponse>, I>>(
+    object: I,
+  ): IntegrationsServiceListFailedDeliveriesResponse {
+    const message = createBaseIntegrationsServiceListFailedDeliveriesResponse();
+    message.result = object.result?.map((e) => IntegrationDeliveryItem.fromPartial(e)) || [];
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationResponse.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseIntegrationsServiceReplayDeliveriesRequest(): IntegrationsServiceReplayDeliveriesRequest {
+  return { attachmentId: "", deliveryId: "" };
+}
+
+export const IntegrationsServiceReplayDeliveriesRequest = {
+  encode(message: IntegrationsServiceReplayDeliveriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.attachmentId !== "") {
+      writer.uint32(10).string(message.attachmentId);
+    }
+    if (message.deliveryId !== "") {
+      writer.uint32(18).string(message.deliveryId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationsServiceReplayDeliveriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationsServiceReplayDeliveriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.attachmentId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.deliveryId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IntegrationsServiceReplayDeliveriesRequest {
+    return {
+      attachmentId: isSet(object.attachmentId) ? String(object.attachmentId) : "",
+      deliveryId: isSet(object.deliveryId) ? String(object.deliveryId) : "",
+    };
+  },
+
+  toJSON(message: IntegrationsServiceReplayDeliveriesRequest): unknown {
+    const obj: any = {};
+    message.attachmentId !== undefined && (obj.attachmentId = message.attachmentId);
+    message.deliveryId !== undefined && (obj.deliveryId = message.deliveryId);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IntegrationsServiceReplayDeliveriesRequest>, I>>(
+    base?: I,
+  ): IntegrationsServiceReplayDeliveriesRequest {
+    return IntegrationsServiceReplayDeliveriesRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<IntegrationsServiceReplayDeliveriesRequest>, I>>(
+    object: I,
+  ): IntegrationsServiceReplayDeliveriesRequest {
+    const message = createBaseIntegrationsServiceReplayDeliveriesRequest();
+    message.attachmentId = object.attachmentId ?? "";
+    message.deliveryId = object.deliveryId ?? "";
+    return message;
+  },
+};
+
+function createBaseIntegrationsServiceReplayDeliveriesResponse(): IntegrationsServiceReplayDeliveriesResponse {
+  return { replayed: 0 };
+}
+
+export const IntegrationsServiceReplayDeliveriesResponse = {
+  encode(message: IntegrationsServiceReplayDeliveriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.replayed !== 0) {
+      writer.uint32(8).int32(message.replayed);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationsServiceReplayDeliveriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationsServiceReplayDeliveriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.replayed = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IntegrationsServiceReplayDeliveriesResponse {
+    return { replayed: isSet(object.replayed) ? Number(object.replayed) : 0 };
+  },
+
+  toJSON(message: IntegrationsServiceReplayDeliveriesResponse): unknown {
+    const obj: any = {};
+    message.replayed !== undefined && (obj.replayed = Math.round(message.replayed));
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IntegrationsServiceReplayDeliveriesResponse>, I>>(
+    base?: I,
+  ): IntegrationsServiceReplayDeliveriesResponse {
+    return IntegrationsServiceReplayDeliveriesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<IntegrationsServiceReplayDeliveriesResponse>, I>>(
+    object: I,
+  ): IntegrationsServiceReplayDeliveriesResponse {
+    const message = createBaseIntegrationsServiceReplayDeliveriesResponse();
+    message.replayed = object.replayed ?? 0;
+    return message;
+  },
+};
+
+function createBaseIntegrationDeliveryItem(): IntegrationDeliveryItem {
+  return {
+    id: "",
+    attachmentId: "",
+    workflowRunId: "",
+    status: 0,
+    attempts: 0,
+    lastError: "",
+    createdAt: undefined,
+    updatedAt: undefined,
+    finishedAt: undefined,
+    duration: undefined,
+  };
+}
+
+export const IntegrationDeliveryItem = {
+  encode(message: IntegrationDeliveryItem, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.attachmentId !== "") {
+      writer.uint32(18).string(message.attachmentId);
+    }
+    if (message.workflowRunId !== "") {
+      writer.uint32(26).string(message.workflowRunId);
+    }
+    if (message.status !== 0) {
+      writer.uint32(32).int32(message.status);
+    }
+    if (message.attempts !== 0) {
+      writer.uint32(40).int32(message.attempts);
+    }
+    if (message.lastError !== "") {
+      writer.uint32(50).string(message.lastError);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.updatedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedAt), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.finishedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.finishedAt), writer.uint32(74).fork()).ldelim();
+    }
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(82).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IntegrationDeliveryItem {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIntegrationDeliveryItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.attachmentId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.workflowRunId = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.attempts = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.lastError = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.updatedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.finishedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IntegrationDeliveryItem {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      attachmentId: isSet(object.attachmentId) ? String(object.attachmentId) : "",
+      workflowRunId: isSet(object.workflowRunId) ? String(object.workflowRunId) : "",
+      status: isSet(object.status) ? integrationDeliveryItem_StatusFromJSON(object.status) : 0,
+      attempts: isSet(object.attempts) ? Number(object.attempts) : 0,
+      lastError: isSet(object.lastError) ? String(object.lastError) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      updatedAt: isSet(object.updatedAt) ? fromJsonTimestamp(object.updatedAt) : undefined,
+      finishedAt: isSet(object.finishedAt) ? fromJsonTimestamp(object.finishedAt) : undefined,
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+    };
+  },
+
+  toJSON(message: IntegrationDeliveryItem): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.attachmentId !== undefined && (obj.attachmentId = message.attachmentId);
+    message.workflowRunId !== undefined && (obj.workflowRunId = message.workflowRunId);
+    message.status !== undefined && (obj.status = integrationDeliveryItem_StatusToJSON(message.status));
+    message.attempts !== undefined && (obj.attempts = Math.round(message.attempts));
+    message.lastError !== undefined && (obj.lastError = message.lastError);
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.updatedAt !== undefined && (obj.updatedAt = message.updatedAt.toISOString());
+    message.finishedAt !== undefined && (obj.finishedAt = message.finishedAt.toISOString());
+    message.duration !== undefined && (obj.duration = message.duration ? Duration.toJSON(message.duration) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<IntegrationDeliveryItem>, I>>(base?: I): IntegrationDeliveryItem {
+    return IntegrationDeliveryItem.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<IntegrationDeliveryItem>, I>>(object: I): IntegrationDeliveryItem {
+    const message = createBaseIntegrationDeliveryItem();
+    message.id = object.id ?? "";
+    message.attachmentId = object.attachmentId ?? "";
+    message.workflowRunId = object.workflowRunId ?? "";
+    message.status = object.status ?? 0;
+    message.attempts = object.attempts ?? 0;
+    message.lastError = object.lastError ?? "";
+    message.createdAt = object.createdAt ?? undefined;
+    message.updatedAt = object.updatedAt ?? undefined;
+    message.finishedAt = object.finishedAt ?? undefined;
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    return message;
+  },
+};
+
+export interface IntegrationsService {
+  /** Integrations available and loaded in the controlplane ready to be used during registration */
+  ListAvailable(
+    request: DeepPartial<IntegrationsServiceListAvailableRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListAvailableResponse>;
+  /**
+   * Registration Related operations
+   * Register a new integration in the organization
+   */
+  Register(
+    request: DeepPartial<IntegrationsServiceRegisterRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceRegisterResponse>;
+  /** Delete registered integrations */
+  Deregister(
+    request: DeepPartial<IntegrationsServiceDeregisterRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceDeregisterResponse>;
+  /** List registered integrations */
+  ListRegistrations(
+    request: DeepPartial<IntegrationsServiceListRegistrationsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListRegistrationsResponse>;
+  /** View registered integration */
+  DescribeRegistration(
+    request: DeepPartial<IntegrationsServiceDescribeRegistrationRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceDescribeRegistrationResponse>;
+  /**
+   * Attachment Related operations
+   * Attach an integration to a workflow
+   */
+  Attach(
+    request: DeepPartial<IntegrationsServiceAttachRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceAttachResponse>;
+  /** Detach integration from a workflow */
+  Detach(
+    request: DeepPartial<IntegrationsServiceDetachRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceDetachResponse>;
+  /** List attachments */
+  ListAttachments(
+    request: DeepPartial<ListAttachmentsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ListAttachmentsResponse>;
+  /**
+   * Delivery Related operations
+   * List the delivery history of an attached integration
+   */
+  ListDeliveries(
+    request: DeepPartial<IntegrationsServiceListDeliveriesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListDeliveriesResponse>;
+  /** List the deliveries to an attached integration that ran out of retries */
+  ListFailedDeliveries(
+    request: DeepPartial<IntegrationsServiceListFailedDeliveriesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListFailedDeliveriesResponse>;
+  /** Queue failed deliveries to an attached integration to be retried */
+  ReplayDeliveries(
+    request: DeepPartial<IntegrationsServiceReplayDeliveriesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceReplayDeliveriesResponse>;
+}
+
+export class IntegrationsServiceClientImpl implements IntegrationsService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.ListAvailable = this.ListAvailable.bind(this);
+    this.Register = this.Register.bind(this);
+    this.Deregister = this.Deregister.bind(this);
+    this.ListRegistrations = this.ListRegistrations.bind(this);
+    this.DescribeRegistration = this.DescribeRegistration.bind(this);
+    this.Attach = this.Attach.bind(this);
+    this.Detach = this.Detach.bind(this);
+    this.ListAttachments = this.ListAttachments.bind(this);
+    this.ListDeliveries = this.ListDeliveries.bind(this);
+    this.ListFailedDeliveries = this.ListFailedDeliveries.bind(this);
+    this.ReplayDeliveries = this.ReplayDeliveries.bind(this);
+  }
+
+  ListAvailable(
+    request: DeepPartial<IntegrationsServiceListAvailableRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListAvailableResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceListAvailableDesc,
+      IntegrationsServiceListAvailableRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Register(
+    request: DeepPartial<IntegrationsServiceRegisterRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceRegisterResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceRegisterDesc,
+      IntegrationsServiceRegisterRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Deregister(
+    request: DeepPartial<IntegrationsServiceDeregisterRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceDeregisterResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceDeregisterDesc,
+      IntegrationsServiceDeregisterRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ListRegistrations(
+    request: DeepPartial<IntegrationsServiceListRegistrationsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListRegistrationsResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceListRegistrationsDesc,
+      IntegrationsServiceListRegistrationsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  DescribeRegistration(
+    request: DeepPartial<IntegrationsServiceDescribeRegistrationRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceDescribeRegistrationResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceDescribeRegistrationDesc,
+      IntegrationsServiceDescribeRegistrationRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Attach(
+    request: DeepPartial<IntegrationsServiceAttachRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceAttachResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceAttachDesc,
+      IntegrationsServiceAttachRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Detach(
+    request: DeepPartial<IntegrationsServiceDetachRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceDetachResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceDetachDesc,
+      IntegrationsServiceDetachRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ListAttachments(
+    request: DeepPartial<ListAttachmentsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ListAttachmentsResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceListAttachmentsDesc,
+      ListAttachmentsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ListDeliveries(
+    request: DeepPartial<IntegrationsServiceListDeliveriesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListDeliveriesResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceListDeliveriesDesc,
+      IntegrationsServiceListDeliveriesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ListFailedDeliveries(
+    request: DeepPartial<IntegrationsServiceListFailedDeliveriesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceListFailedDeliveriesResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceListFailedDeliveriesDesc,
+      IntegrationsServiceListFailedDeliveriesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ReplayDeliveries(
+    request: DeepPartial<IntegrationsServiceReplayDeliveriesRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<IntegrationsServiceReplayDeliveriesResponse> {
+    return this.rpc.unary(
+      IntegrationsServiceReplayDeliveriesDesc,
+      IntegrationsServiceReplayDeliveriesRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const IntegrationsServiceDesc = { serviceName: "controlplane.v1.IntegrationsService" };
+
+export const IntegrationsServiceListAvailableDesc: UnaryMethodDefinitionish = {
+  methodName: "ListAvailable",
+  service: IntegrationsServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return IntegrationsServiceListAvailableRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = IntegrationsServiceListAvailableResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const IntegrationsServiceRegisterDesc: UnaryMethodDefinitionish = {
+  methodName: "Register",
+  service: IntegrationsServiceDesc,
   requestStream: false,
   responseStream: false,
   requestType: {
@@ -1937,6 +2770,75 @@ export const IntegrationsServiceListAttachmentsDesc: UnaryMethodDefinitionish =
   } as any,
 };
 
+export const IntegrationsServiceListDeliveriesDesc: UnaryMethodDefinitionish = {
+  methodName: "ListDeliveries",
+  service: IntegrationsServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return IntegrationsServiceListDeliveriesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = IntegrationsServiceListDeliveriesResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const IntegrationsServiceListFailedDeliveriesDesc: UnaryMethodDefinitionish = {
+  methodName: "ListFailedDeliveries",
+  service: IntegrationsServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return IntegrationsServiceListFailedDeliveriesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = IntegrationsServiceListFailedDeliveriesResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const IntegrationsServiceReplayDeliveriesDesc: UnaryMethodDefinitionish = {
+  methodName: "ReplayDeliveries",
+  service: IntegrationsServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return IntegrationsServiceReplayDeliveriesRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = IntegrationsServiceReplayDeliveriesResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
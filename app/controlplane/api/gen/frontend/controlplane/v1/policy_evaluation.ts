@@ -0,0 +1,1919 @@
+/* eslint-disable */
+import { grpc } from "@improbable-eng/grpc-web";
+import { BrowserHeaders } from "browser-headers";
+import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../../google/protobuf/timestamp";
+import { CursorPaginationRequest, CursorPaginationResponse } from "./pagination";
+import { PolicyViolation, WorkflowRef } from "./response_messages";
+
+export const protobufPackage = "controlplane.v1";
+
+export interface PolicyEvaluationServiceListRequest {
+  /** Scope to a project and optionally to one of its workflows */
+  projectName: string;
+  workflowName: string;
+  /** by policy name */
+  policyName: string;
+  /** by name of the material the policy was evaluated against */
+  materialName: string;
+  /** by result of the evaluation */
+  status: PolicyEvaluationItem_Status;
+  /**
+   * only the evaluations of the most recent run of each workflow,
+   * i.e to know which projects are currently failing a policy
+   */
+  latestOnly: boolean;
+  /** by the findings of the violations. When set, only the matching violations are returned */
+  findingType: string;
+  /** CRITICAL, HIGH, MEDIUM, LOW */
+  severity: string;
+  /** identifier of the finding, i.e CVE-2024-1234 */
+  externalId: string;
+  /** by creation date, both ends of the range are optional */
+  createdAfter?: Date;
+  createdBefore?: Date;
+  /** pagination options */
+  pagination?: CursorPaginationRequest;
+}
+
+export interface PolicyEvaluationServiceListResponse {
+  result: PolicyEvaluationItem[];
+  pagination?: CursorPaginationResponse;
+}
+
+export interface PolicyEvaluationItem {
+  id: string;
+  createdAt?: Date;
+  workflowRunId: string;
+  /** workflow of the run, and the project it belongs to */
+  workflow?: WorkflowRef;
+  /** policy name */
+  name: string;
+  /** material the policy was evaluated against, empty for attestation policies */
+  materialName: string;
+  materialType: string;
+  /** where the policy was loaded from and its digest */
+  policyUri: string;
+  policyDigest: string;
+  /** group the policy belongs to, if any */
+  groupName: string;
+  status: PolicyEvaluationItem_Status;
+  skipReasons: string[];
+  gate: boolean;
+  /** number of violations, not counting the suppressed ones */
+  violationsCount: number;
+  /** whether the evaluation belongs to the most recent run of the workflow */
+  latest: boolean;
+  violations: PolicyViolation[];
+}
+
+export enum PolicyEvaluationItem_Status {
+  STATUS_UNSPECIFIED = 0,
+  STATUS_PASSED = 1,
+  STATUS_FAILED = 2,
+  STATUS_SKIPPED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function policyEvaluationItem_StatusFromJSON(object: any): PolicyEvaluationItem_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return PolicyEvaluationItem_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "STATUS_PASSED":
+      return PolicyEvaluationItem_Status.STATUS_PASSED;
+    case 2:
+    case "STATUS_FAILED":
+      return PolicyEvaluationItem_Status.STATUS_FAILED;
+    case 3:
+    case "STATUS_SKIPPED":
+      return PolicyEvaluationItem_Status.STATUS_SKIPPED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PolicyEvaluationItem_Status.UNRECOGNIZED;
+  }
+}
+
+export function policyEvaluationItem_StatusToJSON(object: PolicyEvaluationItem_Status): string {
+  switch (object) {
+    case PolicyEvaluationItem_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case PolicyEvaluationItem_Status.STATUS_PASSED:
+      return "STATUS_PASSED";
+    case PolicyEvaluationItem_Status.STATUS_FAILED:
+      return "STATUS_FAILED";
+    case PolicyEvaluationItem_Status.STATUS_SKIPPED:
+      return "STATUS_SKIPPED";
+    case PolicyEvaluationItem_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface PolicyEvaluationServiceImpactRequest {
+  /** policy to evaluate from a policy provider, i.e chainloop://my-policy@2 */
+  policyRef: string;
+  /** or policy group to evaluate */
+  groupRef: string;
+  /** arguments of the policy or group */
+  with: { [key: string]: string };
+  /** Runs to evaluate, scoped to a project */
+  projectName: string;
+  /** semver constraint on the version of the project, i.e ">= 1.2.0, < 2.0.0" */
+  versionRange: string;
+  /** by creation date, both ends of the range are optional */
+  createdAfter?: Date;
+  createdBefore?: Date;
+  /** maximum number of runs to evaluate, most recent first */
+  limit: number;
+}
+
+export interface PolicyEvaluationServiceImpactRequest_WithEntry {
+  key: string;
+  value: string;
+}
+
+export interface PolicyEvaluationServiceImpactResponse {
+  result?: PolicyImpactAnalysis;
+}
+
+export interface PolicyEvaluationServiceImpactReportRequest {
+  id: string;
+}
+
+export interface PolicyEvaluationServiceImpactReportResponse {
+  result?: PolicyImpactAnalysis;
+}
+
+export interface PolicyImpactAnalysis {
+  id: string;
+  status: PolicyImpactAnalysis_Status;
+  createdAt?: Date;
+  finishedAt?: Date;
+  /** number of runs evaluated */
+  evaluatedCount: number;
+  /** runs that fail the policy and didn't fail it when they were attested */
+  newlyFailing: PolicyImpactItem[];
+  /** runs that couldn't be evaluated, i.e their materials are no longer available */
+  errored: PolicyImpactItem[];
+  /** why the analysis failed */
+  error: string;
+}
+
+export enum PolicyImpactAnalysis_Status {
+  STATUS_UNSPECIFIED = 0,
+  STATUS_RUNNING = 1,
+  STATUS_SUCCEEDED = 2,
+  STATUS_FAILED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function policyImpactAnalysis_StatusFromJSON(object: any): PolicyImpactAnalysis_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return PolicyImpactAnalysis_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "STATUS_RUNNING":
+      return PolicyImpactAnalysis_Status.STATUS_RUNNING;
+    case 2:
+    case "STATUS_SUCCEEDED":
+      return PolicyImpactAnalysis_Status.STATUS_SUCCEEDED;
+    case 3:
+    case "STATUS_FAILED":
+      return PolicyImpactAnalysis_Status.STATUS_FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PolicyImpactAnalysis_Status.UNRECOGNIZED;
+  }
+}
+
+export function policyImpactAnalysis_StatusToJSON(object: PolicyImpactAnalysis_Status): string {
+  switch (object) {
+    case PolicyImpactAnalysis_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case PolicyImpactAnalysis_Status.STATUS_RUNNING:
+      return "STATUS_RUNNING";
+    case PolicyImpactAnalysis_Status.STATUS_SUCCEEDED:
+      return "STATUS_SUCCEEDED";
+    case PolicyImpactAnalysis_Status.STATUS_FAILED:
+      return "STATUS_FAILED";
+    case PolicyImpactAnalysis_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface PolicyImpactItem {
+  workflowRunId: string;
+  createdAt?: Date;
+  /** workflow of the run, and the project it belongs to */
+  workflow?: WorkflowRef;
+  projectVersion: string;
+  /** new violations, not counting the suppressed ones */
+  violations: PolicyImpactViolation[];
+  /** why the run couldn't be evaluated */
+  error: string;
+}
+
+export interface PolicyImpactViolation {
+  policyName: string;
+  /** material the policy was evaluated against, empty for attestation policies */
+  materialName: string;
+  message: string;
+}
+
+function createBasePolicyEvaluationServiceListRequest(): PolicyEvaluationServiceListRequest {
+  return {
+    projectName: "",
+    workflowName: "",
+    policyName: "",
+    materialName: "",
+    status: 0,
+    latestOnly: false,
+    findingType: "",
+    severity: "",
+    externalId: "",
+    createdAfter: undefined,
+    createdBefore: undefined,
+    pagination: undefined,
+  };
+}
+
+export const PolicyEvaluationServiceListRequest = {
+  encode(message: PolicyEvaluationServiceListRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectName !== "") {
+      writer.uint32(10).string(message.projectName);
+    }
+    if (message.workflowName !== "") {
+      writer.uint32(18).string(message.workflowName);
+    }
+    if (message.policyName !== "") {
+      writer.uint32(26).string(message.policyName);
+    }
+    if (message.materialName !== "") {
+      writer.uint32(34).string(message.materialName);
+    }
+    if (message.status !== 0) {
+      writer.uint32(40).int32(message.status);
+    }
+    if (message.latestOnly === true) {
+      writer.uint32(48).bool(message.latestOnly);
+    }
+    if (message.findingType !== "") {
+      writer.uint32(58).string(message.findingType);
+    }
+    if (message.severity !== "") {
+      writer.uint32(66).string(message.severity);
+    }
+    if (message.externalId !== "") {
+      writer.uint32(74).string(message.externalId);
+    }
+    if (message.createdAfter !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAfter), writer.uint32(82).fork()).ldelim();
+    }
+    if (message.createdBefore !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdBefore), writer.uint32(90).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      CursorPaginationRequest.encode(message.pagination, writer.uint32(98).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceListRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceListRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.workflowName = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.policyName = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.materialName = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.latestOnly = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.findingType = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.severity = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.externalId = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.createdAfter = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.createdBefore = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.pagination = CursorPaginationRequest.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceListRequest {
+    return {
+      projectName: isSet(object.projectName) ? String(object.projectName) : "",
+      workflowName: isSet(object.workflowName) ? String(object.workflowName) : "",
+      policyName: isSet(object.policyName) ? String(object.policyName) : "",
+      materialName: isSet(object.materialName) ? String(object.materialName) : "",
+      status: isSet(object.status) ? policyEvaluationItem_StatusFromJSON(object.status) : 0,
+      latestOnly: isSet(object.latestOnly) ? Boolean(object.latestOnly) : false,
+      findingType: isSet(object.findingType) ? String(object.findingType) : "",
+      severity: isSet(object.severity) ? String(object.severity) : "",
+      externalId: isSet(object.externalId) ? String(object.externalId) : "",
+      createdAfter: isSet(object.createdAfter) ? fromJsonTimestamp(object.createdAfter) : undefined,
+      createdBefore: isSet(object.createdBefore) ? fromJsonTimestamp(object.createdBefore) : undefined,
+      pagination: isSet(object.pagination) ? CursorPaginationRequest.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: PolicyEvaluationServiceListRequest): unknown {
+    const obj: any = {};
+    message.projectName !== undefined && (obj.projectName = message.projectName);
+    message.workflowName !== undefined && (obj.workflowName = message.workflowName);
+    message.policyName !== undefined && (obj.policyName = message.policyName);
+    message.materialName !== undefined && (obj.materialName = message.materialName);
+    message.status !== undefined && (obj.status = policyEvaluationItem_StatusToJSON(message.status));
+    message.latestOnly !== undefined && (obj.latestOnly = message.latestOnly);
+    message.findingType !== undefined && (obj.findingType = message.findingType);
+    message.severity !== undefined && (obj.severity = message.severity);
+    message.externalId !== undefined && (obj.externalId = message.externalId);
+    message.createdAfter !== undefined && (obj.createdAfter = message.createdAfter.toISOString());
+    message.createdBefore !== undefined && (obj.createdBefore = message.createdBefore.toISOString());
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? CursorPaginationRequest.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceListRequest>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceListRequest {
+    return PolicyEvaluationServiceListRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceListRequest>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceListRequest {
+    const message = createBasePolicyEvaluationServiceListRequest();
+    message.projectName = object.projectName ?? "";
+    message.workflowName = object.workflowName ?? "";
+    message.policyName = object.policyName ?? "";
+    message.materialName = object.materialName ?? "";
+    message.status = object.status ?? 0;
+    message.latestOnly = object.latestOnly ?? false;
+    message.findingType = object.findingType ?? "";
+    message.severity = object.severity ?? "";
+    message.externalId = object.externalId ?? "";
+    message.createdAfter = object.createdAfter ?? undefined;
+    message.createdBefore = object.createdBefore ?? undefined;
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? CursorPaginationRequest.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyEvaluationServiceListResponse(): PolicyEvaluationServiceListResponse {
+  return { result: [], pagination: undefined };
+}
+
+export const PolicyEvaluationServiceListResponse = {
+  encode(message: PolicyEvaluationServiceListResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      PolicyEvaluationItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      CursorPaginationResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceListResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceListResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(PolicyEvaluationItem.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = CursorPaginationResponse.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceListResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => PolicyEvaluationItem.fromJSON(e)) : [],
+      pagination: isSet(object.pagination) ? CursorPaginationResponse.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: PolicyEvaluationServiceListResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? PolicyEvaluationItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? CursorPaginationResponse.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceListResponse>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceListResponse {
+    return PolicyEvaluationServiceListResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceListResponse>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceListResponse {
+    const message = createBasePolicyEvaluationServiceListResponse();
+    message.result = object.result?.map((e) => PolicyEvaluationItem.fromPartial(e)) || [];
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? CursorPaginationResponse.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyEvaluationItem(): PolicyEvaluationItem {
+  return {
+    id: "",
+    createdAt: undefined,
+    workflowRunId: "",
+    workflow: undefined,
+    name: "",
+    materialName: "",
+    materialType: "",
+    policyUri: "",
+    policyDigest: "",
+    groupName: "",
+    status: 0,
+    skipReasons: [],
+    gate: false,
+    violationsCount: 0,
+    latest: false,
+    violations: [],
+  };
+}
+
+export const PolicyEvaluationItem = {
+  encode(message: PolicyEvaluationItem, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.workflowRunId !== "") {
+      writer.uint32(26).string(message.workflowRunId);
+    }
+    if (message.workflow !== undefined) {
+      WorkflowRef.encode(message.workflow, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(42).string(message.name);
+    }
+    if (message.materialName !== "") {
+      writer.uint32(50).string(message.materialName);
+    }
+    if (message.materialType !== "") {
+      writer.uint32(58).string(message.materialType);
+    }
+    if (message.policyUri !== "") {
+      writer.uint32(66).string(message.policyUri);
+    }
+    if (message.policyDigest !== "") {
+      writer.uint32(74).string(message.policyDigest);
+    }
+    if (message.groupName !== "") {
+      writer.uint32(82).string(message.groupName);
+    }
+    if (message.status !== 0) {
+      writer.uint32(88).int32(message.status);
+    }
+    for (const v of message.skipReasons) {
+      writer.uint32(98).string(v!);
+    }
+    if (message.gate === true) {
+      writer.uint32(104).bool(message.gate);
+    }
+    if (message.violationsCount !== 0) {
+      writer.uint32(112).int32(message.violationsCount);
+    }
+    if (message.latest === true) {
+      writer.uint32(120).bool(message.latest);
+    }
+    for (const v of message.violations) {
+      PolicyViolation.encode(v!, writer.uint32(130).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationItem {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.workflowRunId = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.workflow = WorkflowRef.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.materialName = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.materialType = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.policyUri = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.policyDigest = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.groupName = reader.string();
+          continue;
+        case 11:
+          if (tag !== 88) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.skipReasons.push(reader.string());
+          continue;
+        case 13:
+          if (tag !== 104) {
+            break;
+          }
+
+          message.gate = reader.bool();
+          continue;
+        case 14:
+          if (tag !== 112) {
+            break;
+          }
+
+          message.violationsCount = reader.int32();
+          continue;
+        case 15:
+          if (tag !== 120) {
+            break;
+          }
+
+          message.latest = reader.bool();
+          continue;
+        case 16:
+          if (tag !== 130) {
+            break;
+          }
+
+          message.violations.push(PolicyViolation.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationItem {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      workflowRunId: isSet(object.workflowRunId) ? String(object.workflowRunId) : "",
+      workflow: isSet(object.workflow) ? WorkflowRef.fromJSON(object.workflow) : undefined,
+      name: isSet(object.name) ? String(object.name) : "",
+      materialName: isSet(object.materialName) ? String(object.materialName) : "",
+      materialType: isSet(object.materialType) ? String(object.materialType) : "",
+      policyUri: isSet(object.policyUri) ? String(object.policyUri) : "",
+      policyDigest: isSet(object.policyDigest) ? String(object.policyDigest) : "",
+      groupName: isSet(object.groupName) ? String(object.groupName) : "",
+      status: isSet(object.status) ? policyEvaluationItem_StatusFromJSON(object.status) : 0,
+      skipReasons: Array.isArray(object?.skipReasons) ? object.skipReasons.map((e: any) => String(e)) : [],
+      gate: isSet(object.gate) ? Boolean(object.gate) : false,
+      violationsCount: isSet(object.violationsCount) ? Number(object.violationsCount) : 0,
+      latest: isSet(object.latest) ? Boolean(object.latest) : false,
+      violations: Array.isArray(object?.violations)
+        ? object.violations.map((e: any) => PolicyViolation.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: PolicyEvaluationItem): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.workflowRunId !== undefined && (obj.workflowRunId = message.workflowRunId);
+    message.workflow !== undefined &&
+      (obj.workflow = message.workflow ? WorkflowRef.toJSON(message.workflow) : undefined);
+    message.name !== undefined && (obj.name = message.name);
+    message.materialName !== undefined && (obj.materialName = message.materialName);
+    message.materialType !== undefined && (obj.materialType = message.materialType);
+    message.policyUri !== undefined && (obj.policyUri = message.policyUri);
+    message.policyDigest !== undefined && (obj.policyDigest = message.policyDigest);
+    message.groupName !== undefined && (obj.groupName = message.groupName);
+    message.status !== undefined && (obj.status = policyEvaluationItem_StatusToJSON(message.status));
+    if (message.skipReasons) {
+      obj.skipReasons = message.skipReasons.map((e) => e);
+    } else {
+      obj.skipReasons = [];
+    }
+    message.gate !== undefined && (obj.gate = message.gate);
+    message.violationsCount !== undefined && (obj.violationsCount = Math.round(message.violationsCount));
+    message.latest !== undefined && (obj.latest = message.latest);
+    if (message.violations) {
+      obj.violations = message.violations.map((e) => e ? PolicyViolation.toJSON(e) : undefined);
+    } else {
+      obj.violations = [];
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationItem>, I>>(base?: I): PolicyEvaluationItem {
+    return PolicyEvaluationItem.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationItem>, I>>(object: I): PolicyEvaluationItem {
+    const message = createBasePolicyEvaluationItem();
+    message.id = object.id ?? "";
+    message.createdAt = object.createdAt ?? undefined;
+    message.workflowRunId = object.workflowRunId ?? "";
+    message.workflow = (object.workflow !== undefined && object.workflow !== null)
+      ? WorkflowRef.fromPartial(object.workflow)
+      : undefined;
+    message.name = object.name ?? "";
+    message.materialName = object.materialName ?? "";
+    message.materialType = object.materialType ?? "";
+    message.policyUri = object.policyUri ?? "";
+    message.policyDigest = object.policyDigest ?? "";
+    message.groupName = object.groupName ?? "";
+    message.status = object.status ?? 0;
+    message.skipReasons = object.skipReasons?.map((e) => e) || [];
+    message.gate = object.gate ?? false;
+    message.violationsCount = object.violationsCount ?? 0;
+    message.latest = object.latest ?? false;
+    message.violations = object.violations?.map((e) => PolicyViolation.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+/** Query the policy evaluations extracted from the attestations of the organization */
+function createBasePolicyEvaluationServiceImpactRequest(): PolicyEvaluationServiceImpactRequest {
+  return {
+    policyRef: "",
+    groupRef: "",
+    with: {},
+    projectName: "",
+    versionRange: "",
+    createdAfter: undefined,
+    createdBefore: undefined,
+    limit: 0,
+  };
+}
+
+export const PolicyEvaluationServiceImpactRequest = {
+  encode(message: PolicyEvaluationServiceImpactRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.policyRef !== "") {
+      writer.uint32(10).string(message.policyRef);
+    }
+    if (message.groupRef !== "") {
+      writer.uint32(18).string(message.groupRef);
+    }
+    Object.entries(message.with).forEach(([key, value]) => {
+      PolicyEvaluationServiceImpactRequest_WithEntry.encode({ key: key as any, value }, writer.uint32(26).fork())
+        .ldelim();
+    });
+    if (message.projectName !== "") {
+      writer.uint32(34).string(message.projectName);
+    }
+    if (message.versionRange !== "") {
+      writer.uint32(42).string(message.versionRange);
+    }
+    if (message.createdAfter !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAfter), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.createdBefore !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdBefore), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.limit !== 0) {
+      writer.uint32(64).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceImpactRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceImpactRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.policyRef = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.groupRef = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          const entry3 = PolicyEvaluationServiceImpactRequest_WithEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.with[entry3.key] = entry3.value;
+          }
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.projectName = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.versionRange = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.createdAfter = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createdBefore = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceImpactRequest {
+    return {
+      policyRef: isSet(object.policyRef) ? String(object.policyRef) : "",
+      groupRef: isSet(object.groupRef) ? String(object.groupRef) : "",
+      with: isObject(object.with)
+        ? Object.entries(object.with).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      projectName: isSet(object.projectName) ? String(object.projectName) : "",
+      versionRange: isSet(object.versionRange) ? String(object.versionRange) : "",
+      createdAfter: isSet(object.createdAfter) ? fromJsonTimestamp(object.createdAfter) : undefined,
+      createdBefore: isSet(object.createdBefore) ? fromJsonTimestamp(object.createdBefore) : undefined,
+      limit: isSet(object.limit) ? Number(object.limit) : 0,
+    };
+  },
+
+  toJSON(message: PolicyEvaluationServiceImpactRequest): unknown {
+    const obj: any = {};
+    message.policyRef !== undefined && (obj.policyRef = message.policyRef);
+    message.groupRef !== undefined && (obj.groupRef = message.groupRef);
+    obj.with = {};
+    if (message.with) {
+      Object.entries(message.with).forEach(([k, v]) => {
+        obj.with[k] = v;
+      });
+    }
+    message.projectName !== undefined && (obj.projectName = message.projectName);
+    message.versionRange !== undefined && (obj.versionRange = message.versionRange);
+    message.createdAfter !== undefined && (obj.createdAfter = message.createdAfter.toISOString());
+    message.createdBefore !== undefined && (obj.createdBefore = message.createdBefore.toISOString());
+    message.limit !== undefined && (obj.limit = Math.round(message.limit));
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactRequest>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceImpactRequest {
+    return PolicyEvaluationServiceImpactRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactRequest>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceImpactRequest {
+    const message = createBasePolicyEvaluationServiceImpactRequest();
+    message.policyRef = object.policyRef ?? "";
+    message.groupRef = object.groupRef ?? "";
+    message.with = Object.entries(object.with ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = String(value);
+      }
+      return acc;
+    }, {});
+    message.projectName = object.projectName ?? "";
+    message.versionRange = object.versionRange ?? "";
+    message.createdAfter = object.createdAfter ?? undefined;
+    message.createdBefore = object.createdBefore ?? undefined;
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBasePolicyEvaluationServiceImpactRequest_WithEntry(): PolicyEvaluationServiceImpactRequest_WithEntry {
+  return { key: "", value: "" };
+}
+
+export const PolicyEvaluationServiceImpactRequest_WithEntry = {
+  encode(
+    message: PolicyEvaluationServiceImpactRequest_WithEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceImpactRequest_WithEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceImpactRequest_WithEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceImpactRequest_WithEntry {
+    return { key: isSet(object.key) ? String(object.key) : "", value: isSet(object.value) ? String(object.value) : "" };
+  },
+
+  toJSON(message: PolicyEvaluationServiceImpactRequest_WithEntry): unknown {
+    const obj: any = {};
+    message.key !== undefined && (obj.key = message.key);
+    message.value !== undefined && (obj.value = message.value);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactRequest_WithEntry>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceImpactRequest_WithEntry {
+    return PolicyEvaluationServiceImpactRequest_WithEntry.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactRequest_WithEntry>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceImpactRequest_WithEntry {
+    const message = createBasePolicyEvaluationServiceImpactRequest_WithEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBasePolicyEvaluationServiceImpactResponse(): PolicyEvaluationServiceImpactResponse {
+  return { result: undefined };
+}
+
+export const PolicyEvaluationServiceImpactResponse = {
+  encode(message: PolicyEvaluationServiceImpactResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      PolicyImpactAnalysis.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceImpactResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceImpactResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = PolicyImpactAnalysis.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceImpactResponse {
+    return { result: isSet(object.result) ? PolicyImpactAnalysis.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: PolicyEvaluationServiceImpactResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined &&
+      (obj.result = message.result ? PolicyImpactAnalysis.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactResponse>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceImpactResponse {
+    return PolicyEvaluationServiceImpactResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactResponse>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceImpactResponse {
+    const message = createBasePolicyEvaluationServiceImpactResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? PolicyImpactAnalysis.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyEvaluationServiceImpactReportRequest(): PolicyEvaluationServiceImpactReportRequest {
+  return { id: "" };
+}
+
+export const PolicyEvaluationServiceImpactReportRequest = {
+  encode(message: PolicyEvaluationServiceImpactReportRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceImpactReportRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceImpactReportRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceImpactReportRequest {
+    return { id: isSet(object.id) ? String(object.id) : "" };
+  },
+
+  toJSON(message: PolicyEvaluationServiceImpactReportRequest): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactReportRequest>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceImpactReportRequest {
+    return PolicyEvaluationServiceImpactReportRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactReportRequest>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceImpactReportRequest {
+    const message = createBasePolicyEvaluationServiceImpactReportRequest();
+    message.id = object.id ?? "";
+    return message;
+  },
+};
+
+function createBasePolicyEvaluationServiceImpactReportResponse(): PolicyEvaluationServiceImpactReportResponse {
+  return { result: undefined };
+}
+
+export const PolicyEvaluationServiceImpactReportResponse = {
+  encode(message: PolicyEvaluationServiceImpactReportResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      PolicyImpactAnalysis.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyEvaluationServiceImpactReportResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyEvaluationServiceImpactReportResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = PolicyImpactAnalysis.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyEvaluationServiceImpactReportResponse {
+    return { result: isSet(object.result) ? PolicyImpactAnalysis.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: PolicyEvaluationServiceImpactReportResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined &&
+      (obj.result = message.result ? PolicyImpactAnalysis.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactReportResponse>, I>>(
+    base?: I,
+  ): PolicyEvaluationServiceImpactReportResponse {
+    return PolicyEvaluationServiceImpactReportResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyEvaluationServiceImpactReportResponse>, I>>(
+    object: I,
+  ): PolicyEvaluationServiceImpactReportResponse {
+    const message = createBasePolicyEvaluationServiceImpactReportResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? PolicyImpactAnalysis.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyImpactAnalysis(): PolicyImpactAnalysis {
+  return {
+    id: "",
+    status: 0,
+    createdAt: undefined,
+    finishedAt: undefined,
+    evaluatedCount: 0,
+    newlyFailing: [],
+    errored: [],
+    error: "",
+  };
+}
+
+export const PolicyImpactAnalysis = {
+  encode(message: PolicyImpactAnalysis, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.finishedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.finishedAt), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.evaluatedCount !== 0) {
+      writer.uint32(40).int32(message.evaluatedCount);
+    }
+    for (const v of message.newlyFailing) {
+      PolicyImpactItem.encode(v!, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.errored) {
+      PolicyImpactItem.encode(v!, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.error !== "") {
+      writer.uint32(66).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyImpactAnalysis {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyImpactAnalysis();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.finishedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.evaluatedCount = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.newlyFailing.push(PolicyImpactItem.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.errored.push(PolicyImpactItem.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyImpactAnalysis {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      status: isSet(object.status) ? policyImpactAnalysis_StatusFromJSON(object.status) : 0,
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      finishedAt: isSet(object.finishedAt) ? fromJsonTimestamp(object.finishedAt) : undefined,
+      evaluatedCount: isSet(object.evaluatedCount) ? Number(object.evaluatedCount) : 0,
+      newlyFailing: Array.isArray(object?.newlyFailing)
+        ? object.newlyFailing.map((e: any) => PolicyImpactItem.fromJSON(e))
+        : [],
+      errored: Array.isArray(object?.errored) ? object.errored.map((e: any) => PolicyImpactItem.fromJSON(e)) : [],
+      error: isSet(object.error) ? String(object.error) : "",
+    };
+  },
+
+  toJSON(message: PolicyImpactAnalysis): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.status !== undefined && (obj.status = policyImpactAnalysis_StatusToJSON(message.status));
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.finishedAt !== undefined && (obj.finishedAt = message.finishedAt.toISOString());
+    message.evaluatedCount !== undefined && (obj.evaluatedCount = Math.round(message.evaluatedCount));
+    if (message.newlyFailing) {
+      obj.newlyFailing = message.newlyFailing.map((e) => e ? PolicyImpactItem.toJSON(e) : undefined);
+    } else {
+      obj.newlyFailing = [];
+    }
+    if (message.errored) {
+      obj.errored = message.errored.map((e) => e ? PolicyImpactItem.toJSON(e) : undefined);
+    } else {
+      obj.errored = [];
+    }
+    message.error !== undefined && (obj.error = message.error);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyImpactAnalysis>, I>>(base?: I): PolicyImpactAnalysis {
+    return PolicyImpactAnalysis.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyImpactAnalysis>, I>>(object: I): PolicyImpactAnalysis {
+    const message = createBasePolicyImpactAnalysis();
+    message.id = object.id ?? "";
+    message.status = object.status ?? 0;
+    message.createdAt = object.createdAt ?? undefined;
+    message.finishedAt = object.finishedAt ?? undefined;
+    message.evaluatedCount = object.evaluatedCount ?? 0;
+    message.newlyFailing = object.newlyFailing?.map((e) => PolicyImpactItem.fromPartial(e)) || [];
+    message.errored = object.errored?.map((e) => PolicyImpactItem.fromPartial(e)) || [];
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBasePolicyImpactItem(): PolicyImpactItem {
+  return {
+    workflowRunId: "",
+    createdAt: undefined,
+    workflow: undefined,
+    projectVersion: "",
+    violations: [],
+    error: "",
+  };
+}
+
+export const PolicyImpactItem = {
+  encode(message: PolicyImpactItem, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.workflowRunId !== "") {
+      writer.uint32(10).string(message.workflowRunId);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.workflow !== undefined) {
+      WorkflowRef.encode(message.workflow, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.projectVersion !== "") {
+      writer.uint32(34).string(message.projectVersion);
+    }
+    for (const v of message.violations) {
+      PolicyImpactViolation.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.error !== "") {
+      writer.uint32(50).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyImpactItem {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyImpactItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.workflowRunId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.workflow = WorkflowRef.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.projectVersion = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.violations.push(PolicyImpactViolation.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyImpactItem {
+    return {
+      workflowRunId: isSet(object.workflowRunId) ? String(object.workflowRunId) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      workflow: isSet(object.workflow) ? WorkflowRef.fromJSON(object.workflow) : undefined,
+      projectVersion: isSet(object.projectVersion) ? String(object.projectVersion) : "",
+      violations: Array.isArray(object?.violations)
+        ? object.violations.map((e: any) => PolicyImpactViolation.fromJSON(e))
+        : [],
+      error: isSet(object.error) ? String(object.error) : "",
+    };
+  },
+
+  toJSON(message: PolicyImpactItem): unknown {
+    const obj: any = {};
+    message.workflowRunId !== undefined && (obj.workflowRunId = message.workflowRunId);
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.workflow !== undefined &&
+      (obj.workflow = message.workflow ? WorkflowRef.toJSON(message.workflow) : undefined);
+    message.projectVersion !== undefined && (obj.projectVersion = message.projectVersion);
+    if (message.violations) {
+      obj.violations = message.violations.map((e) => e ? PolicyImpactViolation.toJSON(e) : undefined);
+    } else {
+      obj.violations = [];
+    }
+    message.error !== undefined && (obj.error = message.error);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyImpactItem>, I>>(base?: I): PolicyImpactItem {
+    return PolicyImpactItem.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyImpactItem>, I>>(object: I): PolicyImpactItem {
+    const message = createBasePolicyImpactItem();
+    message.workflowRunId = object.workflowRunId ?? "";
+    message.createdAt = object.createdAt ?? undefined;
+    message.workflow = (object.workflow !== undefined && object.workflow !== null)
+      ? WorkflowRef.fromPartial(object.workflow)
+      : undefined;
+    message.projectVersion = object.projectVersion ?? "";
+    message.violations = object.violations?.map((e) => PolicyImpactViolation.fromPartial(e)) || [];
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBasePolicyImpactViolation(): PolicyImpactViolation {
+  return { policyName: "", materialName: "", message: "" };
+}
+
+export const PolicyImpactViolation = {
+  encode(message: PolicyImpactViolation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.policyName !== "") {
+      writer.uint32(10).string(message.policyName);
+    }
+    if (message.materialName !== "") {
+      writer.uint32(18).string(message.materialName);
+    }
+    if (message.message !== "") {
+      writer.uint32(26).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyImpactViolation {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyImpactViolation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.policyName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.materialName = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyImpactViolation {
+    return {
+      policyName: isSet(object.policyName) ? String(object.policyName) : "",
+      materialName: isSet(object.materialName) ? String(object.materialName) : "",
+      message: isSet(object.message) ? String(object.message) : "",
+    };
+  },
+
+  toJSON(message: PolicyImpactViolation): unknown {
+    const obj: any = {};
+    message.policyName !== undefined && (obj.policyName = message.policyName);
+    message.materialName !== undefined && (obj.materialName = message.materialName);
+    message.message !== undefined && (obj.message = message.message);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyImpactViolation>, I>>(base?: I): PolicyImpactViolation {
+    return PolicyImpactViolation.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyImpactViolation>, I>>(object: I): PolicyImpactViolation {
+    const message = createBasePolicyImpactViolation();
+    message.policyName = object.policyName ?? "";
+    message.materialName = object.materialName ?? "";
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+export interface PolicyEvaluationService {
+  /** List the policy evaluations, most recent first */
+  List(
+    request: DeepPartial<PolicyEvaluationServiceListRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyEvaluationServiceListResponse>;
+  /**
+   * Re-evaluate a policy or policy group against the attestations already stored,
+   * i.e to know which releases would fail a new version of a policy.
+   * The original runs and their evaluations are left untouched.
+   * The analysis runs in the background, its report is retrieved with ImpactReport
+   */
+  Impact(
+    request: DeepPartial<PolicyEvaluationServiceImpactRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyEvaluationServiceImpactResponse>;
+  /** Status of an analysis started with Impact, and its report once it finishes */
+  ImpactReport(
+    request: DeepPartial<PolicyEvaluationServiceImpactReportRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyEvaluationServiceImpactReportResponse>;
+}
+
+export class PolicyEvaluationServiceClientImpl implements PolicyEvaluationService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.List = this.List.bind(this);
+    this.Impact = this.Impact.bind(this);
+    this.ImpactReport = this.ImpactReport.bind(this);
+  }
+
+  List(
+    request: DeepPartial<PolicyEvaluationServiceListRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyEvaluationServiceListResponse> {
+    return this.rpc.unary(
+      PolicyEvaluationServiceListDesc,
+      PolicyEvaluationServiceListRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Impact(
+    request: DeepPartial<PolicyEvaluationServiceImpactRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyEvaluationServiceImpactResponse> {
+    return this.rpc.unary(
+      PolicyEvaluationServiceImpactDesc,
+      PolicyEvaluationServiceImpactRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  ImpactReport(
+    request: DeepPartial<PolicyEvaluationServiceImpactReportRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyEvaluationServiceImpactReportResponse> {
+    return this.rpc.unary(
+      PolicyEvaluationServiceImpactReportDesc,
+      PolicyEvaluationServiceImpactReportRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const PolicyEvaluationServiceDesc = { serviceName: "controlplane.v1.PolicyEvaluationService" };
+
+export const PolicyEvaluationServiceListDesc: UnaryMethodDefinitionish = {
+  methodName: "List",
+  service: PolicyEvaluationServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyEvaluationServiceListRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyEvaluationServiceListResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PolicyEvaluationServiceImpactDesc: UnaryMethodDefinitionish = {
+  methodName: "Impact",
+  service: PolicyEvaluationServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyEvaluationServiceImpactRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyEvaluationServiceImpactResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PolicyEvaluationServiceImpactReportDesc: UnaryMethodDefinitionish = {
+  methodName: "ImpactReport",
+  service: PolicyEvaluationServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyEvaluationServiceImpactReportRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyEvaluationServiceImpactReportResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
+  requestStream: any;
+  responseStream: any;
+}
+
+type UnaryMethodDefinitionish = UnaryMethodDefinitionishR;
+
+interface Rpc {
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any>;
+}
+
+export class GrpcWebImpl {
+  private host: string;
+  private options: {
+    transport?: grpc.TransportFactory;
+
+    debug?: boolean;
+    metadata?: grpc.Metadata;
+    upStreamRetryCodes?: number[];
+  };
+
+  constructor(
+    host: string,
+    options: {
+      transport?: grpc.TransportFactory;
+
+      debug?: boolean;
+      metadata?: grpc.Metadata;
+      upStreamRetryCodes?: number[];
+    },
+  ) {
+    this.host = host;
+    this.options = options;
+  }
+
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any> {
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata = metadata && this.options.metadata
+      ? new BrowserHeaders({ ...this.options?.metadata.headersMap, ...metadata?.headersMap })
+      : metadata || this.options.metadata;
+    return new Promise((resolve, reject) => {
+      grpc.unary(methodDesc, {
+        request,
+        host: this.host,
+        metadata: maybeCombinedMetadata,
+        transport: this.options.transport,
+        debug: this.options.debug,
+        onEnd: function (response) {
+          if (response.status === grpc.Code.OK) {
+            resolve(response.message!.toObject());
+          } else {
+            const err = new GrpcWebError(response.statusMessage, response.status, response.trailers);
+            reject(err);
+          }
+        },
+      });
+    });
+  }
+}
+
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
+
+export class GrpcWebError extends tsProtoGlobalThis.Error {
+  constructor(message: string, public code: grpc.Code, public metadata: grpc.Metadata) {
+    super(message);
+  }
+}
@@ -0,0 +1,1253 @@
+/* eslint-disable */
+import { grpc } from "@improbable-eng/grpc-web";
+import { BrowserHeaders } from "browser-headers";
+import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../../google/protobuf/timestamp";
+
+export const protobufPackage = "controlplane.v1";
+
+export interface PolicyExceptionServiceCreateRequest {
+  /**
+   * Scope of the exception, empty values match everything.
+   * At least one of policy_name, material_name or external_id must be set
+   */
+  projectName: string;
+  workflowName: string;
+  policyName: string;
+  materialName: string;
+  /** identifier of the finding, i.e CVE-2024-1234 */
+  externalId: string;
+  /** why the violations can be waived */
+  justification: string;
+  expiresAt?: Date;
+}
+
+export interface PolicyExceptionServiceCreateResponse {
+  result?: PolicyExceptionItem;
+}
+
+export interface PolicyExceptionServiceApproveRequest {
+  id: string;
+}
+
+export interface PolicyExceptionServiceApproveResponse {
+  result?: PolicyExceptionItem;
+}
+
+export interface PolicyExceptionServiceRevokeRequest {
+  id: string;
+}
+
+export interface PolicyExceptionServiceRevokeResponse {
+}
+
+export interface PolicyExceptionServiceListRequest {
+  /** the exceptions scoped to the project */
+  projectName: string;
+  status: PolicyExceptionItem_Status;
+}
+
+export interface PolicyExceptionServiceListResponse {
+  result: PolicyExceptionItem[];
+}
+
+export interface PolicyExceptionItem {
+  id: string;
+  createdAt?: Date;
+  /** Scope of the exception, empty values match everything */
+  projectName: string;
+  workflowName: string;
+  policyName: string;
+  materialName: string;
+  externalId: string;
+  justification: string;
+  /** emails of the users that requested and approved the exception */
+  createdBy: string;
+  approvedBy: string;
+  approvedAt?: Date;
+  expiresAt?: Date;
+  revokedAt?: Date;
+  status: PolicyExceptionItem_Status;
+}
+
+export enum PolicyExceptionItem_Status {
+  STATUS_UNSPECIFIED = 0,
+  /** waiting for approval */
+  STATUS_PENDING = 1,
+  /** approved, it suppresses the matching violations */
+  STATUS_ACTIVE = 2,
+  STATUS_EXPIRED = 3,
+  STATUS_REVOKED = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function policyExceptionItem_StatusFromJSON(object: any): PolicyExceptionItem_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return PolicyExceptionItem_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "STATUS_PENDING":
+      return PolicyExceptionItem_Status.STATUS_PENDING;
+    case 2:
+    case "STATUS_ACTIVE":
+      return PolicyExceptionItem_Status.STATUS_ACTIVE;
+    case 3:
+    case "STATUS_EXPIRED":
+      return PolicyExceptionItem_Status.STATUS_EXPIRED;
+    case 4:
+    case "STATUS_REVOKED":
+      return PolicyExceptionItem_Status.STATUS_REVOKED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PolicyExceptionItem_Status.UNRECOGNIZED;
+  }
+}
+
+export function policyExceptionItem_StatusToJSON(object: PolicyExceptionItem_Status): string {
+  switch (object) {
+    case PolicyExceptionItem_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case PolicyExceptionItem_Status.STATUS_PENDING:
+      return "STATUS_PENDING";
+    case PolicyExceptionItem_Status.STATUS_ACTIVE:
+      return "STATUS_ACTIVE";
+    case PolicyExceptionItem_Status.STATUS_EXPIRED:
+      return "STATUS_EXPIRED";
+    case PolicyExceptionItem_Status.STATUS_REVOKED:
+      return "STATUS_REVOKED";
+    case PolicyExceptionItem_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+function createBasePolicyExceptionServiceCreateRequest(): PolicyExceptionServiceCreateRequest {
+  return {
+    projectName: "",
+    workflowName: "",
+    policyName: "",
+    materialName: "",
+    externalId: "",
+    justification: "",
+    expiresAt: undefined,
+  };
+}
+
+export const PolicyExceptionServiceCreateRequest = {
+  encode(message: PolicyExceptionServiceCreateRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectName !== "") {
+      writer.uint32(10).string(message.projectName);
+    }
+    if (message.workflowName !== "") {
+      writer.uint32(18).string(message.workflowName);
+    }
+    if (message.policyName !== "") {
+      writer.uint32(26).string(message.policyName);
+    }
+    if (message.materialName !== "") {
+      writer.uint32(34).string(message.materialName);
+    }
+    if (message.externalId !== "") {
+      writer.uint32(42).string(message.externalId);
+    }
+    if (message.justification !== "") {
+      writer.uint32(50).string(message.justification);
+    }
+    if (message.expiresAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.expiresAt), writer.uint32(58).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceCreateRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceCreateRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.workflowName = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.policyName = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.materialName = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.externalId = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.justification = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.expiresAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceCreateRequest {
+    return {
+      projectName: isSet(object.projectName) ? String(object.projectName) : "",
+      workflowName: isSet(object.workflowName) ? String(object.workflowName) : "",
+      policyName: isSet(object.policyName) ? String(object.policyName) : "",
+      materialName: isSet(object.materialName) ? String(object.materialName) : "",
+      externalId: isSet(object.externalId) ? String(object.externalId) : "",
+      justification: isSet(object.justification) ? String(object.justification) : "",
+      expiresAt: isSet(object.expiresAt) ? fromJsonTimestamp(object.expiresAt) : undefined,
+    };
+  },
+
+  toJSON(message: PolicyExceptionServiceCreateRequest): unknown {
+    const obj: any = {};
+    message.projectName !== undefined && (obj.projectName = message.projectName);
+    message.workflowName !== undefined && (obj.workflowName = message.workflowName);
+    message.policyName !== undefined && (obj.policyName = message.policyName);
+    message.materialName !== undefined && (obj.materialName = message.materialName);
+    message.externalId !== undefined && (obj.externalId = message.externalId);
+    message.justification !== undefined && (obj.justification = message.justification);
+    message.expiresAt !== undefined && (obj.expiresAt = message.expiresAt.toISOString());
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceCreateRequest>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceCreateRequest {
+    return PolicyExceptionServiceCreateRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceCreateRequest>, I>>(
+    object: I,
+  ): PolicyExceptionServiceCreateRequest {
+    const message = createBasePolicyExceptionServiceCreateRequest();
+    message.projectName = object.projectName ?? "";
+    message.workflowName = object.workflowName ?? "";
+    message.policyName = object.policyName ?? "";
+    message.materialName = object.materialName ?? "";
+    message.externalId = object.externalId ?? "";
+    message.justification = object.justification ?? "";
+    message.expiresAt = object.expiresAt ?? undefined;
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceCreateResponse(): PolicyExceptionServiceCreateResponse {
+  return { result: undefined };
+}
+
+export const PolicyExceptionServiceCreateResponse = {
+  encode(message: PolicyExceptionServiceCreateResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      PolicyExceptionItem.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceCreateResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceCreateResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = PolicyExceptionItem.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceCreateResponse {
+    return { result: isSet(object.result) ? PolicyExceptionItem.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: PolicyExceptionServiceCreateResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined &&
+      (obj.result = message.result ? PolicyExceptionItem.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceCreateResponse>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceCreateResponse {
+    return PolicyExceptionServiceCreateResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceCreateResponse>, I>>(
+    object: I,
+  ): PolicyExceptionServiceCreateResponse {
+    const message = createBasePolicyExceptionServiceCreateResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? PolicyExceptionItem.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceApproveRequest(): PolicyExceptionServiceApproveRequest {
+  return { id: "" };
+}
+
+export const PolicyExceptionServiceApproveRequest = {
+  encode(message: PolicyExceptionServiceApproveRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceApproveRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceApproveRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceApproveRequest {
+    return { id: isSet(object.id) ? String(object.id) : "" };
+  },
+
+  toJSON(message: PolicyExceptionServiceApproveRequest): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceApproveRequest>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceApproveRequest {
+    return PolicyExceptionServiceApproveRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceApproveRequest>, I>>(
+    object: I,
+  ): PolicyExceptionServiceApproveRequest {
+    const message = createBasePolicyExceptionServiceApproveRequest();
+    message.id = object.id ?? "";
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceApproveResponse(): PolicyExceptionServiceApproveResponse {
+  return { result: undefined };
+}
+
+export const PolicyExceptionServiceApproveResponse = {
+  encode(message: PolicyExceptionServiceApproveResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      PolicyExceptionItem.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceApproveResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceApproveResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = PolicyExceptionItem.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceApproveResponse {
+    return { result: isSet(object.result) ? PolicyExceptionItem.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: PolicyExceptionServiceApproveResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined &&
+      (obj.result = message.result ? PolicyExceptionItem.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceApproveResponse>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceApproveResponse {
+    return PolicyExceptionServiceApproveResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceApproveResponse>, I>>(
+    object: I,
+  ): PolicyExceptionServiceApproveResponse {
+    const message = createBasePolicyExceptionServiceApproveResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? PolicyExceptionItem.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceRevokeRequest(): PolicyExceptionServiceRevokeRequest {
+  return { id: "" };
+}
+
+export const PolicyExceptionServiceRevokeRequest = {
+  encode(message: PolicyExceptionServiceRevokeRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceRevokeRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceRevokeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceRevokeRequest {
+    return { id: isSet(object.id) ? String(object.id) : "" };
+  },
+
+  toJSON(message: PolicyExceptionServiceRevokeRequest): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceRevokeRequest>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceRevokeRequest {
+    return PolicyExceptionServiceRevokeRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceRevokeRequest>, I>>(
+    object: I,
+  ): PolicyExceptionServiceRevokeRequest {
+    const message = createBasePolicyExceptionServiceRevokeRequest();
+    message.id = object.id ?? "";
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceRevokeResponse(): PolicyExceptionServiceRevokeResponse {
+  return {};
+}
+
+export const PolicyExceptionServiceRevokeResponse = {
+  encode(_: PolicyExceptionServiceRevokeResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceRevokeResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceRevokeResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): PolicyExceptionServiceRevokeResponse {
+    return {};
+  },
+
+  toJSON(_: PolicyExceptionServiceRevokeResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceRevokeResponse>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceRevokeResponse {
+    return PolicyExceptionServiceRevokeResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceRevokeResponse>, I>>(
+    _: I,
+  ): PolicyExceptionServiceRevokeResponse {
+    const message = createBasePolicyExceptionServiceRevokeResponse();
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceListRequest(): PolicyExceptionServiceListRequest {
+  return { projectName: "", status: 0 };
+}
+
+export const PolicyExceptionServiceListRequest = {
+  encode(message: PolicyExceptionServiceListRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectName !== "") {
+      writer.uint32(10).string(message.projectName);
+    }
+    if (message.status !== 0) {
+      writer.uint32(16).int32(message.status);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceListRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceListRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceListRequest {
+    return {
+      projectName: isSet(object.projectName) ? String(object.projectName) : "",
+      status: isSet(object.status) ? policyExceptionItem_StatusFromJSON(object.status) : 0,
+    };
+  },
+
+  toJSON(message: PolicyExceptionServiceListRequest): unknown {
+    const obj: any = {};
+    message.projectName !== undefined && (obj.projectName = message.projectName);
+    message.status !== undefined && (obj.status = policyExceptionItem_StatusToJSON(message.status));
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceListRequest>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceListRequest {
+    return PolicyExceptionServiceListRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceListRequest>, I>>(
+    object: I,
+  ): PolicyExceptionServiceListRequest {
+    const message = createBasePolicyExceptionServiceListRequest();
+    message.projectName = object.projectName ?? "";
+    message.status = object.status ?? 0;
+    return message;
+  },
+};
+
+function createBasePolicyExceptionServiceListResponse(): PolicyExceptionServiceListResponse {
+  return { result: [] };
+}
+
+export const PolicyExceptionServiceListResponse = {
+  encode(message: PolicyExceptionServiceListResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      PolicyExceptionItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionServiceListResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionServiceListResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(PolicyExceptionItem.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionServiceListResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => PolicyExceptionItem.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: PolicyExceptionServiceListResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? PolicyExceptionItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionServiceListResponse>, I>>(
+    base?: I,
+  ): PolicyExceptionServiceListResponse {
+    return PolicyExceptionServiceListResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionServiceListResponse>, I>>(
+    object: I,
+  ): PolicyExceptionServiceListResponse {
+    const message = createBasePolicyExceptionServiceListResponse();
+    message.result = object.result?.map((e) => PolicyExceptionItem.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBasePolicyExceptionItem(): PolicyExceptionItem {
+  return {
+    id: "",
+    createdAt: undefined,
+    projectName: "",
+    workflowName: "",
+    policyName: "",
+    materialName: "",
+    externalId: "",
+    justification: "",
+    createdBy: "",
+    approvedBy: "",
+    approvedAt: undefined,
+    expiresAt: undefined,
+    revokedAt: undefined,
+    status: 0,
+  };
+}
+
+export const PolicyExceptionItem = {
+  encode(message: PolicyExceptionItem, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.projectName !== "") {
+      writer.uint32(26).string(message.projectName);
+    }
+    if (message.workflowName !== "") {
+      writer.uint32(34).string(message.workflowName);
+    }
+    if (message.policyName !== "") {
+      writer.uint32(42).string(message.policyName);
+    }
+    if (message.materialName !== "") {
+      writer.uint32(50).string(message.materialName);
+    }
+    if (message.externalId !== "") {
+      writer.uint32(58).string(message.externalId);
+    }
+    if (message.justification !== "") {
+      writer.uint32(66).string(message.justification);
+    }
+    if (message.createdBy !== "") {
+      writer.uint32(74).string(message.createdBy);
+    }
+    if (message.approvedBy !== "") {
+      writer.uint32(82).string(message.approvedBy);
+    }
+    if (message.approvedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.approvedAt), writer.uint32(90).fork()).ldelim();
+    }
+    if (message.expiresAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.expiresAt), writer.uint32(98).fork()).ldelim();
+    }
+    if (message.revokedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.revokedAt), writer.uint32(106).fork()).ldelim();
+    }
+    if (message.status !== 0) {
+      writer.uint32(112).int32(message.status);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PolicyExceptionItem {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePolicyExceptionItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.projectName = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.workflowName = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.policyName = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.materialName = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.externalId = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.justification = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.createdBy = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.approvedBy = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.approvedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.expiresAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.revokedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 14:
+          if (tag !== 112) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PolicyExceptionItem {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      projectName: isSet(object.projectName) ? String(object.projectName) : "",
+      workflowName: isSet(object.workflowName) ? String(object.workflowName) : "",
+      policyName: isSet(object.policyName) ? String(object.policyName) : "",
+      materialName: isSet(object.materialName) ? String(object.materialName) : "",
+      externalId: isSet(object.externalId) ? String(object.externalId) : "",
+      justification: isSet(object.justification) ? String(object.justification) : "",
+      createdBy: isSet(object.createdBy) ? String(object.createdBy) : "",
+      approvedBy: isSet(object.approvedBy) ? String(object.approvedBy) : "",
+      approvedAt: isSet(object.approvedAt) ? fromJsonTimestamp(object.approvedAt) : undefined,
+      expiresAt: isSet(object.expiresAt) ? fromJsonTimestamp(object.expiresAt) : undefined,
+      revokedAt: isSet(object.revokedAt) ? fromJsonTimestamp(object.revokedAt) : undefined,
+      status: isSet(object.status) ? policyExceptionItem_StatusFromJSON(object.status) : 0,
+    };
+  },
+
+  toJSON(message: PolicyExceptionItem): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.projectName !== undefined && (obj.projectName = message.projectName);
+    message.workflowName !== undefined && (obj.workflowName = message.workflowName);
+    message.policyName !== undefined && (obj.policyName = message.policyName);
+    message.materialName !== undefined && (obj.materialName = message.materialName);
+    message.externalId !== undefined && (obj.externalId = message.externalId);
+    message.justification !== undefined && (obj.justification = message.justification);
+    message.createdBy !== undefined && (obj.createdBy = message.createdBy);
+    message.approvedBy !== undefined && (obj.approvedBy = message.approvedBy);
+    message.approvedAt !== undefined && (obj.approvedAt = message.approvedAt.toISOString());
+    message.expiresAt !== undefined && (obj.expiresAt = message.expiresAt.toISOString());
+    message.revokedAt !== undefined && (obj.revokedAt = message.revokedAt.toISOString());
+    message.status !== undefined && (obj.status = policyExceptionItem_StatusToJSON(message.status));
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PolicyExceptionItem>, I>>(base?: I): PolicyExceptionItem {
+    return PolicyExceptionItem.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PolicyExceptionItem>, I>>(object: I): PolicyExceptionItem {
+    const message = createBasePolicyExceptionItem();
+    message.id = object.id ?? "";
+    message.createdAt = object.createdAt ?? undefined;
+    message.projectName = object.projectName ?? "";
+    message.workflowName = object.workflowName ?? "";
+    message.policyName = object.policyName ?? "";
+    message.materialName = object.materialName ?? "";
+    message.externalId = object.externalId ?? "";
+    message.justification = object.justification ?? "";
+    message.createdBy = object.createdBy ?? "";
+    message.approvedBy = object.approvedBy ?? "";
+    message.approvedAt = object.approvedAt ?? undefined;
+    message.expiresAt = object.expiresAt ?? undefined;
+    message.revokedAt = object.revokedAt ?? undefined;
+    message.status = object.status ?? 0;
+    return message;
+  },
+};
+
+/** Manage the exceptions that waive policy violations across the organization */
+export interface PolicyExceptionService {
+  /** Request an exception, it takes effect once approved */
+  Create(
+    request: DeepPartial<PolicyExceptionServiceCreateRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceCreateResponse>;
+  /** Approve a pending exception, it can't be approved by the user that requested it */
+  Approve(
+    request: DeepPartial<PolicyExceptionServiceApproveRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceApproveResponse>;
+  /** Revoke an exception before it expires */
+  Revoke(
+    request: DeepPartial<PolicyExceptionServiceRevokeRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceRevokeResponse>;
+  /** List the exceptions, most recent first */
+  List(
+    request: DeepPartial<PolicyExceptionServiceListRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceListResponse>;
+}
+
+export class PolicyExceptionServiceClientImpl implements PolicyExceptionService {
+  private readonly rpc: Rpc;
+
+  constructor(rpc: Rpc) {
+    this.rpc = rpc;
+    this.Create = this.Create.bind(this);
+    this.Approve = this.Approve.bind(this);
+    this.Revoke = this.Revoke.bind(this);
+    this.List = this.List.bind(this);
+  }
+
+  Create(
+    request: DeepPartial<PolicyExceptionServiceCreateRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceCreateResponse> {
+    return this.rpc.unary(
+      PolicyExceptionServiceCreateDesc,
+      PolicyExceptionServiceCreateRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Approve(
+    request: DeepPartial<PolicyExceptionServiceApproveRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceApproveResponse> {
+    return this.rpc.unary(
+      PolicyExceptionServiceApproveDesc,
+      PolicyExceptionServiceApproveRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  Revoke(
+    request: DeepPartial<PolicyExceptionServiceRevokeRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceRevokeResponse> {
+    return this.rpc.unary(
+      PolicyExceptionServiceRevokeDesc,
+      PolicyExceptionServiceRevokeRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  List(
+    request: DeepPartial<PolicyExceptionServiceListRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<PolicyExceptionServiceListResponse> {
+    return this.rpc.unary(
+      PolicyExceptionServiceListDesc,
+      PolicyExceptionServiceListRequest.fromPartial(request),
+      metadata,
+    );
+  }
+}
+
+export const PolicyExceptionServiceDesc = { serviceName: "controlplane.v1.PolicyExceptionService" };
+
+export const PolicyExceptionServiceCreateDesc: UnaryMethodDefinitionish = {
+  methodName: "Create",
+  service: PolicyExceptionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyExceptionServiceCreateRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyExceptionServiceCreateResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PolicyExceptionServiceApproveDesc: UnaryMethodDefinitionish = {
+  methodName: "Approve",
+  service: PolicyExceptionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyExceptionServiceApproveRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyExceptionServiceApproveResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PolicyExceptionServiceRevokeDesc: UnaryMethodDefinitionish = {
+  methodName: "Revoke",
+  service: PolicyExceptionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyExceptionServiceRevokeRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyExceptionServiceRevokeResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const PolicyExceptionServiceListDesc: UnaryMethodDefinitionish = {
+  methodName: "List",
+  service: PolicyExceptionServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return PolicyExceptionServiceListRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = PolicyExceptionServiceListResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
+  requestStream: any;
+  responseStream: any;
+}
+
+type UnaryMethodDefinitionish = UnaryMethodDefinitionishR;
+
+interface Rpc {
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any>;
+}
+
+export class GrpcWebImpl {
+  private host: string;
+  private options: {
+    transport?: grpc.TransportFactory;
+
+    debug?: boolean;
+    metadata?: grpc.Metadata;
+    upStreamRetryCodes?: number[];
+  };
+
+  constructor(
+    host: string,
+    options: {
+      transport?: grpc.TransportFactory;
+
+      debug?: boolean;
+      metadata?: grpc.Metadata;
+      upStreamRetryCodes?: number[];
+    },
+  ) {
+    this.host = host;
+    this.options = options;
+  }
+
+  unary<T extends UnaryMethodDefinitionish>(
+    methodDesc: T,
+    _request: any,
+    metadata: grpc.Metadata | undefined,
+  ): Promise<any> {
+    const request = { ..._request, ...methodDesc.requestType };
+    const maybeCombinedMetadata = metadata && this.options.metadata
+      ? new BrowserHeaders({ ...this.options?.metadata.headersMap, ...metadata?.headersMap })
+      : metadata || this.options.metadata;
+    return new Promise((resolve, reject) => {
+      grpc.unary(methodDesc, {
+        request,
+        host: this.host,
+        metadata: maybeCombinedMetadata,
+        transport: this.options.transport,
+        debug: this.options.debug,
+        onEnd: function (response) {
+          if (response.status === grpc.Code.OK) {
+            resolve(response.message!.toObject());
+          } else {
+            const err = new GrpcWebError(response.statusMessage, response.status, response.trailers);
+            reject(err);
+          }
+        },
+      });
+    });
+  }
+}
+
+declare var self: any | undefined;
+declare var window: any | undefined;
+declare var global: any | undefined;
+var tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
+
+export class GrpcWebError extends tsProtoGlobalThis.Error {
+  constructor(message: string, public code: grpc.Code, public metadata: grpc.Metadata) {
+    super(message);
+  }
+}
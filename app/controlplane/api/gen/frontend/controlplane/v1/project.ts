@@ -5,7 +5,7 @@ import _m0 from "protobufjs/minimal";
 import { Timestamp } from "../../google/protobuf/timestamp";
 import { Group } from "./group";
 import { OffsetPaginationRequest, OffsetPaginationResponse } from "./pagination";
-import { User } from "./response_messages";
+import { ProjectVersion, User } from "./response_messages";
 import {
   IdentityReference,
   ProjectMemberRole,
@@ -15,6 +15,112 @@ import {
 
 export const protobufPackage = "controlplane.v1";
 
+/** ProjectItem represents a project in the organization */
+export interface ProjectItem {
+  id: string;
+  name: string;
+  createdAt?: Date;
+  updatedAt?: Date;
+}
+
+/** ProjectServiceCreateRequest contains the information needed to create a project */
+export interface ProjectServiceCreateRequest {
+  name: string;
+}
+
+export interface ProjectServiceCreateResponse {
+  result?: ProjectItem;
+}
+
+export interface ProjectServiceListRequest {
+  /** Pagination parameters to limit and offset results */
+  pagination?: OffsetPaginationRequest;
+}
+
+export interface ProjectServiceListResponse {
+  result: ProjectItem[];
+  /** Pagination information for the response */
+  pagination?: OffsetPaginationResponse;
+}
+
+export interface ProjectServiceDescribeRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+}
+
+export interface ProjectServiceDescribeResponse {
+  result?: ProjectItem;
+  /** The version currently marked as the latest one, if any */
+  latestVersion?: ProjectVersion;
+}
+
+export interface ProjectServiceDeleteRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+}
+
+export interface ProjectServiceDeleteResponse {
+}
+
+export interface ProjectServiceListVersionsRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+  /** Pagination parameters to limit and offset results */
+  pagination?: OffsetPaginationRequest;
+}
+
+export interface ProjectServiceListVersionsResponse {
+  result: ProjectVersion[];
+  /** Pagination information for the response */
+  pagination?: OffsetPaginationResponse;
+}
+
+export interface ProjectServiceDescribeVersionRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+  /** The name of the version, i.e v1.2.0 */
+  version: string;
+}
+
+export interface ProjectServiceDescribeVersionResponse {
+  result?: ProjectVersion;
+}
+
+export interface ProjectServiceCreateVersionRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+  /** The name of the version, i.e v1.2.0 */
+  version: string;
+  /** Create the version directly as released instead of as a pre-release */
+  released: boolean;
+}
+
+export interface ProjectServiceCreateVersionResponse {
+  result?: ProjectVersion;
+}
+
+export interface ProjectServicePromoteVersionRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+  /** The name of the version, i.e v1.2.0 */
+  version: string;
+}
+
+export interface ProjectServicePromoteVersionResponse {
+  result?: ProjectVersion;
+}
+
+export interface ProjectServiceUnreleaseVersionRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+  /** The name of the version, i.e v1.2.0 */
+  version: string;
+}
+
+export interface ProjectServiceUnreleaseVersionResponse {
+  result?: ProjectVersion;
+}
+
 /** ProjectServiceListMembersRequest contains the information needed to list members of a project */
 export interface ProjectServiceListMembersRequest {
   /** IdentityReference is used to specify the project by either its ID or name */
@@ -102,34 +208,1392 @@ export interface ProjectServiceUpdateMemberRoleRequest {
 export interface ProjectServiceUpdateMemberRoleResponse {
 }
 
-export interface ProjectServiceListPendingInvitationsRequest {
-  /** IdentityReference is used to specify the project by either its ID or name */
-  projectReference?: IdentityReference;
-  /** Pagination parameters to limit and offset results */
-  pagination?: OffsetPaginationRequest;
-}
+export interface ProjectServiceListPendingInvitationsRequest {
+  /** IdentityReference is used to specify the project by either its ID or name */
+  projectReference?: IdentityReference;
+  /** Pagination parameters to limit and offset results */
+  pagination?: OffsetPaginationRequest;
+}
+
+/** ProjectServiceListPendingInvitationsResponse contains a list of pending invitations for a project */
+export interface ProjectServiceListPendingInvitationsResponse {
+  /** List of pending invitations for the project */
+  invitations: PendingProjectInvitation[];
+  /** Pagination information for the response */
+  pagination?: OffsetPaginationResponse;
+}
+
+/** PendingInvitation represents an invitation to join a project that has not yet been accepted */
+export interface PendingProjectInvitation {
+  /** The email address of the user invited to the project */
+  userEmail: string;
+  /** The user who sent the invitation */
+  invitedBy?:
+    | User
+    | undefined;
+  /** Timestamp when the invitation was created */
+  createdAt?: Date;
+  /** Unique identifier for the invitation */
+  invitationId: string;
+}
+
+function createBaseProjectItem(): ProjectItem {
+  return { id: "", name: "", createdAt: undefined, updatedAt: undefined };
+}
+
+export const ProjectItem = {
+  encode(message: ProjectItem, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.updatedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedAt), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectItem {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectItem();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.updatedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectItem {
+    return {
+      id: isSet(object.id) ? String(object.id) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      updatedAt: isSet(object.updatedAt) ? fromJsonTimestamp(object.updatedAt) : undefined,
+    };
+  },
+
+  toJSON(message: ProjectItem): unknown {
+    const obj: any = {};
+    message.id !== undefined && (obj.id = message.id);
+    message.name !== undefined && (obj.name = message.name);
+    message.createdAt !== undefined && (obj.createdAt = message.createdAt.toISOString());
+    message.updatedAt !== undefined && (obj.updatedAt = message.updatedAt.toISOString());
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectItem>, I>>(base?: I): ProjectItem {
+    return ProjectItem.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectItem>, I>>(object: I): ProjectItem {
+    const message = createBaseProjectItem();
+    message.id = object.id ?? "";
+    message.name = object.name ?? "";
+    message.createdAt = object.createdAt ?? undefined;
+    message.updatedAt = object.updatedAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceCreateRequest(): ProjectServiceCreateRequest {
+  return { name: "" };
+}
+
+export const ProjectServiceCreateRequest = {
+  encode(message: ProjectServiceCreateRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceCreateRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceCreateRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceCreateRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: ProjectServiceCreateRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceCreateRequest>, I>>(base?: I): ProjectServiceCreateRequest {
+    return ProjectServiceCreateRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceCreateRequest>, I>>(object: I): ProjectServiceCreateRequest {
+    const message = createBaseProjectServiceCreateRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseProjectServiceCreateResponse(): ProjectServiceCreateResponse {
+  return { result: undefined };
+}
+
+export const ProjectServiceCreateResponse = {
+  encode(message: ProjectServiceCreateResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      ProjectItem.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceCreateResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceCreateResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = ProjectItem.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceCreateResponse {
+    return { result: isSet(object.result) ? ProjectItem.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: ProjectServiceCreateResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? ProjectItem.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceCreateResponse>, I>>(base?: I): ProjectServiceCreateResponse {
+    return ProjectServiceCreateResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceCreateResponse>, I>>(object: I): ProjectServiceCreateResponse {
+    const message = createBaseProjectServiceCreateResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? ProjectItem.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceListRequest(): ProjectServiceListRequest {
+  return { pagination: undefined };
+}
+
+export const ProjectServiceListRequest = {
+  encode(message: ProjectServiceListRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pagination !== undefined) {
+      OffsetPaginationRequest.encode(message.pagination, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceListRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceListRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationRequest.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceListRequest {
+    return { pagination: isSet(object.pagination) ? OffsetPaginationRequest.fromJSON(object.pagination) : undefined };
+  },
+
+  toJSON(message: ProjectServiceListRequest): unknown {
+    const obj: any = {};
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationRequest.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceListRequest>, I>>(base?: I): ProjectServiceListRequest {
+    return ProjectServiceListRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceListRequest>, I>>(object: I): ProjectServiceListRequest {
+    const message = createBaseProjectServiceListRequest();
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationRequest.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceListResponse(): ProjectServiceListResponse {
+  return { result: [], pagination: undefined };
+}
+
+export const ProjectServiceListResponse = {
+  encode(message: ProjectServiceListResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      ProjectItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceListResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceListResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(ProjectItem.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationResponse.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceListResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => ProjectItem.fromJSON(e)) : [],
+      pagination: isSet(object.pagination) ? OffsetPaginationResponse.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: ProjectServiceListResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? ProjectItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationResponse.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceListResponse>, I>>(base?: I): ProjectServiceListResponse {
+    return ProjectServiceListResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceListResponse>, I>>(object: I): ProjectServiceListResponse {
+    const message = createBaseProjectServiceListResponse();
+    message.result = object.result?.map((e) => ProjectItem.fromPartial(e)) || [];
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationResponse.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceDescribeRequest(): ProjectServiceDescribeRequest {
+  return { projectReference: undefined };
+}
+
+export const ProjectServiceDescribeRequest = {
+  encode(message: ProjectServiceDescribeRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceDescribeRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceDescribeRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceDescribeRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ProjectServiceDescribeRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceDescribeRequest>, I>>(base?: I): ProjectServiceDescribeRequest {
+    return ProjectServiceDescribeRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceDescribeRequest>, I>>(
+    object: I,
+  ): ProjectServiceDescribeRequest {
+    const message = createBaseProjectServiceDescribeRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceDescribeResponse(): ProjectServiceDescribeResponse {
+  return { result: undefined, latestVersion: undefined };
+}
+
+export const ProjectServiceDescribeResponse = {
+  encode(message: ProjectServiceDescribeResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      ProjectItem.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.latestVersion !== undefined) {
+      ProjectVersion.encode(message.latestVersion, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceDescribeResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceDescribeResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = ProjectItem.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.latestVersion = ProjectVersion.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceDescribeResponse {
+    return {
+      result: isSet(object.result) ? ProjectItem.fromJSON(object.result) : undefined,
+      latestVersion: isSet(object.latestVersion) ? ProjectVersion.fromJSON(object.latestVersion) : undefined,
+    };
+  },
+
+  toJSON(message: ProjectServiceDescribeResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? ProjectItem.toJSON(message.result) : undefined);
+    message.latestVersion !== undefined &&
+      (obj.latestVersion = message.latestVersion ? ProjectVersion.toJSON(message.latestVersion) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceDescribeResponse>, I>>(base?: I): ProjectServiceDescribeResponse {
+    return ProjectServiceDescribeResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceDescribeResponse>, I>>(
+    object: I,
+  ): ProjectServiceDescribeResponse {
+    const message = createBaseProjectServiceDescribeResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? ProjectItem.fromPartial(object.result)
+      : undefined;
+    message.latestVersion = (object.latestVersion !== undefined && object.latestVersion !== null)
+      ? ProjectVersion.fromPartial(object.latestVersion)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceDeleteRequest(): ProjectServiceDeleteRequest {
+  return { projectReference: undefined };
+}
+
+export const ProjectServiceDeleteRequest = {
+  encode(message: ProjectServiceDeleteRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceDeleteRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceDeleteRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceDeleteRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ProjectServiceDeleteRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceDeleteRequest>, I>>(base?: I): ProjectServiceDeleteRequest {
+    return ProjectServiceDeleteRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceDeleteRequest>, I>>(object: I): ProjectServiceDeleteRequest {
+    const message = createBaseProjectServiceDeleteRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceDeleteResponse(): ProjectServiceDeleteResponse {
+  return {};
+}
+
+export const ProjectServiceDeleteResponse = {
+  encode(_: ProjectServiceDeleteResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceDeleteResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceDeleteResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ProjectServiceDeleteResponse {
+    return {};
+  },
+
+  toJSON(_: ProjectServiceDeleteResponse): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceDeleteResponse>, I>>(base?: I): ProjectServiceDeleteResponse {
+    return ProjectServiceDeleteResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceDeleteResponse>, I>>(_: I): ProjectServiceDeleteResponse {
+    const message = createBaseProjectServiceDeleteResponse();
+    return message;
+  },
+};
+
+function createBaseProjectServiceListVersionsRequest(): ProjectServiceListVersionsRequest {
+  return { projectReference: undefined, pagination: undefined };
+}
+
+export const ProjectServiceListVersionsRequest = {
+  encode(message: ProjectServiceListVersionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationRequest.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceListVersionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceListVersionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationRequest.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceListVersionsRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+      pagination: isSet(object.pagination) ? OffsetPaginationRequest.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: ProjectServiceListVersionsRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationRequest.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceListVersionsRequest>, I>>(
+    base?: I,
+  ): ProjectServiceListVersionsRequest {
+    return ProjectServiceListVersionsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceListVersionsRequest>, I>>(
+    object: I,
+  ): ProjectServiceListVersionsRequest {
+    const message = createBaseProjectServiceListVersionsRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationRequest.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceListVersionsResponse(): ProjectServiceListVersionsResponse {
+  return { result: [], pagination: undefined };
+}
+
+export const ProjectServiceListVersionsResponse = {
+  encode(message: ProjectServiceListVersionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      ProjectVersion.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      OffsetPaginationResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceListVersionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceListVersionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(ProjectVersion.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = OffsetPaginationResponse.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceListVersionsResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => ProjectVersion.fromJSON(e)) : [],
+      pagination: isSet(object.pagination) ? OffsetPaginationResponse.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: ProjectServiceListVersionsResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? ProjectVersion.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? OffsetPaginationResponse.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceListVersionsResponse>, I>>(
+    base?: I,
+  ): ProjectServiceListVersionsResponse {
+    return ProjectServiceListVersionsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceListVersionsResponse>, I>>(
+    object: I,
+  ): ProjectServiceListVersionsResponse {
+    const message = createBaseProjectServiceListVersionsResponse();
+    message.result = object.result?.map((e) => ProjectVersion.fromPartial(e)) || [];
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? OffsetPaginationResponse.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceDescribeVersionRequest(): ProjectServiceDescribeVersionRequest {
+  return { projectReference: undefined, version: "" };
+}
+
+export const ProjectServiceDescribeVersionRequest = {
+  encode(message: ProjectServiceDescribeVersionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceDescribeVersionRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceDescribeVersionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceDescribeVersionRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+      version: isSet(object.version) ? String(object.version) : "",
+    };
+  },
+
+  toJSON(message: ProjectServiceDescribeVersionRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    message.version !== undefined && (obj.version = message.version);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceDescribeVersionRequest>, I>>(
+    base?: I,
+  ): ProjectServiceDescribeVersionRequest {
+    return ProjectServiceDescribeVersionRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceDescribeVersionRequest>, I>>(
+    object: I,
+  ): ProjectServiceDescribeVersionRequest {
+    const message = createBaseProjectServiceDescribeVersionRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    message.version = object.version ?? "";
+    return message;
+  },
+};
+
+function createBaseProjectServiceDescribeVersionResponse(): ProjectServiceDescribeVersionResponse {
+  return { result: undefined };
+}
+
+export const ProjectServiceDescribeVersionResponse = {
+  encode(message: ProjectServiceDescribeVersionResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      ProjectVersion.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceDescribeVersionResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceDescribeVersionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = ProjectVersion.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceDescribeVersionResponse {
+    return { result: isSet(object.result) ? ProjectVersion.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: ProjectServiceDescribeVersionResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? ProjectVersion.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceDescribeVersionResponse>, I>>(
+    base?: I,
+  ): ProjectServiceDescribeVersionResponse {
+    return ProjectServiceDescribeVersionResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceDescribeVersionResponse>, I>>(
+    object: I,
+  ): ProjectServiceDescribeVersionResponse {
+    const message = createBaseProjectServiceDescribeVersionResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? ProjectVersion.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceCreateVersionRequest(): ProjectServiceCreateVersionRequest {
+  return { projectReference: undefined, version: "", released: false };
+}
+
+export const ProjectServiceCreateVersionRequest = {
+  encode(message: ProjectServiceCreateVersionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    if (message.released === true) {
+      writer.uint32(24).bool(message.released);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceCreateVersionRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceCreateVersionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.released = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceCreateVersionRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+      version: isSet(object.version) ? String(object.version) : "",
+      released: isSet(object.released) ? Boolean(object.released) : false,
+    };
+  },
+
+  toJSON(message: ProjectServiceCreateVersionRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    message.version !== undefined && (obj.version = message.version);
+    message.released !== undefined && (obj.released = message.released);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceCreateVersionRequest>, I>>(
+    base?: I,
+  ): ProjectServiceCreateVersionRequest {
+    return ProjectServiceCreateVersionRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceCreateVersionRequest>, I>>(
+    object: I,
+  ): ProjectServiceCreateVersionRequest {
+    const message = createBaseProjectServiceCreateVersionRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    message.version = object.version ?? "";
+    message.released = object.released ?? false;
+    return message;
+  },
+};
+
+function createBaseProjectServiceCreateVersionResponse(): ProjectServiceCreateVersionResponse {
+  return { result: undefined };
+}
+
+export const ProjectServiceCreateVersionResponse = {
+  encode(message: ProjectServiceCreateVersionResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      ProjectVersion.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceCreateVersionResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceCreateVersionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = ProjectVersion.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceCreateVersionResponse {
+    return { result: isSet(object.result) ? ProjectVersion.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: ProjectServiceCreateVersionResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? ProjectVersion.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceCreateVersionResponse>, I>>(
+    base?: I,
+  ): ProjectServiceCreateVersionResponse {
+    return ProjectServiceCreateVersionResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceCreateVersionResponse>, I>>(
+    object: I,
+  ): ProjectServiceCreateVersionResponse {
+    const message = createBaseProjectServiceCreateVersionResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? ProjectVersion.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServicePromoteVersionRequest(): ProjectServicePromoteVersionRequest {
+  return { projectReference: undefined, version: "" };
+}
+
+export const ProjectServicePromoteVersionRequest = {
+  encode(message: ProjectServicePromoteVersionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServicePromoteVersionRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServicePromoteVersionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServicePromoteVersionRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+      version: isSet(object.version) ? String(object.version) : "",
+    };
+  },
+
+  toJSON(message: ProjectServicePromoteVersionRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    message.version !== undefined && (obj.version = message.version);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServicePromoteVersionRequest>, I>>(
+    base?: I,
+  ): ProjectServicePromoteVersionRequest {
+    return ProjectServicePromoteVersionRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServicePromoteVersionRequest>, I>>(
+    object: I,
+  ): ProjectServicePromoteVersionRequest {
+    const message = createBaseProjectServicePromoteVersionRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    message.version = object.version ?? "";
+    return message;
+  },
+};
+
+function createBaseProjectServicePromoteVersionResponse(): ProjectServicePromoteVersionResponse {
+  return { result: undefined };
+}
+
+export const ProjectServicePromoteVersionResponse = {
+  encode(message: ProjectServicePromoteVersionResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      ProjectVersion.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServicePromoteVersionResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServicePromoteVersionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = ProjectVersion.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServicePromoteVersionResponse {
+    return { result: isSet(object.result) ? ProjectVersion.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: ProjectServicePromoteVersionResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? ProjectVersion.toJSON(message.result) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServicePromoteVersionResponse>, I>>(
+    base?: I,
+  ): ProjectServicePromoteVersionResponse {
+    return ProjectServicePromoteVersionResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServicePromoteVersionResponse>, I>>(
+    object: I,
+  ): ProjectServicePromoteVersionResponse {
+    const message = createBaseProjectServicePromoteVersionResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? ProjectVersion.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseProjectServiceUnreleaseVersionRequest(): ProjectServiceUnreleaseVersionRequest {
+  return { projectReference: undefined, version: "" };
+}
+
+export const ProjectServiceUnreleaseVersionRequest = {
+  encode(message: ProjectServiceUnreleaseVersionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectReference !== undefined) {
+      IdentityReference.encode(message.projectReference, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.version !== "") {
+      writer.uint32(18).string(message.version);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceUnreleaseVersionRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceUnreleaseVersionRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectReference = IdentityReference.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceUnreleaseVersionRequest {
+    return {
+      projectReference: isSet(object.projectReference)
+        ? IdentityReference.fromJSON(object.projectReference)
+        : undefined,
+      version: isSet(object.version) ? String(object.version) : "",
+    };
+  },
+
+  toJSON(message: ProjectServiceUnreleaseVersionRequest): unknown {
+    const obj: any = {};
+    message.projectReference !== undefined &&
+      (obj.projectReference = message.projectReference
+        ? IdentityReference.toJSON(message.projectReference)
+        : undefined);
+    message.version !== undefined && (obj.version = message.version);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ProjectServiceUnreleaseVersionRequest>, I>>(
+    base?: I,
+  ): ProjectServiceUnreleaseVersionRequest {
+    return ProjectServiceUnreleaseVersionRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceUnreleaseVersionRequest>, I>>(
+    object: I,
+  ): ProjectServiceUnreleaseVersionRequest {
+    const message = createBaseProjectServiceUnreleaseVersionRequest();
+    message.projectReference = (object.projectReference !== undefined && object.projectReference !== null)
+      ? IdentityReference.fromPartial(object.projectReference)
+      : undefined;
+    message.version = object.version ?? "";
+    return message;
+  },
+};
+
+function createBaseProjectServiceUnreleaseVersionResponse(): ProjectServiceUnreleaseVersionResponse {
+  return { result: undefined };
+}
+
+export const ProjectServiceUnreleaseVersionResponse = {
+  encode(message: ProjectServiceUnreleaseVersionResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.result !== undefined) {
+      ProjectVersion.encode(message.result, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProjectServiceUnreleaseVersionResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProjectServiceUnreleaseVersionResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result = ProjectVersion.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ProjectServiceUnreleaseVersionResponse {
+    return { result: isSet(object.result) ? ProjectVersion.fromJSON(object.result) : undefined };
+  },
+
+  toJSON(message: ProjectServiceUnreleaseVersionResponse): unknown {
+    const obj: any = {};
+    message.result !== undefined && (obj.result = message.result ? ProjectVersion.toJSON(message.result) : undefined);
+    return obj;
+  },
 
-/** ProjectServiceListPendingInvitationsResponse contains a list of pending invitations for a project */
-export interface ProjectServiceListPendingInvitationsResponse {
-  /** List of pending invitations for the project */
-  invitations: PendingProjectInvitation[];
-  /** Pagination information for the response */
-  pagination?: OffsetPaginationResponse;
-}
+  create<I extends Exact<DeepPartial<ProjectServiceUnreleaseVersionResponse>, I>>(
+    base?: I,
+  ): ProjectServiceUnreleaseVersionResponse {
+    return ProjectServiceUnreleaseVersionResponse.fromPartial(base ?? {});
+  },
 
-/** PendingInvitation represents an invitation to join a project that has not yet been accepted */
-export interface PendingProjectInvitation {
-  /** The email address of the user invited to the project */
-  userEmail: string;
-  /** The user who sent the invitation */
-  invitedBy?:
-    | User
-    | undefined;
-  /** Timestamp when the invitation was created */
-  createdAt?: Date;
-  /** Unique identifier for the invitation */
-  invitationId: string;
-}
+  fromPartial<I extends Exact<DeepPartial<ProjectServiceUnreleaseVersionResponse>, I>>(
+    object: I,
+  ): ProjectServiceUnreleaseVersionResponse {
+    const message = createBaseProjectServiceUnreleaseVersionResponse();
+    message.result = (object.result !== undefined && object.result !== null)
+      ? ProjectVersion.fromPartial(object.result)
+      : undefined;
+    return message;
+  },
+};
 
 function createBaseProjectServiceListMembersRequest(): ProjectServiceListMembersRequest {
   return { projectReference: undefined, pagination: undefined };
@@ -1213,6 +2677,43 @@ export const PendingProjectInvitation = {
 };
 
 export interface ProjectService {
+  /** Project management */
+  Create(
+    request: DeepPartial<ProjectServiceCreateRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceCreateResponse>;
+  List(request: DeepPartial<ProjectServiceListRequest>, metadata?: grpc.Metadata): Promise<ProjectServiceListResponse>;
+  Describe(
+    request: DeepPartial<ProjectServiceDescribeRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceDescribeResponse>;
+  Delete(
+    request: DeepPartial<ProjectServiceDeleteRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceDeleteResponse>;
+  /** Project version management */
+  ListVersions(
+    request: DeepPartial<ProjectServiceListVersionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceListVersionsResponse>;
+  DescribeVersion(
+    request: DeepPartial<ProjectServiceDescribeVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceDescribeVersionResponse>;
+  CreateVersion(
+    request: DeepPartial<ProjectServiceCreateVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceCreateVersionResponse>;
+  /** PromoteVersion marks a pre-release version as released */
+  PromoteVersion(
+    request: DeepPartial<ProjectServicePromoteVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServicePromoteVersionResponse>;
+  /** UnreleaseVersion moves a released version back to pre-release */
+  UnreleaseVersion(
+    request: DeepPartial<ProjectServiceUnreleaseVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceUnreleaseVersionResponse>;
   /** Project membership management */
   ListMembers(
     request: DeepPartial<ProjectServiceListMembersRequest>,
@@ -1241,6 +2742,15 @@ export class ProjectServiceClientImpl implements ProjectService {
 
   constructor(rpc: Rpc) {
     this.rpc = rpc;
+    this.Create = this.Create.bind(this);
+    this.List = this.List.bind(this);
+    this.Describe = this.Describe.bind(this);
+    this.Delete = this.Delete.bind(this);
+    this.ListVersions = this.ListVersions.bind(this);
+    this.DescribeVersion = this.DescribeVersion.bind(this);
+    this.CreateVersion = this.CreateVersion.bind(this);
+    this.PromoteVersion = this.PromoteVersion.bind(this);
+    this.UnreleaseVersion = this.UnreleaseVersion.bind(this);
     this.ListMembers = this.ListMembers.bind(this);
     this.AddMember = this.AddMember.bind(this);
     this.RemoveMember = this.RemoveMember.bind(this);
@@ -1248,6 +2758,86 @@ export class ProjectServiceClientImpl implements ProjectService {
     this.ListPendingInvitations = this.ListPendingInvitations.bind(this);
   }
 
+  Create(
+    request: DeepPartial<ProjectServiceCreateRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceCreateResponse> {
+    return this.rpc.unary(ProjectServiceCreateDesc, ProjectServiceCreateRequest.fromPartial(request), metadata);
+  }
+
+  List(request: DeepPartial<ProjectServiceListRequest>, metadata?: grpc.Metadata): Promise<ProjectServiceListResponse> {
+    return this.rpc.unary(ProjectServiceListDesc, ProjectServiceListRequest.fromPartial(request), metadata);
+  }
+
+  Describe(
+    request: DeepPartial<ProjectServiceDescribeRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceDescribeResponse> {
+    return this.rpc.unary(ProjectServiceDescribeDesc, ProjectServiceDescribeRequest.fromPartial(request), metadata);
+  }
+
+  Delete(
+    request: DeepPartial<ProjectServiceDeleteRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceDeleteResponse> {
+    return this.rpc.unary(ProjectServiceDeleteDesc, ProjectServiceDeleteRequest.fromPartial(request), metadata);
+  }
+
+  ListVersions(
+    request: DeepPartial<ProjectServiceListVersionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceListVersionsResponse> {
+    return this.rpc.unary(
+      ProjectServiceListVersionsDesc,
+      ProjectServiceListVersionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  DescribeVersion(
+    request: DeepPartial<ProjectServiceDescribeVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceDescribeVersionResponse> {
+    return this.rpc.unary(
+      ProjectServiceDescribeVersionDesc,
+      ProjectServiceDescribeVersionRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  CreateVersion(
+    request: DeepPartial<ProjectServiceCreateVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceCreateVersionResponse> {
+    return this.rpc.unary(
+      ProjectServiceCreateVersionDesc,
+      ProjectServiceCreateVersionRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  PromoteVersion(
+    request: DeepPartial<ProjectServicePromoteVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServicePromoteVersionResponse> {
+    return this.rpc.unary(
+      ProjectServicePromoteVersionDesc,
+      ProjectServicePromoteVersionRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
+  UnreleaseVersion(
+    request: DeepPartial<ProjectServiceUnreleaseVersionRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<ProjectServiceUnreleaseVersionResponse> {
+    return this.rpc.unary(
+      ProjectServiceUnreleaseVersionDesc,
+      ProjectServiceUnreleaseVersionRequest.fromPartial(request),
+      metadata,
+    );
+  }
+
   ListMembers(
     request: DeepPartial<ProjectServiceListMembersRequest>,
     metadata?: grpc.Metadata,
@@ -1302,6 +2892,213 @@ export class ProjectServiceClientImpl implements ProjectService {
 
 export const ProjectServiceDesc = { serviceName: "controlplane.v1.ProjectService" };
 
+export const ProjectServiceCreateDesc: UnaryMethodDefinitionish = {
+  methodName: "Create",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceCreateRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceCreateResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceListDesc: UnaryMethodDefinitionish = {
+  methodName: "List",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceListRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceListResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceDescribeDesc: UnaryMethodDefinitionish = {
+  methodName: "Describe",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceDescribeRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceDescribeResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceDeleteDesc: UnaryMethodDefinitionish = {
+  methodName: "Delete",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceDeleteRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceDeleteResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceListVersionsDesc: UnaryMethodDefinitionish = {
+  methodName: "ListVersions",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceListVersionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceListVersionsResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceDescribeVersionDesc: UnaryMethodDefinitionish = {
+  methodName: "DescribeVersion",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceDescribeVersionRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceDescribeVersionResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceCreateVersionDesc: UnaryMethodDefinitionish = {
+  methodName: "CreateVersion",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceCreateVersionRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceCreateVersionResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServicePromoteVersionDesc: UnaryMethodDefinitionish = {
+  methodName: "PromoteVersion",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServicePromoteVersionRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServicePromoteVersionResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
+export const ProjectServiceUnreleaseVersionDesc: UnaryMethodDefinitionish = {
+  methodName: "UnreleaseVersion",
+  service: ProjectServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return ProjectServiceUnreleaseVersionRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = ProjectServiceUnreleaseVersionResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
 export const ProjectServiceListMembersDesc: UnaryMethodDefinitionish = {
   methodName: "ListMembers",
   service: ProjectServiceDesc,
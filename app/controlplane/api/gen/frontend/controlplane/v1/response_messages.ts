@@ -3,7 +3,10 @@ import Long from "long";
 import _m0 from "protobufjs/minimal";
 import {
   PolicyLicenseViolationFinding,
+  PolicyMalwareFinding,
+  PolicyMisconfigurationFinding,
   PolicySASTFinding,
+  PolicySecretFinding,
   PolicyVulnerabilityFinding,
 } from "../../attestation/v1/crafting_state";
 import { Timestamp } from "../../google/protobuf/timestamp";
@@ -734,6 +737,11 @@ export interface PolicyViolation {
   vulnerability?: PolicyVulnerabilityFinding | undefined;
   sast?: PolicySASTFinding | undefined;
   licenseViolation?: PolicyLicenseViolationFinding | undefined;
+  secret?: PolicySecretFinding | undefined;
+  misconfiguration?: PolicyMisconfigurationFinding | undefined;
+  malware?: PolicyMalwareFinding | undefined;
+  /** ID of the policy exception that suppressed the violation, if any */
+  exceptionId: string;
 }
 
 export interface PolicyReference {
@@ -967,6 +975,8 @@ export interface CASBackendItem {
   fallback: boolean;
   /** Whether this backend is provisioned and operated by Chainloop using */
   isManaged: boolean;
+  /** ID of the backend this one is a replica of, if any */
+  primaryId?: string | undefined;
 }
 
 export enum CASBackendItem_ValidationStatus {
@@ -3192,6 +3202,10 @@ function createBasePolicyViolation(): PolicyViolation {
     vulnerability: undefined,
     sast: undefined,
     licenseViolation: undefined,
+    secret: undefined,
+    misconfiguration: undefined,
+    malware: undefined,
+    exceptionId: "",
   };
 }
 
@@ -3215,6 +3229,18 @@ export const PolicyViolation = {
     if (message.licenseViolation !== undefined) {
       PolicyLicenseViolationFinding.encode(message.licenseViolation, writer.uint32(50).fork()).ldelim();
     }
+    if (message.secret !== undefined) {
+      PolicySecretFinding.encode(message.secret, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.misconfiguration !== undefined) {
+      PolicyMisconfigurationFinding.encode(message.misconfiguration, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.malware !== undefined) {
+      PolicyMalwareFinding.encode(message.malware, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.exceptionId !== "") {
+      writer.uint32(58).string(message.exceptionId);
+    }
     return writer;
   },
 
@@ -3267,6 +3293,34 @@ export const PolicyViolation = {
 
           message.licenseViolation = PolicyLicenseViolationFinding.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.secret = PolicySecretFinding.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.misconfiguration = PolicyMisconfigurationFinding.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.malware = PolicyMalwareFinding.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.exceptionId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3288,6 +3342,12 @@ export const PolicyViolation = {
       licenseViolation: isSet(object.licenseViolation)
         ? PolicyLicenseViolationFinding.fromJSON(object.licenseViolation)
         : undefined,
+      secret: isSet(object.secret) ? PolicySecretFinding.fromJSON(object.secret) : undefined,
+      misconfiguration: isSet(object.misconfiguration)
+        ? PolicyMisconfigurationFinding.fromJSON(object.misconfiguration)
+        : undefined,
+      malware: isSet(object.malware) ? PolicyMalwareFinding.fromJSON(object.malware) : undefined,
+      exceptionId: isSet(object.exceptionId) ? String(object.exceptionId) : "",
     };
   },
 
@@ -3304,6 +3364,14 @@ export const PolicyViolation = {
     message.licenseViolation !== undefined && (obj.licenseViolation = message.licenseViolation
       ? PolicyLicenseViolationFinding.toJSON(message.licenseViolation)
       : undefined);
+    message.secret !== undefined &&
+      (obj.secret = message.secret ? PolicySecretFinding.toJSON(message.secret) : undefined);
+    message.misconfiguration !== undefined && (obj.misconfiguration = message.misconfiguration
+      ? PolicyMisconfigurationFinding.toJSON(message.misconfiguration)
+      : undefined);
+    message.malware !== undefined &&
+      (obj.malware = message.malware ? PolicyMalwareFinding.toJSON(message.malware) : undefined);
+    message.exceptionId !== undefined && (obj.exceptionId = message.exceptionId);
     return obj;
   },
 
@@ -3325,6 +3393,16 @@ export const PolicyViolation = {
     message.licenseViolation = (object.licenseViolation !== undefined && object.licenseViolation !== null)
       ? PolicyLicenseViolationFinding.fromPartial(object.licenseViolation)
       : undefined;
+    message.secret = (object.secret !== undefined && object.secret !== null)
+      ? PolicySecretFinding.fromPartial(object.secret)
+      : undefined;
+    message.misconfiguration = (object.misconfiguration !== undefined && object.misconfiguration !== null)
+      ? PolicyMisconfigurationFinding.fromPartial(object.misconfiguration)
+      : undefined;
+    message.malware = (object.malware !== undefined && object.malware !== null)
+      ? PolicyMalwareFinding.fromPartial(object.malware)
+      : undefined;
+    message.exceptionId = object.exceptionId ?? "";
     return message;
   },
 };
@@ -4650,6 +4728,7 @@ function createBaseCASBackendItem(): CASBackendItem {
     updatedAt: undefined,
     fallback: false,
     isManaged: false,
+    primaryId: undefined,
   };
 }
 
@@ -4700,6 +4779,9 @@ export const CASBackendItem = {
     if (message.isManaged === true) {
       writer.uint32(120).bool(message.isManaged);
     }
+    if (message.primaryId !== undefined) {
+      writer.uint32(130).string(message.primaryId);
+    }
     return writer;
   },
 
@@ -4815,6 +4897,13 @@ export const CASBackendItem = {
 
           message.isManaged = reader.bool();
           continue;
+        case 16:
+          if (tag !== 130) {
+            break;
+          }
+
+          message.primaryId = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4843,6 +4932,7 @@ export const CASBackendItem = {
       updatedAt: isSet(object.updatedAt) ? fromJsonTimestamp(object.updatedAt) : undefined,
       fallback: isSet(object.fallback) ? Boolean(object.fallback) : false,
       isManaged: isSet(object.isManaged) ? Boolean(object.isManaged) : false,
+      primaryId: isSet(object.primaryId) ? String(object.primaryId) : undefined,
     };
   },
 
@@ -4865,6 +4955,7 @@ export const CASBackendItem = {
     message.updatedAt !== undefined && (obj.updatedAt = message.updatedAt.toISOString());
     message.fallback !== undefined && (obj.fallback = message.fallback);
     message.isManaged !== undefined && (obj.isManaged = message.isManaged);
+    message.primaryId !== undefined && (obj.primaryId = message.primaryId);
     return obj;
   },
 
@@ -4891,6 +4982,7 @@ export const CASBackendItem = {
     message.updatedAt = object.updatedAt ?? undefined;
     message.fallback = object.fallback ?? false;
     message.isManaged = object.isManaged ?? false;
+    message.primaryId = object.primaryId ?? undefined;
     return message;
   },
 };
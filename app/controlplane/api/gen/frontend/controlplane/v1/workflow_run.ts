@@ -2,7 +2,11 @@
 import { grpc } from "@improbable-eng/grpc-web";
 import { BrowserHeaders } from "browser-headers";
 import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../../google/protobuf/timestamp";
 import {
+  CraftingSchema_Material_MaterialType,
+  craftingSchema_Material_MaterialTypeFromJSON,
+  craftingSchema_Material_MaterialTypeToJSON,
   CraftingSchema_Runner_RunnerType,
   craftingSchema_Runner_RunnerTypeFromJSON,
   craftingSchema_Runner_RunnerTypeToJSON,
@@ -10,6 +14,7 @@ import {
   PolicyGroup,
 } from "../../workflowcontract/v1/crafting_schema";
 import { CursorPaginationRequest, CursorPaginationResponse } from "./pagination";
+import { PolicyExceptionItem } from "./policy_exception";
 import {
   AttestationItem,
   CASBackendItem,
@@ -80,6 +85,14 @@ export interface AttestationServiceGetPolicyGroupResponse {
   reference?: RemotePolicyReference;
 }
 
+export interface AttestationServiceListPolicyExceptionsRequest {
+  workflowRunId: string;
+}
+
+export interface AttestationServiceListPolicyExceptionsResponse {
+  result: PolicyExceptionItem[];
+}
+
 export interface AttestationServiceGetContractRequest {
   contractRevision: number;
   workflowName: string;
@@ -294,6 +307,39 @@ export interface WorkflowRunServiceViewResponse_VerificationResult {
   failureReason: string;
 }
 
+export interface WorkflowRunServiceSearchRequest {
+  /** Scope the search to a project and optionally to one of its workflows */
+  projectName: string;
+  workflowName: string;
+  /** by digest of any of the materials, i.e sha256:deadbeef. sha256 is assumed if no algorithm is provided */
+  materialDigest: string;
+  /** by type of any of the materials */
+  materialType: CraftingSchema_Material_MaterialType;
+  /** by name of any of the subjects of the attestation */
+  subjectName: string;
+  /** by attestation annotation, the value is optional */
+  annotationName: string;
+  annotationValue: string;
+  /** by the runner that executed the workflow */
+  runnerType: CraftingSchema_Runner_RunnerType;
+  /** by canonical policy status */
+  policyStatus: PolicyStatusFilter;
+  /** by whether policy violations were found */
+  hasPolicyViolations?:
+    | boolean
+    | undefined;
+  /** by creation date, both ends of the range are optional */
+  createdAfter?: Date;
+  createdBefore?: Date;
+  /** pagination options */
+  pagination?: CursorPaginationRequest;
+}
+
+export interface WorkflowRunServiceSearchResponse {
+  result: WorkflowRunItem[];
+  pagination?: CursorPaginationResponse;
+}
+
 export interface AttestationServiceGetUploadCredsRequest {
   workflowRunId: string;
 }
@@ -872,6 +918,135 @@ export const AttestationServiceGetPolicyGroupResponse = {
   },
 };
 
+function createBaseAttestationServiceListPolicyExceptionsRequest(): AttestationServiceListPolicyExceptionsRequest {
+  return { workflowRunId: "" };
+}
+
+export const AttestationServiceListPolicyExceptionsRequest = {
+  encode(message: AttestationServiceListPolicyExceptionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.workflowRunId !== "") {
+      writer.uint32(10).string(message.workflowRunId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AttestationServiceListPolicyExceptionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAttestationServiceListPolicyExceptionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.workflowRunId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AttestationServiceListPolicyExceptionsRequest {
+    return { workflowRunId: isSet(object.workflowRunId) ? String(object.workflowRunId) : "" };
+  },
+
+  toJSON(message: AttestationServiceListPolicyExceptionsRequest): unknown {
+    const obj: any = {};
+    message.workflowRunId !== undefined && (obj.workflowRunId = message.workflowRunId);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AttestationServiceListPolicyExceptionsRequest>, I>>(
+    base?: I,
+  ): AttestationServiceListPolicyExceptionsRequest {
+    return AttestationServiceListPolicyExceptionsRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AttestationServiceListPolicyExceptionsRequest>, I>>(
+    object: I,
+  ): AttestationServiceListPolicyExceptionsRequest {
+    const message = createBaseAttestationServiceListPolicyExceptionsRequest();
+    message.workflowRunId = object.workflowRunId ?? "";
+    return message;
+  },
+};
+
+function createBaseAttestationServiceListPolicyExceptionsResponse(): AttestationServiceListPolicyExceptionsResponse {
+  return { result: [] };
+}
+
+export const AttestationServiceListPolicyExceptionsResponse = {
+  encode(
+    message: AttestationServiceListPolicyExceptionsResponse,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    for (const v of message.result) {
+      PolicyExceptionItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AttestationServiceListPolicyExceptionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAttestationServiceListPolicyExceptionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(PolicyExceptionItem.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AttestationServiceListPolicyExceptionsResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => PolicyExceptionItem.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: AttestationServiceListPolicyExceptionsResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? PolicyExceptionItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AttestationServiceListPolicyExceptionsResponse>, I>>(
+    base?: I,
+  ): AttestationServiceListPolicyExceptionsResponse {
+    return AttestationServiceListPolicyExceptionsResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AttestationServiceListPolicyExceptionsResponse>, I>>(
+    object: I,
+  ): AttestationServiceListPolicyExceptionsResponse {
+    const message = createBaseAttestationServiceListPolicyExceptionsResponse();
+    message.result = object.result?.map((e) => PolicyExceptionItem.fromPartial(e)) || [];
+    return message;
+  },
+};
+
 function createBaseAttestationServiceGetContractRequest(): AttestationServiceGetContractRequest {
   return { contractRevision: 0, workflowName: "", projectName: "" };
 }
@@ -2537,6 +2712,322 @@ export const WorkflowRunServiceViewResponse_VerificationResult = {
   },
 };
 
+function createBaseWorkflowRunServiceSearchRequest(): WorkflowRunServiceSearchRequest {
+  return {
+    projectName: "",
+    workflowName: "",
+    materialDigest: "",
+    materialType: 0,
+    subjectName: "",
+    annotationName: "",
+    annotationValue: "",
+    runnerType: 0,
+    policyStatus: 0,
+    hasPolicyViolations: undefined,
+    createdAfter: undefined,
+    createdBefore: undefined,
+    pagination: undefined,
+  };
+}
+
+export const WorkflowRunServiceSearchRequest = {
+  encode(message: WorkflowRunServiceSearchRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.projectName !== "") {
+      writer.uint32(10).string(message.projectName);
+    }
+    if (message.workflowName !== "") {
+      writer.uint32(18).string(message.workflowName);
+    }
+    if (message.materialDigest !== "") {
+      writer.uint32(26).string(message.materialDigest);
+    }
+    if (message.materialType !== 0) {
+      writer.uint32(32).int32(message.materialType);
+    }
+    if (message.subjectName !== "") {
+      writer.uint32(42).string(message.subjectName);
+    }
+    if (message.annotationName !== "") {
+      writer.uint32(50).string(message.annotationName);
+    }
+    if (message.annotationValue !== "") {
+      writer.uint32(58).string(message.annotationValue);
+    }
+    if (message.runnerType !== 0) {
+      writer.uint32(64).int32(message.runnerType);
+    }
+    if (message.policyStatus !== 0) {
+      writer.uint32(72).int32(message.policyStatus);
+    }
+    if (message.hasPolicyViolations !== undefined) {
+      writer.uint32(80).bool(message.hasPolicyViolations);
+    }
+    if (message.createdAfter !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAfter), writer.uint32(90).fork()).ldelim();
+    }
+    if (message.createdBefore !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdBefore), writer.uint32(98).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      CursorPaginationRequest.encode(message.pagination, writer.uint32(106).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorkflowRunServiceSearchRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkflowRunServiceSearchRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projectName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.workflowName = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.materialDigest = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.materialType = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.subjectName = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.annotationName = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.annotationValue = reader.string();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.runnerType = reader.int32() as any;
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.policyStatus = reader.int32() as any;
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.hasPolicyViolations = reader.bool();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.createdAfter = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.createdBefore = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.pagination = CursorPaginationRequest.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WorkflowRunServiceSearchRequest {
+    return {
+      projectName: isSet(object.projectName) ? String(object.projectName) : "",
+      workflowName: isSet(object.workflowName) ? String(object.workflowName) : "",
+      materialDigest: isSet(object.materialDigest) ? String(object.materialDigest) : "",
+      materialType: isSet(object.materialType) ? craftingSchema_Material_MaterialTypeFromJSON(object.materialType) : 0,
+      subjectName: isSet(object.subjectName) ? String(object.subjectName) : "",
+      annotationName: isSet(object.annotationName) ? String(object.annotationName) : "",
+      annotationValue: isSet(object.annotationValue) ? String(object.annotationValue) : "",
+      runnerType: isSet(object.runnerType) ? craftingSchema_Runner_RunnerTypeFromJSON(object.runnerType) : 0,
+      policyStatus: isSet(object.policyStatus) ? policyStatusFilterFromJSON(object.policyStatus) : 0,
+      hasPolicyViolations: isSet(object.hasPolicyViolations) ? Boolean(object.hasPolicyViolations) : undefined,
+      createdAfter: isSet(object.createdAfter) ? fromJsonTimestamp(object.createdAfter) : undefined,
+      createdBefore: isSet(object.createdBefore) ? fromJsonTimestamp(object.createdBefore) : undefined,
+      pagination: isSet(object.pagination) ? CursorPaginationRequest.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: WorkflowRunServiceSearchRequest): unknown {
+    const obj: any = {};
+    message.projectName !== undefined && (obj.projectName = message.projectName);
+    message.workflowName !== undefined && (obj.workflowName = message.workflowName);
+    message.materialDigest !== undefined && (obj.materialDigest = message.materialDigest);
+    message.materialType !== undefined &&
+      (obj.materialType = craftingSchema_Material_MaterialTypeToJSON(message.materialType));
+    message.subjectName !== undefined && (obj.subjectName = message.subjectName);
+    message.annotationName !== undefined && (obj.annotationName = message.annotationName);
+    message.annotationValue !== undefined && (obj.annotationValue = message.annotationValue);
+    message.runnerType !== undefined && (obj.runnerType = craftingSchema_Runner_RunnerTypeToJSON(message.runnerType));
+    message.policyStatus !== undefined && (obj.policyStatus = policyStatusFilterToJSON(message.policyStatus));
+    message.hasPolicyViolations !== undefined && (obj.hasPolicyViolations = message.hasPolicyViolations);
+    message.createdAfter !== undefined && (obj.createdAfter = message.createdAfter.toISOString());
+    message.createdBefore !== undefined && (obj.createdBefore = message.createdBefore.toISOString());
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? CursorPaginationRequest.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WorkflowRunServiceSearchRequest>, I>>(base?: I): WorkflowRunServiceSearchRequest {
+    return WorkflowRunServiceSearchRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WorkflowRunServiceSearchRequest>, I>>(
+    object: I,
+  ): WorkflowRunServiceSearchRequest {
+    const message = createBaseWorkflowRunServiceSearchRequest();
+    message.projectName = object.projectName ?? "";
+    message.workflowName = object.workflowName ?? "";
+    message.materialDigest = object.materialDigest ?? "";
+    message.materialType = object.materialType ?? 0;
+    message.subjectName = object.subjectName ?? "";
+    message.annotationName = object.annotationName ?? "";
+    message.annotationValue = object.annotationValue ?? "";
+    message.runnerType = object.runnerType ?? 0;
+    message.policyStatus = object.policyStatus ?? 0;
+    message.hasPolicyViolations = object.hasPolicyViolations ?? undefined;
+    message.createdAfter = object.createdAfter ?? undefined;
+    message.createdBefore = object.createdBefore ?? undefined;
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? CursorPaginationRequest.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseWorkflowRunServiceSearchResponse(): WorkflowRunServiceSearchResponse {
+  return { result: [], pagination: undefined };
+}
+
+export const WorkflowRunServiceSearchResponse = {
+  encode(message: WorkflowRunServiceSearchResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.result) {
+      WorkflowRunItem.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.pagination !== undefined) {
+      CursorPaginationResponse.encode(message.pagination, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorkflowRunServiceSearchResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkflowRunServiceSearchResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.result.push(WorkflowRunItem.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pagination = CursorPaginationResponse.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WorkflowRunServiceSearchResponse {
+    return {
+      result: Array.isArray(object?.result) ? object.result.map((e: any) => WorkflowRunItem.fromJSON(e)) : [],
+      pagination: isSet(object.pagination) ? CursorPaginationResponse.fromJSON(object.pagination) : undefined,
+    };
+  },
+
+  toJSON(message: WorkflowRunServiceSearchResponse): unknown {
+    const obj: any = {};
+    if (message.result) {
+      obj.result = message.result.map((e) => e ? WorkflowRunItem.toJSON(e) : undefined);
+    } else {
+      obj.result = [];
+    }
+    message.pagination !== undefined &&
+      (obj.pagination = message.pagination ? CursorPaginationResponse.toJSON(message.pagination) : undefined);
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WorkflowRunServiceSearchResponse>, I>>(
+    base?: I,
+  ): WorkflowRunServiceSearchResponse {
+    return WorkflowRunServiceSearchResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WorkflowRunServiceSearchResponse>, I>>(
+    object: I,
+  ): WorkflowRunServiceSearchResponse {
+    const message = createBaseWorkflowRunServiceSearchResponse();
+    message.result = object.result?.map((e) => WorkflowRunItem.fromPartial(e)) || [];
+    message.pagination = (object.pagination !== undefined && object.pagination !== null)
+      ? CursorPaginationResponse.fromPartial(object.pagination)
+      : undefined;
+    return message;
+  },
+};
+
 function createBaseAttestationServiceGetUploadCredsRequest(): AttestationServiceGetUploadCredsRequest {
   return { workflowRunId: "" };
 }
@@ -2786,6 +3277,11 @@ export interface AttestationService {
     request: DeepPartial<AttestationServiceGetPolicyGroupRequest>,
     metadata?: grpc.Metadata,
   ): Promise<AttestationServiceGetPolicyGroupResponse>;
+  /** Get the active policy exceptions that apply to the workflow run */
+  ListPolicyExceptions(
+    request: DeepPartial<AttestationServiceListPolicyExceptionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<AttestationServiceListPolicyExceptionsResponse>;
 }
 
 export class AttestationServiceClientImpl implements AttestationService {
@@ -2801,6 +3297,7 @@ export class AttestationServiceClientImpl implements AttestationService {
     this.Cancel = this.Cancel.bind(this);
     this.GetPolicy = this.GetPolicy.bind(this);
     this.GetPolicyGroup = this.GetPolicyGroup.bind(this);
+    this.ListPolicyExceptions = this.ListPolicyExceptions.bind(this);
   }
 
   FindOrCreateWorkflow(
@@ -2878,6 +3375,17 @@ export class AttestationServiceClientImpl implements AttestationService {
       metadata,
     );
   }
+
+  ListPolicyExceptions(
+    request: DeepPartial<AttestationServiceListPolicyExceptionsRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<AttestationServiceListPolicyExceptionsResponse> {
+    return this.rpc.unary(
+      AttestationServiceListPolicyExceptionsDesc,
+      AttestationServiceListPolicyExceptionsRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const AttestationServiceDesc = { serviceName: "controlplane.v1.AttestationService" };
@@ -3066,6 +3574,29 @@ export const AttestationServiceGetPolicyGroupDesc: UnaryMethodDefinitionish = {
   } as any,
 };
 
+export const AttestationServiceListPolicyExceptionsDesc: UnaryMethodDefinitionish = {
+  methodName: "ListPolicyExceptions",
+  service: AttestationServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return AttestationServiceListPolicyExceptionsRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = AttestationServiceListPolicyExceptionsResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
 /** Administrative service for the operator */
 export interface WorkflowRunService {
   List(
@@ -3076,6 +3607,11 @@ export interface WorkflowRunService {
     request: DeepPartial<WorkflowRunServiceViewRequest>,
     metadata?: grpc.Metadata,
   ): Promise<WorkflowRunServiceViewResponse>;
+  /** Search runs by the content of their attestations, i.e the runs that included a given SBOM */
+  Search(
+    request: DeepPartial<WorkflowRunServiceSearchRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<WorkflowRunServiceSearchResponse>;
 }
 
 export class WorkflowRunServiceClientImpl implements WorkflowRunService {
@@ -3085,6 +3621,7 @@ export class WorkflowRunServiceClientImpl implements WorkflowRunService {
     this.rpc = rpc;
     this.List = this.List.bind(this);
     this.View = this.View.bind(this);
+    this.Search = this.Search.bind(this);
   }
 
   List(
@@ -3100,6 +3637,17 @@ export class WorkflowRunServiceClientImpl implements WorkflowRunService {
   ): Promise<WorkflowRunServiceViewResponse> {
     return this.rpc.unary(WorkflowRunServiceViewDesc, WorkflowRunServiceViewRequest.fromPartial(request), metadata);
   }
+
+  Search(
+    request: DeepPartial<WorkflowRunServiceSearchRequest>,
+    metadata?: grpc.Metadata,
+  ): Promise<WorkflowRunServiceSearchResponse> {
+    return this.rpc.unary(
+      WorkflowRunServiceSearchDesc,
+      WorkflowRunServiceSearchRequest.fromPartial(request),
+      metadata,
+    );
+  }
 }
 
 export const WorkflowRunServiceDesc = { serviceName: "controlplane.v1.WorkflowRunService" };
@@ -3150,6 +3698,29 @@ export const WorkflowRunServiceViewDesc: UnaryMethodDefinitionish = {
   } as any,
 };
 
+export const WorkflowRunServiceSearchDesc: UnaryMethodDefinitionish = {
+  methodName: "Search",
+  service: WorkflowRunServiceDesc,
+  requestStream: false,
+  responseStream: false,
+  requestType: {
+    serializeBinary() {
+      return WorkflowRunServiceSearchRequest.encode(this).finish();
+    },
+  } as any,
+  responseType: {
+    deserializeBinary(data: Uint8Array) {
+      const value = WorkflowRunServiceSearchResponse.decode(data);
+      return {
+        ...value,
+        toObject() {
+          return value;
+        },
+      };
+    },
+  } as any,
+};
+
 interface UnaryMethodDefinitionishR extends grpc.UnaryMethodDefinition<any, any> {
   requestStream: any;
   responseStream: any;
@@ -3273,6 +3844,28 @@ type KeysOfUnion<T> = T extends T ? keyof T : never;
 export type Exact<P, I extends P> = P extends Builtin ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
@@ -104,6 +104,8 @@ export enum CraftingSchema_Runner_RunnerType {
   TEAMCITY_PIPELINE = 7,
   TEKTON_PIPELINE = 8,
   CHAINLOOP_SANDBOX = 9,
+  BITBUCKET_PIPELINE = 10,
+  BUILDKITE_PIPELINE = 11,
   UNRECOGNIZED = -1,
 }
 
@@ -139,6 +141,12 @@ export function craftingSchema_Runner_RunnerTypeFromJSON(object: any): CraftingS
     case 9:
     case "CHAINLOOP_SANDBOX":
       return CraftingSchema_Runner_RunnerType.CHAINLOOP_SANDBOX;
+    case 10:
+    case "BITBUCKET_PIPELINE":
+      return CraftingSchema_Runner_RunnerType.BITBUCKET_PIPELINE;
+    case 11:
+    case "BUILDKITE_PIPELINE":
+      return CraftingSchema_Runner_RunnerType.BUILDKITE_PIPELINE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -168,6 +176,10 @@ export function craftingSchema_Runner_RunnerTypeToJSON(object: CraftingSchema_Ru
       return "TEKTON_PIPELINE";
     case CraftingSchema_Runner_RunnerType.CHAINLOOP_SANDBOX:
       return "CHAINLOOP_SANDBOX";
+    case CraftingSchema_Runner_RunnerType.BITBUCKET_PIPELINE:
+      return "BITBUCKET_PIPELINE";
+    case CraftingSchema_Runner_RunnerType.BUILDKITE_PIPELINE:
+      return "BUILDKITE_PIPELINE";
     case CraftingSchema_Runner_RunnerType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -326,6 +338,46 @@ export enum CraftingSchema_Material_MaterialType {
    * https://github.com/Checkmarx/ast-cli/blob/main/internal/wrappers/results-json.go
    */
   CHECKMARX_JSON = 42,
+  /**
+   * TRIVY_JSON - Trivy vulnerability scan report in JSON format (trivy --format json)
+   * https://trivy.dev/latest/docs/configuration/reporting/#json
+   */
+  TRIVY_JSON = 43,
+  /**
+   * GRYPE_JSON - Grype vulnerability scan report in JSON format (grype -o json)
+   * https://github.com/anchore/grype#output-formats
+   */
+  GRYPE_JSON = 44,
+  /**
+   * SBOM_CYCLONEDX_XML - CycloneDX SBOM in XML format
+   * https://cyclonedx.org/docs/latest/xml/
+   */
+  SBOM_CYCLONEDX_XML = 45,
+  /**
+   * SBOM_SPDX_TAG_VALUE - SPDX SBOM in tag-value format
+   * https://spdx.github.io/spdx-spec/v2.3/conformance/
+   */
+  SBOM_SPDX_TAG_VALUE = 46,
+  /**
+   * SEMGREP_JSON - Semgrep report in JSON format (semgrep scan --json)
+   * https://semgrep.dev/docs/cli-reference
+   */
+  SEMGREP_JSON = 47,
+  /**
+   * SNYK_JSON - Snyk report in JSON format, either open source (snyk test --json) or Snyk Code (snyk code test --json) results
+   * https://docs.snyk.io/snyk-cli/commands/test
+   */
+  SNYK_JSON = 48,
+  /**
+   * TERRAFORM_PLAN_JSON - Terraform plan in JSON format (terraform show -json <planfile>)
+   * https://developer.hashicorp.com/terraform/internals/json-format
+   */
+  TERRAFORM_PLAN_JSON = 49,
+  /**
+   * KUBERNETES_MANIFESTS - Bundle of Kubernetes manifests in YAML format, i.e multi-document YAML files or the output of helm template
+   * https://kubernetes.io/docs/concepts/overview/working-with-objects/
+   */
+  KUBERNETES_MANIFESTS = 50,
   UNRECOGNIZED = -1,
 }
 
@@ -460,6 +512,30 @@ export function craftingSchema_Material_MaterialTypeFromJSON(object: any): Craft
     case 42:
     case "CHECKMARX_JSON":
       return CraftingSchema_Material_MaterialType.CHECKMARX_JSON;
+    case 43:
+    case "TRIVY_JSON":
+      return CraftingSchema_Material_MaterialType.TRIVY_JSON;
+    case 44:
+    case "GRYPE_JSON":
+      return CraftingSchema_Material_MaterialType.GRYPE_JSON;
+    case 45:
+    case "SBOM_CYCLONEDX_XML":
+      return CraftingSchema_Material_MaterialType.SBOM_CYCLONEDX_XML;
+    case 46:
+    case "SBOM_SPDX_TAG_VALUE":
+      return CraftingSchema_Material_MaterialType.SBOM_SPDX_TAG_VALUE;
+    case 47:
+    case "SEMGREP_JSON":
+      return CraftingSchema_Material_MaterialType.SEMGREP_JSON;
+    case 48:
+    case "SNYK_JSON":
+      return CraftingSchema_Material_MaterialType.SNYK_JSON;
+    case 49:
+    case "TERRAFORM_PLAN_JSON":
+      return CraftingSchema_Material_MaterialType.TERRAFORM_PLAN_JSON;
+    case 50:
+    case "KUBERNETES_MANIFESTS":
+      return CraftingSchema_Material_MaterialType.KUBERNETES_MANIFESTS;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -555,6 +631,22 @@ export function craftingSchema_Material_MaterialTypeToJSON(object: CraftingSchem
       return "COBERTURA_XML";
     case CraftingSchema_Material_MaterialType.CHECKMARX_JSON:
       return "CHECKMARX_JSON";
+    case CraftingSchema_Material_MaterialType.TRIVY_JSON:
+      return "TRIVY_JSON";
+    case CraftingSchema_Material_MaterialType.GRYPE_JSON:
+      return "GRYPE_JSON";
+    case CraftingSchema_Material_MaterialType.SBOM_CYCLONEDX_XML:
+      return "SBOM_CYCLONEDX_XML";
+    case CraftingSchema_Material_MaterialType.SBOM_SPDX_TAG_VALUE:
+      return "SBOM_SPDX_TAG_VALUE";
+    case CraftingSchema_Material_MaterialType.SEMGREP_JSON:
+      return "SEMGREP_JSON";
+    case CraftingSchema_Material_MaterialType.SNYK_JSON:
+      return "SNYK_JSON";
+    case CraftingSchema_Material_MaterialType.TERRAFORM_PLAN_JSON:
+      return "TERRAFORM_PLAN_JSON";
+    case CraftingSchema_Material_MaterialType.KUBERNETES_MANIFESTS:
+      return "KUBERNETES_MANIFESTS";
     case CraftingSchema_Material_MaterialType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -733,6 +825,9 @@ export interface Metadata {
    *   VULNERABILITY    -> attestation.v1.PolicyVulnerabilityFinding
    *   SAST             -> attestation.v1.PolicySASTFinding
    *   LICENSE_VIOLATION -> attestation.v1.PolicyLicenseViolationFinding
+   *   SECRET           -> attestation.v1.PolicySecretFinding
+   *   MISCONFIGURATION -> attestation.v1.PolicyMisconfigurationFinding
+   *   MALWARE          -> attestation.v1.PolicyMalwareFinding
    */
   findingType?: string | undefined;
 }
@@ -101,21 +101,25 @@ const (
 	CraftingSchema_Runner_TEAMCITY_PIPELINE       CraftingSchema_Runner_RunnerType = 7
 	CraftingSchema_Runner_TEKTON_PIPELINE         CraftingSchema_Runner_RunnerType = 8
 	CraftingSchema_Runner_CHAINLOOP_SANDBOX       CraftingSchema_Runner_RunnerType = 9
+	CraftingSchema_Runner_BITBUCKET_PIPELINE      CraftingSchema_Runner_RunnerType = 10
+	CraftingSchema_Runner_BUILDKITE_PIPELINE      CraftingSchema_Runner_RunnerType = 11
 )
 
 // Enum value maps for CraftingSchema_Runner_RunnerType.
 var (
 	CraftingSchema_Runner_RunnerType_name = map[int32]string{
-		0: "RUNNER_TYPE_UNSPECIFIED",
-		1: "GITHUB_ACTION",
-		2: "GITLAB_PIPELINE",
-		3: "AZURE_PIPELINE",
-		4: "JENKINS_JOB",
-		5: "CIRCLECI_BUILD",
-		6: "DAGGER_PIPELINE",
-		7: "TEAMCITY_PIPELINE",
-		8: "TEKTON_PIPELINE",
-		9: "CHAINLOOP_SANDBOX",
+		0:  "RUNNER_TYPE_UNSPECIFIED",
+		1:  "GITHUB_ACTION",
+		2:  "GITLAB_PIPELINE",
+		3:  "AZURE_PIPELINE",
+		4:  "JENKINS_JOB",
+		5:  "CIRCLECI_BUILD",
+		6:  "DAGGER_PIPELINE",
+		7:  "TEAMCITY_PIPELINE",
+		8:  "TEKTON_PIPELINE",
+		9:  "CHAINLOOP_SANDBOX",
+		10: "BITBUCKET_PIPELINE",
+		11: "BUILDKITE_PIPELINE",
 	}
 	CraftingSchema_Runner_RunnerType_value = map[string]int32{
 		"RUNNER_TYPE_UNSPECIFIED": 0,
@@ -128,6 +132,8 @@ var (
 		"TEAMCITY_PIPELINE":       7,
 		"TEKTON_PIPELINE":         8,
 		"CHAINLOOP_SANDBOX":       9,
+		"BITBUCKET_PIPELINE":      10,
+		"BUILDKITE_PIPELINE":      11,
 	}
 )
 
@@ -250,6 +256,30 @@ const (
 	// Checkmarx One native JSON report (ScanResultsCollection)
 	// https://github.com/Checkmarx/ast-cli/blob/main/internal/wrappers/results-json.go
 	CraftingSchema_Material_CHECKMARX_JSON CraftingSchema_Material_MaterialType = 42
+	// Trivy vulnerability scan report in JSON format (trivy --format json)
+	// https://trivy.dev/latest/docs/configuration/reporting/#json
+	CraftingSchema_Material_TRIVY_JSON CraftingSchema_Material_MaterialType = 43
+	// Grype vulnerability scan report in JSON format (grype -o json)
+	// https://github.com/anchore/grype#output-formats
+	CraftingSchema_Material_GRYPE_JSON CraftingSchema_Material_MaterialType = 44
+	// CycloneDX SBOM in XML format
+	// https://cyclonedx.org/docs/latest/xml/
+	CraftingSchema_Material_SBOM_CYCLONEDX_XML CraftingSchema_Material_MaterialType = 45
+	// SPDX SBOM in tag-value format
+	// https://spdx.github.io/spdx-spec/v2.3/conformance/
+	CraftingSchema_Material_SBOM_SPDX_TAG_VALUE CraftingSchema_Material_MaterialType = 46
+	// Semgrep report in JSON format (semgrep scan --json)
+	// https://semgrep.dev/docs/cli-reference
+	CraftingSchema_Material_SEMGREP_JSON CraftingSchema_Material_MaterialType = 47
+	// Snyk report in JSON format, either open source (snyk test --json) or Snyk Code (snyk code test --json) results
+	// https://docs.snyk.io/snyk-cli/commands/test
+	CraftingSchema_Material_SNYK_JSON CraftingSchema_Material_MaterialType = 48
+	// Terraform plan in JSON format (terraform show -json <planfile>)
+	// https://developer.hashicorp.com/terraform/internals/json-format
+	CraftingSchema_Material_TERRAFORM_PLAN_JSON CraftingSchema_Material_MaterialType = 49
+	// Bundle of Kubernetes manifests in YAML format, i.e multi-document YAML files or the output of helm template
+	// https://kubernetes.io/docs/concepts/overview/working-with-objects/
+	CraftingSchema_Material_KUBERNETES_MANIFESTS CraftingSchema_Material_MaterialType = 50
 )
 
 // Enum value maps for CraftingSchema_Material_MaterialType.
@@ -298,6 +328,14 @@ var (
 		40: "TRUFFLEHOG_JSON",
 		41: "COBERTURA_XML",
 		42: "CHECKMARX_JSON",
+		43: "TRIVY_JSON",
+		44: "GRYPE_JSON",
+		45: "SBOM_CYCLONEDX_XML",
+		46: "SBOM_SPDX_TAG_VALUE",
+		47: "SEMGREP_JSON",
+		48: "SNYK_JSON",
+		49: "TERRAFORM_PLAN_JSON",
+		50: "KUBERNETES_MANIFESTS",
 	}
 	CraftingSchema_Material_MaterialType_value = map[string]int32{
 		"MATERIAL_TYPE_UNSPECIFIED":       0,
@@ -343,6 +381,14 @@ var (
 		"TRUFFLEHOG_JSON":                 40,
 		"COBERTURA_XML":                   41,
 		"CHECKMARX_JSON":                  42,
+		"TRIVY_JSON":                      43,
+		"GRYPE_JSON":                      44,
+		"SBOM_CYCLONEDX_XML":              45,
+		"SBOM_SPDX_TAG_VALUE":             46,
+		"SEMGREP_JSON":                    47,
+		"SNYK_JSON":                       48,
+		"TERRAFORM_PLAN_JSON":             49,
+		"KUBERNETES_MANIFESTS":            50,
 	}
 )
 
@@ -831,12 +877,11 @@ type PolicyAttachment struct {
 	// optional arguments for policies. Multivalued arguments can be set through multiline strings or comma separated values. It will be
 	// parsed and passed as an array value to the policy engine.
 	// with:
-	//
-	//	user: john
-	//	users: john, sarah
-	//	licenses: |
-	//	  AGPL-1.0
-	//	  AGPL-3.0
+	//   user: john
+	//   users: john, sarah
+	//   licenses: |
+	//     AGPL-1.0
+	//     AGPL-3.0
 	With map[string]string `protobuf:"bytes,5,rep,name=with,proto3" json:"with,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// List of requirements this policy contributes to satisfy
 	Requirements []string `protobuf:"bytes,6,rep,name=requirements,proto3" json:"requirements,omitempty"`
@@ -1036,10 +1081,12 @@ type Metadata struct {
 	// Declares the structured output schema for policy violations.
 	// When set, the policy engine validates that violations conform to the
 	// corresponding proto message:
-	//
-	//	VULNERABILITY    -> attestation.v1.PolicyVulnerabilityFinding
-	//	SAST             -> attestation.v1.PolicySASTFinding
-	//	LICENSE_VIOLATION -> attestation.v1.PolicyLicenseViolationFinding
+	//   VULNERABILITY    -> attestation.v1.PolicyVulnerabilityFinding
+	//   SAST             -> attestation.v1.PolicySASTFinding
+	//   LICENSE_VIOLATION -> attestation.v1.PolicyLicenseViolationFinding
+	//   SECRET           -> attestation.v1.PolicySecretFinding
+	//   MISCONFIGURATION -> attestation.v1.PolicyMisconfigurationFinding
+	//   MALWARE          -> attestation.v1.PolicyMalwareFinding
 	FindingType   *string `protobuf:"bytes,7,opt,name=finding_type,json=findingType,proto3,oneof" json:"finding_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2091,7 +2138,7 @@ var File_workflowcontract_v1_crafting_schema_proto protoreflect.FileDescriptor
 
 const file_workflowcontract_v1_crafting_schema_proto_rawDesc = "" +
 	"\n" +
-	")workflowcontract/v1/crafting_schema.proto\x12\x13workflowcontract.v1\x1a\x1bbuf/validate/validate.proto\"\xd0\x12\n" +
+	")workflowcontract/v1/crafting_schema.proto\x12\x13workflowcontract.v1\x1a\x1bbuf/validate/validate.proto\"\xa5\x14\n" +
 	"\x0eCraftingSchema\x122\n" +
 	"\x0eschema_version\x18\x01 \x01(\tB\v\xbaH\x06r\x04\n" +
 	"\x02v1\x18\x01R\rschemaVersion\x12N\n" +
@@ -2100,9 +2147,9 @@ const file_workflowcontract_v1_crafting_schema_proto_rawDesc = "" +
 	"\x06runner\x18\x04 \x01(\v2*.workflowcontract.v1.CraftingSchema.RunnerB\x02\x18\x01R\x06runner\x12E\n" +
 	"\vannotations\x18\x05 \x03(\v2\x1f.workflowcontract.v1.AnnotationB\x02\x18\x01R\vannotations\x12=\n" +
 	"\bpolicies\x18\x06 \x01(\v2\x1d.workflowcontract.v1.PoliciesB\x02\x18\x01R\bpolicies\x12S\n" +
-	"\rpolicy_groups\x18\a \x03(\v2*.workflowcontract.v1.PolicyGroupAttachmentB\x02\x18\x01R\fpolicyGroups\x1a\xca\x02\n" +
+	"\rpolicy_groups\x18\a \x03(\v2*.workflowcontract.v1.PolicyGroupAttachmentB\x02\x18\x01R\fpolicyGroups\x1a\xfa\x02\n" +
 	"\x06Runner\x12W\n" +
-	"\x04type\x18\x01 \x01(\x0e25.workflowcontract.v1.CraftingSchema.Runner.RunnerTypeB\f\xbaH\a\x82\x01\x04\x10\x01 \x00\x18\x01R\x04type\"\xe2\x01\n" +
+	"\x04type\x18\x01 \x01(\x0e25.workflowcontract.v1.CraftingSchema.Runner.RunnerTypeB\f\xbaH\a\x82\x01\x04\x10\x01 \x00\x18\x01R\x04type\"\x92\x02\n" +
 	"\n" +
 	"RunnerType\x12\x1b\n" +
 	"\x17RUNNER_TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
@@ -2114,7 +2161,10 @@ const file_workflowcontract_v1_crafting_schema_proto_rawDesc = "" +
 	"\x0fDAGGER_PIPELINE\x10\x06\x12\x15\n" +
 	"\x11TEAMCITY_PIPELINE\x10\a\x12\x13\n" +
 	"\x0fTEKTON_PIPELINE\x10\b\x12\x15\n" +
-	"\x11CHAINLOOP_SANDBOX\x10\t:\x02\x18\x01\x1a\x9b\f\n" +
+	"\x11CHAINLOOP_SANDBOX\x10\t\x12\x16\n" +
+	"\x12BITBUCKET_PIPELINE\x10\n" +
+	"\x12\x16\n" +
+	"\x12BUILDKITE_PIPELINE\x10\v:\x02\x18\x01\x1a\xc0\r\n" +
 	"\bMaterial\x12[\n" +
 	"\x04type\x18\x01 \x01(\x0e29.workflowcontract.v1.CraftingSchema.Material.MaterialTypeB\f\xbaH\a\x82\x01\x04\x10\x01 \x00\x18\x01R\x04type\x12\x99\x01\n" +
 	"\x04name\x18\x02 \x01(\tB\x84\x01\xbaH\x7f\xba\x01|\n" +
@@ -2125,7 +2175,7 @@ const file_workflowcontract_v1_crafting_schema_proto_rawDesc = "" +
 	"\vskip_upload\x18\x06 \x01(\bR\n" +
 	"skipUpload\x12\xaa\x01\n" +
 	"\x05group\x18\a \x01(\tB\x93\x01\xbaH\x8f\x01\xba\x01\x8b\x01\n" +
-	"\x0egroup.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a=this == '' || this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x05group\"\xc0\a\n" +
+	"\x0egroup.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a=this == '' || this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x05group\"\xe5\b\n" +
 	"\fMaterialType\x12\x1d\n" +
 	"\x19MATERIAL_TYPE_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -2173,7 +2223,17 @@ const file_workflowcontract_v1_crafting_schema_proto_rawDesc = "" +
 	"\x0fRADAMSA_CRASHES\x10'\x12\x13\n" +
 	"\x0fTRUFFLEHOG_JSON\x10(\x12\x11\n" +
 	"\rCOBERTURA_XML\x10)\x12\x12\n" +
-	"\x0eCHECKMARX_JSON\x10*:\x02\x18\x01:\x02\x18\x01\"\xfb\x01\n" +
+	"\x0eCHECKMARX_JSON\x10*\x12\x0e\n" +
+	"\n" +
+	"TRIVY_JSON\x10+\x12\x0e\n" +
+	"\n" +
+	"GRYPE_JSON\x10,\x12\x16\n" +
+	"\x12SBOM_CYCLONEDX_XML\x10-\x12\x17\n" +
+	"\x13SBOM_SPDX_TAG_VALUE\x10.\x12\x10\n" +
+	"\fSEMGREP_JSON\x10/\x12\r\n" +
+	"\tSNYK_JSON\x100\x12\x17\n" +
+	"\x13TERRAFORM_PLAN_JSON\x101\x12\x18\n" +
+	"\x14KUBERNETES_MANIFESTS\x102:\x02\x18\x01:\x02\x18\x01\"\xfb\x01\n" +
 	"\x10CraftingSchemaV2\x128\n" +
 	"\vapi_version\x18\x01 \x01(\tB\x17\xbaH\x14r\x12\n" +
 	"\x10chainloop.dev/v1R\n" +
@@ -2226,14 +2286,14 @@ const file_workflowcontract_v1_crafting_schema_proto_rawDesc = "" +
 	"r\b\n" +
 	"\x06PolicyR\x04kind\x12A\n" +
 	"\bmetadata\x18\x03 \x01(\v2\x1d.workflowcontract.v1.MetadataB\x06\xbaH\x03\xc8\x01\x01R\bmetadata\x12;\n" +
-	"\x04spec\x18\x04 \x01(\v2\x1f.workflowcontract.v1.PolicySpecB\x06\xbaH\x03\xc8\x01\x01R\x04spec\"\xfa\x03\n" +
+	"\x04spec\x18\x04 \x01(\v2\x1f.workflowcontract.v1.PolicySpecB\x06\xbaH\x03\xc8\x01\x01R\x04spec\"\x9d\x04\n" +
 	"\bMetadata\x12\x97\x01\n" +
 	"\x04name\x18\x03 \x01(\tB\x82\x01\xbaH\x7f\xba\x01|\n" +
 	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x04name\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12P\n" +
 	"\vannotations\x18\x05 \x03(\v2..workflowcontract.v1.Metadata.AnnotationsEntryR\vannotations\x12'\n" +
-	"\forganization\x18\x06 \x01(\tH\x00R\forganization\x88\x01\x01\x12U\n" +
-	"\ffinding_type\x18\a \x01(\tB-\xbaH*r(R\rVULNERABILITYR\x04SASTR\x11LICENSE_VIOLATIONH\x01R\vfindingType\x88\x01\x01\x1a>\n" +
+	"\forganization\x18\x06 \x01(\tH\x00R\forganization\x88\x01\x01\x12x\n" +
+	"\ffinding_type\x18\a \x01(\tBP\xbaHMrKR\rVULNERABILITYR\x04SASTR\x11LICENSE_VIOLATIONR\x06SECRETR\x10MISCONFIGURATIONR\aMALWAREH\x01R\vfindingType\x88\x01\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
@@ -34,6 +34,8 @@ var CraftingMaterialInValidationOrder = []CraftingSchema_Material_MaterialType{
 	CraftingSchema_Material_OPENVEX,
 	CraftingSchema_Material_SBOM_CYCLONEDX_JSON,
 	CraftingSchema_Material_SBOM_SPDX_JSON,
+	CraftingSchema_Material_SBOM_CYCLONEDX_XML,
+	CraftingSchema_Material_SBOM_SPDX_TAG_VALUE,
 	CraftingSchema_Material_CSAF_VEX,
 	CraftingSchema_Material_CSAF_INFORMATIONAL_ADVISORY,
 	CraftingSchema_Material_CSAF_SECURITY_ADVISORY,
@@ -43,6 +45,9 @@ var CraftingMaterialInValidationOrder = []CraftingSchema_Material_MaterialType{
 	CraftingSchema_Material_YELP_DETECT_SECRETS_BASELINE,
 	CraftingSchema_Material_SYSINTERNALS_SIGCHECK,
 	CraftingSchema_Material_OSSF_SCORECARD_JSON,
+	CraftingSchema_Material_TRIVY_JSON,
+	CraftingSchema_Material_GRYPE_JSON,
+	CraftingSchema_Material_TERRAFORM_PLAN_JSON,
 	CraftingSchema_Material_OPENAPI_SPEC,
 	CraftingSchema_Material_ASYNCAPI_SPEC,
 	CraftingSchema_Material_GRAPHQL_SPEC,
@@ -57,8 +62,14 @@ var CraftingMaterialInValidationOrder = []CraftingSchema_Material_MaterialType{
 	// Checkmarx native report is generic JSON that risks shadowing (or being
 	// shadowed by) other JSON kinds; it must be referenced with an explicit
 	// --kind CHECKMARX_JSON. Revisit once the fingerprint is proven strong.
+	// NOTE: KUBERNETES_MANIFESTS is intentionally omitted from auto-detection.
+	// Any YAML document with an apiVersion and a kind is accepted, which would
+	// shadow other YAML files such as Chainloop contracts or policies.
 	CraftingSchema_Material_HELM_CHART,
 	CraftingSchema_Material_SARIF,
+	// after SARIF so Snyk Code reports, in SARIF format, are still detected as SARIF
+	CraftingSchema_Material_SEMGREP_JSON,
+	CraftingSchema_Material_SNYK_JSON,
 	CraftingSchema_Material_BLACKDUCK_SCA_JSON,
 	CraftingSchema_Material_TWISTCLI_SCAN_JSON,
 	CraftingSchema_Material_ZAP_DAST_ZIP,
@@ -26,6 +26,7 @@ import (
 	flag "github.com/spf13/pflag"
 
 	conf "github.com/chainloop-dev/chainloop/app/controlplane/internal/conf/controlplane/config/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/internal/dispatcher"
 	"github.com/chainloop-dev/chainloop/app/controlplane/internal/server"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
 	"github.com/chainloop-dev/chainloop/app/controlplane/plugins"
@@ -64,7 +65,9 @@ func init() {
 func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ms *server.HTTPMetricsServer, profilerSvc *server.HTTPProfilerServer,
 	expirer *biz.WorkflowRunExpirerUseCase, plugins sdk.AvailablePlugins,
 	userAccessSyncer *biz.UserAccessSyncerUseCase, casBackendChecker *biz.CASBackendChecker,
-	apiTokenStaleRevoker *biz.APITokenStaleRevoker, cfg *conf.Bootstrap) *app {
+	apiTokenStaleRevoker *biz.APITokenStaleRevoker, casRetentionSweeper *biz.CASRetentionSweeper,
+	policyExceptionExpirer *biz.PolicyExceptionExpirer, searchIndexer *biz.WorkflowRunSearchIndexer,
+	fanOutDispatcher *dispatcher.FanOutDispatcher, cfg *conf.Bootstrap) *app {
 	servers := []transport.Server{gs, hs, ms}
 	if cfg.EnableProfiler {
 		servers = append(servers, profilerSvc)
@@ -78,7 +81,7 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, ms *server.HTTP
 			kratos.Metadata(map[string]string{}),
 			kratos.Logger(logger),
 			kratos.Server(servers...),
-		), expirer, plugins, userAccessSyncer, casBackendChecker, apiTokenStaleRevoker}
+		), expirer, plugins, userAccessSyncer, casBackendChecker, apiTokenStaleRevoker, casRetentionSweeper, policyExceptionExpirer, searchIndexer, fanOutDispatcher}
 }
 
 func main() {
@@ -165,6 +168,9 @@ func main() {
 		}
 	}()
 
+	// Execute the persisted integration deliveries, including the ones left pending by a previous run
+	go app.fanOutDispatcher.Start(ctx, &dispatcher.WorkerOpts{})
+
 	// Calculate initial delay: 1 minute base + 0-5 minutes jitter
 	// This protects boot phase and spreads validation across pods
 	baseDelay := 1 * time.Minute
@@ -178,6 +184,24 @@ func main() {
 		InitialDelay:  initialDelay,
 	})
 
+	// Start the background CAS retention sweeper (every 6 hours)
+	go app.casRetentionSweeper.Start(ctx, &biz.CASRetentionSweeperOpts{
+		CheckInterval: 6 * time.Hour,
+		InitialDelay:  initialDelay,
+	})
+
+	// Start the background policy exceptions expirer (every 10 minutes)
+	go app.policyExceptionExpirer.Start(ctx, &biz.PolicyExceptionExpirerOpts{
+		CheckInterval: 10 * time.Minute,
+		InitialDelay:  initialDelay,
+	})
+
+	// Start the background search indexer of the attestations (every 10 minutes)
+	go app.searchIndexer.Start(ctx, &biz.WorkflowRunSearchIndexerOpts{
+		CheckInterval: 10 * time.Minute,
+		InitialDelay:  initialDelay,
+	})
+
 	// Start the background CAS Backend checker for DEFAULT backends (every 30 minutes)
 	if app.casBackendChecker != nil {
 		go app.casBackendChecker.Start(ctx, &biz.CASBackendCheckerOpts{
@@ -215,6 +239,14 @@ type app struct {
 	casBackendChecker *biz.CASBackendChecker
 	// Background sweeper that auto-revokes stale API tokens
 	apiTokenStaleRevoker *biz.APITokenStaleRevoker
+	// Background sweeper that garbage collects CAS artifacts according to the retention rules
+	casRetentionSweeper *biz.CASRetentionSweeper
+	// Background sweeper that raises audit events for the expired policy exceptions
+	policyExceptionExpirer *biz.PolicyExceptionExpirer
+	// Background indexer of the attestations that are pending to be indexed for search
+	searchIndexer *biz.WorkflowRunSearchIndexer
+	// Background workers that deliver attestations to the attached integrations
+	fanOutDispatcher *dispatcher.FanOutDispatcher
 }
 
 // newNatsConfig converts the proto config to a plain natsconn.Config.
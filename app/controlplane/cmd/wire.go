@@ -42,6 +42,7 @@ import (
 	"github.com/chainloop-dev/chainloop/pkg/cache/policyevalbundle"
 	"github.com/chainloop-dev/chainloop/pkg/credentials"
 	"github.com/chainloop-dev/chainloop/pkg/natsconn"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/wire"
@@ -69,10 +70,12 @@ func wireApp(context.Context, *conf.Bootstrap, credentials.ReaderWriter, log.Log
 			newDataConf,
 			newPolicyProviderConfig,
 			newNatsConfig,
+			newVulnerabilityDB,
 			natsconn.New,
 			cacheProviderSet,
 			auditor.NewAuditLogPublisher,
 			newCASServerOptions,
+			newCASProvidersConfig,
 			newAuthAllowList,
 			newJWTConfig,
 			authzConfig,
@@ -125,6 +128,23 @@ func serviceOpts(l log.Logger, authzUC *biz.AuthzUseCase, pUC *biz.ProjectUseCas
 	}
 }
 
+// newVulnerabilityDB loads the local OSV database, if configured
+func newVulnerabilityDB(conf *conf.Bootstrap) (*osv.Database, error) {
+	if conf.GetVulnerabilityDbPath() == "" {
+		return nil, nil
+	}
+
+	return osv.Load(conf.GetVulnerabilityDbPath())
+}
+
+// newCASProvidersConfig enables the CAS backend providers that reach resources of the server, if configured
+func newCASProvidersConfig(conf *conf.Bootstrap) *loader.Config {
+	return &loader.Config{
+		FilesystemBaseDir:        conf.GetCasBackends().GetFilesystemBaseDir(),
+		GCSAllowWorkloadIdentity: conf.GetCasBackends().GetGcsAllowWorkloadIdentity(),
+	}
+}
+
 func newCASServerOptions(in *conf.Bootstrap_CASServer) *biz.CASServerDefaultOpts {
 	if in == nil {
 		return &biz.CASServerDefaultOpts{}
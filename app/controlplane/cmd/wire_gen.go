@@ -26,6 +26,7 @@ import (
 	"github.com/chainloop-dev/chainloop/pkg/cache/policyevalbundle"
 	"github.com/chainloop-dev/chainloop/pkg/credentials"
 	"github.com/chainloop-dev/chainloop/pkg/natsconn"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/wire"
@@ -60,7 +61,8 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 	membershipRepo := data.NewMembershipRepo(dataData, groupRepo, logger)
 	organizationRepo := data.NewOrganizationRepo(dataData, logger)
 	casBackendRepo := data.NewCASBackendRepo(dataData, logger)
-	providers := loader.LoadProviders(readerWriter)
+	loaderConfig := newCASProvidersConfig(bootstrap)
+	providers := loader.LoadProviders(readerWriter, loaderConfig)
 	bootstrap_CASServer := bootstrap.CasServer
 	casServerDefaultOpts := newCASServerOptions(bootstrap_CASServer)
 	bootstrap_NatsServer := bootstrap.NatsServer
@@ -203,16 +205,18 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 	}
 	casMappingRepo := data.NewCASMappingRepo(dataData, casBackendRepo, logger)
 	casMappingUseCase := biz.NewCASMappingUseCase(casMappingRepo, membershipUseCase, logger)
+	policyEvaluationRepo := data.NewPolicyEvaluationRepo(dataData, logger)
 	workflowRunUseCaseOpts := &biz.WorkflowRunUseCaseOpts{
-		WfrRepo:      workflowRunRepo,
-		WfRepo:       workflowRepo,
-		OrgRepo:      organizationRepo,
-		SigningUC:    signingUseCase,
-		AuditorUC:    auditorUseCase,
-		Logger:       logger,
-		BundleCache:  attestationbundleCache,
-		CASClient:    casClientUseCase,
-		CASMappingUC: casMappingUseCase,
+		WfrRepo:              workflowRunRepo,
+		WfRepo:               workflowRepo,
+		OrgRepo:              organizationRepo,
+		SigningUC:            signingUseCase,
+		AuditorUC:            auditorUseCase,
+		Logger:               logger,
+		BundleCache:          attestationbundleCache,
+		CASClient:            casClientUseCase,
+		CASMappingUC:         casMappingUseCase,
+		PolicyEvaluationRepo: policyEvaluationRepo,
 	}
 	workflowRunUseCase, err := biz.NewWorkflowRunUseCase(workflowRunUseCaseOpts)
 	if err != nil {
@@ -244,7 +248,9 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 	}
 	workflowRunService := service.NewWorkflowRunService(newWorkflowRunServiceOpts)
 	attestationUseCase := biz.NewAttestationUseCase(casClientUseCase, logger)
-	fanOutDispatcher := dispatcher.New(integrationUseCase, workflowUseCase, workflowRunUseCase, readerWriter, casClientUseCase, availablePlugins, logger)
+	integrationDeliveryRepo := data.NewIntegrationDeliveryRepo(dataData, logger)
+	integrationDeliveryUseCase := biz.NewIntegrationDeliveryUseCase(integrationDeliveryRepo, integrationAttachmentRepo, logger)
+	fanOutDispatcher := dispatcher.New(integrationUseCase, workflowUseCase, workflowRunUseCase, integrationDeliveryUseCase, readerWriter, casClientUseCase, availablePlugins, logger)
 	v6 := bootstrap.PrometheusIntegration
 	orgMetricsRepo := data.NewOrgMetricsRepo(dataData, logger)
 	orgMetricsUseCase, err := biz.NewOrgMetricsUseCase(orgMetricsRepo, organizationRepo, workflowUseCase, logger)
@@ -255,6 +261,8 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 		return nil, nil, err
 	}
 	prometheusUseCase := biz.NewPrometheusUseCase(v6, organizationUseCase, orgMetricsUseCase, logger)
+	policyExceptionRepo := data.NewPolicyExceptionRepo(dataData, logger)
+	policyExceptionUseCase := biz.NewPolicyExceptionUseCase(policyExceptionRepo, auditorUseCase, logger)
 	newAttestationServiceOpts := &service.NewAttestationServiceOpts{
 		WorkflowRunUC:      workflowRunUseCase,
 		WorkflowUC:         workflowUseCase,
@@ -273,6 +281,7 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 		ProjectVersionUC:   projectVersionUseCase,
 		SigningUseCase:     signingUseCase,
 		UserUC:             userUseCase,
+		PolicyExceptionUC:  policyExceptionUseCase,
 		BootstrapConfig:    bootstrap,
 		MembershipsCache:   cache,
 		Opts:               v5,
@@ -282,7 +291,7 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 	contextService := service.NewContextService(casBackendUseCase, userUseCase, v5...)
 	casCredentialsService := service.NewCASCredentialsService(casCredentialsUseCase, casMappingUseCase, casBackendUseCase, authzUseCase, v5...)
 	orgMetricsService := service.NewOrgMetricsService(orgMetricsUseCase, v5...)
-	integrationsService := service.NewIntegrationsService(integrationUseCase, workflowUseCase, availablePlugins, v5...)
+	integrationsService := service.NewIntegrationsService(integrationUseCase, integrationDeliveryUseCase, workflowUseCase, availablePlugins, v5...)
 	organizationService := service.NewOrganizationService(membershipUseCase, organizationUseCase, v5...)
 	casBackendService := service.NewCASBackendService(casBackendUseCase, providers, v5...)
 	casRedirectService, err := service.NewCASRedirectService(casMappingUseCase, casCredentialsUseCase, bootstrap_CASServer, v5...)
@@ -314,7 +323,22 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 	signingService := service.NewSigningService(signingUseCase, v5...)
 	prometheusService := service.NewPrometheusService(organizationUseCase, prometheusUseCase, v5...)
 	groupService := service.NewGroupService(groupUseCase, v5...)
-	projectService := service.NewProjectService(v5...)
+	projectService := service.NewProjectService(projectVersionUseCase, v5...)
+	policyEvaluationUseCase := biz.NewPolicyEvaluationUseCase(policyEvaluationRepo, logger)
+	database, err := newVulnerabilityDB(bootstrap)
+	if err != nil {
+		cleanup3()
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	policyImpactAnalysisRepo := data.NewPolicyImpactAnalysisRepo(dataData, logger)
+	policyImpactUseCase := biz.NewPolicyImpactUseCase(policyImpactAnalysisRepo, workflowRunUseCase, workflowContractUseCase, policyExceptionUseCase, casClientUseCase, casMappingUseCase, database, logger)
+	policyEvaluationService := service.NewPolicyEvaluationService(policyEvaluationUseCase, policyImpactUseCase, workflowUseCase, projectUseCase, v5...)
+	policyExceptionService := service.NewPolicyExceptionService(policyExceptionUseCase, workflowUseCase, projectUseCase, v5...)
+	casRetentionRuleRepo := data.NewCASRetentionRuleRepo(dataData, logger)
+	casRetentionUseCase := biz.NewCASRetentionUseCase(casRetentionRuleRepo, casBackendRepo, auditorUseCase, logger)
+	casRetentionService := service.NewCASRetentionService(casRetentionUseCase, v5...)
 	confServer := bootstrap.Server
 	federatedAuthentication := bootstrap.FederatedAuthentication
 	operationAuthorizationProvider := bootstrap.OperationAuthorizationProvider
@@ -360,6 +384,9 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 		PrometheusSvc:       prometheusService,
 		GroupSvc:            groupService,
 		ProjectSvc:          projectService,
+		PolicyEvaluationSvc: policyEvaluationService,
+		PolicyExceptionSvc:  policyExceptionService,
+		CASRetentionSvc:     casRetentionService,
 		Logger:              logger,
 		ServerConfig:        confServer,
 		AuthConfig:          auth,
@@ -401,7 +428,10 @@ func wireApp(contextContext context.Context, bootstrap *conf.Bootstrap, readerWr
 	distributedLock := data.NewPostgresLock(dataData, logger)
 	casBackendChecker := biz.NewCASBackendChecker(logger, casBackendRepo, casBackendUseCase, distributedLock)
 	apiTokenStaleRevoker := biz.NewAPITokenStaleRevoker(organizationRepo, apiTokenRepo, apiTokenUseCase, logger)
-	mainApp := newApp(logger, grpcServer, httpServer, httpMetricsServer, httpProfilerServer, workflowRunExpirerUseCase, availablePlugins, userAccessSyncerUseCase, casBackendChecker, apiTokenStaleRevoker, bootstrap)
+	casRetentionSweeper := biz.NewCASRetentionSweeper(logger, casRetentionRuleRepo, casBackendRepo, casMappingRepo, providers, auditorUseCase, distributedLock)
+	policyExceptionExpirer := biz.NewPolicyExceptionExpirer(logger, policyExceptionRepo, auditorUseCase, distributedLock)
+	workflowRunSearchIndexer := biz.NewWorkflowRunSearchIndexer(logger, workflowRunRepo, workflowRunUseCase, distributedLock)
+	mainApp := newApp(logger, grpcServer, httpServer, httpMetricsServer, httpProfilerServer, workflowRunExpirerUseCase, availablePlugins, userAccessSyncerUseCase, casBackendChecker, apiTokenStaleRevoker, casRetentionSweeper, policyExceptionExpirer, workflowRunSearchIndexer, fanOutDispatcher, bootstrap)
 	return mainApp, func() {
 		cleanup3()
 		cleanup2()
@@ -454,6 +484,23 @@ func serviceOpts(l log.Logger, authzUC *biz.AuthzUseCase, pUC *biz.ProjectUseCas
 	return []service.NewOpt{service.WithLogger(l), service.WithEnforcer(authzUC), service.WithProjectUseCase(pUC), service.WithGroupUseCase(gUC)}
 }
 
+// newVulnerabilityDB loads the local OSV database, if configured
+func newVulnerabilityDB(conf2 *conf.Bootstrap) (*osv.Database, error) {
+	if conf2.GetVulnerabilityDbPath() == "" {
+		return nil, nil
+	}
+
+	return osv.Load(conf2.GetVulnerabilityDbPath())
+}
+
+// newCASProvidersConfig enables the CAS backend providers that reach resources of the server, if configured
+func newCASProvidersConfig(conf2 *conf.Bootstrap) *loader.Config {
+	return &loader.Config{
+		FilesystemBaseDir:        conf2.GetCasBackends().GetFilesystemBaseDir(),
+		GCSAllowWorkloadIdentity: conf2.GetCasBackends().GetGcsAllowWorkloadIdentity(),
+	}
+}
+
 func newCASServerOptions(in *conf.Bootstrap_CASServer) *biz.CASServerDefaultOpts {
 	if in == nil {
 		return &biz.CASServerDefaultOpts{}
@@ -81,7 +81,12 @@ type Bootstrap struct {
 	// Optional external operation authorization provider
 	OperationAuthorizationProvider *OperationAuthorizationProvider `protobuf:"bytes,20,opt,name=operation_authorization_provider,json=operationAuthorizationProvider,proto3" json:"operation_authorization_provider,omitempty"`
 	// Attestation storage and processing options
-	Attestations  *Attestations `protobuf:"bytes,21,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	Attestations *Attestations `protobuf:"bytes,21,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	// Local mirror of the OSV vulnerability database, either a directory of advisories or a zip archive,
+	// queried by the chainloop.osv_lookup builtin in the policies evaluated by the controlplane, i.e during impact analysis
+	VulnerabilityDbPath string `protobuf:"bytes,22,opt,name=vulnerability_db_path,json=vulnerabilityDbPath,proto3" json:"vulnerability_db_path,omitempty"`
+	// Deployment-level settings of the CAS backend providers
+	CasBackends   *Bootstrap_CASBackends `protobuf:"bytes,23,opt,name=cas_backends,json=casBackends,proto3" json:"cas_backends,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -257,6 +262,20 @@ func (x *Bootstrap) GetAttestations() *Attestations {
 	return nil
 }
 
+func (x *Bootstrap) GetVulnerabilityDbPath() string {
+	if x != nil {
+		return x.VulnerabilityDbPath
+	}
+	return ""
+}
+
+func (x *Bootstrap) GetCasBackends() *Bootstrap_CASBackends {
+	if x != nil {
+		return x.CasBackends
+	}
+	return nil
+}
+
 type Attestations struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// When true, skip writing the attestation bundle to the per-run row in
@@ -1097,6 +1116,62 @@ type Bootstrap_NatsServer_Token struct {
 
 func (*Bootstrap_NatsServer_Token) isBootstrap_NatsServer_Authentication() {}
 
+type Bootstrap_CASBackends struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Directory the FILESYSTEM CAS backends must be located in, the provider is disabled unless it's set.
+	// It must match the artifact CAS configuration since both services access the same directories
+	FilesystemBaseDir string `protobuf:"bytes,1,opt,name=filesystem_base_dir,json=filesystemBaseDir,proto3" json:"filesystem_base_dir,omitempty"`
+	// Let GCS CAS backends without a service account key use the workload identity of the server.
+	// Any organization could reach the buckets granted to that identity, so only enable it in single-tenant deployments
+	GcsAllowWorkloadIdentity bool `protobuf:"varint,2,opt,name=gcs_allow_workload_identity,json=gcsAllowWorkloadIdentity,proto3" json:"gcs_allow_workload_identity,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Bootstrap_CASBackends) Reset() {
+	*x = Bootstrap_CASBackends{}
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bootstrap_CASBackends) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bootstrap_CASBackends) ProtoMessage() {}
+
+func (x *Bootstrap_CASBackends) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bootstrap_CASBackends.ProtoReflect.Descriptor instead.
+func (*Bootstrap_CASBackends) Descriptor() ([]byte, []int) {
+	return file_controlplane_config_v1_conf_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *Bootstrap_CASBackends) GetFilesystemBaseDir() string {
+	if x != nil {
+		return x.FilesystemBaseDir
+	}
+	return ""
+}
+
+func (x *Bootstrap_CASBackends) GetGcsAllowWorkloadIdentity() bool {
+	if x != nil {
+		return x.GcsAllowWorkloadIdentity
+	}
+	return false
+}
+
 type Bootstrap_Observability_Sentry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Dsn           string                 `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
@@ -1107,7 +1182,7 @@ type Bootstrap_Observability_Sentry struct {
 
 func (x *Bootstrap_Observability_Sentry) Reset() {
 	*x = Bootstrap_Observability_Sentry{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[14]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1119,7 +1194,7 @@ func (x *Bootstrap_Observability_Sentry) String() string {
 func (*Bootstrap_Observability_Sentry) ProtoMessage() {}
 
 func (x *Bootstrap_Observability_Sentry) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[14]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,7 +1241,7 @@ type Bootstrap_Observability_Tracing struct {
 
 func (x *Bootstrap_Observability_Tracing) Reset() {
 	*x = Bootstrap_Observability_Tracing{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[15]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1178,7 +1253,7 @@ func (x *Bootstrap_Observability_Tracing) String() string {
 func (*Bootstrap_Observability_Tracing) ProtoMessage() {}
 
 func (x *Bootstrap_Observability_Tracing) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[15]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1311,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[16]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1248,7 +1323,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[16]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1303,7 +1378,7 @@ type Server_TLS struct {
 
 func (x *Server_TLS) Reset() {
 	*x = Server_TLS{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[17]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +1390,7 @@ func (x *Server_TLS) String() string {
 func (*Server_TLS) ProtoMessage() {}
 
 func (x *Server_TLS) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[17]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +1435,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[18]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +1447,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[18]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,7 +1514,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[19]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1451,7 +1526,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[19]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1516,7 +1591,7 @@ type Auth_OIDC struct {
 
 func (x *Auth_OIDC) Reset() {
 	*x = Auth_OIDC{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[20]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1528,7 +1603,7 @@ func (x *Auth_OIDC) String() string {
 func (*Auth_OIDC) ProtoMessage() {}
 
 func (x *Auth_OIDC) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[20]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +1658,7 @@ type CA_FileCA struct {
 
 func (x *CA_FileCA) Reset() {
 	*x = CA_FileCA{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[21]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1595,7 +1670,7 @@ func (x *CA_FileCA) String() string {
 func (*CA_FileCA) ProtoMessage() {}
 
 func (x *CA_FileCA) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[21]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1649,7 +1724,7 @@ type CA_EJBCA struct {
 
 func (x *CA_EJBCA) Reset() {
 	*x = CA_EJBCA{}
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[22]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1661,7 +1736,7 @@ func (x *CA_EJBCA) String() string {
 func (*CA_EJBCA) ProtoMessage() {}
 
 func (x *CA_EJBCA) ProtoReflect() protoreflect.Message {
-	mi := &file_controlplane_config_v1_conf_proto_msgTypes[22]
+	mi := &file_controlplane_config_v1_conf_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,7 +1805,7 @@ var File_controlplane_config_v1_conf_proto protoreflect.FileDescriptor
 
 const file_controlplane_config_v1_conf_proto_rawDesc = "" +
 	"\n" +
-	"!controlplane/config/v1/conf.proto\x12\x16controlplane.config.v1\x1a\x1bbuf/validate/validate.proto\x1a#controlplane/config/v1/config.proto\x1a\x1bcredentials/v1/config.proto\x1a\x1egoogle/protobuf/duration.proto\"\xb1\x11\n" +
+	"!controlplane/config/v1/conf.proto\x12\x16controlplane.config.v1\x1a\x1bbuf/validate/validate.proto\x1a#controlplane/config/v1/config.proto\x1a\x1bcredentials/v1/config.proto\x1a\x1egoogle/protobuf/duration.proto\"\xb5\x13\n" +
 	"\tBootstrap\x126\n" +
 	"\x06server\x18\x01 \x01(\v2\x1e.controlplane.config.v1.ServerR\x06server\x120\n" +
 	"\x04data\x18\x02 \x01(\v2\x1c.controlplane.config.v1.DataR\x04data\x120\n" +
@@ -1757,7 +1832,9 @@ const file_controlplane_config_v1_conf_proto_rawDesc = "" +
 	"\x15restrict_org_creation\x18\x12 \x01(\bR\x13restrictOrgCreation\x12(\n" +
 	"\x10ui_dashboard_url\x18\x13 \x01(\tR\x0euiDashboardUrl\x12\x80\x01\n" +
 	" operation_authorization_provider\x18\x14 \x01(\v26.controlplane.config.v1.OperationAuthorizationProviderR\x1eoperationAuthorizationProvider\x12H\n" +
-	"\fattestations\x18\x15 \x01(\v2$.controlplane.config.v1.AttestationsR\fattestations\x1a\x8d\x03\n" +
+	"\fattestations\x18\x15 \x01(\v2$.controlplane.config.v1.AttestationsR\fattestations\x122\n" +
+	"\x15vulnerability_db_path\x18\x16 \x01(\tR\x13vulnerabilityDbPath\x12P\n" +
+	"\fcas_backends\x18\x17 \x01(\v2-.controlplane.config.v1.Bootstrap.CASBackendsR\vcasBackends\x1a\x8d\x03\n" +
 	"\rObservability\x12N\n" +
 	"\x06sentry\x18\x01 \x01(\v26.controlplane.config.v1.Bootstrap.Observability.SentryR\x06sentry\x12Q\n" +
 	"\atracing\x18\x02 \x01(\v27.controlplane.config.v1.Bootstrap.Observability.TracingR\atracing\x1a<\n" +
@@ -1780,7 +1857,10 @@ const file_controlplane_config_v1_conf_proto_rawDesc = "" +
 	"\x03uri\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x03uri\x12\x1f\n" +
 	"\x05token\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01H\x00R\x05token\x12\x1a\n" +
 	"\breplicas\x18\x03 \x01(\x05R\breplicasB\x10\n" +
-	"\x0eauthenticationJ\x04\b\b\x10\tR\x15referrer_shared_index\"6\n" +
+	"\x0eauthentication\x1a|\n" +
+	"\vCASBackends\x12.\n" +
+	"\x13filesystem_base_dir\x18\x01 \x01(\tR\x11filesystemBaseDir\x12=\n" +
+	"\x1bgcs_allow_workload_identity\x18\x02 \x01(\bR\x18gcsAllowWorkloadIdentityJ\x04\b\b\x10\tR\x15referrer_shared_index\"6\n" +
 	"\fAttestations\x12&\n" +
 	"\x0fskip_db_storage\x18\x01 \x01(\bR\rskipDbStorage\"v\n" +
 	"\x1eOperationAuthorizationProvider\x12\x1a\n" +
@@ -1876,7 +1956,7 @@ func file_controlplane_config_v1_conf_proto_rawDescGZIP() []byte {
 	return file_controlplane_config_v1_conf_proto_rawDescData
 }
 
-var file_controlplane_config_v1_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_controlplane_config_v1_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_controlplane_config_v1_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),                       // 0: controlplane.config.v1.Bootstrap
 	(*Attestations)(nil),                    // 1: controlplane.config.v1.Attestations
@@ -1892,57 +1972,59 @@ var file_controlplane_config_v1_conf_proto_goTypes = []any{
 	(*Bootstrap_Observability)(nil),         // 11: controlplane.config.v1.Bootstrap.Observability
 	(*Bootstrap_CASServer)(nil),             // 12: controlplane.config.v1.Bootstrap.CASServer
 	(*Bootstrap_NatsServer)(nil),            // 13: controlplane.config.v1.Bootstrap.NatsServer
-	(*Bootstrap_Observability_Sentry)(nil),  // 14: controlplane.config.v1.Bootstrap.Observability.Sentry
-	(*Bootstrap_Observability_Tracing)(nil), // 15: controlplane.config.v1.Bootstrap.Observability.Tracing
-	(*Server_HTTP)(nil),                     // 16: controlplane.config.v1.Server.HTTP
-	(*Server_TLS)(nil),                      // 17: controlplane.config.v1.Server.TLS
-	(*Server_GRPC)(nil),                     // 18: controlplane.config.v1.Server.GRPC
-	(*Data_Database)(nil),                   // 19: controlplane.config.v1.Data.Database
-	(*Auth_OIDC)(nil),                       // 20: controlplane.config.v1.Auth.OIDC
-	(*CA_FileCA)(nil),                       // 21: controlplane.config.v1.CA.FileCA
-	(*CA_EJBCA)(nil),                        // 22: controlplane.config.v1.CA.EJBCA
-	(*v1.Credentials)(nil),                  // 23: credentials.v1.Credentials
-	(*v11.OnboardingSpec)(nil),              // 24: controlplane.config.v1.OnboardingSpec
-	(*v11.AllowList)(nil),                   // 25: controlplane.config.v1.AllowList
-	(*durationpb.Duration)(nil),             // 26: google.protobuf.Duration
+	(*Bootstrap_CASBackends)(nil),           // 14: controlplane.config.v1.Bootstrap.CASBackends
+	(*Bootstrap_Observability_Sentry)(nil),  // 15: controlplane.config.v1.Bootstrap.Observability.Sentry
+	(*Bootstrap_Observability_Tracing)(nil), // 16: controlplane.config.v1.Bootstrap.Observability.Tracing
+	(*Server_HTTP)(nil),                     // 17: controlplane.config.v1.Server.HTTP
+	(*Server_TLS)(nil),                      // 18: controlplane.config.v1.Server.TLS
+	(*Server_GRPC)(nil),                     // 19: controlplane.config.v1.Server.GRPC
+	(*Data_Database)(nil),                   // 20: controlplane.config.v1.Data.Database
+	(*Auth_OIDC)(nil),                       // 21: controlplane.config.v1.Auth.OIDC
+	(*CA_FileCA)(nil),                       // 22: controlplane.config.v1.CA.FileCA
+	(*CA_EJBCA)(nil),                        // 23: controlplane.config.v1.CA.EJBCA
+	(*v1.Credentials)(nil),                  // 24: credentials.v1.Credentials
+	(*v11.OnboardingSpec)(nil),              // 25: controlplane.config.v1.OnboardingSpec
+	(*v11.AllowList)(nil),                   // 26: controlplane.config.v1.AllowList
+	(*durationpb.Duration)(nil),             // 27: google.protobuf.Duration
 }
 var file_controlplane_config_v1_conf_proto_depIdxs = []int32{
 	5,  // 0: controlplane.config.v1.Bootstrap.server:type_name -> controlplane.config.v1.Server
 	6,  // 1: controlplane.config.v1.Bootstrap.data:type_name -> controlplane.config.v1.Data
 	7,  // 2: controlplane.config.v1.Bootstrap.auth:type_name -> controlplane.config.v1.Auth
 	11, // 3: controlplane.config.v1.Bootstrap.observability:type_name -> controlplane.config.v1.Bootstrap.Observability
-	23, // 4: controlplane.config.v1.Bootstrap.credentials_service:type_name -> credentials.v1.Credentials
+	24, // 4: controlplane.config.v1.Bootstrap.credentials_service:type_name -> credentials.v1.Credentials
 	12, // 5: controlplane.config.v1.Bootstrap.cas_server:type_name -> controlplane.config.v1.Bootstrap.CASServer
 	9,  // 6: controlplane.config.v1.Bootstrap.certificate_authority:type_name -> controlplane.config.v1.CA
 	9,  // 7: controlplane.config.v1.Bootstrap.certificate_authorities:type_name -> controlplane.config.v1.CA
 	8,  // 8: controlplane.config.v1.Bootstrap.timestamp_authorities:type_name -> controlplane.config.v1.TSA
-	24, // 9: controlplane.config.v1.Bootstrap.onboarding:type_name -> controlplane.config.v1.OnboardingSpec
+	25, // 9: controlplane.config.v1.Bootstrap.onboarding:type_name -> controlplane.config.v1.OnboardingSpec
 	10, // 10: controlplane.config.v1.Bootstrap.prometheus_integration:type_name -> controlplane.config.v1.PrometheusIntegrationSpec
 	4,  // 11: controlplane.config.v1.Bootstrap.policy_providers:type_name -> controlplane.config.v1.PolicyProvider
 	13, // 12: controlplane.config.v1.Bootstrap.nats_server:type_name -> controlplane.config.v1.Bootstrap.NatsServer
 	3,  // 13: controlplane.config.v1.Bootstrap.federated_authentication:type_name -> controlplane.config.v1.FederatedAuthentication
 	2,  // 14: controlplane.config.v1.Bootstrap.operation_authorization_provider:type_name -> controlplane.config.v1.OperationAuthorizationProvider
 	1,  // 15: controlplane.config.v1.Bootstrap.attestations:type_name -> controlplane.config.v1.Attestations
-	16, // 16: controlplane.config.v1.Server.http:type_name -> controlplane.config.v1.Server.HTTP
-	18, // 17: controlplane.config.v1.Server.grpc:type_name -> controlplane.config.v1.Server.GRPC
-	16, // 18: controlplane.config.v1.Server.http_metrics:type_name -> controlplane.config.v1.Server.HTTP
-	19, // 19: controlplane.config.v1.Data.database:type_name -> controlplane.config.v1.Data.Database
-	25, // 20: controlplane.config.v1.Auth.allow_list:type_name -> controlplane.config.v1.AllowList
-	20, // 21: controlplane.config.v1.Auth.oidc:type_name -> controlplane.config.v1.Auth.OIDC
-	21, // 22: controlplane.config.v1.CA.file_ca:type_name -> controlplane.config.v1.CA.FileCA
-	22, // 23: controlplane.config.v1.CA.ejbca_ca:type_name -> controlplane.config.v1.CA.EJBCA
-	14, // 24: controlplane.config.v1.Bootstrap.Observability.sentry:type_name -> controlplane.config.v1.Bootstrap.Observability.Sentry
-	15, // 25: controlplane.config.v1.Bootstrap.Observability.tracing:type_name -> controlplane.config.v1.Bootstrap.Observability.Tracing
-	18, // 26: controlplane.config.v1.Bootstrap.CASServer.grpc:type_name -> controlplane.config.v1.Server.GRPC
-	26, // 27: controlplane.config.v1.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	26, // 28: controlplane.config.v1.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	17, // 29: controlplane.config.v1.Server.GRPC.tls_config:type_name -> controlplane.config.v1.Server.TLS
-	26, // 30: controlplane.config.v1.Data.Database.max_conn_idle_time:type_name -> google.protobuf.Duration
-	31, // [31:31] is the sub-list for method output_type
-	31, // [31:31] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	14, // 16: controlplane.config.v1.Bootstrap.cas_backends:type_name -> controlplane.config.v1.Bootstrap.CASBackends
+	17, // 17: controlplane.config.v1.Server.http:type_name -> controlplane.config.v1.Server.HTTP
+	19, // 18: controlplane.config.v1.Server.grpc:type_name -> controlplane.config.v1.Server.GRPC
+	17, // 19: controlplane.config.v1.Server.http_metrics:type_name -> controlplane.config.v1.Server.HTTP
+	20, // 20: controlplane.config.v1.Data.database:type_name -> controlplane.config.v1.Data.Database
+	26, // 21: controlplane.config.v1.Auth.allow_list:type_name -> controlplane.config.v1.AllowList
+	21, // 22: controlplane.config.v1.Auth.oidc:type_name -> controlplane.config.v1.Auth.OIDC
+	22, // 23: controlplane.config.v1.CA.file_ca:type_name -> controlplane.config.v1.CA.FileCA
+	23, // 24: controlplane.config.v1.CA.ejbca_ca:type_name -> controlplane.config.v1.CA.EJBCA
+	15, // 25: controlplane.config.v1.Bootstrap.Observability.sentry:type_name -> controlplane.config.v1.Bootstrap.Observability.Sentry
+	16, // 26: controlplane.config.v1.Bootstrap.Observability.tracing:type_name -> controlplane.config.v1.Bootstrap.Observability.Tracing
+	19, // 27: controlplane.config.v1.Bootstrap.CASServer.grpc:type_name -> controlplane.config.v1.Server.GRPC
+	27, // 28: controlplane.config.v1.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	27, // 29: controlplane.config.v1.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	18, // 30: controlplane.config.v1.Server.GRPC.tls_config:type_name -> controlplane.config.v1.Server.TLS
+	27, // 31: controlplane.config.v1.Data.Database.max_conn_idle_time:type_name -> google.protobuf.Duration
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_controlplane_config_v1_conf_proto_init() }
@@ -1957,14 +2039,14 @@ func file_controlplane_config_v1_conf_proto_init() {
 	file_controlplane_config_v1_conf_proto_msgTypes[13].OneofWrappers = []any{
 		(*Bootstrap_NatsServer_Token)(nil),
 	}
-	file_controlplane_config_v1_conf_proto_msgTypes[15].OneofWrappers = []any{}
+	file_controlplane_config_v1_conf_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_config_v1_conf_proto_rawDesc), len(file_controlplane_config_v1_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	crv1 "github.com/google/go-containerregistry/pkg/v1"
@@ -41,31 +42,53 @@ type FanOutDispatcher struct {
 	integrationUC       *biz.IntegrationUseCase
 	wfUC                *biz.WorkflowUseCase
 	wfRunUC             *biz.WorkflowRunUseCase
+	deliveryUC          *biz.IntegrationDeliveryUseCase
 	credentialsProvider credentials.ReaderWriter
 	casClient           biz.CASClient
 	log                 *log.Helper
 	l                   log.Logger
 	loaded              sdk.AvailablePlugins
+	// wakeUp signals the workers that new deliveries have been enqueued
+	wakeUp chan struct{}
 }
 
 // maxDispatchElapsedTime bounds the total time the dispatcher will keep
-// retrying a single integration delivery. With the default exponential
+// retrying a single integration delivery attempt. With the default exponential
 // backoff (500ms initial, ×1.5 multiplier, 60s max interval) this yields
 // roughly 12-15 attempts — enough to ride out a transient blip or a brief
-// endpoint restart, while staying short enough not to leak goroutines in
-// the current in-process, fire-and-forget dispatch model.
+// endpoint restart. Longer outages are handled by the persisted delivery,
+// which is rescheduled by the workers until it gets dead-lettered.
 //
 // It is a var (not a const) so tests can shrink it to avoid waiting for
 // the real 5m budget. Production code must not mutate it.
 var maxDispatchElapsedTime = 5 * time.Minute
 
-func New(integrationUC *biz.IntegrationUseCase, wfUC *biz.WorkflowUseCase, wfRunUC *biz.WorkflowRunUseCase, creds credentials.ReaderWriter, c biz.CASClient, registered sdk.AvailablePlugins, l log.Logger) *FanOutDispatcher {
-	return &FanOutDispatcher{integrationUC, wfUC, wfRunUC, creds, c, servicelogger.ScopedHelper(l, "fanout-dispatcher"), l, registered}
+const (
+	// How long a claimed delivery is hidden from other workers. It must outlive
+	// an attempt so a delivery is only picked up again if its worker died.
+	deliveryLeaseMargin = 5 * time.Minute
+
+	defaultWorkerCheckInterval = 15 * time.Second
+	defaultWorkerConcurrency   = 10
+)
+
+// errIntegrationUnavailable is returned when the attached integration can't be executed
+// i.e it has been deleted or detached, or its plugin is not loaded in this controlplane
+var errIntegrationUnavailable = errors.New("integration not available")
+
+// errReadingCredentials is returned when the credentials of the integration can't be read,
+// unlike errIntegrationUnavailable it might be transient so the delivery is retried
+var errReadingCredentials = errors.New("reading credentials")
+
+func New(integrationUC *biz.IntegrationUseCase, wfUC *biz.WorkflowUseCase, wfRunUC *biz.WorkflowRunUseCase, deliveryUC *biz.IntegrationDeliveryUseCase, creds credentials.ReaderWriter, c biz.CASClient, registered sdk.AvailablePlugins, l log.Logger) *FanOutDispatcher {
+	return &FanOutDispatcher{integrationUC, wfUC, wfRunUC, deliveryUC, creds, c, servicelogger.ScopedHelper(l, "fanout-dispatcher"), l, registered, make(chan struct{}, 1)}
 }
 
 // Dispatch item is a plugin instance + resolved inputs that gets hydrated
 // during the dispatch process with information from both the DB and the attestation
 type dispatchItem struct {
+	// Attachment this item was created from
+	attachmentID uuid.UUID
 	// Configuration
 	registrationConfig, attachmentConfig []byte
 	credentials                          *sdk.Credentials
@@ -81,6 +104,7 @@ type dispatchQueue []*dispatchItem
 
 type RunOpts struct {
 	Envelope            *dsse.Envelope
+	AttestationDigest   string
 	OrgID               string
 	WorkflowID          string
 	WorkflowRunID       string
@@ -88,9 +112,10 @@ type RunOpts struct {
 	DownloadSecretName  string
 }
 
+// Run persists a delivery for each integration attached to the workflow.
+// The actual execution happens asynchronously in the workers, see Start
 func (d *FanOutDispatcher) Run(ctx context.Context, opts *RunOpts) error {
-	// Hydration process for the dispatch queue
-	// 1. Load all the integrations that are attached to this workflow
+	// Load all the integrations that are attached to this workflow
 	queue, err := d.initDispatchQueue(ctx, opts.OrgID, opts.WorkflowID)
 	if err != nil {
 		return fmt.Errorf("loading integration info: %w", err)
@@ -102,53 +127,251 @@ func (d *FanOutDispatcher) Run(ctx context.Context, opts *RunOpts) error {
 		return nil
 	}
 
-	// 2. Hydrate the dispatch queue with the actual inputs
-	if err := d.loadInputs(ctx, queue, opts.Envelope, opts.DownloadBackendType, opts.DownloadSecretName, opts.OrgID); err != nil {
-		return fmt.Errorf("loading materials: %w", err)
+	orgUUID, err := uuid.Parse(opts.OrgID)
+	if err != nil {
+		return fmt.Errorf("parsing org ID: %w", err)
 	}
 
-	// 3 - Calculate workflow / run information
-	wf, err := d.wfUC.FindByID(ctx, opts.WorkflowID)
+	wfUUID, err := uuid.Parse(opts.WorkflowID)
 	if err != nil {
-		return fmt.Errorf("finding workflow: %w", err)
-	} else if wf == nil {
-		return fmt.Errorf("workflow not found")
+		return fmt.Errorf("parsing workflow ID: %w", err)
+	}
+
+	wfRunUUID, err := uuid.Parse(opts.WorkflowRunID)
+	if err != nil {
+		return fmt.Errorf("parsing workflow run ID: %w", err)
+	}
+
+	// Persist the deliveries so they survive a restart of the controlplane
+	deliveries := make([]*biz.IntegrationDeliveryCreateOpts, 0, len(queue))
+	for _, item := range queue {
+		deliveries = append(deliveries, &biz.IntegrationDeliveryCreateOpts{
+			OrgID: orgUUID, WorkflowID: wfUUID, WorkflowRunID: wfRunUUID, AttachmentID: item.attachmentID,
+			Envelope:            opts.Envelope,
+			AttestationDigest:   opts.AttestationDigest,
+			DownloadBackendType: opts.DownloadBackendType,
+			DownloadSecretName:  opts.DownloadSecretName,
+		})
+	}
+
+	if err := d.deliveryUC.Enqueue(ctx, deliveries); err != nil {
+		return fmt.Errorf("enqueueing deliveries: %w", err)
+	}
+
+	// Let the workers know there is work to do
+	d.notify()
+
+	return nil
+}
+
+type WorkerOpts struct {
+	// Interval between checks for due deliveries, defaults to 15 seconds
+	CheckInterval time.Duration
+	// Max number of deliveries executed concurrently, defaults to 10
+	Concurrency int
+}
+
+// Start runs the worker loop that executes the persisted deliveries until the context is cancelled.
+// Deliveries are claimed with a lease, so pending or interrupted deliveries are picked up again after a restart
+// and several controlplane replicas can run workers concurrently.
+func (d *FanOutDispatcher) Start(ctx context.Context, opts *WorkerOpts) {
+	interval := defaultWorkerCheckInterval
+	if opts != nil && opts.CheckInterval > 0 {
+		interval = opts.CheckInterval
+	}
+
+	concurrency := defaultWorkerConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	d.log.Infow("msg", "integration delivery worker started", "interval", interval, "concurrency", concurrency)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// deliveries in flight, each one takes a slot until it finishes
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		d.processDue(ctx, slots, &wg)
+
+		select {
+		case <-ctx.Done():
+			d.log.Info("integration delivery worker stopping due to context cancellation")
+			return
+		case <-ticker.C:
+		case <-d.wakeUp:
+		}
+	}
+}
+
+// processDue claims as many due deliveries as free slots and executes them in the background.
+// It doesn't wait for them, every finished delivery wakes the worker up to claim the next ones,
+// so a slow integration only holds its own slot.
+func (d *FanOutDispatcher) processDue(ctx context.Context, slots chan struct{}, wg *sync.WaitGroup) {
+	for ctx.Err() == nil {
+		// slots are only taken here, so they can't run out while claiming
+		free := cap(slots) - len(slots)
+		if free == 0 {
+			return
+		}
+
+		deliveries, err := d.deliveryUC.ClaimDue(ctx, free, maxDispatchElapsedTime+deliveryLeaseMargin)
+		if err != nil {
+			d.log.Errorw("msg", "claiming integration deliveries", "error", err)
+			return
+		}
+
+		for _, delivery := range deliveries {
+			slots <- struct{}{}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.deliver(ctx, delivery)
+
+				<-slots
+				d.notify()
+			}()
+		}
+
+		if len(deliveries) < free {
+			return
+		}
+	}
+}
+
+// notify lets the worker know there is work to do or room to take it
+func (d *FanOutDispatcher) notify() {
+	select {
+	case d.wakeUp <- struct{}{}:
+	default:
+	}
+}
+
+// deliver executes a claimed delivery and records its outcome
+func (d *FanOutDispatcher) deliver(ctx context.Context, delivery *biz.IntegrationDelivery) {
+	start := time.Now()
+	err := d.execute(ctx, delivery)
+	duration := time.Since(start)
+
+	if err != nil {
+		d.log.Warnw("msg", "integration delivery failed", "ID", delivery.ID, "attachmentID", delivery.AttachmentID, "attempt", delivery.Attempts, "duration", duration, "error", err)
+
+		record := d.deliveryUC.MarkFailed
+		// Retrying won't bring back a deleted integration or an unregistered plugin
+		if errors.Is(err, errIntegrationUnavailable) {
+			record = d.deliveryUC.MarkDeadLetter
+		}
+
+		d.logOutcomeErr("recording integration delivery failure", delivery, record(ctx, delivery, duration, err))
+		return
 	}
 
-	wfRun, err := d.wfRunUC.GetByIDInOrg(ctx, opts.OrgID, opts.WorkflowRunID)
+	d.logOutcomeErr("recording integration delivery success", delivery, d.deliveryUC.MarkSucceeded(ctx, delivery, duration))
+}
+
+func (d *FanOutDispatcher) logOutcomeErr(msg string, delivery *biz.IntegrationDelivery, err error) {
+	switch {
+	case err == nil:
+	// The attempt outlived its lease, the outcome of the worker that claimed the delivery again prevails
+	case errors.Is(err, biz.ErrIntegrationDeliveryLeaseLost):
+		d.log.Warnw("msg", msg, "ID", delivery.ID, "attempt", delivery.Attempts, "error", err)
+	default:
+		d.log.Errorw("msg", msg, "ID", delivery.ID, "error", err)
+	}
+}
+
+// execute re-hydrates the execution request from the persisted delivery and runs the integration
+func (d *FanOutDispatcher) execute(ctx context.Context, delivery *biz.IntegrationDelivery) error {
+	orgID := delivery.OrgID.String()
+
+	attachment, err := d.integrationUC.GetAttachment(ctx, delivery.OrgID, delivery.AttachmentID)
 	if err != nil {
-		return fmt.Errorf("finding workflow: %w", err)
+		// The integration was detached from the workflow
+		if biz.IsNotFound(err) {
+			return fmt.Errorf("%w: %w", errIntegrationUnavailable, err)
+		}
+
+		return fmt.Errorf("finding attachment: %w", err)
+	}
+
+	item, err := d.newDispatchItem(ctx, orgID, attachment)
+	if err != nil {
+		return err
+	}
+
+	wfRun, err := d.wfRunUC.GetByIDInOrg(ctx, orgID, delivery.WorkflowRunID.String())
+	if err != nil {
+		return fmt.Errorf("finding workflow run: %w", err)
 	} else if wfRun == nil {
 		return fmt.Errorf("workflowRun not found")
 	}
 
-	workflowMetadata := &sdk.ChainloopMetadata{
+	queue := dispatchQueue{item}
+	if err := d.loadInputs(ctx, queue, delivery.Envelope, downloadBackend(delivery, wfRun)); err != nil {
+		return fmt.Errorf("loading materials: %w", err)
+	}
+
+	metadata, err := d.loadMetadata(ctx, delivery.WorkflowID.String(), wfRun)
+	if err != nil {
+		return err
+	}
+
+	return dispatch(ctx, item.plugin, generateRequest(item, metadata), d.log)
+}
+
+// Calculate workflow / run information
+// downloadBackend returns the CAS backend the materials of the delivery are downloaded from.
+// The backend is taken from the workflow run when possible since it carries its replicas,
+// that way the download can fall back to them if the backend is unavailable.
+func downloadBackend(delivery *biz.IntegrationDelivery, wfRun *biz.WorkflowRun) *biz.CASBackend {
+	for _, b := range wfRun.CASBackends {
+		if b.SecretName == delivery.DownloadSecretName {
+			return b
+		}
+	}
+
+	return &biz.CASBackend{
+		Provider:       biz.CASBackendProvider(delivery.DownloadBackendType),
+		SecretName:     delivery.DownloadSecretName,
+		OrganizationID: delivery.OrgID,
+	}
+}
+
+func (d *FanOutDispatcher) loadMetadata(ctx context.Context, workflowID string, wfRun *biz.WorkflowRun) (*sdk.ChainloopMetadata, error) {
+	wf, err := d.wfUC.FindByID(ctx, workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("finding workflow: %w", err)
+	} else if wf == nil {
+		return nil, fmt.Errorf("workflow not found")
+	}
+
+	metadata := &sdk.ChainloopMetadata{
 		Workflow: &sdk.ChainloopMetadataWorkflow{
-			ID:      opts.WorkflowID,
+			ID:      workflowID,
 			Name:    wf.Name,
 			Project: wf.Project,
 			Team:    wf.Team,
 		},
 		WorkflowRun: &sdk.ChainloopMetadataWorkflowRun{
-			ID:                opts.WorkflowRunID,
+			ID:                wfRun.ID.String(),
 			State:             wfRun.State,
 			StartedAt:         *wfRun.CreatedAt,
-			FinishedAt:        *wfRun.FinishedAt,
 			RunnerType:        wfRun.RunnerType,
 			RunURL:            wfRun.RunURL,
 			AttestationDigest: wfRun.Attestation.Digest,
 		},
 	}
 
-	// Dispatch the integrations
-	for _, item := range queue {
-		req := generateRequest(item, workflowMetadata)
-		go func(p sdk.FanOut, r *sdk.ExecutionRequest) {
-			_ = dispatch(ctx, p, req, d.log)
-		}(item.plugin, req)
+	// The delivery might be picked up before the run gets marked as finished
+	if wfRun.FinishedAt != nil {
+		metadata.WorkflowRun.FinishedAt = *wfRun.FinishedAt
 	}
 
-	return nil
+	return metadata, nil
 }
 
 // Initialize the dispatchQueue with information about all the attached integrations
@@ -169,47 +392,67 @@ func (d *FanOutDispatcher) initDispatchQueue(ctx context.Context, orgID, workflo
 	}
 
 	for _, attachment := range attachments {
-		// Get the integration DB object
-		dbIntegration, err := d.integrationUC.FindByIDInOrg(ctx, orgID, attachment.IntegrationID.String())
+		item, err := d.newDispatchItem(ctx, orgID, attachment.IntegrationAttachment)
 		if err != nil {
-			return nil, fmt.Errorf("finding integration in DB: %w", err)
-		} else if dbIntegration == nil {
-			d.log.Warnw("msg", "integration not found", "workflowID", workflowID, "ID", attachment.IntegrationID.String())
-			continue
-		}
-
-		// Find the integration backend from the list of registered integrations
-		backend, err := d.loaded.FindByID(dbIntegration.Kind)
-		if err != nil {
-			d.log.Warnw("msg", "integration backend not registered, skipped", "Kind", attachment.IntegrationID.String(), "err", err.Error())
-			continue
-		}
-
-		d.log.Infow("msg", "found attached integration", "workflowID", workflowID, "integration", backend.String())
+			if errors.Is(err, errIntegrationUnavailable) {
+				d.log.Warnw("msg", "attached integration skipped", "workflowID", workflowID, "err", err.Error())
+				continue
+			}
 
-		// Craft required configuration
-		creds := &sdk.Credentials{}
-		if dbIntegration.SecretName != "" {
-			if err := d.credentialsProvider.ReadCredentials(ctx, dbIntegration.SecretName, creds); err != nil {
-				d.log.Warnw("msg", "error reading credentials", "err", err.Error())
+			// The delivery is still enqueued, the workers will retry reading the credentials
+			if errors.Is(err, errReadingCredentials) {
+				d.log.Warnw("msg", "attached integration credentials not available yet", "workflowID", workflowID, "err", err.Error())
+				queue = append(queue, &dispatchItem{attachmentID: attachment.IntegrationAttachment.ID})
 				continue
 			}
+
+			return nil, err
 		}
 
-		// All the required configuration needed to run the integration
-		queue = append(queue, &dispatchItem{
-			registrationConfig: dbIntegration.Config,
-			attachmentConfig:   attachment.IntegrationAttachment.Config,
-			credentials:        creds,
-			plugin:             backend,
-		})
+		d.log.Infow("msg", "found attached integration", "workflowID", workflowID, "integration", item.plugin.String())
+
+		queue = append(queue, item)
 	}
 
 	return queue, nil
 }
 
+// newDispatchItem loads all the configuration required to run the integration of the attachment
+func (d *FanOutDispatcher) newDispatchItem(ctx context.Context, orgID string, attachment *biz.IntegrationAttachment) (*dispatchItem, error) {
+	// Get the integration DB object
+	dbIntegration, err := d.integrationUC.FindByIDInOrg(ctx, orgID, attachment.IntegrationID.String())
+	if err != nil {
+		return nil, fmt.Errorf("finding integration in DB: %w", err)
+	} else if dbIntegration == nil {
+		return nil, fmt.Errorf("%w: integration %s not found", errIntegrationUnavailable, attachment.IntegrationID)
+	}
+
+	// Find the integration backend from the list of registered integrations
+	backend, err := d.loaded.FindByID(dbIntegration.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("%w: backend %s not registered: %w", errIntegrationUnavailable, dbIntegration.Kind, err)
+	}
+
+	// Craft required configuration
+	creds := &sdk.Credentials{}
+	if dbIntegration.SecretName != "" {
+		if err := d.credentialsProvider.ReadCredentials(ctx, dbIntegration.SecretName, creds); err != nil {
+			return nil, fmt.Errorf("%w: %w", errReadingCredentials, err)
+		}
+	}
+
+	// All the required configuration needed to run the integration
+	return &dispatchItem{
+		attachmentID:       attachment.ID,
+		registrationConfig: dbIntegration.Config,
+		attachmentConfig:   attachment.Config,
+		credentials:        creds,
+		plugin:             backend,
+	}, nil
+}
+
 // Load the inputs for the dispatchItem, both materials and attestation
-func (d *FanOutDispatcher) loadInputs(ctx context.Context, queue dispatchQueue, att *dsse.Envelope, backendType, secretName, orgID string) error {
+func (d *FanOutDispatcher) loadInputs(ctx context.Context, queue dispatchQueue, att *dsse.Envelope, backend *biz.CASBackend) error {
 	if att == nil {
 		return fmt.Errorf("attestation is nil")
 	}
@@ -263,12 +506,8 @@ func (d *FanOutDispatcher) loadInputs(ctx context.Context, queue dispatchQueue,
 			if item.plugin.IsSubscribedTo(material.Type) {
 				// It's a downloadable and has not been downloaded yet
 				if !downloaded && material.Hash != nil && material.UploadedToCAS {
-					orgUUID, err := uuid.Parse(orgID)
-					if err != nil {
-						return fmt.Errorf("parsing org id: %w", err)
-					}
 					buf := bytes.NewBuffer(nil)
-					if err := d.casClient.Download(ctx, backendType, secretName, orgUUID, buf, material.Hash.String()); err != nil {
+					if err := d.casClient.Download(ctx, backend, buf, material.Hash.String()); err != nil {
 						return fmt.Errorf("downloading from CAS: %w", err)
 					}
 
@@ -49,6 +49,24 @@ var integrationInfoBuilder = func(b sdk.FanOut) *dispatchItem {
 	}
 }
 
+var testBackend = &biz.CASBackend{Provider: "backend-type", SecretName: "secret-name"}
+
+func TestDownloadBackend(t *testing.T) {
+	orgID := uuid.New()
+	delivery := &biz.IntegrationDelivery{OrgID: orgID, DownloadBackendType: "OCI", DownloadSecretName: "secret-name"}
+
+	t.Run("the backend of the run carries its replicas", func(t *testing.T) {
+		runBackend := &biz.CASBackend{Provider: "OCI", SecretName: "secret-name", Replicas: []*biz.CASBackend{{Provider: "AWS-S3", SecretName: "replica"}}}
+		wfRun := &biz.WorkflowRun{CASBackends: []*biz.CASBackend{{SecretName: "other"}, runBackend}}
+		assert.Same(t, runBackend, downloadBackend(delivery, wfRun))
+	})
+
+	t.Run("falls back to the backend stored in the delivery", func(t *testing.T) {
+		got := downloadBackend(delivery, &biz.WorkflowRun{})
+		assert.Equal(t, &biz.CASBackend{Provider: "OCI", SecretName: "secret-name", OrganizationID: orgID}, got)
+	})
+}
+
 func (s *dispatcherTestSuite) TestLoadInputsEnvelope() {
 	queue := dispatchQueue{integrationInfoBuilder(s.ociIntegrationBackend)}
 	envelope, err := testEnvelope("testdata/attestation.json")
@@ -58,7 +76,7 @@ func (s *dispatcherTestSuite) TestLoadInputsEnvelope() {
 	s.ociIntegrationBackend.(*mockedSDK.FanOut).On("IsSubscribedTo", "SBOM_CYCLONEDX_JSON").Return(false)
 	s.ociIntegrationBackend.(*mockedSDK.FanOut).On("String").Return("mocked-integration")
 
-	err = s.dispatcher.loadInputs(context.TODO(), queue, envelope, "backend-type", "secret-name", uuid.NewString())
+	err = s.dispatcher.loadInputs(context.TODO(), queue, envelope, testBackend)
 	assert.NoError(s.T(), err)
 
 	// Only one integration is registered
@@ -102,14 +120,14 @@ func (s *dispatcherTestSuite) TestLoadInputsMaterials() {
 	require.NoError(s.T(), err)
 
 	// Simulate SBOM download
-	s.casClient.On("Download", mock.Anything, "backend-type", "secret-name", mock.Anything, mock.Anything, mock.Anything).
+	s.casClient.On("Download", mock.Anything, testBackend, mock.Anything, mock.Anything).
 		Return(nil).Run(func(args mock.Arguments) {
 		buf := bytes.NewBuffer([]byte("SBOM Content"))
-		_, err := io.Copy(args.Get(4).(io.Writer), buf)
+		_, err := io.Copy(args.Get(2).(io.Writer), buf)
 		s.NoError(err)
 	})
 
-	err = s.dispatcher.loadInputs(context.TODO(), queue, envelope, "backend-type", "secret-name", uuid.NewString())
+	err = s.dispatcher.loadInputs(context.TODO(), queue, envelope, testBackend)
 	assert.NoError(s.T(), err)
 	require.Len(s.T(), queue, 3)
 
@@ -336,7 +354,7 @@ func (s *dispatcherTestSuite) SetupTest() {
 	l := log.NewStdLogger(io.Discard)
 
 	s.casClient = mocks.NewCASClient(s.T())
-	s.dispatcher = New(s.Integration, nil, nil, nil, s.casClient, registeredIntegrations, l)
+	s.dispatcher = New(s.Integration, nil, nil, nil, nil, s.casClient, registeredIntegrations, l)
 }
 
 func (s *dispatcherTestSuite) newMock(_ context.Context) *mockedSDK.FanOut {
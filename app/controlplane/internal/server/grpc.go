@@ -89,6 +89,9 @@ type Opts struct {
 	PrometheusSvc       *service.PrometheusService
 	GroupSvc            *service.GroupService
 	ProjectSvc          *service.ProjectService
+	PolicyEvaluationSvc *service.PolicyEvaluationService
+	PolicyExceptionSvc  *service.PolicyExceptionService
+	CASRetentionSvc     *service.CASRetentionService
 	// Utils
 	Logger              log.Logger
 	ServerConfig        *conf.Server
@@ -174,6 +177,9 @@ func NewGRPCServer(opts *Opts) (*grpc.Server, error) {
 	v1.RegisterSigningServiceServer(srv, opts.SigningSvc)
 	v1.RegisterGroupServiceServer(srv, opts.GroupSvc)
 	v1.RegisterProjectServiceServer(srv, opts.ProjectSvc)
+	v1.RegisterPolicyEvaluationServiceServer(srv, opts.PolicyEvaluationSvc)
+	v1.RegisterPolicyExceptionServiceServer(srv, opts.PolicyExceptionSvc)
+	v1.RegisterCASRetentionServiceServer(srv, opts.CASRetentionSvc)
 
 	// Register Prometheus metrics
 	grpc_prometheus.Register(srv.Server)
@@ -64,6 +64,7 @@ type AttestationService struct {
 	projectUseCase          *biz.ProjectUseCase
 	signingUseCase          *biz.SigningUseCase
 	userUseCase             *biz.UserUseCase
+	policyExceptionUseCase  *biz.PolicyExceptionUseCase
 	bootstrapConfig         *conf.Bootstrap
 	membershipsCache        cache.Cache[*entities.Membership]
 }
@@ -86,6 +87,7 @@ type NewAttestationServiceOpts struct {
 	ProjectVersionUC   *biz.ProjectVersionUseCase
 	SigningUseCase     *biz.SigningUseCase
 	UserUC             *biz.UserUseCase
+	PolicyExceptionUC  *biz.PolicyExceptionUseCase
 	BootstrapConfig    *conf.Bootstrap
 	MembershipsCache   cache.Cache[*entities.Membership]
 	Opts               []NewOpt
@@ -111,6 +113,7 @@ func NewAttestationService(opts *NewAttestationServiceOpts) *AttestationService
 		projectVersionUseCase:   opts.ProjectVersionUC,
 		signingUseCase:          opts.SigningUseCase,
 		userUseCase:             opts.UserUC,
+		policyExceptionUseCase:  opts.PolicyExceptionUC,
 		bootstrapConfig:         opts.BootstrapConfig,
 		membershipsCache:        opts.MembershipsCache,
 	}
@@ -367,6 +370,8 @@ func (s *AttestationService) storeAttestation(ctx context.Context, bundle []byte
 			return nil, handleUseCaseErr(err, s.log)
 		}
 
+		// Only the backend the content was uploaded to is recorded. Its replicas get the content mirrored
+		// in the background and might miss it, downloads go through them only when they hold the artifact.
 		for _, ref := range references {
 			s.log.Infow("msg", "creating CAS mapping", "name", ref.Name, "digest", ref.Digest, "project", wf.ProjectID.String(), "workflowRun", workflowRunID, "casBackend", casBackend.ID.String())
 			if _, err := s.casMappingUseCase.Create(ctx, ref.Digest, casBackend.ID.String(), &biz.CASMappingCreateOpts{
@@ -380,17 +385,17 @@ func (s *AttestationService) storeAttestation(ctx context.Context, bundle []byte
 
 	secretName := casBackend.SecretName
 
-	// Run integrations dispatcher
-	go func() {
-		if err := s.integrationDispatcher.Run(context.TODO(), &dispatcher.RunOpts{
-			Envelope: dsseEnv, OrgID: robotAccount.OrgID, WorkflowID: wf.ID.String(),
-			DownloadBackendType: string(casBackend.Provider),
-			DownloadSecretName:  secretName,
-			WorkflowRunID:       workflowRunID,
-		}); err != nil {
-			_ = handleUseCaseErr(err, s.log)
-		}
-	}()
+	// Enqueue the integration deliveries, they are executed asynchronously by the dispatcher workers.
+	// The request fails otherwise so the client retries it, enqueueing the same deliveries again is a no-op.
+	if err := s.integrationDispatcher.Run(ctx, &dispatcher.RunOpts{
+		Envelope: dsseEnv, AttestationDigest: digest.String(),
+		OrgID: robotAccount.OrgID, WorkflowID: wf.ID.String(),
+		DownloadBackendType: string(casBackend.Provider),
+		DownloadSecretName:  secretName,
+		WorkflowRunID:       workflowRunID,
+	}); err != nil {
+		return nil, handleUseCaseErr(fmt.Errorf("enqueueing integration deliveries: %w", err), s.log)
+	}
 
 	// promote release if the workflowRun is successful
 	if markAsReleased != nil && *markAsReleased {
@@ -497,8 +502,8 @@ func (s *AttestationService) GetUploadCreds(ctx context.Context, req *cpAPI.Atte
 	// Return the backend information and associated credentials (if applicable)
 	resp := &cpAPI.AttestationServiceGetUploadCredsResponse_Result{Backend: bizCASBackendToPb(backend)}
 	if backend.SecretName != "" {
-		ref := &biz.CASCredsOpts{BackendType: string(backend.Provider), SecretPath: backend.SecretName, Role: casJWT.Uploader, MaxBytes: backend.Limits.MaxBytes, OrgID: backend.OrganizationID}
-		t, err := s.casCredsUseCase.GenerateTemporaryCredentials(ref)
+		// the CAS mirrors the uploaded artifacts to the replicas of the backend
+		t, err := s.casCredsUseCase.GenerateTemporaryCredentials(biz.NewCASCredsOpts(backend, casJWT.Uploader))
 		if err != nil {
 			return nil, handleUseCaseErr(err, s.log)
 		}
@@ -553,6 +558,37 @@ func (s *AttestationService) GetPolicyGroup(ctx context.Context, req *cpAPI.Atte
 	}}, nil
 }
 
+// ListPolicyExceptions returns the exceptions the policy verifier needs to apply during the attestation of a run
+func (s *AttestationService) ListPolicyExceptions(ctx context.Context, req *cpAPI.AttestationServiceListPolicyExceptionsRequest) (*cpAPI.AttestationServiceListPolicyExceptionsResponse, error) {
+	robotAccount := usercontext.CurrentRobotAccount(ctx)
+	if robotAccount == nil {
+		return nil, errors.NotFound("not found", "robot account not found")
+	}
+
+	// This will make sure the provided workflowRunID belongs to the org encoded in the robot account
+	wf, err := s.findWorkflowFromTokenOrNameOrRunID(ctx, robotAccount.OrgID, "", "", req.GetWorkflowRunId())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	// Apply RBAC on the project
+	if _, err = s.userHasPermissionOnProject(ctx, robotAccount.OrgID, &cpAPI.IdentityReference{Name: &wf.Project}, authz.PolicyWorkflowRunCreate); err != nil {
+		return nil, err
+	}
+
+	exceptions, err := s.policyExceptionUseCase.FindActive(ctx, robotAccount.OrgID, wf)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*cpAPI.PolicyExceptionItem, 0, len(exceptions))
+	for _, e := range exceptions {
+		result = append(result, bizPolicyExceptionToPb(e))
+	}
+
+	return &cpAPI.AttestationServiceListPolicyExceptionsResponse{Result: result}, nil
+}
+
 func bizAttestationToPb(att *biz.Attestation, predicate chainloop.NormalizablePredicate) (*cpAPI.AttestationItem, error) {
 	if att == nil || att.Envelope == nil {
 		return nil, nil
@@ -602,9 +638,10 @@ func extractPolicyEvaluations(in map[string][]*chainloop.PolicyEvaluation) map[s
 			violations := make([]*cpAPI.PolicyViolation, 0, len(ev.Violations))
 			for _, vi := range ev.Violations {
 				out := &cpAPI.PolicyViolation{
-					Subject:  vi.Subject,
-					Message:  vi.Message,
-					Suppress: vi.Suppress,
+					Subject:     vi.Subject,
+					Message:     vi.Message,
+					Suppress:    vi.Suppress,
+					ExceptionId: vi.ExceptionID,
 				}
 				switch {
 				case vi.Vulnerability != nil:
@@ -613,6 +650,12 @@ func extractPolicyEvaluations(in map[string][]*chainloop.PolicyEvaluation) map[s
 					out.Finding = &cpAPI.PolicyViolation_Sast{Sast: vi.Sast}
 				case vi.LicenseViolation != nil:
 					out.Finding = &cpAPI.PolicyViolation_LicenseViolation{LicenseViolation: vi.LicenseViolation}
+				case vi.Secret != nil:
+					out.Finding = &cpAPI.PolicyViolation_Secret{Secret: vi.Secret}
+				case vi.Misconfiguration != nil:
+					out.Finding = &cpAPI.PolicyViolation_Misconfiguration{Misconfiguration: vi.Misconfiguration}
+				case vi.Malware != nil:
+					out.Finding = &cpAPI.PolicyViolation_Malware{Malware: vi.Malware}
 				}
 				violations = append(violations, out)
 			}
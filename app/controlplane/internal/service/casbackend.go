@@ -83,8 +83,17 @@ func (s *CASBackendService) Create(ctx context.Context, req *pb.CASBackendServic
 		return nil, errors.BadRequest("invalid config", "config is invalid")
 	}
 
+	// Some providers confine the backends of each organization and canonicalize their location
+	location := req.Location
+	if r, ok := backendP.(backend.LocationResolver); ok {
+		location, err = r.ResolveLocation(currentOrg.ID, req.Location)
+		if err != nil {
+			return nil, errors.BadRequest("invalid config", err.Error())
+		}
+	}
+
 	// Validate and extract the credentials so they can be stored in the next step
-	creds, err := backendP.ValidateAndExtractCredentials(req.Location, credsJSON)
+	creds, err := backendP.ValidateAndExtractCredentials(location, credsJSON)
 	if err != nil {
 		return nil, errors.BadRequest("invalid config", err.Error())
 	}
@@ -96,7 +105,7 @@ func (s *CASBackendService) Create(ctx context.Context, req *pb.CASBackendServic
 	}
 
 	// For now we only support one backend which is set as default
-	res, err := s.uc.Create(ctx, currentOrg.ID, req.Name, req.Location, req.Description, biz.CASBackendProvider(req.Provider), creds, req.Default, req.Fallback, maxBytes)
+	res, err := s.uc.Create(ctx, currentOrg.ID, req.Name, location, req.Description, biz.CASBackendProvider(req.Provider), creds, req.Default, req.Fallback, maxBytes)
 	if err != nil {
 		return nil, handleUseCaseErr(err, s.log)
 	}
@@ -142,12 +151,27 @@ func (s *CASBackendService) Update(ctx context.Context, req *pb.CASBackendServic
 		maxBytes = req.MaxBytes
 	}
 
+	// the backend must be a valid replica once updated, checked upfront so the update is not partially applied
+	if req.ReplicaOf != nil {
+		if err := s.uc.ValidateReplicaOf(ctx, currentOrg.ID, backend.ID.String(), req.GetReplicaOf(), req.Default, req.Fallback, maxBytes); err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		}
+	}
+
 	// For now we only support one backend which is set as default
 	res, err := s.uc.Update(ctx, currentOrg.ID, backend.ID.String(), req.Description, creds, req.Default, req.Fallback, maxBytes)
 	if err != nil {
 		return nil, handleUseCaseErr(err, s.log)
 	}
 
+	// Attach or detach the backend from its primary
+	if req.ReplicaOf != nil {
+		res, err = s.uc.SetReplicaOf(ctx, currentOrg.ID, backend.ID.String(), req.GetReplicaOf())
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		}
+	}
+
 	return &pb.CASBackendServiceUpdateResponse{Result: bizCASBackendToPb(res)}, nil
 }
 
@@ -242,6 +266,10 @@ func bizCASBackendToPb(in *biz.CASBackend) *pb.CASBackendItem {
 		r.ValidationError = in.ValidationError
 	}
 
+	if in.PrimaryID != nil {
+		r.PrimaryId = biz.ToPtr(in.PrimaryID.String())
+	}
+
 	return r
 }
 
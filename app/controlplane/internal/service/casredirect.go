@@ -110,8 +110,8 @@ func (s *CASRedirectService) GetDownloadURL(ctx context.Context, req *pb.GetDown
 		return nil, kerrors.NotFound("not found", "CAS backend is inline")
 	}
 
-	// check if the backend is on a valid state, if not return an error
-	if backend.ValidationStatus != biz.CASBackendValidationOK {
+	// check if the backend, or any of its replicas, is on a valid state, if not return an error
+	if backend.ReadLocations()[0].ValidationStatus != biz.CASBackendValidationOK {
 		return nil, pb.ErrorCasBackendErrorReasonInvalid("CAS Storage is in an invalid state and can't download artifacts, please fix it before attempting it again")
 	}
 
@@ -126,8 +126,8 @@ func (s *CASRedirectService) GetDownloadURL(ctx context.Context, req *pb.GetDown
 
 	// 2- add authentication token to the query params ?t=[token]
 	if backend.SecretName != "" {
-		ref := &biz.CASCredsOpts{BackendType: string(backend.Provider), SecretPath: backend.SecretName, Role: casJWT.Downloader, MaxBytes: backend.Limits.MaxBytes, OrgID: backend.OrganizationID}
-		t, err := s.casCredsUseCase.GenerateTemporaryCredentials(ref)
+		// the token targets the first healthy location, falling back to the rest
+		t, err := s.casCredsUseCase.GenerateTemporaryCredentials(biz.NewCASCredsOpts(backend, casJWT.Downloader))
 		if err != nil {
 			return nil, handleUseCaseErr(err, s.log)
 		}
@@ -0,0 +1,114 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CASRetentionService struct {
+	pb.UnimplementedCASRetentionServiceServer
+	*service
+
+	uc *biz.CASRetentionUseCase
+}
+
+func NewCASRetentionService(uc *biz.CASRetentionUseCase, opts ...NewOpt) *CASRetentionService {
+	return &CASRetentionService{
+		service: newService(opts...),
+		uc:      uc,
+	}
+}
+
+func (s *CASRetentionService) List(ctx context.Context, _ *pb.CASRetentionServiceListRequest) (*pb.CASRetentionServiceListResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.uc.List(ctx, currentOrg.ID)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	res := make([]*pb.CASRetentionRuleItem, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, bizCASRetentionRuleToPb(r))
+	}
+
+	return &pb.CASRetentionServiceListResponse{Result: res}, nil
+}
+
+func (s *CASRetentionService) Set(ctx context.Context, req *pb.CASRetentionServiceSetRequest) (*pb.CASRetentionServiceSetResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.uc.Set(ctx, currentOrg.ID, &biz.CASRetentionRuleSetOpts{
+		CASBackendName:       req.GetCasBackendName(),
+		MaxAgeDays:           int(req.GetMaxAgeDays()),
+		KeepReleasedVersions: req.GetKeepReleasedVersions(),
+	})
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.CASRetentionServiceSetResponse{Result: bizCASRetentionRuleToPb(rule)}, nil
+}
+
+func (s *CASRetentionService) Delete(ctx context.Context, req *pb.CASRetentionServiceDeleteRequest) (*pb.CASRetentionServiceDeleteResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.uc.Delete(ctx, currentOrg.ID, req.GetId()); err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.CASRetentionServiceDeleteResponse{}, nil
+}
+
+func bizCASRetentionRuleToPb(r *biz.CASRetentionRule) *pb.CASRetentionRuleItem {
+	item := &pb.CASRetentionRuleItem{
+		Id:                   r.ID.String(),
+		MaxAgeDays:           int32(r.MaxAgeDays),
+		KeepReleasedVersions: r.KeepReleasedVersions,
+	}
+
+	if r.CASBackend != nil {
+		item.CasBackendName = r.CASBackend.Name
+	}
+
+	if r.CreatedAt != nil {
+		item.CreatedAt = timestamppb.New(*r.CreatedAt)
+	}
+
+	if r.UpdatedAt != nil {
+		item.UpdatedAt = timestamppb.New(*r.UpdatedAt)
+	}
+
+	if r.LastSweptAt != nil {
+		item.LastSweptAt = timestamppb.New(*r.LastSweptAt)
+	}
+
+	return item
+}
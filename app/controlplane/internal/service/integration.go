@@ -25,6 +25,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/plugins/sdk/v1"
 	errors "github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -33,14 +34,16 @@ type IntegrationsService struct {
 	*service
 
 	integrationUC *biz.IntegrationUseCase
+	deliveryUC    *biz.IntegrationDeliveryUseCase
 	workflowUC    *biz.WorkflowUseCase
 	integrations  sdk.AvailablePlugins
 }
 
-func NewIntegrationsService(uc *biz.IntegrationUseCase, wuc *biz.WorkflowUseCase, integrations sdk.AvailablePlugins, opts ...NewOpt) *IntegrationsService {
+func NewIntegrationsService(uc *biz.IntegrationUseCase, duc *biz.IntegrationDeliveryUseCase, wuc *biz.WorkflowUseCase, integrations sdk.AvailablePlugins, opts ...NewOpt) *IntegrationsService {
 	return &IntegrationsService{
 		service:       newService(opts...),
 		integrationUC: uc,
+		deliveryUC:    duc,
 		workflowUC:    wuc,
 		integrations:  integrations,
 	}
@@ -263,40 +266,189 @@ func (s *IntegrationsService) Detach(ctx context.Context, req *pb.IntegrationsSe
 		return nil, err
 	}
 
-	// find the project it belongs to
-	orgID, err := uuid.Parse(org.ID)
-	if err != nil {
-		return nil, errors.BadRequest("bad request", "invalid organization")
+	// Apply RBAC
+	if err := s.authorizeAttachment(ctx, org.ID, req.Id, authz.PolicyAttachedIntegrationDetach); err != nil {
+		return nil, err
+	}
+
+	if err := s.integrationUC.Detach(ctx, org.ID, req.Id); err != nil {
+		if biz.IsNotFound(err) {
+			return nil, errors.NotFound("not found", err.Error())
+		}
+
+		return nil, handleUseCaseErr(err, s.log)
 	}
-	attID, err := uuid.Parse(req.Id)
+
+	return &pb.IntegrationsServiceDetachResponse{}, nil
+}
+
+func (s *IntegrationsService) ListDeliveries(ctx context.Context, req *pb.IntegrationsServiceListDeliveriesRequest) (*pb.IntegrationsServiceListDeliveriesResponse, error) {
+	org, err := requireCurrentOrg(ctx)
 	if err != nil {
-		return nil, errors.BadRequest("bad request", "invalid integration attachment")
+		return nil, err
 	}
 
-	att, err := s.integrationUC.GetAttachment(ctx, orgID, attID)
+	// Apply RBAC
+	if err := s.authorizeAttachment(ctx, org.ID, req.AttachmentId, authz.PolicyIntegrationDeliveryList); err != nil {
+		return nil, err
+	}
+
+	// Initialize the pagination options, with default values
+	paginationOpts, err := initializePaginationOpts(req.GetPagination())
 	if err != nil {
 		return nil, handleUseCaseErr(err, s.log)
 	}
 
-	wf, err := s.workflowUC.FindByIDInOrg(ctx, org.ID, att.WorkflowID.String())
+	filters := &biz.IntegrationDeliveryListFilters{}
+	if req.WorkflowRunId != "" {
+		runID, err := uuid.Parse(req.WorkflowRunId)
+		if err != nil {
+			return nil, errors.BadRequest("bad request", "invalid workflow run ID")
+		}
+		filters.WorkflowRunID = &runID
+	}
+
+	switch req.Status {
+	case pb.IntegrationDeliveryItem_STATUS_PENDING:
+		filters.Status = biz.ToPtr(biz.IntegrationDeliveryStatusPending)
+	case pb.IntegrationDeliveryItem_STATUS_SUCCEEDED:
+		filters.Status = biz.ToPtr(biz.IntegrationDeliveryStatusSucceeded)
+	case pb.IntegrationDeliveryItem_STATUS_DEAD_LETTER:
+		filters.Status = biz.ToPtr(biz.IntegrationDeliveryStatusDeadLetter)
+	}
+
+	deliveries, total, err := s.deliveryUC.List(ctx, org.ID, req.AttachmentId, filters, paginationOpts)
 	if err != nil {
 		return nil, handleUseCaseErr(err, s.log)
 	}
 
+	result := make([]*pb.IntegrationDeliveryItem, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, bizIntegrationDeliveryToPb(d))
+	}
+
+	return &pb.IntegrationsServiceListDeliveriesResponse{
+		Result:     result,
+		Pagination: paginationToPb(total, paginationOpts.Offset(), paginationOpts.Limit()),
+	}, nil
+}
+
+func (s *IntegrationsService) ListFailedDeliveries(ctx context.Context, req *pb.IntegrationsServiceListFailedDeliveriesRequest) (*pb.IntegrationsServiceListFailedDeliveriesResponse, error) {
+	org, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Apply RBAC
-	if err = s.authorizeResource(ctx, authz.PolicyAttachedIntegrationDetach, authz.ResourceTypeProject, wf.ProjectID); err != nil {
+	if err := s.authorizeAttachment(ctx, org.ID, req.AttachmentId, authz.PolicyIntegrationDeliveryList); err != nil {
+		return nil, err
+	}
+
+	// Initialize the pagination options, with default values
+	paginationOpts, err := initializePaginationOpts(req.GetPagination())
+	if err != nil {
 		return nil, handleUseCaseErr(err, s.log)
 	}
 
-	if err := s.integrationUC.Detach(ctx, org.ID, req.Id); err != nil {
-		if biz.IsNotFound(err) {
-			return nil, errors.NotFound("not found", err.Error())
-		}
+	deliveries, total, err := s.deliveryUC.ListFailed(ctx, org.ID, req.AttachmentId, paginationOpts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*pb.IntegrationDeliveryItem, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, bizIntegrationDeliveryToPb(d))
+	}
+
+	return &pb.IntegrationsServiceListFailedDeliveriesResponse{
+		Result:     result,
+		Pagination: paginationToPb(total, paginationOpts.Offset(), paginationOpts.Limit()),
+	}, nil
+}
+
+func (s *IntegrationsService) ReplayDeliveries(ctx context.Context, req *pb.IntegrationsServiceReplayDeliveriesRequest) (*pb.IntegrationsServiceReplayDeliveriesResponse, error) {
+	org, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	// Apply RBAC
+	if err := s.authorizeAttachment(ctx, org.ID, req.AttachmentId, authz.PolicyIntegrationDeliveryReplay); err != nil {
+		return nil, err
+	}
+
+	replayed, err := s.deliveryUC.Replay(ctx, org.ID, req.AttachmentId, req.DeliveryId)
+	if err != nil {
 		return nil, handleUseCaseErr(err, s.log)
 	}
 
-	return &pb.IntegrationsServiceDetachResponse{}, nil
+	return &pb.IntegrationsServiceReplayDeliveriesResponse{Replayed: int32(replayed)}, nil
+}
+
+// authorizeAttachment checks the policy against the project of the workflow the integration is attached to
+func (s *IntegrationsService) authorizeAttachment(ctx context.Context, orgID, attachmentID string, policy *authz.Policy) error {
+	// find the project it belongs to
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return errors.BadRequest("bad request", "invalid organization")
+	}
+	attID, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return errors.BadRequest("bad request", "invalid integration attachment")
+	}
+
+	att, err := s.integrationUC.GetAttachment(ctx, orgUUID, attID)
+	if err != nil {
+		return handleUseCaseErr(err, s.log)
+	}
+
+	wf, err := s.workflowUC.FindByIDInOrg(ctx, orgID, att.WorkflowID.String())
+	if err != nil {
+		return handleUseCaseErr(err, s.log)
+	}
+
+	if err = s.authorizeResource(ctx, policy, authz.ResourceTypeProject, wf.ProjectID); err != nil {
+		return handleUseCaseErr(err, s.log)
+	}
+
+	return nil
+}
+
+func bizIntegrationDeliveryToPb(d *biz.IntegrationDelivery) *pb.IntegrationDeliveryItem {
+	item := &pb.IntegrationDeliveryItem{
+		Id:            d.ID.String(),
+		AttachmentId:  d.AttachmentID.String(),
+		WorkflowRunId: d.WorkflowRunID.String(),
+		Attempts:      int32(d.Attempts),
+		LastError:     d.LastError,
+	}
+
+	if d.Duration > 0 {
+		item.Duration = durationpb.New(d.Duration)
+	}
+
+	switch d.Status {
+	case biz.IntegrationDeliveryStatusPending:
+		item.Status = pb.IntegrationDeliveryItem_STATUS_PENDING
+	case biz.IntegrationDeliveryStatusSucceeded:
+		item.Status = pb.IntegrationDeliveryItem_STATUS_SUCCEEDED
+	case biz.IntegrationDeliveryStatusDeadLetter:
+		item.Status = pb.IntegrationDeliveryItem_STATUS_DEAD_LETTER
+	}
+
+	if d.CreatedAt != nil {
+		item.CreatedAt = timestamppb.New(*d.CreatedAt)
+	}
+
+	if d.UpdatedAt != nil {
+		item.UpdatedAt = timestamppb.New(*d.UpdatedAt)
+	}
+
+	if d.FinishedAt != nil {
+		item.FinishedAt = timestamppb.New(*d.FinishedAt)
+	}
+
+	return item
 }
 
 func bizIntegrationToPb(e *biz.Integration) *pb.RegisteredIntegrationItem {
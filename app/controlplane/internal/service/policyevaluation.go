@@ -0,0 +1,320 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"slices"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/usercontext/entities"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PolicyEvaluationService struct {
+	pb.UnimplementedPolicyEvaluationServiceServer
+	*service
+
+	policyEvaluationUC *biz.PolicyEvaluationUseCase
+	policyImpactUC     *biz.PolicyImpactUseCase
+	workflowUC         *biz.WorkflowUseCase
+	projectUC          *biz.ProjectUseCase
+}
+
+func NewPolicyEvaluationService(uc *biz.PolicyEvaluationUseCase, impactUC *biz.PolicyImpactUseCase, wfUC *biz.WorkflowUseCase, projectUC *biz.ProjectUseCase, opts ...NewOpt) *PolicyEvaluationService {
+	return &PolicyEvaluationService{
+		service:            newService(opts...),
+		policyEvaluationUC: uc,
+		policyImpactUC:     impactUC,
+		workflowUC:         wfUC,
+		projectUC:          projectUC,
+	}
+}
+
+func (s *PolicyEvaluationService) List(ctx context.Context, req *pb.PolicyEvaluationServiceListRequest) (*pb.PolicyEvaluationServiceListResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply RBAC if needed
+	filters := &biz.PolicyEvaluationListFilters{
+		ProjectIDs:   s.visibleProjects(ctx),
+		PolicyName:   req.GetPolicyName(),
+		MaterialName: req.GetMaterialName(),
+		Status:       pbPolicyEvaluationStatusToBiz(req.GetStatus()),
+		LatestOnly:   req.GetLatestOnly(),
+		FindingType:  biz.PolicyFindingType(req.GetFindingType()),
+		Severity:     req.GetSeverity(),
+		ExternalID:   req.GetExternalId(),
+	}
+
+	// by workflow and project name
+	if req.GetWorkflowName() != "" && req.GetProjectName() != "" {
+		wf, err := s.workflowUC.FindByNameInOrg(ctx, currentOrg.ID, req.GetProjectName(), req.GetWorkflowName())
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		} else if wf == nil {
+			return nil, errors.NotFound("not found", "workflow not found")
+		}
+
+		filters.ProjectID = &wf.ProjectID
+		filters.WorkflowID = &wf.ID
+	} else if req.GetProjectName() != "" {
+		project, err := s.projectUC.FindProjectByReference(ctx, currentOrg.ID, &biz.IdentityReference{Name: biz.ToPtr(req.GetProjectName())})
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		} else if project == nil {
+			return nil, errors.NotFound("not found", "project not found")
+		}
+
+		filters.ProjectID = &project.ID
+	}
+
+	if req.CreatedAfter != nil {
+		filters.CreatedAfter = biz.ToPtr(req.GetCreatedAfter().AsTime())
+	}
+
+	if req.CreatedBefore != nil {
+		filters.CreatedBefore = biz.ToPtr(req.GetCreatedBefore().AsTime())
+	}
+
+	p := req.GetPagination()
+	paginationOpts, err := pagination.NewCursor(p.GetCursor(), int(p.GetLimit()))
+	if err != nil {
+		return nil, errors.InternalServer("invalid", "invalid pagination options")
+	}
+
+	evaluations, nextCursor, err := s.policyEvaluationUC.List(ctx, currentOrg.ID, filters, paginationOpts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*pb.PolicyEvaluationItem, 0, len(evaluations))
+	for _, e := range evaluations {
+		result = append(result, bizPolicyEvaluationToPb(e))
+	}
+
+	return &pb.PolicyEvaluationServiceListResponse{Result: result, Pagination: bizCursorToPb(nextCursor)}, nil
+}
+
+// Impact starts re-evaluating a policy or a group against the attestations of the runs visible to the user
+func (s *PolicyEvaluationService) Impact(ctx context.Context, req *pb.PolicyEvaluationServiceImpactRequest) (*pb.PolicyEvaluationServiceImpactResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// we need this token to forward it to the policy providers
+	token, err := entities.GetRawToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &biz.PolicyImpactOpts{
+		OrgID:        currentOrg.ID,
+		OrgName:      currentOrg.Name,
+		Token:        token,
+		PolicyRef:    req.GetPolicyRef(),
+		GroupRef:     req.GetGroupRef(),
+		With:         req.GetWith(),
+		ProjectIDs:   s.visibleProjects(ctx),
+		VersionRange: req.GetVersionRange(),
+		Limit:        int(req.GetLimit()),
+	}
+
+	if req.GetProjectName() != "" {
+		project, err := s.projectUC.FindProjectByReference(ctx, currentOrg.ID, &biz.IdentityReference{Name: biz.ToPtr(req.GetProjectName())})
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		} else if project == nil || (opts.ProjectIDs != nil && !slices.Contains(opts.ProjectIDs, project.ID)) {
+			return nil, errors.NotFound("not found", "project not found")
+		}
+
+		opts.ProjectIDs = []uuid.UUID{project.ID}
+	}
+
+	if req.CreatedAfter != nil {
+		opts.CreatedAfter = biz.ToPtr(req.GetCreatedAfter().AsTime())
+	}
+
+	if req.CreatedBefore != nil {
+		opts.CreatedBefore = biz.ToPtr(req.GetCreatedBefore().AsTime())
+	}
+
+	analysis, err := s.policyImpactUC.Start(ctx, opts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.PolicyEvaluationServiceImpactResponse{Result: bizPolicyImpactAnalysisToPb(analysis)}, nil
+}
+
+// ImpactReport returns the status of an impact analysis, and its report once it succeeds
+func (s *PolicyEvaluationService) ImpactReport(ctx context.Context, req *pb.PolicyEvaluationServiceImpactReportRequest) (*pb.PolicyEvaluationServiceImpactReportResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, errors.BadRequest("invalid", "invalid analysis ID")
+	}
+
+	analysis, err := s.policyImpactUC.FindByID(ctx, currentOrg.ID, id)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.PolicyEvaluationServiceImpactReportResponse{Result: bizPolicyImpactAnalysisToPb(analysis)}, nil
+}
+
+func bizPolicyImpactAnalysisToPb(a *biz.PolicyImpactAnalysis) *pb.PolicyImpactAnalysis {
+	res := &pb.PolicyImpactAnalysis{Id: a.ID.String(), Error: a.Error}
+
+	switch a.Status {
+	case biz.PolicyImpactAnalysisRunning:
+		res.Status = pb.PolicyImpactAnalysis_STATUS_RUNNING
+	case biz.PolicyImpactAnalysisSucceeded:
+		res.Status = pb.PolicyImpactAnalysis_STATUS_SUCCEEDED
+	case biz.PolicyImpactAnalysisFailed:
+		res.Status = pb.PolicyImpactAnalysis_STATUS_FAILED
+	}
+
+	if a.CreatedAt != nil {
+		res.CreatedAt = timestamppb.New(*a.CreatedAt)
+	}
+
+	if a.FinishedAt != nil {
+		res.FinishedAt = timestamppb.New(*a.FinishedAt)
+	}
+
+	if report := a.Report; report != nil {
+		res.EvaluatedCount = int32(report.EvaluatedCount)
+		for _, r := range report.NewlyFailing {
+			res.NewlyFailing = append(res.NewlyFailing, bizPolicyImpactResultToPb(r))
+		}
+
+		for _, r := range report.Errored {
+			res.Errored = append(res.Errored, bizPolicyImpactResultToPb(r))
+		}
+	}
+
+	return res
+}
+
+func bizPolicyImpactResultToPb(r *biz.PolicyImpactResult) *pb.PolicyImpactItem {
+	item := &pb.PolicyImpactItem{WorkflowRunId: r.WorkflowRunID.String(), ProjectVersion: r.ProjectVersion, Error: r.Error}
+
+	if r.CreatedAt != nil {
+		item.CreatedAt = timestamppb.New(*r.CreatedAt)
+	}
+
+	if r.WorkflowID != uuid.Nil {
+		item.Workflow = &pb.WorkflowRef{Id: r.WorkflowID.String(), Name: r.WorkflowName, ProjectName: r.ProjectName}
+	}
+
+	for _, v := range r.Violations {
+		item.Violations = append(item.Violations, &pb.PolicyImpactViolation{PolicyName: v.PolicyName, MaterialName: v.MaterialName, Message: v.Message})
+	}
+
+	return item
+}
+
+func bizPolicyEvaluationToPb(e *biz.PolicyEvaluation) *pb.PolicyEvaluationItem {
+	item := &pb.PolicyEvaluationItem{
+		Id:              e.ID.String(),
+		WorkflowRunId:   e.WorkflowRunID.String(),
+		Name:            e.Name,
+		MaterialName:    e.MaterialName,
+		MaterialType:    e.MaterialType,
+		PolicyUri:       e.PolicyURI,
+		PolicyDigest:    e.PolicyDigest,
+		GroupName:       e.GroupName,
+		Status:          bizPolicyEvaluationStatusToPb(e.Status),
+		SkipReasons:     e.SkipReasons,
+		Gate:            e.Gate,
+		ViolationsCount: int32(e.ViolationsCount),
+		Latest:          e.Latest,
+	}
+
+	if e.CreatedAt != nil {
+		item.CreatedAt = timestamppb.New(*e.CreatedAt)
+	}
+
+	if e.Workflow != nil {
+		item.Workflow = bizWorkflowRefToPb(e.Workflow)
+	}
+
+	for _, v := range e.Violations {
+		out := &pb.PolicyViolation{
+			Subject:     v.Subject,
+			Message:     v.Message,
+			Suppress:    v.Suppressed,
+			ExceptionId: v.ExceptionID,
+		}
+
+		switch {
+		case v.Vulnerability != nil:
+			out.Finding = &pb.PolicyViolation_Vulnerability{Vulnerability: v.Vulnerability}
+		case v.Sast != nil:
+			out.Finding = &pb.PolicyViolation_Sast{Sast: v.Sast}
+		case v.LicenseViolation != nil:
+			out.Finding = &pb.PolicyViolation_LicenseViolation{LicenseViolation: v.LicenseViolation}
+		case v.Secret != nil:
+			out.Finding = &pb.PolicyViolation_Secret{Secret: v.Secret}
+		case v.Misconfiguration != nil:
+			out.Finding = &pb.PolicyViolation_Misconfiguration{Misconfiguration: v.Misconfiguration}
+		case v.Malware != nil:
+			out.Finding = &pb.PolicyViolation_Malware{Malware: v.Malware}
+		}
+
+		item.Violations = append(item.Violations, out)
+	}
+
+	return item
+}
+
+func bizPolicyEvaluationStatusToPb(s biz.PolicyEvaluationStatus) pb.PolicyEvaluationItem_Status {
+	switch s {
+	case biz.PolicyEvaluationPassed:
+		return pb.PolicyEvaluationItem_STATUS_PASSED
+	case biz.PolicyEvaluationFailed:
+		return pb.PolicyEvaluationItem_STATUS_FAILED
+	case biz.PolicyEvaluationSkipped:
+		return pb.PolicyEvaluationItem_STATUS_SKIPPED
+	}
+
+	return pb.PolicyEvaluationItem_STATUS_UNSPECIFIED
+}
+
+func pbPolicyEvaluationStatusToBiz(s pb.PolicyEvaluationItem_Status) biz.PolicyEvaluationStatus {
+	switch s {
+	case pb.PolicyEvaluationItem_STATUS_PASSED:
+		return biz.PolicyEvaluationPassed
+	case pb.PolicyEvaluationItem_STATUS_FAILED:
+		return biz.PolicyEvaluationFailed
+	case pb.PolicyEvaluationItem_STATUS_SKIPPED:
+		return biz.PolicyEvaluationSkipped
+	}
+
+	return ""
+}
@@ -0,0 +1,228 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PolicyExceptionService struct {
+	pb.UnimplementedPolicyExceptionServiceServer
+	*service
+
+	policyExceptionUC *biz.PolicyExceptionUseCase
+	workflowUC        *biz.WorkflowUseCase
+	projectUC         *biz.ProjectUseCase
+}
+
+func NewPolicyExceptionService(uc *biz.PolicyExceptionUseCase, wfUC *biz.WorkflowUseCase, projectUC *biz.ProjectUseCase, opts ...NewOpt) *PolicyExceptionService {
+	return &PolicyExceptionService{
+		service:           newService(opts...),
+		policyExceptionUC: uc,
+		workflowUC:        wfUC,
+		projectUC:         projectUC,
+	}
+}
+
+func (s *PolicyExceptionService) Create(ctx context.Context, req *pb.PolicyExceptionServiceCreateRequest) (*pb.PolicyExceptionServiceCreateResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentUser, err := requireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID, err := uuid.Parse(currentOrg.ID)
+	if err != nil {
+		return nil, errors.BadRequest("invalid", "invalid organization ID")
+	}
+
+	opts := &biz.PolicyExceptionCreateOpts{
+		OrgID:         orgID,
+		PolicyName:    req.GetPolicyName(),
+		MaterialName:  req.GetMaterialName(),
+		ExternalID:    req.GetExternalId(),
+		Justification: req.GetJustification(),
+		CreatedBy:     currentUser.Email,
+		ExpiresAt:     req.GetExpiresAt().AsTime(),
+	}
+
+	opts.ProjectID, opts.WorkflowID, err = s.resolveScope(ctx, currentOrg.ID, req.GetProjectName(), req.GetWorkflowName())
+	if err != nil {
+		return nil, err
+	}
+
+	exception, err := s.policyExceptionUC.Create(ctx, opts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.PolicyExceptionServiceCreateResponse{Result: bizPolicyExceptionToPb(exception)}, nil
+}
+
+func (s *PolicyExceptionService) Approve(ctx context.Context, req *pb.PolicyExceptionServiceApproveRequest) (*pb.PolicyExceptionServiceApproveResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	currentUser, err := requireCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exception, err := s.policyExceptionUC.Approve(ctx, currentOrg.ID, req.GetId(), currentUser.Email)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.PolicyExceptionServiceApproveResponse{Result: bizPolicyExceptionToPb(exception)}, nil
+}
+
+func (s *PolicyExceptionService) Revoke(ctx context.Context, req *pb.PolicyExceptionServiceRevokeRequest) (*pb.PolicyExceptionServiceRevokeResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.policyExceptionUC.Revoke(ctx, currentOrg.ID, req.GetId()); err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.PolicyExceptionServiceRevokeResponse{}, nil
+}
+
+func (s *PolicyExceptionService) List(ctx context.Context, req *pb.PolicyExceptionServiceListRequest) (*pb.PolicyExceptionServiceListResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &biz.PolicyExceptionListFilters{Status: pbPolicyExceptionStatusToBiz(req.GetStatus())}
+	filters.ProjectID, _, err = s.resolveScope(ctx, currentOrg.ID, req.GetProjectName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	exceptions, err := s.policyExceptionUC.List(ctx, currentOrg.ID, filters)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*pb.PolicyExceptionItem, 0, len(exceptions))
+	for _, e := range exceptions {
+		result = append(result, bizPolicyExceptionToPb(e))
+	}
+
+	return &pb.PolicyExceptionServiceListResponse{Result: result}, nil
+}
+
+// resolveScope returns the IDs of the project and workflow referenced by name, if any
+func (s *PolicyExceptionService) resolveScope(ctx context.Context, orgID, projectName, workflowName string) (*uuid.UUID, *uuid.UUID, error) {
+	if workflowName != "" {
+		wf, err := s.workflowUC.FindByNameInOrg(ctx, orgID, projectName, workflowName)
+		if err != nil {
+			return nil, nil, handleUseCaseErr(err, s.log)
+		} else if wf == nil {
+			return nil, nil, errors.NotFound("not found", "workflow not found")
+		}
+
+		return &wf.ProjectID, &wf.ID, nil
+	}
+
+	if projectName != "" {
+		project, err := s.projectUC.FindProjectByReference(ctx, orgID, &biz.IdentityReference{Name: biz.ToPtr(projectName)})
+		if err != nil {
+			return nil, nil, handleUseCaseErr(err, s.log)
+		} else if project == nil {
+			return nil, nil, errors.NotFound("not found", "project not found")
+		}
+
+		return &project.ID, nil, nil
+	}
+
+	return nil, nil, nil
+}
+
+func bizPolicyExceptionToPb(e *biz.PolicyException) *pb.PolicyExceptionItem {
+	item := &pb.PolicyExceptionItem{
+		Id:            e.ID.String(),
+		ProjectName:   e.ProjectName,
+		WorkflowName:  e.WorkflowName,
+		PolicyName:    e.PolicyName,
+		MaterialName:  e.MaterialName,
+		ExternalId:    e.ExternalID,
+		Justification: e.Justification,
+		CreatedBy:     e.CreatedBy,
+		ApprovedBy:    e.ApprovedBy,
+		ExpiresAt:     timestamppb.New(e.ExpiresAt),
+		Status:        bizPolicyExceptionStatusToPb(e.Status(time.Now())),
+	}
+
+	if e.CreatedAt != nil {
+		item.CreatedAt = timestamppb.New(*e.CreatedAt)
+	}
+
+	if e.ApprovedAt != nil {
+		item.ApprovedAt = timestamppb.New(*e.ApprovedAt)
+	}
+
+	if e.RevokedAt != nil {
+		item.RevokedAt = timestamppb.New(*e.RevokedAt)
+	}
+
+	return item
+}
+
+func bizPolicyExceptionStatusToPb(s biz.PolicyExceptionStatus) pb.PolicyExceptionItem_Status {
+	switch s {
+	case biz.PolicyExceptionPending:
+		return pb.PolicyExceptionItem_STATUS_PENDING
+	case biz.PolicyExceptionActive:
+		return pb.PolicyExceptionItem_STATUS_ACTIVE
+	case biz.PolicyExceptionExpired:
+		return pb.PolicyExceptionItem_STATUS_EXPIRED
+	case biz.PolicyExceptionRevoked:
+		return pb.PolicyExceptionItem_STATUS_REVOKED
+	}
+
+	return pb.PolicyExceptionItem_STATUS_UNSPECIFIED
+}
+
+func pbPolicyExceptionStatusToBiz(s pb.PolicyExceptionItem_Status) biz.PolicyExceptionStatus {
+	switch s {
+	case pb.PolicyExceptionItem_STATUS_PENDING:
+		return biz.PolicyExceptionPending
+	case pb.PolicyExceptionItem_STATUS_ACTIVE:
+		return biz.PolicyExceptionActive
+	case pb.PolicyExceptionItem_STATUS_EXPIRED:
+		return biz.PolicyExceptionExpired
+	case pb.PolicyExceptionItem_STATUS_REVOKED:
+		return biz.PolicyExceptionRevoked
+	}
+
+	return ""
+}
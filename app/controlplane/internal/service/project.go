@@ -22,6 +22,7 @@ import (
 	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/authz"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/usercontext/entities"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/google/uuid"
@@ -31,14 +32,232 @@ import (
 type ProjectService struct {
 	pb.UnimplementedProjectServiceServer
 	*service
+
+	projectVersionUseCase *biz.ProjectVersionUseCase
 }
 
-func NewProjectService(opts ...NewOpt) *ProjectService {
+func NewProjectService(projectVersionUC *biz.ProjectVersionUseCase, opts ...NewOpt) *ProjectService {
 	return &ProjectService{
-		service: newService(opts...),
+		service:               newService(opts...),
+		projectVersionUseCase: projectVersionUC,
 	}
 }
 
+// Create creates a new project in the current organization.
+func (s *ProjectService) Create(ctx context.Context, req *pb.ProjectServiceCreateRequest) (*pb.ProjectServiceCreateResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userCanCreateProject(ctx); err != nil {
+		return nil, err
+	}
+
+	var opts []biz.ProjectCreateOpt
+	// add current user as the owner of the project
+	if user := entities.CurrentUser(ctx); user != nil {
+		userID, err := uuid.Parse(user.ID)
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		}
+		opts = append(opts, biz.WithProjectOwner(userID))
+	}
+
+	p, err := s.projectUseCase.Create(ctx, currentOrg.ID, req.GetName(), opts...)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.ProjectServiceCreateResponse{Result: bizProjectToPb(p)}, nil
+}
+
+// List lists the projects the current user has access to.
+func (s *ProjectService) List(ctx context.Context, req *pb.ProjectServiceListRequest) (*pb.ProjectServiceListResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the pagination options, with default values
+	paginationOpts, err := initializePaginationOpts(req.GetPagination())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	projects, total, err := s.projectUseCase.List(ctx, currentOrg.ID, s.visibleProjects(ctx), paginationOpts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*pb.ProjectItem, 0, len(projects))
+	for _, p := range projects {
+		result = append(result, bizProjectToPb(p))
+	}
+
+	return &pb.ProjectServiceListResponse{
+		Result:     result,
+		Pagination: paginationToPb(total, paginationOpts.Offset(), paginationOpts.Limit()),
+	}, nil
+}
+
+// Describe returns a project along with its latest version.
+func (s *ProjectService) Describe(ctx context.Context, req *pb.ProjectServiceDescribeRequest) (*pb.ProjectServiceDescribeResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectRead)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ProjectServiceDescribeResponse{Result: bizProjectToPb(p)}
+
+	// The project might not have any version yet
+	latest, err := s.projectVersionUseCase.FindLatestByProject(ctx, p.ID.String())
+	if err != nil && !biz.IsNotFound(err) {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+	res.LatestVersion = bizProjectVersionToPb(latest)
+
+	return res, nil
+}
+
+// Delete deletes a project. The project must not contain any workflow.
+func (s *ProjectService) Delete(ctx context.Context, req *pb.ProjectServiceDeleteRequest) (*pb.ProjectServiceDeleteResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.projectUseCase.Delete(ctx, currentOrg.ID, &biz.IdentityReference{ID: &p.ID}); err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.ProjectServiceDeleteResponse{}, nil
+}
+
+// ListVersions lists the versions of a project, most recent first.
+func (s *ProjectService) ListVersions(ctx context.Context, req *pb.ProjectServiceListVersionsRequest) (*pb.ProjectServiceListVersionsResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectVersionList)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the pagination options, with default values
+	paginationOpts, err := initializePaginationOpts(req.GetPagination())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	versions, total, err := s.projectVersionUseCase.List(ctx, p.ID.String(), paginationOpts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*pb.ProjectVersion, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, bizProjectVersionToPb(v))
+	}
+
+	return &pb.ProjectServiceListVersionsResponse{
+		Result:     result,
+		Pagination: paginationToPb(total, paginationOpts.Offset(), paginationOpts.Limit()),
+	}, nil
+}
+
+// DescribeVersion returns a specific version of a project.
+func (s *ProjectService) DescribeVersion(ctx context.Context, req *pb.ProjectServiceDescribeVersionRequest) (*pb.ProjectServiceDescribeVersionResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectVersionRead)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.projectVersionUseCase.FindByProjectAndVersion(ctx, p.ID.String(), req.GetVersion())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.ProjectServiceDescribeVersionResponse{Result: bizProjectVersionToPb(v)}, nil
+}
+
+// CreateVersion creates a new version in a project. The new version becomes the latest one.
+func (s *ProjectService) CreateVersion(ctx context.Context, req *pb.ProjectServiceCreateVersionRequest) (*pb.ProjectServiceCreateVersionResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectVersionCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.projectVersionUseCase.CreateInProject(ctx, p, req.GetVersion(), !req.GetReleased())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.ProjectServiceCreateVersionResponse{Result: bizProjectVersionToPb(v)}, nil
+}
+
+// PromoteVersion marks a pre-release version as released.
+func (s *ProjectService) PromoteVersion(ctx context.Context, req *pb.ProjectServicePromoteVersionRequest) (*pb.ProjectServicePromoteVersionResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectVersionUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.projectVersionUseCase.Release(ctx, p, req.GetVersion())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.ProjectServicePromoteVersionResponse{Result: bizProjectVersionToPb(v)}, nil
+}
+
+// UnreleaseVersion moves a released version back to pre-release.
+func (s *ProjectService) UnreleaseVersion(ctx context.Context, req *pb.ProjectServiceUnreleaseVersionRequest) (*pb.ProjectServiceUnreleaseVersionResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := s.userHasPermissionOnProject(ctx, currentOrg.ID, req.GetProjectReference(), authz.PolicyProjectVersionUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.projectVersionUseCase.Unrelease(ctx, p, req.GetVersion())
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	return &pb.ProjectServiceUnreleaseVersionResponse{Result: bizProjectVersionToPb(v)}, nil
+}
+
 // ListMembers lists the members of a project.
 func (s *ProjectService) ListMembers(ctx context.Context, req *pb.ProjectServiceListMembersRequest) (*pb.ProjectServiceListMembersResponse, error) {
 	currentOrg, err := requireCurrentOrg(ctx)
@@ -380,6 +599,24 @@ func mapProjectMemberRoleToAuthzRole(role pb.ProjectMemberRole) authz.Role {
 	}
 }
 
+// bizProjectToPb converts a biz.Project to a pb.ProjectItem
+func bizProjectToPb(p *biz.Project) *pb.ProjectItem {
+	item := &pb.ProjectItem{
+		Id:   p.ID.String(),
+		Name: p.Name,
+	}
+
+	if p.CreatedAt != nil {
+		item.CreatedAt = timestamppb.New(*p.CreatedAt)
+	}
+
+	if p.UpdatedAt != nil {
+		item.UpdatedAt = timestamppb.New(*p.UpdatedAt)
+	}
+
+	return item
+}
+
 // bizProjectMembershipToPb converts a biz.ProjectMembership to a pb.ProjectMember
 func bizProjectMembershipToPb(m *biz.ProjectMembership) *pb.ProjectMember {
 	role := mapAuthzRoleToProjectMemberRole(m.Role)
@@ -0,0 +1,114 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/testhelpers"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/usercontext/entities"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestProjectService(t *testing.T) {
+	suite.Run(t, new(projectServiceIntegrationTestSuite))
+}
+
+type projectServiceIntegrationTestSuite struct {
+	testhelpers.UseCasesEachTestSuite
+	org *biz.Organization
+	svc *ProjectService
+	ctx context.Context
+}
+
+func (s *projectServiceIntegrationTestSuite) SetupTest() {
+	s.TestingUseCases = testhelpers.NewTestingUseCases(s.T())
+
+	var err error
+	s.org, err = s.Organization.CreateWithRandomName(context.Background())
+	s.Require().NoError(err)
+
+	s.svc = NewProjectService(s.ProjectVersion, WithProjectUseCase(s.Project))
+	s.ctx = entities.WithCurrentOrg(context.Background(), &entities.Org{ID: s.org.ID, Name: s.org.Name})
+}
+
+func (s *projectServiceIntegrationTestSuite) listNames(ctx context.Context) []string {
+	resp, err := s.svc.List(ctx, &pb.ProjectServiceListRequest{})
+	s.Require().NoError(err)
+
+	var names []string
+	for _, p := range resp.GetResult() {
+		names = append(names, p.GetName())
+	}
+
+	return names
+}
+
+func (s *projectServiceIntegrationTestSuite) TestList() {
+	p1, err := s.Project.Create(s.ctx, s.org.ID, "project-1")
+	s.Require().NoError(err)
+	_, err = s.Project.Create(s.ctx, s.org.ID, "project-2")
+	s.Require().NoError(err)
+
+	s.Run("all the projects without RBAC", func() {
+		s.Equal([]string{"project-1", "project-2"}, s.listNames(s.ctx))
+	})
+
+	s.Run("only the project of a project scoped token", func() {
+		ctx := entities.WithCurrentAPIToken(s.ctx, &entities.APIToken{ProjectID: &p1.ID})
+		s.Equal([]string{"project-1"}, s.listNames(ctx))
+	})
+
+	s.Run("paginated", func() {
+		resp, err := s.svc.List(s.ctx, &pb.ProjectServiceListRequest{Pagination: &pb.OffsetPaginationRequest{Page: 2, PageSize: 1}})
+		s.Require().NoError(err)
+		s.Len(resp.GetResult(), 1)
+		s.Equal("project-2", resp.GetResult()[0].GetName())
+		s.EqualValues(2, resp.GetPagination().GetTotalCount())
+	})
+}
+
+func (s *projectServiceIntegrationTestSuite) TestDelete() {
+	p, err := s.Project.Create(s.ctx, s.org.ID, "to-delete")
+	s.Require().NoError(err)
+
+	wf, err := s.Workflow.Create(s.ctx, &biz.WorkflowCreateOpts{Name: "wf", OrgID: s.org.ID, Project: p.Name})
+	s.Require().NoError(err)
+
+	req := &pb.ProjectServiceDeleteRequest{ProjectReference: &pb.IdentityReference{Name: &p.Name}}
+
+	s.Run("projects with workflows can't be deleted", func() {
+		_, err := s.svc.Delete(s.ctx, req)
+		s.True(errors.IsBadRequest(err), "want bad request, got %v", err)
+	})
+
+	s.Run("deletes the project", func() {
+		s.Require().NoError(s.Workflow.Delete(s.ctx, s.org.ID, wf.ID.String()))
+
+		_, err := s.svc.Delete(s.ctx, req)
+		s.Require().NoError(err)
+		s.Empty(s.listNames(s.ctx))
+	})
+
+	s.Run("unknown project", func() {
+		_, err := s.svc.Delete(s.ctx, req)
+		s.True(errors.IsNotFound(err), "want not found, got %v", err)
+	})
+}
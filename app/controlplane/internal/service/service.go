@@ -59,6 +59,9 @@ var ProviderSet = wire.NewSet(
 	NewPrometheusService,
 	NewGroupService,
 	NewProjectService,
+	NewPolicyEvaluationService,
+	NewPolicyExceptionService,
+	NewCASRetentionService,
 	wire.Struct(new(NewWorkflowRunServiceOpts), "*"),
 	wire.Struct(new(NewAttestationServiceOpts), "*"),
 	wire.Struct(new(NewAttestationStateServiceOpt), "*"),
@@ -420,6 +423,10 @@ func handleUseCaseErr(err error, l *log.Helper) error {
 		return status.Error(codes.AlreadyExists, err.Error())
 	case biz.IsErrReleasedVersionImmutable(err):
 		return status.Error(codes.FailedPrecondition, err.Error())
+	case biz.IsErrTooManyRequests(err):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case biz.IsErrConflict(err):
+		return status.Error(codes.Aborted, err.Error())
 	default:
 		// Client errors already converted by this function can be processed again
 		// (e.g. AttestationService.Store wraps storeAttestation, which converts internally).
@@ -442,7 +449,7 @@ func handleUseCaseErr(err error, l *log.Helper) error {
 func isClientErrorCode(c codes.Code) bool {
 	switch c {
 	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.PermissionDenied,
-		codes.Unimplemented, codes.AlreadyExists, codes.FailedPrecondition:
+		codes.Unimplemented, codes.AlreadyExists, codes.FailedPrecondition, codes.ResourceExhausted, codes.Aborted:
 		return true
 	default:
 		return false
@@ -81,6 +81,18 @@ func TestHandleUseCaseErr(t *testing.T) {
 			wantCode:    codes.AlreadyExists,
 			wantMessage: "duplicated: name taken",
 		},
+		{
+			name:        "too many requests error maps to resource exhausted",
+			err:         biz.NewErrTooManyRequestsStr("an analysis is already running"),
+			wantCode:    codes.ResourceExhausted,
+			wantMessage: "too many requests: an analysis is already running",
+		},
+		{
+			name:        "conflict error maps to aborted",
+			err:         fmt.Errorf("approving policy exception: %w", biz.NewErrConflictStr("the exception is no longer pending")),
+			wantCode:    codes.Aborted,
+			wantMessage: "approving policy exception: conflict: the exception is no longer pending",
+		},
 		{
 			name:        "server-side status error is still masked",
 			err:         status.Error(codes.Unavailable, "connection to database lost"),
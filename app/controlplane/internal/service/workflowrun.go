@@ -112,7 +112,7 @@ func (s *WorkflowRunService) resolvePolicyEvaluations(
 	}
 
 	var buf bytes.Buffer
-	if err := s.casClient.Download(ctx, string(mapping.CASBackend.Provider), mapping.CASBackend.SecretName, mapping.CASBackend.OrganizationID, &buf, digest); err != nil {
+	if err := s.casClient.Download(ctx, mapping.CASBackend, &buf, digest); err != nil {
 		return nil, fmt.Errorf("downloading policy eval bundle: %w", err)
 	}
 
@@ -136,29 +136,9 @@ func (s *WorkflowRunService) List(ctx context.Context, req *pb.WorkflowRunServic
 	filters.ProjectIDs = visibleProjectIDs
 
 	// Track the resolved project so a project version can be looked up by name.
-	var projectID *uuid.UUID
-
-	// by workflow and project name
-	if req.GetWorkflowName() != "" && req.GetProjectName() != "" {
-		wf, err := s.workflowUseCase.FindByNameInOrg(ctx, currentOrg.ID, req.GetProjectName(), req.GetWorkflowName())
-		if err != nil {
-			return nil, handleUseCaseErr(err, s.log)
-		} else if wf == nil {
-			return nil, errors.NotFound("not found", "workflow not found")
-		}
-
-		filters.WorkflowID = &wf.ID
-		projectID = &wf.ProjectID
-	} else if req.GetProjectName() != "" {
-		// by project name only
-		pID, err := s.validateAndGetProjectID(ctx, currentOrg.ID, req.GetProjectName(), visibleProjectIDs)
-		if err != nil {
-			return nil, handleUseCaseErr(err, s.log)
-		}
-
-		// Override the filter to only include this specific project
-		filters.ProjectIDs = []uuid.UUID{pID}
-		projectID = &pID
+	projectID, err := s.scopeToWorkflow(ctx, currentOrg.ID, req.GetProjectName(), req.GetWorkflowName(), filters)
+	if err != nil {
+		return nil, err
 	}
 
 	// by project version
@@ -240,6 +220,98 @@ func (s *WorkflowRunService) List(ctx context.Context, req *pb.WorkflowRunServic
 	return &pb.WorkflowRunServiceListResponse{Result: result, Pagination: bizCursorToPb(nextCursor)}, nil
 }
 
+// Search the workflow runs by the content of their attestations
+func (s *WorkflowRunService) Search(ctx context.Context, req *pb.WorkflowRunServiceSearchRequest) (*pb.WorkflowRunServiceSearchResponse, error) {
+	currentOrg, err := requireCurrentOrg(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply RBAC if needed
+	filters := &biz.RunListFilters{ProjectIDs: s.visibleProjects(ctx)}
+	if _, err := s.scopeToWorkflow(ctx, currentOrg.ID, req.GetProjectName(), req.GetWorkflowName(), filters); err != nil {
+		return nil, err
+	}
+
+	filters.MaterialDigest = req.GetMaterialDigest()
+	filters.SubjectName = req.GetSubjectName()
+	filters.AnnotationName = req.GetAnnotationName()
+	filters.AnnotationValue = req.GetAnnotationValue()
+	filters.PolicyViolationsFilter = req.HasPolicyViolations
+
+	if t := req.GetMaterialType(); t != craftingpb.CraftingSchema_Material_MATERIAL_TYPE_UNSPECIFIED {
+		filters.MaterialType = t.String()
+	}
+
+	if t := req.GetRunnerType(); t != craftingpb.CraftingSchema_Runner_RUNNER_TYPE_UNSPECIFIED {
+		filters.RunnerType = t.String()
+	}
+
+	if req.GetPolicyStatus() != pb.PolicyStatusFilter_POLICY_STATUS_FILTER_UNSPECIFIED {
+		s := pbPolicyStatusFilterToBiz(req.GetPolicyStatus())
+		filters.PolicyStatus = &s
+	}
+
+	if req.CreatedAfter != nil {
+		filters.CreatedAfter = biz.ToPtr(req.GetCreatedAfter().AsTime())
+	}
+
+	if req.CreatedBefore != nil {
+		filters.CreatedBefore = biz.ToPtr(req.GetCreatedBefore().AsTime())
+	}
+
+	p := req.GetPagination()
+	paginationOpts, err := pagination.NewCursor(p.GetCursor(), int(p.GetLimit()))
+	if err != nil {
+		return nil, errors.InternalServer("invalid", "invalid pagination options")
+	}
+
+	workflowRuns, nextCursor, err := s.wrUseCase.List(ctx, currentOrg.ID, filters, paginationOpts)
+	if err != nil {
+		return nil, handleUseCaseErr(err, s.log)
+	}
+
+	result := make([]*pb.WorkflowRunItem, 0, len(workflowRuns))
+	for _, wr := range workflowRuns {
+		wrResp := bizWorkFlowRunToPb(wr)
+		wrResp.Workflow = bizWorkflowToPb(wr.Workflow)
+		result = append(result, wrResp)
+	}
+
+	return &pb.WorkflowRunServiceSearchResponse{Result: result, Pagination: bizCursorToPb(nextCursor)}, nil
+}
+
+// scopeToWorkflow narrows down the filters to the provided project and, optionally, one of its workflows.
+// It returns the ID of the project if one was provided.
+func (s *WorkflowRunService) scopeToWorkflow(ctx context.Context, orgID, projectName, workflowName string, filters *biz.RunListFilters) (*uuid.UUID, error) {
+	// by workflow and project name
+	if workflowName != "" && projectName != "" {
+		wf, err := s.workflowUseCase.FindByNameInOrg(ctx, orgID, projectName, workflowName)
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		} else if wf == nil {
+			return nil, errors.NotFound("not found", "workflow not found")
+		}
+
+		filters.WorkflowID = &wf.ID
+		return &wf.ProjectID, nil
+	}
+
+	if projectName != "" {
+		// by project name only
+		pID, err := s.validateAndGetProjectID(ctx, orgID, projectName, filters.ProjectIDs)
+		if err != nil {
+			return nil, handleUseCaseErr(err, s.log)
+		}
+
+		// Override the filter to only include this specific project
+		filters.ProjectIDs = []uuid.UUID{pID}
+		return &pID, nil
+	}
+
+	return nil, nil
+}
+
 func bizCursorToPb(cursor string) *pb.CursorPaginationResponse {
 	return &pb.CursorPaginationResponse{NextCursor: cursor}
 }
@@ -27,12 +27,14 @@ import (
 var (
 	_ auditor.LogEntry = (*CASArtifactUploaded)(nil)
 	_ auditor.LogEntry = (*CASArtifactDownloaded)(nil)
+	_ auditor.LogEntry = (*CASArtifactDeleted)(nil)
 )
 
 const (
 	CASArtifactType                 auditor.TargetType = "CASArtifact"
 	CASArtifactUploadedActionType   string             = "CASArtifactUploaded"
 	CASArtifactDownloadedActionType string             = "CASArtifactDownloaded"
+	CASArtifactDeletedActionType    string             = "CASArtifactDeleted"
 )
 
 // CASArtifactBase contains the common fields for all CAS artifact events.
@@ -116,3 +118,33 @@ func (c *CASArtifactDownloaded) ActionInfo() (json.RawMessage, error) {
 func (c *CASArtifactDownloaded) Description() string {
 	return fmt.Sprintf("artifact %s (%d bytes) was downloaded", c.Digest, c.SizeBytes)
 }
+
+// CASArtifactDeleted represents the removal of an artifact from a CAS backend
+// by the retention sweeper, once it got older than the retention rule allows
+type CASArtifactDeleted struct {
+	*CASArtifactBase
+	CASBackendID    *uuid.UUID `json:"cas_backend_id,omitempty"`
+	CASBackendName  string     `json:"cas_backend_name,omitempty"`
+	RetentionRuleID *uuid.UUID `json:"retention_rule_id,omitempty"`
+	MaxAgeDays      int        `json:"max_age_days"`
+}
+
+func (c *CASArtifactDeleted) ActionType() string {
+	return CASArtifactDeletedActionType
+}
+
+func (c *CASArtifactDeleted) ActionInfo() (json.RawMessage, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	if c.CASBackendID == nil {
+		return nil, errors.New("cas backend id is required")
+	}
+
+	return json.Marshal(&c)
+}
+
+func (c *CASArtifactDeleted) Description() string {
+	return fmt.Sprintf("artifact %s was deleted from CAS backend %s by the retention policy, older than %d days", c.Digest, c.CASBackendName, c.MaxAgeDays)
+}
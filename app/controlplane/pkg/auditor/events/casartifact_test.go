@@ -33,6 +33,11 @@ func TestCASArtifactEvents(t *testing.T) {
 	orgUUID, err := uuid.Parse("1089bb36-e27b-428b-8009-d015c8737c54")
 	require.NoError(t, err)
 
+	backendUUID, err := uuid.Parse("3089bb36-e27b-428b-8009-d015c8737c56")
+	require.NoError(t, err)
+	ruleUUID, err := uuid.Parse("4089bb36-e27b-428b-8009-d015c8737c57")
+	require.NoError(t, err)
+
 	const (
 		digest      = "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
 		fileName    = "sbom.cyclonedx.json"
@@ -92,6 +97,20 @@ func TestCASArtifactEvents(t *testing.T) {
 			},
 			expected: "testdata/casartifacts/casartifact_downloaded.json",
 		},
+		{
+			name: "artifact deleted by retention policy",
+			event: &events.CASArtifactDeleted{
+				CASArtifactBase: &events.CASArtifactBase{
+					Digest:      digest,
+					BackendType: backendType,
+				},
+				CASBackendID:    &backendUUID,
+				CASBackendName:  "my-backend",
+				RetentionRuleID: &ruleUUID,
+				MaxAgeDays:      90,
+			},
+			expected: "testdata/casartifacts/casartifact_deleted.json",
+		},
 	}
 
 	for _, tt := range tests {
@@ -157,6 +176,15 @@ func TestCASArtifactEventsFailed(t *testing.T) {
 			},
 			expectedErr: "digest is required",
 		},
+		{
+			name: "artifact deleted with missing backend",
+			event: &events.CASArtifactDeleted{
+				CASArtifactBase: &events.CASArtifactBase{
+					Digest: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+				},
+			},
+			expectedErr: "cas backend id is required",
+		},
 	}
 
 	for _, tt := range tests {
@@ -102,6 +102,8 @@ type CASBackendUpdated struct {
 	NewDescription     *string `json:"new_description,omitempty"`
 	CredentialsChanged bool    `json:"credentials_changed"`
 	PreviousDefault    bool    `json:"previous_default"`
+	// Name of the backend it has become a replica of, empty when it was detached from its primary
+	ReplicaOf *string `json:"replica_of,omitempty"`
 }
 
 func (c *CASBackendUpdated) ActionType() string {
@@ -123,6 +125,16 @@ func (c *CASBackendUpdated) Description() string {
 		credentialsInfo = " and updated credentials"
 	}
 
+	if c.ReplicaOf != nil {
+		if *c.ReplicaOf == "" {
+			return fmt.Sprintf("%s has detached CAS backend %s from its primary",
+				auditor.GetActorIdentifier(), c.CASBackendName)
+		}
+
+		return fmt.Sprintf("%s has set CAS backend %s as a replica of %s",
+			auditor.GetActorIdentifier(), c.CASBackendName, *c.ReplicaOf)
+	}
+
 	if c.PreviousDefault != c.Default {
 		defaultStatus := "default"
 		if !c.Default {
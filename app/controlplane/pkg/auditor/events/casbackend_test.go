@@ -39,6 +39,7 @@ func TestCASBackendEvents(t *testing.T) {
 
 	backendName := "test-backend"
 	backendDescription := "test description"
+	primaryName := "primary-backend"
 	backendLocation := "test-location"
 	backendProvider := "OCI"
 
@@ -101,6 +102,21 @@ func TestCASBackendEvents(t *testing.T) {
 			actor:    auditor.ActorTypeUser,
 			actorID:  userUUID,
 		},
+		{
+			name: "CAS Backend set as a replica by user",
+			event: &events.CASBackendUpdated{
+				CASBackendBase: &events.CASBackendBase{
+					CASBackendID:   &backendUUID,
+					CASBackendName: backendName,
+					Provider:       backendProvider,
+					Location:       backendLocation,
+				},
+				ReplicaOf: &primaryName,
+			},
+			expected: "testdata/casbackends/casbackend_updated_replica.json",
+			actor:    auditor.ActorTypeUser,
+			actorID:  userUUID,
+		},
 		{
 			name: "CAS Backend soft deleted by user",
 			event: &events.CASBackendDeleted{
@@ -0,0 +1,116 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor"
+	"github.com/google/uuid"
+)
+
+var (
+	_ auditor.LogEntry = (*CASRetentionRuleSet)(nil)
+	_ auditor.LogEntry = (*CASRetentionRuleDeleted)(nil)
+)
+
+const (
+	CASRetentionRuleType              auditor.TargetType = "CASRetentionRule"
+	CASRetentionRuleSetActionType     string             = "CASRetentionRuleSet"
+	CASRetentionRuleDeletedActionType string             = "CASRetentionRuleDeleted"
+)
+
+// CASRetentionRuleBase contains the common fields for all CAS retention rule events.
+// Rules without CAS backend are the organization default.
+type CASRetentionRuleBase struct {
+	RuleID         *uuid.UUID `json:"rule_id,omitempty"`
+	CASBackendID   *uuid.UUID `json:"cas_backend_id,omitempty"`
+	CASBackendName string     `json:"cas_backend_name,omitempty"`
+}
+
+func (c *CASRetentionRuleBase) RequiresActor() bool {
+	return true
+}
+
+func (c *CASRetentionRuleBase) TargetType() auditor.TargetType {
+	return CASRetentionRuleType
+}
+
+func (c *CASRetentionRuleBase) TargetID() *uuid.UUID {
+	return c.RuleID
+}
+
+func (c *CASRetentionRuleBase) ActionInfo() (json.RawMessage, error) {
+	if c.RuleID == nil {
+		return nil, errors.New("retention rule id is required")
+	}
+
+	return json.Marshal(&c)
+}
+
+func (c *CASRetentionRuleBase) scope() string {
+	if c.CASBackendName == "" {
+		return "the organization default"
+	}
+
+	return fmt.Sprintf("CAS backend %s", c.CASBackendName)
+}
+
+// CASRetentionRuleSet represents the creation or update of a retention rule
+type CASRetentionRuleSet struct {
+	*CASRetentionRuleBase
+	MaxAgeDays           int  `json:"max_age_days"`
+	KeepReleasedVersions bool `json:"keep_released_versions"`
+}
+
+func (c *CASRetentionRuleSet) ActionType() string {
+	return CASRetentionRuleSetActionType
+}
+
+func (c *CASRetentionRuleSet) ActionInfo() (json.RawMessage, error) {
+	if _, err := c.CASRetentionRuleBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&c)
+}
+
+func (c *CASRetentionRuleSet) Description() string {
+	return fmt.Sprintf("%s has set a retention of %d days on %s", auditor.GetActorIdentifier(), c.MaxAgeDays, c.scope())
+}
+
+// CASRetentionRuleDeleted represents the removal of a retention rule
+type CASRetentionRuleDeleted struct {
+	*CASRetentionRuleBase
+}
+
+func (c *CASRetentionRuleDeleted) ActionType() string {
+	return CASRetentionRuleDeletedActionType
+}
+
+func (c *CASRetentionRuleDeleted) ActionInfo() (json.RawMessage, error) {
+	if _, err := c.CASRetentionRuleBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&c)
+}
+
+func (c *CASRetentionRuleDeleted) Description() string {
+	return fmt.Sprintf("%s has removed the retention rule of %s", auditor.GetActorIdentifier(), c.scope())
+}
@@ -0,0 +1,190 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor"
+	"github.com/google/uuid"
+)
+
+var (
+	_ auditor.LogEntry = (*PolicyExceptionCreated)(nil)
+	_ auditor.LogEntry = (*PolicyExceptionApproved)(nil)
+	_ auditor.LogEntry = (*PolicyExceptionRevoked)(nil)
+	_ auditor.LogEntry = (*PolicyExceptionExpired)(nil)
+)
+
+const (
+	PolicyExceptionType               auditor.TargetType = "PolicyException"
+	PolicyExceptionCreatedActionType  string             = "PolicyExceptionCreated"
+	PolicyExceptionApprovedActionType string             = "PolicyExceptionApproved"
+	PolicyExceptionRevokedActionType  string             = "PolicyExceptionRevoked"
+	PolicyExceptionExpiredActionType  string             = "PolicyExceptionExpired"
+)
+
+// PolicyExceptionBase contains the common fields for all policy exception events,
+// empty scope fields match everything
+type PolicyExceptionBase struct {
+	ExceptionID  *uuid.UUID `json:"exception_id,omitempty"`
+	ProjectName  string     `json:"project_name,omitempty"`
+	WorkflowName string     `json:"workflow_name,omitempty"`
+	PolicyName   string     `json:"policy_name,omitempty"`
+	MaterialName string     `json:"material_name,omitempty"`
+	ExternalID   string     `json:"external_id,omitempty"`
+}
+
+func (p *PolicyExceptionBase) RequiresActor() bool {
+	return true
+}
+
+func (p *PolicyExceptionBase) TargetType() auditor.TargetType {
+	return PolicyExceptionType
+}
+
+func (p *PolicyExceptionBase) TargetID() *uuid.UUID {
+	return p.ExceptionID
+}
+
+func (p *PolicyExceptionBase) ActionInfo() (json.RawMessage, error) {
+	if p.ExceptionID == nil {
+		return nil, errors.New("exception id is required")
+	}
+
+	return json.Marshal(&p)
+}
+
+// scope returns a human readable description of what the exception waives
+func (p *PolicyExceptionBase) scope() string {
+	var parts []string
+	if p.PolicyName != "" {
+		parts = append(parts, fmt.Sprintf("policy %s", p.PolicyName))
+	}
+
+	if p.ExternalID != "" {
+		parts = append(parts, fmt.Sprintf("finding %s", p.ExternalID))
+	}
+
+	if p.MaterialName != "" {
+		parts = append(parts, fmt.Sprintf("material %s", p.MaterialName))
+	}
+
+	switch {
+	case p.WorkflowName != "":
+		parts = append(parts, fmt.Sprintf("workflow %s/%s", p.ProjectName, p.WorkflowName))
+	case p.ProjectName != "":
+		parts = append(parts, fmt.Sprintf("project %s", p.ProjectName))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// PolicyExceptionCreated represents the request of a new policy exception, pending approval
+type PolicyExceptionCreated struct {
+	*PolicyExceptionBase
+	Justification string    `json:"justification"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+func (p *PolicyExceptionCreated) ActionType() string {
+	return PolicyExceptionCreatedActionType
+}
+
+func (p *PolicyExceptionCreated) ActionInfo() (json.RawMessage, error) {
+	if _, err := p.PolicyExceptionBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&p)
+}
+
+func (p *PolicyExceptionCreated) Description() string {
+	return fmt.Sprintf("%s has requested a policy exception for %s until %s", auditor.GetActorIdentifier(), p.scope(), p.ExpiresAt.Format(time.DateOnly))
+}
+
+// PolicyExceptionApproved represents the approval of a policy exception, from then on it suppresses the matching violations
+type PolicyExceptionApproved struct {
+	*PolicyExceptionBase
+}
+
+func (p *PolicyExceptionApproved) ActionType() string {
+	return PolicyExceptionApprovedActionType
+}
+
+func (p *PolicyExceptionApproved) ActionInfo() (json.RawMessage, error) {
+	if _, err := p.PolicyExceptionBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&p)
+}
+
+func (p *PolicyExceptionApproved) Description() string {
+	return fmt.Sprintf("%s has approved the policy exception for %s", auditor.GetActorIdentifier(), p.scope())
+}
+
+// PolicyExceptionRevoked represents the revocation of a policy exception before its expiration
+type PolicyExceptionRevoked struct {
+	*PolicyExceptionBase
+}
+
+func (p *PolicyExceptionRevoked) ActionType() string {
+	return PolicyExceptionRevokedActionType
+}
+
+func (p *PolicyExceptionRevoked) ActionInfo() (json.RawMessage, error) {
+	if _, err := p.PolicyExceptionBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&p)
+}
+
+func (p *PolicyExceptionRevoked) Description() string {
+	return fmt.Sprintf("%s has revoked the policy exception for %s", auditor.GetActorIdentifier(), p.scope())
+}
+
+// PolicyExceptionExpired is raised by the controlplane once an approved exception
+// reaches its expiration date, so it doesn't require an actor
+type PolicyExceptionExpired struct {
+	*PolicyExceptionBase
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (p *PolicyExceptionExpired) RequiresActor() bool {
+	return false
+}
+
+func (p *PolicyExceptionExpired) ActionType() string {
+	return PolicyExceptionExpiredActionType
+}
+
+func (p *PolicyExceptionExpired) ActionInfo() (json.RawMessage, error) {
+	if _, err := p.PolicyExceptionBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&p)
+}
+
+func (p *PolicyExceptionExpired) Description() string {
+	return fmt.Sprintf("the policy exception for %s has expired", p.scope())
+}
@@ -0,0 +1,126 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyExceptionEvents(t *testing.T) {
+	userUUID, err := uuid.Parse("1089bb36-e27b-428b-8009-d015c8737c54")
+	require.NoError(t, err)
+	orgUUID, err := uuid.Parse("1089bb36-e27b-428b-8009-d015c8737c54")
+	require.NoError(t, err)
+	exceptionUUID, err := uuid.Parse("5089bb36-e27b-428b-8009-d015c8737c58")
+	require.NoError(t, err)
+	expiresAt := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	base := &events.PolicyExceptionBase{
+		ExceptionID:  &exceptionUUID,
+		ProjectName:  "test-project",
+		WorkflowName: "test-workflow",
+		PolicyName:   "cve-policy",
+		ExternalID:   "CVE-2024-1234",
+	}
+
+	tests := []struct {
+		name     string
+		event    auditor.LogEntry
+		expected string
+		actor    auditor.ActorType
+	}{
+		{
+			name: "exception created",
+			event: &events.PolicyExceptionCreated{
+				PolicyExceptionBase: base,
+				Justification:       "not exploitable, the vulnerable function is not reachable",
+				ExpiresAt:           expiresAt,
+			},
+			expected: "testdata/policyexceptions/policyexception_created.json",
+		},
+		{
+			name:     "exception approved",
+			event:    &events.PolicyExceptionApproved{PolicyExceptionBase: base},
+			expected: "testdata/policyexceptions/policyexception_approved.json",
+		},
+		{
+			name: "organization-wide exception revoked",
+			event: &events.PolicyExceptionRevoked{PolicyExceptionBase: &events.PolicyExceptionBase{
+				ExceptionID: &exceptionUUID,
+				PolicyName:  "license-policy",
+			}},
+			expected: "testdata/policyexceptions/policyexception_revoked.json",
+		},
+		{
+			name: "exception expired",
+			event: &events.PolicyExceptionExpired{
+				PolicyExceptionBase: base,
+				ExpiresAt:           expiresAt,
+			},
+			expected: "testdata/policyexceptions/policyexception_expired.json",
+			actor:    auditor.ActorTypeSystem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor := auditor.WithActor(auditor.ActorTypeUser, userUUID, testEmail, testName)
+			if tt.actor == auditor.ActorTypeSystem {
+				actor = auditor.WithActor(auditor.ActorTypeSystem, uuid.Nil, "", "")
+			}
+
+			eventPayload, err := auditor.GenerateAuditEvent(tt.event, auditor.WithOrgID(orgUUID), actor)
+			require.NoError(t, err)
+
+			want, err := json.MarshalIndent(eventPayload.Data, "", "  ")
+			require.NoError(t, err)
+
+			if updateGolden {
+				err := os.MkdirAll(filepath.Dir(tt.expected), 0755)
+				require.NoError(t, err)
+				err = os.WriteFile(filepath.Clean(tt.expected), want, 0600)
+				require.NoError(t, err)
+			}
+
+			gotRaw, err := os.ReadFile(filepath.Clean(tt.expected))
+			require.NoError(t, err)
+
+			var gotPayload auditor.AuditEventPayload
+			err = json.Unmarshal(gotRaw, &gotPayload)
+			require.NoError(t, err)
+			got, err := json.MarshalIndent(gotPayload, "", "  ")
+			require.NoError(t, err)
+
+			assert.Equal(t, string(want), string(got))
+		})
+	}
+}
+
+func TestPolicyExceptionEventsFailed(t *testing.T) {
+	_, err := (&events.PolicyExceptionExpired{PolicyExceptionBase: &events.PolicyExceptionBase{}}).ActionInfo()
+	assert.ErrorContains(t, err, "exception id is required")
+}
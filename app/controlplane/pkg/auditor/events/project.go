@@ -28,6 +28,7 @@ import (
 
 var (
 	_ auditor.LogEntry = (*ProjectCreated)(nil)
+	_ auditor.LogEntry = (*ProjectDeleted)(nil)
 	_ auditor.LogEntry = (*ProjectVersionCreated)(nil)
 	_ auditor.LogEntry = (*ProjectVersionUpdated)(nil)
 	_ auditor.LogEntry = (*ProjectVersionDeleted)(nil)
@@ -39,6 +40,7 @@ var (
 const (
 	ProjectType                        auditor.TargetType = "Project"
 	ProjectCreatedActionType           string             = "ProjectCreated"
+	ProjectDeletedActionType           string             = "ProjectDeleted"
 	ProjectVersionCreatedActionType    string             = "ProjectVersionCreated"
 	ProjectVersionUpdatedActionType    string             = "ProjectVersionUpdated"
 	ProjectVersionDeletedActionType    string             = "ProjectVersionDeleted"
@@ -94,6 +96,27 @@ func (p *ProjectCreated) Description() string {
 	return fmt.Sprintf("%s has created the project '%s'", auditor.GetActorIdentifier(), p.ProjectName)
 }
 
+// ProjectDeleted represents the deletion of a project
+type ProjectDeleted struct {
+	*ProjectBase
+}
+
+func (p *ProjectDeleted) ActionType() string {
+	return ProjectDeletedActionType
+}
+
+func (p *ProjectDeleted) ActionInfo() (json.RawMessage, error) {
+	if _, err := p.ProjectBase.ActionInfo(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&p)
+}
+
+func (p *ProjectDeleted) Description() string {
+	return fmt.Sprintf("%s has deleted the project '%s'", auditor.GetActorIdentifier(), p.ProjectName)
+}
+
 // ProjectVersionCreated represents the creation of a project version
 type ProjectVersionCreated struct {
 	*ProjectBase
@@ -168,6 +191,8 @@ type ProjectVersionUpdated struct {
 	// project's latest one. Always emitted, like Prerelease on the sibling
 	// events, so consumers can tell "not a promotion" from "older producer".
 	MarkedAsLatest bool `json:"marked_as_latest"`
+	// Prerelease is set when the update changed the release status of the version
+	Prerelease *bool `json:"prerelease,omitempty"`
 }
 
 func (p *ProjectVersionUpdated) ActionType() string {
@@ -194,6 +219,12 @@ func (p *ProjectVersionUpdated) Description() string {
 	case p.MarkedAsLatest:
 		return fmt.Sprintf("%s has promoted version '%s' to latest for project '%s'",
 			auditor.GetActorIdentifier(), p.Version, p.ProjectName)
+	case p.Prerelease != nil && !*p.Prerelease:
+		return fmt.Sprintf("%s has released version '%s' for project '%s'",
+			auditor.GetActorIdentifier(), p.Version, p.ProjectName)
+	case p.Prerelease != nil:
+		return fmt.Sprintf("%s has moved version '%s' back to pre-release for project '%s'",
+			auditor.GetActorIdentifier(), p.Version, p.ProjectName)
 	default:
 		return fmt.Sprintf("%s has updated version '%s' for project '%s'",
 			auditor.GetActorIdentifier(), p.Version, p.ProjectName)
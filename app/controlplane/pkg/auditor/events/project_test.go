@@ -63,6 +63,18 @@ func TestProjectEvents(t *testing.T) {
 			actor:    auditor.ActorTypeUser,
 			actorID:  userUUID,
 		},
+		{
+			name: "ProjectDeleted",
+			event: &events.ProjectDeleted{
+				ProjectBase: &events.ProjectBase{
+					ProjectID:   &projectUUID,
+					ProjectName: projectName,
+				},
+			},
+			expected: "testdata/projects/project_deleted.json",
+			actor:    auditor.ActorTypeUser,
+			actorID:  userUUID,
+		},
 		{
 			name: "ProjectVersionCreated",
 			event: &events.ProjectVersionCreated{
@@ -122,6 +134,36 @@ func TestProjectEvents(t *testing.T) {
 			actor:    auditor.ActorTypeUser,
 			actorID:  userUUID,
 		},
+		{
+			name: "ProjectVersionUpdated released",
+			event: &events.ProjectVersionUpdated{
+				ProjectBase: &events.ProjectBase{
+					ProjectID:   &projectUUID,
+					ProjectName: projectName,
+				},
+				VersionID:  &versionUUID,
+				Version:    "v1.0.0",
+				Prerelease: boolPtr(false),
+			},
+			expected: "testdata/projects/project_version_released.json",
+			actor:    auditor.ActorTypeUser,
+			actorID:  userUUID,
+		},
+		{
+			name: "ProjectVersionUpdated moved back to pre-release",
+			event: &events.ProjectVersionUpdated{
+				ProjectBase: &events.ProjectBase{
+					ProjectID:   &projectUUID,
+					ProjectName: projectName,
+				},
+				VersionID:  &versionUUID,
+				Version:    "v1.0.0",
+				Prerelease: boolPtr(true),
+			},
+			expected: "testdata/projects/project_version_unreleased.json",
+			actor:    auditor.ActorTypeUser,
+			actorID:  userUUID,
+		},
 		{
 			name: "ProjectMembershipAdded",
 			event: &events.ProjectMembershipAdded{
@@ -401,3 +443,7 @@ func TestProjectEventsFailed(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -57,10 +57,12 @@ const (
 	ResourceAvailableIntegration    = "integration_available"
 	ResourceRegisteredIntegration   = "integration_registered"
 	ResourceAttachedIntegration     = "integration_attached"
+	ResourceIntegrationDelivery     = "integration_delivery"
 	ResourceOrgMetric               = "metrics_org"
 	ResourceWorkflowRun             = "workflow_run"
 	ResourceWorkflow                = "workflow"
 	ResourceProject                 = "project"
+	ResourceProjectVersion          = "project_version"
 	Organization                    = "organization"
 	OrganizationMemberships         = "organization_memberships"
 	ResourceGroup                   = "group"
@@ -69,6 +71,7 @@ const (
 	ResourceProjectMembership       = "project_membership"
 	ResourceOrganizationInvitations = "organization_invitations"
 	ResourceDefaultBackend          = "default_backend"
+	ResourcePolicyException         = "policy_exception"
 
 	// Top level instance admin role
 	// this is used to know if an user is a super admin of the chainloop instance
@@ -130,6 +133,9 @@ var (
 	PolicyAttachedIntegrationList   = &Policy{ResourceAttachedIntegration, ActionList}
 	PolicyAttachedIntegrationAttach = &Policy{ResourceAttachedIntegration, ActionCreate}
 	PolicyAttachedIntegrationDetach = &Policy{ResourceAttachedIntegration, ActionDelete}
+	// Integration deliveries
+	PolicyIntegrationDeliveryList   = &Policy{ResourceIntegrationDelivery, ActionList}
+	PolicyIntegrationDeliveryReplay = &Policy{ResourceIntegrationDelivery, ActionUpdate}
 	// Org Metrics
 	PolicyOrgMetricsRead = &Policy{ResourceOrgMetric, ActionList}
 	// Workflow Contract
@@ -150,7 +156,15 @@ var (
 	PolicyWorkflowUpdate = &Policy{ResourceWorkflow, ActionUpdate}
 	PolicyWorkflowDelete = &Policy{ResourceWorkflow, ActionDelete}
 	// Projects
+	PolicyProjectList   = &Policy{ResourceProject, ActionList}
+	PolicyProjectRead   = &Policy{ResourceProject, ActionRead}
 	PolicyProjectCreate = &Policy{ResourceProject, ActionCreate}
+	PolicyProjectDelete = &Policy{ResourceProject, ActionDelete}
+	// Project Versions
+	PolicyProjectVersionList   = &Policy{ResourceProjectVersion, ActionList}
+	PolicyProjectVersionRead   = &Policy{ResourceProjectVersion, ActionRead}
+	PolicyProjectVersionCreate = &Policy{ResourceProjectVersion, ActionCreate}
+	PolicyProjectVersionUpdate = &Policy{ResourceProjectVersion, ActionUpdate}
 
 	// Organization
 	PolicyOrganizationCreate = &Policy{Organization, ActionCreate}
@@ -177,6 +191,8 @@ var (
 	PolicyProjectRemoveMemberships = &Policy{ResourceProjectMembership, ActionDelete}
 	// Organization Invitations
 	PolicyOrganizationInvitationsCreate = &Policy{ResourceOrganizationInvitations, ActionCreate}
+	// Policy exceptions, managing them is restricted to org admins
+	PolicyPolicyExceptionList = &Policy{ResourcePolicyException, ActionList}
 
 	// Manage owners (promote to/demote from owner, remove owners)
 	PolicyOrganizationManageOwners = &Policy{OrganizationMemberships, ActionManageOwners}
@@ -226,6 +242,8 @@ var RolesMap = map[Role][]*Policy{
 		PolicyRegisteredIntegrationList,
 		// Attached integrations
 		PolicyAttachedIntegrationList,
+		// Integration deliveries
+		PolicyIntegrationDeliveryList,
 		// Metrics
 		PolicyOrgMetricsRead,
 		// Workflow Contract
@@ -237,8 +255,16 @@ var RolesMap = map[Role][]*Policy{
 		// Workflow
 		PolicyWorkflowList,
 		PolicyWorkflowRead,
+		// Project
+		PolicyProjectList,
+		PolicyProjectRead,
+		// Project Versions
+		PolicyProjectVersionList,
+		PolicyProjectVersionRead,
 		// Organization
 		PolicyOrganizationRead,
+		// Policy exceptions
+		PolicyPolicyExceptionList,
 
 		// List organization memberships
 		PolicyOrganizationListMemberships,
@@ -262,6 +288,15 @@ var RolesMap = map[Role][]*Policy{
 		PolicyWorkflowRunList,
 		PolicyWorkflowRunRead,
 
+		// Projects and their versions (RBAC will be applied)
+		PolicyProjectList,
+		PolicyProjectRead,
+		PolicyProjectDelete,
+		PolicyProjectVersionList,
+		PolicyProjectVersionRead,
+		PolicyProjectVersionCreate,
+		PolicyProjectVersionUpdate,
+
 		PolicyArtifactDownload,
 		PolicyArtifactUpload,
 
@@ -278,6 +313,9 @@ var RolesMap = map[Role][]*Policy{
 		PolicyAttachedIntegrationList,
 		PolicyAttachedIntegrationAttach,
 		PolicyAttachedIntegrationDetach,
+		// integration deliveries (RBAC will be applied)
+		PolicyIntegrationDeliveryList,
+		PolicyIntegrationDeliveryReplay,
 
 		PolicyOrgMetricsRead,
 		PolicyReferrerRead,
@@ -303,6 +341,10 @@ var RolesMap = map[Role][]*Policy{
 	RoleProjectViewer: {
 		PolicyWorkflowRead,
 		PolicyWorkflowRunRead,
+		// project and versions
+		PolicyProjectRead,
+		PolicyProjectVersionList,
+		PolicyProjectVersionRead,
 		// workflow contracts
 		PolicyWorkflowContractList,
 		PolicyWorkflowContractRead,
@@ -325,9 +367,16 @@ var RolesMap = map[Role][]*Policy{
 		PolicyWorkflowUpdate,
 		PolicyWorkflowDelete,
 
+		// project operations
+		PolicyProjectDelete,
+		PolicyProjectVersionCreate,
+		PolicyProjectVersionUpdate,
+
 		// integrations
 		PolicyAttachedIntegrationAttach,
 		PolicyAttachedIntegrationDetach,
+		PolicyIntegrationDeliveryList,
+		PolicyIntegrationDeliveryReplay,
 
 		// Project API Token
 		PolicyAPITokenCreate,
@@ -366,6 +415,8 @@ var ServerOperationsMap = map[string]*OperationPolicy{
 	"/controlplane.v1.CASBackendService/List":       {Policies: []*Policy{PolicyCASBackendList}},
 	"/controlplane.v1.CASBackendService/Revalidate": {Policies: []*Policy{PolicyCASBackendUpdate}},
 	"/controlplane.v1.CASBackendService/Create":     {Policies: []*Policy{PolicyCASBackendCreate}},
+	// CAS retention rules listing
+	"/controlplane.v1.CASRetentionService/List": {Policies: []*Policy{PolicyCASBackendList}},
 	// Available integrations
 	"/controlplane.v1.IntegrationsService/ListAvailable": {Policies: []*Policy{PolicyAvailableIntegrationList, PolicyAvailableIntegrationRead}},
 	// Registered integrations
@@ -376,6 +427,10 @@ var ServerOperationsMap = map[string]*OperationPolicy{
 	"/controlplane.v1.IntegrationsService/ListAttachments": {Policies: []*Policy{PolicyAttachedIntegrationList}},
 	"/controlplane.v1.IntegrationsService/Attach":          {Policies: []*Policy{PolicyAttachedIntegrationAttach}},
 	"/controlplane.v1.IntegrationsService/Detach":          {Policies: []*Policy{PolicyAttachedIntegrationDetach}},
+	// Integration deliveries
+	"/controlplane.v1.IntegrationsService/ListDeliveries":       {Policies: []*Policy{PolicyIntegrationDeliveryList}},
+	"/controlplane.v1.IntegrationsService/ListFailedDeliveries": {Policies: []*Policy{PolicyIntegrationDeliveryList}},
+	"/controlplane.v1.IntegrationsService/ReplayDeliveries":     {Policies: []*Policy{PolicyIntegrationDeliveryReplay}},
 	// Metrics
 	"/controlplane.v1.OrgMetricsService/Totals":                  {Policies: []*Policy{PolicyOrgMetricsRead}},
 	"/controlplane.v1.OrgMetricsService/TopWorkflowsByRunsCount": {Policies: []*Policy{PolicyOrgMetricsRead}},
@@ -387,8 +442,13 @@ var ServerOperationsMap = map[string]*OperationPolicy{
 	"/controlplane.v1.WorkflowService/Update": {Policies: []*Policy{PolicyWorkflowUpdate}},
 	"/controlplane.v1.WorkflowService/Delete": {Policies: []*Policy{PolicyWorkflowDelete}},
 	// WorkflowRun
-	"/controlplane.v1.WorkflowRunService/List": {Policies: []*Policy{PolicyWorkflowRunList}},
-	"/controlplane.v1.WorkflowRunService/View": {Policies: []*Policy{PolicyWorkflowRunRead}},
+	"/controlplane.v1.WorkflowRunService/List":   {Policies: []*Policy{PolicyWorkflowRunList}},
+	"/controlplane.v1.WorkflowRunService/Search": {Policies: []*Policy{PolicyWorkflowRunList}},
+	"/controlplane.v1.WorkflowRunService/View":   {Policies: []*Policy{PolicyWorkflowRunRead}},
+	// Policy evaluations extracted from the workflow runs, Impact and ImpactReport are admin-only
+	"/controlplane.v1.PolicyEvaluationService/List": {Policies: []*Policy{PolicyWorkflowRunList}},
+	// Policy exceptions, Create, Approve and Revoke are admin-only
+	"/controlplane.v1.PolicyExceptionService/List": {Policies: []*Policy{PolicyPolicyExceptionList}},
 	// Workflow Contracts
 	"/controlplane.v1.WorkflowContractService/List":     {Policies: []*Policy{PolicyWorkflowContractList}},
 	"/controlplane.v1.WorkflowContractService/Describe": {Policies: []*Policy{PolicyWorkflowContractRead}},
@@ -432,6 +492,16 @@ var ServerOperationsMap = map[string]*OperationPolicy{
 	"/controlplane.v1.GroupService/ListPendingInvitations":       {},
 	"/controlplane.v1.GroupService/UpdateMemberMaintainerStatus": {},
 
+	// Projects
+	"/controlplane.v1.ProjectService/Create":           {Policies: []*Policy{PolicyProjectCreate}},
+	"/controlplane.v1.ProjectService/List":             {Policies: []*Policy{PolicyProjectList}},
+	"/controlplane.v1.ProjectService/Describe":         {Policies: []*Policy{PolicyProjectRead}},
+	"/controlplane.v1.ProjectService/Delete":           {Policies: []*Policy{PolicyProjectDelete}},
+	"/controlplane.v1.ProjectService/ListVersions":     {Policies: []*Policy{PolicyProjectVersionList}},
+	"/controlplane.v1.ProjectService/DescribeVersion":  {Policies: []*Policy{PolicyProjectVersionRead}},
+	"/controlplane.v1.ProjectService/CreateVersion":    {Policies: []*Policy{PolicyProjectVersionCreate}},
+	"/controlplane.v1.ProjectService/PromoteVersion":   {Policies: []*Policy{PolicyProjectVersionUpdate}},
+	"/controlplane.v1.ProjectService/UnreleaseVersion": {Policies: []*Policy{PolicyProjectVersionUpdate}},
 	// Project Memberships
 	"/controlplane.v1.ProjectService/ListMembers":            {Policies: []*Policy{PolicyProjectListMemberships}},
 	"/controlplane.v1.ProjectService/AddMember":              {Policies: []*Policy{PolicyProjectAddMemberships}},
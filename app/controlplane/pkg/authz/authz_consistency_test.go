@@ -61,6 +61,7 @@ var authzExemptProcedures = map[string]struct{}{
 	"/controlplane.v1.AttestationService/GetPolicyGroup":       {},
 	"/controlplane.v1.AttestationService/GetUploadCreds":       {},
 	"/controlplane.v1.AttestationService/Init":                 {},
+	"/controlplane.v1.AttestationService/ListPolicyExceptions": {},
 	"/controlplane.v1.AttestationService/Store":                {},
 	"/controlplane.v1.AttestationStateService/Initialized":     {},
 	"/controlplane.v1.AttestationStateService/Read":            {},
@@ -83,6 +84,10 @@ var adminOnlyProcedures = map[string]struct{}{
 	"/controlplane.v1.CASBackendService/Delete": {},
 	"/controlplane.v1.CASBackendService/Update": {},
 
+	// CAS retention rule mutations (List is role-gated in ServerOperationsMap).
+	"/controlplane.v1.CASRetentionService/Delete": {},
+	"/controlplane.v1.CASRetentionService/Set":    {},
+
 	// Group catalog mutations (List/Get and membership ops are role-open in ServerOperationsMap).
 	"/controlplane.v1.GroupService/Create": {},
 	"/controlplane.v1.GroupService/Delete": {},
@@ -95,6 +100,15 @@ var adminOnlyProcedures = map[string]struct{}{
 	"/controlplane.v1.OrgInvitationService/ListSent": {},
 	"/controlplane.v1.OrgInvitationService/Revoke":   {},
 
+	// Policy impact analyses evaluate policies server-side against every attestation (List is role-gated in ServerOperationsMap).
+	"/controlplane.v1.PolicyEvaluationService/Impact":       {},
+	"/controlplane.v1.PolicyEvaluationService/ImpactReport": {},
+
+	// Policy exception management (List is role-gated in ServerOperationsMap).
+	"/controlplane.v1.PolicyExceptionService/Approve": {},
+	"/controlplane.v1.PolicyExceptionService/Create":  {},
+	"/controlplane.v1.PolicyExceptionService/Revoke":  {},
+
 	// Organization and membership mutations (Create/Delete/ListMemberships are handled separately).
 	"/controlplane.v1.OrganizationService/DeleteMembership": {},
 	"/controlplane.v1.OrganizationService/Update":           {},
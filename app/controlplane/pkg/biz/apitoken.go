@@ -58,6 +58,9 @@ var defaultAuthzPolicies = []*authz.Policy{
 	authz.PolicyWorkflowRunList, authz.PolicyWorkflowRunRead,
 	// To read, list and create workflows
 	authz.PolicyWorkflowRead, authz.PolicyWorkflowList, authz.PolicyWorkflowCreate,
+	// To read projects and their versions
+	authz.PolicyProjectRead, authz.PolicyProjectList,
+	authz.PolicyProjectVersionList, authz.PolicyProjectVersionRead,
 	// Add permissions to workflow contract management
 	authz.PolicyWorkflowContractList, authz.PolicyWorkflowContractRead, authz.PolicyWorkflowContractUpdate, authz.PolicyWorkflowContractCreate,
 	// to download artifacts and list referrers
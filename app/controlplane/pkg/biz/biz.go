@@ -41,12 +41,18 @@ var ProviderSet = wire.NewSet(
 	NewCASBackendUseCase,
 	NewOrgMetricsUseCase,
 	NewIntegrationUseCase,
+	NewIntegrationDeliveryUseCase,
 	NewMembershipUseCase,
 	NewCASClientUseCase,
 	NewOrgInvitationUseCase,
 	NewAttestationUseCase,
 	NewWorkflowRunExpirerUseCase,
+	NewWorkflowRunSearchIndexer,
 	NewCASMappingUseCase,
+	NewPolicyEvaluationUseCase,
+	NewPolicyExceptionUseCase,
+	NewPolicyExceptionExpirer,
+	NewPolicyImpactUseCase,
 	NewReferrerUseCase,
 	NewAPITokenUseCase,
 	NewAttestationStateUseCase,
@@ -58,6 +64,8 @@ var ProviderSet = wire.NewSet(
 	NewUserAccessSyncerUseCase,
 	NewGroupUseCase,
 	NewCASBackendChecker,
+	NewCASRetentionUseCase,
+	NewCASRetentionSweeper,
 	NewAPITokenStaleRevoker,
 	NewAuthzUseCase,
 	wire.Bind(new(PromObservable), new(*PrometheusUseCase)),
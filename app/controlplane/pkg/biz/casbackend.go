@@ -27,6 +27,8 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
 	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/azureblob"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/filesystem"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/gcs"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/oci"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/s3"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/s3accesspoint"
@@ -121,10 +123,45 @@ type CASBackend struct {
 	Fallback bool
 	// Managed indicates this backend is provisioned and operated by Chainloop
 	Managed bool
+	// Backend this one is a replica of, if any
+	PrimaryID *uuid.UUID
+	// Primary is the backend this one is a replica of. Only loaded when the backend is
+	// retrieved to download artifacts, since the replica might not have received them yet.
+	Primary *CASBackend
+	// Replicas the artifacts uploaded to this backend are mirrored to.
+	// Only loaded when the backend is retrieved to upload or download artifacts.
+	Replicas []*CASBackend
 
 	Limits *CASBackendLimits
 }
 
+// IsReplica returns whether the backend mirrors the artifacts of another one
+func (b *CASBackend) IsReplica() bool {
+	return b.PrimaryID != nil
+}
+
+// ReadLocations returns the backend, its replicas and, for a replica, its primary in the order
+// they should be tried to download an artifact. Healthy locations go first, the backend itself
+// taking precedence. The primary is always a location since mirroring to a replica is asynchronous.
+func (b *CASBackend) ReadLocations() []*CASBackend {
+	locations := append([]*CASBackend{b}, b.Replicas...)
+	if b.Primary != nil {
+		locations = append(locations, b.Primary)
+	}
+
+	healthy := make([]*CASBackend, 0, len(locations))
+	var unhealthy []*CASBackend
+	for _, l := range locations {
+		if l.ValidationStatus == CASBackendValidationOK {
+			healthy = append(healthy, l)
+		} else {
+			unhealthy = append(unhealthy, l)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
 type CASBackendLimits struct {
 	// Max number of bytes allowed to be stored in this backend per blob
 	MaxBytes int64
@@ -169,6 +206,8 @@ type CASBackendRepo interface {
 	ListBackends(ctx context.Context, defaultsOrFallbacks bool) ([]*CASBackend, error)
 	Create(context.Context, *CASBackendCreateOpts) (*CASBackend, error)
 	Update(context.Context, *CASBackendUpdateOpts) (*CASBackend, error)
+	// UpdatePrimary turns the backend into a replica of the primary one, or detaches it when primaryID is nil
+	UpdatePrimary(ctx context.Context, ID uuid.UUID, primaryID *uuid.UUID) error
 	Delete(ctx context.Context, ID uuid.UUID) error
 	SoftDelete(ctx context.Context, ID uuid.UUID) error
 }
@@ -528,6 +567,11 @@ func (uc *CASBackendUseCase) Update(ctx context.Context, orgID, id string, descr
 		return nil, NewErrValidationStr("managed CAS backends cannot be modified")
 	}
 
+	// Replicas only receive the artifacts mirrored from their primary
+	if before.IsReplica() && ((defaultB != nil && *defaultB) || (fallbackB != nil && *fallbackB)) {
+		return nil, NewErrValidationStr("a replica cannot be the default or fallback backend, detach it from its primary first")
+	}
+
 	// Validate max_bytes if provided
 	if maxBytes != nil && *maxBytes < MinCASBackendMaxBytes {
 		return nil, NewErrValidationStr(fmt.Sprintf("max_bytes must be at least %s", bytefmt.ByteSize(uint64(MinCASBackendMaxBytes))))
@@ -617,6 +661,145 @@ func (uc *CASBackendUseCase) Update(ctx context.Context, orgID, id string, descr
 	return after, nil
 }
 
+// SetReplicaOf turns the backend into a replica of the one with the given name in the same organization.
+// From then on the artifacts uploaded to the primary get mirrored to it, and downloads fall back to it
+// when the primary is unavailable. An empty primaryName detaches the backend from its current primary.
+func (uc *CASBackendUseCase) SetReplicaOf(ctx context.Context, orgID, id, primaryName string) (*CASBackend, error) {
+	ctx, span := otelx.Start(ctx, casBackendTracer, "CASBackendUseCase.SetReplicaOf")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	backendUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	replica, err := uc.repo.FindByIDInOrg(ctx, orgUUID, backendUUID)
+	if err != nil {
+		return nil, err
+	} else if replica == nil {
+		return nil, NewErrNotFound("CAS Backend")
+	}
+
+	var primaryID *uuid.UUID
+	if primaryName != "" {
+		primary, err := uc.repo.FindByNameInOrg(ctx, orgUUID, primaryName)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := validateReplica(primary, replica); err != nil {
+			return nil, err
+		}
+
+		primaryID = &primary.ID
+	}
+
+	if err := uc.repo.UpdatePrimary(ctx, replica.ID, primaryID); err != nil {
+		return nil, fmt.Errorf("updating primary backend: %w", err)
+	}
+
+	after, err := uc.repo.FindByIDInOrg(ctx, orgUUID, backendUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.CASBackendUpdated{
+			CASBackendBase: &events.CASBackendBase{
+				CASBackendID:   &after.ID,
+				CASBackendName: after.Name,
+				Provider:       displayProvider(after),
+				Location:       displayLocation(after),
+				Default:        after.Default,
+			},
+			PreviousDefault: replica.Default,
+			ReplicaOf:       &primaryName,
+		}, &orgUUID)
+	}
+
+	return after, nil
+}
+
+// ValidateReplicaOf checks that the backend can become a replica of the one with the given name once the
+// given changes to its default and fallback flags and its max size are applied.
+// It allows validating an update as a whole before storing any of its changes.
+func (uc *CASBackendUseCase) ValidateReplicaOf(ctx context.Context, orgID, id, primaryName string, defaultB, fallbackB *bool, maxBytes *int64) error {
+	ctx, span := otelx.Start(ctx, casBackendTracer, "CASBackendUseCase.ValidateReplicaOf")
+	defer span.End()
+
+	// detaching the backend is always possible
+	if primaryName == "" {
+		return nil
+	}
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return NewErrInvalidUUID(err)
+	}
+
+	backendUUID, err := uuid.Parse(id)
+	if err != nil {
+		return NewErrInvalidUUID(err)
+	}
+
+	replica, err := uc.repo.FindByIDInOrg(ctx, orgUUID, backendUUID)
+	if err != nil {
+		return err
+	} else if replica == nil {
+		return NewErrNotFound("CAS Backend")
+	}
+
+	primary, err := uc.repo.FindByNameInOrg(ctx, orgUUID, primaryName)
+	if err != nil {
+		return err
+	}
+
+	updated := *replica
+	if defaultB != nil {
+		updated.Default = *defaultB
+	}
+	if fallbackB != nil {
+		updated.Fallback = *fallbackB
+	}
+	if maxBytes != nil {
+		updated.Limits = &CASBackendLimits{MaxBytes: *maxBytes}
+	}
+
+	return validateReplica(primary, &updated)
+}
+
+// validateReplica checks that the replica can mirror the artifacts of the primary backend.
+// Replication is one level deep, a primary can not be a replica itself and vice versa.
+func validateReplica(primary, replica *CASBackend) error {
+	switch {
+	case primary.ID == replica.ID:
+		return NewErrValidationStr("a CAS backend cannot be a replica of itself")
+	case primary.Inline || replica.Inline:
+		return NewErrValidationStr("inline CAS backends cannot be replicated")
+	case replica.Managed:
+		return NewErrValidationStr("managed CAS backends cannot be modified")
+	case replica.Default || replica.Fallback:
+		return NewErrValidationStr("the default or fallback CAS backend cannot be a replica")
+	case primary.IsReplica():
+		return NewErrValidationStr(fmt.Sprintf("%q is already a replica of another CAS backend", primary.Name))
+	case len(replica.Replicas) > 0:
+		return NewErrValidationStr(fmt.Sprintf("%q has replicas of its own", replica.Name))
+	}
+
+	// the replica must be able to store everything the primary accepts
+	if primary.Limits != nil && replica.Limits != nil && replica.Limits.MaxBytes < primary.Limits.MaxBytes {
+		return NewErrValidationStr(fmt.Sprintf("the replica max size (%s) is lower than the primary one (%s)",
+			bytefmt.ByteSize(uint64(replica.Limits.MaxBytes)), bytefmt.ByteSize(uint64(primary.Limits.MaxBytes))))
+	}
+
+	return nil
+}
+
 // Deprecated: use Create and update methods separately instead
 func (uc *CASBackendUseCase) CreateOrUpdate(ctx context.Context, orgID, name, username, password string, provider CASBackendProvider, defaultB bool) (*CASBackend, error) {
 	ctx, span := otelx.Start(ctx, casBackendTracer, "CASBackendUseCase.CreateOrUpdate")
@@ -701,6 +884,13 @@ func (uc *CASBackendUseCase) SoftDelete(ctx context.Context, orgID, id string) e
 		return err
 	}
 
+	// The replicas of the deleted backend become regular backends
+	for _, r := range backend.Replicas {
+		if err := uc.repo.UpdatePrimary(ctx, r.ID, nil); err != nil {
+			return fmt.Errorf("detaching replica %s: %w", r.Name, err)
+		}
+	}
+
 	// If we just deleted the default backend, we need to promote the next available backend.
 	// The deleted backend is filtered out by DeletedAtIsNil in the finders,
 	// so no exclusion needed here.
@@ -901,7 +1091,7 @@ func (uc *CASBackendUseCase) PerformValidation(ctx context.Context, id string) e
 
 // Implements https://pkg.go.dev/entgo.io/ent/schema/field#EnumValues
 func (CASBackendProvider) Values() (kinds []string) {
-	for _, s := range []CASBackendProvider{azureblob.ProviderID, oci.ProviderID, CASBackendInline, s3.ProviderID, s3accesspoint.ProviderID} {
+	for _, s := range []CASBackendProvider{azureblob.ProviderID, oci.ProviderID, CASBackendInline, s3.ProviderID, s3accesspoint.ProviderID, filesystem.ProviderID, gcs.ProviderID} {
 		kinds = append(kinds, string(s))
 	}
 
@@ -468,6 +468,202 @@ func (s *casBackendTestSuite) TestUpdateDemoteToFallbackPromotesManaged() {
 	s.Require().NoError(err)
 }
 
+func (s *casBackendTestSuite) TestSetReplicaOf() {
+	ctx := context.Background()
+
+	primaryID := uuid.New()
+	replicaID := uuid.New()
+	limits := &biz.CASBackendLimits{MaxBytes: biz.MinCASBackendMaxBytes}
+
+	tests := []struct {
+		name    string
+		primary *biz.CASBackend
+		replica *biz.CASBackend
+		wantErr string
+	}{
+		{
+			name:    "valid replica",
+			primary: &biz.CASBackend{ID: primaryID, Name: "primary", Provider: backendType, Default: true, Limits: limits},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType, Limits: limits},
+		},
+		{
+			name:    "replica of itself",
+			primary: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType},
+			wantErr: "replica of itself",
+		},
+		{
+			name:    "inline primary",
+			primary: &biz.CASBackend{ID: primaryID, Name: "primary", Provider: biz.CASBackendInline, Inline: true},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType},
+			wantErr: "inline",
+		},
+		{
+			name:    "default replica",
+			primary: &biz.CASBackend{ID: primaryID, Name: "primary", Provider: backendType},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType, Default: true},
+			wantErr: "default or fallback",
+		},
+		{
+			name:    "primary is a replica",
+			primary: &biz.CASBackend{ID: primaryID, Name: "primary", Provider: backendType, PrimaryID: &replicaID},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType},
+			wantErr: "already a replica",
+		},
+		{
+			name:    "replica with replicas",
+			primary: &biz.CASBackend{ID: primaryID, Name: "primary", Provider: backendType},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType, Replicas: []*biz.CASBackend{{ID: uuid.New()}}},
+			wantErr: "has replicas of its own",
+		},
+		{
+			name:    "replica can't store what the primary accepts",
+			primary: &biz.CASBackend{ID: primaryID, Name: "primary", Provider: backendType, Limits: &biz.CASBackendLimits{MaxBytes: 2 * biz.MinCASBackendMaxBytes}},
+			replica: &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType, Limits: limits},
+			wantErr: "max size",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.resetMock()
+
+			s.repo.On("FindByIDInOrg", mock.Anything, s.validUUID, replicaID).Return(tc.replica, nil)
+			s.repo.On("FindByNameInOrg", mock.Anything, s.validUUID, tc.primary.Name).Return(tc.primary, nil)
+			if tc.wantErr == "" {
+				s.repo.On("UpdatePrimary", mock.Anything, replicaID, &tc.primary.ID).Return(nil)
+			}
+
+			_, err := s.useCase.SetReplicaOf(ctx, s.validUUID.String(), replicaID.String(), tc.primary.Name)
+			if tc.wantErr != "" {
+				s.ErrorContains(err, tc.wantErr)
+				s.True(biz.IsErrValidation(err))
+				return
+			}
+
+			s.NoError(err)
+		})
+	}
+
+	s.Run("detach", func() {
+		s.resetMock()
+
+		replica := &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType, PrimaryID: &primaryID}
+		s.repo.On("FindByIDInOrg", mock.Anything, s.validUUID, replicaID).Return(replica, nil)
+		s.repo.On("UpdatePrimary", mock.Anything, replicaID, (*uuid.UUID)(nil)).Return(nil)
+
+		_, err := s.useCase.SetReplicaOf(ctx, s.validUUID.String(), replicaID.String(), "")
+		s.NoError(err)
+	})
+}
+
+func (s *casBackendTestSuite) TestValidateReplicaOf() {
+	ctx := context.Background()
+
+	replicaID := uuid.New()
+	limits := &biz.CASBackendLimits{MaxBytes: biz.MinCASBackendMaxBytes}
+	primary := &biz.CASBackend{ID: uuid.New(), Name: "primary", Provider: backendType, Limits: &biz.CASBackendLimits{MaxBytes: 2 * biz.MinCASBackendMaxBytes}}
+	replica := &biz.CASBackend{ID: replicaID, Name: "replica", Provider: backendType, Default: true, Limits: limits}
+
+	tests := []struct {
+		name      string
+		defaultB  *bool
+		fallbackB *bool
+		maxBytes  *int64
+		wantErr   string
+	}{
+		{
+			name:    "still the default backend",
+			wantErr: "default or fallback",
+		},
+		{
+			name:     "still too small",
+			defaultB: toPtrBool(false),
+			wantErr:  "max size",
+		},
+		{
+			name:      "becomes the fallback backend",
+			defaultB:  toPtrBool(false),
+			fallbackB: toPtrBool(true),
+			maxBytes:  biz.ToPtr(2 * biz.MinCASBackendMaxBytes),
+			wantErr:   "default or fallback",
+		},
+		{
+			name:     "valid once updated",
+			defaultB: toPtrBool(false),
+			maxBytes: biz.ToPtr(2 * biz.MinCASBackendMaxBytes),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.resetMock()
+
+			s.repo.On("FindByIDInOrg", mock.Anything, s.validUUID, replicaID).Return(replica, nil)
+			s.repo.On("FindByNameInOrg", mock.Anything, s.validUUID, primary.Name).Return(primary, nil)
+
+			err := s.useCase.ValidateReplicaOf(ctx, s.validUUID.String(), replicaID.String(), primary.Name, tc.defaultB, tc.fallbackB, tc.maxBytes)
+			if tc.wantErr != "" {
+				s.ErrorContains(err, tc.wantErr)
+				s.True(biz.IsErrValidation(err))
+				return
+			}
+
+			s.NoError(err)
+			// nothing gets updated
+			s.repo.AssertNotCalled(s.T(), "UpdatePrimary", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func (s *casBackendTestSuite) TestUpdateReplicaCannotBecomeDefault() {
+	replicaID := uuid.New()
+	replica := &biz.CASBackend{ID: replicaID, Provider: backendType, PrimaryID: biz.ToPtr(uuid.New())}
+	s.repo.On("FindByIDInOrg", mock.Anything, s.validUUID, replicaID).Return(replica, nil)
+
+	_, err := s.useCase.Update(context.Background(), s.validUUID.String(), replicaID.String(), nil, nil, toPtrBool(true), nil, nil)
+	s.ErrorContains(err, "a replica cannot be the default or fallback backend")
+}
+
+func TestCASBackendReadLocations(t *testing.T) {
+	healthyReplica := &biz.CASBackend{Name: "healthy-replica", ValidationStatus: biz.CASBackendValidationOK}
+	invalidReplica := &biz.CASBackend{Name: "invalid-replica", ValidationStatus: biz.CASBackendValidationFailed}
+
+	testCases := []struct {
+		name   string
+		status biz.CASBackendValidationStatus
+		want   []string
+	}{
+		{name: "healthy primary goes first", status: biz.CASBackendValidationOK, want: []string{"primary", "healthy-replica", "invalid-replica"}},
+		{name: "invalid primary falls back to the healthy replicas", status: biz.CASBackendValidationFailed, want: []string{"healthy-replica", "primary", "invalid-replica"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			primary := &biz.CASBackend{Name: "primary", ValidationStatus: tc.status, Replicas: []*biz.CASBackend{invalidReplica, healthyReplica}}
+
+			var got []string
+			for _, l := range primary.ReadLocations() {
+				got = append(got, l.Name)
+			}
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	t.Run("replica falls back to its primary", func(t *testing.T) {
+		primary := &biz.CASBackend{Name: "primary", ValidationStatus: biz.CASBackendValidationOK}
+		replica := &biz.CASBackend{Name: "replica", ValidationStatus: biz.CASBackendValidationOK, Primary: primary}
+
+		var got []string
+		for _, l := range replica.ReadLocations() {
+			got = append(got, l.Name)
+		}
+
+		assert.Equal(t, []string{"replica", "primary"}, got)
+	})
+}
+
 // Run all the tests
 func TestCASBackend(t *testing.T) {
 	suite.Run(t, new(casBackendTestSuite))
@@ -30,7 +30,6 @@ import (
 	"github.com/chainloop-dev/chainloop/pkg/otelx"
 	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
 	"github.com/go-kratos/kratos/v2/log"
-	"github.com/google/uuid"
 )
 
 var casClientTracer = otelx.Tracer("chainloop-controlplane", "biz/casclient")
@@ -46,11 +45,13 @@ type CASClientUseCase struct {
 }
 
 type CASUploader interface {
-	Upload(ctx context.Context, backendType, secretID string, orgID uuid.UUID, content io.Reader, filename, digest string) error
+	// Upload stores the content in the backend, the CAS mirrors it to its replicas
+	Upload(ctx context.Context, backend *CASBackend, content io.Reader, filename, digest string) error
 }
 
 type CASDownloader interface {
-	Download(ctx context.Context, backendType, secretID string, orgID uuid.UUID, w io.Writer, digest string) error
+	// Download retrieves the content from the backend, or from one of its replicas if it's not available
+	Download(ctx context.Context, backend *CASBackend, w io.Writer, digest string) error
 }
 
 type CASClient interface {
@@ -102,8 +103,8 @@ func NewCASClientUseCase(credsProvider *CASCredentialsUseCase, config *conf.Boot
 	return uc
 }
 
-// The secretID is embedded in the JWT token and is used to identify the secret by the CAS server
-func (uc *CASClientUseCase) Upload(ctx context.Context, backendType, secretID string, orgID uuid.UUID, content io.Reader, filename, digest string) error {
+// The backend secrets are embedded in the JWT token and are used to identify the secret by the CAS server
+func (uc *CASClientUseCase) Upload(ctx context.Context, backend *CASBackend, content io.Reader, filename, digest string) error {
 	ctx, span := otelx.Start(ctx, casClientTracer, "CASClientUseCase.Upload")
 	defer span.End()
 
@@ -111,7 +112,12 @@ func (uc *CASClientUseCase) Upload(ctx context.Context, backendType, secretID st
 
 	// client with temporary set of credentials
 	// SourceInternal flags this as the control plane's own traffic so the CAS doesn't emit audit events for it
-	client, closeFn, err := uc.casAPIClient(&CASCredsOpts{BackendType: backendType, SecretPath: secretID, Role: casJWT.Uploader, OrgID: orgID, SourceInternal: true})
+	creds := NewCASCredsOpts(backend, casJWT.Uploader)
+	creds.SourceInternal = true
+	// internal uploads are not subject to the backend size limits
+	creds.MaxBytes = 0
+
+	client, closeFn, err := uc.casAPIClient(creds)
 	if err != nil {
 		return fmt.Errorf("failed to create cas client: %w", err)
 	}
@@ -127,14 +133,17 @@ func (uc *CASClientUseCase) Upload(ctx context.Context, backendType, secretID st
 	return nil
 }
 
-func (uc *CASClientUseCase) Download(ctx context.Context, backendType, secretID string, orgID uuid.UUID, w io.Writer, digest string) error {
+func (uc *CASClientUseCase) Download(ctx context.Context, backend *CASBackend, w io.Writer, digest string) error {
 	ctx, span := otelx.Start(ctx, casClientTracer, "CASClientUseCase.Download")
 	defer span.End()
 
 	uc.logger.Infow("msg", "download initialized", "digest", digest)
 
 	// SourceInternal flags this as the control plane's own traffic so the CAS doesn't emit audit events for it
-	client, closeFn, err := uc.casAPIClient(&CASCredsOpts{BackendType: backendType, SecretPath: secretID, Role: casJWT.Downloader, OrgID: orgID, SourceInternal: true})
+	creds := NewCASCredsOpts(backend, casJWT.Downloader)
+	creds.SourceInternal = true
+
+	client, closeFn, err := uc.casAPIClient(creds)
 	if err != nil {
 		return fmt.Errorf("failed to create cas client: %w", err)
 	}
@@ -58,6 +58,33 @@ type CASCredsOpts struct {
 	// SourceInternal flags tokens minted for the control plane's own CAS
 	// client so the CAS can skip audit events for internal traffic
 	SourceInternal bool
+	// Replicas of the backend, uploads are mirrored to them and downloads fall back to them
+	Replicas []*CASBackend
+}
+
+// NewCASCredsOpts returns the options to operate on the given backend with the given role.
+// Uploads target the backend and get mirrored to its replicas, while downloads are served
+// from the first healthy location, falling back to the rest, see CASBackend.ReadLocations.
+func NewCASCredsOpts(backend *CASBackend, role robotaccount.Role) *CASCredsOpts {
+	locations := append([]*CASBackend{backend}, backend.Replicas...)
+	if role == robotaccount.Downloader {
+		locations = backend.ReadLocations()
+	}
+
+	primary := locations[0]
+	opts := &CASCredsOpts{
+		BackendType: string(primary.Provider),
+		SecretPath:  primary.SecretName,
+		Role:        role,
+		OrgID:       primary.OrganizationID,
+		Replicas:    locations[1:],
+	}
+
+	if primary.Limits != nil {
+		opts.MaxBytes = primary.Limits.MaxBytes
+	}
+
+	return opts
 }
 
 func (uc *CASCredentialsUseCase) GenerateTemporaryCredentials(backendRef *CASCredsOpts) (string, error) {
@@ -70,5 +97,13 @@ func (uc *CASCredentialsUseCase) GenerateTemporaryCredentials(backendRef *CASCre
 		opts = append(opts, robotaccount.WithSourceInternal())
 	}
 
+	for _, r := range backendRef.Replicas {
+		if r.Inline || r.SecretName == "" {
+			continue
+		}
+
+		opts = append(opts, robotaccount.WithReplicas(&robotaccount.Replica{BackendType: string(r.Provider), StoredSecretID: r.SecretName}))
+	}
+
 	return uc.jwtBuilder.GenerateJWT(backendRef.BackendType, backendRef.SecretPath, jwt.CASAudience, backendRef.Role, backendRef.MaxBytes, backendRef.OrgID.String(), opts...)
 }
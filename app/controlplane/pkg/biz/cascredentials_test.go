@@ -0,0 +1,41 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz_test
+
+import (
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	casJWT "github.com/chainloop-dev/chainloop/internal/robotaccount/cas"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCASCredsOpts(t *testing.T) {
+	orgID := uuid.New()
+	replica := &biz.CASBackend{Provider: "AWS-S3", SecretName: "replica", OrganizationID: orgID, ValidationStatus: biz.CASBackendValidationOK, Limits: &biz.CASBackendLimits{MaxBytes: 200}}
+	primary := &biz.CASBackend{Provider: "OCI", SecretName: "primary", OrganizationID: orgID, ValidationStatus: biz.CASBackendValidationFailed, Limits: &biz.CASBackendLimits{MaxBytes: 100}, Replicas: []*biz.CASBackend{replica}}
+
+	t.Run("uploads target the primary", func(t *testing.T) {
+		got := biz.NewCASCredsOpts(primary, casJWT.Uploader)
+		assert.Equal(t, &biz.CASCredsOpts{BackendType: "OCI", SecretPath: "primary", Role: casJWT.Uploader, MaxBytes: 100, OrgID: orgID, Replicas: []*biz.CASBackend{replica}}, got)
+	})
+
+	t.Run("downloads target the first healthy location", func(t *testing.T) {
+		got := biz.NewCASCredsOpts(primary, casJWT.Downloader)
+		assert.Equal(t, &biz.CASCredsOpts{BackendType: "AWS-S3", SecretPath: "replica", Role: casJWT.Downloader, MaxBytes: 200, OrgID: orgID, Replicas: []*biz.CASBackend{primary}}, got)
+	})
+}
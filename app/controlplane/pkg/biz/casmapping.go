@@ -62,6 +62,38 @@ type CASMappingRepo interface {
 	// backends an artifact already has. Kept here because it must ship in the same release the
 	// platform bumps to for the product scope itself.
 	ListByDigestInOrg(ctx context.Context, digest string, orgID uuid.UUID) ([]*CASMapping, error)
+	// FindExpired returns a page of the digests stored in the CAS backend that can be garbage collected
+	FindExpired(ctx context.Context, casBackendID uuid.UUID, opts *CASMappingExpiredOpts) (*CASMappingExpiredPage, error)
+	// MarkForDeletion checks again, while preventing new mappings to the backend storage, that the digest
+	// can be garbage collected. If so, it marks the digest as being deleted, which rejects new mappings to it
+	// in the backends sharing the storage until FinishDeletion or CancelDeletion are called.
+	// It reports whether the digest was marked.
+	MarkForDeletion(ctx context.Context, digest string, casBackendID uuid.UUID, opts *CASMappingExpiredOpts) (bool, error)
+	// FinishDeletion removes the mappings of a digest marked for deletion, once its blob has been deleted
+	FinishDeletion(ctx context.Context, digest string, casBackendID uuid.UUID) error
+	// CancelDeletion unmarks a digest whose blob could not be deleted
+	CancelDeletion(ctx context.Context, digest string, casBackendID uuid.UUID) error
+	// ListMarkedForDeletion returns the digests of the backend still marked for deletion, i.e by an interrupted sweep
+	ListMarkedForDeletion(ctx context.Context, casBackendID uuid.UUID) ([]string, error)
+}
+
+// CASMappingExpiredOpts narrows down which artifacts of a CAS backend can be deleted.
+// A digest is eligible when every mapping to the backend, if any, was created before OlderThan,
+// and no other CAS backend pointing to the same storage location references it, since deleting
+// the blob would break those too.
+type CASMappingExpiredOpts struct {
+	OlderThan time.Time
+	// Skip the digests referenced by workflow runs of released project versions
+	KeepReleasedVersions bool
+	// Digests are returned in lexicographical order starting after this one
+	AfterDigest string
+	Limit       int
+}
+
+type CASMappingExpiredPage struct {
+	Digests []string
+	// Last digest examined, to be used as AfterDigest to get the next page. Empty when there are no more.
+	NextDigest string
 }
 
 type CASMappingUseCase struct {
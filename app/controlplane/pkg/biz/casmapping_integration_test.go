@@ -567,6 +567,25 @@ func (s *casMappingIntegrationSuite) TestCreate() {
 	}
 }
 
+func (s *casMappingIntegrationSuite) TestCreateWhileMarkedForDeletion() {
+	ctx := context.Background()
+
+	// the retention sweeper is deleting the blob from the storage of the backend
+	require.NoError(s.T(), s.Data.DB.CASBlobDeletion.Create().SetDigest(validDigest).SetCasBackendID(s.casBackend1.ID).Exec(ctx))
+
+	_, err := s.CASMapping.Create(ctx, validDigest, s.casBackend1.ID.String(), nil)
+	s.ErrorContains(err, "being garbage collected")
+
+	// other blobs are not affected
+	_, err = s.CASMapping.Create(ctx, validDigest2, s.casBackend1.ID.String(), nil)
+	s.NoError(err)
+
+	_, err = s.Data.DB.CASBlobDeletion.Delete().Exec(ctx)
+	require.NoError(s.T(), err)
+	_, err = s.CASMapping.Create(ctx, validDigest, s.casBackend1.ID.String(), nil)
+	s.NoError(err)
+}
+
 type casMappingIntegrationSuite struct {
 	testhelpers.UseCasesEachTestSuite
 	casBackend1, casBackend2, casBackend3  *biz.CASBackend
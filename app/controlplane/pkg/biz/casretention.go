@@ -0,0 +1,202 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var casRetentionTracer = otelx.Tracer("chainloop-controlplane", "biz/casretention")
+
+// CASRetentionRule defines for how long the artifacts stored in a CAS backend are kept
+type CASRetentionRule struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	// CAS backend the rule applies to. Nil for the organization default rule, which
+	// applies to every backend of the organization without a rule of its own.
+	CASBackend *CASBackend
+	// Artifacts not referenced during this number of days are eligible for deletion
+	MaxAgeDays int
+	// Never delete artifacts referenced by workflow runs of released project versions
+	KeepReleasedVersions              bool
+	CreatedAt, UpdatedAt, LastSweptAt *time.Time
+}
+
+// IsDefault reports whether the rule is the organization default
+func (r *CASRetentionRule) IsDefault() bool {
+	return r.CASBackend == nil
+}
+
+type CASRetentionRuleUpsertOpts struct {
+	OrgID uuid.UUID
+	// Nil to set the organization default rule
+	CASBackendID         *uuid.UUID
+	MaxAgeDays           int
+	KeepReleasedVersions bool
+}
+
+type CASRetentionRuleRepo interface {
+	// Upsert creates the rule for the CAS backend, or the organization default, or replaces the existing one
+	Upsert(ctx context.Context, opts *CASRetentionRuleUpsertOpts) (*CASRetentionRule, error)
+	List(ctx context.Context, orgID uuid.UUID) ([]*CASRetentionRule, error)
+	// ListAll returns the rules across all organizations
+	ListAll(ctx context.Context) ([]*CASRetentionRule, error)
+	FindByIDInOrg(ctx context.Context, orgID, id uuid.UUID) (*CASRetentionRule, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	MarkSwept(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type CASRetentionUseCase struct {
+	repo           CASRetentionRuleRepo
+	casBackendRepo CASBackendRepo
+	auditorUC      *AuditorUseCase
+	logger         *log.Helper
+}
+
+func NewCASRetentionUseCase(repo CASRetentionRuleRepo, casBackendRepo CASBackendRepo, auditorUC *AuditorUseCase, logger log.Logger) *CASRetentionUseCase {
+	return &CASRetentionUseCase{
+		repo:           repo,
+		casBackendRepo: casBackendRepo,
+		auditorUC:      auditorUC,
+		logger:         servicelogger.ScopedHelper(logger, "biz/cas-retention"),
+	}
+}
+
+type CASRetentionRuleSetOpts struct {
+	// Name of the CAS backend, empty to set the organization default rule
+	CASBackendName       string
+	MaxAgeDays           int
+	KeepReleasedVersions bool
+}
+
+// Set creates or replaces the retention rule of a CAS backend, or the organization default one
+func (uc *CASRetentionUseCase) Set(ctx context.Context, orgID string, opts *CASRetentionRuleSetOpts) (*CASRetentionRule, error) {
+	ctx, span := otelx.Start(ctx, casRetentionTracer, "CASRetentionUseCase.Set")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	if opts == nil || opts.MaxAgeDays <= 0 {
+		return nil, NewErrValidationStr("the maximum age must be a positive number of days")
+	}
+
+	upsertOpts := &CASRetentionRuleUpsertOpts{
+		OrgID:                orgUUID,
+		MaxAgeDays:           opts.MaxAgeDays,
+		KeepReleasedVersions: opts.KeepReleasedVersions,
+	}
+
+	var backend *CASBackend
+	if opts.CASBackendName != "" {
+		backend, err = uc.casBackendRepo.FindByNameInOrg(ctx, orgUUID, opts.CASBackendName)
+		if err != nil {
+			return nil, fmt.Errorf("finding CAS backend: %w", err)
+		} else if backend == nil {
+			return nil, NewErrNotFound("CAS backend")
+		}
+
+		if backend.Inline {
+			return nil, NewErrValidationStr("inline CAS backends embed the artifacts in the attestation, they can't have a retention rule")
+		}
+
+		upsertOpts.CASBackendID = &backend.ID
+	}
+
+	rule, err := uc.repo.Upsert(ctx, upsertOpts)
+	if err != nil {
+		return nil, fmt.Errorf("storing retention rule: %w", err)
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.CASRetentionRuleSet{
+			CASRetentionRuleBase: retentionRuleEventBase(rule),
+			MaxAgeDays:           rule.MaxAgeDays,
+			KeepReleasedVersions: rule.KeepReleasedVersions,
+		}, &orgUUID)
+	}
+
+	return rule, nil
+}
+
+// List returns the retention rules of the organization
+func (uc *CASRetentionUseCase) List(ctx context.Context, orgID string) ([]*CASRetentionRule, error) {
+	ctx, span := otelx.Start(ctx, casRetentionTracer, "CASRetentionUseCase.List")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	return uc.repo.List(ctx, orgUUID)
+}
+
+// Delete removes a retention rule, the artifacts it covered are kept forever from now on
+// unless there is an organization default rule
+func (uc *CASRetentionUseCase) Delete(ctx context.Context, orgID, ruleID string) error {
+	ctx, span := otelx.Start(ctx, casRetentionTracer, "CASRetentionUseCase.Delete")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return NewErrInvalidUUID(err)
+	}
+
+	ruleUUID, err := uuid.Parse(ruleID)
+	if err != nil {
+		return NewErrInvalidUUID(err)
+	}
+
+	rule, err := uc.repo.FindByIDInOrg(ctx, orgUUID, ruleUUID)
+	if err != nil {
+		return fmt.Errorf("finding retention rule: %w", err)
+	} else if rule == nil {
+		return NewErrNotFound("retention rule")
+	}
+
+	if err := uc.repo.Delete(ctx, rule.ID); err != nil {
+		return fmt.Errorf("deleting retention rule: %w", err)
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.CASRetentionRuleDeleted{
+			CASRetentionRuleBase: retentionRuleEventBase(rule),
+		}, &orgUUID)
+	}
+
+	return nil
+}
+
+func retentionRuleEventBase(rule *CASRetentionRule) *events.CASRetentionRuleBase {
+	base := &events.CASRetentionRuleBase{RuleID: &rule.ID}
+	if rule.CASBackend != nil {
+		base.CASBackendID = &rule.CASBackend.ID
+		base.CASBackendName = rule.CASBackend.Name
+	}
+
+	return base
+}
@@ -0,0 +1,378 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+	cr_v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/uuid"
+)
+
+var casRetentionSweeperTracer = otelx.Tracer("chainloop-controlplane", "biz/casretention_sweeper")
+
+const (
+	defaultRetentionSweepInterval = 6 * time.Hour
+	// Upper bound on how long a single sweep is allowed to hold the distributed lock,
+	// the next tick will pick up where it stopped
+	defaultRetentionMaxSweepDuration = 1 * time.Hour
+	// Number of digests examined per query
+	retentionSweepPageSize = 100
+
+	lockKeyRetentionSweeper = "cas-retention-sweeper"
+)
+
+// CASRetentionSweeper periodically garbage collects the artifacts of the CAS backends
+// that have a retention rule. Only backends implementing backend.Deleter are swept.
+type CASRetentionSweeper struct {
+	logger         *log.Helper
+	ruleRepo       CASRetentionRuleRepo
+	casBackendRepo CASBackendRepo
+	casMappingRepo CASMappingRepo
+	providers      backend.Providers
+	auditorUC      *AuditorUseCase
+	lock           DistributedLock
+}
+
+type CASRetentionSweeperOpts struct {
+	// Interval between sweeps, defaults to 6 hours
+	CheckInterval time.Duration
+	// Initial delay before the first sweep. If not set, runs immediately.
+	InitialDelay time.Duration
+}
+
+func NewCASRetentionSweeper(logger log.Logger, ruleRepo CASRetentionRuleRepo, casBackendRepo CASBackendRepo, casMappingRepo CASMappingRepo, providers backend.Providers, auditorUC *AuditorUseCase, lock DistributedLock) *CASRetentionSweeper {
+	return &CASRetentionSweeper{
+		logger:         log.NewHelper(log.With(logger, "component", "biz/CASRetentionSweeper")),
+		ruleRepo:       ruleRepo,
+		casBackendRepo: casBackendRepo,
+		casMappingRepo: casMappingRepo,
+		providers:      providers,
+		auditorUC:      auditorUC,
+		lock:           lock,
+	}
+}
+
+// Start runs the sweeper periodically until the context is cancelled
+func (s *CASRetentionSweeper) Start(ctx context.Context, opts *CASRetentionSweeperOpts) {
+	interval := defaultRetentionSweepInterval
+	if opts != nil && opts.CheckInterval > 0 {
+		interval = opts.CheckInterval
+	}
+
+	var initialDelay time.Duration
+	if opts != nil && opts.InitialDelay > 0 {
+		initialDelay = opts.InitialDelay
+	}
+
+	s.logger.Infow("msg", "CAS retention sweeper configured", "initialDelay", initialDelay, "interval", interval)
+
+	timer := time.NewTimer(initialDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("CAS retention sweeper stopping due to context cancellation")
+			return
+		case <-timer.C:
+			if err := s.Sweep(ctx); err != nil {
+				s.logger.Errorf("CAS retention sweep failed: %v", err)
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// Sweep applies the retention rules of every organization once
+func (s *CASRetentionSweeper) Sweep(ctx context.Context) error {
+	acquired, release, err := s.lock.TryAcquire(ctx, lockKeyRetentionSweeper)
+	if err != nil {
+		return fmt.Errorf("acquiring sweeper lock: %w", err)
+	}
+	if !acquired {
+		s.logger.Debug("another replica is running the CAS retention sweep, skipping")
+		return nil
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(ctx, defaultRetentionMaxSweepDuration)
+	defer cancel()
+
+	ctx, span := otelx.Start(ctx, casRetentionSweeperTracer, "CASRetentionSweeper.Sweep")
+	defer span.End()
+
+	rules, err := s.ruleRepo.ListAll(ctx)
+	if err != nil {
+		return fmt.Errorf("listing retention rules: %w", err)
+	}
+
+	// Group the rules by organization, a backend specific rule takes precedence over the org default
+	byOrg := make(map[uuid.UUID][]*CASRetentionRule)
+	var orgs []uuid.UUID
+	for _, r := range rules {
+		if _, ok := byOrg[r.OrganizationID]; !ok {
+			orgs = append(orgs, r.OrganizationID)
+		}
+		byOrg[r.OrganizationID] = append(byOrg[r.OrganizationID], r)
+	}
+
+	for _, orgID := range orgs {
+		backends, err := s.casBackendRepo.List(ctx, orgID)
+		if err != nil {
+			return fmt.Errorf("listing CAS backends: %w", err)
+		}
+
+		for _, b := range backends {
+			if b.Inline {
+				continue
+			}
+
+			rule := ruleForBackend(byOrg[orgID], b)
+			if rule == nil {
+				// the rule might have been removed after a sweep was interrupted
+				s.cancelDeletions(ctx, b)
+				continue
+			}
+
+			deleted, err := s.sweepBackend(ctx, b, rule)
+			if err != nil {
+				// keep going with the rest of backends, a misconfigured one should not block the others
+				s.logger.Errorw("msg", "sweeping CAS backend", "backend", b.ID, "error", err)
+				continue
+			}
+
+			if deleted > 0 {
+				s.logger.Infow("msg", "CAS backend swept", "backend", b.ID, "deleted", deleted)
+			}
+		}
+
+		for _, r := range byOrg[orgID] {
+			if err := s.ruleRepo.MarkSwept(ctx, r.ID, time.Now()); err != nil {
+				s.logger.Errorw("msg", "marking retention rule as swept", "rule", r.ID, "error", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ruleForBackend returns the rule that applies to the backend, if any
+func ruleForBackend(rules []*CASRetentionRule, b *CASBackend) *CASRetentionRule {
+	var orgDefault *CASRetentionRule
+	for _, r := range rules {
+		if r.IsDefault() {
+			orgDefault = r
+		} else if r.CASBackend.ID == b.ID {
+			return r
+		}
+	}
+
+	return orgDefault
+}
+
+// sweepBackend deletes the expired artifacts of the backend and returns how many were removed
+func (s *CASRetentionSweeper) sweepBackend(ctx context.Context, b *CASBackend, rule *CASRetentionRule) (int, error) {
+	provider, ok := s.providers[string(b.Provider)]
+	if !ok {
+		return 0, fmt.Errorf("CAS backend provider not found: %s", b.Provider)
+	}
+
+	storage, err := provider.FromCredentials(ctx, b.SecretName)
+	if err != nil {
+		return 0, fmt.Errorf("loading CAS backend: %w", err)
+	}
+
+	deleter, ok := storage.(backend.Deleter)
+	if !ok {
+		s.logger.Debugw("msg", "CAS backend does not support deletion, skipping", "backend", b.ID, "provider", b.Provider)
+		return 0, nil
+	}
+
+	opts := &CASMappingExpiredOpts{
+		OlderThan:            time.Now().AddDate(0, 0, -rule.MaxAgeDays),
+		KeepReleasedVersions: rule.KeepReleasedVersions,
+		Limit:                retentionSweepPageSize,
+	}
+
+	var deleted int
+	if err := s.resumeDeletions(ctx, deleter, b, rule, opts, &deleted); err != nil {
+		return deleted, err
+	}
+
+	for {
+		page, err := s.casMappingRepo.FindExpired(ctx, b.ID, opts)
+		if err != nil {
+			return deleted, fmt.Errorf("finding expired artifacts: %w", err)
+		}
+
+		for _, digest := range page.Digests {
+			if err := s.deleteArtifact(ctx, deleter, b, rule, digest, opts, &deleted); err != nil {
+				return deleted, err
+			}
+		}
+
+		if page.NextDigest == "" {
+			break
+		}
+
+		opts.AfterDigest = page.NextDigest
+	}
+
+	// Blobs uploaded but never referenced by an attestation have no mappings, they can only be found by
+	// walking the storage. An attestation in progress might be about to reference one of them though, the
+	// Artifact CAS skips the upload of the blobs that already exist. That's why only the storages that can
+	// record those skipped uploads are walked, see backend.Toucher, the modification time of the blob is
+	// then the last time it was uploaded or reused, and the threshold, days, exceeds by far how long an
+	// attestation can be in progress.
+	lister, ok := storage.(backend.Lister)
+	if !ok {
+		return deleted, nil
+	}
+
+	if _, ok := storage.(backend.Toucher); !ok {
+		return deleted, nil
+	}
+
+	err = lister.List(ctx, func(hex string, modifiedAt time.Time) error {
+		if !modifiedAt.Before(opts.OlderThan) {
+			return nil
+		}
+
+		return s.deleteArtifact(ctx, deleter, b, rule, fmt.Sprintf("sha256:%s", hex), opts, &deleted)
+	})
+	if err != nil {
+		return deleted, fmt.Errorf("listing artifacts: %w", err)
+	}
+
+	return deleted, nil
+}
+
+// resumeDeletions goes through the digests left marked for deletion by an interrupted sweep
+func (s *CASRetentionSweeper) resumeDeletions(ctx context.Context, deleter backend.Deleter, b *CASBackend, rule *CASRetentionRule, opts *CASMappingExpiredOpts, deleted *int) error {
+	digests, err := s.casMappingRepo.ListMarkedForDeletion(ctx, b.ID)
+	if err != nil {
+		return fmt.Errorf("listing artifacts marked for deletion: %w", err)
+	}
+
+	for _, digest := range digests {
+		if err := s.deleteArtifact(ctx, deleter, b, rule, digest, opts, deleted); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cancelDeletions unmarks the digests of a backend left marked for deletion by an interrupted sweep
+func (s *CASRetentionSweeper) cancelDeletions(ctx context.Context, b *CASBackend) {
+	digests, err := s.casMappingRepo.ListMarkedForDeletion(ctx, b.ID)
+	if err != nil {
+		s.logger.Errorw("msg", "listing artifacts marked for deletion", "backend", b.ID, "error", err)
+		return
+	}
+
+	for _, digest := range digests {
+		if err := s.casMappingRepo.CancelDeletion(ctx, digest, b.ID); err != nil {
+			s.logger.Errorw("msg", "unmarking artifact", "backend", b.ID, "digest", digest, "error", err)
+		}
+	}
+}
+
+// deleteArtifact deletes the blob and its mappings if it's still not referenced, increasing the deleted counter.
+// Failures are logged so the rest of artifacts are processed, only a cancelled or expired context is returned.
+func (s *CASRetentionSweeper) deleteArtifact(ctx context.Context, deleter backend.Deleter, b *CASBackend, rule *CASRetentionRule, digest string, opts *CASMappingExpiredOpts, deleted *int) error {
+	ok, err := s.deleteIfUnreferenced(ctx, deleter, b, rule, digest, opts)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		s.logger.Errorw("msg", "deleting artifact", "backend", b.ID, "digest", digest, "error", err)
+		return nil
+	}
+
+	if ok {
+		*deleted++
+	}
+
+	return nil
+}
+
+// deleteIfUnreferenced checks again that the artifact is not referenced and marks it for deletion, which
+// prevents new references from being created, and then removes the blob and its mappings.
+func (s *CASRetentionSweeper) deleteIfUnreferenced(ctx context.Context, deleter backend.Deleter, b *CASBackend, rule *CASRetentionRule, digest string, opts *CASMappingExpiredOpts) (bool, error) {
+	marked, err := s.casMappingRepo.MarkForDeletion(ctx, digest, b.ID, opts)
+	if err != nil {
+		return false, err
+	} else if !marked {
+		// referenced after all, drop the mark an interrupted sweep might have left, i.e if the rule changed since
+		return false, s.casMappingRepo.CancelDeletion(ctx, digest, b.ID)
+	}
+
+	if err := s.deleteMarked(ctx, deleter, b, digest); err != nil {
+		return false, err
+	}
+
+	if s.auditorUC != nil {
+		s.auditorUC.Dispatch(ctx, &events.CASArtifactDeleted{
+			CASArtifactBase: &events.CASArtifactBase{
+				Digest:      digest,
+				BackendType: displayProvider(b),
+			},
+			CASBackendID:    &b.ID,
+			CASBackendName:  b.Name,
+			RetentionRuleID: &rule.ID,
+			MaxAgeDays:      rule.MaxAgeDays,
+		}, &b.OrganizationID)
+	}
+
+	return true, nil
+}
+
+// deleteMarked deletes the blob of a digest marked for deletion, out of any transaction since it might take
+// a while, and then its mappings. If the blob can't be deleted the mark is removed and the mappings are kept,
+// so the digest is retried in the next sweep.
+func (s *CASRetentionSweeper) deleteMarked(ctx context.Context, deleter backend.Deleter, b *CASBackend, digest string) error {
+	h, err := cr_v1.NewHash(digest)
+	if err != nil {
+		return fmt.Errorf("invalid digest: %w", err)
+	}
+
+	// the blobs are stored by their hex encoded sum
+	if err := deleter.Delete(ctx, h.Hex); err != nil {
+		// the context might be done already, keep the mark from blocking new uploads of the digest
+		if cancelErr := s.casMappingRepo.CancelDeletion(context.WithoutCancel(ctx), digest, b.ID); cancelErr != nil {
+			s.logger.Errorw("msg", "unmarking artifact", "backend", b.ID, "digest", digest, "error", cancelErr)
+		}
+
+		return fmt.Errorf("deleting blob: %w", err)
+	}
+
+	if err := s.casMappingRepo.FinishDeletion(ctx, digest, b.ID); err != nil {
+		return fmt.Errorf("deleting mappings: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,295 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	bizMocks "github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/mocks"
+	backends "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	blobM "github.com/chainloop-dev/chainloop/pkg/blobmanager/mocks"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCASRetentionSet(t *testing.T) {
+	orgID := uuid.New()
+	backendID := uuid.New()
+
+	testCases := []struct {
+		name    string
+		orgID   string
+		opts    *biz.CASRetentionRuleSetOpts
+		backend *biz.CASBackend
+		wantErr string
+		// expected backend in the upsert, nil for the org default
+		wantBackendID *uuid.UUID
+	}{
+		{
+			name:    "invalid org",
+			orgID:   "invalid",
+			opts:    &biz.CASRetentionRuleSetOpts{MaxAgeDays: 10},
+			wantErr: "invalid UUID",
+		},
+		{
+			name:    "max age is required",
+			orgID:   orgID.String(),
+			opts:    &biz.CASRetentionRuleSetOpts{},
+			wantErr: "positive number of days",
+		},
+		{
+			name:    "inline backends can't have rules",
+			orgID:   orgID.String(),
+			opts:    &biz.CASRetentionRuleSetOpts{CASBackendName: "inline", MaxAgeDays: 10},
+			backend: &biz.CASBackend{ID: backendID, Name: "inline", Inline: true},
+			wantErr: "inline CAS backends",
+		},
+		{
+			name:  "organization default",
+			orgID: orgID.String(),
+			opts:  &biz.CASRetentionRuleSetOpts{MaxAgeDays: 10, KeepReleasedVersions: true},
+		},
+		{
+			name:          "backend rule",
+			orgID:         orgID.String(),
+			opts:          &biz.CASRetentionRuleSetOpts{CASBackendName: "my-bucket", MaxAgeDays: 10},
+			backend:       &biz.CASBackend{ID: backendID, Name: "my-bucket"},
+			wantBackendID: &backendID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := bizMocks.NewCASRetentionRuleRepo(t)
+			backendRepo := bizMocks.NewCASBackendRepo(t)
+			uc := biz.NewCASRetentionUseCase(repo, backendRepo, nil, log.NewStdLogger(io.Discard))
+
+			if tc.backend != nil {
+				backendRepo.On("FindByNameInOrg", mock.Anything, orgID, tc.opts.CASBackendName).Return(tc.backend, nil)
+			}
+
+			if tc.wantErr == "" {
+				repo.On("Upsert", mock.Anything, &biz.CASRetentionRuleUpsertOpts{
+					OrgID:                orgID,
+					CASBackendID:         tc.wantBackendID,
+					MaxAgeDays:           tc.opts.MaxAgeDays,
+					KeepReleasedVersions: tc.opts.KeepReleasedVersions,
+				}).Return(&biz.CASRetentionRule{ID: uuid.New(), OrganizationID: orgID, CASBackend: tc.backend, MaxAgeDays: tc.opts.MaxAgeDays}, nil)
+			}
+
+			rule, err := uc.Set(context.Background(), tc.orgID, tc.opts)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.opts.MaxAgeDays, rule.MaxAgeDays)
+		})
+	}
+}
+
+type fakeLock struct {
+	acquired bool
+}
+
+func (l *fakeLock) TryAcquire(_ context.Context, _ string) (bool, func(), error) {
+	return l.acquired, func() {}, nil
+}
+
+// deletableStorage is a CAS backend that supports deletion
+type deletableStorage struct {
+	*blobM.UploaderDownloader
+	*blobM.Deleter
+}
+
+// listableStorage is a CAS backend that supports deletion, listing its artifacts and refreshing them
+type listableStorage struct {
+	*blobM.UploaderDownloader
+	*blobM.Deleter
+	*blobM.Lister
+	*blobM.Toucher
+}
+
+// listableOnlyStorage can list its artifacts but can't tell when they were reused
+type listableOnlyStorage struct {
+	*blobM.UploaderDownloader
+	*blobM.Deleter
+	*blobM.Lister
+}
+
+func TestCASRetentionSweep(t *testing.T) {
+	orgID := uuid.New()
+	ociBackend := &biz.CASBackend{ID: uuid.New(), Name: "oci", Provider: "OCI", SecretName: "oci-secret", OrganizationID: orgID}
+	s3Backend := &biz.CASBackend{ID: uuid.New(), Name: "s3", Provider: "AWS-S3", SecretName: "s3-secret", OrganizationID: orgID}
+	inlineBackend := &biz.CASBackend{ID: uuid.New(), Name: "inline", Provider: biz.CASBackendInline, Inline: true, OrganizationID: orgID}
+
+	orgDefault := &biz.CASRetentionRule{ID: uuid.New(), OrganizationID: orgID, MaxAgeDays: 30, KeepReleasedVersions: true}
+	s3Rule := &biz.CASRetentionRule{ID: uuid.New(), OrganizationID: orgID, CASBackend: s3Backend, MaxAgeDays: 7}
+
+	hex1 := strings.Repeat("1", 64)
+	hex2 := strings.Repeat("2", 64)
+	hex3 := strings.Repeat("3", 64)
+	hex4 := strings.Repeat("4", 64)
+
+	t.Run("another replica holds the lock", func(t *testing.T) {
+		ruleRepo := bizMocks.NewCASRetentionRuleRepo(t)
+		sweeper := biz.NewCASRetentionSweeper(log.NewStdLogger(io.Discard), ruleRepo, bizMocks.NewCASBackendRepo(t), bizMocks.NewCASMappingRepo(t), nil, nil, &fakeLock{})
+		require.NoError(t, sweeper.Sweep(context.Background()))
+	})
+
+	t.Run("rules are applied per backend", func(t *testing.T) {
+		ruleRepo := bizMocks.NewCASRetentionRuleRepo(t)
+		backendRepo := bizMocks.NewCASBackendRepo(t)
+		mappingRepo := bizMocks.NewCASMappingRepo(t)
+		ociProvider := blobM.NewProvider(t)
+		s3Provider := blobM.NewProvider(t)
+
+		ruleRepo.On("ListAll", mock.Anything).Return([]*biz.CASRetentionRule{orgDefault, s3Rule}, nil)
+		backendRepo.On("List", mock.Anything, orgID).Return([]*biz.CASBackend{ociBackend, s3Backend, inlineBackend}, nil)
+
+		// The OCI backend supports deletion and is governed by the organization default
+		deleter := blobM.NewDeleter(t)
+		ociProvider.On("FromCredentials", mock.Anything, "oci-secret").Return(&deletableStorage{blobM.NewUploaderDownloader(t), deleter}, nil)
+		expiredOpts := mock.MatchedBy(func(o *biz.CASMappingExpiredOpts) bool {
+			return o.KeepReleasedVersions && o.OlderThan.Before(time.Now().AddDate(0, 0, -29))
+		})
+		mappingRepo.On("FindExpired", mock.Anything, ociBackend.ID, expiredOpts).Return(&biz.CASMappingExpiredPage{Digests: []string{"sha256:" + hex1, "sha256:" + hex2}, NextDigest: "sha256:" + hex2}, nil).Once()
+		mappingRepo.On("FindExpired", mock.Anything, ociBackend.ID, expiredOpts).Return(&biz.CASMappingExpiredPage{Digests: []string{"sha256:" + hex3, "sha256:" + hex4}}, nil).Once()
+
+		mappingRepo.EXPECT().ListMarkedForDeletion(mock.Anything, ociBackend.ID).Return(nil, nil)
+		for _, h := range []string{hex1, hex2, hex3} {
+			mappingRepo.EXPECT().MarkForDeletion(mock.Anything, "sha256:"+h, ociBackend.ID, expiredOpts).Return(true, nil)
+		}
+		// the blobs are deleted by their hex encoded sum, and then their mappings
+		deleter.On("Delete", mock.Anything, hex1).Return(nil)
+		deleter.On("Delete", mock.Anything, hex3).Return(nil)
+		mappingRepo.EXPECT().FinishDeletion(mock.Anything, "sha256:"+hex1, ociBackend.ID).Return(nil)
+		mappingRepo.EXPECT().FinishDeletion(mock.Anything, "sha256:"+hex3, ociBackend.ID).Return(nil)
+		// failing to delete the blob keeps the mappings so it's retried later
+		deleter.On("Delete", mock.Anything, hex2).Return(errors.New("boom"))
+		mappingRepo.EXPECT().CancelDeletion(mock.Anything, "sha256:"+hex2, ociBackend.ID).Return(nil)
+		// referenced again by the time it's deleted
+		mappingRepo.EXPECT().MarkForDeletion(mock.Anything, "sha256:"+hex4, ociBackend.ID, expiredOpts).Return(false, nil)
+		mappingRepo.EXPECT().CancelDeletion(mock.Anything, "sha256:"+hex4, ociBackend.ID).Return(nil)
+
+		// The s3 backend has its own rule but can't delete blobs, so it's left untouched
+		s3Provider.On("FromCredentials", mock.Anything, "s3-secret").Return(blobM.NewUploaderDownloader(t), nil)
+
+		ruleRepo.On("MarkSwept", mock.Anything, orgDefault.ID, mock.Anything).Return(nil)
+		ruleRepo.On("MarkSwept", mock.Anything, s3Rule.ID, mock.Anything).Return(nil)
+
+		providers := backends.Providers{"OCI": ociProvider, "AWS-S3": s3Provider}
+		sweeper := biz.NewCASRetentionSweeper(log.NewStdLogger(io.Discard), ruleRepo, backendRepo, mappingRepo, providers, nil, &fakeLock{acquired: true})
+		require.NoError(t, sweeper.Sweep(context.Background()))
+
+		deleter.AssertNotCalled(t, "Delete", mock.Anything, hex4)
+		mappingRepo.AssertNotCalled(t, "FindExpired", mock.Anything, s3Backend.ID, mock.Anything)
+	})
+
+	t.Run("deletions of an interrupted sweep are resumed", func(t *testing.T) {
+		ruleRepo := bizMocks.NewCASRetentionRuleRepo(t)
+		backendRepo := bizMocks.NewCASBackendRepo(t)
+		mappingRepo := bizMocks.NewCASMappingRepo(t)
+		ociProvider := blobM.NewProvider(t)
+
+		ruleRepo.On("ListAll", mock.Anything).Return([]*biz.CASRetentionRule{s3Rule}, nil)
+		// the OCI backend doesn't have a rule anymore, so its marks are dropped
+		backendRepo.On("List", mock.Anything, orgID).Return([]*biz.CASBackend{ociBackend, s3Backend}, nil)
+		mappingRepo.EXPECT().ListMarkedForDeletion(mock.Anything, ociBackend.ID).Return([]string{"sha256:" + hex2}, nil)
+		mappingRepo.EXPECT().CancelDeletion(mock.Anything, "sha256:"+hex2, ociBackend.ID).Return(nil)
+
+		deleter := blobM.NewDeleter(t)
+		s3Provider := blobM.NewProvider(t)
+		s3Provider.On("FromCredentials", mock.Anything, "s3-secret").Return(&deletableStorage{blobM.NewUploaderDownloader(t), deleter}, nil)
+		mappingRepo.EXPECT().ListMarkedForDeletion(mock.Anything, s3Backend.ID).Return([]string{"sha256:" + hex1}, nil)
+		// checked again in case the rule changed
+		mappingRepo.EXPECT().MarkForDeletion(mock.Anything, "sha256:"+hex1, s3Backend.ID, mock.Anything).Return(true, nil)
+		deleter.On("Delete", mock.Anything, hex1).Return(nil)
+		mappingRepo.EXPECT().FinishDeletion(mock.Anything, "sha256:"+hex1, s3Backend.ID).Return(nil)
+		mappingRepo.On("FindExpired", mock.Anything, s3Backend.ID, mock.Anything).Return(&biz.CASMappingExpiredPage{}, nil)
+		ruleRepo.On("MarkSwept", mock.Anything, s3Rule.ID, mock.Anything).Return(nil)
+
+		providers := backends.Providers{"OCI": ociProvider, "AWS-S3": s3Provider}
+		sweeper := biz.NewCASRetentionSweeper(log.NewStdLogger(io.Discard), ruleRepo, backendRepo, mappingRepo, providers, nil, &fakeLock{acquired: true})
+		require.NoError(t, sweeper.Sweep(context.Background()))
+	})
+
+	t.Run("never attested blobs are found by listing the storage", func(t *testing.T) {
+		ruleRepo := bizMocks.NewCASRetentionRuleRepo(t)
+		backendRepo := bizMocks.NewCASBackendRepo(t)
+		mappingRepo := bizMocks.NewCASMappingRepo(t)
+		s3Provider := blobM.NewProvider(t)
+
+		ruleRepo.On("ListAll", mock.Anything).Return([]*biz.CASRetentionRule{s3Rule}, nil)
+		backendRepo.On("List", mock.Anything, orgID).Return([]*biz.CASBackend{s3Backend}, nil)
+
+		deleter := blobM.NewDeleter(t)
+		lister := blobM.NewLister(t)
+		s3Provider.On("FromCredentials", mock.Anything, "s3-secret").Return(&listableStorage{blobM.NewUploaderDownloader(t), deleter, lister, blobM.NewToucher(t)}, nil)
+		mappingRepo.EXPECT().ListMarkedForDeletion(mock.Anything, s3Backend.ID).Return(nil, nil)
+		mappingRepo.On("FindExpired", mock.Anything, s3Backend.ID, mock.Anything).Return(&biz.CASMappingExpiredPage{}, nil)
+
+		lister.On("List", mock.Anything, mock.Anything).Return(func(_ context.Context, fn func(string, time.Time) error) error {
+			// old enough, and recently uploaded, maybe by an attestation in progress
+			if err := fn(hex1, time.Now().AddDate(0, 0, -8)); err != nil {
+				return err
+			}
+
+			return fn(hex2, time.Now().Add(-time.Hour))
+		})
+
+		mappingRepo.EXPECT().MarkForDeletion(mock.Anything, "sha256:"+hex1, s3Backend.ID, mock.Anything).Return(true, nil)
+		deleter.On("Delete", mock.Anything, hex1).Return(nil)
+		mappingRepo.EXPECT().FinishDeletion(mock.Anything, "sha256:"+hex1, s3Backend.ID).Return(nil)
+		ruleRepo.On("MarkSwept", mock.Anything, s3Rule.ID, mock.Anything).Return(nil)
+
+		sweeper := biz.NewCASRetentionSweeper(log.NewStdLogger(io.Discard), ruleRepo, backendRepo, mappingRepo, backends.Providers{"AWS-S3": s3Provider}, nil, &fakeLock{acquired: true})
+		require.NoError(t, sweeper.Sweep(context.Background()))
+
+		mappingRepo.AssertNotCalled(t, "MarkForDeletion", mock.Anything, "sha256:"+hex2, mock.Anything, mock.Anything)
+	})
+
+	t.Run("storages that can't tell when a blob was reused are not listed", func(t *testing.T) {
+		ruleRepo := bizMocks.NewCASRetentionRuleRepo(t)
+		backendRepo := bizMocks.NewCASBackendRepo(t)
+		mappingRepo := bizMocks.NewCASMappingRepo(t)
+		s3Provider := blobM.NewProvider(t)
+
+		ruleRepo.On("ListAll", mock.Anything).Return([]*biz.CASRetentionRule{s3Rule}, nil)
+		backendRepo.On("List", mock.Anything, orgID).Return([]*biz.CASBackend{s3Backend}, nil)
+
+		lister := blobM.NewLister(t)
+		s3Provider.On("FromCredentials", mock.Anything, "s3-secret").Return(&listableOnlyStorage{blobM.NewUploaderDownloader(t), blobM.NewDeleter(t), lister}, nil)
+		mappingRepo.EXPECT().ListMarkedForDeletion(mock.Anything, s3Backend.ID).Return(nil, nil)
+		mappingRepo.On("FindExpired", mock.Anything, s3Backend.ID, mock.Anything).Return(&biz.CASMappingExpiredPage{}, nil)
+		ruleRepo.On("MarkSwept", mock.Anything, s3Rule.ID, mock.Anything).Return(nil)
+
+		sweeper := biz.NewCASRetentionSweeper(log.NewStdLogger(io.Discard), ruleRepo, backendRepo, mappingRepo, backends.Providers{"AWS-S3": s3Provider}, nil, &fakeLock{acquired: true})
+		require.NoError(t, sweeper.Sweep(context.Background()))
+
+		lister.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+	})
+}
@@ -200,3 +200,37 @@ func (e ErrAlreadyExists) Error() string {
 func IsErrAlreadyExists(err error) bool {
 	return errors.As(err, &ErrAlreadyExists{})
 }
+
+// ErrTooManyRequests is returned when an operation is rate limited, it can be retried later
+type ErrTooManyRequests struct {
+	err error
+}
+
+func NewErrTooManyRequestsStr(errMsg string) ErrTooManyRequests {
+	return ErrTooManyRequests{errors.New(errMsg)}
+}
+
+func (e ErrTooManyRequests) Error() string {
+	return fmt.Sprintf("too many requests: %s", e.err.Error())
+}
+
+func IsErrTooManyRequests(err error) bool {
+	return errors.As(err, &ErrTooManyRequests{})
+}
+
+// ErrConflict is returned when the state of a resource changed concurrently, so the operation no longer applies
+type ErrConflict struct {
+	err error
+}
+
+func NewErrConflictStr(errMsg string) ErrConflict {
+	return ErrConflict{errors.New(errMsg)}
+}
+
+func (e ErrConflict) Error() string {
+	return fmt.Sprintf("conflict: %s", e.err.Error())
+}
+
+func IsErrConflict(err error) bool {
+	return errors.As(err, &ErrConflict{})
+}
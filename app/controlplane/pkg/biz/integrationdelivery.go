@@ -0,0 +1,278 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+var integrationDeliveryTracer = otelx.Tracer("chainloop-controlplane", "biz/integrationdelivery")
+
+type IntegrationDeliveryStatus string
+
+var (
+	// The delivery is waiting to be picked up, either for the first time or to be retried
+	IntegrationDeliveryStatusPending IntegrationDeliveryStatus = "pending"
+	// The integration received the attestation
+	IntegrationDeliveryStatusSucceeded IntegrationDeliveryStatus = "succeeded"
+	// The delivery ran out of attempts and will not be retried unless replayed
+	IntegrationDeliveryStatusDeadLetter IntegrationDeliveryStatus = "dead_letter"
+)
+
+// Implements https://pkg.go.dev/entgo.io/ent/schema/field#EnumValues
+func (IntegrationDeliveryStatus) Values() (kinds []string) {
+	for _, s := range []IntegrationDeliveryStatus{IntegrationDeliveryStatusPending, IntegrationDeliveryStatusSucceeded, IntegrationDeliveryStatusDeadLetter} {
+		kinds = append(kinds, string(s))
+	}
+
+	return
+}
+
+const (
+	// IntegrationDeliveryMaxAttempts is the number of times a delivery is picked up
+	// before it is moved to the dead-letter state
+	IntegrationDeliveryMaxAttempts = 5
+	// Upper bound for the delay between two attempts of the same delivery
+	integrationDeliveryMaxRetryDelay = time.Hour
+)
+
+// ErrIntegrationDeliveryLeaseLost is returned when recording the outcome of an attempt of a delivery that has been
+// claimed again since, i.e the attempt outlived its lease. The outcome recorded by the latest attempt prevails.
+var ErrIntegrationDeliveryLeaseLost = errors.New("the integration delivery was claimed again by another worker")
+
+// IntegrationDelivery is a persisted fan-out job for an attached integration and workflow run
+type IntegrationDelivery struct {
+	ID                                             uuid.UUID
+	OrgID, WorkflowID, WorkflowRunID, AttachmentID uuid.UUID
+	Status                                         IntegrationDeliveryStatus
+	Attempts                                       int
+	LastError                                      string
+	// How long the last attempt took
+	Duration                                        time.Duration
+	NextAttemptAt, CreatedAt, UpdatedAt, FinishedAt *time.Time
+	Envelope                                        *dsse.Envelope
+	DownloadBackendType, DownloadSecretName         string
+}
+
+type IntegrationDeliveryCreateOpts struct {
+	OrgID, WorkflowID, WorkflowRunID, AttachmentID uuid.UUID
+	// Attestation to be delivered and its digest
+	Envelope          *dsse.Envelope
+	AttestationDigest string
+	// CAS backend used to download the materials
+	DownloadBackendType, DownloadSecretName string
+}
+
+type IntegrationDeliveryListFilters struct {
+	Status        *IntegrationDeliveryStatus
+	WorkflowRunID *uuid.UUID
+}
+
+type IntegrationDeliveryRepo interface {
+	// CreateBulk creates the deliveries in a single transaction. The deliveries already enqueued
+	// for the same attestation digest and attachment are skipped
+	CreateBulk(ctx context.Context, opts []*IntegrationDeliveryCreateOpts) error
+	// ClaimDue locks up to limit pending deliveries whose next attempt is due, increases their attempts
+	// and pushes their next attempt by the lease duration so no other worker picks them up in the meantime
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*IntegrationDelivery, error)
+	// MarkSucceeded records the success of the attempt claimed with the given attempts count and clears the envelope,
+	// it's not needed anymore. It returns ErrIntegrationDeliveryLeaseLost if the delivery has been claimed again since.
+	MarkSucceeded(ctx context.Context, id uuid.UUID, attempts int, duration time.Duration) error
+	// MarkFailed records the failure of the attempt claimed with the given attempts count and schedules the next one.
+	// A nil nextAttemptAt moves it to the dead-letter state. It returns ErrIntegrationDeliveryLeaseLost like MarkSucceeded.
+	MarkFailed(ctx context.Context, id uuid.UUID, attempts int, reason string, duration time.Duration, nextAttemptAt *time.Time) error
+	ListByAttachment(ctx context.Context, attachmentID uuid.UUID, filters *IntegrationDeliveryListFilters, p *pagination.OffsetPaginationOpts) ([]*IntegrationDelivery, int, error)
+	// Requeue moves dead-lettered deliveries of the attachment back to pending, optionally only the one with the given ID
+	Requeue(ctx context.Context, attachmentID uuid.UUID, deliveryID *uuid.UUID) (int, error)
+}
+
+type IntegrationDeliveryUseCase struct {
+	repo             IntegrationDeliveryRepo
+	integrationARepo IntegrationAttachmentRepo
+	logger           *log.Helper
+}
+
+func NewIntegrationDeliveryUseCase(repo IntegrationDeliveryRepo, iaRepo IntegrationAttachmentRepo, l log.Logger) *IntegrationDeliveryUseCase {
+	return &IntegrationDeliveryUseCase{repo: repo, integrationARepo: iaRepo, logger: servicelogger.ScopedHelper(l, "biz/integration-delivery")}
+}
+
+// Enqueue persists the pending deliveries so they can be picked up by the dispatcher workers.
+// Either all of them are enqueued or none, and enqueueing the same attestation to the same attachment twice is a no-op.
+func (uc *IntegrationDeliveryUseCase) Enqueue(ctx context.Context, opts []*IntegrationDeliveryCreateOpts) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.Enqueue")
+	defer span.End()
+
+	if len(opts) == 0 {
+		return nil
+	}
+
+	for _, o := range opts {
+		if o == nil || o.Envelope == nil {
+			return NewErrValidationStr("attestation envelope is required")
+		} else if o.AttestationDigest == "" {
+			return NewErrValidationStr("attestation digest is required")
+		}
+	}
+
+	return uc.repo.CreateBulk(ctx, opts)
+}
+
+// ClaimDue returns the deliveries that are ready to be executed, see IntegrationDeliveryRepo.ClaimDue
+func (uc *IntegrationDeliveryUseCase) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*IntegrationDelivery, error) {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.ClaimDue")
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, NewErrValidationStr("limit must be greater than 0")
+	}
+
+	return uc.repo.ClaimDue(ctx, limit, lease)
+}
+
+// MarkSucceeded records the successful attempt, it took the given duration
+func (uc *IntegrationDeliveryUseCase) MarkSucceeded(ctx context.Context, d *IntegrationDelivery, duration time.Duration) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.MarkSucceeded")
+	defer span.End()
+
+	return uc.repo.MarkSucceeded(ctx, d.ID, d.Attempts, duration)
+}
+
+// MarkFailed records a failed attempt. The delivery is scheduled to be retried with an
+// exponential delay until it reaches IntegrationDeliveryMaxAttempts, then it is dead-lettered.
+func (uc *IntegrationDeliveryUseCase) MarkFailed(ctx context.Context, d *IntegrationDelivery, duration time.Duration, cause error) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.MarkFailed")
+	defer span.End()
+
+	var nextAttemptAt *time.Time
+	if d.Attempts < IntegrationDeliveryMaxAttempts {
+		next := time.Now().Add(integrationDeliveryRetryDelay(d.Attempts))
+		nextAttemptAt = &next
+	} else {
+		uc.logger.Warnw("msg", "integration delivery moved to dead-letter", "ID", d.ID, "attachmentID", d.AttachmentID, "attempts", d.Attempts)
+	}
+
+	return uc.repo.MarkFailed(ctx, d.ID, d.Attempts, cause.Error(), duration, nextAttemptAt)
+}
+
+// MarkDeadLetter records a failed attempt that can't succeed by retrying, i.e the integration
+// is gone, so the delivery is dead-lettered right away regardless of its remaining attempts.
+func (uc *IntegrationDeliveryUseCase) MarkDeadLetter(ctx context.Context, d *IntegrationDelivery, duration time.Duration, cause error) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.MarkDeadLetter")
+	defer span.End()
+
+	uc.logger.Warnw("msg", "integration delivery moved to dead-letter", "ID", d.ID, "attachmentID", d.AttachmentID, "attempts", d.Attempts, "error", cause)
+
+	return uc.repo.MarkFailed(ctx, d.ID, d.Attempts, cause.Error(), duration, nil)
+}
+
+// List returns the delivery history of an attached integration, most recent first
+func (uc *IntegrationDeliveryUseCase) List(ctx context.Context, orgID, attachmentID string, filters *IntegrationDeliveryListFilters, p *pagination.OffsetPaginationOpts) ([]*IntegrationDelivery, int, error) {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.List")
+	defer span.End()
+
+	attachmentUUID, err := uc.findAttachment(ctx, orgID, attachmentID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.ListByAttachment(ctx, attachmentUUID, filters, p)
+}
+
+// ListFailed returns the dead-lettered deliveries of an attached integration
+func (uc *IntegrationDeliveryUseCase) ListFailed(ctx context.Context, orgID, attachmentID string, p *pagination.OffsetPaginationOpts) ([]*IntegrationDelivery, int, error) {
+	status := IntegrationDeliveryStatusDeadLetter
+	return uc.List(ctx, orgID, attachmentID, &IntegrationDeliveryListFilters{Status: &status}, p)
+}
+
+// Replay moves the dead-lettered deliveries of an attached integration back to the queue.
+// If deliveryID is provided only that delivery is replayed. It returns the number of replayed deliveries.
+func (uc *IntegrationDeliveryUseCase) Replay(ctx context.Context, orgID, attachmentID, deliveryID string) (int, error) {
+	ctx, span := otelx.Start(ctx, integrationDeliveryTracer, "IntegrationDeliveryUseCase.Replay")
+	defer span.End()
+
+	attachmentUUID, err := uc.findAttachment(ctx, orgID, attachmentID)
+	if err != nil {
+		return 0, err
+	}
+
+	var deliveryUUID *uuid.UUID
+	if deliveryID != "" {
+		id, err := uuid.Parse(deliveryID)
+		if err != nil {
+			return 0, NewErrInvalidUUID(err)
+		}
+		deliveryUUID = &id
+	}
+
+	n, err := uc.repo.Requeue(ctx, attachmentUUID, deliveryUUID)
+	if err != nil {
+		return 0, fmt.Errorf("requeueing deliveries: %w", err)
+	}
+
+	if deliveryUUID != nil && n == 0 {
+		return 0, NewErrNotFound("failed delivery")
+	}
+
+	uc.logger.Infow("msg", "integration deliveries replayed", "attachmentID", attachmentID, "count", n)
+
+	return n, nil
+}
+
+// findAttachment makes sure the attachment exists in the organization
+func (uc *IntegrationDeliveryUseCase) findAttachment(ctx context.Context, orgID, attachmentID string) (uuid.UUID, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return uuid.Nil, NewErrInvalidUUID(err)
+	}
+
+	attachmentUUID, err := uuid.Parse(attachmentID)
+	if err != nil {
+		return uuid.Nil, NewErrInvalidUUID(err)
+	}
+
+	attachment, err := uc.integrationARepo.FindByIDInOrg(ctx, orgUUID, attachmentUUID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("finding attachment: %w", err)
+	} else if attachment == nil {
+		return uuid.Nil, NewErrNotFound("attachment")
+	}
+
+	return attachmentUUID, nil
+}
+
+// integrationDeliveryRetryDelay returns the delay before the next attempt, 1m, 4m, 16m... capped to an hour
+func integrationDeliveryRetryDelay(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	// avoid overflowing the shift, the cap is reached way before
+	if attempts > 4 {
+		return integrationDeliveryMaxRetryDelay
+	}
+
+	return min(time.Minute<<(2*(attempts-1)), integrationDeliveryMaxRetryDelay)
+}
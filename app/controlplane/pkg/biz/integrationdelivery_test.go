@@ -0,0 +1,196 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubIntegrationDeliveryRepo records the calls exercised here;
+// any other call panics on the nil embedded interface.
+type stubIntegrationDeliveryRepo struct {
+	IntegrationDeliveryRepo
+	nextAttemptAt *time.Time
+	reason        string
+	requeued      int
+	created       []*IntegrationDeliveryCreateOpts
+}
+
+func (s *stubIntegrationDeliveryRepo) CreateBulk(_ context.Context, opts []*IntegrationDeliveryCreateOpts) error {
+	s.created = append(s.created, opts...)
+	return nil
+}
+
+func (s *stubIntegrationDeliveryRepo) MarkFailed(_ context.Context, _ uuid.UUID, _ int, reason string, _ time.Duration, nextAttemptAt *time.Time) error {
+	s.reason, s.nextAttemptAt = reason, nextAttemptAt
+	return nil
+}
+
+func (s *stubIntegrationDeliveryRepo) Requeue(_ context.Context, _ uuid.UUID, _ *uuid.UUID) (int, error) {
+	return s.requeued, nil
+}
+
+type stubIntegrationAttachmentRepo struct {
+	IntegrationAttachmentRepo
+	attachment *IntegrationAttachment
+}
+
+func (s *stubIntegrationAttachmentRepo) FindByIDInOrg(_ context.Context, _, _ uuid.UUID) (*IntegrationAttachment, error) {
+	return s.attachment, nil
+}
+
+func TestIntegrationDeliveryEnqueue(t *testing.T) {
+	envelope := &dsse.Envelope{}
+	testCases := []struct {
+		name    string
+		opts    []*IntegrationDeliveryCreateOpts
+		wantErr bool
+	}{
+		{name: "nothing to enqueue"},
+		{
+			name: "deliveries of an attestation",
+			opts: []*IntegrationDeliveryCreateOpts{
+				{AttachmentID: uuid.New(), Envelope: envelope, AttestationDigest: "sha256:deadbeef"},
+				{AttachmentID: uuid.New(), Envelope: envelope, AttestationDigest: "sha256:deadbeef"},
+			},
+		},
+		{
+			name: "missing envelope",
+			opts: []*IntegrationDeliveryCreateOpts{
+				{AttachmentID: uuid.New(), Envelope: envelope, AttestationDigest: "sha256:deadbeef"},
+				{AttachmentID: uuid.New(), AttestationDigest: "sha256:deadbeef"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing digest",
+			opts:    []*IntegrationDeliveryCreateOpts{{AttachmentID: uuid.New(), Envelope: envelope}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &stubIntegrationDeliveryRepo{}
+			uc := NewIntegrationDeliveryUseCase(repo, nil, log.NewStdLogger(io.Discard))
+
+			err := uc.Enqueue(context.Background(), tc.opts)
+			if tc.wantErr {
+				assert.True(t, IsErrValidation(err))
+				// none of them is enqueued
+				assert.Empty(t, repo.created)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.opts, repo.created)
+		})
+	}
+}
+
+func TestIntegrationDeliveryMarkFailed(t *testing.T) {
+	testCases := []struct {
+		name       string
+		attempts   int
+		deadLetter bool
+	}{
+		{name: "first attempt is retried", attempts: 1},
+		{name: "attempts left are retried", attempts: IntegrationDeliveryMaxAttempts - 1},
+		{name: "last attempt is dead-lettered", attempts: IntegrationDeliveryMaxAttempts, deadLetter: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &stubIntegrationDeliveryRepo{}
+			uc := NewIntegrationDeliveryUseCase(repo, nil, log.NewStdLogger(io.Discard))
+
+			err := uc.MarkFailed(context.Background(), &IntegrationDelivery{ID: uuid.New(), Attempts: tc.attempts}, time.Second, errors.New("boom"))
+			require.NoError(t, err)
+			assert.Equal(t, "boom", repo.reason)
+
+			if tc.deadLetter {
+				assert.Nil(t, repo.nextAttemptAt)
+				return
+			}
+
+			require.NotNil(t, repo.nextAttemptAt)
+			assert.WithinDuration(t, time.Now().Add(integrationDeliveryRetryDelay(tc.attempts)), *repo.nextAttemptAt, time.Second)
+		})
+	}
+}
+
+func TestIntegrationDeliveryMarkDeadLetter(t *testing.T) {
+	repo := &stubIntegrationDeliveryRepo{}
+	uc := NewIntegrationDeliveryUseCase(repo, nil, log.NewStdLogger(io.Discard))
+
+	// It doesn't wait for the attempts to run out
+	err := uc.MarkDeadLetter(context.Background(), &IntegrationDelivery{ID: uuid.New(), Attempts: 1}, time.Second, errors.New("integration not available"))
+	require.NoError(t, err)
+	assert.Equal(t, "integration not available", repo.reason)
+	assert.Nil(t, repo.nextAttemptAt)
+}
+
+func TestIntegrationDeliveryRetryDelay(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		0:  time.Minute,
+		1:  time.Minute,
+		2:  4 * time.Minute,
+		3:  16 * time.Minute,
+		4:  time.Hour,
+		50: time.Hour,
+	} {
+		assert.Equal(t, want, integrationDeliveryRetryDelay(attempts), "attempts: %d", attempts)
+	}
+}
+
+func TestIntegrationDeliveryReplay(t *testing.T) {
+	orgID, attachmentID := uuid.NewString(), uuid.NewString()
+
+	t.Run("attachment not found", func(t *testing.T) {
+		uc := NewIntegrationDeliveryUseCase(&stubIntegrationDeliveryRepo{}, &stubIntegrationAttachmentRepo{}, log.NewStdLogger(io.Discard))
+		_, err := uc.Replay(context.Background(), orgID, attachmentID, "")
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("single delivery not in dead-letter", func(t *testing.T) {
+		uc := NewIntegrationDeliveryUseCase(&stubIntegrationDeliveryRepo{}, &stubIntegrationAttachmentRepo{attachment: &IntegrationAttachment{}}, log.NewStdLogger(io.Discard))
+		_, err := uc.Replay(context.Background(), orgID, attachmentID, uuid.NewString())
+		assert.True(t, IsNotFound(err))
+	})
+
+	t.Run("all the failed deliveries", func(t *testing.T) {
+		uc := NewIntegrationDeliveryUseCase(&stubIntegrationDeliveryRepo{requeued: 3}, &stubIntegrationAttachmentRepo{attachment: &IntegrationAttachment{}}, log.NewStdLogger(io.Discard))
+		n, err := uc.Replay(context.Background(), orgID, attachmentID, "")
+		require.NoError(t, err)
+		assert.Equal(t, 3, n)
+	})
+
+	t.Run("invalid delivery ID", func(t *testing.T) {
+		uc := NewIntegrationDeliveryUseCase(&stubIntegrationDeliveryRepo{}, &stubIntegrationAttachmentRepo{attachment: &IntegrationAttachment{}}, log.NewStdLogger(io.Discard))
+		_, err := uc.Replay(context.Background(), orgID, attachmentID, "not-a-uuid")
+		assert.True(t, IsErrInvalidUUID(err))
+	})
+}
@@ -913,6 +913,69 @@ func (_c *CASBackendRepo_Update_Call) RunAndReturn(run func(context1 context.Con
 	return _c
 }
 
+// UpdatePrimary provides a mock function for the type CASBackendRepo
+func (_mock *CASBackendRepo) UpdatePrimary(ctx context.Context, ID uuid.UUID, primaryID *uuid.UUID) error {
+	ret := _mock.Called(ctx, ID, primaryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePrimary")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, ID, primaryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// CASBackendRepo_UpdatePrimary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePrimary'
+type CASBackendRepo_UpdatePrimary_Call struct {
+	*mock.Call
+}
+
+// UpdatePrimary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID uuid.UUID
+//   - primaryID *uuid.UUID
+func (_e *CASBackendRepo_Expecter) UpdatePrimary(ctx interface{}, ID interface{}, primaryID interface{}) *CASBackendRepo_UpdatePrimary_Call {
+	return &CASBackendRepo_UpdatePrimary_Call{Call: _e.mock.On("UpdatePrimary", ctx, ID, primaryID)}
+}
+
+func (_c *CASBackendRepo_UpdatePrimary_Call) Run(run func(ctx context.Context, ID uuid.UUID, primaryID *uuid.UUID)) *CASBackendRepo_UpdatePrimary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(*uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *CASBackendRepo_UpdatePrimary_Call) Return(err error) *CASBackendRepo_UpdatePrimary_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *CASBackendRepo_UpdatePrimary_Call) RunAndReturn(run func(ctx context.Context, ID uuid.UUID, primaryID *uuid.UUID) error) *CASBackendRepo_UpdatePrimary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateValidationStatus provides a mock function for the type CASBackendRepo
 func (_mock *CASBackendRepo) UpdateValidationStatus(ctx context.Context, ID uuid.UUID, status biz.CASBackendValidationStatus, validationError *string) error {
 	ret := _mock.Called(ctx, ID, status, validationError)
@@ -39,6 +39,69 @@ func (_m *CASMappingRepo) EXPECT() *CASMappingRepo_Expecter {
 	return &CASMappingRepo_Expecter{mock: &_m.Mock}
 }
 
+// CancelDeletion provides a mock function for the type CASMappingRepo
+func (_mock *CASMappingRepo) CancelDeletion(ctx context.Context, digest string, casBackendID uuid.UUID) error {
+	ret := _mock.Called(ctx, digest, casBackendID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelDeletion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, digest, casBackendID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// CASMappingRepo_CancelDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelDeletion'
+type CASMappingRepo_CancelDeletion_Call struct {
+	*mock.Call
+}
+
+// CancelDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - casBackendID uuid.UUID
+func (_e *CASMappingRepo_Expecter) CancelDeletion(ctx interface{}, digest interface{}, casBackendID interface{}) *CASMappingRepo_CancelDeletion_Call {
+	return &CASMappingRepo_CancelDeletion_Call{Call: _e.mock.On("CancelDeletion", ctx, digest, casBackendID)}
+}
+
+func (_c *CASMappingRepo_CancelDeletion_Call) Run(run func(ctx context.Context, digest string, casBackendID uuid.UUID)) *CASMappingRepo_CancelDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *CASMappingRepo_CancelDeletion_Call) Return(err error) *CASMappingRepo_CancelDeletion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *CASMappingRepo_CancelDeletion_Call) RunAndReturn(run func(ctx context.Context, digest string, casBackendID uuid.UUID) error) *CASMappingRepo_CancelDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type CASMappingRepo
 func (_mock *CASMappingRepo) Create(ctx context.Context, digest string, casBackendID uuid.UUID, opts *biz.CASMappingCreateOpts) (*biz.CASMapping, error) {
 	ret := _mock.Called(ctx, digest, casBackendID, opts)
@@ -199,6 +262,143 @@ func (_c *CASMappingRepo_FindByDigestInOrgs_Call) RunAndReturn(run func(ctx cont
 	return _c
 }
 
+// FindExpired provides a mock function for the type CASMappingRepo
+func (_mock *CASMappingRepo) FindExpired(ctx context.Context, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts) (*biz.CASMappingExpiredPage, error) {
+	ret := _mock.Called(ctx, casBackendID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExpired")
+	}
+
+	var r0 *biz.CASMappingExpiredPage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *biz.CASMappingExpiredOpts) (*biz.CASMappingExpiredPage, error)); ok {
+		return returnFunc(ctx, casBackendID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, *biz.CASMappingExpiredOpts) *biz.CASMappingExpiredPage); ok {
+		r0 = returnFunc(ctx, casBackendID, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*biz.CASMappingExpiredPage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, *biz.CASMappingExpiredOpts) error); ok {
+		r1 = returnFunc(ctx, casBackendID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CASMappingRepo_FindExpired_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExpired'
+type CASMappingRepo_FindExpired_Call struct {
+	*mock.Call
+}
+
+// FindExpired is a helper method to define mock.On call
+//   - ctx context.Context
+//   - casBackendID uuid.UUID
+//   - opts *biz.CASMappingExpiredOpts
+func (_e *CASMappingRepo_Expecter) FindExpired(ctx interface{}, casBackendID interface{}, opts interface{}) *CASMappingRepo_FindExpired_Call {
+	return &CASMappingRepo_FindExpired_Call{Call: _e.mock.On("FindExpired", ctx, casBackendID, opts)}
+}
+
+func (_c *CASMappingRepo_FindExpired_Call) Run(run func(ctx context.Context, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts)) *CASMappingRepo_FindExpired_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 *biz.CASMappingExpiredOpts
+		if args[2] != nil {
+			arg2 = args[2].(*biz.CASMappingExpiredOpts)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *CASMappingRepo_FindExpired_Call) Return(cASMappingExpiredPage *biz.CASMappingExpiredPage, err error) *CASMappingRepo_FindExpired_Call {
+	_c.Call.Return(cASMappingExpiredPage, err)
+	return _c
+}
+
+func (_c *CASMappingRepo_FindExpired_Call) RunAndReturn(run func(ctx context.Context, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts) (*biz.CASMappingExpiredPage, error)) *CASMappingRepo_FindExpired_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishDeletion provides a mock function for the type CASMappingRepo
+func (_mock *CASMappingRepo) FinishDeletion(ctx context.Context, digest string, casBackendID uuid.UUID) error {
+	ret := _mock.Called(ctx, digest, casBackendID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishDeletion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, digest, casBackendID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// CASMappingRepo_FinishDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishDeletion'
+type CASMappingRepo_FinishDeletion_Call struct {
+	*mock.Call
+}
+
+// FinishDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - casBackendID uuid.UUID
+func (_e *CASMappingRepo_Expecter) FinishDeletion(ctx interface{}, digest interface{}, casBackendID interface{}) *CASMappingRepo_FinishDeletion_Call {
+	return &CASMappingRepo_FinishDeletion_Call{Call: _e.mock.On("FinishDeletion", ctx, digest, casBackendID)}
+}
+
+func (_c *CASMappingRepo_FinishDeletion_Call) Run(run func(ctx context.Context, digest string, casBackendID uuid.UUID)) *CASMappingRepo_FinishDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *CASMappingRepo_FinishDeletion_Call) Return(err error) *CASMappingRepo_FinishDeletion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *CASMappingRepo_FinishDeletion_Call) RunAndReturn(run func(ctx context.Context, digest string, casBackendID uuid.UUID) error) *CASMappingRepo_FinishDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListByDigestInOrg provides a mock function for the type CASMappingRepo
 func (_mock *CASMappingRepo) ListByDigestInOrg(ctx context.Context, digest string, orgID uuid.UUID) ([]*biz.CASMapping, error) {
 	ret := _mock.Called(ctx, digest, orgID)
@@ -272,3 +472,149 @@ func (_c *CASMappingRepo_ListByDigestInOrg_Call) RunAndReturn(run func(ctx conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListMarkedForDeletion provides a mock function for the type CASMappingRepo
+func (_mock *CASMappingRepo) ListMarkedForDeletion(ctx context.Context, casBackendID uuid.UUID) ([]string, error) {
+	ret := _mock.Called(ctx, casBackendID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMarkedForDeletion")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return returnFunc(ctx, casBackendID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = returnFunc(ctx, casBackendID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, casBackendID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CASMappingRepo_ListMarkedForDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMarkedForDeletion'
+type CASMappingRepo_ListMarkedForDeletion_Call struct {
+	*mock.Call
+}
+
+// ListMarkedForDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - casBackendID uuid.UUID
+func (_e *CASMappingRepo_Expecter) ListMarkedForDeletion(ctx interface{}, casBackendID interface{}) *CASMappingRepo_ListMarkedForDeletion_Call {
+	return &CASMappingRepo_ListMarkedForDeletion_Call{Call: _e.mock.On("ListMarkedForDeletion", ctx, casBackendID)}
+}
+
+func (_c *CASMappingRepo_ListMarkedForDeletion_Call) Run(run func(ctx context.Context, casBackendID uuid.UUID)) *CASMappingRepo_ListMarkedForDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *CASMappingRepo_ListMarkedForDeletion_Call) Return(strings []string, err error) *CASMappingRepo_ListMarkedForDeletion_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *CASMappingRepo_ListMarkedForDeletion_Call) RunAndReturn(run func(ctx context.Context, casBackendID uuid.UUID) ([]string, error)) *CASMappingRepo_ListMarkedForDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkForDeletion provides a mock function for the type CASMappingRepo
+func (_mock *CASMappingRepo) MarkForDeletion(ctx context.Context, digest string, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts) (bool, error) {
+	ret := _mock.Called(ctx, digest, casBackendID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkForDeletion")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, *biz.CASMappingExpiredOpts) (bool, error)); ok {
+		return returnFunc(ctx, digest, casBackendID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, *biz.CASMappingExpiredOpts) bool); ok {
+		r0 = returnFunc(ctx, digest, casBackendID, opts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, *biz.CASMappingExpiredOpts) error); ok {
+		r1 = returnFunc(ctx, digest, casBackendID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CASMappingRepo_MarkForDeletion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkForDeletion'
+type CASMappingRepo_MarkForDeletion_Call struct {
+	*mock.Call
+}
+
+// MarkForDeletion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+//   - casBackendID uuid.UUID
+//   - opts *biz.CASMappingExpiredOpts
+func (_e *CASMappingRepo_Expecter) MarkForDeletion(ctx interface{}, digest interface{}, casBackendID interface{}, opts interface{}) *CASMappingRepo_MarkForDeletion_Call {
+	return &CASMappingRepo_MarkForDeletion_Call{Call: _e.mock.On("MarkForDeletion", ctx, digest, casBackendID, opts)}
+}
+
+func (_c *CASMappingRepo_MarkForDeletion_Call) Run(run func(ctx context.Context, digest string, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts)) *CASMappingRepo_MarkForDeletion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 *biz.CASMappingExpiredOpts
+		if args[3] != nil {
+			arg3 = args[3].(*biz.CASMappingExpiredOpts)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *CASMappingRepo_MarkForDeletion_Call) Return(b bool, err error) *CASMappingRepo_MarkForDeletion_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *CASMappingRepo_MarkForDeletion_Call) RunAndReturn(run func(ctx context.Context, digest string, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts) (bool, error)) *CASMappingRepo_MarkForDeletion_Call {
+	_c.Call.Return(run)
+	return _c
+}
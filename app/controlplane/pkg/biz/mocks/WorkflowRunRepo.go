@@ -605,6 +605,80 @@ func (_c *WorkflowRunRepo_ListNotFinishedOlderThan_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ListNotSearchIndexed provides a mock function for the type WorkflowRunRepo
+func (_mock *WorkflowRunRepo) ListNotSearchIndexed(ctx context.Context, after *pagination.Cursor, limit int) ([]*biz.WorkflowRun, error) {
+	ret := _mock.Called(ctx, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotSearchIndexed")
+	}
+
+	var r0 []*biz.WorkflowRun
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pagination.Cursor, int) ([]*biz.WorkflowRun, error)); ok {
+		return returnFunc(ctx, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *pagination.Cursor, int) []*biz.WorkflowRun); ok {
+		r0 = returnFunc(ctx, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*biz.WorkflowRun)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *pagination.Cursor, int) error); ok {
+		r1 = returnFunc(ctx, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// WorkflowRunRepo_ListNotSearchIndexed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotSearchIndexed'
+type WorkflowRunRepo_ListNotSearchIndexed_Call struct {
+	*mock.Call
+}
+
+// ListNotSearchIndexed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - after *pagination.Cursor
+//   - limit int
+func (_e *WorkflowRunRepo_Expecter) ListNotSearchIndexed(ctx interface{}, after interface{}, limit interface{}) *WorkflowRunRepo_ListNotSearchIndexed_Call {
+	return &WorkflowRunRepo_ListNotSearchIndexed_Call{Call: _e.mock.On("ListNotSearchIndexed", ctx, after, limit)}
+}
+
+func (_c *WorkflowRunRepo_ListNotSearchIndexed_Call) Run(run func(ctx context.Context, after *pagination.Cursor, limit int)) *WorkflowRunRepo_ListNotSearchIndexed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *pagination.Cursor
+		if args[1] != nil {
+			arg1 = args[1].(*pagination.Cursor)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowRunRepo_ListNotSearchIndexed_Call) Return(workflowRuns []*biz.WorkflowRun, err error) *WorkflowRunRepo_ListNotSearchIndexed_Call {
+	_c.Call.Return(workflowRuns, err)
+	return _c
+}
+
+func (_c *WorkflowRunRepo_ListNotSearchIndexed_Call) RunAndReturn(run func(ctx context.Context, after *pagination.Cursor, limit int) ([]*biz.WorkflowRun, error)) *WorkflowRunRepo_ListNotSearchIndexed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarkAsFinished provides a mock function for the type WorkflowRunRepo
 func (_mock *WorkflowRunRepo) MarkAsFinished(ctx context.Context, ID uuid.UUID, status biz.WorkflowRunStatus, reason string) error {
 	ret := _mock.Called(ctx, ID, status, reason)
@@ -818,6 +892,69 @@ func (_c *WorkflowRunRepo_SaveAttestationDigest_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// SaveSearchEntries provides a mock function for the type WorkflowRunRepo
+func (_mock *WorkflowRunRepo) SaveSearchEntries(ctx context.Context, ID uuid.UUID, entries []*biz.WorkflowRunSearchEntry) error {
+	ret := _mock.Called(ctx, ID, entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSearchEntries")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, []*biz.WorkflowRunSearchEntry) error); ok {
+		r0 = returnFunc(ctx, ID, entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WorkflowRunRepo_SaveSearchEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSearchEntries'
+type WorkflowRunRepo_SaveSearchEntries_Call struct {
+	*mock.Call
+}
+
+// SaveSearchEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ID uuid.UUID
+//   - entries []*biz.WorkflowRunSearchEntry
+func (_e *WorkflowRunRepo_Expecter) SaveSearchEntries(ctx interface{}, ID interface{}, entries interface{}) *WorkflowRunRepo_SaveSearchEntries_Call {
+	return &WorkflowRunRepo_SaveSearchEntries_Call{Call: _e.mock.On("SaveSearchEntries", ctx, ID, entries)}
+}
+
+func (_c *WorkflowRunRepo_SaveSearchEntries_Call) Run(run func(ctx context.Context, ID uuid.UUID, entries []*biz.WorkflowRunSearchEntry)) *WorkflowRunRepo_SaveSearchEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 []*biz.WorkflowRunSearchEntry
+		if args[2] != nil {
+			arg2 = args[2].([]*biz.WorkflowRunSearchEntry)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *WorkflowRunRepo_SaveSearchEntries_Call) Return(err error) *WorkflowRunRepo_SaveSearchEntries_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WorkflowRunRepo_SaveSearchEntries_Call) RunAndReturn(run func(ctx context.Context, ID uuid.UUID, entries []*biz.WorkflowRunSearchEntry) error) *WorkflowRunRepo_SaveSearchEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdatePolicyStatus provides a mock function for the type WorkflowRunRepo
 func (_mock *WorkflowRunRepo) UpdatePolicyStatus(ctx context.Context, ID uuid.UUID, summary *chainloop.PolicyStatusSummary) error {
 	ret := _mock.Called(ctx, ID, summary)
@@ -0,0 +1,392 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	attv1 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var policyEvaluationTracer = otelx.Tracer("chainloop-controlplane", "biz/policyevaluation")
+
+// PolicyEvaluation is the result of evaluating a policy in a workflow run,
+// extracted from its attestation
+type PolicyEvaluation struct {
+	ID            uuid.UUID
+	CreatedAt     *time.Time
+	WorkflowRunID uuid.UUID
+	ProjectID     uuid.UUID
+	// Workflow of the run, and the project it belongs to
+	Workflow *WorkflowRef
+	// Policy name
+	Name string
+	// Material the policy was evaluated against, empty for attestation policies
+	MaterialName, MaterialType string
+	// Where the policy was loaded from, and its digest
+	PolicyURI, PolicyDigest string
+	// Group the policy belongs to, if any
+	GroupName   string
+	Status      PolicyEvaluationStatus
+	SkipReasons []string
+	Gate        bool
+	// Number of violations, not counting the suppressed ones
+	ViolationsCount int
+	// Whether it belongs to the most recent run of the workflow
+	Latest     bool
+	Violations []*PolicyEvaluationViolation
+}
+
+type PolicyEvaluationViolation struct {
+	Subject, Message string
+	// Excluded from the policy gate
+	Suppressed bool
+	// Policy exception that suppressed the violation, if any
+	ExceptionID string
+	// Structured finding, only one of them is set
+	Vulnerability    *attv1.PolicyVulnerabilityFinding
+	Sast             *attv1.PolicySASTFinding
+	LicenseViolation *attv1.PolicyLicenseViolationFinding
+	Secret           *attv1.PolicySecretFinding
+	Misconfiguration *attv1.PolicyMisconfigurationFinding
+	Malware          *attv1.PolicyMalwareFinding
+}
+
+// FindingType returns the type of the structured finding of the violation, empty if it has none
+func (v *PolicyEvaluationViolation) FindingType() PolicyFindingType {
+	switch {
+	case v.Vulnerability != nil:
+		return PolicyFindingVulnerability
+	case v.Sast != nil:
+		return PolicyFindingSAST
+	case v.LicenseViolation != nil:
+		return PolicyFindingLicenseViolation
+	case v.Secret != nil:
+		return PolicyFindingSecret
+	case v.Misconfiguration != nil:
+		return PolicyFindingMisconfiguration
+	case v.Malware != nil:
+		return PolicyFindingMalware
+	}
+
+	return ""
+}
+
+// Severity returns the severity of the finding, if it has one
+func (v *PolicyEvaluationViolation) Severity() string {
+	switch {
+	case v.Vulnerability != nil:
+		return strings.ToUpper(v.Vulnerability.GetSeverity())
+	case v.Sast != nil:
+		return strings.ToUpper(v.Sast.GetSeverity())
+	case v.Secret != nil:
+		return strings.ToUpper(v.Secret.GetSeverity())
+	case v.Misconfiguration != nil:
+		return strings.ToUpper(v.Misconfiguration.GetSeverity())
+	case v.Malware != nil:
+		return strings.ToUpper(v.Malware.GetSeverity())
+	}
+
+	return ""
+}
+
+// ExternalID returns the identifier of the finding: the CVE of a vulnerability,
+// the rule of a SAST finding or a leaked secret, the license of a license violation,
+// the check of a misconfiguration and the signature of a malware
+func (v *PolicyEvaluationViolation) ExternalID() string {
+	switch {
+	case v.Vulnerability != nil:
+		return v.Vulnerability.GetExternalId()
+	case v.Sast != nil:
+		return v.Sast.GetRuleId()
+	case v.LicenseViolation != nil:
+		return v.LicenseViolation.GetLicenseId()
+	case v.Secret != nil:
+		return v.Secret.GetRuleId()
+	case v.Misconfiguration != nil:
+		return v.Misconfiguration.GetCheckId()
+	case v.Malware != nil:
+		return v.Malware.GetSignature()
+	}
+
+	return ""
+}
+
+// PackagePURL returns the package the finding was found in, if any
+func (v *PolicyEvaluationViolation) PackagePURL() string {
+	switch {
+	case v.Vulnerability != nil:
+		return v.Vulnerability.GetPackagePurl()
+	case v.LicenseViolation != nil:
+		return v.LicenseViolation.GetPackagePurl()
+	case v.Malware != nil:
+		return v.Malware.GetPackagePurl()
+	}
+
+	return ""
+}
+
+type PolicyEvaluationStatus string
+
+const (
+	PolicyEvaluationPassed  PolicyEvaluationStatus = "PASSED"
+	PolicyEvaluationFailed  PolicyEvaluationStatus = "FAILED"
+	PolicyEvaluationSkipped PolicyEvaluationStatus = "SKIPPED"
+)
+
+// Implements https://pkg.go.dev/entgo.io/ent/schema/field#EnumValues
+func (PolicyEvaluationStatus) Values() (values []string) {
+	for _, s := range []PolicyEvaluationStatus{
+		PolicyEvaluationPassed,
+		PolicyEvaluationFailed,
+		PolicyEvaluationSkipped,
+	} {
+		values = append(values, string(s))
+	}
+
+	return
+}
+
+type PolicyFindingType string
+
+const (
+	PolicyFindingVulnerability    PolicyFindingType = "VULNERABILITY"
+	PolicyFindingSAST             PolicyFindingType = "SAST"
+	PolicyFindingLicenseViolation PolicyFindingType = "LICENSE_VIOLATION"
+	PolicyFindingSecret           PolicyFindingType = "SECRET"
+	PolicyFindingMisconfiguration PolicyFindingType = "MISCONFIGURATION"
+	PolicyFindingMalware          PolicyFindingType = "MALWARE"
+)
+
+// Implements https://pkg.go.dev/entgo.io/ent/schema/field#EnumValues
+func (PolicyFindingType) Values() (values []string) {
+	for _, s := range []PolicyFindingType{
+		PolicyFindingVulnerability,
+		PolicyFindingSAST,
+		PolicyFindingLicenseViolation,
+		PolicyFindingSecret,
+		PolicyFindingMisconfiguration,
+		PolicyFindingMalware,
+	} {
+		values = append(values, string(s))
+	}
+
+	return
+}
+
+// NewPolicyEvaluations flattens the evaluations of an attestation, grouped by material
+func NewPolicyEvaluations(evaluations map[string][]*chainloop.PolicyEvaluation) []*PolicyEvaluation {
+	// sorted to get a deterministic output
+	keys := make([]string, 0, len(evaluations))
+	for k := range evaluations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var res []*PolicyEvaluation
+	for _, k := range keys {
+		for _, ev := range evaluations[k] {
+			e := &PolicyEvaluation{
+				Name:         ev.Name,
+				MaterialName: ev.MaterialName,
+				MaterialType: ev.Type,
+				SkipReasons:  ev.SkipReasons,
+				Gate:         ev.Gate,
+				Status:       PolicyEvaluationPassed,
+			}
+
+			if e.MaterialName == "" {
+				e.MaterialName = ev.MaterialNameFallback
+			}
+
+			ref := ev.PolicyReference
+			if ref == nil {
+				ref = ev.PolicyReferenceFallback
+			}
+
+			if ref != nil {
+				e.PolicyURI = ref.GetUri()
+				// policies loaded from git are referenced by their commit, i.e sha1
+				e.PolicyDigest = resourceDigest(ref.GetDigest())
+			}
+
+			if ev.GroupReference != nil {
+				e.GroupName = ev.GroupReference.GetName()
+			}
+
+			for _, v := range ev.Violations {
+				e.Violations = append(e.Violations, &PolicyEvaluationViolation{
+					Subject:          v.Subject,
+					Message:          v.Message,
+					Suppressed:       v.Suppress,
+					ExceptionID:      v.ExceptionID,
+					Vulnerability:    v.Vulnerability,
+					Sast:             v.Sast,
+					LicenseViolation: v.LicenseViolation,
+					Secret:           v.Secret,
+					Misconfiguration: v.Misconfiguration,
+					Malware:          v.Malware,
+				})
+
+				if !v.Suppress {
+					e.ViolationsCount++
+				}
+			}
+
+			switch {
+			case e.ViolationsCount > 0:
+				e.Status = PolicyEvaluationFailed
+			case ev.Skipped:
+				e.Status = PolicyEvaluationSkipped
+			}
+
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
+
+type PolicyEvaluationRepo interface {
+	// Save replaces the policy evaluations of a workflow run
+	Save(ctx context.Context, runID uuid.UUID, evaluations []*PolicyEvaluation) error
+	List(ctx context.Context, orgID uuid.UUID, f *PolicyEvaluationListFilters, p *pagination.CursorOptions) ([]*PolicyEvaluation, string, error)
+}
+
+// PolicyEvaluationListFilters are combined, all of them must match
+type PolicyEvaluationListFilters struct {
+	// Projects visible to the user, nil means all of them
+	ProjectIDs []uuid.UUID
+	// Scope to a project and optionally to one of its workflows
+	ProjectID, WorkflowID *uuid.UUID
+	PolicyName            string
+	MaterialName          string
+	Status                PolicyEvaluationStatus
+	// Only evaluations of the most recent run of each workflow
+	LatestOnly bool
+	// Filters on the violations, at least one violation must match all of them.
+	// Only the matching violations are returned.
+	FindingType PolicyFindingType
+	Severity    string
+	ExternalID  string
+	// Creation date range, both ends are optional
+	CreatedAfter, CreatedBefore *time.Time
+}
+
+// HasViolationFilters returns whether the filters apply to the violations of the evaluations
+func (f *PolicyEvaluationListFilters) HasViolationFilters() bool {
+	return f.FindingType != "" || f.Severity != "" || f.ExternalID != ""
+}
+
+func (f *PolicyEvaluationListFilters) validate() error {
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return NewErrValidationStr("the start of the date range must be before its end")
+	}
+
+	if f.WorkflowID != nil && f.ProjectID == nil {
+		return NewErrValidationStr("a workflow filter requires the project")
+	}
+
+	// severities are stored in upper case
+	f.Severity = strings.ToUpper(f.Severity)
+
+	return nil
+}
+
+type PolicyEvaluationUseCase struct {
+	repo   PolicyEvaluationRepo
+	logger *log.Helper
+}
+
+func NewPolicyEvaluationUseCase(repo PolicyEvaluationRepo, l log.Logger) *PolicyEvaluationUseCase {
+	if l == nil {
+		l = log.NewStdLogger(io.Discard)
+	}
+
+	return &PolicyEvaluationUseCase{repo: repo, logger: servicelogger.ScopedHelper(l, "biz/policyevaluation")}
+}
+
+// List the policy evaluations of the organization, most recent first
+func (uc *PolicyEvaluationUseCase) List(ctx context.Context, orgID string, f *PolicyEvaluationListFilters, p *pagination.CursorOptions) ([]*PolicyEvaluation, string, error) {
+	ctx, span := otelx.Start(ctx, policyEvaluationTracer, "PolicyEvaluationUseCase.List")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, "", NewErrInvalidUUID(err)
+	}
+
+	if f == nil {
+		f = &PolicyEvaluationListFilters{}
+	}
+
+	if err := f.validate(); err != nil {
+		return nil, "", err
+	}
+
+	return uc.repo.List(ctx, orgUUID, f, p)
+}
+
+// savePolicyEvaluations extracts the policy evaluations of the attestation of the run so they can be queried on their own
+func (uc *WorkflowRunUseCase) savePolicyEvaluations(ctx context.Context, run *WorkflowRun, predicate chainloop.NormalizablePredicate) error {
+	evaluations := predicate.GetPolicyEvaluations()
+
+	// The complete evaluations, including structured findings, might have been offloaded to CAS
+	if predicate.GetPolicyEvaluationsRef() != nil {
+		resolved, err := uc.policyEvaluationsFromCAS(ctx, run, predicate)
+		if err != nil {
+			// not blocking, the attestation is valid anyway
+			uc.logger.Warnw("msg", "failed to retrieve policy evaluations from CAS, using inline", "runID", run.ID, "error", err)
+		} else {
+			evaluations = resolved
+		}
+	}
+
+	return uc.policyEvaluationRepo.Save(ctx, run.ID, NewPolicyEvaluations(evaluations))
+}
+
+// policyEvaluationsFromCAS downloads the policy evaluations offloaded to CAS by the attestation
+// from the backend of the run. It can't rely on the CAS mappings since they are not stored yet.
+func (uc *WorkflowRunUseCase) policyEvaluationsFromCAS(ctx context.Context, run *WorkflowRun, predicate chainloop.NormalizablePredicate) (map[string][]*chainloop.PolicyEvaluation, error) {
+	// the content is addressed by its sha256 digest in CAS
+	digest := resourceDigest(predicate.GetPolicyEvaluationsRef().GetDigest())
+	if !strings.HasPrefix(digest, "sha256:") {
+		return nil, fmt.Errorf("policy evaluations ref must have a sha256 digest, got %q", digest)
+	}
+
+	if uc.casClient == nil || len(run.CASBackends) == 0 {
+		return nil, fmt.Errorf("no CAS backend to download the policy evaluations from")
+	}
+
+	var buf bytes.Buffer
+	if err := uc.casClient.Download(ctx, run.CASBackends[0], &buf, digest); err != nil {
+		return nil, fmt.Errorf("downloading policy evaluations: %w", err)
+	}
+
+	return chainloop.PolicyEvaluationsFromBundle(buf.Bytes())
+}
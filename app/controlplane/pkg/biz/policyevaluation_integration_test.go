@@ -0,0 +1,291 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/testhelpers"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/pkg/credentials"
+	creds "github.com/chainloop-dev/chainloop/pkg/credentials/mocks"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPolicyEvaluationUseCase(t *testing.T) {
+	suite.Run(t, new(policyEvaluationIntegrationTestSuite))
+}
+
+type policyEvaluationIntegrationTestSuite struct {
+	testhelpers.UseCasesEachTestSuite
+	org, org2  *biz.Organization
+	workflow   *biz.Workflow
+	casBackend *biz.CASBackend
+	run        *biz.WorkflowRun
+}
+
+func (s *policyEvaluationIntegrationTestSuite) SetupTest() {
+	var err error
+	ctx := context.Background()
+
+	credsWriter := creds.NewReaderWriter(s.T())
+	credsWriter.On("SaveCredentials", mock.Anything, mock.Anything, &credentials.OCIKeypair{Repo: "repo", Username: "username", Password: "pass"}).Return("stored-OCI-secret", nil)
+
+	s.TestingUseCases = testhelpers.NewTestingUseCases(s.T(), testhelpers.WithCredsReaderWriter(credsWriter))
+
+	s.org, err = s.Organization.CreateWithRandomName(ctx)
+	s.Require().NoError(err)
+	s.org2, err = s.Organization.CreateWithRandomName(ctx)
+	s.Require().NoError(err)
+
+	s.workflow, err = s.Workflow.Create(ctx, &biz.WorkflowCreateOpts{Name: "test-workflow", OrgID: s.org.ID, Project: "test-project"})
+	s.Require().NoError(err)
+
+	s.casBackend, err = s.CASBackend.CreateOrUpdate(ctx, s.org.ID, "repo", "username", "pass", backendType, true)
+	s.Require().NoError(err)
+
+	s.run = s.attest("testdata/attestations/with-policy-evaluations.json")
+}
+
+// attest creates a run of the workflow and stores the given attestation
+func (s *policyEvaluationIntegrationTestSuite) attest(path string) *biz.WorkflowRun {
+	ctx := context.Background()
+
+	contractVersion, err := s.WorkflowContract.Describe(ctx, s.org.ID, s.workflow.ContractID.String(), 0)
+	s.Require().NoError(err)
+
+	run, err := s.WorkflowRun.Create(ctx, &biz.WorkflowRunCreateOpts{
+		WorkflowID: s.workflow.ID.String(), ContractRevision: contractVersion, CASBackendID: s.casBackend.ID,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.WorkflowRun.SaveAttestation(ctx, run.ID.String(), testhelpers.BundleBytesFromEnvelope(s.T(), path))
+	s.Require().NoError(err)
+
+	return run
+}
+
+func (s *policyEvaluationIntegrationTestSuite) TestSave() {
+	ctx := context.Background()
+
+	got, _, err := s.PolicyEvaluation.List(ctx, s.org.ID, nil, &pagination.CursorOptions{Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(got, 3)
+
+	byKey := make(map[string]*biz.PolicyEvaluation)
+	for _, e := range got {
+		s.Equal(s.run.ID, e.WorkflowRunID)
+		// dated as the run, not when they were stored
+		s.WithinDuration(*s.run.CreatedAt, *e.CreatedAt, time.Millisecond)
+		s.Equal(s.workflow.ProjectID, e.ProjectID)
+		s.Equal(&biz.WorkflowRef{ID: s.workflow.ID, Name: "test-workflow", ProjectName: "test-project"}, e.Workflow)
+		s.True(e.Latest)
+		byKey[e.MaterialName+"/"+e.Name] = e
+	}
+
+	failed := byKey["skynet-sbom/cve-policy"]
+	s.Require().NotNil(failed)
+	s.Equal(biz.PolicyEvaluationFailed, failed.Status)
+	s.Equal("chainloop://cve-policy", failed.PolicyURI)
+	s.Equal("sha256:2f5b8f2e1e6c0a3f2f4d4c9b4a1f1b4e7a8c5d0e9f3b2a1c4d5e6f708192a3b4", failed.PolicyDigest)
+	s.Equal(1, failed.ViolationsCount)
+	s.Require().Len(failed.Violations, 2)
+
+	skipped := byKey["skynet-sbom/license-policy"]
+	s.Require().NotNil(skipped)
+	s.Equal(biz.PolicyEvaluationSkipped, skipped.Status)
+	s.Equal([]string{"no licenses found"}, skipped.SkipReasons)
+
+	passed := byKey["skynet2-sbom/cve-policy"]
+	s.Require().NotNil(passed)
+	s.Equal(biz.PolicyEvaluationPassed, passed.Status)
+	s.Empty(passed.Violations)
+
+	s.Run("storing the attestation again doesn't duplicate the evaluations", func() {
+		_, err := s.WorkflowRun.SaveAttestation(ctx, s.run.ID.String(), testhelpers.BundleBytesFromEnvelope(s.T(), "testdata/attestations/with-policy-evaluations.json"))
+		s.Require().NoError(err)
+
+		got, _, err := s.PolicyEvaluation.List(ctx, s.org.ID, nil, &pagination.CursorOptions{Limit: 10})
+		s.Require().NoError(err)
+		s.Len(got, 3)
+	})
+
+	s.Run("an older run attested later doesn't become the latest", func() {
+		contractVersion, err := s.WorkflowContract.Describe(ctx, s.org.ID, s.workflow.ContractID.String(), 0)
+		s.Require().NoError(err)
+
+		older, err := s.WorkflowRun.Create(ctx, &biz.WorkflowRunCreateOpts{
+			WorkflowID: s.workflow.ID.String(), ContractRevision: contractVersion, CASBackendID: s.casBackend.ID,
+		})
+		s.Require().NoError(err)
+
+		newer := s.attest("testdata/attestations/with-policy-evaluations.json")
+
+		_, err = s.WorkflowRun.SaveAttestation(ctx, older.ID.String(), testhelpers.BundleBytesFromEnvelope(s.T(), "testdata/attestations/with-policy-evaluations.json"))
+		s.Require().NoError(err)
+
+		got, _, err := s.PolicyEvaluation.List(ctx, s.org.ID, &biz.PolicyEvaluationListFilters{LatestOnly: true}, &pagination.CursorOptions{Limit: 10})
+		s.Require().NoError(err)
+		s.Len(got, 3)
+		for _, e := range got {
+			s.Equal(newer.ID, e.WorkflowRunID)
+		}
+	})
+
+	s.Run("a newer run of the workflow becomes the latest", func() {
+		newer := s.attest("testdata/attestations/full.json")
+
+		got, _, err := s.PolicyEvaluation.List(ctx, s.org.ID, &biz.PolicyEvaluationListFilters{LatestOnly: true}, &pagination.CursorOptions{Limit: 10})
+		s.Require().NoError(err)
+		// the newer run has no policy evaluations
+		s.Empty(got)
+
+		got, _, err = s.PolicyEvaluation.List(ctx, s.org.ID, nil, &pagination.CursorOptions{Limit: 10})
+		s.Require().NoError(err)
+		s.Len(got, 9)
+		for _, e := range got {
+			s.NotEqual(newer.ID, e.WorkflowRunID)
+			s.False(e.Latest)
+		}
+	})
+
+	s.Run("an older run attested after a newer one without evaluations doesn't become the latest", func() {
+		contractVersion, err := s.WorkflowContract.Describe(ctx, s.org.ID, s.workflow.ContractID.String(), 0)
+		s.Require().NoError(err)
+
+		older, err := s.WorkflowRun.Create(ctx, &biz.WorkflowRunCreateOpts{
+			WorkflowID: s.workflow.ID.String(), ContractRevision: contractVersion, CASBackendID: s.casBackend.ID,
+		})
+		s.Require().NoError(err)
+
+		s.attest("testdata/attestations/full.json")
+
+		_, err = s.WorkflowRun.SaveAttestation(ctx, older.ID.String(), testhelpers.BundleBytesFromEnvelope(s.T(), "testdata/attestations/with-policy-evaluations.json"))
+		s.Require().NoError(err)
+
+		got, _, err := s.PolicyEvaluation.List(ctx, s.org.ID, &biz.PolicyEvaluationListFilters{LatestOnly: true}, &pagination.CursorOptions{Limit: 10})
+		s.Require().NoError(err)
+		s.Empty(got)
+	})
+}
+
+func (s *policyEvaluationIntegrationTestSuite) TestList() {
+	ctx := context.Background()
+	yesterday := time.Now().Add(-24 * time.Hour)
+	otherProject := uuid.New()
+
+	testCases := []struct {
+		name    string
+		orgID   string
+		filters *biz.PolicyEvaluationListFilters
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "by policy name",
+			filters: &biz.PolicyEvaluationListFilters{PolicyName: "cve-policy"},
+			want:    2,
+		},
+		{
+			name:    "by material name",
+			filters: &biz.PolicyEvaluationListFilters{MaterialName: "skynet2-sbom"},
+			want:    1,
+		},
+		{
+			name:    "by status",
+			filters: &biz.PolicyEvaluationListFilters{PolicyName: "cve-policy", Status: biz.PolicyEvaluationFailed, LatestOnly: true},
+			want:    1,
+		},
+		{
+			name:    "by project",
+			filters: &biz.PolicyEvaluationListFilters{ProjectID: &s.workflow.ProjectID, WorkflowID: &s.workflow.ID},
+			want:    3,
+		},
+		{
+			name:    "by project, no results",
+			filters: &biz.PolicyEvaluationListFilters{ProjectID: &otherProject},
+			want:    0,
+		},
+		{
+			name:    "restricted to the visible projects",
+			filters: &biz.PolicyEvaluationListFilters{ProjectIDs: []uuid.UUID{otherProject}},
+			want:    0,
+		},
+		{
+			name:    "by finding, no results since inline violations have no findings",
+			filters: &biz.PolicyEvaluationListFilters{ExternalID: "CVE-2024-1234"},
+			want:    0,
+		},
+		{
+			name:    "by date range",
+			filters: &biz.PolicyEvaluationListFilters{CreatedAfter: &yesterday},
+			want:    3,
+		},
+		{
+			name:    "by date range, no results",
+			filters: &biz.PolicyEvaluationListFilters{CreatedBefore: &yesterday},
+			want:    0,
+		},
+		{
+			name:  "scoped to the org",
+			orgID: s.org2.ID,
+			want:  0,
+		},
+		{
+			name:    "workflow requires the project",
+			filters: &biz.PolicyEvaluationListFilters{WorkflowID: &s.workflow.ID},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			orgID := s.org.ID
+			if tc.orgID != "" {
+				orgID = tc.orgID
+			}
+
+			got, _, err := s.PolicyEvaluation.List(ctx, orgID, tc.filters, &pagination.CursorOptions{Limit: 10})
+			if tc.wantErr {
+				s.True(biz.IsErrValidation(err))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Len(got, tc.want)
+		})
+	}
+
+	s.Run("paginates", func() {
+		got, cursor, err := s.PolicyEvaluation.List(ctx, s.org.ID, nil, &pagination.CursorOptions{Limit: 2})
+		s.Require().NoError(err)
+		s.Len(got, 2)
+		s.Require().NotEmpty(cursor)
+
+		p, err := pagination.NewCursor(cursor, 2)
+		s.Require().NoError(err)
+		next, cursor, err := s.PolicyEvaluation.List(ctx, s.org.ID, nil, p)
+		s.Require().NoError(err)
+		s.Len(next, 1)
+		s.Empty(cursor)
+		s.NotEqual(got[1].ID, next[0].ID)
+	})
+}
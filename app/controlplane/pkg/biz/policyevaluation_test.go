@@ -0,0 +1,219 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"testing"
+	"time"
+
+	attv1 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/google/uuid"
+	intoto "github.com/in-toto/attestation/go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPolicyEvaluations(t *testing.T) {
+	vuln := &attv1.PolicyVulnerabilityFinding{ExternalId: "CVE-2024-1234", Severity: "high", PackagePurl: "pkg:maven/log4j@2.14"}
+
+	got := NewPolicyEvaluations(map[string][]*chainloop.PolicyEvaluation{
+		"sbom": {
+			{
+				Name:            "cve-policy",
+				MaterialName:    "sbom",
+				Type:            "SBOM_CYCLONEDX_JSON",
+				PolicyReference: &intoto.ResourceDescriptor{Uri: "chainloop://cve-policy", Digest: map[string]string{"sha256": "deadbeef"}},
+				GroupReference:  &intoto.ResourceDescriptor{Name: "sca"},
+				Gate:            true,
+				Violations: []*chainloop.PolicyViolation{
+					{Subject: "cve-policy", Message: "CVE-2024-1234 found", Vulnerability: vuln},
+					{Subject: "cve-policy", Message: "suppressed", Suppress: true},
+				},
+			},
+			{
+				// old attestations
+				Name:                    "license-policy",
+				MaterialNameFallback:    "sbom",
+				PolicyReferenceFallback: &intoto.ResourceDescriptor{Uri: "chainloop://license-policy"},
+				Skipped:                 true,
+				SkipReasons:             []string{"no licenses"},
+			},
+		},
+		"attestation": {
+			{
+				Name: "only-suppressed",
+				// loaded from git, referenced by its commit
+				PolicyReference: &intoto.ResourceDescriptor{Uri: "git+https://github.com/chainloop-dev/policies.git//only-suppressed.yaml", Digest: map[string]string{"sha1": "cafebabe"}},
+				Violations:      []*chainloop.PolicyViolation{{Message: "suppressed", Suppress: true}},
+			},
+		},
+	})
+
+	require.Len(t, got, 3)
+
+	// sorted by key
+	assert.Equal(t, "only-suppressed", got[0].Name)
+	assert.Equal(t, PolicyEvaluationPassed, got[0].Status)
+	assert.Equal(t, 0, got[0].ViolationsCount)
+	assert.Len(t, got[0].Violations, 1)
+	assert.Equal(t, "sha1:cafebabe", got[0].PolicyDigest)
+
+	assert.Equal(t, &PolicyEvaluation{
+		Name:            "cve-policy",
+		MaterialName:    "sbom",
+		MaterialType:    "SBOM_CYCLONEDX_JSON",
+		PolicyURI:       "chainloop://cve-policy",
+		PolicyDigest:    "sha256:deadbeef",
+		GroupName:       "sca",
+		Status:          PolicyEvaluationFailed,
+		Gate:            true,
+		ViolationsCount: 1,
+		Violations: []*PolicyEvaluationViolation{
+			{Subject: "cve-policy", Message: "CVE-2024-1234 found", Vulnerability: vuln},
+			{Subject: "cve-policy", Message: "suppressed", Suppressed: true},
+		},
+	}, got[1])
+
+	assert.Equal(t, "sbom", got[2].MaterialName)
+	assert.Equal(t, "chainloop://license-policy", got[2].PolicyURI)
+	assert.Empty(t, got[2].PolicyDigest)
+	assert.Equal(t, PolicyEvaluationSkipped, got[2].Status)
+	assert.Equal(t, []string{"no licenses"}, got[2].SkipReasons)
+}
+
+func TestPolicyEvaluationViolationFinding(t *testing.T) {
+	testCases := []struct {
+		name         string
+		violation    *PolicyEvaluationViolation
+		wantType     PolicyFindingType
+		wantSeverity string
+		wantID       string
+		wantPURL     string
+	}{
+		{
+			name:      "no finding",
+			violation: &PolicyEvaluationViolation{Message: "wrong"},
+		},
+		{
+			name:         "vulnerability",
+			violation:    &PolicyEvaluationViolation{Vulnerability: &attv1.PolicyVulnerabilityFinding{ExternalId: "CVE-2024-1234", Severity: "critical", PackagePurl: "pkg:npm/lodash@4.17.20"}},
+			wantType:     PolicyFindingVulnerability,
+			wantSeverity: "CRITICAL",
+			wantID:       "CVE-2024-1234",
+			wantPURL:     "pkg:npm/lodash@4.17.20",
+		},
+		{
+			name:         "sast",
+			violation:    &PolicyEvaluationViolation{Sast: &attv1.PolicySASTFinding{RuleId: "go/sql-injection", Severity: "HIGH"}},
+			wantType:     PolicyFindingSAST,
+			wantSeverity: "HIGH",
+			wantID:       "go/sql-injection",
+		},
+		{
+			name:      "license violation",
+			violation: &PolicyEvaluationViolation{LicenseViolation: &attv1.PolicyLicenseViolationFinding{LicenseId: "GPL-3.0-only", PackagePurl: "pkg:npm/foo@1.0.0"}},
+			wantType:  PolicyFindingLicenseViolation,
+			wantID:    "GPL-3.0-only",
+			wantPURL:  "pkg:npm/foo@1.0.0",
+		},
+		{
+			name:         "secret",
+			violation:    &PolicyEvaluationViolation{Secret: &attv1.PolicySecretFinding{RuleId: "aws-access-token", Severity: "high"}},
+			wantType:     PolicyFindingSecret,
+			wantSeverity: "HIGH",
+			wantID:       "aws-access-token",
+		},
+		{
+			name:         "misconfiguration",
+			violation:    &PolicyEvaluationViolation{Misconfiguration: &attv1.PolicyMisconfigurationFinding{CheckId: "AVD-KSV-0017", Severity: "medium"}},
+			wantType:     PolicyFindingMisconfiguration,
+			wantSeverity: "MEDIUM",
+			wantID:       "AVD-KSV-0017",
+		},
+		{
+			name:      "malware",
+			violation: &PolicyEvaluationViolation{Malware: &attv1.PolicyMalwareFinding{Signature: "MAL-2025-1234", PackagePurl: "pkg:npm/evil@1.0.0"}},
+			wantType:  PolicyFindingMalware,
+			wantID:    "MAL-2025-1234",
+			wantPURL:  "pkg:npm/evil@1.0.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.wantType, tc.violation.FindingType())
+			assert.Equal(t, tc.wantSeverity, tc.violation.Severity())
+			assert.Equal(t, tc.wantID, tc.violation.ExternalID())
+			assert.Equal(t, tc.wantPURL, tc.violation.PackagePURL())
+		})
+	}
+}
+
+func TestPolicyEvaluationListFiltersValidate(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	id := ToPtr(uuid.New())
+
+	testCases := []struct {
+		name    string
+		filters *PolicyEvaluationListFilters
+		wantErr string
+	}{
+		{
+			name:    "empty",
+			filters: &PolicyEvaluationListFilters{},
+		},
+		{
+			name:    "valid date range",
+			filters: &PolicyEvaluationListFilters{CreatedAfter: &before, CreatedBefore: &now},
+		},
+		{
+			name:    "invalid date range",
+			filters: &PolicyEvaluationListFilters{CreatedAfter: &now, CreatedBefore: &before},
+			wantErr: "the start of the date range must be before its end",
+		},
+		{
+			name:    "workflow without project",
+			filters: &PolicyEvaluationListFilters{WorkflowID: id},
+			wantErr: "a workflow filter requires the project",
+		},
+		{
+			name:    "workflow with project",
+			filters: &PolicyEvaluationListFilters{WorkflowID: id, ProjectID: id},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filters.validate()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.True(t, IsErrValidation(err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("severity is normalized", func(t *testing.T) {
+		f := &PolicyEvaluationListFilters{Severity: "high"}
+		require.NoError(t, f.validate())
+		assert.Equal(t, "HIGH", f.Severity)
+		assert.True(t, f.HasViolationFilters())
+	})
+}
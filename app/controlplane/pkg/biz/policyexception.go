@@ -0,0 +1,313 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var policyExceptionTracer = otelx.Tracer("chainloop-controlplane", "biz/policyexception")
+
+// Exceptions are meant to be temporary, they need to be requested again after this period
+const maxPolicyExceptionDuration = 365 * 24 * time.Hour
+
+// PolicyException waives the policy violations matching its scope until it expires.
+// It only takes effect once approved by a different user than the one that requested it.
+type PolicyException struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	// Scope of the exception, nil or empty values match everything
+	ProjectID    *uuid.UUID
+	ProjectName  string
+	WorkflowID   *uuid.UUID
+	WorkflowName string
+	PolicyName   string
+	MaterialName string
+	// Identifier of the finding, i.e CVE-2024-1234
+	ExternalID    string
+	Justification string
+	// Emails of the users that requested and approved the exception
+	CreatedBy, ApprovedBy            string
+	CreatedAt, ApprovedAt, RevokedAt *time.Time
+	ExpiresAt                        time.Time
+}
+
+// Status returns the status of the exception at the given time
+func (e *PolicyException) Status(at time.Time) PolicyExceptionStatus {
+	switch {
+	case e.RevokedAt != nil:
+		return PolicyExceptionRevoked
+	case !e.ExpiresAt.After(at):
+		return PolicyExceptionExpired
+	case e.ApprovedAt == nil:
+		return PolicyExceptionPending
+	}
+
+	return PolicyExceptionActive
+}
+
+type PolicyExceptionStatus string
+
+const (
+	// Waiting for approval
+	PolicyExceptionPending PolicyExceptionStatus = "PENDING"
+	// Approved, it suppresses the matching violations
+	PolicyExceptionActive  PolicyExceptionStatus = "ACTIVE"
+	PolicyExceptionExpired PolicyExceptionStatus = "EXPIRED"
+	PolicyExceptionRevoked PolicyExceptionStatus = "REVOKED"
+)
+
+type PolicyExceptionCreateOpts struct {
+	OrgID uuid.UUID
+	// Optional scope, the workflow requires the project
+	ProjectID, WorkflowID                *uuid.UUID
+	PolicyName, MaterialName, ExternalID string
+	Justification                        string
+	// Email of the user requesting the exception
+	CreatedBy string
+	ExpiresAt time.Time
+}
+
+func (o *PolicyExceptionCreateOpts) validate(now time.Time) error {
+	if o.PolicyName == "" && o.MaterialName == "" && o.ExternalID == "" {
+		return NewErrValidationStr("the exception must be scoped to a policy, a material or a finding")
+	}
+
+	if o.WorkflowID != nil && o.ProjectID == nil {
+		return NewErrValidationStr("a workflow scope requires the project")
+	}
+
+	if o.Justification == "" {
+		return NewErrValidationStr("a justification is required")
+	}
+
+	if o.CreatedBy == "" {
+		return NewErrValidationStr("exceptions can only be requested by users")
+	}
+
+	if !o.ExpiresAt.After(now) {
+		return NewErrValidationStr("the expiration date must be in the future")
+	}
+
+	if o.ExpiresAt.Sub(now) > maxPolicyExceptionDuration {
+		return NewErrValidationStr("exceptions can't last longer than a year")
+	}
+
+	return nil
+}
+
+type PolicyExceptionListFilters struct {
+	ProjectID *uuid.UUID
+	Status    PolicyExceptionStatus
+}
+
+type PolicyExceptionRepo interface {
+	Create(ctx context.Context, opts *PolicyExceptionCreateOpts) (*PolicyException, error)
+	FindByIDInOrg(ctx context.Context, orgID, id uuid.UUID) (*PolicyException, error)
+	List(ctx context.Context, orgID uuid.UUID, filters *PolicyExceptionListFilters, at time.Time) ([]*PolicyException, error)
+	// FindActive returns the approved exceptions not revoked nor expired at the given time
+	// that apply to a workflow: the organization-wide ones, the ones of its project and its own
+	FindActive(ctx context.Context, orgID, projectID, workflowID uuid.UUID, at time.Time) ([]*PolicyException, error)
+	Approve(ctx context.Context, id uuid.UUID, approvedBy string, at time.Time) error
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+	// FindExpired returns up to limit approved, not revoked exceptions expired before the given time
+	// whose expiration has not been processed yet, across all organizations
+	FindExpired(ctx context.Context, at time.Time, limit int) ([]*PolicyException, error)
+	MarkExpirationProcessed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+type PolicyExceptionUseCase struct {
+	repo      PolicyExceptionRepo
+	auditorUC *AuditorUseCase
+	logger    *log.Helper
+}
+
+func NewPolicyExceptionUseCase(repo PolicyExceptionRepo, auditorUC *AuditorUseCase, logger log.Logger) *PolicyExceptionUseCase {
+	return &PolicyExceptionUseCase{
+		repo:      repo,
+		auditorUC: auditorUC,
+		logger:    servicelogger.ScopedHelper(logger, "biz/policy-exception"),
+	}
+}
+
+// Create requests a new exception, it needs to be approved before taking effect
+func (uc *PolicyExceptionUseCase) Create(ctx context.Context, opts *PolicyExceptionCreateOpts) (*PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionTracer, "PolicyExceptionUseCase.Create")
+	defer span.End()
+
+	if opts == nil {
+		return nil, NewErrValidationStr("missing options")
+	}
+
+	if err := opts.validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	exception, err := uc.repo.Create(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("storing policy exception: %w", err)
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.PolicyExceptionCreated{
+			PolicyExceptionBase: policyExceptionEventBase(exception),
+			Justification:       exception.Justification,
+			ExpiresAt:           exception.ExpiresAt,
+		}, &exception.OrganizationID)
+	}
+
+	return exception, nil
+}
+
+// Approve makes a pending exception effective. It can't be approved by the user that requested it.
+func (uc *PolicyExceptionUseCase) Approve(ctx context.Context, orgID, exceptionID, approvedBy string) (*PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionTracer, "PolicyExceptionUseCase.Approve")
+	defer span.End()
+
+	exception, err := uc.findByIDInOrg(ctx, orgID, exceptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if status := exception.Status(now); status != PolicyExceptionPending {
+		return nil, NewErrValidationStr(fmt.Sprintf("only pending exceptions can be approved, this one is %s", status))
+	}
+
+	if approvedBy == "" {
+		return nil, NewErrValidationStr("exceptions can only be approved by users")
+	}
+
+	if approvedBy == exception.CreatedBy {
+		return nil, NewErrValidationStr("exceptions must be approved by a different user than the one that requested them")
+	}
+
+	if err := uc.repo.Approve(ctx, exception.ID, approvedBy, now); err != nil {
+		return nil, fmt.Errorf("approving policy exception: %w", err)
+	}
+
+	exception.ApprovedBy = approvedBy
+	exception.ApprovedAt = &now
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.PolicyExceptionApproved{
+			PolicyExceptionBase: policyExceptionEventBase(exception),
+		}, &exception.OrganizationID)
+	}
+
+	return exception, nil
+}
+
+// Revoke disables an exception before its expiration
+func (uc *PolicyExceptionUseCase) Revoke(ctx context.Context, orgID, exceptionID string) error {
+	ctx, span := otelx.Start(ctx, policyExceptionTracer, "PolicyExceptionUseCase.Revoke")
+	defer span.End()
+
+	exception, err := uc.findByIDInOrg(ctx, orgID, exceptionID)
+	if err != nil {
+		return err
+	}
+
+	if exception.RevokedAt != nil {
+		return NewErrValidationStr("the exception is already revoked")
+	}
+
+	if err := uc.repo.Revoke(ctx, exception.ID, time.Now()); err != nil {
+		return fmt.Errorf("revoking policy exception: %w", err)
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.PolicyExceptionRevoked{
+			PolicyExceptionBase: policyExceptionEventBase(exception),
+		}, &exception.OrganizationID)
+	}
+
+	return nil
+}
+
+// List returns the exceptions of the organization, most recent first
+func (uc *PolicyExceptionUseCase) List(ctx context.Context, orgID string, filters *PolicyExceptionListFilters) ([]*PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionTracer, "PolicyExceptionUseCase.List")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	if filters == nil {
+		filters = &PolicyExceptionListFilters{}
+	}
+
+	return uc.repo.List(ctx, orgUUID, filters, time.Now())
+}
+
+// FindActive returns the exceptions that currently apply to the runs of the workflow
+func (uc *PolicyExceptionUseCase) FindActive(ctx context.Context, orgID string, wf *Workflow) ([]*PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionTracer, "PolicyExceptionUseCase.FindActive")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	if wf == nil {
+		return nil, NewErrValidationStr("missing workflow")
+	}
+
+	return uc.repo.FindActive(ctx, orgUUID, wf.ProjectID, wf.ID, time.Now())
+}
+
+func (uc *PolicyExceptionUseCase) findByIDInOrg(ctx context.Context, orgID, exceptionID string) (*PolicyException, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	exceptionUUID, err := uuid.Parse(exceptionID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	exception, err := uc.repo.FindByIDInOrg(ctx, orgUUID, exceptionUUID)
+	if err != nil {
+		return nil, fmt.Errorf("finding policy exception: %w", err)
+	} else if exception == nil {
+		return nil, NewErrNotFound("policy exception")
+	}
+
+	return exception, nil
+}
+
+func policyExceptionEventBase(e *PolicyException) *events.PolicyExceptionBase {
+	return &events.PolicyExceptionBase{
+		ExceptionID:  &e.ID,
+		ProjectName:  e.ProjectName,
+		WorkflowName: e.WorkflowName,
+		PolicyName:   e.PolicyName,
+		MaterialName: e.MaterialName,
+		ExternalID:   e.ExternalID,
+	}
+}
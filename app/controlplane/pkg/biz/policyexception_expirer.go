@@ -0,0 +1,140 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var policyExceptionExpirerTracer = otelx.Tracer("chainloop-controlplane", "biz/policyexception_expirer")
+
+const (
+	defaultPolicyExceptionExpirerInterval = 10 * time.Minute
+	// Number of exceptions processed per query
+	policyExceptionExpirerPageSize = 100
+
+	lockKeyPolicyExceptionExpirer = "policy-exception-expirer"
+)
+
+// PolicyExceptionExpirer periodically raises an audit event for each approved exception
+// that reached its expiration date. Expired exceptions stop applying right away,
+// regardless of the expirer, since the lookups take the expiration into account.
+type PolicyExceptionExpirer struct {
+	logger    *log.Helper
+	repo      PolicyExceptionRepo
+	auditorUC *AuditorUseCase
+	lock      DistributedLock
+}
+
+type PolicyExceptionExpirerOpts struct {
+	// Interval between sweeps, defaults to 10 minutes
+	CheckInterval time.Duration
+	// Initial delay before the first sweep. If not set, runs immediately.
+	InitialDelay time.Duration
+}
+
+func NewPolicyExceptionExpirer(logger log.Logger, repo PolicyExceptionRepo, auditorUC *AuditorUseCase, lock DistributedLock) *PolicyExceptionExpirer {
+	return &PolicyExceptionExpirer{
+		logger:    log.NewHelper(log.With(logger, "component", "biz/PolicyExceptionExpirer")),
+		repo:      repo,
+		auditorUC: auditorUC,
+		lock:      lock,
+	}
+}
+
+// Start runs the expirer periodically until the context is cancelled
+func (e *PolicyExceptionExpirer) Start(ctx context.Context, opts *PolicyExceptionExpirerOpts) {
+	interval := defaultPolicyExceptionExpirerInterval
+	if opts != nil && opts.CheckInterval > 0 {
+		interval = opts.CheckInterval
+	}
+
+	var initialDelay time.Duration
+	if opts != nil && opts.InitialDelay > 0 {
+		initialDelay = opts.InitialDelay
+	}
+
+	e.logger.Infow("msg", "policy exception expirer configured", "initialDelay", initialDelay, "interval", interval)
+
+	timer := time.NewTimer(initialDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.logger.Info("policy exception expirer stopping due to context cancellation")
+			return
+		case <-timer.C:
+			if err := e.Sweep(ctx); err != nil {
+				e.logger.Errorf("policy exception expiration sweep failed: %v", err)
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// Sweep processes the exceptions expired since the last run
+func (e *PolicyExceptionExpirer) Sweep(ctx context.Context) error {
+	acquired, release, err := e.lock.TryAcquire(ctx, lockKeyPolicyExceptionExpirer)
+	if err != nil {
+		return fmt.Errorf("acquiring expirer lock: %w", err)
+	}
+	if !acquired {
+		e.logger.Debug("another replica is running the policy exception expirer, skipping")
+		return nil
+	}
+	defer release()
+
+	ctx, span := otelx.Start(ctx, policyExceptionExpirerTracer, "PolicyExceptionExpirer.Sweep")
+	defer span.End()
+
+	now := time.Now()
+	for {
+		expired, err := e.repo.FindExpired(ctx, now, policyExceptionExpirerPageSize)
+		if err != nil {
+			return fmt.Errorf("finding expired policy exceptions: %w", err)
+		}
+
+		for _, exception := range expired {
+			// marked before dispatching the event, so it's not raised twice
+			if err := e.repo.MarkExpirationProcessed(ctx, exception.ID, now); err != nil {
+				return fmt.Errorf("marking policy exception %s as expired: %w", exception.ID, err)
+			}
+
+			if e.auditorUC != nil {
+				e.auditorUC.Dispatch(ctx, &events.PolicyExceptionExpired{
+					PolicyExceptionBase: policyExceptionEventBase(exception),
+					ExpiresAt:           exception.ExpiresAt,
+				}, &exception.OrganizationID)
+			}
+		}
+
+		if len(expired) > 0 {
+			e.logger.Infow("msg", "policy exceptions expired", "count", len(expired))
+		}
+
+		if len(expired) < policyExceptionExpirerPageSize {
+			return nil
+		}
+	}
+}
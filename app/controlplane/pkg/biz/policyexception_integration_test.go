@@ -0,0 +1,209 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/testhelpers"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPolicyExceptionUseCase(t *testing.T) {
+	suite.Run(t, new(policyExceptionIntegrationTestSuite))
+}
+
+type policyExceptionIntegrationTestSuite struct {
+	testhelpers.UseCasesEachTestSuite
+	org, org2           *biz.Organization
+	workflow, workflow2 *biz.Workflow
+}
+
+func (s *policyExceptionIntegrationTestSuite) SetupTest() {
+	var err error
+	ctx := context.Background()
+
+	s.TestingUseCases = testhelpers.NewTestingUseCases(s.T())
+
+	s.org, err = s.Organization.CreateWithRandomName(ctx)
+	s.Require().NoError(err)
+	s.org2, err = s.Organization.CreateWithRandomName(ctx)
+	s.Require().NoError(err)
+
+	s.workflow, err = s.Workflow.Create(ctx, &biz.WorkflowCreateOpts{Name: "test-workflow", OrgID: s.org.ID, Project: "test-project"})
+	s.Require().NoError(err)
+	s.workflow2, err = s.Workflow.Create(ctx, &biz.WorkflowCreateOpts{Name: "test-workflow", OrgID: s.org.ID, Project: "another-project"})
+	s.Require().NoError(err)
+}
+
+func (s *policyExceptionIntegrationTestSuite) create(f func(o *biz.PolicyExceptionCreateOpts)) *biz.PolicyException {
+	o := &biz.PolicyExceptionCreateOpts{
+		OrgID:         uuid.MustParse(s.org.ID),
+		PolicyName:    "cve-policy",
+		Justification: "not exploitable",
+		CreatedBy:     "john@chainloop.dev",
+		ExpiresAt:     time.Now().Add(24 * time.Hour),
+	}
+	if f != nil {
+		f(o)
+	}
+
+	e, err := s.PolicyException.Create(context.Background(), o)
+	s.Require().NoError(err)
+	return e
+}
+
+func (s *policyExceptionIntegrationTestSuite) TestApprove() {
+	ctx := context.Background()
+	e := s.create(nil)
+	s.Equal(biz.PolicyExceptionPending, e.Status(time.Now()))
+
+	s.Run("the requester can't approve it", func() {
+		_, err := s.PolicyException.Approve(ctx, s.org.ID, e.ID.String(), "john@chainloop.dev")
+		s.True(biz.IsErrValidation(err))
+	})
+
+	s.Run("scoped to the org", func() {
+		_, err := s.PolicyException.Approve(ctx, s.org2.ID, e.ID.String(), "jane@chainloop.dev")
+		s.True(biz.IsNotFound(err))
+	})
+
+	s.Run("approved by another user", func() {
+		got, err := s.PolicyException.Approve(ctx, s.org.ID, e.ID.String(), "jane@chainloop.dev")
+		s.Require().NoError(err)
+		s.Equal("jane@chainloop.dev", got.ApprovedBy)
+		s.Equal(biz.PolicyExceptionActive, got.Status(time.Now()))
+	})
+
+	s.Run("it can't be approved twice", func() {
+		_, err := s.PolicyException.Approve(ctx, s.org.ID, e.ID.String(), "jane@chainloop.dev")
+		s.True(biz.IsErrValidation(err))
+	})
+
+	// concurrent requests that read the exception while it was still pending
+	repo := data.NewPolicyExceptionRepo(s.Data, s.L)
+
+	s.Run("a concurrent approval conflicts", func() {
+		err := repo.Approve(ctx, e.ID, "mary@chainloop.dev", time.Now())
+		s.True(biz.IsErrConflict(err))
+
+		got, err := repo.FindByIDInOrg(ctx, e.OrganizationID, e.ID)
+		s.Require().NoError(err)
+		s.Equal("jane@chainloop.dev", got.ApprovedBy)
+	})
+
+	s.Run("an expired exception can't be approved", func() {
+		expired := s.create(nil)
+		err := repo.Approve(ctx, expired.ID, "jane@chainloop.dev", expired.ExpiresAt.Add(time.Minute))
+		s.True(biz.IsErrConflict(err))
+	})
+
+	s.Run("a revoked exception can't be approved", func() {
+		revoked := s.create(nil)
+		s.Require().NoError(s.PolicyException.Revoke(ctx, s.org.ID, revoked.ID.String()))
+		err := repo.Approve(ctx, revoked.ID, "jane@chainloop.dev", time.Now())
+		s.True(biz.IsErrConflict(err))
+	})
+}
+
+func (s *policyExceptionIntegrationTestSuite) TestRevoke() {
+	ctx := context.Background()
+	e := s.create(nil)
+
+	s.Run("scoped to the org", func() {
+		err := s.PolicyException.Revoke(ctx, s.org2.ID, e.ID.String())
+		s.True(biz.IsNotFound(err))
+	})
+
+	s.Run("revoked", func() {
+		s.Require().NoError(s.PolicyException.Revoke(ctx, s.org.ID, e.ID.String()))
+	})
+
+	s.Run("it can't be revoked twice", func() {
+		err := s.PolicyException.Revoke(ctx, s.org.ID, e.ID.String())
+		s.True(biz.IsErrValidation(err))
+	})
+
+	s.Run("a concurrent revocation conflicts", func() {
+		err := data.NewPolicyExceptionRepo(s.Data, s.L).Revoke(ctx, e.ID, time.Now())
+		s.True(biz.IsErrConflict(err))
+	})
+}
+
+func (s *policyExceptionIntegrationTestSuite) TestFindActive() {
+	ctx := context.Background()
+	approve := func(e *biz.PolicyException) {
+		_, err := s.PolicyException.Approve(ctx, s.org.ID, e.ID.String(), "jane@chainloop.dev")
+		s.Require().NoError(err)
+	}
+
+	orgWide := s.create(nil)
+	approve(orgWide)
+	byProject := s.create(func(o *biz.PolicyExceptionCreateOpts) { o.ProjectID = &s.workflow.ProjectID })
+	approve(byProject)
+	byWorkflow := s.create(func(o *biz.PolicyExceptionCreateOpts) {
+		o.ProjectID, o.WorkflowID = &s.workflow.ProjectID, &s.workflow.ID
+	})
+	approve(byWorkflow)
+	// pending
+	s.create(nil)
+	revoked := s.create(nil)
+	approve(revoked)
+	s.Require().NoError(s.PolicyException.Revoke(ctx, s.org.ID, revoked.ID.String()))
+
+	got, err := s.PolicyException.FindActive(ctx, s.org.ID, s.workflow)
+	s.Require().NoError(err)
+	s.ElementsMatch([]uuid.UUID{orgWide.ID, byProject.ID, byWorkflow.ID}, exceptionIDs(got))
+
+	got, err = s.PolicyException.FindActive(ctx, s.org.ID, s.workflow2)
+	s.Require().NoError(err)
+	s.ElementsMatch([]uuid.UUID{orgWide.ID}, exceptionIDs(got))
+
+	s.Run("by status", func() {
+		got, err := s.PolicyException.List(ctx, s.org.ID, &biz.PolicyExceptionListFilters{Status: biz.PolicyExceptionPending})
+		s.Require().NoError(err)
+		s.Len(got, 1)
+
+		got, err = s.PolicyException.List(ctx, s.org.ID, &biz.PolicyExceptionListFilters{Status: biz.PolicyExceptionRevoked})
+		s.Require().NoError(err)
+		s.Equal([]uuid.UUID{revoked.ID}, exceptionIDs(got))
+	})
+
+	s.Run("by project", func() {
+		got, err := s.PolicyException.List(ctx, s.org.ID, &biz.PolicyExceptionListFilters{ProjectID: &s.workflow.ProjectID})
+		s.Require().NoError(err)
+		s.ElementsMatch([]uuid.UUID{byProject.ID, byWorkflow.ID}, exceptionIDs(got))
+	})
+
+	s.Run("scoped to the org", func() {
+		got, err := s.PolicyException.List(ctx, s.org2.ID, nil)
+		s.Require().NoError(err)
+		s.Empty(got)
+	})
+}
+
+func exceptionIDs(exceptions []*biz.PolicyException) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(exceptions))
+	for _, e := range exceptions {
+		ids = append(ids, e.ID)
+	}
+	return ids
+}
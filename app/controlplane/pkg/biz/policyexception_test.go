@@ -0,0 +1,144 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyExceptionStatus(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		exception *PolicyException
+		want      PolicyExceptionStatus
+	}{
+		{
+			name:      "pending",
+			exception: &PolicyException{ExpiresAt: after},
+			want:      PolicyExceptionPending,
+		},
+		{
+			name:      "active",
+			exception: &PolicyException{ApprovedAt: &before, ExpiresAt: after},
+			want:      PolicyExceptionActive,
+		},
+		{
+			name:      "expired",
+			exception: &PolicyException{ApprovedAt: &before, ExpiresAt: before},
+			want:      PolicyExceptionExpired,
+		},
+		{
+			name:      "expired before being approved",
+			exception: &PolicyException{ExpiresAt: before},
+			want:      PolicyExceptionExpired,
+		},
+		{
+			name:      "revoked",
+			exception: &PolicyException{ApprovedAt: &before, RevokedAt: &before, ExpiresAt: after},
+			want:      PolicyExceptionRevoked,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.exception.Status(now))
+		})
+	}
+}
+
+func TestPolicyExceptionCreateOptsValidate(t *testing.T) {
+	now := time.Now()
+	id := ToPtr(uuid.New())
+
+	valid := func(f func(o *PolicyExceptionCreateOpts)) *PolicyExceptionCreateOpts {
+		o := &PolicyExceptionCreateOpts{
+			PolicyName:    "cve-policy",
+			Justification: "not exploitable",
+			CreatedBy:     "john@chainloop.dev",
+			ExpiresAt:     now.Add(24 * time.Hour),
+		}
+		if f != nil {
+			f(o)
+		}
+		return o
+	}
+
+	testCases := []struct {
+		name    string
+		opts    *PolicyExceptionCreateOpts
+		wantErr string
+	}{
+		{
+			name: "valid",
+			opts: valid(nil),
+		},
+		{
+			name:    "missing scope",
+			opts:    valid(func(o *PolicyExceptionCreateOpts) { o.PolicyName = "" }),
+			wantErr: "the exception must be scoped to a policy, a material or a finding",
+		},
+		{
+			name: "by finding",
+			opts: valid(func(o *PolicyExceptionCreateOpts) { o.PolicyName, o.ExternalID = "", "CVE-2024-1234" }),
+		},
+		{
+			name:    "workflow without project",
+			opts:    valid(func(o *PolicyExceptionCreateOpts) { o.WorkflowID = id }),
+			wantErr: "a workflow scope requires the project",
+		},
+		{
+			name:    "missing justification",
+			opts:    valid(func(o *PolicyExceptionCreateOpts) { o.Justification = "" }),
+			wantErr: "a justification is required",
+		},
+		{
+			name:    "missing user",
+			opts:    valid(func(o *PolicyExceptionCreateOpts) { o.CreatedBy = "" }),
+			wantErr: "exceptions can only be requested by users",
+		},
+		{
+			name:    "expiration in the past",
+			opts:    valid(func(o *PolicyExceptionCreateOpts) { o.ExpiresAt = now.Add(-time.Minute) }),
+			wantErr: "the expiration date must be in the future",
+		},
+		{
+			name:    "expiration too far",
+			opts:    valid(func(o *PolicyExceptionCreateOpts) { o.ExpiresAt = now.Add(2 * maxPolicyExceptionDuration) }),
+			wantErr: "exceptions can't last longer than a year",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.validate(now)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.True(t, IsErrValidation(err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
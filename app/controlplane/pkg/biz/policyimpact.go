@@ -0,0 +1,740 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	pb "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	schemav1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	attv1 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	loader "github.com/chainloop-dev/chainloop/pkg/policies"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+var policyImpactTracer = otelx.Tracer("chainloop-controlplane", "biz/policyimpact")
+
+const (
+	// Number of runs evaluated by default and at most in a single analysis, most recent first
+	defaultPolicyImpactLimit = 100
+	maxPolicyImpactLimit     = 500
+	// Runs evaluated concurrently, each one in its own working directory
+	policyImpactWorkers = 5
+	// Upper bound for the evaluation of a single run, including the download of its materials
+	policyImpactRunTimeout = 2 * time.Minute
+	// Upper bound for the whole analysis, runs not evaluated by then are reported as errored
+	policyImpactTimeout = 10 * time.Minute
+	// Upper bound for the evaluation of a single policy
+	policyImpactPolicyTimeout = 30 * time.Second
+	// Analyses still running after this long were interrupted, i.e the controlplane instance running them stopped
+	policyImpactStaleAfter = policyImpactTimeout + 5*time.Minute
+	// Analyses an organization can start per hour, only one of them runs at a time
+	policyImpactMaxPerHour = 10
+	// Size of the materials downloaded from backends without limits
+	policyImpactMaxMaterialBytes int64 = 100 * 1024 * 1024
+)
+
+type PolicyImpactOpts struct {
+	OrgID string
+	// Used to resolve the policies from the providers on behalf of the requester
+	OrgName, Token string
+	// Either a policy or a policy group reference, and their arguments
+	PolicyRef, GroupRef string
+	With                map[string]string
+	// Runs to evaluate. Projects visible to the user, nil means all of them
+	ProjectIDs []uuid.UUID
+	// Semver constraint on the project version, i.e ">= 1.2.0, < 2.0.0"
+	VersionRange string
+	// by creation date, both ends are optional
+	CreatedAfter, CreatedBefore *time.Time
+	Limit                       int
+}
+
+func (o *PolicyImpactOpts) validate() error {
+	if (o.PolicyRef == "") == (o.GroupRef == "") {
+		return NewErrValidationStr("either a policy or a policy group must be provided")
+	}
+
+	// the controlplane doesn't load policies from its filesystem nor from arbitrary URLs
+	if !loader.IsProviderScheme(o.PolicyRef + o.GroupRef) {
+		return NewErrValidationStr("only policies and groups from a policy provider can be evaluated, i.e chainloop://my-policy")
+	}
+
+	if o.Limit < 0 || o.Limit > maxPolicyImpactLimit {
+		return NewErrValidation(fmt.Errorf("at most %d runs can be evaluated", maxPolicyImpactLimit))
+	}
+
+	if o.VersionRange != "" {
+		if _, err := semver.NewConstraint(o.VersionRange); err != nil {
+			return NewErrValidation(fmt.Errorf("invalid version range: %w", err))
+		}
+	}
+
+	return nil
+}
+
+type PolicyImpactAnalysisStatus string
+
+const (
+	PolicyImpactAnalysisRunning   PolicyImpactAnalysisStatus = "running"
+	PolicyImpactAnalysisSucceeded PolicyImpactAnalysisStatus = "succeeded"
+	PolicyImpactAnalysisFailed    PolicyImpactAnalysisStatus = "failed"
+)
+
+// Values implement https://pkg.go.dev/entgo.io/ent/schema/field#EnumValues
+func (PolicyImpactAnalysisStatus) Values() (kinds []string) {
+	for _, s := range []PolicyImpactAnalysisStatus{PolicyImpactAnalysisRunning, PolicyImpactAnalysisSucceeded, PolicyImpactAnalysisFailed} {
+		kinds = append(kinds, string(s))
+	}
+
+	return
+}
+
+// PolicyImpactAnalysis is an analysis running in the background, its report is available once it succeeds
+type PolicyImpactAnalysis struct {
+	ID                    uuid.UUID
+	Status                PolicyImpactAnalysisStatus
+	CreatedAt, FinishedAt *time.Time
+	Report                *PolicyImpactReport
+	// why the analysis failed
+	Error string
+}
+
+type PolicyImpactAnalysisRepo interface {
+	// Create records a running analysis. Only one analysis runs at a time in an organization,
+	// ErrAlreadyExists is returned if there's another one running
+	Create(ctx context.Context, orgID uuid.UUID) (*PolicyImpactAnalysis, error)
+	// CountSince returns the number of analyses started in the organization since the given time
+	CountSince(ctx context.Context, orgID uuid.UUID, since time.Time) (int, error)
+	// Finish records the report of a running analysis, or the reason it failed if there's no report
+	Finish(ctx context.Context, id uuid.UUID, report *PolicyImpactReport, reason string) error
+	// FailStale fails the analyses of the organization running since before the given time
+	FailStale(ctx context.Context, orgID uuid.UUID, startedBefore time.Time, reason string) error
+	// FindByID returns the analysis of the organization, nil if it doesn't exist
+	FindByID(ctx context.Context, orgID, id uuid.UUID) (*PolicyImpactAnalysis, error)
+}
+
+// PolicyImpactReport is the outcome of evaluating a policy against the stored attestations
+type PolicyImpactReport struct {
+	EvaluatedCount int
+	// Runs with violations of the policy that were not reported when they were attested
+	NewlyFailing []*PolicyImpactResult
+	// Runs that couldn't be evaluated
+	Errored []*PolicyImpactResult
+}
+
+type PolicyImpactResult struct {
+	WorkflowRunID uuid.UUID
+	CreatedAt     *time.Time
+	// workflow of the run and the project it belongs to
+	WorkflowID                                uuid.UUID
+	WorkflowName, ProjectName, ProjectVersion string
+	// Violations not present in the original evaluation of the run
+	Violations []*PolicyImpactViolation
+	// why the run couldn't be evaluated
+	Error string
+}
+
+func newPolicyImpactResult(run *WorkflowRun) *PolicyImpactResult {
+	result := &PolicyImpactResult{WorkflowRunID: run.ID, CreatedAt: run.CreatedAt}
+	if wf := run.Workflow; wf != nil {
+		result.WorkflowID, result.WorkflowName, result.ProjectName = wf.ID, wf.Name, wf.Project
+	}
+
+	if run.ProjectVersion != nil {
+		result.ProjectVersion = run.ProjectVersion.Version
+	}
+
+	return result
+}
+
+type PolicyImpactViolation struct {
+	PolicyName, MaterialName, Message string
+}
+
+// PolicyImpactUseCase evaluates policies retroactively against the attestations already stored,
+// without altering the runs nor their original evaluations
+type PolicyImpactUseCase struct {
+	repo         PolicyImpactAnalysisRepo
+	wfRunUC      *WorkflowRunUseCase
+	contractUC   *WorkflowContractUseCase
+	exceptionUC  *PolicyExceptionUseCase
+	casClient    CASClient
+	casMappingUC *CASMappingUseCase
+	// local OSV database queried by the policies, optional
+	vulnDB *osv.Database
+	logger *log.Helper
+}
+
+func NewPolicyImpactUseCase(repo PolicyImpactAnalysisRepo, wfRunUC *WorkflowRunUseCase, contractUC *WorkflowContractUseCase, exceptionUC *PolicyExceptionUseCase, casClient CASClient, casMappingUC *CASMappingUseCase, vulnDB *osv.Database, logger log.Logger) *PolicyImpactUseCase {
+	return &PolicyImpactUseCase{
+		repo:         repo,
+		wfRunUC:      wfRunUC,
+		contractUC:   contractUC,
+		exceptionUC:  exceptionUC,
+		casClient:    casClient,
+		casMappingUC: casMappingUC,
+		vulnDB:       vulnDB,
+		logger:       log.NewHelper(log.With(logger, "component", "biz/policyimpact")),
+	}
+}
+
+// Start validates the analysis and starts evaluating the policy against the attestations of the selected runs
+// in the background. The report of the runs that would fail it is available with FindByID once it finishes.
+// An organization runs one analysis at a time, and starts a limited number of them per hour.
+func (uc *PolicyImpactUseCase) Start(ctx context.Context, opts *PolicyImpactOpts) (*PolicyImpactAnalysis, error) {
+	ctx, span := otelx.Start(ctx, policyImpactTracer, "PolicyImpactUseCase.Start")
+	defer span.End()
+
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	orgUUID, err := uuid.Parse(opts.OrgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	// the selection and the policies are resolved upfront so the errors are reported right away
+	runs, err := uc.selectRuns(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &policyProviderClient{contractUC: uc.contractUC, orgName: opts.OrgName, token: opts.Token}
+	policies, err := uc.policyAttachments(ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.failStale(ctx, orgUUID); err != nil {
+		return nil, err
+	}
+
+	started, err := uc.repo.CountSince(ctx, orgUUID, time.Now().Add(-time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("counting analyses: %w", err)
+	} else if started >= policyImpactMaxPerHour {
+		return nil, NewErrTooManyRequestsStr(fmt.Sprintf("at most %d analyses can be started per hour", policyImpactMaxPerHour))
+	}
+
+	analysis, err := uc.repo.Create(ctx, orgUUID)
+	if err != nil {
+		if IsErrAlreadyExists(err) {
+			return nil, NewErrTooManyRequestsStr("there is another analysis running in the organization, wait for it to finish")
+		}
+
+		return nil, fmt.Errorf("creating analysis: %w", err)
+	}
+
+	// the analysis outlives the request
+	go uc.run(context.WithoutCancel(ctx), analysis.ID, orgUUID, runs, client, policies, opts)
+
+	return analysis, nil
+}
+
+// FindByID returns an analysis of the organization, the ones that were interrupted are reported as failed
+func (uc *PolicyImpactUseCase) FindByID(ctx context.Context, orgID string, id uuid.UUID) (*PolicyImpactAnalysis, error) {
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	if err := uc.failStale(ctx, orgUUID); err != nil {
+		return nil, err
+	}
+
+	analysis, err := uc.repo.FindByID(ctx, orgUUID, id)
+	if err != nil {
+		return nil, fmt.Errorf("finding analysis: %w", err)
+	} else if analysis == nil {
+		return nil, NewErrNotFound("policy impact analysis")
+	}
+
+	return analysis, nil
+}
+
+func (uc *PolicyImpactUseCase) failStale(ctx context.Context, orgID uuid.UUID) error {
+	if err := uc.repo.FailStale(ctx, orgID, time.Now().Add(-policyImpactStaleAfter), "the analysis was interrupted"); err != nil {
+		return fmt.Errorf("failing interrupted analyses: %w", err)
+	}
+
+	return nil
+}
+
+// run evaluates the runs and records the report of the analysis
+func (uc *PolicyImpactUseCase) run(ctx context.Context, id, orgID uuid.UUID, runs []*WorkflowRun, client pb.AttestationServiceClient, policies *schemav1.Policies, opts *PolicyImpactOpts) {
+	ctx, span := otelx.Start(ctx, policyImpactTracer, "PolicyImpactUseCase.run")
+	defer span.End()
+
+	// a policy crashing the evaluation must not take the controlplane down
+	defer func() {
+		if r := recover(); r != nil {
+			uc.logger.Errorw("msg", "policy impact analysis panicked", "ID", id, "error", r)
+			if err := uc.repo.Finish(ctx, id, nil, "the analysis failed unexpectedly"); err != nil {
+				uc.logger.Errorw("msg", "recording policy impact analysis failure", "ID", id, "error", err)
+			}
+		}
+	}()
+
+	report := uc.analyze(ctx, orgID, runs, client, policies, opts)
+	if err := uc.repo.Finish(ctx, id, report, ""); err != nil {
+		uc.logger.Errorw("msg", "recording policy impact analysis report", "ID", id, "error", err)
+	}
+}
+
+// analyze evaluates the policy against the attestations of the runs and reports the ones that would fail it
+func (uc *PolicyImpactUseCase) analyze(ctx context.Context, orgID uuid.UUID, runs []*WorkflowRun, client pb.AttestationServiceClient, policies *schemav1.Policies, opts *PolicyImpactOpts) *PolicyImpactReport {
+	ctx, cancel := context.WithTimeout(ctx, policyImpactTimeout)
+	defer cancel()
+
+	results := make([]*PolicyImpactResult, len(runs))
+	var g errgroup.Group
+	g.SetLimit(policyImpactWorkers)
+
+	for i, run := range runs {
+		g.Go(func() error {
+			results[i] = uc.evaluateRun(ctx, orgID, run, client, policies, opts)
+			// one run failing doesn't invalidate the rest of the analysis
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	report := &PolicyImpactReport{EvaluatedCount: len(runs)}
+	for _, r := range results {
+		switch {
+		case r.Error != "":
+			uc.logger.Debugw("msg", "run could not be evaluated", "runID", r.WorkflowRunID, "error", r.Error)
+			report.Errored = append(report.Errored, r)
+		case len(r.Violations) > 0:
+			report.NewlyFailing = append(report.NewlyFailing, r)
+		}
+	}
+
+	return report
+}
+
+// selectRuns returns the most recent successful runs matching the selector, up to the limit
+func (uc *PolicyImpactUseCase) selectRuns(ctx context.Context, opts *PolicyImpactOpts) ([]*WorkflowRun, error) {
+	limit := opts.Limit
+	if limit == 0 {
+		limit = defaultPolicyImpactLimit
+	}
+
+	var constraint *semver.Constraints
+	if opts.VersionRange != "" {
+		// already validated
+		constraint, _ = semver.NewConstraint(opts.VersionRange)
+	}
+
+	filters := &RunListFilters{
+		Status:        WorkflowRunSuccess,
+		ProjectIDs:    opts.ProjectIDs,
+		CreatedAfter:  opts.CreatedAfter,
+		CreatedBefore: opts.CreatedBefore,
+	}
+
+	runs := make([]*WorkflowRun, 0, limit)
+	var cursor string
+	for {
+		p, err := pagination.NewCursor(cursor, pagination.DefaultCursorLimit)
+		if err != nil {
+			return nil, fmt.Errorf("paginating runs: %w", err)
+		}
+
+		page, next, err := uc.wfRunUC.List(ctx, opts.OrgID, filters, p)
+		if err != nil {
+			return nil, fmt.Errorf("listing runs: %w", err)
+		}
+
+		for _, r := range page {
+			if constraint != nil && !versionInRange(r.ProjectVersion, constraint) {
+				continue
+			}
+
+			runs = append(runs, r)
+			if len(runs) == limit {
+				return runs, nil
+			}
+		}
+
+		if next == "" {
+			return runs, nil
+		}
+
+		cursor = next
+	}
+}
+
+// versionInRange returns whether the version of a run satisfies the constraint, runs without a semver version never do
+func versionInRange(pv *ProjectVersion, constraint *semver.Constraints) bool {
+	if pv == nil {
+		return false
+	}
+
+	v, err := semver.NewVersion(pv.Version)
+	if err != nil {
+		return false
+	}
+
+	return constraint.Check(v)
+}
+
+// policyAttachments returns the attachments to evaluate a single policy, which depend on the kinds of materials it supports.
+// Groups already declare how their policies are attached, they are only checked to be available.
+func (uc *PolicyImpactUseCase) policyAttachments(ctx context.Context, client pb.AttestationServiceClient, opts *PolicyImpactOpts) (*schemav1.Policies, error) {
+	logger := zerolog.Nop()
+	if opts.GroupRef != "" {
+		groupAtt := &schemav1.PolicyGroupAttachment{Ref: opts.GroupRef, With: opts.With}
+		if _, _, err := loader.LoadPolicyGroup(ctx, groupAtt, &loader.LoadPolicyGroupOptions{Client: client, Logger: &logger, ProviderRefsOnly: true}); err != nil {
+			return nil, policyResolutionError(err)
+		}
+
+		return nil, nil
+	}
+
+	attachment := &schemav1.PolicyAttachment{Policy: &schemav1.PolicyAttachment_Ref{Ref: opts.PolicyRef}, With: opts.With}
+	types, err := loader.NewPolicyVerifier(nil, client, &logger, loader.WithProviderRefsOnly(true)).PolicyTypes(ctx, attachment)
+	if err != nil {
+		return nil, policyResolutionError(err)
+	}
+
+	policies := &schemav1.Policies{}
+	if slices.Contains(types, schemav1.CraftingSchema_Material_ATTESTATION) {
+		policies.Attestation = append(policies.Attestation, attachment)
+	}
+
+	if slices.ContainsFunc(types, func(t schemav1.CraftingSchema_Material_MaterialType) bool {
+		return t != schemav1.CraftingSchema_Material_ATTESTATION
+	}) {
+		policies.Materials = append(policies.Materials, attachment)
+	}
+
+	return policies, nil
+}
+
+// policyResolutionError surfaces the errors of the providers, i.e the policy not being found
+func policyResolutionError(err error) error {
+	if IsNotFound(err) || IsErrUnauthorized(err) {
+		return err
+	}
+
+	return NewErrValidation(fmt.Errorf("loading policy: %w", err))
+}
+
+// evaluateRun evaluates the policies against the attestation of a run and reports the new violations
+func (uc *PolicyImpactUseCase) evaluateRun(ctx context.Context, orgID uuid.UUID, run *WorkflowRun, client pb.AttestationServiceClient, policies *schemav1.Policies, opts *PolicyImpactOpts) *PolicyImpactResult {
+	result := newPolicyImpactResult(run)
+
+	violations, err := uc.runViolations(ctx, orgID, run, client, policies, opts)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Violations = violations
+	return result
+}
+
+// runViolations evaluates the policies against the attestation of a run and its materials, which are downloaded
+// from CAS into a working directory only available to this evaluation
+func (uc *PolicyImpactUseCase) runViolations(ctx context.Context, orgID uuid.UUID, run *WorkflowRun, client pb.AttestationServiceClient, policies *schemav1.Policies, opts *PolicyImpactOpts) ([]*PolicyImpactViolation, error) {
+	// the analysis ran out of time
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("run not evaluated: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, policyImpactRunTimeout)
+	defer cancel()
+
+	if err := uc.wfRunUC.addAttestationFromBundle(ctx, run); err != nil {
+		return nil, fmt.Errorf("retrieving attestation: %w", err)
+	}
+
+	if run.Attestation == nil || run.Attestation.Envelope == nil {
+		return nil, errors.New("attestation not available")
+	}
+
+	statement, err := chainloop.ExtractStatement(run.Attestation.Envelope)
+	if err != nil {
+		return nil, fmt.Errorf("extracting statement: %w", err)
+	}
+
+	predicate, err := chainloop.ExtractPredicate(run.Attestation.Envelope)
+	if err != nil {
+		return nil, fmt.Errorf("extracting predicate: %w", err)
+	}
+
+	workDir, err := os.MkdirTemp("", "policy-impact-*")
+	if err != nil {
+		return nil, fmt.Errorf("creating working directory: %w", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	verifier, err := uc.newVerifier(ctx, orgID, run, client, policies, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var evaluations []*attv1.PolicyEvaluation
+	for i, m := range predicate.GetMaterials() {
+		material, path, err := uc.materialToEvaluate(ctx, orgID, m, filepath.Join(workDir, fmt.Sprint(i)))
+		if err != nil {
+			return nil, fmt.Errorf("material %q: %w", m.Name, err)
+		}
+
+		evs, err := verifier.VerifyMaterial(ctx, material, path)
+		if err != nil {
+			return nil, fmt.Errorf("evaluating material %q: %w", m.Name, err)
+		}
+
+		evaluations = append(evaluations, evs...)
+	}
+
+	evs, err := verifier.VerifyStatement(ctx, statement)
+	if err != nil {
+		return nil, fmt.Errorf("evaluating attestation: %w", err)
+	}
+
+	evaluations = append(evaluations, evs...)
+
+	return newViolations(predicate.GetPolicyEvaluations(), evaluations), nil
+}
+
+func (uc *PolicyImpactUseCase) newVerifier(ctx context.Context, orgID uuid.UUID, run *WorkflowRun, client pb.AttestationServiceClient, policies *schemav1.Policies, opts *PolicyImpactOpts) (loader.Verifier, error) {
+	// The runs are evaluated with the exceptions active today, the same way a new attestation would be
+	exceptions, err := uc.exceptionUC.FindActive(ctx, orgID.String(), run.Workflow)
+	if err != nil {
+		return nil, fmt.Errorf("finding policy exceptions: %w", err)
+	}
+
+	items := make([]*pb.PolicyExceptionItem, 0, len(exceptions))
+	for _, e := range exceptions {
+		items = append(items, &pb.PolicyExceptionItem{Id: e.ID.String(), PolicyName: e.PolicyName, MaterialName: e.MaterialName, ExternalId: e.ExternalID})
+	}
+
+	var version string
+	if run.ProjectVersion != nil {
+		version = run.ProjectVersion.Version
+	}
+
+	verifierOpts := []loader.PolicyVerifierOption{
+		loader.WithProjectContext(run.Workflow.Project, version),
+		loader.WithPolicyExceptions(loader.NewStaticPolicyExceptions(items...)),
+		loader.WithVulnerabilityDB(uc.vulnDB),
+		// Policies are evaluated in the server, so they can't read local files nor reach the network,
+		// and they are bounded in time
+		loader.WithProviderRefsOnly(true),
+		loader.WithNetworkDisabled(true),
+		loader.WithExecutionTimeout(policyImpactPolicyTimeout),
+	}
+
+	logger := zerolog.Nop()
+	if opts.GroupRef != "" {
+		groups := []*schemav1.PolicyGroupAttachment{{Ref: opts.GroupRef, With: opts.With}}
+		return loader.NewPolicyGroupVerifier(groups, nil, client, &logger, verifierOpts...), nil
+	}
+
+	return loader.NewPolicyVerifier(policies, client, &logger, verifierOpts...), nil
+}
+
+// materialToEvaluate rebuilds a material of the attestation, placing the content of artifacts in the given path
+func (uc *PolicyImpactUseCase) materialToEvaluate(ctx context.Context, orgID uuid.UUID, m *chainloop.NormalizedMaterial, dir string) (*attv1.Attestation_Material, string, error) {
+	material := &attv1.Attestation_Material{
+		Id:            m.Name,
+		MaterialType:  schemav1.CraftingSchema_Material_MaterialType(schemav1.CraftingSchema_Material_MaterialType_value[m.Type]),
+		Annotations:   m.Annotations,
+		UploadedToCas: m.UploadedToCAS,
+	}
+
+	var digest string
+	if m.Hash != nil {
+		digest = m.Hash.String()
+	}
+
+	switch material.MaterialType {
+	case schemav1.CraftingSchema_Material_STRING:
+		material.M = &attv1.Attestation_Material_String_{String_: &attv1.Attestation_Material_KeyVal{Id: m.Name, Value: string(m.Value), Digest: digest}}
+		return material, "", nil
+	case schemav1.CraftingSchema_Material_CONTAINER_IMAGE:
+		material.M = &attv1.Attestation_Material_ContainerImage_{ContainerImage: &attv1.Attestation_Material_ContainerImage{Id: m.Name, Name: string(m.Value), Digest: digest, Tag: m.Tag}}
+		return material, "", nil
+	}
+
+	material.M = &attv1.Attestation_Material_Artifact_{Artifact: &attv1.Attestation_Material_Artifact{Id: m.Name, Name: m.Filename, Digest: digest}}
+	// the content was not stored, policies requiring it will fail to evaluate
+	if !m.EmbeddedInline && !m.UploadedToCAS {
+		return material, "", nil
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, "", fmt.Errorf("creating directory: %w", err)
+	}
+
+	// the filename is kept since some materials are detected by their extension
+	path := filepath.Join(dir, filepath.Base(m.Filename))
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("creating file: %w", err)
+	}
+	defer f.Close()
+
+	if m.EmbeddedInline {
+		if _, err := f.Write(m.Value); err != nil {
+			return nil, "", fmt.Errorf("writing file: %w", err)
+		}
+
+		return material, path, nil
+	}
+
+	mapping, err := uc.casMappingUC.FindCASMappingForDownloadByOrg(ctx, digest, []uuid.UUID{orgID}, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("finding CAS mapping: %w", err)
+	} else if mapping == nil {
+		return nil, "", errors.New("not available in CAS")
+	}
+
+	maxBytes := policyImpactMaxMaterialBytes
+	if l := mapping.CASBackend.Limits; l != nil && l.MaxBytes > 0 {
+		maxBytes = l.MaxBytes
+	}
+
+	if err := uc.casClient.Download(ctx, mapping.CASBackend, &limitedWriter{w: f, remaining: maxBytes}, digest); err != nil {
+		return nil, "", fmt.Errorf("downloading from CAS: %w", err)
+	}
+
+	return material, path, nil
+}
+
+var errMaterialTooLarge = errors.New("material exceeds the maximum size of the CAS backend")
+
+// limitedWriter fails once more than the allowed bytes are written to it
+type limitedWriter struct {
+	w         io.Writer
+	remaining int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining {
+		return 0, errMaterialTooLarge
+	}
+
+	n, err := l.w.Write(p)
+	l.remaining -= int64(n)
+	return n, err
+}
+
+// newViolations returns the violations of the evaluations that were not reported for the same policy and material
+// when the run was attested
+func newViolations(original map[string][]*chainloop.PolicyEvaluation, evaluations []*attv1.PolicyEvaluation) []*PolicyImpactViolation {
+	type key struct{ policy, material, message string }
+
+	reported := make(map[key]bool)
+	for _, evs := range original {
+		for _, ev := range evs {
+			for _, v := range ev.Violations {
+				if !v.Suppress {
+					reported[key{ev.Name, ev.MaterialName, v.Message}] = true
+				}
+			}
+		}
+	}
+
+	var result []*PolicyImpactViolation
+	for _, ev := range evaluations {
+		for _, v := range ev.GetViolations() {
+			if v.GetSuppress() || reported[key{ev.GetName(), ev.GetMaterialName(), v.GetMessage()}] {
+				continue
+			}
+
+			result = append(result, &PolicyImpactViolation{PolicyName: ev.GetName(), MaterialName: ev.GetMaterialName(), Message: v.GetMessage()})
+		}
+	}
+
+	return result
+}
+
+// policyProviderClient resolves the policies and groups referenced with chainloop:// from the providers
+// on behalf of the requester, the same way the attestation service does for the CLI.
+// It only implements the methods used by the policy loaders, and caches the responses.
+type policyProviderClient struct {
+	pb.AttestationServiceClient
+
+	contractUC     *WorkflowContractUseCase
+	orgName, token string
+
+	policies sync.Map
+	groups   sync.Map
+}
+
+func (c *policyProviderClient) GetPolicy(ctx context.Context, in *pb.AttestationServiceGetPolicyRequest, _ ...grpc.CallOption) (*pb.AttestationServiceGetPolicyResponse, error) {
+	key := fmt.Sprintf("%s/%s/%s", in.GetProvider(), in.GetOrgName(), in.GetPolicyName())
+	if resp, ok := c.policies.Load(key); ok {
+		return resp.(*pb.AttestationServiceGetPolicyResponse), nil
+	}
+
+	remotePolicy, err := c.contractUC.GetPolicy(ctx, in.GetProvider(), in.GetPolicyName(), in.GetOrgName(), c.orgName, c.token)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AttestationServiceGetPolicyResponse{Policy: remotePolicy.Policy, Reference: &pb.RemotePolicyReference{
+		Url:    remotePolicy.ProviderRef.URL,
+		Digest: remotePolicy.ProviderRef.Digest,
+	}}
+	c.policies.Store(key, resp)
+
+	return resp, nil
+}
+
+func (c *policyProviderClient) GetPolicyGroup(ctx context.Context, in *pb.AttestationServiceGetPolicyGroupRequest, _ ...grpc.CallOption) (*pb.AttestationServiceGetPolicyGroupResponse, error) {
+	key := fmt.Sprintf("%s/%s/%s", in.GetProvider(), in.GetOrgName(), in.GetGroupName())
+	if resp, ok := c.groups.Load(key); ok {
+		return resp.(*pb.AttestationServiceGetPolicyGroupResponse), nil
+	}
+
+	remoteGroup, err := c.contractUC.GetPolicyGroup(ctx, in.GetProvider(), in.GetGroupName(), in.GetOrgName(), c.orgName, c.token)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AttestationServiceGetPolicyGroupResponse{Group: remoteGroup.PolicyGroup, Reference: &pb.RemotePolicyReference{
+		Url:    remoteGroup.ProviderRef.URL,
+		Digest: remoteGroup.ProviderRef.Digest,
+	}}
+	c.groups.Store(key, resp)
+
+	return resp, nil
+}
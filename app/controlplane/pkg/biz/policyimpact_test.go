@@ -0,0 +1,205 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	schemav1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attv1 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyImpactOptsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    *PolicyImpactOpts
+		wantErr string
+	}{
+		{
+			name: "policy",
+			opts: &PolicyImpactOpts{PolicyRef: "chainloop://my-policy", VersionRange: ">= 1.2.0, < 2.0.0"},
+		},
+		{
+			name: "group",
+			opts: &PolicyImpactOpts{GroupRef: "chainloop://my-group", Limit: maxPolicyImpactLimit},
+		},
+		{
+			name:    "nothing to evaluate",
+			opts:    &PolicyImpactOpts{},
+			wantErr: "either a policy or a policy group must be provided",
+		},
+		{
+			name:    "both policy and group",
+			opts:    &PolicyImpactOpts{PolicyRef: "chainloop://my-policy", GroupRef: "chainloop://my-group"},
+			wantErr: "either a policy or a policy group must be provided",
+		},
+		{
+			name:    "not from a provider",
+			opts:    &PolicyImpactOpts{PolicyRef: "https://example.com/policy.yaml"},
+			wantErr: "only policies and groups from a policy provider can be evaluated",
+		},
+		{
+			name:    "too many runs",
+			opts:    &PolicyImpactOpts{PolicyRef: "chainloop://my-policy", Limit: maxPolicyImpactLimit + 1},
+			wantErr: "at most 500 runs can be evaluated",
+		},
+		{
+			name:    "invalid version range",
+			opts:    &PolicyImpactOpts{PolicyRef: "chainloop://my-policy", VersionRange: "not a range"},
+			wantErr: "invalid version range",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.validate()
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				assert.True(t, IsErrValidation(err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVersionInRange(t *testing.T) {
+	constraint, err := semver.NewConstraint(">= 1.2.0, < 2.0.0")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		pv   *ProjectVersion
+		want bool
+	}{
+		{name: "no version", pv: nil},
+		{name: "in range", pv: &ProjectVersion{Version: "1.4.1"}, want: true},
+		{name: "with prefix", pv: &ProjectVersion{Version: "v1.2.0"}, want: true},
+		{name: "out of range", pv: &ProjectVersion{Version: "2.0.0"}},
+		{name: "not semver", pv: &ProjectVersion{Version: "nightly"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, versionInRange(tc.pv, constraint))
+		})
+	}
+}
+
+func TestNewViolations(t *testing.T) {
+	original := map[string][]*chainloop.PolicyEvaluation{
+		"sbom": {
+			{Name: "already-failing", MaterialName: "sbom", Violations: []*chainloop.PolicyViolation{{Message: "missing license"}}},
+			{Name: "suppressed", MaterialName: "sbom", Violations: []*chainloop.PolicyViolation{{Message: "waived", Suppress: true}}},
+			{Name: "passing", MaterialName: "sbom"},
+		},
+		"other-sbom": {
+			{Name: "passing", MaterialName: "other-sbom", Violations: []*chainloop.PolicyViolation{{Message: "missing supplier"}}},
+		},
+	}
+
+	evaluations := []*attv1.PolicyEvaluation{
+		{Name: "already-failing", MaterialName: "sbom", Violations: []*attv1.PolicyEvaluation_Violation{
+			{Message: "missing license"},
+			{Message: "missing supplier"},
+		}},
+		{Name: "suppressed", MaterialName: "sbom", Violations: []*attv1.PolicyEvaluation_Violation{{Message: "not waived anymore"}}},
+		{Name: "passing", MaterialName: "sbom", Violations: []*attv1.PolicyEvaluation_Violation{
+			{Message: "new violation"},
+			{Message: "waived by an exception", Suppress: true},
+			// reported for another material
+			{Message: "missing supplier"},
+		}},
+		{Name: "passing", MaterialName: "other-sbom", Violations: []*attv1.PolicyEvaluation_Violation{{Message: "missing supplier"}}},
+		{Name: "new-policy", Violations: []*attv1.PolicyEvaluation_Violation{{Message: "missing commit"}}},
+	}
+
+	got := newViolations(original, evaluations)
+	assert.Equal(t, []*PolicyImpactViolation{
+		{PolicyName: "already-failing", MaterialName: "sbom", Message: "missing supplier"},
+		{PolicyName: "suppressed", MaterialName: "sbom", Message: "not waived anymore"},
+		{PolicyName: "passing", MaterialName: "sbom", Message: "new violation"},
+		{PolicyName: "passing", MaterialName: "sbom", Message: "missing supplier"},
+		{PolicyName: "new-policy", Message: "missing commit"},
+	}, got)
+}
+
+func TestLimitedWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := &limitedWriter{w: &buf, remaining: 10}
+
+	_, err := w.Write([]byte("12345"))
+	require.NoError(t, err)
+	_, err = w.Write([]byte("67890"))
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("1"))
+	assert.ErrorIs(t, err, errMaterialTooLarge)
+	assert.Equal(t, "1234567890", buf.String())
+}
+
+func TestMaterialToEvaluate(t *testing.T) {
+	uc := &PolicyImpactUseCase{}
+	ctx := context.Background()
+	orgID := uuid.New()
+
+	t.Run("string", func(t *testing.T) {
+		m, path, err := uc.materialToEvaluate(ctx, orgID, &chainloop.NormalizedMaterial{Name: "version", Type: "STRING", Value: []byte("1.0.0")}, t.TempDir())
+		require.NoError(t, err)
+		assert.Empty(t, path)
+		assert.Equal(t, schemav1.CraftingSchema_Material_STRING, m.MaterialType)
+		assert.Equal(t, "1.0.0", m.GetString_().GetValue())
+	})
+
+	t.Run("container image", func(t *testing.T) {
+		m, path, err := uc.materialToEvaluate(ctx, orgID, &chainloop.NormalizedMaterial{Name: "image", Type: "CONTAINER_IMAGE", Value: []byte("ghcr.io/chainloop-dev/chainloop/cli"), Tag: "v1.0.0"}, t.TempDir())
+		require.NoError(t, err)
+		assert.Empty(t, path)
+		assert.Equal(t, "ghcr.io/chainloop-dev/chainloop/cli", m.GetContainerImage().GetName())
+		assert.Equal(t, "v1.0.0", m.GetContainerImage().GetTag())
+	})
+
+	t.Run("inline artifact", func(t *testing.T) {
+		dir := t.TempDir()
+		m, path, err := uc.materialToEvaluate(ctx, orgID, &chainloop.NormalizedMaterial{
+			Name: "sbom", Type: "SBOM_CYCLONEDX_JSON", Filename: "sbom.cdx.json", Value: []byte(`{"bomFormat": "CycloneDX"}`), EmbeddedInline: true,
+		}, dir)
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(dir, "sbom.cdx.json"), path)
+		assert.Equal(t, "sbom.cdx.json", m.GetArtifact().GetName())
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"bomFormat": "CycloneDX"}`, string(content))
+	})
+
+	t.Run("content not stored", func(t *testing.T) {
+		m, path, err := uc.materialToEvaluate(ctx, orgID, &chainloop.NormalizedMaterial{Name: "report", Type: "JUNIT_XML", Filename: "report.xml"}, t.TempDir())
+		require.NoError(t, err)
+		assert.Empty(t, path)
+		assert.NotNil(t, m.GetArtifact())
+	})
+}
@@ -38,6 +38,10 @@ type ProjectsRepo interface {
 	FindProjectByOrgIDAndID(ctx context.Context, orgID uuid.UUID, projectID uuid.UUID) (*Project, error)
 	Create(ctx context.Context, orgID uuid.UUID, name string) (*Project, error)
 	ListProjectsByOrgID(ctx context.Context, orgID uuid.UUID) ([]*Project, error)
+	// List retrieves a paginated list of projects in an organization, optionally restricted to the given project IDs.
+	List(ctx context.Context, orgID uuid.UUID, projectIDs []uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*Project, int, error)
+	// SoftDelete marks a project and its versions as deleted. It fails if the project still has workflows.
+	SoftDelete(ctx context.Context, orgID uuid.UUID, projectID uuid.UUID) error
 	// ListMembers retrieves a list of members in a project, optionally filtered by admin status.
 	ListMembers(ctx context.Context, orgID uuid.UUID, projectID uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*ProjectMembership, int, error)
 	// AddMemberToProject adds a user or group to a project with a specific role.
@@ -204,7 +208,20 @@ func (uc *ProjectUseCase) FindProjectByReference(ctx context.Context, orgID stri
 	}
 }
 
-func (uc *ProjectUseCase) Create(ctx context.Context, orgID, name string) (*Project, error) {
+type projectCreateOpts struct {
+	owner *uuid.UUID
+}
+
+type ProjectCreateOpt func(*projectCreateOpts)
+
+// WithProjectOwner sets the user that will be granted the admin role on the newly created project
+func WithProjectOwner(userID uuid.UUID) ProjectCreateOpt {
+	return func(o *projectCreateOpts) {
+		o.owner = &userID
+	}
+}
+
+func (uc *ProjectUseCase) Create(ctx context.Context, orgID, name string, opts ...ProjectCreateOpt) (*Project, error) {
 	ctx, span := otelx.Start(ctx, projectTracer, "ProjectUseCase.Create")
 	defer span.End()
 
@@ -212,14 +229,34 @@ func (uc *ProjectUseCase) Create(ctx context.Context, orgID, name string) (*Proj
 		return nil, NewErrValidationStr("orgID or project name are empty")
 	}
 
+	if err := ValidateIsDNS1123(name); err != nil {
+		return nil, NewErrValidation(err)
+	}
+
 	orgUUID, err := uuid.Parse(orgID)
 	if err != nil {
 		return nil, NewErrInvalidUUID(err)
 	}
 
+	co := &projectCreateOpts{}
+	for _, o := range opts {
+		o(co)
+	}
+
 	project, err := uc.projectsRepository.Create(ctx, orgUUID, name)
 	if err != nil {
-		return nil, err
+		if IsErrAlreadyExists(err) {
+			return nil, NewErrAlreadyExistsStr(fmt.Sprintf("project %q already exists", name))
+		}
+
+		return nil, fmt.Errorf("can't create the project: %w", err)
+	}
+
+	// Set the project admin if requested
+	if co.owner != nil {
+		if err = uc.membershipUC.SetProjectOwner(ctx, orgUUID, project.ID, *co.owner); err != nil {
+			return nil, fmt.Errorf("failed to set project owner: %w", err)
+		}
 	}
 
 	// Dispatch audit event for project creation
@@ -233,6 +270,51 @@ func (uc *ProjectUseCase) Create(ctx context.Context, orgID, name string) (*Proj
 	return project, nil
 }
 
+// List returns the projects of the organization with pagination.
+// If projectIDs is not nil, the result is restricted to those projects.
+func (uc *ProjectUseCase) List(ctx context.Context, orgID string, projectIDs []uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*Project, int, error) {
+	ctx, span := otelx.Start(ctx, projectTracer, "ProjectUseCase.List")
+	defer span.End()
+
+	orgUUID, err := uuid.Parse(orgID)
+	if err != nil {
+		return nil, 0, NewErrInvalidUUID(err)
+	}
+
+	// Use default pagination options if none provided
+	pgOpts := pagination.NewDefaultOffsetPaginationOpts()
+	if paginationOpts != nil {
+		pgOpts = paginationOpts
+	}
+
+	return uc.projectsRepository.List(ctx, orgUUID, projectIDs, pgOpts)
+}
+
+// Delete soft-deletes a project along with its versions.
+// Projects that still contain workflows can not be deleted.
+func (uc *ProjectUseCase) Delete(ctx context.Context, orgID string, reference *IdentityReference) error {
+	ctx, span := otelx.Start(ctx, projectTracer, "ProjectUseCase.Delete")
+	defer span.End()
+
+	project, err := uc.FindProjectByReference(ctx, orgID, reference)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.projectsRepository.SoftDelete(ctx, project.OrgID, project.ID); err != nil {
+		return err
+	}
+
+	uc.auditorUC.Dispatch(ctx, &events.ProjectDeleted{
+		ProjectBase: &events.ProjectBase{
+			ProjectID:   &project.ID,
+			ProjectName: project.Name,
+		},
+	}, &project.OrgID)
+
+	return nil
+}
+
 // ListMembers lists the members of a project with pagination.
 func (uc *ProjectUseCase) ListMembers(ctx context.Context, orgID uuid.UUID, projectRef *IdentityReference, paginationOpts *pagination.OffsetPaginationOpts) ([]*ProjectMembership, int, error) {
 	ctx, span := otelx.Start(ctx, projectTracer, "ProjectUseCase.ListMembers")
@@ -37,6 +37,132 @@ func TestProjectUseCase(t *testing.T) {
 	suite.Run(t, new(projectGroupMembersIntegrationTestSuite))
 	suite.Run(t, new(projectAdminPermissionsTestSuite))
 	suite.Run(t, new(projectPermissionsTestSuite))
+	suite.Run(t, new(projectListDeleteIntegrationTestSuite))
+}
+
+type projectListDeleteIntegrationTestSuite struct {
+	testhelpers.UseCasesEachTestSuite
+	org *biz.Organization
+}
+
+func (s *projectListDeleteIntegrationTestSuite) SetupTest() {
+	var err error
+	s.TestingUseCases = testhelpers.NewTestingUseCases(s.T())
+
+	s.org, err = s.Organization.CreateWithRandomName(context.Background())
+	s.Require().NoError(err)
+}
+
+func (s *projectListDeleteIntegrationTestSuite) TestList() {
+	ctx := context.Background()
+
+	var projects []*biz.Project
+	for _, name := range []string{"project-c", "project-a", "project-b"} {
+		p, err := s.Project.Create(ctx, s.org.ID, name)
+		s.Require().NoError(err)
+		projects = append(projects, p)
+	}
+
+	// projects of other organizations are not listed
+	otherOrg, err := s.Organization.CreateWithRandomName(ctx)
+	s.Require().NoError(err)
+	_, err = s.Project.Create(ctx, otherOrg.ID, "project-a")
+	s.Require().NoError(err)
+
+	names := func(projects []*biz.Project) []string {
+		var res []string
+		for _, p := range projects {
+			res = append(res, p.Name)
+		}
+		return res
+	}
+
+	s.Run("sorted by name", func() {
+		got, total, err := s.Project.List(ctx, s.org.ID, nil, nil)
+		s.Require().NoError(err)
+		s.Equal(3, total)
+		s.Equal([]string{"project-a", "project-b", "project-c"}, names(got))
+	})
+
+	s.Run("paginated", func() {
+		opts, err := pagination.NewOffsetPaginationOpts(2, 2)
+		s.Require().NoError(err)
+
+		got, total, err := s.Project.List(ctx, s.org.ID, nil, opts)
+		s.Require().NoError(err)
+		s.Equal(3, total)
+		s.Equal([]string{"project-c"}, names(got))
+	})
+
+	s.Run("restricted to the given projects", func() {
+		got, total, err := s.Project.List(ctx, s.org.ID, []uuid.UUID{projects[0].ID}, nil)
+		s.Require().NoError(err)
+		s.Equal(1, total)
+		s.Equal([]string{"project-c"}, names(got))
+
+		// an empty slice, unlike nil, means no project is visible
+		got, total, err = s.Project.List(ctx, s.org.ID, []uuid.UUID{}, nil)
+		s.Require().NoError(err)
+		s.Equal(0, total)
+		s.Empty(got)
+	})
+
+	s.Run("deleted projects are not listed", func() {
+		s.Require().NoError(s.Project.Delete(ctx, s.org.ID, &biz.IdentityReference{ID: &projects[1].ID}))
+
+		got, total, err := s.Project.List(ctx, s.org.ID, nil, nil)
+		s.Require().NoError(err)
+		s.Equal(2, total)
+		s.Equal([]string{"project-b", "project-c"}, names(got))
+	})
+}
+
+func (s *projectListDeleteIntegrationTestSuite) TestDelete() {
+	ctx := context.Background()
+
+	project, err := s.Project.Create(ctx, s.org.ID, "to-delete")
+	s.Require().NoError(err)
+	_, err = s.ProjectVersion.Create(ctx, project.ID.String(), "1.0.0", true)
+	s.Require().NoError(err)
+
+	ref := &biz.IdentityReference{Name: &project.Name}
+
+	s.Run("projects with workflows can't be deleted", func() {
+		wf, err := s.Workflow.Create(ctx, &biz.WorkflowCreateOpts{Name: "wf", OrgID: s.org.ID, Project: project.Name})
+		s.Require().NoError(err)
+
+		err = s.Project.Delete(ctx, s.org.ID, ref)
+		s.True(biz.IsErrValidation(err))
+
+		s.Require().NoError(s.Workflow.Delete(ctx, s.org.ID, wf.ID.String()))
+	})
+
+	s.Run("projects of other organizations can't be deleted", func() {
+		otherOrg, err := s.Organization.CreateWithRandomName(ctx)
+		s.Require().NoError(err)
+
+		err = s.Project.Delete(ctx, otherOrg.ID, &biz.IdentityReference{ID: &project.ID})
+		s.True(biz.IsNotFound(err))
+	})
+
+	s.Run("the project and its versions are deleted", func() {
+		s.Require().NoError(s.Project.Delete(ctx, s.org.ID, ref))
+
+		_, err := s.Project.FindProjectByReference(ctx, s.org.ID, ref)
+		s.True(biz.IsNotFound(err))
+
+		_, err = s.ProjectVersion.FindByProjectAndVersion(ctx, project.ID.String(), "1.0.0")
+		s.True(biz.IsNotFound(err))
+
+		// deleting it again fails
+		err = s.Project.Delete(ctx, s.org.ID, ref)
+		s.True(biz.IsNotFound(err))
+	})
+
+	s.Run("the name can be reused", func() {
+		_, err := s.Project.Create(ctx, s.org.ID, "to-delete")
+		s.NoError(err)
+	})
 }
 
 // Utility struct for project members tests
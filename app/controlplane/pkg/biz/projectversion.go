@@ -17,10 +17,12 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/auditor/events"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
 	"github.com/chainloop-dev/chainloop/pkg/otelx"
 	"github.com/chainloop-dev/chainloop/pkg/servicelogger"
 	"github.com/go-kratos/kratos/v2/log"
@@ -100,6 +102,10 @@ type ProjectVersionRepo interface {
 	Update(ctx context.Context, versionID uuid.UUID, updates *ProjectVersionUpdateOpts) (*ProjectVersion, error)
 	Create(ctx context.Context, projectID uuid.UUID, version string, prerelease bool) (*ProjectVersion, error)
 	MarkAsLatest(ctx context.Context, projectID, versionID uuid.UUID) (*ProjectVersionPromotion, error)
+	// ListByProject returns the versions of a project, most recent first
+	ListByProject(ctx context.Context, projectID uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*ProjectVersion, int, error)
+	// FindLatestByProject returns the version currently marked as latest for a project
+	FindLatestByProject(ctx context.Context, projectID uuid.UUID) (*ProjectVersion, error)
 }
 
 type ProjectVersionUseCase struct {
@@ -202,3 +208,122 @@ func (uc *ProjectVersionUseCase) Create(ctx context.Context, projectID, version
 
 	return uc.projectRepo.Create(ctx, projectUUID, version, prerelease)
 }
+
+// CreateInProject creates a new version for the given project and records it in the audit log.
+// The new version becomes the latest one of the project.
+func (uc *ProjectVersionUseCase) CreateInProject(ctx context.Context, project *Project, version string, prerelease bool) (*ProjectVersion, error) {
+	ctx, span := otelx.Start(ctx, projectVersionTracer, "ProjectVersionUseCase.CreateInProject")
+	defer span.End()
+
+	if project == nil {
+		return nil, NewErrValidationStr("project is required")
+	}
+
+	pv, err := uc.Create(ctx, project.ID.String(), version, prerelease)
+	if err != nil {
+		if IsErrAlreadyExists(err) {
+			return nil, NewErrAlreadyExistsStr(fmt.Sprintf("version %q already exists in project %q", version, project.Name))
+		}
+
+		return nil, err
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.ProjectVersionCreated{
+			ProjectBase: &events.ProjectBase{
+				ProjectID:   &project.ID,
+				ProjectName: project.Name,
+			},
+			VersionID:  &pv.ID,
+			Version:    pv.Version,
+			Prerelease: pv.Prerelease,
+		}, &project.OrgID)
+	}
+
+	return pv, nil
+}
+
+// List returns the versions of a project with pagination
+func (uc *ProjectVersionUseCase) List(ctx context.Context, projectID string, paginationOpts *pagination.OffsetPaginationOpts) ([]*ProjectVersion, int, error) {
+	ctx, span := otelx.Start(ctx, projectVersionTracer, "ProjectVersionUseCase.List")
+	defer span.End()
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, 0, NewErrInvalidUUID(err)
+	}
+
+	// Use default pagination options if none provided
+	pgOpts := pagination.NewDefaultOffsetPaginationOpts()
+	if paginationOpts != nil {
+		pgOpts = paginationOpts
+	}
+
+	return uc.projectRepo.ListByProject(ctx, projectUUID, pgOpts)
+}
+
+// FindLatestByProject returns the latest version of a project
+func (uc *ProjectVersionUseCase) FindLatestByProject(ctx context.Context, projectID string) (*ProjectVersion, error) {
+	ctx, span := otelx.Start(ctx, projectVersionTracer, "ProjectVersionUseCase.FindLatestByProject")
+	defer span.End()
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, NewErrInvalidUUID(err)
+	}
+
+	return uc.projectRepo.FindLatestByProject(ctx, projectUUID)
+}
+
+// Release marks a pre-release version of the project as released
+func (uc *ProjectVersionUseCase) Release(ctx context.Context, project *Project, version string) (*ProjectVersion, error) {
+	ctx, span := otelx.Start(ctx, projectVersionTracer, "ProjectVersionUseCase.Release")
+	defer span.End()
+
+	return uc.setReleaseStatus(ctx, project, version, true)
+}
+
+// Unrelease moves a released version of the project back to pre-release
+func (uc *ProjectVersionUseCase) Unrelease(ctx context.Context, project *Project, version string) (*ProjectVersion, error) {
+	ctx, span := otelx.Start(ctx, projectVersionTracer, "ProjectVersionUseCase.Unrelease")
+	defer span.End()
+
+	return uc.setReleaseStatus(ctx, project, version, false)
+}
+
+func (uc *ProjectVersionUseCase) setReleaseStatus(ctx context.Context, project *Project, version string, release bool) (*ProjectVersion, error) {
+	if project == nil {
+		return nil, NewErrValidationStr("project is required")
+	}
+
+	pv, err := uc.projectRepo.FindByProjectAndVersion(ctx, project.ID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case release && !pv.Prerelease:
+		return nil, NewErrValidationStr(fmt.Sprintf("version %q is already released", version))
+	case !release && pv.Prerelease:
+		return nil, NewErrValidationStr(fmt.Sprintf("version %q is already a pre-release", version))
+	}
+
+	updated, err := uc.UpdateReleaseStatus(ctx, pv.ID.String(), release)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.auditorUC != nil {
+		uc.auditorUC.Dispatch(ctx, &events.ProjectVersionUpdated{
+			ProjectBase: &events.ProjectBase{
+				ProjectID:   &project.ID,
+				ProjectName: project.Name,
+			},
+			VersionID:  &updated.ID,
+			Version:    updated.Version,
+			Prerelease: &updated.Prerelease,
+		}, &project.OrgID)
+	}
+
+	return updated, nil
+}
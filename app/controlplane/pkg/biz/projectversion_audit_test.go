@@ -24,17 +24,32 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// stubProjectVersionRepo returns a canned promotion outcome. Only MarkAsLatest
-// is exercised; any other call panics on the nil embedded interface.
+// stubProjectVersionRepo returns canned outcomes for the calls exercised here;
+// any other call panics on the nil embedded interface.
 type stubProjectVersionRepo struct {
 	ProjectVersionRepo
 	promotion *ProjectVersionPromotion
+	version   *ProjectVersion
 }
 
 func (s *stubProjectVersionRepo) MarkAsLatest(_ context.Context, _, _ uuid.UUID) (*ProjectVersionPromotion, error) {
 	return s.promotion, nil
 }
 
+func (s *stubProjectVersionRepo) FindByProjectAndVersion(_ context.Context, _ uuid.UUID, _ string) (*ProjectVersion, error) {
+	return s.version, nil
+}
+
+func (s *stubProjectVersionRepo) Update(_ context.Context, _ uuid.UUID, updates *ProjectVersionUpdateOpts) (*ProjectVersion, error) {
+	updated := *s.version
+	updated.Prerelease = *updates.Prerelease
+	return &updated, nil
+}
+
+func (s *stubProjectVersionRepo) Create(_ context.Context, _ uuid.UUID, _ string, _ bool) (*ProjectVersion, error) {
+	return s.version, nil
+}
+
 // Promoting a version out of band must be as loud as promoting it through an
 // attestation, so that whatever tracks the latest version of a project follows.
 func TestMarkAsLatestDispatchesProjectVersionUpdated(t *testing.T) {
@@ -78,3 +93,79 @@ func TestMarkAsLatestDispatchesProjectVersionUpdated(t *testing.T) {
 		})
 	}
 }
+
+// Changing the release status of a version must be reported, and asking for
+// the status the version already has must fail without reporting anything.
+func TestReleaseStatusDispatchesProjectVersionUpdated(t *testing.T) {
+	project := &Project{ID: uuid.New(), Name: "test-project", OrgID: uuid.New()}
+
+	testCases := []struct {
+		name           string
+		release        bool
+		prerelease     bool
+		wantErr        bool
+		wantPrerelease bool
+	}{
+		{
+			name:       "release a pre-release",
+			release:    true,
+			prerelease: true,
+		},
+		{
+			name:           "unrelease a release",
+			prerelease:     false,
+			wantPrerelease: true,
+		},
+		{
+			name:    "release an already released version",
+			release: true,
+			wantErr: true,
+		},
+		{
+			name:       "unrelease a pre-release",
+			prerelease: true,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			auditorUC, publisher := newRecordingAuditor()
+			version := &ProjectVersion{ID: uuid.New(), Version: "v1.0.0", Prerelease: tc.prerelease}
+			uc := NewProjectVersionUseCase(&stubProjectVersionRepo{version: version}, auditorUC, nil)
+
+			ctx := ctxWithAPITokenActor(context.Background())
+			var got *ProjectVersion
+			var err error
+			if tc.release {
+				got, err = uc.Release(ctx, project, version.Version)
+			} else {
+				got, err = uc.Unrelease(ctx, project, version.Version)
+			}
+
+			if tc.wantErr {
+				require.True(t, IsErrValidation(err))
+				require.Empty(t, publisher.published)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantPrerelease, got.Prerelease)
+			publisher.assertSingleProjectVersionEvent(t, events.ProjectVersionUpdatedActionType, project, version, false)
+		})
+	}
+}
+
+func TestCreateInProjectDispatchesProjectVersionCreated(t *testing.T) {
+	project := &Project{ID: uuid.New(), Name: "test-project", OrgID: uuid.New()}
+	version := &ProjectVersion{ID: uuid.New(), Version: "v1.0.0", Prerelease: true, Latest: true}
+
+	auditorUC, publisher := newRecordingAuditor()
+	uc := NewProjectVersionUseCase(&stubProjectVersionRepo{version: version}, auditorUC, nil)
+
+	got, err := uc.CreateInProject(ctxWithAPITokenActor(context.Background()), project, version.Version, true)
+	require.NoError(t, err)
+	require.Equal(t, version, got)
+
+	publisher.assertSingleProjectVersionEvent(t, events.ProjectVersionCreatedActionType, project, version, false)
+}
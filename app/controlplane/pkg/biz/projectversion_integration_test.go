@@ -23,6 +23,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/testhelpers"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -255,6 +256,39 @@ func (s *ProjectVersionIntegrationTestSuite) TestFindByProjectAndVersionIgnoresS
 	require.Equal(t, recreated.ID, found.ID)
 }
 
+func (s *ProjectVersionIntegrationTestSuite) TestList() {
+	t := s.T()
+	ctx := context.Background()
+
+	for _, v := range []string{"1.0.0", "1.1.0", "1.2.0"} {
+		_, err := s.ProjectVersion.Create(ctx, s.project.ID.String(), v, true)
+		require.NoError(t, err)
+	}
+
+	// most recent first
+	got, total, err := s.ProjectVersion.List(ctx, s.project.ID.String(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+	require.Len(t, got, 3)
+	require.Equal(t, "1.2.0", got[0].Version)
+	require.Equal(t, "1.0.0", got[2].Version)
+
+	opts, err := pagination.NewOffsetPaginationOpts(2, 2)
+	require.NoError(t, err)
+	got, total, err = s.ProjectVersion.List(ctx, s.project.ID.String(), opts)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+	require.Len(t, got, 1)
+	require.Equal(t, "1.0.0", got[0].Version)
+
+	latest, err := s.ProjectVersion.FindLatestByProject(ctx, s.project.ID.String())
+	require.NoError(t, err)
+	require.Equal(t, "1.2.0", latest.Version)
+
+	_, _, err = s.ProjectVersion.List(ctx, "invalid-uuid", nil)
+	require.True(t, biz.IsErrInvalidUUID(err))
+}
+
 func TestProjectVersionUseCase(t *testing.T) {
 	suite.Run(t, new(ProjectVersionIntegrationTestSuite))
 }
@@ -77,6 +77,8 @@ type TestingUseCases struct {
 	Project                *biz.ProjectUseCase
 	OrgMetrics             *biz.OrgMetricsUseCase
 	Group                  *biz.GroupUseCase
+	PolicyEvaluation       *biz.PolicyEvaluationUseCase
+	PolicyException        *biz.PolicyExceptionUseCase
 	// Repositories that can be used for custom crafting of use-cases
 	Repos *TestingRepos
 }
@@ -103,16 +103,18 @@ func WireTestData(contextContext context.Context, testDatabase *TestDatabase, t
 	casClient := newNilCASClient()
 	casMappingRepo := data.NewCASMappingRepo(dataData, casBackendRepo, logger)
 	casMappingUseCase := biz.NewCASMappingUseCase(casMappingRepo, membershipUseCase, logger)
+	policyEvaluationRepo := data.NewPolicyEvaluationRepo(dataData, logger)
 	workflowRunUseCaseOpts := &biz.WorkflowRunUseCaseOpts{
-		WfrRepo:      workflowRunRepo,
-		WfRepo:       workflowRepo,
-		OrgRepo:      organizationRepo,
-		SigningUC:    signingUseCase,
-		AuditorUC:    auditorUseCase,
-		Logger:       logger,
-		BundleCache:  cache,
-		CASClient:    casClient,
-		CASMappingUC: casMappingUseCase,
+		WfrRepo:              workflowRunRepo,
+		WfRepo:               workflowRepo,
+		OrgRepo:              organizationRepo,
+		SigningUC:            signingUseCase,
+		AuditorUC:            auditorUseCase,
+		Logger:               logger,
+		BundleCache:          cache,
+		CASClient:            casClient,
+		CASMappingUC:         casMappingUseCase,
+		PolicyEvaluationRepo: policyEvaluationRepo,
 	}
 	workflowRunUseCase, err := biz.NewWorkflowRunUseCase(workflowRunUseCaseOpts)
 	if err != nil {
@@ -169,6 +171,9 @@ func WireTestData(contextContext context.Context, testDatabase *TestDatabase, t
 	projectVersionUseCase := biz.NewProjectVersionUseCase(projectVersionRepo, auditorUseCase, logger)
 	groupUseCase := biz.NewGroupUseCase(logger, groupRepo, membershipRepo, userRepo, orgInvitationUseCase, auditorUseCase, orgInvitationRepo, authzUseCase, membershipUseCase)
 	projectUseCase := biz.NewProjectsUseCase(logger, projectsRepo, membershipRepo, auditorUseCase, groupUseCase, membershipUseCase, orgInvitationUseCase, orgInvitationRepo, authzUseCase)
+	policyEvaluationUseCase := biz.NewPolicyEvaluationUseCase(policyEvaluationRepo, logger)
+	policyExceptionRepo := data.NewPolicyExceptionRepo(dataData, logger)
+	policyExceptionUseCase := biz.NewPolicyExceptionUseCase(policyExceptionRepo, auditorUseCase, logger)
 	testingRepos := &TestingRepos{
 		Membership:        membershipRepo,
 		Referrer:          referrerRepo,
@@ -205,6 +210,8 @@ func WireTestData(contextContext context.Context, testDatabase *TestDatabase, t
 		Project:                projectUseCase,
 		OrgMetrics:             orgMetricsUseCase,
 		Group:                  groupUseCase,
+		PolicyEvaluation:       policyEvaluationUseCase,
+		PolicyException:        policyExceptionUseCase,
 		Repos:                  testingRepos,
 	}
 	return testingUseCases, func() {
@@ -115,6 +115,10 @@ type WorkflowRunRepo interface {
 	SaveAttestationDigest(ctx context.Context, ID uuid.UUID, digest string, blockReleasedVersions bool) error
 	GetBundle(ctx context.Context, wrID uuid.UUID) ([]byte, error)
 	UpdatePolicyStatus(ctx context.Context, ID uuid.UUID, summary *chainloop.PolicyStatusSummary) error
+	// SaveSearchEntries replaces the indexed content of the attestation of the run
+	SaveSearchEntries(ctx context.Context, ID uuid.UUID, entries []*WorkflowRunSearchEntry) error
+	// ListNotSearchIndexed lists the attested runs pending to be indexed after the given cursor, oldest first
+	ListNotSearchIndexed(ctx context.Context, after *pagination.Cursor, limit int) ([]*WorkflowRun, error)
 	List(ctx context.Context, orgID uuid.UUID, f *RunListFilters, p *pagination.CursorOptions) ([]*WorkflowRun, string, error)
 	// List the runs that have not finished and are older than a given time
 	ListNotFinishedOlderThan(ctx context.Context, olderThan time.Time, limit int) ([]*WorkflowRun, error)
@@ -133,6 +137,8 @@ type WorkflowRunUseCase struct {
 	bundleCache    *attestationbundle.Cache
 	casClient      CASClient
 	casMappingUC   *CASMappingUseCase
+
+	policyEvaluationRepo PolicyEvaluationRepo
 }
 
 type WorkflowRunUseCaseOpts struct {
@@ -145,6 +151,8 @@ type WorkflowRunUseCaseOpts struct {
 	BundleCache  *attestationbundle.Cache
 	CASClient    CASClient
 	CASMappingUC *CASMappingUseCase
+	// Where the policy evaluations extracted from the attestations are stored
+	PolicyEvaluationRepo PolicyEvaluationRepo
 }
 
 func NewWorkflowRunUseCase(opts *WorkflowRunUseCaseOpts) (*WorkflowRunUseCase, error) {
@@ -163,6 +171,8 @@ func NewWorkflowRunUseCase(opts *WorkflowRunUseCaseOpts) (*WorkflowRunUseCase, e
 		bundleCache:    opts.BundleCache,
 		casClient:      opts.CASClient,
 		casMappingUC:   opts.CASMappingUC,
+
+		policyEvaluationRepo: opts.PolicyEvaluationRepo,
 	}, nil
 }
 
@@ -506,6 +516,12 @@ func (uc *WorkflowRunUseCase) SaveAttestation(ctx context.Context, id string, bu
 		return nil, fmt.Errorf("updating policy status: %w", err)
 	}
 
+	// Index the content of the attestation, its policy evaluations and what the run can be searched by. The attestation
+	// is already stored at this point, so a failure is not fatal, the run is left for the search indexer to retry
+	if err := uc.indexAttestation(ctx, run, dsseEnv); err != nil {
+		uc.logger.Warnw("msg", "failed to index attestation, it will be retried", "workflowRunID", runID, "error", err)
+	}
+
 	return &digest, nil
 }
 
@@ -521,6 +537,19 @@ type RunListFilters struct {
 	// Filter by whether the run had gates in effect. Orthogonal to
 	// PolicyStatus — a PASSED run can still have had gates.
 	PolicyHasGates *bool
+	// Filter by runner type, i.e GITHUB_ACTION
+	RunnerType string
+	// Filter by creation date, both ends are optional
+	CreatedAfter, CreatedBefore *time.Time
+	// Filters on the content of the attestation, backed by the search index
+	// Runs that included a material with this digest, i.e sha256:deadbeef
+	MaterialDigest string
+	// Runs that included a material of this type, i.e SBOM_CYCLONEDX_JSON
+	MaterialType string
+	// Runs whose attestation has this subject
+	SubjectName string
+	// Runs whose attestation has this annotation, the value is optional
+	AnnotationName, AnnotationValue string
 }
 
 // List the workflowruns associated with an org and optionally filtered by a workflow
@@ -533,6 +562,12 @@ func (uc *WorkflowRunUseCase) List(ctx context.Context, orgID string, f *RunList
 		return nil, "", NewErrInvalidUUID(err)
 	}
 
+	if f != nil {
+		if err := f.normalize(); err != nil {
+			return nil, "", err
+		}
+	}
+
 	// Workflow and version filters are independent dimensions and compose as an
 	// AND: a run carries both a workflow edge and a version_id column, so
 	// "runs of workflow X at version Y" is a well-formed query. The version is
@@ -668,7 +703,22 @@ func (uc *WorkflowRunUseCase) GetByDigestInOrg(ctx context.Context, orgID, diges
 // addAttestationFromBundle resolves the attestation bundle using cache → DB → CAS fallback.
 // Bundles are being migrated from the workflow run DB column into CAS; the layered resolution
 // provides backward compatibility and prepares for dropping the DB column.
+// Failures downloading the bundle from CAS are logged and the run is left without attestation.
 func (uc *WorkflowRunUseCase) addAttestationFromBundle(ctx context.Context, wfRun *WorkflowRun) error {
+	err := uc.loadAttestationFromBundle(ctx, wfRun)
+	if errors.Is(err, errBundleDownload) {
+		uc.logger.Warnw("msg", "failed to download bundle from CAS", "digest", wfRun.Attestation.Digest, "error", err)
+		return nil
+	}
+
+	return err
+}
+
+var errBundleDownload = errors.New("downloading bundle from CAS")
+
+// loadAttestationFromBundle is like addAttestationFromBundle but it also returns the errors downloading
+// the bundle from CAS, wrapping errBundleDownload
+func (uc *WorkflowRunUseCase) loadAttestationFromBundle(ctx context.Context, wfRun *WorkflowRun) error {
 	if wfRun == nil || wfRun.State != string(WorkflowRunSuccess) {
 		return nil
 	}
@@ -704,8 +754,7 @@ func (uc *WorkflowRunUseCase) addAttestationFromBundle(ctx context.Context, wfRu
 	// Layer 3: CAS download by digest
 	bundleBytes, err = uc.downloadBundleFromCAS(ctx, digest, wfRun.Workflow.OrgID)
 	if err != nil {
-		uc.logger.Warnw("msg", "failed to download bundle from CAS", "digest", digest, "error", err)
-		return nil
+		return fmt.Errorf("%w: %w", errBundleDownload, err)
 	}
 
 	if len(bundleBytes) > 0 {
@@ -749,7 +798,7 @@ func (uc *WorkflowRunUseCase) downloadBundleFromCAS(ctx context.Context, digest
 	}
 
 	var buf bytes.Buffer
-	if err := uc.casClient.Download(ctx, string(mapping.CASBackend.Provider), mapping.CASBackend.SecretName, mapping.CASBackend.OrganizationID, &buf, digest); err != nil {
+	if err := uc.casClient.Download(ctx, mapping.CASBackend, &buf, digest); err != nil {
 		return nil, fmt.Errorf("downloading attestation bundle: %w", err)
 	}
 
@@ -136,6 +136,137 @@ func (s *workflowRunIntegrationTestSuite) TestList() {
 	}
 }
 
+func (s *workflowRunIntegrationTestSuite) TestListSearch() {
+	ctx := context.Background()
+	const sbomDigest = "16159bb881eb4ab7eb5d8afc5350b0feeed1e31c0a268e355e74f9ccbe885e0c"
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	// runOrg1 is attested with testdata/attestations/full.json
+	testCases := []struct {
+		name    string
+		orgID   string
+		filters *biz.RunListFilters
+		want    []*biz.WorkflowRun
+		wantErr bool
+	}{
+		{
+			name:    "by material digest",
+			filters: &biz.RunListFilters{MaterialDigest: "sha256:" + sbomDigest},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by material digest without algorithm",
+			filters: &biz.RunListFilters{MaterialDigest: sbomDigest},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by material digest, no results",
+			filters: &biz.RunListFilters{MaterialDigest: "sha256:deadbeef"},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "by material digest is scoped to the org",
+			orgID:   s.org2.ID,
+			filters: &biz.RunListFilters{MaterialDigest: sbomDigest},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "by material type",
+			filters: &biz.RunListFilters{MaterialType: "SBOM_CYCLONEDX_JSON"},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by material digest and type",
+			filters: &biz.RunListFilters{MaterialDigest: sbomDigest, MaterialType: "SBOM_CYCLONEDX_JSON"},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			// the container image and the SBOM are different materials
+			name:    "by material digest and type of different materials",
+			filters: &biz.RunListFilters{MaterialDigest: sbomDigest, MaterialType: "CONTAINER_IMAGE"},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "by material type, no results",
+			filters: &biz.RunListFilters{MaterialType: "SARIF"},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "by subject name",
+			filters: &biz.RunListFilters{SubjectName: "chainloop.dev/workflow/only-sbom"},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by annotation name",
+			filters: &biz.RunListFilters{AnnotationName: "toplevel"},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by annotation name and value",
+			filters: &biz.RunListFilters{AnnotationName: "branch", AnnotationValue: "stable"},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by annotation name and value, no results",
+			filters: &biz.RunListFilters{AnnotationName: "branch", AnnotationValue: "main"},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "all the content filters must match",
+			filters: &biz.RunListFilters{MaterialType: "SBOM_CYCLONEDX_JSON", AnnotationName: "branch", AnnotationValue: "main"},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "by date range",
+			filters: &biz.RunListFilters{CreatedAfter: &yesterday},
+			want:    []*biz.WorkflowRun{s.runOrg1},
+		},
+		{
+			name:    "by date range, no results",
+			filters: &biz.RunListFilters{CreatedBefore: &yesterday},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "by runner type, no results",
+			filters: &biz.RunListFilters{RunnerType: "GITHUB_ACTION"},
+			want:    []*biz.WorkflowRun{},
+		},
+		{
+			name:    "annotation value requires its name",
+			filters: &biz.RunListFilters{AnnotationValue: "stable"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			orgID := s.org.ID
+			if tc.orgID != "" {
+				orgID = tc.orgID
+			}
+
+			got, _, err := s.WorkflowRun.List(ctx, orgID, tc.filters, &pagination.CursorOptions{Limit: 10})
+			if tc.wantErr {
+				s.True(biz.IsErrValidation(err))
+				return
+			}
+
+			s.Require().NoError(err)
+			gotIDs := make([]uuid.UUID, 0, len(got))
+			for _, g := range got {
+				gotIDs = append(gotIDs, g.ID)
+			}
+
+			wantIDs := make([]uuid.UUID, 0, len(tc.want))
+			for _, w := range tc.want {
+				wantIDs = append(wantIDs, w.ID)
+			}
+
+			s.ElementsMatch(wantIDs, gotIDs)
+		})
+	}
+}
+
 func (s *workflowRunIntegrationTestSuite) TestListExcludesSoftDeletedWorkflows() {
 	ctx := context.Background()
 
@@ -0,0 +1,159 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	intoto "github.com/in-toto/attestation/go/v1"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+// WorkflowRunSearchEntry is a piece of the attestation of a workflow run
+// that gets indexed so runs can be searched by it.
+type WorkflowRunSearchEntry struct {
+	Kind WorkflowRunSearchEntryKind
+	// material name, subject name or annotation key
+	Name string
+	// material type
+	Type string
+	// digest of the material or subject, i.e sha256:deadbeef
+	Digest string
+	// annotation value
+	Value string
+}
+
+type WorkflowRunSearchEntryKind string
+
+const (
+	WorkflowRunSearchEntryMaterial   WorkflowRunSearchEntryKind = "MATERIAL"
+	WorkflowRunSearchEntrySubject    WorkflowRunSearchEntryKind = "SUBJECT"
+	WorkflowRunSearchEntryAnnotation WorkflowRunSearchEntryKind = "ANNOTATION"
+)
+
+// Implements https://pkg.go.dev/entgo.io/ent/schema/field#EnumValues
+func (WorkflowRunSearchEntryKind) Values() (kinds []string) {
+	for _, s := range []WorkflowRunSearchEntryKind{
+		WorkflowRunSearchEntryMaterial,
+		WorkflowRunSearchEntrySubject,
+		WorkflowRunSearchEntryAnnotation,
+	} {
+		kinds = append(kinds, string(s))
+	}
+
+	return
+}
+
+// NewWorkflowRunSearchEntries extracts the searchable content of an attestation:
+// its materials, the subjects of the statement and the attestation annotations
+func NewWorkflowRunSearchEntries(statement *intoto.Statement, predicate chainloop.NormalizablePredicate) []*WorkflowRunSearchEntry {
+	var entries []*WorkflowRunSearchEntry
+
+	for _, m := range predicate.GetMaterials() {
+		e := &WorkflowRunSearchEntry{Kind: WorkflowRunSearchEntryMaterial, Name: m.Name, Type: m.Type}
+		if m.Hash != nil {
+			e.Digest = m.Hash.String()
+		}
+
+		entries = append(entries, e)
+	}
+
+	for _, s := range statement.GetSubject() {
+		entries = append(entries, &WorkflowRunSearchEntry{
+			Kind: WorkflowRunSearchEntrySubject, Name: s.GetName(), Digest: resourceDigest(s.GetDigest()),
+		})
+	}
+
+	// sorted to get a deterministic output
+	annotations := predicate.GetAnnotations()
+	keys := make([]string, 0, len(annotations))
+	for k := range annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		entries = append(entries, &WorkflowRunSearchEntry{Kind: WorkflowRunSearchEntryAnnotation, Name: k, Value: annotations[k]})
+	}
+
+	return entries
+}
+
+// indexAttestation saves the policy evaluations and the searchable content of the attestation of the run.
+// The run is flagged as indexed once both are stored, so the search indexer retries the ones that failed.
+func (uc *WorkflowRunUseCase) indexAttestation(ctx context.Context, run *WorkflowRun, envelope *dsse.Envelope) error {
+	statement, err := chainloop.ExtractStatement(envelope)
+	if err != nil {
+		return fmt.Errorf("extracting statement: %w", err)
+	}
+
+	predicate, err := chainloop.ExtractPredicate(envelope)
+	if err != nil {
+		return fmt.Errorf("extracting predicate: %w", err)
+	}
+
+	if err := uc.savePolicyEvaluations(ctx, run, predicate); err != nil {
+		return fmt.Errorf("saving policy evaluations: %w", err)
+	}
+
+	if err := uc.wfRunRepo.SaveSearchEntries(ctx, run.ID, NewWorkflowRunSearchEntries(statement, predicate)); err != nil {
+		return fmt.Errorf("indexing attestation: %w", err)
+	}
+
+	return nil
+}
+
+// resourceDigest returns the digest of a resource descriptor, i.e a subject or a policy reference,
+// in the algorithm:hex format, preferring sha256
+func resourceDigest(digests map[string]string) string {
+	if d, ok := digests["sha256"]; ok {
+		return "sha256:" + d
+	}
+
+	algorithms := make([]string, 0, len(digests))
+	for alg := range digests {
+		algorithms = append(algorithms, alg)
+	}
+
+	if len(algorithms) == 0 {
+		return ""
+	}
+
+	sort.Strings(algorithms)
+	return fmt.Sprintf("%s:%s", algorithms[0], digests[algorithms[0]])
+}
+
+// normalize validates the filters and turns them into the format stored in the search index
+func (f *RunListFilters) normalize() error {
+	if f.AnnotationValue != "" && f.AnnotationName == "" {
+		return NewErrValidationStr("an annotation value requires the annotation name")
+	}
+
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return NewErrValidationStr("the start of the date range must be before its end")
+	}
+
+	// digests are stored with their algorithm, sha256 is assumed if missing
+	if f.MaterialDigest != "" && !strings.Contains(f.MaterialDigest, ":") {
+		f.MaterialDigest = "sha256:" + f.MaterialDigest
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+var workflowRunSearchIndexerTracer = otelx.Tracer("chainloop-controlplane", "biz/workflowrunsearch_indexer")
+
+const (
+	defaultWorkflowRunSearchIndexerInterval = 10 * time.Minute
+	// Number of runs processed per query
+	workflowRunSearchIndexerPageSize = 100
+
+	lockKeyWorkflowRunSearchIndexer = "workflow-run-search-indexer"
+)
+
+// WorkflowRunSearchIndexer periodically indexes the attestations of the runs pending to be indexed for search,
+// that is, the ones whose indexing failed when the attestation was stored and the ones that predate the search index.
+// Indexing includes storing the policy evaluations of the attestation, see WorkflowRunUseCase.indexAttestation.
+type WorkflowRunSearchIndexer struct {
+	logger  *log.Helper
+	repo    WorkflowRunRepo
+	wfRunUC *WorkflowRunUseCase
+	lock    DistributedLock
+}
+
+type WorkflowRunSearchIndexerOpts struct {
+	// Interval between sweeps, defaults to 10 minutes
+	CheckInterval time.Duration
+	// Initial delay before the first sweep. If not set, runs immediately.
+	InitialDelay time.Duration
+}
+
+func NewWorkflowRunSearchIndexer(logger log.Logger, repo WorkflowRunRepo, wfRunUC *WorkflowRunUseCase, lock DistributedLock) *WorkflowRunSearchIndexer {
+	return &WorkflowRunSearchIndexer{
+		logger:  log.NewHelper(log.With(logger, "component", "biz/WorkflowRunSearchIndexer")),
+		repo:    repo,
+		wfRunUC: wfRunUC,
+		lock:    lock,
+	}
+}
+
+// Start runs the indexer periodically until the context is cancelled
+func (i *WorkflowRunSearchIndexer) Start(ctx context.Context, opts *WorkflowRunSearchIndexerOpts) {
+	interval := defaultWorkflowRunSearchIndexerInterval
+	if opts != nil && opts.CheckInterval > 0 {
+		interval = opts.CheckInterval
+	}
+
+	var initialDelay time.Duration
+	if opts != nil && opts.InitialDelay > 0 {
+		initialDelay = opts.InitialDelay
+	}
+
+	i.logger.Infow("msg", "workflow run search indexer configured", "initialDelay", initialDelay, "interval", interval)
+
+	timer := time.NewTimer(initialDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			i.logger.Info("workflow run search indexer stopping due to context cancellation")
+			return
+		case <-timer.C:
+			if err := i.Sweep(ctx); err != nil {
+				i.logger.Errorf("workflow run search indexing sweep failed: %v", err)
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// Sweep indexes the runs pending to be indexed. The runs that can't be indexed are left for the next sweep,
+// except the ones whose attestation is not stored anymore, which are flagged as indexed with no content.
+func (i *WorkflowRunSearchIndexer) Sweep(ctx context.Context) error {
+	acquired, release, err := i.lock.TryAcquire(ctx, lockKeyWorkflowRunSearchIndexer)
+	if err != nil {
+		return fmt.Errorf("acquiring indexer lock: %w", err)
+	}
+	if !acquired {
+		i.logger.Debug("another replica is running the workflow run search indexer, skipping")
+		return nil
+	}
+	defer release()
+
+	ctx, span := otelx.Start(ctx, workflowRunSearchIndexerTracer, "WorkflowRunSearchIndexer.Sweep")
+	defer span.End()
+
+	var cursor *pagination.Cursor
+	for {
+		runs, err := i.repo.ListNotSearchIndexed(ctx, cursor, workflowRunSearchIndexerPageSize)
+		if err != nil {
+			return fmt.Errorf("listing runs pending to be indexed: %w", err)
+		}
+
+		var indexed int
+		for _, run := range runs {
+			err := i.index(ctx, run)
+			if errors.Is(err, errAttestationUnavailable) {
+				i.logger.Warnw("msg", "skipping the indexing of workflow run", "workflowRunID", run.ID, "error", err)
+				// so it's not retrieved again in every sweep
+				err = i.repo.SaveSearchEntries(ctx, run.ID, nil)
+			}
+
+			if err != nil {
+				i.logger.Warnw("msg", "failed to index workflow run", "workflowRunID", run.ID, "error", err)
+			} else {
+				indexed++
+			}
+
+			cursor = &pagination.Cursor{Timestamp: run.CreatedAt, ID: &run.ID}
+		}
+
+		if indexed > 0 {
+			i.logger.Infow("msg", "workflow runs indexed", "count", indexed)
+		}
+
+		if len(runs) < workflowRunSearchIndexerPageSize {
+			return nil
+		}
+	}
+}
+
+// errAttestationUnavailable is returned when the attestation of a run can't be indexed in any later attempt either
+var errAttestationUnavailable = errors.New("attestation not available")
+
+func (i *WorkflowRunSearchIndexer) index(ctx context.Context, run *WorkflowRun) error {
+	// only the attestations of successful runs are retrieved from their bundle
+	if run.State != string(WorkflowRunSuccess) {
+		return fmt.Errorf("%w: run in state %s", errAttestationUnavailable, run.State)
+	}
+
+	// the attestation is either in the run or in its bundle
+	if err := i.wfRunUC.loadAttestationFromBundle(ctx, run); err != nil {
+		return fmt.Errorf("retrieving attestation: %w", err)
+	}
+
+	// neither the run nor CAS have the bundle
+	if run.Attestation.Envelope == nil {
+		return fmt.Errorf("%w: attestation %s not found", errAttestationUnavailable, run.Attestation.Digest)
+	}
+
+	return i.wfRunUC.indexAttestation(ctx, run, run.Attestation.Envelope)
+}
@@ -0,0 +1,108 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	bizMocks "github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/mocks"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz/testhelpers"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkflowRunSearchIndexerSweep(t *testing.T) {
+	logger := log.NewStdLogger(io.Discard)
+	newRun := func(createdAt time.Time) *biz.WorkflowRun {
+		return &biz.WorkflowRun{
+			ID: uuid.New(), State: string(biz.WorkflowRunSuccess), CreatedAt: &createdAt,
+			Workflow: &biz.Workflow{OrgID: uuid.New()}, Attestation: &biz.Attestation{Digest: "sha256:deadbeef"},
+		}
+	}
+
+	t.Run("another replica holds the lock", func(t *testing.T) {
+		repo := bizMocks.NewWorkflowRunRepo(t)
+		uc, err := biz.NewWorkflowRunUseCase(&biz.WorkflowRunUseCaseOpts{WfrRepo: repo})
+		require.NoError(t, err)
+
+		indexer := biz.NewWorkflowRunSearchIndexer(logger, repo, uc, &fakeLock{})
+		require.NoError(t, indexer.Sweep(context.Background()))
+	})
+
+	t.Run("indexes the pending runs and skips the failing ones", func(t *testing.T) {
+		repo := bizMocks.NewWorkflowRunRepo(t)
+		evRepo := bizMocks.NewPolicyEvaluationRepo(t)
+		uc, err := biz.NewWorkflowRunUseCase(&biz.WorkflowRunUseCaseOpts{WfrRepo: repo, PolicyEvaluationRepo: evRepo})
+		require.NoError(t, err)
+
+		failing := newRun(time.Now().Add(-2 * time.Hour))
+		pending := newRun(time.Now().Add(-1 * time.Hour))
+		repo.On("ListNotSearchIndexed", mock.Anything, (*pagination.Cursor)(nil), mock.Anything).Return([]*biz.WorkflowRun{failing, pending}, nil)
+
+		// the attestation of the first run can't be retrieved, it's left for the next sweep
+		repo.On("GetBundle", mock.Anything, failing.ID).Return(nil, errors.New("boom"))
+		repo.On("GetBundle", mock.Anything, pending.ID).Return(testhelpers.BundleBytesFromEnvelope(t, "testdata/attestations/full.json"), nil)
+		evRepo.On("Save", mock.Anything, pending.ID, mock.Anything).Return(nil)
+		repo.On("SaveSearchEntries", mock.Anything, pending.ID, mock.MatchedBy(func(entries []*biz.WorkflowRunSearchEntry) bool {
+			return len(entries) > 0
+		})).Return(nil)
+
+		indexer := biz.NewWorkflowRunSearchIndexer(logger, repo, uc, &fakeLock{acquired: true})
+		require.NoError(t, indexer.Sweep(context.Background()))
+	})
+
+	t.Run("runs whose policy evaluations can't be saved are not flagged as indexed", func(t *testing.T) {
+		repo := bizMocks.NewWorkflowRunRepo(t)
+		evRepo := bizMocks.NewPolicyEvaluationRepo(t)
+		uc, err := biz.NewWorkflowRunUseCase(&biz.WorkflowRunUseCaseOpts{WfrRepo: repo, PolicyEvaluationRepo: evRepo})
+		require.NoError(t, err)
+
+		pending := newRun(time.Now().Add(-1 * time.Hour))
+		repo.On("ListNotSearchIndexed", mock.Anything, (*pagination.Cursor)(nil), mock.Anything).Return([]*biz.WorkflowRun{pending}, nil)
+		repo.On("GetBundle", mock.Anything, pending.ID).Return(testhelpers.BundleBytesFromEnvelope(t, "testdata/attestations/full.json"), nil)
+		evRepo.On("Save", mock.Anything, pending.ID, mock.Anything).Return(errors.New("boom"))
+
+		// SaveSearchEntries, which flags the run, is not expected
+		indexer := biz.NewWorkflowRunSearchIndexer(logger, repo, uc, &fakeLock{acquired: true})
+		require.NoError(t, indexer.Sweep(context.Background()))
+	})
+
+	t.Run("runs whose attestation is not available are flagged as indexed", func(t *testing.T) {
+		repo := bizMocks.NewWorkflowRunRepo(t)
+		uc, err := biz.NewWorkflowRunUseCase(&biz.WorkflowRunUseCaseOpts{WfrRepo: repo})
+		require.NoError(t, err)
+
+		failed := newRun(time.Now().Add(-2 * time.Hour))
+		failed.State = string(biz.WorkflowRunError)
+		notStored := newRun(time.Now().Add(-1 * time.Hour))
+		repo.On("ListNotSearchIndexed", mock.Anything, (*pagination.Cursor)(nil), mock.Anything).Return([]*biz.WorkflowRun{failed, notStored}, nil)
+		repo.On("GetBundle", mock.Anything, notStored.ID).Return(nil, biz.NewErrNotFound("bundle"))
+
+		repo.On("SaveSearchEntries", mock.Anything, failed.ID, mock.Anything).Return(nil)
+		repo.On("SaveSearchEntries", mock.Anything, notStored.ID, mock.Anything).Return(nil)
+
+		indexer := biz.NewWorkflowRunSearchIndexer(logger, repo, uc, &fakeLock{acquired: true})
+		require.NoError(t, indexer.Sweep(context.Background()))
+	})
+}
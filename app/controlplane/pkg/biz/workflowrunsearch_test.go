@@ -0,0 +1,125 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package biz
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorkflowRunSearchEntries(t *testing.T) {
+	raw, err := os.ReadFile("testdata/attestations/full.json")
+	require.NoError(t, err)
+
+	var envelope dsse.Envelope
+	require.NoError(t, json.Unmarshal(raw, &envelope))
+
+	statement, err := chainloop.ExtractStatement(&envelope)
+	require.NoError(t, err)
+	predicate, err := chainloop.ExtractPredicate(&envelope)
+	require.NoError(t, err)
+
+	const sbomDigest = "sha256:16159bb881eb4ab7eb5d8afc5350b0feeed1e31c0a268e355e74f9ccbe885e0c"
+	want := []*WorkflowRunSearchEntry{
+		{Kind: WorkflowRunSearchEntryMaterial, Name: "image", Type: "CONTAINER_IMAGE", Digest: "sha256:264f55a6ff9cec2f4742a9faacc033b29f65c04dd4480e71e23579d484288d61"},
+		{Kind: WorkflowRunSearchEntryMaterial, Name: "skynet-sbom", Type: "SBOM_CYCLONEDX_JSON", Digest: sbomDigest},
+		{Kind: WorkflowRunSearchEntryMaterial, Name: "skynet2-sbom", Type: "SBOM_CYCLONEDX_JSON", Digest: sbomDigest},
+		{Kind: WorkflowRunSearchEntrySubject, Name: "chainloop.dev/workflow/only-sbom", Digest: "sha256:3036f2e5d709a23808eaa05570f1718afff92d4ad35e232c138738372ac93dfb"},
+		{Kind: WorkflowRunSearchEntryAnnotation, Name: "branch", Value: "stable"},
+		{Kind: WorkflowRunSearchEntryAnnotation, Name: "toplevel", Value: "true"},
+	}
+
+	assert.ElementsMatch(t, want, NewWorkflowRunSearchEntries(statement, predicate))
+}
+
+func TestResourceDigest(t *testing.T) {
+	testCases := []struct {
+		name    string
+		digests map[string]string
+		want    string
+	}{
+		{name: "no digest"},
+		{name: "sha256 is preferred", digests: map[string]string{"sha1": "aa", "sha256": "bb"}, want: "sha256:bb"},
+		{name: "other algorithms", digests: map[string]string{"sha512": "cc", "sha1": "aa"}, want: "sha1:aa"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, resourceDigest(tc.digests))
+		})
+	}
+}
+
+func TestRunListFiltersNormalize(t *testing.T) {
+	now := time.Now()
+	yesterday := now.Add(-24 * time.Hour)
+
+	testCases := []struct {
+		name       string
+		filters    *RunListFilters
+		wantDigest string
+		wantErr    bool
+	}{
+		{
+			name:    "no filters",
+			filters: &RunListFilters{},
+		},
+		{
+			name:       "digest without algorithm",
+			filters:    &RunListFilters{MaterialDigest: "deadbeef"},
+			wantDigest: "sha256:deadbeef",
+		},
+		{
+			name:       "digest with algorithm",
+			filters:    &RunListFilters{MaterialDigest: "sha512:deadbeef"},
+			wantDigest: "sha512:deadbeef",
+		},
+		{
+			name:    "annotation value without name",
+			filters: &RunListFilters{AnnotationValue: "stable"},
+			wantErr: true,
+		},
+		{
+			name:    "valid date range",
+			filters: &RunListFilters{CreatedAfter: &yesterday, CreatedBefore: &now},
+		},
+		{
+			name:    "inverted date range",
+			filters: &RunListFilters{CreatedAfter: &now, CreatedBefore: &yesterday},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.filters.normalize()
+			if tc.wantErr {
+				assert.True(t, IsErrValidation(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDigest, tc.filters.MaterialDigest)
+		})
+	}
+}
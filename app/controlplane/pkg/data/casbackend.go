@@ -50,6 +50,7 @@ func (r *CASBackendRepo) List(ctx context.Context, orgID uuid.UUID) ([]*biz.CASB
 	backends, err := orgScopedQuery(r.data.DB, orgID).QueryCasBackends().
 		Where(casbackend.DeletedAtIsNil()).
 		Order(ent.Desc(casbackend.FieldCreatedAt)).
+		WithReplicas(liveReplicas).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list cas backends: %w", err)
@@ -70,6 +71,7 @@ func (r *CASBackendRepo) FindDefaultBackend(ctx context.Context, orgID uuid.UUID
 
 	backend, err := orgScopedQuery(r.data.DB, orgID).QueryCasBackends().
 		Where(casbackend.Default(true), casbackend.DeletedAtIsNil()).
+		WithReplicas(liveReplicas).
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return nil, err
@@ -85,6 +87,7 @@ func (r *CASBackendRepo) FindFallbackBackend(ctx context.Context, orgID uuid.UUI
 
 	backend, err := orgScopedQuery(r.data.DB, orgID).QueryCasBackends().
 		Where(casbackend.Fallback(true), casbackend.DeletedAtIsNil()).
+		WithReplicas(liveReplicas).
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return nil, err
@@ -282,7 +285,8 @@ func (r *CASBackendRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.CASBa
 	defer span.End()
 
 	backend, err := r.data.DB.CASBackend.Query().
-		Where(casbackend.ID(id), casbackend.DeletedAtIsNil()).Only(ctx)
+		Where(casbackend.ID(id), casbackend.DeletedAtIsNil()).
+		WithReplicas(liveReplicas).Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return nil, err
 	} else if backend == nil {
@@ -299,7 +303,8 @@ func (r *CASBackendRepo) FindByIDInOrg(ctx context.Context, orgID, id uuid.UUID)
 	defer span.End()
 
 	backend, err := orgScopedQuery(r.data.DB, orgID).QueryCasBackends().
-		Where(casbackend.ID(id), casbackend.DeletedAtIsNil()).Only(ctx)
+		Where(casbackend.ID(id), casbackend.DeletedAtIsNil()).
+		WithReplicas(liveReplicas).Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return nil, err
 	} else if backend == nil {
@@ -315,7 +320,8 @@ func (r *CASBackendRepo) FindByNameInOrg(ctx context.Context, orgID uuid.UUID, n
 
 	backend, err := orgScopedQuery(r.data.DB, orgID).
 		QueryCasBackends().
-		Where(casbackend.Name(name), casbackend.DeletedAtIsNil()).Only(ctx)
+		Where(casbackend.Name(name), casbackend.DeletedAtIsNil()).
+		WithReplicas(liveReplicas).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, biz.NewErrNotFound("CAS backend")
@@ -327,6 +333,26 @@ func (r *CASBackendRepo) FindByNameInOrg(ctx context.Context, orgID uuid.UUID, n
 	return entCASBackendToBiz(backend), nil
 }
 
+// UpdatePrimary sets the backend the given one is a replica of, a nil primaryID detaches it
+func (r *CASBackendRepo) UpdatePrimary(ctx context.Context, id uuid.UUID, primaryID *uuid.UUID) error {
+	ctx, span := otelx.Start(ctx, casBackendRepoTracer, "CASBackendRepo.UpdatePrimary")
+	defer span.End()
+
+	update := r.data.DB.CASBackend.UpdateOneID(id).SetUpdatedAt(time.Now())
+	if primaryID != nil {
+		update = update.SetPrimaryBackendID(*primaryID)
+	} else {
+		update = update.ClearPrimaryBackendID()
+	}
+
+	return update.Exec(ctx)
+}
+
+// liveReplicas narrows down the eager-loaded replicas to the ones that have not been deleted
+func liveReplicas(q *ent.CASBackendQuery) {
+	q.Where(casbackend.DeletedAtIsNil()).Order(ent.Asc(casbackend.FieldCreatedAt))
+}
+
 // Set deleted at instead of actually deleting the backend
 func (r *CASBackendRepo) SoftDelete(ctx context.Context, id uuid.UUID) error {
 	ctx, span := otelx.Start(ctx, casBackendRepoTracer, "CASBackendRepo.SoftDelete")
@@ -362,7 +388,7 @@ func (r *CASBackendRepo) UpdateValidationStatus(ctx context.Context, id uuid.UUI
 }
 
 // ListBackends returns CAS backends across all organizations. Only not inline backends are returned
-// If defaultsOrFallbacks is true, only default and fallback backends are returned
+// If defaultsOrFallbacks is true, only default and fallback backends, and replicas, are returned
 func (r *CASBackendRepo) ListBackends(ctx context.Context, defaultsOrFallbacks bool) ([]*biz.CASBackend, error) {
 	ctx, span := otelx.Start(ctx, casBackendRepoTracer, "CASBackendRepo.ListBackends")
 	defer span.End()
@@ -376,9 +402,11 @@ func (r *CASBackendRepo) ListBackends(ctx context.Context, defaultsOrFallbacks b
 		)
 
 	if defaultsOrFallbacks {
+		// replicas are included since downloads fail over to them
 		query = query.Where(casbackend.Or(
 			casbackend.Default(true),
 			casbackend.Fallback(true),
+			casbackend.PrimaryBackendIDNotNil(),
 		))
 	}
 
@@ -424,5 +452,17 @@ func entCASBackendToBiz(backend *ent.CASBackend) *biz.CASBackend {
 		OrganizationID:   backend.OrganizationCasBackends,
 	}
 
+	if backend.PrimaryBackendID != uuid.Nil {
+		r.PrimaryID = &backend.PrimaryBackendID
+	}
+
+	for _, replica := range backend.Edges.Replicas {
+		r.Replicas = append(r.Replicas, entCASBackendToBiz(replica))
+	}
+
+	if backend.Edges.Primary != nil {
+		r.Primary = entCASBackendToBiz(backend.Edges.Primary)
+	}
+
 	return r
 }
@@ -23,9 +23,11 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casmapping"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
 	"github.com/chainloop-dev/chainloop/pkg/otelx"
 	"github.com/go-kratos/kratos/v2/log"
@@ -88,24 +90,51 @@ func (r *CASMappingRepo) Create(ctx context.Context, digest string, casBackendID
 		}
 	}
 
-	query := r.data.DB.CASMapping.Create().
-		SetDigest(digest).
-		SetCasBackendID(casBackendID).
-		SetOrganizationID(casBackend.OrganizationID)
+	var mappingID uuid.UUID
+	if err := WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		// Shared lock on the backend, the retention sweeper takes an exclusive one while it checks
+		// that a blob is unreferenced and marks it for deletion, so a new reference can't show up in between
+		if _, err := tx.CASBackend.Query().Where(casbackend.ID(casBackendID)).ForShare().IDs(ctx); err != nil {
+			return fmt.Errorf("failed to lock cas backend: %w", err)
+		}
 
-	if opts != nil {
-		query.SetNillableProjectID(opts.ProjectID).
-			SetNillableProductID(opts.ProductID).
-			SetNillableWorkflowRunID(opts.WorkflowRunID)
-	}
+		// and once marked, the blob is being deleted from the storage, which might be shared with other backends
+		deleting, err := tx.CASBlobDeletion.Query().
+			Where(
+				casblobdeletion.Digest(digest),
+				casblobdeletion.HasCasBackendWith(casbackend.ProviderEQ(casBackend.Provider), casbackend.Location(casBackend.Location)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to check cas blob deletions: %w", err)
+		} else if deleting {
+			return biz.NewErrValidationStr("the artifact is being garbage collected, upload it again")
+		}
 
-	mapping, err := query.Save(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create casMapping: %w", err)
+		query := tx.CASMapping.Create().
+			SetDigest(digest).
+			SetCasBackendID(casBackendID).
+			SetOrganizationID(casBackend.OrganizationID)
+
+		if opts != nil {
+			query.SetNillableProjectID(opts.ProjectID).
+				SetNillableProductID(opts.ProductID).
+				SetNillableWorkflowRunID(opts.WorkflowRunID)
+		}
+
+		mapping, err := query.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create casMapping: %w", err)
+		}
+
+		mappingID = mapping.ID
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	// reload to get the edges
-	return r.findByID(ctx, mapping.ID)
+	return r.findByID(ctx, mappingID)
 }
 
 // FindByDigestInOrgs returns a single CAS mapping for the digest that is reachable through one of
@@ -179,6 +208,210 @@ func (r *CASMappingRepo) ListByDigestInOrg(ctx context.Context, digest string, o
 	return result, nil
 }
 
+// FindExpired returns a page of the digests stored in the CAS backend that can be garbage collected.
+// Candidates are the digests with at least one mapping older than the threshold, out of which the ones
+// still referenced are discarded, see referencedPredicates.
+func (r *CASMappingRepo) FindExpired(ctx context.Context, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts) (*biz.CASMappingExpiredPage, error) {
+	ctx, span := otelx.Start(ctx, casMappingRepoTracer, "CASMappingRepo.FindExpired")
+	defer span.End()
+
+	if opts == nil || opts.Limit <= 0 {
+		return nil, biz.NewErrValidationStr("a page size is required")
+	}
+
+	backend, err := r.data.DB.CASBackend.Get(ctx, casBackendID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.NewErrNotFound("CAS backend")
+		}
+		return nil, fmt.Errorf("failed to find cas backend: %w", err)
+	}
+
+	candidates, err := r.data.DB.CASMapping.Query().
+		Where(
+			casmapping.HasCasBackendWith(casbackend.ID(casBackendID)),
+			casmapping.CreatedAtLT(opts.OlderThan),
+			casmapping.DigestGT(opts.AfterDigest),
+		).
+		Unique(true).
+		Order(casmapping.ByDigest()).
+		Limit(opts.Limit).
+		Select(casmapping.FieldDigest).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired cas mappings: %w", err)
+	}
+
+	page := &biz.CASMappingExpiredPage{}
+	if len(candidates) == 0 {
+		return page, nil
+	}
+
+	if len(candidates) == opts.Limit {
+		page.NextDigest = candidates[len(candidates)-1]
+	}
+
+	keep, err := r.data.DB.CASMapping.Query().
+		Where(casmapping.DigestIn(candidates...), casmapping.Or(referencedPredicates(backend, opts)...)).
+		Unique(true).
+		Select(casmapping.FieldDigest).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query referenced cas mappings: %w", err)
+	}
+
+	kept := make(map[string]struct{}, len(keep))
+	for _, d := range keep {
+		kept[d] = struct{}{}
+	}
+
+	for _, d := range candidates {
+		if _, ok := kept[d]; !ok {
+			page.Digests = append(page.Digests, d)
+		}
+	}
+
+	return page, nil
+}
+
+// MarkForDeletion checks again that the digest is not referenced and, if so, marks it as being deleted.
+// The backends sharing the storage are locked only for the duration of this short transaction, the mark
+// is what prevents new mappings from being created afterwards, while the blob is deleted from the storage.
+func (r *CASMappingRepo) MarkForDeletion(ctx context.Context, digest string, casBackendID uuid.UUID, opts *biz.CASMappingExpiredOpts) (bool, error) {
+	ctx, span := otelx.Start(ctx, casMappingRepoTracer, "CASMappingRepo.MarkForDeletion")
+	defer span.End()
+
+	if opts == nil {
+		return false, biz.NewErrValidationStr("the expiration options are required")
+	}
+
+	var marked bool
+	err := WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		backend, err := tx.CASBackend.Get(ctx, casBackendID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return biz.NewErrNotFound("CAS backend")
+			}
+			return fmt.Errorf("failed to find cas backend: %w", err)
+		}
+
+		if _, err := tx.CASBackend.Query().
+			Where(casbackend.ProviderEQ(backend.Provider), casbackend.Location(backend.Location)).
+			ForUpdate().
+			IDs(ctx); err != nil {
+			return fmt.Errorf("failed to lock cas backends: %w", err)
+		}
+
+		referenced, err := tx.CASMapping.Query().
+			Where(casmapping.Digest(digest), casmapping.Or(referencedPredicates(backend, opts)...)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query referenced cas mappings: %w", err)
+		} else if referenced {
+			return nil
+		}
+
+		// a mark left by an interrupted sweep is reused
+		if err := tx.CASBlobDeletion.Create().
+			SetDigest(digest).
+			SetCasBackendID(casBackendID).
+			OnConflictColumns(casblobdeletion.FieldDigest, casblobdeletion.FieldCasBackendID).
+			Ignore().
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to mark cas blob for deletion: %w", err)
+		}
+
+		marked = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return marked, nil
+}
+
+// FinishDeletion removes the mappings of a digest whose blob has been deleted, along with its mark
+func (r *CASMappingRepo) FinishDeletion(ctx context.Context, digest string, casBackendID uuid.UUID) error {
+	ctx, span := otelx.Start(ctx, casMappingRepoTracer, "CASMappingRepo.FinishDeletion")
+	defer span.End()
+
+	return WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		if _, err := tx.CASMapping.Delete().
+			Where(casmapping.Digest(digest), casmapping.HasCasBackendWith(casbackend.ID(casBackendID))).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete cas mappings: %w", err)
+		}
+
+		if _, err := tx.CASBlobDeletion.Delete().
+			Where(casblobdeletion.Digest(digest), casblobdeletion.CasBackendID(casBackendID)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to unmark cas blob: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// CancelDeletion removes the mark of a digest whose blob could not be deleted, keeping its mappings
+func (r *CASMappingRepo) CancelDeletion(ctx context.Context, digest string, casBackendID uuid.UUID) error {
+	ctx, span := otelx.Start(ctx, casMappingRepoTracer, "CASMappingRepo.CancelDeletion")
+	defer span.End()
+
+	if _, err := r.data.DB.CASBlobDeletion.Delete().
+		Where(casblobdeletion.Digest(digest), casblobdeletion.CasBackendID(casBackendID)).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to unmark cas blob: %w", err)
+	}
+
+	return nil
+}
+
+// ListMarkedForDeletion returns the digests of the backend that are still marked for deletion
+func (r *CASMappingRepo) ListMarkedForDeletion(ctx context.Context, casBackendID uuid.UUID) ([]string, error) {
+	ctx, span := otelx.Start(ctx, casMappingRepoTracer, "CASMappingRepo.ListMarkedForDeletion")
+	defer span.End()
+
+	digests, err := r.data.DB.CASBlobDeletion.Query().
+		Where(casblobdeletion.CasBackendID(casBackendID)).
+		Select(casblobdeletion.FieldDigest).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cas blobs marked for deletion: %w", err)
+	}
+
+	return digests, nil
+}
+
+// referencedPredicates match the mappings that keep a digest of the backend alive: a mapping more recent
+// than the threshold, a mapping from another live backend sharing the same storage or, when requested,
+// a mapping of a workflow run of a released project version
+func referencedPredicates(backend *ent.CASBackend, opts *biz.CASMappingExpiredOpts) []predicate.CASMapping {
+	inBackend := casmapping.HasCasBackendWith(casbackend.ID(backend.ID))
+	referenced := []predicate.CASMapping{
+		casmapping.And(inBackend, casmapping.CreatedAtGTE(opts.OlderThan)),
+		// the blob is shared with other backends pointing to the same bucket or repository
+		casmapping.HasCasBackendWith(
+			casbackend.IDNEQ(backend.ID),
+			casbackend.ProviderEQ(backend.Provider),
+			casbackend.Location(backend.Location),
+			casbackend.DeletedAtIsNil(),
+		),
+	}
+
+	if opts.KeepReleasedVersions {
+		// workflow_run_id has no edge, so match it against the released runs with a sub-query
+		referenced = append(referenced, casmapping.And(inBackend, func(s *sql.Selector) {
+			t := sql.Table(workflowrun.Table)
+			sub := sql.Select(t.C(workflowrun.FieldID)).From(t)
+			workflowrun.HasVersionWith(projectversion.Prerelease(false))(sub)
+			s.Where(sql.In(s.C(casmapping.FieldWorkflowRunID), sub))
+		}))
+	}
+
+	return referenced
+}
+
 // queryServiceable narrows a CAS mapping query down to the mappings matching the given predicates
 // that can still serve their artifact, with the backend eager-loaded so the result can be mapped to
 // the biz layer.
@@ -187,18 +420,21 @@ func (r *CASMappingRepo) queryServiceable(preds ...predicate.CASMapping) *ent.CA
 		Where(preds...).
 		// Never return a mapping whose backend has been (soft) deleted; it cannot serve downloads.
 		Where(casmapping.HasCasBackendWith(casbackend.DeletedAtIsNil())).
-		WithCasBackend()
+		// The primary of a replica is loaded too, the artifact might not have been mirrored yet
+		WithCasBackend(func(q *ent.CASBackendQuery) { q.WithReplicas(liveReplicas).WithPrimary(liveReplicas) })
 }
 
 // findOnePreferringDefault returns the first CAS mapping matching the given predicates, preferring
-// the one stored in the default backend and breaking ties on the oldest mapping. It returns
-// (nil, nil) when nothing matches.
+// the ones stored in a healthy backend, then the one in the default backend and breaking ties on
+// the oldest mapping. It returns (nil, nil) when nothing matches.
 func (r *CASMappingRepo) findOnePreferringDefault(ctx context.Context, preds ...predicate.CASMapping) (*ent.CASMapping, error) {
 	ctx, span := otelx.Start(ctx, casMappingRepoTracer, "CASMappingRepo.findOnePreferringDefault")
 	defer span.End()
 
 	m, err := r.queryServiceable(preds...).
 		Order(
+			// "OK" sorts after "Invalid"
+			casmapping.ByCasBackendField(casbackend.FieldValidationStatus, sql.OrderDesc()),
 			casmapping.ByCasBackendField(casbackend.FieldDefault, sql.OrderDesc()),
 			casmapping.ByCreatedAt(sql.OrderAsc()),
 		).
@@ -0,0 +1,201 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var casRetentionRuleRepoTracer = otelx.Tracer("chainloop-controlplane", "data/casretentionrule")
+
+type CASRetentionRuleRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewCASRetentionRuleRepo(data *Data, logger log.Logger) biz.CASRetentionRuleRepo {
+	return &CASRetentionRuleRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *CASRetentionRuleRepo) Upsert(ctx context.Context, opts *biz.CASRetentionRuleUpsertOpts) (*biz.CASRetentionRule, error) {
+	ctx, span := otelx.Start(ctx, casRetentionRuleRepoTracer, "CASRetentionRuleRepo.Upsert")
+	defer span.End()
+
+	var id uuid.UUID
+	if err := WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		q := tx.CASRetentionRule.Query().Where(casretentionrule.OrganizationID(opts.OrgID))
+		if opts.CASBackendID != nil {
+			q = q.Where(casretentionrule.CasBackendID(*opts.CASBackendID))
+		} else {
+			q = q.Where(casretentionrule.CasBackendIDIsNil())
+		}
+
+		existing, err := q.ForUpdate().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if existing != nil {
+			id = existing.ID
+			return tx.CASRetentionRule.UpdateOneID(existing.ID).
+				SetMaxAgeDays(opts.MaxAgeDays).
+				SetKeepReleasedVersions(opts.KeepReleasedVersions).
+				Exec(ctx)
+		}
+
+		created, err := tx.CASRetentionRule.Create().
+			SetOrganizationID(opts.OrgID).
+			SetNillableCasBackendID(opts.CASBackendID).
+			SetMaxAgeDays(opts.MaxAgeDays).
+			SetKeepReleasedVersions(opts.KeepReleasedVersions).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		id = created.ID
+		return nil
+	}); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.NewErrAlreadyExists(err)
+		}
+		return nil, fmt.Errorf("failed to store retention rule: %w", err)
+	}
+
+	return r.findByID(ctx, id)
+}
+
+func (r *CASRetentionRuleRepo) List(ctx context.Context, orgID uuid.UUID) ([]*biz.CASRetentionRule, error) {
+	ctx, span := otelx.Start(ctx, casRetentionRuleRepoTracer, "CASRetentionRuleRepo.List")
+	defer span.End()
+
+	return r.list(ctx, casretentionrule.OrganizationID(orgID))
+}
+
+func (r *CASRetentionRuleRepo) ListAll(ctx context.Context) ([]*biz.CASRetentionRule, error) {
+	ctx, span := otelx.Start(ctx, casRetentionRuleRepoTracer, "CASRetentionRuleRepo.ListAll")
+	defer span.End()
+
+	return r.list(ctx)
+}
+
+func (r *CASRetentionRuleRepo) list(ctx context.Context, preds ...predicate.CASRetentionRule) ([]*biz.CASRetentionRule, error) {
+	rules, err := r.data.DB.CASRetentionRule.Query().
+		Where(preds...).
+		WithCasBackend().
+		// the organization default first
+		Order(
+			casretentionrule.ByOrganizationID(),
+			casretentionrule.ByCasBackendID(sql.OrderNullsFirst()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retention rules: %w", err)
+	}
+
+	res := make([]*biz.CASRetentionRule, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, entCASRetentionRuleToBiz(rule))
+	}
+
+	return res, nil
+}
+
+func (r *CASRetentionRuleRepo) FindByIDInOrg(ctx context.Context, orgID, id uuid.UUID) (*biz.CASRetentionRule, error) {
+	ctx, span := otelx.Start(ctx, casRetentionRuleRepoTracer, "CASRetentionRuleRepo.FindByIDInOrg")
+	defer span.End()
+
+	rule, err := r.data.DB.CASRetentionRule.Query().
+		Where(casretentionrule.ID(id), casretentionrule.OrganizationID(orgID)).
+		WithCasBackend().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.NewErrNotFound("retention rule")
+		}
+		return nil, fmt.Errorf("failed to find retention rule: %w", err)
+	}
+
+	return entCASRetentionRuleToBiz(rule), nil
+}
+
+func (r *CASRetentionRuleRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	ctx, span := otelx.Start(ctx, casRetentionRuleRepoTracer, "CASRetentionRuleRepo.Delete")
+	defer span.End()
+
+	if err := r.data.DB.CASRetentionRule.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return biz.NewErrNotFound("retention rule")
+		}
+		return fmt.Errorf("failed to delete retention rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CASRetentionRuleRepo) MarkSwept(ctx context.Context, id uuid.UUID, at time.Time) error {
+	ctx, span := otelx.Start(ctx, casRetentionRuleRepoTracer, "CASRetentionRuleRepo.MarkSwept")
+	defer span.End()
+
+	// the rule might have been removed while the sweep was running
+	if err := r.data.DB.CASRetentionRule.Update().
+		Where(casretentionrule.ID(id)).
+		SetLastSweptAt(at).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update retention rule: %w", err)
+	}
+
+	return nil
+}
+
+func (r *CASRetentionRuleRepo) findByID(ctx context.Context, id uuid.UUID) (*biz.CASRetentionRule, error) {
+	rule, err := r.data.DB.CASRetentionRule.Query().
+		Where(casretentionrule.ID(id)).
+		WithCasBackend().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find retention rule: %w", err)
+	}
+
+	return entCASRetentionRuleToBiz(rule), nil
+}
+
+func entCASRetentionRuleToBiz(rule *ent.CASRetentionRule) *biz.CASRetentionRule {
+	return &biz.CASRetentionRule{
+		ID:                   rule.ID,
+		OrganizationID:       rule.OrganizationID,
+		CASBackend:           entCASBackendToBiz(rule.Edges.CasBackend),
+		MaxAgeDays:           rule.MaxAgeDays,
+		KeepReleasedVersions: rule.KeepReleasedVersions,
+		CreatedAt:            toTimePtr(rule.CreatedAt),
+		UpdatedAt:            toTimePtr(rule.UpdatedAt),
+		LastSweptAt:          toTimePtr(rule.LastSweptAt),
+	}
+}
@@ -51,7 +51,9 @@ var ProviderSet = wire.NewSet(
 	NewOrgMetricsRepo,
 	NewIntegrationRepo,
 	NewIntegrationAttachmentRepo,
+	NewIntegrationDeliveryRepo,
 	NewCASMappingRepo,
+	NewCASRetentionRuleRepo,
 	NewMembershipRepo,
 	NewOrgInvitation,
 	NewReferrerRepo,
@@ -60,6 +62,9 @@ var ProviderSet = wire.NewSet(
 	NewProjectVersionRepo,
 	NewProjectsRepo,
 	NewGroupRepo,
+	NewPolicyEvaluationRepo,
+	NewPolicyExceptionRepo,
+	NewPolicyImpactAnalysisRepo,
 	NewPostgresLock,
 )
 
@@ -52,6 +52,8 @@ type CASBackend struct {
 	MaxBlobSizeBytes int64 `json:"max_blob_size_bytes,omitempty"`
 	// OrganizationCasBackends holds the value of the "organization_cas_backends" field.
 	OrganizationCasBackends uuid.UUID `json:"organization_cas_backends,omitempty"`
+	// PrimaryBackendID holds the value of the "primary_backend_id" field.
+	PrimaryBackendID uuid.UUID `json:"primary_backend_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CASBackendQuery when eager-loading is set.
 	Edges        CASBackendEdges `json:"edges"`
@@ -64,9 +66,13 @@ type CASBackendEdges struct {
 	Organization *Organization `json:"organization,omitempty"`
 	// WorkflowRun holds the value of the workflow_run edge.
 	WorkflowRun []*WorkflowRun `json:"workflow_run,omitempty"`
+	// Primary holds the value of the primary edge.
+	Primary *CASBackend `json:"primary,omitempty"`
+	// Replicas holds the value of the replicas edge.
+	Replicas []*CASBackend `json:"replicas,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -89,6 +95,26 @@ func (e CASBackendEdges) WorkflowRunOrErr() ([]*WorkflowRun, error) {
 	return nil, &NotLoadedError{edge: "workflow_run"}
 }
 
+// PrimaryOrErr returns the Primary value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CASBackendEdges) PrimaryOrErr() (*CASBackend, error) {
+	if e.Primary != nil {
+		return e.Primary, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: casbackend.Label}
+	}
+	return nil, &NotLoadedError{edge: "primary"}
+}
+
+// ReplicasOrErr returns the Replicas value or an error if the edge
+// was not loaded in eager-loading.
+func (e CASBackendEdges) ReplicasOrErr() ([]*CASBackend, error) {
+	if e.loadedTypes[3] {
+		return e.Replicas, nil
+	}
+	return nil, &NotLoadedError{edge: "replicas"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CASBackend) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -102,7 +128,7 @@ func (*CASBackend) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case casbackend.FieldCreatedAt, casbackend.FieldUpdatedAt, casbackend.FieldValidatedAt, casbackend.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
-		case casbackend.FieldID, casbackend.FieldOrganizationCasBackends:
+		case casbackend.FieldID, casbackend.FieldOrganizationCasBackends, casbackend.FieldPrimaryBackendID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -221,6 +247,12 @@ func (_m *CASBackend) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.OrganizationCasBackends = *value
 			}
+		case casbackend.FieldPrimaryBackendID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_backend_id", values[i])
+			} else if value != nil {
+				_m.PrimaryBackendID = *value
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -244,6 +276,16 @@ func (_m *CASBackend) QueryWorkflowRun() *WorkflowRunQuery {
 	return NewCASBackendClient(_m.config).QueryWorkflowRun(_m)
 }
 
+// QueryPrimary queries the "primary" edge of the CASBackend entity.
+func (_m *CASBackend) QueryPrimary() *CASBackendQuery {
+	return NewCASBackendClient(_m.config).QueryPrimary(_m)
+}
+
+// QueryReplicas queries the "replicas" edge of the CASBackend entity.
+func (_m *CASBackend) QueryReplicas() *CASBackendQuery {
+	return NewCASBackendClient(_m.config).QueryReplicas(_m)
+}
+
 // Update returns a builder for updating this CASBackend.
 // Note that you need to call CASBackend.Unwrap() before calling this method if this CASBackend
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -314,6 +356,9 @@ func (_m *CASBackend) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("organization_cas_backends=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationCasBackends))
+	builder.WriteString(", ")
+	builder.WriteString("primary_backend_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PrimaryBackendID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -49,10 +49,16 @@ const (
 	FieldMaxBlobSizeBytes = "max_blob_size_bytes"
 	// FieldOrganizationCasBackends holds the string denoting the organization_cas_backends field in the database.
 	FieldOrganizationCasBackends = "organization_cas_backends"
+	// FieldPrimaryBackendID holds the string denoting the primary_backend_id field in the database.
+	FieldPrimaryBackendID = "primary_backend_id"
 	// EdgeOrganization holds the string denoting the organization edge name in mutations.
 	EdgeOrganization = "organization"
 	// EdgeWorkflowRun holds the string denoting the workflow_run edge name in mutations.
 	EdgeWorkflowRun = "workflow_run"
+	// EdgePrimary holds the string denoting the primary edge name in mutations.
+	EdgePrimary = "primary"
+	// EdgeReplicas holds the string denoting the replicas edge name in mutations.
+	EdgeReplicas = "replicas"
 	// Table holds the table name of the casbackend in the database.
 	Table = "cas_backends"
 	// OrganizationTable is the table that holds the organization relation/edge.
@@ -67,6 +73,14 @@ const (
 	// WorkflowRunInverseTable is the table name for the WorkflowRun entity.
 	// It exists in this package in order to avoid circular dependency with the "workflowrun" package.
 	WorkflowRunInverseTable = "workflow_runs"
+	// PrimaryTable is the table that holds the primary relation/edge.
+	PrimaryTable = "cas_backends"
+	// PrimaryColumn is the table column denoting the primary relation/edge.
+	PrimaryColumn = "primary_backend_id"
+	// ReplicasTable is the table that holds the replicas relation/edge.
+	ReplicasTable = "cas_backends"
+	// ReplicasColumn is the table column denoting the replicas relation/edge.
+	ReplicasColumn = "primary_backend_id"
 )
 
 // Columns holds all SQL columns for casbackend fields.
@@ -88,6 +102,7 @@ var Columns = []string{
 	FieldManaged,
 	FieldMaxBlobSizeBytes,
 	FieldOrganizationCasBackends,
+	FieldPrimaryBackendID,
 }
 
 var (
@@ -128,7 +143,7 @@ var (
 // ProviderValidator is a validator for the "provider" field enum values. It is called by the builders before save.
 func ProviderValidator(pr biz.CASBackendProvider) error {
 	switch pr {
-	case "AzureBlob", "OCI", "INLINE", "AWS-S3", "AWS-S3-ACCESS-POINT":
+	case "AzureBlob", "OCI", "INLINE", "AWS-S3", "AWS-S3-ACCESS-POINT", "FILESYSTEM", "GCS":
 		return nil
 	default:
 		return fmt.Errorf("casbackend: invalid enum value for provider field: %q", pr)
@@ -235,6 +250,11 @@ func ByOrganizationCasBackends(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrganizationCasBackends, opts...).ToFunc()
 }
 
+// ByPrimaryBackendID orders the results by the primary_backend_id field.
+func ByPrimaryBackendID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryBackendID, opts...).ToFunc()
+}
+
 // ByOrganizationField orders the results by organization field.
 func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -255,6 +275,27 @@ func ByWorkflowRun(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newWorkflowRunStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPrimaryField orders the results by primary field.
+func ByPrimaryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrimaryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReplicasCount orders the results by replicas count.
+func ByReplicasCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReplicasStep(), opts...)
+	}
+}
+
+// ByReplicas orders the results by replicas terms.
+func ByReplicas(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplicasStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrganizationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -269,3 +310,17 @@ func newWorkflowRunStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, WorkflowRunTable, WorkflowRunPrimaryKey...),
 	)
 }
+func newPrimaryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PrimaryTable, PrimaryColumn),
+	)
+}
+func newReplicasStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReplicasTable, ReplicasColumn),
+	)
+}
@@ -127,6 +127,11 @@ func OrganizationCasBackends(v uuid.UUID) predicate.CASBackend {
 	return predicate.CASBackend(sql.FieldEQ(FieldOrganizationCasBackends, v))
 }
 
+// PrimaryBackendID applies equality check predicate on the "primary_backend_id" field. It's identical to PrimaryBackendIDEQ.
+func PrimaryBackendID(v uuid.UUID) predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldEQ(FieldPrimaryBackendID, v))
+}
+
 // LocationEQ applies the EQ predicate on the "location" field.
 func LocationEQ(v string) predicate.CASBackend {
 	return predicate.CASBackend(sql.FieldEQ(FieldLocation, v))
@@ -792,6 +797,36 @@ func OrganizationCasBackendsNotIn(vs ...uuid.UUID) predicate.CASBackend {
 	return predicate.CASBackend(sql.FieldNotIn(FieldOrganizationCasBackends, vs...))
 }
 
+// PrimaryBackendIDEQ applies the EQ predicate on the "primary_backend_id" field.
+func PrimaryBackendIDEQ(v uuid.UUID) predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldEQ(FieldPrimaryBackendID, v))
+}
+
+// PrimaryBackendIDNEQ applies the NEQ predicate on the "primary_backend_id" field.
+func PrimaryBackendIDNEQ(v uuid.UUID) predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldNEQ(FieldPrimaryBackendID, v))
+}
+
+// PrimaryBackendIDIn applies the In predicate on the "primary_backend_id" field.
+func PrimaryBackendIDIn(vs ...uuid.UUID) predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldIn(FieldPrimaryBackendID, vs...))
+}
+
+// PrimaryBackendIDNotIn applies the NotIn predicate on the "primary_backend_id" field.
+func PrimaryBackendIDNotIn(vs ...uuid.UUID) predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldNotIn(FieldPrimaryBackendID, vs...))
+}
+
+// PrimaryBackendIDIsNil applies the IsNil predicate on the "primary_backend_id" field.
+func PrimaryBackendIDIsNil() predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldIsNull(FieldPrimaryBackendID))
+}
+
+// PrimaryBackendIDNotNil applies the NotNil predicate on the "primary_backend_id" field.
+func PrimaryBackendIDNotNil() predicate.CASBackend {
+	return predicate.CASBackend(sql.FieldNotNull(FieldPrimaryBackendID))
+}
+
 // HasOrganization applies the HasEdge predicate on the "organization" edge.
 func HasOrganization() predicate.CASBackend {
 	return predicate.CASBackend(func(s *sql.Selector) {
@@ -838,6 +873,52 @@ func HasWorkflowRunWith(preds ...predicate.WorkflowRun) predicate.CASBackend {
 	})
 }
 
+// HasPrimary applies the HasEdge predicate on the "primary" edge.
+func HasPrimary() predicate.CASBackend {
+	return predicate.CASBackend(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PrimaryTable, PrimaryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrimaryWith applies the HasEdge predicate on the "primary" edge with a given conditions (other predicates).
+func HasPrimaryWith(preds ...predicate.CASBackend) predicate.CASBackend {
+	return predicate.CASBackend(func(s *sql.Selector) {
+		step := newPrimaryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplicas applies the HasEdge predicate on the "replicas" edge.
+func HasReplicas() predicate.CASBackend {
+	return predicate.CASBackend(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReplicasTable, ReplicasColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplicasWith applies the HasEdge predicate on the "replicas" edge with a given conditions (other predicates).
+func HasReplicasWith(preds ...predicate.CASBackend) predicate.CASBackend {
+	return predicate.CASBackend(func(s *sql.Selector) {
+		step := newReplicasStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CASBackend) predicate.CASBackend {
 	return predicate.CASBackend(sql.AndPredicates(predicates...))
@@ -203,6 +203,20 @@ func (_c *CASBackendCreate) SetOrganizationCasBackends(v uuid.UUID) *CASBackendC
 	return _c
 }
 
+// SetPrimaryBackendID sets the "primary_backend_id" field.
+func (_c *CASBackendCreate) SetPrimaryBackendID(v uuid.UUID) *CASBackendCreate {
+	_c.mutation.SetPrimaryBackendID(v)
+	return _c
+}
+
+// SetNillablePrimaryBackendID sets the "primary_backend_id" field if the given value is not nil.
+func (_c *CASBackendCreate) SetNillablePrimaryBackendID(v *uuid.UUID) *CASBackendCreate {
+	if v != nil {
+		_c.SetPrimaryBackendID(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CASBackendCreate) SetID(v uuid.UUID) *CASBackendCreate {
 	_c.mutation.SetID(v)
@@ -243,6 +257,40 @@ func (_c *CASBackendCreate) AddWorkflowRun(v ...*WorkflowRun) *CASBackendCreate
 	return _c.AddWorkflowRunIDs(ids...)
 }
 
+// SetPrimaryID sets the "primary" edge to the CASBackend entity by ID.
+func (_c *CASBackendCreate) SetPrimaryID(id uuid.UUID) *CASBackendCreate {
+	_c.mutation.SetPrimaryID(id)
+	return _c
+}
+
+// SetNillablePrimaryID sets the "primary" edge to the CASBackend entity by ID if the given value is not nil.
+func (_c *CASBackendCreate) SetNillablePrimaryID(id *uuid.UUID) *CASBackendCreate {
+	if id != nil {
+		_c = _c.SetPrimaryID(*id)
+	}
+	return _c
+}
+
+// SetPrimary sets the "primary" edge to the CASBackend entity.
+func (_c *CASBackendCreate) SetPrimary(v *CASBackend) *CASBackendCreate {
+	return _c.SetPrimaryID(v.ID)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the CASBackend entity by IDs.
+func (_c *CASBackendCreate) AddReplicaIDs(ids ...uuid.UUID) *CASBackendCreate {
+	_c.mutation.AddReplicaIDs(ids...)
+	return _c
+}
+
+// AddReplicas adds the "replicas" edges to the CASBackend entity.
+func (_c *CASBackendCreate) AddReplicas(v ...*CASBackend) *CASBackendCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplicaIDs(ids...)
+}
+
 // Mutation returns the CASBackendMutation object of the builder.
 func (_c *CASBackendCreate) Mutation() *CASBackendMutation {
 	return _c.mutation
@@ -495,6 +543,39 @@ func (_c *CASBackendCreate) createSpec() (*CASBackend, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PrimaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casbackend.PrimaryTable,
+			Columns: []string{casbackend.PrimaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PrimaryBackendID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -715,6 +796,24 @@ func (u *CASBackendUpsert) UpdateOrganizationCasBackends() *CASBackendUpsert {
 	return u
 }
 
+// SetPrimaryBackendID sets the "primary_backend_id" field.
+func (u *CASBackendUpsert) SetPrimaryBackendID(v uuid.UUID) *CASBackendUpsert {
+	u.Set(casbackend.FieldPrimaryBackendID, v)
+	return u
+}
+
+// UpdatePrimaryBackendID sets the "primary_backend_id" field to the value that was provided on create.
+func (u *CASBackendUpsert) UpdatePrimaryBackendID() *CASBackendUpsert {
+	u.SetExcluded(casbackend.FieldPrimaryBackendID)
+	return u
+}
+
+// ClearPrimaryBackendID clears the value of the "primary_backend_id" field.
+func (u *CASBackendUpsert) ClearPrimaryBackendID() *CASBackendUpsert {
+	u.SetNull(casbackend.FieldPrimaryBackendID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -971,6 +1070,27 @@ func (u *CASBackendUpsertOne) UpdateOrganizationCasBackends() *CASBackendUpsertO
 	})
 }
 
+// SetPrimaryBackendID sets the "primary_backend_id" field.
+func (u *CASBackendUpsertOne) SetPrimaryBackendID(v uuid.UUID) *CASBackendUpsertOne {
+	return u.Update(func(s *CASBackendUpsert) {
+		s.SetPrimaryBackendID(v)
+	})
+}
+
+// UpdatePrimaryBackendID sets the "primary_backend_id" field to the value that was provided on create.
+func (u *CASBackendUpsertOne) UpdatePrimaryBackendID() *CASBackendUpsertOne {
+	return u.Update(func(s *CASBackendUpsert) {
+		s.UpdatePrimaryBackendID()
+	})
+}
+
+// ClearPrimaryBackendID clears the value of the "primary_backend_id" field.
+func (u *CASBackendUpsertOne) ClearPrimaryBackendID() *CASBackendUpsertOne {
+	return u.Update(func(s *CASBackendUpsert) {
+		s.ClearPrimaryBackendID()
+	})
+}
+
 // Exec executes the query.
 func (u *CASBackendUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1394,6 +1514,27 @@ func (u *CASBackendUpsertBulk) UpdateOrganizationCasBackends() *CASBackendUpsert
 	})
 }
 
+// SetPrimaryBackendID sets the "primary_backend_id" field.
+func (u *CASBackendUpsertBulk) SetPrimaryBackendID(v uuid.UUID) *CASBackendUpsertBulk {
+	return u.Update(func(s *CASBackendUpsert) {
+		s.SetPrimaryBackendID(v)
+	})
+}
+
+// UpdatePrimaryBackendID sets the "primary_backend_id" field to the value that was provided on create.
+func (u *CASBackendUpsertBulk) UpdatePrimaryBackendID() *CASBackendUpsertBulk {
+	return u.Update(func(s *CASBackendUpsert) {
+		s.UpdatePrimaryBackendID()
+	})
+}
+
+// ClearPrimaryBackendID clears the value of the "primary_backend_id" field.
+func (u *CASBackendUpsertBulk) ClearPrimaryBackendID() *CASBackendUpsertBulk {
+	return u.Update(func(s *CASBackendUpsert) {
+		s.ClearPrimaryBackendID()
+	})
+}
+
 // Exec executes the query.
 func (u *CASBackendUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
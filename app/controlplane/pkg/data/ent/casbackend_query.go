@@ -29,6 +29,8 @@ type CASBackendQuery struct {
 	predicates       []predicate.CASBackend
 	withOrganization *OrganizationQuery
 	withWorkflowRun  *WorkflowRunQuery
+	withPrimary      *CASBackendQuery
+	withReplicas     *CASBackendQuery
 	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -110,6 +112,50 @@ func (_q *CASBackendQuery) QueryWorkflowRun() *WorkflowRunQuery {
 	return query
 }
 
+// QueryPrimary chains the current query on the "primary" edge.
+func (_q *CASBackendQuery) QueryPrimary() *CASBackendQuery {
+	query := (&CASBackendClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casbackend.Table, casbackend.FieldID, selector),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, casbackend.PrimaryTable, casbackend.PrimaryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplicas chains the current query on the "replicas" edge.
+func (_q *CASBackendQuery) QueryReplicas() *CASBackendQuery {
+	query := (&CASBackendClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casbackend.Table, casbackend.FieldID, selector),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, casbackend.ReplicasTable, casbackend.ReplicasColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CASBackend entity from the query.
 // Returns a *NotFoundError when no CASBackend was found.
 func (_q *CASBackendQuery) First(ctx context.Context) (*CASBackend, error) {
@@ -304,6 +350,8 @@ func (_q *CASBackendQuery) Clone() *CASBackendQuery {
 		predicates:       append([]predicate.CASBackend{}, _q.predicates...),
 		withOrganization: _q.withOrganization.Clone(),
 		withWorkflowRun:  _q.withWorkflowRun.Clone(),
+		withPrimary:      _q.withPrimary.Clone(),
+		withReplicas:     _q.withReplicas.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -333,6 +381,28 @@ func (_q *CASBackendQuery) WithWorkflowRun(opts ...func(*WorkflowRunQuery)) *CAS
 	return _q
 }
 
+// WithPrimary tells the query-builder to eager-load the nodes that are connected to
+// the "primary" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CASBackendQuery) WithPrimary(opts ...func(*CASBackendQuery)) *CASBackendQuery {
+	query := (&CASBackendClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrimary = query
+	return _q
+}
+
+// WithReplicas tells the query-builder to eager-load the nodes that are connected to
+// the "replicas" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CASBackendQuery) WithReplicas(opts ...func(*CASBackendQuery)) *CASBackendQuery {
+	query := (&CASBackendClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReplicas = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -411,9 +481,11 @@ func (_q *CASBackendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 	var (
 		nodes       = []*CASBackend{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withOrganization != nil,
 			_q.withWorkflowRun != nil,
+			_q.withPrimary != nil,
+			_q.withReplicas != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -450,6 +522,19 @@ func (_q *CASBackendQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*C
 			return nil, err
 		}
 	}
+	if query := _q.withPrimary; query != nil {
+		if err := _q.loadPrimary(ctx, query, nodes, nil,
+			func(n *CASBackend, e *CASBackend) { n.Edges.Primary = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReplicas; query != nil {
+		if err := _q.loadReplicas(ctx, query, nodes,
+			func(n *CASBackend) { n.Edges.Replicas = []*CASBackend{} },
+			func(n *CASBackend, e *CASBackend) { n.Edges.Replicas = append(n.Edges.Replicas, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -543,6 +628,65 @@ func (_q *CASBackendQuery) loadWorkflowRun(ctx context.Context, query *WorkflowR
 	}
 	return nil
 }
+func (_q *CASBackendQuery) loadPrimary(ctx context.Context, query *CASBackendQuery, nodes []*CASBackend, init func(*CASBackend), assign func(*CASBackend, *CASBackend)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*CASBackend)
+	for i := range nodes {
+		fk := nodes[i].PrimaryBackendID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(casbackend.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "primary_backend_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *CASBackendQuery) loadReplicas(ctx context.Context, query *CASBackendQuery, nodes []*CASBackend, init func(*CASBackend), assign func(*CASBackend, *CASBackend)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*CASBackend)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(casbackend.FieldPrimaryBackendID)
+	}
+	query.Where(predicate.CASBackend(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(casbackend.ReplicasColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PrimaryBackendID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "primary_backend_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CASBackendQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -575,6 +719,9 @@ func (_q *CASBackendQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withOrganization != nil {
 			_spec.Node.AddColumnOnce(casbackend.FieldOrganizationCasBackends)
 		}
+		if _q.withPrimary != nil {
+			_spec.Node.AddColumnOnce(casbackend.FieldPrimaryBackendID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -218,6 +218,26 @@ func (_u *CASBackendUpdate) SetNillableOrganizationCasBackends(v *uuid.UUID) *CA
 	return _u
 }
 
+// SetPrimaryBackendID sets the "primary_backend_id" field.
+func (_u *CASBackendUpdate) SetPrimaryBackendID(v uuid.UUID) *CASBackendUpdate {
+	_u.mutation.SetPrimaryBackendID(v)
+	return _u
+}
+
+// SetNillablePrimaryBackendID sets the "primary_backend_id" field if the given value is not nil.
+func (_u *CASBackendUpdate) SetNillablePrimaryBackendID(v *uuid.UUID) *CASBackendUpdate {
+	if v != nil {
+		_u.SetPrimaryBackendID(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryBackendID clears the value of the "primary_backend_id" field.
+func (_u *CASBackendUpdate) ClearPrimaryBackendID() *CASBackendUpdate {
+	_u.mutation.ClearPrimaryBackendID()
+	return _u
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (_u *CASBackendUpdate) SetOrganizationID(id uuid.UUID) *CASBackendUpdate {
 	_u.mutation.SetOrganizationID(id)
@@ -244,6 +264,40 @@ func (_u *CASBackendUpdate) AddWorkflowRun(v ...*WorkflowRun) *CASBackendUpdate
 	return _u.AddWorkflowRunIDs(ids...)
 }
 
+// SetPrimaryID sets the "primary" edge to the CASBackend entity by ID.
+func (_u *CASBackendUpdate) SetPrimaryID(id uuid.UUID) *CASBackendUpdate {
+	_u.mutation.SetPrimaryID(id)
+	return _u
+}
+
+// SetNillablePrimaryID sets the "primary" edge to the CASBackend entity by ID if the given value is not nil.
+func (_u *CASBackendUpdate) SetNillablePrimaryID(id *uuid.UUID) *CASBackendUpdate {
+	if id != nil {
+		_u = _u.SetPrimaryID(*id)
+	}
+	return _u
+}
+
+// SetPrimary sets the "primary" edge to the CASBackend entity.
+func (_u *CASBackendUpdate) SetPrimary(v *CASBackend) *CASBackendUpdate {
+	return _u.SetPrimaryID(v.ID)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the CASBackend entity by IDs.
+func (_u *CASBackendUpdate) AddReplicaIDs(ids ...uuid.UUID) *CASBackendUpdate {
+	_u.mutation.AddReplicaIDs(ids...)
+	return _u
+}
+
+// AddReplicas adds the "replicas" edges to the CASBackend entity.
+func (_u *CASBackendUpdate) AddReplicas(v ...*CASBackend) *CASBackendUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicaIDs(ids...)
+}
+
 // Mutation returns the CASBackendMutation object of the builder.
 func (_u *CASBackendUpdate) Mutation() *CASBackendMutation {
 	return _u.mutation
@@ -276,6 +330,33 @@ func (_u *CASBackendUpdate) RemoveWorkflowRun(v ...*WorkflowRun) *CASBackendUpda
 	return _u.RemoveWorkflowRunIDs(ids...)
 }
 
+// ClearPrimary clears the "primary" edge to the CASBackend entity.
+func (_u *CASBackendUpdate) ClearPrimary() *CASBackendUpdate {
+	_u.mutation.ClearPrimary()
+	return _u
+}
+
+// ClearReplicas clears all "replicas" edges to the CASBackend entity.
+func (_u *CASBackendUpdate) ClearReplicas() *CASBackendUpdate {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to CASBackend entities by IDs.
+func (_u *CASBackendUpdate) RemoveReplicaIDs(ids ...uuid.UUID) *CASBackendUpdate {
+	_u.mutation.RemoveReplicaIDs(ids...)
+	return _u
+}
+
+// RemoveReplicas removes "replicas" edges to CASBackend entities.
+func (_u *CASBackendUpdate) RemoveReplicas(v ...*CASBackend) *CASBackendUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicaIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CASBackendUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -462,6 +543,80 @@ func (_u *CASBackendUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PrimaryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casbackend.PrimaryTable,
+			Columns: []string{casbackend.PrimaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrimaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casbackend.PrimaryTable,
+			Columns: []string{casbackend.PrimaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicasIDs(); len(nodes) > 0 && !_u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -669,6 +824,26 @@ func (_u *CASBackendUpdateOne) SetNillableOrganizationCasBackends(v *uuid.UUID)
 	return _u
 }
 
+// SetPrimaryBackendID sets the "primary_backend_id" field.
+func (_u *CASBackendUpdateOne) SetPrimaryBackendID(v uuid.UUID) *CASBackendUpdateOne {
+	_u.mutation.SetPrimaryBackendID(v)
+	return _u
+}
+
+// SetNillablePrimaryBackendID sets the "primary_backend_id" field if the given value is not nil.
+func (_u *CASBackendUpdateOne) SetNillablePrimaryBackendID(v *uuid.UUID) *CASBackendUpdateOne {
+	if v != nil {
+		_u.SetPrimaryBackendID(*v)
+	}
+	return _u
+}
+
+// ClearPrimaryBackendID clears the value of the "primary_backend_id" field.
+func (_u *CASBackendUpdateOne) ClearPrimaryBackendID() *CASBackendUpdateOne {
+	_u.mutation.ClearPrimaryBackendID()
+	return _u
+}
+
 // SetOrganizationID sets the "organization" edge to the Organization entity by ID.
 func (_u *CASBackendUpdateOne) SetOrganizationID(id uuid.UUID) *CASBackendUpdateOne {
 	_u.mutation.SetOrganizationID(id)
@@ -695,6 +870,40 @@ func (_u *CASBackendUpdateOne) AddWorkflowRun(v ...*WorkflowRun) *CASBackendUpda
 	return _u.AddWorkflowRunIDs(ids...)
 }
 
+// SetPrimaryID sets the "primary" edge to the CASBackend entity by ID.
+func (_u *CASBackendUpdateOne) SetPrimaryID(id uuid.UUID) *CASBackendUpdateOne {
+	_u.mutation.SetPrimaryID(id)
+	return _u
+}
+
+// SetNillablePrimaryID sets the "primary" edge to the CASBackend entity by ID if the given value is not nil.
+func (_u *CASBackendUpdateOne) SetNillablePrimaryID(id *uuid.UUID) *CASBackendUpdateOne {
+	if id != nil {
+		_u = _u.SetPrimaryID(*id)
+	}
+	return _u
+}
+
+// SetPrimary sets the "primary" edge to the CASBackend entity.
+func (_u *CASBackendUpdateOne) SetPrimary(v *CASBackend) *CASBackendUpdateOne {
+	return _u.SetPrimaryID(v.ID)
+}
+
+// AddReplicaIDs adds the "replicas" edge to the CASBackend entity by IDs.
+func (_u *CASBackendUpdateOne) AddReplicaIDs(ids ...uuid.UUID) *CASBackendUpdateOne {
+	_u.mutation.AddReplicaIDs(ids...)
+	return _u
+}
+
+// AddReplicas adds the "replicas" edges to the CASBackend entity.
+func (_u *CASBackendUpdateOne) AddReplicas(v ...*CASBackend) *CASBackendUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplicaIDs(ids...)
+}
+
 // Mutation returns the CASBackendMutation object of the builder.
 func (_u *CASBackendUpdateOne) Mutation() *CASBackendMutation {
 	return _u.mutation
@@ -727,6 +936,33 @@ func (_u *CASBackendUpdateOne) RemoveWorkflowRun(v ...*WorkflowRun) *CASBackendU
 	return _u.RemoveWorkflowRunIDs(ids...)
 }
 
+// ClearPrimary clears the "primary" edge to the CASBackend entity.
+func (_u *CASBackendUpdateOne) ClearPrimary() *CASBackendUpdateOne {
+	_u.mutation.ClearPrimary()
+	return _u
+}
+
+// ClearReplicas clears all "replicas" edges to the CASBackend entity.
+func (_u *CASBackendUpdateOne) ClearReplicas() *CASBackendUpdateOne {
+	_u.mutation.ClearReplicas()
+	return _u
+}
+
+// RemoveReplicaIDs removes the "replicas" edge to CASBackend entities by IDs.
+func (_u *CASBackendUpdateOne) RemoveReplicaIDs(ids ...uuid.UUID) *CASBackendUpdateOne {
+	_u.mutation.RemoveReplicaIDs(ids...)
+	return _u
+}
+
+// RemoveReplicas removes "replicas" edges to CASBackend entities.
+func (_u *CASBackendUpdateOne) RemoveReplicas(v ...*CASBackend) *CASBackendUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplicaIDs(ids...)
+}
+
 // Where appends a list predicates to the CASBackendUpdate builder.
 func (_u *CASBackendUpdateOne) Where(ps ...predicate.CASBackend) *CASBackendUpdateOne {
 	_u.mutation.Where(ps...)
@@ -943,6 +1179,80 @@ func (_u *CASBackendUpdateOne) sqlSave(ctx context.Context) (_node *CASBackend,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PrimaryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casbackend.PrimaryTable,
+			Columns: []string{casbackend.PrimaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrimaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   casbackend.PrimaryTable,
+			Columns: []string{casbackend.PrimaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReplicasIDs(); len(nodes) > 0 && !_u.mutation.ReplicasCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplicasIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   casbackend.ReplicasTable,
+			Columns: []string{casbackend.ReplicasColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &CASBackend{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
+	"github.com/google/uuid"
+)
+
+// CASBlobDeletion is the model entity for the CASBlobDeletion schema.
+type CASBlobDeletion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest string `json:"digest,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// CasBackendID holds the value of the "cas_backend_id" field.
+	CasBackendID uuid.UUID `json:"cas_backend_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CASBlobDeletionQuery when eager-loading is set.
+	Edges        CASBlobDeletionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CASBlobDeletionEdges holds the relations/edges for other nodes in the graph.
+type CASBlobDeletionEdges struct {
+	// CasBackend holds the value of the cas_backend edge.
+	CasBackend *CASBackend `json:"cas_backend,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CasBackendOrErr returns the CasBackend value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CASBlobDeletionEdges) CasBackendOrErr() (*CASBackend, error) {
+	if e.CasBackend != nil {
+		return e.CasBackend, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: casbackend.Label}
+	}
+	return nil, &NotLoadedError{edge: "cas_backend"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CASBlobDeletion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case casblobdeletion.FieldDigest:
+			values[i] = new(sql.NullString)
+		case casblobdeletion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case casblobdeletion.FieldID, casblobdeletion.FieldCasBackendID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CASBlobDeletion fields.
+func (_m *CASBlobDeletion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case casblobdeletion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case casblobdeletion.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = value.String
+			}
+		case casblobdeletion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case casblobdeletion.FieldCasBackendID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field cas_backend_id", values[i])
+			} else if value != nil {
+				_m.CasBackendID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CASBlobDeletion.
+// This includes values selected through modifiers, order, etc.
+func (_m *CASBlobDeletion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCasBackend queries the "cas_backend" edge of the CASBlobDeletion entity.
+func (_m *CASBlobDeletion) QueryCasBackend() *CASBackendQuery {
+	return NewCASBlobDeletionClient(_m.config).QueryCasBackend(_m)
+}
+
+// Update returns a builder for updating this CASBlobDeletion.
+// Note that you need to call CASBlobDeletion.Unwrap() before calling this method if this CASBlobDeletion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CASBlobDeletion) Update() *CASBlobDeletionUpdateOne {
+	return NewCASBlobDeletionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CASBlobDeletion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CASBlobDeletion) Unwrap() *CASBlobDeletion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CASBlobDeletion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CASBlobDeletion) String() string {
+	var builder strings.Builder
+	builder.WriteString("CASBlobDeletion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("digest=")
+	builder.WriteString(_m.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("cas_backend_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CasBackendID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CASBlobDeletions is a parsable slice of CASBlobDeletion.
+type CASBlobDeletions []*CASBlobDeletion
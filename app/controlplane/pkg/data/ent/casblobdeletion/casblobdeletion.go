@@ -0,0 +1,97 @@
+// Code generated by ent, DO NOT EDIT.
+
+package casblobdeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the casblobdeletion type in the database.
+	Label = "cas_blob_deletion"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldCasBackendID holds the string denoting the cas_backend_id field in the database.
+	FieldCasBackendID = "cas_backend_id"
+	// EdgeCasBackend holds the string denoting the cas_backend edge name in mutations.
+	EdgeCasBackend = "cas_backend"
+	// Table holds the table name of the casblobdeletion in the database.
+	Table = "cas_blob_deletions"
+	// CasBackendTable is the table that holds the cas_backend relation/edge.
+	CasBackendTable = "cas_blob_deletions"
+	// CasBackendInverseTable is the table name for the CASBackend entity.
+	// It exists in this package in order to avoid circular dependency with the "casbackend" package.
+	CasBackendInverseTable = "cas_backends"
+	// CasBackendColumn is the table column denoting the cas_backend relation/edge.
+	CasBackendColumn = "cas_backend_id"
+)
+
+// Columns holds all SQL columns for casblobdeletion fields.
+var Columns = []string{
+	FieldID,
+	FieldDigest,
+	FieldCreatedAt,
+	FieldCasBackendID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CASBlobDeletion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByCasBackendID orders the results by the cas_backend_id field.
+func ByCasBackendID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCasBackendID, opts...).ToFunc()
+}
+
+// ByCasBackendField orders the results by cas_backend field.
+func ByCasBackendField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCasBackendStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCasBackendStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CasBackendInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CasBackendTable, CasBackendColumn),
+	)
+}
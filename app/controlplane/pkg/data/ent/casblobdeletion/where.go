@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package casblobdeletion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldLTE(FieldID, id))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldDigest, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CasBackendID applies equality check predicate on the "cas_backend_id" field. It's identical to CasBackendIDEQ.
+func CasBackendID(v uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldCasBackendID, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CasBackendIDEQ applies the EQ predicate on the "cas_backend_id" field.
+func CasBackendIDEQ(v uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldEQ(FieldCasBackendID, v))
+}
+
+// CasBackendIDNEQ applies the NEQ predicate on the "cas_backend_id" field.
+func CasBackendIDNEQ(v uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNEQ(FieldCasBackendID, v))
+}
+
+// CasBackendIDIn applies the In predicate on the "cas_backend_id" field.
+func CasBackendIDIn(vs ...uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldIn(FieldCasBackendID, vs...))
+}
+
+// CasBackendIDNotIn applies the NotIn predicate on the "cas_backend_id" field.
+func CasBackendIDNotIn(vs ...uuid.UUID) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.FieldNotIn(FieldCasBackendID, vs...))
+}
+
+// HasCasBackend applies the HasEdge predicate on the "cas_backend" edge.
+func HasCasBackend() predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CasBackendTable, CasBackendColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCasBackendWith applies the HasEdge predicate on the "cas_backend" edge with a given conditions (other predicates).
+func HasCasBackendWith(preds ...predicate.CASBackend) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(func(s *sql.Selector) {
+		step := newCasBackendStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CASBlobDeletion) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CASBlobDeletion) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CASBlobDeletion) predicate.CASBlobDeletion {
+	return predicate.CASBlobDeletion(sql.NotPredicates(p))
+}
@@ -0,0 +1,547 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
+	"github.com/google/uuid"
+)
+
+// CASBlobDeletionCreate is the builder for creating a CASBlobDeletion entity.
+type CASBlobDeletionCreate struct {
+	config
+	mutation *CASBlobDeletionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDigest sets the "digest" field.
+func (_c *CASBlobDeletionCreate) SetDigest(v string) *CASBlobDeletionCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CASBlobDeletionCreate) SetCreatedAt(v time.Time) *CASBlobDeletionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CASBlobDeletionCreate) SetNillableCreatedAt(v *time.Time) *CASBlobDeletionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetCasBackendID sets the "cas_backend_id" field.
+func (_c *CASBlobDeletionCreate) SetCasBackendID(v uuid.UUID) *CASBlobDeletionCreate {
+	_c.mutation.SetCasBackendID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CASBlobDeletionCreate) SetID(v uuid.UUID) *CASBlobDeletionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CASBlobDeletionCreate) SetNillableID(v *uuid.UUID) *CASBlobDeletionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCasBackend sets the "cas_backend" edge to the CASBackend entity.
+func (_c *CASBlobDeletionCreate) SetCasBackend(v *CASBackend) *CASBlobDeletionCreate {
+	return _c.SetCasBackendID(v.ID)
+}
+
+// Mutation returns the CASBlobDeletionMutation object of the builder.
+func (_c *CASBlobDeletionCreate) Mutation() *CASBlobDeletionMutation {
+	return _c.mutation
+}
+
+// Save creates the CASBlobDeletion in the database.
+func (_c *CASBlobDeletionCreate) Save(ctx context.Context) (*CASBlobDeletion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CASBlobDeletionCreate) SaveX(ctx context.Context) *CASBlobDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CASBlobDeletionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CASBlobDeletionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CASBlobDeletionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := casblobdeletion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := casblobdeletion.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CASBlobDeletionCreate) check() error {
+	if _, ok := _c.mutation.Digest(); !ok {
+		return &ValidationError{Name: "digest", err: errors.New(`ent: missing required field "CASBlobDeletion.digest"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CASBlobDeletion.created_at"`)}
+	}
+	if _, ok := _c.mutation.CasBackendID(); !ok {
+		return &ValidationError{Name: "cas_backend_id", err: errors.New(`ent: missing required field "CASBlobDeletion.cas_backend_id"`)}
+	}
+	if len(_c.mutation.CasBackendIDs()) == 0 {
+		return &ValidationError{Name: "cas_backend", err: errors.New(`ent: missing required edge "CASBlobDeletion.cas_backend"`)}
+	}
+	return nil
+}
+
+func (_c *CASBlobDeletionCreate) sqlSave(ctx context.Context) (*CASBlobDeletion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CASBlobDeletionCreate) createSpec() (*CASBlobDeletion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CASBlobDeletion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(casblobdeletion.Table, sqlgraph.NewFieldSpec(casblobdeletion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(casblobdeletion.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(casblobdeletion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.CasBackendIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   casblobdeletion.CasBackendTable,
+			Columns: []string{casblobdeletion.CasBackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CasBackendID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CASBlobDeletion.Create().
+//		SetDigest(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CASBlobDeletionUpsert) {
+//			SetDigest(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CASBlobDeletionCreate) OnConflict(opts ...sql.ConflictOption) *CASBlobDeletionUpsertOne {
+	_c.conflict = opts
+	return &CASBlobDeletionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CASBlobDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CASBlobDeletionCreate) OnConflictColumns(columns ...string) *CASBlobDeletionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CASBlobDeletionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CASBlobDeletionUpsertOne is the builder for "upsert"-ing
+	//  one CASBlobDeletion node.
+	CASBlobDeletionUpsertOne struct {
+		create *CASBlobDeletionCreate
+	}
+
+	// CASBlobDeletionUpsert is the "OnConflict" setter.
+	CASBlobDeletionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CASBlobDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(casblobdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CASBlobDeletionUpsertOne) UpdateNewValues() *CASBlobDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(casblobdeletion.FieldID)
+		}
+		if _, exists := u.create.mutation.Digest(); exists {
+			s.SetIgnore(casblobdeletion.FieldDigest)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(casblobdeletion.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CasBackendID(); exists {
+			s.SetIgnore(casblobdeletion.FieldCasBackendID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CASBlobDeletion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CASBlobDeletionUpsertOne) Ignore() *CASBlobDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CASBlobDeletionUpsertOne) DoNothing() *CASBlobDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CASBlobDeletionCreate.OnConflict
+// documentation for more info.
+func (u *CASBlobDeletionUpsertOne) Update(set func(*CASBlobDeletionUpsert)) *CASBlobDeletionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CASBlobDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *CASBlobDeletionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CASBlobDeletionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CASBlobDeletionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CASBlobDeletionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CASBlobDeletionUpsertOne.ID is not supported by MySQL driver. Use CASBlobDeletionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CASBlobDeletionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CASBlobDeletionCreateBulk is the builder for creating many CASBlobDeletion entities in bulk.
+type CASBlobDeletionCreateBulk struct {
+	config
+	err      error
+	builders []*CASBlobDeletionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CASBlobDeletion entities in the database.
+func (_c *CASBlobDeletionCreateBulk) Save(ctx context.Context) ([]*CASBlobDeletion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CASBlobDeletion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CASBlobDeletionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CASBlobDeletionCreateBulk) SaveX(ctx context.Context) []*CASBlobDeletion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CASBlobDeletionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CASBlobDeletionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CASBlobDeletion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CASBlobDeletionUpsert) {
+//			SetDigest(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CASBlobDeletionCreateBulk) OnConflict(opts ...sql.ConflictOption) *CASBlobDeletionUpsertBulk {
+	_c.conflict = opts
+	return &CASBlobDeletionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CASBlobDeletion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CASBlobDeletionCreateBulk) OnConflictColumns(columns ...string) *CASBlobDeletionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CASBlobDeletionUpsertBulk{
+		create: _c,
+	}
+}
+
+// CASBlobDeletionUpsertBulk is the builder for "upsert"-ing
+// a bulk of CASBlobDeletion nodes.
+type CASBlobDeletionUpsertBulk struct {
+	create *CASBlobDeletionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CASBlobDeletion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(casblobdeletion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CASBlobDeletionUpsertBulk) UpdateNewValues() *CASBlobDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(casblobdeletion.FieldID)
+			}
+			if _, exists := b.mutation.Digest(); exists {
+				s.SetIgnore(casblobdeletion.FieldDigest)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(casblobdeletion.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CasBackendID(); exists {
+				s.SetIgnore(casblobdeletion.FieldCasBackendID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CASBlobDeletion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CASBlobDeletionUpsertBulk) Ignore() *CASBlobDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CASBlobDeletionUpsertBulk) DoNothing() *CASBlobDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CASBlobDeletionCreateBulk.OnConflict
+// documentation for more info.
+func (u *CASBlobDeletionUpsertBulk) Update(set func(*CASBlobDeletionUpsert)) *CASBlobDeletionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CASBlobDeletionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *CASBlobDeletionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CASBlobDeletionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CASBlobDeletionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CASBlobDeletionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
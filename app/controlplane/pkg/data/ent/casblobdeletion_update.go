@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// CASBlobDeletionUpdate is the builder for updating CASBlobDeletion entities.
+type CASBlobDeletionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CASBlobDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CASBlobDeletionUpdate builder.
+func (_u *CASBlobDeletionUpdate) Where(ps ...predicate.CASBlobDeletion) *CASBlobDeletionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the CASBlobDeletionMutation object of the builder.
+func (_u *CASBlobDeletionUpdate) Mutation() *CASBlobDeletionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CASBlobDeletionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CASBlobDeletionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CASBlobDeletionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CASBlobDeletionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CASBlobDeletionUpdate) check() error {
+	if _u.mutation.CasBackendCleared() && len(_u.mutation.CasBackendIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CASBlobDeletion.cas_backend"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CASBlobDeletionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CASBlobDeletionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CASBlobDeletionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(casblobdeletion.Table, casblobdeletion.Columns, sqlgraph.NewFieldSpec(casblobdeletion.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{casblobdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CASBlobDeletionUpdateOne is the builder for updating a single CASBlobDeletion entity.
+type CASBlobDeletionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CASBlobDeletionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the CASBlobDeletionMutation object of the builder.
+func (_u *CASBlobDeletionUpdateOne) Mutation() *CASBlobDeletionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CASBlobDeletionUpdate builder.
+func (_u *CASBlobDeletionUpdateOne) Where(ps ...predicate.CASBlobDeletion) *CASBlobDeletionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CASBlobDeletionUpdateOne) Select(field string, fields ...string) *CASBlobDeletionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CASBlobDeletion entity.
+func (_u *CASBlobDeletionUpdateOne) Save(ctx context.Context) (*CASBlobDeletion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CASBlobDeletionUpdateOne) SaveX(ctx context.Context) *CASBlobDeletion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CASBlobDeletionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CASBlobDeletionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CASBlobDeletionUpdateOne) check() error {
+	if _u.mutation.CasBackendCleared() && len(_u.mutation.CasBackendIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CASBlobDeletion.cas_backend"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CASBlobDeletionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CASBlobDeletionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CASBlobDeletionUpdateOne) sqlSave(ctx context.Context) (_node *CASBlobDeletion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(casblobdeletion.Table, casblobdeletion.Columns, sqlgraph.NewFieldSpec(casblobdeletion.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CASBlobDeletion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, casblobdeletion.FieldID)
+		for _, f := range fields {
+			if !casblobdeletion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != casblobdeletion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CASBlobDeletion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{casblobdeletion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/google/uuid"
+)
+
+// CASRetentionRule is the model entity for the CASRetentionRule schema.
+type CASRetentionRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// MaxAgeDays holds the value of the "max_age_days" field.
+	MaxAgeDays int `json:"max_age_days,omitempty"`
+	// KeepReleasedVersions holds the value of the "keep_released_versions" field.
+	KeepReleasedVersions bool `json:"keep_released_versions,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// LastSweptAt holds the value of the "last_swept_at" field.
+	LastSweptAt time.Time `json:"last_swept_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// CasBackendID holds the value of the "cas_backend_id" field.
+	CasBackendID uuid.UUID `json:"cas_backend_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CASRetentionRuleQuery when eager-loading is set.
+	Edges        CASRetentionRuleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CASRetentionRuleEdges holds the relations/edges for other nodes in the graph.
+type CASRetentionRuleEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// CasBackend holds the value of the cas_backend edge.
+	CasBackend *CASBackend `json:"cas_backend,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CASRetentionRuleEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// CasBackendOrErr returns the CasBackend value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CASRetentionRuleEdges) CasBackendOrErr() (*CASBackend, error) {
+	if e.CasBackend != nil {
+		return e.CasBackend, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: casbackend.Label}
+	}
+	return nil, &NotLoadedError{edge: "cas_backend"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CASRetentionRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case casretentionrule.FieldKeepReleasedVersions:
+			values[i] = new(sql.NullBool)
+		case casretentionrule.FieldMaxAgeDays:
+			values[i] = new(sql.NullInt64)
+		case casretentionrule.FieldCreatedAt, casretentionrule.FieldUpdatedAt, casretentionrule.FieldLastSweptAt:
+			values[i] = new(sql.NullTime)
+		case casretentionrule.FieldID, casretentionrule.FieldOrganizationID, casretentionrule.FieldCasBackendID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CASRetentionRule fields.
+func (_m *CASRetentionRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case casretentionrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case casretentionrule.FieldMaxAgeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_age_days", values[i])
+			} else if value.Valid {
+				_m.MaxAgeDays = int(value.Int64)
+			}
+		case casretentionrule.FieldKeepReleasedVersions:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field keep_released_versions", values[i])
+			} else if value.Valid {
+				_m.KeepReleasedVersions = value.Bool
+			}
+		case casretentionrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case casretentionrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case casretentionrule.FieldLastSweptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_swept_at", values[i])
+			} else if value.Valid {
+				_m.LastSweptAt = value.Time
+			}
+		case casretentionrule.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case casretentionrule.FieldCasBackendID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field cas_backend_id", values[i])
+			} else if value != nil {
+				_m.CasBackendID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CASRetentionRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *CASRetentionRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the CASRetentionRule entity.
+func (_m *CASRetentionRule) QueryOrganization() *OrganizationQuery {
+	return NewCASRetentionRuleClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryCasBackend queries the "cas_backend" edge of the CASRetentionRule entity.
+func (_m *CASRetentionRule) QueryCasBackend() *CASBackendQuery {
+	return NewCASRetentionRuleClient(_m.config).QueryCasBackend(_m)
+}
+
+// Update returns a builder for updating this CASRetentionRule.
+// Note that you need to call CASRetentionRule.Unwrap() before calling this method if this CASRetentionRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CASRetentionRule) Update() *CASRetentionRuleUpdateOne {
+	return NewCASRetentionRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CASRetentionRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CASRetentionRule) Unwrap() *CASRetentionRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CASRetentionRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CASRetentionRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("CASRetentionRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("max_age_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxAgeDays))
+	builder.WriteString(", ")
+	builder.WriteString("keep_released_versions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeepReleasedVersions))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_swept_at=")
+	builder.WriteString(_m.LastSweptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("cas_backend_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CasBackendID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CASRetentionRules is a parsable slice of CASRetentionRule.
+type CASRetentionRules []*CASRetentionRule
@@ -0,0 +1,160 @@
+// Code generated by ent, DO NOT EDIT.
+
+package casretentionrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the casretentionrule type in the database.
+	Label = "cas_retention_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMaxAgeDays holds the string denoting the max_age_days field in the database.
+	FieldMaxAgeDays = "max_age_days"
+	// FieldKeepReleasedVersions holds the string denoting the keep_released_versions field in the database.
+	FieldKeepReleasedVersions = "keep_released_versions"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldLastSweptAt holds the string denoting the last_swept_at field in the database.
+	FieldLastSweptAt = "last_swept_at"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldCasBackendID holds the string denoting the cas_backend_id field in the database.
+	FieldCasBackendID = "cas_backend_id"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeCasBackend holds the string denoting the cas_backend edge name in mutations.
+	EdgeCasBackend = "cas_backend"
+	// Table holds the table name of the casretentionrule in the database.
+	Table = "cas_retention_rules"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "cas_retention_rules"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// CasBackendTable is the table that holds the cas_backend relation/edge.
+	CasBackendTable = "cas_retention_rules"
+	// CasBackendInverseTable is the table name for the CASBackend entity.
+	// It exists in this package in order to avoid circular dependency with the "casbackend" package.
+	CasBackendInverseTable = "cas_backends"
+	// CasBackendColumn is the table column denoting the cas_backend relation/edge.
+	CasBackendColumn = "cas_backend_id"
+)
+
+// Columns holds all SQL columns for casretentionrule fields.
+var Columns = []string{
+	FieldID,
+	FieldMaxAgeDays,
+	FieldKeepReleasedVersions,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldLastSweptAt,
+	FieldOrganizationID,
+	FieldCasBackendID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MaxAgeDaysValidator is a validator for the "max_age_days" field. It is called by the builders before save.
+	MaxAgeDaysValidator func(int) error
+	// DefaultKeepReleasedVersions holds the default value on creation for the "keep_released_versions" field.
+	DefaultKeepReleasedVersions bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the CASRetentionRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMaxAgeDays orders the results by the max_age_days field.
+func ByMaxAgeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAgeDays, opts...).ToFunc()
+}
+
+// ByKeepReleasedVersions orders the results by the keep_released_versions field.
+func ByKeepReleasedVersions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeepReleasedVersions, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByLastSweptAt orders the results by the last_swept_at field.
+func ByLastSweptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSweptAt, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByCasBackendID orders the results by the cas_backend_id field.
+func ByCasBackendID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCasBackendID, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCasBackendField orders the results by cas_backend field.
+func ByCasBackendField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCasBackendStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+	)
+}
+func newCasBackendStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CasBackendInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, CasBackendTable, CasBackendColumn),
+	)
+}
@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package casretentionrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLTE(FieldID, id))
+}
+
+// MaxAgeDays applies equality check predicate on the "max_age_days" field. It's identical to MaxAgeDaysEQ.
+func MaxAgeDays(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldMaxAgeDays, v))
+}
+
+// KeepReleasedVersions applies equality check predicate on the "keep_released_versions" field. It's identical to KeepReleasedVersionsEQ.
+func KeepReleasedVersions(v bool) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldKeepReleasedVersions, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// LastSweptAt applies equality check predicate on the "last_swept_at" field. It's identical to LastSweptAtEQ.
+func LastSweptAt(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldLastSweptAt, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// CasBackendID applies equality check predicate on the "cas_backend_id" field. It's identical to CasBackendIDEQ.
+func CasBackendID(v uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldCasBackendID, v))
+}
+
+// MaxAgeDaysEQ applies the EQ predicate on the "max_age_days" field.
+func MaxAgeDaysEQ(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysNEQ applies the NEQ predicate on the "max_age_days" field.
+func MaxAgeDaysNEQ(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysIn applies the In predicate on the "max_age_days" field.
+func MaxAgeDaysIn(vs ...int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldMaxAgeDays, vs...))
+}
+
+// MaxAgeDaysNotIn applies the NotIn predicate on the "max_age_days" field.
+func MaxAgeDaysNotIn(vs ...int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldMaxAgeDays, vs...))
+}
+
+// MaxAgeDaysGT applies the GT predicate on the "max_age_days" field.
+func MaxAgeDaysGT(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGT(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysGTE applies the GTE predicate on the "max_age_days" field.
+func MaxAgeDaysGTE(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGTE(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysLT applies the LT predicate on the "max_age_days" field.
+func MaxAgeDaysLT(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLT(FieldMaxAgeDays, v))
+}
+
+// MaxAgeDaysLTE applies the LTE predicate on the "max_age_days" field.
+func MaxAgeDaysLTE(v int) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLTE(FieldMaxAgeDays, v))
+}
+
+// KeepReleasedVersionsEQ applies the EQ predicate on the "keep_released_versions" field.
+func KeepReleasedVersionsEQ(v bool) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldKeepReleasedVersions, v))
+}
+
+// KeepReleasedVersionsNEQ applies the NEQ predicate on the "keep_released_versions" field.
+func KeepReleasedVersionsNEQ(v bool) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldKeepReleasedVersions, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// LastSweptAtEQ applies the EQ predicate on the "last_swept_at" field.
+func LastSweptAtEQ(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldLastSweptAt, v))
+}
+
+// LastSweptAtNEQ applies the NEQ predicate on the "last_swept_at" field.
+func LastSweptAtNEQ(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldLastSweptAt, v))
+}
+
+// LastSweptAtIn applies the In predicate on the "last_swept_at" field.
+func LastSweptAtIn(vs ...time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldLastSweptAt, vs...))
+}
+
+// LastSweptAtNotIn applies the NotIn predicate on the "last_swept_at" field.
+func LastSweptAtNotIn(vs ...time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldLastSweptAt, vs...))
+}
+
+// LastSweptAtGT applies the GT predicate on the "last_swept_at" field.
+func LastSweptAtGT(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGT(FieldLastSweptAt, v))
+}
+
+// LastSweptAtGTE applies the GTE predicate on the "last_swept_at" field.
+func LastSweptAtGTE(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldGTE(FieldLastSweptAt, v))
+}
+
+// LastSweptAtLT applies the LT predicate on the "last_swept_at" field.
+func LastSweptAtLT(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLT(FieldLastSweptAt, v))
+}
+
+// LastSweptAtLTE applies the LTE predicate on the "last_swept_at" field.
+func LastSweptAtLTE(v time.Time) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldLTE(FieldLastSweptAt, v))
+}
+
+// LastSweptAtIsNil applies the IsNil predicate on the "last_swept_at" field.
+func LastSweptAtIsNil() predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIsNull(FieldLastSweptAt))
+}
+
+// LastSweptAtNotNil applies the NotNil predicate on the "last_swept_at" field.
+func LastSweptAtNotNil() predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotNull(FieldLastSweptAt))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// CasBackendIDEQ applies the EQ predicate on the "cas_backend_id" field.
+func CasBackendIDEQ(v uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldEQ(FieldCasBackendID, v))
+}
+
+// CasBackendIDNEQ applies the NEQ predicate on the "cas_backend_id" field.
+func CasBackendIDNEQ(v uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNEQ(FieldCasBackendID, v))
+}
+
+// CasBackendIDIn applies the In predicate on the "cas_backend_id" field.
+func CasBackendIDIn(vs ...uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIn(FieldCasBackendID, vs...))
+}
+
+// CasBackendIDNotIn applies the NotIn predicate on the "cas_backend_id" field.
+func CasBackendIDNotIn(vs ...uuid.UUID) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotIn(FieldCasBackendID, vs...))
+}
+
+// CasBackendIDIsNil applies the IsNil predicate on the "cas_backend_id" field.
+func CasBackendIDIsNil() predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldIsNull(FieldCasBackendID))
+}
+
+// CasBackendIDNotNil applies the NotNil predicate on the "cas_backend_id" field.
+func CasBackendIDNotNil() predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.FieldNotNull(FieldCasBackendID))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCasBackend applies the HasEdge predicate on the "cas_backend" edge.
+func HasCasBackend() predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, CasBackendTable, CasBackendColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCasBackendWith applies the HasEdge predicate on the "cas_backend" edge with a given conditions (other predicates).
+func HasCasBackendWith(preds ...predicate.CASBackend) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(func(s *sql.Selector) {
+		step := newCasBackendStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CASRetentionRule) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CASRetentionRule) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CASRetentionRule) predicate.CASRetentionRule {
+	return predicate.CASRetentionRule(sql.NotPredicates(p))
+}
@@ -0,0 +1,857 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/google/uuid"
+)
+
+// CASRetentionRuleCreate is the builder for creating a CASRetentionRule entity.
+type CASRetentionRuleCreate struct {
+	config
+	mutation *CASRetentionRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (_c *CASRetentionRuleCreate) SetMaxAgeDays(v int) *CASRetentionRuleCreate {
+	_c.mutation.SetMaxAgeDays(v)
+	return _c
+}
+
+// SetKeepReleasedVersions sets the "keep_released_versions" field.
+func (_c *CASRetentionRuleCreate) SetKeepReleasedVersions(v bool) *CASRetentionRuleCreate {
+	_c.mutation.SetKeepReleasedVersions(v)
+	return _c
+}
+
+// SetNillableKeepReleasedVersions sets the "keep_released_versions" field if the given value is not nil.
+func (_c *CASRetentionRuleCreate) SetNillableKeepReleasedVersions(v *bool) *CASRetentionRuleCreate {
+	if v != nil {
+		_c.SetKeepReleasedVersions(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CASRetentionRuleCreate) SetCreatedAt(v time.Time) *CASRetentionRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CASRetentionRuleCreate) SetNillableCreatedAt(v *time.Time) *CASRetentionRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CASRetentionRuleCreate) SetUpdatedAt(v time.Time) *CASRetentionRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CASRetentionRuleCreate) SetNillableUpdatedAt(v *time.Time) *CASRetentionRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetLastSweptAt sets the "last_swept_at" field.
+func (_c *CASRetentionRuleCreate) SetLastSweptAt(v time.Time) *CASRetentionRuleCreate {
+	_c.mutation.SetLastSweptAt(v)
+	return _c
+}
+
+// SetNillableLastSweptAt sets the "last_swept_at" field if the given value is not nil.
+func (_c *CASRetentionRuleCreate) SetNillableLastSweptAt(v *time.Time) *CASRetentionRuleCreate {
+	if v != nil {
+		_c.SetLastSweptAt(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *CASRetentionRuleCreate) SetOrganizationID(v uuid.UUID) *CASRetentionRuleCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetCasBackendID sets the "cas_backend_id" field.
+func (_c *CASRetentionRuleCreate) SetCasBackendID(v uuid.UUID) *CASRetentionRuleCreate {
+	_c.mutation.SetCasBackendID(v)
+	return _c
+}
+
+// SetNillableCasBackendID sets the "cas_backend_id" field if the given value is not nil.
+func (_c *CASRetentionRuleCreate) SetNillableCasBackendID(v *uuid.UUID) *CASRetentionRuleCreate {
+	if v != nil {
+		_c.SetCasBackendID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CASRetentionRuleCreate) SetID(v uuid.UUID) *CASRetentionRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CASRetentionRuleCreate) SetNillableID(v *uuid.UUID) *CASRetentionRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *CASRetentionRuleCreate) SetOrganization(v *Organization) *CASRetentionRuleCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetCasBackend sets the "cas_backend" edge to the CASBackend entity.
+func (_c *CASRetentionRuleCreate) SetCasBackend(v *CASBackend) *CASRetentionRuleCreate {
+	return _c.SetCasBackendID(v.ID)
+}
+
+// Mutation returns the CASRetentionRuleMutation object of the builder.
+func (_c *CASRetentionRuleCreate) Mutation() *CASRetentionRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the CASRetentionRule in the database.
+func (_c *CASRetentionRuleCreate) Save(ctx context.Context) (*CASRetentionRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CASRetentionRuleCreate) SaveX(ctx context.Context) *CASRetentionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CASRetentionRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CASRetentionRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CASRetentionRuleCreate) defaults() {
+	if _, ok := _c.mutation.KeepReleasedVersions(); !ok {
+		v := casretentionrule.DefaultKeepReleasedVersions
+		_c.mutation.SetKeepReleasedVersions(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := casretentionrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := casretentionrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := casretentionrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CASRetentionRuleCreate) check() error {
+	if _, ok := _c.mutation.MaxAgeDays(); !ok {
+		return &ValidationError{Name: "max_age_days", err: errors.New(`ent: missing required field "CASRetentionRule.max_age_days"`)}
+	}
+	if v, ok := _c.mutation.MaxAgeDays(); ok {
+		if err := casretentionrule.MaxAgeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "max_age_days", err: fmt.Errorf(`ent: validator failed for field "CASRetentionRule.max_age_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.KeepReleasedVersions(); !ok {
+		return &ValidationError{Name: "keep_released_versions", err: errors.New(`ent: missing required field "CASRetentionRule.keep_released_versions"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CASRetentionRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CASRetentionRule.updated_at"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "CASRetentionRule.organization_id"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "CASRetentionRule.organization"`)}
+	}
+	return nil
+}
+
+func (_c *CASRetentionRuleCreate) sqlSave(ctx context.Context) (*CASRetentionRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CASRetentionRuleCreate) createSpec() (*CASRetentionRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CASRetentionRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(casretentionrule.Table, sqlgraph.NewFieldSpec(casretentionrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.MaxAgeDays(); ok {
+		_spec.SetField(casretentionrule.FieldMaxAgeDays, field.TypeInt, value)
+		_node.MaxAgeDays = value
+	}
+	if value, ok := _c.mutation.KeepReleasedVersions(); ok {
+		_spec.SetField(casretentionrule.FieldKeepReleasedVersions, field.TypeBool, value)
+		_node.KeepReleasedVersions = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(casretentionrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(casretentionrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.LastSweptAt(); ok {
+		_spec.SetField(casretentionrule.FieldLastSweptAt, field.TypeTime, value)
+		_node.LastSweptAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   casretentionrule.OrganizationTable,
+			Columns: []string{casretentionrule.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CasBackendIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   casretentionrule.CasBackendTable,
+			Columns: []string{casretentionrule.CasBackendColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(casbackend.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CasBackendID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CASRetentionRule.Create().
+//		SetMaxAgeDays(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CASRetentionRuleUpsert) {
+//			SetMaxAgeDays(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CASRetentionRuleCreate) OnConflict(opts ...sql.ConflictOption) *CASRetentionRuleUpsertOne {
+	_c.conflict = opts
+	return &CASRetentionRuleUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CASRetentionRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CASRetentionRuleCreate) OnConflictColumns(columns ...string) *CASRetentionRuleUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CASRetentionRuleUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// CASRetentionRuleUpsertOne is the builder for "upsert"-ing
+	//  one CASRetentionRule node.
+	CASRetentionRuleUpsertOne struct {
+		create *CASRetentionRuleCreate
+	}
+
+	// CASRetentionRuleUpsert is the "OnConflict" setter.
+	CASRetentionRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *CASRetentionRuleUpsert) SetMaxAgeDays(v int) *CASRetentionRuleUpsert {
+	u.Set(casretentionrule.FieldMaxAgeDays, v)
+	return u
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsert) UpdateMaxAgeDays() *CASRetentionRuleUpsert {
+	u.SetExcluded(casretentionrule.FieldMaxAgeDays)
+	return u
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *CASRetentionRuleUpsert) AddMaxAgeDays(v int) *CASRetentionRuleUpsert {
+	u.Add(casretentionrule.FieldMaxAgeDays, v)
+	return u
+}
+
+// SetKeepReleasedVersions sets the "keep_released_versions" field.
+func (u *CASRetentionRuleUpsert) SetKeepReleasedVersions(v bool) *CASRetentionRuleUpsert {
+	u.Set(casretentionrule.FieldKeepReleasedVersions, v)
+	return u
+}
+
+// UpdateKeepReleasedVersions sets the "keep_released_versions" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsert) UpdateKeepReleasedVersions() *CASRetentionRuleUpsert {
+	u.SetExcluded(casretentionrule.FieldKeepReleasedVersions)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CASRetentionRuleUpsert) SetUpdatedAt(v time.Time) *CASRetentionRuleUpsert {
+	u.Set(casretentionrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsert) UpdateUpdatedAt() *CASRetentionRuleUpsert {
+	u.SetExcluded(casretentionrule.FieldUpdatedAt)
+	return u
+}
+
+// SetLastSweptAt sets the "last_swept_at" field.
+func (u *CASRetentionRuleUpsert) SetLastSweptAt(v time.Time) *CASRetentionRuleUpsert {
+	u.Set(casretentionrule.FieldLastSweptAt, v)
+	return u
+}
+
+// UpdateLastSweptAt sets the "last_swept_at" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsert) UpdateLastSweptAt() *CASRetentionRuleUpsert {
+	u.SetExcluded(casretentionrule.FieldLastSweptAt)
+	return u
+}
+
+// ClearLastSweptAt clears the value of the "last_swept_at" field.
+func (u *CASRetentionRuleUpsert) ClearLastSweptAt() *CASRetentionRuleUpsert {
+	u.SetNull(casretentionrule.FieldLastSweptAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.CASRetentionRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(casretentionrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CASRetentionRuleUpsertOne) UpdateNewValues() *CASRetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(casretentionrule.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(casretentionrule.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(casretentionrule.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.CasBackendID(); exists {
+			s.SetIgnore(casretentionrule.FieldCasBackendID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CASRetentionRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *CASRetentionRuleUpsertOne) Ignore() *CASRetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CASRetentionRuleUpsertOne) DoNothing() *CASRetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CASRetentionRuleCreate.OnConflict
+// documentation for more info.
+func (u *CASRetentionRuleUpsertOne) Update(set func(*CASRetentionRuleUpsert)) *CASRetentionRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CASRetentionRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *CASRetentionRuleUpsertOne) SetMaxAgeDays(v int) *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetMaxAgeDays(v)
+	})
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *CASRetentionRuleUpsertOne) AddMaxAgeDays(v int) *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.AddMaxAgeDays(v)
+	})
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertOne) UpdateMaxAgeDays() *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateMaxAgeDays()
+	})
+}
+
+// SetKeepReleasedVersions sets the "keep_released_versions" field.
+func (u *CASRetentionRuleUpsertOne) SetKeepReleasedVersions(v bool) *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetKeepReleasedVersions(v)
+	})
+}
+
+// UpdateKeepReleasedVersions sets the "keep_released_versions" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertOne) UpdateKeepReleasedVersions() *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateKeepReleasedVersions()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CASRetentionRuleUpsertOne) SetUpdatedAt(v time.Time) *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertOne) UpdateUpdatedAt() *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastSweptAt sets the "last_swept_at" field.
+func (u *CASRetentionRuleUpsertOne) SetLastSweptAt(v time.Time) *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetLastSweptAt(v)
+	})
+}
+
+// UpdateLastSweptAt sets the "last_swept_at" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertOne) UpdateLastSweptAt() *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateLastSweptAt()
+	})
+}
+
+// ClearLastSweptAt clears the value of the "last_swept_at" field.
+func (u *CASRetentionRuleUpsertOne) ClearLastSweptAt() *CASRetentionRuleUpsertOne {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.ClearLastSweptAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CASRetentionRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CASRetentionRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CASRetentionRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *CASRetentionRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: CASRetentionRuleUpsertOne.ID is not supported by MySQL driver. Use CASRetentionRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *CASRetentionRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// CASRetentionRuleCreateBulk is the builder for creating many CASRetentionRule entities in bulk.
+type CASRetentionRuleCreateBulk struct {
+	config
+	err      error
+	builders []*CASRetentionRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the CASRetentionRule entities in the database.
+func (_c *CASRetentionRuleCreateBulk) Save(ctx context.Context) ([]*CASRetentionRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CASRetentionRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CASRetentionRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CASRetentionRuleCreateBulk) SaveX(ctx context.Context) []*CASRetentionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CASRetentionRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CASRetentionRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.CASRetentionRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.CASRetentionRuleUpsert) {
+//			SetMaxAgeDays(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *CASRetentionRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *CASRetentionRuleUpsertBulk {
+	_c.conflict = opts
+	return &CASRetentionRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.CASRetentionRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *CASRetentionRuleCreateBulk) OnConflictColumns(columns ...string) *CASRetentionRuleUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &CASRetentionRuleUpsertBulk{
+		create: _c,
+	}
+}
+
+// CASRetentionRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of CASRetentionRule nodes.
+type CASRetentionRuleUpsertBulk struct {
+	create *CASRetentionRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.CASRetentionRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(casretentionrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *CASRetentionRuleUpsertBulk) UpdateNewValues() *CASRetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(casretentionrule.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(casretentionrule.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(casretentionrule.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.CasBackendID(); exists {
+				s.SetIgnore(casretentionrule.FieldCasBackendID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.CASRetentionRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *CASRetentionRuleUpsertBulk) Ignore() *CASRetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *CASRetentionRuleUpsertBulk) DoNothing() *CASRetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the CASRetentionRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *CASRetentionRuleUpsertBulk) Update(set func(*CASRetentionRuleUpsert)) *CASRetentionRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&CASRetentionRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (u *CASRetentionRuleUpsertBulk) SetMaxAgeDays(v int) *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetMaxAgeDays(v)
+	})
+}
+
+// AddMaxAgeDays adds v to the "max_age_days" field.
+func (u *CASRetentionRuleUpsertBulk) AddMaxAgeDays(v int) *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.AddMaxAgeDays(v)
+	})
+}
+
+// UpdateMaxAgeDays sets the "max_age_days" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertBulk) UpdateMaxAgeDays() *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateMaxAgeDays()
+	})
+}
+
+// SetKeepReleasedVersions sets the "keep_released_versions" field.
+func (u *CASRetentionRuleUpsertBulk) SetKeepReleasedVersions(v bool) *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetKeepReleasedVersions(v)
+	})
+}
+
+// UpdateKeepReleasedVersions sets the "keep_released_versions" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertBulk) UpdateKeepReleasedVersions() *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateKeepReleasedVersions()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *CASRetentionRuleUpsertBulk) SetUpdatedAt(v time.Time) *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertBulk) UpdateUpdatedAt() *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetLastSweptAt sets the "last_swept_at" field.
+func (u *CASRetentionRuleUpsertBulk) SetLastSweptAt(v time.Time) *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.SetLastSweptAt(v)
+	})
+}
+
+// UpdateLastSweptAt sets the "last_swept_at" field to the value that was provided on create.
+func (u *CASRetentionRuleUpsertBulk) UpdateLastSweptAt() *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.UpdateLastSweptAt()
+	})
+}
+
+// ClearLastSweptAt clears the value of the "last_swept_at" field.
+func (u *CASRetentionRuleUpsertBulk) ClearLastSweptAt() *CASRetentionRuleUpsertBulk {
+	return u.Update(func(s *CASRetentionRuleUpsert) {
+		s.ClearLastSweptAt()
+	})
+}
+
+// Exec executes the query.
+func (u *CASRetentionRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the CASRetentionRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for CASRetentionRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *CASRetentionRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
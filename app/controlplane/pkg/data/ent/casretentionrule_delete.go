@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// CASRetentionRuleDelete is the builder for deleting a CASRetentionRule entity.
+type CASRetentionRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *CASRetentionRuleMutation
+}
+
+// Where appends a list predicates to the CASRetentionRuleDelete builder.
+func (_d *CASRetentionRuleDelete) Where(ps ...predicate.CASRetentionRule) *CASRetentionRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CASRetentionRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CASRetentionRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CASRetentionRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(casretentionrule.Table, sqlgraph.NewFieldSpec(casretentionrule.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CASRetentionRuleDeleteOne is the builder for deleting a single CASRetentionRule entity.
+type CASRetentionRuleDeleteOne struct {
+	_d *CASRetentionRuleDelete
+}
+
+// Where appends a list predicates to the CASRetentionRuleDelete builder.
+func (_d *CASRetentionRuleDeleteOne) Where(ps ...predicate.CASRetentionRule) *CASRetentionRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CASRetentionRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{casretentionrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CASRetentionRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
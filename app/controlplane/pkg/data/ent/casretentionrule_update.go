@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// CASRetentionRuleUpdate is the builder for updating CASRetentionRule entities.
+type CASRetentionRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *CASRetentionRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the CASRetentionRuleUpdate builder.
+func (_u *CASRetentionRuleUpdate) Where(ps ...predicate.CASRetentionRule) *CASRetentionRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (_u *CASRetentionRuleUpdate) SetMaxAgeDays(v int) *CASRetentionRuleUpdate {
+	_u.mutation.ResetMaxAgeDays()
+	_u.mutation.SetMaxAgeDays(v)
+	return _u
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (_u *CASRetentionRuleUpdate) SetNillableMaxAgeDays(v *int) *CASRetentionRuleUpdate {
+	if v != nil {
+		_u.SetMaxAgeDays(*v)
+	}
+	return _u
+}
+
+// AddMaxAgeDays adds value to the "max_age_days" field.
+func (_u *CASRetentionRuleUpdate) AddMaxAgeDays(v int) *CASRetentionRuleUpdate {
+	_u.mutation.AddMaxAgeDays(v)
+	return _u
+}
+
+// SetKeepReleasedVersions sets the "keep_released_versions" field.
+func (_u *CASRetentionRuleUpdate) SetKeepReleasedVersions(v bool) *CASRetentionRuleUpdate {
+	_u.mutation.SetKeepReleasedVersions(v)
+	return _u
+}
+
+// SetNillableKeepReleasedVersions sets the "keep_released_versions" field if the given value is not nil.
+func (_u *CASRetentionRuleUpdate) SetNillableKeepReleasedVersions(v *bool) *CASRetentionRuleUpdate {
+	if v != nil {
+		_u.SetKeepReleasedVersions(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CASRetentionRuleUpdate) SetUpdatedAt(v time.Time) *CASRetentionRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLastSweptAt sets the "last_swept_at" field.
+func (_u *CASRetentionRuleUpdate) SetLastSweptAt(v time.Time) *CASRetentionRuleUpdate {
+	_u.mutation.SetLastSweptAt(v)
+	return _u
+}
+
+// SetNillableLastSweptAt sets the "last_swept_at" field if the given value is not nil.
+func (_u *CASRetentionRuleUpdate) SetNillableLastSweptAt(v *time.Time) *CASRetentionRuleUpdate {
+	if v != nil {
+		_u.SetLastSweptAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSweptAt clears the value of the "last_swept_at" field.
+func (_u *CASRetentionRuleUpdate) ClearLastSweptAt() *CASRetentionRuleUpdate {
+	_u.mutation.ClearLastSweptAt()
+	return _u
+}
+
+// Mutation returns the CASRetentionRuleMutation object of the builder.
+func (_u *CASRetentionRuleUpdate) Mutation() *CASRetentionRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CASRetentionRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CASRetentionRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CASRetentionRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CASRetentionRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CASRetentionRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := casretentionrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CASRetentionRuleUpdate) check() error {
+	if v, ok := _u.mutation.MaxAgeDays(); ok {
+		if err := casretentionrule.MaxAgeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "max_age_days", err: fmt.Errorf(`ent: validator failed for field "CASRetentionRule.max_age_days": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CASRetentionRule.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CASRetentionRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CASRetentionRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CASRetentionRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(casretentionrule.Table, casretentionrule.Columns, sqlgraph.NewFieldSpec(casretentionrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MaxAgeDays(); ok {
+		_spec.SetField(casretentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAgeDays(); ok {
+		_spec.AddField(casretentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepReleasedVersions(); ok {
+		_spec.SetField(casretentionrule.FieldKeepReleasedVersions, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(casretentionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSweptAt(); ok {
+		_spec.SetField(casretentionrule.FieldLastSweptAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSweptAtCleared() {
+		_spec.ClearField(casretentionrule.FieldLastSweptAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{casretentionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CASRetentionRuleUpdateOne is the builder for updating a single CASRetentionRule entity.
+type CASRetentionRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *CASRetentionRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetMaxAgeDays sets the "max_age_days" field.
+func (_u *CASRetentionRuleUpdateOne) SetMaxAgeDays(v int) *CASRetentionRuleUpdateOne {
+	_u.mutation.ResetMaxAgeDays()
+	_u.mutation.SetMaxAgeDays(v)
+	return _u
+}
+
+// SetNillableMaxAgeDays sets the "max_age_days" field if the given value is not nil.
+func (_u *CASRetentionRuleUpdateOne) SetNillableMaxAgeDays(v *int) *CASRetentionRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxAgeDays(*v)
+	}
+	return _u
+}
+
+// AddMaxAgeDays adds value to the "max_age_days" field.
+func (_u *CASRetentionRuleUpdateOne) AddMaxAgeDays(v int) *CASRetentionRuleUpdateOne {
+	_u.mutation.AddMaxAgeDays(v)
+	return _u
+}
+
+// SetKeepReleasedVersions sets the "keep_released_versions" field.
+func (_u *CASRetentionRuleUpdateOne) SetKeepReleasedVersions(v bool) *CASRetentionRuleUpdateOne {
+	_u.mutation.SetKeepReleasedVersions(v)
+	return _u
+}
+
+// SetNillableKeepReleasedVersions sets the "keep_released_versions" field if the given value is not nil.
+func (_u *CASRetentionRuleUpdateOne) SetNillableKeepReleasedVersions(v *bool) *CASRetentionRuleUpdateOne {
+	if v != nil {
+		_u.SetKeepReleasedVersions(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CASRetentionRuleUpdateOne) SetUpdatedAt(v time.Time) *CASRetentionRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLastSweptAt sets the "last_swept_at" field.
+func (_u *CASRetentionRuleUpdateOne) SetLastSweptAt(v time.Time) *CASRetentionRuleUpdateOne {
+	_u.mutation.SetLastSweptAt(v)
+	return _u
+}
+
+// SetNillableLastSweptAt sets the "last_swept_at" field if the given value is not nil.
+func (_u *CASRetentionRuleUpdateOne) SetNillableLastSweptAt(v *time.Time) *CASRetentionRuleUpdateOne {
+	if v != nil {
+		_u.SetLastSweptAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSweptAt clears the value of the "last_swept_at" field.
+func (_u *CASRetentionRuleUpdateOne) ClearLastSweptAt() *CASRetentionRuleUpdateOne {
+	_u.mutation.ClearLastSweptAt()
+	return _u
+}
+
+// Mutation returns the CASRetentionRuleMutation object of the builder.
+func (_u *CASRetentionRuleUpdateOne) Mutation() *CASRetentionRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CASRetentionRuleUpdate builder.
+func (_u *CASRetentionRuleUpdateOne) Where(ps ...predicate.CASRetentionRule) *CASRetentionRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CASRetentionRuleUpdateOne) Select(field string, fields ...string) *CASRetentionRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CASRetentionRule entity.
+func (_u *CASRetentionRuleUpdateOne) Save(ctx context.Context) (*CASRetentionRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CASRetentionRuleUpdateOne) SaveX(ctx context.Context) *CASRetentionRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CASRetentionRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CASRetentionRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CASRetentionRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := casretentionrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CASRetentionRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxAgeDays(); ok {
+		if err := casretentionrule.MaxAgeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "max_age_days", err: fmt.Errorf(`ent: validator failed for field "CASRetentionRule.max_age_days": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CASRetentionRule.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CASRetentionRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CASRetentionRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *CASRetentionRuleUpdateOne) sqlSave(ctx context.Context) (_node *CASRetentionRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(casretentionrule.Table, casretentionrule.Columns, sqlgraph.NewFieldSpec(casretentionrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CASRetentionRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, casretentionrule.FieldID)
+		for _, f := range fields {
+			if !casretentionrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != casretentionrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MaxAgeDays(); ok {
+		_spec.SetField(casretentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAgeDays(); ok {
+		_spec.AddField(casretentionrule.FieldMaxAgeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.KeepReleasedVersions(); ok {
+		_spec.SetField(casretentionrule.FieldKeepReleasedVersions, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(casretentionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LastSweptAt(); ok {
+		_spec.SetField(casretentionrule.FieldLastSweptAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSweptAtCleared() {
+		_spec.ClearField(casretentionrule.FieldLastSweptAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &CASRetentionRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{casretentionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -19,14 +19,21 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/apitoken"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/attestation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casmapping"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/group"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/groupmembership"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integration"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationattachment"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/membership"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/orginvitation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/referrer"
@@ -36,6 +43,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontract"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontractversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 )
 
 // Client is the client that holds all ent builders.
@@ -49,8 +57,12 @@ type Client struct {
 	Attestation *AttestationClient
 	// CASBackend is the client for interacting with the CASBackend builders.
 	CASBackend *CASBackendClient
+	// CASBlobDeletion is the client for interacting with the CASBlobDeletion builders.
+	CASBlobDeletion *CASBlobDeletionClient
 	// CASMapping is the client for interacting with the CASMapping builders.
 	CASMapping *CASMappingClient
+	// CASRetentionRule is the client for interacting with the CASRetentionRule builders.
+	CASRetentionRule *CASRetentionRuleClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
 	// GroupMembership is the client for interacting with the GroupMembership builders.
@@ -59,12 +71,22 @@ type Client struct {
 	Integration *IntegrationClient
 	// IntegrationAttachment is the client for interacting with the IntegrationAttachment builders.
 	IntegrationAttachment *IntegrationAttachmentClient
+	// IntegrationDelivery is the client for interacting with the IntegrationDelivery builders.
+	IntegrationDelivery *IntegrationDeliveryClient
 	// Membership is the client for interacting with the Membership builders.
 	Membership *MembershipClient
 	// OrgInvitation is the client for interacting with the OrgInvitation builders.
 	OrgInvitation *OrgInvitationClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// PolicyEvaluation is the client for interacting with the PolicyEvaluation builders.
+	PolicyEvaluation *PolicyEvaluationClient
+	// PolicyException is the client for interacting with the PolicyException builders.
+	PolicyException *PolicyExceptionClient
+	// PolicyImpactAnalysis is the client for interacting with the PolicyImpactAnalysis builders.
+	PolicyImpactAnalysis *PolicyImpactAnalysisClient
+	// PolicyViolation is the client for interacting with the PolicyViolation builders.
+	PolicyViolation *PolicyViolationClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
 	// ProjectVersion is the client for interacting with the ProjectVersion builders.
@@ -83,6 +105,8 @@ type Client struct {
 	WorkflowContractVersion *WorkflowContractVersionClient
 	// WorkflowRun is the client for interacting with the WorkflowRun builders.
 	WorkflowRun *WorkflowRunClient
+	// WorkflowRunSearchEntry is the client for interacting with the WorkflowRunSearchEntry builders.
+	WorkflowRunSearchEntry *WorkflowRunSearchEntryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -97,14 +121,21 @@ func (c *Client) init() {
 	c.APIToken = NewAPITokenClient(c.config)
 	c.Attestation = NewAttestationClient(c.config)
 	c.CASBackend = NewCASBackendClient(c.config)
+	c.CASBlobDeletion = NewCASBlobDeletionClient(c.config)
 	c.CASMapping = NewCASMappingClient(c.config)
+	c.CASRetentionRule = NewCASRetentionRuleClient(c.config)
 	c.Group = NewGroupClient(c.config)
 	c.GroupMembership = NewGroupMembershipClient(c.config)
 	c.Integration = NewIntegrationClient(c.config)
 	c.IntegrationAttachment = NewIntegrationAttachmentClient(c.config)
+	c.IntegrationDelivery = NewIntegrationDeliveryClient(c.config)
 	c.Membership = NewMembershipClient(c.config)
 	c.OrgInvitation = NewOrgInvitationClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
+	c.PolicyEvaluation = NewPolicyEvaluationClient(c.config)
+	c.PolicyException = NewPolicyExceptionClient(c.config)
+	c.PolicyImpactAnalysis = NewPolicyImpactAnalysisClient(c.config)
+	c.PolicyViolation = NewPolicyViolationClient(c.config)
 	c.Project = NewProjectClient(c.config)
 	c.ProjectVersion = NewProjectVersionClient(c.config)
 	c.Referrer = NewReferrerClient(c.config)
@@ -114,6 +145,7 @@ func (c *Client) init() {
 	c.WorkflowContract = NewWorkflowContractClient(c.config)
 	c.WorkflowContractVersion = NewWorkflowContractVersionClient(c.config)
 	c.WorkflowRun = NewWorkflowRunClient(c.config)
+	c.WorkflowRunSearchEntry = NewWorkflowRunSearchEntryClient(c.config)
 }
 
 type (
@@ -209,14 +241,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		APIToken:                NewAPITokenClient(cfg),
 		Attestation:             NewAttestationClient(cfg),
 		CASBackend:              NewCASBackendClient(cfg),
+		CASBlobDeletion:         NewCASBlobDeletionClient(cfg),
 		CASMapping:              NewCASMappingClient(cfg),
+		CASRetentionRule:        NewCASRetentionRuleClient(cfg),
 		Group:                   NewGroupClient(cfg),
 		GroupMembership:         NewGroupMembershipClient(cfg),
 		Integration:             NewIntegrationClient(cfg),
 		IntegrationAttachment:   NewIntegrationAttachmentClient(cfg),
+		IntegrationDelivery:     NewIntegrationDeliveryClient(cfg),
 		Membership:              NewMembershipClient(cfg),
 		OrgInvitation:           NewOrgInvitationClient(cfg),
 		Organization:            NewOrganizationClient(cfg),
+		PolicyEvaluation:        NewPolicyEvaluationClient(cfg),
+		PolicyException:         NewPolicyExceptionClient(cfg),
+		PolicyImpactAnalysis:    NewPolicyImpactAnalysisClient(cfg),
+		PolicyViolation:         NewPolicyViolationClient(cfg),
 		Project:                 NewProjectClient(cfg),
 		ProjectVersion:          NewProjectVersionClient(cfg),
 		Referrer:                NewReferrerClient(cfg),
@@ -226,6 +265,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		WorkflowContract:        NewWorkflowContractClient(cfg),
 		WorkflowContractVersion: NewWorkflowContractVersionClient(cfg),
 		WorkflowRun:             NewWorkflowRunClient(cfg),
+		WorkflowRunSearchEntry:  NewWorkflowRunSearchEntryClient(cfg),
 	}, nil
 }
 
@@ -248,14 +288,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		APIToken:                NewAPITokenClient(cfg),
 		Attestation:             NewAttestationClient(cfg),
 		CASBackend:              NewCASBackendClient(cfg),
+		CASBlobDeletion:         NewCASBlobDeletionClient(cfg),
 		CASMapping:              NewCASMappingClient(cfg),
+		CASRetentionRule:        NewCASRetentionRuleClient(cfg),
 		Group:                   NewGroupClient(cfg),
 		GroupMembership:         NewGroupMembershipClient(cfg),
 		Integration:             NewIntegrationClient(cfg),
 		IntegrationAttachment:   NewIntegrationAttachmentClient(cfg),
+		IntegrationDelivery:     NewIntegrationDeliveryClient(cfg),
 		Membership:              NewMembershipClient(cfg),
 		OrgInvitation:           NewOrgInvitationClient(cfg),
 		Organization:            NewOrganizationClient(cfg),
+		PolicyEvaluation:        NewPolicyEvaluationClient(cfg),
+		PolicyException:         NewPolicyExceptionClient(cfg),
+		PolicyImpactAnalysis:    NewPolicyImpactAnalysisClient(cfg),
+		PolicyViolation:         NewPolicyViolationClient(cfg),
 		Project:                 NewProjectClient(cfg),
 		ProjectVersion:          NewProjectVersionClient(cfg),
 		Referrer:                NewReferrerClient(cfg),
@@ -265,6 +312,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		WorkflowContract:        NewWorkflowContractClient(cfg),
 		WorkflowContractVersion: NewWorkflowContractVersionClient(cfg),
 		WorkflowRun:             NewWorkflowRunClient(cfg),
+		WorkflowRunSearchEntry:  NewWorkflowRunSearchEntryClient(cfg),
 	}, nil
 }
 
@@ -294,11 +342,13 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.APIToken, c.Attestation, c.CASBackend, c.CASMapping, c.Group,
-		c.GroupMembership, c.Integration, c.IntegrationAttachment, c.Membership,
-		c.OrgInvitation, c.Organization, c.Project, c.ProjectVersion, c.Referrer,
-		c.RobotAccount, c.User, c.Workflow, c.WorkflowContract,
-		c.WorkflowContractVersion, c.WorkflowRun,
+		c.APIToken, c.Attestation, c.CASBackend, c.CASBlobDeletion, c.CASMapping,
+		c.CASRetentionRule, c.Group, c.GroupMembership, c.Integration,
+		c.IntegrationAttachment, c.IntegrationDelivery, c.Membership, c.OrgInvitation,
+		c.Organization, c.PolicyEvaluation, c.PolicyException, c.PolicyImpactAnalysis,
+		c.PolicyViolation, c.Project, c.ProjectVersion, c.Referrer, c.RobotAccount,
+		c.User, c.Workflow, c.WorkflowContract, c.WorkflowContractVersion,
+		c.WorkflowRun, c.WorkflowRunSearchEntry,
 	} {
 		n.Use(hooks...)
 	}
@@ -308,11 +358,13 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.APIToken, c.Attestation, c.CASBackend, c.CASMapping, c.Group,
-		c.GroupMembership, c.Integration, c.IntegrationAttachment, c.Membership,
-		c.OrgInvitation, c.Organization, c.Project, c.ProjectVersion, c.Referrer,
-		c.RobotAccount, c.User, c.Workflow, c.WorkflowContract,
-		c.WorkflowContractVersion, c.WorkflowRun,
+		c.APIToken, c.Attestation, c.CASBackend, c.CASBlobDeletion, c.CASMapping,
+		c.CASRetentionRule, c.Group, c.GroupMembership, c.Integration,
+		c.IntegrationAttachment, c.IntegrationDelivery, c.Membership, c.OrgInvitation,
+		c.Organization, c.PolicyEvaluation, c.PolicyException, c.PolicyImpactAnalysis,
+		c.PolicyViolation, c.Project, c.ProjectVersion, c.Referrer, c.RobotAccount,
+		c.User, c.Workflow, c.WorkflowContract, c.WorkflowContractVersion,
+		c.WorkflowRun, c.WorkflowRunSearchEntry,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -327,8 +379,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Attestation.mutate(ctx, m)
 	case *CASBackendMutation:
 		return c.CASBackend.mutate(ctx, m)
+	case *CASBlobDeletionMutation:
+		return c.CASBlobDeletion.mutate(ctx, m)
 	case *CASMappingMutation:
 		return c.CASMapping.mutate(ctx, m)
+	case *CASRetentionRuleMutation:
+		return c.CASRetentionRule.mutate(ctx, m)
 	case *GroupMutation:
 		return c.Group.mutate(ctx, m)
 	case *GroupMembershipMutation:
@@ -337,12 +393,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Integration.mutate(ctx, m)
 	case *IntegrationAttachmentMutation:
 		return c.IntegrationAttachment.mutate(ctx, m)
+	case *IntegrationDeliveryMutation:
+		return c.IntegrationDelivery.mutate(ctx, m)
 	case *MembershipMutation:
 		return c.Membership.mutate(ctx, m)
 	case *OrgInvitationMutation:
 		return c.OrgInvitation.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
+	case *PolicyEvaluationMutation:
+		return c.PolicyEvaluation.mutate(ctx, m)
+	case *PolicyExceptionMutation:
+		return c.PolicyException.mutate(ctx, m)
+	case *PolicyImpactAnalysisMutation:
+		return c.PolicyImpactAnalysis.mutate(ctx, m)
+	case *PolicyViolationMutation:
+		return c.PolicyViolation.mutate(ctx, m)
 	case *ProjectMutation:
 		return c.Project.mutate(ctx, m)
 	case *ProjectVersionMutation:
@@ -361,6 +427,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.WorkflowContractVersion.mutate(ctx, m)
 	case *WorkflowRunMutation:
 		return c.WorkflowRun.mutate(ctx, m)
+	case *WorkflowRunSearchEntryMutation:
+		return c.WorkflowRunSearchEntry.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -836,6 +904,38 @@ func (c *CASBackendClient) QueryWorkflowRun(_m *CASBackend) *WorkflowRunQuery {
 	return query
 }
 
+// QueryPrimary queries the primary edge of a CASBackend.
+func (c *CASBackendClient) QueryPrimary(_m *CASBackend) *CASBackendQuery {
+	query := (&CASBackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casbackend.Table, casbackend.FieldID, id),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, casbackend.PrimaryTable, casbackend.PrimaryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplicas queries the replicas edge of a CASBackend.
+func (c *CASBackendClient) QueryReplicas(_m *CASBackend) *CASBackendQuery {
+	query := (&CASBackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casbackend.Table, casbackend.FieldID, id),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, casbackend.ReplicasTable, casbackend.ReplicasColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CASBackendClient) Hooks() []Hook {
 	return c.hooks.CASBackend
@@ -861,6 +961,155 @@ func (c *CASBackendClient) mutate(ctx context.Context, m *CASBackendMutation) (V
 	}
 }
 
+// CASBlobDeletionClient is a client for the CASBlobDeletion schema.
+type CASBlobDeletionClient struct {
+	config
+}
+
+// NewCASBlobDeletionClient returns a client for the CASBlobDeletion from the given config.
+func NewCASBlobDeletionClient(c config) *CASBlobDeletionClient {
+	return &CASBlobDeletionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `casblobdeletion.Hooks(f(g(h())))`.
+func (c *CASBlobDeletionClient) Use(hooks ...Hook) {
+	c.hooks.CASBlobDeletion = append(c.hooks.CASBlobDeletion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `casblobdeletion.Intercept(f(g(h())))`.
+func (c *CASBlobDeletionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CASBlobDeletion = append(c.inters.CASBlobDeletion, interceptors...)
+}
+
+// Create returns a builder for creating a CASBlobDeletion entity.
+func (c *CASBlobDeletionClient) Create() *CASBlobDeletionCreate {
+	mutation := newCASBlobDeletionMutation(c.config, OpCreate)
+	return &CASBlobDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CASBlobDeletion entities.
+func (c *CASBlobDeletionClient) CreateBulk(builders ...*CASBlobDeletionCreate) *CASBlobDeletionCreateBulk {
+	return &CASBlobDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CASBlobDeletionClient) MapCreateBulk(slice any, setFunc func(*CASBlobDeletionCreate, int)) *CASBlobDeletionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CASBlobDeletionCreateBulk{err: fmt.Errorf("calling to CASBlobDeletionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CASBlobDeletionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CASBlobDeletionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CASBlobDeletion.
+func (c *CASBlobDeletionClient) Update() *CASBlobDeletionUpdate {
+	mutation := newCASBlobDeletionMutation(c.config, OpUpdate)
+	return &CASBlobDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CASBlobDeletionClient) UpdateOne(_m *CASBlobDeletion) *CASBlobDeletionUpdateOne {
+	mutation := newCASBlobDeletionMutation(c.config, OpUpdateOne, withCASBlobDeletion(_m))
+	return &CASBlobDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CASBlobDeletionClient) UpdateOneID(id uuid.UUID) *CASBlobDeletionUpdateOne {
+	mutation := newCASBlobDeletionMutation(c.config, OpUpdateOne, withCASBlobDeletionID(id))
+	return &CASBlobDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CASBlobDeletion.
+func (c *CASBlobDeletionClient) Delete() *CASBlobDeletionDelete {
+	mutation := newCASBlobDeletionMutation(c.config, OpDelete)
+	return &CASBlobDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CASBlobDeletionClient) DeleteOne(_m *CASBlobDeletion) *CASBlobDeletionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CASBlobDeletionClient) DeleteOneID(id uuid.UUID) *CASBlobDeletionDeleteOne {
+	builder := c.Delete().Where(casblobdeletion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CASBlobDeletionDeleteOne{builder}
+}
+
+// Query returns a query builder for CASBlobDeletion.
+func (c *CASBlobDeletionClient) Query() *CASBlobDeletionQuery {
+	return &CASBlobDeletionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCASBlobDeletion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CASBlobDeletion entity by its id.
+func (c *CASBlobDeletionClient) Get(ctx context.Context, id uuid.UUID) (*CASBlobDeletion, error) {
+	return c.Query().Where(casblobdeletion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CASBlobDeletionClient) GetX(ctx context.Context, id uuid.UUID) *CASBlobDeletion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCasBackend queries the cas_backend edge of a CASBlobDeletion.
+func (c *CASBlobDeletionClient) QueryCasBackend(_m *CASBlobDeletion) *CASBackendQuery {
+	query := (&CASBackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casblobdeletion.Table, casblobdeletion.FieldID, id),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, casblobdeletion.CasBackendTable, casblobdeletion.CasBackendColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CASBlobDeletionClient) Hooks() []Hook {
+	return c.hooks.CASBlobDeletion
+}
+
+// Interceptors returns the client interceptors.
+func (c *CASBlobDeletionClient) Interceptors() []Interceptor {
+	return c.inters.CASBlobDeletion
+}
+
+func (c *CASBlobDeletionClient) mutate(ctx context.Context, m *CASBlobDeletionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CASBlobDeletionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CASBlobDeletionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CASBlobDeletionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CASBlobDeletionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CASBlobDeletion mutation op: %q", m.Op())
+	}
+}
+
 // CASMappingClient is a client for the CASMapping schema.
 type CASMappingClient struct {
 	config
@@ -1042,6 +1291,171 @@ func (c *CASMappingClient) mutate(ctx context.Context, m *CASMappingMutation) (V
 	}
 }
 
+// CASRetentionRuleClient is a client for the CASRetentionRule schema.
+type CASRetentionRuleClient struct {
+	config
+}
+
+// NewCASRetentionRuleClient returns a client for the CASRetentionRule from the given config.
+func NewCASRetentionRuleClient(c config) *CASRetentionRuleClient {
+	return &CASRetentionRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `casretentionrule.Hooks(f(g(h())))`.
+func (c *CASRetentionRuleClient) Use(hooks ...Hook) {
+	c.hooks.CASRetentionRule = append(c.hooks.CASRetentionRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `casretentionrule.Intercept(f(g(h())))`.
+func (c *CASRetentionRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CASRetentionRule = append(c.inters.CASRetentionRule, interceptors...)
+}
+
+// Create returns a builder for creating a CASRetentionRule entity.
+func (c *CASRetentionRuleClient) Create() *CASRetentionRuleCreate {
+	mutation := newCASRetentionRuleMutation(c.config, OpCreate)
+	return &CASRetentionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CASRetentionRule entities.
+func (c *CASRetentionRuleClient) CreateBulk(builders ...*CASRetentionRuleCreate) *CASRetentionRuleCreateBulk {
+	return &CASRetentionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CASRetentionRuleClient) MapCreateBulk(slice any, setFunc func(*CASRetentionRuleCreate, int)) *CASRetentionRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CASRetentionRuleCreateBulk{err: fmt.Errorf("calling to CASRetentionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CASRetentionRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CASRetentionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CASRetentionRule.
+func (c *CASRetentionRuleClient) Update() *CASRetentionRuleUpdate {
+	mutation := newCASRetentionRuleMutation(c.config, OpUpdate)
+	return &CASRetentionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CASRetentionRuleClient) UpdateOne(_m *CASRetentionRule) *CASRetentionRuleUpdateOne {
+	mutation := newCASRetentionRuleMutation(c.config, OpUpdateOne, withCASRetentionRule(_m))
+	return &CASRetentionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CASRetentionRuleClient) UpdateOneID(id uuid.UUID) *CASRetentionRuleUpdateOne {
+	mutation := newCASRetentionRuleMutation(c.config, OpUpdateOne, withCASRetentionRuleID(id))
+	return &CASRetentionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CASRetentionRule.
+func (c *CASRetentionRuleClient) Delete() *CASRetentionRuleDelete {
+	mutation := newCASRetentionRuleMutation(c.config, OpDelete)
+	return &CASRetentionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CASRetentionRuleClient) DeleteOne(_m *CASRetentionRule) *CASRetentionRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CASRetentionRuleClient) DeleteOneID(id uuid.UUID) *CASRetentionRuleDeleteOne {
+	builder := c.Delete().Where(casretentionrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CASRetentionRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for CASRetentionRule.
+func (c *CASRetentionRuleClient) Query() *CASRetentionRuleQuery {
+	return &CASRetentionRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCASRetentionRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CASRetentionRule entity by its id.
+func (c *CASRetentionRuleClient) Get(ctx context.Context, id uuid.UUID) (*CASRetentionRule, error) {
+	return c.Query().Where(casretentionrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CASRetentionRuleClient) GetX(ctx context.Context, id uuid.UUID) *CASRetentionRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a CASRetentionRule.
+func (c *CASRetentionRuleClient) QueryOrganization(_m *CASRetentionRule) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casretentionrule.Table, casretentionrule.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, casretentionrule.OrganizationTable, casretentionrule.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCasBackend queries the cas_backend edge of a CASRetentionRule.
+func (c *CASRetentionRuleClient) QueryCasBackend(_m *CASRetentionRule) *CASBackendQuery {
+	query := (&CASBackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(casretentionrule.Table, casretentionrule.FieldID, id),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, casretentionrule.CasBackendTable, casretentionrule.CasBackendColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CASRetentionRuleClient) Hooks() []Hook {
+	return c.hooks.CASRetentionRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *CASRetentionRuleClient) Interceptors() []Interceptor {
+	return c.inters.CASRetentionRule
+}
+
+func (c *CASRetentionRuleClient) mutate(ctx context.Context, m *CASRetentionRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CASRetentionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CASRetentionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CASRetentionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CASRetentionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CASRetentionRule mutation op: %q", m.Op())
+	}
+}
+
 // GroupClient is a client for the Group schema.
 type GroupClient struct {
 	config
@@ -1702,96 +2116,277 @@ func (c *IntegrationAttachmentClient) mutate(ctx context.Context, m *Integration
 	}
 }
 
-// MembershipClient is a client for the Membership schema.
-type MembershipClient struct {
+// IntegrationDeliveryClient is a client for the IntegrationDelivery schema.
+type IntegrationDeliveryClient struct {
 	config
 }
 
-// NewMembershipClient returns a client for the Membership from the given config.
-func NewMembershipClient(c config) *MembershipClient {
-	return &MembershipClient{config: c}
+// NewIntegrationDeliveryClient returns a client for the IntegrationDelivery from the given config.
+func NewIntegrationDeliveryClient(c config) *IntegrationDeliveryClient {
+	return &IntegrationDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `membership.Hooks(f(g(h())))`.
-func (c *MembershipClient) Use(hooks ...Hook) {
-	c.hooks.Membership = append(c.hooks.Membership, hooks...)
+// A call to `Use(f, g, h)` equals to `integrationdelivery.Hooks(f(g(h())))`.
+func (c *IntegrationDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.IntegrationDelivery = append(c.hooks.IntegrationDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `membership.Intercept(f(g(h())))`.
-func (c *MembershipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Membership = append(c.inters.Membership, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `integrationdelivery.Intercept(f(g(h())))`.
+func (c *IntegrationDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IntegrationDelivery = append(c.inters.IntegrationDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a Membership entity.
-func (c *MembershipClient) Create() *MembershipCreate {
-	mutation := newMembershipMutation(c.config, OpCreate)
-	return &MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a IntegrationDelivery entity.
+func (c *IntegrationDeliveryClient) Create() *IntegrationDeliveryCreate {
+	mutation := newIntegrationDeliveryMutation(c.config, OpCreate)
+	return &IntegrationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Membership entities.
-func (c *MembershipClient) CreateBulk(builders ...*MembershipCreate) *MembershipCreateBulk {
-	return &MembershipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of IntegrationDelivery entities.
+func (c *IntegrationDeliveryClient) CreateBulk(builders ...*IntegrationDeliveryCreate) *IntegrationDeliveryCreateBulk {
+	return &IntegrationDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *MembershipClient) MapCreateBulk(slice any, setFunc func(*MembershipCreate, int)) *MembershipCreateBulk {
+func (c *IntegrationDeliveryClient) MapCreateBulk(slice any, setFunc func(*IntegrationDeliveryCreate, int)) *IntegrationDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &MembershipCreateBulk{err: fmt.Errorf("calling to MembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &IntegrationDeliveryCreateBulk{err: fmt.Errorf("calling to IntegrationDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*MembershipCreate, rv.Len())
+	builders := make([]*IntegrationDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &MembershipCreateBulk{config: c.config, builders: builders}
+	return &IntegrationDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Membership.
-func (c *MembershipClient) Update() *MembershipUpdate {
-	mutation := newMembershipMutation(c.config, OpUpdate)
-	return &MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for IntegrationDelivery.
+func (c *IntegrationDeliveryClient) Update() *IntegrationDeliveryUpdate {
+	mutation := newIntegrationDeliveryMutation(c.config, OpUpdate)
+	return &IntegrationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *MembershipClient) UpdateOne(_m *Membership) *MembershipUpdateOne {
-	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembership(_m))
-	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IntegrationDeliveryClient) UpdateOne(_m *IntegrationDelivery) *IntegrationDeliveryUpdateOne {
+	mutation := newIntegrationDeliveryMutation(c.config, OpUpdateOne, withIntegrationDelivery(_m))
+	return &IntegrationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *MembershipClient) UpdateOneID(id uuid.UUID) *MembershipUpdateOne {
-	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembershipID(id))
-	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *IntegrationDeliveryClient) UpdateOneID(id uuid.UUID) *IntegrationDeliveryUpdateOne {
+	mutation := newIntegrationDeliveryMutation(c.config, OpUpdateOne, withIntegrationDeliveryID(id))
+	return &IntegrationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Membership.
-func (c *MembershipClient) Delete() *MembershipDelete {
-	mutation := newMembershipMutation(c.config, OpDelete)
-	return &MembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for IntegrationDelivery.
+func (c *IntegrationDeliveryClient) Delete() *IntegrationDeliveryDelete {
+	mutation := newIntegrationDeliveryMutation(c.config, OpDelete)
+	return &IntegrationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *MembershipClient) DeleteOne(_m *Membership) *MembershipDeleteOne {
+func (c *IntegrationDeliveryClient) DeleteOne(_m *IntegrationDelivery) *IntegrationDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *MembershipClient) DeleteOneID(id uuid.UUID) *MembershipDeleteOne {
-	builder := c.Delete().Where(membership.ID(id))
+func (c *IntegrationDeliveryClient) DeleteOneID(id uuid.UUID) *IntegrationDeliveryDeleteOne {
+	builder := c.Delete().Where(integrationdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &MembershipDeleteOne{builder}
+	return &IntegrationDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for Membership.
-func (c *MembershipClient) Query() *MembershipQuery {
-	return &MembershipQuery{
+// Query returns a query builder for IntegrationDelivery.
+func (c *IntegrationDeliveryClient) Query() *IntegrationDeliveryQuery {
+	return &IntegrationDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeMembership},
+		ctx:    &QueryContext{Type: TypeIntegrationDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a IntegrationDelivery entity by its id.
+func (c *IntegrationDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*IntegrationDelivery, error) {
+	return c.Query().Where(integrationdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *IntegrationDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *IntegrationDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a IntegrationDelivery.
+func (c *IntegrationDeliveryClient) QueryOrganization(_m *IntegrationDelivery) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(integrationdelivery.Table, integrationdelivery.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, integrationdelivery.OrganizationTable, integrationdelivery.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflowRun queries the workflow_run edge of a IntegrationDelivery.
+func (c *IntegrationDeliveryClient) QueryWorkflowRun(_m *IntegrationDelivery) *WorkflowRunQuery {
+	query := (&WorkflowRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(integrationdelivery.Table, integrationdelivery.FieldID, id),
+			sqlgraph.To(workflowrun.Table, workflowrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, integrationdelivery.WorkflowRunTable, integrationdelivery.WorkflowRunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIntegrationAttachment queries the integration_attachment edge of a IntegrationDelivery.
+func (c *IntegrationDeliveryClient) QueryIntegrationAttachment(_m *IntegrationDelivery) *IntegrationAttachmentQuery {
+	query := (&IntegrationAttachmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(integrationdelivery.Table, integrationdelivery.FieldID, id),
+			sqlgraph.To(integrationattachment.Table, integrationattachment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, integrationdelivery.IntegrationAttachmentTable, integrationdelivery.IntegrationAttachmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *IntegrationDeliveryClient) Hooks() []Hook {
+	return c.hooks.IntegrationDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *IntegrationDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.IntegrationDelivery
+}
+
+func (c *IntegrationDeliveryClient) mutate(ctx context.Context, m *IntegrationDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IntegrationDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IntegrationDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IntegrationDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IntegrationDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IntegrationDelivery mutation op: %q", m.Op())
+	}
+}
+
+// MembershipClient is a client for the Membership schema.
+type MembershipClient struct {
+	config
+}
+
+// NewMembershipClient returns a client for the Membership from the given config.
+func NewMembershipClient(c config) *MembershipClient {
+	return &MembershipClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `membership.Hooks(f(g(h())))`.
+func (c *MembershipClient) Use(hooks ...Hook) {
+	c.hooks.Membership = append(c.hooks.Membership, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `membership.Intercept(f(g(h())))`.
+func (c *MembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Membership = append(c.inters.Membership, interceptors...)
+}
+
+// Create returns a builder for creating a Membership entity.
+func (c *MembershipClient) Create() *MembershipCreate {
+	mutation := newMembershipMutation(c.config, OpCreate)
+	return &MembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Membership entities.
+func (c *MembershipClient) CreateBulk(builders ...*MembershipCreate) *MembershipCreateBulk {
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MembershipClient) MapCreateBulk(slice any, setFunc func(*MembershipCreate, int)) *MembershipCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MembershipCreateBulk{err: fmt.Errorf("calling to MembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MembershipCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MembershipCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Membership.
+func (c *MembershipClient) Update() *MembershipUpdate {
+	mutation := newMembershipMutation(c.config, OpUpdate)
+	return &MembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MembershipClient) UpdateOne(_m *Membership) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembership(_m))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MembershipClient) UpdateOneID(id uuid.UUID) *MembershipUpdateOne {
+	mutation := newMembershipMutation(c.config, OpUpdateOne, withMembershipID(id))
+	return &MembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Membership.
+func (c *MembershipClient) Delete() *MembershipDelete {
+	mutation := newMembershipMutation(c.config, OpDelete)
+	return &MembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MembershipClient) DeleteOne(_m *Membership) *MembershipDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MembershipClient) DeleteOneID(id uuid.UUID) *MembershipDeleteOne {
+	builder := c.Delete().Where(membership.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MembershipDeleteOne{builder}
+}
+
+// Query returns a query builder for Membership.
+func (c *MembershipClient) Query() *MembershipQuery {
+	return &MembershipQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMembership},
 		inters: c.Interceptors(),
 	}
 }
@@ -2217,18 +2812,593 @@ func (c *OrganizationClient) QueryWorkflows(_m *Organization) *WorkflowQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// QueryWorkflowruns queries the workflowruns edge of a Organization.
+func (c *OrganizationClient) QueryWorkflowruns(_m *Organization) *WorkflowRunQuery {
+	query := (&WorkflowRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(workflowrun.Table, workflowrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.WorkflowrunsTable, organization.WorkflowrunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCasBackends queries the cas_backends edge of a Organization.
+func (c *OrganizationClient) QueryCasBackends(_m *Organization) *CASBackendQuery {
+	query := (&CASBackendClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(casbackend.Table, casbackend.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CasBackendsTable, organization.CasBackendsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIntegrations queries the integrations edge of a Organization.
+func (c *OrganizationClient) QueryIntegrations(_m *Organization) *IntegrationQuery {
+	query := (&IntegrationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(integration.Table, integration.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.IntegrationsTable, organization.IntegrationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a Organization.
+func (c *OrganizationClient) QueryAPITokens(_m *Organization) *APITokenQuery {
+	query := (&APITokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.APITokensTable, organization.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProjects queries the projects edge of a Organization.
+func (c *OrganizationClient) QueryProjects(_m *Organization) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.ProjectsTable, organization.ProjectsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroups queries the groups edge of a Organization.
+func (c *OrganizationClient) QueryGroups(_m *Organization) *GroupQuery {
+	query := (&GroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(group.Table, group.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.GroupsTable, organization.GroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
+}
+
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+	}
+}
+
+// PolicyEvaluationClient is a client for the PolicyEvaluation schema.
+type PolicyEvaluationClient struct {
+	config
+}
+
+// NewPolicyEvaluationClient returns a client for the PolicyEvaluation from the given config.
+func NewPolicyEvaluationClient(c config) *PolicyEvaluationClient {
+	return &PolicyEvaluationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyevaluation.Hooks(f(g(h())))`.
+func (c *PolicyEvaluationClient) Use(hooks ...Hook) {
+	c.hooks.PolicyEvaluation = append(c.hooks.PolicyEvaluation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policyevaluation.Intercept(f(g(h())))`.
+func (c *PolicyEvaluationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyEvaluation = append(c.inters.PolicyEvaluation, interceptors...)
+}
+
+// Create returns a builder for creating a PolicyEvaluation entity.
+func (c *PolicyEvaluationClient) Create() *PolicyEvaluationCreate {
+	mutation := newPolicyEvaluationMutation(c.config, OpCreate)
+	return &PolicyEvaluationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicyEvaluation entities.
+func (c *PolicyEvaluationClient) CreateBulk(builders ...*PolicyEvaluationCreate) *PolicyEvaluationCreateBulk {
+	return &PolicyEvaluationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicyEvaluationClient) MapCreateBulk(slice any, setFunc func(*PolicyEvaluationCreate, int)) *PolicyEvaluationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicyEvaluationCreateBulk{err: fmt.Errorf("calling to PolicyEvaluationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PolicyEvaluationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicyEvaluationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PolicyEvaluation.
+func (c *PolicyEvaluationClient) Update() *PolicyEvaluationUpdate {
+	mutation := newPolicyEvaluationMutation(c.config, OpUpdate)
+	return &PolicyEvaluationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicyEvaluationClient) UpdateOne(_m *PolicyEvaluation) *PolicyEvaluationUpdateOne {
+	mutation := newPolicyEvaluationMutation(c.config, OpUpdateOne, withPolicyEvaluation(_m))
+	return &PolicyEvaluationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicyEvaluationClient) UpdateOneID(id uuid.UUID) *PolicyEvaluationUpdateOne {
+	mutation := newPolicyEvaluationMutation(c.config, OpUpdateOne, withPolicyEvaluationID(id))
+	return &PolicyEvaluationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicyEvaluation.
+func (c *PolicyEvaluationClient) Delete() *PolicyEvaluationDelete {
+	mutation := newPolicyEvaluationMutation(c.config, OpDelete)
+	return &PolicyEvaluationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicyEvaluationClient) DeleteOne(_m *PolicyEvaluation) *PolicyEvaluationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicyEvaluationClient) DeleteOneID(id uuid.UUID) *PolicyEvaluationDeleteOne {
+	builder := c.Delete().Where(policyevaluation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicyEvaluationDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicyEvaluation.
+func (c *PolicyEvaluationClient) Query() *PolicyEvaluationQuery {
+	return &PolicyEvaluationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicyEvaluation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PolicyEvaluation entity by its id.
+func (c *PolicyEvaluationClient) Get(ctx context.Context, id uuid.UUID) (*PolicyEvaluation, error) {
+	return c.Query().Where(policyevaluation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicyEvaluationClient) GetX(ctx context.Context, id uuid.UUID) *PolicyEvaluation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkflowrun queries the workflowrun edge of a PolicyEvaluation.
+func (c *PolicyEvaluationClient) QueryWorkflowrun(_m *PolicyEvaluation) *WorkflowRunQuery {
+	query := (&WorkflowRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyevaluation.Table, policyevaluation.FieldID, id),
+			sqlgraph.To(workflowrun.Table, workflowrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, policyevaluation.WorkflowrunTable, policyevaluation.WorkflowrunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryViolations queries the violations edge of a PolicyEvaluation.
+func (c *PolicyEvaluationClient) QueryViolations(_m *PolicyEvaluation) *PolicyViolationQuery {
+	query := (&PolicyViolationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyevaluation.Table, policyevaluation.FieldID, id),
+			sqlgraph.To(policyviolation.Table, policyviolation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, policyevaluation.ViolationsTable, policyevaluation.ViolationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PolicyEvaluationClient) Hooks() []Hook {
+	return c.hooks.PolicyEvaluation
+}
+
+// Interceptors returns the client interceptors.
+func (c *PolicyEvaluationClient) Interceptors() []Interceptor {
+	return c.inters.PolicyEvaluation
+}
+
+func (c *PolicyEvaluationClient) mutate(ctx context.Context, m *PolicyEvaluationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PolicyEvaluationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PolicyEvaluationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PolicyEvaluationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PolicyEvaluationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PolicyEvaluation mutation op: %q", m.Op())
+	}
+}
+
+// PolicyExceptionClient is a client for the PolicyException schema.
+type PolicyExceptionClient struct {
+	config
+}
+
+// NewPolicyExceptionClient returns a client for the PolicyException from the given config.
+func NewPolicyExceptionClient(c config) *PolicyExceptionClient {
+	return &PolicyExceptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyexception.Hooks(f(g(h())))`.
+func (c *PolicyExceptionClient) Use(hooks ...Hook) {
+	c.hooks.PolicyException = append(c.hooks.PolicyException, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policyexception.Intercept(f(g(h())))`.
+func (c *PolicyExceptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyException = append(c.inters.PolicyException, interceptors...)
+}
+
+// Create returns a builder for creating a PolicyException entity.
+func (c *PolicyExceptionClient) Create() *PolicyExceptionCreate {
+	mutation := newPolicyExceptionMutation(c.config, OpCreate)
+	return &PolicyExceptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicyException entities.
+func (c *PolicyExceptionClient) CreateBulk(builders ...*PolicyExceptionCreate) *PolicyExceptionCreateBulk {
+	return &PolicyExceptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicyExceptionClient) MapCreateBulk(slice any, setFunc func(*PolicyExceptionCreate, int)) *PolicyExceptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicyExceptionCreateBulk{err: fmt.Errorf("calling to PolicyExceptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PolicyExceptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicyExceptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PolicyException.
+func (c *PolicyExceptionClient) Update() *PolicyExceptionUpdate {
+	mutation := newPolicyExceptionMutation(c.config, OpUpdate)
+	return &PolicyExceptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicyExceptionClient) UpdateOne(_m *PolicyException) *PolicyExceptionUpdateOne {
+	mutation := newPolicyExceptionMutation(c.config, OpUpdateOne, withPolicyException(_m))
+	return &PolicyExceptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicyExceptionClient) UpdateOneID(id uuid.UUID) *PolicyExceptionUpdateOne {
+	mutation := newPolicyExceptionMutation(c.config, OpUpdateOne, withPolicyExceptionID(id))
+	return &PolicyExceptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicyException.
+func (c *PolicyExceptionClient) Delete() *PolicyExceptionDelete {
+	mutation := newPolicyExceptionMutation(c.config, OpDelete)
+	return &PolicyExceptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicyExceptionClient) DeleteOne(_m *PolicyException) *PolicyExceptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicyExceptionClient) DeleteOneID(id uuid.UUID) *PolicyExceptionDeleteOne {
+	builder := c.Delete().Where(policyexception.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicyExceptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicyException.
+func (c *PolicyExceptionClient) Query() *PolicyExceptionQuery {
+	return &PolicyExceptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicyException},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PolicyException entity by its id.
+func (c *PolicyExceptionClient) Get(ctx context.Context, id uuid.UUID) (*PolicyException, error) {
+	return c.Query().Where(policyexception.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicyExceptionClient) GetX(ctx context.Context, id uuid.UUID) *PolicyException {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a PolicyException.
+func (c *PolicyExceptionClient) QueryOrganization(_m *PolicyException) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyexception.Table, policyexception.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, policyexception.OrganizationTable, policyexception.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProject queries the project edge of a PolicyException.
+func (c *PolicyExceptionClient) QueryProject(_m *PolicyException) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyexception.Table, policyexception.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, policyexception.ProjectTable, policyexception.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflow queries the workflow edge of a PolicyException.
+func (c *PolicyExceptionClient) QueryWorkflow(_m *PolicyException) *WorkflowQuery {
+	query := (&WorkflowClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyexception.Table, policyexception.FieldID, id),
+			sqlgraph.To(workflow.Table, workflow.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, policyexception.WorkflowTable, policyexception.WorkflowColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PolicyExceptionClient) Hooks() []Hook {
+	return c.hooks.PolicyException
+}
+
+// Interceptors returns the client interceptors.
+func (c *PolicyExceptionClient) Interceptors() []Interceptor {
+	return c.inters.PolicyException
+}
+
+func (c *PolicyExceptionClient) mutate(ctx context.Context, m *PolicyExceptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PolicyExceptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PolicyExceptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PolicyExceptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PolicyExceptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PolicyException mutation op: %q", m.Op())
+	}
+}
+
+// PolicyImpactAnalysisClient is a client for the PolicyImpactAnalysis schema.
+type PolicyImpactAnalysisClient struct {
+	config
+}
+
+// NewPolicyImpactAnalysisClient returns a client for the PolicyImpactAnalysis from the given config.
+func NewPolicyImpactAnalysisClient(c config) *PolicyImpactAnalysisClient {
+	return &PolicyImpactAnalysisClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyimpactanalysis.Hooks(f(g(h())))`.
+func (c *PolicyImpactAnalysisClient) Use(hooks ...Hook) {
+	c.hooks.PolicyImpactAnalysis = append(c.hooks.PolicyImpactAnalysis, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policyimpactanalysis.Intercept(f(g(h())))`.
+func (c *PolicyImpactAnalysisClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyImpactAnalysis = append(c.inters.PolicyImpactAnalysis, interceptors...)
+}
+
+// Create returns a builder for creating a PolicyImpactAnalysis entity.
+func (c *PolicyImpactAnalysisClient) Create() *PolicyImpactAnalysisCreate {
+	mutation := newPolicyImpactAnalysisMutation(c.config, OpCreate)
+	return &PolicyImpactAnalysisCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicyImpactAnalysis entities.
+func (c *PolicyImpactAnalysisClient) CreateBulk(builders ...*PolicyImpactAnalysisCreate) *PolicyImpactAnalysisCreateBulk {
+	return &PolicyImpactAnalysisCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicyImpactAnalysisClient) MapCreateBulk(slice any, setFunc func(*PolicyImpactAnalysisCreate, int)) *PolicyImpactAnalysisCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicyImpactAnalysisCreateBulk{err: fmt.Errorf("calling to PolicyImpactAnalysisClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PolicyImpactAnalysisCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicyImpactAnalysisCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PolicyImpactAnalysis.
+func (c *PolicyImpactAnalysisClient) Update() *PolicyImpactAnalysisUpdate {
+	mutation := newPolicyImpactAnalysisMutation(c.config, OpUpdate)
+	return &PolicyImpactAnalysisUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicyImpactAnalysisClient) UpdateOne(_m *PolicyImpactAnalysis) *PolicyImpactAnalysisUpdateOne {
+	mutation := newPolicyImpactAnalysisMutation(c.config, OpUpdateOne, withPolicyImpactAnalysis(_m))
+	return &PolicyImpactAnalysisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicyImpactAnalysisClient) UpdateOneID(id uuid.UUID) *PolicyImpactAnalysisUpdateOne {
+	mutation := newPolicyImpactAnalysisMutation(c.config, OpUpdateOne, withPolicyImpactAnalysisID(id))
+	return &PolicyImpactAnalysisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicyImpactAnalysis.
+func (c *PolicyImpactAnalysisClient) Delete() *PolicyImpactAnalysisDelete {
+	mutation := newPolicyImpactAnalysisMutation(c.config, OpDelete)
+	return &PolicyImpactAnalysisDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicyImpactAnalysisClient) DeleteOne(_m *PolicyImpactAnalysis) *PolicyImpactAnalysisDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicyImpactAnalysisClient) DeleteOneID(id uuid.UUID) *PolicyImpactAnalysisDeleteOne {
+	builder := c.Delete().Where(policyimpactanalysis.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicyImpactAnalysisDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicyImpactAnalysis.
+func (c *PolicyImpactAnalysisClient) Query() *PolicyImpactAnalysisQuery {
+	return &PolicyImpactAnalysisQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicyImpactAnalysis},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PolicyImpactAnalysis entity by its id.
+func (c *PolicyImpactAnalysisClient) Get(ctx context.Context, id uuid.UUID) (*PolicyImpactAnalysis, error) {
+	return c.Query().Where(policyimpactanalysis.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicyImpactAnalysisClient) GetX(ctx context.Context, id uuid.UUID) *PolicyImpactAnalysis {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// QueryWorkflowruns queries the workflowruns edge of a Organization.
-func (c *OrganizationClient) QueryWorkflowruns(_m *Organization) *WorkflowRunQuery {
-	query := (&WorkflowRunClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a PolicyImpactAnalysis.
+func (c *PolicyImpactAnalysisClient) QueryOrganization(_m *PolicyImpactAnalysis) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(workflowrun.Table, workflowrun.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.WorkflowrunsTable, organization.WorkflowrunsColumn),
+			sqlgraph.From(policyimpactanalysis.Table, policyimpactanalysis.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, policyimpactanalysis.OrganizationTable, policyimpactanalysis.OrganizationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2236,79 +3406,148 @@ func (c *OrganizationClient) QueryWorkflowruns(_m *Organization) *WorkflowRunQue
 	return query
 }
 
-// QueryCasBackends queries the cas_backends edge of a Organization.
-func (c *OrganizationClient) QueryCasBackends(_m *Organization) *CASBackendQuery {
-	query := (&CASBackendClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(casbackend.Table, casbackend.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.CasBackendsTable, organization.CasBackendsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *PolicyImpactAnalysisClient) Hooks() []Hook {
+	return c.hooks.PolicyImpactAnalysis
+}
+
+// Interceptors returns the client interceptors.
+func (c *PolicyImpactAnalysisClient) Interceptors() []Interceptor {
+	return c.inters.PolicyImpactAnalysis
+}
+
+func (c *PolicyImpactAnalysisClient) mutate(ctx context.Context, m *PolicyImpactAnalysisMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PolicyImpactAnalysisCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PolicyImpactAnalysisUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PolicyImpactAnalysisUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PolicyImpactAnalysisDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PolicyImpactAnalysis mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryIntegrations queries the integrations edge of a Organization.
-func (c *OrganizationClient) QueryIntegrations(_m *Organization) *IntegrationQuery {
-	query := (&IntegrationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(integration.Table, integration.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.IntegrationsTable, organization.IntegrationsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// PolicyViolationClient is a client for the PolicyViolation schema.
+type PolicyViolationClient struct {
+	config
+}
+
+// NewPolicyViolationClient returns a client for the PolicyViolation from the given config.
+func NewPolicyViolationClient(c config) *PolicyViolationClient {
+	return &PolicyViolationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `policyviolation.Hooks(f(g(h())))`.
+func (c *PolicyViolationClient) Use(hooks ...Hook) {
+	c.hooks.PolicyViolation = append(c.hooks.PolicyViolation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `policyviolation.Intercept(f(g(h())))`.
+func (c *PolicyViolationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PolicyViolation = append(c.inters.PolicyViolation, interceptors...)
+}
+
+// Create returns a builder for creating a PolicyViolation entity.
+func (c *PolicyViolationClient) Create() *PolicyViolationCreate {
+	mutation := newPolicyViolationMutation(c.config, OpCreate)
+	return &PolicyViolationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PolicyViolation entities.
+func (c *PolicyViolationClient) CreateBulk(builders ...*PolicyViolationCreate) *PolicyViolationCreateBulk {
+	return &PolicyViolationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PolicyViolationClient) MapCreateBulk(slice any, setFunc func(*PolicyViolationCreate, int)) *PolicyViolationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PolicyViolationCreateBulk{err: fmt.Errorf("calling to PolicyViolationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*PolicyViolationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PolicyViolationCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryAPITokens queries the api_tokens edge of a Organization.
-func (c *OrganizationClient) QueryAPITokens(_m *Organization) *APITokenQuery {
-	query := (&APITokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(apitoken.Table, apitoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.APITokensTable, organization.APITokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for PolicyViolation.
+func (c *PolicyViolationClient) Update() *PolicyViolationUpdate {
+	mutation := newPolicyViolationMutation(c.config, OpUpdate)
+	return &PolicyViolationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PolicyViolationClient) UpdateOne(_m *PolicyViolation) *PolicyViolationUpdateOne {
+	mutation := newPolicyViolationMutation(c.config, OpUpdateOne, withPolicyViolation(_m))
+	return &PolicyViolationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PolicyViolationClient) UpdateOneID(id uuid.UUID) *PolicyViolationUpdateOne {
+	mutation := newPolicyViolationMutation(c.config, OpUpdateOne, withPolicyViolationID(id))
+	return &PolicyViolationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PolicyViolation.
+func (c *PolicyViolationClient) Delete() *PolicyViolationDelete {
+	mutation := newPolicyViolationMutation(c.config, OpDelete)
+	return &PolicyViolationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PolicyViolationClient) DeleteOne(_m *PolicyViolation) *PolicyViolationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PolicyViolationClient) DeleteOneID(id uuid.UUID) *PolicyViolationDeleteOne {
+	builder := c.Delete().Where(policyviolation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PolicyViolationDeleteOne{builder}
+}
+
+// Query returns a query builder for PolicyViolation.
+func (c *PolicyViolationClient) Query() *PolicyViolationQuery {
+	return &PolicyViolationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePolicyViolation},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryProjects queries the projects edge of a Organization.
-func (c *OrganizationClient) QueryProjects(_m *Organization) *ProjectQuery {
-	query := (&ProjectClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(project.Table, project.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.ProjectsTable, organization.ProjectsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a PolicyViolation entity by its id.
+func (c *PolicyViolationClient) Get(ctx context.Context, id uuid.UUID) (*PolicyViolation, error) {
+	return c.Query().Where(policyviolation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PolicyViolationClient) GetX(ctx context.Context, id uuid.UUID) *PolicyViolation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryGroups queries the groups edge of a Organization.
-func (c *OrganizationClient) QueryGroups(_m *Organization) *GroupQuery {
-	query := (&GroupClient{config: c.config}).Query()
+// QueryPolicyEvaluation queries the policy_evaluation edge of a PolicyViolation.
+func (c *PolicyViolationClient) QueryPolicyEvaluation(_m *PolicyViolation) *PolicyEvaluationQuery {
+	query := (&PolicyEvaluationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(group.Table, group.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.GroupsTable, organization.GroupsColumn),
+			sqlgraph.From(policyviolation.Table, policyviolation.FieldID, id),
+			sqlgraph.To(policyevaluation.Table, policyevaluation.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, policyviolation.PolicyEvaluationTable, policyviolation.PolicyEvaluationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2317,27 +3556,27 @@ func (c *OrganizationClient) QueryGroups(_m *Organization) *GroupQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *PolicyViolationClient) Hooks() []Hook {
+	return c.hooks.PolicyViolation
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationClient) Interceptors() []Interceptor {
-	return c.inters.Organization
+func (c *PolicyViolationClient) Interceptors() []Interceptor {
+	return c.inters.PolicyViolation
 }
 
-func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+func (c *PolicyViolationClient) mutate(ctx context.Context, m *PolicyViolationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PolicyViolationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PolicyViolationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PolicyViolationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PolicyViolationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PolicyViolation mutation op: %q", m.Op())
 	}
 }
 
@@ -3977,6 +5216,38 @@ func (c *WorkflowRunClient) QueryAttestationBundle(_m *WorkflowRun) *Attestation
 	return query
 }
 
+// QuerySearchEntries queries the search_entries edge of a WorkflowRun.
+func (c *WorkflowRunClient) QuerySearchEntries(_m *WorkflowRun) *WorkflowRunSearchEntryQuery {
+	query := (&WorkflowRunSearchEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrun.Table, workflowrun.FieldID, id),
+			sqlgraph.To(workflowrunsearchentry.Table, workflowrunsearchentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowrun.SearchEntriesTable, workflowrun.SearchEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPolicyEvaluations queries the policy_evaluations edge of a WorkflowRun.
+func (c *WorkflowRunClient) QueryPolicyEvaluations(_m *WorkflowRun) *PolicyEvaluationQuery {
+	query := (&PolicyEvaluationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrun.Table, workflowrun.FieldID, id),
+			sqlgraph.To(policyevaluation.Table, policyevaluation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowrun.PolicyEvaluationsTable, workflowrun.PolicyEvaluationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WorkflowRunClient) Hooks() []Hook {
 	return c.hooks.WorkflowRun
@@ -4002,18 +5273,171 @@ func (c *WorkflowRunClient) mutate(ctx context.Context, m *WorkflowRunMutation)
 	}
 }
 
+// WorkflowRunSearchEntryClient is a client for the WorkflowRunSearchEntry schema.
+type WorkflowRunSearchEntryClient struct {
+	config
+}
+
+// NewWorkflowRunSearchEntryClient returns a client for the WorkflowRunSearchEntry from the given config.
+func NewWorkflowRunSearchEntryClient(c config) *WorkflowRunSearchEntryClient {
+	return &WorkflowRunSearchEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workflowrunsearchentry.Hooks(f(g(h())))`.
+func (c *WorkflowRunSearchEntryClient) Use(hooks ...Hook) {
+	c.hooks.WorkflowRunSearchEntry = append(c.hooks.WorkflowRunSearchEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workflowrunsearchentry.Intercept(f(g(h())))`.
+func (c *WorkflowRunSearchEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkflowRunSearchEntry = append(c.inters.WorkflowRunSearchEntry, interceptors...)
+}
+
+// Create returns a builder for creating a WorkflowRunSearchEntry entity.
+func (c *WorkflowRunSearchEntryClient) Create() *WorkflowRunSearchEntryCreate {
+	mutation := newWorkflowRunSearchEntryMutation(c.config, OpCreate)
+	return &WorkflowRunSearchEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkflowRunSearchEntry entities.
+func (c *WorkflowRunSearchEntryClient) CreateBulk(builders ...*WorkflowRunSearchEntryCreate) *WorkflowRunSearchEntryCreateBulk {
+	return &WorkflowRunSearchEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkflowRunSearchEntryClient) MapCreateBulk(slice any, setFunc func(*WorkflowRunSearchEntryCreate, int)) *WorkflowRunSearchEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkflowRunSearchEntryCreateBulk{err: fmt.Errorf("calling to WorkflowRunSearchEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkflowRunSearchEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkflowRunSearchEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkflowRunSearchEntry.
+func (c *WorkflowRunSearchEntryClient) Update() *WorkflowRunSearchEntryUpdate {
+	mutation := newWorkflowRunSearchEntryMutation(c.config, OpUpdate)
+	return &WorkflowRunSearchEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkflowRunSearchEntryClient) UpdateOne(_m *WorkflowRunSearchEntry) *WorkflowRunSearchEntryUpdateOne {
+	mutation := newWorkflowRunSearchEntryMutation(c.config, OpUpdateOne, withWorkflowRunSearchEntry(_m))
+	return &WorkflowRunSearchEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkflowRunSearchEntryClient) UpdateOneID(id uuid.UUID) *WorkflowRunSearchEntryUpdateOne {
+	mutation := newWorkflowRunSearchEntryMutation(c.config, OpUpdateOne, withWorkflowRunSearchEntryID(id))
+	return &WorkflowRunSearchEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkflowRunSearchEntry.
+func (c *WorkflowRunSearchEntryClient) Delete() *WorkflowRunSearchEntryDelete {
+	mutation := newWorkflowRunSearchEntryMutation(c.config, OpDelete)
+	return &WorkflowRunSearchEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkflowRunSearchEntryClient) DeleteOne(_m *WorkflowRunSearchEntry) *WorkflowRunSearchEntryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkflowRunSearchEntryClient) DeleteOneID(id uuid.UUID) *WorkflowRunSearchEntryDeleteOne {
+	builder := c.Delete().Where(workflowrunsearchentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkflowRunSearchEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkflowRunSearchEntry.
+func (c *WorkflowRunSearchEntryClient) Query() *WorkflowRunSearchEntryQuery {
+	return &WorkflowRunSearchEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkflowRunSearchEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkflowRunSearchEntry entity by its id.
+func (c *WorkflowRunSearchEntryClient) Get(ctx context.Context, id uuid.UUID) (*WorkflowRunSearchEntry, error) {
+	return c.Query().Where(workflowrunsearchentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkflowRunSearchEntryClient) GetX(ctx context.Context, id uuid.UUID) *WorkflowRunSearchEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkflowrun queries the workflowrun edge of a WorkflowRunSearchEntry.
+func (c *WorkflowRunSearchEntryClient) QueryWorkflowrun(_m *WorkflowRunSearchEntry) *WorkflowRunQuery {
+	query := (&WorkflowRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrunsearchentry.Table, workflowrunsearchentry.FieldID, id),
+			sqlgraph.To(workflowrun.Table, workflowrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowrunsearchentry.WorkflowrunTable, workflowrunsearchentry.WorkflowrunColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkflowRunSearchEntryClient) Hooks() []Hook {
+	return c.hooks.WorkflowRunSearchEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkflowRunSearchEntryClient) Interceptors() []Interceptor {
+	return c.inters.WorkflowRunSearchEntry
+}
+
+func (c *WorkflowRunSearchEntryClient) mutate(ctx context.Context, m *WorkflowRunSearchEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkflowRunSearchEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkflowRunSearchEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkflowRunSearchEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkflowRunSearchEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkflowRunSearchEntry mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		APIToken, Attestation, CASBackend, CASMapping, Group, GroupMembership,
-		Integration, IntegrationAttachment, Membership, OrgInvitation, Organization,
-		Project, ProjectVersion, Referrer, RobotAccount, User, Workflow,
-		WorkflowContract, WorkflowContractVersion, WorkflowRun []ent.Hook
+		APIToken, Attestation, CASBackend, CASBlobDeletion, CASMapping,
+		CASRetentionRule, Group, GroupMembership, Integration, IntegrationAttachment,
+		IntegrationDelivery, Membership, OrgInvitation, Organization, PolicyEvaluation,
+		PolicyException, PolicyImpactAnalysis, PolicyViolation, Project,
+		ProjectVersion, Referrer, RobotAccount, User, Workflow, WorkflowContract,
+		WorkflowContractVersion, WorkflowRun, WorkflowRunSearchEntry []ent.Hook
 	}
 	inters struct {
-		APIToken, Attestation, CASBackend, CASMapping, Group, GroupMembership,
-		Integration, IntegrationAttachment, Membership, OrgInvitation, Organization,
-		Project, ProjectVersion, Referrer, RobotAccount, User, Workflow,
-		WorkflowContract, WorkflowContractVersion, WorkflowRun []ent.Interceptor
+		APIToken, Attestation, CASBackend, CASBlobDeletion, CASMapping,
+		CASRetentionRule, Group, GroupMembership, Integration, IntegrationAttachment,
+		IntegrationDelivery, Membership, OrgInvitation, Organization, PolicyEvaluation,
+		PolicyException, PolicyImpactAnalysis, PolicyViolation, Project,
+		ProjectVersion, Referrer, RobotAccount, User, Workflow, WorkflowContract,
+		WorkflowContractVersion, WorkflowRun, WorkflowRunSearchEntry []ent.Interceptor
 	}
 )
@@ -15,14 +15,21 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/apitoken"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/attestation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casmapping"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/group"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/groupmembership"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integration"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationattachment"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/membership"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/orginvitation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/referrer"
@@ -32,6 +39,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontract"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontractversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -95,14 +103,21 @@ func checkColumn(t, c string) error {
 			apitoken.Table:                apitoken.ValidColumn,
 			attestation.Table:             attestation.ValidColumn,
 			casbackend.Table:              casbackend.ValidColumn,
+			casblobdeletion.Table:         casblobdeletion.ValidColumn,
 			casmapping.Table:              casmapping.ValidColumn,
+			casretentionrule.Table:        casretentionrule.ValidColumn,
 			group.Table:                   group.ValidColumn,
 			groupmembership.Table:         groupmembership.ValidColumn,
 			integration.Table:             integration.ValidColumn,
 			integrationattachment.Table:   integrationattachment.ValidColumn,
+			integrationdelivery.Table:     integrationdelivery.ValidColumn,
 			membership.Table:              membership.ValidColumn,
 			orginvitation.Table:           orginvitation.ValidColumn,
 			organization.Table:            organization.ValidColumn,
+			policyevaluation.Table:        policyevaluation.ValidColumn,
+			policyexception.Table:         policyexception.ValidColumn,
+			policyimpactanalysis.Table:    policyimpactanalysis.ValidColumn,
+			policyviolation.Table:         policyviolation.ValidColumn,
 			project.Table:                 project.ValidColumn,
 			projectversion.Table:          projectversion.ValidColumn,
 			referrer.Table:                referrer.ValidColumn,
@@ -112,6 +127,7 @@ func checkColumn(t, c string) error {
 			workflowcontract.Table:        workflowcontract.ValidColumn,
 			workflowcontractversion.Table: workflowcontractversion.ValidColumn,
 			workflowrun.Table:             workflowrun.ValidColumn,
+			workflowrunsearchentry.Table:  workflowrunsearchentry.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
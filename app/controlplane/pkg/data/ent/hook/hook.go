@@ -45,6 +45,18 @@ func (f CASBackendFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CASBackendMutation", m)
 }
 
+// The CASBlobDeletionFunc type is an adapter to allow the use of ordinary
+// function as CASBlobDeletion mutator.
+type CASBlobDeletionFunc func(context.Context, *ent.CASBlobDeletionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CASBlobDeletionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CASBlobDeletionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CASBlobDeletionMutation", m)
+}
+
 // The CASMappingFunc type is an adapter to allow the use of ordinary
 // function as CASMapping mutator.
 type CASMappingFunc func(context.Context, *ent.CASMappingMutation) (ent.Value, error)
@@ -57,6 +69,18 @@ func (f CASMappingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CASMappingMutation", m)
 }
 
+// The CASRetentionRuleFunc type is an adapter to allow the use of ordinary
+// function as CASRetentionRule mutator.
+type CASRetentionRuleFunc func(context.Context, *ent.CASRetentionRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CASRetentionRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CASRetentionRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CASRetentionRuleMutation", m)
+}
+
 // The GroupFunc type is an adapter to allow the use of ordinary
 // function as Group mutator.
 type GroupFunc func(context.Context, *ent.GroupMutation) (ent.Value, error)
@@ -105,6 +129,18 @@ func (f IntegrationAttachmentFunc) Mutate(ctx context.Context, m ent.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IntegrationAttachmentMutation", m)
 }
 
+// The IntegrationDeliveryFunc type is an adapter to allow the use of ordinary
+// function as IntegrationDelivery mutator.
+type IntegrationDeliveryFunc func(context.Context, *ent.IntegrationDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IntegrationDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IntegrationDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IntegrationDeliveryMutation", m)
+}
+
 // The MembershipFunc type is an adapter to allow the use of ordinary
 // function as Membership mutator.
 type MembershipFunc func(context.Context, *ent.MembershipMutation) (ent.Value, error)
@@ -141,6 +177,54 @@ func (f OrganizationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrganizationMutation", m)
 }
 
+// The PolicyEvaluationFunc type is an adapter to allow the use of ordinary
+// function as PolicyEvaluation mutator.
+type PolicyEvaluationFunc func(context.Context, *ent.PolicyEvaluationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyEvaluationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyEvaluationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyEvaluationMutation", m)
+}
+
+// The PolicyExceptionFunc type is an adapter to allow the use of ordinary
+// function as PolicyException mutator.
+type PolicyExceptionFunc func(context.Context, *ent.PolicyExceptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyExceptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyExceptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyExceptionMutation", m)
+}
+
+// The PolicyImpactAnalysisFunc type is an adapter to allow the use of ordinary
+// function as PolicyImpactAnalysis mutator.
+type PolicyImpactAnalysisFunc func(context.Context, *ent.PolicyImpactAnalysisMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyImpactAnalysisFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyImpactAnalysisMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyImpactAnalysisMutation", m)
+}
+
+// The PolicyViolationFunc type is an adapter to allow the use of ordinary
+// function as PolicyViolation mutator.
+type PolicyViolationFunc func(context.Context, *ent.PolicyViolationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PolicyViolationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PolicyViolationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PolicyViolationMutation", m)
+}
+
 // The ProjectFunc type is an adapter to allow the use of ordinary
 // function as Project mutator.
 type ProjectFunc func(context.Context, *ent.ProjectMutation) (ent.Value, error)
@@ -249,6 +333,18 @@ func (f WorkflowRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowRunMutation", m)
 }
 
+// The WorkflowRunSearchEntryFunc type is an adapter to allow the use of ordinary
+// function as WorkflowRunSearchEntry mutator.
+type WorkflowRunSearchEntryFunc func(context.Context, *ent.WorkflowRunSearchEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkflowRunSearchEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkflowRunSearchEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkflowRunSearchEntryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
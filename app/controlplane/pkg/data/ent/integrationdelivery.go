@@ -0,0 +1,348 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationattachment"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/google/uuid"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+// IntegrationDelivery is the model entity for the IntegrationDelivery schema.
+type IntegrationDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status biz.IntegrationDeliveryStatus `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration time.Duration `json:"duration,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Envelope holds the value of the "envelope" field.
+	Envelope *dsse.Envelope `json:"envelope,omitempty"`
+	// DownloadBackendType holds the value of the "download_backend_type" field.
+	DownloadBackendType string `json:"download_backend_type,omitempty"`
+	// DownloadSecretName holds the value of the "download_secret_name" field.
+	DownloadSecretName string `json:"download_secret_name,omitempty"`
+	// WorkflowID holds the value of the "workflow_id" field.
+	WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+	// AttestationDigest holds the value of the "attestation_digest" field.
+	AttestationDigest string `json:"attestation_digest,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// WorkflowRunID holds the value of the "workflow_run_id" field.
+	WorkflowRunID uuid.UUID `json:"workflow_run_id,omitempty"`
+	// IntegrationAttachmentID holds the value of the "integration_attachment_id" field.
+	IntegrationAttachmentID uuid.UUID `json:"integration_attachment_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IntegrationDeliveryQuery when eager-loading is set.
+	Edges        IntegrationDeliveryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IntegrationDeliveryEdges holds the relations/edges for other nodes in the graph.
+type IntegrationDeliveryEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// WorkflowRun holds the value of the workflow_run edge.
+	WorkflowRun *WorkflowRun `json:"workflow_run,omitempty"`
+	// IntegrationAttachment holds the value of the integration_attachment edge.
+	IntegrationAttachment *IntegrationAttachment `json:"integration_attachment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IntegrationDeliveryEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// WorkflowRunOrErr returns the WorkflowRun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IntegrationDeliveryEdges) WorkflowRunOrErr() (*WorkflowRun, error) {
+	if e.WorkflowRun != nil {
+		return e.WorkflowRun, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: workflowrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflow_run"}
+}
+
+// IntegrationAttachmentOrErr returns the IntegrationAttachment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IntegrationDeliveryEdges) IntegrationAttachmentOrErr() (*IntegrationAttachment, error) {
+	if e.IntegrationAttachment != nil {
+		return e.IntegrationAttachment, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: integrationattachment.Label}
+	}
+	return nil, &NotLoadedError{edge: "integration_attachment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IntegrationDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case integrationdelivery.FieldEnvelope:
+			values[i] = new([]byte)
+		case integrationdelivery.FieldAttempts, integrationdelivery.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case integrationdelivery.FieldStatus, integrationdelivery.FieldLastError, integrationdelivery.FieldDownloadBackendType, integrationdelivery.FieldDownloadSecretName, integrationdelivery.FieldAttestationDigest:
+			values[i] = new(sql.NullString)
+		case integrationdelivery.FieldNextAttemptAt, integrationdelivery.FieldCreatedAt, integrationdelivery.FieldUpdatedAt, integrationdelivery.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case integrationdelivery.FieldID, integrationdelivery.FieldWorkflowID, integrationdelivery.FieldOrganizationID, integrationdelivery.FieldWorkflowRunID, integrationdelivery.FieldIntegrationAttachmentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IntegrationDelivery fields.
+func (_m *IntegrationDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case integrationdelivery.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case integrationdelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = biz.IntegrationDeliveryStatus(value.String)
+			}
+		case integrationdelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case integrationdelivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case integrationdelivery.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				_m.Duration = time.Duration(value.Int64)
+			}
+		case integrationdelivery.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = value.Time
+			}
+		case integrationdelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case integrationdelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case integrationdelivery.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Time
+			}
+		case integrationdelivery.FieldEnvelope:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field envelope", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Envelope); err != nil {
+					return fmt.Errorf("unmarshal field envelope: %w", err)
+				}
+			}
+		case integrationdelivery.FieldDownloadBackendType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field download_backend_type", values[i])
+			} else if value.Valid {
+				_m.DownloadBackendType = value.String
+			}
+		case integrationdelivery.FieldDownloadSecretName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field download_secret_name", values[i])
+			} else if value.Valid {
+				_m.DownloadSecretName = value.String
+			}
+		case integrationdelivery.FieldWorkflowID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value != nil {
+				_m.WorkflowID = *value
+			}
+		case integrationdelivery.FieldAttestationDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field attestation_digest", values[i])
+			} else if value.Valid {
+				_m.AttestationDigest = value.String
+			}
+		case integrationdelivery.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case integrationdelivery.FieldWorkflowRunID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_run_id", values[i])
+			} else if value != nil {
+				_m.WorkflowRunID = *value
+			}
+		case integrationdelivery.FieldIntegrationAttachmentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field integration_attachment_id", values[i])
+			} else if value != nil {
+				_m.IntegrationAttachmentID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IntegrationDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *IntegrationDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the IntegrationDelivery entity.
+func (_m *IntegrationDelivery) QueryOrganization() *OrganizationQuery {
+	return NewIntegrationDeliveryClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryWorkflowRun queries the "workflow_run" edge of the IntegrationDelivery entity.
+func (_m *IntegrationDelivery) QueryWorkflowRun() *WorkflowRunQuery {
+	return NewIntegrationDeliveryClient(_m.config).QueryWorkflowRun(_m)
+}
+
+// QueryIntegrationAttachment queries the "integration_attachment" edge of the IntegrationDelivery entity.
+func (_m *IntegrationDelivery) QueryIntegrationAttachment() *IntegrationAttachmentQuery {
+	return NewIntegrationDeliveryClient(_m.config).QueryIntegrationAttachment(_m)
+}
+
+// Update returns a builder for updating this IntegrationDelivery.
+// Note that you need to call IntegrationDelivery.Unwrap() before calling this method if this IntegrationDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *IntegrationDelivery) Update() *IntegrationDeliveryUpdateOne {
+	return NewIntegrationDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the IntegrationDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *IntegrationDelivery) Unwrap() *IntegrationDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IntegrationDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *IntegrationDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("IntegrationDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("duration=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Duration))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(_m.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(_m.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("envelope=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Envelope))
+	builder.WriteString(", ")
+	builder.WriteString("download_backend_type=")
+	builder.WriteString(_m.DownloadBackendType)
+	builder.WriteString(", ")
+	builder.WriteString("download_secret_name=")
+	builder.WriteString(_m.DownloadSecretName)
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowID))
+	builder.WriteString(", ")
+	builder.WriteString("attestation_digest=")
+	builder.WriteString(_m.AttestationDigest)
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_run_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowRunID))
+	builder.WriteString(", ")
+	builder.WriteString("integration_attachment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IntegrationAttachmentID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IntegrationDeliveries is a parsable slice of IntegrationDelivery.
+type IntegrationDeliveries []*IntegrationDelivery
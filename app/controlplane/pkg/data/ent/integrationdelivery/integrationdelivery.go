@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package integrationdelivery
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the integrationdelivery type in the database.
+	Label = "integration_delivery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldEnvelope holds the string denoting the envelope field in the database.
+	FieldEnvelope = "envelope"
+	// FieldDownloadBackendType holds the string denoting the download_backend_type field in the database.
+	FieldDownloadBackendType = "download_backend_type"
+	// FieldDownloadSecretName holds the string denoting the download_secret_name field in the database.
+	FieldDownloadSecretName = "download_secret_name"
+	// FieldWorkflowID holds the string denoting the workflow_id field in the database.
+	FieldWorkflowID = "workflow_id"
+	// FieldAttestationDigest holds the string denoting the attestation_digest field in the database.
+	FieldAttestationDigest = "attestation_digest"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldWorkflowRunID holds the string denoting the workflow_run_id field in the database.
+	FieldWorkflowRunID = "workflow_run_id"
+	// FieldIntegrationAttachmentID holds the string denoting the integration_attachment_id field in the database.
+	FieldIntegrationAttachmentID = "integration_attachment_id"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeWorkflowRun holds the string denoting the workflow_run edge name in mutations.
+	EdgeWorkflowRun = "workflow_run"
+	// EdgeIntegrationAttachment holds the string denoting the integration_attachment edge name in mutations.
+	EdgeIntegrationAttachment = "integration_attachment"
+	// Table holds the table name of the integrationdelivery in the database.
+	Table = "integration_deliveries"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "integration_deliveries"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// WorkflowRunTable is the table that holds the workflow_run relation/edge.
+	WorkflowRunTable = "integration_deliveries"
+	// WorkflowRunInverseTable is the table name for the WorkflowRun entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowrun" package.
+	WorkflowRunInverseTable = "workflow_runs"
+	// WorkflowRunColumn is the table column denoting the workflow_run relation/edge.
+	WorkflowRunColumn = "workflow_run_id"
+	// IntegrationAttachmentTable is the table that holds the integration_attachment relation/edge.
+	IntegrationAttachmentTable = "integration_deliveries"
+	// IntegrationAttachmentInverseTable is the table name for the IntegrationAttachment entity.
+	// It exists in this package in order to avoid circular dependency with the "integrationattachment" package.
+	IntegrationAttachmentInverseTable = "integration_attachments"
+	// IntegrationAttachmentColumn is the table column denoting the integration_attachment relation/edge.
+	IntegrationAttachmentColumn = "integration_attachment_id"
+)
+
+// Columns holds all SQL columns for integrationdelivery fields.
+var Columns = []string{
+	FieldID,
+	FieldStatus,
+	FieldAttempts,
+	FieldLastError,
+	FieldDuration,
+	FieldNextAttemptAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldFinishedAt,
+	FieldEnvelope,
+	FieldDownloadBackendType,
+	FieldDownloadSecretName,
+	FieldWorkflowID,
+	FieldAttestationDigest,
+	FieldOrganizationID,
+	FieldWorkflowRunID,
+	FieldIntegrationAttachmentID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// DefaultNextAttemptAt holds the default value on creation for the "next_attempt_at" field.
+	DefaultNextAttemptAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+const DefaultStatus biz.IntegrationDeliveryStatus = "pending"
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s biz.IntegrationDeliveryStatus) error {
+	switch s {
+	case "pending", "succeeded", "dead_letter":
+		return nil
+	default:
+		return fmt.Errorf("integrationdelivery: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the IntegrationDelivery queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByDuration orders the results by the duration field.
+func ByDuration(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDuration, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByDownloadBackendType orders the results by the download_backend_type field.
+func ByDownloadBackendType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadBackendType, opts...).ToFunc()
+}
+
+// ByDownloadSecretName orders the results by the download_secret_name field.
+func ByDownloadSecretName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDownloadSecretName, opts...).ToFunc()
+}
+
+// ByWorkflowID orders the results by the workflow_id field.
+func ByWorkflowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowID, opts...).ToFunc()
+}
+
+// ByAttestationDigest orders the results by the attestation_digest field.
+func ByAttestationDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttestationDigest, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByWorkflowRunID orders the results by the workflow_run_id field.
+func ByWorkflowRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowRunID, opts...).ToFunc()
+}
+
+// ByIntegrationAttachmentID orders the results by the integration_attachment_id field.
+func ByIntegrationAttachmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntegrationAttachmentID, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWorkflowRunField orders the results by workflow_run field.
+func ByWorkflowRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByIntegrationAttachmentField orders the results by integration_attachment field.
+func ByIntegrationAttachmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIntegrationAttachmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+	)
+}
+func newWorkflowRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowRunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, WorkflowRunTable, WorkflowRunColumn),
+	)
+}
+func newIntegrationAttachmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IntegrationAttachmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, IntegrationAttachmentTable, IntegrationAttachmentColumn),
+	)
+}
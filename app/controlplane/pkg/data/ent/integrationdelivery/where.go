@@ -0,0 +1,927 @@
+// Code generated by ent, DO NOT EDIT.
+
+package integrationdelivery
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldDuration, vc))
+}
+
+// NextAttemptAt applies equality check predicate on the "next_attempt_at" field. It's identical to NextAttemptAtEQ.
+func NextAttemptAt(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// DownloadBackendType applies equality check predicate on the "download_backend_type" field. It's identical to DownloadBackendTypeEQ.
+func DownloadBackendType(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldDownloadBackendType, v))
+}
+
+// DownloadSecretName applies equality check predicate on the "download_secret_name" field. It's identical to DownloadSecretNameEQ.
+func DownloadSecretName(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldDownloadSecretName, v))
+}
+
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// AttestationDigest applies equality check predicate on the "attestation_digest" field. It's identical to AttestationDigestEQ.
+func AttestationDigest(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldAttestationDigest, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// WorkflowRunID applies equality check predicate on the "workflow_run_id" field. It's identical to WorkflowRunIDEQ.
+func WorkflowRunID(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldWorkflowRunID, v))
+}
+
+// IntegrationAttachmentID applies equality check predicate on the "integration_attachment_id" field. It's identical to IntegrationAttachmentIDEQ.
+func IntegrationAttachmentID(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldIntegrationAttachmentID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v biz.IntegrationDeliveryStatus) predicate.IntegrationDelivery {
+	vc := v
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v biz.IntegrationDeliveryStatus) predicate.IntegrationDelivery {
+	vc := v
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...biz.IntegrationDeliveryStatus) predicate.IntegrationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...biz.IntegrationDeliveryStatus) predicate.IntegrationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldAttempts, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldDuration, vc))
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldDuration, vc))
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...time.Duration) predicate.IntegrationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldDuration, v...))
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...time.Duration) predicate.IntegrationDelivery {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldDuration, v...))
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldDuration, vc))
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldDuration, vc))
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldDuration, vc))
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v time.Duration) predicate.IntegrationDelivery {
+	vc := int64(v)
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldDuration, vc))
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldDuration))
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldDuration))
+}
+
+// NextAttemptAtEQ applies the EQ predicate on the "next_attempt_at" field.
+func NextAttemptAtEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtNEQ applies the NEQ predicate on the "next_attempt_at" field.
+func NextAttemptAtNEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtIn applies the In predicate on the "next_attempt_at" field.
+func NextAttemptAtIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtNotIn applies the NotIn predicate on the "next_attempt_at" field.
+func NextAttemptAtNotIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldNextAttemptAt, vs...))
+}
+
+// NextAttemptAtGT applies the GT predicate on the "next_attempt_at" field.
+func NextAttemptAtGT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtGTE applies the GTE predicate on the "next_attempt_at" field.
+func NextAttemptAtGTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLT applies the LT predicate on the "next_attempt_at" field.
+func NextAttemptAtLT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldNextAttemptAt, v))
+}
+
+// NextAttemptAtLTE applies the LTE predicate on the "next_attempt_at" field.
+func NextAttemptAtLTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldNextAttemptAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// EnvelopeIsNil applies the IsNil predicate on the "envelope" field.
+func EnvelopeIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldEnvelope))
+}
+
+// EnvelopeNotNil applies the NotNil predicate on the "envelope" field.
+func EnvelopeNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldEnvelope))
+}
+
+// DownloadBackendTypeEQ applies the EQ predicate on the "download_backend_type" field.
+func DownloadBackendTypeEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeNEQ applies the NEQ predicate on the "download_backend_type" field.
+func DownloadBackendTypeNEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeIn applies the In predicate on the "download_backend_type" field.
+func DownloadBackendTypeIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldDownloadBackendType, vs...))
+}
+
+// DownloadBackendTypeNotIn applies the NotIn predicate on the "download_backend_type" field.
+func DownloadBackendTypeNotIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldDownloadBackendType, vs...))
+}
+
+// DownloadBackendTypeGT applies the GT predicate on the "download_backend_type" field.
+func DownloadBackendTypeGT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeGTE applies the GTE predicate on the "download_backend_type" field.
+func DownloadBackendTypeGTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeLT applies the LT predicate on the "download_backend_type" field.
+func DownloadBackendTypeLT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeLTE applies the LTE predicate on the "download_backend_type" field.
+func DownloadBackendTypeLTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeContains applies the Contains predicate on the "download_backend_type" field.
+func DownloadBackendTypeContains(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContains(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeHasPrefix applies the HasPrefix predicate on the "download_backend_type" field.
+func DownloadBackendTypeHasPrefix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasPrefix(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeHasSuffix applies the HasSuffix predicate on the "download_backend_type" field.
+func DownloadBackendTypeHasSuffix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasSuffix(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeIsNil applies the IsNil predicate on the "download_backend_type" field.
+func DownloadBackendTypeIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldDownloadBackendType))
+}
+
+// DownloadBackendTypeNotNil applies the NotNil predicate on the "download_backend_type" field.
+func DownloadBackendTypeNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldDownloadBackendType))
+}
+
+// DownloadBackendTypeEqualFold applies the EqualFold predicate on the "download_backend_type" field.
+func DownloadBackendTypeEqualFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEqualFold(FieldDownloadBackendType, v))
+}
+
+// DownloadBackendTypeContainsFold applies the ContainsFold predicate on the "download_backend_type" field.
+func DownloadBackendTypeContainsFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContainsFold(FieldDownloadBackendType, v))
+}
+
+// DownloadSecretNameEQ applies the EQ predicate on the "download_secret_name" field.
+func DownloadSecretNameEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameNEQ applies the NEQ predicate on the "download_secret_name" field.
+func DownloadSecretNameNEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameIn applies the In predicate on the "download_secret_name" field.
+func DownloadSecretNameIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldDownloadSecretName, vs...))
+}
+
+// DownloadSecretNameNotIn applies the NotIn predicate on the "download_secret_name" field.
+func DownloadSecretNameNotIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldDownloadSecretName, vs...))
+}
+
+// DownloadSecretNameGT applies the GT predicate on the "download_secret_name" field.
+func DownloadSecretNameGT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameGTE applies the GTE predicate on the "download_secret_name" field.
+func DownloadSecretNameGTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameLT applies the LT predicate on the "download_secret_name" field.
+func DownloadSecretNameLT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameLTE applies the LTE predicate on the "download_secret_name" field.
+func DownloadSecretNameLTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameContains applies the Contains predicate on the "download_secret_name" field.
+func DownloadSecretNameContains(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContains(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameHasPrefix applies the HasPrefix predicate on the "download_secret_name" field.
+func DownloadSecretNameHasPrefix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasPrefix(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameHasSuffix applies the HasSuffix predicate on the "download_secret_name" field.
+func DownloadSecretNameHasSuffix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasSuffix(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameIsNil applies the IsNil predicate on the "download_secret_name" field.
+func DownloadSecretNameIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldDownloadSecretName))
+}
+
+// DownloadSecretNameNotNil applies the NotNil predicate on the "download_secret_name" field.
+func DownloadSecretNameNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldDownloadSecretName))
+}
+
+// DownloadSecretNameEqualFold applies the EqualFold predicate on the "download_secret_name" field.
+func DownloadSecretNameEqualFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEqualFold(FieldDownloadSecretName, v))
+}
+
+// DownloadSecretNameContainsFold applies the ContainsFold predicate on the "download_secret_name" field.
+func DownloadSecretNameContainsFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContainsFold(FieldDownloadSecretName, v))
+}
+
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDGT applies the GT predicate on the "workflow_id" field.
+func WorkflowIDGT(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldWorkflowID, v))
+}
+
+// WorkflowIDGTE applies the GTE predicate on the "workflow_id" field.
+func WorkflowIDGTE(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDLT applies the LT predicate on the "workflow_id" field.
+func WorkflowIDLT(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldWorkflowID, v))
+}
+
+// WorkflowIDLTE applies the LTE predicate on the "workflow_id" field.
+func WorkflowIDLTE(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldWorkflowID, v))
+}
+
+// AttestationDigestEQ applies the EQ predicate on the "attestation_digest" field.
+func AttestationDigestEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldAttestationDigest, v))
+}
+
+// AttestationDigestNEQ applies the NEQ predicate on the "attestation_digest" field.
+func AttestationDigestNEQ(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldAttestationDigest, v))
+}
+
+// AttestationDigestIn applies the In predicate on the "attestation_digest" field.
+func AttestationDigestIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldAttestationDigest, vs...))
+}
+
+// AttestationDigestNotIn applies the NotIn predicate on the "attestation_digest" field.
+func AttestationDigestNotIn(vs ...string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldAttestationDigest, vs...))
+}
+
+// AttestationDigestGT applies the GT predicate on the "attestation_digest" field.
+func AttestationDigestGT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGT(FieldAttestationDigest, v))
+}
+
+// AttestationDigestGTE applies the GTE predicate on the "attestation_digest" field.
+func AttestationDigestGTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldGTE(FieldAttestationDigest, v))
+}
+
+// AttestationDigestLT applies the LT predicate on the "attestation_digest" field.
+func AttestationDigestLT(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLT(FieldAttestationDigest, v))
+}
+
+// AttestationDigestLTE applies the LTE predicate on the "attestation_digest" field.
+func AttestationDigestLTE(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldLTE(FieldAttestationDigest, v))
+}
+
+// AttestationDigestContains applies the Contains predicate on the "attestation_digest" field.
+func AttestationDigestContains(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContains(FieldAttestationDigest, v))
+}
+
+// AttestationDigestHasPrefix applies the HasPrefix predicate on the "attestation_digest" field.
+func AttestationDigestHasPrefix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasPrefix(FieldAttestationDigest, v))
+}
+
+// AttestationDigestHasSuffix applies the HasSuffix predicate on the "attestation_digest" field.
+func AttestationDigestHasSuffix(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldHasSuffix(FieldAttestationDigest, v))
+}
+
+// AttestationDigestIsNil applies the IsNil predicate on the "attestation_digest" field.
+func AttestationDigestIsNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIsNull(FieldAttestationDigest))
+}
+
+// AttestationDigestNotNil applies the NotNil predicate on the "attestation_digest" field.
+func AttestationDigestNotNil() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotNull(FieldAttestationDigest))
+}
+
+// AttestationDigestEqualFold applies the EqualFold predicate on the "attestation_digest" field.
+func AttestationDigestEqualFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEqualFold(FieldAttestationDigest, v))
+}
+
+// AttestationDigestContainsFold applies the ContainsFold predicate on the "attestation_digest" field.
+func AttestationDigestContainsFold(v string) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldContainsFold(FieldAttestationDigest, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// WorkflowRunIDEQ applies the EQ predicate on the "workflow_run_id" field.
+func WorkflowRunIDEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDNEQ applies the NEQ predicate on the "workflow_run_id" field.
+func WorkflowRunIDNEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldWorkflowRunID, v))
+}
+
+// WorkflowRunIDIn applies the In predicate on the "workflow_run_id" field.
+func WorkflowRunIDIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldWorkflowRunID, vs...))
+}
+
+// WorkflowRunIDNotIn applies the NotIn predicate on the "workflow_run_id" field.
+func WorkflowRunIDNotIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldWorkflowRunID, vs...))
+}
+
+// IntegrationAttachmentIDEQ applies the EQ predicate on the "integration_attachment_id" field.
+func IntegrationAttachmentIDEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldEQ(FieldIntegrationAttachmentID, v))
+}
+
+// IntegrationAttachmentIDNEQ applies the NEQ predicate on the "integration_attachment_id" field.
+func IntegrationAttachmentIDNEQ(v uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNEQ(FieldIntegrationAttachmentID, v))
+}
+
+// IntegrationAttachmentIDIn applies the In predicate on the "integration_attachment_id" field.
+func IntegrationAttachmentIDIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldIn(FieldIntegrationAttachmentID, vs...))
+}
+
+// IntegrationAttachmentIDNotIn applies the NotIn predicate on the "integration_attachment_id" field.
+func IntegrationAttachmentIDNotIn(vs ...uuid.UUID) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.FieldNotIn(FieldIntegrationAttachmentID, vs...))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkflowRun applies the HasEdge predicate on the "workflow_run" edge.
+func HasWorkflowRun() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, WorkflowRunTable, WorkflowRunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowRunWith applies the HasEdge predicate on the "workflow_run" edge with a given conditions (other predicates).
+func HasWorkflowRunWith(preds ...predicate.WorkflowRun) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(func(s *sql.Selector) {
+		step := newWorkflowRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIntegrationAttachment applies the HasEdge predicate on the "integration_attachment" edge.
+func HasIntegrationAttachment() predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, IntegrationAttachmentTable, IntegrationAttachmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIntegrationAttachmentWith applies the HasEdge predicate on the "integration_attachment" edge with a given conditions (other predicates).
+func HasIntegrationAttachmentWith(preds ...predicate.IntegrationAttachment) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(func(s *sql.Selector) {
+		step := newIntegrationAttachmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IntegrationDelivery) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IntegrationDelivery) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IntegrationDelivery) predicate.IntegrationDelivery {
+	return predicate.IntegrationDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,1420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationattachment"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/google/uuid"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+// IntegrationDeliveryCreate is the builder for creating a IntegrationDelivery entity.
+type IntegrationDeliveryCreate struct {
+	config
+	mutation *IntegrationDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStatus sets the "status" field.
+func (_c *IntegrationDeliveryCreate) SetStatus(v biz.IntegrationDeliveryStatus) *IntegrationDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableStatus(v *biz.IntegrationDeliveryStatus) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *IntegrationDeliveryCreate) SetAttempts(v int) *IntegrationDeliveryCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableAttempts(v *int) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *IntegrationDeliveryCreate) SetLastError(v string) *IntegrationDeliveryCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableLastError(v *string) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetDuration sets the "duration" field.
+func (_c *IntegrationDeliveryCreate) SetDuration(v time.Duration) *IntegrationDeliveryCreate {
+	_c.mutation.SetDuration(v)
+	return _c
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableDuration(v *time.Duration) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetDuration(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *IntegrationDeliveryCreate) SetNextAttemptAt(v time.Time) *IntegrationDeliveryCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableNextAttemptAt(v *time.Time) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *IntegrationDeliveryCreate) SetCreatedAt(v time.Time) *IntegrationDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableCreatedAt(v *time.Time) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *IntegrationDeliveryCreate) SetUpdatedAt(v time.Time) *IntegrationDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableUpdatedAt(v *time.Time) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *IntegrationDeliveryCreate) SetFinishedAt(v time.Time) *IntegrationDeliveryCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableFinishedAt(v *time.Time) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetEnvelope sets the "envelope" field.
+func (_c *IntegrationDeliveryCreate) SetEnvelope(v *dsse.Envelope) *IntegrationDeliveryCreate {
+	_c.mutation.SetEnvelope(v)
+	return _c
+}
+
+// SetDownloadBackendType sets the "download_backend_type" field.
+func (_c *IntegrationDeliveryCreate) SetDownloadBackendType(v string) *IntegrationDeliveryCreate {
+	_c.mutation.SetDownloadBackendType(v)
+	return _c
+}
+
+// SetNillableDownloadBackendType sets the "download_backend_type" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableDownloadBackendType(v *string) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetDownloadBackendType(*v)
+	}
+	return _c
+}
+
+// SetDownloadSecretName sets the "download_secret_name" field.
+func (_c *IntegrationDeliveryCreate) SetDownloadSecretName(v string) *IntegrationDeliveryCreate {
+	_c.mutation.SetDownloadSecretName(v)
+	return _c
+}
+
+// SetNillableDownloadSecretName sets the "download_secret_name" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableDownloadSecretName(v *string) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetDownloadSecretName(*v)
+	}
+	return _c
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_c *IntegrationDeliveryCreate) SetWorkflowID(v uuid.UUID) *IntegrationDeliveryCreate {
+	_c.mutation.SetWorkflowID(v)
+	return _c
+}
+
+// SetAttestationDigest sets the "attestation_digest" field.
+func (_c *IntegrationDeliveryCreate) SetAttestationDigest(v string) *IntegrationDeliveryCreate {
+	_c.mutation.SetAttestationDigest(v)
+	return _c
+}
+
+// SetNillableAttestationDigest sets the "attestation_digest" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableAttestationDigest(v *string) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetAttestationDigest(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *IntegrationDeliveryCreate) SetOrganizationID(v uuid.UUID) *IntegrationDeliveryCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetWorkflowRunID sets the "workflow_run_id" field.
+func (_c *IntegrationDeliveryCreate) SetWorkflowRunID(v uuid.UUID) *IntegrationDeliveryCreate {
+	_c.mutation.SetWorkflowRunID(v)
+	return _c
+}
+
+// SetIntegrationAttachmentID sets the "integration_attachment_id" field.
+func (_c *IntegrationDeliveryCreate) SetIntegrationAttachmentID(v uuid.UUID) *IntegrationDeliveryCreate {
+	_c.mutation.SetIntegrationAttachmentID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *IntegrationDeliveryCreate) SetID(v uuid.UUID) *IntegrationDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *IntegrationDeliveryCreate) SetNillableID(v *uuid.UUID) *IntegrationDeliveryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *IntegrationDeliveryCreate) SetOrganization(v *Organization) *IntegrationDeliveryCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetWorkflowRun sets the "workflow_run" edge to the WorkflowRun entity.
+func (_c *IntegrationDeliveryCreate) SetWorkflowRun(v *WorkflowRun) *IntegrationDeliveryCreate {
+	return _c.SetWorkflowRunID(v.ID)
+}
+
+// SetIntegrationAttachment sets the "integration_attachment" edge to the IntegrationAttachment entity.
+func (_c *IntegrationDeliveryCreate) SetIntegrationAttachment(v *IntegrationAttachment) *IntegrationDeliveryCreate {
+	return _c.SetIntegrationAttachmentID(v.ID)
+}
+
+// Mutation returns the IntegrationDeliveryMutation object of the builder.
+func (_c *IntegrationDeliveryCreate) Mutation() *IntegrationDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the IntegrationDelivery in the database.
+func (_c *IntegrationDeliveryCreate) Save(ctx context.Context) (*IntegrationDelivery, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *IntegrationDeliveryCreate) SaveX(ctx context.Context) *IntegrationDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IntegrationDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IntegrationDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *IntegrationDeliveryCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := integrationdelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := integrationdelivery.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := integrationdelivery.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := integrationdelivery.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := integrationdelivery.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := integrationdelivery.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *IntegrationDeliveryCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "IntegrationDelivery.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := integrationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IntegrationDelivery.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "IntegrationDelivery.attempts"`)}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "IntegrationDelivery.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IntegrationDelivery.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "IntegrationDelivery.updated_at"`)}
+	}
+	if _, ok := _c.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow_id", err: errors.New(`ent: missing required field "IntegrationDelivery.workflow_id"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "IntegrationDelivery.organization_id"`)}
+	}
+	if _, ok := _c.mutation.WorkflowRunID(); !ok {
+		return &ValidationError{Name: "workflow_run_id", err: errors.New(`ent: missing required field "IntegrationDelivery.workflow_run_id"`)}
+	}
+	if _, ok := _c.mutation.IntegrationAttachmentID(); !ok {
+		return &ValidationError{Name: "integration_attachment_id", err: errors.New(`ent: missing required field "IntegrationDelivery.integration_attachment_id"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "IntegrationDelivery.organization"`)}
+	}
+	if len(_c.mutation.WorkflowRunIDs()) == 0 {
+		return &ValidationError{Name: "workflow_run", err: errors.New(`ent: missing required edge "IntegrationDelivery.workflow_run"`)}
+	}
+	if len(_c.mutation.IntegrationAttachmentIDs()) == 0 {
+		return &ValidationError{Name: "integration_attachment", err: errors.New(`ent: missing required edge "IntegrationDelivery.integration_attachment"`)}
+	}
+	return nil
+}
+
+func (_c *IntegrationDeliveryCreate) sqlSave(ctx context.Context) (*IntegrationDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *IntegrationDeliveryCreate) createSpec() (*IntegrationDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IntegrationDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(integrationdelivery.Table, sqlgraph.NewFieldSpec(integrationdelivery.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(integrationdelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(integrationdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(integrationdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.Duration(); ok {
+		_spec.SetField(integrationdelivery.FieldDuration, field.TypeInt64, value)
+		_node.Duration = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(integrationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := _c.mutation.Envelope(); ok {
+		_spec.SetField(integrationdelivery.FieldEnvelope, field.TypeJSON, value)
+		_node.Envelope = value
+	}
+	if value, ok := _c.mutation.DownloadBackendType(); ok {
+		_spec.SetField(integrationdelivery.FieldDownloadBackendType, field.TypeString, value)
+		_node.DownloadBackendType = value
+	}
+	if value, ok := _c.mutation.DownloadSecretName(); ok {
+		_spec.SetField(integrationdelivery.FieldDownloadSecretName, field.TypeString, value)
+		_node.DownloadSecretName = value
+	}
+	if value, ok := _c.mutation.WorkflowID(); ok {
+		_spec.SetField(integrationdelivery.FieldWorkflowID, field.TypeUUID, value)
+		_node.WorkflowID = value
+	}
+	if value, ok := _c.mutation.AttestationDigest(); ok {
+		_spec.SetField(integrationdelivery.FieldAttestationDigest, field.TypeString, value)
+		_node.AttestationDigest = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   integrationdelivery.OrganizationTable,
+			Columns: []string{integrationdelivery.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkflowRunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   integrationdelivery.WorkflowRunTable,
+			Columns: []string{integrationdelivery.WorkflowRunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowRunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IntegrationAttachmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   integrationdelivery.IntegrationAttachmentTable,
+			Columns: []string{integrationdelivery.IntegrationAttachmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(integrationattachment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IntegrationAttachmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IntegrationDelivery.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IntegrationDeliveryUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *IntegrationDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *IntegrationDeliveryUpsertOne {
+	_c.conflict = opts
+	return &IntegrationDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IntegrationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *IntegrationDeliveryCreate) OnConflictColumns(columns ...string) *IntegrationDeliveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &IntegrationDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// IntegrationDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one IntegrationDelivery node.
+	IntegrationDeliveryUpsertOne struct {
+		create *IntegrationDeliveryCreate
+	}
+
+	// IntegrationDeliveryUpsert is the "OnConflict" setter.
+	IntegrationDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *IntegrationDeliveryUpsert) SetStatus(v biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateStatus() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *IntegrationDeliveryUpsert) SetAttempts(v int) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateAttempts() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *IntegrationDeliveryUpsert) AddAttempts(v int) *IntegrationDeliveryUpsert {
+	u.Add(integrationdelivery.FieldAttempts, v)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *IntegrationDeliveryUpsert) SetLastError(v string) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateLastError() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *IntegrationDeliveryUpsert) ClearLastError() *IntegrationDeliveryUpsert {
+	u.SetNull(integrationdelivery.FieldLastError)
+	return u
+}
+
+// SetDuration sets the "duration" field.
+func (u *IntegrationDeliveryUpsert) SetDuration(v time.Duration) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldDuration, v)
+	return u
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateDuration() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldDuration)
+	return u
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *IntegrationDeliveryUpsert) AddDuration(v time.Duration) *IntegrationDeliveryUpsert {
+	u.Add(integrationdelivery.FieldDuration, v)
+	return u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (u *IntegrationDeliveryUpsert) ClearDuration() *IntegrationDeliveryUpsert {
+	u.SetNull(integrationdelivery.FieldDuration)
+	return u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *IntegrationDeliveryUpsert) SetNextAttemptAt(v time.Time) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldNextAttemptAt, v)
+	return u
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateNextAttemptAt() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldNextAttemptAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IntegrationDeliveryUpsert) SetUpdatedAt(v time.Time) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateUpdatedAt() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldUpdatedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *IntegrationDeliveryUpsert) SetFinishedAt(v time.Time) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateFinishedAt() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *IntegrationDeliveryUpsert) ClearFinishedAt() *IntegrationDeliveryUpsert {
+	u.SetNull(integrationdelivery.FieldFinishedAt)
+	return u
+}
+
+// SetEnvelope sets the "envelope" field.
+func (u *IntegrationDeliveryUpsert) SetEnvelope(v *dsse.Envelope) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldEnvelope, v)
+	return u
+}
+
+// UpdateEnvelope sets the "envelope" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateEnvelope() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldEnvelope)
+	return u
+}
+
+// ClearEnvelope clears the value of the "envelope" field.
+func (u *IntegrationDeliveryUpsert) ClearEnvelope() *IntegrationDeliveryUpsert {
+	u.SetNull(integrationdelivery.FieldEnvelope)
+	return u
+}
+
+// SetDownloadBackendType sets the "download_backend_type" field.
+func (u *IntegrationDeliveryUpsert) SetDownloadBackendType(v string) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldDownloadBackendType, v)
+	return u
+}
+
+// UpdateDownloadBackendType sets the "download_backend_type" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateDownloadBackendType() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldDownloadBackendType)
+	return u
+}
+
+// ClearDownloadBackendType clears the value of the "download_backend_type" field.
+func (u *IntegrationDeliveryUpsert) ClearDownloadBackendType() *IntegrationDeliveryUpsert {
+	u.SetNull(integrationdelivery.FieldDownloadBackendType)
+	return u
+}
+
+// SetDownloadSecretName sets the "download_secret_name" field.
+func (u *IntegrationDeliveryUpsert) SetDownloadSecretName(v string) *IntegrationDeliveryUpsert {
+	u.Set(integrationdelivery.FieldDownloadSecretName, v)
+	return u
+}
+
+// UpdateDownloadSecretName sets the "download_secret_name" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsert) UpdateDownloadSecretName() *IntegrationDeliveryUpsert {
+	u.SetExcluded(integrationdelivery.FieldDownloadSecretName)
+	return u
+}
+
+// ClearDownloadSecretName clears the value of the "download_secret_name" field.
+func (u *IntegrationDeliveryUpsert) ClearDownloadSecretName() *IntegrationDeliveryUpsert {
+	u.SetNull(integrationdelivery.FieldDownloadSecretName)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.IntegrationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(integrationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IntegrationDeliveryUpsertOne) UpdateNewValues() *IntegrationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(integrationdelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(integrationdelivery.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.WorkflowID(); exists {
+			s.SetIgnore(integrationdelivery.FieldWorkflowID)
+		}
+		if _, exists := u.create.mutation.AttestationDigest(); exists {
+			s.SetIgnore(integrationdelivery.FieldAttestationDigest)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(integrationdelivery.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.WorkflowRunID(); exists {
+			s.SetIgnore(integrationdelivery.FieldWorkflowRunID)
+		}
+		if _, exists := u.create.mutation.IntegrationAttachmentID(); exists {
+			s.SetIgnore(integrationdelivery.FieldIntegrationAttachmentID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IntegrationDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *IntegrationDeliveryUpsertOne) Ignore() *IntegrationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IntegrationDeliveryUpsertOne) DoNothing() *IntegrationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IntegrationDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *IntegrationDeliveryUpsertOne) Update(set func(*IntegrationDeliveryUpsert)) *IntegrationDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IntegrationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *IntegrationDeliveryUpsertOne) SetStatus(v biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateStatus() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *IntegrationDeliveryUpsertOne) SetAttempts(v int) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *IntegrationDeliveryUpsertOne) AddAttempts(v int) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateAttempts() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *IntegrationDeliveryUpsertOne) SetLastError(v string) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateLastError() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *IntegrationDeliveryUpsertOne) ClearLastError() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *IntegrationDeliveryUpsertOne) SetDuration(v time.Duration) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *IntegrationDeliveryUpsertOne) AddDuration(v time.Duration) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateDuration() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (u *IntegrationDeliveryUpsertOne) ClearDuration() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearDuration()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *IntegrationDeliveryUpsertOne) SetNextAttemptAt(v time.Time) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateNextAttemptAt() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IntegrationDeliveryUpsertOne) SetUpdatedAt(v time.Time) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateUpdatedAt() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *IntegrationDeliveryUpsertOne) SetFinishedAt(v time.Time) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateFinishedAt() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *IntegrationDeliveryUpsertOne) ClearFinishedAt() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetEnvelope sets the "envelope" field.
+func (u *IntegrationDeliveryUpsertOne) SetEnvelope(v *dsse.Envelope) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetEnvelope(v)
+	})
+}
+
+// UpdateEnvelope sets the "envelope" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateEnvelope() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateEnvelope()
+	})
+}
+
+// ClearEnvelope clears the value of the "envelope" field.
+func (u *IntegrationDeliveryUpsertOne) ClearEnvelope() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearEnvelope()
+	})
+}
+
+// SetDownloadBackendType sets the "download_backend_type" field.
+func (u *IntegrationDeliveryUpsertOne) SetDownloadBackendType(v string) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetDownloadBackendType(v)
+	})
+}
+
+// UpdateDownloadBackendType sets the "download_backend_type" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateDownloadBackendType() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateDownloadBackendType()
+	})
+}
+
+// ClearDownloadBackendType clears the value of the "download_backend_type" field.
+func (u *IntegrationDeliveryUpsertOne) ClearDownloadBackendType() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearDownloadBackendType()
+	})
+}
+
+// SetDownloadSecretName sets the "download_secret_name" field.
+func (u *IntegrationDeliveryUpsertOne) SetDownloadSecretName(v string) *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetDownloadSecretName(v)
+	})
+}
+
+// UpdateDownloadSecretName sets the "download_secret_name" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertOne) UpdateDownloadSecretName() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateDownloadSecretName()
+	})
+}
+
+// ClearDownloadSecretName clears the value of the "download_secret_name" field.
+func (u *IntegrationDeliveryUpsertOne) ClearDownloadSecretName() *IntegrationDeliveryUpsertOne {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearDownloadSecretName()
+	})
+}
+
+// Exec executes the query.
+func (u *IntegrationDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IntegrationDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IntegrationDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *IntegrationDeliveryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: IntegrationDeliveryUpsertOne.ID is not supported by MySQL driver. Use IntegrationDeliveryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *IntegrationDeliveryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// IntegrationDeliveryCreateBulk is the builder for creating many IntegrationDelivery entities in bulk.
+type IntegrationDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*IntegrationDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the IntegrationDelivery entities in the database.
+func (_c *IntegrationDeliveryCreateBulk) Save(ctx context.Context) ([]*IntegrationDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*IntegrationDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IntegrationDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *IntegrationDeliveryCreateBulk) SaveX(ctx context.Context) []*IntegrationDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *IntegrationDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *IntegrationDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.IntegrationDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.IntegrationDeliveryUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *IntegrationDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *IntegrationDeliveryUpsertBulk {
+	_c.conflict = opts
+	return &IntegrationDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.IntegrationDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *IntegrationDeliveryCreateBulk) OnConflictColumns(columns ...string) *IntegrationDeliveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &IntegrationDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// IntegrationDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of IntegrationDelivery nodes.
+type IntegrationDeliveryUpsertBulk struct {
+	create *IntegrationDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.IntegrationDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(integrationdelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *IntegrationDeliveryUpsertBulk) UpdateNewValues() *IntegrationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(integrationdelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(integrationdelivery.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.WorkflowID(); exists {
+				s.SetIgnore(integrationdelivery.FieldWorkflowID)
+			}
+			if _, exists := b.mutation.AttestationDigest(); exists {
+				s.SetIgnore(integrationdelivery.FieldAttestationDigest)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(integrationdelivery.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.WorkflowRunID(); exists {
+				s.SetIgnore(integrationdelivery.FieldWorkflowRunID)
+			}
+			if _, exists := b.mutation.IntegrationAttachmentID(); exists {
+				s.SetIgnore(integrationdelivery.FieldIntegrationAttachmentID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.IntegrationDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *IntegrationDeliveryUpsertBulk) Ignore() *IntegrationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *IntegrationDeliveryUpsertBulk) DoNothing() *IntegrationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the IntegrationDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *IntegrationDeliveryUpsertBulk) Update(set func(*IntegrationDeliveryUpsert)) *IntegrationDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&IntegrationDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *IntegrationDeliveryUpsertBulk) SetStatus(v biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateStatus() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *IntegrationDeliveryUpsertBulk) SetAttempts(v int) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *IntegrationDeliveryUpsertBulk) AddAttempts(v int) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateAttempts() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *IntegrationDeliveryUpsertBulk) SetLastError(v string) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateLastError() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *IntegrationDeliveryUpsertBulk) ClearLastError() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetDuration sets the "duration" field.
+func (u *IntegrationDeliveryUpsertBulk) SetDuration(v time.Duration) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetDuration(v)
+	})
+}
+
+// AddDuration adds v to the "duration" field.
+func (u *IntegrationDeliveryUpsertBulk) AddDuration(v time.Duration) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.AddDuration(v)
+	})
+}
+
+// UpdateDuration sets the "duration" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateDuration() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateDuration()
+	})
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (u *IntegrationDeliveryUpsertBulk) ClearDuration() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearDuration()
+	})
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (u *IntegrationDeliveryUpsertBulk) SetNextAttemptAt(v time.Time) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetNextAttemptAt(v)
+	})
+}
+
+// UpdateNextAttemptAt sets the "next_attempt_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateNextAttemptAt() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateNextAttemptAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *IntegrationDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateUpdatedAt() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *IntegrationDeliveryUpsertBulk) SetFinishedAt(v time.Time) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateFinishedAt() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *IntegrationDeliveryUpsertBulk) ClearFinishedAt() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetEnvelope sets the "envelope" field.
+func (u *IntegrationDeliveryUpsertBulk) SetEnvelope(v *dsse.Envelope) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetEnvelope(v)
+	})
+}
+
+// UpdateEnvelope sets the "envelope" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateEnvelope() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateEnvelope()
+	})
+}
+
+// ClearEnvelope clears the value of the "envelope" field.
+func (u *IntegrationDeliveryUpsertBulk) ClearEnvelope() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearEnvelope()
+	})
+}
+
+// SetDownloadBackendType sets the "download_backend_type" field.
+func (u *IntegrationDeliveryUpsertBulk) SetDownloadBackendType(v string) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetDownloadBackendType(v)
+	})
+}
+
+// UpdateDownloadBackendType sets the "download_backend_type" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateDownloadBackendType() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateDownloadBackendType()
+	})
+}
+
+// ClearDownloadBackendType clears the value of the "download_backend_type" field.
+func (u *IntegrationDeliveryUpsertBulk) ClearDownloadBackendType() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearDownloadBackendType()
+	})
+}
+
+// SetDownloadSecretName sets the "download_secret_name" field.
+func (u *IntegrationDeliveryUpsertBulk) SetDownloadSecretName(v string) *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.SetDownloadSecretName(v)
+	})
+}
+
+// UpdateDownloadSecretName sets the "download_secret_name" field to the value that was provided on create.
+func (u *IntegrationDeliveryUpsertBulk) UpdateDownloadSecretName() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.UpdateDownloadSecretName()
+	})
+}
+
+// ClearDownloadSecretName clears the value of the "download_secret_name" field.
+func (u *IntegrationDeliveryUpsertBulk) ClearDownloadSecretName() *IntegrationDeliveryUpsertBulk {
+	return u.Update(func(s *IntegrationDeliveryUpsert) {
+		s.ClearDownloadSecretName()
+	})
+}
+
+// Exec executes the query.
+func (u *IntegrationDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the IntegrationDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for IntegrationDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *IntegrationDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// IntegrationDeliveryDelete is the builder for deleting a IntegrationDelivery entity.
+type IntegrationDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *IntegrationDeliveryMutation
+}
+
+// Where appends a list predicates to the IntegrationDeliveryDelete builder.
+func (_d *IntegrationDeliveryDelete) Where(ps ...predicate.IntegrationDelivery) *IntegrationDeliveryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *IntegrationDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *IntegrationDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *IntegrationDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(integrationdelivery.Table, sqlgraph.NewFieldSpec(integrationdelivery.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// IntegrationDeliveryDeleteOne is the builder for deleting a single IntegrationDelivery entity.
+type IntegrationDeliveryDeleteOne struct {
+	_d *IntegrationDeliveryDelete
+}
+
+// Where appends a list predicates to the IntegrationDeliveryDelete builder.
+func (_d *IntegrationDeliveryDeleteOne) Where(ps ...predicate.IntegrationDelivery) *IntegrationDeliveryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *IntegrationDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{integrationdelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *IntegrationDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
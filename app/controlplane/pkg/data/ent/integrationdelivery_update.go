@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+// IntegrationDeliveryUpdate is the builder for updating IntegrationDelivery entities.
+type IntegrationDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *IntegrationDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the IntegrationDeliveryUpdate builder.
+func (_u *IntegrationDeliveryUpdate) Where(ps ...predicate.IntegrationDelivery) *IntegrationDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *IntegrationDeliveryUpdate) SetStatus(v biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableStatus(v *biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *IntegrationDeliveryUpdate) SetAttempts(v int) *IntegrationDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableAttempts(v *int) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *IntegrationDeliveryUpdate) AddAttempts(v int) *IntegrationDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *IntegrationDeliveryUpdate) SetLastError(v string) *IntegrationDeliveryUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableLastError(v *string) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *IntegrationDeliveryUpdate) ClearLastError() *IntegrationDeliveryUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *IntegrationDeliveryUpdate) SetDuration(v time.Duration) *IntegrationDeliveryUpdate {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableDuration(v *time.Duration) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *IntegrationDeliveryUpdate) AddDuration(v time.Duration) *IntegrationDeliveryUpdate {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *IntegrationDeliveryUpdate) ClearDuration() *IntegrationDeliveryUpdate {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *IntegrationDeliveryUpdate) SetNextAttemptAt(v time.Time) *IntegrationDeliveryUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableNextAttemptAt(v *time.Time) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IntegrationDeliveryUpdate) SetUpdatedAt(v time.Time) *IntegrationDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *IntegrationDeliveryUpdate) SetFinishedAt(v time.Time) *IntegrationDeliveryUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableFinishedAt(v *time.Time) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *IntegrationDeliveryUpdate) ClearFinishedAt() *IntegrationDeliveryUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetEnvelope sets the "envelope" field.
+func (_u *IntegrationDeliveryUpdate) SetEnvelope(v *dsse.Envelope) *IntegrationDeliveryUpdate {
+	_u.mutation.SetEnvelope(v)
+	return _u
+}
+
+// ClearEnvelope clears the value of the "envelope" field.
+func (_u *IntegrationDeliveryUpdate) ClearEnvelope() *IntegrationDeliveryUpdate {
+	_u.mutation.ClearEnvelope()
+	return _u
+}
+
+// SetDownloadBackendType sets the "download_backend_type" field.
+func (_u *IntegrationDeliveryUpdate) SetDownloadBackendType(v string) *IntegrationDeliveryUpdate {
+	_u.mutation.SetDownloadBackendType(v)
+	return _u
+}
+
+// SetNillableDownloadBackendType sets the "download_backend_type" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableDownloadBackendType(v *string) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetDownloadBackendType(*v)
+	}
+	return _u
+}
+
+// ClearDownloadBackendType clears the value of the "download_backend_type" field.
+func (_u *IntegrationDeliveryUpdate) ClearDownloadBackendType() *IntegrationDeliveryUpdate {
+	_u.mutation.ClearDownloadBackendType()
+	return _u
+}
+
+// SetDownloadSecretName sets the "download_secret_name" field.
+func (_u *IntegrationDeliveryUpdate) SetDownloadSecretName(v string) *IntegrationDeliveryUpdate {
+	_u.mutation.SetDownloadSecretName(v)
+	return _u
+}
+
+// SetNillableDownloadSecretName sets the "download_secret_name" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdate) SetNillableDownloadSecretName(v *string) *IntegrationDeliveryUpdate {
+	if v != nil {
+		_u.SetDownloadSecretName(*v)
+	}
+	return _u
+}
+
+// ClearDownloadSecretName clears the value of the "download_secret_name" field.
+func (_u *IntegrationDeliveryUpdate) ClearDownloadSecretName() *IntegrationDeliveryUpdate {
+	_u.mutation.ClearDownloadSecretName()
+	return _u
+}
+
+// Mutation returns the IntegrationDeliveryMutation object of the builder.
+func (_u *IntegrationDeliveryUpdate) Mutation() *IntegrationDeliveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *IntegrationDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IntegrationDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *IntegrationDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IntegrationDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IntegrationDeliveryUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := integrationdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IntegrationDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := integrationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IntegrationDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationDelivery.organization"`)
+	}
+	if _u.mutation.WorkflowRunCleared() && len(_u.mutation.WorkflowRunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationDelivery.workflow_run"`)
+	}
+	if _u.mutation.IntegrationAttachmentCleared() && len(_u.mutation.IntegrationAttachmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationDelivery.integration_attachment"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *IntegrationDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IntegrationDeliveryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *IntegrationDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(integrationdelivery.Table, integrationdelivery.Columns, sqlgraph.NewFieldSpec(integrationdelivery.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(integrationdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(integrationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(integrationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(integrationdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(integrationdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(integrationdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(integrationdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(integrationdelivery.FieldDuration, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(integrationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(integrationdelivery.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Envelope(); ok {
+		_spec.SetField(integrationdelivery.FieldEnvelope, field.TypeJSON, value)
+	}
+	if _u.mutation.EnvelopeCleared() {
+		_spec.ClearField(integrationdelivery.FieldEnvelope, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DownloadBackendType(); ok {
+		_spec.SetField(integrationdelivery.FieldDownloadBackendType, field.TypeString, value)
+	}
+	if _u.mutation.DownloadBackendTypeCleared() {
+		_spec.ClearField(integrationdelivery.FieldDownloadBackendType, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadSecretName(); ok {
+		_spec.SetField(integrationdelivery.FieldDownloadSecretName, field.TypeString, value)
+	}
+	if _u.mutation.DownloadSecretNameCleared() {
+		_spec.ClearField(integrationdelivery.FieldDownloadSecretName, field.TypeString)
+	}
+	if _u.mutation.AttestationDigestCleared() {
+		_spec.ClearField(integrationdelivery.FieldAttestationDigest, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{integrationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// IntegrationDeliveryUpdateOne is the builder for updating a single IntegrationDelivery entity.
+type IntegrationDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *IntegrationDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *IntegrationDeliveryUpdateOne) SetStatus(v biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableStatus(v *biz.IntegrationDeliveryStatus) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *IntegrationDeliveryUpdateOne) SetAttempts(v int) *IntegrationDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableAttempts(v *int) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *IntegrationDeliveryUpdateOne) AddAttempts(v int) *IntegrationDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *IntegrationDeliveryUpdateOne) SetLastError(v string) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableLastError(v *string) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *IntegrationDeliveryUpdateOne) ClearLastError() *IntegrationDeliveryUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetDuration sets the "duration" field.
+func (_u *IntegrationDeliveryUpdateOne) SetDuration(v time.Duration) *IntegrationDeliveryUpdateOne {
+	_u.mutation.ResetDuration()
+	_u.mutation.SetDuration(v)
+	return _u
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableDuration(v *time.Duration) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDuration(*v)
+	}
+	return _u
+}
+
+// AddDuration adds value to the "duration" field.
+func (_u *IntegrationDeliveryUpdateOne) AddDuration(v time.Duration) *IntegrationDeliveryUpdateOne {
+	_u.mutation.AddDuration(v)
+	return _u
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (_u *IntegrationDeliveryUpdateOne) ClearDuration() *IntegrationDeliveryUpdateOne {
+	_u.mutation.ClearDuration()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *IntegrationDeliveryUpdateOne) SetNextAttemptAt(v time.Time) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableNextAttemptAt(v *time.Time) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *IntegrationDeliveryUpdateOne) SetUpdatedAt(v time.Time) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *IntegrationDeliveryUpdateOne) SetFinishedAt(v time.Time) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableFinishedAt(v *time.Time) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *IntegrationDeliveryUpdateOne) ClearFinishedAt() *IntegrationDeliveryUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetEnvelope sets the "envelope" field.
+func (_u *IntegrationDeliveryUpdateOne) SetEnvelope(v *dsse.Envelope) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetEnvelope(v)
+	return _u
+}
+
+// ClearEnvelope clears the value of the "envelope" field.
+func (_u *IntegrationDeliveryUpdateOne) ClearEnvelope() *IntegrationDeliveryUpdateOne {
+	_u.mutation.ClearEnvelope()
+	return _u
+}
+
+// SetDownloadBackendType sets the "download_backend_type" field.
+func (_u *IntegrationDeliveryUpdateOne) SetDownloadBackendType(v string) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetDownloadBackendType(v)
+	return _u
+}
+
+// SetNillableDownloadBackendType sets the "download_backend_type" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableDownloadBackendType(v *string) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDownloadBackendType(*v)
+	}
+	return _u
+}
+
+// ClearDownloadBackendType clears the value of the "download_backend_type" field.
+func (_u *IntegrationDeliveryUpdateOne) ClearDownloadBackendType() *IntegrationDeliveryUpdateOne {
+	_u.mutation.ClearDownloadBackendType()
+	return _u
+}
+
+// SetDownloadSecretName sets the "download_secret_name" field.
+func (_u *IntegrationDeliveryUpdateOne) SetDownloadSecretName(v string) *IntegrationDeliveryUpdateOne {
+	_u.mutation.SetDownloadSecretName(v)
+	return _u
+}
+
+// SetNillableDownloadSecretName sets the "download_secret_name" field if the given value is not nil.
+func (_u *IntegrationDeliveryUpdateOne) SetNillableDownloadSecretName(v *string) *IntegrationDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDownloadSecretName(*v)
+	}
+	return _u
+}
+
+// ClearDownloadSecretName clears the value of the "download_secret_name" field.
+func (_u *IntegrationDeliveryUpdateOne) ClearDownloadSecretName() *IntegrationDeliveryUpdateOne {
+	_u.mutation.ClearDownloadSecretName()
+	return _u
+}
+
+// Mutation returns the IntegrationDeliveryMutation object of the builder.
+func (_u *IntegrationDeliveryUpdateOne) Mutation() *IntegrationDeliveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the IntegrationDeliveryUpdate builder.
+func (_u *IntegrationDeliveryUpdateOne) Where(ps ...predicate.IntegrationDelivery) *IntegrationDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *IntegrationDeliveryUpdateOne) Select(field string, fields ...string) *IntegrationDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated IntegrationDelivery entity.
+func (_u *IntegrationDeliveryUpdateOne) Save(ctx context.Context) (*IntegrationDelivery, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *IntegrationDeliveryUpdateOne) SaveX(ctx context.Context) *IntegrationDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *IntegrationDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *IntegrationDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *IntegrationDeliveryUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := integrationdelivery.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *IntegrationDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := integrationdelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "IntegrationDelivery.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationDelivery.organization"`)
+	}
+	if _u.mutation.WorkflowRunCleared() && len(_u.mutation.WorkflowRunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationDelivery.workflow_run"`)
+	}
+	if _u.mutation.IntegrationAttachmentCleared() && len(_u.mutation.IntegrationAttachmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IntegrationDelivery.integration_attachment"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *IntegrationDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *IntegrationDeliveryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *IntegrationDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *IntegrationDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(integrationdelivery.Table, integrationdelivery.Columns, sqlgraph.NewFieldSpec(integrationdelivery.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "IntegrationDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, integrationdelivery.FieldID)
+		for _, f := range fields {
+			if !integrationdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != integrationdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(integrationdelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(integrationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(integrationdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(integrationdelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(integrationdelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Duration(); ok {
+		_spec.SetField(integrationdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDuration(); ok {
+		_spec.AddField(integrationdelivery.FieldDuration, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationCleared() {
+		_spec.ClearField(integrationdelivery.FieldDuration, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(integrationdelivery.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(integrationdelivery.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(integrationdelivery.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Envelope(); ok {
+		_spec.SetField(integrationdelivery.FieldEnvelope, field.TypeJSON, value)
+	}
+	if _u.mutation.EnvelopeCleared() {
+		_spec.ClearField(integrationdelivery.FieldEnvelope, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DownloadBackendType(); ok {
+		_spec.SetField(integrationdelivery.FieldDownloadBackendType, field.TypeString, value)
+	}
+	if _u.mutation.DownloadBackendTypeCleared() {
+		_spec.ClearField(integrationdelivery.FieldDownloadBackendType, field.TypeString)
+	}
+	if value, ok := _u.mutation.DownloadSecretName(); ok {
+		_spec.SetField(integrationdelivery.FieldDownloadSecretName, field.TypeString, value)
+	}
+	if _u.mutation.DownloadSecretNameCleared() {
+		_spec.ClearField(integrationdelivery.FieldDownloadSecretName, field.TypeString)
+	}
+	if _u.mutation.AttestationDigestCleared() {
+		_spec.ClearField(integrationdelivery.FieldAttestationDigest, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &IntegrationDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{integrationdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
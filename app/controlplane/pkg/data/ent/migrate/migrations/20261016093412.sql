@@ -0,0 +1,6 @@
+-- Create "integration_deliveries" table
+CREATE TABLE "integration_deliveries" ("id" uuid NOT NULL, "status" character varying NOT NULL DEFAULT 'pending', "attempts" bigint NOT NULL DEFAULT 0, "last_error" text NULL, "next_attempt_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "finished_at" timestamptz NULL, "envelope" jsonb NOT NULL, "download_backend_type" character varying NULL, "download_secret_name" character varying NULL, "workflow_id" uuid NOT NULL, "organization_id" uuid NOT NULL, "workflow_run_id" uuid NOT NULL, "integration_attachment_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "integration_deliveries_integra_b8e4609dc9fbb13fe3b4ff7adca02e82" FOREIGN KEY ("integration_attachment_id") REFERENCES "integration_attachments" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "integration_deliveries_organizations_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "integration_deliveries_workflow_runs_workflow_run" FOREIGN KEY ("workflow_run_id") REFERENCES "workflow_runs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "integrationdelivery_integration_attachment_id_created_at" to table: "integration_deliveries"
+CREATE INDEX "integrationdelivery_integration_attachment_id_created_at" ON "integration_deliveries" ("integration_attachment_id", "created_at");
+-- Create index "integrationdelivery_status_next_attempt_at" to table: "integration_deliveries"
+CREATE INDEX "integrationdelivery_status_next_attempt_at" ON "integration_deliveries" ("status", "next_attempt_at");
@@ -0,0 +1,2 @@
+-- Modify "integration_deliveries" table
+ALTER TABLE "integration_deliveries" ADD COLUMN "duration" bigint NULL;
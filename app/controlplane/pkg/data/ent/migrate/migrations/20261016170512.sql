@@ -0,0 +1,6 @@
+-- Create "cas_retention_rules" table
+CREATE TABLE "cas_retention_rules" ("id" uuid NOT NULL, "max_age_days" bigint NOT NULL, "keep_released_versions" boolean NOT NULL DEFAULT true, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "updated_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "last_swept_at" timestamptz NULL, "organization_id" uuid NOT NULL, "cas_backend_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "cas_retention_rules_cas_backends_cas_backend" FOREIGN KEY ("cas_backend_id") REFERENCES "cas_backends" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "cas_retention_rules_organizations_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "casretentionrule_organization_id" to table: "cas_retention_rules"
+CREATE UNIQUE INDEX "casretentionrule_organization_id" ON "cas_retention_rules" ("organization_id") WHERE (cas_backend_id IS NULL);
+-- Create index "casretentionrule_organization_id_cas_backend_id" to table: "cas_retention_rules"
+CREATE UNIQUE INDEX "casretentionrule_organization_id_cas_backend_id" ON "cas_retention_rules" ("organization_id", "cas_backend_id") WHERE (cas_backend_id IS NOT NULL);
@@ -0,0 +1,2 @@
+-- Modify "cas_backends" table
+ALTER TABLE "cas_backends" ADD COLUMN "primary_backend_id" uuid NULL, ADD CONSTRAINT "cas_backends_cas_backends_replicas" FOREIGN KEY ("primary_backend_id") REFERENCES "cas_backends" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,12 @@
+-- Create index "workflowrun_organization_id_runner_type_created_at" to table: "workflow_runs"
+CREATE INDEX "workflowrun_organization_id_runner_type_created_at" ON "workflow_runs" ("organization_id", "runner_type", "created_at" DESC);
+-- Create "workflow_run_search_entries" table
+CREATE TABLE "workflow_run_search_entries" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "organization_id" uuid NOT NULL, "kind" character varying NOT NULL, "name" character varying NOT NULL, "type" character varying NULL, "digest" character varying NULL, "value" text NULL, "workflowrun_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "workflow_run_search_entries_workflow_runs_search_entries" FOREIGN KEY ("workflowrun_id") REFERENCES "workflow_runs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "workflowrunsearchentry_organization_id_kind_digest" to table: "workflow_run_search_entries"
+CREATE INDEX "workflowrunsearchentry_organization_id_kind_digest" ON "workflow_run_search_entries" ("organization_id", "kind", "digest");
+-- Create index "workflowrunsearchentry_organization_id_kind_name" to table: "workflow_run_search_entries"
+CREATE INDEX "workflowrunsearchentry_organization_id_kind_name" ON "workflow_run_search_entries" ("organization_id", "kind", "name");
+-- Create index "workflowrunsearchentry_organization_id_kind_type" to table: "workflow_run_search_entries"
+CREATE INDEX "workflowrunsearchentry_organization_id_kind_type" ON "workflow_run_search_entries" ("organization_id", "kind", "type");
+-- Create index "workflowrunsearchentry_workflowrun_id" to table: "workflow_run_search_entries"
+CREATE INDEX "workflowrunsearchentry_workflowrun_id" ON "workflow_run_search_entries" ("workflowrun_id");
@@ -0,0 +1,18 @@
+-- Create "policy_evaluations" table
+CREATE TABLE "policy_evaluations" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "organization_id" uuid NOT NULL, "project_id" uuid NOT NULL, "workflow_id" uuid NOT NULL, "name" character varying NOT NULL, "material_name" character varying NULL, "material_type" character varying NULL, "policy_uri" character varying NULL, "policy_digest" character varying NULL, "group_name" character varying NULL, "status" character varying NOT NULL, "skip_reasons" jsonb NULL, "gate" boolean NOT NULL DEFAULT false, "violations_count" bigint NOT NULL DEFAULT 0, "latest" boolean NOT NULL DEFAULT true, "workflowrun_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "policy_evaluations_workflow_runs_policy_evaluations" FOREIGN KEY ("workflowrun_id") REFERENCES "workflow_runs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "policyevaluation_organization_id_latest_name" to table: "policy_evaluations"
+CREATE INDEX "policyevaluation_organization_id_latest_name" ON "policy_evaluations" ("organization_id", "latest", "name");
+-- Create index "policyevaluation_organization_id_name_created_at" to table: "policy_evaluations"
+CREATE INDEX "policyevaluation_organization_id_name_created_at" ON "policy_evaluations" ("organization_id", "name", "created_at" DESC);
+-- Create index "policyevaluation_workflow_id_latest" to table: "policy_evaluations"
+CREATE INDEX "policyevaluation_workflow_id_latest" ON "policy_evaluations" ("workflow_id", "latest");
+-- Create index "policyevaluation_workflowrun_id" to table: "policy_evaluations"
+CREATE INDEX "policyevaluation_workflowrun_id" ON "policy_evaluations" ("workflowrun_id");
+-- Create "policy_violations" table
+CREATE TABLE "policy_violations" ("id" uuid NOT NULL, "organization_id" uuid NOT NULL, "subject" character varying NULL, "message" text NOT NULL, "suppressed" boolean NOT NULL DEFAULT false, "finding_type" character varying NULL, "severity" character varying NULL, "external_id" character varying NULL, "package_purl" character varying NULL, "finding" jsonb NULL, "policy_evaluation_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "policy_violations_policy_evaluations_violations" FOREIGN KEY ("policy_evaluation_id") REFERENCES "policy_evaluations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "policyviolation_organization_id_external_id" to table: "policy_violations"
+CREATE INDEX "policyviolation_organization_id_external_id" ON "policy_violations" ("organization_id", "external_id");
+-- Create index "policyviolation_organization_id_finding_type_severity" to table: "policy_violations"
+CREATE INDEX "policyviolation_organization_id_finding_type_severity" ON "policy_violations" ("organization_id", "finding_type", "severity");
+-- Create index "policyviolation_policy_evaluation_id" to table: "policy_violations"
+CREATE INDEX "policyviolation_policy_evaluation_id" ON "policy_violations" ("policy_evaluation_id");
@@ -0,0 +1,8 @@
+-- Create "policy_exceptions" table
+CREATE TABLE "policy_exceptions" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "policy_name" character varying NULL, "material_name" character varying NULL, "external_id" character varying NULL, "justification" text NOT NULL, "created_by" character varying NOT NULL, "approved_by" character varying NULL, "approved_at" timestamptz NULL, "expires_at" timestamptz NOT NULL, "revoked_at" timestamptz NULL, "expiration_processed_at" timestamptz NULL, "organization_id" uuid NOT NULL, "project_id" uuid NULL, "workflow_id" uuid NULL, PRIMARY KEY ("id"), CONSTRAINT "policy_exceptions_organizations_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "policy_exceptions_projects_project" FOREIGN KEY ("project_id") REFERENCES "projects" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, CONSTRAINT "policy_exceptions_workflows_workflow" FOREIGN KEY ("workflow_id") REFERENCES "workflows" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "policyexception_expires_at" to table: "policy_exceptions"
+CREATE INDEX "policyexception_expires_at" ON "policy_exceptions" ("expires_at") WHERE (expiration_processed_at IS NULL);
+-- Create index "policyexception_organization_id_created_at" to table: "policy_exceptions"
+CREATE INDEX "policyexception_organization_id_created_at" ON "policy_exceptions" ("organization_id", "created_at" DESC);
+-- Modify "policy_violations" table
+ALTER TABLE "policy_violations" ADD COLUMN "exception_id" character varying NULL;
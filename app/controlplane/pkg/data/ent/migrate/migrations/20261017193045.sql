@@ -0,0 +1,6 @@
+-- Modify "workflow_runs" table
+ALTER TABLE "workflow_runs" ADD COLUMN "search_indexed_at" timestamptz NULL;
+-- Create index "workflowrun_search_indexed_at" to table: "workflow_runs"
+CREATE INDEX "workflowrun_search_indexed_at" ON "workflow_runs" ("search_indexed_at") WHERE ((search_indexed_at IS NULL) AND (attestation_digest IS NOT NULL));
+-- The runs already indexed don't need to be backfilled
+UPDATE "workflow_runs" SET "search_indexed_at" = CURRENT_TIMESTAMP WHERE "id" IN (SELECT DISTINCT "workflowrun_id" FROM "workflow_run_search_entries");
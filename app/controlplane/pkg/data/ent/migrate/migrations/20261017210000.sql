@@ -0,0 +1,6 @@
+-- Create "cas_blob_deletions" table
+CREATE TABLE "cas_blob_deletions" ("id" uuid NOT NULL, "digest" character varying NOT NULL, "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "cas_backend_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "cas_blob_deletions_cas_backends_cas_backend" FOREIGN KEY ("cas_backend_id") REFERENCES "cas_backends" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "casblobdeletion_cas_backend_id" to table: "cas_blob_deletions"
+CREATE INDEX "casblobdeletion_cas_backend_id" ON "cas_blob_deletions" ("cas_backend_id");
+-- Create index "casblobdeletion_digest_cas_backend_id" to table: "cas_blob_deletions"
+CREATE UNIQUE INDEX "casblobdeletion_digest_cas_backend_id" ON "cas_blob_deletions" ("digest", "cas_backend_id");
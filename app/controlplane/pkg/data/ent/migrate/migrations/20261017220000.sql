@@ -0,0 +1,4 @@
+-- Modify "integration_deliveries" table
+ALTER TABLE "integration_deliveries" ADD COLUMN "attestation_digest" character varying NULL;
+-- Create index "integrationdelivery_attestation_digest_integration_attachment_id" to table: "integration_deliveries"
+CREATE UNIQUE INDEX "integrationdelivery_attestation_digest_integration_attachment_id" ON "integration_deliveries" ("attestation_digest", "integration_attachment_id");
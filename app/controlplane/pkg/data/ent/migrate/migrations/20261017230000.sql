@@ -0,0 +1,6 @@
+-- Attestation policies are stored with an empty material name so they are covered by the unique index
+UPDATE "policy_evaluations" SET "material_name" = '' WHERE "material_name" IS NULL;
+-- Keep a single latest evaluation per policy and material, the most recent one
+UPDATE "policy_evaluations" AS "pe" SET "latest" = false WHERE "pe"."latest" AND EXISTS (SELECT 1 FROM "policy_evaluations" AS "o" WHERE "o"."latest" AND "o"."workflow_id" = "pe"."workflow_id" AND "o"."name" = "pe"."name" AND "o"."material_name" = "pe"."material_name" AND ("o"."created_at", "o"."id") > ("pe"."created_at", "pe"."id"));
+-- Create index "policyevaluation_workflow_id_name_material_name" to table: "policy_evaluations"
+CREATE UNIQUE INDEX "policyevaluation_workflow_id_name_material_name" ON "policy_evaluations" ("workflow_id", "name", "material_name") WHERE latest;
@@ -0,0 +1,2 @@
+-- Modify "integration_deliveries" table, the envelope is cleared once the delivery succeeds
+ALTER TABLE "integration_deliveries" ALTER COLUMN "envelope" DROP NOT NULL;
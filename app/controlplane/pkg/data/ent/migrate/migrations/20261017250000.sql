@@ -0,0 +1,6 @@
+-- Create "policy_impact_analyses" table
+CREATE TABLE "policy_impact_analyses" ("id" uuid NOT NULL, "status" character varying NOT NULL DEFAULT 'running', "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP, "finished_at" timestamptz NULL, "report" jsonb NULL, "error" text NULL, "organization_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "policy_impact_analyses_organizations_organization" FOREIGN KEY ("organization_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- Create index "policyimpactanalysis_organization_id" to table: "policy_impact_analyses"
+CREATE UNIQUE INDEX "policyimpactanalysis_organization_id" ON "policy_impact_analyses" ("organization_id") WHERE ((status)::text = 'running'::text);
+-- Create index "policyimpactanalysis_organization_id_created_at" to table: "policy_impact_analyses"
+CREATE INDEX "policyimpactanalysis_organization_id_created_at" ON "policy_impact_analyses" ("organization_id", "created_at" DESC);
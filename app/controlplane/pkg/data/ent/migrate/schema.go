@@ -102,7 +102,7 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "location", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
-		{Name: "provider", Type: field.TypeEnum, Enums: []string{"AzureBlob", "OCI", "INLINE", "AWS-S3", "AWS-S3-ACCESS-POINT"}},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"AzureBlob", "OCI", "INLINE", "AWS-S3", "AWS-S3-ACCESS-POINT", "FILESYSTEM", "GCS"}},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "secret_name", Type: field.TypeString},
 		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
@@ -115,6 +115,7 @@ var (
 		{Name: "fallback", Type: field.TypeBool, Default: false},
 		{Name: "managed", Type: field.TypeBool, Default: false},
 		{Name: "max_blob_size_bytes", Type: field.TypeInt64},
+		{Name: "primary_backend_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "organization_cas_backends", Type: field.TypeUUID},
 	}
 	// CasBackendsTable holds the schema information for the "cas_backends" table.
@@ -124,8 +125,14 @@ var (
 		PrimaryKey: []*schema.Column{CasBackendsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "cas_backends_organizations_cas_backends",
+				Symbol:     "cas_backends_cas_backends_replicas",
 				Columns:    []*schema.Column{CasBackendsColumns[16]},
+				RefColumns: []*schema.Column{CasBackendsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "cas_backends_organizations_cas_backends",
+				Columns:    []*schema.Column{CasBackendsColumns[17]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -134,13 +141,46 @@ var (
 			{
 				Name:    "casbackend_name_organization_cas_backends",
 				Unique:  true,
-				Columns: []*schema.Column{CasBackendsColumns[2], CasBackendsColumns[16]},
+				Columns: []*schema.Column{CasBackendsColumns[2], CasBackendsColumns[17]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at IS NULL",
 				},
 			},
 		},
 	}
+	// CasBlobDeletionsColumns holds the columns for the "cas_blob_deletions" table.
+	CasBlobDeletionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "digest", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "cas_backend_id", Type: field.TypeUUID},
+	}
+	// CasBlobDeletionsTable holds the schema information for the "cas_blob_deletions" table.
+	CasBlobDeletionsTable = &schema.Table{
+		Name:       "cas_blob_deletions",
+		Columns:    CasBlobDeletionsColumns,
+		PrimaryKey: []*schema.Column{CasBlobDeletionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cas_blob_deletions_cas_backends_cas_backend",
+				Columns:    []*schema.Column{CasBlobDeletionsColumns[3]},
+				RefColumns: []*schema.Column{CasBackendsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "casblobdeletion_digest_cas_backend_id",
+				Unique:  true,
+				Columns: []*schema.Column{CasBlobDeletionsColumns[1], CasBlobDeletionsColumns[3]},
+			},
+			{
+				Name:    "casblobdeletion_cas_backend_id",
+				Unique:  false,
+				Columns: []*schema.Column{CasBlobDeletionsColumns[3]},
+			},
+		},
+	}
 	// CasMappingsColumns holds the columns for the "cas_mappings" table.
 	CasMappingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -195,6 +235,55 @@ var (
 			},
 		},
 	}
+	// CasRetentionRulesColumns holds the columns for the "cas_retention_rules" table.
+	CasRetentionRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "max_age_days", Type: field.TypeInt},
+		{Name: "keep_released_versions", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "last_swept_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "cas_backend_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// CasRetentionRulesTable holds the schema information for the "cas_retention_rules" table.
+	CasRetentionRulesTable = &schema.Table{
+		Name:       "cas_retention_rules",
+		Columns:    CasRetentionRulesColumns,
+		PrimaryKey: []*schema.Column{CasRetentionRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cas_retention_rules_organizations_organization",
+				Columns:    []*schema.Column{CasRetentionRulesColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "cas_retention_rules_cas_backends_cas_backend",
+				Columns:    []*schema.Column{CasRetentionRulesColumns[7]},
+				RefColumns: []*schema.Column{CasBackendsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "casretentionrule_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{CasRetentionRulesColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "cas_backend_id IS NULL",
+				},
+			},
+			{
+				Name:    "casretentionrule_organization_id_cas_backend_id",
+				Unique:  true,
+				Columns: []*schema.Column{CasRetentionRulesColumns[6], CasRetentionRulesColumns[7]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "cas_backend_id IS NOT NULL",
+				},
+			},
+		},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -335,6 +424,69 @@ var (
 			},
 		},
 	}
+	// IntegrationDeliveriesColumns holds the columns for the "integration_deliveries" table.
+	IntegrationDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "dead_letter"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "duration", Type: field.TypeInt64, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "envelope", Type: field.TypeJSON, Nullable: true},
+		{Name: "download_backend_type", Type: field.TypeString, Nullable: true},
+		{Name: "download_secret_name", Type: field.TypeString, Nullable: true},
+		{Name: "workflow_id", Type: field.TypeUUID},
+		{Name: "attestation_digest", Type: field.TypeString, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "workflow_run_id", Type: field.TypeUUID},
+		{Name: "integration_attachment_id", Type: field.TypeUUID},
+	}
+	// IntegrationDeliveriesTable holds the schema information for the "integration_deliveries" table.
+	IntegrationDeliveriesTable = &schema.Table{
+		Name:       "integration_deliveries",
+		Columns:    IntegrationDeliveriesColumns,
+		PrimaryKey: []*schema.Column{IntegrationDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "integration_deliveries_organizations_organization",
+				Columns:    []*schema.Column{IntegrationDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "integration_deliveries_workflow_runs_workflow_run",
+				Columns:    []*schema.Column{IntegrationDeliveriesColumns[15]},
+				RefColumns: []*schema.Column{WorkflowRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "integration_deliveries_integration_attachments_integration_attachment",
+				Columns:    []*schema.Column{IntegrationDeliveriesColumns[16]},
+				RefColumns: []*schema.Column{IntegrationAttachmentsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "integrationdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{IntegrationDeliveriesColumns[1], IntegrationDeliveriesColumns[5]},
+			},
+			{
+				Name:    "integrationdelivery_integration_attachment_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{IntegrationDeliveriesColumns[16], IntegrationDeliveriesColumns[6]},
+			},
+			{
+				Name:    "integrationdelivery_attestation_digest_integration_attachment_id",
+				Unique:  true,
+				Columns: []*schema.Column{IntegrationDeliveriesColumns[13], IntegrationDeliveriesColumns[16]},
+			},
+		},
+	}
 	// MembershipsColumns holds the columns for the "memberships" table.
 	MembershipsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -464,6 +616,229 @@ var (
 			},
 		},
 	}
+	// PolicyEvaluationsColumns holds the columns for the "policy_evaluations" table.
+	PolicyEvaluationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "project_id", Type: field.TypeUUID},
+		{Name: "workflow_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "material_name", Type: field.TypeString, Nullable: true},
+		{Name: "material_type", Type: field.TypeString, Nullable: true},
+		{Name: "policy_uri", Type: field.TypeString, Nullable: true},
+		{Name: "policy_digest", Type: field.TypeString, Nullable: true},
+		{Name: "group_name", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PASSED", "FAILED", "SKIPPED"}},
+		{Name: "skip_reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "gate", Type: field.TypeBool, Default: false},
+		{Name: "violations_count", Type: field.TypeInt, Default: 0},
+		{Name: "latest", Type: field.TypeBool, Default: true},
+		{Name: "workflowrun_id", Type: field.TypeUUID},
+	}
+	// PolicyEvaluationsTable holds the schema information for the "policy_evaluations" table.
+	PolicyEvaluationsTable = &schema.Table{
+		Name:       "policy_evaluations",
+		Columns:    PolicyEvaluationsColumns,
+		PrimaryKey: []*schema.Column{PolicyEvaluationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_evaluations_workflow_runs_policy_evaluations",
+				Columns:    []*schema.Column{PolicyEvaluationsColumns[16]},
+				RefColumns: []*schema.Column{WorkflowRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyevaluation_workflowrun_id",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyEvaluationsColumns[16]},
+			},
+			{
+				Name:    "policyevaluation_organization_id_name_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyEvaluationsColumns[2], PolicyEvaluationsColumns[5], PolicyEvaluationsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					DescColumns: map[string]bool{
+						PolicyEvaluationsColumns[1].Name: true,
+					},
+				},
+			},
+			{
+				Name:    "policyevaluation_organization_id_latest_name",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyEvaluationsColumns[2], PolicyEvaluationsColumns[15], PolicyEvaluationsColumns[5]},
+			},
+			{
+				Name:    "policyevaluation_workflow_id_latest",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyEvaluationsColumns[4], PolicyEvaluationsColumns[15]},
+			},
+			{
+				Name:    "policyevaluation_workflow_id_name_material_name",
+				Unique:  true,
+				Columns: []*schema.Column{PolicyEvaluationsColumns[4], PolicyEvaluationsColumns[5], PolicyEvaluationsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "latest",
+				},
+			},
+		},
+	}
+	// PolicyExceptionsColumns holds the columns for the "policy_exceptions" table.
+	PolicyExceptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "policy_name", Type: field.TypeString, Nullable: true},
+		{Name: "material_name", Type: field.TypeString, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "justification", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeString},
+		{Name: "approved_by", Type: field.TypeString, Nullable: true},
+		{Name: "approved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expiration_processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "project_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "workflow_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// PolicyExceptionsTable holds the schema information for the "policy_exceptions" table.
+	PolicyExceptionsTable = &schema.Table{
+		Name:       "policy_exceptions",
+		Columns:    PolicyExceptionsColumns,
+		PrimaryKey: []*schema.Column{PolicyExceptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_exceptions_organizations_organization",
+				Columns:    []*schema.Column{PolicyExceptionsColumns[12]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "policy_exceptions_projects_project",
+				Columns:    []*schema.Column{PolicyExceptionsColumns[13]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "policy_exceptions_workflows_workflow",
+				Columns:    []*schema.Column{PolicyExceptionsColumns[14]},
+				RefColumns: []*schema.Column{WorkflowsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyexception_organization_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyExceptionsColumns[12], PolicyExceptionsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					DescColumns: map[string]bool{
+						PolicyExceptionsColumns[1].Name: true,
+					},
+				},
+			},
+			{
+				Name:    "policyexception_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyExceptionsColumns[9]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "expiration_processed_at IS NULL",
+				},
+			},
+		},
+	}
+	// PolicyImpactAnalysesColumns holds the columns for the "policy_impact_analyses" table.
+	PolicyImpactAnalysesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed"}, Default: "running"},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "report", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// PolicyImpactAnalysesTable holds the schema information for the "policy_impact_analyses" table.
+	PolicyImpactAnalysesTable = &schema.Table{
+		Name:       "policy_impact_analyses",
+		Columns:    PolicyImpactAnalysesColumns,
+		PrimaryKey: []*schema.Column{PolicyImpactAnalysesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_impact_analyses_organizations_organization",
+				Columns:    []*schema.Column{PolicyImpactAnalysesColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyimpactanalysis_organization_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyImpactAnalysesColumns[6], PolicyImpactAnalysesColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					DescColumns: map[string]bool{
+						PolicyImpactAnalysesColumns[2].Name: true,
+					},
+				},
+			},
+			{
+				Name:    "policyimpactanalysis_organization_id",
+				Unique:  true,
+				Columns: []*schema.Column{PolicyImpactAnalysesColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status = 'running'",
+				},
+			},
+		},
+	}
+	// PolicyViolationsColumns holds the columns for the "policy_violations" table.
+	PolicyViolationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "suppressed", Type: field.TypeBool, Default: false},
+		{Name: "exception_id", Type: field.TypeString, Nullable: true},
+		{Name: "finding_type", Type: field.TypeEnum, Nullable: true, Enums: []string{"VULNERABILITY", "SAST", "LICENSE_VIOLATION", "SECRET", "MISCONFIGURATION", "MALWARE"}},
+		{Name: "severity", Type: field.TypeString, Nullable: true},
+		{Name: "external_id", Type: field.TypeString, Nullable: true},
+		{Name: "package_purl", Type: field.TypeString, Nullable: true},
+		{Name: "finding", Type: field.TypeJSON, Nullable: true},
+		{Name: "policy_evaluation_id", Type: field.TypeUUID},
+	}
+	// PolicyViolationsTable holds the schema information for the "policy_violations" table.
+	PolicyViolationsTable = &schema.Table{
+		Name:       "policy_violations",
+		Columns:    PolicyViolationsColumns,
+		PrimaryKey: []*schema.Column{PolicyViolationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "policy_violations_policy_evaluations_violations",
+				Columns:    []*schema.Column{PolicyViolationsColumns[11]},
+				RefColumns: []*schema.Column{PolicyEvaluationsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "policyviolation_policy_evaluation_id",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyViolationsColumns[11]},
+			},
+			{
+				Name:    "policyviolation_organization_id_external_id",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyViolationsColumns[1], PolicyViolationsColumns[8]},
+			},
+			{
+				Name:    "policyviolation_organization_id_finding_type_severity",
+				Unique:  false,
+				Columns: []*schema.Column{PolicyViolationsColumns[1], PolicyViolationsColumns[6], PolicyViolationsColumns[7]},
+			},
+		},
+	}
 	// ProjectsColumns holds the columns for the "projects" table.
 	ProjectsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -786,6 +1161,7 @@ var (
 		{Name: "policy_violations_count", Type: field.TypeInt32, Nullable: true},
 		{Name: "policy_violations_suppressed", Type: field.TypeInt32, Nullable: true},
 		{Name: "policy_has_gates", Type: field.TypeBool, Nullable: true},
+		{Name: "search_indexed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "organization_id", Type: field.TypeUUID},
 		{Name: "version_id", Type: field.TypeUUID},
 		{Name: "workflow_id", Type: field.TypeUUID},
@@ -799,25 +1175,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "workflow_runs_organizations_workflowruns",
-				Columns:    []*schema.Column{WorkflowRunsColumns[20]},
+				Columns:    []*schema.Column{WorkflowRunsColumns[21]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "workflow_runs_project_versions_runs",
-				Columns:    []*schema.Column{WorkflowRunsColumns[21]},
+				Columns:    []*schema.Column{WorkflowRunsColumns[22]},
 				RefColumns: []*schema.Column{ProjectVersionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "workflow_runs_workflows_workflowruns",
-				Columns:    []*schema.Column{WorkflowRunsColumns[22]},
+				Columns:    []*schema.Column{WorkflowRunsColumns[23]},
 				RefColumns: []*schema.Column{WorkflowsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "workflow_runs_workflow_contract_versions_contract_version",
-				Columns:    []*schema.Column{WorkflowRunsColumns[23]},
+				Columns:    []*schema.Column{WorkflowRunsColumns[24]},
 				RefColumns: []*schema.Column{WorkflowContractVersionsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -836,7 +1212,7 @@ var (
 			{
 				Name:    "workflowrun_workflow_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{WorkflowRunsColumns[22], WorkflowRunsColumns[1]},
+				Columns: []*schema.Column{WorkflowRunsColumns[23], WorkflowRunsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
 						WorkflowRunsColumns[1].Name: true,
@@ -846,7 +1222,7 @@ var (
 			{
 				Name:    "workflowrun_workflow_id_state_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{WorkflowRunsColumns[22], WorkflowRunsColumns[3], WorkflowRunsColumns[1]},
+				Columns: []*schema.Column{WorkflowRunsColumns[23], WorkflowRunsColumns[3], WorkflowRunsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
 						WorkflowRunsColumns[1].Name: true,
@@ -856,7 +1232,7 @@ var (
 			{
 				Name:    "workflowrun_organization_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{WorkflowRunsColumns[20], WorkflowRunsColumns[1]},
+				Columns: []*schema.Column{WorkflowRunsColumns[21], WorkflowRunsColumns[1]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
 						WorkflowRunsColumns[1].Name: true,
@@ -881,12 +1257,12 @@ var (
 			{
 				Name:    "workflowrun_workflow_id",
 				Unique:  false,
-				Columns: []*schema.Column{WorkflowRunsColumns[22]},
+				Columns: []*schema.Column{WorkflowRunsColumns[23]},
 			},
 			{
 				Name:    "workflowrun_version_id_workflow_id",
 				Unique:  false,
-				Columns: []*schema.Column{WorkflowRunsColumns[21], WorkflowRunsColumns[22]},
+				Columns: []*schema.Column{WorkflowRunsColumns[22], WorkflowRunsColumns[23]},
 			},
 			{
 				Name:    "workflowrun_policy_status",
@@ -901,6 +1277,72 @@ var (
 					Where: "policy_has_gates = true",
 				},
 			},
+			{
+				Name:    "workflowrun_organization_id_runner_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunsColumns[21], WorkflowRunsColumns[6], WorkflowRunsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					DescColumns: map[string]bool{
+						WorkflowRunsColumns[1].Name: true,
+					},
+				},
+			},
+			{
+				Name:    "workflowrun_search_indexed_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunsColumns[20]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "search_indexed_at IS NULL AND attestation_digest IS NOT NULL",
+				},
+			},
+		},
+	}
+	// WorkflowRunSearchEntriesColumns holds the columns for the "workflow_run_search_entries" table.
+	WorkflowRunSearchEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"MATERIAL", "SUBJECT", "ANNOTATION"}},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString, Nullable: true},
+		{Name: "digest", Type: field.TypeString, Nullable: true},
+		{Name: "value", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "workflowrun_id", Type: field.TypeUUID},
+	}
+	// WorkflowRunSearchEntriesTable holds the schema information for the "workflow_run_search_entries" table.
+	WorkflowRunSearchEntriesTable = &schema.Table{
+		Name:       "workflow_run_search_entries",
+		Columns:    WorkflowRunSearchEntriesColumns,
+		PrimaryKey: []*schema.Column{WorkflowRunSearchEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_run_search_entries_workflow_runs_search_entries",
+				Columns:    []*schema.Column{WorkflowRunSearchEntriesColumns[8]},
+				RefColumns: []*schema.Column{WorkflowRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowrunsearchentry_workflowrun_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunSearchEntriesColumns[8]},
+			},
+			{
+				Name:    "workflowrunsearchentry_organization_id_kind_digest",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunSearchEntriesColumns[2], WorkflowRunSearchEntriesColumns[3], WorkflowRunSearchEntriesColumns[6]},
+			},
+			{
+				Name:    "workflowrunsearchentry_organization_id_kind_type",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunSearchEntriesColumns[2], WorkflowRunSearchEntriesColumns[3], WorkflowRunSearchEntriesColumns[5]},
+			},
+			{
+				Name:    "workflowrunsearchentry_organization_id_kind_name",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowRunSearchEntriesColumns[2], WorkflowRunSearchEntriesColumns[3], WorkflowRunSearchEntriesColumns[4]},
+			},
 		},
 	}
 	// ReferrerReferencesColumns holds the columns for the "referrer_references" table.
@@ -983,14 +1425,21 @@ var (
 		APITokensTable,
 		AttestationsTable,
 		CasBackendsTable,
+		CasBlobDeletionsTable,
 		CasMappingsTable,
+		CasRetentionRulesTable,
 		GroupsTable,
 		GroupMembershipsTable,
 		IntegrationsTable,
 		IntegrationAttachmentsTable,
+		IntegrationDeliveriesTable,
 		MembershipsTable,
 		OrgInvitationsTable,
 		OrganizationsTable,
+		PolicyEvaluationsTable,
+		PolicyExceptionsTable,
+		PolicyImpactAnalysesTable,
+		PolicyViolationsTable,
 		ProjectsTable,
 		ProjectVersionsTable,
 		ReferrersTable,
@@ -1000,6 +1449,7 @@ var (
 		WorkflowContractsTable,
 		WorkflowContractVersionsTable,
 		WorkflowRunsTable,
+		WorkflowRunSearchEntriesTable,
 		ReferrerReferencesTable,
 		ReferrerWorkflowsTable,
 		WorkflowRunCasBackendsTable,
@@ -1011,21 +1461,34 @@ func init() {
 	APITokensTable.ForeignKeys[1].RefTable = WorkflowsTable
 	APITokensTable.ForeignKeys[2].RefTable = OrganizationsTable
 	AttestationsTable.ForeignKeys[0].RefTable = WorkflowRunsTable
-	CasBackendsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	CasBackendsTable.ForeignKeys[0].RefTable = CasBackendsTable
+	CasBackendsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	CasBlobDeletionsTable.ForeignKeys[0].RefTable = CasBackendsTable
 	CasMappingsTable.ForeignKeys[0].RefTable = CasBackendsTable
 	CasMappingsTable.ForeignKeys[1].RefTable = OrganizationsTable
 	CasMappingsTable.ForeignKeys[2].RefTable = ProjectsTable
+	CasRetentionRulesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	CasRetentionRulesTable.ForeignKeys[1].RefTable = CasBackendsTable
 	GroupsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	GroupMembershipsTable.ForeignKeys[0].RefTable = GroupsTable
 	GroupMembershipsTable.ForeignKeys[1].RefTable = UsersTable
 	IntegrationsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	IntegrationAttachmentsTable.ForeignKeys[0].RefTable = IntegrationsTable
 	IntegrationAttachmentsTable.ForeignKeys[1].RefTable = WorkflowsTable
+	IntegrationDeliveriesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	IntegrationDeliveriesTable.ForeignKeys[1].RefTable = WorkflowRunsTable
+	IntegrationDeliveriesTable.ForeignKeys[2].RefTable = IntegrationAttachmentsTable
 	MembershipsTable.ForeignKeys[0].RefTable = MembershipsTable
 	MembershipsTable.ForeignKeys[1].RefTable = OrganizationsTable
 	MembershipsTable.ForeignKeys[2].RefTable = UsersTable
 	OrgInvitationsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrgInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	PolicyEvaluationsTable.ForeignKeys[0].RefTable = WorkflowRunsTable
+	PolicyExceptionsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	PolicyExceptionsTable.ForeignKeys[1].RefTable = ProjectsTable
+	PolicyExceptionsTable.ForeignKeys[2].RefTable = WorkflowsTable
+	PolicyImpactAnalysesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	PolicyViolationsTable.ForeignKeys[0].RefTable = PolicyEvaluationsTable
 	ProjectsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	ProjectVersionsTable.ForeignKeys[0].RefTable = ProjectsTable
 	RobotAccountsTable.ForeignKeys[0].RefTable = WorkflowsTable
@@ -1039,6 +1502,7 @@ func init() {
 	WorkflowRunsTable.ForeignKeys[1].RefTable = ProjectVersionsTable
 	WorkflowRunsTable.ForeignKeys[2].RefTable = WorkflowsTable
 	WorkflowRunsTable.ForeignKeys[3].RefTable = WorkflowContractVersionsTable
+	WorkflowRunSearchEntriesTable.ForeignKeys[0].RefTable = WorkflowRunsTable
 	ReferrerReferencesTable.ForeignKeys[0].RefTable = ReferrersTable
 	ReferrerReferencesTable.ForeignKeys[1].RefTable = ReferrersTable
 	ReferrerWorkflowsTable.ForeignKeys[0].RefTable = ReferrersTable
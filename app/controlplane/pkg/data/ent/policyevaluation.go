@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/google/uuid"
+)
+
+// PolicyEvaluation is the model entity for the PolicyEvaluation schema.
+type PolicyEvaluation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// WorkflowrunID holds the value of the "workflowrun_id" field.
+	WorkflowrunID uuid.UUID `json:"workflowrun_id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID uuid.UUID `json:"project_id,omitempty"`
+	// WorkflowID holds the value of the "workflow_id" field.
+	WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// MaterialName holds the value of the "material_name" field.
+	MaterialName string `json:"material_name,omitempty"`
+	// MaterialType holds the value of the "material_type" field.
+	MaterialType string `json:"material_type,omitempty"`
+	// PolicyURI holds the value of the "policy_uri" field.
+	PolicyURI string `json:"policy_uri,omitempty"`
+	// PolicyDigest holds the value of the "policy_digest" field.
+	PolicyDigest string `json:"policy_digest,omitempty"`
+	// GroupName holds the value of the "group_name" field.
+	GroupName string `json:"group_name,omitempty"`
+	// Status holds the value of the "status" field.
+	Status biz.PolicyEvaluationStatus `json:"status,omitempty"`
+	// SkipReasons holds the value of the "skip_reasons" field.
+	SkipReasons []string `json:"skip_reasons,omitempty"`
+	// Gate holds the value of the "gate" field.
+	Gate bool `json:"gate,omitempty"`
+	// ViolationsCount holds the value of the "violations_count" field.
+	ViolationsCount int `json:"violations_count,omitempty"`
+	// Latest holds the value of the "latest" field.
+	Latest bool `json:"latest,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyEvaluationQuery when eager-loading is set.
+	Edges        PolicyEvaluationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyEvaluationEdges holds the relations/edges for other nodes in the graph.
+type PolicyEvaluationEdges struct {
+	// Workflowrun holds the value of the workflowrun edge.
+	Workflowrun *WorkflowRun `json:"workflowrun,omitempty"`
+	// Violations holds the value of the violations edge.
+	Violations []*PolicyViolation `json:"violations,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// WorkflowrunOrErr returns the Workflowrun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyEvaluationEdges) WorkflowrunOrErr() (*WorkflowRun, error) {
+	if e.Workflowrun != nil {
+		return e.Workflowrun, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workflowrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflowrun"}
+}
+
+// ViolationsOrErr returns the Violations value or an error if the edge
+// was not loaded in eager-loading.
+func (e PolicyEvaluationEdges) ViolationsOrErr() ([]*PolicyViolation, error) {
+	if e.loadedTypes[1] {
+		return e.Violations, nil
+	}
+	return nil, &NotLoadedError{edge: "violations"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyEvaluation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyevaluation.FieldSkipReasons:
+			values[i] = new([]byte)
+		case policyevaluation.FieldGate, policyevaluation.FieldLatest:
+			values[i] = new(sql.NullBool)
+		case policyevaluation.FieldViolationsCount:
+			values[i] = new(sql.NullInt64)
+		case policyevaluation.FieldName, policyevaluation.FieldMaterialName, policyevaluation.FieldMaterialType, policyevaluation.FieldPolicyURI, policyevaluation.FieldPolicyDigest, policyevaluation.FieldGroupName, policyevaluation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case policyevaluation.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case policyevaluation.FieldID, policyevaluation.FieldWorkflowrunID, policyevaluation.FieldOrganizationID, policyevaluation.FieldProjectID, policyevaluation.FieldWorkflowID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyEvaluation fields.
+func (_m *PolicyEvaluation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyevaluation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyevaluation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case policyevaluation.FieldWorkflowrunID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflowrun_id", values[i])
+			} else if value != nil {
+				_m.WorkflowrunID = *value
+			}
+		case policyevaluation.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case policyevaluation.FieldProjectID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				_m.ProjectID = *value
+			}
+		case policyevaluation.FieldWorkflowID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value != nil {
+				_m.WorkflowID = *value
+			}
+		case policyevaluation.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case policyevaluation.FieldMaterialName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field material_name", values[i])
+			} else if value.Valid {
+				_m.MaterialName = value.String
+			}
+		case policyevaluation.FieldMaterialType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field material_type", values[i])
+			} else if value.Valid {
+				_m.MaterialType = value.String
+			}
+		case policyevaluation.FieldPolicyURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_uri", values[i])
+			} else if value.Valid {
+				_m.PolicyURI = value.String
+			}
+		case policyevaluation.FieldPolicyDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_digest", values[i])
+			} else if value.Valid {
+				_m.PolicyDigest = value.String
+			}
+		case policyevaluation.FieldGroupName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_name", values[i])
+			} else if value.Valid {
+				_m.GroupName = value.String
+			}
+		case policyevaluation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = biz.PolicyEvaluationStatus(value.String)
+			}
+		case policyevaluation.FieldSkipReasons:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_reasons", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SkipReasons); err != nil {
+					return fmt.Errorf("unmarshal field skip_reasons: %w", err)
+				}
+			}
+		case policyevaluation.FieldGate:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field gate", values[i])
+			} else if value.Valid {
+				_m.Gate = value.Bool
+			}
+		case policyevaluation.FieldViolationsCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field violations_count", values[i])
+			} else if value.Valid {
+				_m.ViolationsCount = int(value.Int64)
+			}
+		case policyevaluation.FieldLatest:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field latest", values[i])
+			} else if value.Valid {
+				_m.Latest = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyEvaluation.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyEvaluation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkflowrun queries the "workflowrun" edge of the PolicyEvaluation entity.
+func (_m *PolicyEvaluation) QueryWorkflowrun() *WorkflowRunQuery {
+	return NewPolicyEvaluationClient(_m.config).QueryWorkflowrun(_m)
+}
+
+// QueryViolations queries the "violations" edge of the PolicyEvaluation entity.
+func (_m *PolicyEvaluation) QueryViolations() *PolicyViolationQuery {
+	return NewPolicyEvaluationClient(_m.config).QueryViolations(_m)
+}
+
+// Update returns a builder for updating this PolicyEvaluation.
+// Note that you need to call PolicyEvaluation.Unwrap() before calling this method if this PolicyEvaluation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyEvaluation) Update() *PolicyEvaluationUpdateOne {
+	return NewPolicyEvaluationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyEvaluation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyEvaluation) Unwrap() *PolicyEvaluation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyEvaluation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyEvaluation) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyEvaluation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("workflowrun_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowrunID))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("material_name=")
+	builder.WriteString(_m.MaterialName)
+	builder.WriteString(", ")
+	builder.WriteString("material_type=")
+	builder.WriteString(_m.MaterialType)
+	builder.WriteString(", ")
+	builder.WriteString("policy_uri=")
+	builder.WriteString(_m.PolicyURI)
+	builder.WriteString(", ")
+	builder.WriteString("policy_digest=")
+	builder.WriteString(_m.PolicyDigest)
+	builder.WriteString(", ")
+	builder.WriteString("group_name=")
+	builder.WriteString(_m.GroupName)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("skip_reasons=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SkipReasons))
+	builder.WriteString(", ")
+	builder.WriteString("gate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Gate))
+	builder.WriteString(", ")
+	builder.WriteString("violations_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ViolationsCount))
+	builder.WriteString(", ")
+	builder.WriteString("latest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Latest))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyEvaluations is a parsable slice of PolicyEvaluation.
+type PolicyEvaluations []*PolicyEvaluation
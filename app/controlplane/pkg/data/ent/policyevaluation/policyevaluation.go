@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyevaluation
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the policyevaluation type in the database.
+	Label = "policy_evaluation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldWorkflowrunID holds the string denoting the workflowrun_id field in the database.
+	FieldWorkflowrunID = "workflowrun_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldProjectID holds the string denoting the project_id field in the database.
+	FieldProjectID = "project_id"
+	// FieldWorkflowID holds the string denoting the workflow_id field in the database.
+	FieldWorkflowID = "workflow_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldMaterialName holds the string denoting the material_name field in the database.
+	FieldMaterialName = "material_name"
+	// FieldMaterialType holds the string denoting the material_type field in the database.
+	FieldMaterialType = "material_type"
+	// FieldPolicyURI holds the string denoting the policy_uri field in the database.
+	FieldPolicyURI = "policy_uri"
+	// FieldPolicyDigest holds the string denoting the policy_digest field in the database.
+	FieldPolicyDigest = "policy_digest"
+	// FieldGroupName holds the string denoting the group_name field in the database.
+	FieldGroupName = "group_name"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldSkipReasons holds the string denoting the skip_reasons field in the database.
+	FieldSkipReasons = "skip_reasons"
+	// FieldGate holds the string denoting the gate field in the database.
+	FieldGate = "gate"
+	// FieldViolationsCount holds the string denoting the violations_count field in the database.
+	FieldViolationsCount = "violations_count"
+	// FieldLatest holds the string denoting the latest field in the database.
+	FieldLatest = "latest"
+	// EdgeWorkflowrun holds the string denoting the workflowrun edge name in mutations.
+	EdgeWorkflowrun = "workflowrun"
+	// EdgeViolations holds the string denoting the violations edge name in mutations.
+	EdgeViolations = "violations"
+	// Table holds the table name of the policyevaluation in the database.
+	Table = "policy_evaluations"
+	// WorkflowrunTable is the table that holds the workflowrun relation/edge.
+	WorkflowrunTable = "policy_evaluations"
+	// WorkflowrunInverseTable is the table name for the WorkflowRun entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowrun" package.
+	WorkflowrunInverseTable = "workflow_runs"
+	// WorkflowrunColumn is the table column denoting the workflowrun relation/edge.
+	WorkflowrunColumn = "workflowrun_id"
+	// ViolationsTable is the table that holds the violations relation/edge.
+	ViolationsTable = "policy_violations"
+	// ViolationsInverseTable is the table name for the PolicyViolation entity.
+	// It exists in this package in order to avoid circular dependency with the "policyviolation" package.
+	ViolationsInverseTable = "policy_violations"
+	// ViolationsColumn is the table column denoting the violations relation/edge.
+	ViolationsColumn = "policy_evaluation_id"
+)
+
+// Columns holds all SQL columns for policyevaluation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldWorkflowrunID,
+	FieldOrganizationID,
+	FieldProjectID,
+	FieldWorkflowID,
+	FieldName,
+	FieldMaterialName,
+	FieldMaterialType,
+	FieldPolicyURI,
+	FieldPolicyDigest,
+	FieldGroupName,
+	FieldStatus,
+	FieldSkipReasons,
+	FieldGate,
+	FieldViolationsCount,
+	FieldLatest,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultGate holds the default value on creation for the "gate" field.
+	DefaultGate bool
+	// DefaultViolationsCount holds the default value on creation for the "violations_count" field.
+	DefaultViolationsCount int
+	// DefaultLatest holds the default value on creation for the "latest" field.
+	DefaultLatest bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s biz.PolicyEvaluationStatus) error {
+	switch s {
+	case "PASSED", "FAILED", "SKIPPED":
+		return nil
+	default:
+		return fmt.Errorf("policyevaluation: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PolicyEvaluation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByWorkflowrunID orders the results by the workflowrun_id field.
+func ByWorkflowrunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowrunID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByProjectID orders the results by the project_id field.
+func ByProjectID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProjectID, opts...).ToFunc()
+}
+
+// ByWorkflowID orders the results by the workflow_id field.
+func ByWorkflowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByMaterialName orders the results by the material_name field.
+func ByMaterialName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaterialName, opts...).ToFunc()
+}
+
+// ByMaterialType orders the results by the material_type field.
+func ByMaterialType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaterialType, opts...).ToFunc()
+}
+
+// ByPolicyURI orders the results by the policy_uri field.
+func ByPolicyURI(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyURI, opts...).ToFunc()
+}
+
+// ByPolicyDigest orders the results by the policy_digest field.
+func ByPolicyDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyDigest, opts...).ToFunc()
+}
+
+// ByGroupName orders the results by the group_name field.
+func ByGroupName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGroupName, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByGate orders the results by the gate field.
+func ByGate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGate, opts...).ToFunc()
+}
+
+// ByViolationsCountField orders the results by the violations_count field.
+func ByViolationsCountField(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldViolationsCount, opts...).ToFunc()
+}
+
+// ByLatest orders the results by the latest field.
+func ByLatest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatest, opts...).ToFunc()
+}
+
+// ByWorkflowrunField orders the results by workflowrun field.
+func ByWorkflowrunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowrunStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByViolationsCount orders the results by violations count.
+func ByViolationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newViolationsStep(), opts...)
+	}
+}
+
+// ByViolations orders the results by violations terms.
+func ByViolations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newViolationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWorkflowrunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowrunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkflowrunTable, WorkflowrunColumn),
+	)
+}
+func newViolationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ViolationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ViolationsTable, ViolationsColumn),
+	)
+}
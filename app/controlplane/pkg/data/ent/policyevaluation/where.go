@@ -0,0 +1,909 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyevaluation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkflowrunID applies equality check predicate on the "workflowrun_id" field. It's identical to WorkflowrunIDEQ.
+func WorkflowrunID(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldWorkflowrunID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldProjectID, v))
+}
+
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldName, v))
+}
+
+// MaterialName applies equality check predicate on the "material_name" field. It's identical to MaterialNameEQ.
+func MaterialName(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldMaterialName, v))
+}
+
+// MaterialType applies equality check predicate on the "material_type" field. It's identical to MaterialTypeEQ.
+func MaterialType(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldMaterialType, v))
+}
+
+// PolicyURI applies equality check predicate on the "policy_uri" field. It's identical to PolicyURIEQ.
+func PolicyURI(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldPolicyURI, v))
+}
+
+// PolicyDigest applies equality check predicate on the "policy_digest" field. It's identical to PolicyDigestEQ.
+func PolicyDigest(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldPolicyDigest, v))
+}
+
+// GroupName applies equality check predicate on the "group_name" field. It's identical to GroupNameEQ.
+func GroupName(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldGroupName, v))
+}
+
+// Gate applies equality check predicate on the "gate" field. It's identical to GateEQ.
+func Gate(v bool) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldGate, v))
+}
+
+// ViolationsCount applies equality check predicate on the "violations_count" field. It's identical to ViolationsCountEQ.
+func ViolationsCount(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldViolationsCount, v))
+}
+
+// Latest applies equality check predicate on the "latest" field. It's identical to LatestEQ.
+func Latest(v bool) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldLatest, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// WorkflowrunIDEQ applies the EQ predicate on the "workflowrun_id" field.
+func WorkflowrunIDEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldWorkflowrunID, v))
+}
+
+// WorkflowrunIDNEQ applies the NEQ predicate on the "workflowrun_id" field.
+func WorkflowrunIDNEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldWorkflowrunID, v))
+}
+
+// WorkflowrunIDIn applies the In predicate on the "workflowrun_id" field.
+func WorkflowrunIDIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldWorkflowrunID, vs...))
+}
+
+// WorkflowrunIDNotIn applies the NotIn predicate on the "workflowrun_id" field.
+func WorkflowrunIDNotIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldWorkflowrunID, vs...))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDGT applies the GT predicate on the "project_id" field.
+func ProjectIDGT(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldProjectID, v))
+}
+
+// ProjectIDGTE applies the GTE predicate on the "project_id" field.
+func ProjectIDGTE(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldProjectID, v))
+}
+
+// ProjectIDLT applies the LT predicate on the "project_id" field.
+func ProjectIDLT(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldProjectID, v))
+}
+
+// ProjectIDLTE applies the LTE predicate on the "project_id" field.
+func ProjectIDLTE(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldProjectID, v))
+}
+
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDGT applies the GT predicate on the "workflow_id" field.
+func WorkflowIDGT(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldWorkflowID, v))
+}
+
+// WorkflowIDGTE applies the GTE predicate on the "workflow_id" field.
+func WorkflowIDGTE(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDLT applies the LT predicate on the "workflow_id" field.
+func WorkflowIDLT(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldWorkflowID, v))
+}
+
+// WorkflowIDLTE applies the LTE predicate on the "workflow_id" field.
+func WorkflowIDLTE(v uuid.UUID) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldWorkflowID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContainsFold(FieldName, v))
+}
+
+// MaterialNameEQ applies the EQ predicate on the "material_name" field.
+func MaterialNameEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldMaterialName, v))
+}
+
+// MaterialNameNEQ applies the NEQ predicate on the "material_name" field.
+func MaterialNameNEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldMaterialName, v))
+}
+
+// MaterialNameIn applies the In predicate on the "material_name" field.
+func MaterialNameIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldMaterialName, vs...))
+}
+
+// MaterialNameNotIn applies the NotIn predicate on the "material_name" field.
+func MaterialNameNotIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldMaterialName, vs...))
+}
+
+// MaterialNameGT applies the GT predicate on the "material_name" field.
+func MaterialNameGT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldMaterialName, v))
+}
+
+// MaterialNameGTE applies the GTE predicate on the "material_name" field.
+func MaterialNameGTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldMaterialName, v))
+}
+
+// MaterialNameLT applies the LT predicate on the "material_name" field.
+func MaterialNameLT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldMaterialName, v))
+}
+
+// MaterialNameLTE applies the LTE predicate on the "material_name" field.
+func MaterialNameLTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldMaterialName, v))
+}
+
+// MaterialNameContains applies the Contains predicate on the "material_name" field.
+func MaterialNameContains(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContains(FieldMaterialName, v))
+}
+
+// MaterialNameHasPrefix applies the HasPrefix predicate on the "material_name" field.
+func MaterialNameHasPrefix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasPrefix(FieldMaterialName, v))
+}
+
+// MaterialNameHasSuffix applies the HasSuffix predicate on the "material_name" field.
+func MaterialNameHasSuffix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasSuffix(FieldMaterialName, v))
+}
+
+// MaterialNameIsNil applies the IsNil predicate on the "material_name" field.
+func MaterialNameIsNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIsNull(FieldMaterialName))
+}
+
+// MaterialNameNotNil applies the NotNil predicate on the "material_name" field.
+func MaterialNameNotNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotNull(FieldMaterialName))
+}
+
+// MaterialNameEqualFold applies the EqualFold predicate on the "material_name" field.
+func MaterialNameEqualFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEqualFold(FieldMaterialName, v))
+}
+
+// MaterialNameContainsFold applies the ContainsFold predicate on the "material_name" field.
+func MaterialNameContainsFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContainsFold(FieldMaterialName, v))
+}
+
+// MaterialTypeEQ applies the EQ predicate on the "material_type" field.
+func MaterialTypeEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldMaterialType, v))
+}
+
+// MaterialTypeNEQ applies the NEQ predicate on the "material_type" field.
+func MaterialTypeNEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldMaterialType, v))
+}
+
+// MaterialTypeIn applies the In predicate on the "material_type" field.
+func MaterialTypeIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldMaterialType, vs...))
+}
+
+// MaterialTypeNotIn applies the NotIn predicate on the "material_type" field.
+func MaterialTypeNotIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldMaterialType, vs...))
+}
+
+// MaterialTypeGT applies the GT predicate on the "material_type" field.
+func MaterialTypeGT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldMaterialType, v))
+}
+
+// MaterialTypeGTE applies the GTE predicate on the "material_type" field.
+func MaterialTypeGTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldMaterialType, v))
+}
+
+// MaterialTypeLT applies the LT predicate on the "material_type" field.
+func MaterialTypeLT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldMaterialType, v))
+}
+
+// MaterialTypeLTE applies the LTE predicate on the "material_type" field.
+func MaterialTypeLTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldMaterialType, v))
+}
+
+// MaterialTypeContains applies the Contains predicate on the "material_type" field.
+func MaterialTypeContains(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContains(FieldMaterialType, v))
+}
+
+// MaterialTypeHasPrefix applies the HasPrefix predicate on the "material_type" field.
+func MaterialTypeHasPrefix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasPrefix(FieldMaterialType, v))
+}
+
+// MaterialTypeHasSuffix applies the HasSuffix predicate on the "material_type" field.
+func MaterialTypeHasSuffix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasSuffix(FieldMaterialType, v))
+}
+
+// MaterialTypeIsNil applies the IsNil predicate on the "material_type" field.
+func MaterialTypeIsNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIsNull(FieldMaterialType))
+}
+
+// MaterialTypeNotNil applies the NotNil predicate on the "material_type" field.
+func MaterialTypeNotNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotNull(FieldMaterialType))
+}
+
+// MaterialTypeEqualFold applies the EqualFold predicate on the "material_type" field.
+func MaterialTypeEqualFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEqualFold(FieldMaterialType, v))
+}
+
+// MaterialTypeContainsFold applies the ContainsFold predicate on the "material_type" field.
+func MaterialTypeContainsFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContainsFold(FieldMaterialType, v))
+}
+
+// PolicyURIEQ applies the EQ predicate on the "policy_uri" field.
+func PolicyURIEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldPolicyURI, v))
+}
+
+// PolicyURINEQ applies the NEQ predicate on the "policy_uri" field.
+func PolicyURINEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldPolicyURI, v))
+}
+
+// PolicyURIIn applies the In predicate on the "policy_uri" field.
+func PolicyURIIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldPolicyURI, vs...))
+}
+
+// PolicyURINotIn applies the NotIn predicate on the "policy_uri" field.
+func PolicyURINotIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldPolicyURI, vs...))
+}
+
+// PolicyURIGT applies the GT predicate on the "policy_uri" field.
+func PolicyURIGT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldPolicyURI, v))
+}
+
+// PolicyURIGTE applies the GTE predicate on the "policy_uri" field.
+func PolicyURIGTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldPolicyURI, v))
+}
+
+// PolicyURILT applies the LT predicate on the "policy_uri" field.
+func PolicyURILT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldPolicyURI, v))
+}
+
+// PolicyURILTE applies the LTE predicate on the "policy_uri" field.
+func PolicyURILTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldPolicyURI, v))
+}
+
+// PolicyURIContains applies the Contains predicate on the "policy_uri" field.
+func PolicyURIContains(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContains(FieldPolicyURI, v))
+}
+
+// PolicyURIHasPrefix applies the HasPrefix predicate on the "policy_uri" field.
+func PolicyURIHasPrefix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasPrefix(FieldPolicyURI, v))
+}
+
+// PolicyURIHasSuffix applies the HasSuffix predicate on the "policy_uri" field.
+func PolicyURIHasSuffix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasSuffix(FieldPolicyURI, v))
+}
+
+// PolicyURIIsNil applies the IsNil predicate on the "policy_uri" field.
+func PolicyURIIsNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIsNull(FieldPolicyURI))
+}
+
+// PolicyURINotNil applies the NotNil predicate on the "policy_uri" field.
+func PolicyURINotNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotNull(FieldPolicyURI))
+}
+
+// PolicyURIEqualFold applies the EqualFold predicate on the "policy_uri" field.
+func PolicyURIEqualFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEqualFold(FieldPolicyURI, v))
+}
+
+// PolicyURIContainsFold applies the ContainsFold predicate on the "policy_uri" field.
+func PolicyURIContainsFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContainsFold(FieldPolicyURI, v))
+}
+
+// PolicyDigestEQ applies the EQ predicate on the "policy_digest" field.
+func PolicyDigestEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldPolicyDigest, v))
+}
+
+// PolicyDigestNEQ applies the NEQ predicate on the "policy_digest" field.
+func PolicyDigestNEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldPolicyDigest, v))
+}
+
+// PolicyDigestIn applies the In predicate on the "policy_digest" field.
+func PolicyDigestIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldPolicyDigest, vs...))
+}
+
+// PolicyDigestNotIn applies the NotIn predicate on the "policy_digest" field.
+func PolicyDigestNotIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldPolicyDigest, vs...))
+}
+
+// PolicyDigestGT applies the GT predicate on the "policy_digest" field.
+func PolicyDigestGT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldPolicyDigest, v))
+}
+
+// PolicyDigestGTE applies the GTE predicate on the "policy_digest" field.
+func PolicyDigestGTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldPolicyDigest, v))
+}
+
+// PolicyDigestLT applies the LT predicate on the "policy_digest" field.
+func PolicyDigestLT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldPolicyDigest, v))
+}
+
+// PolicyDigestLTE applies the LTE predicate on the "policy_digest" field.
+func PolicyDigestLTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldPolicyDigest, v))
+}
+
+// PolicyDigestContains applies the Contains predicate on the "policy_digest" field.
+func PolicyDigestContains(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContains(FieldPolicyDigest, v))
+}
+
+// PolicyDigestHasPrefix applies the HasPrefix predicate on the "policy_digest" field.
+func PolicyDigestHasPrefix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasPrefix(FieldPolicyDigest, v))
+}
+
+// PolicyDigestHasSuffix applies the HasSuffix predicate on the "policy_digest" field.
+func PolicyDigestHasSuffix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasSuffix(FieldPolicyDigest, v))
+}
+
+// PolicyDigestIsNil applies the IsNil predicate on the "policy_digest" field.
+func PolicyDigestIsNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIsNull(FieldPolicyDigest))
+}
+
+// PolicyDigestNotNil applies the NotNil predicate on the "policy_digest" field.
+func PolicyDigestNotNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotNull(FieldPolicyDigest))
+}
+
+// PolicyDigestEqualFold applies the EqualFold predicate on the "policy_digest" field.
+func PolicyDigestEqualFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEqualFold(FieldPolicyDigest, v))
+}
+
+// PolicyDigestContainsFold applies the ContainsFold predicate on the "policy_digest" field.
+func PolicyDigestContainsFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContainsFold(FieldPolicyDigest, v))
+}
+
+// GroupNameEQ applies the EQ predicate on the "group_name" field.
+func GroupNameEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldGroupName, v))
+}
+
+// GroupNameNEQ applies the NEQ predicate on the "group_name" field.
+func GroupNameNEQ(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldGroupName, v))
+}
+
+// GroupNameIn applies the In predicate on the "group_name" field.
+func GroupNameIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldGroupName, vs...))
+}
+
+// GroupNameNotIn applies the NotIn predicate on the "group_name" field.
+func GroupNameNotIn(vs ...string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldGroupName, vs...))
+}
+
+// GroupNameGT applies the GT predicate on the "group_name" field.
+func GroupNameGT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldGroupName, v))
+}
+
+// GroupNameGTE applies the GTE predicate on the "group_name" field.
+func GroupNameGTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldGroupName, v))
+}
+
+// GroupNameLT applies the LT predicate on the "group_name" field.
+func GroupNameLT(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldGroupName, v))
+}
+
+// GroupNameLTE applies the LTE predicate on the "group_name" field.
+func GroupNameLTE(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldGroupName, v))
+}
+
+// GroupNameContains applies the Contains predicate on the "group_name" field.
+func GroupNameContains(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContains(FieldGroupName, v))
+}
+
+// GroupNameHasPrefix applies the HasPrefix predicate on the "group_name" field.
+func GroupNameHasPrefix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasPrefix(FieldGroupName, v))
+}
+
+// GroupNameHasSuffix applies the HasSuffix predicate on the "group_name" field.
+func GroupNameHasSuffix(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldHasSuffix(FieldGroupName, v))
+}
+
+// GroupNameIsNil applies the IsNil predicate on the "group_name" field.
+func GroupNameIsNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIsNull(FieldGroupName))
+}
+
+// GroupNameNotNil applies the NotNil predicate on the "group_name" field.
+func GroupNameNotNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotNull(FieldGroupName))
+}
+
+// GroupNameEqualFold applies the EqualFold predicate on the "group_name" field.
+func GroupNameEqualFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEqualFold(FieldGroupName, v))
+}
+
+// GroupNameContainsFold applies the ContainsFold predicate on the "group_name" field.
+func GroupNameContainsFold(v string) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldContainsFold(FieldGroupName, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v biz.PolicyEvaluationStatus) predicate.PolicyEvaluation {
+	vc := v
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v biz.PolicyEvaluationStatus) predicate.PolicyEvaluation {
+	vc := v
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...biz.PolicyEvaluationStatus) predicate.PolicyEvaluation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...biz.PolicyEvaluationStatus) predicate.PolicyEvaluation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// SkipReasonsIsNil applies the IsNil predicate on the "skip_reasons" field.
+func SkipReasonsIsNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIsNull(FieldSkipReasons))
+}
+
+// SkipReasonsNotNil applies the NotNil predicate on the "skip_reasons" field.
+func SkipReasonsNotNil() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotNull(FieldSkipReasons))
+}
+
+// GateEQ applies the EQ predicate on the "gate" field.
+func GateEQ(v bool) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldGate, v))
+}
+
+// GateNEQ applies the NEQ predicate on the "gate" field.
+func GateNEQ(v bool) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldGate, v))
+}
+
+// ViolationsCountEQ applies the EQ predicate on the "violations_count" field.
+func ViolationsCountEQ(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldViolationsCount, v))
+}
+
+// ViolationsCountNEQ applies the NEQ predicate on the "violations_count" field.
+func ViolationsCountNEQ(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldViolationsCount, v))
+}
+
+// ViolationsCountIn applies the In predicate on the "violations_count" field.
+func ViolationsCountIn(vs ...int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldIn(FieldViolationsCount, vs...))
+}
+
+// ViolationsCountNotIn applies the NotIn predicate on the "violations_count" field.
+func ViolationsCountNotIn(vs ...int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNotIn(FieldViolationsCount, vs...))
+}
+
+// ViolationsCountGT applies the GT predicate on the "violations_count" field.
+func ViolationsCountGT(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGT(FieldViolationsCount, v))
+}
+
+// ViolationsCountGTE applies the GTE predicate on the "violations_count" field.
+func ViolationsCountGTE(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldGTE(FieldViolationsCount, v))
+}
+
+// ViolationsCountLT applies the LT predicate on the "violations_count" field.
+func ViolationsCountLT(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLT(FieldViolationsCount, v))
+}
+
+// ViolationsCountLTE applies the LTE predicate on the "violations_count" field.
+func ViolationsCountLTE(v int) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldLTE(FieldViolationsCount, v))
+}
+
+// LatestEQ applies the EQ predicate on the "latest" field.
+func LatestEQ(v bool) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldEQ(FieldLatest, v))
+}
+
+// LatestNEQ applies the NEQ predicate on the "latest" field.
+func LatestNEQ(v bool) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.FieldNEQ(FieldLatest, v))
+}
+
+// HasWorkflowrun applies the HasEdge predicate on the "workflowrun" edge.
+func HasWorkflowrun() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkflowrunTable, WorkflowrunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowrunWith applies the HasEdge predicate on the "workflowrun" edge with a given conditions (other predicates).
+func HasWorkflowrunWith(preds ...predicate.WorkflowRun) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(func(s *sql.Selector) {
+		step := newWorkflowrunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasViolations applies the HasEdge predicate on the "violations" edge.
+func HasViolations() predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ViolationsTable, ViolationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasViolationsWith applies the HasEdge predicate on the "violations" edge with a given conditions (other predicates).
+func HasViolationsWith(preds ...predicate.PolicyViolation) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(func(s *sql.Selector) {
+		step := newViolationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PolicyEvaluation) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PolicyEvaluation) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PolicyEvaluation) predicate.PolicyEvaluation {
+	return predicate.PolicyEvaluation(sql.NotPredicates(p))
+}
@@ -0,0 +1,924 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/google/uuid"
+)
+
+// PolicyEvaluationCreate is the builder for creating a PolicyEvaluation entity.
+type PolicyEvaluationCreate struct {
+	config
+	mutation *PolicyEvaluationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PolicyEvaluationCreate) SetCreatedAt(v time.Time) *PolicyEvaluationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableCreatedAt(v *time.Time) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkflowrunID sets the "workflowrun_id" field.
+func (_c *PolicyEvaluationCreate) SetWorkflowrunID(v uuid.UUID) *PolicyEvaluationCreate {
+	_c.mutation.SetWorkflowrunID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *PolicyEvaluationCreate) SetOrganizationID(v uuid.UUID) *PolicyEvaluationCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *PolicyEvaluationCreate) SetProjectID(v uuid.UUID) *PolicyEvaluationCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_c *PolicyEvaluationCreate) SetWorkflowID(v uuid.UUID) *PolicyEvaluationCreate {
+	_c.mutation.SetWorkflowID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *PolicyEvaluationCreate) SetName(v string) *PolicyEvaluationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetMaterialName sets the "material_name" field.
+func (_c *PolicyEvaluationCreate) SetMaterialName(v string) *PolicyEvaluationCreate {
+	_c.mutation.SetMaterialName(v)
+	return _c
+}
+
+// SetNillableMaterialName sets the "material_name" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableMaterialName(v *string) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetMaterialName(*v)
+	}
+	return _c
+}
+
+// SetMaterialType sets the "material_type" field.
+func (_c *PolicyEvaluationCreate) SetMaterialType(v string) *PolicyEvaluationCreate {
+	_c.mutation.SetMaterialType(v)
+	return _c
+}
+
+// SetNillableMaterialType sets the "material_type" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableMaterialType(v *string) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetMaterialType(*v)
+	}
+	return _c
+}
+
+// SetPolicyURI sets the "policy_uri" field.
+func (_c *PolicyEvaluationCreate) SetPolicyURI(v string) *PolicyEvaluationCreate {
+	_c.mutation.SetPolicyURI(v)
+	return _c
+}
+
+// SetNillablePolicyURI sets the "policy_uri" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillablePolicyURI(v *string) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetPolicyURI(*v)
+	}
+	return _c
+}
+
+// SetPolicyDigest sets the "policy_digest" field.
+func (_c *PolicyEvaluationCreate) SetPolicyDigest(v string) *PolicyEvaluationCreate {
+	_c.mutation.SetPolicyDigest(v)
+	return _c
+}
+
+// SetNillablePolicyDigest sets the "policy_digest" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillablePolicyDigest(v *string) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetPolicyDigest(*v)
+	}
+	return _c
+}
+
+// SetGroupName sets the "group_name" field.
+func (_c *PolicyEvaluationCreate) SetGroupName(v string) *PolicyEvaluationCreate {
+	_c.mutation.SetGroupName(v)
+	return _c
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableGroupName(v *string) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetGroupName(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PolicyEvaluationCreate) SetStatus(v biz.PolicyEvaluationStatus) *PolicyEvaluationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetSkipReasons sets the "skip_reasons" field.
+func (_c *PolicyEvaluationCreate) SetSkipReasons(v []string) *PolicyEvaluationCreate {
+	_c.mutation.SetSkipReasons(v)
+	return _c
+}
+
+// SetGate sets the "gate" field.
+func (_c *PolicyEvaluationCreate) SetGate(v bool) *PolicyEvaluationCreate {
+	_c.mutation.SetGate(v)
+	return _c
+}
+
+// SetNillableGate sets the "gate" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableGate(v *bool) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetGate(*v)
+	}
+	return _c
+}
+
+// SetViolationsCount sets the "violations_count" field.
+func (_c *PolicyEvaluationCreate) SetViolationsCount(v int) *PolicyEvaluationCreate {
+	_c.mutation.SetViolationsCount(v)
+	return _c
+}
+
+// SetNillableViolationsCount sets the "violations_count" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableViolationsCount(v *int) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetViolationsCount(*v)
+	}
+	return _c
+}
+
+// SetLatest sets the "latest" field.
+func (_c *PolicyEvaluationCreate) SetLatest(v bool) *PolicyEvaluationCreate {
+	_c.mutation.SetLatest(v)
+	return _c
+}
+
+// SetNillableLatest sets the "latest" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableLatest(v *bool) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetLatest(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyEvaluationCreate) SetID(v uuid.UUID) *PolicyEvaluationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyEvaluationCreate) SetNillableID(v *uuid.UUID) *PolicyEvaluationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowrun sets the "workflowrun" edge to the WorkflowRun entity.
+func (_c *PolicyEvaluationCreate) SetWorkflowrun(v *WorkflowRun) *PolicyEvaluationCreate {
+	return _c.SetWorkflowrunID(v.ID)
+}
+
+// AddViolationIDs adds the "violations" edge to the PolicyViolation entity by IDs.
+func (_c *PolicyEvaluationCreate) AddViolationIDs(ids ...uuid.UUID) *PolicyEvaluationCreate {
+	_c.mutation.AddViolationIDs(ids...)
+	return _c
+}
+
+// AddViolations adds the "violations" edges to the PolicyViolation entity.
+func (_c *PolicyEvaluationCreate) AddViolations(v ...*PolicyViolation) *PolicyEvaluationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddViolationIDs(ids...)
+}
+
+// Mutation returns the PolicyEvaluationMutation object of the builder.
+func (_c *PolicyEvaluationCreate) Mutation() *PolicyEvaluationMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyEvaluation in the database.
+func (_c *PolicyEvaluationCreate) Save(ctx context.Context) (*PolicyEvaluation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyEvaluationCreate) SaveX(ctx context.Context) *PolicyEvaluation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyEvaluationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyEvaluationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyEvaluationCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := policyevaluation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.Gate(); !ok {
+		v := policyevaluation.DefaultGate
+		_c.mutation.SetGate(v)
+	}
+	if _, ok := _c.mutation.ViolationsCount(); !ok {
+		v := policyevaluation.DefaultViolationsCount
+		_c.mutation.SetViolationsCount(v)
+	}
+	if _, ok := _c.mutation.Latest(); !ok {
+		v := policyevaluation.DefaultLatest
+		_c.mutation.SetLatest(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyevaluation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyEvaluationCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PolicyEvaluation.created_at"`)}
+	}
+	if _, ok := _c.mutation.WorkflowrunID(); !ok {
+		return &ValidationError{Name: "workflowrun_id", err: errors.New(`ent: missing required field "PolicyEvaluation.workflowrun_id"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "PolicyEvaluation.organization_id"`)}
+	}
+	if _, ok := _c.mutation.ProjectID(); !ok {
+		return &ValidationError{Name: "project_id", err: errors.New(`ent: missing required field "PolicyEvaluation.project_id"`)}
+	}
+	if _, ok := _c.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow_id", err: errors.New(`ent: missing required field "PolicyEvaluation.workflow_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PolicyEvaluation.name"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PolicyEvaluation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := policyevaluation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PolicyEvaluation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Gate(); !ok {
+		return &ValidationError{Name: "gate", err: errors.New(`ent: missing required field "PolicyEvaluation.gate"`)}
+	}
+	if _, ok := _c.mutation.ViolationsCount(); !ok {
+		return &ValidationError{Name: "violations_count", err: errors.New(`ent: missing required field "PolicyEvaluation.violations_count"`)}
+	}
+	if _, ok := _c.mutation.Latest(); !ok {
+		return &ValidationError{Name: "latest", err: errors.New(`ent: missing required field "PolicyEvaluation.latest"`)}
+	}
+	if len(_c.mutation.WorkflowrunIDs()) == 0 {
+		return &ValidationError{Name: "workflowrun", err: errors.New(`ent: missing required edge "PolicyEvaluation.workflowrun"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyEvaluationCreate) sqlSave(ctx context.Context) (*PolicyEvaluation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyEvaluationCreate) createSpec() (*PolicyEvaluation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyEvaluation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyevaluation.Table, sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(policyevaluation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(policyevaluation.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := _c.mutation.ProjectID(); ok {
+		_spec.SetField(policyevaluation.FieldProjectID, field.TypeUUID, value)
+		_node.ProjectID = value
+	}
+	if value, ok := _c.mutation.WorkflowID(); ok {
+		_spec.SetField(policyevaluation.FieldWorkflowID, field.TypeUUID, value)
+		_node.WorkflowID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(policyevaluation.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.MaterialName(); ok {
+		_spec.SetField(policyevaluation.FieldMaterialName, field.TypeString, value)
+		_node.MaterialName = value
+	}
+	if value, ok := _c.mutation.MaterialType(); ok {
+		_spec.SetField(policyevaluation.FieldMaterialType, field.TypeString, value)
+		_node.MaterialType = value
+	}
+	if value, ok := _c.mutation.PolicyURI(); ok {
+		_spec.SetField(policyevaluation.FieldPolicyURI, field.TypeString, value)
+		_node.PolicyURI = value
+	}
+	if value, ok := _c.mutation.PolicyDigest(); ok {
+		_spec.SetField(policyevaluation.FieldPolicyDigest, field.TypeString, value)
+		_node.PolicyDigest = value
+	}
+	if value, ok := _c.mutation.GroupName(); ok {
+		_spec.SetField(policyevaluation.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(policyevaluation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.SkipReasons(); ok {
+		_spec.SetField(policyevaluation.FieldSkipReasons, field.TypeJSON, value)
+		_node.SkipReasons = value
+	}
+	if value, ok := _c.mutation.Gate(); ok {
+		_spec.SetField(policyevaluation.FieldGate, field.TypeBool, value)
+		_node.Gate = value
+	}
+	if value, ok := _c.mutation.ViolationsCount(); ok {
+		_spec.SetField(policyevaluation.FieldViolationsCount, field.TypeInt, value)
+		_node.ViolationsCount = value
+	}
+	if value, ok := _c.mutation.Latest(); ok {
+		_spec.SetField(policyevaluation.FieldLatest, field.TypeBool, value)
+		_node.Latest = value
+	}
+	if nodes := _c.mutation.WorkflowrunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyevaluation.WorkflowrunTable,
+			Columns: []string{policyevaluation.WorkflowrunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowrunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ViolationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyEvaluation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyEvaluationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyEvaluationCreate) OnConflict(opts ...sql.ConflictOption) *PolicyEvaluationUpsertOne {
+	_c.conflict = opts
+	return &PolicyEvaluationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyEvaluation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyEvaluationCreate) OnConflictColumns(columns ...string) *PolicyEvaluationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyEvaluationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyEvaluationUpsertOne is the builder for "upsert"-ing
+	//  one PolicyEvaluation node.
+	PolicyEvaluationUpsertOne struct {
+		create *PolicyEvaluationCreate
+	}
+
+	// PolicyEvaluationUpsert is the "OnConflict" setter.
+	PolicyEvaluationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetLatest sets the "latest" field.
+func (u *PolicyEvaluationUpsert) SetLatest(v bool) *PolicyEvaluationUpsert {
+	u.Set(policyevaluation.FieldLatest, v)
+	return u
+}
+
+// UpdateLatest sets the "latest" field to the value that was provided on create.
+func (u *PolicyEvaluationUpsert) UpdateLatest() *PolicyEvaluationUpsert {
+	u.SetExcluded(policyevaluation.FieldLatest)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyEvaluation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyevaluation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyEvaluationUpsertOne) UpdateNewValues() *PolicyEvaluationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyevaluation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(policyevaluation.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.WorkflowrunID(); exists {
+			s.SetIgnore(policyevaluation.FieldWorkflowrunID)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(policyevaluation.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(policyevaluation.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.WorkflowID(); exists {
+			s.SetIgnore(policyevaluation.FieldWorkflowID)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(policyevaluation.FieldName)
+		}
+		if _, exists := u.create.mutation.MaterialName(); exists {
+			s.SetIgnore(policyevaluation.FieldMaterialName)
+		}
+		if _, exists := u.create.mutation.MaterialType(); exists {
+			s.SetIgnore(policyevaluation.FieldMaterialType)
+		}
+		if _, exists := u.create.mutation.PolicyURI(); exists {
+			s.SetIgnore(policyevaluation.FieldPolicyURI)
+		}
+		if _, exists := u.create.mutation.PolicyDigest(); exists {
+			s.SetIgnore(policyevaluation.FieldPolicyDigest)
+		}
+		if _, exists := u.create.mutation.GroupName(); exists {
+			s.SetIgnore(policyevaluation.FieldGroupName)
+		}
+		if _, exists := u.create.mutation.Status(); exists {
+			s.SetIgnore(policyevaluation.FieldStatus)
+		}
+		if _, exists := u.create.mutation.SkipReasons(); exists {
+			s.SetIgnore(policyevaluation.FieldSkipReasons)
+		}
+		if _, exists := u.create.mutation.Gate(); exists {
+			s.SetIgnore(policyevaluation.FieldGate)
+		}
+		if _, exists := u.create.mutation.ViolationsCount(); exists {
+			s.SetIgnore(policyevaluation.FieldViolationsCount)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyEvaluation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyEvaluationUpsertOne) Ignore() *PolicyEvaluationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyEvaluationUpsertOne) DoNothing() *PolicyEvaluationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyEvaluationCreate.OnConflict
+// documentation for more info.
+func (u *PolicyEvaluationUpsertOne) Update(set func(*PolicyEvaluationUpsert)) *PolicyEvaluationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyEvaluationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLatest sets the "latest" field.
+func (u *PolicyEvaluationUpsertOne) SetLatest(v bool) *PolicyEvaluationUpsertOne {
+	return u.Update(func(s *PolicyEvaluationUpsert) {
+		s.SetLatest(v)
+	})
+}
+
+// UpdateLatest sets the "latest" field to the value that was provided on create.
+func (u *PolicyEvaluationUpsertOne) UpdateLatest() *PolicyEvaluationUpsertOne {
+	return u.Update(func(s *PolicyEvaluationUpsert) {
+		s.UpdateLatest()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyEvaluationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyEvaluationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyEvaluationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyEvaluationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyEvaluationUpsertOne.ID is not supported by MySQL driver. Use PolicyEvaluationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyEvaluationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyEvaluationCreateBulk is the builder for creating many PolicyEvaluation entities in bulk.
+type PolicyEvaluationCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyEvaluationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyEvaluation entities in the database.
+func (_c *PolicyEvaluationCreateBulk) Save(ctx context.Context) ([]*PolicyEvaluation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyEvaluation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyEvaluationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyEvaluationCreateBulk) SaveX(ctx context.Context) []*PolicyEvaluation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyEvaluationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyEvaluationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyEvaluation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyEvaluationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyEvaluationCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyEvaluationUpsertBulk {
+	_c.conflict = opts
+	return &PolicyEvaluationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyEvaluation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyEvaluationCreateBulk) OnConflictColumns(columns ...string) *PolicyEvaluationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyEvaluationUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyEvaluationUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyEvaluation nodes.
+type PolicyEvaluationUpsertBulk struct {
+	create *PolicyEvaluationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyEvaluation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyevaluation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyEvaluationUpsertBulk) UpdateNewValues() *PolicyEvaluationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyevaluation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(policyevaluation.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.WorkflowrunID(); exists {
+				s.SetIgnore(policyevaluation.FieldWorkflowrunID)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(policyevaluation.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(policyevaluation.FieldProjectID)
+			}
+			if _, exists := b.mutation.WorkflowID(); exists {
+				s.SetIgnore(policyevaluation.FieldWorkflowID)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(policyevaluation.FieldName)
+			}
+			if _, exists := b.mutation.MaterialName(); exists {
+				s.SetIgnore(policyevaluation.FieldMaterialName)
+			}
+			if _, exists := b.mutation.MaterialType(); exists {
+				s.SetIgnore(policyevaluation.FieldMaterialType)
+			}
+			if _, exists := b.mutation.PolicyURI(); exists {
+				s.SetIgnore(policyevaluation.FieldPolicyURI)
+			}
+			if _, exists := b.mutation.PolicyDigest(); exists {
+				s.SetIgnore(policyevaluation.FieldPolicyDigest)
+			}
+			if _, exists := b.mutation.GroupName(); exists {
+				s.SetIgnore(policyevaluation.FieldGroupName)
+			}
+			if _, exists := b.mutation.Status(); exists {
+				s.SetIgnore(policyevaluation.FieldStatus)
+			}
+			if _, exists := b.mutation.SkipReasons(); exists {
+				s.SetIgnore(policyevaluation.FieldSkipReasons)
+			}
+			if _, exists := b.mutation.Gate(); exists {
+				s.SetIgnore(policyevaluation.FieldGate)
+			}
+			if _, exists := b.mutation.ViolationsCount(); exists {
+				s.SetIgnore(policyevaluation.FieldViolationsCount)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyEvaluation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyEvaluationUpsertBulk) Ignore() *PolicyEvaluationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyEvaluationUpsertBulk) DoNothing() *PolicyEvaluationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyEvaluationCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyEvaluationUpsertBulk) Update(set func(*PolicyEvaluationUpsert)) *PolicyEvaluationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyEvaluationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetLatest sets the "latest" field.
+func (u *PolicyEvaluationUpsertBulk) SetLatest(v bool) *PolicyEvaluationUpsertBulk {
+	return u.Update(func(s *PolicyEvaluationUpsert) {
+		s.SetLatest(v)
+	})
+}
+
+// UpdateLatest sets the "latest" field to the value that was provided on create.
+func (u *PolicyEvaluationUpsertBulk) UpdateLatest() *PolicyEvaluationUpsertBulk {
+	return u.Update(func(s *PolicyEvaluationUpsert) {
+		s.UpdateLatest()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyEvaluationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyEvaluationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyEvaluationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyEvaluationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/google/uuid"
+)
+
+// PolicyEvaluationQuery is the builder for querying PolicyEvaluation entities.
+type PolicyEvaluationQuery struct {
+	config
+	ctx             *QueryContext
+	order           []policyevaluation.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.PolicyEvaluation
+	withWorkflowrun *WorkflowRunQuery
+	withViolations  *PolicyViolationQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PolicyEvaluationQuery builder.
+func (_q *PolicyEvaluationQuery) Where(ps ...predicate.PolicyEvaluation) *PolicyEvaluationQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *PolicyEvaluationQuery) Limit(limit int) *PolicyEvaluationQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *PolicyEvaluationQuery) Offset(offset int) *PolicyEvaluationQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *PolicyEvaluationQuery) Unique(unique bool) *PolicyEvaluationQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *PolicyEvaluationQuery) Order(o ...policyevaluation.OrderOption) *PolicyEvaluationQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryWorkflowrun chains the current query on the "workflowrun" edge.
+func (_q *PolicyEvaluationQuery) QueryWorkflowrun() *WorkflowRunQuery {
+	query := (&WorkflowRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyevaluation.Table, policyevaluation.FieldID, selector),
+			sqlgraph.To(workflowrun.Table, workflowrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, policyevaluation.WorkflowrunTable, policyevaluation.WorkflowrunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryViolations chains the current query on the "violations" edge.
+func (_q *PolicyEvaluationQuery) QueryViolations() *PolicyViolationQuery {
+	query := (&PolicyViolationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(policyevaluation.Table, policyevaluation.FieldID, selector),
+			sqlgraph.To(policyviolation.Table, policyviolation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, policyevaluation.ViolationsTable, policyevaluation.ViolationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PolicyEvaluation entity from the query.
+// Returns a *NotFoundError when no PolicyEvaluation was found.
+func (_q *PolicyEvaluationQuery) First(ctx context.Context) (*PolicyEvaluation, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{policyevaluation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) FirstX(ctx context.Context) *PolicyEvaluation {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PolicyEvaluation ID from the query.
+// Returns a *NotFoundError when no PolicyEvaluation ID was found.
+func (_q *PolicyEvaluationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{policyevaluation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PolicyEvaluation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PolicyEvaluation entity is found.
+// Returns a *NotFoundError when no PolicyEvaluation entities are found.
+func (_q *PolicyEvaluationQuery) Only(ctx context.Context) (*PolicyEvaluation, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{policyevaluation.Label}
+	default:
+		return nil, &NotSingularError{policyevaluation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) OnlyX(ctx context.Context) *PolicyEvaluation {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PolicyEvaluation ID in the query.
+// Returns a *NotSingularError when more than one PolicyEvaluation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *PolicyEvaluationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{policyevaluation.Label}
+	default:
+		err = &NotSingularError{policyevaluation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PolicyEvaluations.
+func (_q *PolicyEvaluationQuery) All(ctx context.Context) ([]*PolicyEvaluation, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PolicyEvaluation, *PolicyEvaluationQuery]()
+	return withInterceptors[[]*PolicyEvaluation](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) AllX(ctx context.Context) []*PolicyEvaluation {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PolicyEvaluation IDs.
+func (_q *PolicyEvaluationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(policyevaluation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *PolicyEvaluationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*PolicyEvaluationQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *PolicyEvaluationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *PolicyEvaluationQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PolicyEvaluationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *PolicyEvaluationQuery) Clone() *PolicyEvaluationQuery {
+	if _q == nil {
+		return nil
+	}
+	return &PolicyEvaluationQuery{
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]policyevaluation.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.PolicyEvaluation{}, _q.predicates...),
+		withWorkflowrun: _q.withWorkflowrun.Clone(),
+		withViolations:  _q.withViolations.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithWorkflowrun tells the query-builder to eager-load the nodes that are connected to
+// the "workflowrun" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PolicyEvaluationQuery) WithWorkflowrun(opts ...func(*WorkflowRunQuery)) *PolicyEvaluationQuery {
+	query := (&WorkflowRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWorkflowrun = query
+	return _q
+}
+
+// WithViolations tells the query-builder to eager-load the nodes that are connected to
+// the "violations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PolicyEvaluationQuery) WithViolations(opts ...func(*PolicyViolationQuery)) *PolicyEvaluationQuery {
+	query := (&PolicyViolationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withViolations = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PolicyEvaluation.Query().
+//		GroupBy(policyevaluation.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *PolicyEvaluationQuery) GroupBy(field string, fields ...string) *PolicyEvaluationGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PolicyEvaluationGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = policyevaluation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PolicyEvaluation.Query().
+//		Select(policyevaluation.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *PolicyEvaluationQuery) Select(fields ...string) *PolicyEvaluationSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &PolicyEvaluationSelect{PolicyEvaluationQuery: _q}
+	sbuild.label = policyevaluation.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PolicyEvaluationSelect configured with the given aggregations.
+func (_q *PolicyEvaluationQuery) Aggregate(fns ...AggregateFunc) *PolicyEvaluationSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *PolicyEvaluationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !policyevaluation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *PolicyEvaluationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PolicyEvaluation, error) {
+	var (
+		nodes       = []*PolicyEvaluation{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withWorkflowrun != nil,
+			_q.withViolations != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PolicyEvaluation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PolicyEvaluation{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withWorkflowrun; query != nil {
+		if err := _q.loadWorkflowrun(ctx, query, nodes, nil,
+			func(n *PolicyEvaluation, e *WorkflowRun) { n.Edges.Workflowrun = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withViolations; query != nil {
+		if err := _q.loadViolations(ctx, query, nodes,
+			func(n *PolicyEvaluation) { n.Edges.Violations = []*PolicyViolation{} },
+			func(n *PolicyEvaluation, e *PolicyViolation) { n.Edges.Violations = append(n.Edges.Violations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *PolicyEvaluationQuery) loadWorkflowrun(ctx context.Context, query *WorkflowRunQuery, nodes []*PolicyEvaluation, init func(*PolicyEvaluation), assign func(*PolicyEvaluation, *WorkflowRun)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*PolicyEvaluation)
+	for i := range nodes {
+		fk := nodes[i].WorkflowrunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workflowrun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "workflowrun_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *PolicyEvaluationQuery) loadViolations(ctx context.Context, query *PolicyViolationQuery, nodes []*PolicyEvaluation, init func(*PolicyEvaluation), assign func(*PolicyEvaluation, *PolicyViolation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*PolicyEvaluation)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(policyviolation.FieldPolicyEvaluationID)
+	}
+	query.Where(predicate.PolicyViolation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(policyevaluation.ViolationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PolicyEvaluationID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "policy_evaluation_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *PolicyEvaluationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *PolicyEvaluationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(policyevaluation.Table, policyevaluation.Columns, sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyevaluation.FieldID)
+		for i := range fields {
+			if fields[i] != policyevaluation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withWorkflowrun != nil {
+			_spec.Node.AddColumnOnce(policyevaluation.FieldWorkflowrunID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *PolicyEvaluationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(policyevaluation.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = policyevaluation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *PolicyEvaluationQuery) ForUpdate(opts ...sql.LockOption) *PolicyEvaluationQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *PolicyEvaluationQuery) ForShare(opts ...sql.LockOption) *PolicyEvaluationQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *PolicyEvaluationQuery) Modify(modifiers ...func(s *sql.Selector)) *PolicyEvaluationSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// PolicyEvaluationGroupBy is the group-by builder for PolicyEvaluation entities.
+type PolicyEvaluationGroupBy struct {
+	selector
+	build *PolicyEvaluationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *PolicyEvaluationGroupBy) Aggregate(fns ...AggregateFunc) *PolicyEvaluationGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *PolicyEvaluationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PolicyEvaluationQuery, *PolicyEvaluationGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *PolicyEvaluationGroupBy) sqlScan(ctx context.Context, root *PolicyEvaluationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PolicyEvaluationSelect is the builder for selecting fields of PolicyEvaluation entities.
+type PolicyEvaluationSelect struct {
+	*PolicyEvaluationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *PolicyEvaluationSelect) Aggregate(fns ...AggregateFunc) *PolicyEvaluationSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *PolicyEvaluationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PolicyEvaluationQuery, *PolicyEvaluationSelect](ctx, _s.PolicyEvaluationQuery, _s, _s.inters, v)
+}
+
+func (_s *PolicyEvaluationSelect) sqlScan(ctx context.Context, root *PolicyEvaluationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *PolicyEvaluationSelect) Modify(modifiers ...func(s *sql.Selector)) *PolicyEvaluationSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
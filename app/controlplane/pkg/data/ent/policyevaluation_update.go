@@ -0,0 +1,447 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// PolicyEvaluationUpdate is the builder for updating PolicyEvaluation entities.
+type PolicyEvaluationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PolicyEvaluationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PolicyEvaluationUpdate builder.
+func (_u *PolicyEvaluationUpdate) Where(ps ...predicate.PolicyEvaluation) *PolicyEvaluationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetLatest sets the "latest" field.
+func (_u *PolicyEvaluationUpdate) SetLatest(v bool) *PolicyEvaluationUpdate {
+	_u.mutation.SetLatest(v)
+	return _u
+}
+
+// SetNillableLatest sets the "latest" field if the given value is not nil.
+func (_u *PolicyEvaluationUpdate) SetNillableLatest(v *bool) *PolicyEvaluationUpdate {
+	if v != nil {
+		_u.SetLatest(*v)
+	}
+	return _u
+}
+
+// AddViolationIDs adds the "violations" edge to the PolicyViolation entity by IDs.
+func (_u *PolicyEvaluationUpdate) AddViolationIDs(ids ...uuid.UUID) *PolicyEvaluationUpdate {
+	_u.mutation.AddViolationIDs(ids...)
+	return _u
+}
+
+// AddViolations adds the "violations" edges to the PolicyViolation entity.
+func (_u *PolicyEvaluationUpdate) AddViolations(v ...*PolicyViolation) *PolicyEvaluationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViolationIDs(ids...)
+}
+
+// Mutation returns the PolicyEvaluationMutation object of the builder.
+func (_u *PolicyEvaluationUpdate) Mutation() *PolicyEvaluationMutation {
+	return _u.mutation
+}
+
+// ClearViolations clears all "violations" edges to the PolicyViolation entity.
+func (_u *PolicyEvaluationUpdate) ClearViolations() *PolicyEvaluationUpdate {
+	_u.mutation.ClearViolations()
+	return _u
+}
+
+// RemoveViolationIDs removes the "violations" edge to PolicyViolation entities by IDs.
+func (_u *PolicyEvaluationUpdate) RemoveViolationIDs(ids ...uuid.UUID) *PolicyEvaluationUpdate {
+	_u.mutation.RemoveViolationIDs(ids...)
+	return _u
+}
+
+// RemoveViolations removes "violations" edges to PolicyViolation entities.
+func (_u *PolicyEvaluationUpdate) RemoveViolations(v ...*PolicyViolation) *PolicyEvaluationUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViolationIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyEvaluationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyEvaluationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyEvaluationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyEvaluationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyEvaluationUpdate) check() error {
+	if _u.mutation.WorkflowrunCleared() && len(_u.mutation.WorkflowrunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyEvaluation.workflowrun"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyEvaluationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyEvaluationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyEvaluationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyevaluation.Table, policyevaluation.Columns, sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.MaterialNameCleared() {
+		_spec.ClearField(policyevaluation.FieldMaterialName, field.TypeString)
+	}
+	if _u.mutation.MaterialTypeCleared() {
+		_spec.ClearField(policyevaluation.FieldMaterialType, field.TypeString)
+	}
+	if _u.mutation.PolicyURICleared() {
+		_spec.ClearField(policyevaluation.FieldPolicyURI, field.TypeString)
+	}
+	if _u.mutation.PolicyDigestCleared() {
+		_spec.ClearField(policyevaluation.FieldPolicyDigest, field.TypeString)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(policyevaluation.FieldGroupName, field.TypeString)
+	}
+	if _u.mutation.SkipReasonsCleared() {
+		_spec.ClearField(policyevaluation.FieldSkipReasons, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Latest(); ok {
+		_spec.SetField(policyevaluation.FieldLatest, field.TypeBool, value)
+	}
+	if _u.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViolationsIDs(); len(nodes) > 0 && !_u.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViolationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyevaluation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyEvaluationUpdateOne is the builder for updating a single PolicyEvaluation entity.
+type PolicyEvaluationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PolicyEvaluationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetLatest sets the "latest" field.
+func (_u *PolicyEvaluationUpdateOne) SetLatest(v bool) *PolicyEvaluationUpdateOne {
+	_u.mutation.SetLatest(v)
+	return _u
+}
+
+// SetNillableLatest sets the "latest" field if the given value is not nil.
+func (_u *PolicyEvaluationUpdateOne) SetNillableLatest(v *bool) *PolicyEvaluationUpdateOne {
+	if v != nil {
+		_u.SetLatest(*v)
+	}
+	return _u
+}
+
+// AddViolationIDs adds the "violations" edge to the PolicyViolation entity by IDs.
+func (_u *PolicyEvaluationUpdateOne) AddViolationIDs(ids ...uuid.UUID) *PolicyEvaluationUpdateOne {
+	_u.mutation.AddViolationIDs(ids...)
+	return _u
+}
+
+// AddViolations adds the "violations" edges to the PolicyViolation entity.
+func (_u *PolicyEvaluationUpdateOne) AddViolations(v ...*PolicyViolation) *PolicyEvaluationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddViolationIDs(ids...)
+}
+
+// Mutation returns the PolicyEvaluationMutation object of the builder.
+func (_u *PolicyEvaluationUpdateOne) Mutation() *PolicyEvaluationMutation {
+	return _u.mutation
+}
+
+// ClearViolations clears all "violations" edges to the PolicyViolation entity.
+func (_u *PolicyEvaluationUpdateOne) ClearViolations() *PolicyEvaluationUpdateOne {
+	_u.mutation.ClearViolations()
+	return _u
+}
+
+// RemoveViolationIDs removes the "violations" edge to PolicyViolation entities by IDs.
+func (_u *PolicyEvaluationUpdateOne) RemoveViolationIDs(ids ...uuid.UUID) *PolicyEvaluationUpdateOne {
+	_u.mutation.RemoveViolationIDs(ids...)
+	return _u
+}
+
+// RemoveViolations removes "violations" edges to PolicyViolation entities.
+func (_u *PolicyEvaluationUpdateOne) RemoveViolations(v ...*PolicyViolation) *PolicyEvaluationUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveViolationIDs(ids...)
+}
+
+// Where appends a list predicates to the PolicyEvaluationUpdate builder.
+func (_u *PolicyEvaluationUpdateOne) Where(ps ...predicate.PolicyEvaluation) *PolicyEvaluationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyEvaluationUpdateOne) Select(field string, fields ...string) *PolicyEvaluationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyEvaluation entity.
+func (_u *PolicyEvaluationUpdateOne) Save(ctx context.Context) (*PolicyEvaluation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyEvaluationUpdateOne) SaveX(ctx context.Context) *PolicyEvaluation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyEvaluationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyEvaluationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyEvaluationUpdateOne) check() error {
+	if _u.mutation.WorkflowrunCleared() && len(_u.mutation.WorkflowrunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyEvaluation.workflowrun"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyEvaluationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyEvaluationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyEvaluationUpdateOne) sqlSave(ctx context.Context) (_node *PolicyEvaluation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyevaluation.Table, policyevaluation.Columns, sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyEvaluation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyevaluation.FieldID)
+		for _, f := range fields {
+			if !policyevaluation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policyevaluation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.MaterialNameCleared() {
+		_spec.ClearField(policyevaluation.FieldMaterialName, field.TypeString)
+	}
+	if _u.mutation.MaterialTypeCleared() {
+		_spec.ClearField(policyevaluation.FieldMaterialType, field.TypeString)
+	}
+	if _u.mutation.PolicyURICleared() {
+		_spec.ClearField(policyevaluation.FieldPolicyURI, field.TypeString)
+	}
+	if _u.mutation.PolicyDigestCleared() {
+		_spec.ClearField(policyevaluation.FieldPolicyDigest, field.TypeString)
+	}
+	if _u.mutation.GroupNameCleared() {
+		_spec.ClearField(policyevaluation.FieldGroupName, field.TypeString)
+	}
+	if _u.mutation.SkipReasonsCleared() {
+		_spec.ClearField(policyevaluation.FieldSkipReasons, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Latest(); ok {
+		_spec.SetField(policyevaluation.FieldLatest, field.TypeBool, value)
+	}
+	if _u.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedViolationsIDs(); len(nodes) > 0 && !_u.mutation.ViolationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ViolationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   policyevaluation.ViolationsTable,
+			Columns: []string{policyevaluation.ViolationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PolicyEvaluation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyevaluation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
+	"github.com/google/uuid"
+)
+
+// PolicyException is the model entity for the PolicyException schema.
+type PolicyException struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// PolicyName holds the value of the "policy_name" field.
+	PolicyName string `json:"policy_name,omitempty"`
+	// MaterialName holds the value of the "material_name" field.
+	MaterialName string `json:"material_name,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// Justification holds the value of the "justification" field.
+	Justification string `json:"justification,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// ApprovedBy holds the value of the "approved_by" field.
+	ApprovedBy string `json:"approved_by,omitempty"`
+	// ApprovedAt holds the value of the "approved_at" field.
+	ApprovedAt time.Time `json:"approved_at,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+	// ExpirationProcessedAt holds the value of the "expiration_processed_at" field.
+	ExpirationProcessedAt time.Time `json:"expiration_processed_at,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// ProjectID holds the value of the "project_id" field.
+	ProjectID uuid.UUID `json:"project_id,omitempty"`
+	// WorkflowID holds the value of the "workflow_id" field.
+	WorkflowID uuid.UUID `json:"workflow_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyExceptionQuery when eager-loading is set.
+	Edges        PolicyExceptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyExceptionEdges holds the relations/edges for other nodes in the graph.
+type PolicyExceptionEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// Project holds the value of the project edge.
+	Project *Project `json:"project,omitempty"`
+	// Workflow holds the value of the workflow edge.
+	Workflow *Workflow `json:"workflow,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyExceptionEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// ProjectOrErr returns the Project value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyExceptionEdges) ProjectOrErr() (*Project, error) {
+	if e.Project != nil {
+		return e.Project, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: project.Label}
+	}
+	return nil, &NotLoadedError{edge: "project"}
+}
+
+// WorkflowOrErr returns the Workflow value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyExceptionEdges) WorkflowOrErr() (*Workflow, error) {
+	if e.Workflow != nil {
+		return e.Workflow, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: workflow.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflow"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyException) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyexception.FieldPolicyName, policyexception.FieldMaterialName, policyexception.FieldExternalID, policyexception.FieldJustification, policyexception.FieldCreatedBy, policyexception.FieldApprovedBy:
+			values[i] = new(sql.NullString)
+		case policyexception.FieldCreatedAt, policyexception.FieldApprovedAt, policyexception.FieldExpiresAt, policyexception.FieldRevokedAt, policyexception.FieldExpirationProcessedAt:
+			values[i] = new(sql.NullTime)
+		case policyexception.FieldID, policyexception.FieldOrganizationID, policyexception.FieldProjectID, policyexception.FieldWorkflowID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyException fields.
+func (_m *PolicyException) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyexception.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyexception.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case policyexception.FieldPolicyName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_name", values[i])
+			} else if value.Valid {
+				_m.PolicyName = value.String
+			}
+		case policyexception.FieldMaterialName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field material_name", values[i])
+			} else if value.Valid {
+				_m.MaterialName = value.String
+			}
+		case policyexception.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case policyexception.FieldJustification:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field justification", values[i])
+			} else if value.Valid {
+				_m.Justification = value.String
+			}
+		case policyexception.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case policyexception.FieldApprovedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_by", values[i])
+			} else if value.Valid {
+				_m.ApprovedBy = value.String
+			}
+		case policyexception.FieldApprovedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field approved_at", values[i])
+			} else if value.Valid {
+				_m.ApprovedAt = value.Time
+			}
+		case policyexception.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case policyexception.FieldRevokedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_at", values[i])
+			} else if value.Valid {
+				_m.RevokedAt = value.Time
+			}
+		case policyexception.FieldExpirationProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiration_processed_at", values[i])
+			} else if value.Valid {
+				_m.ExpirationProcessedAt = value.Time
+			}
+		case policyexception.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case policyexception.FieldProjectID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field project_id", values[i])
+			} else if value != nil {
+				_m.ProjectID = *value
+			}
+		case policyexception.FieldWorkflowID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value != nil {
+				_m.WorkflowID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyException.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyException) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the PolicyException entity.
+func (_m *PolicyException) QueryOrganization() *OrganizationQuery {
+	return NewPolicyExceptionClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryProject queries the "project" edge of the PolicyException entity.
+func (_m *PolicyException) QueryProject() *ProjectQuery {
+	return NewPolicyExceptionClient(_m.config).QueryProject(_m)
+}
+
+// QueryWorkflow queries the "workflow" edge of the PolicyException entity.
+func (_m *PolicyException) QueryWorkflow() *WorkflowQuery {
+	return NewPolicyExceptionClient(_m.config).QueryWorkflow(_m)
+}
+
+// Update returns a builder for updating this PolicyException.
+// Note that you need to call PolicyException.Unwrap() before calling this method if this PolicyException
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyException) Update() *PolicyExceptionUpdateOne {
+	return NewPolicyExceptionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyException entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyException) Unwrap() *PolicyException {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyException is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyException) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyException(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("policy_name=")
+	builder.WriteString(_m.PolicyName)
+	builder.WriteString(", ")
+	builder.WriteString("material_name=")
+	builder.WriteString(_m.MaterialName)
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("justification=")
+	builder.WriteString(_m.Justification)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("approved_by=")
+	builder.WriteString(_m.ApprovedBy)
+	builder.WriteString(", ")
+	builder.WriteString("approved_at=")
+	builder.WriteString(_m.ApprovedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("revoked_at=")
+	builder.WriteString(_m.RevokedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expiration_processed_at=")
+	builder.WriteString(_m.ExpirationProcessedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("project_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProjectID))
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyExceptions is a parsable slice of PolicyException.
+type PolicyExceptions []*PolicyException
@@ -0,0 +1,951 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyexception
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PolicyName applies equality check predicate on the "policy_name" field. It's identical to PolicyNameEQ.
+func PolicyName(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldPolicyName, v))
+}
+
+// MaterialName applies equality check predicate on the "material_name" field. It's identical to MaterialNameEQ.
+func MaterialName(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldMaterialName, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldExternalID, v))
+}
+
+// Justification applies equality check predicate on the "justification" field. It's identical to JustificationEQ.
+func Justification(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldJustification, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// ApprovedBy applies equality check predicate on the "approved_by" field. It's identical to ApprovedByEQ.
+func ApprovedBy(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedAt applies equality check predicate on the "approved_at" field. It's identical to ApprovedAtEQ.
+func ApprovedAt(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevokedAt applies equality check predicate on the "revoked_at" field. It's identical to RevokedAtEQ.
+func RevokedAt(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// ExpirationProcessedAt applies equality check predicate on the "expiration_processed_at" field. It's identical to ExpirationProcessedAtEQ.
+func ExpirationProcessedAt(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldExpirationProcessedAt, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// ProjectID applies equality check predicate on the "project_id" field. It's identical to ProjectIDEQ.
+func ProjectID(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldProjectID, v))
+}
+
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// PolicyNameEQ applies the EQ predicate on the "policy_name" field.
+func PolicyNameEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldPolicyName, v))
+}
+
+// PolicyNameNEQ applies the NEQ predicate on the "policy_name" field.
+func PolicyNameNEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldPolicyName, v))
+}
+
+// PolicyNameIn applies the In predicate on the "policy_name" field.
+func PolicyNameIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldPolicyName, vs...))
+}
+
+// PolicyNameNotIn applies the NotIn predicate on the "policy_name" field.
+func PolicyNameNotIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldPolicyName, vs...))
+}
+
+// PolicyNameGT applies the GT predicate on the "policy_name" field.
+func PolicyNameGT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldPolicyName, v))
+}
+
+// PolicyNameGTE applies the GTE predicate on the "policy_name" field.
+func PolicyNameGTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldPolicyName, v))
+}
+
+// PolicyNameLT applies the LT predicate on the "policy_name" field.
+func PolicyNameLT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldPolicyName, v))
+}
+
+// PolicyNameLTE applies the LTE predicate on the "policy_name" field.
+func PolicyNameLTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldPolicyName, v))
+}
+
+// PolicyNameContains applies the Contains predicate on the "policy_name" field.
+func PolicyNameContains(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContains(FieldPolicyName, v))
+}
+
+// PolicyNameHasPrefix applies the HasPrefix predicate on the "policy_name" field.
+func PolicyNameHasPrefix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasPrefix(FieldPolicyName, v))
+}
+
+// PolicyNameHasSuffix applies the HasSuffix predicate on the "policy_name" field.
+func PolicyNameHasSuffix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasSuffix(FieldPolicyName, v))
+}
+
+// PolicyNameIsNil applies the IsNil predicate on the "policy_name" field.
+func PolicyNameIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldPolicyName))
+}
+
+// PolicyNameNotNil applies the NotNil predicate on the "policy_name" field.
+func PolicyNameNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldPolicyName))
+}
+
+// PolicyNameEqualFold applies the EqualFold predicate on the "policy_name" field.
+func PolicyNameEqualFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEqualFold(FieldPolicyName, v))
+}
+
+// PolicyNameContainsFold applies the ContainsFold predicate on the "policy_name" field.
+func PolicyNameContainsFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContainsFold(FieldPolicyName, v))
+}
+
+// MaterialNameEQ applies the EQ predicate on the "material_name" field.
+func MaterialNameEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldMaterialName, v))
+}
+
+// MaterialNameNEQ applies the NEQ predicate on the "material_name" field.
+func MaterialNameNEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldMaterialName, v))
+}
+
+// MaterialNameIn applies the In predicate on the "material_name" field.
+func MaterialNameIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldMaterialName, vs...))
+}
+
+// MaterialNameNotIn applies the NotIn predicate on the "material_name" field.
+func MaterialNameNotIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldMaterialName, vs...))
+}
+
+// MaterialNameGT applies the GT predicate on the "material_name" field.
+func MaterialNameGT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldMaterialName, v))
+}
+
+// MaterialNameGTE applies the GTE predicate on the "material_name" field.
+func MaterialNameGTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldMaterialName, v))
+}
+
+// MaterialNameLT applies the LT predicate on the "material_name" field.
+func MaterialNameLT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldMaterialName, v))
+}
+
+// MaterialNameLTE applies the LTE predicate on the "material_name" field.
+func MaterialNameLTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldMaterialName, v))
+}
+
+// MaterialNameContains applies the Contains predicate on the "material_name" field.
+func MaterialNameContains(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContains(FieldMaterialName, v))
+}
+
+// MaterialNameHasPrefix applies the HasPrefix predicate on the "material_name" field.
+func MaterialNameHasPrefix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasPrefix(FieldMaterialName, v))
+}
+
+// MaterialNameHasSuffix applies the HasSuffix predicate on the "material_name" field.
+func MaterialNameHasSuffix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasSuffix(FieldMaterialName, v))
+}
+
+// MaterialNameIsNil applies the IsNil predicate on the "material_name" field.
+func MaterialNameIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldMaterialName))
+}
+
+// MaterialNameNotNil applies the NotNil predicate on the "material_name" field.
+func MaterialNameNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldMaterialName))
+}
+
+// MaterialNameEqualFold applies the EqualFold predicate on the "material_name" field.
+func MaterialNameEqualFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEqualFold(FieldMaterialName, v))
+}
+
+// MaterialNameContainsFold applies the ContainsFold predicate on the "material_name" field.
+func MaterialNameContainsFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContainsFold(FieldMaterialName, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldExternalID))
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldExternalID))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// JustificationEQ applies the EQ predicate on the "justification" field.
+func JustificationEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldJustification, v))
+}
+
+// JustificationNEQ applies the NEQ predicate on the "justification" field.
+func JustificationNEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldJustification, v))
+}
+
+// JustificationIn applies the In predicate on the "justification" field.
+func JustificationIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldJustification, vs...))
+}
+
+// JustificationNotIn applies the NotIn predicate on the "justification" field.
+func JustificationNotIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldJustification, vs...))
+}
+
+// JustificationGT applies the GT predicate on the "justification" field.
+func JustificationGT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldJustification, v))
+}
+
+// JustificationGTE applies the GTE predicate on the "justification" field.
+func JustificationGTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldJustification, v))
+}
+
+// JustificationLT applies the LT predicate on the "justification" field.
+func JustificationLT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldJustification, v))
+}
+
+// JustificationLTE applies the LTE predicate on the "justification" field.
+func JustificationLTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldJustification, v))
+}
+
+// JustificationContains applies the Contains predicate on the "justification" field.
+func JustificationContains(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContains(FieldJustification, v))
+}
+
+// JustificationHasPrefix applies the HasPrefix predicate on the "justification" field.
+func JustificationHasPrefix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasPrefix(FieldJustification, v))
+}
+
+// JustificationHasSuffix applies the HasSuffix predicate on the "justification" field.
+func JustificationHasSuffix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasSuffix(FieldJustification, v))
+}
+
+// JustificationEqualFold applies the EqualFold predicate on the "justification" field.
+func JustificationEqualFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEqualFold(FieldJustification, v))
+}
+
+// JustificationContainsFold applies the ContainsFold predicate on the "justification" field.
+func JustificationContainsFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContainsFold(FieldJustification, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// ApprovedByEQ applies the EQ predicate on the "approved_by" field.
+func ApprovedByEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByNEQ applies the NEQ predicate on the "approved_by" field.
+func ApprovedByNEQ(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldApprovedBy, v))
+}
+
+// ApprovedByIn applies the In predicate on the "approved_by" field.
+func ApprovedByIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByNotIn applies the NotIn predicate on the "approved_by" field.
+func ApprovedByNotIn(vs ...string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldApprovedBy, vs...))
+}
+
+// ApprovedByGT applies the GT predicate on the "approved_by" field.
+func ApprovedByGT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldApprovedBy, v))
+}
+
+// ApprovedByGTE applies the GTE predicate on the "approved_by" field.
+func ApprovedByGTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldApprovedBy, v))
+}
+
+// ApprovedByLT applies the LT predicate on the "approved_by" field.
+func ApprovedByLT(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldApprovedBy, v))
+}
+
+// ApprovedByLTE applies the LTE predicate on the "approved_by" field.
+func ApprovedByLTE(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldApprovedBy, v))
+}
+
+// ApprovedByContains applies the Contains predicate on the "approved_by" field.
+func ApprovedByContains(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContains(FieldApprovedBy, v))
+}
+
+// ApprovedByHasPrefix applies the HasPrefix predicate on the "approved_by" field.
+func ApprovedByHasPrefix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasPrefix(FieldApprovedBy, v))
+}
+
+// ApprovedByHasSuffix applies the HasSuffix predicate on the "approved_by" field.
+func ApprovedByHasSuffix(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldHasSuffix(FieldApprovedBy, v))
+}
+
+// ApprovedByIsNil applies the IsNil predicate on the "approved_by" field.
+func ApprovedByIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldApprovedBy))
+}
+
+// ApprovedByNotNil applies the NotNil predicate on the "approved_by" field.
+func ApprovedByNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldApprovedBy))
+}
+
+// ApprovedByEqualFold applies the EqualFold predicate on the "approved_by" field.
+func ApprovedByEqualFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEqualFold(FieldApprovedBy, v))
+}
+
+// ApprovedByContainsFold applies the ContainsFold predicate on the "approved_by" field.
+func ApprovedByContainsFold(v string) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldContainsFold(FieldApprovedBy, v))
+}
+
+// ApprovedAtEQ applies the EQ predicate on the "approved_at" field.
+func ApprovedAtEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtNEQ applies the NEQ predicate on the "approved_at" field.
+func ApprovedAtNEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldApprovedAt, v))
+}
+
+// ApprovedAtIn applies the In predicate on the "approved_at" field.
+func ApprovedAtIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtNotIn applies the NotIn predicate on the "approved_at" field.
+func ApprovedAtNotIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldApprovedAt, vs...))
+}
+
+// ApprovedAtGT applies the GT predicate on the "approved_at" field.
+func ApprovedAtGT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldApprovedAt, v))
+}
+
+// ApprovedAtGTE applies the GTE predicate on the "approved_at" field.
+func ApprovedAtGTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtLT applies the LT predicate on the "approved_at" field.
+func ApprovedAtLT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldApprovedAt, v))
+}
+
+// ApprovedAtLTE applies the LTE predicate on the "approved_at" field.
+func ApprovedAtLTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldApprovedAt, v))
+}
+
+// ApprovedAtIsNil applies the IsNil predicate on the "approved_at" field.
+func ApprovedAtIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldApprovedAt))
+}
+
+// ApprovedAtNotNil applies the NotNil predicate on the "approved_at" field.
+func ApprovedAtNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldApprovedAt))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RevokedAtEQ applies the EQ predicate on the "revoked_at" field.
+func RevokedAtEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtNEQ applies the NEQ predicate on the "revoked_at" field.
+func RevokedAtNEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldRevokedAt, v))
+}
+
+// RevokedAtIn applies the In predicate on the "revoked_at" field.
+func RevokedAtIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtNotIn applies the NotIn predicate on the "revoked_at" field.
+func RevokedAtNotIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldRevokedAt, vs...))
+}
+
+// RevokedAtGT applies the GT predicate on the "revoked_at" field.
+func RevokedAtGT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldRevokedAt, v))
+}
+
+// RevokedAtGTE applies the GTE predicate on the "revoked_at" field.
+func RevokedAtGTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldRevokedAt, v))
+}
+
+// RevokedAtLT applies the LT predicate on the "revoked_at" field.
+func RevokedAtLT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldRevokedAt, v))
+}
+
+// RevokedAtLTE applies the LTE predicate on the "revoked_at" field.
+func RevokedAtLTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldRevokedAt, v))
+}
+
+// RevokedAtIsNil applies the IsNil predicate on the "revoked_at" field.
+func RevokedAtIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldRevokedAt))
+}
+
+// RevokedAtNotNil applies the NotNil predicate on the "revoked_at" field.
+func RevokedAtNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldRevokedAt))
+}
+
+// ExpirationProcessedAtEQ applies the EQ predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldExpirationProcessedAt, v))
+}
+
+// ExpirationProcessedAtNEQ applies the NEQ predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtNEQ(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldExpirationProcessedAt, v))
+}
+
+// ExpirationProcessedAtIn applies the In predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldExpirationProcessedAt, vs...))
+}
+
+// ExpirationProcessedAtNotIn applies the NotIn predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtNotIn(vs ...time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldExpirationProcessedAt, vs...))
+}
+
+// ExpirationProcessedAtGT applies the GT predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtGT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGT(FieldExpirationProcessedAt, v))
+}
+
+// ExpirationProcessedAtGTE applies the GTE predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtGTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldGTE(FieldExpirationProcessedAt, v))
+}
+
+// ExpirationProcessedAtLT applies the LT predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtLT(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLT(FieldExpirationProcessedAt, v))
+}
+
+// ExpirationProcessedAtLTE applies the LTE predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtLTE(v time.Time) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldLTE(FieldExpirationProcessedAt, v))
+}
+
+// ExpirationProcessedAtIsNil applies the IsNil predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldExpirationProcessedAt))
+}
+
+// ExpirationProcessedAtNotNil applies the NotNil predicate on the "expiration_processed_at" field.
+func ExpirationProcessedAtNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldExpirationProcessedAt))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// ProjectIDEQ applies the EQ predicate on the "project_id" field.
+func ProjectIDEQ(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldProjectID, v))
+}
+
+// ProjectIDNEQ applies the NEQ predicate on the "project_id" field.
+func ProjectIDNEQ(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldProjectID, v))
+}
+
+// ProjectIDIn applies the In predicate on the "project_id" field.
+func ProjectIDIn(vs ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldProjectID, vs...))
+}
+
+// ProjectIDNotIn applies the NotIn predicate on the "project_id" field.
+func ProjectIDNotIn(vs ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldProjectID, vs...))
+}
+
+// ProjectIDIsNil applies the IsNil predicate on the "project_id" field.
+func ProjectIDIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldProjectID))
+}
+
+// ProjectIDNotNil applies the NotNil predicate on the "project_id" field.
+func ProjectIDNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldProjectID))
+}
+
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...uuid.UUID) predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDIsNil applies the IsNil predicate on the "workflow_id" field.
+func WorkflowIDIsNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldIsNull(FieldWorkflowID))
+}
+
+// WorkflowIDNotNil applies the NotNil predicate on the "workflow_id" field.
+func WorkflowIDNotNil() predicate.PolicyException {
+	return predicate.PolicyException(sql.FieldNotNull(FieldWorkflowID))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.PolicyException {
+	return predicate.PolicyException(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.PolicyException {
+	return predicate.PolicyException(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProject applies the HasEdge predicate on the "project" edge.
+func HasProject() predicate.PolicyException {
+	return predicate.PolicyException(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ProjectTable, ProjectColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProjectWith applies the HasEdge predicate on the "project" edge with a given conditions (other predicates).
+func HasProjectWith(preds ...predicate.Project) predicate.PolicyException {
+	return predicate.PolicyException(func(s *sql.Selector) {
+		step := newProjectStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkflow applies the HasEdge predicate on the "workflow" edge.
+func HasWorkflow() predicate.PolicyException {
+	return predicate.PolicyException(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, WorkflowTable, WorkflowColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowWith applies the HasEdge predicate on the "workflow" edge with a given conditions (other predicates).
+func HasWorkflowWith(preds ...predicate.Workflow) predicate.PolicyException {
+	return predicate.PolicyException(func(s *sql.Selector) {
+		step := newWorkflowStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PolicyException) predicate.PolicyException {
+	return predicate.PolicyException(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PolicyException) predicate.PolicyException {
+	return predicate.PolicyException(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PolicyException) predicate.PolicyException {
+	return predicate.PolicyException(sql.NotPredicates(p))
+}
@@ -0,0 +1,1060 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
+	"github.com/google/uuid"
+)
+
+// PolicyExceptionCreate is the builder for creating a PolicyException entity.
+type PolicyExceptionCreate struct {
+	config
+	mutation *PolicyExceptionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PolicyExceptionCreate) SetCreatedAt(v time.Time) *PolicyExceptionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableCreatedAt(v *time.Time) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetPolicyName sets the "policy_name" field.
+func (_c *PolicyExceptionCreate) SetPolicyName(v string) *PolicyExceptionCreate {
+	_c.mutation.SetPolicyName(v)
+	return _c
+}
+
+// SetNillablePolicyName sets the "policy_name" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillablePolicyName(v *string) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetPolicyName(*v)
+	}
+	return _c
+}
+
+// SetMaterialName sets the "material_name" field.
+func (_c *PolicyExceptionCreate) SetMaterialName(v string) *PolicyExceptionCreate {
+	_c.mutation.SetMaterialName(v)
+	return _c
+}
+
+// SetNillableMaterialName sets the "material_name" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableMaterialName(v *string) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetMaterialName(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *PolicyExceptionCreate) SetExternalID(v string) *PolicyExceptionCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableExternalID(v *string) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetJustification sets the "justification" field.
+func (_c *PolicyExceptionCreate) SetJustification(v string) *PolicyExceptionCreate {
+	_c.mutation.SetJustification(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *PolicyExceptionCreate) SetCreatedBy(v string) *PolicyExceptionCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_c *PolicyExceptionCreate) SetApprovedBy(v string) *PolicyExceptionCreate {
+	_c.mutation.SetApprovedBy(v)
+	return _c
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableApprovedBy(v *string) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetApprovedBy(*v)
+	}
+	return _c
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_c *PolicyExceptionCreate) SetApprovedAt(v time.Time) *PolicyExceptionCreate {
+	_c.mutation.SetApprovedAt(v)
+	return _c
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableApprovedAt(v *time.Time) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetApprovedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *PolicyExceptionCreate) SetExpiresAt(v time.Time) *PolicyExceptionCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *PolicyExceptionCreate) SetRevokedAt(v time.Time) *PolicyExceptionCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableRevokedAt(v *time.Time) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetExpirationProcessedAt sets the "expiration_processed_at" field.
+func (_c *PolicyExceptionCreate) SetExpirationProcessedAt(v time.Time) *PolicyExceptionCreate {
+	_c.mutation.SetExpirationProcessedAt(v)
+	return _c
+}
+
+// SetNillableExpirationProcessedAt sets the "expiration_processed_at" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableExpirationProcessedAt(v *time.Time) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetExpirationProcessedAt(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *PolicyExceptionCreate) SetOrganizationID(v uuid.UUID) *PolicyExceptionCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetProjectID sets the "project_id" field.
+func (_c *PolicyExceptionCreate) SetProjectID(v uuid.UUID) *PolicyExceptionCreate {
+	_c.mutation.SetProjectID(v)
+	return _c
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableProjectID(v *uuid.UUID) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetProjectID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (_c *PolicyExceptionCreate) SetWorkflowID(v uuid.UUID) *PolicyExceptionCreate {
+	_c.mutation.SetWorkflowID(v)
+	return _c
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableWorkflowID(v *uuid.UUID) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetWorkflowID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyExceptionCreate) SetID(v uuid.UUID) *PolicyExceptionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyExceptionCreate) SetNillableID(v *uuid.UUID) *PolicyExceptionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *PolicyExceptionCreate) SetOrganization(v *Organization) *PolicyExceptionCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_c *PolicyExceptionCreate) SetProject(v *Project) *PolicyExceptionCreate {
+	return _c.SetProjectID(v.ID)
+}
+
+// SetWorkflow sets the "workflow" edge to the Workflow entity.
+func (_c *PolicyExceptionCreate) SetWorkflow(v *Workflow) *PolicyExceptionCreate {
+	return _c.SetWorkflowID(v.ID)
+}
+
+// Mutation returns the PolicyExceptionMutation object of the builder.
+func (_c *PolicyExceptionCreate) Mutation() *PolicyExceptionMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyException in the database.
+func (_c *PolicyExceptionCreate) Save(ctx context.Context) (*PolicyException, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyExceptionCreate) SaveX(ctx context.Context) *PolicyException {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyExceptionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyExceptionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyExceptionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := policyexception.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyexception.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyExceptionCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PolicyException.created_at"`)}
+	}
+	if _, ok := _c.mutation.Justification(); !ok {
+		return &ValidationError{Name: "justification", err: errors.New(`ent: missing required field "PolicyException.justification"`)}
+	}
+	if v, ok := _c.mutation.Justification(); ok {
+		if err := policyexception.JustificationValidator(v); err != nil {
+			return &ValidationError{Name: "justification", err: fmt.Errorf(`ent: validator failed for field "PolicyException.justification": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "PolicyException.created_by"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "PolicyException.expires_at"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "PolicyException.organization_id"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "PolicyException.organization"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyExceptionCreate) sqlSave(ctx context.Context) (*PolicyException, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyExceptionCreate) createSpec() (*PolicyException, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyException{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyexception.Table, sqlgraph.NewFieldSpec(policyexception.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(policyexception.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.PolicyName(); ok {
+		_spec.SetField(policyexception.FieldPolicyName, field.TypeString, value)
+		_node.PolicyName = value
+	}
+	if value, ok := _c.mutation.MaterialName(); ok {
+		_spec.SetField(policyexception.FieldMaterialName, field.TypeString, value)
+		_node.MaterialName = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(policyexception.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.Justification(); ok {
+		_spec.SetField(policyexception.FieldJustification, field.TypeString, value)
+		_node.Justification = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(policyexception.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedBy(); ok {
+		_spec.SetField(policyexception.FieldApprovedBy, field.TypeString, value)
+		_node.ApprovedBy = value
+	}
+	if value, ok := _c.mutation.ApprovedAt(); ok {
+		_spec.SetField(policyexception.FieldApprovedAt, field.TypeTime, value)
+		_node.ApprovedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(policyexception.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(policyexception.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = value
+	}
+	if value, ok := _c.mutation.ExpirationProcessedAt(); ok {
+		_spec.SetField(policyexception.FieldExpirationProcessedAt, field.TypeTime, value)
+		_node.ExpirationProcessedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   policyexception.OrganizationTable,
+			Columns: []string{policyexception.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   policyexception.ProjectTable,
+			Columns: []string{policyexception.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProjectID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WorkflowIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   policyexception.WorkflowTable,
+			Columns: []string{policyexception.WorkflowColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflow.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyException.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyExceptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyExceptionCreate) OnConflict(opts ...sql.ConflictOption) *PolicyExceptionUpsertOne {
+	_c.conflict = opts
+	return &PolicyExceptionUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyException.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyExceptionCreate) OnConflictColumns(columns ...string) *PolicyExceptionUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyExceptionUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyExceptionUpsertOne is the builder for "upsert"-ing
+	//  one PolicyException node.
+	PolicyExceptionUpsertOne struct {
+		create *PolicyExceptionCreate
+	}
+
+	// PolicyExceptionUpsert is the "OnConflict" setter.
+	PolicyExceptionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PolicyExceptionUpsert) SetApprovedBy(v string) *PolicyExceptionUpsert {
+	u.Set(policyexception.FieldApprovedBy, v)
+	return u
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PolicyExceptionUpsert) UpdateApprovedBy() *PolicyExceptionUpsert {
+	u.SetExcluded(policyexception.FieldApprovedBy)
+	return u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PolicyExceptionUpsert) ClearApprovedBy() *PolicyExceptionUpsert {
+	u.SetNull(policyexception.FieldApprovedBy)
+	return u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PolicyExceptionUpsert) SetApprovedAt(v time.Time) *PolicyExceptionUpsert {
+	u.Set(policyexception.FieldApprovedAt, v)
+	return u
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsert) UpdateApprovedAt() *PolicyExceptionUpsert {
+	u.SetExcluded(policyexception.FieldApprovedAt)
+	return u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PolicyExceptionUpsert) ClearApprovedAt() *PolicyExceptionUpsert {
+	u.SetNull(policyexception.FieldApprovedAt)
+	return u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PolicyExceptionUpsert) SetRevokedAt(v time.Time) *PolicyExceptionUpsert {
+	u.Set(policyexception.FieldRevokedAt, v)
+	return u
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsert) UpdateRevokedAt() *PolicyExceptionUpsert {
+	u.SetExcluded(policyexception.FieldRevokedAt)
+	return u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PolicyExceptionUpsert) ClearRevokedAt() *PolicyExceptionUpsert {
+	u.SetNull(policyexception.FieldRevokedAt)
+	return u
+}
+
+// SetExpirationProcessedAt sets the "expiration_processed_at" field.
+func (u *PolicyExceptionUpsert) SetExpirationProcessedAt(v time.Time) *PolicyExceptionUpsert {
+	u.Set(policyexception.FieldExpirationProcessedAt, v)
+	return u
+}
+
+// UpdateExpirationProcessedAt sets the "expiration_processed_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsert) UpdateExpirationProcessedAt() *PolicyExceptionUpsert {
+	u.SetExcluded(policyexception.FieldExpirationProcessedAt)
+	return u
+}
+
+// ClearExpirationProcessedAt clears the value of the "expiration_processed_at" field.
+func (u *PolicyExceptionUpsert) ClearExpirationProcessedAt() *PolicyExceptionUpsert {
+	u.SetNull(policyexception.FieldExpirationProcessedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyException.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyexception.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyExceptionUpsertOne) UpdateNewValues() *PolicyExceptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyexception.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(policyexception.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.PolicyName(); exists {
+			s.SetIgnore(policyexception.FieldPolicyName)
+		}
+		if _, exists := u.create.mutation.MaterialName(); exists {
+			s.SetIgnore(policyexception.FieldMaterialName)
+		}
+		if _, exists := u.create.mutation.ExternalID(); exists {
+			s.SetIgnore(policyexception.FieldExternalID)
+		}
+		if _, exists := u.create.mutation.Justification(); exists {
+			s.SetIgnore(policyexception.FieldJustification)
+		}
+		if _, exists := u.create.mutation.CreatedBy(); exists {
+			s.SetIgnore(policyexception.FieldCreatedBy)
+		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(policyexception.FieldExpiresAt)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(policyexception.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.ProjectID(); exists {
+			s.SetIgnore(policyexception.FieldProjectID)
+		}
+		if _, exists := u.create.mutation.WorkflowID(); exists {
+			s.SetIgnore(policyexception.FieldWorkflowID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyException.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyExceptionUpsertOne) Ignore() *PolicyExceptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyExceptionUpsertOne) DoNothing() *PolicyExceptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyExceptionCreate.OnConflict
+// documentation for more info.
+func (u *PolicyExceptionUpsertOne) Update(set func(*PolicyExceptionUpsert)) *PolicyExceptionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyExceptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PolicyExceptionUpsertOne) SetApprovedBy(v string) *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertOne) UpdateApprovedBy() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PolicyExceptionUpsertOne) ClearApprovedBy() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PolicyExceptionUpsertOne) SetApprovedAt(v time.Time) *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertOne) UpdateApprovedAt() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PolicyExceptionUpsertOne) ClearApprovedAt() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PolicyExceptionUpsertOne) SetRevokedAt(v time.Time) *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertOne) UpdateRevokedAt() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PolicyExceptionUpsertOne) ClearRevokedAt() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetExpirationProcessedAt sets the "expiration_processed_at" field.
+func (u *PolicyExceptionUpsertOne) SetExpirationProcessedAt(v time.Time) *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetExpirationProcessedAt(v)
+	})
+}
+
+// UpdateExpirationProcessedAt sets the "expiration_processed_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertOne) UpdateExpirationProcessedAt() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateExpirationProcessedAt()
+	})
+}
+
+// ClearExpirationProcessedAt clears the value of the "expiration_processed_at" field.
+func (u *PolicyExceptionUpsertOne) ClearExpirationProcessedAt() *PolicyExceptionUpsertOne {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearExpirationProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyExceptionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyExceptionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyExceptionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyExceptionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyExceptionUpsertOne.ID is not supported by MySQL driver. Use PolicyExceptionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyExceptionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyExceptionCreateBulk is the builder for creating many PolicyException entities in bulk.
+type PolicyExceptionCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyExceptionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyException entities in the database.
+func (_c *PolicyExceptionCreateBulk) Save(ctx context.Context) ([]*PolicyException, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyException, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyExceptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyExceptionCreateBulk) SaveX(ctx context.Context) []*PolicyException {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyExceptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyExceptionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyException.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyExceptionUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyExceptionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyExceptionUpsertBulk {
+	_c.conflict = opts
+	return &PolicyExceptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyException.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyExceptionCreateBulk) OnConflictColumns(columns ...string) *PolicyExceptionUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyExceptionUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyExceptionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyException nodes.
+type PolicyExceptionUpsertBulk struct {
+	create *PolicyExceptionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyException.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyexception.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyExceptionUpsertBulk) UpdateNewValues() *PolicyExceptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyexception.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(policyexception.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.PolicyName(); exists {
+				s.SetIgnore(policyexception.FieldPolicyName)
+			}
+			if _, exists := b.mutation.MaterialName(); exists {
+				s.SetIgnore(policyexception.FieldMaterialName)
+			}
+			if _, exists := b.mutation.ExternalID(); exists {
+				s.SetIgnore(policyexception.FieldExternalID)
+			}
+			if _, exists := b.mutation.Justification(); exists {
+				s.SetIgnore(policyexception.FieldJustification)
+			}
+			if _, exists := b.mutation.CreatedBy(); exists {
+				s.SetIgnore(policyexception.FieldCreatedBy)
+			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(policyexception.FieldExpiresAt)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(policyexception.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.ProjectID(); exists {
+				s.SetIgnore(policyexception.FieldProjectID)
+			}
+			if _, exists := b.mutation.WorkflowID(); exists {
+				s.SetIgnore(policyexception.FieldWorkflowID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyException.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyExceptionUpsertBulk) Ignore() *PolicyExceptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyExceptionUpsertBulk) DoNothing() *PolicyExceptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyExceptionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyExceptionUpsertBulk) Update(set func(*PolicyExceptionUpsert)) *PolicyExceptionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyExceptionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (u *PolicyExceptionUpsertBulk) SetApprovedBy(v string) *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetApprovedBy(v)
+	})
+}
+
+// UpdateApprovedBy sets the "approved_by" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertBulk) UpdateApprovedBy() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateApprovedBy()
+	})
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (u *PolicyExceptionUpsertBulk) ClearApprovedBy() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearApprovedBy()
+	})
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (u *PolicyExceptionUpsertBulk) SetApprovedAt(v time.Time) *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetApprovedAt(v)
+	})
+}
+
+// UpdateApprovedAt sets the "approved_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertBulk) UpdateApprovedAt() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateApprovedAt()
+	})
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (u *PolicyExceptionUpsertBulk) ClearApprovedAt() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearApprovedAt()
+	})
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (u *PolicyExceptionUpsertBulk) SetRevokedAt(v time.Time) *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetRevokedAt(v)
+	})
+}
+
+// UpdateRevokedAt sets the "revoked_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertBulk) UpdateRevokedAt() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateRevokedAt()
+	})
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (u *PolicyExceptionUpsertBulk) ClearRevokedAt() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearRevokedAt()
+	})
+}
+
+// SetExpirationProcessedAt sets the "expiration_processed_at" field.
+func (u *PolicyExceptionUpsertBulk) SetExpirationProcessedAt(v time.Time) *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.SetExpirationProcessedAt(v)
+	})
+}
+
+// UpdateExpirationProcessedAt sets the "expiration_processed_at" field to the value that was provided on create.
+func (u *PolicyExceptionUpsertBulk) UpdateExpirationProcessedAt() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.UpdateExpirationProcessedAt()
+	})
+}
+
+// ClearExpirationProcessedAt clears the value of the "expiration_processed_at" field.
+func (u *PolicyExceptionUpsertBulk) ClearExpirationProcessedAt() *PolicyExceptionUpsertBulk {
+	return u.Update(func(s *PolicyExceptionUpsert) {
+		s.ClearExpirationProcessedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyExceptionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyExceptionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyExceptionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyExceptionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// PolicyExceptionUpdate is the builder for updating PolicyException entities.
+type PolicyExceptionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PolicyExceptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PolicyExceptionUpdate builder.
+func (_u *PolicyExceptionUpdate) Where(ps ...predicate.PolicyException) *PolicyExceptionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *PolicyExceptionUpdate) SetApprovedBy(v string) *PolicyExceptionUpdate {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *PolicyExceptionUpdate) SetNillableApprovedBy(v *string) *PolicyExceptionUpdate {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *PolicyExceptionUpdate) ClearApprovedBy() *PolicyExceptionUpdate {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *PolicyExceptionUpdate) SetApprovedAt(v time.Time) *PolicyExceptionUpdate {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *PolicyExceptionUpdate) SetNillableApprovedAt(v *time.Time) *PolicyExceptionUpdate {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *PolicyExceptionUpdate) ClearApprovedAt() *PolicyExceptionUpdate {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *PolicyExceptionUpdate) SetRevokedAt(v time.Time) *PolicyExceptionUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *PolicyExceptionUpdate) SetNillableRevokedAt(v *time.Time) *PolicyExceptionUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *PolicyExceptionUpdate) ClearRevokedAt() *PolicyExceptionUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetExpirationProcessedAt sets the "expiration_processed_at" field.
+func (_u *PolicyExceptionUpdate) SetExpirationProcessedAt(v time.Time) *PolicyExceptionUpdate {
+	_u.mutation.SetExpirationProcessedAt(v)
+	return _u
+}
+
+// SetNillableExpirationProcessedAt sets the "expiration_processed_at" field if the given value is not nil.
+func (_u *PolicyExceptionUpdate) SetNillableExpirationProcessedAt(v *time.Time) *PolicyExceptionUpdate {
+	if v != nil {
+		_u.SetExpirationProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearExpirationProcessedAt clears the value of the "expiration_processed_at" field.
+func (_u *PolicyExceptionUpdate) ClearExpirationProcessedAt() *PolicyExceptionUpdate {
+	_u.mutation.ClearExpirationProcessedAt()
+	return _u
+}
+
+// Mutation returns the PolicyExceptionMutation object of the builder.
+func (_u *PolicyExceptionUpdate) Mutation() *PolicyExceptionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyExceptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyExceptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyExceptionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyExceptionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyExceptionUpdate) check() error {
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyException.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyExceptionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyExceptionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyExceptionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyexception.Table, policyexception.Columns, sqlgraph.NewFieldSpec(policyexception.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PolicyNameCleared() {
+		_spec.ClearField(policyexception.FieldPolicyName, field.TypeString)
+	}
+	if _u.mutation.MaterialNameCleared() {
+		_spec.ClearField(policyexception.FieldMaterialName, field.TypeString)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(policyexception.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(policyexception.FieldApprovedBy, field.TypeString, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(policyexception.FieldApprovedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(policyexception.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(policyexception.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(policyexception.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(policyexception.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpirationProcessedAt(); ok {
+		_spec.SetField(policyexception.FieldExpirationProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpirationProcessedAtCleared() {
+		_spec.ClearField(policyexception.FieldExpirationProcessedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyexception.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyExceptionUpdateOne is the builder for updating a single PolicyException entity.
+type PolicyExceptionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PolicyExceptionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetApprovedBy sets the "approved_by" field.
+func (_u *PolicyExceptionUpdateOne) SetApprovedBy(v string) *PolicyExceptionUpdateOne {
+	_u.mutation.SetApprovedBy(v)
+	return _u
+}
+
+// SetNillableApprovedBy sets the "approved_by" field if the given value is not nil.
+func (_u *PolicyExceptionUpdateOne) SetNillableApprovedBy(v *string) *PolicyExceptionUpdateOne {
+	if v != nil {
+		_u.SetApprovedBy(*v)
+	}
+	return _u
+}
+
+// ClearApprovedBy clears the value of the "approved_by" field.
+func (_u *PolicyExceptionUpdateOne) ClearApprovedBy() *PolicyExceptionUpdateOne {
+	_u.mutation.ClearApprovedBy()
+	return _u
+}
+
+// SetApprovedAt sets the "approved_at" field.
+func (_u *PolicyExceptionUpdateOne) SetApprovedAt(v time.Time) *PolicyExceptionUpdateOne {
+	_u.mutation.SetApprovedAt(v)
+	return _u
+}
+
+// SetNillableApprovedAt sets the "approved_at" field if the given value is not nil.
+func (_u *PolicyExceptionUpdateOne) SetNillableApprovedAt(v *time.Time) *PolicyExceptionUpdateOne {
+	if v != nil {
+		_u.SetApprovedAt(*v)
+	}
+	return _u
+}
+
+// ClearApprovedAt clears the value of the "approved_at" field.
+func (_u *PolicyExceptionUpdateOne) ClearApprovedAt() *PolicyExceptionUpdateOne {
+	_u.mutation.ClearApprovedAt()
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *PolicyExceptionUpdateOne) SetRevokedAt(v time.Time) *PolicyExceptionUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *PolicyExceptionUpdateOne) SetNillableRevokedAt(v *time.Time) *PolicyExceptionUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *PolicyExceptionUpdateOne) ClearRevokedAt() *PolicyExceptionUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// SetExpirationProcessedAt sets the "expiration_processed_at" field.
+func (_u *PolicyExceptionUpdateOne) SetExpirationProcessedAt(v time.Time) *PolicyExceptionUpdateOne {
+	_u.mutation.SetExpirationProcessedAt(v)
+	return _u
+}
+
+// SetNillableExpirationProcessedAt sets the "expiration_processed_at" field if the given value is not nil.
+func (_u *PolicyExceptionUpdateOne) SetNillableExpirationProcessedAt(v *time.Time) *PolicyExceptionUpdateOne {
+	if v != nil {
+		_u.SetExpirationProcessedAt(*v)
+	}
+	return _u
+}
+
+// ClearExpirationProcessedAt clears the value of the "expiration_processed_at" field.
+func (_u *PolicyExceptionUpdateOne) ClearExpirationProcessedAt() *PolicyExceptionUpdateOne {
+	_u.mutation.ClearExpirationProcessedAt()
+	return _u
+}
+
+// Mutation returns the PolicyExceptionMutation object of the builder.
+func (_u *PolicyExceptionUpdateOne) Mutation() *PolicyExceptionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PolicyExceptionUpdate builder.
+func (_u *PolicyExceptionUpdateOne) Where(ps ...predicate.PolicyException) *PolicyExceptionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyExceptionUpdateOne) Select(field string, fields ...string) *PolicyExceptionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyException entity.
+func (_u *PolicyExceptionUpdateOne) Save(ctx context.Context) (*PolicyException, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyExceptionUpdateOne) SaveX(ctx context.Context) *PolicyException {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyExceptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyExceptionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyExceptionUpdateOne) check() error {
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyException.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyExceptionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyExceptionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyExceptionUpdateOne) sqlSave(ctx context.Context) (_node *PolicyException, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyexception.Table, policyexception.Columns, sqlgraph.NewFieldSpec(policyexception.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyException.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyexception.FieldID)
+		for _, f := range fields {
+			if !policyexception.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policyexception.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PolicyNameCleared() {
+		_spec.ClearField(policyexception.FieldPolicyName, field.TypeString)
+	}
+	if _u.mutation.MaterialNameCleared() {
+		_spec.ClearField(policyexception.FieldMaterialName, field.TypeString)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(policyexception.FieldExternalID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedBy(); ok {
+		_spec.SetField(policyexception.FieldApprovedBy, field.TypeString, value)
+	}
+	if _u.mutation.ApprovedByCleared() {
+		_spec.ClearField(policyexception.FieldApprovedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.ApprovedAt(); ok {
+		_spec.SetField(policyexception.FieldApprovedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ApprovedAtCleared() {
+		_spec.ClearField(policyexception.FieldApprovedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(policyexception.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(policyexception.FieldRevokedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpirationProcessedAt(); ok {
+		_spec.SetField(policyexception.FieldExpirationProcessedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpirationProcessedAtCleared() {
+		_spec.ClearField(policyexception.FieldExpirationProcessedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PolicyException{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyexception.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
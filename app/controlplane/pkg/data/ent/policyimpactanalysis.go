@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/google/uuid"
+)
+
+// PolicyImpactAnalysis is the model entity for the PolicyImpactAnalysis schema.
+type PolicyImpactAnalysis struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status biz.PolicyImpactAnalysisStatus `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+	// Report holds the value of the "report" field.
+	Report *biz.PolicyImpactReport `json:"report,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyImpactAnalysisQuery when eager-loading is set.
+	Edges        PolicyImpactAnalysisEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyImpactAnalysisEdges holds the relations/edges for other nodes in the graph.
+type PolicyImpactAnalysisEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyImpactAnalysisEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyImpactAnalysis) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyimpactanalysis.FieldReport:
+			values[i] = new([]byte)
+		case policyimpactanalysis.FieldStatus, policyimpactanalysis.FieldError:
+			values[i] = new(sql.NullString)
+		case policyimpactanalysis.FieldCreatedAt, policyimpactanalysis.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		case policyimpactanalysis.FieldID, policyimpactanalysis.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyImpactAnalysis fields.
+func (_m *PolicyImpactAnalysis) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyimpactanalysis.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyimpactanalysis.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = biz.PolicyImpactAnalysisStatus(value.String)
+			}
+		case policyimpactanalysis.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case policyimpactanalysis.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = value.Time
+			}
+		case policyimpactanalysis.FieldReport:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field report", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Report); err != nil {
+					return fmt.Errorf("unmarshal field report: %w", err)
+				}
+			}
+		case policyimpactanalysis.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case policyimpactanalysis.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyImpactAnalysis.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyImpactAnalysis) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the PolicyImpactAnalysis entity.
+func (_m *PolicyImpactAnalysis) QueryOrganization() *OrganizationQuery {
+	return NewPolicyImpactAnalysisClient(_m.config).QueryOrganization(_m)
+}
+
+// Update returns a builder for updating this PolicyImpactAnalysis.
+// Note that you need to call PolicyImpactAnalysis.Unwrap() before calling this method if this PolicyImpactAnalysis
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyImpactAnalysis) Update() *PolicyImpactAnalysisUpdateOne {
+	return NewPolicyImpactAnalysisClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyImpactAnalysis entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyImpactAnalysis) Unwrap() *PolicyImpactAnalysis {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyImpactAnalysis is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyImpactAnalysis) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyImpactAnalysis(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("finished_at=")
+	builder.WriteString(_m.FinishedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("report=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Report))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyImpactAnalyses is a parsable slice of PolicyImpactAnalysis.
+type PolicyImpactAnalyses []*PolicyImpactAnalysis
@@ -0,0 +1,341 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyimpactanalysis
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldError, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v biz.PolicyImpactAnalysisStatus) predicate.PolicyImpactAnalysis {
+	vc := v
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v biz.PolicyImpactAnalysisStatus) predicate.PolicyImpactAnalysis {
+	vc := v
+	return predicate.PolicyImpactAnalysis(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...biz.PolicyImpactAnalysisStatus) predicate.PolicyImpactAnalysis {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PolicyImpactAnalysis(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...biz.PolicyImpactAnalysisStatus) predicate.PolicyImpactAnalysis {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PolicyImpactAnalysis(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// ReportIsNil applies the IsNil predicate on the "report" field.
+func ReportIsNil() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIsNull(FieldReport))
+}
+
+// ReportNotNil applies the NotNil predicate on the "report" field.
+func ReportNotNil() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotNull(FieldReport))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldContainsFold(FieldError, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PolicyImpactAnalysis) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PolicyImpactAnalysis) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PolicyImpactAnalysis) predicate.PolicyImpactAnalysis {
+	return predicate.PolicyImpactAnalysis(sql.NotPredicates(p))
+}
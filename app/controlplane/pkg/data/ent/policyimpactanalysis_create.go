@@ -0,0 +1,825 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/google/uuid"
+)
+
+// PolicyImpactAnalysisCreate is the builder for creating a PolicyImpactAnalysis entity.
+type PolicyImpactAnalysisCreate struct {
+	config
+	mutation *PolicyImpactAnalysisMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetStatus sets the "status" field.
+func (_c *PolicyImpactAnalysisCreate) SetStatus(v biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PolicyImpactAnalysisCreate) SetNillableStatus(v *biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PolicyImpactAnalysisCreate) SetCreatedAt(v time.Time) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PolicyImpactAnalysisCreate) SetNillableCreatedAt(v *time.Time) *PolicyImpactAnalysisCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *PolicyImpactAnalysisCreate) SetFinishedAt(v time.Time) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *PolicyImpactAnalysisCreate) SetNillableFinishedAt(v *time.Time) *PolicyImpactAnalysisCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetReport sets the "report" field.
+func (_c *PolicyImpactAnalysisCreate) SetReport(v *biz.PolicyImpactReport) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetReport(v)
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *PolicyImpactAnalysisCreate) SetError(v string) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *PolicyImpactAnalysisCreate) SetNillableError(v *string) *PolicyImpactAnalysisCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *PolicyImpactAnalysisCreate) SetOrganizationID(v uuid.UUID) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyImpactAnalysisCreate) SetID(v uuid.UUID) *PolicyImpactAnalysisCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyImpactAnalysisCreate) SetNillableID(v *uuid.UUID) *PolicyImpactAnalysisCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *PolicyImpactAnalysisCreate) SetOrganization(v *Organization) *PolicyImpactAnalysisCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the PolicyImpactAnalysisMutation object of the builder.
+func (_c *PolicyImpactAnalysisCreate) Mutation() *PolicyImpactAnalysisMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyImpactAnalysis in the database.
+func (_c *PolicyImpactAnalysisCreate) Save(ctx context.Context) (*PolicyImpactAnalysis, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyImpactAnalysisCreate) SaveX(ctx context.Context) *PolicyImpactAnalysis {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyImpactAnalysisCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyImpactAnalysisCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyImpactAnalysisCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := policyimpactanalysis.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := policyimpactanalysis.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyimpactanalysis.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyImpactAnalysisCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PolicyImpactAnalysis.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := policyimpactanalysis.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PolicyImpactAnalysis.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PolicyImpactAnalysis.created_at"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "PolicyImpactAnalysis.organization_id"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "PolicyImpactAnalysis.organization"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyImpactAnalysisCreate) sqlSave(ctx context.Context) (*PolicyImpactAnalysis, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyImpactAnalysisCreate) createSpec() (*PolicyImpactAnalysis, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyImpactAnalysis{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyimpactanalysis.Table, sqlgraph.NewFieldSpec(policyimpactanalysis.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(policyimpactanalysis.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(policyimpactanalysis.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(policyimpactanalysis.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = value
+	}
+	if value, ok := _c.mutation.Report(); ok {
+		_spec.SetField(policyimpactanalysis.FieldReport, field.TypeJSON, value)
+		_node.Report = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(policyimpactanalysis.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   policyimpactanalysis.OrganizationTable,
+			Columns: []string{policyimpactanalysis.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyImpactAnalysis.Create().
+//		SetStatus(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyImpactAnalysisUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyImpactAnalysisCreate) OnConflict(opts ...sql.ConflictOption) *PolicyImpactAnalysisUpsertOne {
+	_c.conflict = opts
+	return &PolicyImpactAnalysisUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyImpactAnalysis.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyImpactAnalysisCreate) OnConflictColumns(columns ...string) *PolicyImpactAnalysisUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyImpactAnalysisUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyImpactAnalysisUpsertOne is the builder for "upsert"-ing
+	//  one PolicyImpactAnalysis node.
+	PolicyImpactAnalysisUpsertOne struct {
+		create *PolicyImpactAnalysisCreate
+	}
+
+	// PolicyImpactAnalysisUpsert is the "OnConflict" setter.
+	PolicyImpactAnalysisUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *PolicyImpactAnalysisUpsert) SetStatus(v biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpsert {
+	u.Set(policyimpactanalysis.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsert) UpdateStatus() *PolicyImpactAnalysisUpsert {
+	u.SetExcluded(policyimpactanalysis.FieldStatus)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *PolicyImpactAnalysisUpsert) SetFinishedAt(v time.Time) *PolicyImpactAnalysisUpsert {
+	u.Set(policyimpactanalysis.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsert) UpdateFinishedAt() *PolicyImpactAnalysisUpsert {
+	u.SetExcluded(policyimpactanalysis.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *PolicyImpactAnalysisUpsert) ClearFinishedAt() *PolicyImpactAnalysisUpsert {
+	u.SetNull(policyimpactanalysis.FieldFinishedAt)
+	return u
+}
+
+// SetReport sets the "report" field.
+func (u *PolicyImpactAnalysisUpsert) SetReport(v *biz.PolicyImpactReport) *PolicyImpactAnalysisUpsert {
+	u.Set(policyimpactanalysis.FieldReport, v)
+	return u
+}
+
+// UpdateReport sets the "report" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsert) UpdateReport() *PolicyImpactAnalysisUpsert {
+	u.SetExcluded(policyimpactanalysis.FieldReport)
+	return u
+}
+
+// ClearReport clears the value of the "report" field.
+func (u *PolicyImpactAnalysisUpsert) ClearReport() *PolicyImpactAnalysisUpsert {
+	u.SetNull(policyimpactanalysis.FieldReport)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *PolicyImpactAnalysisUpsert) SetError(v string) *PolicyImpactAnalysisUpsert {
+	u.Set(policyimpactanalysis.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsert) UpdateError() *PolicyImpactAnalysisUpsert {
+	u.SetExcluded(policyimpactanalysis.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *PolicyImpactAnalysisUpsert) ClearError() *PolicyImpactAnalysisUpsert {
+	u.SetNull(policyimpactanalysis.FieldError)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyImpactAnalysis.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyimpactanalysis.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyImpactAnalysisUpsertOne) UpdateNewValues() *PolicyImpactAnalysisUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyimpactanalysis.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(policyimpactanalysis.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(policyimpactanalysis.FieldOrganizationID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyImpactAnalysis.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyImpactAnalysisUpsertOne) Ignore() *PolicyImpactAnalysisUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyImpactAnalysisUpsertOne) DoNothing() *PolicyImpactAnalysisUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyImpactAnalysisCreate.OnConflict
+// documentation for more info.
+func (u *PolicyImpactAnalysisUpsertOne) Update(set func(*PolicyImpactAnalysisUpsert)) *PolicyImpactAnalysisUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyImpactAnalysisUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PolicyImpactAnalysisUpsertOne) SetStatus(v biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertOne) UpdateStatus() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *PolicyImpactAnalysisUpsertOne) SetFinishedAt(v time.Time) *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertOne) UpdateFinishedAt() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *PolicyImpactAnalysisUpsertOne) ClearFinishedAt() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetReport sets the "report" field.
+func (u *PolicyImpactAnalysisUpsertOne) SetReport(v *biz.PolicyImpactReport) *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetReport(v)
+	})
+}
+
+// UpdateReport sets the "report" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertOne) UpdateReport() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateReport()
+	})
+}
+
+// ClearReport clears the value of the "report" field.
+func (u *PolicyImpactAnalysisUpsertOne) ClearReport() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.ClearReport()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *PolicyImpactAnalysisUpsertOne) SetError(v string) *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertOne) UpdateError() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *PolicyImpactAnalysisUpsertOne) ClearError() *PolicyImpactAnalysisUpsertOne {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyImpactAnalysisUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyImpactAnalysisCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyImpactAnalysisUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyImpactAnalysisUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyImpactAnalysisUpsertOne.ID is not supported by MySQL driver. Use PolicyImpactAnalysisUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyImpactAnalysisUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyImpactAnalysisCreateBulk is the builder for creating many PolicyImpactAnalysis entities in bulk.
+type PolicyImpactAnalysisCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyImpactAnalysisCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyImpactAnalysis entities in the database.
+func (_c *PolicyImpactAnalysisCreateBulk) Save(ctx context.Context) ([]*PolicyImpactAnalysis, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyImpactAnalysis, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyImpactAnalysisMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyImpactAnalysisCreateBulk) SaveX(ctx context.Context) []*PolicyImpactAnalysis {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyImpactAnalysisCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyImpactAnalysisCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyImpactAnalysis.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyImpactAnalysisUpsert) {
+//			SetStatus(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyImpactAnalysisCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyImpactAnalysisUpsertBulk {
+	_c.conflict = opts
+	return &PolicyImpactAnalysisUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyImpactAnalysis.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyImpactAnalysisCreateBulk) OnConflictColumns(columns ...string) *PolicyImpactAnalysisUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyImpactAnalysisUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyImpactAnalysisUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyImpactAnalysis nodes.
+type PolicyImpactAnalysisUpsertBulk struct {
+	create *PolicyImpactAnalysisCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyImpactAnalysis.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyimpactanalysis.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyImpactAnalysisUpsertBulk) UpdateNewValues() *PolicyImpactAnalysisUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyimpactanalysis.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(policyimpactanalysis.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(policyimpactanalysis.FieldOrganizationID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyImpactAnalysis.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyImpactAnalysisUpsertBulk) Ignore() *PolicyImpactAnalysisUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyImpactAnalysisUpsertBulk) DoNothing() *PolicyImpactAnalysisUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyImpactAnalysisCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyImpactAnalysisUpsertBulk) Update(set func(*PolicyImpactAnalysisUpsert)) *PolicyImpactAnalysisUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyImpactAnalysisUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *PolicyImpactAnalysisUpsertBulk) SetStatus(v biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertBulk) UpdateStatus() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *PolicyImpactAnalysisUpsertBulk) SetFinishedAt(v time.Time) *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertBulk) UpdateFinishedAt() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *PolicyImpactAnalysisUpsertBulk) ClearFinishedAt() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetReport sets the "report" field.
+func (u *PolicyImpactAnalysisUpsertBulk) SetReport(v *biz.PolicyImpactReport) *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetReport(v)
+	})
+}
+
+// UpdateReport sets the "report" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertBulk) UpdateReport() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateReport()
+	})
+}
+
+// ClearReport clears the value of the "report" field.
+func (u *PolicyImpactAnalysisUpsertBulk) ClearReport() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.ClearReport()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *PolicyImpactAnalysisUpsertBulk) SetError(v string) *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *PolicyImpactAnalysisUpsertBulk) UpdateError() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *PolicyImpactAnalysisUpsertBulk) ClearError() *PolicyImpactAnalysisUpsertBulk {
+	return u.Update(func(s *PolicyImpactAnalysisUpsert) {
+		s.ClearError()
+	})
+}
+
+// Exec executes the query.
+func (u *PolicyImpactAnalysisUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyImpactAnalysisCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyImpactAnalysisCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyImpactAnalysisUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// PolicyImpactAnalysisDelete is the builder for deleting a PolicyImpactAnalysis entity.
+type PolicyImpactAnalysisDelete struct {
+	config
+	hooks    []Hook
+	mutation *PolicyImpactAnalysisMutation
+}
+
+// Where appends a list predicates to the PolicyImpactAnalysisDelete builder.
+func (_d *PolicyImpactAnalysisDelete) Where(ps ...predicate.PolicyImpactAnalysis) *PolicyImpactAnalysisDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PolicyImpactAnalysisDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PolicyImpactAnalysisDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PolicyImpactAnalysisDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(policyimpactanalysis.Table, sqlgraph.NewFieldSpec(policyimpactanalysis.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PolicyImpactAnalysisDeleteOne is the builder for deleting a single PolicyImpactAnalysis entity.
+type PolicyImpactAnalysisDeleteOne struct {
+	_d *PolicyImpactAnalysisDelete
+}
+
+// Where appends a list predicates to the PolicyImpactAnalysisDelete builder.
+func (_d *PolicyImpactAnalysisDeleteOne) Where(ps ...predicate.PolicyImpactAnalysis) *PolicyImpactAnalysisDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PolicyImpactAnalysisDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{policyimpactanalysis.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PolicyImpactAnalysisDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
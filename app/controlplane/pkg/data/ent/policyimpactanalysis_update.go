@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// PolicyImpactAnalysisUpdate is the builder for updating PolicyImpactAnalysis entities.
+type PolicyImpactAnalysisUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PolicyImpactAnalysisMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PolicyImpactAnalysisUpdate builder.
+func (_u *PolicyImpactAnalysisUpdate) Where(ps ...predicate.PolicyImpactAnalysis) *PolicyImpactAnalysisUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PolicyImpactAnalysisUpdate) SetStatus(v biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PolicyImpactAnalysisUpdate) SetNillableStatus(v *biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *PolicyImpactAnalysisUpdate) SetFinishedAt(v time.Time) *PolicyImpactAnalysisUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *PolicyImpactAnalysisUpdate) SetNillableFinishedAt(v *time.Time) *PolicyImpactAnalysisUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *PolicyImpactAnalysisUpdate) ClearFinishedAt() *PolicyImpactAnalysisUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetReport sets the "report" field.
+func (_u *PolicyImpactAnalysisUpdate) SetReport(v *biz.PolicyImpactReport) *PolicyImpactAnalysisUpdate {
+	_u.mutation.SetReport(v)
+	return _u
+}
+
+// ClearReport clears the value of the "report" field.
+func (_u *PolicyImpactAnalysisUpdate) ClearReport() *PolicyImpactAnalysisUpdate {
+	_u.mutation.ClearReport()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *PolicyImpactAnalysisUpdate) SetError(v string) *PolicyImpactAnalysisUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *PolicyImpactAnalysisUpdate) SetNillableError(v *string) *PolicyImpactAnalysisUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *PolicyImpactAnalysisUpdate) ClearError() *PolicyImpactAnalysisUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the PolicyImpactAnalysisMutation object of the builder.
+func (_u *PolicyImpactAnalysisUpdate) Mutation() *PolicyImpactAnalysisMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyImpactAnalysisUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyImpactAnalysisUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyImpactAnalysisUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyImpactAnalysisUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyImpactAnalysisUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := policyimpactanalysis.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PolicyImpactAnalysis.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyImpactAnalysis.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyImpactAnalysisUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyImpactAnalysisUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyImpactAnalysisUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyimpactanalysis.Table, policyimpactanalysis.Columns, sqlgraph.NewFieldSpec(policyimpactanalysis.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(policyimpactanalysis.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(policyimpactanalysis.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(policyimpactanalysis.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Report(); ok {
+		_spec.SetField(policyimpactanalysis.FieldReport, field.TypeJSON, value)
+	}
+	if _u.mutation.ReportCleared() {
+		_spec.ClearField(policyimpactanalysis.FieldReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(policyimpactanalysis.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(policyimpactanalysis.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyimpactanalysis.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyImpactAnalysisUpdateOne is the builder for updating a single PolicyImpactAnalysis entity.
+type PolicyImpactAnalysisUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PolicyImpactAnalysisMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *PolicyImpactAnalysisUpdateOne) SetStatus(v biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PolicyImpactAnalysisUpdateOne) SetNillableStatus(v *biz.PolicyImpactAnalysisStatus) *PolicyImpactAnalysisUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *PolicyImpactAnalysisUpdateOne) SetFinishedAt(v time.Time) *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *PolicyImpactAnalysisUpdateOne) SetNillableFinishedAt(v *time.Time) *PolicyImpactAnalysisUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *PolicyImpactAnalysisUpdateOne) ClearFinishedAt() *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetReport sets the "report" field.
+func (_u *PolicyImpactAnalysisUpdateOne) SetReport(v *biz.PolicyImpactReport) *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.SetReport(v)
+	return _u
+}
+
+// ClearReport clears the value of the "report" field.
+func (_u *PolicyImpactAnalysisUpdateOne) ClearReport() *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.ClearReport()
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *PolicyImpactAnalysisUpdateOne) SetError(v string) *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *PolicyImpactAnalysisUpdateOne) SetNillableError(v *string) *PolicyImpactAnalysisUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *PolicyImpactAnalysisUpdateOne) ClearError() *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// Mutation returns the PolicyImpactAnalysisMutation object of the builder.
+func (_u *PolicyImpactAnalysisUpdateOne) Mutation() *PolicyImpactAnalysisMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PolicyImpactAnalysisUpdate builder.
+func (_u *PolicyImpactAnalysisUpdateOne) Where(ps ...predicate.PolicyImpactAnalysis) *PolicyImpactAnalysisUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyImpactAnalysisUpdateOne) Select(field string, fields ...string) *PolicyImpactAnalysisUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyImpactAnalysis entity.
+func (_u *PolicyImpactAnalysisUpdateOne) Save(ctx context.Context) (*PolicyImpactAnalysis, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyImpactAnalysisUpdateOne) SaveX(ctx context.Context) *PolicyImpactAnalysis {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyImpactAnalysisUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyImpactAnalysisUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyImpactAnalysisUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := policyimpactanalysis.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "PolicyImpactAnalysis.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyImpactAnalysis.organization"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyImpactAnalysisUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyImpactAnalysisUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyImpactAnalysisUpdateOne) sqlSave(ctx context.Context) (_node *PolicyImpactAnalysis, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyimpactanalysis.Table, policyimpactanalysis.Columns, sqlgraph.NewFieldSpec(policyimpactanalysis.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyImpactAnalysis.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyimpactanalysis.FieldID)
+		for _, f := range fields {
+			if !policyimpactanalysis.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policyimpactanalysis.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(policyimpactanalysis.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(policyimpactanalysis.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(policyimpactanalysis.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Report(); ok {
+		_spec.SetField(policyimpactanalysis.FieldReport, field.TypeJSON, value)
+	}
+	if _u.mutation.ReportCleared() {
+		_spec.ClearField(policyimpactanalysis.FieldReport, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(policyimpactanalysis.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(policyimpactanalysis.FieldError, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PolicyImpactAnalysis{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyimpactanalysis.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/google/uuid"
+)
+
+// PolicyViolation is the model entity for the PolicyViolation schema.
+type PolicyViolation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// PolicyEvaluationID holds the value of the "policy_evaluation_id" field.
+	PolicyEvaluationID uuid.UUID `json:"policy_evaluation_id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Subject holds the value of the "subject" field.
+	Subject string `json:"subject,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// Suppressed holds the value of the "suppressed" field.
+	Suppressed bool `json:"suppressed,omitempty"`
+	// ExceptionID holds the value of the "exception_id" field.
+	ExceptionID string `json:"exception_id,omitempty"`
+	// FindingType holds the value of the "finding_type" field.
+	FindingType biz.PolicyFindingType `json:"finding_type,omitempty"`
+	// Severity holds the value of the "severity" field.
+	Severity string `json:"severity,omitempty"`
+	// ExternalID holds the value of the "external_id" field.
+	ExternalID string `json:"external_id,omitempty"`
+	// PackagePurl holds the value of the "package_purl" field.
+	PackagePurl string `json:"package_purl,omitempty"`
+	// Finding holds the value of the "finding" field.
+	Finding jsontext.Value `json:"finding,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PolicyViolationQuery when eager-loading is set.
+	Edges        PolicyViolationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PolicyViolationEdges holds the relations/edges for other nodes in the graph.
+type PolicyViolationEdges struct {
+	// PolicyEvaluation holds the value of the policy_evaluation edge.
+	PolicyEvaluation *PolicyEvaluation `json:"policy_evaluation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PolicyEvaluationOrErr returns the PolicyEvaluation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PolicyViolationEdges) PolicyEvaluationOrErr() (*PolicyEvaluation, error) {
+	if e.PolicyEvaluation != nil {
+		return e.PolicyEvaluation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: policyevaluation.Label}
+	}
+	return nil, &NotLoadedError{edge: "policy_evaluation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PolicyViolation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case policyviolation.FieldFinding:
+			values[i] = new([]byte)
+		case policyviolation.FieldSuppressed:
+			values[i] = new(sql.NullBool)
+		case policyviolation.FieldSubject, policyviolation.FieldMessage, policyviolation.FieldExceptionID, policyviolation.FieldFindingType, policyviolation.FieldSeverity, policyviolation.FieldExternalID, policyviolation.FieldPackagePurl:
+			values[i] = new(sql.NullString)
+		case policyviolation.FieldID, policyviolation.FieldPolicyEvaluationID, policyviolation.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PolicyViolation fields.
+func (_m *PolicyViolation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case policyviolation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case policyviolation.FieldPolicyEvaluationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_evaluation_id", values[i])
+			} else if value != nil {
+				_m.PolicyEvaluationID = *value
+			}
+		case policyviolation.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case policyviolation.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case policyviolation.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case policyviolation.FieldSuppressed:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suppressed", values[i])
+			} else if value.Valid {
+				_m.Suppressed = value.Bool
+			}
+		case policyviolation.FieldExceptionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exception_id", values[i])
+			} else if value.Valid {
+				_m.ExceptionID = value.String
+			}
+		case policyviolation.FieldFindingType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field finding_type", values[i])
+			} else if value.Valid {
+				_m.FindingType = biz.PolicyFindingType(value.String)
+			}
+		case policyviolation.FieldSeverity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field severity", values[i])
+			} else if value.Valid {
+				_m.Severity = value.String
+			}
+		case policyviolation.FieldExternalID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
+			} else if value.Valid {
+				_m.ExternalID = value.String
+			}
+		case policyviolation.FieldPackagePurl:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field package_purl", values[i])
+			} else if value.Valid {
+				_m.PackagePurl = value.String
+			}
+		case policyviolation.FieldFinding:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field finding", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Finding); err != nil {
+					return fmt.Errorf("unmarshal field finding: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PolicyViolation.
+// This includes values selected through modifiers, order, etc.
+func (_m *PolicyViolation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPolicyEvaluation queries the "policy_evaluation" edge of the PolicyViolation entity.
+func (_m *PolicyViolation) QueryPolicyEvaluation() *PolicyEvaluationQuery {
+	return NewPolicyViolationClient(_m.config).QueryPolicyEvaluation(_m)
+}
+
+// Update returns a builder for updating this PolicyViolation.
+// Note that you need to call PolicyViolation.Unwrap() before calling this method if this PolicyViolation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PolicyViolation) Update() *PolicyViolationUpdateOne {
+	return NewPolicyViolationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PolicyViolation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PolicyViolation) Unwrap() *PolicyViolation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PolicyViolation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PolicyViolation) String() string {
+	var builder strings.Builder
+	builder.WriteString("PolicyViolation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("policy_evaluation_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PolicyEvaluationID))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("suppressed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Suppressed))
+	builder.WriteString(", ")
+	builder.WriteString("exception_id=")
+	builder.WriteString(_m.ExceptionID)
+	builder.WriteString(", ")
+	builder.WriteString("finding_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FindingType))
+	builder.WriteString(", ")
+	builder.WriteString("severity=")
+	builder.WriteString(_m.Severity)
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
+	builder.WriteString(", ")
+	builder.WriteString("package_purl=")
+	builder.WriteString(_m.PackagePurl)
+	builder.WriteString(", ")
+	builder.WriteString("finding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Finding))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PolicyViolations is a parsable slice of PolicyViolation.
+type PolicyViolations []*PolicyViolation
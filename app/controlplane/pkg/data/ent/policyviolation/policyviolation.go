@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyviolation
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the policyviolation type in the database.
+	Label = "policy_violation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPolicyEvaluationID holds the string denoting the policy_evaluation_id field in the database.
+	FieldPolicyEvaluationID = "policy_evaluation_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldSuppressed holds the string denoting the suppressed field in the database.
+	FieldSuppressed = "suppressed"
+	// FieldExceptionID holds the string denoting the exception_id field in the database.
+	FieldExceptionID = "exception_id"
+	// FieldFindingType holds the string denoting the finding_type field in the database.
+	FieldFindingType = "finding_type"
+	// FieldSeverity holds the string denoting the severity field in the database.
+	FieldSeverity = "severity"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
+	// FieldPackagePurl holds the string denoting the package_purl field in the database.
+	FieldPackagePurl = "package_purl"
+	// FieldFinding holds the string denoting the finding field in the database.
+	FieldFinding = "finding"
+	// EdgePolicyEvaluation holds the string denoting the policy_evaluation edge name in mutations.
+	EdgePolicyEvaluation = "policy_evaluation"
+	// Table holds the table name of the policyviolation in the database.
+	Table = "policy_violations"
+	// PolicyEvaluationTable is the table that holds the policy_evaluation relation/edge.
+	PolicyEvaluationTable = "policy_violations"
+	// PolicyEvaluationInverseTable is the table name for the PolicyEvaluation entity.
+	// It exists in this package in order to avoid circular dependency with the "policyevaluation" package.
+	PolicyEvaluationInverseTable = "policy_evaluations"
+	// PolicyEvaluationColumn is the table column denoting the policy_evaluation relation/edge.
+	PolicyEvaluationColumn = "policy_evaluation_id"
+)
+
+// Columns holds all SQL columns for policyviolation fields.
+var Columns = []string{
+	FieldID,
+	FieldPolicyEvaluationID,
+	FieldOrganizationID,
+	FieldSubject,
+	FieldMessage,
+	FieldSuppressed,
+	FieldExceptionID,
+	FieldFindingType,
+	FieldSeverity,
+	FieldExternalID,
+	FieldPackagePurl,
+	FieldFinding,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSuppressed holds the default value on creation for the "suppressed" field.
+	DefaultSuppressed bool
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// FindingTypeValidator is a validator for the "finding_type" field enum values. It is called by the builders before save.
+func FindingTypeValidator(ft biz.PolicyFindingType) error {
+	switch ft {
+	case "VULNERABILITY", "SAST", "LICENSE_VIOLATION", "SECRET", "MISCONFIGURATION", "MALWARE":
+		return nil
+	default:
+		return fmt.Errorf("policyviolation: invalid enum value for finding_type field: %q", ft)
+	}
+}
+
+// OrderOption defines the ordering options for the PolicyViolation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPolicyEvaluationID orders the results by the policy_evaluation_id field.
+func ByPolicyEvaluationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyEvaluationID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// BySuppressed orders the results by the suppressed field.
+func BySuppressed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressed, opts...).ToFunc()
+}
+
+// ByExceptionID orders the results by the exception_id field.
+func ByExceptionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExceptionID, opts...).ToFunc()
+}
+
+// ByFindingType orders the results by the finding_type field.
+func ByFindingType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFindingType, opts...).ToFunc()
+}
+
+// BySeverity orders the results by the severity field.
+func BySeverity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeverity, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
+}
+
+// ByPackagePurl orders the results by the package_purl field.
+func ByPackagePurl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackagePurl, opts...).ToFunc()
+}
+
+// ByPolicyEvaluationField orders the results by policy_evaluation field.
+func ByPolicyEvaluationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPolicyEvaluationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPolicyEvaluationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PolicyEvaluationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PolicyEvaluationTable, PolicyEvaluationColumn),
+	)
+}
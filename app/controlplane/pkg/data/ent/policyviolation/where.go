@@ -0,0 +1,699 @@
+// Code generated by ent, DO NOT EDIT.
+
+package policyviolation
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldID, id))
+}
+
+// PolicyEvaluationID applies equality check predicate on the "policy_evaluation_id" field. It's identical to PolicyEvaluationIDEQ.
+func PolicyEvaluationID(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldPolicyEvaluationID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldSubject, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldMessage, v))
+}
+
+// Suppressed applies equality check predicate on the "suppressed" field. It's identical to SuppressedEQ.
+func Suppressed(v bool) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldSuppressed, v))
+}
+
+// ExceptionID applies equality check predicate on the "exception_id" field. It's identical to ExceptionIDEQ.
+func ExceptionID(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldExceptionID, v))
+}
+
+// Severity applies equality check predicate on the "severity" field. It's identical to SeverityEQ.
+func Severity(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldSeverity, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldExternalID, v))
+}
+
+// PackagePurl applies equality check predicate on the "package_purl" field. It's identical to PackagePurlEQ.
+func PackagePurl(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldPackagePurl, v))
+}
+
+// PolicyEvaluationIDEQ applies the EQ predicate on the "policy_evaluation_id" field.
+func PolicyEvaluationIDEQ(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldPolicyEvaluationID, v))
+}
+
+// PolicyEvaluationIDNEQ applies the NEQ predicate on the "policy_evaluation_id" field.
+func PolicyEvaluationIDNEQ(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldPolicyEvaluationID, v))
+}
+
+// PolicyEvaluationIDIn applies the In predicate on the "policy_evaluation_id" field.
+func PolicyEvaluationIDIn(vs ...uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldPolicyEvaluationID, vs...))
+}
+
+// PolicyEvaluationIDNotIn applies the NotIn predicate on the "policy_evaluation_id" field.
+func PolicyEvaluationIDNotIn(vs ...uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldPolicyEvaluationID, vs...))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldSubject))
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldSubject))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// SuppressedEQ applies the EQ predicate on the "suppressed" field.
+func SuppressedEQ(v bool) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldSuppressed, v))
+}
+
+// SuppressedNEQ applies the NEQ predicate on the "suppressed" field.
+func SuppressedNEQ(v bool) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldSuppressed, v))
+}
+
+// ExceptionIDEQ applies the EQ predicate on the "exception_id" field.
+func ExceptionIDEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldExceptionID, v))
+}
+
+// ExceptionIDNEQ applies the NEQ predicate on the "exception_id" field.
+func ExceptionIDNEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldExceptionID, v))
+}
+
+// ExceptionIDIn applies the In predicate on the "exception_id" field.
+func ExceptionIDIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldExceptionID, vs...))
+}
+
+// ExceptionIDNotIn applies the NotIn predicate on the "exception_id" field.
+func ExceptionIDNotIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldExceptionID, vs...))
+}
+
+// ExceptionIDGT applies the GT predicate on the "exception_id" field.
+func ExceptionIDGT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldExceptionID, v))
+}
+
+// ExceptionIDGTE applies the GTE predicate on the "exception_id" field.
+func ExceptionIDGTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldExceptionID, v))
+}
+
+// ExceptionIDLT applies the LT predicate on the "exception_id" field.
+func ExceptionIDLT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldExceptionID, v))
+}
+
+// ExceptionIDLTE applies the LTE predicate on the "exception_id" field.
+func ExceptionIDLTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldExceptionID, v))
+}
+
+// ExceptionIDContains applies the Contains predicate on the "exception_id" field.
+func ExceptionIDContains(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContains(FieldExceptionID, v))
+}
+
+// ExceptionIDHasPrefix applies the HasPrefix predicate on the "exception_id" field.
+func ExceptionIDHasPrefix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasPrefix(FieldExceptionID, v))
+}
+
+// ExceptionIDHasSuffix applies the HasSuffix predicate on the "exception_id" field.
+func ExceptionIDHasSuffix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasSuffix(FieldExceptionID, v))
+}
+
+// ExceptionIDIsNil applies the IsNil predicate on the "exception_id" field.
+func ExceptionIDIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldExceptionID))
+}
+
+// ExceptionIDNotNil applies the NotNil predicate on the "exception_id" field.
+func ExceptionIDNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldExceptionID))
+}
+
+// ExceptionIDEqualFold applies the EqualFold predicate on the "exception_id" field.
+func ExceptionIDEqualFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEqualFold(FieldExceptionID, v))
+}
+
+// ExceptionIDContainsFold applies the ContainsFold predicate on the "exception_id" field.
+func ExceptionIDContainsFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContainsFold(FieldExceptionID, v))
+}
+
+// FindingTypeEQ applies the EQ predicate on the "finding_type" field.
+func FindingTypeEQ(v biz.PolicyFindingType) predicate.PolicyViolation {
+	vc := v
+	return predicate.PolicyViolation(sql.FieldEQ(FieldFindingType, vc))
+}
+
+// FindingTypeNEQ applies the NEQ predicate on the "finding_type" field.
+func FindingTypeNEQ(v biz.PolicyFindingType) predicate.PolicyViolation {
+	vc := v
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldFindingType, vc))
+}
+
+// FindingTypeIn applies the In predicate on the "finding_type" field.
+func FindingTypeIn(vs ...biz.PolicyFindingType) predicate.PolicyViolation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PolicyViolation(sql.FieldIn(FieldFindingType, v...))
+}
+
+// FindingTypeNotIn applies the NotIn predicate on the "finding_type" field.
+func FindingTypeNotIn(vs ...biz.PolicyFindingType) predicate.PolicyViolation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldFindingType, v...))
+}
+
+// FindingTypeIsNil applies the IsNil predicate on the "finding_type" field.
+func FindingTypeIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldFindingType))
+}
+
+// FindingTypeNotNil applies the NotNil predicate on the "finding_type" field.
+func FindingTypeNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldFindingType))
+}
+
+// SeverityEQ applies the EQ predicate on the "severity" field.
+func SeverityEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldSeverity, v))
+}
+
+// SeverityNEQ applies the NEQ predicate on the "severity" field.
+func SeverityNEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldSeverity, v))
+}
+
+// SeverityIn applies the In predicate on the "severity" field.
+func SeverityIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldSeverity, vs...))
+}
+
+// SeverityNotIn applies the NotIn predicate on the "severity" field.
+func SeverityNotIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldSeverity, vs...))
+}
+
+// SeverityGT applies the GT predicate on the "severity" field.
+func SeverityGT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldSeverity, v))
+}
+
+// SeverityGTE applies the GTE predicate on the "severity" field.
+func SeverityGTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldSeverity, v))
+}
+
+// SeverityLT applies the LT predicate on the "severity" field.
+func SeverityLT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldSeverity, v))
+}
+
+// SeverityLTE applies the LTE predicate on the "severity" field.
+func SeverityLTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldSeverity, v))
+}
+
+// SeverityContains applies the Contains predicate on the "severity" field.
+func SeverityContains(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContains(FieldSeverity, v))
+}
+
+// SeverityHasPrefix applies the HasPrefix predicate on the "severity" field.
+func SeverityHasPrefix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasPrefix(FieldSeverity, v))
+}
+
+// SeverityHasSuffix applies the HasSuffix predicate on the "severity" field.
+func SeverityHasSuffix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasSuffix(FieldSeverity, v))
+}
+
+// SeverityIsNil applies the IsNil predicate on the "severity" field.
+func SeverityIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldSeverity))
+}
+
+// SeverityNotNil applies the NotNil predicate on the "severity" field.
+func SeverityNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldSeverity))
+}
+
+// SeverityEqualFold applies the EqualFold predicate on the "severity" field.
+func SeverityEqualFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEqualFold(FieldSeverity, v))
+}
+
+// SeverityContainsFold applies the ContainsFold predicate on the "severity" field.
+func SeverityContainsFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContainsFold(FieldSeverity, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDIsNil applies the IsNil predicate on the "external_id" field.
+func ExternalIDIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldExternalID))
+}
+
+// ExternalIDNotNil applies the NotNil predicate on the "external_id" field.
+func ExternalIDNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldExternalID))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContainsFold(FieldExternalID, v))
+}
+
+// PackagePurlEQ applies the EQ predicate on the "package_purl" field.
+func PackagePurlEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEQ(FieldPackagePurl, v))
+}
+
+// PackagePurlNEQ applies the NEQ predicate on the "package_purl" field.
+func PackagePurlNEQ(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNEQ(FieldPackagePurl, v))
+}
+
+// PackagePurlIn applies the In predicate on the "package_purl" field.
+func PackagePurlIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIn(FieldPackagePurl, vs...))
+}
+
+// PackagePurlNotIn applies the NotIn predicate on the "package_purl" field.
+func PackagePurlNotIn(vs ...string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotIn(FieldPackagePurl, vs...))
+}
+
+// PackagePurlGT applies the GT predicate on the "package_purl" field.
+func PackagePurlGT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGT(FieldPackagePurl, v))
+}
+
+// PackagePurlGTE applies the GTE predicate on the "package_purl" field.
+func PackagePurlGTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldGTE(FieldPackagePurl, v))
+}
+
+// PackagePurlLT applies the LT predicate on the "package_purl" field.
+func PackagePurlLT(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLT(FieldPackagePurl, v))
+}
+
+// PackagePurlLTE applies the LTE predicate on the "package_purl" field.
+func PackagePurlLTE(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldLTE(FieldPackagePurl, v))
+}
+
+// PackagePurlContains applies the Contains predicate on the "package_purl" field.
+func PackagePurlContains(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContains(FieldPackagePurl, v))
+}
+
+// PackagePurlHasPrefix applies the HasPrefix predicate on the "package_purl" field.
+func PackagePurlHasPrefix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasPrefix(FieldPackagePurl, v))
+}
+
+// PackagePurlHasSuffix applies the HasSuffix predicate on the "package_purl" field.
+func PackagePurlHasSuffix(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldHasSuffix(FieldPackagePurl, v))
+}
+
+// PackagePurlIsNil applies the IsNil predicate on the "package_purl" field.
+func PackagePurlIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldPackagePurl))
+}
+
+// PackagePurlNotNil applies the NotNil predicate on the "package_purl" field.
+func PackagePurlNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldPackagePurl))
+}
+
+// PackagePurlEqualFold applies the EqualFold predicate on the "package_purl" field.
+func PackagePurlEqualFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldEqualFold(FieldPackagePurl, v))
+}
+
+// PackagePurlContainsFold applies the ContainsFold predicate on the "package_purl" field.
+func PackagePurlContainsFold(v string) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldContainsFold(FieldPackagePurl, v))
+}
+
+// FindingIsNil applies the IsNil predicate on the "finding" field.
+func FindingIsNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldIsNull(FieldFinding))
+}
+
+// FindingNotNil applies the NotNil predicate on the "finding" field.
+func FindingNotNil() predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.FieldNotNull(FieldFinding))
+}
+
+// HasPolicyEvaluation applies the HasEdge predicate on the "policy_evaluation" edge.
+func HasPolicyEvaluation() predicate.PolicyViolation {
+	return predicate.PolicyViolation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PolicyEvaluationTable, PolicyEvaluationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPolicyEvaluationWith applies the HasEdge predicate on the "policy_evaluation" edge with a given conditions (other predicates).
+func HasPolicyEvaluationWith(preds ...predicate.PolicyEvaluation) predicate.PolicyViolation {
+	return predicate.PolicyViolation(func(s *sql.Selector) {
+		step := newPolicyEvaluationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PolicyViolation) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PolicyViolation) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PolicyViolation) predicate.PolicyViolation {
+	return predicate.PolicyViolation(sql.NotPredicates(p))
+}
@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/google/uuid"
+)
+
+// PolicyViolationCreate is the builder for creating a PolicyViolation entity.
+type PolicyViolationCreate struct {
+	config
+	mutation *PolicyViolationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPolicyEvaluationID sets the "policy_evaluation_id" field.
+func (_c *PolicyViolationCreate) SetPolicyEvaluationID(v uuid.UUID) *PolicyViolationCreate {
+	_c.mutation.SetPolicyEvaluationID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *PolicyViolationCreate) SetOrganizationID(v uuid.UUID) *PolicyViolationCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *PolicyViolationCreate) SetSubject(v string) *PolicyViolationCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableSubject(v *string) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *PolicyViolationCreate) SetMessage(v string) *PolicyViolationCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetSuppressed sets the "suppressed" field.
+func (_c *PolicyViolationCreate) SetSuppressed(v bool) *PolicyViolationCreate {
+	_c.mutation.SetSuppressed(v)
+	return _c
+}
+
+// SetNillableSuppressed sets the "suppressed" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableSuppressed(v *bool) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetSuppressed(*v)
+	}
+	return _c
+}
+
+// SetExceptionID sets the "exception_id" field.
+func (_c *PolicyViolationCreate) SetExceptionID(v string) *PolicyViolationCreate {
+	_c.mutation.SetExceptionID(v)
+	return _c
+}
+
+// SetNillableExceptionID sets the "exception_id" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableExceptionID(v *string) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetExceptionID(*v)
+	}
+	return _c
+}
+
+// SetFindingType sets the "finding_type" field.
+func (_c *PolicyViolationCreate) SetFindingType(v biz.PolicyFindingType) *PolicyViolationCreate {
+	_c.mutation.SetFindingType(v)
+	return _c
+}
+
+// SetNillableFindingType sets the "finding_type" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableFindingType(v *biz.PolicyFindingType) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetFindingType(*v)
+	}
+	return _c
+}
+
+// SetSeverity sets the "severity" field.
+func (_c *PolicyViolationCreate) SetSeverity(v string) *PolicyViolationCreate {
+	_c.mutation.SetSeverity(v)
+	return _c
+}
+
+// SetNillableSeverity sets the "severity" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableSeverity(v *string) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetSeverity(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *PolicyViolationCreate) SetExternalID(v string) *PolicyViolationCreate {
+	_c.mutation.SetExternalID(v)
+	return _c
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableExternalID(v *string) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetExternalID(*v)
+	}
+	return _c
+}
+
+// SetPackagePurl sets the "package_purl" field.
+func (_c *PolicyViolationCreate) SetPackagePurl(v string) *PolicyViolationCreate {
+	_c.mutation.SetPackagePurl(v)
+	return _c
+}
+
+// SetNillablePackagePurl sets the "package_purl" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillablePackagePurl(v *string) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetPackagePurl(*v)
+	}
+	return _c
+}
+
+// SetFinding sets the "finding" field.
+func (_c *PolicyViolationCreate) SetFinding(v jsontext.Value) *PolicyViolationCreate {
+	_c.mutation.SetFinding(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PolicyViolationCreate) SetID(v uuid.UUID) *PolicyViolationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PolicyViolationCreate) SetNillableID(v *uuid.UUID) *PolicyViolationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPolicyEvaluation sets the "policy_evaluation" edge to the PolicyEvaluation entity.
+func (_c *PolicyViolationCreate) SetPolicyEvaluation(v *PolicyEvaluation) *PolicyViolationCreate {
+	return _c.SetPolicyEvaluationID(v.ID)
+}
+
+// Mutation returns the PolicyViolationMutation object of the builder.
+func (_c *PolicyViolationCreate) Mutation() *PolicyViolationMutation {
+	return _c.mutation
+}
+
+// Save creates the PolicyViolation in the database.
+func (_c *PolicyViolationCreate) Save(ctx context.Context) (*PolicyViolation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PolicyViolationCreate) SaveX(ctx context.Context) *PolicyViolation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyViolationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyViolationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PolicyViolationCreate) defaults() {
+	if _, ok := _c.mutation.Suppressed(); !ok {
+		v := policyviolation.DefaultSuppressed
+		_c.mutation.SetSuppressed(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := policyviolation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PolicyViolationCreate) check() error {
+	if _, ok := _c.mutation.PolicyEvaluationID(); !ok {
+		return &ValidationError{Name: "policy_evaluation_id", err: errors.New(`ent: missing required field "PolicyViolation.policy_evaluation_id"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "PolicyViolation.organization_id"`)}
+	}
+	if _, ok := _c.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "PolicyViolation.message"`)}
+	}
+	if _, ok := _c.mutation.Suppressed(); !ok {
+		return &ValidationError{Name: "suppressed", err: errors.New(`ent: missing required field "PolicyViolation.suppressed"`)}
+	}
+	if v, ok := _c.mutation.FindingType(); ok {
+		if err := policyviolation.FindingTypeValidator(v); err != nil {
+			return &ValidationError{Name: "finding_type", err: fmt.Errorf(`ent: validator failed for field "PolicyViolation.finding_type": %w`, err)}
+		}
+	}
+	if len(_c.mutation.PolicyEvaluationIDs()) == 0 {
+		return &ValidationError{Name: "policy_evaluation", err: errors.New(`ent: missing required edge "PolicyViolation.policy_evaluation"`)}
+	}
+	return nil
+}
+
+func (_c *PolicyViolationCreate) sqlSave(ctx context.Context) (*PolicyViolation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PolicyViolationCreate) createSpec() (*PolicyViolation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PolicyViolation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(policyviolation.Table, sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(policyviolation.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(policyviolation.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(policyviolation.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.Suppressed(); ok {
+		_spec.SetField(policyviolation.FieldSuppressed, field.TypeBool, value)
+		_node.Suppressed = value
+	}
+	if value, ok := _c.mutation.ExceptionID(); ok {
+		_spec.SetField(policyviolation.FieldExceptionID, field.TypeString, value)
+		_node.ExceptionID = value
+	}
+	if value, ok := _c.mutation.FindingType(); ok {
+		_spec.SetField(policyviolation.FieldFindingType, field.TypeEnum, value)
+		_node.FindingType = value
+	}
+	if value, ok := _c.mutation.Severity(); ok {
+		_spec.SetField(policyviolation.FieldSeverity, field.TypeString, value)
+		_node.Severity = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(policyviolation.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
+	}
+	if value, ok := _c.mutation.PackagePurl(); ok {
+		_spec.SetField(policyviolation.FieldPackagePurl, field.TypeString, value)
+		_node.PackagePurl = value
+	}
+	if value, ok := _c.mutation.Finding(); ok {
+		_spec.SetField(policyviolation.FieldFinding, field.TypeJSON, value)
+		_node.Finding = value
+	}
+	if nodes := _c.mutation.PolicyEvaluationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   policyviolation.PolicyEvaluationTable,
+			Columns: []string{policyviolation.PolicyEvaluationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PolicyEvaluationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyViolation.Create().
+//		SetPolicyEvaluationID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyViolationUpsert) {
+//			SetPolicyEvaluationID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyViolationCreate) OnConflict(opts ...sql.ConflictOption) *PolicyViolationUpsertOne {
+	_c.conflict = opts
+	return &PolicyViolationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyViolation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyViolationCreate) OnConflictColumns(columns ...string) *PolicyViolationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyViolationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PolicyViolationUpsertOne is the builder for "upsert"-ing
+	//  one PolicyViolation node.
+	PolicyViolationUpsertOne struct {
+		create *PolicyViolationCreate
+	}
+
+	// PolicyViolationUpsert is the "OnConflict" setter.
+	PolicyViolationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.PolicyViolation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyviolation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyViolationUpsertOne) UpdateNewValues() *PolicyViolationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(policyviolation.FieldID)
+		}
+		if _, exists := u.create.mutation.PolicyEvaluationID(); exists {
+			s.SetIgnore(policyviolation.FieldPolicyEvaluationID)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(policyviolation.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.Subject(); exists {
+			s.SetIgnore(policyviolation.FieldSubject)
+		}
+		if _, exists := u.create.mutation.Message(); exists {
+			s.SetIgnore(policyviolation.FieldMessage)
+		}
+		if _, exists := u.create.mutation.Suppressed(); exists {
+			s.SetIgnore(policyviolation.FieldSuppressed)
+		}
+		if _, exists := u.create.mutation.ExceptionID(); exists {
+			s.SetIgnore(policyviolation.FieldExceptionID)
+		}
+		if _, exists := u.create.mutation.FindingType(); exists {
+			s.SetIgnore(policyviolation.FieldFindingType)
+		}
+		if _, exists := u.create.mutation.Severity(); exists {
+			s.SetIgnore(policyviolation.FieldSeverity)
+		}
+		if _, exists := u.create.mutation.ExternalID(); exists {
+			s.SetIgnore(policyviolation.FieldExternalID)
+		}
+		if _, exists := u.create.mutation.PackagePurl(); exists {
+			s.SetIgnore(policyviolation.FieldPackagePurl)
+		}
+		if _, exists := u.create.mutation.Finding(); exists {
+			s.SetIgnore(policyviolation.FieldFinding)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyViolation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PolicyViolationUpsertOne) Ignore() *PolicyViolationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyViolationUpsertOne) DoNothing() *PolicyViolationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyViolationCreate.OnConflict
+// documentation for more info.
+func (u *PolicyViolationUpsertOne) Update(set func(*PolicyViolationUpsert)) *PolicyViolationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyViolationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PolicyViolationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyViolationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyViolationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PolicyViolationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: PolicyViolationUpsertOne.ID is not supported by MySQL driver. Use PolicyViolationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PolicyViolationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PolicyViolationCreateBulk is the builder for creating many PolicyViolation entities in bulk.
+type PolicyViolationCreateBulk struct {
+	config
+	err      error
+	builders []*PolicyViolationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PolicyViolation entities in the database.
+func (_c *PolicyViolationCreateBulk) Save(ctx context.Context) ([]*PolicyViolation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PolicyViolation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PolicyViolationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PolicyViolationCreateBulk) SaveX(ctx context.Context) []*PolicyViolation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PolicyViolationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PolicyViolationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PolicyViolation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PolicyViolationUpsert) {
+//			SetPolicyEvaluationID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PolicyViolationCreateBulk) OnConflict(opts ...sql.ConflictOption) *PolicyViolationUpsertBulk {
+	_c.conflict = opts
+	return &PolicyViolationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PolicyViolation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PolicyViolationCreateBulk) OnConflictColumns(columns ...string) *PolicyViolationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PolicyViolationUpsertBulk{
+		create: _c,
+	}
+}
+
+// PolicyViolationUpsertBulk is the builder for "upsert"-ing
+// a bulk of PolicyViolation nodes.
+type PolicyViolationUpsertBulk struct {
+	create *PolicyViolationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PolicyViolation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(policyviolation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *PolicyViolationUpsertBulk) UpdateNewValues() *PolicyViolationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(policyviolation.FieldID)
+			}
+			if _, exists := b.mutation.PolicyEvaluationID(); exists {
+				s.SetIgnore(policyviolation.FieldPolicyEvaluationID)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(policyviolation.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.Subject(); exists {
+				s.SetIgnore(policyviolation.FieldSubject)
+			}
+			if _, exists := b.mutation.Message(); exists {
+				s.SetIgnore(policyviolation.FieldMessage)
+			}
+			if _, exists := b.mutation.Suppressed(); exists {
+				s.SetIgnore(policyviolation.FieldSuppressed)
+			}
+			if _, exists := b.mutation.ExceptionID(); exists {
+				s.SetIgnore(policyviolation.FieldExceptionID)
+			}
+			if _, exists := b.mutation.FindingType(); exists {
+				s.SetIgnore(policyviolation.FieldFindingType)
+			}
+			if _, exists := b.mutation.Severity(); exists {
+				s.SetIgnore(policyviolation.FieldSeverity)
+			}
+			if _, exists := b.mutation.ExternalID(); exists {
+				s.SetIgnore(policyviolation.FieldExternalID)
+			}
+			if _, exists := b.mutation.PackagePurl(); exists {
+				s.SetIgnore(policyviolation.FieldPackagePurl)
+			}
+			if _, exists := b.mutation.Finding(); exists {
+				s.SetIgnore(policyviolation.FieldFinding)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PolicyViolation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PolicyViolationUpsertBulk) Ignore() *PolicyViolationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PolicyViolationUpsertBulk) DoNothing() *PolicyViolationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PolicyViolationCreateBulk.OnConflict
+// documentation for more info.
+func (u *PolicyViolationUpsertBulk) Update(set func(*PolicyViolationUpsert)) *PolicyViolationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PolicyViolationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PolicyViolationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PolicyViolationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PolicyViolationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PolicyViolationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
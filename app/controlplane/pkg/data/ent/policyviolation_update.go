@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+)
+
+// PolicyViolationUpdate is the builder for updating PolicyViolation entities.
+type PolicyViolationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PolicyViolationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PolicyViolationUpdate builder.
+func (_u *PolicyViolationUpdate) Where(ps ...predicate.PolicyViolation) *PolicyViolationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PolicyViolationMutation object of the builder.
+func (_u *PolicyViolationUpdate) Mutation() *PolicyViolationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PolicyViolationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyViolationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PolicyViolationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyViolationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyViolationUpdate) check() error {
+	if _u.mutation.PolicyEvaluationCleared() && len(_u.mutation.PolicyEvaluationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyViolation.policy_evaluation"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyViolationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyViolationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyViolationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyviolation.Table, policyviolation.Columns, sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(policyviolation.FieldSubject, field.TypeString)
+	}
+	if _u.mutation.ExceptionIDCleared() {
+		_spec.ClearField(policyviolation.FieldExceptionID, field.TypeString)
+	}
+	if _u.mutation.FindingTypeCleared() {
+		_spec.ClearField(policyviolation.FieldFindingType, field.TypeEnum)
+	}
+	if _u.mutation.SeverityCleared() {
+		_spec.ClearField(policyviolation.FieldSeverity, field.TypeString)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(policyviolation.FieldExternalID, field.TypeString)
+	}
+	if _u.mutation.PackagePurlCleared() {
+		_spec.ClearField(policyviolation.FieldPackagePurl, field.TypeString)
+	}
+	if _u.mutation.FindingCleared() {
+		_spec.ClearField(policyviolation.FieldFinding, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyviolation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PolicyViolationUpdateOne is the builder for updating a single PolicyViolation entity.
+type PolicyViolationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PolicyViolationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the PolicyViolationMutation object of the builder.
+func (_u *PolicyViolationUpdateOne) Mutation() *PolicyViolationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PolicyViolationUpdate builder.
+func (_u *PolicyViolationUpdateOne) Where(ps ...predicate.PolicyViolation) *PolicyViolationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PolicyViolationUpdateOne) Select(field string, fields ...string) *PolicyViolationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PolicyViolation entity.
+func (_u *PolicyViolationUpdateOne) Save(ctx context.Context) (*PolicyViolation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PolicyViolationUpdateOne) SaveX(ctx context.Context) *PolicyViolation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PolicyViolationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PolicyViolationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PolicyViolationUpdateOne) check() error {
+	if _u.mutation.PolicyEvaluationCleared() && len(_u.mutation.PolicyEvaluationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PolicyViolation.policy_evaluation"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *PolicyViolationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PolicyViolationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *PolicyViolationUpdateOne) sqlSave(ctx context.Context) (_node *PolicyViolation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(policyviolation.Table, policyviolation.Columns, sqlgraph.NewFieldSpec(policyviolation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PolicyViolation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, policyviolation.FieldID)
+		for _, f := range fields {
+			if !policyviolation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != policyviolation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(policyviolation.FieldSubject, field.TypeString)
+	}
+	if _u.mutation.ExceptionIDCleared() {
+		_spec.ClearField(policyviolation.FieldExceptionID, field.TypeString)
+	}
+	if _u.mutation.FindingTypeCleared() {
+		_spec.ClearField(policyviolation.FieldFindingType, field.TypeEnum)
+	}
+	if _u.mutation.SeverityCleared() {
+		_spec.ClearField(policyviolation.FieldSeverity, field.TypeString)
+	}
+	if _u.mutation.ExternalIDCleared() {
+		_spec.ClearField(policyviolation.FieldExternalID, field.TypeString)
+	}
+	if _u.mutation.PackagePurlCleared() {
+		_spec.ClearField(policyviolation.FieldPackagePurl, field.TypeString)
+	}
+	if _u.mutation.FindingCleared() {
+		_spec.ClearField(policyviolation.FieldFinding, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &PolicyViolation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{policyviolation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
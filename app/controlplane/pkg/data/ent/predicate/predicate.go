@@ -15,9 +15,15 @@ type Attestation func(*sql.Selector)
 // CASBackend is the predicate function for casbackend builders.
 type CASBackend func(*sql.Selector)
 
+// CASBlobDeletion is the predicate function for casblobdeletion builders.
+type CASBlobDeletion func(*sql.Selector)
+
 // CASMapping is the predicate function for casmapping builders.
 type CASMapping func(*sql.Selector)
 
+// CASRetentionRule is the predicate function for casretentionrule builders.
+type CASRetentionRule func(*sql.Selector)
+
 // Group is the predicate function for group builders.
 type Group func(*sql.Selector)
 
@@ -30,6 +36,9 @@ type Integration func(*sql.Selector)
 // IntegrationAttachment is the predicate function for integrationattachment builders.
 type IntegrationAttachment func(*sql.Selector)
 
+// IntegrationDelivery is the predicate function for integrationdelivery builders.
+type IntegrationDelivery func(*sql.Selector)
+
 // Membership is the predicate function for membership builders.
 type Membership func(*sql.Selector)
 
@@ -39,6 +48,18 @@ type OrgInvitation func(*sql.Selector)
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
+// PolicyEvaluation is the predicate function for policyevaluation builders.
+type PolicyEvaluation func(*sql.Selector)
+
+// PolicyException is the predicate function for policyexception builders.
+type PolicyException func(*sql.Selector)
+
+// PolicyImpactAnalysis is the predicate function for policyimpactanalysis builders.
+type PolicyImpactAnalysis func(*sql.Selector)
+
+// PolicyViolation is the predicate function for policyviolation builders.
+type PolicyViolation func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
@@ -65,3 +86,6 @@ type WorkflowContractVersion func(*sql.Selector)
 
 // WorkflowRun is the predicate function for workflowrun builders.
 type WorkflowRun func(*sql.Selector)
+
+// WorkflowRunSearchEntry is the predicate function for workflowrunsearchentry builders.
+type WorkflowRunSearchEntry func(*sql.Selector)
@@ -8,14 +8,21 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/apitoken"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/attestation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casblobdeletion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casmapping"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casretentionrule"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/group"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/groupmembership"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integration"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationattachment"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/membership"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/orginvitation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/referrer"
@@ -26,6 +33,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontract"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontractversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 	"github.com/google/uuid"
 )
 
@@ -93,6 +101,16 @@ func init() {
 	casbackendDescID := casbackendFields[0].Descriptor()
 	// casbackend.DefaultID holds the default value on creation for the id field.
 	casbackend.DefaultID = casbackendDescID.Default.(func() uuid.UUID)
+	casblobdeletionFields := schema.CASBlobDeletion{}.Fields()
+	_ = casblobdeletionFields
+	// casblobdeletionDescCreatedAt is the schema descriptor for created_at field.
+	casblobdeletionDescCreatedAt := casblobdeletionFields[2].Descriptor()
+	// casblobdeletion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	casblobdeletion.DefaultCreatedAt = casblobdeletionDescCreatedAt.Default.(func() time.Time)
+	// casblobdeletionDescID is the schema descriptor for id field.
+	casblobdeletionDescID := casblobdeletionFields[0].Descriptor()
+	// casblobdeletion.DefaultID holds the default value on creation for the id field.
+	casblobdeletion.DefaultID = casblobdeletionDescID.Default.(func() uuid.UUID)
 	casmappingFields := schema.CASMapping{}.Fields()
 	_ = casmappingFields
 	// casmappingDescCreatedAt is the schema descriptor for created_at field.
@@ -103,6 +121,30 @@ func init() {
 	casmappingDescID := casmappingFields[0].Descriptor()
 	// casmapping.DefaultID holds the default value on creation for the id field.
 	casmapping.DefaultID = casmappingDescID.Default.(func() uuid.UUID)
+	casretentionruleFields := schema.CASRetentionRule{}.Fields()
+	_ = casretentionruleFields
+	// casretentionruleDescMaxAgeDays is the schema descriptor for max_age_days field.
+	casretentionruleDescMaxAgeDays := casretentionruleFields[1].Descriptor()
+	// casretentionrule.MaxAgeDaysValidator is a validator for the "max_age_days" field. It is called by the builders before save.
+	casretentionrule.MaxAgeDaysValidator = casretentionruleDescMaxAgeDays.Validators[0].(func(int) error)
+	// casretentionruleDescKeepReleasedVersions is the schema descriptor for keep_released_versions field.
+	casretentionruleDescKeepReleasedVersions := casretentionruleFields[2].Descriptor()
+	// casretentionrule.DefaultKeepReleasedVersions holds the default value on creation for the keep_released_versions field.
+	casretentionrule.DefaultKeepReleasedVersions = casretentionruleDescKeepReleasedVersions.Default.(bool)
+	// casretentionruleDescCreatedAt is the schema descriptor for created_at field.
+	casretentionruleDescCreatedAt := casretentionruleFields[3].Descriptor()
+	// casretentionrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	casretentionrule.DefaultCreatedAt = casretentionruleDescCreatedAt.Default.(func() time.Time)
+	// casretentionruleDescUpdatedAt is the schema descriptor for updated_at field.
+	casretentionruleDescUpdatedAt := casretentionruleFields[4].Descriptor()
+	// casretentionrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	casretentionrule.DefaultUpdatedAt = casretentionruleDescUpdatedAt.Default.(func() time.Time)
+	// casretentionrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	casretentionrule.UpdateDefaultUpdatedAt = casretentionruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// casretentionruleDescID is the schema descriptor for id field.
+	casretentionruleDescID := casretentionruleFields[0].Descriptor()
+	// casretentionrule.DefaultID holds the default value on creation for the id field.
+	casretentionrule.DefaultID = casretentionruleDescID.Default.(func() uuid.UUID)
 	groupFields := schema.Group{}.Fields()
 	_ = groupFields
 	// groupDescName is the schema descriptor for name field.
@@ -171,6 +213,30 @@ func init() {
 	integrationattachmentDescID := integrationattachmentFields[0].Descriptor()
 	// integrationattachment.DefaultID holds the default value on creation for the id field.
 	integrationattachment.DefaultID = integrationattachmentDescID.Default.(func() uuid.UUID)
+	integrationdeliveryFields := schema.IntegrationDelivery{}.Fields()
+	_ = integrationdeliveryFields
+	// integrationdeliveryDescAttempts is the schema descriptor for attempts field.
+	integrationdeliveryDescAttempts := integrationdeliveryFields[2].Descriptor()
+	// integrationdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	integrationdelivery.DefaultAttempts = integrationdeliveryDescAttempts.Default.(int)
+	// integrationdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	integrationdeliveryDescNextAttemptAt := integrationdeliveryFields[5].Descriptor()
+	// integrationdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	integrationdelivery.DefaultNextAttemptAt = integrationdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// integrationdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	integrationdeliveryDescCreatedAt := integrationdeliveryFields[6].Descriptor()
+	// integrationdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	integrationdelivery.DefaultCreatedAt = integrationdeliveryDescCreatedAt.Default.(func() time.Time)
+	// integrationdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	integrationdeliveryDescUpdatedAt := integrationdeliveryFields[7].Descriptor()
+	// integrationdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	integrationdelivery.DefaultUpdatedAt = integrationdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// integrationdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	integrationdelivery.UpdateDefaultUpdatedAt = integrationdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// integrationdeliveryDescID is the schema descriptor for id field.
+	integrationdeliveryDescID := integrationdeliveryFields[0].Descriptor()
+	// integrationdelivery.DefaultID holds the default value on creation for the id field.
+	integrationdelivery.DefaultID = integrationdeliveryDescID.Default.(func() uuid.UUID)
 	membershipFields := schema.Membership{}.Fields()
 	_ = membershipFields
 	// membershipDescCurrent is the schema descriptor for current field.
@@ -241,6 +307,62 @@ func init() {
 	organizationDescID := organizationFields[0].Descriptor()
 	// organization.DefaultID holds the default value on creation for the id field.
 	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
+	policyevaluationFields := schema.PolicyEvaluation{}.Fields()
+	_ = policyevaluationFields
+	// policyevaluationDescCreatedAt is the schema descriptor for created_at field.
+	policyevaluationDescCreatedAt := policyevaluationFields[1].Descriptor()
+	// policyevaluation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	policyevaluation.DefaultCreatedAt = policyevaluationDescCreatedAt.Default.(func() time.Time)
+	// policyevaluationDescGate is the schema descriptor for gate field.
+	policyevaluationDescGate := policyevaluationFields[14].Descriptor()
+	// policyevaluation.DefaultGate holds the default value on creation for the gate field.
+	policyevaluation.DefaultGate = policyevaluationDescGate.Default.(bool)
+	// policyevaluationDescViolationsCount is the schema descriptor for violations_count field.
+	policyevaluationDescViolationsCount := policyevaluationFields[15].Descriptor()
+	// policyevaluation.DefaultViolationsCount holds the default value on creation for the violations_count field.
+	policyevaluation.DefaultViolationsCount = policyevaluationDescViolationsCount.Default.(int)
+	// policyevaluationDescLatest is the schema descriptor for latest field.
+	policyevaluationDescLatest := policyevaluationFields[16].Descriptor()
+	// policyevaluation.DefaultLatest holds the default value on creation for the latest field.
+	policyevaluation.DefaultLatest = policyevaluationDescLatest.Default.(bool)
+	// policyevaluationDescID is the schema descriptor for id field.
+	policyevaluationDescID := policyevaluationFields[0].Descriptor()
+	// policyevaluation.DefaultID holds the default value on creation for the id field.
+	policyevaluation.DefaultID = policyevaluationDescID.Default.(func() uuid.UUID)
+	policyexceptionFields := schema.PolicyException{}.Fields()
+	_ = policyexceptionFields
+	// policyexceptionDescCreatedAt is the schema descriptor for created_at field.
+	policyexceptionDescCreatedAt := policyexceptionFields[1].Descriptor()
+	// policyexception.DefaultCreatedAt holds the default value on creation for the created_at field.
+	policyexception.DefaultCreatedAt = policyexceptionDescCreatedAt.Default.(func() time.Time)
+	// policyexceptionDescJustification is the schema descriptor for justification field.
+	policyexceptionDescJustification := policyexceptionFields[5].Descriptor()
+	// policyexception.JustificationValidator is a validator for the "justification" field. It is called by the builders before save.
+	policyexception.JustificationValidator = policyexceptionDescJustification.Validators[0].(func(string) error)
+	// policyexceptionDescID is the schema descriptor for id field.
+	policyexceptionDescID := policyexceptionFields[0].Descriptor()
+	// policyexception.DefaultID holds the default value on creation for the id field.
+	policyexception.DefaultID = policyexceptionDescID.Default.(func() uuid.UUID)
+	policyimpactanalysisFields := schema.PolicyImpactAnalysis{}.Fields()
+	_ = policyimpactanalysisFields
+	// policyimpactanalysisDescCreatedAt is the schema descriptor for created_at field.
+	policyimpactanalysisDescCreatedAt := policyimpactanalysisFields[2].Descriptor()
+	// policyimpactanalysis.DefaultCreatedAt holds the default value on creation for the created_at field.
+	policyimpactanalysis.DefaultCreatedAt = policyimpactanalysisDescCreatedAt.Default.(func() time.Time)
+	// policyimpactanalysisDescID is the schema descriptor for id field.
+	policyimpactanalysisDescID := policyimpactanalysisFields[0].Descriptor()
+	// policyimpactanalysis.DefaultID holds the default value on creation for the id field.
+	policyimpactanalysis.DefaultID = policyimpactanalysisDescID.Default.(func() uuid.UUID)
+	policyviolationFields := schema.PolicyViolation{}.Fields()
+	_ = policyviolationFields
+	// policyviolationDescSuppressed is the schema descriptor for suppressed field.
+	policyviolationDescSuppressed := policyviolationFields[5].Descriptor()
+	// policyviolation.DefaultSuppressed holds the default value on creation for the suppressed field.
+	policyviolation.DefaultSuppressed = policyviolationDescSuppressed.Default.(bool)
+	// policyviolationDescID is the schema descriptor for id field.
+	policyviolationDescID := policyviolationFields[0].Descriptor()
+	// policyviolation.DefaultID holds the default value on creation for the id field.
+	policyviolation.DefaultID = policyviolationDescID.Default.(func() uuid.UUID)
 	projectFields := schema.Project{}.Fields()
 	_ = projectFields
 	// projectDescName is the schema descriptor for name field.
@@ -393,4 +515,14 @@ func init() {
 	workflowrunDescID := workflowrunFields[0].Descriptor()
 	// workflowrun.DefaultID holds the default value on creation for the id field.
 	workflowrun.DefaultID = workflowrunDescID.Default.(func() uuid.UUID)
+	workflowrunsearchentryFields := schema.WorkflowRunSearchEntry{}.Fields()
+	_ = workflowrunsearchentryFields
+	// workflowrunsearchentryDescCreatedAt is the schema descriptor for created_at field.
+	workflowrunsearchentryDescCreatedAt := workflowrunsearchentryFields[1].Descriptor()
+	// workflowrunsearchentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	workflowrunsearchentry.DefaultCreatedAt = workflowrunsearchentryDescCreatedAt.Default.(func() time.Time)
+	// workflowrunsearchentryDescID is the schema descriptor for id field.
+	workflowrunsearchentryDescID := workflowrunsearchentryFields[0].Descriptor()
+	// workflowrunsearchentry.DefaultID holds the default value on creation for the id field.
+	workflowrunsearchentry.DefaultID = workflowrunsearchentryDescID.Default.(func() uuid.UUID)
 }
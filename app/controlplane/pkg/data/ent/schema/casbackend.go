@@ -64,6 +64,9 @@ func (CASBackend) Fields() []ent.Field {
 		field.Bool("managed").Default(false),
 		field.Int64("max_blob_size_bytes"),
 		field.UUID("organization_cas_backends", uuid.UUID{}),
+		// Backend this one is a replica of, uploads to the primary are mirrored here and
+		// downloads fall back to it when the primary is unavailable
+		field.UUID("primary_backend_id", uuid.UUID{}).Optional(),
 	}
 }
 
@@ -72,6 +75,9 @@ func (CASBackend) Edges() []ent.Edge {
 		edge.From("organization", Organization.Type).Ref("cas_backends").Unique().Field("organization_cas_backends").Required(),
 		// WorkflowRuns might be associated with multiple CASBackends
 		edge.From("workflow_run", WorkflowRun.Type).Ref("cas_backends"),
+		edge.To("replicas", CASBackend.Type).
+			From("primary").Unique().Field("primary_backend_id").
+			Annotations(entsql.OnDelete(entsql.SetNull)),
 	}
 }
 
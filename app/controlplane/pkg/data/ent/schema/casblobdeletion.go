@@ -0,0 +1,60 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CASBlobDeletion marks a blob of a CAS backend that the retention sweeper is deleting from the storage.
+// While it exists, no new mappings to the blob can be created in the backends sharing that storage.
+type CASBlobDeletion struct {
+	ent.Schema
+}
+
+func (CASBlobDeletion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.String("digest").Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+
+		// edge fields to be able to access to them directly
+		field.UUID("cas_backend_id", uuid.UUID{}).Immutable(),
+	}
+}
+
+func (CASBlobDeletion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("cas_backend", CASBackend.Type).Field("cas_backend_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (CASBlobDeletion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("digest", "cas_backend_id").Unique(),
+		index.Fields("cas_backend_id"),
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CASRetentionRule defines for how long the artifacts stored in a CAS backend are kept.
+// A rule without CAS backend is the organization default and applies to every backend
+// that does not have a rule of its own.
+type CASRetentionRule struct {
+	ent.Schema
+}
+
+func (CASRetentionRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		// Artifacts not referenced during this number of days are eligible for deletion
+		field.Int("max_age_days").Positive(),
+		// Never delete artifacts referenced by workflow runs of released project versions
+		field.Bool("keep_released_versions").Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		// Last time the sweeper processed this rule
+		field.Time("last_swept_at").Optional(),
+
+		// edge fields to be able to access to them directly
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+		field.UUID("cas_backend_id", uuid.UUID{}).Immutable().Optional(),
+	}
+}
+
+func (CASRetentionRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).Field("organization_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("cas_backend", CASBackend.Type).Field("cas_backend_id").Unique().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (CASRetentionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		// One default rule per organization and one rule per CAS backend
+		index.Fields("organization_id").Unique().Annotations(
+			entsql.IndexWhere("cas_backend_id IS NULL"),
+		),
+		index.Fields("organization_id", "cas_backend_id").Unique().Annotations(
+			entsql.IndexWhere("cas_backend_id IS NOT NULL"),
+		),
+	}
+}
@@ -0,0 +1,93 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
+)
+
+// IntegrationDelivery is a persisted fan-out job, one per attached integration
+// and workflow run. It holds everything the dispatcher needs to rebuild the
+// execution request so pending deliveries survive a controlplane restart.
+type IntegrationDelivery struct {
+	ent.Schema
+}
+
+func (IntegrationDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Enum("status").
+			GoType(biz.IntegrationDeliveryStatus("")).
+			Default(string(biz.IntegrationDeliveryStatusPending)),
+		// Number of times the delivery has been picked up by a worker
+		field.Int("attempts").Default(0),
+		field.Text("last_error").Optional(),
+		// How long the last attempt took
+		field.Int64("duration").GoType(time.Duration(0)).Optional(),
+		// When the delivery becomes eligible to be picked up again. Workers push it
+		// forward when claiming a delivery so a crashed worker releases it on expiry.
+		field.Time("next_attempt_at").Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		field.Time("finished_at").Optional(),
+		// Inputs required to re-hydrate the execution request. The envelope is cleared once delivered
+		field.JSON("envelope", &dsse.Envelope{}).Optional(),
+		field.String("download_backend_type").Optional(),
+		field.String("download_secret_name").Optional(),
+		field.UUID("workflow_id", uuid.UUID{}).Immutable(),
+		// Digest of the delivered attestation, a delivery is enqueued once per attestation and attachment.
+		// Deliveries enqueued before it was recorded don't have it
+		field.String("attestation_digest").Optional().Immutable(),
+
+		// edge fields to be able to access to them directly
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+		field.UUID("workflow_run_id", uuid.UUID{}).Immutable(),
+		field.UUID("integration_attachment_id", uuid.UUID{}).Immutable(),
+	}
+}
+
+func (IntegrationDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).Field("organization_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("workflow_run", WorkflowRun.Type).Field("workflow_run_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("integration_attachment", IntegrationAttachment.Type).Field("integration_attachment_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (IntegrationDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// Used by the workers to find the deliveries that are due
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("integration_attachment_id", "created_at"),
+		// Enqueueing the deliveries of an attestation again is a no-op
+		index.Fields("attestation_digest", "integration_attachment_id").Unique(),
+	}
+}
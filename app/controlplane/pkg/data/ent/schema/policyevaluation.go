@@ -0,0 +1,91 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+// PolicyEvaluation is the result of evaluating a policy during a workflow run,
+// extracted from its attestation so evaluations can be queried across the organization.
+type PolicyEvaluation struct {
+	ent.Schema
+}
+
+func (PolicyEvaluation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		field.UUID("workflowrun_id", uuid.UUID{}).Immutable(),
+		// Denormalized scope of the run, so evaluations can be filtered without joining the runs
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+		field.UUID("project_id", uuid.UUID{}).Immutable(),
+		field.UUID("workflow_id", uuid.UUID{}).Immutable(),
+		// policy name
+		field.String("name").Immutable(),
+		// material the policy was evaluated against, empty (not null) for attestation policies
+		// so they are covered by the unique index of the latest evaluations
+		field.String("material_name").Optional().Immutable(),
+		field.String("material_type").Optional().Immutable(),
+		// where the policy was loaded from and its digest
+		field.String("policy_uri").Optional().Immutable(),
+		field.String("policy_digest").Optional().Immutable(),
+		// group the policy belongs to, if any
+		field.String("group_name").Optional().Immutable(),
+		field.Enum("status").GoType(biz.PolicyEvaluationStatus("")).Immutable(),
+		field.Strings("skip_reasons").Optional().Immutable(),
+		field.Bool("gate").Default(false).Immutable(),
+		// number of violations, not counting the suppressed ones
+		field.Int("violations_count").Default(0).Immutable(),
+		// whether the evaluation belongs to the most recent run of the workflow
+		field.Bool("latest").Default(true),
+	}
+}
+
+func (PolicyEvaluation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workflowrun", WorkflowRun.Type).Field("workflowrun_id").Ref("policy_evaluations").
+			Immutable().Unique().Required(),
+		edge.To("violations", PolicyViolation.Type).Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (PolicyEvaluation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workflowrun_id"),
+		// evaluations of a policy over time
+		index.Fields("organization_id", "name", "created_at").
+			Annotations(entsql.DescColumns("created_at")),
+		// current state of a policy across the organization
+		index.Fields("organization_id", "latest", "name"),
+		// to flip the latest flag when a new run of the workflow is attested
+		index.Fields("workflow_id", "latest"),
+		// only the evaluations of a single run of the workflow are flagged as the latest ones,
+		// concurrent runs racing to flag theirs conflict
+		index.Fields("workflow_id", "name", "material_name").Unique().Annotations(entsql.IndexWhere("latest")),
+	}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PolicyException waives the violations of a policy that match its scope, until it expires.
+// Exceptions only take effect once approved by a different user than the one requesting them.
+type PolicyException struct {
+	ent.Schema
+}
+
+func (PolicyException) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		// Scope of the exception, empty values match everything
+		field.String("policy_name").Optional().Immutable(),
+		field.String("material_name").Optional().Immutable(),
+		// identifier of the finding, i.e CVE-2024-1234
+		field.String("external_id").Optional().Immutable(),
+		field.Text("justification").NotEmpty().Immutable(),
+		// email of the user that requested the exception
+		field.String("created_by").Immutable(),
+		// email of the user that approved the exception
+		field.String("approved_by").Optional(),
+		field.Time("approved_at").Optional(),
+		field.Time("expires_at").Immutable(),
+		field.Time("revoked_at").Optional(),
+		// Set once the expiration has been processed, so it's audited only once
+		field.Time("expiration_processed_at").Optional(),
+
+		// edge fields to be able to access to them directly
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+		field.UUID("project_id", uuid.UUID{}).Immutable().Optional(),
+		field.UUID("workflow_id", uuid.UUID{}).Immutable().Optional(),
+	}
+}
+
+func (PolicyException) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).Field("organization_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("project", Project.Type).Field("project_id").Unique().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("workflow", Workflow.Type).Field("workflow_id").Unique().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (PolicyException) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "created_at").
+			Annotations(entsql.DescColumns("created_at")),
+		// exceptions pending to be marked as expired
+		index.Fields("expires_at").Annotations(
+			entsql.IndexWhere("expiration_processed_at IS NULL"),
+		),
+	}
+}
@@ -0,0 +1,71 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+// PolicyImpactAnalysis is the retroactive evaluation of a policy against stored attestations.
+// It runs in the background and keeps its report once finished.
+type PolicyImpactAnalysis struct {
+	ent.Schema
+}
+
+func (PolicyImpactAnalysis) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Enum("status").
+			GoType(biz.PolicyImpactAnalysisStatus("")).
+			Default(string(biz.PolicyImpactAnalysisRunning)),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		field.Time("finished_at").Optional(),
+		field.JSON("report", &biz.PolicyImpactReport{}).Optional(),
+		// why the analysis failed
+		field.Text("error").Optional(),
+
+		// edge fields to be able to access to them directly
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+	}
+}
+
+func (PolicyImpactAnalysis) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("organization", Organization.Type).Field("organization_id").Unique().Required().Immutable().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (PolicyImpactAnalysis) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "created_at").
+			Annotations(entsql.DescColumns("created_at")),
+		// only one analysis runs at a time in an organization
+		index.Fields("organization_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status = 'running'")),
+	}
+}
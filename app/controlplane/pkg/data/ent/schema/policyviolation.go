@@ -0,0 +1,73 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+// PolicyViolation is a violation raised by a policy evaluation, with its structured finding if any
+type PolicyViolation struct {
+	ent.Schema
+}
+
+func (PolicyViolation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.UUID("policy_evaluation_id", uuid.UUID{}).Immutable(),
+		// Denormalized organization scope, so findings can be looked up without joining the evaluations
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+		field.String("subject").Optional().Immutable(),
+		field.Text("message").Immutable(),
+		// excluded from the policy gate, i.e by an assessment
+		field.Bool("suppressed").Default(false).Immutable(),
+		// policy exception that waived the violation, if any
+		field.String("exception_id").Optional().Immutable(),
+		// Structured finding, if the policy declares a finding type
+		field.Enum("finding_type").GoType(biz.PolicyFindingType("")).Optional().Immutable(),
+		// CRITICAL, HIGH, MEDIUM, LOW
+		field.String("severity").Optional().Immutable(),
+		// identifier of the finding: CVE for vulnerabilities, rule for SAST and secrets, license for license violations,
+		// check for misconfigurations and signature for malware
+		field.String("external_id").Optional().Immutable(),
+		field.String("package_purl").Optional().Immutable(),
+		// the whole finding
+		field.JSON("finding", json.RawMessage{}).Optional().Immutable(),
+	}
+}
+
+func (PolicyViolation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("policy_evaluation", PolicyEvaluation.Type).Field("policy_evaluation_id").Ref("violations").
+			Immutable().Unique().Required(),
+	}
+}
+
+func (PolicyViolation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("policy_evaluation_id"),
+		// where is this CVE found
+		index.Fields("organization_id", "external_id"),
+		index.Fields("organization_id", "finding_type", "severity"),
+	}
+}
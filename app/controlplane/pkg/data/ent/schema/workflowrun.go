@@ -80,6 +80,9 @@ func (WorkflowRun) Fields() []ent.Field {
 		field.Int32("policy_violations_count").Optional().Nillable(),
 		field.Int32("policy_violations_suppressed").Optional().Nillable(),
 		field.Bool("policy_has_gates").Optional().Nillable(),
+		// When the content of the attestation got indexed for search. Unset for the runs
+		// pending to be indexed, either because indexing failed or because they predate the index.
+		field.Time("search_indexed_at").Optional().Nillable(),
 	}
 }
 
@@ -98,6 +101,10 @@ func (WorkflowRun) Edges() []ent.Edge {
 		// not required since we have old data
 		edge.From("version", ProjectVersion.Type).Field("version_id").Ref("runs").Unique().Required(),
 		edge.To("attestation_bundle", Attestation.Type).Unique().Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		// Content of the attestation indexed for search
+		edge.To("search_entries", WorkflowRunSearchEntry.Type).Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		// Policy evaluations extracted from the attestation
+		edge.To("policy_evaluations", PolicyEvaluation.Type).Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 	}
 }
 
@@ -125,5 +132,9 @@ func (WorkflowRun) Indexes() []ent.Index {
 		// the column, and false is the high-cardinality majority where a
 		// seqscan is fine anyway.
 		index.Fields("policy_has_gates").Annotations(entsql.IndexWhere("policy_has_gates = true")),
+		// Search by runner type
+		index.Fields("organization_id", "runner_type", "created_at").Annotations(entsql.DescColumns("created_at")),
+		// Search index backfill, only the attested runs pending to be indexed
+		index.Fields("search_indexed_at").Annotations(entsql.IndexWhere("search_indexed_at IS NULL AND attestation_digest IS NOT NULL")),
 	}
 }
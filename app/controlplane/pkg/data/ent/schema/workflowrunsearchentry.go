@@ -0,0 +1,75 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+// WorkflowRunSearchEntry indexes the content of the attestation of a workflow run
+// (materials, subjects and annotations) so runs can be searched by it without loading the attestations.
+type WorkflowRunSearchEntry struct {
+	ent.Schema
+}
+
+func (WorkflowRunSearchEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(&entsql.Annotation{Default: "CURRENT_TIMESTAMP"}),
+		field.UUID("workflowrun_id", uuid.UUID{}).Immutable(),
+		// Denormalized organization scope, so lookups by digest don't need to join the runs
+		field.UUID("organization_id", uuid.UUID{}).Immutable(),
+		field.Enum("kind").GoType(biz.WorkflowRunSearchEntryKind("")).Immutable(),
+		// material name, subject name or annotation key
+		field.String("name").Immutable(),
+		// material type, i.e SBOM_CYCLONEDX_JSON
+		field.String("type").Optional().Immutable(),
+		// digest of the material or subject, i.e sha256:deadbeef
+		field.String("digest").Optional().Immutable(),
+		// annotation value
+		field.Text("value").Optional().Immutable(),
+	}
+}
+
+func (WorkflowRunSearchEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("workflowrun", WorkflowRun.Type).Field("workflowrun_id").Ref("search_entries").
+			Immutable().Unique().Required(),
+	}
+}
+
+func (WorkflowRunSearchEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workflowrun_id"),
+		// which runs included this artifact
+		index.Fields("organization_id", "kind", "digest"),
+		// which runs included this kind of material
+		index.Fields("organization_id", "kind", "type"),
+		// annotations and subjects by name
+		index.Fields("organization_id", "kind", "name"),
+	}
+}
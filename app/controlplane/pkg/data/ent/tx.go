@@ -18,8 +18,12 @@ type Tx struct {
 	Attestation *AttestationClient
 	// CASBackend is the client for interacting with the CASBackend builders.
 	CASBackend *CASBackendClient
+	// CASBlobDeletion is the client for interacting with the CASBlobDeletion builders.
+	CASBlobDeletion *CASBlobDeletionClient
 	// CASMapping is the client for interacting with the CASMapping builders.
 	CASMapping *CASMappingClient
+	// CASRetentionRule is the client for interacting with the CASRetentionRule builders.
+	CASRetentionRule *CASRetentionRuleClient
 	// Group is the client for interacting with the Group builders.
 	Group *GroupClient
 	// GroupMembership is the client for interacting with the GroupMembership builders.
@@ -28,12 +32,22 @@ type Tx struct {
 	Integration *IntegrationClient
 	// IntegrationAttachment is the client for interacting with the IntegrationAttachment builders.
 	IntegrationAttachment *IntegrationAttachmentClient
+	// IntegrationDelivery is the client for interacting with the IntegrationDelivery builders.
+	IntegrationDelivery *IntegrationDeliveryClient
 	// Membership is the client for interacting with the Membership builders.
 	Membership *MembershipClient
 	// OrgInvitation is the client for interacting with the OrgInvitation builders.
 	OrgInvitation *OrgInvitationClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// PolicyEvaluation is the client for interacting with the PolicyEvaluation builders.
+	PolicyEvaluation *PolicyEvaluationClient
+	// PolicyException is the client for interacting with the PolicyException builders.
+	PolicyException *PolicyExceptionClient
+	// PolicyImpactAnalysis is the client for interacting with the PolicyImpactAnalysis builders.
+	PolicyImpactAnalysis *PolicyImpactAnalysisClient
+	// PolicyViolation is the client for interacting with the PolicyViolation builders.
+	PolicyViolation *PolicyViolationClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
 	// ProjectVersion is the client for interacting with the ProjectVersion builders.
@@ -52,6 +66,8 @@ type Tx struct {
 	WorkflowContractVersion *WorkflowContractVersionClient
 	// WorkflowRun is the client for interacting with the WorkflowRun builders.
 	WorkflowRun *WorkflowRunClient
+	// WorkflowRunSearchEntry is the client for interacting with the WorkflowRunSearchEntry builders.
+	WorkflowRunSearchEntry *WorkflowRunSearchEntryClient
 
 	// lazily loaded.
 	client     *Client
@@ -186,14 +202,21 @@ func (tx *Tx) init() {
 	tx.APIToken = NewAPITokenClient(tx.config)
 	tx.Attestation = NewAttestationClient(tx.config)
 	tx.CASBackend = NewCASBackendClient(tx.config)
+	tx.CASBlobDeletion = NewCASBlobDeletionClient(tx.config)
 	tx.CASMapping = NewCASMappingClient(tx.config)
+	tx.CASRetentionRule = NewCASRetentionRuleClient(tx.config)
 	tx.Group = NewGroupClient(tx.config)
 	tx.GroupMembership = NewGroupMembershipClient(tx.config)
 	tx.Integration = NewIntegrationClient(tx.config)
 	tx.IntegrationAttachment = NewIntegrationAttachmentClient(tx.config)
+	tx.IntegrationDelivery = NewIntegrationDeliveryClient(tx.config)
 	tx.Membership = NewMembershipClient(tx.config)
 	tx.OrgInvitation = NewOrgInvitationClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
+	tx.PolicyEvaluation = NewPolicyEvaluationClient(tx.config)
+	tx.PolicyException = NewPolicyExceptionClient(tx.config)
+	tx.PolicyImpactAnalysis = NewPolicyImpactAnalysisClient(tx.config)
+	tx.PolicyViolation = NewPolicyViolationClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
 	tx.ProjectVersion = NewProjectVersionClient(tx.config)
 	tx.Referrer = NewReferrerClient(tx.config)
@@ -203,6 +226,7 @@ func (tx *Tx) init() {
 	tx.WorkflowContract = NewWorkflowContractClient(tx.config)
 	tx.WorkflowContractVersion = NewWorkflowContractVersionClient(tx.config)
 	tx.WorkflowRun = NewWorkflowRunClient(tx.config)
+	tx.WorkflowRunSearchEntry = NewWorkflowRunSearchEntryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
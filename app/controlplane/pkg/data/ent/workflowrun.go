@@ -70,6 +70,8 @@ type WorkflowRun struct {
 	PolicyViolationsSuppressed *int32 `json:"policy_violations_suppressed,omitempty"`
 	// PolicyHasGates holds the value of the "policy_has_gates" field.
 	PolicyHasGates *bool `json:"policy_has_gates,omitempty"`
+	// SearchIndexedAt holds the value of the "search_indexed_at" field.
+	SearchIndexedAt *time.Time `json:"search_indexed_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the WorkflowRunQuery when eager-loading is set.
 	Edges                         WorkflowRunEdges `json:"edges"`
@@ -91,9 +93,13 @@ type WorkflowRunEdges struct {
 	Version *ProjectVersion `json:"version,omitempty"`
 	// AttestationBundle holds the value of the attestation_bundle edge.
 	AttestationBundle *Attestation `json:"attestation_bundle,omitempty"`
+	// SearchEntries holds the value of the search_entries edge.
+	SearchEntries []*WorkflowRunSearchEntry `json:"search_entries,omitempty"`
+	// PolicyEvaluations holds the value of the policy_evaluations edge.
+	PolicyEvaluations []*PolicyEvaluation `json:"policy_evaluations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [8]bool
 }
 
 // WorkflowOrErr returns the Workflow value or an error if the edge
@@ -160,6 +166,24 @@ func (e WorkflowRunEdges) AttestationBundleOrErr() (*Attestation, error) {
 	return nil, &NotLoadedError{edge: "attestation_bundle"}
 }
 
+// SearchEntriesOrErr returns the SearchEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowRunEdges) SearchEntriesOrErr() ([]*WorkflowRunSearchEntry, error) {
+	if e.loadedTypes[6] {
+		return e.SearchEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "search_entries"}
+}
+
+// PolicyEvaluationsOrErr returns the PolicyEvaluations value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowRunEdges) PolicyEvaluationsOrErr() ([]*PolicyEvaluation, error) {
+	if e.loadedTypes[7] {
+		return e.PolicyEvaluations, nil
+	}
+	return nil, &NotLoadedError{edge: "policy_evaluations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*WorkflowRun) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -173,7 +197,7 @@ func (*WorkflowRun) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case workflowrun.FieldState, workflowrun.FieldReason, workflowrun.FieldRunURL, workflowrun.FieldRunnerType, workflowrun.FieldAttestationDigest, workflowrun.FieldPolicyStatus:
 			values[i] = new(sql.NullString)
-		case workflowrun.FieldCreatedAt, workflowrun.FieldFinishedAt:
+		case workflowrun.FieldCreatedAt, workflowrun.FieldFinishedAt, workflowrun.FieldSearchIndexedAt:
 			values[i] = new(sql.NullTime)
 		case workflowrun.FieldID, workflowrun.FieldVersionID, workflowrun.FieldWorkflowID, workflowrun.FieldOrganizationID:
 			values[i] = new(uuid.UUID)
@@ -342,6 +366,13 @@ func (_m *WorkflowRun) assignValues(columns []string, values []any) error {
 				_m.PolicyHasGates = new(bool)
 				*_m.PolicyHasGates = value.Bool
 			}
+		case workflowrun.FieldSearchIndexedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field search_indexed_at", values[i])
+			} else if value.Valid {
+				_m.SearchIndexedAt = new(time.Time)
+				*_m.SearchIndexedAt = value.Time
+			}
 		case workflowrun.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field workflow_run_contract_version", values[i])
@@ -392,6 +423,16 @@ func (_m *WorkflowRun) QueryAttestationBundle() *AttestationQuery {
 	return NewWorkflowRunClient(_m.config).QueryAttestationBundle(_m)
 }
 
+// QuerySearchEntries queries the "search_entries" edge of the WorkflowRun entity.
+func (_m *WorkflowRun) QuerySearchEntries() *WorkflowRunSearchEntryQuery {
+	return NewWorkflowRunClient(_m.config).QuerySearchEntries(_m)
+}
+
+// QueryPolicyEvaluations queries the "policy_evaluations" edge of the WorkflowRun entity.
+func (_m *WorkflowRun) QueryPolicyEvaluations() *PolicyEvaluationQuery {
+	return NewWorkflowRunClient(_m.config).QueryPolicyEvaluations(_m)
+}
+
 // Update returns a builder for updating this WorkflowRun.
 // Note that you need to call WorkflowRun.Unwrap() before calling this method if this WorkflowRun
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -496,6 +537,11 @@ func (_m *WorkflowRun) String() string {
 		builder.WriteString("policy_has_gates=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.SearchIndexedAt; v != nil {
+		builder.WriteString("search_indexed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -152,6 +152,11 @@ func PolicyHasGates(v bool) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.FieldEQ(FieldPolicyHasGates, v))
 }
 
+// SearchIndexedAt applies equality check predicate on the "search_indexed_at" field. It's identical to SearchIndexedAtEQ.
+func SearchIndexedAt(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldSearchIndexedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.FieldEQ(FieldCreatedAt, v))
@@ -1092,6 +1097,56 @@ func PolicyHasGatesNotNil() predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.FieldNotNull(FieldPolicyHasGates))
 }
 
+// SearchIndexedAtEQ applies the EQ predicate on the "search_indexed_at" field.
+func SearchIndexedAtEQ(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldEQ(FieldSearchIndexedAt, v))
+}
+
+// SearchIndexedAtNEQ applies the NEQ predicate on the "search_indexed_at" field.
+func SearchIndexedAtNEQ(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNEQ(FieldSearchIndexedAt, v))
+}
+
+// SearchIndexedAtIn applies the In predicate on the "search_indexed_at" field.
+func SearchIndexedAtIn(vs ...time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldIn(FieldSearchIndexedAt, vs...))
+}
+
+// SearchIndexedAtNotIn applies the NotIn predicate on the "search_indexed_at" field.
+func SearchIndexedAtNotIn(vs ...time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNotIn(FieldSearchIndexedAt, vs...))
+}
+
+// SearchIndexedAtGT applies the GT predicate on the "search_indexed_at" field.
+func SearchIndexedAtGT(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGT(FieldSearchIndexedAt, v))
+}
+
+// SearchIndexedAtGTE applies the GTE predicate on the "search_indexed_at" field.
+func SearchIndexedAtGTE(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldGTE(FieldSearchIndexedAt, v))
+}
+
+// SearchIndexedAtLT applies the LT predicate on the "search_indexed_at" field.
+func SearchIndexedAtLT(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLT(FieldSearchIndexedAt, v))
+}
+
+// SearchIndexedAtLTE applies the LTE predicate on the "search_indexed_at" field.
+func SearchIndexedAtLTE(v time.Time) predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldLTE(FieldSearchIndexedAt, v))
+}
+
+// SearchIndexedAtIsNil applies the IsNil predicate on the "search_indexed_at" field.
+func SearchIndexedAtIsNil() predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldIsNull(FieldSearchIndexedAt))
+}
+
+// SearchIndexedAtNotNil applies the NotNil predicate on the "search_indexed_at" field.
+func SearchIndexedAtNotNil() predicate.WorkflowRun {
+	return predicate.WorkflowRun(sql.FieldNotNull(FieldSearchIndexedAt))
+}
+
 // HasWorkflow applies the HasEdge predicate on the "workflow" edge.
 func HasWorkflow() predicate.WorkflowRun {
 	return predicate.WorkflowRun(func(s *sql.Selector) {
@@ -1230,6 +1285,52 @@ func HasAttestationBundleWith(preds ...predicate.Attestation) predicate.Workflow
 	})
 }
 
+// HasSearchEntries applies the HasEdge predicate on the "search_entries" edge.
+func HasSearchEntries() predicate.WorkflowRun {
+	return predicate.WorkflowRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SearchEntriesTable, SearchEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSearchEntriesWith applies the HasEdge predicate on the "search_entries" edge with a given conditions (other predicates).
+func HasSearchEntriesWith(preds ...predicate.WorkflowRunSearchEntry) predicate.WorkflowRun {
+	return predicate.WorkflowRun(func(s *sql.Selector) {
+		step := newSearchEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPolicyEvaluations applies the HasEdge predicate on the "policy_evaluations" edge.
+func HasPolicyEvaluations() predicate.WorkflowRun {
+	return predicate.WorkflowRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PolicyEvaluationsTable, PolicyEvaluationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPolicyEvaluationsWith applies the HasEdge predicate on the "policy_evaluations" edge with a given conditions (other predicates).
+func HasPolicyEvaluationsWith(preds ...predicate.PolicyEvaluation) predicate.WorkflowRun {
+	return predicate.WorkflowRun(func(s *sql.Selector) {
+		step := newPolicyEvaluationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.WorkflowRun) predicate.WorkflowRun {
 	return predicate.WorkflowRun(sql.AndPredicates(predicates...))
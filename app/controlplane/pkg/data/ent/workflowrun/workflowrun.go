@@ -61,6 +61,8 @@ const (
 	FieldPolicyViolationsSuppressed = "policy_violations_suppressed"
 	// FieldPolicyHasGates holds the string denoting the policy_has_gates field in the database.
 	FieldPolicyHasGates = "policy_has_gates"
+	// FieldSearchIndexedAt holds the string denoting the search_indexed_at field in the database.
+	FieldSearchIndexedAt = "search_indexed_at"
 	// EdgeWorkflow holds the string denoting the workflow edge name in mutations.
 	EdgeWorkflow = "workflow"
 	// EdgeOrganization holds the string denoting the organization edge name in mutations.
@@ -73,6 +75,10 @@ const (
 	EdgeVersion = "version"
 	// EdgeAttestationBundle holds the string denoting the attestation_bundle edge name in mutations.
 	EdgeAttestationBundle = "attestation_bundle"
+	// EdgeSearchEntries holds the string denoting the search_entries edge name in mutations.
+	EdgeSearchEntries = "search_entries"
+	// EdgePolicyEvaluations holds the string denoting the policy_evaluations edge name in mutations.
+	EdgePolicyEvaluations = "policy_evaluations"
 	// Table holds the table name of the workflowrun in the database.
 	Table = "workflow_runs"
 	// WorkflowTable is the table that holds the workflow relation/edge.
@@ -115,6 +121,20 @@ const (
 	AttestationBundleInverseTable = "attestations"
 	// AttestationBundleColumn is the table column denoting the attestation_bundle relation/edge.
 	AttestationBundleColumn = "workflowrun_id"
+	// SearchEntriesTable is the table that holds the search_entries relation/edge.
+	SearchEntriesTable = "workflow_run_search_entries"
+	// SearchEntriesInverseTable is the table name for the WorkflowRunSearchEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowrunsearchentry" package.
+	SearchEntriesInverseTable = "workflow_run_search_entries"
+	// SearchEntriesColumn is the table column denoting the search_entries relation/edge.
+	SearchEntriesColumn = "workflowrun_id"
+	// PolicyEvaluationsTable is the table that holds the policy_evaluations relation/edge.
+	PolicyEvaluationsTable = "policy_evaluations"
+	// PolicyEvaluationsInverseTable is the table name for the PolicyEvaluation entity.
+	// It exists in this package in order to avoid circular dependency with the "policyevaluation" package.
+	PolicyEvaluationsInverseTable = "policy_evaluations"
+	// PolicyEvaluationsColumn is the table column denoting the policy_evaluations relation/edge.
+	PolicyEvaluationsColumn = "workflowrun_id"
 )
 
 // Columns holds all SQL columns for workflowrun fields.
@@ -142,6 +162,7 @@ var Columns = []string{
 	FieldPolicyViolationsCount,
 	FieldPolicyViolationsSuppressed,
 	FieldPolicyHasGates,
+	FieldSearchIndexedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "workflow_runs"
@@ -325,6 +346,11 @@ func ByPolicyHasGates(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPolicyHasGates, opts...).ToFunc()
 }
 
+// BySearchIndexedAt orders the results by the search_indexed_at field.
+func BySearchIndexedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchIndexedAt, opts...).ToFunc()
+}
+
 // ByWorkflowField orders the results by workflow field.
 func ByWorkflowField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -373,6 +399,34 @@ func ByAttestationBundleField(field string, opts ...sql.OrderTermOption) OrderOp
 		sqlgraph.OrderByNeighborTerms(s, newAttestationBundleStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySearchEntriesCount orders the results by search_entries count.
+func BySearchEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSearchEntriesStep(), opts...)
+	}
+}
+
+// BySearchEntries orders the results by search_entries terms.
+func BySearchEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSearchEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPolicyEvaluationsCount orders the results by policy_evaluations count.
+func ByPolicyEvaluationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPolicyEvaluationsStep(), opts...)
+	}
+}
+
+// ByPolicyEvaluations orders the results by policy_evaluations terms.
+func ByPolicyEvaluations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPolicyEvaluationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newWorkflowStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -415,3 +469,17 @@ func newAttestationBundleStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, AttestationBundleTable, AttestationBundleColumn),
 	)
 }
+func newSearchEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SearchEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SearchEntriesTable, SearchEntriesColumn),
+	)
+}
+func newPolicyEvaluationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PolicyEvaluationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PolicyEvaluationsTable, PolicyEvaluationsColumn),
+	)
+}
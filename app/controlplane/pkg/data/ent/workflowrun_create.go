@@ -16,10 +16,12 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/attestation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontractversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 	"github.com/google/uuid"
 	"github.com/secure-systems-lab/go-securesystemslib/dsse"
 )
@@ -284,6 +286,20 @@ func (_c *WorkflowRunCreate) SetNillablePolicyHasGates(v *bool) *WorkflowRunCrea
 	return _c
 }
 
+// SetSearchIndexedAt sets the "search_indexed_at" field.
+func (_c *WorkflowRunCreate) SetSearchIndexedAt(v time.Time) *WorkflowRunCreate {
+	_c.mutation.SetSearchIndexedAt(v)
+	return _c
+}
+
+// SetNillableSearchIndexedAt sets the "search_indexed_at" field if the given value is not nil.
+func (_c *WorkflowRunCreate) SetNillableSearchIndexedAt(v *time.Time) *WorkflowRunCreate {
+	if v != nil {
+		_c.SetSearchIndexedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *WorkflowRunCreate) SetID(v uuid.UUID) *WorkflowRunCreate {
 	_c.mutation.SetID(v)
@@ -366,6 +382,36 @@ func (_c *WorkflowRunCreate) SetAttestationBundle(v *Attestation) *WorkflowRunCr
 	return _c.SetAttestationBundleID(v.ID)
 }
 
+// AddSearchEntryIDs adds the "search_entries" edge to the WorkflowRunSearchEntry entity by IDs.
+func (_c *WorkflowRunCreate) AddSearchEntryIDs(ids ...uuid.UUID) *WorkflowRunCreate {
+	_c.mutation.AddSearchEntryIDs(ids...)
+	return _c
+}
+
+// AddSearchEntries adds the "search_entries" edges to the WorkflowRunSearchEntry entity.
+func (_c *WorkflowRunCreate) AddSearchEntries(v ...*WorkflowRunSearchEntry) *WorkflowRunCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSearchEntryIDs(ids...)
+}
+
+// AddPolicyEvaluationIDs adds the "policy_evaluations" edge to the PolicyEvaluation entity by IDs.
+func (_c *WorkflowRunCreate) AddPolicyEvaluationIDs(ids ...uuid.UUID) *WorkflowRunCreate {
+	_c.mutation.AddPolicyEvaluationIDs(ids...)
+	return _c
+}
+
+// AddPolicyEvaluations adds the "policy_evaluations" edges to the PolicyEvaluation entity.
+func (_c *WorkflowRunCreate) AddPolicyEvaluations(v ...*PolicyEvaluation) *WorkflowRunCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPolicyEvaluationIDs(ids...)
+}
+
 // Mutation returns the WorkflowRunMutation object of the builder.
 func (_c *WorkflowRunCreate) Mutation() *WorkflowRunMutation {
 	return _c.mutation
@@ -569,6 +615,10 @@ func (_c *WorkflowRunCreate) createSpec() (*WorkflowRun, *sqlgraph.CreateSpec) {
 		_spec.SetField(workflowrun.FieldPolicyHasGates, field.TypeBool, value)
 		_node.PolicyHasGates = &value
 	}
+	if value, ok := _c.mutation.SearchIndexedAt(); ok {
+		_spec.SetField(workflowrun.FieldSearchIndexedAt, field.TypeTime, value)
+		_node.SearchIndexedAt = &value
+	}
 	if nodes := _c.mutation.WorkflowIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -669,6 +719,38 @@ func (_c *WorkflowRunCreate) createSpec() (*WorkflowRun, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SearchEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PolicyEvaluationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -1081,6 +1163,24 @@ func (u *WorkflowRunUpsert) ClearPolicyHasGates() *WorkflowRunUpsert {
 	return u
 }
 
+// SetSearchIndexedAt sets the "search_indexed_at" field.
+func (u *WorkflowRunUpsert) SetSearchIndexedAt(v time.Time) *WorkflowRunUpsert {
+	u.Set(workflowrun.FieldSearchIndexedAt, v)
+	return u
+}
+
+// UpdateSearchIndexedAt sets the "search_indexed_at" field to the value that was provided on create.
+func (u *WorkflowRunUpsert) UpdateSearchIndexedAt() *WorkflowRunUpsert {
+	u.SetExcluded(workflowrun.FieldSearchIndexedAt)
+	return u
+}
+
+// ClearSearchIndexedAt clears the value of the "search_indexed_at" field.
+func (u *WorkflowRunUpsert) ClearSearchIndexedAt() *WorkflowRunUpsert {
+	u.SetNull(workflowrun.FieldSearchIndexedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1558,6 +1658,27 @@ func (u *WorkflowRunUpsertOne) ClearPolicyHasGates() *WorkflowRunUpsertOne {
 	})
 }
 
+// SetSearchIndexedAt sets the "search_indexed_at" field.
+func (u *WorkflowRunUpsertOne) SetSearchIndexedAt(v time.Time) *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetSearchIndexedAt(v)
+	})
+}
+
+// UpdateSearchIndexedAt sets the "search_indexed_at" field to the value that was provided on create.
+func (u *WorkflowRunUpsertOne) UpdateSearchIndexedAt() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateSearchIndexedAt()
+	})
+}
+
+// ClearSearchIndexedAt clears the value of the "search_indexed_at" field.
+func (u *WorkflowRunUpsertOne) ClearSearchIndexedAt() *WorkflowRunUpsertOne {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearSearchIndexedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *WorkflowRunUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -2202,6 +2323,27 @@ func (u *WorkflowRunUpsertBulk) ClearPolicyHasGates() *WorkflowRunUpsertBulk {
 	})
 }
 
+// SetSearchIndexedAt sets the "search_indexed_at" field.
+func (u *WorkflowRunUpsertBulk) SetSearchIndexedAt(v time.Time) *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.SetSearchIndexedAt(v)
+	})
+}
+
+// UpdateSearchIndexedAt sets the "search_indexed_at" field to the value that was provided on create.
+func (u *WorkflowRunUpsertBulk) UpdateSearchIndexedAt() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.UpdateSearchIndexedAt()
+	})
+}
+
+// ClearSearchIndexedAt clears the value of the "search_indexed_at" field.
+func (u *WorkflowRunUpsertBulk) ClearSearchIndexedAt() *WorkflowRunUpsertBulk {
+	return u.Update(func(s *WorkflowRunUpsert) {
+		s.ClearSearchIndexedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *WorkflowRunUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
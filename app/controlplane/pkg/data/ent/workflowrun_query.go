@@ -16,11 +16,13 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/attestation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontractversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 	"github.com/google/uuid"
 )
 
@@ -37,6 +39,8 @@ type WorkflowRunQuery struct {
 	withCasBackends       *CASBackendQuery
 	withVersion           *ProjectVersionQuery
 	withAttestationBundle *AttestationQuery
+	withSearchEntries     *WorkflowRunSearchEntryQuery
+	withPolicyEvaluations *PolicyEvaluationQuery
 	withFKs               bool
 	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -207,6 +211,50 @@ func (_q *WorkflowRunQuery) QueryAttestationBundle() *AttestationQuery {
 	return query
 }
 
+// QuerySearchEntries chains the current query on the "search_entries" edge.
+func (_q *WorkflowRunQuery) QuerySearchEntries() *WorkflowRunSearchEntryQuery {
+	query := (&WorkflowRunSearchEntryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrun.Table, workflowrun.FieldID, selector),
+			sqlgraph.To(workflowrunsearchentry.Table, workflowrunsearchentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowrun.SearchEntriesTable, workflowrun.SearchEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPolicyEvaluations chains the current query on the "policy_evaluations" edge.
+func (_q *WorkflowRunQuery) QueryPolicyEvaluations() *PolicyEvaluationQuery {
+	query := (&PolicyEvaluationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowrun.Table, workflowrun.FieldID, selector),
+			sqlgraph.To(policyevaluation.Table, policyevaluation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowrun.PolicyEvaluationsTable, workflowrun.PolicyEvaluationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first WorkflowRun entity from the query.
 // Returns a *NotFoundError when no WorkflowRun was found.
 func (_q *WorkflowRunQuery) First(ctx context.Context) (*WorkflowRun, error) {
@@ -405,6 +453,8 @@ func (_q *WorkflowRunQuery) Clone() *WorkflowRunQuery {
 		withCasBackends:       _q.withCasBackends.Clone(),
 		withVersion:           _q.withVersion.Clone(),
 		withAttestationBundle: _q.withAttestationBundle.Clone(),
+		withSearchEntries:     _q.withSearchEntries.Clone(),
+		withPolicyEvaluations: _q.withPolicyEvaluations.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -478,6 +528,28 @@ func (_q *WorkflowRunQuery) WithAttestationBundle(opts ...func(*AttestationQuery
 	return _q
 }
 
+// WithSearchEntries tells the query-builder to eager-load the nodes that are connected to
+// the "search_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkflowRunQuery) WithSearchEntries(opts ...func(*WorkflowRunSearchEntryQuery)) *WorkflowRunQuery {
+	query := (&WorkflowRunSearchEntryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSearchEntries = query
+	return _q
+}
+
+// WithPolicyEvaluations tells the query-builder to eager-load the nodes that are connected to
+// the "policy_evaluations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WorkflowRunQuery) WithPolicyEvaluations(opts ...func(*PolicyEvaluationQuery)) *WorkflowRunQuery {
+	query := (&PolicyEvaluationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPolicyEvaluations = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -557,13 +629,15 @@ func (_q *WorkflowRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 		nodes       = []*WorkflowRun{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [8]bool{
 			_q.withWorkflow != nil,
 			_q.withOrganization != nil,
 			_q.withContractVersion != nil,
 			_q.withCasBackends != nil,
 			_q.withVersion != nil,
 			_q.withAttestationBundle != nil,
+			_q.withSearchEntries != nil,
+			_q.withPolicyEvaluations != nil,
 		}
 	)
 	if _q.withContractVersion != nil {
@@ -630,6 +704,24 @@ func (_q *WorkflowRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withSearchEntries; query != nil {
+		if err := _q.loadSearchEntries(ctx, query, nodes,
+			func(n *WorkflowRun) { n.Edges.SearchEntries = []*WorkflowRunSearchEntry{} },
+			func(n *WorkflowRun, e *WorkflowRunSearchEntry) {
+				n.Edges.SearchEntries = append(n.Edges.SearchEntries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withPolicyEvaluations; query != nil {
+		if err := _q.loadPolicyEvaluations(ctx, query, nodes,
+			func(n *WorkflowRun) { n.Edges.PolicyEvaluations = []*PolicyEvaluation{} },
+			func(n *WorkflowRun, e *PolicyEvaluation) {
+				n.Edges.PolicyEvaluations = append(n.Edges.PolicyEvaluations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -840,6 +932,66 @@ func (_q *WorkflowRunQuery) loadAttestationBundle(ctx context.Context, query *At
 	}
 	return nil
 }
+func (_q *WorkflowRunQuery) loadSearchEntries(ctx context.Context, query *WorkflowRunSearchEntryQuery, nodes []*WorkflowRun, init func(*WorkflowRun), assign func(*WorkflowRun, *WorkflowRunSearchEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*WorkflowRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workflowrunsearchentry.FieldWorkflowrunID)
+	}
+	query.Where(predicate.WorkflowRunSearchEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workflowrun.SearchEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkflowrunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workflowrun_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WorkflowRunQuery) loadPolicyEvaluations(ctx context.Context, query *PolicyEvaluationQuery, nodes []*WorkflowRun, init func(*WorkflowRun), assign func(*WorkflowRun, *PolicyEvaluation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*WorkflowRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(policyevaluation.FieldWorkflowrunID)
+	}
+	query.Where(predicate.PolicyEvaluation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workflowrun.PolicyEvaluationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WorkflowrunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "workflowrun_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *WorkflowRunQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
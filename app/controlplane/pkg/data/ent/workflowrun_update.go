@@ -14,10 +14,12 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/attestation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/casbackend"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowcontractversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 	"github.com/google/uuid"
 	"github.com/secure-systems-lab/go-securesystemslib/dsse"
 )
@@ -425,6 +427,26 @@ func (_u *WorkflowRunUpdate) ClearPolicyHasGates() *WorkflowRunUpdate {
 	return _u
 }
 
+// SetSearchIndexedAt sets the "search_indexed_at" field.
+func (_u *WorkflowRunUpdate) SetSearchIndexedAt(v time.Time) *WorkflowRunUpdate {
+	_u.mutation.SetSearchIndexedAt(v)
+	return _u
+}
+
+// SetNillableSearchIndexedAt sets the "search_indexed_at" field if the given value is not nil.
+func (_u *WorkflowRunUpdate) SetNillableSearchIndexedAt(v *time.Time) *WorkflowRunUpdate {
+	if v != nil {
+		_u.SetSearchIndexedAt(*v)
+	}
+	return _u
+}
+
+// ClearSearchIndexedAt clears the value of the "search_indexed_at" field.
+func (_u *WorkflowRunUpdate) ClearSearchIndexedAt() *WorkflowRunUpdate {
+	_u.mutation.ClearSearchIndexedAt()
+	return _u
+}
+
 // SetContractVersionID sets the "contract_version" edge to the WorkflowContractVersion entity by ID.
 func (_u *WorkflowRunUpdate) SetContractVersionID(id uuid.UUID) *WorkflowRunUpdate {
 	_u.mutation.SetContractVersionID(id)
@@ -483,6 +505,36 @@ func (_u *WorkflowRunUpdate) SetAttestationBundle(v *Attestation) *WorkflowRunUp
 	return _u.SetAttestationBundleID(v.ID)
 }
 
+// AddSearchEntryIDs adds the "search_entries" edge to the WorkflowRunSearchEntry entity by IDs.
+func (_u *WorkflowRunUpdate) AddSearchEntryIDs(ids ...uuid.UUID) *WorkflowRunUpdate {
+	_u.mutation.AddSearchEntryIDs(ids...)
+	return _u
+}
+
+// AddSearchEntries adds the "search_entries" edges to the WorkflowRunSearchEntry entity.
+func (_u *WorkflowRunUpdate) AddSearchEntries(v ...*WorkflowRunSearchEntry) *WorkflowRunUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSearchEntryIDs(ids...)
+}
+
+// AddPolicyEvaluationIDs adds the "policy_evaluations" edge to the PolicyEvaluation entity by IDs.
+func (_u *WorkflowRunUpdate) AddPolicyEvaluationIDs(ids ...uuid.UUID) *WorkflowRunUpdate {
+	_u.mutation.AddPolicyEvaluationIDs(ids...)
+	return _u
+}
+
+// AddPolicyEvaluations adds the "policy_evaluations" edges to the PolicyEvaluation entity.
+func (_u *WorkflowRunUpdate) AddPolicyEvaluations(v ...*PolicyEvaluation) *WorkflowRunUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPolicyEvaluationIDs(ids...)
+}
+
 // Mutation returns the WorkflowRunMutation object of the builder.
 func (_u *WorkflowRunUpdate) Mutation() *WorkflowRunMutation {
 	return _u.mutation
@@ -527,6 +579,48 @@ func (_u *WorkflowRunUpdate) ClearAttestationBundle() *WorkflowRunUpdate {
 	return _u
 }
 
+// ClearSearchEntries clears all "search_entries" edges to the WorkflowRunSearchEntry entity.
+func (_u *WorkflowRunUpdate) ClearSearchEntries() *WorkflowRunUpdate {
+	_u.mutation.ClearSearchEntries()
+	return _u
+}
+
+// RemoveSearchEntryIDs removes the "search_entries" edge to WorkflowRunSearchEntry entities by IDs.
+func (_u *WorkflowRunUpdate) RemoveSearchEntryIDs(ids ...uuid.UUID) *WorkflowRunUpdate {
+	_u.mutation.RemoveSearchEntryIDs(ids...)
+	return _u
+}
+
+// RemoveSearchEntries removes "search_entries" edges to WorkflowRunSearchEntry entities.
+func (_u *WorkflowRunUpdate) RemoveSearchEntries(v ...*WorkflowRunSearchEntry) *WorkflowRunUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSearchEntryIDs(ids...)
+}
+
+// ClearPolicyEvaluations clears all "policy_evaluations" edges to the PolicyEvaluation entity.
+func (_u *WorkflowRunUpdate) ClearPolicyEvaluations() *WorkflowRunUpdate {
+	_u.mutation.ClearPolicyEvaluations()
+	return _u
+}
+
+// RemovePolicyEvaluationIDs removes the "policy_evaluations" edge to PolicyEvaluation entities by IDs.
+func (_u *WorkflowRunUpdate) RemovePolicyEvaluationIDs(ids ...uuid.UUID) *WorkflowRunUpdate {
+	_u.mutation.RemovePolicyEvaluationIDs(ids...)
+	return _u
+}
+
+// RemovePolicyEvaluations removes "policy_evaluations" edges to PolicyEvaluation entities.
+func (_u *WorkflowRunUpdate) RemovePolicyEvaluations(v ...*PolicyEvaluation) *WorkflowRunUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePolicyEvaluationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WorkflowRunUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -716,6 +810,12 @@ func (_u *WorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.PolicyHasGatesCleared() {
 		_spec.ClearField(workflowrun.FieldPolicyHasGates, field.TypeBool)
 	}
+	if value, ok := _u.mutation.SearchIndexedAt(); ok {
+		_spec.SetField(workflowrun.FieldSearchIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SearchIndexedAtCleared() {
+		_spec.ClearField(workflowrun.FieldSearchIndexedAt, field.TypeTime)
+	}
 	if _u.mutation.ContractVersionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -848,6 +948,96 @@ func (_u *WorkflowRunUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SearchEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSearchEntriesIDs(); len(nodes) > 0 && !_u.mutation.SearchEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SearchEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PolicyEvaluationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPolicyEvaluationsIDs(); len(nodes) > 0 && !_u.mutation.PolicyEvaluationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PolicyEvaluationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -1259,6 +1449,26 @@ func (_u *WorkflowRunUpdateOne) ClearPolicyHasGates() *WorkflowRunUpdateOne {
 	return _u
 }
 
+// SetSearchIndexedAt sets the "search_indexed_at" field.
+func (_u *WorkflowRunUpdateOne) SetSearchIndexedAt(v time.Time) *WorkflowRunUpdateOne {
+	_u.mutation.SetSearchIndexedAt(v)
+	return _u
+}
+
+// SetNillableSearchIndexedAt sets the "search_indexed_at" field if the given value is not nil.
+func (_u *WorkflowRunUpdateOne) SetNillableSearchIndexedAt(v *time.Time) *WorkflowRunUpdateOne {
+	if v != nil {
+		_u.SetSearchIndexedAt(*v)
+	}
+	return _u
+}
+
+// ClearSearchIndexedAt clears the value of the "search_indexed_at" field.
+func (_u *WorkflowRunUpdateOne) ClearSearchIndexedAt() *WorkflowRunUpdateOne {
+	_u.mutation.ClearSearchIndexedAt()
+	return _u
+}
+
 // SetContractVersionID sets the "contract_version" edge to the WorkflowContractVersion entity by ID.
 func (_u *WorkflowRunUpdateOne) SetContractVersionID(id uuid.UUID) *WorkflowRunUpdateOne {
 	_u.mutation.SetContractVersionID(id)
@@ -1317,6 +1527,36 @@ func (_u *WorkflowRunUpdateOne) SetAttestationBundle(v *Attestation) *WorkflowRu
 	return _u.SetAttestationBundleID(v.ID)
 }
 
+// AddSearchEntryIDs adds the "search_entries" edge to the WorkflowRunSearchEntry entity by IDs.
+func (_u *WorkflowRunUpdateOne) AddSearchEntryIDs(ids ...uuid.UUID) *WorkflowRunUpdateOne {
+	_u.mutation.AddSearchEntryIDs(ids...)
+	return _u
+}
+
+// AddSearchEntries adds the "search_entries" edges to the WorkflowRunSearchEntry entity.
+func (_u *WorkflowRunUpdateOne) AddSearchEntries(v ...*WorkflowRunSearchEntry) *WorkflowRunUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSearchEntryIDs(ids...)
+}
+
+// AddPolicyEvaluationIDs adds the "policy_evaluations" edge to the PolicyEvaluation entity by IDs.
+func (_u *WorkflowRunUpdateOne) AddPolicyEvaluationIDs(ids ...uuid.UUID) *WorkflowRunUpdateOne {
+	_u.mutation.AddPolicyEvaluationIDs(ids...)
+	return _u
+}
+
+// AddPolicyEvaluations adds the "policy_evaluations" edges to the PolicyEvaluation entity.
+func (_u *WorkflowRunUpdateOne) AddPolicyEvaluations(v ...*PolicyEvaluation) *WorkflowRunUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPolicyEvaluationIDs(ids...)
+}
+
 // Mutation returns the WorkflowRunMutation object of the builder.
 func (_u *WorkflowRunUpdateOne) Mutation() *WorkflowRunMutation {
 	return _u.mutation
@@ -1361,6 +1601,48 @@ func (_u *WorkflowRunUpdateOne) ClearAttestationBundle() *WorkflowRunUpdateOne {
 	return _u
 }
 
+// ClearSearchEntries clears all "search_entries" edges to the WorkflowRunSearchEntry entity.
+func (_u *WorkflowRunUpdateOne) ClearSearchEntries() *WorkflowRunUpdateOne {
+	_u.mutation.ClearSearchEntries()
+	return _u
+}
+
+// RemoveSearchEntryIDs removes the "search_entries" edge to WorkflowRunSearchEntry entities by IDs.
+func (_u *WorkflowRunUpdateOne) RemoveSearchEntryIDs(ids ...uuid.UUID) *WorkflowRunUpdateOne {
+	_u.mutation.RemoveSearchEntryIDs(ids...)
+	return _u
+}
+
+// RemoveSearchEntries removes "search_entries" edges to WorkflowRunSearchEntry entities.
+func (_u *WorkflowRunUpdateOne) RemoveSearchEntries(v ...*WorkflowRunSearchEntry) *WorkflowRunUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSearchEntryIDs(ids...)
+}
+
+// ClearPolicyEvaluations clears all "policy_evaluations" edges to the PolicyEvaluation entity.
+func (_u *WorkflowRunUpdateOne) ClearPolicyEvaluations() *WorkflowRunUpdateOne {
+	_u.mutation.ClearPolicyEvaluations()
+	return _u
+}
+
+// RemovePolicyEvaluationIDs removes the "policy_evaluations" edge to PolicyEvaluation entities by IDs.
+func (_u *WorkflowRunUpdateOne) RemovePolicyEvaluationIDs(ids ...uuid.UUID) *WorkflowRunUpdateOne {
+	_u.mutation.RemovePolicyEvaluationIDs(ids...)
+	return _u
+}
+
+// RemovePolicyEvaluations removes "policy_evaluations" edges to PolicyEvaluation entities.
+func (_u *WorkflowRunUpdateOne) RemovePolicyEvaluations(v ...*PolicyEvaluation) *WorkflowRunUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePolicyEvaluationIDs(ids...)
+}
+
 // Where appends a list predicates to the WorkflowRunUpdate builder.
 func (_u *WorkflowRunUpdateOne) Where(ps ...predicate.WorkflowRun) *WorkflowRunUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1580,6 +1862,12 @@ func (_u *WorkflowRunUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRun
 	if _u.mutation.PolicyHasGatesCleared() {
 		_spec.ClearField(workflowrun.FieldPolicyHasGates, field.TypeBool)
 	}
+	if value, ok := _u.mutation.SearchIndexedAt(); ok {
+		_spec.SetField(workflowrun.FieldSearchIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SearchIndexedAtCleared() {
+		_spec.ClearField(workflowrun.FieldSearchIndexedAt, field.TypeTime)
+	}
 	if _u.mutation.ContractVersionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1712,6 +2000,96 @@ func (_u *WorkflowRunUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRun
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SearchEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSearchEntriesIDs(); len(nodes) > 0 && !_u.mutation.SearchEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SearchEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.SearchEntriesTable,
+			Columns: []string{workflowrun.SearchEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PolicyEvaluationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPolicyEvaluationsIDs(); len(nodes) > 0 && !_u.mutation.PolicyEvaluationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PolicyEvaluationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowrun.PolicyEvaluationsTable,
+			Columns: []string{workflowrun.PolicyEvaluationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(policyevaluation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &WorkflowRun{config: _u.config}
 	_spec.Assign = _node.assignValues
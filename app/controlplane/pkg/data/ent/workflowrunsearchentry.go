@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
+	"github.com/google/uuid"
+)
+
+// WorkflowRunSearchEntry is the model entity for the WorkflowRunSearchEntry schema.
+type WorkflowRunSearchEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// WorkflowrunID holds the value of the "workflowrun_id" field.
+	WorkflowrunID uuid.UUID `json:"workflowrun_id,omitempty"`
+	// OrganizationID holds the value of the "organization_id" field.
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind biz.WorkflowRunSearchEntryKind `json:"kind,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Digest holds the value of the "digest" field.
+	Digest string `json:"digest,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkflowRunSearchEntryQuery when eager-loading is set.
+	Edges        WorkflowRunSearchEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkflowRunSearchEntryEdges holds the relations/edges for other nodes in the graph.
+type WorkflowRunSearchEntryEdges struct {
+	// Workflowrun holds the value of the workflowrun edge.
+	Workflowrun *WorkflowRun `json:"workflowrun,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WorkflowrunOrErr returns the Workflowrun value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkflowRunSearchEntryEdges) WorkflowrunOrErr() (*WorkflowRun, error) {
+	if e.Workflowrun != nil {
+		return e.Workflowrun, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: workflowrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "workflowrun"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkflowRunSearchEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflowrunsearchentry.FieldKind, workflowrunsearchentry.FieldName, workflowrunsearchentry.FieldType, workflowrunsearchentry.FieldDigest, workflowrunsearchentry.FieldValue:
+			values[i] = new(sql.NullString)
+		case workflowrunsearchentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case workflowrunsearchentry.FieldID, workflowrunsearchentry.FieldWorkflowrunID, workflowrunsearchentry.FieldOrganizationID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkflowRunSearchEntry fields.
+func (_m *WorkflowRunSearchEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflowrunsearchentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case workflowrunsearchentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case workflowrunsearchentry.FieldWorkflowrunID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field workflowrun_id", values[i])
+			} else if value != nil {
+				_m.WorkflowrunID = *value
+			}
+		case workflowrunsearchentry.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case workflowrunsearchentry.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = biz.WorkflowRunSearchEntryKind(value.String)
+			}
+		case workflowrunsearchentry.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case workflowrunsearchentry.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case workflowrunsearchentry.FieldDigest:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value.Valid {
+				_m.Digest = value.String
+			}
+		case workflowrunsearchentry.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the WorkflowRunSearchEntry.
+// This includes values selected through modifiers, order, etc.
+func (_m *WorkflowRunSearchEntry) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWorkflowrun queries the "workflowrun" edge of the WorkflowRunSearchEntry entity.
+func (_m *WorkflowRunSearchEntry) QueryWorkflowrun() *WorkflowRunQuery {
+	return NewWorkflowRunSearchEntryClient(_m.config).QueryWorkflowrun(_m)
+}
+
+// Update returns a builder for updating this WorkflowRunSearchEntry.
+// Note that you need to call WorkflowRunSearchEntry.Unwrap() before calling this method if this WorkflowRunSearchEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WorkflowRunSearchEntry) Update() *WorkflowRunSearchEntryUpdateOne {
+	return NewWorkflowRunSearchEntryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WorkflowRunSearchEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WorkflowRunSearchEntry) Unwrap() *WorkflowRunSearchEntry {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkflowRunSearchEntry is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WorkflowRunSearchEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkflowRunSearchEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("workflowrun_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WorkflowrunID))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(_m.Digest)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkflowRunSearchEntries is a parsable slice of WorkflowRunSearchEntry.
+type WorkflowRunSearchEntries []*WorkflowRunSearchEntry
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowrunsearchentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkflowrunID applies equality check predicate on the "workflowrun_id" field. It's identical to WorkflowrunIDEQ.
+func WorkflowrunID(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldWorkflowrunID, v))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldName, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldType, v))
+}
+
+// Digest applies equality check predicate on the "digest" field. It's identical to DigestEQ.
+func Digest(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldDigest, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldValue, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// WorkflowrunIDEQ applies the EQ predicate on the "workflowrun_id" field.
+func WorkflowrunIDEQ(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldWorkflowrunID, v))
+}
+
+// WorkflowrunIDNEQ applies the NEQ predicate on the "workflowrun_id" field.
+func WorkflowrunIDNEQ(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldWorkflowrunID, v))
+}
+
+// WorkflowrunIDIn applies the In predicate on the "workflowrun_id" field.
+func WorkflowrunIDIn(vs ...uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldWorkflowrunID, vs...))
+}
+
+// WorkflowrunIDNotIn applies the NotIn predicate on the "workflowrun_id" field.
+func WorkflowrunIDNotIn(vs ...uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldWorkflowrunID, vs...))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDGT applies the GT predicate on the "organization_id" field.
+func OrganizationIDGT(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldOrganizationID, v))
+}
+
+// OrganizationIDGTE applies the GTE predicate on the "organization_id" field.
+func OrganizationIDGTE(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldOrganizationID, v))
+}
+
+// OrganizationIDLT applies the LT predicate on the "organization_id" field.
+func OrganizationIDLT(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldOrganizationID, v))
+}
+
+// OrganizationIDLTE applies the LTE predicate on the "organization_id" field.
+func OrganizationIDLTE(v uuid.UUID) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldOrganizationID, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v biz.WorkflowRunSearchEntryKind) predicate.WorkflowRunSearchEntry {
+	vc := v
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldKind, vc))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v biz.WorkflowRunSearchEntryKind) predicate.WorkflowRunSearchEntry {
+	vc := v
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldKind, vc))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...biz.WorkflowRunSearchEntryKind) predicate.WorkflowRunSearchEntry {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldKind, v...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...biz.WorkflowRunSearchEntryKind) predicate.WorkflowRunSearchEntry {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldKind, v...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeIsNil applies the IsNil predicate on the "type" field.
+func TypeIsNil() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIsNull(FieldType))
+}
+
+// TypeNotNil applies the NotNil predicate on the "type" field.
+func TypeNotNil() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotNull(FieldType))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContainsFold(FieldType, v))
+}
+
+// DigestEQ applies the EQ predicate on the "digest" field.
+func DigestEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldDigest, v))
+}
+
+// DigestNEQ applies the NEQ predicate on the "digest" field.
+func DigestNEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldDigest, v))
+}
+
+// DigestIn applies the In predicate on the "digest" field.
+func DigestIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldDigest, vs...))
+}
+
+// DigestNotIn applies the NotIn predicate on the "digest" field.
+func DigestNotIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldDigest, vs...))
+}
+
+// DigestGT applies the GT predicate on the "digest" field.
+func DigestGT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldDigest, v))
+}
+
+// DigestGTE applies the GTE predicate on the "digest" field.
+func DigestGTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldDigest, v))
+}
+
+// DigestLT applies the LT predicate on the "digest" field.
+func DigestLT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldDigest, v))
+}
+
+// DigestLTE applies the LTE predicate on the "digest" field.
+func DigestLTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldDigest, v))
+}
+
+// DigestContains applies the Contains predicate on the "digest" field.
+func DigestContains(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContains(FieldDigest, v))
+}
+
+// DigestHasPrefix applies the HasPrefix predicate on the "digest" field.
+func DigestHasPrefix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasPrefix(FieldDigest, v))
+}
+
+// DigestHasSuffix applies the HasSuffix predicate on the "digest" field.
+func DigestHasSuffix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasSuffix(FieldDigest, v))
+}
+
+// DigestIsNil applies the IsNil predicate on the "digest" field.
+func DigestIsNil() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIsNull(FieldDigest))
+}
+
+// DigestNotNil applies the NotNil predicate on the "digest" field.
+func DigestNotNil() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotNull(FieldDigest))
+}
+
+// DigestEqualFold applies the EqualFold predicate on the "digest" field.
+func DigestEqualFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEqualFold(FieldDigest, v))
+}
+
+// DigestContainsFold applies the ContainsFold predicate on the "digest" field.
+func DigestContainsFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContainsFold(FieldDigest, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueIsNil applies the IsNil predicate on the "value" field.
+func ValueIsNil() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldIsNull(FieldValue))
+}
+
+// ValueNotNil applies the NotNil predicate on the "value" field.
+func ValueNotNil() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldNotNull(FieldValue))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.FieldContainsFold(FieldValue, v))
+}
+
+// HasWorkflowrun applies the HasEdge predicate on the "workflowrun" edge.
+func HasWorkflowrun() predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WorkflowrunTable, WorkflowrunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowrunWith applies the HasEdge predicate on the "workflowrun" edge with a given conditions (other predicates).
+func HasWorkflowrunWith(preds ...predicate.WorkflowRun) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(func(s *sql.Selector) {
+		step := newWorkflowrunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkflowRunSearchEntry) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkflowRunSearchEntry) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkflowRunSearchEntry) predicate.WorkflowRunSearchEntry {
+	return predicate.WorkflowRunSearchEntry(sql.NotPredicates(p))
+}
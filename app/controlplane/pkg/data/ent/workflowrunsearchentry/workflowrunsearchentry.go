@@ -0,0 +1,149 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowrunsearchentry
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the workflowrunsearchentry type in the database.
+	Label = "workflow_run_search_entry"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldWorkflowrunID holds the string denoting the workflowrun_id field in the database.
+	FieldWorkflowrunID = "workflowrun_id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldDigest holds the string denoting the digest field in the database.
+	FieldDigest = "digest"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// EdgeWorkflowrun holds the string denoting the workflowrun edge name in mutations.
+	EdgeWorkflowrun = "workflowrun"
+	// Table holds the table name of the workflowrunsearchentry in the database.
+	Table = "workflow_run_search_entries"
+	// WorkflowrunTable is the table that holds the workflowrun relation/edge.
+	WorkflowrunTable = "workflow_run_search_entries"
+	// WorkflowrunInverseTable is the table name for the WorkflowRun entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowrun" package.
+	WorkflowrunInverseTable = "workflow_runs"
+	// WorkflowrunColumn is the table column denoting the workflowrun relation/edge.
+	WorkflowrunColumn = "workflowrun_id"
+)
+
+// Columns holds all SQL columns for workflowrunsearchentry fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldWorkflowrunID,
+	FieldOrganizationID,
+	FieldKind,
+	FieldName,
+	FieldType,
+	FieldDigest,
+	FieldValue,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k biz.WorkflowRunSearchEntryKind) error {
+	switch k {
+	case "MATERIAL", "SUBJECT", "ANNOTATION":
+		return nil
+	default:
+		return fmt.Errorf("workflowrunsearchentry: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the WorkflowRunSearchEntry queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByWorkflowrunID orders the results by the workflowrun_id field.
+func ByWorkflowrunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowrunID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByDigest orders the results by the digest field.
+func ByDigest(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDigest, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByWorkflowrunField orders the results by workflowrun field.
+func ByWorkflowrunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowrunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newWorkflowrunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowrunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WorkflowrunTable, WorkflowrunColumn),
+	)
+}
@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
+	"github.com/google/uuid"
+)
+
+// WorkflowRunSearchEntryCreate is the builder for creating a WorkflowRunSearchEntry entity.
+type WorkflowRunSearchEntryCreate struct {
+	config
+	mutation *WorkflowRunSearchEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WorkflowRunSearchEntryCreate) SetCreatedAt(v time.Time) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WorkflowRunSearchEntryCreate) SetNillableCreatedAt(v *time.Time) *WorkflowRunSearchEntryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetWorkflowrunID sets the "workflowrun_id" field.
+func (_c *WorkflowRunSearchEntryCreate) SetWorkflowrunID(v uuid.UUID) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetWorkflowrunID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *WorkflowRunSearchEntryCreate) SetOrganizationID(v uuid.UUID) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *WorkflowRunSearchEntryCreate) SetKind(v biz.WorkflowRunSearchEntryKind) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WorkflowRunSearchEntryCreate) SetName(v string) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *WorkflowRunSearchEntryCreate) SetType(v string) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *WorkflowRunSearchEntryCreate) SetNillableType(v *string) *WorkflowRunSearchEntryCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *WorkflowRunSearchEntryCreate) SetDigest(v string) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetNillableDigest sets the "digest" field if the given value is not nil.
+func (_c *WorkflowRunSearchEntryCreate) SetNillableDigest(v *string) *WorkflowRunSearchEntryCreate {
+	if v != nil {
+		_c.SetDigest(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *WorkflowRunSearchEntryCreate) SetValue(v string) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *WorkflowRunSearchEntryCreate) SetNillableValue(v *string) *WorkflowRunSearchEntryCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WorkflowRunSearchEntryCreate) SetID(v uuid.UUID) *WorkflowRunSearchEntryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WorkflowRunSearchEntryCreate) SetNillableID(v *uuid.UUID) *WorkflowRunSearchEntryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetWorkflowrun sets the "workflowrun" edge to the WorkflowRun entity.
+func (_c *WorkflowRunSearchEntryCreate) SetWorkflowrun(v *WorkflowRun) *WorkflowRunSearchEntryCreate {
+	return _c.SetWorkflowrunID(v.ID)
+}
+
+// Mutation returns the WorkflowRunSearchEntryMutation object of the builder.
+func (_c *WorkflowRunSearchEntryCreate) Mutation() *WorkflowRunSearchEntryMutation {
+	return _c.mutation
+}
+
+// Save creates the WorkflowRunSearchEntry in the database.
+func (_c *WorkflowRunSearchEntryCreate) Save(ctx context.Context) (*WorkflowRunSearchEntry, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WorkflowRunSearchEntryCreate) SaveX(ctx context.Context) *WorkflowRunSearchEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkflowRunSearchEntryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkflowRunSearchEntryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WorkflowRunSearchEntryCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := workflowrunsearchentry.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := workflowrunsearchentry.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WorkflowRunSearchEntryCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WorkflowRunSearchEntry.created_at"`)}
+	}
+	if _, ok := _c.mutation.WorkflowrunID(); !ok {
+		return &ValidationError{Name: "workflowrun_id", err: errors.New(`ent: missing required field "WorkflowRunSearchEntry.workflowrun_id"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "WorkflowRunSearchEntry.organization_id"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "WorkflowRunSearchEntry.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := workflowrunsearchentry.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "WorkflowRunSearchEntry.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WorkflowRunSearchEntry.name"`)}
+	}
+	if len(_c.mutation.WorkflowrunIDs()) == 0 {
+		return &ValidationError{Name: "workflowrun", err: errors.New(`ent: missing required edge "WorkflowRunSearchEntry.workflowrun"`)}
+	}
+	return nil
+}
+
+func (_c *WorkflowRunSearchEntryCreate) sqlSave(ctx context.Context) (*WorkflowRunSearchEntry, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WorkflowRunSearchEntryCreate) createSpec() (*WorkflowRunSearchEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowRunSearchEntry{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(workflowrunsearchentry.Table, sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldDigest, field.TypeString, value)
+		_node.Digest = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(workflowrunsearchentry.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if nodes := _c.mutation.WorkflowrunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowrunsearchentry.WorkflowrunTable,
+			Columns: []string{workflowrunsearchentry.WorkflowrunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WorkflowrunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowRunSearchEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkflowRunSearchEntryCreate) OnConflict(opts ...sql.ConflictOption) *WorkflowRunSearchEntryUpsertOne {
+	_c.conflict = opts
+	return &WorkflowRunSearchEntryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkflowRunSearchEntryCreate) OnConflictColumns(columns ...string) *WorkflowRunSearchEntryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowRunSearchEntryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// WorkflowRunSearchEntryUpsertOne is the builder for "upsert"-ing
+	//  one WorkflowRunSearchEntry node.
+	WorkflowRunSearchEntryUpsertOne struct {
+		create *WorkflowRunSearchEntryCreate
+	}
+
+	// WorkflowRunSearchEntryUpsert is the "OnConflict" setter.
+	WorkflowRunSearchEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workflowrunsearchentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkflowRunSearchEntryUpsertOne) UpdateNewValues() *WorkflowRunSearchEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.WorkflowrunID(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldWorkflowrunID)
+		}
+		if _, exists := u.create.mutation.OrganizationID(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldOrganizationID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldKind)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldName)
+		}
+		if _, exists := u.create.mutation.GetType(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldType)
+		}
+		if _, exists := u.create.mutation.Digest(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldDigest)
+		}
+		if _, exists := u.create.mutation.Value(); exists {
+			s.SetIgnore(workflowrunsearchentry.FieldValue)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WorkflowRunSearchEntryUpsertOne) Ignore() *WorkflowRunSearchEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowRunSearchEntryUpsertOne) DoNothing() *WorkflowRunSearchEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowRunSearchEntryCreate.OnConflict
+// documentation for more info.
+func (u *WorkflowRunSearchEntryUpsertOne) Update(set func(*WorkflowRunSearchEntryUpsert)) *WorkflowRunSearchEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowRunSearchEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *WorkflowRunSearchEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowRunSearchEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowRunSearchEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WorkflowRunSearchEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: WorkflowRunSearchEntryUpsertOne.ID is not supported by MySQL driver. Use WorkflowRunSearchEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WorkflowRunSearchEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WorkflowRunSearchEntryCreateBulk is the builder for creating many WorkflowRunSearchEntry entities in bulk.
+type WorkflowRunSearchEntryCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowRunSearchEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WorkflowRunSearchEntry entities in the database.
+func (_c *WorkflowRunSearchEntryCreateBulk) Save(ctx context.Context) ([]*WorkflowRunSearchEntry, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WorkflowRunSearchEntry, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowRunSearchEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WorkflowRunSearchEntryCreateBulk) SaveX(ctx context.Context) []*WorkflowRunSearchEntry {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WorkflowRunSearchEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WorkflowRunSearchEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WorkflowRunSearchEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WorkflowRunSearchEntryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *WorkflowRunSearchEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *WorkflowRunSearchEntryUpsertBulk {
+	_c.conflict = opts
+	return &WorkflowRunSearchEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *WorkflowRunSearchEntryCreateBulk) OnConflictColumns(columns ...string) *WorkflowRunSearchEntryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &WorkflowRunSearchEntryUpsertBulk{
+		create: _c,
+	}
+}
+
+// WorkflowRunSearchEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of WorkflowRunSearchEntry nodes.
+type WorkflowRunSearchEntryUpsertBulk struct {
+	create *WorkflowRunSearchEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(workflowrunsearchentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *WorkflowRunSearchEntryUpsertBulk) UpdateNewValues() *WorkflowRunSearchEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.WorkflowrunID(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldWorkflowrunID)
+			}
+			if _, exists := b.mutation.OrganizationID(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldOrganizationID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldKind)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldName)
+			}
+			if _, exists := b.mutation.GetType(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldType)
+			}
+			if _, exists := b.mutation.Digest(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldDigest)
+			}
+			if _, exists := b.mutation.Value(); exists {
+				s.SetIgnore(workflowrunsearchentry.FieldValue)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WorkflowRunSearchEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WorkflowRunSearchEntryUpsertBulk) Ignore() *WorkflowRunSearchEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WorkflowRunSearchEntryUpsertBulk) DoNothing() *WorkflowRunSearchEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WorkflowRunSearchEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *WorkflowRunSearchEntryUpsertBulk) Update(set func(*WorkflowRunSearchEntryUpsert)) *WorkflowRunSearchEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WorkflowRunSearchEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *WorkflowRunSearchEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WorkflowRunSearchEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WorkflowRunSearchEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WorkflowRunSearchEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
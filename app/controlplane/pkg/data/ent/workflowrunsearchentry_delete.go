@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
+)
+
+// WorkflowRunSearchEntryDelete is the builder for deleting a WorkflowRunSearchEntry entity.
+type WorkflowRunSearchEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorkflowRunSearchEntryMutation
+}
+
+// Where appends a list predicates to the WorkflowRunSearchEntryDelete builder.
+func (_d *WorkflowRunSearchEntryDelete) Where(ps ...predicate.WorkflowRunSearchEntry) *WorkflowRunSearchEntryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *WorkflowRunSearchEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WorkflowRunSearchEntryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *WorkflowRunSearchEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(workflowrunsearchentry.Table, sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// WorkflowRunSearchEntryDeleteOne is the builder for deleting a single WorkflowRunSearchEntry entity.
+type WorkflowRunSearchEntryDeleteOne struct {
+	_d *WorkflowRunSearchEntryDelete
+}
+
+// Where appends a list predicates to the WorkflowRunSearchEntryDelete builder.
+func (_d *WorkflowRunSearchEntryDeleteOne) Where(ps ...predicate.WorkflowRunSearchEntry) *WorkflowRunSearchEntryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *WorkflowRunSearchEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{workflowrunsearchentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *WorkflowRunSearchEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
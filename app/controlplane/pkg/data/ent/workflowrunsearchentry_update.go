@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
+)
+
+// WorkflowRunSearchEntryUpdate is the builder for updating WorkflowRunSearchEntry entities.
+type WorkflowRunSearchEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WorkflowRunSearchEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WorkflowRunSearchEntryUpdate builder.
+func (_u *WorkflowRunSearchEntryUpdate) Where(ps ...predicate.WorkflowRunSearchEntry) *WorkflowRunSearchEntryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the WorkflowRunSearchEntryMutation object of the builder.
+func (_u *WorkflowRunSearchEntryUpdate) Mutation() *WorkflowRunSearchEntryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WorkflowRunSearchEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkflowRunSearchEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WorkflowRunSearchEntryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkflowRunSearchEntryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkflowRunSearchEntryUpdate) check() error {
+	if _u.mutation.WorkflowrunCleared() && len(_u.mutation.WorkflowrunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowRunSearchEntry.workflowrun"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowRunSearchEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowRunSearchEntryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkflowRunSearchEntryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowrunsearchentry.Table, workflowrunsearchentry.Columns, sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TypeCleared() {
+		_spec.ClearField(workflowrunsearchentry.FieldType, field.TypeString)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(workflowrunsearchentry.FieldDigest, field.TypeString)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(workflowrunsearchentry.FieldValue, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowrunsearchentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WorkflowRunSearchEntryUpdateOne is the builder for updating a single WorkflowRunSearchEntry entity.
+type WorkflowRunSearchEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WorkflowRunSearchEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the WorkflowRunSearchEntryMutation object of the builder.
+func (_u *WorkflowRunSearchEntryUpdateOne) Mutation() *WorkflowRunSearchEntryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the WorkflowRunSearchEntryUpdate builder.
+func (_u *WorkflowRunSearchEntryUpdateOne) Where(ps ...predicate.WorkflowRunSearchEntry) *WorkflowRunSearchEntryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WorkflowRunSearchEntryUpdateOne) Select(field string, fields ...string) *WorkflowRunSearchEntryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WorkflowRunSearchEntry entity.
+func (_u *WorkflowRunSearchEntryUpdateOne) Save(ctx context.Context) (*WorkflowRunSearchEntry, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WorkflowRunSearchEntryUpdateOne) SaveX(ctx context.Context) *WorkflowRunSearchEntry {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WorkflowRunSearchEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WorkflowRunSearchEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WorkflowRunSearchEntryUpdateOne) check() error {
+	if _u.mutation.WorkflowrunCleared() && len(_u.mutation.WorkflowrunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkflowRunSearchEntry.workflowrun"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *WorkflowRunSearchEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WorkflowRunSearchEntryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *WorkflowRunSearchEntryUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowRunSearchEntry, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowrunsearchentry.Table, workflowrunsearchentry.Columns, sqlgraph.NewFieldSpec(workflowrunsearchentry.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkflowRunSearchEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowrunsearchentry.FieldID)
+		for _, f := range fields {
+			if !workflowrunsearchentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowrunsearchentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TypeCleared() {
+		_spec.ClearField(workflowrunsearchentry.FieldType, field.TypeString)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(workflowrunsearchentry.FieldDigest, field.TypeString)
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(workflowrunsearchentry.FieldValue, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &WorkflowRunSearchEntry{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowrunsearchentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
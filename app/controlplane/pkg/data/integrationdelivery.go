@@ -0,0 +1,269 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/integrationdelivery"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var integrationDeliveryRepoTracer = otelx.Tracer("chainloop-controlplane", "data/integrationdelivery")
+
+type IntegrationDeliveryRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewIntegrationDeliveryRepo(data *Data, logger log.Logger) biz.IntegrationDeliveryRepo {
+	return &IntegrationDeliveryRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *IntegrationDeliveryRepo) CreateBulk(ctx context.Context, opts []*biz.IntegrationDeliveryCreateOpts) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryRepoTracer, "IntegrationDeliveryRepo.CreateBulk")
+	defer span.End()
+
+	builders := make([]*ent.IntegrationDeliveryCreate, 0, len(opts))
+	for _, o := range opts {
+		builders = append(builders, r.data.DB.IntegrationDelivery.Create().
+			SetOrganizationID(o.OrgID).
+			SetWorkflowID(o.WorkflowID).
+			SetWorkflowRunID(o.WorkflowRunID).
+			SetIntegrationAttachmentID(o.AttachmentID).
+			SetEnvelope(o.Envelope).
+			SetAttestationDigest(o.AttestationDigest).
+			SetDownloadBackendType(o.DownloadBackendType).
+			SetDownloadSecretName(o.DownloadSecretName))
+	}
+
+	// A single statement, so either all the deliveries are enqueued or none.
+	// They might have been enqueued already, i.e the request was retried
+	if err := r.data.DB.IntegrationDelivery.CreateBulk(builders...).
+		OnConflictColumns(integrationdelivery.FieldAttestationDigest, integrationdelivery.FieldIntegrationAttachmentID).
+		Ignore().
+		Exec(ctx); err != nil {
+		return fmt.Errorf("creating integration deliveries: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IntegrationDeliveryRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*biz.IntegrationDelivery, error) {
+	ctx, span := otelx.Start(ctx, integrationDeliveryRepoTracer, "IntegrationDeliveryRepo.ClaimDue")
+	defer span.End()
+
+	var claimed []*ent.IntegrationDelivery
+	if err := WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		now := time.Now()
+		// Skip the rows locked by other workers, they are being claimed concurrently
+		due, err := tx.IntegrationDelivery.Query().
+			Where(
+				integrationdelivery.StatusEQ(biz.IntegrationDeliveryStatusPending),
+				integrationdelivery.NextAttemptAtLTE(now),
+			).
+			Order(ent.Asc(integrationdelivery.FieldNextAttemptAt)).
+			Limit(limit).
+			ForUpdate(sql.WithLockAction(sql.SkipLocked)).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range due {
+			updated, err := tx.IntegrationDelivery.UpdateOne(d).
+				AddAttempts(1).
+				SetNextAttemptAt(now.Add(lease)).
+				SetUpdatedAt(now).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+
+			claimed = append(claimed, updated)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("claiming integration deliveries: %w", err)
+	}
+
+	res := make([]*biz.IntegrationDelivery, 0, len(claimed))
+	for _, d := range claimed {
+		res = append(res, entIntegrationDeliveryToBiz(d))
+	}
+
+	return res, nil
+}
+
+func (r *IntegrationDeliveryRepo) MarkSucceeded(ctx context.Context, id uuid.UUID, attempts int, duration time.Duration) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryRepoTracer, "IntegrationDeliveryRepo.MarkSucceeded")
+	defer span.End()
+
+	now := time.Now()
+	n, err := claimedDelivery(r.data.DB.IntegrationDelivery.Update(), id, attempts).
+		SetStatus(biz.IntegrationDeliveryStatusSucceeded).
+		SetFinishedAt(now).
+		SetUpdatedAt(now).
+		SetDuration(duration).
+		ClearLastError().
+		// the attestation is not needed anymore, succeeded deliveries are not replayed
+		ClearEnvelope().
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return leaseLostIfNone(n)
+}
+
+func (r *IntegrationDeliveryRepo) MarkFailed(ctx context.Context, id uuid.UUID, attempts int, reason string, duration time.Duration, nextAttemptAt *time.Time) error {
+	ctx, span := otelx.Start(ctx, integrationDeliveryRepoTracer, "IntegrationDeliveryRepo.MarkFailed")
+	defer span.End()
+
+	now := time.Now()
+	q := claimedDelivery(r.data.DB.IntegrationDelivery.Update(), id, attempts).SetLastError(reason).SetDuration(duration).SetUpdatedAt(now)
+	if nextAttemptAt != nil {
+		q.SetNextAttemptAt(*nextAttemptAt)
+	} else {
+		q.SetStatus(biz.IntegrationDeliveryStatusDeadLetter).SetFinishedAt(now)
+	}
+
+	n, err := q.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return leaseLostIfNone(n)
+}
+
+// claimedDelivery restricts the update to the delivery as it was claimed by the attempt,
+// a worker whose lease expired must not overwrite the outcome of the one that claimed it again
+func claimedDelivery(q *ent.IntegrationDeliveryUpdate, id uuid.UUID, attempts int) *ent.IntegrationDeliveryUpdate {
+	return q.Where(
+		integrationdelivery.ID(id),
+		integrationdelivery.Attempts(attempts),
+		integrationdelivery.StatusEQ(biz.IntegrationDeliveryStatusPending),
+	)
+}
+
+func leaseLostIfNone(updated int) error {
+	if updated == 0 {
+		return biz.ErrIntegrationDeliveryLeaseLost
+	}
+
+	return nil
+}
+
+// ListByAttachment returns the deliveries of an attachment, most recent first
+func (r *IntegrationDeliveryRepo) ListByAttachment(ctx context.Context, attachmentID uuid.UUID, filters *biz.IntegrationDeliveryListFilters, paginationOpts *pagination.OffsetPaginationOpts) ([]*biz.IntegrationDelivery, int, error) {
+	ctx, span := otelx.Start(ctx, integrationDeliveryRepoTracer, "IntegrationDeliveryRepo.ListByAttachment")
+	defer span.End()
+
+	query := r.data.DB.IntegrationDelivery.Query().
+		Where(integrationdelivery.IntegrationAttachmentID(attachmentID))
+
+	if filters != nil {
+		if filters.Status != nil {
+			query = query.Where(integrationdelivery.StatusEQ(*filters.Status))
+		}
+
+		if filters.WorkflowRunID != nil {
+			query = query.Where(integrationdelivery.WorkflowRunID(*filters.WorkflowRunID))
+		}
+	}
+
+	totalCount, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count integration deliveries: %w", err)
+	}
+
+	query = query.Order(ent.Desc(integrationdelivery.FieldCreatedAt))
+	if paginationOpts != nil {
+		query = query.
+			Limit(paginationOpts.Limit()).
+			Offset(paginationOpts.Offset())
+	}
+
+	deliveries, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list integration deliveries: %w", err)
+	}
+
+	res := make([]*biz.IntegrationDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, entIntegrationDeliveryToBiz(d))
+	}
+
+	return res, totalCount, nil
+}
+
+func (r *IntegrationDeliveryRepo) Requeue(ctx context.Context, attachmentID uuid.UUID, deliveryID *uuid.UUID) (int, error) {
+	ctx, span := otelx.Start(ctx, integrationDeliveryRepoTracer, "IntegrationDeliveryRepo.Requeue")
+	defer span.End()
+
+	q := r.data.DB.IntegrationDelivery.Update().
+		Where(
+			integrationdelivery.IntegrationAttachmentID(attachmentID),
+			integrationdelivery.StatusEQ(biz.IntegrationDeliveryStatusDeadLetter),
+		)
+
+	if deliveryID != nil {
+		q = q.Where(integrationdelivery.ID(*deliveryID))
+	}
+
+	// Reset the attempts so the replayed delivery gets the full retry budget
+	now := time.Now()
+	return q.
+		SetStatus(biz.IntegrationDeliveryStatusPending).
+		SetAttempts(0).
+		SetNextAttemptAt(now).
+		SetUpdatedAt(now).
+		ClearFinishedAt().
+		Save(ctx)
+}
+
+func entIntegrationDeliveryToBiz(d *ent.IntegrationDelivery) *biz.IntegrationDelivery {
+	return &biz.IntegrationDelivery{
+		ID:                  d.ID,
+		OrgID:               d.OrganizationID,
+		WorkflowID:          d.WorkflowID,
+		WorkflowRunID:       d.WorkflowRunID,
+		AttachmentID:        d.IntegrationAttachmentID,
+		Status:              d.Status,
+		Attempts:            d.Attempts,
+		LastError:           d.LastError,
+		Duration:            d.Duration,
+		NextAttemptAt:       toTimePtr(d.NextAttemptAt),
+		CreatedAt:           toTimePtr(d.CreatedAt),
+		UpdatedAt:           toTimePtr(d.UpdatedAt),
+		FinishedAt:          toTimePtr(d.FinishedAt),
+		Envelope:            d.Envelope,
+		DownloadBackendType: d.DownloadBackendType,
+		DownloadSecretName:  d.DownloadSecretName,
+	}
+}
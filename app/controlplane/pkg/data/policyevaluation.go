@@ -0,0 +1,414 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyevaluation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyviolation"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
+	attv1 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var policyEvaluationRepoTracer = otelx.Tracer("chainloop-controlplane", "data/policyevaluation")
+
+type PolicyEvaluationRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPolicyEvaluationRepo(data *Data, logger log.Logger) biz.PolicyEvaluationRepo {
+	return &PolicyEvaluationRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+// Save replaces the policy evaluations of the run. They are flagged as the latest ones of the workflow
+// unless a more recent run of the same workflow has been stored already.
+func (r *PolicyEvaluationRepo) Save(ctx context.Context, runID uuid.UUID, evaluations []*biz.PolicyEvaluation) error {
+	ctx, span := otelx.Start(ctx, policyEvaluationRepoTracer, "PolicyEvaluationRepo.Save")
+	defer span.End()
+
+	return WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		run, err := tx.WorkflowRun.Query().Where(workflowrun.ID(runID)).WithWorkflow().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return biz.NewErrNotFound(fmt.Sprintf("workflow run with id %s not found", runID))
+			}
+			return err
+		}
+
+		wf := run.Edges.Workflow
+		if wf == nil {
+			return fmt.Errorf("workflow of run %s not found", runID)
+		}
+
+		if _, err := tx.PolicyEvaluation.Delete().Where(policyevaluation.WorkflowrunID(runID)).Exec(ctx); err != nil {
+			return fmt.Errorf("removing previous evaluations: %w", err)
+		}
+
+		// runs might be attested out of order, they are sorted by creation date and ID
+		olderRun := workflowrun.Or(
+			workflowrun.CreatedAtLT(run.CreatedAt),
+			workflowrun.And(workflowrun.CreatedAt(run.CreatedAt), workflowrun.IDLT(run.ID)),
+		)
+		newerRun := workflowrun.Or(
+			workflowrun.CreatedAtGT(run.CreatedAt),
+			workflowrun.And(workflowrun.CreatedAt(run.CreatedAt), workflowrun.IDGT(run.ID)),
+		)
+
+		// Unflag the evaluations of older runs. A concurrent save of another run of the workflow might not be
+		// visible yet, in that case the unique index of the latest evaluations makes one of them fail
+		if err := tx.PolicyEvaluation.Update().
+			Where(
+				policyevaluation.WorkflowID(wf.ID),
+				policyevaluation.Latest(true),
+				policyevaluation.HasWorkflowrunWith(olderRun),
+			).
+			SetLatest(false).Exec(ctx); err != nil {
+			return fmt.Errorf("updating previous evaluations: %w", err)
+		}
+
+		// Only the evaluations of the most recent attested run of the workflow are the latest ones,
+		// even if that run has no evaluations
+		newer, err := tx.WorkflowRun.Query().
+			Where(
+				workflowrun.WorkflowID(wf.ID),
+				workflowrun.AttestationDigestNotNil(),
+				newerRun,
+			).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("checking newer runs: %w", err)
+		}
+
+		if len(evaluations) == 0 {
+			return nil
+		}
+
+		latest := latestEvaluations(evaluations, !newer)
+
+		evBuilders := make([]*ent.PolicyEvaluationCreate, 0, len(evaluations))
+		var vBuilders []*ent.PolicyViolationCreate
+		for _, e := range evaluations {
+			evID := uuid.New()
+			evBuilders = append(evBuilders, tx.PolicyEvaluation.Create().
+				SetID(evID).
+				// evaluations are sorted as the runs they belong to, which might be indexed later on
+				SetCreatedAt(run.CreatedAt).
+				SetWorkflowrunID(runID).
+				SetOrganizationID(run.OrganizationID).
+				SetProjectID(wf.ProjectID).
+				SetWorkflowID(wf.ID).
+				SetName(e.Name).
+				SetMaterialName(e.MaterialName).
+				SetNillableMaterialType(toStringPtr(e.MaterialType)).
+				SetNillablePolicyURI(toStringPtr(e.PolicyURI)).
+				SetNillablePolicyDigest(toStringPtr(e.PolicyDigest)).
+				SetNillableGroupName(toStringPtr(e.GroupName)).
+				SetStatus(e.Status).
+				SetSkipReasons(e.SkipReasons).
+				SetGate(e.Gate).
+				SetViolationsCount(e.ViolationsCount).
+				SetLatest(latest[e]))
+
+			for _, v := range e.Violations {
+				b := tx.PolicyViolation.Create().
+					SetPolicyEvaluationID(evID).
+					SetOrganizationID(run.OrganizationID).
+					SetNillableSubject(toStringPtr(v.Subject)).
+					SetMessage(v.Message).
+					SetSuppressed(v.Suppressed).
+					SetNillableExceptionID(toStringPtr(v.ExceptionID)).
+					SetNillableSeverity(toStringPtr(v.Severity())).
+					SetNillableExternalID(toStringPtr(v.ExternalID())).
+					SetNillablePackagePurl(toStringPtr(v.PackagePURL()))
+
+				if ft := v.FindingType(); ft != "" {
+					finding, err := marshalFinding(v)
+					if err != nil {
+						return fmt.Errorf("marshaling finding: %w", err)
+					}
+
+					b.SetFindingType(ft).SetFinding(finding)
+				}
+
+				vBuilders = append(vBuilders, b)
+			}
+		}
+
+		if err := tx.PolicyEvaluation.CreateBulk(evBuilders...).Exec(ctx); err != nil {
+			return fmt.Errorf("saving evaluations: %w", err)
+		}
+
+		if len(vBuilders) > 0 {
+			if err := tx.PolicyViolation.CreateBulk(vBuilders...).Exec(ctx); err != nil {
+				return fmt.Errorf("saving violations: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// latestEvaluations returns the evaluations to be flagged as the latest ones. A policy might be evaluated
+// more than once against the same material, only the one with the most violations is flagged.
+func latestEvaluations(evaluations []*biz.PolicyEvaluation, latestRun bool) map[*biz.PolicyEvaluation]bool {
+	res := make(map[*biz.PolicyEvaluation]bool, len(evaluations))
+	if !latestRun {
+		return res
+	}
+
+	type key struct{ name, material string }
+	flagged := make(map[key]*biz.PolicyEvaluation)
+	for _, e := range evaluations {
+		k := key{e.Name, e.MaterialName}
+		if prev, ok := flagged[k]; ok {
+			if prev.ViolationsCount >= e.ViolationsCount {
+				continue
+			}
+
+			res[prev] = false
+		}
+
+		flagged[k] = e
+		res[e] = true
+	}
+
+	return res
+}
+
+func (r *PolicyEvaluationRepo) List(ctx context.Context, orgID uuid.UUID, f *biz.PolicyEvaluationListFilters, p *pagination.CursorOptions) (result []*biz.PolicyEvaluation, cursor string, err error) {
+	ctx, span := otelx.Start(ctx, policyEvaluationRepoTracer, "PolicyEvaluationRepo.List")
+	defer span.End()
+
+	if p == nil {
+		return nil, "", errors.New("pagination options is required")
+	}
+
+	if f == nil {
+		f = &biz.PolicyEvaluationListFilters{}
+	}
+
+	q := r.data.DB.PolicyEvaluation.Query().
+		Where(
+			policyevaluation.OrganizationID(orgID),
+			policyevaluation.HasWorkflowrunWith(workflowrun.HasWorkflowWith(workflow.DeletedAtIsNil())),
+		).
+		Order(ent.Desc(policyevaluation.FieldCreatedAt), ent.Desc(policyevaluation.FieldID)).
+		WithWorkflowrun(func(q *ent.WorkflowRunQuery) {
+			q.WithWorkflowAndProject()
+		}).
+		Limit(p.Limit + 1)
+
+	if f.ProjectIDs != nil {
+		q = q.Where(policyevaluation.ProjectIDIn(f.ProjectIDs...))
+	}
+
+	if f.ProjectID != nil {
+		q = q.Where(policyevaluation.ProjectID(*f.ProjectID))
+	}
+
+	if f.WorkflowID != nil {
+		q = q.Where(policyevaluation.WorkflowID(*f.WorkflowID))
+	}
+
+	if f.PolicyName != "" {
+		q = q.Where(policyevaluation.Name(f.PolicyName))
+	}
+
+	if f.MaterialName != "" {
+		q = q.Where(policyevaluation.MaterialName(f.MaterialName))
+	}
+
+	if f.Status != "" {
+		q = q.Where(policyevaluation.StatusEQ(f.Status))
+	}
+
+	if f.LatestOnly {
+		q = q.Where(policyevaluation.Latest(true))
+	}
+
+	// by date range
+	if f.CreatedAfter != nil {
+		q = q.Where(policyevaluation.CreatedAtGTE(*f.CreatedAfter))
+	}
+
+	if f.CreatedBefore != nil {
+		q = q.Where(policyevaluation.CreatedAtLT(*f.CreatedBefore))
+	}
+
+	// by the findings, only the matching violations are loaded
+	if f.HasViolationFilters() {
+		var vPredicates []predicate.PolicyViolation
+		if f.FindingType != "" {
+			vPredicates = append(vPredicates, policyviolation.FindingTypeEQ(f.FindingType))
+		}
+
+		if f.Severity != "" {
+			vPredicates = append(vPredicates, policyviolation.Severity(f.Severity))
+		}
+
+		if f.ExternalID != "" {
+			vPredicates = append(vPredicates, policyviolation.ExternalID(f.ExternalID))
+		}
+
+		q = q.Where(policyevaluation.HasViolationsWith(vPredicates...)).
+			WithViolations(func(q *ent.PolicyViolationQuery) {
+				q.Where(vPredicates...)
+			})
+	} else {
+		q = q.WithViolations()
+	}
+
+	if p.Cursor != nil {
+		q = q.Where(
+			func(s *sql.Selector) {
+				s.Where(sql.CompositeLT([]string{s.C(policyevaluation.FieldCreatedAt), s.C(policyevaluation.FieldID)}, p.Cursor.Timestamp, p.Cursor.ID))
+			})
+	}
+
+	evaluations, err := q.All(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for i, e := range evaluations {
+		// Check if there are additional items for another page
+		// if so, set the cursor to the last item in the window
+		if i == p.Limit {
+			prev := evaluations[i-1]
+			cursor = pagination.EncodeCursor(prev.CreatedAt, prev.ID)
+			continue
+		}
+
+		ev, err := entPolicyEvaluationToBiz(e)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to convert policy evaluation: %w", err)
+		}
+
+		result = append(result, ev)
+	}
+
+	return result, cursor, nil
+}
+
+// marshalFinding serializes the structured finding of the violation
+func marshalFinding(v *biz.PolicyEvaluationViolation) (json.RawMessage, error) {
+	var m proto.Message
+	switch {
+	case v.Vulnerability != nil:
+		m = v.Vulnerability
+	case v.Sast != nil:
+		m = v.Sast
+	case v.LicenseViolation != nil:
+		m = v.LicenseViolation
+	case v.Secret != nil:
+		m = v.Secret
+	case v.Misconfiguration != nil:
+		m = v.Misconfiguration
+	case v.Malware != nil:
+		m = v.Malware
+	default:
+		return nil, nil
+	}
+
+	return protojson.Marshal(m)
+}
+
+func entPolicyEvaluationToBiz(e *ent.PolicyEvaluation) (*biz.PolicyEvaluation, error) {
+	res := &biz.PolicyEvaluation{
+		ID:              e.ID,
+		CreatedAt:       toTimePtr(e.CreatedAt),
+		WorkflowRunID:   e.WorkflowrunID,
+		ProjectID:       e.ProjectID,
+		Name:            e.Name,
+		MaterialName:    e.MaterialName,
+		MaterialType:    e.MaterialType,
+		PolicyURI:       e.PolicyURI,
+		PolicyDigest:    e.PolicyDigest,
+		GroupName:       e.GroupName,
+		Status:          e.Status,
+		SkipReasons:     e.SkipReasons,
+		Gate:            e.Gate,
+		ViolationsCount: e.ViolationsCount,
+		Latest:          e.Latest,
+	}
+
+	if run := e.Edges.Workflowrun; run != nil && run.Edges.Workflow != nil {
+		wf := run.Edges.Workflow
+		res.Workflow = &biz.WorkflowRef{ID: wf.ID, Name: wf.Name}
+		if wf.Edges.Project != nil {
+			res.Workflow.ProjectName = wf.Edges.Project.Name
+		}
+	}
+
+	for _, v := range e.Edges.Violations {
+		bv := &biz.PolicyEvaluationViolation{
+			Subject:     v.Subject,
+			Message:     v.Message,
+			Suppressed:  v.Suppressed,
+			ExceptionID: v.ExceptionID,
+		}
+
+		if len(v.Finding) > 0 {
+			var err error
+			switch v.FindingType {
+			case biz.PolicyFindingVulnerability:
+				bv.Vulnerability = &attv1.PolicyVulnerabilityFinding{}
+				err = protojson.Unmarshal(v.Finding, bv.Vulnerability)
+			case biz.PolicyFindingSAST:
+				bv.Sast = &attv1.PolicySASTFinding{}
+				err = protojson.Unmarshal(v.Finding, bv.Sast)
+			case biz.PolicyFindingLicenseViolation:
+				bv.LicenseViolation = &attv1.PolicyLicenseViolationFinding{}
+				err = protojson.Unmarshal(v.Finding, bv.LicenseViolation)
+			case biz.PolicyFindingSecret:
+				bv.Secret = &attv1.PolicySecretFinding{}
+				err = protojson.Unmarshal(v.Finding, bv.Secret)
+			case biz.PolicyFindingMisconfiguration:
+				bv.Misconfiguration = &attv1.PolicyMisconfigurationFinding{}
+				err = protojson.Unmarshal(v.Finding, bv.Misconfiguration)
+			case biz.PolicyFindingMalware:
+				bv.Malware = &attv1.PolicyMalwareFinding{}
+				err = protojson.Unmarshal(v.Finding, bv.Malware)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("unmarshaling finding: %w", err)
+			}
+		}
+
+		res.Violations = append(res.Violations, bv)
+	}
+
+	return res, nil
+}
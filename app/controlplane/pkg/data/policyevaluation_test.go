@@ -0,0 +1,46 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestEvaluations(t *testing.T) {
+	sbomPassed := &biz.PolicyEvaluation{Name: "cve-policy", MaterialName: "sbom"}
+	sbomFailed := &biz.PolicyEvaluation{Name: "cve-policy", MaterialName: "sbom", ViolationsCount: 2}
+	otherSBOM := &biz.PolicyEvaluation{Name: "cve-policy", MaterialName: "other-sbom"}
+	attestation := &biz.PolicyEvaluation{Name: "cve-policy"}
+	evaluations := []*biz.PolicyEvaluation{sbomPassed, sbomFailed, otherSBOM, attestation}
+
+	t.Run("the evaluations of an older run are not flagged", func(t *testing.T) {
+		got := latestEvaluations(evaluations, false)
+		for _, e := range evaluations {
+			assert.False(t, got[e])
+		}
+	})
+
+	t.Run("a single evaluation per policy and material is flagged", func(t *testing.T) {
+		got := latestEvaluations(evaluations, true)
+		assert.False(t, got[sbomPassed])
+		assert.True(t, got[sbomFailed])
+		assert.True(t, got[otherSBOM])
+		assert.True(t, got[attestation])
+	})
+}
@@ -0,0 +1,265 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyexception"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/predicate"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var policyExceptionRepoTracer = otelx.Tracer("chainloop-controlplane", "data/policyexception")
+
+type PolicyExceptionRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPolicyExceptionRepo(data *Data, logger log.Logger) biz.PolicyExceptionRepo {
+	return &PolicyExceptionRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *PolicyExceptionRepo) Create(ctx context.Context, opts *biz.PolicyExceptionCreateOpts) (*biz.PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.Create")
+	defer span.End()
+
+	created, err := r.data.DB.PolicyException.Create().
+		SetOrganizationID(opts.OrgID).
+		SetNillableProjectID(opts.ProjectID).
+		SetNillableWorkflowID(opts.WorkflowID).
+		SetNillablePolicyName(toStringPtr(opts.PolicyName)).
+		SetNillableMaterialName(toStringPtr(opts.MaterialName)).
+		SetNillableExternalID(toStringPtr(opts.ExternalID)).
+		SetJustification(opts.Justification).
+		SetCreatedBy(opts.CreatedBy).
+		SetExpiresAt(opts.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create policy exception: %w", err)
+	}
+
+	return r.findByID(ctx, created.ID)
+}
+
+func (r *PolicyExceptionRepo) FindByIDInOrg(ctx context.Context, orgID, id uuid.UUID) (*biz.PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.FindByIDInOrg")
+	defer span.End()
+
+	exceptions, err := r.list(ctx, policyexception.ID(id), policyexception.OrganizationID(orgID))
+	if err != nil {
+		return nil, err
+	} else if len(exceptions) == 0 {
+		return nil, nil
+	}
+
+	return exceptions[0], nil
+}
+
+func (r *PolicyExceptionRepo) List(ctx context.Context, orgID uuid.UUID, filters *biz.PolicyExceptionListFilters, at time.Time) ([]*biz.PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.List")
+	defer span.End()
+
+	preds := []predicate.PolicyException{policyexception.OrganizationID(orgID)}
+	if filters.ProjectID != nil {
+		preds = append(preds, policyexception.ProjectID(*filters.ProjectID))
+	}
+
+	switch filters.Status {
+	case biz.PolicyExceptionPending:
+		preds = append(preds, policyexception.RevokedAtIsNil(), policyexception.ExpiresAtGT(at), policyexception.ApprovedAtIsNil())
+	case biz.PolicyExceptionActive:
+		preds = append(preds, activePolicyExceptionPredicates(at)...)
+	case biz.PolicyExceptionExpired:
+		preds = append(preds, policyexception.RevokedAtIsNil(), policyexception.ExpiresAtLTE(at))
+	case biz.PolicyExceptionRevoked:
+		preds = append(preds, policyexception.RevokedAtNotNil())
+	}
+
+	return r.list(ctx, preds...)
+}
+
+func (r *PolicyExceptionRepo) FindActive(ctx context.Context, orgID, projectID, workflowID uuid.UUID, at time.Time) ([]*biz.PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.FindActive")
+	defer span.End()
+
+	preds := append(activePolicyExceptionPredicates(at),
+		policyexception.OrganizationID(orgID),
+		policyexception.Or(policyexception.ProjectIDIsNil(), policyexception.ProjectID(projectID)),
+		policyexception.Or(policyexception.WorkflowIDIsNil(), policyexception.WorkflowID(workflowID)),
+	)
+
+	return r.list(ctx, preds...)
+}
+
+func (r *PolicyExceptionRepo) Approve(ctx context.Context, id uuid.UUID, approvedBy string, at time.Time) error {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.Approve")
+	defer span.End()
+
+	// only pending exceptions are approved, and only once even with concurrent requests
+	n, err := r.data.DB.PolicyException.Update().
+		Where(
+			policyexception.ID(id),
+			policyexception.ApprovedAtIsNil(),
+			policyexception.RevokedAtIsNil(),
+			policyexception.ExpiresAtGT(at),
+		).
+		SetApprovedBy(approvedBy).
+		SetApprovedAt(at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to approve policy exception: %w", err)
+	} else if n == 0 {
+		return biz.NewErrConflictStr("the exception is no longer pending")
+	}
+
+	return nil
+}
+
+func (r *PolicyExceptionRepo) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.Revoke")
+	defer span.End()
+
+	// revoked only once, even with concurrent requests
+	n, err := r.data.DB.PolicyException.Update().
+		Where(policyexception.ID(id), policyexception.RevokedAtIsNil()).
+		SetRevokedAt(at).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke policy exception: %w", err)
+	} else if n == 0 {
+		return biz.NewErrConflictStr("the exception is already revoked")
+	}
+
+	return nil
+}
+
+func (r *PolicyExceptionRepo) FindExpired(ctx context.Context, at time.Time, limit int) ([]*biz.PolicyException, error) {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.FindExpired")
+	defer span.End()
+
+	exceptions, err := r.data.DB.PolicyException.Query().
+		Where(
+			policyexception.ExpiresAtLTE(at),
+			policyexception.ExpirationProcessedAtIsNil(),
+			policyexception.ApprovedAtNotNil(),
+			policyexception.RevokedAtIsNil(),
+		).
+		WithProject().
+		WithWorkflow().
+		Order(ent.Asc(policyexception.FieldExpiresAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find expired policy exceptions: %w", err)
+	}
+
+	res := make([]*biz.PolicyException, 0, len(exceptions))
+	for _, e := range exceptions {
+		res = append(res, entPolicyExceptionToBiz(e))
+	}
+
+	return res, nil
+}
+
+func (r *PolicyExceptionRepo) MarkExpirationProcessed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	ctx, span := otelx.Start(ctx, policyExceptionRepoTracer, "PolicyExceptionRepo.MarkExpirationProcessed")
+	defer span.End()
+
+	if err := r.data.DB.PolicyException.UpdateOneID(id).SetExpirationProcessedAt(at).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update policy exception: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PolicyExceptionRepo) findByID(ctx context.Context, id uuid.UUID) (*biz.PolicyException, error) {
+	exceptions, err := r.list(ctx, policyexception.ID(id))
+	if err != nil {
+		return nil, err
+	} else if len(exceptions) == 0 {
+		return nil, biz.NewErrNotFound("policy exception")
+	}
+
+	return exceptions[0], nil
+}
+
+func (r *PolicyExceptionRepo) list(ctx context.Context, preds ...predicate.PolicyException) ([]*biz.PolicyException, error) {
+	exceptions, err := r.data.DB.PolicyException.Query().
+		Where(preds...).
+		WithProject().
+		WithWorkflow().
+		Order(ent.Desc(policyexception.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list policy exceptions: %w", err)
+	}
+
+	res := make([]*biz.PolicyException, 0, len(exceptions))
+	for _, e := range exceptions {
+		res = append(res, entPolicyExceptionToBiz(e))
+	}
+
+	return res, nil
+}
+
+// approved, not revoked and not expired at the given time
+func activePolicyExceptionPredicates(at time.Time) []predicate.PolicyException {
+	return []predicate.PolicyException{
+		policyexception.ApprovedAtNotNil(),
+		policyexception.RevokedAtIsNil(),
+		policyexception.ExpiresAtGT(at),
+	}
+}
+
+func entPolicyExceptionToBiz(e *ent.PolicyException) *biz.PolicyException {
+	res := &biz.PolicyException{
+		ID:             e.ID,
+		OrganizationID: e.OrganizationID,
+		PolicyName:     e.PolicyName,
+		MaterialName:   e.MaterialName,
+		ExternalID:     e.ExternalID,
+		Justification:  e.Justification,
+		CreatedBy:      e.CreatedBy,
+		ApprovedBy:     e.ApprovedBy,
+		CreatedAt:      toTimePtr(e.CreatedAt),
+		ApprovedAt:     toTimePtr(e.ApprovedAt),
+		RevokedAt:      toTimePtr(e.RevokedAt),
+		ExpiresAt:      e.ExpiresAt,
+	}
+
+	if p := e.Edges.Project; p != nil {
+		res.ProjectID = &p.ID
+		res.ProjectName = p.Name
+	}
+
+	if wf := e.Edges.Workflow; wf != nil {
+		res.WorkflowID = &wf.ID
+		res.WorkflowName = wf.Name
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package data
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/policyimpactanalysis"
+	"github.com/chainloop-dev/chainloop/pkg/otelx"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+var policyImpactAnalysisRepoTracer = otelx.Tracer("chainloop-controlplane", "data/policyimpactanalysis")
+
+type PolicyImpactAnalysisRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewPolicyImpactAnalysisRepo(data *Data, logger log.Logger) biz.PolicyImpactAnalysisRepo {
+	return &PolicyImpactAnalysisRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *PolicyImpactAnalysisRepo) Create(ctx context.Context, orgID uuid.UUID) (*biz.PolicyImpactAnalysis, error) {
+	ctx, span := otelx.Start(ctx, policyImpactAnalysisRepoTracer, "PolicyImpactAnalysisRepo.Create")
+	defer span.End()
+
+	analysis, err := r.data.DB.PolicyImpactAnalysis.Create().
+		SetOrganizationID(orgID).
+		Save(ctx)
+	if err != nil {
+		// there's another analysis running in the organization
+		if ent.IsConstraintError(err) {
+			return nil, biz.NewErrAlreadyExists(err)
+		}
+
+		return nil, fmt.Errorf("failed to create policy impact analysis: %w", err)
+	}
+
+	return entPolicyImpactAnalysisToBiz(analysis), nil
+}
+
+func (r *PolicyImpactAnalysisRepo) CountSince(ctx context.Context, orgID uuid.UUID, since time.Time) (int, error) {
+	ctx, span := otelx.Start(ctx, policyImpactAnalysisRepoTracer, "PolicyImpactAnalysisRepo.CountSince")
+	defer span.End()
+
+	return r.data.DB.PolicyImpactAnalysis.Query().
+		Where(policyimpactanalysis.OrganizationID(orgID), policyimpactanalysis.CreatedAtGTE(since)).
+		Count(ctx)
+}
+
+func (r *PolicyImpactAnalysisRepo) Finish(ctx context.Context, id uuid.UUID, report *biz.PolicyImpactReport, reason string) error {
+	ctx, span := otelx.Start(ctx, policyImpactAnalysisRepoTracer, "PolicyImpactAnalysisRepo.Finish")
+	defer span.End()
+
+	update := r.data.DB.PolicyImpactAnalysis.Update().
+		// an analysis failed as interrupted is not overridden
+		Where(policyimpactanalysis.ID(id), policyimpactanalysis.StatusEQ(biz.PolicyImpactAnalysisRunning)).
+		SetFinishedAt(time.Now())
+
+	if report != nil {
+		update = update.SetStatus(biz.PolicyImpactAnalysisSucceeded).SetReport(report)
+	} else {
+		update = update.SetStatus(biz.PolicyImpactAnalysisFailed).SetError(reason)
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("failed to finish policy impact analysis: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PolicyImpactAnalysisRepo) FailStale(ctx context.Context, orgID uuid.UUID, startedBefore time.Time, reason string) error {
+	ctx, span := otelx.Start(ctx, policyImpactAnalysisRepoTracer, "PolicyImpactAnalysisRepo.FailStale")
+	defer span.End()
+
+	err := r.data.DB.PolicyImpactAnalysis.Update().
+		Where(
+			policyimpactanalysis.OrganizationID(orgID),
+			policyimpactanalysis.StatusEQ(biz.PolicyImpactAnalysisRunning),
+			policyimpactanalysis.CreatedAtLT(startedBefore),
+		).
+		SetStatus(biz.PolicyImpactAnalysisFailed).
+		SetError(reason).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fail stale policy impact analyses: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PolicyImpactAnalysisRepo) FindByID(ctx context.Context, orgID, id uuid.UUID) (*biz.PolicyImpactAnalysis, error) {
+	ctx, span := otelx.Start(ctx, policyImpactAnalysisRepoTracer, "PolicyImpactAnalysisRepo.FindByID")
+	defer span.End()
+
+	analysis, err := r.data.DB.PolicyImpactAnalysis.Query().
+		Where(policyimpactanalysis.ID(id), policyimpactanalysis.OrganizationID(orgID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to find policy impact analysis: %w", err)
+	}
+
+	return entPolicyImpactAnalysisToBiz(analysis), nil
+}
+
+func entPolicyImpactAnalysisToBiz(a *ent.PolicyImpactAnalysis) *biz.PolicyImpactAnalysis {
+	return &biz.PolicyImpactAnalysis{
+		ID:         a.ID,
+		Status:     a.Status,
+		CreatedAt:  toTimePtr(a.CreatedAt),
+		FinishedAt: toTimePtr(a.FinishedAt),
+		Report:     a.Report,
+		Error:      a.Error,
+	}
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/organization"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/orginvitation"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/project"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
 	"github.com/chainloop-dev/chainloop/pkg/otelx"
 
@@ -116,13 +118,110 @@ func (r *ProjectRepo) Create(ctx context.Context, orgID uuid.UUID, name string)
 	defer span.End()
 
 	pro, err := r.data.DB.Project.Create().SetOrganizationID(orgID).SetName(name).Save(ctx)
-	if err != nil && !ent.IsNotFound(err) {
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.NewErrAlreadyExists(err)
+		}
 		return nil, err
 	}
 
 	return entProjectToBiz(pro), nil
 }
 
+// List returns the projects of an organization, optionally restricted to the provided project IDs
+func (r *ProjectRepo) List(ctx context.Context, orgID uuid.UUID, projectIDs []uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*biz.Project, int, error) {
+	ctx, span := otelx.Start(ctx, projectRepoTracer, "ProjectRepo.List")
+	defer span.End()
+
+	query := r.data.DB.Project.Query().Where(
+		project.OrganizationID(orgID),
+		project.DeletedAtIsNil(),
+	)
+
+	// a nil slice means no filtering has been requested
+	if projectIDs != nil {
+		query = query.Where(project.IDIn(projectIDs...))
+	}
+
+	totalCount, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count projects: %w", err)
+	}
+
+	if paginationOpts != nil {
+		query = query.
+			Order(ent.Asc(project.FieldName)).
+			Limit(paginationOpts.Limit()).
+			Offset(paginationOpts.Offset())
+	}
+
+	prs, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list projects failed: %w", err)
+	}
+
+	res := make([]*biz.Project, 0, len(prs))
+	for _, p := range prs {
+		res = append(res, entProjectToBiz(p))
+	}
+
+	return res, totalCount, nil
+}
+
+// SoftDelete marks a project and all its versions as deleted and removes the memberships on it.
+// Projects that still have workflows can not be deleted.
+func (r *ProjectRepo) SoftDelete(ctx context.Context, orgID uuid.UUID, projectID uuid.UUID) error {
+	ctx, span := otelx.Start(ctx, projectRepoTracer, "ProjectRepo.SoftDelete")
+	defer span.End()
+
+	return WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		now := time.Now()
+
+		workflows, err := tx.Workflow.Query().Where(
+			workflow.ProjectID(projectID),
+			workflow.DeletedAtIsNil(),
+		).Count(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to count project workflows: %w", err)
+		}
+
+		if workflows > 0 {
+			return biz.NewErrValidationStr(fmt.Sprintf("project has %d workflows, delete them first", workflows))
+		}
+
+		_, err = tx.Project.UpdateOneID(projectID).
+			Where(project.OrganizationID(orgID), project.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			SetUpdatedAt(now).
+			Save(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return biz.NewErrNotFound("project")
+			}
+			return fmt.Errorf("failed to mark project as deleted: %w", err)
+		}
+
+		if err := tx.ProjectVersion.Update().
+			Where(projectversion.ProjectID(projectID), projectversion.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("failed to mark project versions as deleted: %w", err)
+		}
+
+		// Delete all memberships on this project
+		_, err = tx.Membership.Delete().Where(
+			membership.HasOrganizationWith(organization.ID(orgID)),
+			membership.ResourceID(projectID),
+			membership.ResourceTypeEQ(authz.ResourceTypeProject),
+		).Exec(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to delete project memberships: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // ListMembers lists all members of a project (both users and groups)
 func (r *ProjectRepo) ListMembers(ctx context.Context, orgID uuid.UUID, projectID uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*biz.ProjectMembership, int, error) {
 	ctx, span := otelx.Start(ctx, projectRepoTracer, "ProjectRepo.ListMembers")
@@ -23,6 +23,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/biz"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
 	"github.com/chainloop-dev/chainloop/pkg/otelx"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
@@ -110,12 +111,70 @@ func (r *ProjectVersionRepo) Create(ctx context.Context, projectID uuid.UUID, ve
 		res, err = createProjectVersionWithTx(ctx, tx, projectID, version, prerelease, nil)
 		return err
 	}); err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, biz.NewErrAlreadyExists(err)
+		}
 		return nil, err
 	}
 
 	return entProjectVersionToBiz(res), nil
 }
 
+// ListByProject returns the versions of a project, most recent first
+func (r *ProjectVersionRepo) ListByProject(ctx context.Context, projectID uuid.UUID, paginationOpts *pagination.OffsetPaginationOpts) ([]*biz.ProjectVersion, int, error) {
+	ctx, span := otelx.Start(ctx, projectVersionRepoTracer, "ProjectVersionRepo.ListByProject")
+	defer span.End()
+
+	query := r.data.DB.ProjectVersion.Query().Where(
+		projectversion.ProjectID(projectID),
+		projectversion.DeletedAtIsNil(),
+	)
+
+	totalCount, err := query.Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count project versions: %w", err)
+	}
+
+	if paginationOpts != nil {
+		query = query.
+			Order(ent.Desc(projectversion.FieldCreatedAt)).
+			Limit(paginationOpts.Limit()).
+			Offset(paginationOpts.Offset())
+	}
+
+	versions, err := query.All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list project versions: %w", err)
+	}
+
+	res := make([]*biz.ProjectVersion, 0, len(versions))
+	for _, v := range versions {
+		res = append(res, entProjectVersionToBiz(v))
+	}
+
+	return res, totalCount, nil
+}
+
+// FindLatestByProject returns the version marked as latest for a project
+func (r *ProjectVersionRepo) FindLatestByProject(ctx context.Context, projectID uuid.UUID) (*biz.ProjectVersion, error) {
+	ctx, span := otelx.Start(ctx, projectVersionRepoTracer, "ProjectVersionRepo.FindLatestByProject")
+	defer span.End()
+
+	pv, err := r.data.DB.ProjectVersion.Query().Where(
+		projectversion.ProjectID(projectID),
+		projectversion.DeletedAtIsNil(),
+		projectversion.Latest(true),
+	).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, biz.NewErrNotFound("Version")
+		}
+		return nil, err
+	}
+
+	return entProjectVersionToBiz(pv), nil
+}
+
 func createProjectVersionWithTx(ctx context.Context, tx *ent.Tx, projectID uuid.UUID, version string, prerelease bool, markAsLatest *bool) (*ent.ProjectVersion, error) {
 	if version == "" {
 		return nil, biz.NewErrValidationStr("version must not be empty")
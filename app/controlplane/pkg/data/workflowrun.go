@@ -30,6 +30,7 @@ import (
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/projectversion"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflow"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrun"
+	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/data/ent/workflowrunsearchentry"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/pagination"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
 	"github.com/chainloop-dev/chainloop/pkg/otelx"
@@ -201,7 +202,7 @@ func eagerLoadWorkflowRun(client *ent.Client) *ent.WorkflowRunQuery {
 		WithWorkflow(func(q *ent.WorkflowQuery) { q.WithOrganization().WithProject() }).
 		WithVersion().
 		WithContractVersion().
-		WithCasBackends()
+		WithCasBackends(func(q *ent.CASBackendQuery) { q.WithReplicas(liveReplicas) })
 }
 
 func (r *WorkflowRunRepo) FindByID(ctx context.Context, id uuid.UUID) (*biz.WorkflowRun, error) {
@@ -239,7 +240,8 @@ func (r *WorkflowRunRepo) FindByIDInOrg(ctx context.Context, orgID, id uuid.UUID
 	run, err := orgScopedQuery(r.data.DB, orgID).
 		QueryWorkflows().
 		QueryWorkflowruns().Where(workflowrun.ID(id)).
-		WithWorkflowAndProject().WithVersion().WithContractVersion().WithCasBackends().
+		WithWorkflowAndProject().WithVersion().WithContractVersion().
+		WithCasBackends(func(q *ent.CASBackendQuery) { q.WithReplicas(liveReplicas) }).
 		Only(ctx)
 	if err != nil && !ent.IsNotFound(err) {
 		return nil, err
@@ -360,6 +362,132 @@ func (r *WorkflowRunRepo) UpdatePolicyStatus(ctx context.Context, id uuid.UUID,
 	return nil
 }
 
+// SaveSearchEntries replaces the indexed content of the attestation of the run,
+// so indexing the same attestation again doesn't duplicate the entries, and flags the run as indexed
+func (r *WorkflowRunRepo) SaveSearchEntries(ctx context.Context, id uuid.UUID, entries []*biz.WorkflowRunSearchEntry) error {
+	ctx, span := otelx.Start(ctx, workflowRunRepoTracer, "WorkflowRunRepo.SaveSearchEntries")
+	defer span.End()
+
+	return WithTx(ctx, r.data.DB, func(tx *ent.Tx) error {
+		run, err := tx.WorkflowRun.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return biz.NewErrNotFound(fmt.Sprintf("workflow run with id %s not found", id))
+			}
+			return err
+		}
+
+		if _, err := tx.WorkflowRunSearchEntry.Delete().Where(workflowrunsearchentry.WorkflowrunID(id)).Exec(ctx); err != nil {
+			return fmt.Errorf("removing previous entries: %w", err)
+		}
+
+		if err := tx.WorkflowRun.UpdateOneID(id).SetSearchIndexedAt(time.Now()).Exec(ctx); err != nil {
+			return fmt.Errorf("flagging the run as indexed: %w", err)
+		}
+
+		if len(entries) == 0 {
+			return nil
+		}
+
+		builders := make([]*ent.WorkflowRunSearchEntryCreate, 0, len(entries))
+		for _, e := range entries {
+			builders = append(builders, tx.WorkflowRunSearchEntry.Create().
+				SetWorkflowrunID(id).
+				SetOrganizationID(run.OrganizationID).
+				SetKind(e.Kind).
+				SetName(e.Name).
+				SetNillableType(toStringPtr(e.Type)).
+				SetNillableDigest(toStringPtr(e.Digest)).
+				SetNillableValue(toStringPtr(e.Value)))
+		}
+
+		if err := tx.WorkflowRunSearchEntry.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("saving entries: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ListNotSearchIndexed returns the attested runs whose attestation is pending to be indexed for search,
+// ordered by creation date and ID and starting after the given cursor, if any
+func (r *WorkflowRunRepo) ListNotSearchIndexed(ctx context.Context, after *pagination.Cursor, limit int) ([]*biz.WorkflowRun, error) {
+	ctx, span := otelx.Start(ctx, workflowRunRepoTracer, "WorkflowRunRepo.ListNotSearchIndexed")
+	defer span.End()
+
+	// the CAS backends are required to download the policy evaluations offloaded by the attestation
+	q := eagerLoadWorkflowRun(r.data.DB).
+		Where(
+			workflowrun.SearchIndexedAtIsNil(),
+			workflowrun.AttestationDigestNotNil(),
+			workflowrun.AttestationDigestNEQ(""),
+		).
+		Order(ent.Asc(workflowrun.FieldCreatedAt), ent.Asc(workflowrun.FieldID)).
+		Limit(limit)
+
+	// runs sharing the creation date are told apart by their ID
+	if after != nil {
+		q = q.Where(func(s *sql.Selector) {
+			s.Where(sql.CompositeGT([]string{s.C(workflowrun.FieldCreatedAt), s.C(workflowrun.FieldID)}, after.Timestamp, after.ID))
+		})
+	}
+
+	runs, err := q.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*biz.WorkflowRun, 0, len(runs))
+	for _, wr := range runs {
+		r, err := entWrToBizWr(ctx, wr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert workflow run: %w", err)
+		}
+
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// searchEntryPredicates translates the filters on the content of the attestation
+// into lookups in the search index, each one of them must be satisfied
+func searchEntryPredicates(orgID uuid.UUID, filters *biz.RunListFilters) []predicate.WorkflowRun {
+	var res []predicate.WorkflowRun
+	match := func(kind biz.WorkflowRunSearchEntryKind, ps ...predicate.WorkflowRunSearchEntry) {
+		ps = append(ps, workflowrunsearchentry.OrganizationID(orgID), workflowrunsearchentry.KindEQ(kind))
+		res = append(res, workflowrun.HasSearchEntriesWith(ps...))
+	}
+
+	// the digest and the type must match the same material
+	var materialPreds []predicate.WorkflowRunSearchEntry
+	if filters.MaterialDigest != "" {
+		materialPreds = append(materialPreds, workflowrunsearchentry.Digest(filters.MaterialDigest))
+	}
+
+	if filters.MaterialType != "" {
+		materialPreds = append(materialPreds, workflowrunsearchentry.Type(filters.MaterialType))
+	}
+
+	if len(materialPreds) > 0 {
+		match(biz.WorkflowRunSearchEntryMaterial, materialPreds...)
+	}
+
+	if filters.SubjectName != "" {
+		match(biz.WorkflowRunSearchEntrySubject, workflowrunsearchentry.Name(filters.SubjectName))
+	}
+
+	if filters.AnnotationName != "" {
+		ps := []predicate.WorkflowRunSearchEntry{workflowrunsearchentry.Name(filters.AnnotationName)}
+		if filters.AnnotationValue != "" {
+			ps = append(ps, workflowrunsearchentry.Value(filters.AnnotationValue))
+		}
+		match(biz.WorkflowRunSearchEntryAnnotation, ps...)
+	}
+
+	return res
+}
+
 // policyStatusToEnt maps the domain PolicyStatus onto the ent-generated enum.
 // PolicyStatusUnspecified collapses to NOT_APPLICABLE so every persisted row
 // has a deterministic categorical value.
@@ -499,6 +627,24 @@ func (r *WorkflowRunRepo) List(ctx context.Context, orgID uuid.UUID, filters *bi
 		q = q.Where(workflowrun.PolicyHasGates(*filters.PolicyHasGates))
 	}
 
+	if filters != nil && filters.RunnerType != "" {
+		q = q.Where(workflowrun.RunnerType(filters.RunnerType))
+	}
+
+	// by date range
+	if filters != nil && filters.CreatedAfter != nil {
+		q = q.Where(workflowrun.CreatedAtGTE(*filters.CreatedAfter))
+	}
+
+	if filters != nil && filters.CreatedBefore != nil {
+		q = q.Where(workflowrun.CreatedAtLT(*filters.CreatedBefore))
+	}
+
+	// by the content of the attestation
+	if filters != nil {
+		q = q.Where(searchEntryPredicates(orgID, filters)...)
+	}
+
 	if p.Cursor != nil {
 		q = q.Where(
 			func(s *sql.Selector) {
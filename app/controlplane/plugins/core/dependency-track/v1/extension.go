@@ -75,20 +75,24 @@ type attachmentConfig struct {
 	Filter      string `json:"filter"`
 }
 
-const description = "Send CycloneDX SBOMs to your Dependency-Track instance"
+const description = "Send CycloneDX SBOMs and the packages found by Trivy and Grype to your Dependency-Track instance"
 
 func New(l log.Logger) (sdk.FanOut, error) {
 	base, err := sdk.NewFanOut(
 		&sdk.NewParams{
 			ID:          "dependency-track",
-			Version:     "1.7",
+			Version:     "1.8",
 			Description: description,
 			Logger:      l,
 			InputSchema: &sdk.InputSchema{
 				Registration: registrationRequest{},
 				Attachment:   attachmentRequest{},
 			},
-		}, sdk.WithInputMaterial(schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_JSON))
+		},
+		sdk.WithInputMaterial(schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_JSON),
+		sdk.WithInputMaterial(schemaapi.CraftingSchema_Material_TRIVY_JSON),
+		sdk.WithInputMaterial(schemaapi.CraftingSchema_Material_GRYPE_JSON),
+	)
 
 	if err != nil {
 		return nil, err
@@ -225,10 +229,15 @@ func doExecute(ctx context.Context, req *sdk.ExecutionRequest, sbom *sdk.Execute
 		"workflowID", req.Workflow.ID,
 	)
 
+	content, err := sbomContent(sbom)
+	if err != nil {
+		return fmt.Errorf("preparing SBOM: %w", err)
+	}
+
 	// Create an SBOM client and perform validation and upload
 	d, err := client.NewSBOMUploader(registrationConfig.Domain,
 		req.RegistrationInfo.Credentials.Password,
-		bytes.NewReader(sbom.Content),
+		bytes.NewReader(content),
 		attachmentConfig.ProjectID,
 		projectName,
 		attachmentConfig.ParentID)
@@ -388,7 +397,11 @@ func validateExecuteOpts(m *sdk.ExecuteMaterial, regConfig *sdk.RegistrationResp
 		return errors.New("invalid input")
 	}
 
-	if m.Type != schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_JSON.String() {
+	switch m.Type {
+	case schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_JSON.String(),
+		schemaapi.CraftingSchema_Material_TRIVY_JSON.String(),
+		schemaapi.CraftingSchema_Material_GRYPE_JSON.String():
+	default:
 		return fmt.Errorf("invalid input type: %s", m.Type)
 	}
 
@@ -0,0 +1,109 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencytrack
+
+import (
+	"encoding/json"
+	"fmt"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/app/controlplane/plugins/sdk/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/grype"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/trivy"
+)
+
+// Minimal CycloneDX document, enough for Dependency-Track to analyze the components
+type cdxBOM struct {
+	BOMFormat   string         `json:"bomFormat"`
+	SpecVersion string         `json:"specVersion"`
+	Version     int            `json:"version"`
+	Metadata    cdxMetadata    `json:"metadata"`
+	Components  []cdxComponent `json:"components"`
+}
+
+type cdxMetadata struct {
+	Tools struct {
+		Components []cdxComponent `json:"components"`
+	} `json:"tools"`
+	Component *cdxComponent `json:"component,omitempty"`
+}
+
+type cdxComponent struct {
+	Type    string `json:"type"`
+	BOMRef  string `json:"bom-ref,omitempty"`
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+// sbomContent returns the CycloneDX SBOM to upload for the material. Vulnerability reports are
+// converted to an SBOM with the packages they list, so Dependency-Track runs its own analysis on them.
+// Unless the scan listed all the packages, only the vulnerable ones are known.
+func sbomContent(m *sdk.ExecuteMaterial) ([]byte, error) {
+	bom := &cdxBOM{BOMFormat: "CycloneDX", SpecVersion: "1.5", Version: 1, Components: make([]cdxComponent, 0)}
+
+	switch m.Type {
+	case schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_JSON.String():
+		return m.Content, nil
+	case schemaapi.CraftingSchema_Material_TRIVY_JSON.String():
+		report, err := trivy.Parse(m.Content)
+		if err != nil {
+			return nil, fmt.Errorf("parsing trivy report: %w", err)
+		}
+
+		tool := cdxComponent{Type: "application", Name: "trivy"}
+		if report.Trivy != nil {
+			tool.Version = report.Trivy.Version
+		}
+
+		bom.Metadata.Tools.Components = append(bom.Metadata.Tools.Components, tool)
+		bom.Metadata.Component = &cdxComponent{Type: trivyComponentType(report.ArtifactType), Name: report.ArtifactName}
+		for _, p := range report.Packages() {
+			bom.Components = append(bom.Components, newLibrary(p.Name, p.Version, p.Identifier.PURL))
+		}
+	case schemaapi.CraftingSchema_Material_GRYPE_JSON.String():
+		report, err := grype.Parse(m.Content)
+		if err != nil {
+			return nil, fmt.Errorf("parsing grype report: %w", err)
+		}
+
+		bom.Metadata.Tools.Components = append(bom.Metadata.Tools.Components, cdxComponent{Type: "application", Name: report.Descriptor.Name, Version: report.Descriptor.Version})
+		for _, p := range report.Packages() {
+			bom.Components = append(bom.Components, newLibrary(p.Name, p.Version, p.PURL))
+		}
+	default:
+		return nil, fmt.Errorf("invalid input type: %s", m.Type)
+	}
+
+	return json.Marshal(bom)
+}
+
+func newLibrary(name, version, purl string) cdxComponent {
+	ref := purl
+	if ref == "" {
+		ref = name + "@" + version
+	}
+
+	return cdxComponent{Type: "library", BOMRef: ref, Name: name, Version: version, PURL: purl}
+}
+
+func trivyComponentType(artifactType string) string {
+	if artifactType == "container_image" {
+		return "container"
+	}
+
+	return "application"
+}
@@ -0,0 +1,112 @@
+//
+// Copyright 2023 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencytrack
+
+import (
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/app/controlplane/plugins/sdk/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/renderer/chainloop"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSBOMContent(t *testing.T) {
+	testCases := []struct {
+		name     string
+		material string
+		content  string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "cyclonedx",
+			material: "SBOM_CYCLONEDX_JSON",
+			content:  `{"bomFormat": "CycloneDX"}`,
+			want:     `{"bomFormat": "CycloneDX"}`,
+		},
+		{
+			name:     "trivy",
+			material: "TRIVY_JSON",
+			content: `{
+				"SchemaVersion": 2, "ArtifactName": "alpine:3.18", "ArtifactType": "container_image", "Trivy": {"Version": "0.56.2"},
+				"Results": [{"Target": "alpine:3.18", "Vulnerabilities": [
+					{"VulnerabilityID": "CVE-2024-0727", "PkgName": "libssl3", "PkgIdentifier": {"PURL": "pkg:apk/alpine/libssl3@3.1.4-r2"}, "InstalledVersion": "3.1.4-r2"},
+					{"VulnerabilityID": "CVE-2024-2511", "PkgName": "libssl3", "PkgIdentifier": {"PURL": "pkg:apk/alpine/libssl3@3.1.4-r2"}, "InstalledVersion": "3.1.4-r2"}
+				]}]
+			}`,
+			want: `{
+				"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+				"metadata": {
+					"tools": {"components": [{"type": "application", "name": "trivy", "version": "0.56.2"}]},
+					"component": {"type": "container", "name": "alpine:3.18"}
+				},
+				"components": [{"type": "library", "bom-ref": "pkg:apk/alpine/libssl3@3.1.4-r2", "name": "libssl3", "version": "3.1.4-r2", "purl": "pkg:apk/alpine/libssl3@3.1.4-r2"}]
+			}`,
+		},
+		{
+			name:     "grype",
+			material: "GRYPE_JSON",
+			content: `{
+				"descriptor": {"name": "grype", "version": "0.82.2"},
+				"matches": [{"vulnerability": {"id": "GHSA-29mw-wpgm-hmr9"}, "artifact": {"name": "lodash", "version": "4.17.20", "type": "npm", "purl": "pkg:npm/lodash@4.17.20"}}]
+			}`,
+			want: `{
+				"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+				"metadata": {"tools": {"components": [{"type": "application", "name": "grype", "version": "0.82.2"}]}},
+				"components": [{"type": "library", "bom-ref": "pkg:npm/lodash@4.17.20", "name": "lodash", "version": "4.17.20", "purl": "pkg:npm/lodash@4.17.20"}]
+			}`,
+		},
+		{
+			name:     "clean grype report",
+			material: "GRYPE_JSON",
+			content:  `{"descriptor": {"name": "grype", "version": "0.82.2"}, "matches": []}`,
+			want: `{
+				"bomFormat": "CycloneDX", "specVersion": "1.5", "version": 1,
+				"metadata": {"tools": {"components": [{"type": "application", "name": "grype", "version": "0.82.2"}]}},
+				"components": []
+			}`,
+		},
+		{
+			name:     "not a trivy report",
+			material: "TRIVY_JSON",
+			content:  `{"bomFormat": "CycloneDX"}`,
+			wantErr:  "parsing trivy report",
+		},
+		{
+			name:     "unsupported material",
+			material: "SBOM_SPDX_JSON",
+			content:  `{}`,
+			wantErr:  "invalid input type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := sbomContent(&sdk.ExecuteMaterial{
+				NormalizedMaterial: &chainloop.NormalizedMaterial{Type: tc.material},
+				Content:            []byte(tc.content),
+			})
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
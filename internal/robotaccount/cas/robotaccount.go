@@ -51,6 +51,15 @@ type Claims struct {
 	// The CAS skips audit event emission for this traffic so it doesn't
 	// pollute per-org usage numbers. The zero value (false) means client traffic.
 	SourceInternal bool `json:"source-internal,omitempty"`
+	// Replicas of the backend. Uploads get mirrored to them and
+	// downloads fall back to them, in order, if the backend can't serve the artifact.
+	Replicas []*Replica `json:"replicas,omitempty"`
+}
+
+// Replica references an additional backend holding a copy of the artifacts
+type Replica struct {
+	StoredSecretID string `json:"secret-id"`
+	BackendType    string `json:"backend"`
 }
 
 type Role string
@@ -127,6 +136,13 @@ func WithSourceInternal() GenerateOpt {
 	}
 }
 
+// WithReplicas attaches the replicas of the backend to the token
+func WithReplicas(replicas ...*Replica) GenerateOpt {
+	return func(c *Claims) {
+		c.Replicas = append(c.Replicas, replicas...)
+	}
+}
+
 // GenerateJWT mints a CAS token. All fields are required, including
 // orgID — managed providers (e.g. AWS-S3-ACCESS-POINT) need it to scope
 // per-tenant STS sessions and other providers still record it for
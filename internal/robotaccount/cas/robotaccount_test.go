@@ -156,9 +156,15 @@ func TestGenerateJWT(t *testing.T) {
 		name               string
 		opts               []GenerateOpt
 		wantSourceInternal bool
+		wantReplicas       []*Replica
 	}{
 		{name: "default, client traffic"},
 		{name: "internal controlplane traffic", opts: []GenerateOpt{WithSourceInternal()}, wantSourceInternal: true},
+		{
+			name:         "with replicas",
+			opts:         []GenerateOpt{WithReplicas(&Replica{BackendType: "AWS-S3", StoredSecretID: "replica-secret-id"})},
+			wantReplicas: []*Replica{{BackendType: "AWS-S3", StoredSecretID: "replica-secret-id"}},
+		},
 	}
 
 	for _, tc := range tests {
@@ -182,6 +188,7 @@ func TestGenerateJWT(t *testing.T) {
 			assert.Equal(t, claims.MaxBytes, int64(123))
 			assert.Equal(t, "org-uuid", claims.OrgID)
 			assert.Equal(t, tc.wantSourceInternal, claims.SourceInternal)
+			assert.Equal(t, tc.wantReplicas, claims.Replicas)
 			assert.WithinDuration(t, time.Now(), claims.ExpiresAt.Time, 10*time.Second)
 		})
 	}
@@ -0,0 +1,58 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+// Finding represents a structured violation, of the finding type declared in the policy metadata.
+// It's a flat union of the secret, misconfiguration and malware finding fields, since TinyGo
+// can't marshal interfaces; only the fields of the declared type must be set.
+// Use the NewSecretFinding, NewMisconfigurationFinding and NewMalwareFinding constructors.
+type Finding struct {
+	Message string `json:"message"`
+	// Shared by several finding types
+	Severity       string `json:"severity,omitempty"`
+	Location       string `json:"location,omitempty"`
+	LineNumber     int32  `json:"line_number,omitempty"`
+	Recommendation string `json:"recommendation,omitempty"`
+	PackagePurl    string `json:"package_purl,omitempty"`
+	// SECRET
+	RuleID      string `json:"rule_id,omitempty"`
+	Commit      string `json:"commit,omitempty"`
+	Verified    bool   `json:"verified,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// MISCONFIGURATION
+	CheckID    string   `json:"check_id,omitempty"`
+	Resource   string   `json:"resource,omitempty"`
+	References []string `json:"references,omitempty"`
+	// MALWARE
+	Signature string `json:"signature,omitempty"`
+	Digest    string `json:"digest,omitempty"`
+	Engine    string `json:"engine,omitempty"`
+}
+
+// NewSecretFinding creates a leaked secret finding. The secret itself must never be part of it.
+func NewSecretFinding(message, ruleID, location string) Finding {
+	return Finding{Message: message, RuleID: ruleID, Location: location}
+}
+
+// NewMisconfigurationFinding creates an infrastructure as code misconfiguration finding.
+func NewMisconfigurationFinding(message, checkID, severity, location string) Finding {
+	return Finding{Message: message, CheckID: checkID, Severity: severity, Location: location}
+}
+
+// NewMalwareFinding creates a malware finding.
+func NewMalwareFinding(message, signature, location string) Finding {
+	return Finding{Message: message, Signature: signature, Location: location}
+}
@@ -0,0 +1,142 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/extism/go-pdk"
+)
+
+// Declare the helper host functions provided by Chainloop
+// They take string offsets and return the offset of the JSON encoded result, or 0 on error
+//
+//go:wasmimport env chainloop_purl_parse
+func chainloop_purl_parse(purlOffset uint64) uint64
+
+//go:wasmimport env chainloop_semver_compare
+func chainloop_semver_compare(aOffset, bOffset uint64) uint64
+
+//go:wasmimport env chainloop_semver_satisfies
+func chainloop_semver_satisfies(versionOffset, constraintOffset uint64) uint64
+
+//go:wasmimport env chainloop_spdx_expression_satisfies
+func chainloop_spdx_expression_satisfies(expressionOffset, allowlistOffset uint64) uint64
+
+// PURL is a parsed package URL
+type PURL struct {
+	Type       string            `json:"type"`
+	Namespace  string            `json:"namespace"`
+	Name       string            `json:"name"`
+	Version    string            `json:"version"`
+	Qualifiers map[string]string `json:"qualifiers"`
+	Subpath    string            `json:"subpath"`
+}
+
+// ParsePURL parses a package URL into its components.
+//
+// Example:
+//
+//	purl, err := chainlooppolicy.ParsePURL("pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1")
+//	if err != nil {
+//	    return chainlooppolicy.Fail(err.Error())
+//	}
+//	// purl.Namespace == "org.apache.logging.log4j", purl.Name == "log4j-core"
+func ParsePURL(purl string) (*PURL, error) {
+	purlMem := pdk.AllocateString(purl)
+
+	var result PURL
+	if err := readHelperResult("purl_parse", chainloop_purl_parse(purlMem.Offset()), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// SemverCompare compares two semantic versions, optionally prefixed with "v",
+// returning -1 if a is lower than b, 0 if they are equal and 1 if a is greater than b.
+func SemverCompare(a, b string) (int, error) {
+	aMem := pdk.AllocateString(a)
+	bMem := pdk.AllocateString(b)
+
+	var result int
+	if err := readHelperResult("semver_compare", chainloop_semver_compare(aMem.Offset(), bMem.Offset()), &result); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+// SemverSatisfies checks if a semantic version satisfies a constraint, i.e ">= 1.2.0, < 2.0.0".
+//
+// Example:
+//
+//	affected, err := chainlooppolicy.SemverSatisfies(component.Version, ">= 2.0.0, < 2.17.1")
+func SemverSatisfies(version, constraint string) (bool, error) {
+	versionMem := pdk.AllocateString(version)
+	constraintMem := pdk.AllocateString(constraint)
+
+	var result bool
+	if err := readHelperResult("semver_satisfies", chainloop_semver_satisfies(versionMem.Offset(), constraintMem.Offset()), &result); err != nil {
+		return false, err
+	}
+
+	return result, nil
+}
+
+// SPDXExpressionSatisfies checks if an SPDX license expression can be complied with using only
+// the allowed licenses, i.e "(MIT OR GPL-3.0-only) AND Apache-2.0" is satisfied by ["MIT", "Apache-2.0"].
+//
+// Example:
+//
+//	ok, err := chainlooppolicy.SPDXExpressionSatisfies(license.Expression, []string{"MIT", "Apache-2.0"})
+func SPDXExpressionSatisfies(expression string, allowlist []string) (bool, error) {
+	allowlistJSON, err := json.Marshal(allowlist)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode allowlist: %w", err)
+	}
+
+	expressionMem := pdk.AllocateString(expression)
+	allowlistMem := pdk.AllocateBytes(allowlistJSON)
+
+	var result bool
+	if err := readHelperResult("spdx_expression_satisfies", chainloop_spdx_expression_satisfies(expressionMem.Offset(), allowlistMem.Offset()), &result); err != nil {
+		return false, err
+	}
+
+	return result, nil
+}
+
+// readHelperResult decodes the JSON result of a helper host function
+func readHelperResult(name string, resultOffset uint64, v any) error {
+	// Check if result is zero (error from host function)
+	if resultOffset == 0 {
+		return fmt.Errorf("%s returned error (check the arguments are valid)", name)
+	}
+
+	resultMem := pdk.FindMemory(resultOffset)
+	resultBytes := resultMem.ReadBytes()
+	if resultBytes == nil {
+		return fmt.Errorf("failed to read %s result from memory", name)
+	}
+
+	if err := json.Unmarshal(resultBytes, v); err != nil {
+		return fmt.Errorf("failed to parse %s result: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package _go
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/extism/go-pdk"
+)
+
+// Declare the host function provided by Chainloop
+// The host function signature: (purlOffset: i64) -> i64
+//
+//go:wasmimport env chainloop_osv_lookup
+func chainloop_osv_lookup(purlOffset uint64) uint64
+
+// Vulnerability is a known vulnerability affecting a package version,
+// found in the vulnerability database configured in Chainloop.
+type Vulnerability struct {
+	// ID is the advisory identifier (e.g., "GHSA-29mw-wpgm-hmr9")
+	ID string `json:"id"`
+	// Aliases are other identifiers of the same vulnerability (e.g., CVEs)
+	Aliases []string `json:"aliases"`
+	Summary string   `json:"summary"`
+	// Severity is LOW, MEDIUM, HIGH or CRITICAL, when known
+	Severity     string  `json:"severity"`
+	CVSSV3Score  float64 `json:"cvss_v3_score"`
+	CVSSV3Vector string  `json:"cvss_v3_vector"`
+	// FixedVersions are the versions fixing the vulnerability
+	FixedVersions []string `json:"fixed_versions"`
+	// Warning is set when the version couldn't be compared with the affected ranges,
+	// so the package might not be affected
+	Warning string `json:"warning"`
+}
+
+// OSVLookup calls the Chainloop osv_lookup builtin to find the known vulnerabilities
+// affecting a package version, without network access.
+//
+// Parameters:
+//   - purl: The package URL, including the version (e.g., "pkg:npm/lodash@4.17.20")
+//
+// Returns:
+//   - []Vulnerability: The vulnerabilities affecting the package, empty if none
+//   - error: Error if the lookup fails
+//
+// Example:
+//
+//	vulns, err := chainlooppolicy.OSVLookup("pkg:npm/lodash@4.17.20")
+//	if err != nil {
+//	    chainlooppolicy.LogError("Lookup failed: %v", err)
+//	    return
+//	}
+//
+//	for _, v := range vulns {
+//	    if v.Severity == "CRITICAL" {
+//	        result.AddViolationf("lodash is affected by %s", v.ID)
+//	    }
+//	}
+//
+// Note: The lookup requires a vulnerability database to be configured in Chainloop,
+// with the --vulnerability-db flag in the CLI.
+func OSVLookup(purl string) ([]Vulnerability, error) {
+	purlMem := pdk.AllocateString(purl)
+
+	resultOffset := chainloop_osv_lookup(purlMem.Offset())
+
+	// Check if result is zero (error from host function)
+	if resultOffset == 0 {
+		return nil, fmt.Errorf("osv lookup returned error (check if a vulnerability database is configured and the purl is valid)")
+	}
+
+	resultBytes := pdk.FindMemory(resultOffset)
+	if resultBytes.ReadBytes() == nil {
+		return nil, fmt.Errorf("failed to read osv lookup result from memory")
+	}
+
+	var result []Vulnerability
+	if err := json.Unmarshal(resultBytes.ReadBytes(), &result); err != nil {
+		return nil, fmt.Errorf("failed to parse osv lookup result: %w", err)
+	}
+
+	return result, nil
+}
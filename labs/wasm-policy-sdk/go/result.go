@@ -22,8 +22,10 @@ import "fmt"
 type Result struct {
 	Skipped    bool     `json:"skipped"`
 	Violations []string `json:"violations"`
-	SkipReason string   `json:"skip_reason"`
-	Ignore     bool     `json:"ignore"`
+	// Structured findings. When present, the host uses them instead of Violations.
+	Findings   []Finding `json:"findings,omitempty"`
+	SkipReason string    `json:"skip_reason"`
+	Ignore     bool      `json:"ignore"`
 }
 
 // Success creates a successful result (no violations, not skipped).
@@ -77,6 +79,13 @@ func (r *Result) AddViolationf(format string, args ...interface{}) {
 	r.Violations = append(r.Violations, fmt.Sprintf(format, args...))
 }
 
+// AddFinding adds a structured finding to the result, along with its message as a violation.
+// Once a finding is added, plain violations are ignored by the host, so don't mix them.
+func (r *Result) AddFinding(f Finding) {
+	r.Findings = append(r.Findings, f)
+	r.Violations = append(r.Violations, f.Message)
+}
+
 // HasViolations returns true if there are any violations.
 func (r *Result) HasViolations() bool {
 	return len(r.Violations) > 0
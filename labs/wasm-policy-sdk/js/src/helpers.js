@@ -0,0 +1,134 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/**
+ * Helper Functions
+ *
+ * Functions for parsing package URLs, comparing semantic versions and evaluating
+ * SPDX license expressions, with the same behavior as the Rego builtins.
+ *
+ * NOTE: Host and Memory are global objects provided by the Extism runtime.
+ * They do NOT need to be imported from '@extism/js-pdk'.
+ */
+
+/**
+ * Decodes the JSON result of a helper host function.
+ *
+ * @param {string} name - The helper name, for error messages
+ * @param {bigint} resultOffset - The offset returned by the host function
+ * @returns {*} The decoded result
+ * @throws {Error} If the host function failed
+ */
+function readHelperResult(name, resultOffset) {
+    if (resultOffset === 0n) {
+        throw new Error(`${name} returned error (check the arguments are valid)`);
+    }
+
+    const resultMem = Memory.find(resultOffset);
+    if (!resultMem) {
+        throw new Error(`failed to read ${name} result from memory`);
+    }
+
+    try {
+        return resultMem.readJsonObject();
+    } catch (e) {
+        throw new Error(`failed to parse ${name} result: ${e.message}`);
+    }
+}
+
+/**
+ * Parses a package URL into its components.
+ *
+ * @param {string} purl - The package URL (e.g., "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1")
+ * @returns {Object} The type, namespace, name, version, qualifiers and subpath of the package URL
+ * @throws {Error} If the package URL is invalid
+ *
+ * @example
+ * const purl = purlParse("pkg:npm/%40angular/core@17.0.0");
+ * // purl.namespace === "@angular", purl.name === "core"
+ */
+function purlParse(purl) {
+    const { chainloop_purl_parse } = Host.getFunctions();
+
+    const purlMem = Memory.fromString(purl);
+
+    return readHelperResult('purl_parse', chainloop_purl_parse(purlMem.offset));
+}
+
+/**
+ * Compares two semantic versions, optionally prefixed with "v".
+ *
+ * @param {string} a - The semantic version
+ * @param {string} b - The semantic version to compare with
+ * @returns {number} -1 if a is lower than b, 0 if they are equal, 1 if a is greater than b
+ * @throws {Error} If any of the versions is invalid
+ */
+function semverCompare(a, b) {
+    const { chainloop_semver_compare } = Host.getFunctions();
+
+    const aMem = Memory.fromString(a);
+    const bMem = Memory.fromString(b);
+
+    return readHelperResult('semver_compare', chainloop_semver_compare(aMem.offset, bMem.offset));
+}
+
+/**
+ * Checks if a semantic version satisfies a constraint.
+ *
+ * @param {string} version - The semantic version
+ * @param {string} constraint - The version constraint (e.g., ">= 1.2.0, < 2.0.0")
+ * @returns {boolean} Whether the version satisfies the constraint
+ * @throws {Error} If the version or the constraint are invalid
+ *
+ * @example
+ * if (semverSatisfies(component.version, ">= 2.0.0, < 2.17.1")) {
+ *   result.violations.push(`log4j-core ${component.version} is affected by Log4Shell`);
+ * }
+ */
+function semverSatisfies(version, constraint) {
+    const { chainloop_semver_satisfies } = Host.getFunctions();
+
+    const versionMem = Memory.fromString(version);
+    const constraintMem = Memory.fromString(constraint);
+
+    return readHelperResult('semver_satisfies', chainloop_semver_satisfies(versionMem.offset, constraintMem.offset));
+}
+
+/**
+ * Checks if an SPDX license expression can be complied with using only the allowed licenses.
+ *
+ * @param {string} expression - The SPDX license expression (e.g., "(MIT OR GPL-3.0-only) AND Apache-2.0")
+ * @param {string[]} allowlist - The allowed SPDX license identifiers
+ * @returns {boolean} Whether the expression is satisfied by the allowed licenses
+ * @throws {Error} If the expression is invalid
+ *
+ * @example
+ * spdxExpressionSatisfies("(MIT OR GPL-3.0-only) AND Apache-2.0", ["MIT", "Apache-2.0"]); // true
+ */
+function spdxExpressionSatisfies(expression, allowlist) {
+    const { chainloop_spdx_expression_satisfies } = Host.getFunctions();
+
+    const expressionMem = Memory.fromString(expression);
+    const allowlistMem = Memory.fromString(JSON.stringify(allowlist));
+
+    return readHelperResult('spdx_expression_satisfies', chainloop_spdx_expression_satisfies(expressionMem.offset, allowlistMem.offset));
+}
+
+module.exports = {
+  purlParse,
+  semverCompare,
+  semverSatisfies,
+  spdxExpressionSatisfies
+};
@@ -61,14 +61,45 @@ export interface DiscoverResult {
 export function discover(digest: string, kind?: string): DiscoverResult;
 export function discoverByDigest(digest: string): DiscoverResult;
 
+// Vulnerability Lookup
+export interface Vulnerability {
+  id: string;
+  aliases?: string[];
+  summary?: string;
+  severity?: string;
+  cvss_v3_score?: number;
+  cvss_v3_vector?: string;
+  fixed_versions?: string[];
+  warning?: string;
+}
+
+export function osvLookup(purl: string): Vulnerability[];
+
+// Helpers
+export interface PURL {
+  type: string;
+  namespace: string;
+  name: string;
+  version: string;
+  qualifiers: Record<string, string>;
+  subpath: string;
+}
+
+export function purlParse(purl: string): PURL;
+export function semverCompare(a: string, b: string): number;
+export function semverSatisfies(version: string, constraint: string): boolean;
+export function spdxExpressionSatisfies(expression: string, allowlist: string[]): boolean;
+
 // Results
 export interface Result {
   skipped: boolean;
   violations: string[];
+  findings: Finding[];
   skip_reason: string;
   ignore: boolean;
 
   addViolation(message: string): void;
+  addFinding(finding: Finding): void;
   hasViolations(): boolean;
   isSuccess(): boolean;
 }
@@ -78,5 +109,15 @@ export function fail(...violations: string[]): Result;
 export function skip(reason: string): Result;
 export function outputResult(result: Result): void;
 
+// Structured findings, of the finding type declared in the policy metadata
+export interface Finding {
+  message: string;
+  [field: string]: unknown;
+}
+
+export function secretFinding(message: string, ruleId: string, location: string, fields?: Record<string, unknown>): Finding;
+export function misconfigurationFinding(message: string, checkId: string, severity: string, location: string, fields?: Record<string, unknown>): Finding;
+export function malwareFinding(message: string, signature: string, location: string, fields?: Record<string, unknown>): Finding;
+
 // Execution
 export function run(fn: () => void): number;
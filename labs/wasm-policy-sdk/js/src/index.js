@@ -52,7 +52,10 @@ const {
 const {
   success,
   fail,
-  skip
+  skip,
+  secretFinding,
+  misconfigurationFinding,
+  malwareFinding
 } = require('./result');
 
 const {
@@ -68,6 +71,17 @@ const {
   discoverByDigest
 } = require('./discover');
 
+const {
+  osvLookup
+} = require('./osv');
+
+const {
+  purlParse,
+  semverCompare,
+  semverSatisfies,
+  spdxExpressionSatisfies
+} = require('./helpers');
+
 // Re-export all functions
 module.exports = {
   // Material extraction
@@ -96,10 +110,22 @@ module.exports = {
   discover,
   discoverByDigest,
 
+  // Vulnerability Lookup
+  osvLookup,
+
+  // Helpers
+  purlParse,
+  semverCompare,
+  semverSatisfies,
+  spdxExpressionSatisfies,
+
   // Results
   success,
   fail,
   skip,
+  secretFinding,
+  misconfigurationFinding,
+  malwareFinding,
   outputResult,
 
   // Execution
@@ -0,0 +1,67 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/**
+ * Vulnerability Lookup Functions
+ *
+ * Functions for finding known vulnerabilities in the vulnerability database
+ * configured in Chainloop, without network access.
+ *
+ * NOTE: Host and Memory are global objects provided by the Extism runtime.
+ * They do NOT need to be imported from '@extism/js-pdk'.
+ */
+
+/**
+ * osvLookup calls the Chainloop osv_lookup builtin to find the known vulnerabilities
+ * affecting a package version.
+ *
+ * @param {string} purl - The package URL, including the version (e.g., "pkg:npm/lodash@4.17.20")
+ * @returns {Array<Object>} The vulnerabilities affecting the package, empty if none
+ * @throws {Error} If the lookup fails
+ *
+ * @example
+ * const vulns = osvLookup("pkg:npm/lodash@4.17.20");
+ * for (const v of vulns) {
+ *   if (v.severity === "CRITICAL") {
+ *     result.violations.push(`lodash is affected by ${v.id}, fixed in ${v.fixed_versions}`);
+ *   }
+ * }
+ */
+function osvLookup(purl) {
+    const { chainloop_osv_lookup } = Host.getFunctions();
+
+    const purlMem = Memory.fromString(purl);
+
+    const resultOffset = chainloop_osv_lookup(purlMem.offset);
+
+    if (resultOffset === 0n) {
+        throw new Error('osv lookup returned error (check if a vulnerability database is configured and the purl is valid)');
+    }
+
+    const resultMem = Memory.find(resultOffset);
+    if (!resultMem) {
+        throw new Error('failed to read osv lookup result from memory');
+    }
+
+    try {
+        return resultMem.readJsonObject();
+    } catch (e) {
+        throw new Error(`failed to parse osv lookup result: ${e.message}`);
+    }
+}
+
+module.exports = {
+  osvLookup
+};
@@ -13,7 +13,14 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-const { success, fail, skip } = require('./result');
+const {
+  success,
+  fail,
+  skip,
+  secretFinding,
+  misconfigurationFinding,
+  malwareFinding
+} = require('./result');
 
 /**
  * Outputs a policy result as JSON.
@@ -33,6 +40,7 @@ function outputResult(result) {
   const output = {
     skipped: result.skipped,
     violations: result.violations,
+    findings: result.findings,
     skip_reason: result.skip_reason,
     ignore: result.ignore
   };
@@ -45,5 +53,8 @@ module.exports = {
   // Re-export result builders for convenience
   success,
   fail,
-  skip
+  skip,
+  secretFinding,
+  misconfigurationFinding,
+  malwareFinding
 };
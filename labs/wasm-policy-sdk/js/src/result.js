@@ -27,6 +27,7 @@ function success() {
   return {
     skipped: false,
     violations: [],
+    findings: [],
     skip_reason: "",
     ignore: false,
 
@@ -38,6 +39,16 @@ function success() {
       this.violations.push(message);
     },
 
+    /**
+     * Adds a structured finding to the result, along with its message as a violation.
+     * Once a finding is added, plain violations are ignored by the host, so don't mix them.
+     * @param {Object} finding - The finding, i.e from secretFinding()
+     */
+    addFinding(finding) {
+      this.findings.push(finding);
+      this.violations.push(finding.message);
+    },
+
     /**
      * Returns true if there are any violations.
      * @returns {boolean}
@@ -70,6 +81,7 @@ function fail(...violations) {
   return {
     skipped: false,
     violations: violations,
+    findings: [],
     skip_reason: "",
     ignore: false,
 
@@ -77,6 +89,11 @@ function fail(...violations) {
       this.violations.push(message);
     },
 
+    addFinding(finding) {
+      this.findings.push(finding);
+      this.violations.push(finding.message);
+    },
+
     hasViolations() {
       return this.violations.length > 0;
     },
@@ -102,6 +119,7 @@ function skip(reason) {
   return {
     skipped: true,
     violations: [],
+    findings: [],
     skip_reason: reason,
     ignore: false,
 
@@ -109,6 +127,11 @@ function skip(reason) {
       this.violations.push(message);
     },
 
+    addFinding(finding) {
+      this.findings.push(finding);
+      this.violations.push(finding.message);
+    },
+
     hasViolations() {
       return this.violations.length > 0;
     },
@@ -119,8 +142,54 @@ function skip(reason) {
   };
 }
 
+/**
+ * Creates a leaked secret finding. The secret itself must never be part of it.
+ *
+ * @param {string} message - Human-readable violation description
+ * @param {string} ruleId - Rule that detected the secret, i.e aws-access-token
+ * @param {string} location - File path where the secret was found
+ * @param {Object} [fields] - Optional fields, i.e {line_number: 12, verified: true}
+ * @returns {Object} A secret finding
+ *
+ * @example
+ * result.addFinding(secretFinding("AWS key found", "aws-access-token", "config.env", {line_number: 3}));
+ */
+function secretFinding(message, ruleId, location, fields = {}) {
+  return { ...fields, message, rule_id: ruleId, location };
+}
+
+/**
+ * Creates an infrastructure as code misconfiguration finding.
+ *
+ * @param {string} message - Human-readable violation description
+ * @param {string} checkId - Identifier of the failed check, i.e AVD-KSV-0017
+ * @param {string} severity - Severity of the misconfiguration
+ * @param {string} location - File path of the misconfigured resource
+ * @param {Object} [fields] - Optional fields, i.e {resource: "Deployment/api"}
+ * @returns {Object} A misconfiguration finding
+ */
+function misconfigurationFinding(message, checkId, severity, location, fields = {}) {
+  return { ...fields, message, check_id: checkId, severity, location };
+}
+
+/**
+ * Creates a malware finding.
+ *
+ * @param {string} message - Human-readable violation description
+ * @param {string} signature - Signature or rule that matched, i.e MAL-2025-1234
+ * @param {string} location - File path where the malware was found
+ * @param {Object} [fields] - Optional fields, i.e {package_purl: "pkg:npm/evil@1.0.0"}
+ * @returns {Object} A malware finding
+ */
+function malwareFinding(message, signature, location, fields = {}) {
+  return { ...fields, message, signature, location };
+}
+
 module.exports = {
   success,
   fail,
-  skip
+  skip,
+  secretFinding,
+  misconfigurationFinding,
+  malwareFinding
 };
@@ -28,13 +28,19 @@ import (
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/accesschk"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/attestation"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/cobertura"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/cyclonedx"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/dranzer"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/jacoco"
 	materialsjunit "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/junit"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/kubernetes"
 	materialsradamsa "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/radamsa"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/semgrep"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/snyk"
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/trufflehog"
 	"github.com/chainloop-dev/chainloop/pkg/tabular"
 	intoto "github.com/in-toto/attestation/go/v1"
+	spdxjson "github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/tagvalue"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -229,6 +235,47 @@ func (m *Attestation_Material) ingestMaterialToJSON(rawMaterial []byte, value st
 			return nil, fmt.Errorf("invalid Cobertura report file: %w", err)
 		}
 		return json.Marshal(&report)
+	case v1.CraftingSchema_Material_SBOM_CYCLONEDX_XML:
+		// rendered as CycloneDX JSON, so SBOM policies work regardless of the format
+		bom, err := cyclonedx.Parse(rawMaterial)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CycloneDX XML SBOM: %w", err)
+		}
+		return json.Marshal(bom)
+	case v1.CraftingSchema_Material_SBOM_SPDX_TAG_VALUE:
+		// rendered as SPDX 2.3 JSON, so SBOM policies work regardless of the format
+		doc, err := tagvalue.Read(bytes.NewReader(rawMaterial))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SPDX tag-value SBOM: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := spdxjson.Write(doc, &buf); err != nil {
+			return nil, fmt.Errorf("failed to render SPDX SBOM: %w", err)
+		}
+		return buf.Bytes(), nil
+	case v1.CraftingSchema_Material_SEMGREP_JSON:
+		report, err := semgrep.Parse(rawMaterial)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Semgrep report: %w", err)
+		}
+		return withFindings(rawMaterial, report.Findings())
+	case v1.CraftingSchema_Material_SNYK_JSON:
+		report, err := snyk.Parse(rawMaterial)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Snyk report: %w", err)
+		}
+		if report.Code != nil {
+			return withFindings(rawMaterial, report.SASTFindings())
+		}
+		return withFindings(rawMaterial, report.VulnerabilityFindings())
+	case v1.CraftingSchema_Material_KUBERNETES_MANIFESTS:
+		// the YAML documents are rendered as a JSON array, so the policy engine
+		// exposes the resources as input.elements
+		resources, err := kubernetes.Parse(rawMaterial)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Kubernetes manifests: %w", err)
+		}
+		return json.Marshal(resources)
 	case v1.CraftingSchema_Material_SYSINTERNALS_SIGCHECK:
 		report, err := tabular.Parse(rawMaterial)
 		if err != nil {
@@ -263,6 +310,31 @@ func (m *Attestation_Material) ingestMaterialToJSON(rawMaterial []byte, value st
 	return rawMaterial, nil
 }
 
+// FindingsInputKey is the key of the scanner results mapped to structured findings, added to the
+// report given to policies, i.e input.chainloop_findings, so they can be returned as violations as is
+const FindingsInputKey = "chainloop_findings"
+
+// withFindings adds the structured findings to a JSON report, preserving the scanner-specific fields.
+// Arrays are exposed as input.elements, as any other JSON material.
+func withFindings(rawMaterial []byte, findings any) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(rawMaterial))
+	decoder.UseNumber()
+
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to parse input: %w", err)
+	}
+
+	report, ok := decoded.(map[string]any)
+	if !ok {
+		report = map[string]any{"elements": decoded}
+	}
+
+	report[FindingsInputKey] = findings
+
+	return json.Marshal(report)
+}
+
 // CraftingStateToIntotoDescriptor creates an intoto descriptor from a material in crafting state
 func (m *Attestation_Material) CraftingStateToIntotoDescriptor(name string) (*intoto.ResourceDescriptor, error) {
 	material := &intoto.ResourceDescriptor{}
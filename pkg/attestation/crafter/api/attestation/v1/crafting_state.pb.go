@@ -152,7 +152,7 @@ func (x Commit_CommitVerification_VerificationStatus) Number() protoreflect.Enum
 
 // Deprecated: Use Commit_CommitVerification_VerificationStatus.Descriptor instead.
 func (Commit_CommitVerification_VerificationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{9, 1, 0}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{12, 1, 0}
 }
 
 type Attestation struct {
@@ -1052,6 +1052,373 @@ func (x *PolicyLicenseViolationFinding) GetAssessment() *PolicyAssessmentResult
 	return nil
 }
 
+// Output schema for leaked secret findings from policy evaluation, i.e gitleaks or trufflehog.
+// Used when a policy declares finding_type: SECRET.
+// The secret itself must never be included.
+type PolicySecretFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Human-readable violation description
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Tool-specific rule that detected the secret (e.g., aws-access-token, generic-api-key)
+	RuleId string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// File path where the secret was found
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Line number in the file
+	LineNumber int32 `protobuf:"varint,4,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	// Severity level (CRITICAL, HIGH, MEDIUM, LOW)
+	Severity string `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Commit that introduced the secret, when the git history was scanned
+	Commit string `protobuf:"bytes,6,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Whether the scanner verified that the secret is live
+	Verified bool `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Stable identifier of the leak reported by the scanner (e.g., gitleaks fingerprint)
+	Fingerprint string `protobuf:"bytes,8,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Suggested fix, i.e rotating the credential
+	Recommendation string `protobuf:"bytes,9,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	// Optional assessment context. See PolicyAssessmentResult.
+	Assessment    *PolicyAssessmentResult `protobuf:"bytes,10,opt,name=assessment,proto3,oneof" json:"assessment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicySecretFinding) Reset() {
+	*x = PolicySecretFinding{}
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicySecretFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicySecretFinding) ProtoMessage() {}
+
+func (x *PolicySecretFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicySecretFinding.ProtoReflect.Descriptor instead.
+func (*PolicySecretFinding) Descriptor() ([]byte, []int) {
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PolicySecretFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *PolicySecretFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *PolicySecretFinding) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *PolicySecretFinding) GetAssessment() *PolicyAssessmentResult {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+// Output schema for misconfiguration findings from policy evaluation, i.e IaC scanners.
+// Used when a policy declares finding_type: MISCONFIGURATION.
+type PolicyMisconfigurationFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Human-readable violation description
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Tool-specific check identifier (e.g., CKV_AWS_20, AVD-AWS-0086)
+	CheckId string `protobuf:"bytes,2,opt,name=check_id,json=checkId,proto3" json:"check_id,omitempty"`
+	// Severity level (CRITICAL, HIGH, MEDIUM, LOW)
+	Severity string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	// File path of the offending configuration
+	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// Misconfigured resource (e.g., aws_s3_bucket.logs, Deployment/default/web)
+	Resource string `protobuf:"bytes,5,opt,name=resource,proto3" json:"resource,omitempty"`
+	// Line number in the file
+	LineNumber int32 `protobuf:"varint,6,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+	// Suggested fix
+	Recommendation string `protobuf:"bytes,7,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	// Links to the documentation of the check
+	References []string `protobuf:"bytes,8,rep,name=references,proto3" json:"references,omitempty"`
+	// Optional assessment context. See PolicyAssessmentResult.
+	Assessment    *PolicyAssessmentResult `protobuf:"bytes,9,opt,name=assessment,proto3,oneof" json:"assessment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyMisconfigurationFinding) Reset() {
+	*x = PolicyMisconfigurationFinding{}
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyMisconfigurationFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyMisconfigurationFinding) ProtoMessage() {}
+
+func (x *PolicyMisconfigurationFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyMisconfigurationFinding.ProtoReflect.Descriptor instead.
+func (*PolicyMisconfigurationFinding) Descriptor() ([]byte, []int) {
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PolicyMisconfigurationFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PolicyMisconfigurationFinding) GetCheckId() string {
+	if x != nil {
+		return x.CheckId
+	}
+	return ""
+}
+
+func (x *PolicyMisconfigurationFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *PolicyMisconfigurationFinding) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PolicyMisconfigurationFinding) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *PolicyMisconfigurationFinding) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
+func (x *PolicyMisconfigurationFinding) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *PolicyMisconfigurationFinding) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+func (x *PolicyMisconfigurationFinding) GetAssessment() *PolicyAssessmentResult {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
+// Output schema for malware findings from policy evaluation, i.e antivirus scanners.
+// Used when a policy declares finding_type: MALWARE.
+type PolicyMalwareFinding struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Human-readable violation description
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Signature or rule that matched (e.g., Win.Test.EICAR_HDB-1)
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Path of the infected file
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// Digest of the infected file (e.g., sha256:deadbeef)
+	Digest string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Package URL of the component containing the file, if any
+	PackagePurl string `protobuf:"bytes,5,opt,name=package_purl,json=packagePurl,proto3" json:"package_purl,omitempty"`
+	// Scanner that detected it (e.g., clamav)
+	Engine string `protobuf:"bytes,6,opt,name=engine,proto3" json:"engine,omitempty"`
+	// Severity level (CRITICAL, HIGH, MEDIUM, LOW)
+	Severity string `protobuf:"bytes,7,opt,name=severity,proto3" json:"severity,omitempty"`
+	// Suggested fix
+	Recommendation string `protobuf:"bytes,8,opt,name=recommendation,proto3" json:"recommendation,omitempty"`
+	// Optional assessment context. See PolicyAssessmentResult.
+	Assessment    *PolicyAssessmentResult `protobuf:"bytes,9,opt,name=assessment,proto3,oneof" json:"assessment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyMalwareFinding) Reset() {
+	*x = PolicyMalwareFinding{}
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyMalwareFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyMalwareFinding) ProtoMessage() {}
+
+func (x *PolicyMalwareFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyMalwareFinding.ProtoReflect.Descriptor instead.
+func (*PolicyMalwareFinding) Descriptor() ([]byte, []int) {
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PolicyMalwareFinding) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetPackagePurl() string {
+	if x != nil {
+		return x.PackagePurl
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetRecommendation() string {
+	if x != nil {
+		return x.Recommendation
+	}
+	return ""
+}
+
+func (x *PolicyMalwareFinding) GetAssessment() *PolicyAssessmentResult {
+	if x != nil {
+		return x.Assessment
+	}
+	return nil
+}
+
 // Assessment context attached to a policy finding by the policy engine via
 // the chainloop.effective_assessments builtin. Sent to CAS as part of the
 // PolicyEvaluationBundle.
@@ -1069,7 +1436,7 @@ type PolicyAssessmentResult struct {
 
 func (x *PolicyAssessmentResult) Reset() {
 	*x = PolicyAssessmentResult{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[7]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1081,7 +1448,7 @@ func (x *PolicyAssessmentResult) String() string {
 func (*PolicyAssessmentResult) ProtoMessage() {}
 
 func (x *PolicyAssessmentResult) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[7]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,7 +1461,7 @@ func (x *PolicyAssessmentResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyAssessmentResult.ProtoReflect.Descriptor instead.
 func (*PolicyAssessmentResult) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{7}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PolicyAssessmentResult) GetEffectiveStatus() string {
@@ -1127,7 +1494,7 @@ type PolicyAssessment struct {
 
 func (x *PolicyAssessment) Reset() {
 	*x = PolicyAssessment{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[8]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1139,7 +1506,7 @@ func (x *PolicyAssessment) String() string {
 func (*PolicyAssessment) ProtoMessage() {}
 
 func (x *PolicyAssessment) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[8]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +1519,7 @@ func (x *PolicyAssessment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyAssessment.ProtoReflect.Descriptor instead.
 func (*PolicyAssessment) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{8}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PolicyAssessment) GetId() string {
@@ -1194,7 +1561,7 @@ type Commit struct {
 
 func (x *Commit) Reset() {
 	*x = Commit{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[9]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1206,7 +1573,7 @@ func (x *Commit) String() string {
 func (*Commit) ProtoMessage() {}
 
 func (x *Commit) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[9]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1219,7 +1586,7 @@ func (x *Commit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit.ProtoReflect.Descriptor instead.
 func (*Commit) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{9}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Commit) GetHash() string {
@@ -1296,7 +1663,7 @@ type CraftingState struct {
 
 func (x *CraftingState) Reset() {
 	*x = CraftingState{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[10]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1308,7 +1675,7 @@ func (x *CraftingState) String() string {
 func (*CraftingState) ProtoMessage() {}
 
 func (x *CraftingState) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[10]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,7 +1688,7 @@ func (x *CraftingState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CraftingState.ProtoReflect.Descriptor instead.
 func (*CraftingState) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{10}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CraftingState) GetSchema() isCraftingState_Schema {
@@ -1410,7 +1777,7 @@ type WorkflowMetadata struct {
 
 func (x *WorkflowMetadata) Reset() {
 	*x = WorkflowMetadata{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[11]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1422,7 +1789,7 @@ func (x *WorkflowMetadata) String() string {
 func (*WorkflowMetadata) ProtoMessage() {}
 
 func (x *WorkflowMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[11]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1435,7 +1802,7 @@ func (x *WorkflowMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowMetadata.ProtoReflect.Descriptor instead.
 func (*WorkflowMetadata) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{11}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *WorkflowMetadata) GetName() string {
@@ -1521,7 +1888,7 @@ type ProjectVersion struct {
 
 func (x *ProjectVersion) Reset() {
 	*x = ProjectVersion{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[12]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1533,7 +1900,7 @@ func (x *ProjectVersion) String() string {
 func (*ProjectVersion) ProtoMessage() {}
 
 func (x *ProjectVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[12]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +1913,7 @@ func (x *ProjectVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectVersion.ProtoReflect.Descriptor instead.
 func (*ProjectVersion) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{12}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ProjectVersion) GetVersion() string {
@@ -1594,7 +1961,7 @@ type ResourceDescriptor struct {
 
 func (x *ResourceDescriptor) Reset() {
 	*x = ResourceDescriptor{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[13]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +1973,7 @@ func (x *ResourceDescriptor) String() string {
 func (*ResourceDescriptor) ProtoMessage() {}
 
 func (x *ResourceDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[13]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +1986,7 @@ func (x *ResourceDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceDescriptor.ProtoReflect.Descriptor instead.
 func (*ResourceDescriptor) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{13}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ResourceDescriptor) GetName() string {
@@ -1698,7 +2065,7 @@ type Attestation_Material struct {
 
 func (x *Attestation_Material) Reset() {
 	*x = Attestation_Material{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[16]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1710,7 +2077,7 @@ func (x *Attestation_Material) String() string {
 func (*Attestation_Material) ProtoMessage() {}
 
 func (x *Attestation_Material) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[16]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1864,7 +2231,7 @@ type Attestation_Auth struct {
 
 func (x *Attestation_Auth) Reset() {
 	*x = Attestation_Auth{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[18]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1876,7 +2243,7 @@ func (x *Attestation_Auth) String() string {
 func (*Attestation_Auth) ProtoMessage() {}
 
 func (x *Attestation_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[18]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1920,7 +2287,7 @@ type Attestation_CASBackend struct {
 
 func (x *Attestation_CASBackend) Reset() {
 	*x = Attestation_CASBackend{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[19]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1932,7 +2299,7 @@ func (x *Attestation_CASBackend) String() string {
 func (*Attestation_CASBackend) ProtoMessage() {}
 
 func (x *Attestation_CASBackend) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[19]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1981,7 +2348,7 @@ type Attestation_SigningOptions struct {
 
 func (x *Attestation_SigningOptions) Reset() {
 	*x = Attestation_SigningOptions{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[20]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1993,7 +2360,7 @@ func (x *Attestation_SigningOptions) String() string {
 func (*Attestation_SigningOptions) ProtoMessage() {}
 
 func (x *Attestation_SigningOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[20]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2392,7 @@ func (x *Attestation_SigningOptions) GetSigningCa() string {
 
 type Attestation_Material_KeyVal struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// NOT USED, kept for compatibility with servers that still perform server-side validation“
+	// NOT USED, kept for compatibility with servers that still perform server-side validation``
 	// TODO: remove after some time
 	//
 	// Deprecated: Marked as deprecated in attestation/v1/crafting_state.proto.
@@ -2038,7 +2405,7 @@ type Attestation_Material_KeyVal struct {
 
 func (x *Attestation_Material_KeyVal) Reset() {
 	*x = Attestation_Material_KeyVal{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[22]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2050,7 +2417,7 @@ func (x *Attestation_Material_KeyVal) String() string {
 func (*Attestation_Material_KeyVal) ProtoMessage() {}
 
 func (x *Attestation_Material_KeyVal) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[22]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2457,7 @@ func (x *Attestation_Material_KeyVal) GetDigest() string {
 
 type Attestation_Material_ContainerImage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// NOT USED, kept for compatibility with servers that still perform server-side validation“
+	// NOT USED, kept for compatibility with servers that still perform server-side validation``
 	// TODO: remove after some time
 	//
 	// Deprecated: Marked as deprecated in attestation/v1/crafting_state.proto.
@@ -2116,7 +2483,7 @@ type Attestation_Material_ContainerImage struct {
 
 func (x *Attestation_Material_ContainerImage) Reset() {
 	*x = Attestation_Material_ContainerImage{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[23]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2128,7 +2495,7 @@ func (x *Attestation_Material_ContainerImage) String() string {
 func (*Attestation_Material_ContainerImage) ProtoMessage() {}
 
 func (x *Attestation_Material_ContainerImage) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[23]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2210,7 +2577,7 @@ func (x *Attestation_Material_ContainerImage) GetHasLatestTag() *wrapperspb.Bool
 
 type Attestation_Material_Artifact struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// NOT USED, kept for compatibility with servers that still perform server-side validation“
+	// NOT USED, kept for compatibility with servers that still perform server-side validation``
 	// TODO: remove after some time
 	//
 	// Deprecated: Marked as deprecated in attestation/v1/crafting_state.proto.
@@ -2230,7 +2597,7 @@ type Attestation_Material_Artifact struct {
 
 func (x *Attestation_Material_Artifact) Reset() {
 	*x = Attestation_Material_Artifact{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[24]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2242,7 +2609,7 @@ func (x *Attestation_Material_Artifact) String() string {
 func (*Attestation_Material_Artifact) ProtoMessage() {}
 
 func (x *Attestation_Material_Artifact) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[24]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2306,7 +2673,7 @@ type Attestation_Material_SBOMArtifact struct {
 
 func (x *Attestation_Material_SBOMArtifact) Reset() {
 	*x = Attestation_Material_SBOMArtifact{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[25]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2318,7 +2685,7 @@ func (x *Attestation_Material_SBOMArtifact) String() string {
 func (*Attestation_Material_SBOMArtifact) ProtoMessage() {}
 
 func (x *Attestation_Material_SBOMArtifact) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[25]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2363,7 +2730,7 @@ type Attestation_Material_SBOMArtifact_MainComponent struct {
 
 func (x *Attestation_Material_SBOMArtifact_MainComponent) Reset() {
 	*x = Attestation_Material_SBOMArtifact_MainComponent{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[26]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2375,7 +2742,7 @@ func (x *Attestation_Material_SBOMArtifact_MainComponent) String() string {
 func (*Attestation_Material_SBOMArtifact_MainComponent) ProtoMessage() {}
 
 func (x *Attestation_Material_SBOMArtifact_MainComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[26]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,20 +2792,26 @@ type PolicyEvaluation_Violation struct {
 	//	*PolicyEvaluation_Violation_Vulnerability
 	//	*PolicyEvaluation_Violation_Sast
 	//	*PolicyEvaluation_Violation_LicenseViolation
+	//	*PolicyEvaluation_Violation_Secret
+	//	*PolicyEvaluation_Violation_Misconfiguration
+	//	*PolicyEvaluation_Violation_Malware
 	Finding isPolicyEvaluation_Violation_Finding `protobuf_oneof:"finding"`
 	// Suppression hint set by the policy. When true the gate count
 	// excludes this entry, but it is still stored in CAS and ingested
 	// (audit trail preserved). Set by the policy author (typically based
 	// on `assessment.effective_status`) — the engine reads the bool
 	// without interpreting why.
-	Suppress      bool `protobuf:"varint,6,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	Suppress bool `protobuf:"varint,6,opt,name=suppress,proto3" json:"suppress,omitempty"`
+	// ID of the policy exception that suppressed the violation, set by the
+	// policy verifier when an approved exception of the organization matches it.
+	ExceptionId   string `protobuf:"bytes,7,opt,name=exception_id,json=exceptionId,proto3" json:"exception_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PolicyEvaluation_Violation) Reset() {
 	*x = PolicyEvaluation_Violation{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[29]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2450,7 +2823,7 @@ func (x *PolicyEvaluation_Violation) String() string {
 func (*PolicyEvaluation_Violation) ProtoMessage() {}
 
 func (x *PolicyEvaluation_Violation) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[29]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2514,6 +2887,33 @@ func (x *PolicyEvaluation_Violation) GetLicenseViolation() *PolicyLicenseViolati
 	return nil
 }
 
+func (x *PolicyEvaluation_Violation) GetSecret() *PolicySecretFinding {
+	if x != nil {
+		if x, ok := x.Finding.(*PolicyEvaluation_Violation_Secret); ok {
+			return x.Secret
+		}
+	}
+	return nil
+}
+
+func (x *PolicyEvaluation_Violation) GetMisconfiguration() *PolicyMisconfigurationFinding {
+	if x != nil {
+		if x, ok := x.Finding.(*PolicyEvaluation_Violation_Misconfiguration); ok {
+			return x.Misconfiguration
+		}
+	}
+	return nil
+}
+
+func (x *PolicyEvaluation_Violation) GetMalware() *PolicyMalwareFinding {
+	if x != nil {
+		if x, ok := x.Finding.(*PolicyEvaluation_Violation_Malware); ok {
+			return x.Malware
+		}
+	}
+	return nil
+}
+
 func (x *PolicyEvaluation_Violation) GetSuppress() bool {
 	if x != nil {
 		return x.Suppress
@@ -2521,6 +2921,13 @@ func (x *PolicyEvaluation_Violation) GetSuppress() bool {
 	return false
 }
 
+func (x *PolicyEvaluation_Violation) GetExceptionId() string {
+	if x != nil {
+		return x.ExceptionId
+	}
+	return ""
+}
+
 type isPolicyEvaluation_Violation_Finding interface {
 	isPolicyEvaluation_Violation_Finding()
 }
@@ -2537,12 +2944,30 @@ type PolicyEvaluation_Violation_LicenseViolation struct {
 	LicenseViolation *PolicyLicenseViolationFinding `protobuf:"bytes,5,opt,name=license_violation,json=licenseViolation,proto3,oneof"`
 }
 
+type PolicyEvaluation_Violation_Secret struct {
+	Secret *PolicySecretFinding `protobuf:"bytes,8,opt,name=secret,proto3,oneof"`
+}
+
+type PolicyEvaluation_Violation_Misconfiguration struct {
+	Misconfiguration *PolicyMisconfigurationFinding `protobuf:"bytes,9,opt,name=misconfiguration,proto3,oneof"`
+}
+
+type PolicyEvaluation_Violation_Malware struct {
+	Malware *PolicyMalwareFinding `protobuf:"bytes,10,opt,name=malware,proto3,oneof"`
+}
+
 func (*PolicyEvaluation_Violation_Vulnerability) isPolicyEvaluation_Violation_Finding() {}
 
 func (*PolicyEvaluation_Violation_Sast) isPolicyEvaluation_Violation_Finding() {}
 
 func (*PolicyEvaluation_Violation_LicenseViolation) isPolicyEvaluation_Violation_Finding() {}
 
+func (*PolicyEvaluation_Violation_Secret) isPolicyEvaluation_Violation_Finding() {}
+
+func (*PolicyEvaluation_Violation_Misconfiguration) isPolicyEvaluation_Violation_Finding() {}
+
+func (*PolicyEvaluation_Violation_Malware) isPolicyEvaluation_Violation_Finding() {}
+
 type PolicyEvaluation_Reference struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2555,7 +2980,7 @@ type PolicyEvaluation_Reference struct {
 
 func (x *PolicyEvaluation_Reference) Reset() {
 	*x = PolicyEvaluation_Reference{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[30]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2567,7 +2992,7 @@ func (x *PolicyEvaluation_Reference) String() string {
 func (*PolicyEvaluation_Reference) ProtoMessage() {}
 
 func (x *PolicyEvaluation_Reference) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[30]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,7 +3048,7 @@ type PolicyEvaluation_RawResult struct {
 
 func (x *PolicyEvaluation_RawResult) Reset() {
 	*x = PolicyEvaluation_RawResult{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[31]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2635,7 +3060,7 @@ func (x *PolicyEvaluation_RawResult) String() string {
 func (*PolicyEvaluation_RawResult) ProtoMessage() {}
 
 func (x *PolicyEvaluation_RawResult) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[31]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,7 +3100,7 @@ type Commit_Remote struct {
 
 func (x *Commit_Remote) Reset() {
 	*x = Commit_Remote{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[32]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2687,7 +3112,7 @@ func (x *Commit_Remote) String() string {
 func (*Commit_Remote) ProtoMessage() {}
 
 func (x *Commit_Remote) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[32]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,7 +3125,7 @@ func (x *Commit_Remote) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit_Remote.ProtoReflect.Descriptor instead.
 func (*Commit_Remote) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{9, 0}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{12, 0}
 }
 
 func (x *Commit_Remote) GetName() string {
@@ -2737,7 +3162,7 @@ type Commit_CommitVerification struct {
 
 func (x *Commit_CommitVerification) Reset() {
 	*x = Commit_CommitVerification{}
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[33]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2749,7 +3174,7 @@ func (x *Commit_CommitVerification) String() string {
 func (*Commit_CommitVerification) ProtoMessage() {}
 
 func (x *Commit_CommitVerification) ProtoReflect() protoreflect.Message {
-	mi := &file_attestation_v1_crafting_state_proto_msgTypes[33]
+	mi := &file_attestation_v1_crafting_state_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2762,7 +3187,7 @@ func (x *Commit_CommitVerification) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Commit_CommitVerification.ProtoReflect.Descriptor instead.
 func (*Commit_CommitVerification) Descriptor() ([]byte, []int) {
-	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{9, 1}
+	return file_attestation_v1_crafting_state_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *Commit_CommitVerification) GetAttempted() bool {
@@ -2915,7 +3340,7 @@ const file_attestation_v1_crafting_state_proto_rawDesc = "" +
 	"\venvironment\x18\x02 \x01(\tR\venvironment\x12$\n" +
 	"\rauthenticated\x18\x03 \x01(\bR\rauthenticated\x12I\n" +
 	"\x04type\x18\x04 \x01(\x0e25.workflowcontract.v1.CraftingSchema.Runner.RunnerTypeR\x04type\x12\x10\n" +
-	"\x03url\x18\x05 \x01(\tR\x03url\"\xec\x0e\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"\xed\x10\n" +
 	"\x10PolicyEvaluation\x12\x97\x01\n" +
 	"\x04name\x18\x01 \x01(\tB\x82\x01\xbaH\x7f\xba\x01|\n" +
 	"\rname.dns-1123\x12:must contain only lowercase letters, numbers, and hyphens.\x1a/this.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?$')R\x04name\x12#\n" +
@@ -2946,14 +3371,19 @@ const file_attestation_v1_crafting_state_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
 	"\tWithEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xe1\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a\xe2\x04\n" +
 	"\tViolation\x12 \n" +
 	"\asubject\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\asubject\x12 \n" +
 	"\amessage\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\amessage\x12R\n" +
 	"\rvulnerability\x18\x03 \x01(\v2*.attestation.v1.PolicyVulnerabilityFindingH\x00R\rvulnerability\x127\n" +
 	"\x04sast\x18\x04 \x01(\v2!.attestation.v1.PolicySASTFindingH\x00R\x04sast\x12\\\n" +
-	"\x11license_violation\x18\x05 \x01(\v2-.attestation.v1.PolicyLicenseViolationFindingH\x00R\x10licenseViolation\x12\x1a\n" +
-	"\bsuppress\x18\x06 \x01(\bR\bsuppressB\t\n" +
+	"\x11license_violation\x18\x05 \x01(\v2-.attestation.v1.PolicyLicenseViolationFindingH\x00R\x10licenseViolation\x12=\n" +
+	"\x06secret\x18\b \x01(\v2#.attestation.v1.PolicySecretFindingH\x00R\x06secret\x12[\n" +
+	"\x10misconfiguration\x18\t \x01(\v2-.attestation.v1.PolicyMisconfigurationFindingH\x00R\x10misconfiguration\x12@\n" +
+	"\amalware\x18\n" +
+	" \x01(\v2$.attestation.v1.PolicyMalwareFindingH\x00R\amalware\x12\x1a\n" +
+	"\bsuppress\x18\x06 \x01(\bR\bsuppress\x12!\n" +
+	"\fexception_id\x18\a \x01(\tR\vexceptionIdB\t\n" +
 	"\afinding\x1a\xfc\x01\n" +
 	"\tReference\x12\x97\x01\n" +
 	"\x04name\x18\x01 \x01(\tB\x82\x01\xbaH\x7f\xba\x01|\n" +
@@ -3009,6 +3439,51 @@ const file_attestation_v1_crafting_state_proto_rawDesc = "" +
 	"\n" +
 	"assessment\x18\b \x01(\v2&.attestation.v1.PolicyAssessmentResultH\x00R\n" +
 	"assessment\x88\x01\x01B\r\n" +
+	"\v_assessment\"\x93\x03\n" +
+	"\x13PolicySecretFinding\x12 \n" +
+	"\amessage\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\amessage\x12\x1f\n" +
+	"\arule_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x06ruleId\x12\"\n" +
+	"\blocation\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\blocation\x12\x1f\n" +
+	"\vline_number\x18\x04 \x01(\x05R\n" +
+	"lineNumber\x12\x1a\n" +
+	"\bseverity\x18\x05 \x01(\tR\bseverity\x12\x16\n" +
+	"\x06commit\x18\x06 \x01(\tR\x06commit\x12\x1a\n" +
+	"\bverified\x18\a \x01(\bR\bverified\x12 \n" +
+	"\vfingerprint\x18\b \x01(\tR\vfingerprint\x12&\n" +
+	"\x0erecommendation\x18\t \x01(\tR\x0erecommendation\x12K\n" +
+	"\n" +
+	"assessment\x18\n" +
+	" \x01(\v2&.attestation.v1.PolicyAssessmentResultH\x00R\n" +
+	"assessment\x88\x01\x01B\r\n" +
+	"\v_assessment\"\x8d\x03\n" +
+	"\x1dPolicyMisconfigurationFinding\x12 \n" +
+	"\amessage\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\amessage\x12!\n" +
+	"\bcheck_id\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\acheckId\x12\"\n" +
+	"\bseverity\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\bseverity\x12\"\n" +
+	"\blocation\x18\x04 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\blocation\x12\x1a\n" +
+	"\bresource\x18\x05 \x01(\tR\bresource\x12\x1f\n" +
+	"\vline_number\x18\x06 \x01(\x05R\n" +
+	"lineNumber\x12&\n" +
+	"\x0erecommendation\x18\a \x01(\tR\x0erecommendation\x12\x1e\n" +
+	"\n" +
+	"references\x18\b \x03(\tR\n" +
+	"references\x12K\n" +
+	"\n" +
+	"assessment\x18\t \x01(\v2&.attestation.v1.PolicyAssessmentResultH\x00R\n" +
+	"assessment\x88\x01\x01B\r\n" +
+	"\v_assessment\"\xf5\x02\n" +
+	"\x14PolicyMalwareFinding\x12 \n" +
+	"\amessage\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\amessage\x12$\n" +
+	"\tsignature\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tsignature\x12\"\n" +
+	"\blocation\x18\x03 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\blocation\x12\x16\n" +
+	"\x06digest\x18\x04 \x01(\tR\x06digest\x12!\n" +
+	"\fpackage_purl\x18\x05 \x01(\tR\vpackagePurl\x12\x16\n" +
+	"\x06engine\x18\x06 \x01(\tR\x06engine\x12\x1a\n" +
+	"\bseverity\x18\a \x01(\tR\bseverity\x12&\n" +
+	"\x0erecommendation\x18\b \x01(\tR\x0erecommendation\x12K\n" +
+	"\n" +
+	"assessment\x18\t \x01(\v2&.attestation.v1.PolicyAssessmentResultH\x00R\n" +
+	"assessment\x88\x01\x01B\r\n" +
 	"\v_assessment\"\x87\x01\n" +
 	"\x16PolicyAssessmentResult\x12)\n" +
 	"\x10effective_status\x18\x01 \x01(\tR\x0feffectiveStatus\x12B\n" +
@@ -3097,7 +3572,7 @@ func file_attestation_v1_crafting_state_proto_rawDescGZIP() []byte {
 }
 
 var file_attestation_v1_crafting_state_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_attestation_v1_crafting_state_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_attestation_v1_crafting_state_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_attestation_v1_crafting_state_proto_goTypes = []any{
 	(Attestation_Auth_AuthType)(0),                    // 0: attestation.v1.Attestation.Auth.AuthType
 	(Commit_CommitVerification_VerificationStatus)(0), // 1: attestation.v1.Commit.CommitVerification.VerificationStatus
@@ -3108,99 +3583,108 @@ var file_attestation_v1_crafting_state_proto_goTypes = []any{
 	(*PolicyVulnerabilityFinding)(nil),                // 6: attestation.v1.PolicyVulnerabilityFinding
 	(*PolicySASTFinding)(nil),                         // 7: attestation.v1.PolicySASTFinding
 	(*PolicyLicenseViolationFinding)(nil),             // 8: attestation.v1.PolicyLicenseViolationFinding
-	(*PolicyAssessmentResult)(nil),                    // 9: attestation.v1.PolicyAssessmentResult
-	(*PolicyAssessment)(nil),                          // 10: attestation.v1.PolicyAssessment
-	(*Commit)(nil),                                    // 11: attestation.v1.Commit
-	(*CraftingState)(nil),                             // 12: attestation.v1.CraftingState
-	(*WorkflowMetadata)(nil),                          // 13: attestation.v1.WorkflowMetadata
-	(*ProjectVersion)(nil),                            // 14: attestation.v1.ProjectVersion
-	(*ResourceDescriptor)(nil),                        // 15: attestation.v1.ResourceDescriptor
-	nil,                                               // 16: attestation.v1.Attestation.MaterialsEntry
-	nil,                                               // 17: attestation.v1.Attestation.AnnotationsEntry
-	(*Attestation_Material)(nil),                      // 18: attestation.v1.Attestation.Material
-	nil,                                               // 19: attestation.v1.Attestation.EnvVarsEntry
-	(*Attestation_Auth)(nil),                          // 20: attestation.v1.Attestation.Auth
-	(*Attestation_CASBackend)(nil),                    // 21: attestation.v1.Attestation.CASBackend
-	(*Attestation_SigningOptions)(nil),                // 22: attestation.v1.Attestation.SigningOptions
-	nil,                                               // 23: attestation.v1.Attestation.Material.AnnotationsEntry
-	(*Attestation_Material_KeyVal)(nil),               // 24: attestation.v1.Attestation.Material.KeyVal
-	(*Attestation_Material_ContainerImage)(nil),       // 25: attestation.v1.Attestation.Material.ContainerImage
-	(*Attestation_Material_Artifact)(nil),             // 26: attestation.v1.Attestation.Material.Artifact
-	(*Attestation_Material_SBOMArtifact)(nil),         // 27: attestation.v1.Attestation.Material.SBOMArtifact
-	(*Attestation_Material_SBOMArtifact_MainComponent)(nil), // 28: attestation.v1.Attestation.Material.SBOMArtifact.MainComponent
-	nil,                                      // 29: attestation.v1.PolicyEvaluation.AnnotationsEntry
-	nil,                                      // 30: attestation.v1.PolicyEvaluation.WithEntry
-	(*PolicyEvaluation_Violation)(nil),       // 31: attestation.v1.PolicyEvaluation.Violation
-	(*PolicyEvaluation_Reference)(nil),       // 32: attestation.v1.PolicyEvaluation.Reference
-	(*PolicyEvaluation_RawResult)(nil),       // 33: attestation.v1.PolicyEvaluation.RawResult
-	(*Commit_Remote)(nil),                    // 34: attestation.v1.Commit.Remote
-	(*Commit_CommitVerification)(nil),        // 35: attestation.v1.Commit.CommitVerification
-	nil,                                      // 36: attestation.v1.ResourceDescriptor.DigestEntry
-	(*timestamppb.Timestamp)(nil),            // 37: google.protobuf.Timestamp
-	(v1.CraftingSchema_Runner_RunnerType)(0), // 38: workflowcontract.v1.CraftingSchema.Runner.RunnerType
-	(v1.CraftingSchema_Material_MaterialType)(0), // 39: workflowcontract.v1.CraftingSchema.Material.MaterialType
-	(*v1.CraftingSchema)(nil),                    // 40: workflowcontract.v1.CraftingSchema
-	(*v1.CraftingSchemaV2)(nil),                  // 41: workflowcontract.v1.CraftingSchemaV2
-	(*structpb.Struct)(nil),                      // 42: google.protobuf.Struct
-	(*wrapperspb.BoolValue)(nil),                 // 43: google.protobuf.BoolValue
+	(*PolicySecretFinding)(nil),                       // 9: attestation.v1.PolicySecretFinding
+	(*PolicyMisconfigurationFinding)(nil),             // 10: attestation.v1.PolicyMisconfigurationFinding
+	(*PolicyMalwareFinding)(nil),                      // 11: attestation.v1.PolicyMalwareFinding
+	(*PolicyAssessmentResult)(nil),                    // 12: attestation.v1.PolicyAssessmentResult
+	(*PolicyAssessment)(nil),                          // 13: attestation.v1.PolicyAssessment
+	(*Commit)(nil),                                    // 14: attestation.v1.Commit
+	(*CraftingState)(nil),                             // 15: attestation.v1.CraftingState
+	(*WorkflowMetadata)(nil),                          // 16: attestation.v1.WorkflowMetadata
+	(*ProjectVersion)(nil),                            // 17: attestation.v1.ProjectVersion
+	(*ResourceDescriptor)(nil),                        // 18: attestation.v1.ResourceDescriptor
+	nil,                                               // 19: attestation.v1.Attestation.MaterialsEntry
+	nil,                                               // 20: attestation.v1.Attestation.AnnotationsEntry
+	(*Attestation_Material)(nil),                      // 21: attestation.v1.Attestation.Material
+	nil,                                               // 22: attestation.v1.Attestation.EnvVarsEntry
+	(*Attestation_Auth)(nil),                          // 23: attestation.v1.Attestation.Auth
+	(*Attestation_CASBackend)(nil),                    // 24: attestation.v1.Attestation.CASBackend
+	(*Attestation_SigningOptions)(nil),                // 25: attestation.v1.Attestation.SigningOptions
+	nil,                                               // 26: attestation.v1.Attestation.Material.AnnotationsEntry
+	(*Attestation_Material_KeyVal)(nil),               // 27: attestation.v1.Attestation.Material.KeyVal
+	(*Attestation_Material_ContainerImage)(nil),       // 28: attestation.v1.Attestation.Material.ContainerImage
+	(*Attestation_Material_Artifact)(nil),             // 29: attestation.v1.Attestation.Material.Artifact
+	(*Attestation_Material_SBOMArtifact)(nil),         // 30: attestation.v1.Attestation.Material.SBOMArtifact
+	(*Attestation_Material_SBOMArtifact_MainComponent)(nil), // 31: attestation.v1.Attestation.Material.SBOMArtifact.MainComponent
+	nil,                                      // 32: attestation.v1.PolicyEvaluation.AnnotationsEntry
+	nil,                                      // 33: attestation.v1.PolicyEvaluation.WithEntry
+	(*PolicyEvaluation_Violation)(nil),       // 34: attestation.v1.PolicyEvaluation.Violation
+	(*PolicyEvaluation_Reference)(nil),       // 35: attestation.v1.PolicyEvaluation.Reference
+	(*PolicyEvaluation_RawResult)(nil),       // 36: attestation.v1.PolicyEvaluation.RawResult
+	(*Commit_Remote)(nil),                    // 37: attestation.v1.Commit.Remote
+	(*Commit_CommitVerification)(nil),        // 38: attestation.v1.Commit.CommitVerification
+	nil,                                      // 39: attestation.v1.ResourceDescriptor.DigestEntry
+	(*timestamppb.Timestamp)(nil),            // 40: google.protobuf.Timestamp
+	(v1.CraftingSchema_Runner_RunnerType)(0), // 41: workflowcontract.v1.CraftingSchema.Runner.RunnerType
+	(v1.CraftingSchema_Material_MaterialType)(0), // 42: workflowcontract.v1.CraftingSchema.Material.MaterialType
+	(*v1.CraftingSchema)(nil),                    // 43: workflowcontract.v1.CraftingSchema
+	(*v1.CraftingSchemaV2)(nil),                  // 44: workflowcontract.v1.CraftingSchemaV2
+	(*structpb.Struct)(nil),                      // 45: google.protobuf.Struct
+	(*wrapperspb.BoolValue)(nil),                 // 46: google.protobuf.BoolValue
 }
 var file_attestation_v1_crafting_state_proto_depIdxs = []int32{
-	37, // 0: attestation.v1.Attestation.initialized_at:type_name -> google.protobuf.Timestamp
-	37, // 1: attestation.v1.Attestation.finished_at:type_name -> google.protobuf.Timestamp
-	13, // 2: attestation.v1.Attestation.workflow:type_name -> attestation.v1.WorkflowMetadata
-	16, // 3: attestation.v1.Attestation.materials:type_name -> attestation.v1.Attestation.MaterialsEntry
-	17, // 4: attestation.v1.Attestation.annotations:type_name -> attestation.v1.Attestation.AnnotationsEntry
-	19, // 5: attestation.v1.Attestation.env_vars:type_name -> attestation.v1.Attestation.EnvVarsEntry
-	38, // 6: attestation.v1.Attestation.runner_type:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
-	11, // 7: attestation.v1.Attestation.head:type_name -> attestation.v1.Commit
+	40, // 0: attestation.v1.Attestation.initialized_at:type_name -> google.protobuf.Timestamp
+	40, // 1: attestation.v1.Attestation.finished_at:type_name -> google.protobuf.Timestamp
+	16, // 2: attestation.v1.Attestation.workflow:type_name -> attestation.v1.WorkflowMetadata
+	19, // 3: attestation.v1.Attestation.materials:type_name -> attestation.v1.Attestation.MaterialsEntry
+	20, // 4: attestation.v1.Attestation.annotations:type_name -> attestation.v1.Attestation.AnnotationsEntry
+	22, // 5: attestation.v1.Attestation.env_vars:type_name -> attestation.v1.Attestation.EnvVarsEntry
+	41, // 6: attestation.v1.Attestation.runner_type:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
+	14, // 7: attestation.v1.Attestation.head:type_name -> attestation.v1.Commit
 	4,  // 8: attestation.v1.Attestation.policy_evaluations:type_name -> attestation.v1.PolicyEvaluation
-	22, // 9: attestation.v1.Attestation.signing_options:type_name -> attestation.v1.Attestation.SigningOptions
+	25, // 9: attestation.v1.Attestation.signing_options:type_name -> attestation.v1.Attestation.SigningOptions
 	3,  // 10: attestation.v1.Attestation.runner_environment:type_name -> attestation.v1.RunnerEnvironment
-	20, // 11: attestation.v1.Attestation.auth:type_name -> attestation.v1.Attestation.Auth
-	21, // 12: attestation.v1.Attestation.cas_backend:type_name -> attestation.v1.Attestation.CASBackend
-	38, // 13: attestation.v1.RunnerEnvironment.type:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
-	29, // 14: attestation.v1.PolicyEvaluation.annotations:type_name -> attestation.v1.PolicyEvaluation.AnnotationsEntry
-	31, // 15: attestation.v1.PolicyEvaluation.violations:type_name -> attestation.v1.PolicyEvaluation.Violation
-	30, // 16: attestation.v1.PolicyEvaluation.with:type_name -> attestation.v1.PolicyEvaluation.WithEntry
-	39, // 17: attestation.v1.PolicyEvaluation.type:type_name -> workflowcontract.v1.CraftingSchema.Material.MaterialType
-	32, // 18: attestation.v1.PolicyEvaluation.policy_reference:type_name -> attestation.v1.PolicyEvaluation.Reference
-	32, // 19: attestation.v1.PolicyEvaluation.group_reference:type_name -> attestation.v1.PolicyEvaluation.Reference
-	33, // 20: attestation.v1.PolicyEvaluation.raw_results:type_name -> attestation.v1.PolicyEvaluation.RawResult
+	23, // 11: attestation.v1.Attestation.auth:type_name -> attestation.v1.Attestation.Auth
+	24, // 12: attestation.v1.Attestation.cas_backend:type_name -> attestation.v1.Attestation.CASBackend
+	41, // 13: attestation.v1.RunnerEnvironment.type:type_name -> workflowcontract.v1.CraftingSchema.Runner.RunnerType
+	32, // 14: attestation.v1.PolicyEvaluation.annotations:type_name -> attestation.v1.PolicyEvaluation.AnnotationsEntry
+	34, // 15: attestation.v1.PolicyEvaluation.violations:type_name -> attestation.v1.PolicyEvaluation.Violation
+	33, // 16: attestation.v1.PolicyEvaluation.with:type_name -> attestation.v1.PolicyEvaluation.WithEntry
+	42, // 17: attestation.v1.PolicyEvaluation.type:type_name -> workflowcontract.v1.CraftingSchema.Material.MaterialType
+	35, // 18: attestation.v1.PolicyEvaluation.policy_reference:type_name -> attestation.v1.PolicyEvaluation.Reference
+	35, // 19: attestation.v1.PolicyEvaluation.group_reference:type_name -> attestation.v1.PolicyEvaluation.Reference
+	36, // 20: attestation.v1.PolicyEvaluation.raw_results:type_name -> attestation.v1.PolicyEvaluation.RawResult
 	4,  // 21: attestation.v1.PolicyEvaluationBundle.evaluations:type_name -> attestation.v1.PolicyEvaluation
-	9,  // 22: attestation.v1.PolicyVulnerabilityFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
-	9,  // 23: attestation.v1.PolicySASTFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
-	9,  // 24: attestation.v1.PolicyLicenseViolationFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
-	10, // 25: attestation.v1.PolicyAssessmentResult.assessments:type_name -> attestation.v1.PolicyAssessment
-	37, // 26: attestation.v1.Commit.date:type_name -> google.protobuf.Timestamp
-	34, // 27: attestation.v1.Commit.remotes:type_name -> attestation.v1.Commit.Remote
-	35, // 28: attestation.v1.Commit.platform_verification:type_name -> attestation.v1.Commit.CommitVerification
-	40, // 29: attestation.v1.CraftingState.input_schema:type_name -> workflowcontract.v1.CraftingSchema
-	41, // 30: attestation.v1.CraftingState.schema_v2:type_name -> workflowcontract.v1.CraftingSchemaV2
-	2,  // 31: attestation.v1.CraftingState.attestation:type_name -> attestation.v1.Attestation
-	14, // 32: attestation.v1.WorkflowMetadata.version:type_name -> attestation.v1.ProjectVersion
-	36, // 33: attestation.v1.ResourceDescriptor.digest:type_name -> attestation.v1.ResourceDescriptor.DigestEntry
-	42, // 34: attestation.v1.ResourceDescriptor.annotations:type_name -> google.protobuf.Struct
-	18, // 35: attestation.v1.Attestation.MaterialsEntry.value:type_name -> attestation.v1.Attestation.Material
-	24, // 36: attestation.v1.Attestation.Material.string:type_name -> attestation.v1.Attestation.Material.KeyVal
-	25, // 37: attestation.v1.Attestation.Material.container_image:type_name -> attestation.v1.Attestation.Material.ContainerImage
-	26, // 38: attestation.v1.Attestation.Material.artifact:type_name -> attestation.v1.Attestation.Material.Artifact
-	27, // 39: attestation.v1.Attestation.Material.sbom_artifact:type_name -> attestation.v1.Attestation.Material.SBOMArtifact
-	37, // 40: attestation.v1.Attestation.Material.added_at:type_name -> google.protobuf.Timestamp
-	39, // 41: attestation.v1.Attestation.Material.material_type:type_name -> workflowcontract.v1.CraftingSchema.Material.MaterialType
-	23, // 42: attestation.v1.Attestation.Material.annotations:type_name -> attestation.v1.Attestation.Material.AnnotationsEntry
-	0,  // 43: attestation.v1.Attestation.Auth.type:type_name -> attestation.v1.Attestation.Auth.AuthType
-	43, // 44: attestation.v1.Attestation.Material.ContainerImage.has_latest_tag:type_name -> google.protobuf.BoolValue
-	26, // 45: attestation.v1.Attestation.Material.SBOMArtifact.artifact:type_name -> attestation.v1.Attestation.Material.Artifact
-	28, // 46: attestation.v1.Attestation.Material.SBOMArtifact.main_component:type_name -> attestation.v1.Attestation.Material.SBOMArtifact.MainComponent
-	6,  // 47: attestation.v1.PolicyEvaluation.Violation.vulnerability:type_name -> attestation.v1.PolicyVulnerabilityFinding
-	7,  // 48: attestation.v1.PolicyEvaluation.Violation.sast:type_name -> attestation.v1.PolicySASTFinding
-	8,  // 49: attestation.v1.PolicyEvaluation.Violation.license_violation:type_name -> attestation.v1.PolicyLicenseViolationFinding
-	1,  // 50: attestation.v1.Commit.CommitVerification.status:type_name -> attestation.v1.Commit.CommitVerification.VerificationStatus
-	51, // [51:51] is the sub-list for method output_type
-	51, // [51:51] is the sub-list for method input_type
-	51, // [51:51] is the sub-list for extension type_name
-	51, // [51:51] is the sub-list for extension extendee
-	0,  // [0:51] is the sub-list for field type_name
+	12, // 22: attestation.v1.PolicyVulnerabilityFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
+	12, // 23: attestation.v1.PolicySASTFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
+	12, // 24: attestation.v1.PolicyLicenseViolationFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
+	12, // 25: attestation.v1.PolicySecretFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
+	12, // 26: attestation.v1.PolicyMisconfigurationFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
+	12, // 27: attestation.v1.PolicyMalwareFinding.assessment:type_name -> attestation.v1.PolicyAssessmentResult
+	13, // 28: attestation.v1.PolicyAssessmentResult.assessments:type_name -> attestation.v1.PolicyAssessment
+	40, // 29: attestation.v1.Commit.date:type_name -> google.protobuf.Timestamp
+	37, // 30: attestation.v1.Commit.remotes:type_name -> attestation.v1.Commit.Remote
+	38, // 31: attestation.v1.Commit.platform_verification:type_name -> attestation.v1.Commit.CommitVerification
+	43, // 32: attestation.v1.CraftingState.input_schema:type_name -> workflowcontract.v1.CraftingSchema
+	44, // 33: attestation.v1.CraftingState.schema_v2:type_name -> workflowcontract.v1.CraftingSchemaV2
+	2,  // 34: attestation.v1.CraftingState.attestation:type_name -> attestation.v1.Attestation
+	17, // 35: attestation.v1.WorkflowMetadata.version:type_name -> attestation.v1.ProjectVersion
+	39, // 36: attestation.v1.ResourceDescriptor.digest:type_name -> attestation.v1.ResourceDescriptor.DigestEntry
+	45, // 37: attestation.v1.ResourceDescriptor.annotations:type_name -> google.protobuf.Struct
+	21, // 38: attestation.v1.Attestation.MaterialsEntry.value:type_name -> attestation.v1.Attestation.Material
+	27, // 39: attestation.v1.Attestation.Material.string:type_name -> attestation.v1.Attestation.Material.KeyVal
+	28, // 40: attestation.v1.Attestation.Material.container_image:type_name -> attestation.v1.Attestation.Material.ContainerImage
+	29, // 41: attestation.v1.Attestation.Material.artifact:type_name -> attestation.v1.Attestation.Material.Artifact
+	30, // 42: attestation.v1.Attestation.Material.sbom_artifact:type_name -> attestation.v1.Attestation.Material.SBOMArtifact
+	40, // 43: attestation.v1.Attestation.Material.added_at:type_name -> google.protobuf.Timestamp
+	42, // 44: attestation.v1.Attestation.Material.material_type:type_name -> workflowcontract.v1.CraftingSchema.Material.MaterialType
+	26, // 45: attestation.v1.Attestation.Material.annotations:type_name -> attestation.v1.Attestation.Material.AnnotationsEntry
+	0,  // 46: attestation.v1.Attestation.Auth.type:type_name -> attestation.v1.Attestation.Auth.AuthType
+	46, // 47: attestation.v1.Attestation.Material.ContainerImage.has_latest_tag:type_name -> google.protobuf.BoolValue
+	29, // 48: attestation.v1.Attestation.Material.SBOMArtifact.artifact:type_name -> attestation.v1.Attestation.Material.Artifact
+	31, // 49: attestation.v1.Attestation.Material.SBOMArtifact.main_component:type_name -> attestation.v1.Attestation.Material.SBOMArtifact.MainComponent
+	6,  // 50: attestation.v1.PolicyEvaluation.Violation.vulnerability:type_name -> attestation.v1.PolicyVulnerabilityFinding
+	7,  // 51: attestation.v1.PolicyEvaluation.Violation.sast:type_name -> attestation.v1.PolicySASTFinding
+	8,  // 52: attestation.v1.PolicyEvaluation.Violation.license_violation:type_name -> attestation.v1.PolicyLicenseViolationFinding
+	9,  // 53: attestation.v1.PolicyEvaluation.Violation.secret:type_name -> attestation.v1.PolicySecretFinding
+	10, // 54: attestation.v1.PolicyEvaluation.Violation.misconfiguration:type_name -> attestation.v1.PolicyMisconfigurationFinding
+	11, // 55: attestation.v1.PolicyEvaluation.Violation.malware:type_name -> attestation.v1.PolicyMalwareFinding
+	1,  // 56: attestation.v1.Commit.CommitVerification.status:type_name -> attestation.v1.Commit.CommitVerification.VerificationStatus
+	57, // [57:57] is the sub-list for method output_type
+	57, // [57:57] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_attestation_v1_crafting_state_proto_init() }
@@ -3211,21 +3695,27 @@ func file_attestation_v1_crafting_state_proto_init() {
 	file_attestation_v1_crafting_state_proto_msgTypes[4].OneofWrappers = []any{}
 	file_attestation_v1_crafting_state_proto_msgTypes[5].OneofWrappers = []any{}
 	file_attestation_v1_crafting_state_proto_msgTypes[6].OneofWrappers = []any{}
+	file_attestation_v1_crafting_state_proto_msgTypes[7].OneofWrappers = []any{}
+	file_attestation_v1_crafting_state_proto_msgTypes[8].OneofWrappers = []any{}
 	file_attestation_v1_crafting_state_proto_msgTypes[9].OneofWrappers = []any{}
-	file_attestation_v1_crafting_state_proto_msgTypes[10].OneofWrappers = []any{
+	file_attestation_v1_crafting_state_proto_msgTypes[12].OneofWrappers = []any{}
+	file_attestation_v1_crafting_state_proto_msgTypes[13].OneofWrappers = []any{
 		(*CraftingState_InputSchema)(nil),
 		(*CraftingState_SchemaV2)(nil),
 	}
-	file_attestation_v1_crafting_state_proto_msgTypes[16].OneofWrappers = []any{
+	file_attestation_v1_crafting_state_proto_msgTypes[19].OneofWrappers = []any{
 		(*Attestation_Material_String_)(nil),
 		(*Attestation_Material_ContainerImage_)(nil),
 		(*Attestation_Material_Artifact_)(nil),
 		(*Attestation_Material_SbomArtifact)(nil),
 	}
-	file_attestation_v1_crafting_state_proto_msgTypes[29].OneofWrappers = []any{
+	file_attestation_v1_crafting_state_proto_msgTypes[32].OneofWrappers = []any{
 		(*PolicyEvaluation_Violation_Vulnerability)(nil),
 		(*PolicyEvaluation_Violation_Sast)(nil),
 		(*PolicyEvaluation_Violation_LicenseViolation)(nil),
+		(*PolicyEvaluation_Violation_Secret)(nil),
+		(*PolicyEvaluation_Violation_Misconfiguration)(nil),
+		(*PolicyEvaluation_Violation_Malware)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3233,7 +3723,7 @@ func file_attestation_v1_crafting_state_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_attestation_v1_crafting_state_proto_rawDesc), len(file_attestation_v1_crafting_state_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   35,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -205,6 +205,80 @@ func TestGetEvaluableContentWithMetadata(t *testing.T) {
 			filename:  "testdata/sbom.cyclonedx.json",
 			testField: "bomFormat",
 		},
+		{
+			name: "cyclonedx xml sbom projected to json",
+			material: &Attestation_Material{
+				MaterialType: schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_XML,
+				M: &Attestation_Material_SbomArtifact{
+					SbomArtifact: &Attestation_Material_SBOMArtifact{
+						Artifact: &Attestation_Material_Artifact{
+							Name: "name", Digest: "sha256:deadbeef", IsSubject: true,
+							Content: []byte(`<bom xmlns="http://cyclonedx.org/schema/bom/1.5"><components><component type="library"><name>commons-text</name></component></components></bom>`),
+						},
+					},
+				},
+				InlineCas: true,
+			},
+			testField: "components",
+		},
+		{
+			name: "spdx tag-value sbom projected to json",
+			material: &Attestation_Material{
+				MaterialType: schemaapi.CraftingSchema_Material_SBOM_SPDX_TAG_VALUE,
+				M: &Attestation_Material_SbomArtifact{
+					SbomArtifact: &Attestation_Material_SBOMArtifact{
+						Artifact: &Attestation_Material_Artifact{
+							Name: "name", Digest: "sha256:deadbeef", IsSubject: true,
+							Content: []byte("SPDXVersion: SPDX-2.3\nDataLicense: CC0-1.0\nSPDXID: SPDXRef-DOCUMENT\nDocumentName: app\n\nPackageName: app\nSPDXID: SPDXRef-Package-app\nPackageDownloadLocation: NOASSERTION\n"),
+						},
+					},
+				},
+				InlineCas: true,
+			},
+			testField: "packages",
+		},
+		{
+			name: "semgrep report with structured findings",
+			material: &Attestation_Material{
+				MaterialType: schemaapi.CraftingSchema_Material_SEMGREP_JSON,
+				M: &Attestation_Material_Artifact_{
+					Artifact: &Attestation_Material_Artifact{
+						Name: "name", Digest: "sha256:deadbeef", IsSubject: true,
+						Content: []byte(`{"version": "1.90.0", "results": [{"check_id": "go.lang.security.audit.crypto.math_random", "path": "main.go", "start": {"line": 3}, "extra": {"message": "weak random", "severity": "WARNING"}}], "errors": [], "paths": {"scanned": ["main.go"]}}`),
+					},
+				},
+				InlineCas: true,
+			},
+			testField: "chainloop_findings",
+		},
+		{
+			name: "snyk report of all projects with structured findings",
+			material: &Attestation_Material{
+				MaterialType: schemaapi.CraftingSchema_Material_SNYK_JSON,
+				M: &Attestation_Material_Artifact_{
+					Artifact: &Attestation_Material_Artifact{
+						Name: "name", Digest: "sha256:deadbeef", IsSubject: true,
+						Content: []byte(`[{"ok": false, "packageManager": "npm", "vulnerabilities": [{"id": "SNYK-JS-LODASH-567746", "title": "Prototype Pollution", "severity": "high", "packageName": "lodash", "version": "4.17.15"}]}]`),
+					},
+				},
+				InlineCas: true,
+			},
+			testField: "elements",
+		},
+		{
+			name: "kubernetes manifests projected to json",
+			material: &Attestation_Material{
+				MaterialType: schemaapi.CraftingSchema_Material_KUBERNETES_MANIFESTS,
+				M: &Attestation_Material_Artifact_{
+					Artifact: &Attestation_Material_Artifact{
+						Name: "name", Digest: "sha256:deadbeef", IsSubject: true,
+						Content: []byte("---\n# Source: web/templates/pod.yaml\napiVersion: v1\nkind: Pod\nmetadata:\n  name: web\nspec:\n  containers:\n    - name: web\n      image: nginx\n      securityContext:\n        privileged: true\n"),
+					},
+				},
+				InlineCas: true,
+			},
+			testField: "elements",
+		},
 		{
 			name: "cobertura xml material projected to json",
 			material: &Attestation_Material{
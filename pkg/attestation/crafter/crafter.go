@@ -38,12 +38,14 @@ import (
 	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/commitverification"
 	"github.com/chainloop-dev/chainloop/pkg/casclient"
 	"github.com/chainloop-dev/chainloop/pkg/policies"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"github.com/go-git/go-git/v6"
 	"github.com/go-git/go-git/v6/plumbing"
 	"github.com/go-git/go-git/v6/plumbing/storer"
 	"github.com/google/go-containerregistry/pkg/authn"
 	intoto "github.com/in-toto/attestation/go/v1"
 	"github.com/rs/zerolog"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -71,9 +73,16 @@ type Crafter struct {
 	stateManager  StateManager
 	// Authn is used to authenticate with the OCI registry
 	ociRegistryAuth authn.Keychain
+	// policyVerifiers verify the signatures of the policies and groups loaded from OCI registries
+	policyVerifiers []signature.Verifier
+	// vulnerabilityDB is the local OSV database the policies can query
+	vulnerabilityDB *osv.Database
 
 	// attestation client is used to load chainloop policies
 	attClient v1.AttestationServiceClient
+	// policy exceptions approved for the current workflow run, fetched once and shared across verifiers
+	policyExceptions      *policies.PolicyExceptions
+	policyExceptionsRunID string
 
 	// noStrictValidation skips strict schema validation
 	noStrictValidation bool
@@ -129,6 +138,39 @@ func WithOCIAuth(server, username, password string) NewOpt {
 	}
 }
 
+// WithPolicyVerificationKeys requires the policies and groups loaded from OCI registries
+// to be signed by any of the provided public keys
+func WithPolicyVerificationKeys(keyPaths ...string) NewOpt {
+	return func(c *Crafter) error {
+		verifiers, err := policies.LoadOCIVerifiers(keyPaths...)
+		if err != nil {
+			return fmt.Errorf("failed to load policy verification keys: %w", err)
+		}
+
+		c.policyVerifiers = verifiers
+		return nil
+	}
+}
+
+// WithVulnerabilityDB opens the local OSV database the policies can query with chainloop.osv_lookup,
+// either a directory of advisories or a zip archive. It's only loaded once a policy queries it.
+func WithVulnerabilityDB(path string) NewOpt {
+	return func(c *Crafter) error {
+		db, err := osv.Open(path)
+		if err != nil {
+			return err
+		}
+
+		c.vulnerabilityDB = db
+		return nil
+	}
+}
+
+// PolicyOCIOptions returns the options used to load policies and groups from OCI registries
+func (c *Crafter) PolicyOCIOptions() *policies.OCIOptions {
+	return &policies.OCIOptions{Keychain: c.ociRegistryAuth, Verifiers: c.policyVerifiers}
+}
+
 func WithNoStrictValidation(noStrictValidation bool) NewOpt {
 	return func(c *Crafter) error {
 		c.noStrictValidation = noStrictValidation
@@ -805,6 +847,11 @@ func (c *Crafter) stageMaterial(ctx context.Context, m *schemaapi.CraftingSchema
 		policies.WithAllowedHostnames(c.CraftingState.Attestation.PoliciesAllowedHostnames...),
 		policies.WithDefaultGate(c.CraftingState.Attestation.GetBlockOnPolicyViolation()),
 		policies.WithProjectContext(projectName, projectVersion),
+		policies.WithOCIKeychain(c.ociRegistryAuth),
+		policies.WithOCIVerifiers(c.policyVerifiers...),
+		policies.WithLocalGitRefs(true),
+		policies.WithVulnerabilityDB(c.vulnerabilityDB),
+		policies.WithPolicyExceptions(c.workflowRunPolicyExceptions()),
 	)
 	policyGroupResults, err := pgv.VerifyMaterial(ctx, mt, value)
 	if err != nil {
@@ -825,6 +872,11 @@ func (c *Crafter) stageMaterial(ctx context.Context, m *schemaapi.CraftingSchema
 		policies.WithAllowedHostnames(c.CraftingState.Attestation.PoliciesAllowedHostnames...),
 		policies.WithDefaultGate(c.CraftingState.Attestation.GetBlockOnPolicyViolation()),
 		policies.WithProjectContext(projectName, projectVersion),
+		policies.WithOCIKeychain(c.ociRegistryAuth),
+		policies.WithOCIVerifiers(c.policyVerifiers...),
+		policies.WithLocalGitRefs(true),
+		policies.WithVulnerabilityDB(c.vulnerabilityDB),
+		policies.WithPolicyExceptions(c.workflowRunPolicyExceptions()),
 		policies.WithRuntimeInputs(addOptions.runtimeInputs),
 	)
 	policyResults, err := pv.VerifyMaterial(ctx, mt, value)
@@ -1049,6 +1101,17 @@ func (c *Crafter) AddMaterialsFromArchive(
 	return result, nil
 }
 
+// workflowRunPolicyExceptions returns the policy exceptions that apply to the current workflow run
+func (c *Crafter) workflowRunPolicyExceptions() *policies.PolicyExceptions {
+	runID := c.CraftingState.GetAttestation().GetWorkflow().GetWorkflowRunId()
+	if c.policyExceptions == nil || c.policyExceptionsRunID != runID {
+		c.policyExceptions = policies.NewPolicyExceptions(c.attClient, runID)
+		c.policyExceptionsRunID = runID
+	}
+
+	return c.policyExceptions
+}
+
 // projectContext returns the project name and version from the workflow
 // metadata so policy verifiers can pass them to the engine. Either may be
 // empty (e.g. dry-run before workflow metadata is populated); built-ins
@@ -1082,6 +1145,11 @@ func (c *Crafter) EvaluateAttestationPolicies(ctx context.Context, attestationID
 		policies.WithDefaultGate(c.CraftingState.Attestation.GetBlockOnPolicyViolation()),
 		policies.WithEvalPhase(phase),
 		policies.WithProjectContext(projectName, projectVersion),
+		policies.WithOCIKeychain(c.ociRegistryAuth),
+		policies.WithOCIVerifiers(c.policyVerifiers...),
+		policies.WithLocalGitRefs(true),
+		policies.WithVulnerabilityDB(c.vulnerabilityDB),
+		policies.WithPolicyExceptions(c.workflowRunPolicyExceptions()),
 	)
 	policyEvaluations, err := pv.VerifyStatement(ctx, statement)
 	if err != nil {
@@ -1093,6 +1161,11 @@ func (c *Crafter) EvaluateAttestationPolicies(ctx context.Context, attestationID
 		policies.WithDefaultGate(c.CraftingState.Attestation.GetBlockOnPolicyViolation()),
 		policies.WithEvalPhase(phase),
 		policies.WithProjectContext(projectName, projectVersion),
+		policies.WithOCIKeychain(c.ociRegistryAuth),
+		policies.WithOCIVerifiers(c.policyVerifiers...),
+		policies.WithLocalGitRefs(true),
+		policies.WithVulnerabilityDB(c.vulnerabilityDB),
+		policies.WithPolicyExceptions(c.workflowRunPolicyExceptions()),
 	)
 	policyGroupResults, err := pgv.VerifyStatement(ctx, statement)
 	if err != nil {
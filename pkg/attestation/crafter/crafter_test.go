@@ -643,6 +643,46 @@ func (s *crafterSuite) TestAddMaterialsAutomatic() {
 			materialPath: "./materials/testdata/openvex_v0.2.0.json",
 			expectedType: schemaapi.CraftingSchema_Material_OPENVEX,
 		},
+		{
+			name:         "cyclonedx xml",
+			materialPath: "./materials/testdata/sbom.cyclonedx-1.5.xml",
+			expectedType: schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_XML,
+		},
+		{
+			name:         "spdx tag-value",
+			materialPath: "./materials/testdata/sbom.spdx",
+			expectedType: schemaapi.CraftingSchema_Material_SBOM_SPDX_TAG_VALUE,
+		},
+		{
+			name:         "trivy",
+			materialPath: "./materials/testdata/trivy-report.json",
+			expectedType: schemaapi.CraftingSchema_Material_TRIVY_JSON,
+		},
+		{
+			name:         "grype",
+			materialPath: "./materials/testdata/grype-report.json",
+			expectedType: schemaapi.CraftingSchema_Material_GRYPE_JSON,
+		},
+		{
+			name:         "semgrep",
+			materialPath: "./materials/testdata/semgrep-report.json",
+			expectedType: schemaapi.CraftingSchema_Material_SEMGREP_JSON,
+		},
+		{
+			name:         "snyk",
+			materialPath: "./materials/testdata/snyk-report.json",
+			expectedType: schemaapi.CraftingSchema_Material_SNYK_JSON,
+		},
+		{
+			name:         "snyk code is still sarif",
+			materialPath: "./materials/testdata/snyk-code-report.json",
+			expectedType: schemaapi.CraftingSchema_Material_SARIF,
+		},
+		{
+			name:         "terraform plan",
+			materialPath: "./materials/testdata/terraform-plan.json",
+			expectedType: schemaapi.CraftingSchema_Material_TERRAFORM_PLAN_JSON,
+		},
 		{
 			name:         "HELM CHART",
 			materialPath: "./materials/testdata/valid-chart.tgz",
@@ -95,6 +95,8 @@ func WalkArchiveEntries(path string, format ArchiveFormat, limits ArchiveLimits,
 var explodableKinds = map[string]struct{}{
 	schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_JSON.String(): {},
 	schemaapi.CraftingSchema_Material_SBOM_SPDX_JSON.String():      {},
+	schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_XML.String():  {},
+	schemaapi.CraftingSchema_Material_SBOM_SPDX_TAG_VALUE.String(): {},
 	schemaapi.CraftingSchema_Material_SARIF.String():               {},
 }
 
@@ -253,6 +253,8 @@ func TestIsExplodableKind(t *testing.T) {
 	// Explodable: SBOM and SARIF bundles.
 	assert.True(t, IsExplodableKind("SBOM_CYCLONEDX_JSON"))
 	assert.True(t, IsExplodableKind("SBOM_SPDX_JSON"))
+	assert.True(t, IsExplodableKind("SBOM_CYCLONEDX_XML"))
+	assert.True(t, IsExplodableKind("SBOM_SPDX_TAG_VALUE"))
 	assert.True(t, IsExplodableKind("SARIF"))
 	// Not explodable: recorded whole even when a zip/tar is provided.
 	assert.False(t, IsExplodableKind("ARTIFACT"))
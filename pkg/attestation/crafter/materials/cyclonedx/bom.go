@@ -0,0 +1,203 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cyclonedx parses CycloneDX SBOMs in XML format. The document is
+// rendered as CycloneDX JSON so the same policies and metadata extraction
+// apply to both formats. Only the commonly used fields are mapped.
+// https://cyclonedx.org/docs/latest/xml/
+package cyclonedx
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotABOM is returned when the document is not a CycloneDX XML SBOM
+var ErrNotABOM = errors.New("not a CycloneDX XML document")
+
+// xmlns of the bom element, followed by the spec version, i.e http://cyclonedx.org/schema/bom/1.5
+const namespacePrefix = "http://cyclonedx.org/schema/bom/"
+
+type BOM struct {
+	XMLName         xml.Name        `xml:"bom" json:"-"`
+	SerialNumber    string          `xml:"serialNumber,attr" json:"serialNumber,omitempty"`
+	Version         int             `xml:"version,attr" json:"version"`
+	Metadata        *Metadata       `xml:"metadata" json:"metadata,omitempty"`
+	Components      []Component     `xml:"components>component" json:"components,omitempty"`
+	Dependencies    []Dependency    `xml:"dependencies>dependency" json:"dependencies,omitempty"`
+	Vulnerabilities []Vulnerability `xml:"vulnerabilities>vulnerability" json:"vulnerabilities,omitempty"`
+}
+
+type Metadata struct {
+	Timestamp string     `xml:"timestamp" json:"timestamp,omitempty"`
+	Tools     *Tools     `xml:"tools" json:"tools,omitempty"`
+	Component *Component `xml:"component" json:"component,omitempty"`
+}
+
+// Tools is either the legacy list of tools (< 1.5) or the tools described as components
+type Tools struct {
+	Tools      []Tool      `xml:"tool"`
+	Components []Component `xml:"components>component"`
+}
+
+type Tool struct {
+	Vendor  string `xml:"vendor" json:"vendor,omitempty"`
+	Name    string `xml:"name" json:"name,omitempty"`
+	Version string `xml:"version" json:"version,omitempty"`
+}
+
+type Component struct {
+	Type        string      `xml:"type,attr" json:"type"`
+	BOMRef      string      `xml:"bom-ref,attr" json:"bom-ref,omitempty"`
+	Group       string      `xml:"group" json:"group,omitempty"`
+	Name        string      `xml:"name" json:"name"`
+	Version     string      `xml:"version" json:"version,omitempty"`
+	Description string      `xml:"description" json:"description,omitempty"`
+	Scope       string      `xml:"scope" json:"scope,omitempty"`
+	Hashes      []Hash      `xml:"hashes>hash" json:"hashes,omitempty"`
+	Licenses    *Licenses   `xml:"licenses" json:"licenses,omitempty"`
+	CPE         string      `xml:"cpe" json:"cpe,omitempty"`
+	PURL        string      `xml:"purl" json:"purl,omitempty"`
+	Properties  []Property  `xml:"properties>property" json:"properties,omitempty"`
+	Components  []Component `xml:"components>component" json:"components,omitempty"`
+}
+
+type Hash struct {
+	Alg     string `xml:"alg,attr" json:"alg"`
+	Content string `xml:",chardata" json:"content"`
+}
+
+type Licenses struct {
+	Licenses    []License `xml:"license"`
+	Expressions []string  `xml:"expression"`
+}
+
+type License struct {
+	ID   string `xml:"id" json:"id,omitempty"`
+	Name string `xml:"name" json:"name,omitempty"`
+	URL  string `xml:"url" json:"url,omitempty"`
+}
+
+type Property struct {
+	Name  string `xml:"name,attr" json:"name"`
+	Value string `xml:",chardata" json:"value"`
+}
+
+type Dependency struct {
+	Ref       string       `xml:"ref,attr"`
+	DependsOn []Dependency `xml:"dependency"`
+}
+
+type Vulnerability struct {
+	BOMRef      string   `xml:"bom-ref,attr" json:"bom-ref,omitempty"`
+	ID          string   `xml:"id" json:"id,omitempty"`
+	Source      *Source  `xml:"source" json:"source,omitempty"`
+	Ratings     []Rating `xml:"ratings>rating" json:"ratings,omitempty"`
+	CWEs        []int    `xml:"cwes>cwe" json:"cwes,omitempty"`
+	Description string   `xml:"description" json:"description,omitempty"`
+	Affects     []Affect `xml:"affects>target" json:"affects,omitempty"`
+}
+
+type Source struct {
+	Name string `xml:"name" json:"name,omitempty"`
+	URL  string `xml:"url" json:"url,omitempty"`
+}
+
+type Rating struct {
+	Source   *Source  `xml:"source" json:"source,omitempty"`
+	Score    *float64 `xml:"score" json:"score,omitempty"`
+	Severity string   `xml:"severity" json:"severity,omitempty"`
+	Method   string   `xml:"method" json:"method,omitempty"`
+	Vector   string   `xml:"vector" json:"vector,omitempty"`
+}
+
+type Affect struct {
+	Ref string `xml:"ref" json:"ref"`
+}
+
+// Parse decodes a CycloneDX XML SBOM, identified by the namespace of its root element
+func Parse(data []byte) (*BOM, error) {
+	var bom BOM
+	if err := xml.Unmarshal(data, &bom); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotABOM, err)
+	}
+
+	if !strings.HasPrefix(bom.XMLName.Space, namespacePrefix) {
+		return nil, fmt.Errorf("%w: unexpected namespace %q", ErrNotABOM, bom.XMLName.Space)
+	}
+
+	// version is optional in the XML schema, 1 by default
+	if bom.Version == 0 {
+		bom.Version = 1
+	}
+
+	return &bom, nil
+}
+
+// SpecVersion returns the version of the CycloneDX specification, from the document namespace
+func (b *BOM) SpecVersion() string {
+	return strings.TrimPrefix(b.XMLName.Space, namespacePrefix)
+}
+
+// MarshalJSON renders the document as CycloneDX JSON
+func (b *BOM) MarshalJSON() ([]byte, error) {
+	type bom BOM
+	return json.Marshal(&struct {
+		BOMFormat   string `json:"bomFormat"`
+		SpecVersion string `json:"specVersion"`
+		*bom
+	}{BOMFormat: "CycloneDX", SpecVersion: b.SpecVersion(), bom: (*bom)(b)})
+}
+
+func (t *Tools) MarshalJSON() ([]byte, error) {
+	if len(t.Components) > 0 {
+		return json.Marshal(map[string]any{"components": t.Components})
+	}
+
+	if t.Tools == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(t.Tools)
+}
+
+func (l *Licenses) MarshalJSON() ([]byte, error) {
+	res := make([]map[string]any, 0, len(l.Licenses)+len(l.Expressions))
+	for _, lic := range l.Licenses {
+		res = append(res, map[string]any{"license": lic})
+	}
+
+	for _, e := range l.Expressions {
+		res = append(res, map[string]any{"expression": e})
+	}
+
+	return json.Marshal(res)
+}
+
+func (d Dependency) MarshalJSON() ([]byte, error) {
+	res := struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn,omitempty"`
+	}{Ref: d.Ref}
+
+	for _, dep := range d.DependsOn {
+		res.DependsOn = append(res.DependsOn, dep.Ref)
+	}
+
+	return json.Marshal(res)
+}
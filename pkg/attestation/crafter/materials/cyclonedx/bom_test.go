@@ -0,0 +1,144 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cyclonedx
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "bom", content: `<bom xmlns="http://cyclonedx.org/schema/bom/1.6"/>`},
+		{name: "another namespace", content: `<bom xmlns="http://example.com/bom"/>`, wantErr: true},
+		{name: "another root element", content: `<testsuites xmlns="http://cyclonedx.org/schema/bom/1.6"/>`, wantErr: true},
+		{name: "json", content: `{"bomFormat": "CycloneDX"}`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bom, err := Parse([]byte(tc.content))
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrNotABOM)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "1.6", bom.SpecVersion())
+			assert.Equal(t, 1, bom.Version)
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	testCases := []struct {
+		name string
+		file string
+		want string
+	}{
+		{
+			name: "1.5",
+			file: "../testdata/sbom.cyclonedx-1.5.xml",
+			want: `{
+				"bomFormat": "CycloneDX",
+				"specVersion": "1.5",
+				"serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+				"version": 1,
+				"metadata": {
+					"timestamp": "2026-10-01T09:12:44Z",
+					"tools": {"components": [{"type": "application", "group": "org.cyclonedx", "name": "cyclonedx-maven-plugin", "version": "2.9.0"}]},
+					"component": {
+						"type": "application", "bom-ref": "pkg:maven/com.example/inventory-service@1.4.2?type=jar", "group": "com.example",
+						"name": "inventory-service", "version": "1.4.2", "purl": "pkg:maven/com.example/inventory-service@1.4.2?type=jar"
+					}
+				},
+				"components": [
+					{
+						"type": "library", "bom-ref": "pkg:maven/org.apache.commons/commons-text@1.9?type=jar", "group": "org.apache.commons",
+						"name": "commons-text", "version": "1.9", "scope": "required",
+						"hashes": [{"alg": "SHA-256", "content": "0812f284ac5dd0d617461d9a2ab6ac6811137f25122dfffd4788a4871e732d00"}],
+						"licenses": [{"license": {"id": "Apache-2.0"}}],
+						"purl": "pkg:maven/org.apache.commons/commons-text@1.9?type=jar"
+					},
+					{
+						"type": "library", "bom-ref": "pkg:maven/org.apache.commons/commons-lang3@3.11?type=jar", "group": "org.apache.commons",
+						"name": "commons-lang3", "version": "3.11",
+						"licenses": [{"expression": "Apache-2.0"}],
+						"purl": "pkg:maven/org.apache.commons/commons-lang3@3.11?type=jar"
+					}
+				],
+				"dependencies": [
+					{"ref": "pkg:maven/com.example/inventory-service@1.4.2?type=jar", "dependsOn": ["pkg:maven/org.apache.commons/commons-text@1.9?type=jar"]},
+					{"ref": "pkg:maven/org.apache.commons/commons-text@1.9?type=jar", "dependsOn": ["pkg:maven/org.apache.commons/commons-lang3@3.11?type=jar"]}
+				]
+			}`,
+		},
+		{
+			name: "1.4 with legacy tools and vulnerabilities",
+			file: "../testdata/sbom.cyclonedx-1.4.xml",
+			want: `{
+				"bomFormat": "CycloneDX",
+				"specVersion": "1.4",
+				"serialNumber": "urn:uuid:9a1e52b5-6b0c-4b8e-9f3e-2d4a1c0b7e11",
+				"version": 1,
+				"metadata": {
+					"timestamp": "2026-09-12T15:03:27Z",
+					"tools": [{"vendor": "aquasecurity", "name": "trivy", "version": "0.45.1"}],
+					"component": {
+						"type": "container", "bom-ref": "pkg:oci/controlplane@sha256%3A15d3b3c5",
+						"name": "ghcr.io/chainloop-dev/chainloop/control-plane:v0.55.0",
+						"properties": [{"name": "aquasecurity:trivy:RepoDigest", "value": "ghcr.io/chainloop-dev/chainloop/control-plane@sha256:15d3b3c5b4e1dfbcb41d68bb8fc6d1e3f9bf6d7ab1b0ac5d1c7ab3c6b8e0a1f2"}]
+					}
+				},
+				"components": [
+					{"type": "library", "bom-ref": "pkg:golang/golang.org/x/net@v0.17.0", "name": "golang.org/x/net", "version": "v0.17.0", "purl": "pkg:golang/golang.org/x/net@v0.17.0"}
+				],
+				"vulnerabilities": [
+					{
+						"bom-ref": "CVE-2023-45288",
+						"id": "CVE-2023-45288",
+						"source": {"name": "ghsa", "url": "https://github.com/advisories/GHSA-4v7x-pqxf-cx7m"},
+						"ratings": [{"score": 7.5, "severity": "high", "method": "CVSSv31", "vector": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H"}],
+						"cwes": [770],
+						"affects": [{"ref": "pkg:golang/golang.org/x/net@v0.17.0"}]
+					}
+				]
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			content, err := os.ReadFile(tc.file)
+			require.NoError(t, err)
+
+			bom, err := Parse(content)
+			require.NoError(t, err)
+
+			got, err := json.Marshal(bom)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
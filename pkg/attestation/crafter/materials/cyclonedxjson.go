@@ -159,15 +159,21 @@ func (i *CyclonedxJSONCrafter) Craft(ctx context.Context, filePath string) (*api
 		},
 	}
 
-	// parse the file to extract the main information
+	i.extractInformation(m, f)
+
+	return res, nil
+}
+
+// extractInformation parses the CycloneDX JSON document to extract the main component, tools and annotations
+func (i *CyclonedxJSONCrafter) extractInformation(m *api.Attestation_Material, f []byte) {
 	var doc cyclonedxDoc
-	if err = json.Unmarshal(f, &doc); err != nil {
+	if err := json.Unmarshal(f, &doc); err != nil {
 		i.logger.Debug().Err(err).Msg("error decoding file to extract main information, skipping ...")
 	}
 
 	// Try with metadata tools format > v1.5
 	var metaV15 cyclonedxMetadataV15
-	if err = json.Unmarshal(doc.Metadata, &metaV15); err != nil {
+	if err := json.Unmarshal(doc.Metadata, &metaV15); err != nil {
 		// try with v1.4
 		var metaV14 cyclonedxMetadataV14
 		if err = json.Unmarshal(doc.Metadata, &metaV14); err != nil {
@@ -180,8 +186,6 @@ func (i *CyclonedxJSONCrafter) Craft(ctx context.Context, filePath string) (*api
 	}
 
 	i.injectAnnotations(m, &doc)
-
-	return res, nil
 }
 
 func (i *CyclonedxJSONCrafter) injectAnnotations(m *api.Attestation_Material, doc *cyclonedxDoc) {
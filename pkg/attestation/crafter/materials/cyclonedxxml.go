@@ -0,0 +1,83 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	api "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/cyclonedx"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	"github.com/rs/zerolog"
+)
+
+type CyclonedxXMLCrafter struct {
+	backend *casclient.CASBackend
+	*crafterCommon
+}
+
+func NewCyclonedxXMLCrafter(materialSchema *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, l *zerolog.Logger) (*CyclonedxXMLCrafter, error) {
+	if materialSchema.Type != schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_XML {
+		return nil, fmt.Errorf("material type is not cyclonedx xml")
+	}
+
+	return &CyclonedxXMLCrafter{
+		backend:       backend,
+		crafterCommon: &crafterCommon{logger: l, input: materialSchema},
+	}, nil
+}
+
+func (i *CyclonedxXMLCrafter) Craft(ctx context.Context, filePath string) (*api.Attestation_Material, error) {
+	f, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open the file: %w", err)
+	}
+
+	bom, err := cyclonedx.Parse(f)
+	if err != nil {
+		i.logger.Debug().Err(err).Msg("error decoding file")
+		return nil, fmt.Errorf("invalid cyclonedx sbom file: %w", ErrInvalidMaterialType)
+	}
+
+	m, err := uploadAndCraft(ctx, i.input, i.backend, filePath, i.logger)
+	if err != nil {
+		return nil, fmt.Errorf("error crafting material: %w", err)
+	}
+
+	res := m
+	res.M = &api.Attestation_Material_SbomArtifact{
+		SbomArtifact: &api.Attestation_Material_SBOMArtifact{
+			Artifact: m.GetArtifact(),
+		},
+	}
+
+	// The main component, tools and annotations are extracted from the JSON rendering
+	// of the document, the same way as for CycloneDX JSON SBOMs
+	doc, err := json.Marshal(bom)
+	if err != nil {
+		i.logger.Debug().Err(err).Msg("error rendering sbom to extract main information, skipping ...")
+		return res, nil
+	}
+
+	jsonCrafter := &CyclonedxJSONCrafter{crafterCommon: i.crafterCommon}
+	jsonCrafter.extractInformation(m, doc)
+
+	return res, nil
+}
@@ -0,0 +1,128 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials_test
+
+import (
+	"context"
+	"testing"
+
+	contractAPI "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attestationApi "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	mUploader "github.com/chainloop-dev/chainloop/pkg/casclient/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCyclonedxXMLCraft(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		filePath                 string
+		wantErr                  string
+		wantDigest               string
+		wantFilename             string
+		wantMainComponent        string
+		wantMainComponentKind    string
+		wantMainComponentVersion string
+		annotations              map[string]string
+	}{
+		{
+			name:     "json sbom",
+			filePath: "./testdata/sbom.cyclonedx.json",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "another xml document",
+			filePath: "./testdata/junit.xml",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:                     "1.5 version",
+			filePath:                 "./testdata/sbom.cyclonedx-1.5.xml",
+			wantDigest:               "sha256:16fd3c7a3a4042ee5e177471e7ca85ff34faf0a40845f02982518ea3b959079b",
+			wantFilename:             "sbom.cyclonedx-1.5.xml",
+			wantMainComponent:        "inventory-service",
+			wantMainComponentKind:    "application",
+			wantMainComponentVersion: "1.4.2",
+			annotations: map[string]string{
+				"chainloop.material.tool.name":    "cyclonedx-maven-plugin",
+				"chainloop.material.tool.version": "2.9.0",
+			},
+		},
+		{
+			name:                     "1.4 version with vulnerabilities",
+			filePath:                 "./testdata/sbom.cyclonedx-1.4.xml",
+			wantDigest:               "sha256:0876a22096a716577f19d17c95e6c73a6e2eb56c8503db866b72a06ee6287f92",
+			wantFilename:             "sbom.cyclonedx-1.4.xml",
+			wantMainComponent:        "ghcr.io/chainloop-dev/chainloop/control-plane",
+			wantMainComponentKind:    "container",
+			wantMainComponentVersion: "sha256:15d3b3c5b4e1dfbcb41d68bb8fc6d1e3f9bf6d7ab1b0ac5d1c7ab3c6b8e0a1f2",
+			annotations: map[string]string{
+				"chainloop.material.tool.name":                   "trivy",
+				"chainloop.material.tool.version":                "0.45.1",
+				"chainloop.material.sbom.vulnerabilities_report": "true",
+			},
+		},
+	}
+
+	schema := &contractAPI.CraftingSchema_Material{
+		Name: "test",
+		Type: contractAPI.CraftingSchema_Material_SBOM_CYCLONEDX_XML,
+	}
+	l := zerolog.Nop()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			uploader := mUploader.NewUploader(t)
+			if tc.wantErr == "" {
+				uploader.On("Upload", context.TODO(), mock.Anything, mock.Anything, mock.Anything).
+					Return(&casclient.UpDownStatus{}, nil)
+			}
+
+			backend := &casclient.CASBackend{Uploader: uploader}
+			crafter, err := materials.NewCyclonedxXMLCrafter(schema, backend, &l)
+			require.NoError(t, err)
+
+			got, err := crafter.Craft(context.TODO(), tc.filePath)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, contractAPI.CraftingSchema_Material_SBOM_CYCLONEDX_XML, got.MaterialType)
+			assert.Equal(t,
+				&attestationApi.Attestation_Material_SBOMArtifact{
+					Artifact: &attestationApi.Attestation_Material_Artifact{
+						Id: "test", Digest: tc.wantDigest, Name: tc.wantFilename,
+					},
+					MainComponent: &attestationApi.Attestation_Material_SBOMArtifact_MainComponent{
+						Name:    tc.wantMainComponent,
+						Kind:    tc.wantMainComponentKind,
+						Version: tc.wantMainComponentVersion,
+					},
+				},
+				got.GetSbomArtifact(),
+			)
+
+			for k, v := range tc.annotations {
+				assert.Equal(t, v, got.Annotations[k])
+			}
+		})
+	}
+}
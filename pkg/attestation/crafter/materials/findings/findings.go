@@ -0,0 +1,59 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package findings has the structured findings scanner results are mapped to. Their keys match
+// the policy finding types, i.e PolicySASTFinding, so policies can return them as violations as is.
+package findings
+
+import "strings"
+
+// SAST is a static analysis finding, with the keys of PolicySASTFinding
+type SAST struct {
+	Message        string   `json:"message"`
+	RuleID         string   `json:"rule_id"`
+	Severity       string   `json:"severity"`
+	Location       string   `json:"location"`
+	LineNumber     int      `json:"line_number,omitempty"`
+	CodeSnippet    string   `json:"code_snippet,omitempty"`
+	Recommendation string   `json:"recommendation,omitempty"`
+	SeverityScore  *float64 `json:"severity_score,omitempty"`
+}
+
+// Vulnerability is a vulnerable package finding, with the keys of PolicyVulnerabilityFinding
+type Vulnerability struct {
+	Message        string   `json:"message"`
+	ExternalID     string   `json:"external_id"`
+	PackagePURL    string   `json:"package_purl"`
+	Severity       string   `json:"severity"`
+	CVSSV3Score    *float64 `json:"cvss_v3_score,omitempty"`
+	CWEs           []string `json:"cwes,omitempty"`
+	Recommendation string   `json:"recommendation,omitempty"`
+	FixedVersion   string   `json:"fixed_version,omitempty"`
+}
+
+// Severity normalizes the severity, or level, reported by a scanner to CRITICAL, HIGH, MEDIUM or LOW
+func Severity(s string) string {
+	switch strings.ToLower(s) {
+	case "critical":
+		return "CRITICAL"
+	case "high", "error":
+		return "HIGH"
+	case "medium", "moderate", "warning":
+		return "MEDIUM"
+	default:
+		// low, info, note and anything unknown
+		return "LOW"
+	}
+}
@@ -0,0 +1,66 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	api "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/grype"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	"github.com/rs/zerolog"
+)
+
+type GrypeJSONCrafter struct {
+	backend *casclient.CASBackend
+	*crafterCommon
+}
+
+func NewGrypeJSONCrafter(materialSchema *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, l *zerolog.Logger) (*GrypeJSONCrafter, error) {
+	if materialSchema.Type != schemaapi.CraftingSchema_Material_GRYPE_JSON {
+		return nil, fmt.Errorf("material type is not a Grype report in JSON format")
+	}
+
+	return &GrypeJSONCrafter{
+		backend:       backend,
+		crafterCommon: &crafterCommon{logger: l, input: materialSchema},
+	}, nil
+}
+
+func (i *GrypeJSONCrafter) Craft(ctx context.Context, filePath string) (*api.Attestation_Material, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open the file: %w", err)
+	}
+
+	report, err := grype.Parse(data)
+	if err != nil {
+		i.logger.Debug().Err(err).Msgf("error decoding file: %s", filePath)
+		return nil, fmt.Errorf("invalid Grype report: %w", ErrInvalidMaterialType)
+	}
+
+	m, err := uploadAndCraft(ctx, i.input, i.backend, filePath, i.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	setScannerAnnotations(m, Tool{Name: report.Descriptor.Name, Version: report.Descriptor.Version})
+
+	return m, nil
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grype parses Grype's JSON report (grype -o json). See
+// https://github.com/anchore/grype#output-formats
+package grype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotAReport is returned when the content is JSON but not a Grype report
+var ErrNotAReport = errors.New("not a grype report")
+
+// Report is the root of a Grype JSON report. Only the fields needed to validate
+// the report and extract its packages are decoded.
+type Report struct {
+	Matches    []Match    `json:"matches"`
+	Source     Source     `json:"source"`
+	Descriptor Descriptor `json:"descriptor"`
+}
+
+// Match is a vulnerability found in a package
+type Match struct {
+	Vulnerability Vulnerability `json:"vulnerability"`
+	Artifact      Artifact      `json:"artifact"`
+}
+
+// Vulnerability is the vulnerability matched, with its fix if any
+type Vulnerability struct {
+	ID       string `json:"id"`
+	Severity string `json:"severity"`
+	Fix      struct {
+		Versions []string `json:"versions"`
+		State    string   `json:"state"`
+	} `json:"fix"`
+}
+
+// Artifact is the vulnerable package
+type Artifact struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Type    string `json:"type"`
+	PURL    string `json:"purl,omitempty"`
+}
+
+// Source is what was scanned, i.e an image or a directory
+type Source struct {
+	Type   string          `json:"type"`
+	Target json.RawMessage `json:"target"`
+}
+
+// Descriptor describes the Grype binary that produced the report
+type Descriptor struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Parse decodes and validates a Grype JSON report, recognized by its descriptor
+func Parse(data []byte) (*Report, error) {
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotAReport, err)
+	}
+
+	if r.Descriptor.Name != "grype" || r.Matches == nil {
+		return nil, ErrNotAReport
+	}
+
+	return &r, nil
+}
+
+// Packages returns the vulnerable packages in the report, deduplicated
+func (r *Report) Packages() []Artifact {
+	var res []Artifact
+	seen := make(map[string]bool)
+	for _, m := range r.Matches {
+		key := m.Artifact.PURL
+		if key == "" {
+			key = m.Artifact.Name + "@" + m.Artifact.Version
+		}
+
+		if m.Artifact.Name == "" || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		res = append(res, m.Artifact)
+	}
+
+	return res
+}
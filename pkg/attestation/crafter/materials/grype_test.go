@@ -0,0 +1,118 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials_test
+
+import (
+	"context"
+	"testing"
+
+	contractAPI "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attestationApi "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	mUploader "github.com/chainloop-dev/chainloop/pkg/casclient/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrypeJSONCraft(t *testing.T) {
+	testCases := []struct {
+		name            string
+		filePath        string
+		wantErr         string
+		wantFilename    string
+		wantDigest      string
+		wantTools       string
+		wantToolVersion string
+	}{
+		{
+			name:     "invalid path",
+			filePath: "./testdata/non-existing.json",
+			wantErr:  "no such file or directory",
+		},
+		{
+			name:     "invalid artifact type",
+			filePath: "./testdata/simple.txt",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "unrecognized json type",
+			filePath: "./testdata/sbom.cyclonedx.json",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "trivy report",
+			filePath: "./testdata/trivy-report.json",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:            "valid report",
+			filePath:        "./testdata/grype-report.json",
+			wantDigest:      "sha256:4e111fce4644999ce2097e008a14ed0a32c3b8cf19e2e306bd193b12e0414a66",
+			wantFilename:    "grype-report.json",
+			wantTools:       `["grype@0.82.2"]`,
+			wantToolVersion: "0.82.2",
+		},
+		{
+			name:            "clean report",
+			filePath:        "./testdata/grype-report-clean.json",
+			wantDigest:      "sha256:06c72b3c6f0bc1128d1c5ca58cbb62974ca790560e78e132321e261175b0602b",
+			wantFilename:    "grype-report-clean.json",
+			wantTools:       `["grype@0.82.2"]`,
+			wantToolVersion: "0.82.2",
+		},
+	}
+
+	schema := &contractAPI.CraftingSchema_Material{
+		Name: "test",
+		Type: contractAPI.CraftingSchema_Material_GRYPE_JSON,
+	}
+
+	l := zerolog.Nop()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock uploader
+			uploader := mUploader.NewUploader(t)
+			if tc.wantErr == "" {
+				uploader.On("Upload", context.TODO(), mock.Anything, mock.Anything, mock.Anything).
+					Return(&casclient.UpDownStatus{}, nil)
+			}
+
+			backend := &casclient.CASBackend{Uploader: uploader}
+			crafter, err := materials.NewGrypeJSONCrafter(schema, backend, &l)
+			require.NoError(t, err)
+
+			got, err := crafter.Craft(context.TODO(), tc.filePath)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, contractAPI.CraftingSchema_Material_GRYPE_JSON.String(), got.MaterialType.String())
+			assert.True(t, got.UploadedToCas)
+			assert.Equal(t, &attestationApi.Attestation_Material_Artifact{
+				Id: "test", Digest: tc.wantDigest, Name: tc.wantFilename,
+			}, got.GetArtifact())
+
+			assert.Equal(t, tc.wantTools, got.Annotations[materials.AnnotationToolsKey])
+			assert.Equal(t, "grype", got.Annotations[materials.AnnotationToolNameKey])
+			assert.Equal(t, tc.wantToolVersion, got.Annotations[materials.AnnotationToolVersionKey])
+		})
+	}
+}
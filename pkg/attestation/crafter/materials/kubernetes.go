@@ -0,0 +1,78 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	api "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/kubernetes"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	"github.com/rs/zerolog"
+)
+
+const (
+	AnnotationKubernetesResourcesCount = "chainloop.material.kubernetes.resources.count"
+	AnnotationKubernetesKinds          = "chainloop.material.kubernetes.kinds"
+)
+
+type KubernetesManifestsCrafter struct {
+	backend *casclient.CASBackend
+	*crafterCommon
+}
+
+func NewKubernetesManifestsCrafter(materialSchema *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, l *zerolog.Logger) (*KubernetesManifestsCrafter, error) {
+	if materialSchema.Type != schemaapi.CraftingSchema_Material_KUBERNETES_MANIFESTS {
+		return nil, fmt.Errorf("material type is not a bundle of Kubernetes manifests")
+	}
+
+	return &KubernetesManifestsCrafter{
+		backend:       backend,
+		crafterCommon: &crafterCommon{logger: l, input: materialSchema},
+	}, nil
+}
+
+func (i *KubernetesManifestsCrafter) Craft(ctx context.Context, filePath string) (*api.Attestation_Material, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open the file: %w", err)
+	}
+
+	resources, err := kubernetes.Parse(data)
+	if err != nil {
+		i.logger.Debug().Err(err).Msgf("error decoding file: %s", filePath)
+		return nil, fmt.Errorf("invalid Kubernetes manifests: %w", ErrInvalidMaterialType)
+	}
+
+	m, err := uploadAndCraft(ctx, i.input, i.backend, filePath, i.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Annotations == nil {
+		m.Annotations = make(map[string]string)
+	}
+
+	m.Annotations[AnnotationKubernetesResourcesCount] = strconv.Itoa(len(resources))
+	m.Annotations[AnnotationKubernetesKinds] = strings.Join(kubernetes.Kinds(resources), ",")
+
+	return m, nil
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kubernetes parses bundles of Kubernetes manifests, i.e multi-document YAML files
+// or the output of helm template, into the list of resources they define.
+package kubernetes
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrNotManifests is returned when the content is not a bundle of Kubernetes manifests
+var ErrNotManifests = errors.New("not a bundle of kubernetes manifests")
+
+// Resource is a Kubernetes object as declared in the manifests
+type Resource map[string]any
+
+// APIVersion returns the group and version of the resource, i.e apps/v1
+func (r Resource) APIVersion() string {
+	v, _ := r["apiVersion"].(string)
+	return v
+}
+
+// Kind returns the kind of the resource, i.e Deployment
+func (r Resource) Kind() string {
+	v, _ := r["kind"].(string)
+	return v
+}
+
+// Parse decodes every YAML, or JSON, document in the bundle. Empty documents, such as the
+// ones helm template renders for disabled templates, are skipped and lists, i.e kind: List,
+// are expanded into their items. Every resource must declare its apiVersion and kind.
+func Parse(data []byte) ([]Resource, error) {
+	var res []Resource
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc any
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, fmt.Errorf("%w: %w", ErrNotManifests, err)
+		}
+
+		if doc == nil {
+			continue
+		}
+
+		obj, ok := doc.(map[string]any)
+		if !ok {
+			return nil, ErrNotManifests
+		}
+
+		resources, err := expand(Resource(obj))
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, resources...)
+	}
+
+	if len(res) == 0 {
+		return nil, ErrNotManifests
+	}
+
+	return res, nil
+}
+
+func expand(r Resource) ([]Resource, error) {
+	if r.APIVersion() == "" || r.Kind() == "" {
+		return nil, ErrNotManifests
+	}
+
+	if r.Kind() != "List" {
+		return []Resource{r}, nil
+	}
+
+	items, _ := r["items"].([]any)
+
+	res := make([]Resource, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, ErrNotManifests
+		}
+
+		expanded, err := expand(Resource(obj))
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, expanded...)
+	}
+
+	return res, nil
+}
+
+// Kinds returns the sorted, distinct kinds of the resources
+func Kinds(resources []Resource) []string {
+	var res []string
+	seen := make(map[string]bool)
+	for _, r := range resources {
+		if seen[r.Kind()] {
+			continue
+		}
+
+		seen[r.Kind()] = true
+		res = append(res, r.Kind())
+	}
+
+	sort.Strings(res)
+
+	return res
+}
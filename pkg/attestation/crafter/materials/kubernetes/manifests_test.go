@@ -0,0 +1,97 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		path      string
+		wantErr   bool
+		wantNames []string
+		wantKinds []string
+	}{
+		{
+			name:      "helm template output",
+			path:      "../testdata/kubernetes-manifests.yaml",
+			wantNames: []string{"web", "web", "web", "web-worker"},
+			wantKinds: []string{"Deployment", "Service", "ServiceAccount"},
+		},
+		{
+			name:      "list",
+			path:      "../testdata/kubernetes-list.yaml",
+			wantNames: []string{"settings", "debug"},
+			wantKinds: []string{"ConfigMap", "Pod"},
+		},
+		{
+			name:    "openapi spec",
+			path:    "../testdata/openapi-3.1.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "json sbom",
+			path:    "../testdata/sbom.cyclonedx.json",
+			wantErr: true,
+		},
+		{
+			name:    "plain text",
+			path:    "../testdata/simple.txt",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			path:    "../testdata/empty.txt",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := os.ReadFile(tc.path)
+			require.NoError(t, err)
+
+			got, err := Parse(data)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrNotManifests)
+				return
+			}
+
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(got))
+			for _, r := range got {
+				metadata, _ := r["metadata"].(map[string]any)
+				name, _ := metadata["name"].(string)
+				names = append(names, name)
+			}
+
+			assert.Equal(t, tc.wantNames, names)
+			assert.Equal(t, tc.wantKinds, Kinds(got))
+		})
+	}
+}
+
+func TestParseMissingKind(t *testing.T) {
+	_, err := Parse([]byte("apiVersion: v1\nkind: ConfigMap\n---\napiVersion: v1\nmetadata:\n  name: foo\n"))
+	assert.ErrorIs(t, err, ErrNotManifests)
+}
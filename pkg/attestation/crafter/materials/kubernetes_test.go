@@ -0,0 +1,112 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials_test
+
+import (
+	"context"
+	"testing"
+
+	contractAPI "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attestationApi "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	mUploader "github.com/chainloop-dev/chainloop/pkg/casclient/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKubernetesManifestsCraft(t *testing.T) {
+	testCases := []struct {
+		name          string
+		filePath      string
+		wantErr       string
+		wantFilename  string
+		wantDigest    string
+		wantResources string
+		wantKinds     string
+	}{
+		{
+			name:     "invalid path",
+			filePath: "./testdata/non-existing.yaml",
+			wantErr:  "no such file or directory",
+		},
+		{
+			name:     "invalid artifact type",
+			filePath: "./testdata/simple.txt",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "openapi spec",
+			filePath: "./testdata/openapi-3.1.yaml",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:          "helm template output",
+			filePath:      "./testdata/kubernetes-manifests.yaml",
+			wantDigest:    "sha256:b1df5162c9d40b548b1323aa550eb1f50753496e71d53653cd2589071b8e2bdf",
+			wantFilename:  "kubernetes-manifests.yaml",
+			wantResources: "4",
+			wantKinds:     "Deployment,Service,ServiceAccount",
+		},
+		{
+			name:          "list",
+			filePath:      "./testdata/kubernetes-list.yaml",
+			wantDigest:    "sha256:e7e929a8aa122c7c5429e0d9ed6db134ac15f4d6b1ed32126be880b2bdcc62bd",
+			wantFilename:  "kubernetes-list.yaml",
+			wantResources: "2",
+			wantKinds:     "ConfigMap,Pod",
+		},
+	}
+
+	schema := &contractAPI.CraftingSchema_Material{
+		Name: "test",
+		Type: contractAPI.CraftingSchema_Material_KUBERNETES_MANIFESTS,
+	}
+
+	l := zerolog.Nop()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock uploader
+			uploader := mUploader.NewUploader(t)
+			if tc.wantErr == "" {
+				uploader.On("Upload", context.TODO(), mock.Anything, mock.Anything, mock.Anything).
+					Return(&casclient.UpDownStatus{}, nil)
+			}
+
+			backend := &casclient.CASBackend{Uploader: uploader}
+			crafter, err := materials.NewKubernetesManifestsCrafter(schema, backend, &l)
+			require.NoError(t, err)
+
+			got, err := crafter.Craft(context.TODO(), tc.filePath)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, contractAPI.CraftingSchema_Material_KUBERNETES_MANIFESTS.String(), got.MaterialType.String())
+			assert.True(t, got.UploadedToCas)
+			assert.Equal(t, &attestationApi.Attestation_Material_Artifact{
+				Id: "test", Digest: tc.wantDigest, Name: tc.wantFilename,
+			}, got.GetArtifact())
+
+			assert.Equal(t, tc.wantResources, got.Annotations[materials.AnnotationKubernetesResourcesCount])
+			assert.Equal(t, tc.wantKinds, got.Annotations[materials.AnnotationKubernetesKinds])
+		})
+	}
+}
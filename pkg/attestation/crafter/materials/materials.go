@@ -165,6 +165,9 @@ type uploadAndCraftOpts struct {
 	// digest, which would collide across unrelated empty materials of any type.
 	// The original filename is preserved.
 	emptyContentFallback []byte
+	// content, when set, replaces the artifact content altogether, i.e to craft a
+	// redacted version of the file. The original filename is preserved.
+	content []byte
 }
 
 type uploadAndCraftOption func(*uploadAndCraftOpts)
@@ -175,6 +178,11 @@ func withEmptyContentFallback(content []byte) uploadAndCraftOption {
 	return func(o *uploadAndCraftOpts) { o.emptyContentFallback = content }
 }
 
+// withContent substitutes the given content for the artifact file
+func withContent(content []byte) uploadAndCraftOption {
+	return func(o *uploadAndCraftOpts) { o.content = content }
+}
+
 // uploadAndCraft uploads the artifact to CAS and crafts the material
 // this function is used by all the uploadable artifacts crafters (SBOMs, JUnit, and more in the future)
 func uploadAndCraft(ctx context.Context, input *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, artifactPath string, l *zerolog.Logger, opts ...uploadAndCraftOption) (*api.Attestation_Material, error) {
@@ -203,6 +211,14 @@ func uploadAndCraft(ctx context.Context, input *schemaapi.CraftingSchema_Materia
 		}
 	}
 
+	if options.content != nil {
+		_ = result.r.Close()
+		result, err = fileStatsFromBytes(result.filename, options.content)
+		if err != nil {
+			return nil, fmt.Errorf("getting file stats: %w", err)
+		}
+	}
+
 	// Determine if we should skip the upload based on contract setting
 	shouldSkipUpload := input.SkipUpload
 
@@ -303,7 +319,7 @@ func fileStats(filepath string) (*fileInfo, error) {
 
 // fileStatsFromBytes builds a fileInfo from in-memory content, preserving the
 // given filename. Used to craft a canonical representation when the on-disk
-// file is empty (see uploadAndCraft's emptyContentFallback).
+// file is empty, or a redacted one (see uploadAndCraft's options).
 func fileStatsFromBytes(filename string, content []byte) (*fileInfo, error) {
 	hash, _, err := cr_v1.SHA256(bytes.NewReader(content))
 	if err != nil {
@@ -350,6 +366,10 @@ func Craft(ctx context.Context, materialSchema *schemaapi.CraftingSchema_Materia
 		crafter, err = NewCyclonedxJSONCrafter(materialSchema, casBackend, logger, WithCycloneDXNoStrictValidation(opts.NoStrictValidation))
 	case schemaapi.CraftingSchema_Material_SBOM_SPDX_JSON:
 		crafter, err = NewSPDXJSONCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_SBOM_CYCLONEDX_XML:
+		crafter, err = NewCyclonedxXMLCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_SBOM_SPDX_TAG_VALUE:
+		crafter, err = NewSPDXTagValueCrafter(materialSchema, casBackend, logger)
 	case schemaapi.CraftingSchema_Material_JUNIT_XML:
 		crafter, err = NewJUnitXMLCrafter(materialSchema, casBackend, logger)
 	case schemaapi.CraftingSchema_Material_JACOCO_XML:
@@ -424,6 +444,18 @@ func Craft(ctx context.Context, materialSchema *schemaapi.CraftingSchema_Materia
 		crafter, err = NewTrufflehogCrafter(materialSchema, casBackend, logger)
 	case schemaapi.CraftingSchema_Material_CHECKMARX_JSON:
 		crafter, err = NewCheckmarxCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_TRIVY_JSON:
+		crafter, err = NewTrivyJSONCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_GRYPE_JSON:
+		crafter, err = NewGrypeJSONCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_SEMGREP_JSON:
+		crafter, err = NewSemgrepJSONCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_SNYK_JSON:
+		crafter, err = NewSnykJSONCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_TERRAFORM_PLAN_JSON:
+		crafter, err = NewTerraformPlanJSONCrafter(materialSchema, casBackend, logger)
+	case schemaapi.CraftingSchema_Material_KUBERNETES_MANIFESTS:
+		crafter, err = NewKubernetesManifestsCrafter(materialSchema, casBackend, logger)
 	default:
 		return nil, fmt.Errorf("material of type %q not supported yet", materialSchema.Type)
 	}
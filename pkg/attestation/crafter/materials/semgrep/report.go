@@ -0,0 +1,95 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semgrep parses Semgrep reports in JSON format (semgrep scan --json)
+// https://semgrep.dev/docs/cli-reference
+package semgrep
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/findings"
+)
+
+// ErrNotAReport is returned when the document is not a Semgrep JSON report
+var ErrNotAReport = errors.New("not a Semgrep JSON report")
+
+type Report struct {
+	Version string          `json:"version"`
+	Results []Result        `json:"results"`
+	Errors  json.RawMessage `json:"errors"`
+	Paths   json.RawMessage `json:"paths"`
+}
+
+type Result struct {
+	CheckID string   `json:"check_id"`
+	Path    string   `json:"path"`
+	Start   Position `json:"start"`
+	Extra   Extra    `json:"extra"`
+}
+
+type Position struct {
+	Line int `json:"line"`
+	Col  int `json:"col"`
+}
+
+type Extra struct {
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+	Lines    string `json:"lines"`
+	Fix      string `json:"fix"`
+}
+
+// Parse decodes a Semgrep JSON report, which always lists its results, errors and scanned paths
+func Parse(data []byte) (*Report, error) {
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotAReport, err)
+	}
+
+	if report.Results == nil || report.Errors == nil || report.Paths == nil {
+		return nil, ErrNotAReport
+	}
+
+	return &report, nil
+}
+
+// Findings maps the results to SAST findings. Semgrep ERROR, WARNING and INFO severities are
+// mapped to HIGH, MEDIUM and LOW.
+func (r *Report) Findings() []*findings.SAST {
+	res := make([]*findings.SAST, 0, len(r.Results))
+	for _, result := range r.Results {
+		f := &findings.SAST{
+			Message:        strings.TrimSpace(result.Extra.Message),
+			RuleID:         result.CheckID,
+			Severity:       findings.Severity(result.Extra.Severity),
+			Location:       result.Path,
+			LineNumber:     result.Start.Line,
+			Recommendation: result.Extra.Fix,
+		}
+
+		// the lines are only included when logged in to the Semgrep AppSec platform
+		if result.Extra.Lines != "requires login" {
+			f.CodeSnippet = result.Extra.Lines
+		}
+
+		res = append(res, f)
+	}
+
+	return res
+}
@@ -0,0 +1,95 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semgrep_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/findings"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/semgrep"
+	policyfindings "github.com/chainloop-dev/chainloop/pkg/policies/findings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "report", content: `{"version": "1.90.0", "results": [], "errors": [], "paths": {"scanned": []}}`},
+		{name: "without version", content: `{"results": [], "errors": [], "paths": {"scanned": []}}`},
+		{name: "without scanned paths", content: `{"version": "1.90.0", "results": [], "errors": []}`, wantErr: true},
+		{name: "sarif", content: `{"version": "2.1.0", "runs": []}`, wantErr: true},
+		{name: "not json", content: `results: []`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := semgrep.Parse([]byte(tc.content))
+			if tc.wantErr {
+				assert.ErrorIs(t, err, semgrep.ErrNotAReport)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFindings(t *testing.T) {
+	content, err := os.ReadFile("../testdata/semgrep-report.json")
+	require.NoError(t, err)
+
+	report, err := semgrep.Parse(content)
+	require.NoError(t, err)
+
+	got := report.Findings()
+	assert.Equal(t, []*findings.SAST{
+		{
+			Message:  "Detected the use of eval(). eval() can be dangerous if used to evaluate dynamic content.",
+			RuleID:   "python.lang.security.audit.eval-detected.eval-detected",
+			Severity: "MEDIUM",
+			Location: "app/handlers.py",
+			// the code snippet requires logging in
+			LineNumber: 42,
+		},
+		{
+			Message:        "Detected user input used to manually construct a SQL string.",
+			RuleID:         "python.flask.security.injection.tainted-sql-string.tainted-sql-string",
+			Severity:       "HIGH",
+			Location:       "app/db.py",
+			LineNumber:     17,
+			CodeSnippet:    `    query = "SELECT * FROM users WHERE id = " + user_id`,
+			Recommendation: `query = "SELECT * FROM users WHERE id = %s"`,
+		},
+	}, got)
+
+	// they can be returned as SAST findings by policies
+	for _, f := range got {
+		data, err := json.Marshal(f)
+		require.NoError(t, err)
+
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal(data, &raw))
+
+		_, err = policyfindings.ValidateFinding(policyfindings.FindingTypeSAST, raw)
+		assert.NoError(t, err)
+	}
+}
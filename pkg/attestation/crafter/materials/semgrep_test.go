@@ -0,0 +1,107 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials_test
+
+import (
+	"context"
+	"testing"
+
+	contractAPI "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attestationApi "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	mUploader "github.com/chainloop-dev/chainloop/pkg/casclient/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemgrepJSONCraft(t *testing.T) {
+	testCases := []struct {
+		name         string
+		filePath     string
+		wantErr      string
+		wantFilename string
+		wantDigest   string
+	}{
+		{
+			name:     "invalid path",
+			filePath: "./testdata/non-existing.json",
+			wantErr:  "no such file or directory",
+		},
+		{
+			name:     "invalid artifact type",
+			filePath: "./testdata/simple.txt",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "trivy report",
+			filePath: "./testdata/trivy-report.json",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:         "valid report",
+			filePath:     "./testdata/semgrep-report.json",
+			wantDigest:   "sha256:3f901eaac06eb0e7b51d3df7aa9e5f8ca940f8cce6f1d26c86f5c75ae2707bfd",
+			wantFilename: "semgrep-report.json",
+		},
+		{
+			name:         "clean report",
+			filePath:     "./testdata/semgrep-report-clean.json",
+			wantDigest:   "sha256:065d2afc9cac13b697ff04401e43d8ee28a9c584abcb356c5eca0ffd83b17c5c",
+			wantFilename: "semgrep-report-clean.json",
+		},
+	}
+
+	schema := &contractAPI.CraftingSchema_Material{
+		Name: "test",
+		Type: contractAPI.CraftingSchema_Material_SEMGREP_JSON,
+	}
+
+	l := zerolog.Nop()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock uploader
+			uploader := mUploader.NewUploader(t)
+			if tc.wantErr == "" {
+				uploader.On("Upload", context.TODO(), mock.Anything, mock.Anything, mock.Anything).
+					Return(&casclient.UpDownStatus{}, nil)
+			}
+
+			backend := &casclient.CASBackend{Uploader: uploader}
+			crafter, err := materials.NewSemgrepJSONCrafter(schema, backend, &l)
+			require.NoError(t, err)
+
+			got, err := crafter.Craft(context.TODO(), tc.filePath)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, contractAPI.CraftingSchema_Material_SEMGREP_JSON.String(), got.MaterialType.String())
+			assert.True(t, got.UploadedToCas)
+			assert.Equal(t, &attestationApi.Attestation_Material_Artifact{
+				Id: "test", Digest: tc.wantDigest, Name: tc.wantFilename,
+			}, got.GetArtifact())
+
+			assert.Equal(t, `["semgrep@1.90.0"]`, got.Annotations[materials.AnnotationToolsKey])
+			assert.Equal(t, "semgrep", got.Annotations[materials.AnnotationToolNameKey])
+			assert.Equal(t, "1.90.0", got.Annotations[materials.AnnotationToolVersionKey])
+		})
+	}
+}
@@ -0,0 +1,279 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snyk parses Snyk reports in JSON format, either open source results (snyk test --json),
+// of one or many projects, or Snyk Code results, which are rendered in SARIF (snyk code test --json)
+// https://docs.snyk.io/snyk-cli/commands/test
+package snyk
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/findings"
+	"github.com/package-url/packageurl-go"
+)
+
+// ErrNotAReport is returned when the document is not a Snyk JSON report
+var ErrNotAReport = errors.New("not a Snyk JSON report")
+
+// Tool name of the Snyk Code runs in SARIF reports
+const codeToolName = "SnykCode"
+
+// Report is either an open source or a Snyk Code report
+type Report struct {
+	// open source results, one per project, more than one when testing with --all-projects
+	Projects []*Project
+	// Snyk Code results
+	Code *CodeReport
+}
+
+type Project struct {
+	OK                *bool           `json:"ok"`
+	PackageManager    string          `json:"packageManager"`
+	ProjectName       string          `json:"projectName"`
+	DisplayTargetFile string          `json:"displayTargetFile"`
+	Vulnerabilities   []Vulnerability `json:"vulnerabilities"`
+}
+
+type Vulnerability struct {
+	ID          string   `json:"id"`
+	Title       string   `json:"title"`
+	Severity    string   `json:"severity"`
+	CVSSScore   *float64 `json:"cvssScore"`
+	PackageName string   `json:"packageName"`
+	Version     string   `json:"version"`
+	Identifiers struct {
+		CVE []string `json:"CVE"`
+		CWE []string `json:"CWE"`
+	} `json:"identifiers"`
+	FixedIn []string `json:"fixedIn"`
+}
+
+// CodeReport is the subset of the SARIF report used to map Snyk Code results
+type CodeReport struct {
+	Runs []struct {
+		Tool struct {
+			Driver struct {
+				Name            string `json:"name"`
+				SemanticVersion string `json:"semanticVersion"`
+			} `json:"driver"`
+		} `json:"tool"`
+		Results []CodeResult `json:"results"`
+	} `json:"runs"`
+}
+
+type CodeResult struct {
+	RuleID  string `json:"ruleId"`
+	Level   string `json:"level"`
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+	Locations []struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+			Region struct {
+				StartLine int `json:"startLine"`
+			} `json:"region"`
+		} `json:"physicalLocation"`
+	} `json:"locations"`
+	Properties struct {
+		PriorityScore *float64 `json:"priorityScore"`
+	} `json:"properties"`
+}
+
+// Parse decodes a Snyk JSON report. Open source reports must include the test outcome, the package manager
+// and the vulnerabilities, which rules out error reports. SARIF reports must only have Snyk Code runs.
+func Parse(data []byte) (*Report, error) {
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var projects []*Project
+		if err := json.Unmarshal(data, &projects); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrNotAReport, err)
+		}
+
+		if len(projects) == 0 {
+			return nil, ErrNotAReport
+		}
+
+		for _, p := range projects {
+			if !p.isValid() {
+				return nil, ErrNotAReport
+			}
+		}
+
+		return &Report{Projects: projects}, nil
+	}
+
+	var project Project
+	if err := json.Unmarshal(data, &project); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotAReport, err)
+	}
+
+	if project.isValid() {
+		return &Report{Projects: []*Project{&project}}, nil
+	}
+
+	var code CodeReport
+	if err := json.Unmarshal(data, &code); err != nil || len(code.Runs) == 0 {
+		return nil, ErrNotAReport
+	}
+
+	for _, run := range code.Runs {
+		if run.Tool.Driver.Name != codeToolName {
+			return nil, ErrNotAReport
+		}
+	}
+
+	return &Report{Code: &code}, nil
+}
+
+func (p *Project) isValid() bool {
+	return p != nil && p.OK != nil && p.PackageManager != "" && p.Vulnerabilities != nil
+}
+
+// Tool returns the name and version of the tool that produced the report. Open source reports don't include the CLI version.
+func (r *Report) Tool() (string, string) {
+	if r.Code != nil {
+		return codeToolName, r.Code.Runs[0].Tool.Driver.SemanticVersion
+	}
+
+	return "snyk", ""
+}
+
+// VulnerabilityFindings maps the vulnerabilities of open source reports to vulnerability findings.
+// Snyk reports a vulnerability once per dependency path, they are only listed once.
+func (r *Report) VulnerabilityFindings() []*findings.Vulnerability {
+	res := make([]*findings.Vulnerability, 0)
+	seen := make(map[string]bool)
+	for _, p := range r.Projects {
+		for _, v := range p.Vulnerabilities {
+			purl := packagePURL(p.PackageManager, v.PackageName, v.Version)
+			key := v.ID + "|" + purl
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+
+			f := &findings.Vulnerability{
+				Message:     fmt.Sprintf("%s in %s@%s", v.Title, v.PackageName, v.Version),
+				ExternalID:  v.ID,
+				PackagePURL: purl,
+				Severity:    findings.Severity(v.Severity),
+				CWEs:        v.Identifiers.CWE,
+			}
+
+			// CVE identifiers are preferred, they are the ones exceptions and VEX statements refer to
+			if len(v.Identifiers.CVE) > 0 {
+				f.ExternalID = v.Identifiers.CVE[0]
+			}
+
+			if v.CVSSScore != nil && *v.CVSSScore >= 0 && *v.CVSSScore <= 10 {
+				f.CVSSV3Score = v.CVSSScore
+			}
+
+			if len(v.FixedIn) > 0 {
+				f.FixedVersion = v.FixedIn[0]
+				f.Recommendation = fmt.Sprintf("Upgrade %s to %s", v.PackageName, strings.Join(v.FixedIn, ", "))
+			}
+
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// SASTFindings maps the Snyk Code results to SAST findings, with the priority score as severity score
+func (r *Report) SASTFindings() []*findings.SAST {
+	res := make([]*findings.SAST, 0)
+	if r.Code == nil {
+		return res
+	}
+
+	for _, run := range r.Code.Runs {
+		for _, result := range run.Results {
+			f := &findings.SAST{
+				Message:       result.Message.Text,
+				RuleID:        result.RuleID,
+				Severity:      findings.Severity(result.Level),
+				SeverityScore: result.Properties.PriorityScore,
+			}
+
+			if len(result.Locations) > 0 {
+				loc := result.Locations[0].PhysicalLocation
+				f.Location = loc.ArtifactLocation.URI
+				f.LineNumber = loc.Region.StartLine
+			}
+
+			res = append(res, f)
+		}
+	}
+
+	return res
+}
+
+// Snyk package managers to purl types
+var purlTypes = map[string]string{
+	"npm":       packageurl.TypeNPM,
+	"yarn":      packageurl.TypeNPM,
+	"pnpm":      packageurl.TypeNPM,
+	"maven":     packageurl.TypeMaven,
+	"gradle":    packageurl.TypeMaven,
+	"sbt":       packageurl.TypeMaven,
+	"pip":       packageurl.TypePyPi,
+	"pipenv":    packageurl.TypePyPi,
+	"poetry":    packageurl.TypePyPi,
+	"rubygems":  packageurl.TypeGem,
+	"nuget":     packageurl.TypeNuget,
+	"paket":     packageurl.TypeNuget,
+	"gomodules": packageurl.TypeGolang,
+	"golangdep": packageurl.TypeGolang,
+	"govendor":  packageurl.TypeGolang,
+	"composer":  packageurl.TypeComposer,
+	"cocoapods": packageurl.TypeCocoapods,
+	"hex":       packageurl.TypeHex,
+	"swift":     packageurl.TypeSwift,
+	"cargo":     packageurl.TypeCargo,
+	"deb":       packageurl.TypeDebian,
+	"apk":       packageurl.TypeApk,
+	"rpm":       packageurl.TypeRPM,
+}
+
+func packagePURL(packageManager, name, version string) string {
+	purlType, ok := purlTypes[packageManager]
+	if !ok {
+		purlType = packageurl.TypeGeneric
+	}
+
+	var namespace string
+	sep := "/"
+	if purlType == packageurl.TypeMaven {
+		// group:artifact
+		sep = ":"
+	}
+
+	if i := strings.LastIndex(name, sep); i > 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+
+	return packageurl.NewPackageURL(purlType, namespace, name, version, nil, "").ToString()
+}
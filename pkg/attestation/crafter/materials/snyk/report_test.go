@@ -0,0 +1,171 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snyk_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/findings"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/snyk"
+	policyfindings "github.com/chainloop-dev/chainloop/pkg/policies/findings"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{name: "project", content: `{"ok": true, "packageManager": "npm", "vulnerabilities": []}`},
+		{name: "all projects", content: ` [{"ok": true, "packageManager": "npm", "vulnerabilities": []}]`},
+		{name: "code", content: `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "SnykCode"}}, "results": []}]}`},
+		{name: "error", content: `{"ok": false, "error": "Could not detect supported target files", "path": "/src"}`, wantErr: true},
+		{name: "no projects", content: `[]`, wantErr: true},
+		{name: "a project with error", content: `[{"ok": true, "packageManager": "npm", "vulnerabilities": []}, {"ok": false, "error": "failed"}]`, wantErr: true},
+		{name: "sarif of another tool", content: `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "CodeQL"}}, "results": []}]}`, wantErr: true},
+		{name: "not json", content: `ok: true`, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := snyk.Parse([]byte(tc.content))
+			if tc.wantErr {
+				assert.ErrorIs(t, err, snyk.ErrNotAReport)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVulnerabilityFindings(t *testing.T) {
+	testCases := []struct {
+		file string
+		want []*findings.Vulnerability
+	}{
+		{
+			file: "../testdata/snyk-report.json",
+			want: []*findings.Vulnerability{
+				{
+					Message:        "Prototype Pollution in lodash@4.17.15",
+					ExternalID:     "CVE-2020-8203",
+					PackagePURL:    "pkg:npm/lodash@4.17.15",
+					Severity:       "HIGH",
+					CVSSV3Score:    ptr(7.3),
+					CWEs:           []string{"CWE-400"},
+					Recommendation: "Upgrade lodash to 4.17.19",
+					FixedVersion:   "4.17.19",
+				},
+				{
+					Message:     "Prototype Pollution in @example/minimist@1.2.5",
+					ExternalID:  "SNYK-JS-MINIMIST-2429795",
+					PackagePURL: "pkg:npm/%40example/minimist@1.2.5",
+					Severity:    "LOW",
+					CVSSV3Score: ptr(3.7),
+					CWEs:        []string{"CWE-1321"},
+				},
+			},
+		},
+		{
+			file: "../testdata/snyk-report-all-projects.json",
+			want: []*findings.Vulnerability{
+				{
+					Message:        "Arbitrary Code Execution in org.apache.commons:commons-text@1.9",
+					ExternalID:     "CVE-2022-42889",
+					PackagePURL:    "pkg:maven/org.apache.commons/commons-text@1.9",
+					Severity:       "CRITICAL",
+					CVSSV3Score:    ptr(9.8),
+					CWEs:           []string{"CWE-94"},
+					Recommendation: "Upgrade org.apache.commons:commons-text to 1.10.0",
+					FixedVersion:   "1.10.0",
+				},
+			},
+		},
+		{
+			file: "../testdata/snyk-code-report.json",
+			want: []*findings.Vulnerability{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			report := parseFile(t, tc.file)
+			got := report.VulnerabilityFindings()
+			assert.Equal(t, tc.want, got)
+			assertValidFindings(t, policyfindings.FindingTypeVulnerability, got)
+		})
+	}
+}
+
+func TestSASTFindings(t *testing.T) {
+	report := parseFile(t, "../testdata/snyk-code-report.json")
+
+	name, version := report.Tool()
+	assert.Equal(t, "SnykCode", name)
+	assert.Equal(t, "1.0.0", version)
+
+	got := report.SASTFindings()
+	assert.Equal(t, []*findings.SAST{
+		{
+			Message:       "Unsanitized input from the HTTP request body flows into query, where it is used in an SQL query.",
+			RuleID:        "javascript/Sqli",
+			Severity:      "HIGH",
+			Location:      "routes/orders.js",
+			LineNumber:    27,
+			SeverityScore: ptr(802),
+		},
+	}, got)
+	assertValidFindings(t, policyfindings.FindingTypeSAST, got)
+
+	assert.Empty(t, parseFile(t, "../testdata/snyk-report.json").SASTFindings())
+}
+
+func parseFile(t *testing.T, path string) *snyk.Report {
+	t.Helper()
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	report, err := snyk.Parse(content)
+	require.NoError(t, err)
+
+	return report
+}
+
+// the findings can be returned by policies as findings of the type
+func assertValidFindings[T any](t *testing.T, findingType string, got []T) {
+	t.Helper()
+
+	for _, f := range got {
+		data, err := json.Marshal(f)
+		require.NoError(t, err)
+
+		var raw map[string]any
+		require.NoError(t, json.Unmarshal(data, &raw))
+
+		_, err = policyfindings.ValidateFinding(findingType, raw)
+		assert.NoError(t, err)
+	}
+}
+
+func ptr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials_test
+
+import (
+	"context"
+	"testing"
+
+	contractAPI "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attestationApi "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	mUploader "github.com/chainloop-dev/chainloop/pkg/casclient/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnykJSONCraft(t *testing.T) {
+	testCases := []struct {
+		name            string
+		filePath        string
+		wantErr         string
+		wantFilename    string
+		wantDigest      string
+		wantToolName    string
+		wantToolVersion string
+	}{
+		{
+			name:     "invalid path",
+			filePath: "./testdata/non-existing.json",
+			wantErr:  "no such file or directory",
+		},
+		{
+			name:     "invalid artifact type",
+			filePath: "./testdata/simple.txt",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "sarif report of another tool",
+			filePath: "./testdata/report.sarif",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:         "open source report",
+			filePath:     "./testdata/snyk-report.json",
+			wantDigest:   "sha256:d95bc4e219aba45523c4eabb97509af874adab114bf44b0636ca6807c1b7edcf",
+			wantFilename: "snyk-report.json",
+			wantToolName: "snyk",
+		},
+		{
+			name:         "open source report of all projects",
+			filePath:     "./testdata/snyk-report-all-projects.json",
+			wantDigest:   "sha256:f70fa0cdf266dac181349c24ee7f734d447c8c92fd41d890fef119a3b2e1fe46",
+			wantFilename: "snyk-report-all-projects.json",
+			wantToolName: "snyk",
+		},
+		{
+			name:            "code report",
+			filePath:        "./testdata/snyk-code-report.json",
+			wantDigest:      "sha256:9394bcb5320891bbc5c08775053c7290653a2f49619b49783cfb4bc1a1942e19",
+			wantFilename:    "snyk-code-report.json",
+			wantToolName:    "SnykCode",
+			wantToolVersion: "1.0.0",
+		},
+	}
+
+	schema := &contractAPI.CraftingSchema_Material{
+		Name: "test",
+		Type: contractAPI.CraftingSchema_Material_SNYK_JSON,
+	}
+
+	l := zerolog.Nop()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock uploader
+			uploader := mUploader.NewUploader(t)
+			if tc.wantErr == "" {
+				uploader.On("Upload", context.TODO(), mock.Anything, mock.Anything, mock.Anything).
+					Return(&casclient.UpDownStatus{}, nil)
+			}
+
+			backend := &casclient.CASBackend{Uploader: uploader}
+			crafter, err := materials.NewSnykJSONCrafter(schema, backend, &l)
+			require.NoError(t, err)
+
+			got, err := crafter.Craft(context.TODO(), tc.filePath)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, contractAPI.CraftingSchema_Material_SNYK_JSON.String(), got.MaterialType.String())
+			assert.True(t, got.UploadedToCas)
+			assert.Equal(t, &attestationApi.Attestation_Material_Artifact{
+				Id: "test", Digest: tc.wantDigest, Name: tc.wantFilename,
+			}, got.GetArtifact())
+
+			assert.Equal(t, tc.wantToolName, got.Annotations[materials.AnnotationToolNameKey])
+			assert.Equal(t, tc.wantToolVersion, got.Annotations[materials.AnnotationToolVersionKey])
+		})
+	}
+}
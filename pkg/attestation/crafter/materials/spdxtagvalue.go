@@ -0,0 +1,81 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	api "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	"github.com/rs/zerolog"
+	"github.com/spdx/tools-golang/tagvalue"
+)
+
+type SPDXTagValueCrafter struct {
+	*crafterCommon
+	backend *casclient.CASBackend
+}
+
+func NewSPDXTagValueCrafter(materialSchema *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, l *zerolog.Logger) (*SPDXTagValueCrafter, error) {
+	if materialSchema.Type != schemaapi.CraftingSchema_Material_SBOM_SPDX_TAG_VALUE {
+		return nil, fmt.Errorf("material type is not spdx tag-value")
+	}
+
+	return &SPDXTagValueCrafter{
+		backend:       backend,
+		crafterCommon: &crafterCommon{logger: l, input: materialSchema},
+	}, nil
+}
+
+func (i *SPDXTagValueCrafter) Craft(ctx context.Context, filePath string) (*api.Attestation_Material, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open the file: %w", err)
+	}
+	defer f.Close()
+
+	// Decode the file to check it's a valid SPDX BOM. Documents of older versions are converted to 2.3
+	doc, err := tagvalue.Read(f)
+	if err != nil || doc.SPDXVersion == "" {
+		i.logger.Debug().Err(err).Msg("error decoding file")
+		return nil, fmt.Errorf("invalid spdx sbom file: %w", ErrInvalidMaterialType)
+	}
+
+	m, err := uploadAndCraft(ctx, i.input, i.backend, filePath, i.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	res := m
+	res.M = &api.Attestation_Material_SbomArtifact{
+		SbomArtifact: &api.Attestation_Material_SBOMArtifact{
+			Artifact: m.GetArtifact(),
+		},
+	}
+
+	// Same document model as SPDX JSON, so the main component and tools are extracted the same way
+	jsonCrafter := &SPDXJSONCrafter{crafterCommon: i.crafterCommon}
+	if err := jsonCrafter.extractMainComponent(m, doc); err != nil {
+		i.logger.Debug().Err(err).Msg("error extracting main component from spdx sbom, skipping...")
+	}
+
+	jsonCrafter.injectAnnotations(m, doc)
+
+	return res, nil
+}
@@ -0,0 +1,97 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	api "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/terraform"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	"github.com/rs/zerolog"
+)
+
+const (
+	AnnotationTerraformResourcesCount = "chainloop.material.terraform.resources.count"
+	AnnotationTerraformResourceTypes  = "chainloop.material.terraform.resources.types"
+	AnnotationTerraformCreateCount    = "chainloop.material.terraform.changes.create"
+	AnnotationTerraformUpdateCount    = "chainloop.material.terraform.changes.update"
+	AnnotationTerraformDeleteCount    = "chainloop.material.terraform.changes.delete"
+	AnnotationTerraformReplaceCount   = "chainloop.material.terraform.changes.replace"
+)
+
+type TerraformPlanJSONCrafter struct {
+	backend *casclient.CASBackend
+	*crafterCommon
+}
+
+func NewTerraformPlanJSONCrafter(materialSchema *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, l *zerolog.Logger) (*TerraformPlanJSONCrafter, error) {
+	if materialSchema.Type != schemaapi.CraftingSchema_Material_TERRAFORM_PLAN_JSON {
+		return nil, fmt.Errorf("material type is not a Terraform plan in JSON format")
+	}
+
+	return &TerraformPlanJSONCrafter{
+		backend:       backend,
+		crafterCommon: &crafterCommon{logger: l, input: materialSchema},
+	}, nil
+}
+
+func (i *TerraformPlanJSONCrafter) Craft(ctx context.Context, filePath string) (*api.Attestation_Material, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open the file: %w", err)
+	}
+
+	plan, err := terraform.Parse(data)
+	if err != nil {
+		i.logger.Debug().Err(err).Msgf("error decoding file: %s", filePath)
+		return nil, fmt.Errorf("invalid Terraform plan: %w", ErrInvalidMaterialType)
+	}
+
+	// The plan carries the sensitive values in clear text, only the redacted plan is stored
+	redacted, err := terraform.Redact(data)
+	if err != nil {
+		return nil, fmt.Errorf("redacting the Terraform plan: %w", err)
+	}
+
+	m, err := uploadAndCraft(ctx, i.input, i.backend, filePath, i.logger, withContent(redacted))
+	if err != nil {
+		return nil, err
+	}
+
+	setScannerAnnotations(m, Tool{Name: "terraform", Version: plan.TerraformVersion})
+	i.injectAnnotations(m, plan)
+
+	return m, nil
+}
+
+func (i *TerraformPlanJSONCrafter) injectAnnotations(m *api.Attestation_Material, plan *terraform.Plan) {
+	summary := plan.Summary()
+	m.Annotations[AnnotationTerraformResourcesCount] = strconv.Itoa(summary.Resources)
+	m.Annotations[AnnotationTerraformCreateCount] = strconv.Itoa(summary.Create)
+	m.Annotations[AnnotationTerraformUpdateCount] = strconv.Itoa(summary.Update)
+	m.Annotations[AnnotationTerraformDeleteCount] = strconv.Itoa(summary.Delete)
+	m.Annotations[AnnotationTerraformReplaceCount] = strconv.Itoa(summary.Replace)
+
+	if types := plan.ResourceTypes(); len(types) > 0 {
+		m.Annotations[AnnotationTerraformResourceTypes] = strings.Join(types, ",")
+	}
+}
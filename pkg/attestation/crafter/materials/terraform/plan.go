@@ -0,0 +1,130 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package terraform parses Terraform plans in JSON format (terraform show -json <planfile>). See
+// https://developer.hashicorp.com/terraform/internals/json-format
+package terraform
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// ErrNotAPlan is returned when the content is JSON but not a Terraform plan
+var ErrNotAPlan = errors.New("not a terraform plan")
+
+// Plan is the root of a Terraform JSON plan. Only the fields needed to validate
+// the plan and summarize its changes are decoded.
+type Plan struct {
+	FormatVersion    string           `json:"format_version"`
+	TerraformVersion string           `json:"terraform_version"`
+	PlannedValues    json.RawMessage  `json:"planned_values"`
+	ResourceChanges  []ResourceChange `json:"resource_changes"`
+}
+
+// ResourceChange is the planned change of a single resource instance
+type ResourceChange struct {
+	Address string `json:"address"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Change  Change `json:"change"`
+}
+
+// Change lists the actions planned for a resource, i.e ["create"] or ["delete", "create"] for replacements
+type Change struct {
+	Actions []string `json:"actions"`
+}
+
+// Summary counts the managed resources in the plan by planned action
+type Summary struct {
+	Resources int
+	Create    int
+	Update    int
+	Delete    int
+	Replace   int
+}
+
+// Parse decodes and validates a Terraform JSON plan. The output of terraform show -json
+// for a state, rather than a plan, has no planned values and is rejected.
+func Parse(data []byte) (*Plan, error) {
+	var p Plan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotAPlan, err)
+	}
+
+	if p.FormatVersion == "" || p.TerraformVersion == "" || p.PlannedValues == nil {
+		return nil, ErrNotAPlan
+	}
+
+	return &p, nil
+}
+
+// Summary counts the managed resources and their planned actions. Data sources are not
+// resources Terraform changes, so they are left out.
+func (p *Plan) Summary() Summary {
+	var s Summary
+	for _, rc := range p.managedResources() {
+		s.Resources++
+
+		actions := rc.Change.Actions
+		switch {
+		case slices.Contains(actions, "create") && slices.Contains(actions, "delete"):
+			s.Replace++
+		case slices.Contains(actions, "create"):
+			s.Create++
+		case slices.Contains(actions, "update"):
+			s.Update++
+		case slices.Contains(actions, "delete"):
+			s.Delete++
+		}
+	}
+
+	return s
+}
+
+// ResourceTypes returns the sorted, distinct types of the managed resources in the plan
+func (p *Plan) ResourceTypes() []string {
+	var res []string
+	seen := make(map[string]bool)
+	for _, rc := range p.managedResources() {
+		if rc.Type == "" || seen[rc.Type] {
+			continue
+		}
+
+		seen[rc.Type] = true
+		res = append(res, rc.Type)
+	}
+
+	sort.Strings(res)
+
+	return res
+}
+
+func (p *Plan) managedResources() []ResourceChange {
+	var res []ResourceChange
+	for _, rc := range p.ResourceChanges {
+		if rc.Mode == "data" {
+			continue
+		}
+
+		res = append(res, rc)
+	}
+
+	return res
+}
@@ -0,0 +1,79 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name        string
+		path        string
+		wantErr     bool
+		wantSummary Summary
+		wantTypes   []string
+	}{
+		{
+			name:        "plan with changes",
+			path:        "../testdata/terraform-plan.json",
+			wantSummary: Summary{Resources: 5, Create: 2, Update: 1, Delete: 1, Replace: 1},
+			wantTypes:   []string{"aws_iam_role", "aws_instance", "aws_s3_bucket", "aws_s3_bucket_public_access_block", "aws_security_group"},
+		},
+		{
+			name: "plan without changes",
+			path: "../testdata/terraform-plan-empty.json",
+		},
+		{
+			name:    "trivy report",
+			path:    "../testdata/trivy-report.json",
+			wantErr: true,
+		},
+		{
+			name:    "not json",
+			path:    "../testdata/simple.txt",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := os.ReadFile(tc.path)
+			require.NoError(t, err)
+
+			got, err := Parse(data)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrNotAPlan)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "1.9.5", got.TerraformVersion)
+			assert.Equal(t, tc.wantSummary, got.Summary())
+			assert.Equal(t, tc.wantTypes, got.ResourceTypes())
+		})
+	}
+}
+
+func TestParseState(t *testing.T) {
+	// terraform show -json of a state file, rather than a plan
+	_, err := Parse([]byte(`{"format_version": "1.0", "terraform_version": "1.9.5", "values": {"root_module": {}}}`))
+	assert.ErrorIs(t, err, ErrNotAPlan)
+}
@@ -0,0 +1,210 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// SensitiveValue replaces the sensitive values of a plan, the same way terraform show does
+const SensitiveValue = "(sensitive value)"
+
+// Redact returns the plan with its sensitive values replaced. terraform show -json outputs
+// them in clear text, flagging them in the before_sensitive, after_sensitive and sensitive_values
+// masks next to them. The input variables are flagged in the configuration instead, so all of them
+// get redacted when the plan has none. The literal values of the configuration are not flagged at all,
+// since that depends on the provider schemas, so all of them get redacted.
+// The plan is returned as is when there is nothing to redact.
+func Redact(data []byte) ([]byte, error) {
+	var doc map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// keep the numbers as they are
+	dec.UseNumber()
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotAPlan, err)
+	}
+
+	r := &redactor{}
+	for _, key := range []string{"resource_changes", "resource_drift"} {
+		changes, _ := doc[key].([]any)
+		for _, rc := range changes {
+			rc, _ := rc.(map[string]any)
+			r.redactChange(rc["change"])
+		}
+	}
+
+	outputChanges, _ := doc["output_changes"].(map[string]any)
+	for _, change := range outputChanges {
+		r.redactChange(change)
+	}
+
+	r.redactValues(doc["planned_values"])
+	if priorState, ok := doc["prior_state"].(map[string]any); ok {
+		r.redactValues(priorState["values"])
+	}
+
+	r.redactVariables(doc)
+	r.redactConstants(doc["configuration"])
+
+	if !r.redacted {
+		return data, nil
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("encoding plan: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// redactor keeps track of whether any value got redacted
+type redactor struct {
+	redacted bool
+}
+
+func (r *redactor) sensitive() string {
+	r.redacted = true
+	return SensitiveValue
+}
+
+// redactChange redacts the values of a change representation
+func (r *redactor) redactChange(change any) {
+	c, _ := change.(map[string]any)
+	r.redactMasked(c, "before", "before_sensitive")
+	r.redactMasked(c, "after", "after_sensitive")
+}
+
+// redactValues redacts the outputs and the resources of a values representation
+func (r *redactor) redactValues(values any) {
+	v, _ := values.(map[string]any)
+	outputs, _ := v["outputs"].(map[string]any)
+	for _, o := range outputs {
+		o, _ := o.(map[string]any)
+		if sensitive, _ := o["sensitive"].(bool); sensitive {
+			if _, ok := o["value"]; ok {
+				o["value"] = r.sensitive()
+			}
+		}
+	}
+
+	r.redactModule(v["root_module"])
+}
+
+func (r *redactor) redactModule(module any) {
+	m, _ := module.(map[string]any)
+	resources, _ := m["resources"].([]any)
+	for _, res := range resources {
+		res, _ := res.(map[string]any)
+		r.redactMasked(res, "values", "sensitive_values")
+	}
+
+	children, _ := m["child_modules"].([]any)
+	for _, c := range children {
+		r.redactModule(c)
+	}
+}
+
+// redactVariables redacts the values of the input variables declared as sensitive in the root module,
+// and their defaults. Without the configuration, the variables can't be told apart and all are redacted.
+func (r *redactor) redactVariables(doc map[string]any) {
+	configuration, _ := doc["configuration"].(map[string]any)
+	rootModule, _ := configuration["root_module"].(map[string]any)
+	declared, hasDeclared := rootModule["variables"].(map[string]any)
+
+	for _, decl := range declared {
+		decl, _ := decl.(map[string]any)
+		if sensitive, _ := decl["sensitive"].(bool); sensitive {
+			if _, ok := decl["default"]; ok {
+				decl["default"] = r.sensitive()
+			}
+		}
+	}
+
+	variables, _ := doc["variables"].(map[string]any)
+	for name, v := range variables {
+		v, _ := v.(map[string]any)
+		if _, ok := v["value"]; !ok {
+			continue
+		}
+
+		decl, _ := declared[name].(map[string]any)
+		if sensitive, _ := decl["sensitive"].(bool); sensitive || !hasDeclared {
+			v["value"] = r.sensitive()
+		}
+	}
+}
+
+// redactConstants redacts the literal values of the expressions found in the configuration, that is,
+// in the arguments of resources, providers, module calls and outputs, including the nested modules and blocks
+func (r *redactor) redactConstants(configuration any) {
+	switch c := configuration.(type) {
+	case map[string]any:
+		for k, v := range c {
+			if k == "constant_value" {
+				c[k] = r.sensitive()
+				continue
+			}
+
+			r.redactConstants(v)
+		}
+	case []any:
+		for _, v := range c {
+			r.redactConstants(v)
+		}
+	}
+}
+
+// redactMasked replaces the parts of m[valueKey] flagged in the m[maskKey] sensitivity mask
+func (r *redactor) redactMasked(m map[string]any, valueKey, maskKey string) {
+	value, ok := m[valueKey]
+	if !ok {
+		return
+	}
+
+	m[valueKey] = r.redactValue(value, m[maskKey])
+}
+
+// redactValue walks the value along its mask. The mask is either true, for a sensitive value,
+// or an object or array with the same shape as the value flagging its sensitive attributes and elements.
+func (r *redactor) redactValue(value, mask any) any {
+	switch mask := mask.(type) {
+	case bool:
+		if mask {
+			return r.sensitive()
+		}
+	case map[string]any:
+		if v, ok := value.(map[string]any); ok {
+			for k, m := range mask {
+				if inner, ok := v[k]; ok {
+					v[k] = r.redactValue(inner, m)
+				}
+			}
+		}
+	case []any:
+		if v, ok := value.([]any); ok {
+			for i := range min(len(v), len(mask)) {
+				v[i] = r.redactValue(v[i], mask[i])
+			}
+		}
+	}
+
+	return value
+}
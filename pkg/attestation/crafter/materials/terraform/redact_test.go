@@ -0,0 +1,96 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	testCases := []struct {
+		name    string
+		plan    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "resource changes",
+			plan: `{"resource_changes":[{"change":{"before":{"name":"a","password":"hunter1"},"after":{"name":"b","password":"hunter2","tags":["x","secret"]},"before_sensitive":{"password":true},"after_sensitive":{"password":true,"tags":[false,true]}}}]}`,
+			want: `{"resource_changes":[{"change":{"after":{"name":"b","password":"(sensitive value)","tags":["x","(sensitive value)"]},"after_sensitive":{"password":true,"tags":[false,true]},"before":{"name":"a","password":"(sensitive value)"},"before_sensitive":{"password":true}}}]}`,
+		},
+		{
+			name: "planned values in child modules and outputs",
+			plan: `{"planned_values":{"outputs":{"token":{"sensitive":true,"value":"hunter2"},"url":{"sensitive":false,"value":"https://example.com"}},"root_module":{"child_modules":[{"resources":[{"values":{"key":"hunter2","size":1024},"sensitive_values":{"key":true}}]}]}}}`,
+			want: `{"planned_values":{"outputs":{"token":{"sensitive":true,"value":"(sensitive value)"},"url":{"sensitive":false,"value":"https://example.com"}},"root_module":{"child_modules":[{"resources":[{"sensitive_values":{"key":true},"values":{"key":"(sensitive value)","size":1024}}]}]}}}`,
+		},
+		{
+			name: "sensitive variables",
+			plan: `{"variables":{"password":{"value":"hunter2"},"region":{"value":"eu-west-1"}},"configuration":{"root_module":{"variables":{"password":{"sensitive":true,"default":"hunter1"},"region":{}}}}}`,
+			want: `{"configuration":{"root_module":{"variables":{"password":{"default":"(sensitive value)","sensitive":true},"region":{}}}},"variables":{"password":{"value":"(sensitive value)"},"region":{"value":"eu-west-1"}}}`,
+		},
+		{
+			name: "literal values in the configuration",
+			plan: `{"configuration":{"provider_config":{"aws":{"expressions":{"region":{"constant_value":"eu-west-1"}}}},"root_module":{"resources":[{"address":"aws_db_instance.db","expressions":{"password":{"constant_value":"hunter2"},"engine":{"references":["var.engine"]},"tags":[{"owner":{"constant_value":"me"}}]}}],"module_calls":{"vpc":{"expressions":{"token":{"constant_value":"hunter3"}},"module":{"resources":[{"expressions":{"secret":{"constant_value":{"key":"hunter4"}}}}]}}}}}}`,
+			want: `{"configuration":{"provider_config":{"aws":{"expressions":{"region":{"constant_value":"(sensitive value)"}}}},"root_module":{"resources":[{"address":"aws_db_instance.db","expressions":{"password":{"constant_value":"(sensitive value)"},"engine":{"references":["var.engine"]},"tags":[{"owner":{"constant_value":"(sensitive value)"}}]}}],"module_calls":{"vpc":{"expressions":{"token":{"constant_value":"(sensitive value)"}},"module":{"resources":[{"expressions":{"secret":{"constant_value":"(sensitive value)"}}}]}}}}}}`,
+		},
+		{
+			name: "variables without configuration",
+			plan: `{"variables":{"region":{"value":"eu-west-1"}}}`,
+			want: `{"variables":{"region":{"value":"(sensitive value)"}}}`,
+		},
+		{
+			name: "nothing to redact",
+			plan: `{"variables":{"region":{"value":"eu-west-1"}},"configuration":{"root_module":{"variables":{"region":{}}}}}`,
+			want: `{"variables":{"region":{"value":"eu-west-1"}},"configuration":{"root_module":{"variables":{"region":{}}}}}`,
+		},
+		{
+			name:    "not json",
+			plan:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Redact([]byte(tc.plan))
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrNotAPlan)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestRedactPlan(t *testing.T) {
+	data, err := os.ReadFile("../testdata/terraform-plan.json")
+	require.NoError(t, err)
+
+	got, err := Redact(data)
+	require.NoError(t, err)
+	assert.NotContains(t, string(got), "hunter")
+
+	// the redacted plan is still a plan with the same changes
+	plan, err := Parse(got)
+	require.NoError(t, err)
+	assert.Equal(t, Summary{Resources: 5, Create: 2, Update: 1, Delete: 1, Replace: 1}, plan.Summary())
+}
@@ -0,0 +1,136 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	contractAPI "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	attestationApi "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	mUploader "github.com/chainloop-dev/chainloop/pkg/casclient/mocks"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraformPlanJSONCraft(t *testing.T) {
+	testCases := []struct {
+		name            string
+		filePath        string
+		wantErr         string
+		wantFilename    string
+		wantDigest      string
+		wantAnnotations map[string]string
+	}{
+		{
+			name:     "invalid path",
+			filePath: "./testdata/non-existing.json",
+			wantErr:  "no such file or directory",
+		},
+		{
+			name:     "invalid artifact type",
+			filePath: "./testdata/simple.txt",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:     "unrecognized json type",
+			filePath: "./testdata/sbom.cyclonedx.json",
+			wantErr:  "unexpected material type",
+		},
+		{
+			name:         "plan with changes",
+			filePath:     "./testdata/terraform-plan.json",
+			wantDigest:   "sha256:faadac6d38284688d57d0d69b39e74d0bd2e447c1e8ab6088a46c8101e4600c6",
+			wantFilename: "terraform-plan.json",
+			wantAnnotations: map[string]string{
+				"chainloop.material.size":                      "3602",
+				"chainloop.material.tools":                     `["terraform@1.9.5"]`,
+				"chainloop.material.tool.name":                 "terraform",
+				"chainloop.material.tool.version":              "1.9.5",
+				"chainloop.material.terraform.resources.count": "5",
+				"chainloop.material.terraform.resources.types": "aws_iam_role,aws_instance,aws_s3_bucket,aws_s3_bucket_public_access_block,aws_security_group",
+				"chainloop.material.terraform.changes.create":  "2",
+				"chainloop.material.terraform.changes.update":  "1",
+				"chainloop.material.terraform.changes.delete":  "1",
+				"chainloop.material.terraform.changes.replace": "1",
+			},
+		},
+		{
+			name:         "plan without changes",
+			filePath:     "./testdata/terraform-plan-empty.json",
+			wantDigest:   "sha256:6963677a9a008ca4c0bc86b3a042b7be47e200760d135f5b56edb908fed9382a",
+			wantFilename: "terraform-plan-empty.json",
+			wantAnnotations: map[string]string{
+				"chainloop.material.size":                      "260",
+				"chainloop.material.tools":                     `["terraform@1.9.5"]`,
+				"chainloop.material.tool.name":                 "terraform",
+				"chainloop.material.tool.version":              "1.9.5",
+				"chainloop.material.terraform.resources.count": "0",
+				"chainloop.material.terraform.changes.create":  "0",
+				"chainloop.material.terraform.changes.update":  "0",
+				"chainloop.material.terraform.changes.delete":  "0",
+				"chainloop.material.terraform.changes.replace": "0",
+			},
+		},
+	}
+
+	schema := &contractAPI.CraftingSchema_Material{
+		Name: "test",
+		Type: contractAPI.CraftingSchema_Material_TERRAFORM_PLAN_JSON,
+	}
+
+	l := zerolog.Nop()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Mock uploader
+			uploader := mUploader.NewUploader(t)
+			var uploaded []byte
+			if tc.wantErr == "" {
+				uploader.On("Upload", context.TODO(), mock.Anything, mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						uploaded, _ = io.ReadAll(args.Get(1).(io.Reader))
+					}).
+					Return(&casclient.UpDownStatus{}, nil)
+			}
+
+			backend := &casclient.CASBackend{Uploader: uploader}
+			crafter, err := materials.NewTerraformPlanJSONCrafter(schema, backend, &l)
+			require.NoError(t, err)
+
+			got, err := crafter.Craft(context.TODO(), tc.filePath)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, contractAPI.CraftingSchema_Material_TERRAFORM_PLAN_JSON.String(), got.MaterialType.String())
+			assert.True(t, got.UploadedToCas)
+			assert.Equal(t, &attestationApi.Attestation_Material_Artifact{
+				Id: "test", Digest: tc.wantDigest, Name: tc.wantFilename,
+			}, got.GetArtifact())
+
+			assert.Equal(t, tc.wantAnnotations, got.Annotations)
+			// the sensitive values never leave the machine
+			assert.NotContains(t, string(uploaded), "hunter")
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package materials
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	api "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/materials/trivy"
+	"github.com/chainloop-dev/chainloop/pkg/casclient"
+	"github.com/rs/zerolog"
+)
+
+type TrivyJSONCrafter struct {
+	backend *casclient.CASBackend
+	*crafterCommon
+}
+
+func NewTrivyJSONCrafter(materialSchema *schemaapi.CraftingSchema_Material, backend *casclient.CASBackend, l *zerolog.Logger) (*TrivyJSONCrafter, error) {
+	if materialSchema.Type != schemaapi.CraftingSchema_Material_TRIVY_JSON {
+		return nil, fmt.Errorf("material type is not a Trivy report in JSON format")
+	}
+
+	return &TrivyJSONCrafter{
+		backend:       backend,
+		crafterCommon: &crafterCommon{logger: l, input: materialSchema},
+	}, nil
+}
+
+func (i *TrivyJSONCrafter) Craft(ctx context.Context, filePath string) (*api.Attestation_Material, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("can't open the file: %w", err)
+	}
+
+	report, err := trivy.Parse(data)
+	if err != nil {
+		i.logger.Debug().Err(err).Msgf("error decoding file: %s", filePath)
+		return nil, fmt.Errorf("invalid Trivy report: %w", ErrInvalidMaterialType)
+	}
+
+	m, err := uploadAndCraft(ctx, i.input, i.backend, filePath, i.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	// The version is only reported since Trivy 0.55
+	tool := Tool{Name: "trivy"}
+	if report.Trivy != nil {
+		tool.Version = report.Trivy.Version
+	}
+
+	setScannerAnnotations(m, tool)
+
+	return m, nil
+}
+
+// setScannerAnnotations sets the tools annotation, and the legacy tool keys, for reports produced by a single scanner
+func setScannerAnnotations(m *api.Attestation_Material, tool Tool) {
+	if m.Annotations == nil {
+		m.Annotations = make(map[string]string)
+	}
+
+	SetToolsAnnotation(m, []Tool{tool})
+
+	m.Annotations[AnnotationToolNameKey] = tool.Name
+	if tool.Version != "" {
+		m.Annotations[AnnotationToolVersionKey] = tool.Version
+	}
+}
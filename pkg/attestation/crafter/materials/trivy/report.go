@@ -0,0 +1,121 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trivy parses Trivy's JSON report (trivy --format json), for image,
+// filesystem and repository scans. See
+// https://trivy.dev/latest/docs/configuration/reporting/#json
+package trivy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotAReport is returned when the content is JSON but not a Trivy report
+var ErrNotAReport = errors.New("not a trivy report")
+
+// Report is the root of a Trivy JSON report. Only the fields needed to validate
+// the report and extract its packages are decoded.
+type Report struct {
+	SchemaVersion int      `json:"SchemaVersion"`
+	ArtifactName  string   `json:"ArtifactName"`
+	ArtifactType  string   `json:"ArtifactType"`
+	Trivy         *Info    `json:"Trivy,omitempty"`
+	Results       []Result `json:"Results"`
+}
+
+// Info describes the Trivy binary that produced the report, present since Trivy 0.55
+type Info struct {
+	Version string `json:"Version"`
+}
+
+// Result groups the findings of a scan target, i.e an OS or a lock file
+type Result struct {
+	Target          string          `json:"Target"`
+	Class           string          `json:"Class"`
+	Type            string          `json:"Type"`
+	Packages        []Package       `json:"Packages,omitempty"`
+	Vulnerabilities []Vulnerability `json:"Vulnerabilities,omitempty"`
+}
+
+// Package is an installed package, only listed with --list-all-pkgs
+type Package struct {
+	Name       string     `json:"Name"`
+	Version    string     `json:"Version"`
+	Identifier Identifier `json:"Identifier"`
+}
+
+// Identifier identifies a package, by its package URL
+type Identifier struct {
+	PURL string `json:"PURL,omitempty"`
+}
+
+// Vulnerability is a vulnerability found in an installed package
+type Vulnerability struct {
+	VulnerabilityID  string     `json:"VulnerabilityID"`
+	PkgName          string     `json:"PkgName"`
+	PkgIdentifier    Identifier `json:"PkgIdentifier"`
+	InstalledVersion string     `json:"InstalledVersion"`
+	FixedVersion     string     `json:"FixedVersion,omitempty"`
+	Severity         string     `json:"Severity"`
+}
+
+// Parse decodes and validates a Trivy JSON report. Reports are recognized by their
+// schema version, 2 since Trivy 0.20, and the name of the scanned artifact.
+func Parse(data []byte) (*Report, error) {
+	var r Report
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrNotAReport, err)
+	}
+
+	if r.SchemaVersion < 2 || r.ArtifactName == "" || r.ArtifactType == "" {
+		return nil, ErrNotAReport
+	}
+
+	return &r, nil
+}
+
+// Packages returns the packages in the report, deduplicated. When the scan didn't list
+// all the packages, only the vulnerable ones are known.
+func (r *Report) Packages() []Package {
+	var res []Package
+	seen := make(map[string]bool)
+	add := func(p Package) {
+		key := p.Identifier.PURL
+		if key == "" {
+			key = p.Name + "@" + p.Version
+		}
+
+		if p.Name == "" || seen[key] {
+			return
+		}
+
+		seen[key] = true
+		res = append(res, p)
+	}
+
+	for _, result := range r.Results {
+		for _, p := range result.Packages {
+			add(p)
+		}
+
+		for _, v := range result.Vulnerabilities {
+			add(Package{Name: v.PkgName, Version: v.InstalledVersion, Identifier: v.PkgIdentifier})
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,77 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trivy
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name         string
+		path         string
+		wantErr      bool
+		wantArtifact string
+		wantPackages []Package
+	}{
+		{
+			name:         "image scan",
+			path:         "../testdata/trivy-report.json",
+			wantArtifact: "ghcr.io/chainloop-dev/chainloop/control-plane:v1.10.0",
+			wantPackages: []Package{
+				{Name: "libcrypto3", Version: "3.3.2-r0", Identifier: Identifier{PURL: "pkg:apk/alpine/libcrypto3@3.3.2-r0?arch=x86_64&distro=3.20.3"}},
+				{Name: "libssl3", Version: "3.3.2-r0", Identifier: Identifier{PURL: "pkg:apk/alpine/libssl3@3.3.2-r0?arch=x86_64&distro=3.20.3"}},
+				{Name: "golang.org/x/crypto", Version: "v0.28.0", Identifier: Identifier{PURL: "pkg:golang/golang.org/x/crypto@v0.28.0"}},
+			},
+		},
+		{
+			name:         "clean filesystem scan",
+			path:         "../testdata/trivy-report-clean.json",
+			wantArtifact: ".",
+		},
+		{
+			name:    "grype report",
+			path:    "../testdata/grype-report.json",
+			wantErr: true,
+		},
+		{
+			name:    "not json",
+			path:    "../testdata/simple.txt",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := os.ReadFile(tc.path)
+			require.NoError(t, err)
+
+			got, err := Parse(data)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrNotAReport)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantArtifact, got.ArtifactName)
+			assert.Equal(t, tc.wantPackages, got.Packages())
+		})
+	}
+}
@@ -104,6 +104,12 @@ var RunnerFactories = map[schemaapi.CraftingSchema_Runner_RunnerType]RunnerFacto
 	schemaapi.CraftingSchema_Runner_CHAINLOOP_SANDBOX: func(_ string, _ *zerolog.Logger) SupportedRunner {
 		return runners.NewChainloopSandbox()
 	},
+	schemaapi.CraftingSchema_Runner_BITBUCKET_PIPELINE: func(authToken string, logger *zerolog.Logger) SupportedRunner {
+		return runners.NewBitbucketPipeline(timeoutCtx, authToken, logger)
+	},
+	schemaapi.CraftingSchema_Runner_BUILDKITE_PIPELINE: func(_ string, logger *zerolog.Logger) SupportedRunner {
+		return runners.NewBuildkitePipeline(timeoutCtx, logger)
+	},
 }
 
 // Load a specific runner
@@ -0,0 +1,138 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runners
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/commitverification"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/oidc"
+	"github.com/rs/zerolog"
+)
+
+type BitbucketPipeline struct {
+	*Generic
+	bitbucketToken *oidc.BitbucketToken
+	logger         *zerolog.Logger
+}
+
+// authtoken is a possible oidc token that could be used to authenticate the runner
+func NewBitbucketPipeline(ctx context.Context, authToken string, logger *zerolog.Logger) *BitbucketPipeline {
+	client, err := oidc.NewBitbucketClient(ctx, authToken, logger)
+	if err != nil {
+		logger.Debug().Err(err).Msgf("failed to create Bitbucket OIDC client: %v", err)
+		return &BitbucketPipeline{
+			Generic:        NewGeneric(),
+			bitbucketToken: nil,
+			logger:         logger,
+		}
+	}
+
+	return &BitbucketPipeline{
+		Generic:        NewGeneric(),
+		bitbucketToken: client.Token,
+		logger:         logger,
+	}
+}
+
+func (r *BitbucketPipeline) ID() schemaapi.CraftingSchema_Runner_RunnerType {
+	return schemaapi.CraftingSchema_Runner_BITBUCKET_PIPELINE
+}
+
+// Checks whether we are within a Bitbucket pipeline
+func (r *BitbucketPipeline) CheckEnv() bool {
+	for _, varName := range []string{"BITBUCKET_BUILD_NUMBER", "BITBUCKET_PIPELINE_UUID"} {
+		if os.Getenv(varName) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *BitbucketPipeline) ListEnvVars() []*EnvVarDefinition {
+	return []*EnvVarDefinition{
+		{"BITBUCKET_WORKSPACE", false},
+		{"BITBUCKET_REPO_FULL_NAME", false},
+		{"BITBUCKET_REPO_UUID", false},
+		{"BITBUCKET_GIT_HTTP_ORIGIN", false},
+		{"BITBUCKET_COMMIT", false},
+		{"BITBUCKET_BUILD_NUMBER", false},
+		{"BITBUCKET_PIPELINE_UUID", false},
+		{"BITBUCKET_STEP_UUID", false},
+		{"BITBUCKET_STEP_TRIGGERER_UUID", false},
+		// Only one of branch or tag is set depending on what triggered the pipeline
+		{"BITBUCKET_BRANCH", true},
+		{"BITBUCKET_TAG", true},
+		// PR-specific variables (optional - only present in pull request pipelines)
+		{"BITBUCKET_PR_ID", true},
+		{"BITBUCKET_PR_DESTINATION_BRANCH", true},
+		// Only present in deployment steps
+		{"BITBUCKET_DEPLOYMENT_ENVIRONMENT", true},
+	}
+}
+
+func (r *BitbucketPipeline) RunURI() string {
+	repo := os.Getenv("BITBUCKET_REPO_FULL_NAME")
+	buildNumber := os.Getenv("BITBUCKET_BUILD_NUMBER")
+	if repo == "" || buildNumber == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("https://bitbucket.org/%s/pipelines/results/%s", repo, buildNumber)
+}
+
+func (r *BitbucketPipeline) ResolveEnvVars() (map[string]string, []*error) {
+	return resolveEnvVars(r.ListEnvVars())
+}
+
+// WorkflowFilePath returns the pipeline definition at the commit being built. Bitbucket
+// only supports the bitbucket-pipelines.yml file at the root of the repository.
+func (r *BitbucketPipeline) WorkflowFilePath() string {
+	if r.bitbucketToken == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/bitbucket-pipelines.yml@%s", os.Getenv("BITBUCKET_REPO_FULL_NAME"), os.Getenv("BITBUCKET_COMMIT"))
+}
+
+func (r *BitbucketPipeline) IsAuthenticated() bool {
+	return r.bitbucketToken != nil
+}
+
+func (r *BitbucketPipeline) FederatedToken() string {
+	if r.bitbucketToken == nil {
+		return ""
+	}
+	return r.bitbucketToken.RawToken
+}
+
+// Environment can't be figured out since Bitbucket exposes whether the step runs
+// in a self-hosted runner neither in the environment nor in the OIDC token
+func (r *BitbucketPipeline) Environment() RunnerEnvironment {
+	return Unknown
+}
+
+func (r *BitbucketPipeline) VerifyCommitSignature(_ context.Context, _ string) *commitverification.CommitVerification {
+	return nil // Not supported for this runner
+}
+
+func (r *BitbucketPipeline) Report(_ []byte, _ string) error {
+	return nil
+}
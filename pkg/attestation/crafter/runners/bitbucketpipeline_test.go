@@ -0,0 +1,177 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runners
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/oidc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+)
+
+type bitbucketPipelineSuite struct {
+	suite.Suite
+	runner *BitbucketPipeline
+}
+
+func (s *bitbucketPipelineSuite) TestCheckEnv() {
+	testCases := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{
+			name: "empty",
+			env:  map[string]string{},
+			want: false,
+		},
+		{
+			name: "missing pipeline UUID",
+			env: map[string]string{
+				"BITBUCKET_BUILD_NUMBER": "42",
+			},
+			want: false,
+		},
+		{
+			name: "missing build number",
+			env: map[string]string{
+				"BITBUCKET_PIPELINE_UUID": "{pipeline-uuid}",
+			},
+			want: false,
+		},
+		{
+			name: "all present",
+			env: map[string]string{
+				"BITBUCKET_BUILD_NUMBER":  "42",
+				"BITBUCKET_PIPELINE_UUID": "{pipeline-uuid}",
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			os.Unsetenv("BITBUCKET_BUILD_NUMBER")
+			os.Unsetenv("BITBUCKET_PIPELINE_UUID")
+
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			s.Equal(tc.want, s.runner.CheckEnv())
+		})
+	}
+}
+
+func (s *bitbucketPipelineSuite) TestListEnvVars() {
+	s.Equal([]*EnvVarDefinition{
+		{"BITBUCKET_WORKSPACE", false},
+		{"BITBUCKET_REPO_FULL_NAME", false},
+		{"BITBUCKET_REPO_UUID", false},
+		{"BITBUCKET_GIT_HTTP_ORIGIN", false},
+		{"BITBUCKET_COMMIT", false},
+		{"BITBUCKET_BUILD_NUMBER", false},
+		{"BITBUCKET_PIPELINE_UUID", false},
+		{"BITBUCKET_STEP_UUID", false},
+		{"BITBUCKET_STEP_TRIGGERER_UUID", false},
+		{"BITBUCKET_BRANCH", true},
+		{"BITBUCKET_TAG", true},
+		{"BITBUCKET_PR_ID", true},
+		{"BITBUCKET_PR_DESTINATION_BRANCH", true},
+		{"BITBUCKET_DEPLOYMENT_ENVIRONMENT", true},
+	}, s.runner.ListEnvVars())
+}
+
+func (s *bitbucketPipelineSuite) TestResolveEnvVars() {
+	resolvedEnvVars, errors := s.runner.ResolveEnvVars()
+	s.Empty(errors)
+	s.Equal(map[string]string{
+		"BITBUCKET_WORKSPACE":             "chainloop",
+		"BITBUCKET_REPO_FULL_NAME":        "chainloop/chainloop",
+		"BITBUCKET_REPO_UUID":             "{repo-uuid}",
+		"BITBUCKET_GIT_HTTP_ORIGIN":       "http://bitbucket.org/chainloop/chainloop",
+		"BITBUCKET_COMMIT":                "1234567890",
+		"BITBUCKET_BUILD_NUMBER":          "42",
+		"BITBUCKET_PIPELINE_UUID":         "{pipeline-uuid}",
+		"BITBUCKET_STEP_UUID":             "{step-uuid}",
+		"BITBUCKET_STEP_TRIGGERER_UUID":   "{user-uuid}",
+		"BITBUCKET_BRANCH":                "feature/awesome",
+		"BITBUCKET_PR_ID":                 "7",
+		"BITBUCKET_PR_DESTINATION_BRANCH": "main",
+	}, resolvedEnvVars)
+}
+
+func (s *bitbucketPipelineSuite) TestResolveEnvVarsMissingRequired() {
+	s.T().Setenv("BITBUCKET_COMMIT", "")
+
+	_, errors := s.runner.ResolveEnvVars()
+	s.Len(errors, 1)
+}
+
+func (s *bitbucketPipelineSuite) TestRunURI() {
+	s.Equal("https://bitbucket.org/chainloop/chainloop/pipelines/results/42", s.runner.RunURI())
+}
+
+func (s *bitbucketPipelineSuite) TestRunnerName() {
+	s.Equal("BITBUCKET_PIPELINE", s.runner.ID().String())
+}
+
+func (s *bitbucketPipelineSuite) TestUnauthenticated() {
+	s.False(s.runner.IsAuthenticated())
+	s.Empty(s.runner.FederatedToken())
+	s.Empty(s.runner.WorkflowFilePath())
+	s.Equal(Unknown, s.runner.Environment())
+}
+
+func (s *bitbucketPipelineSuite) TestAuthenticated() {
+	s.runner.bitbucketToken = &oidc.BitbucketToken{RawToken: "raw-token"}
+
+	s.True(s.runner.IsAuthenticated())
+	s.Equal("raw-token", s.runner.FederatedToken())
+	s.Equal("chainloop/chainloop/bitbucket-pipelines.yml@1234567890", s.runner.WorkflowFilePath())
+	s.Equal(Unknown, s.runner.Environment())
+}
+
+// Run before each test
+func (s *bitbucketPipelineSuite) SetupTest() {
+	logger := zerolog.New(zerolog.Nop()).Level(zerolog.Disabled)
+	t := s.T()
+	// No workspace is set yet so the runner is created without an OIDC token
+	t.Setenv("BITBUCKET_WORKSPACE", "")
+	s.runner = NewBitbucketPipeline(context.Background(), "test-token", &logger)
+	t.Setenv("BITBUCKET_WORKSPACE", "chainloop")
+	t.Setenv("BITBUCKET_REPO_FULL_NAME", "chainloop/chainloop")
+	t.Setenv("BITBUCKET_REPO_UUID", "{repo-uuid}")
+	t.Setenv("BITBUCKET_GIT_HTTP_ORIGIN", "http://bitbucket.org/chainloop/chainloop")
+	t.Setenv("BITBUCKET_COMMIT", "1234567890")
+	t.Setenv("BITBUCKET_BUILD_NUMBER", "42")
+	t.Setenv("BITBUCKET_PIPELINE_UUID", "{pipeline-uuid}")
+	t.Setenv("BITBUCKET_STEP_UUID", "{step-uuid}")
+	t.Setenv("BITBUCKET_STEP_TRIGGERER_UUID", "{user-uuid}")
+	t.Setenv("BITBUCKET_BRANCH", "feature/awesome")
+	t.Setenv("BITBUCKET_TAG", "")
+	t.Setenv("BITBUCKET_PR_ID", "7")
+	t.Setenv("BITBUCKET_PR_DESTINATION_BRANCH", "main")
+	t.Setenv("BITBUCKET_DEPLOYMENT_ENVIRONMENT", "")
+}
+
+// Run the tests
+func TestBitbucketPipelineRunner(t *testing.T) {
+	suite.Run(t, new(bitbucketPipelineSuite))
+}
@@ -0,0 +1,143 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runners
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	schemaapi "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/commitverification"
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/oidc"
+	"github.com/rs/zerolog"
+)
+
+type BuildkitePipeline struct {
+	*Generic
+	buildkiteToken *oidc.BuildkiteToken
+	logger         *zerolog.Logger
+}
+
+func NewBuildkitePipeline(ctx context.Context, logger *zerolog.Logger) *BuildkitePipeline {
+	client, err := oidc.NewBuildkiteClient(ctx, logger)
+	if err != nil {
+		logger.Debug().Err(err).Msgf("failed to create Buildkite OIDC client: %v", err)
+		return &BuildkitePipeline{
+			Generic:        NewGeneric(),
+			buildkiteToken: nil,
+			logger:         logger,
+		}
+	}
+
+	return &BuildkitePipeline{
+		Generic:        NewGeneric(),
+		buildkiteToken: client.Token,
+		logger:         logger,
+	}
+}
+
+func (r *BuildkitePipeline) ID() schemaapi.CraftingSchema_Runner_RunnerType {
+	return schemaapi.CraftingSchema_Runner_BUILDKITE_PIPELINE
+}
+
+// Checks whether we are within a Buildkite job
+func (r *BuildkitePipeline) CheckEnv() bool {
+	return os.Getenv("BUILDKITE") == "true" && os.Getenv("BUILDKITE_BUILD_URL") != ""
+}
+
+func (r *BuildkitePipeline) ListEnvVars() []*EnvVarDefinition {
+	return []*EnvVarDefinition{
+		{"BUILDKITE_ORGANIZATION_SLUG", false},
+		{"BUILDKITE_PIPELINE_SLUG", false},
+		{"BUILDKITE_REPO", false},
+		{"BUILDKITE_COMMIT", false},
+		{"BUILDKITE_BRANCH", false},
+		{"BUILDKITE_BUILD_URL", false},
+		{"BUILDKITE_BUILD_NUMBER", false},
+		{"BUILDKITE_JOB_ID", false},
+		{"BUILDKITE_AGENT_NAME", false},
+		{"BUILDKITE_BUILD_CREATOR", true},
+		{"BUILDKITE_BUILD_CREATOR_EMAIL", true},
+		{"BUILDKITE_TAG", true},
+		{"BUILDKITE_STEP_KEY", true},
+		// PR-specific variables (set to "false" when the build is not for a pull request)
+		{"BUILDKITE_PULL_REQUEST", true},
+		{"BUILDKITE_PULL_REQUEST_BASE_BRANCH", true},
+		{"BUILDKITE_PULL_REQUEST_REPO", true},
+	}
+}
+
+// RunURI points to the job within the build
+func (r *BuildkitePipeline) RunURI() string {
+	buildURL := os.Getenv("BUILDKITE_BUILD_URL")
+	if buildURL == "" {
+		return ""
+	}
+
+	if jobID := os.Getenv("BUILDKITE_JOB_ID"); jobID != "" {
+		return fmt.Sprintf("%s#%s", buildURL, jobID)
+	}
+
+	return buildURL
+}
+
+func (r *BuildkitePipeline) ResolveEnvVars() (map[string]string, []*error) {
+	return resolveEnvVars(r.ListEnvVars())
+}
+
+// WorkflowFilePath returns the pipeline the job belongs to. Buildkite pipelines are defined
+// in the pipeline settings, which might upload steps from any file, so there is no single file to point to.
+func (r *BuildkitePipeline) WorkflowFilePath() string {
+	if r.buildkiteToken == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s", r.buildkiteToken.OrganizationSlug, r.buildkiteToken.PipelineSlug)
+}
+
+func (r *BuildkitePipeline) IsAuthenticated() bool {
+	return r.buildkiteToken != nil
+}
+
+func (r *BuildkitePipeline) FederatedToken() string {
+	if r.buildkiteToken == nil {
+		return ""
+	}
+	return r.buildkiteToken.RawToken
+}
+
+func (r *BuildkitePipeline) Environment() RunnerEnvironment {
+	if r.buildkiteToken != nil {
+		switch r.buildkiteToken.RunnerEnvironment {
+		case "buildkite-hosted":
+			return Managed
+		case oidc.SelfHostedRunner:
+			return SelfHosted
+		default:
+			return Unknown
+		}
+	}
+	return Unknown
+}
+
+func (r *BuildkitePipeline) VerifyCommitSignature(_ context.Context, _ string) *commitverification.CommitVerification {
+	return nil // Not supported for this runner
+}
+
+func (r *BuildkitePipeline) Report(_ []byte, _ string) error {
+	return nil
+}
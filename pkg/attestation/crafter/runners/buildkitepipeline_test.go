@@ -0,0 +1,192 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runners
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/oidc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+)
+
+type buildkitePipelineSuite struct {
+	suite.Suite
+	runner *BuildkitePipeline
+}
+
+func (s *buildkitePipelineSuite) TestCheckEnv() {
+	testCases := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{
+			name: "empty",
+			env:  map[string]string{},
+			want: false,
+		},
+		{
+			name: "missing build URL",
+			env: map[string]string{
+				"BUILDKITE": "true",
+			},
+			want: false,
+		},
+		{
+			name: "missing BUILDKITE",
+			env: map[string]string{
+				"BUILDKITE_BUILD_URL": "https://buildkite.com/chainloop/chainloop/builds/42",
+			},
+			want: false,
+		},
+		{
+			name: "all present",
+			env: map[string]string{
+				"BUILDKITE":           "true",
+				"BUILDKITE_BUILD_URL": "https://buildkite.com/chainloop/chainloop/builds/42",
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			os.Unsetenv("BUILDKITE")
+			os.Unsetenv("BUILDKITE_BUILD_URL")
+
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			s.Equal(tc.want, s.runner.CheckEnv())
+		})
+	}
+}
+
+func (s *buildkitePipelineSuite) TestListEnvVars() {
+	s.Equal([]*EnvVarDefinition{
+		{"BUILDKITE_ORGANIZATION_SLUG", false},
+		{"BUILDKITE_PIPELINE_SLUG", false},
+		{"BUILDKITE_REPO", false},
+		{"BUILDKITE_COMMIT", false},
+		{"BUILDKITE_BRANCH", false},
+		{"BUILDKITE_BUILD_URL", false},
+		{"BUILDKITE_BUILD_NUMBER", false},
+		{"BUILDKITE_JOB_ID", false},
+		{"BUILDKITE_AGENT_NAME", false},
+		{"BUILDKITE_BUILD_CREATOR", true},
+		{"BUILDKITE_BUILD_CREATOR_EMAIL", true},
+		{"BUILDKITE_TAG", true},
+		{"BUILDKITE_STEP_KEY", true},
+		{"BUILDKITE_PULL_REQUEST", true},
+		{"BUILDKITE_PULL_REQUEST_BASE_BRANCH", true},
+		{"BUILDKITE_PULL_REQUEST_REPO", true},
+	}, s.runner.ListEnvVars())
+}
+
+func (s *buildkitePipelineSuite) TestResolveEnvVars() {
+	resolvedEnvVars, errors := s.runner.ResolveEnvVars()
+	s.Empty(errors)
+	s.Equal(map[string]string{
+		"BUILDKITE_ORGANIZATION_SLUG":   "chainloop",
+		"BUILDKITE_PIPELINE_SLUG":       "chainloop",
+		"BUILDKITE_REPO":                "git@github.com:chainloop-dev/chainloop.git",
+		"BUILDKITE_COMMIT":              "1234567890",
+		"BUILDKITE_BRANCH":              "main",
+		"BUILDKITE_BUILD_URL":           "https://buildkite.com/chainloop/chainloop/builds/42",
+		"BUILDKITE_BUILD_NUMBER":        "42",
+		"BUILDKITE_JOB_ID":              "job-id",
+		"BUILDKITE_AGENT_NAME":          "agent-1",
+		"BUILDKITE_BUILD_CREATOR":       "foo",
+		"BUILDKITE_BUILD_CREATOR_EMAIL": "foo@foo.com",
+		"BUILDKITE_PULL_REQUEST":        "false",
+	}, resolvedEnvVars)
+}
+
+func (s *buildkitePipelineSuite) TestRunURI() {
+	s.Equal("https://buildkite.com/chainloop/chainloop/builds/42#job-id", s.runner.RunURI())
+}
+
+func (s *buildkitePipelineSuite) TestRunnerName() {
+	s.Equal("BUILDKITE_PIPELINE", s.runner.ID().String())
+}
+
+func (s *buildkitePipelineSuite) TestUnauthenticated() {
+	s.False(s.runner.IsAuthenticated())
+	s.Empty(s.runner.FederatedToken())
+	s.Empty(s.runner.WorkflowFilePath())
+	s.Equal(Unknown, s.runner.Environment())
+}
+
+func (s *buildkitePipelineSuite) TestAuthenticated() {
+	testCases := []struct {
+		runnerEnvironment string
+		want              RunnerEnvironment
+	}{
+		{"buildkite-hosted", Managed},
+		{"self-hosted", SelfHosted},
+		{"", Unknown},
+	}
+
+	for _, tc := range testCases {
+		s.runner.buildkiteToken = &oidc.BuildkiteToken{
+			OrganizationSlug:  "chainloop",
+			PipelineSlug:      "release",
+			RunnerEnvironment: tc.runnerEnvironment,
+			RawToken:          "raw-token",
+		}
+
+		s.True(s.runner.IsAuthenticated())
+		s.Equal("raw-token", s.runner.FederatedToken())
+		s.Equal("chainloop/release", s.runner.WorkflowFilePath())
+		s.Equal(tc.want, s.runner.Environment())
+	}
+}
+
+// Run before each test
+func (s *buildkitePipelineSuite) SetupTest() {
+	logger := zerolog.New(zerolog.Nop()).Level(zerolog.Disabled)
+	t := s.T()
+	// No agent access token is set yet so the runner is created without an OIDC token
+	t.Setenv("BUILDKITE_OIDC_TOKEN", "")
+	t.Setenv("BUILDKITE_AGENT_ACCESS_TOKEN", "")
+	s.runner = NewBuildkitePipeline(context.Background(), &logger)
+	t.Setenv("BUILDKITE", "true")
+	t.Setenv("BUILDKITE_ORGANIZATION_SLUG", "chainloop")
+	t.Setenv("BUILDKITE_PIPELINE_SLUG", "chainloop")
+	t.Setenv("BUILDKITE_REPO", "git@github.com:chainloop-dev/chainloop.git")
+	t.Setenv("BUILDKITE_COMMIT", "1234567890")
+	t.Setenv("BUILDKITE_BRANCH", "main")
+	t.Setenv("BUILDKITE_BUILD_URL", "https://buildkite.com/chainloop/chainloop/builds/42")
+	t.Setenv("BUILDKITE_BUILD_NUMBER", "42")
+	t.Setenv("BUILDKITE_JOB_ID", "job-id")
+	t.Setenv("BUILDKITE_AGENT_NAME", "agent-1")
+	t.Setenv("BUILDKITE_BUILD_CREATOR", "foo")
+	t.Setenv("BUILDKITE_BUILD_CREATOR_EMAIL", "foo@foo.com")
+	t.Setenv("BUILDKITE_TAG", "")
+	t.Setenv("BUILDKITE_STEP_KEY", "")
+	t.Setenv("BUILDKITE_PULL_REQUEST", "false")
+	t.Setenv("BUILDKITE_PULL_REQUEST_BASE_BRANCH", "")
+	t.Setenv("BUILDKITE_PULL_REQUEST_REPO", "")
+}
+
+// Run the tests
+func TestBuildkitePipelineRunner(t *testing.T) {
+	suite.Run(t, new(buildkitePipelineSuite))
+}
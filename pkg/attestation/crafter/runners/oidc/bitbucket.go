@@ -0,0 +1,145 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rs/zerolog"
+)
+
+// BitbucketTokenEnv is the environment variable name for the Bitbucket Pipelines OIDC token,
+// only set in steps with `oidc: true`.
+// #nosec G101 - This is just the name of an environment variable, not a credential
+const BitbucketTokenEnv = "BITBUCKET_STEP_OIDC_TOKEN"
+
+// BitbucketWorkspaceEnv is the environment variable name for the Bitbucket workspace the pipeline runs in.
+const BitbucketWorkspaceEnv = "BITBUCKET_WORKSPACE"
+
+// bitbucketProviderURLFormat is the OIDC provider of a Bitbucket workspace, which is the token issuer
+const bitbucketProviderURLFormat = "https://api.bitbucket.org/2.0/workspaces/%s/pipelines-config/identity/oidc"
+
+// bitbucketAudiencePrefix is the audience Bitbucket sets to the tokens, followed by the workspace UUID.
+// It can't be customized, so the token is bound to the workspace instead of to Chainloop.
+const bitbucketAudiencePrefix = "ari:cloud:bitbucket::workspace/"
+
+type BitbucketToken struct {
+	oidc.IDToken
+
+	// WorkspaceUUID is the workspace the pipeline runs in.
+	WorkspaceUUID string `json:"workspaceUuid"`
+
+	// RepositoryUUID is the repository the pipeline runs for.
+	RepositoryUUID string `json:"repositoryUuid"`
+
+	// PipelineUUID is the pipeline the step belongs to.
+	PipelineUUID string `json:"pipelineUuid"`
+
+	// StepUUID is the step the token was issued for.
+	StepUUID string `json:"stepUuid"`
+
+	// RawToken is the raw JWT token string used for federated authentication.
+	RawToken string `json:"-"`
+}
+
+type BitbucketOIDCClient struct {
+	Token *BitbucketToken
+}
+
+func NewBitbucketClient(ctx context.Context, authToken string, logger *zerolog.Logger) (*BitbucketOIDCClient, error) {
+	var c BitbucketOIDCClient
+
+	// the provider URL is specific to the workspace the pipeline is running in
+	workspace := os.Getenv(BitbucketWorkspaceEnv)
+	if workspace == "" {
+		return nil, fmt.Errorf("%s environment variable not set", BitbucketWorkspaceEnv)
+	}
+
+	providerURL := fmt.Sprintf(bitbucketProviderURLFormat, workspace)
+	logger.Debug().Str("providerURL", providerURL).Msg("retrieved provider URL")
+
+	tokenContent := os.Getenv(BitbucketTokenEnv)
+	if tokenContent == "" && authToken == "" {
+		return nil, fmt.Errorf("no token provided, neither explicitly nor as an environment variable %s; does your step have `oidc: true`?", BitbucketTokenEnv)
+	}
+
+	var (
+		token *BitbucketToken
+		err   error
+	)
+
+	if tokenContent != "" {
+		logger.Debug().Msgf("retrieved token content from environment variable %s", BitbucketTokenEnv)
+		token, err = parseBitbucketToken(ctx, providerURL, tokenContent)
+	} else {
+		logger.Debug().Msg("no token content in environment variable, trying to parse from raw token")
+		token, err = parseBitbucketToken(ctx, providerURL, authToken)
+		if err != nil {
+			err = fmt.Errorf("invalid authentication token provided, %w", err)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to load token: %w", err)
+	}
+
+	logger.Debug().Msg("OIDC token loaded successfully")
+
+	c.Token = token
+	return &c, nil
+}
+
+// parseBitbucketToken verifies the token against the workspace provider, and that it's meant for the workspace it was issued for
+func parseBitbucketToken(ctx context.Context, providerURL string, tokenString string) (*BitbucketToken, error) {
+	provider, err := oidc.NewProvider(ctx, providerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to OIDC provider: %w", err)
+	}
+
+	verifier := provider.Verifier(&oidc.Config{
+		SkipClientIDCheck: true, // the audience is checked below
+	})
+
+	idToken, err := verifier.Verify(ctx, tokenString)
+	if err != nil {
+		return nil, fmt.Errorf("token verification failed: %w", err)
+	}
+
+	var token BitbucketToken
+	if err := idToken.Claims(&token); err != nil {
+		return nil, fmt.Errorf("failed to extract claims: %w", err)
+	}
+
+	// the workspace UUID claim is wrapped in braces, while the audience is not
+	workspaceUUID := strings.Trim(token.WorkspaceUUID, "{}")
+	if workspaceUUID == "" {
+		return nil, fmt.Errorf("missing workspace UUID claim")
+	}
+
+	if expected := bitbucketAudiencePrefix + workspaceUUID; !slices.Contains(idToken.Audience, expected) {
+		return nil, fmt.Errorf("invalid audience: expected %q", expected)
+	}
+
+	token.IDToken = *idToken
+	token.RawToken = tokenString
+
+	return &token, nil
+}
@@ -0,0 +1,65 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/oidc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBitbucketClient(t *testing.T) {
+	testLogger := zerolog.New(zerolog.Nop()).Level(zerolog.Disabled)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		setupEnv          func(t *testing.T)
+		explicitToken     string
+		expectErrContains string
+	}{
+		{
+			name: "Missing workspace",
+			setupEnv: func(t *testing.T) {
+				t.Setenv(oidc.BitbucketWorkspaceEnv, "")
+				t.Setenv(oidc.BitbucketTokenEnv, "test-token")
+			},
+			expectErrContains: "environment variable not set",
+		},
+		{
+			name: "Missing OIDC token",
+			setupEnv: func(t *testing.T) {
+				t.Setenv(oidc.BitbucketWorkspaceEnv, "chainloop")
+				t.Setenv(oidc.BitbucketTokenEnv, "")
+			},
+			expectErrContains: "no token provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupEnv(t)
+			client, err := oidc.NewBitbucketClient(ctx, tt.explicitToken, &testLogger)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectErrContains)
+			assert.Nil(t, client)
+		})
+	}
+}
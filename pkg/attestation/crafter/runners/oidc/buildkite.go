@@ -0,0 +1,196 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rs/zerolog"
+)
+
+// DefaultBuildkiteProviderURL is the Buildkite OIDC provider, which is the token issuer
+var DefaultBuildkiteProviderURL = "https://agent.buildkite.com"
+
+// DefaultBuildkiteAgentEndpoint is the Buildkite Agent API, used when the agent doesn't expose its endpoint
+const DefaultBuildkiteAgentEndpoint = "https://agent.buildkite.com/v3"
+
+const (
+	// BuildkiteTokenEnv is the environment variable for an OIDC token already requested in the job, i.e
+	// with buildkite-agent oidc request-token --audience chainloop --claim runner_environment
+	// #nosec G101 - This is just the name of an environment variable, not a credential
+	BuildkiteTokenEnv = "BUILDKITE_OIDC_TOKEN"
+	// BuildkiteAgentAccessTokenEnv is the environment variable for the job token used to request OIDC tokens
+	// #nosec G101 - This is just the name of an environment variable, not a credential
+	BuildkiteAgentAccessTokenEnv = "BUILDKITE_AGENT_ACCESS_TOKEN"
+	// BuildkiteAgentEndpointEnv is the environment variable for the Buildkite Agent API endpoint
+	BuildkiteAgentEndpointEnv = "BUILDKITE_AGENT_ENDPOINT"
+	// BuildkiteJobIDEnv is the environment variable for the job the token is requested for
+	BuildkiteJobIDEnv = "BUILDKITE_JOB_ID"
+)
+
+type BuildkiteToken struct {
+	oidc.IDToken
+
+	// OrganizationSlug is the organization the pipeline belongs to.
+	OrganizationSlug string `json:"organization_slug"`
+
+	// PipelineSlug is the pipeline the job belongs to.
+	PipelineSlug string `json:"pipeline_slug"`
+
+	// BuildCommit is the commit being built.
+	BuildCommit string `json:"build_commit"`
+
+	// RunnerEnvironment is the environment the agent is running in, only present
+	// when the optional runner_environment claim is requested.
+	RunnerEnvironment string `json:"runner_environment"`
+
+	// RawToken is the raw JWT token string used for federated authentication.
+	RawToken string `json:"-"`
+}
+
+type BuildkiteOIDCClient struct {
+	Token *BuildkiteToken
+}
+
+// NewBuildkiteClient loads the OIDC token of the job. It's either explicitly set in BUILDKITE_OIDC_TOKEN or
+// requested from the Buildkite Agent API, the same way buildkite-agent oidc request-token does.
+func NewBuildkiteClient(ctx context.Context, logger *zerolog.Logger) (*BuildkiteOIDCClient, error) {
+	var c BuildkiteOIDCClient
+
+	tokenContent := os.Getenv(BuildkiteTokenEnv)
+	if tokenContent != "" {
+		logger.Debug().Msgf("retrieved token content from environment variable %s", BuildkiteTokenEnv)
+	} else {
+		var err error
+		tokenContent, err = requestBuildkiteToken(ctx, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to request token: %w", err)
+		}
+	}
+
+	token, err := parseBuildkiteToken(ctx, DefaultBuildkiteProviderURL, tokenContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load token: %w", err)
+	}
+
+	logger.Debug().Msg("OIDC token loaded successfully")
+
+	c.Token = token
+	return &c, nil
+}
+
+func requestBuildkiteToken(ctx context.Context, logger *zerolog.Logger) (string, error) {
+	accessToken := os.Getenv(BuildkiteAgentAccessTokenEnv)
+	if accessToken == "" {
+		return "", fmt.Errorf("token: %s environment variable not set", BuildkiteAgentAccessTokenEnv)
+	}
+
+	jobID := os.Getenv(BuildkiteJobIDEnv)
+	if jobID == "" {
+		return "", fmt.Errorf("job: %s environment variable not set", BuildkiteJobIDEnv)
+	}
+
+	endpoint := os.Getenv(BuildkiteAgentEndpointEnv)
+	if endpoint == "" {
+		endpoint = DefaultBuildkiteAgentEndpoint
+	}
+
+	requestURL, err := url.JoinPath(endpoint, "jobs", url.PathEscape(jobID), "oidc", "tokens")
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid agent endpoint %q: %w", errURLError, endpoint, err)
+	}
+
+	// runner_environment is optional, it tells whether the agent is hosted by Buildkite
+	body, err := json.Marshal(map[string]any{"audience": ExpectedAudience, "claims": []string{"runner_environment"}})
+	if err != nil {
+		return "", fmt.Errorf("%w: creating request: %w", errRequestError, err)
+	}
+
+	logger.Debug().Msgf("requesting token with audience: %s", ExpectedAudience)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("%w: creating request: %w", errRequestError, err)
+	}
+	req.Header.Add("Authorization", "Token "+accessToken)
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errRequestError, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("%w: reading response: %w", errRequestError, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("%w: response: %s: %s", errRequestError, resp.Status, strings.TrimSpace(string(b)))
+	}
+
+	var payload struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return "", fmt.Errorf("%w: parsing JSON: %w", errToken, err)
+	}
+
+	if payload.Token == "" {
+		return "", fmt.Errorf("%w: empty token", errToken)
+	}
+
+	return payload.Token, nil
+}
+
+func parseBuildkiteToken(ctx context.Context, providerURL string, tokenString string) (*BuildkiteToken, error) {
+	provider, err := oidc.NewProvider(ctx, providerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to OIDC provider: %w", err)
+	}
+
+	verifier := provider.Verifier(&oidc.Config{
+		SkipClientIDCheck: true, // the audience is checked below
+	})
+
+	idToken, err := verifier.Verify(ctx, tokenString)
+	if err != nil {
+		return nil, fmt.Errorf("token verification failed: %w", err)
+	}
+
+	if !slices.Contains(idToken.Audience, ExpectedAudience) {
+		return nil, fmt.Errorf("invalid audience: expected %q", ExpectedAudience)
+	}
+
+	var token BuildkiteToken
+	if err := idToken.Claims(&token); err != nil {
+		return nil, fmt.Errorf("failed to extract claims: %w", err)
+	}
+
+	token.IDToken = *idToken
+	token.RawToken = tokenString
+
+	return &token, nil
+}
@@ -0,0 +1,130 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oidc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/attestation/crafter/runners/oidc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBuildkiteClient(t *testing.T) {
+	testLogger := zerolog.New(zerolog.Nop()).Level(zerolog.Disabled)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		setupEnv          func(t *testing.T)
+		expectErrContains string
+	}{
+		{
+			name: "Missing access token",
+			setupEnv: func(t *testing.T) {
+				t.Setenv(oidc.BuildkiteAgentAccessTokenEnv, "")
+				t.Setenv(oidc.BuildkiteJobIDEnv, "job-id")
+			},
+			expectErrContains: "environment variable not set",
+		},
+		{
+			name: "Missing job ID",
+			setupEnv: func(t *testing.T) {
+				t.Setenv(oidc.BuildkiteAgentAccessTokenEnv, "test-token")
+				t.Setenv(oidc.BuildkiteJobIDEnv, "")
+			},
+			expectErrContains: "environment variable not set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(oidc.BuildkiteTokenEnv, "")
+			tt.setupEnv(t)
+			client, err := oidc.NewBuildkiteClient(ctx, &testLogger)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectErrContains)
+			assert.Nil(t, client)
+		})
+	}
+}
+
+func TestBuildkiteTokenRequest(t *testing.T) {
+	testLogger := zerolog.New(zerolog.Nop()).Level(zerolog.Disabled)
+
+	tests := []struct {
+		name              string
+		serverHandler     func(w http.ResponseWriter, r *http.Request)
+		expectErrContains string
+	}{
+		{
+			name: "Non-200 response",
+			serverHandler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/v3/jobs/job-id/oidc/tokens", r.URL.Path)
+				assert.Equal(t, "Token test-token", r.Header.Get("Authorization"))
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, oidc.ExpectedAudience, body["audience"])
+				assert.Equal(t, []any{"runner_environment"}, body["claims"])
+
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("Forbidden"))
+			},
+			expectErrContains: "response: 403",
+		},
+		{
+			name: "Invalid JSON response",
+			serverHandler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"token": "token", invalid`))
+			},
+			expectErrContains: "parsing JSON",
+		},
+		{
+			name: "Empty token",
+			serverHandler: func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"token": ""}`))
+			},
+			expectErrContains: "empty token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(tt.serverHandler))
+			defer server.Close()
+
+			t.Setenv(oidc.BuildkiteTokenEnv, "")
+			t.Setenv(oidc.BuildkiteAgentEndpointEnv, server.URL+"/v3")
+			t.Setenv(oidc.BuildkiteAgentAccessTokenEnv, "test-token")
+			t.Setenv(oidc.BuildkiteJobIDEnv, "job-id")
+
+			client, err := oidc.NewBuildkiteClient(context.Background(), &testLogger)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectErrContains)
+			assert.Nil(t, client)
+		})
+	}
+}
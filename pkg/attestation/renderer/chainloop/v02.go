@@ -112,11 +112,16 @@ type PolicyViolation struct {
 	Subject  string `json:"subject"`
 	Message  string `json:"message"`
 	Suppress bool   `json:"suppress,omitempty"`
+	// Policy exception that suppressed the violation, if any
+	ExceptionID string `json:"exceptionId,omitempty"`
 	// Mirrors the oneof on attestation.v1.PolicyEvaluation.Violation. Only
 	// populated on the bundle path; never on the inline predicate.
 	Vulnerability    *v1.PolicyVulnerabilityFinding    `json:"vulnerability,omitempty"`
 	Sast             *v1.PolicySASTFinding             `json:"sast,omitempty"`
 	LicenseViolation *v1.PolicyLicenseViolationFinding `json:"licenseViolation,omitempty"`
+	Secret           *v1.PolicySecretFinding           `json:"secret,omitempty"`
+	Misconfiguration *v1.PolicyMisconfigurationFinding `json:"misconfiguration,omitempty"`
+	Malware          *v1.PolicyMalwareFinding          `json:"malware,omitempty"`
 }
 
 type RendererV02 struct {
@@ -412,9 +417,10 @@ func renderEvaluation(ev *v1.PolicyEvaluation, includeSuppressed bool) (*PolicyE
 		}
 
 		out := &PolicyViolation{
-			Subject:  vi.Subject,
-			Message:  vi.Message,
-			Suppress: vi.GetSuppress(),
+			Subject:     vi.Subject,
+			Message:     vi.Message,
+			Suppress:    vi.GetSuppress(),
+			ExceptionID: vi.GetExceptionId(),
 		}
 		if includeSuppressed {
 			switch f := vi.GetFinding().(type) {
@@ -424,6 +430,12 @@ func renderEvaluation(ev *v1.PolicyEvaluation, includeSuppressed bool) (*PolicyE
 				out.Sast = f.Sast
 			case *v1.PolicyEvaluation_Violation_LicenseViolation:
 				out.LicenseViolation = f.LicenseViolation
+			case *v1.PolicyEvaluation_Violation_Secret:
+				out.Secret = f.Secret
+			case *v1.PolicyEvaluation_Violation_Misconfiguration:
+				out.Misconfiguration = f.Misconfiguration
+			case *v1.PolicyEvaluation_Violation_Malware:
+				out.Malware = f.Malware
 			}
 		}
 		violations = append(violations, out)
@@ -469,11 +481,17 @@ func renderReference(ref *v1.PolicyEvaluation_Reference) (*intoto.ResourceDescri
 		// Struct raise errors in some conditions (when a field is not UTF8, for example). We need to handle them, although it's a remote possibility
 		return nil, err
 	}
+	// policies loaded from git are referenced by their commit, so the digest algorithm might not be sha256
+	algorithm, digest, ok := strings.Cut(ref.GetDigest(), ":")
+	if !ok {
+		algorithm, digest = "sha256", ref.GetDigest()
+	}
+
 	return &intoto.ResourceDescriptor{
 		Name: ref.GetName(),
 		Uri:  ref.GetUri(),
 		Digest: map[string]string{
-			"sha256": strings.TrimPrefix(ref.GetDigest(), "sha256:"),
+			algorithm: digest,
 		},
 		Annotations: annotations,
 	}, nil
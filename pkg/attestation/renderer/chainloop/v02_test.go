@@ -840,3 +840,35 @@ func TestPolicyEvaluationsField(t *testing.T) {
 	assert.Equal(t, "sbom", ev.MaterialName)
 	assert.NotNil(t, ev.PolicyReference)
 }
+
+func TestRenderReferenceDigest(t *testing.T) {
+	testCases := []struct {
+		name   string
+		digest string
+		want   map[string]string
+	}{
+		{
+			name:   "sha256",
+			digest: "sha256:aa77c4e8d5d5c6c1e1c0b5aa8a1c1c28c36bb86fa0cbb0e3b9f9e1d3c1e1f2a3",
+			want:   map[string]string{"sha256": "aa77c4e8d5d5c6c1e1c0b5aa8a1c1c28c36bb86fa0cbb0e3b9f9e1d3c1e1f2a3"},
+		},
+		{
+			name:   "git commit",
+			digest: "sha1:4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+			want:   map[string]string{"sha1": "4b825dc642cb6eb9a060e54bf8d69288fbee4904"},
+		},
+		{
+			name:   "no algorithm",
+			digest: "aa77c4e8",
+			want:   map[string]string{"sha256": "aa77c4e8"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := renderReference(&api.PolicyEvaluation_Reference{Name: "policy", Uri: "file://policy.yaml", Digest: tc.digest})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got.GetDigest())
+		})
+	}
+}
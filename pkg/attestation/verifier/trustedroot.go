@@ -0,0 +1,108 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/sigstore/sigstore-go/pkg/root"
+)
+
+// LoadSigstoreTrustedRoot reads a sigstore trusted_root.json document from disk and
+// returns its certificate and timestamp authorities, so bundles can be verified offline.
+func LoadSigstoreTrustedRoot(path string) (*TrustedRoot, error) {
+	sr, err := root.NewTrustedRootFromPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("loading trusted root: %w", err)
+	}
+
+	tr := NewTrustedRoot()
+	for _, ca := range sr.FulcioCertificateAuthorities() {
+		fca, ok := ca.(*root.FulcioCertificateAuthority)
+		if !ok || fca.Root == nil {
+			continue
+		}
+		chain := append([]*x509.Certificate{}, fca.Intermediates...)
+		if err := tr.AddCertificateChain(append(chain, fca.Root)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, tsa := range sr.TimestampingAuthorities() {
+		sta, ok := tsa.(*root.SigstoreTimestampingAuthority)
+		if !ok || sta.Leaf == nil || sta.Root == nil {
+			continue
+		}
+		chain := append([]*x509.Certificate{sta.Leaf}, sta.Intermediates...)
+		if err := tr.AddTimestampAuthorityChain(append(chain, sta.Root)); err != nil {
+			return nil, err
+		}
+	}
+
+	return tr, nil
+}
+
+// NewTrustedRoot returns an empty trusted root ready to be populated
+func NewTrustedRoot() *TrustedRoot {
+	return &TrustedRoot{Keys: make(map[string][]*x509.Certificate), TimestampAuthorities: make(map[string][]*x509.Certificate)}
+}
+
+// AddCertificateChain trusts a signing certificate authority.
+// The chain goes from the certificate issuing the signing certificates up to the root.
+func (tr *TrustedRoot) AddCertificateChain(chain []*x509.Certificate) error {
+	if len(chain) == 0 {
+		return errors.New("empty certificate chain")
+	}
+
+	if tr.Keys == nil {
+		tr.Keys = make(map[string][]*x509.Certificate)
+	}
+
+	tr.Keys[keyIDFor(chain[0])] = chain
+	return nil
+}
+
+// AddTimestampAuthorityChain trusts a timestamp authority.
+// The chain goes from the TSA signing certificate up to the root.
+func (tr *TrustedRoot) AddTimestampAuthorityChain(chain []*x509.Certificate) error {
+	if len(chain) == 0 {
+		return errors.New("empty timestamp authority chain")
+	}
+
+	if tr.TimestampAuthorities == nil {
+		tr.TimestampAuthorities = make(map[string][]*x509.Certificate)
+	}
+
+	tr.TimestampAuthorities[keyIDFor(chain[0])] = chain
+	return nil
+}
+
+// keyIDFor matches the key identifiers served by the controlplane trusted root.
+// Certificates without a subject key identifier are identified by their public key instead,
+// otherwise all of them would get the same identifier and replace each other.
+func keyIDFor(cert *x509.Certificate) string {
+	id := cert.SubjectKeyId
+	if len(id) == 0 {
+		id = cert.RawSubjectPublicKeyInfo
+	}
+
+	sum := sha256.Sum256(id)
+	return hex.EncodeToString(sum[:])
+}
@@ -17,6 +17,7 @@ package verifier
 
 import (
 	"context"
+	"crypto"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/hex"
@@ -28,6 +29,7 @@ import (
 	"github.com/sigstore/cosign/v3/pkg/cosign"
 	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
 	sigstorebundle "github.com/sigstore/sigstore-go/pkg/bundle"
+	"github.com/sigstore/sigstore/pkg/signature"
 	sigdsee "github.com/sigstore/sigstore/pkg/signature/dsse"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -36,6 +38,8 @@ type TrustedRoot struct {
 	// map key identifiers to a chain of certificates
 	Keys                 map[string][]*x509.Certificate
 	TimestampAuthorities map[string][]*x509.Certificate
+	// raw public keys (i.e cosign keys) used to verify bundles that carry no signing certificate
+	PublicKeys []crypto.PublicKey
 }
 
 var ErrMissingVerificationMaterial = errors.New("missing material")
@@ -74,15 +78,19 @@ func VerifyBundle(ctx context.Context, bundleBytes []byte, tr *TrustedRoot) erro
 		if err := verifyCertSignature(ctx, bundle, vc.Certificate(), tr); err != nil {
 			return err
 		}
+	case len(tr.PublicKeys) > 0:
+		if err := verifyKeySignature(ctx, bundle, tr.PublicKeys); err != nil {
+			return err
+		}
 	case bundle.GetVerificationMaterial().GetPublicKey() != nil:
-		// Public-key bundles are not supported at this time
+		// Public-key bundles can only be verified against explicitly trusted keys
 		return fmt.Errorf("%w: public key verification material", ErrUnsupportedVerificationMaterial)
 	default:
 		// No certificate and no public key: nothing to verify the signature against.
 		return ErrMissingVerificationMaterial
 	}
 
-	// The signature has been verified against a trusted certificate. The timestamp
+	// The signature has been verified against a trusted certificate or key. The timestamp
 	// (if present) only validates the signing window; it can never be the sole
 	// verification material.
 	if err := VerifyTimestamps(sb, tr); err != nil && !errors.Is(err, ErrMissingVerificationMaterial) {
@@ -95,11 +103,9 @@ func VerifyBundle(ctx context.Context, bundleBytes []byte, tr *TrustedRoot) erro
 // verifyCertSignature validates the signing certificate against the trusted root
 // chain and verifies the DSSE envelope signature with the certificate's key.
 func verifyCertSignature(ctx context.Context, bundle *protobundle.Bundle, signingCert *x509.Certificate, tr *TrustedRoot) error {
-	akiSum := sha256.Sum256(signingCert.AuthorityKeyId)
-	aki := hex.EncodeToString(akiSum[:])
-	chain, ok := tr.Keys[aki]
-	if !ok {
-		return fmt.Errorf("trusted root not found for signing key with AKI %s", aki)
+	chain, err := issuerChain(signingCert, tr.Keys)
+	if err != nil {
+		return err
 	}
 
 	verifier, err := cosign.ValidateAndUnpackCertWithChain(signingCert, chain, &cosign.CheckOpts{IgnoreSCT: true})
@@ -118,3 +124,53 @@ func verifyCertSignature(ctx context.Context, bundle *protobundle.Bundle, signin
 
 	return nil
 }
+
+// issuerChain returns the trusted chain the signing certificate was issued by, looked up by its
+// authority key identifier. Without one, the chain is the one whose certificate signed it.
+func issuerChain(signingCert *x509.Certificate, keys map[string][]*x509.Certificate) ([]*x509.Certificate, error) {
+	if len(signingCert.AuthorityKeyId) == 0 {
+		for _, chain := range keys {
+			if len(chain) > 0 && signingCert.CheckSignatureFrom(chain[0]) == nil {
+				return chain, nil
+			}
+		}
+
+		return nil, errors.New("trusted root not found for signing key without AKI")
+	}
+
+	akiSum := sha256.Sum256(signingCert.AuthorityKeyId)
+	aki := hex.EncodeToString(akiSum[:])
+	chain, ok := keys[aki]
+	if !ok {
+		return nil, fmt.Errorf("trusted root not found for signing key with AKI %s", aki)
+	}
+
+	return chain, nil
+}
+
+// verifyKeySignature verifies the DSSE envelope signature against any of the trusted public keys.
+func verifyKeySignature(ctx context.Context, bundle *protobundle.Bundle, keys []crypto.PublicKey) error {
+	envelope := attestation.DSSEEnvelopeFromBundle(bundle)
+
+	var lastErr error
+	for _, k := range keys {
+		verifier, err := signature.LoadVerifier(k, crypto.SHA256)
+		if err != nil {
+			return fmt.Errorf("loading public key verifier: %w", err)
+		}
+
+		dsseVerifier, err := dsse.NewEnvelopeVerifier(&sigdsee.VerifierAdapter{SignatureVerifier: verifier})
+		if err != nil {
+			return fmt.Errorf("creating DSSE verifier: %w", err)
+		}
+
+		if _, err := dsseVerifier.Verify(ctx, envelope); err != nil {
+			lastErr = err
+			continue
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("validating the DSSE envelope: %w", lastErr)
+}
@@ -18,14 +18,25 @@ package verifier
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
+	"math/big"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/chainloop-dev/chainloop/pkg/attestation"
+	"github.com/secure-systems-lab/go-securesystemslib/dsse"
 	protobundle "github.com/sigstore/protobuf-specs/gen/pb-go/bundle/v1"
 	sigstorebundle "github.com/sigstore/sigstore-go/pkg/bundle"
 	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	sigdsee "github.com/sigstore/sigstore/pkg/signature/dsse"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -165,3 +176,118 @@ func TestVerifyTimestamps_TypedErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyBundleWithPublicKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	sv, err := signature.LoadECDSASignerVerifier(key, crypto.SHA256)
+	require.NoError(t, err)
+	signer, err := dsse.NewEnvelopeSigner(&sigdsee.SignerAdapter{SignatureSigner: sv})
+	require.NoError(t, err)
+	envelope, err := signer.SignPayload(context.TODO(), "application/vnd.in-toto+json", []byte(`{"_type":"https://in-toto.io/Statement/v1"}`))
+	require.NoError(t, err)
+
+	bundle, err := attestation.BundleFromDSSEEnvelope(envelope)
+	require.NoError(t, err)
+	bundleBytes, err := protojson.Marshal(bundle)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name      string
+		roots     *TrustedRoot
+		expectErr string
+	}{
+		{
+			name:  "trusted key",
+			roots: &TrustedRoot{PublicKeys: []crypto.PublicKey{&key.PublicKey}},
+		},
+		{
+			name:  "trusted key among others",
+			roots: &TrustedRoot{PublicKeys: []crypto.PublicKey{&otherKey.PublicKey, &key.PublicKey}},
+		},
+		{
+			name:      "untrusted key",
+			roots:     &TrustedRoot{PublicKeys: []crypto.PublicKey{&otherKey.PublicKey}},
+			expectErr: "validating the DSSE envelope",
+		},
+		{
+			name:      "no keys",
+			roots:     &TrustedRoot{},
+			expectErr: "missing material",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := VerifyBundle(context.TODO(), bundleBytes, tc.roots)
+			if tc.expectErr != "" {
+				assert.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAddCertificateChain(t *testing.T) {
+	ca, err := os.ReadFile("testdata/ca.pub")
+	require.NoError(t, err)
+	certs, err := cryptoutils.LoadCertificatesFromPEM(bytes.NewReader(ca))
+	require.NoError(t, err)
+
+	tr := NewTrustedRoot()
+	require.NoError(t, tr.AddCertificateChain(certs))
+	assert.Equal(t, certs, tr.Keys["2a522d9652e0933d2a1237c395bc116e012f86dffff13122da59f76e0d2abe27"])
+
+	assert.Error(t, tr.AddCertificateChain(nil))
+	assert.Error(t, tr.AddTimestampAuthorityChain(nil))
+
+	// the resulting root verifies bundles signed by the chain
+	bundleBytes, err := os.ReadFile("testdata/bundle_valid.json")
+	require.NoError(t, err)
+	assert.NoError(t, VerifyBundle(context.TODO(), bundleBytes, tr))
+}
+
+func TestCertificatesWithoutKeyIdentifiers(t *testing.T) {
+	newCert := func(name string, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, crypto.Signer) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		tmpl := &x509.Certificate{
+			SerialNumber: big.NewInt(1), Subject: pkix.Name{CommonName: name},
+			NotBefore: time.Now().Add(-time.Hour), NotAfter: time.Now().Add(time.Hour),
+			IsCA: parent == nil, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		}
+		if parent == nil {
+			parent, parentKey = tmpl, key
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, key.Public(), parentKey)
+		require.NoError(t, err)
+		cert, err := x509.ParseCertificate(der)
+		require.NoError(t, err)
+
+		// as issued by authorities not setting them
+		cert.SubjectKeyId, cert.AuthorityKeyId = nil, nil
+		return cert, key
+	}
+
+	ca1, ca1Key := newCert("ca-1", nil, nil)
+	ca2, _ := newCert("ca-2", nil, nil)
+	leaf, _ := newCert("leaf", ca1, ca1Key)
+
+	tr := NewTrustedRoot()
+	require.NoError(t, tr.AddCertificateChain([]*x509.Certificate{ca1}))
+	require.NoError(t, tr.AddCertificateChain([]*x509.Certificate{ca2}))
+	assert.Len(t, tr.Keys, 2)
+
+	chain, err := issuerChain(leaf, tr.Keys)
+	require.NoError(t, err)
+	assert.Equal(t, []*x509.Certificate{ca1}, chain)
+
+	_, err = issuerChain(leaf, map[string][]*x509.Certificate{keyIDFor(ca2): {ca2}})
+	assert.Error(t, err)
+}
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
@@ -40,6 +42,8 @@ type Backend struct {
 var (
 	_ backend.UploaderDownloader = (*Backend)(nil)
 	_ backend.StreamingUploader  = (*Backend)(nil)
+	_ backend.Deleter            = (*Backend)(nil)
+	_ backend.Lister             = (*Backend)(nil)
 )
 
 // SupportsStreaming reports that the azureblob backend can upload directly from
@@ -200,6 +204,80 @@ func (b *Backend) Download(ctx context.Context, w io.Writer, digest string) erro
 	return nil
 }
 
+// Delete removes the blob from the container, a missing blob is not considered an error
+func (b *Backend) Delete(ctx context.Context, digest string) error {
+	client, err := b.client()
+	if err != nil {
+		return fmt.Errorf("failed to create Azure Blob Storage client: %w", err)
+	}
+
+	if _, err := client.DeleteBlob(ctx, b.container, resourceName(digest), nil); err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// Touch refreshes the last modification time of the blob by setting its metadata again
+func (b *Backend) Touch(ctx context.Context, digest string) error {
+	blobClient, err := b.blobClient(digest)
+	if err != nil {
+		return fmt.Errorf("failed to create Azure Blob Storage client: %w", err)
+	}
+
+	properties, err := blobClient.GetProperties(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get blob properties: %w", err)
+	}
+
+	if _, err := blobClient.SetMetadata(ctx, properties.Metadata, nil); err != nil {
+		return fmt.Errorf("failed to touch blob: %w", err)
+	}
+
+	return nil
+}
+
+// List walks the blobs stored in the container
+func (b *Backend) List(ctx context.Context, fn func(digest string, modifiedAt time.Time) error) error {
+	client, err := b.client()
+	if err != nil {
+		return fmt.Errorf("failed to create Azure Blob Storage client: %w", err)
+	}
+
+	pager := client.NewListBlobsFlatPager(b.container, &azblob.ListBlobsFlatOptions{Prefix: to.Ptr(resourceName(""))})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			if bloberror.HasCode(err, bloberror.ContainerNotFound) {
+				return nil
+			}
+
+			return fmt.Errorf("failed to list blobs: %w", err)
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+
+			var modifiedAt time.Time
+			if item.Properties != nil && item.Properties.LastModified != nil {
+				modifiedAt = *item.Properties.LastModified
+			}
+
+			if err := fn(strings.TrimPrefix(*item.Name, resourceName("")), modifiedAt); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // CheckWritePermissions performs an actual write to the repository to check that the credentials
 func (b *Backend) CheckWritePermissions(ctx context.Context) error {
 	client, err := b.client()
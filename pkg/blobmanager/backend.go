@@ -21,6 +21,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	v1 "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
 	"github.com/google/go-containerregistry/pkg/v1/types"
@@ -60,6 +61,32 @@ type StreamingUploader interface {
 	SupportsStreaming() bool
 }
 
+// Deleter is an optional interface implemented by backends that can remove
+// artifacts from the underlying storage. It is used by the controlplane
+// retention sweeper to garbage collect expired or unreferenced blobs.
+//
+// Deleting an artifact that does not exist is not an error.
+type Deleter interface {
+	Delete(ctx context.Context, digest string) error
+}
+
+// Lister is an optional interface implemented by backends that can enumerate the
+// artifacts they store. It is used by the controlplane retention sweeper to find
+// blobs that were uploaded but never referenced by an attestation.
+type Lister interface {
+	// List calls fn with the digest and last modification time of every stored artifact.
+	// An error returned by fn stops the listing and is returned as is.
+	List(ctx context.Context, fn func(digest string, modifiedAt time.Time) error) error
+}
+
+// Toucher is an optional interface implemented by backends that can refresh the modification time of
+// an artifact. The Artifact CAS touches the artifacts whose upload is skipped because they already exist,
+// so the retention sweeper, which deletes the unreferenced artifacts not modified in a while, doesn't
+// remove one that an attestation in progress is about to reference.
+type Toucher interface {
+	Touch(ctx context.Context, digest string) error
+}
+
 type Describer interface {
 	Describe(ctx context.Context, digest string) (*v1.CASResource, error)
 }
@@ -80,6 +107,13 @@ type Provider interface {
 	ValidateAndExtractCredentials(location string, credsJSON []byte) (any, error)
 }
 
+// LocationResolver is an optional interface implemented by providers whose locations are
+// confined per organization. It returns the canonical location the backend must be registered with,
+// so the same storage is always referenced by the same location.
+type LocationResolver interface {
+	ResolveLocation(orgID, location string) (string, error)
+}
+
 type Providers map[string]Provider
 
 // Detect the media type based on the provided content
@@ -0,0 +1,399 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+)
+
+// Backend stores the artifacts in a local or network mounted directory with the following layout
+//
+//	<root>/blobs/sha256/<first two chars of the digest>/<digest>       artifact content
+//	<root>/blobs/sha256/<first two chars of the digest>/<digest>.json  artifact metadata
+//	<root>/tmp/                                                        in-flight uploads
+//
+// Uploads are written to the tmp directory and renamed into place once the content
+// matches its digest, so readers never observe partially written artifacts.
+type Backend struct {
+	root string
+}
+
+var (
+	_ backend.UploaderDownloader = (*Backend)(nil)
+	_ backend.StreamingUploader  = (*Backend)(nil)
+	_ backend.Deleter            = (*Backend)(nil)
+	_ backend.Lister             = (*Backend)(nil)
+	_ backend.Toucher            = (*Backend)(nil)
+)
+
+const (
+	blobsDir = "blobs/sha256"
+	tmpDir   = "tmp"
+
+	dirPerm  = 0o750
+	filePerm = 0o640
+
+	// uploads in the tmp directory older than this were left behind by an interrupted process
+	staleUploadAge = 24 * time.Hour
+)
+
+// SupportsStreaming reports that the filesystem backend writes the artifact as it is
+// received, the digest is computed on the fly and checked before the artifact is committed
+func (b *Backend) SupportsStreaming() bool { return true }
+
+func NewBackend(root string) (*Backend, error) {
+	if !filepath.IsAbs(root) {
+		return nil, fmt.Errorf("%w: path must be absolute", backend.ErrValidation)
+	}
+
+	return &Backend{root: filepath.Clean(root)}, nil
+}
+
+type metadata struct {
+	Author   string `json:"author"`
+	Filename string `json:"filename"`
+}
+
+var digestRegexp = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// validateDigest makes sure the digest is a hex encoded sha256, since it's used to build paths
+func validateDigest(digest string) error {
+	if !digestRegexp.MatchString(digest) {
+		return fmt.Errorf("invalid digest %q: must be a hex encoded sha256", digest)
+	}
+
+	return nil
+}
+
+func (b *Backend) blobPath(digest string) string {
+	return filepath.Join(b.root, blobsDir, digest[:2], digest)
+}
+
+func (b *Backend) metadataPath(digest string) string {
+	return b.blobPath(digest) + ".json"
+}
+
+// Exists check that the artifact is already present in the repository
+func (b *Backend) Exists(ctx context.Context, digest string) (bool, error) {
+	_, err := b.Describe(ctx, digest)
+	if err != nil && backend.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (b *Backend) Upload(_ context.Context, r io.Reader, resource *pb.CASResource) error {
+	if err := validateDigest(resource.Digest); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(b.blobPath(resource.Digest)), dirPerm); err != nil {
+		return fmt.Errorf("failed to create artifact directory: %w", err)
+	}
+
+	// Write and verify the content first, it will be renamed into place once the metadata is stored
+	tmpBlob, err := b.writeTemp(r, resource.Digest)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpBlob)
+
+	metadataJSON, err := json.Marshal(&metadata{Author: backend.AuthorAnnotation, Filename: resource.FileName})
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	tmpMetadata, err := b.writeTemp(bytes.NewReader(metadataJSON), "")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpMetadata)
+
+	if err := os.Rename(tmpMetadata, b.metadataPath(resource.Digest)); err != nil {
+		return fmt.Errorf("failed to store metadata: %w", err)
+	}
+
+	if err := os.Rename(tmpBlob, b.blobPath(resource.Digest)); err != nil {
+		return fmt.Errorf("failed to store artifact: %w", err)
+	}
+
+	return nil
+}
+
+// writeTemp stores the content in a temporary file and returns its path.
+// If a digest is provided the content is checked against it.
+func (b *Backend) writeTemp(r io.Reader, digest string) (string, error) {
+	dir := filepath.Join(b.root, tmpDir)
+	if err := os.MkdirAll(dir, dirPerm); err != nil {
+		return "", fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	f, err := os.CreateTemp(dir, "upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	// cleanup on any failure, the caller is responsible for it otherwise
+	success := false
+	defer func() {
+		if !success {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	if err := f.Chmod(filePerm); err != nil {
+		return "", fmt.Errorf("failed to set permissions: %w", err)
+	}
+
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, hasher), r); err != nil {
+		return "", fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if digest != "" {
+		if got := hex.EncodeToString(hasher.Sum(nil)); got != digest {
+			return "", fmt.Errorf("failed to validate integrity of object, got=%s, want=%s", got, digest)
+		}
+	}
+
+	// make sure the content is persisted before it gets renamed into place
+	if err := f.Sync(); err != nil {
+		return "", fmt.Errorf("failed to sync file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("failed to close file: %w", err)
+	}
+
+	success = true
+	return f.Name(), nil
+}
+
+func (b *Backend) Describe(_ context.Context, digest string) (*pb.CASResource, error) {
+	if err := validateDigest(digest); err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(b.blobPath(digest))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, backend.NewErrNotFound("artifact")
+		}
+
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	metadataJSON, err := os.ReadFile(b.metadataPath(digest))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("couldn't find file metadata")
+		}
+
+		return nil, fmt.Errorf("failed to read metadata: %w", err)
+	}
+
+	var m metadata
+	if err := json.Unmarshal(metadataJSON, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse metadata: %w", err)
+	}
+
+	// Check asset author is Chainloop that way we can ignore files created by other tools
+	// note: this is not a security mechanism, the content is verified against its digest on read
+	if m.Author != backend.AuthorAnnotation {
+		return nil, errors.New("asset not uploaded by Chainloop")
+	}
+
+	return &pb.CASResource{
+		FileName: m.Filename,
+		Size:     info.Size(),
+		Digest:   digest,
+	}, nil
+}
+
+// Download streams the artifact to the writer while computing its digest.
+// A mismatch is only detected once the content has been written, so callers
+// must discard it if an error is returned.
+func (b *Backend) Download(ctx context.Context, w io.Writer, digest string) error {
+	exists, err := b.Exists(ctx, digest)
+	if err != nil {
+		return err
+	} else if !exists {
+		return backend.NewErrNotFound("artifact")
+	}
+
+	f, err := os.Open(b.blobPath(digest))
+	if err != nil {
+		return fmt.Errorf("failed to open artifact: %w", err)
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(w, hasher), f); err != nil {
+		return fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	if got := hex.EncodeToString(hasher.Sum(nil)); got != digest {
+		return fmt.Errorf("failed to validate integrity of object, got=%s, want=%s", got, digest)
+	}
+
+	return nil
+}
+
+// Delete removes the artifact and its metadata, a missing artifact is not considered an error
+func (b *Backend) Delete(_ context.Context, digest string) error {
+	if err := validateDigest(digest); err != nil {
+		return err
+	}
+
+	// content first, that way a failure in between leaves no visible artifact
+	for _, p := range []string{b.blobPath(digest), b.metadataPath(digest)} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to delete artifact: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Touch refreshes the modification time of the artifact
+func (b *Backend) Touch(_ context.Context, digest string) error {
+	if err := validateDigest(digest); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if err := os.Chtimes(b.blobPath(digest), now, now); err != nil {
+		return fmt.Errorf("failed to touch artifact: %w", err)
+	}
+
+	return nil
+}
+
+// List walks the artifacts stored in the directory, metadata files and in-flight uploads are skipped.
+// Since it's run periodically by the retention sweeper, it also removes the stale uploads.
+func (b *Backend) List(ctx context.Context, fn func(digest string, modifiedAt time.Time) error) error {
+	if err := b.removeStaleUploads(); err != nil {
+		return err
+	}
+
+	var fnErr error
+	err := filepath.WalkDir(filepath.Join(b.root, blobsDir), func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if d.IsDir() || validateDigest(d.Name()) != nil {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		fnErr = fn(d.Name(), info.ModTime())
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to list artifacts: %w", err)
+	}
+
+	return nil
+}
+
+// removeStaleUploads deletes the temporary files left behind by uploads that didn't complete,
+// i.e because the process was killed while writing them
+func (b *Backend) removeStaleUploads() error {
+	dir := filepath.Join(b.root, tmpDir)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to list uploads: %w", err)
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasPrefix(e.Name(), "upload-") {
+			continue
+		}
+
+		info, err := e.Info()
+		if err != nil {
+			// removed in the meantime
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return fmt.Errorf("failed to read upload: %w", err)
+		}
+
+		if time.Since(info.ModTime()) < staleUploadAge {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(dir, e.Name())); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to remove stale upload: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// CheckWritePermissions writes and removes a file in the root directory, which must exist
+func (b *Backend) CheckWritePermissions(_ context.Context) error {
+	f, err := os.CreateTemp(b.root, ".healthcheck-*")
+	if err != nil {
+		return fmt.Errorf("failed to write to directory: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write to directory: %w", err)
+	}
+
+	if err := os.Remove(f.Name()); err != nil {
+		return fmt.Errorf("failed to cleanup healthcheck file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var content = []byte("hello world")
+
+func contentDigest() string {
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:])
+}
+
+func newTestBackend(t *testing.T) *Backend {
+	t.Helper()
+	b, err := NewBackend(t.TempDir())
+	require.NoError(t, err)
+	return b
+}
+
+func TestNewBackend(t *testing.T) {
+	_, err := NewBackend("relative/path")
+	assert.ErrorIs(t, err, backend.ErrValidation)
+}
+
+func TestUploadDownload(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+	digest := contentDigest()
+
+	exists, err := b.Exists(ctx, digest)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	err = b.Upload(ctx, bytes.NewReader(content), &pb.CASResource{Digest: digest, FileName: "test.txt"})
+	require.NoError(t, err)
+
+	exists, err = b.Exists(ctx, digest)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	got, err := b.Describe(ctx, digest)
+	require.NoError(t, err)
+	assert.Equal(t, &pb.CASResource{Digest: digest, FileName: "test.txt", Size: int64(len(content))}, got)
+
+	var buf bytes.Buffer
+	require.NoError(t, b.Download(ctx, &buf, digest))
+	assert.Equal(t, content, buf.Bytes())
+
+	// no leftovers from the upload
+	entries, err := os.ReadDir(filepath.Join(b.root, tmpDir))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestUploadDigestMismatch(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+	digest := contentDigest()
+
+	err := b.Upload(ctx, bytes.NewReader([]byte("tampered")), &pb.CASResource{Digest: digest, FileName: "test.txt"})
+	assert.ErrorContains(t, err, "failed to validate integrity")
+
+	exists, err := b.Exists(ctx, digest)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestInvalidDigest(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	for _, digest := range []string{"", "deadbeef", "../../etc/passwd", "sha256:" + contentDigest()} {
+		t.Run(digest, func(t *testing.T) {
+			err := b.Upload(ctx, bytes.NewReader(content), &pb.CASResource{Digest: digest})
+			assert.ErrorContains(t, err, "invalid digest")
+			_, err = b.Describe(ctx, digest)
+			assert.ErrorContains(t, err, "invalid digest")
+		})
+	}
+}
+
+func TestDownloadCorrupted(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+	digest := contentDigest()
+
+	require.NoError(t, b.Upload(ctx, bytes.NewReader(content), &pb.CASResource{Digest: digest, FileName: "test.txt"}))
+	require.NoError(t, os.WriteFile(b.blobPath(digest), []byte("corrupted"), filePerm))
+
+	var buf bytes.Buffer
+	err := b.Download(ctx, &buf, digest)
+	assert.ErrorContains(t, err, "failed to validate integrity")
+}
+
+func TestDownloadNotFound(t *testing.T) {
+	err := newTestBackend(t).Download(context.Background(), &bytes.Buffer{}, contentDigest())
+	assert.True(t, backend.IsNotFound(err))
+}
+
+func TestDescribeNotUploadedByChainloop(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+	digest := contentDigest()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(b.blobPath(digest)), dirPerm))
+	require.NoError(t, os.WriteFile(b.blobPath(digest), content, filePerm))
+	require.NoError(t, os.WriteFile(b.metadataPath(digest), []byte(`{"author": "other"}`), filePerm))
+
+	_, err := b.Describe(ctx, digest)
+	assert.ErrorContains(t, err, "asset not uploaded by Chainloop")
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+	digest := contentDigest()
+
+	require.NoError(t, b.Upload(ctx, bytes.NewReader(content), &pb.CASResource{Digest: digest, FileName: "test.txt"}))
+	require.NoError(t, b.Delete(ctx, digest))
+
+	exists, err := b.Exists(ctx, digest)
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.NoFileExists(t, b.metadataPath(digest))
+
+	// deleting a missing artifact is not an error
+	assert.NoError(t, b.Delete(ctx, digest))
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	// nothing uploaded yet
+	var digests []string
+	list := func(digest string, modifiedAt time.Time) error {
+		assert.False(t, modifiedAt.IsZero())
+		digests = append(digests, digest)
+		return nil
+	}
+	require.NoError(t, b.List(ctx, list))
+	assert.Empty(t, digests)
+
+	require.NoError(t, b.Upload(ctx, bytes.NewReader(content), &pb.CASResource{Digest: contentDigest(), FileName: "test.txt"}))
+	require.NoError(t, b.List(ctx, list))
+	// the metadata file is not listed
+	assert.Equal(t, []string{contentDigest()}, digests)
+
+	// errors returned by the callback stop the listing
+	stop := errors.New("stop")
+	assert.ErrorIs(t, b.List(ctx, func(string, time.Time) error { return stop }), stop)
+}
+
+func TestListRemovesStaleUploads(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	dir := filepath.Join(b.root, tmpDir)
+	require.NoError(t, os.MkdirAll(dir, dirPerm))
+
+	stale, inFlight := filepath.Join(dir, "upload-stale"), filepath.Join(dir, "upload-in-flight")
+	for _, p := range []string{stale, inFlight} {
+		require.NoError(t, os.WriteFile(p, content, filePerm))
+	}
+
+	old := time.Now().Add(-staleUploadAge - time.Hour)
+	require.NoError(t, os.Chtimes(stale, old, old))
+
+	require.NoError(t, b.List(ctx, func(string, time.Time) error { return nil }))
+	assert.NoFileExists(t, stale)
+	assert.FileExists(t, inFlight)
+}
+
+func TestTouch(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t)
+
+	require.NoError(t, b.Upload(ctx, bytes.NewReader(content), &pb.CASResource{Digest: contentDigest(), FileName: "test.txt"}))
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(b.blobPath(contentDigest()), old, old))
+
+	require.NoError(t, b.Touch(ctx, contentDigest()))
+	require.NoError(t, b.List(ctx, func(_ string, modifiedAt time.Time) error {
+		assert.WithinDuration(t, time.Now(), modifiedAt, time.Minute)
+		return nil
+	}))
+
+	assert.Error(t, b.Touch(ctx, strings.Repeat("f", 64)))
+	assert.Error(t, b.Touch(ctx, "../escape"))
+}
+
+func TestCheckWritePermissions(t *testing.T) {
+	b := newTestBackend(t)
+	assert.NoError(t, b.CheckWritePermissions(context.Background()))
+	// it leaves nothing behind
+	entries, err := os.ReadDir(b.root)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	readOnly := filepath.Join(t.TempDir(), "ro")
+	require.NoError(t, os.Mkdir(readOnly, 0o500))
+	b, err = NewBackend(readOnly)
+	require.NoError(t, err)
+	if os.Geteuid() != 0 {
+		assert.Error(t, b.CheckWritePermissions(context.Background()))
+	}
+}
@@ -0,0 +1,214 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/chainloop-dev/chainloop/pkg/credentials"
+)
+
+type BackendProvider struct {
+	cReader credentials.Reader
+	// directory the backends must be located in
+	baseDir string
+}
+
+var _ backend.Provider = (*BackendProvider)(nil)
+
+// NewBackendProvider returns a provider restricted to directories within baseDir.
+// Backends are located in the server running it so the operator must opt-in by setting it.
+func NewBackendProvider(cReader credentials.Reader, baseDir string) *BackendProvider {
+	return &BackendProvider{cReader: cReader, baseDir: baseDir}
+}
+
+const ProviderID = "FILESYSTEM"
+
+func (p *BackendProvider) ID() string {
+	return ProviderID
+}
+
+func (p *BackendProvider) FromCredentials(ctx context.Context, secretName string) (backend.UploaderDownloader, error) {
+	creds := &Credentials{}
+	if err := p.cReader.ReadCredentials(ctx, secretName, creds); err != nil {
+		return nil, err
+	}
+
+	if err := creds.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid credentials retrieved from storage: %w", err)
+	}
+
+	// the base directory might have changed since the backend was registered
+	root, err := p.resolvePath(creds.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBackend(root)
+}
+
+// ValidateAndExtractCredentials checks that the directory is within the base directory and can be written.
+// Note that both the controlplane and the artifact CAS must have access to the same directory,
+// i.e a shared volume or NFS mount.
+func (p *BackendProvider) ValidateAndExtractCredentials(location string, credsJSON []byte) (any, error) {
+	creds, err := extractCreds(location, credsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("extracting credentials: %w", err)
+	}
+
+	root, err := p.resolvePath(creds.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := NewBackend(root)
+	if err != nil {
+		return nil, fmt.Errorf("creating backend: %w", err)
+	}
+
+	if err := b.CheckWritePermissions(context.TODO()); err != nil {
+		return nil, fmt.Errorf("checking write permissions: %w", err)
+	}
+
+	// store the canonical path, the same directory must always be stored with the same location
+	creds.Path = root
+
+	return creds, nil
+}
+
+// ResolveLocation returns the canonical path of the directory, which must be within the
+// directory of the organization in the base directory, i.e <base>/<orgID>.
+// Relative locations are relative to the directory of the organization, which is created if it doesn't exist yet.
+func (p *BackendProvider) ResolveLocation(orgID, location string) (string, error) {
+	base, err := p.resolveBaseDir()
+	if err != nil {
+		return "", err
+	}
+
+	if orgID == "" || filepath.Base(orgID) != orgID || orgID == "." || orgID == ".." {
+		return "", fmt.Errorf("%w: invalid organization", backend.ErrValidation)
+	}
+
+	orgDir := filepath.Join(base, orgID)
+	if err := os.MkdirAll(orgDir, dirPerm); err != nil {
+		return "", fmt.Errorf("creating organization directory: %w", err)
+	}
+
+	if !filepath.IsAbs(location) {
+		location = filepath.Join(orgDir, location)
+	}
+
+	return resolveWithin(orgDir, location)
+}
+
+// resolvePath returns the path with its symlinks resolved, making sure that it's an existing directory within the base directory
+func (p *BackendProvider) resolvePath(path string) (string, error) {
+	base, err := p.resolveBaseDir()
+	if err != nil {
+		return "", err
+	}
+
+	return resolveWithin(base, path)
+}
+
+func (p *BackendProvider) resolveBaseDir() (string, error) {
+	if p.baseDir == "" {
+		return "", fmt.Errorf("%w: the filesystem provider is not enabled in this server", backend.ErrValidation)
+	}
+
+	base, err := filepath.EvalSymlinks(p.baseDir)
+	if err != nil {
+		return "", fmt.Errorf("resolving base directory: %w", err)
+	}
+
+	return base, nil
+}
+
+// resolveWithin returns the path with its symlinks resolved, making sure that it's an existing directory within base,
+// which must be already resolved
+func resolveWithin(base, path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("%w: path must be absolute", backend.ErrValidation)
+	}
+
+	resolved, err := filepath.EvalSymlinks(filepath.Clean(path))
+	if err != nil {
+		return "", fmt.Errorf("%w: the directory must exist", backend.ErrValidation)
+	}
+
+	// the error message doesn't disclose the base directory, it's part of the server configuration
+	rel, err := filepath.Rel(base, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: the directory is outside of the allowed base directory", backend.ErrValidation)
+	}
+
+	info, err := os.Stat(resolved)
+	if err != nil {
+		return "", fmt.Errorf("%w: the directory must exist", backend.ErrValidation)
+	} else if !info.IsDir() {
+		return "", fmt.Errorf("%w: the path is not a directory", backend.ErrValidation)
+	}
+
+	return resolved, nil
+}
+
+func extractCreds(location string, credsJSON []byte) (*Credentials, error) {
+	var creds *Credentials
+	if len(credsJSON) > 0 {
+		if err := json.Unmarshal(credsJSON, &creds); err != nil {
+			return nil, fmt.Errorf("unmarshaling credentials: %w", err)
+		}
+	}
+
+	if creds == nil {
+		creds = &Credentials{}
+	}
+
+	// The location is the directory, we do not allow overriding it
+	creds.Path = location
+
+	if err := creds.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	return creds, nil
+}
+
+// Credentials of a filesystem backend only contain the root directory,
+// access control is delegated to the operating system
+type Credentials struct {
+	// Absolute path to the root directory of the backend
+	Path string
+}
+
+// Validate that the credentials have all their properties set
+func (c *Credentials) Validate() error {
+	if c.Path == "" {
+		return fmt.Errorf("%w: missing path", backend.ErrValidation)
+	}
+
+	if !filepath.IsAbs(c.Path) {
+		return fmt.Errorf("%w: path must be absolute", backend.ErrValidation)
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/chainloop-dev/chainloop/pkg/credentials/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		creds   *Credentials
+		wantErr bool
+	}{
+		{
+			name:  "valid credentials",
+			creds: &Credentials{Path: "/var/lib/chainloop"},
+		},
+		{
+			name:    "missing path",
+			creds:   &Credentials{},
+			wantErr: true,
+		},
+		{
+			name:    "relative path",
+			creds:   &Credentials{Path: "var/lib/chainloop"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.creds.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFromCredentials(t *testing.T) {
+	ctx := context.Background()
+	r := mocks.NewReader(t)
+	root := t.TempDir()
+
+	r.On("ReadCredentials", ctx, "secretName", mock.AnythingOfType("*filesystem.Credentials")).Return(nil).Run(
+		func(args mock.Arguments) {
+			credentials := args.Get(2).(*Credentials)
+			credentials.Path = root
+		})
+
+	b, err := NewBackendProvider(r, root).FromCredentials(ctx, "secretName")
+	require.NoError(t, err)
+	assert.Equal(t, root, b.(*Backend).root)
+
+	// the base directory changed after the backend was registered
+	_, err = NewBackendProvider(r, t.TempDir()).FromCredentials(ctx, "secretName")
+	assert.ErrorIs(t, err, backend.ErrValidation)
+}
+
+func TestExtractCreds(t *testing.T) {
+	testCases := []struct {
+		name      string
+		location  string
+		credsJSON []byte
+		wantErr   bool
+	}{
+		{
+			name:     "valid location without credentials",
+			location: "/var/lib/chainloop",
+		},
+		{
+			name:      "valid location with empty credentials",
+			location:  "/var/lib/chainloop",
+			credsJSON: []byte(`{}`),
+		},
+		{
+			name:      "the location can't be overridden",
+			location:  "/var/lib/chainloop",
+			credsJSON: []byte(`{"Path": "/tmp"}`),
+		},
+		{
+			name:     "relative location",
+			location: "chainloop",
+			wantErr:  true,
+		},
+		{
+			name:      "invalid credentials",
+			location:  "/var/lib/chainloop",
+			credsJSON: []byte(`{`),
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			creds, err := extractCreds(tc.location, tc.credsJSON)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, &Credentials{Path: tc.location}, creds)
+			}
+		})
+	}
+}
+
+func TestValidateAndExtractCredentials(t *testing.T) {
+	base := t.TempDir()
+	root := filepath.Join(base, "cas")
+	require.NoError(t, os.Mkdir(root, dirPerm))
+
+	outside := t.TempDir()
+	escape := filepath.Join(base, "escape")
+	require.NoError(t, os.Symlink(outside, escape))
+
+	file := filepath.Join(base, "file")
+	require.NoError(t, os.WriteFile(file, nil, filePerm))
+
+	testCases := []struct {
+		name     string
+		baseDir  string
+		location string
+		wantErr  string
+	}{
+		{name: "directory within the base directory", baseDir: base, location: root},
+		{name: "the base directory itself", baseDir: base, location: base},
+		{name: "provider not enabled", location: root, wantErr: "not enabled"},
+		{name: "directory outside of the base directory", baseDir: base, location: outside, wantErr: "outside"},
+		{name: "relative segments escaping the base directory", baseDir: base, location: root + "/../../", wantErr: "outside"},
+		{name: "symlink escaping the base directory", baseDir: base, location: escape, wantErr: "outside"},
+		{name: "missing directory", baseDir: base, location: filepath.Join(base, "missing"), wantErr: "must exist"},
+		{name: "not a directory", baseDir: base, location: file, wantErr: "not a directory"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			creds, err := NewBackendProvider(nil, tc.baseDir).ValidateAndExtractCredentials(tc.location, nil)
+			if tc.wantErr != "" {
+				assert.ErrorIs(t, err, backend.ErrValidation)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, &Credentials{Path: filepath.Clean(tc.location)}, creds)
+		})
+	}
+
+	// validation doesn't leave anything behind
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestValidateAndExtractCredentialsCanonicalPath(t *testing.T) {
+	base := t.TempDir()
+	root := filepath.Join(base, "cas")
+	require.NoError(t, os.Mkdir(root, dirPerm))
+
+	link := filepath.Join(base, "link")
+	require.NoError(t, os.Symlink(root, link))
+
+	for _, location := range []string{root + "/", root + "/../cas", link} {
+		creds, err := NewBackendProvider(nil, base).ValidateAndExtractCredentials(location, nil)
+		require.NoError(t, err)
+		assert.Equal(t, &Credentials{Path: root}, creds)
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	base := t.TempDir()
+	orgDir := filepath.Join(base, "org-1")
+	root := filepath.Join(orgDir, "cas")
+	require.NoError(t, os.MkdirAll(root, dirPerm))
+
+	otherOrgDir := filepath.Join(base, "org-2")
+	require.NoError(t, os.Mkdir(otherOrgDir, dirPerm))
+
+	testCases := []struct {
+		name     string
+		baseDir  string
+		orgID    string
+		location string
+		want     string
+		wantErr  string
+	}{
+		{name: "directory within the organization directory", baseDir: base, orgID: "org-1", location: root, want: root},
+		{name: "the organization directory itself", baseDir: base, orgID: "org-1", location: orgDir + "/", want: orgDir},
+		{name: "the organization directory is created", baseDir: base, orgID: "org-3", location: filepath.Join(base, "org-3"), want: filepath.Join(base, "org-3")},
+		{name: "provider not enabled", orgID: "org-1", location: root, wantErr: "not enabled"},
+		{name: "directory of another organization", baseDir: base, orgID: "org-1", location: otherOrgDir, wantErr: "outside"},
+		{name: "the base directory", baseDir: base, orgID: "org-1", location: base, wantErr: "outside"},
+		{name: "relative segments escaping the organization directory", baseDir: base, orgID: "org-1", location: root + "/../../org-2", wantErr: "outside"},
+		{name: "relative location", baseDir: base, orgID: "org-1", location: "cas", want: root},
+		{name: "relative location escaping the organization directory", baseDir: base, orgID: "org-1", location: "../org-2", wantErr: "outside"},
+		{name: "invalid organization", baseDir: base, orgID: "../org-2", location: otherOrgDir, wantErr: "invalid organization"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewBackendProvider(nil, tc.baseDir).ResolveLocation(tc.orgID, tc.location)
+			if tc.wantErr != "" {
+				assert.ErrorIs(t, err, backend.ErrValidation)
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProviderID(t *testing.T) {
+	assert.Equal(t, "FILESYSTEM", NewBackendProvider(nil, "").ID())
+}
@@ -0,0 +1,238 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	pb "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+type Backend struct {
+	client *storage.Client
+	bucket string
+}
+
+var (
+	_ backend.UploaderDownloader = (*Backend)(nil)
+	_ backend.StreamingUploader  = (*Backend)(nil)
+	_ backend.Deleter            = (*Backend)(nil)
+	_ backend.Lister             = (*Backend)(nil)
+)
+
+// SupportsStreaming reports that the gcs backend can upload directly from a
+// streaming reader. The storage writer sends the artifact in bounded-size
+// chunks through a resumable upload, so CAS never buffers the whole blob in memory.
+func (b *Backend) SupportsStreaming() bool { return true }
+
+// NewBackend creates a backend authenticated with the service account key in the credentials,
+// or with the workload identity of the running service if none is provided
+func NewBackend(ctx context.Context, creds *Credentials, opts ...option.ClientOption) (*Backend, error) {
+	client, err := newClient(ctx, creds, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Backend{
+		client: client,
+		bucket: creds.Bucket,
+	}, nil
+}
+
+func newClient(ctx context.Context, creds *Credentials, opts ...option.ClientOption) (*storage.Client, error) {
+	if !creds.UsesWorkloadIdentity() {
+		opts = append(opts, option.WithAuthCredentialsJSON(option.ServiceAccount, []byte(creds.ServiceAccountKey)))
+	}
+
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+
+	return client, nil
+}
+
+// Close releases the resources of the underlying client
+func (b *Backend) Close() error {
+	return b.client.Close()
+}
+
+func (b *Backend) object(digest string) *storage.ObjectHandle {
+	return b.client.Bucket(b.bucket).Object(resourceName(digest))
+}
+
+func resourceName(digest string) string {
+	return fmt.Sprintf("sha256:%s", digest)
+}
+
+// Exists check that the artifact is already present in the repository
+func (b *Backend) Exists(ctx context.Context, digest string) (bool, error) {
+	_, err := b.Describe(ctx, digest)
+	if err != nil && backend.IsNotFound(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+const (
+	annotationNameAuthor   = "author"
+	annotationNameFilename = "filename"
+)
+
+func (b *Backend) Upload(ctx context.Context, r io.Reader, resource *pb.CASResource) error {
+	// cancelling the context is the only way to abort the upload without committing the partial content
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := b.object(resource.Digest).NewWriter(ctx)
+	w.Metadata = map[string]string{
+		annotationNameAuthor:   backend.AuthorAnnotation,
+		annotationNameFilename: resource.FileName,
+	}
+
+	if _, err := io.Copy(w, r); err != nil {
+		cancel()
+		_ = w.Close()
+		return fmt.Errorf("failed to upload to bucket: %w", err)
+	}
+
+	// the upload is only committed on close
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to upload to bucket: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Backend) Describe(ctx context.Context, digest string) (*pb.CASResource, error) {
+	attrs, err := b.object(digest).Attrs(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, backend.NewErrNotFound("artifact")
+		}
+
+		return nil, fmt.Errorf("failed to read from bucket: %w", err)
+	}
+
+	// Check asset author is Chainloop that way we can ignore files uploaded by other tools
+	// note: this is not a security mechanism, an additional check will be put in place for tamper check
+	author, ok := attrs.Metadata[annotationNameAuthor]
+	if !ok || author != backend.AuthorAnnotation {
+		return nil, errors.New("asset not uploaded by Chainloop")
+	}
+
+	fileName, ok := attrs.Metadata[annotationNameFilename]
+	if !ok {
+		return nil, fmt.Errorf("couldn't find file metadata")
+	}
+
+	return &pb.CASResource{
+		FileName: fileName,
+		Size:     attrs.Size,
+		Digest:   digest,
+	}, nil
+}
+
+func (b *Backend) Download(ctx context.Context, w io.Writer, digest string) error {
+	exists, err := b.Exists(ctx, digest)
+	if err != nil {
+		return err
+	} else if !exists {
+		return backend.NewErrNotFound("artifact")
+	}
+
+	// The reader verifies the CRC32C checksum of the object when it's fully read
+	r, err := b.object(digest).NewReader(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to download from bucket: %w", err)
+	}
+	defer r.Close()
+
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to copy object to writer: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the object from the bucket, a missing object is not considered an error
+func (b *Backend) Delete(ctx context.Context, digest string) error {
+	if err := b.object(digest).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("failed to delete from bucket: %w", err)
+	}
+
+	return nil
+}
+
+// Touch refreshes the update time of the object by writing its metadata again
+func (b *Backend) Touch(ctx context.Context, digest string) error {
+	attrs, err := b.object(digest).Attrs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to read from bucket: %w", err)
+	}
+
+	if _, err := b.object(digest).Update(ctx, storage.ObjectAttrsToUpdate{Metadata: attrs.Metadata}); err != nil {
+		return fmt.Errorf("failed to touch object: %w", err)
+	}
+
+	return nil
+}
+
+// List walks the artifacts stored in the bucket
+func (b *Backend) List(ctx context.Context, fn func(digest string, modifiedAt time.Time) error) error {
+	it := b.client.Bucket(b.bucket).Objects(ctx, &storage.Query{Prefix: resourceName("")})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to list bucket: %w", err)
+		}
+
+		if err := fn(strings.TrimPrefix(attrs.Name, resourceName("")), attrs.Updated); err != nil {
+			return err
+		}
+	}
+}
+
+// CheckWritePermissions performs an actual write to the bucket to check that the credentials
+func (b *Backend) CheckWritePermissions(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := b.client.Bucket(b.bucket).Object("healthcheck").NewWriter(ctx)
+	if _, err := w.Write([]byte("healthcheck")); err != nil {
+		cancel()
+		_ = w.Close()
+		return fmt.Errorf("failed to write to bucket: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to write to bucket: %w", err)
+	}
+
+	return nil
+}
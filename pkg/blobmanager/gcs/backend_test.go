@@ -0,0 +1,132 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/chainloop-dev/chainloop/app/artifact-cas/api/cas/v1"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+const (
+	testBucket = "my-bucket"
+	testDigest = "deadbeef"
+	testData   = "hello world"
+)
+
+// fakeServer emulates the subset of the GCS JSON API used to read objects
+func fakeServer(t *testing.T, author string) *httptest.Server {
+	t.Helper()
+
+	objectPath := fmt.Sprintf("/b/%s/o/%s", testBucket, resourceName(testDigest))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path
+		switch {
+		// object metadata
+		case r.Method == http.MethodGet && path == "/storage/v1"+objectPath:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"bucket": %q, "name": %q, "size": "%d", "metadata": {"author": %q, "filename": "test.txt"}}`,
+				testBucket, resourceName(testDigest), len(testData), author)
+		// object content
+		case r.Method == http.MethodGet && strings.HasSuffix(path, fmt.Sprintf("/%s/%s", testBucket, resourceName(testDigest))):
+			fmt.Fprint(w, testData)
+		case r.Method == http.MethodDelete && path == "/storage/v1"+objectPath:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newTestBackend(t *testing.T, author string) *Backend {
+	t.Helper()
+
+	srv := fakeServer(t, author)
+	b, err := NewBackend(context.Background(), &Credentials{Bucket: testBucket},
+		option.WithEndpoint(srv.URL+"/storage/v1/"), option.WithoutAuthentication())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = b.Close() })
+
+	return b
+}
+
+// TestBackend_SupportsStreaming asserts the gcs backend opts into
+// streaming uploads so the CAS service feeds it directly from the client stream
+func TestBackend_SupportsStreaming(t *testing.T) {
+	var b backend.UploaderDownloader = &Backend{}
+	su, ok := b.(backend.StreamingUploader)
+	require.True(t, ok, "gcs backend must implement backend.StreamingUploader")
+	assert.True(t, su.SupportsStreaming())
+}
+
+func TestDescribe(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("uploaded by chainloop", func(t *testing.T) {
+		got, err := newTestBackend(t, backend.AuthorAnnotation).Describe(ctx, testDigest)
+		require.NoError(t, err)
+		assert.Equal(t, &pb.CASResource{FileName: "test.txt", Size: int64(len(testData)), Digest: testDigest}, got)
+	})
+
+	t.Run("uploaded by other tool", func(t *testing.T) {
+		_, err := newTestBackend(t, "other").Describe(ctx, testDigest)
+		assert.ErrorContains(t, err, "asset not uploaded by Chainloop")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		b := newTestBackend(t, backend.AuthorAnnotation)
+		_, err := b.Describe(ctx, "missing")
+		assert.True(t, backend.IsNotFound(err))
+
+		exists, err := b.Exists(ctx, "missing")
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+func TestDownload(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t, backend.AuthorAnnotation)
+
+	var buf bytes.Buffer
+	require.NoError(t, b.Download(ctx, &buf, testDigest))
+	assert.Equal(t, testData, buf.String())
+
+	err := b.Download(ctx, &buf, "missing")
+	assert.True(t, backend.IsNotFound(err))
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	b := newTestBackend(t, backend.AuthorAnnotation)
+
+	assert.NoError(t, b.Delete(ctx, testDigest))
+	// deleting a missing object is not an error
+	assert.NoError(t, b.Delete(ctx, "missing"))
+}
@@ -0,0 +1,218 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/storage"
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/chainloop-dev/chainloop/pkg/credentials"
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+const (
+	// maxClients bounds the clients kept open, the least recently used one is closed beyond it
+	maxClients = 100
+	// closeGracePeriod delays closing an evicted client, so the requests still using it can finish
+	closeGracePeriod = time.Hour
+)
+
+type BackendProvider struct {
+	cReader credentials.Reader
+	// whether backends without a service account key can use the identity of the server
+	allowWorkloadIdentity bool
+
+	// clients are safe for concurrent use, so backends sharing a key share a client
+	// instead of opening a new one on every request. The clients of keys no longer in use,
+	// i.e after the backend is deleted or its credentials rotated, are eventually evicted and closed.
+	mu      sync.Mutex
+	clients *lru.Cache[string, *storage.Client]
+}
+
+var _ backend.Provider = (*BackendProvider)(nil)
+
+// NewBackendProvider returns a GCS provider. Backends must provide a service account key unless
+// allowWorkloadIdentity is set, since otherwise any tenant could reach the buckets granted to the server identity.
+func NewBackendProvider(cReader credentials.Reader, allowWorkloadIdentity bool) *BackendProvider {
+	// only fails with a non-positive size
+	clients, _ := lru.NewWithEvict(maxClients, func(_ string, c *storage.Client) {
+		time.AfterFunc(closeGracePeriod, func() { _ = c.Close() })
+	})
+
+	return &BackendProvider{
+		cReader:               cReader,
+		allowWorkloadIdentity: allowWorkloadIdentity,
+		clients:               clients,
+	}
+}
+
+const ProviderID = "GCS"
+
+func (p *BackendProvider) ID() string {
+	return ProviderID
+}
+
+func (p *BackendProvider) FromCredentials(ctx context.Context, secretName string) (backend.UploaderDownloader, error) {
+	creds := &Credentials{}
+	if err := p.cReader.ReadCredentials(ctx, secretName, creds); err != nil {
+		return nil, err
+	}
+
+	if err := creds.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid credentials retrieved from storage: %w", err)
+	}
+
+	// the operator might have disabled it since the backend was registered
+	if err := p.checkWorkloadIdentity(creds); err != nil {
+		return nil, err
+	}
+
+	client, err := p.client(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Backend{client: client, bucket: creds.Bucket}, nil
+}
+
+// workloadIdentityClientKey is the key of the client shared by the backends without a service account key,
+// they all authenticate with the workload identity of the server
+const workloadIdentityClientKey = "workload-identity"
+
+// client returns the shared client for the credentials, creating it if needed. Clients are keyed
+// by the digest of the service account key, or by workloadIdentityClientKey if there's none
+func (p *BackendProvider) client(creds *Credentials) (*storage.Client, error) {
+	key := workloadIdentityClientKey
+	if !creds.UsesWorkloadIdentity() {
+		sum := sha256.Sum256([]byte(creds.ServiceAccountKey))
+		key = hex.EncodeToString(sum[:])
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if c, ok := p.clients.Get(key); ok {
+		return c, nil
+	}
+
+	// the client outlives the request, its token source must not be bound to the request context
+	c, err := newClient(context.Background(), creds)
+	if err != nil {
+		return nil, err
+	}
+
+	p.clients.Add(key, c)
+	return c, nil
+}
+
+func (p *BackendProvider) checkWorkloadIdentity(creds *Credentials) error {
+	if creds.UsesWorkloadIdentity() && !p.allowWorkloadIdentity {
+		return fmt.Errorf("%w: a service account key is required, the workload identity of the server is not enabled", backend.ErrValidation)
+	}
+
+	return nil
+}
+
+func (p *BackendProvider) ValidateAndExtractCredentials(location string, credsJSON []byte) (any, error) {
+	creds, err := extractCreds(location, credsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("extracting credentials: %w", err)
+	}
+
+	if err := p.checkWorkloadIdentity(creds); err != nil {
+		return nil, err
+	}
+
+	// Validate that the credentials are valid against the bucket
+	b, err := NewBackend(context.TODO(), creds)
+	if err != nil {
+		return nil, fmt.Errorf("creating backend: %w", err)
+	}
+	defer b.Close()
+
+	if err := b.CheckWritePermissions(context.TODO()); err != nil {
+		return nil, fmt.Errorf("checking write permissions: %w", err)
+	}
+
+	return creds, nil
+}
+
+func extractCreds(location string, credsJSON []byte) (*Credentials, error) {
+	var creds *Credentials
+	if err := json.Unmarshal(credsJSON, &creds); err != nil {
+		return nil, fmt.Errorf("unmarshaling credentials: %w", err)
+	}
+
+	if creds == nil {
+		creds = &Credentials{}
+	}
+
+	// We do not allow overriding the location
+	creds.Bucket = location
+
+	if err := creds.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	return creds, nil
+}
+
+type Credentials struct {
+	// Bucket name
+	Bucket string
+	// Content of a service account JSON key.
+	// If empty, the workload identity of the running service is used instead, if enabled in the provider
+	ServiceAccountKey string
+}
+
+// Validate that the credentials have all the required properties set
+func (c *Credentials) Validate() error {
+	if c.Bucket == "" {
+		return fmt.Errorf("%w: missing bucket", backend.ErrValidation)
+	}
+
+	if c.ServiceAccountKey == "" {
+		return nil
+	}
+
+	var key struct {
+		Type string `json:"type"`
+	}
+
+	if err := json.Unmarshal([]byte(c.ServiceAccountKey), &key); err != nil {
+		return fmt.Errorf("%w: invalid service account key: %w", backend.ErrValidation, err)
+	}
+
+	if key.Type != serviceAccountKeyType {
+		return fmt.Errorf("%w: invalid service account key type %q, want %q", backend.ErrValidation, key.Type, serviceAccountKeyType)
+	}
+
+	return nil
+}
+
+// UsesWorkloadIdentity reports whether the ambient credentials of the service are used to access the bucket
+func (c *Credentials) UsesWorkloadIdentity() bool {
+	return c.ServiceAccountKey == ""
+}
+
+const serviceAccountKeyType = "service_account"
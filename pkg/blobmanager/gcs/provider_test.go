@@ -0,0 +1,217 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcs
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"strconv"
+	"strings"
+	"testing"
+
+	backend "github.com/chainloop-dev/chainloop/pkg/blobmanager"
+	"github.com/chainloop-dev/chainloop/pkg/credentials/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const testServiceAccountKey = `{"type": "service_account", "project_id": "my-project", "client_email": "cas@my-project.iam.gserviceaccount.com"}`
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		creds   *Credentials
+		wantErr bool
+	}{
+		{
+			name:  "valid credentials with service account key",
+			creds: &Credentials{Bucket: "test", ServiceAccountKey: testServiceAccountKey},
+		},
+		{
+			name:  "valid credentials with workload identity",
+			creds: &Credentials{Bucket: "test"},
+		},
+		{
+			name:    "missing bucket",
+			creds:   &Credentials{ServiceAccountKey: testServiceAccountKey},
+			wantErr: true,
+		},
+		{
+			name:    "malformed service account key",
+			creds:   &Credentials{Bucket: "test", ServiceAccountKey: "not-json"},
+			wantErr: true,
+		},
+		{
+			name:    "wrong key type",
+			creds:   &Credentials{Bucket: "test", ServiceAccountKey: `{"type": "authorized_user"}`},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.creds.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFromCredentials(t *testing.T) {
+	ctx := context.Background()
+	r := mocks.NewReader(t)
+	key := generateServiceAccountKey(t)
+
+	r.On("ReadCredentials", ctx, "secretName", mock.AnythingOfType("*gcs.Credentials")).Return(nil).Run(
+		func(args mock.Arguments) {
+			credentials := args.Get(2).(*Credentials)
+			credentials.Bucket = "my-bucket"
+			credentials.ServiceAccountKey = key
+		})
+
+	p := NewBackendProvider(r, false)
+	b, err := p.FromCredentials(ctx, "secretName")
+	require.NoError(t, err)
+	assert.Equal(t, "my-bucket", b.(*Backend).bucket)
+
+	// the client is reused across calls
+	other, err := p.FromCredentials(ctx, "secretName")
+	require.NoError(t, err)
+	assert.Same(t, b.(*Backend).client, other.(*Backend).client)
+	assert.Equal(t, 1, p.clients.Len())
+}
+
+func TestClientsAreBounded(t *testing.T) {
+	key := generateServiceAccountKey(t)
+	credsFor := func(i int) *Credentials {
+		return &Credentials{Bucket: "my-bucket", ServiceAccountKey: strings.Replace(key, "cas@", "cas-"+strconv.Itoa(i)+"@", 1)}
+	}
+
+	p := NewBackendProvider(nil, false)
+	first, err := p.client(credsFor(0))
+	require.NoError(t, err)
+
+	for i := 1; i <= maxClients; i++ {
+		_, err := p.client(credsFor(i))
+		require.NoError(t, err)
+	}
+
+	// the least recently used client got evicted, a new one is created for its key
+	assert.Equal(t, maxClients, p.clients.Len())
+	again, err := p.client(credsFor(0))
+	require.NoError(t, err)
+	assert.NotSame(t, first, again)
+}
+
+func TestWorkloadIdentityOptIn(t *testing.T) {
+	ctx := context.Background()
+	r := mocks.NewReader(t)
+
+	r.On("ReadCredentials", ctx, "secretName", mock.AnythingOfType("*gcs.Credentials")).Return(nil).Run(
+		func(args mock.Arguments) {
+			credentials := args.Get(2).(*Credentials)
+			credentials.Bucket = "my-bucket"
+		})
+
+	_, err := NewBackendProvider(r, false).FromCredentials(ctx, "secretName")
+	assert.ErrorIs(t, err, backend.ErrValidation)
+
+	_, err = NewBackendProvider(nil, false).ValidateAndExtractCredentials("my-bucket", []byte(`{}`))
+	assert.ErrorIs(t, err, backend.ErrValidation)
+	assert.ErrorContains(t, err, "service account key is required")
+}
+
+// generateServiceAccountKey returns a service account JSON key with a valid private key
+func generateServiceAccountKey(t *testing.T) string {
+	t.Helper()
+
+	pk, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "my-project",
+		"client_email": "cas@my-project.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(pk)})),
+	})
+	require.NoError(t, err)
+
+	return string(key)
+}
+
+func TestExtractCreds(t *testing.T) {
+	testCases := []struct {
+		name      string
+		location  string
+		credsJSON []byte
+		want      *Credentials
+		wantErr   bool
+	}{
+		{
+			name:      "service account key",
+			location:  "my-bucket",
+			credsJSON: []byte(`{"serviceAccountKey": ` + strconv.Quote(testServiceAccountKey) + `}`),
+			want:      &Credentials{Bucket: "my-bucket", ServiceAccountKey: testServiceAccountKey},
+		},
+		{
+			name:      "workload identity",
+			location:  "my-bucket",
+			credsJSON: []byte(`{}`),
+			want:      &Credentials{Bucket: "my-bucket"},
+		},
+		{
+			name:      "the bucket can't be overridden",
+			location:  "my-bucket",
+			credsJSON: []byte(`{"bucket": "other-bucket"}`),
+			want:      &Credentials{Bucket: "my-bucket"},
+		},
+		{
+			name:      "missing bucket",
+			credsJSON: []byte(`{}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid service account key",
+			location:  "my-bucket",
+			credsJSON: []byte(`{"serviceAccountKey": "foo"}`),
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			creds, err := extractCreds(tc.location, tc.credsJSON)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.want, creds)
+			}
+		})
+	}
+}
+
+func TestProviderID(t *testing.T) {
+	assert.Equal(t, "GCS", NewBackendProvider(nil, false).ID())
+}
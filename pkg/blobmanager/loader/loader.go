@@ -18,28 +18,51 @@ package loader
 import (
 	backends "github.com/chainloop-dev/chainloop/pkg/blobmanager"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/azureblob"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/filesystem"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/gcs"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/oci"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/s3"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/s3accesspoint"
 	"github.com/chainloop-dev/chainloop/pkg/credentials"
 )
 
+// Config holds the deployment-level settings of the providers that reach
+// resources of the server they run in, they are disabled by default
+type Config struct {
+	// Directory the FILESYSTEM backends must be located in, the provider is not registered when empty
+	FilesystemBaseDir string
+	// Let GCS backends without a service account key use the workload identity of the server.
+	// Any tenant could reach the buckets granted to it, so it's only meant for single-tenant deployments
+	GCSAllowWorkloadIdentity bool
+}
+
 // LoadProviders builds the registry of CAS backend providers consumed by
-// both the controlplane and the artifact-cas binaries. All providers are
-// registered unconditionally — the s3accesspoint provider has no
-// deployment-level config of its own (everything per-tenant lives in the
-// secret blob), so on-prem deployments without managed CAS simply never
-// have managed rows and the provider is dormant.
-func LoadProviders(creader credentials.Reader) backends.Providers {
+// both the controlplane and the artifact-cas binaries. Providers backed by
+// remote storage are registered unconditionally — the s3accesspoint provider
+// has no deployment-level config of its own (everything per-tenant lives in
+// the secret blob), so on-prem deployments without managed CAS simply never
+// have managed rows and the provider is dormant. The filesystem provider
+// stores data in the server itself, so it's only registered when enabled in cfg,
+// the same way GCS backends can only use the server identity when enabled.
+func LoadProviders(creader credentials.Reader, cfg *Config) backends.Providers {
 	ociProvider := oci.NewBackendProvider(creader)
 	azureBlobProvider := azureblob.NewBackendProvider(creader)
 	s3Provider := s3.NewBackendProvider(creader)
 	apProvider := s3accesspoint.NewBackendProvider(creader)
+	gcsProvider := gcs.NewBackendProvider(creader, cfg != nil && cfg.GCSAllowWorkloadIdentity)
 
-	return backends.Providers{
+	providers := backends.Providers{
 		ociProvider.ID():       ociProvider,
 		azureBlobProvider.ID(): azureBlobProvider,
 		s3Provider.ID():        s3Provider,
 		apProvider.ID():        apProvider,
+		gcsProvider.ID():       gcsProvider,
 	}
+
+	if cfg != nil && cfg.FilesystemBaseDir != "" {
+		fsProvider := filesystem.NewBackendProvider(creader, cfg.FilesystemBaseDir)
+		providers[fsProvider.ID()] = fsProvider
+	}
+
+	return providers
 }
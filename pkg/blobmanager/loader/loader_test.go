@@ -22,6 +22,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/azureblob"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/filesystem"
+	"github.com/chainloop-dev/chainloop/pkg/blobmanager/gcs"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/oci"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/s3"
 	"github.com/chainloop-dev/chainloop/pkg/blobmanager/s3accesspoint"
@@ -34,9 +36,18 @@ func (stubReader) ReadCredentials(_ context.Context, _ string, _ any) error { re
 func TestLoadProviders_AllRegistered(t *testing.T) {
 	t.Parallel()
 
-	ps := LoadProviders(stubReader{})
+	ps := LoadProviders(stubReader{}, &Config{FilesystemBaseDir: t.TempDir()})
 	assert.Contains(t, ps, oci.ProviderID)
 	assert.Contains(t, ps, azureblob.ProviderID)
 	assert.Contains(t, ps, s3.ProviderID)
 	assert.Contains(t, ps, s3accesspoint.ProviderID)
+	assert.Contains(t, ps, filesystem.ProviderID)
+	assert.Contains(t, ps, gcs.ProviderID)
+}
+
+func TestLoadProviders_FilesystemOptIn(t *testing.T) {
+	t.Parallel()
+
+	assert.NotContains(t, LoadProviders(stubReader{}, nil), filesystem.ProviderID)
+	assert.NotContains(t, LoadProviders(stubReader{}, &Config{}), filesystem.ProviderID)
 }
@@ -44,6 +44,8 @@ type Backend struct {
 	repo     string
 }
 
+var _ backend.Deleter = (*Backend)(nil)
+
 type Keychain = authn.Keychain
 
 type RegistryOptions struct {
@@ -247,6 +249,46 @@ func (b *Backend) Download(_ context.Context, w io.Writer, digest string) error
 	return nil
 }
 
+// Delete removes the image holding the artifact from the registry.
+// Not every registry supports deleting tags, so the tag removal is best-effort
+// and the manifest is then deleted by digest, which also untags it in most implementations
+func (b *Backend) Delete(_ context.Context, digest string) error {
+	if digest == "" {
+		return errors.New("digest is empty")
+	}
+
+	ref, err := name.ParseReference(b.resourcePath(digest))
+	if err != nil {
+		return fmt.Errorf("parsing reference: %w", err)
+	}
+
+	desc, err := remote.Head(ref, remote.WithAuthFromKeychain(b.keychain))
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("getting image descriptor: %w", err)
+	}
+
+	_ = remote.Delete(ref, remote.WithAuthFromKeychain(b.keychain))
+
+	if err := remote.Delete(ref.Context().Digest(desc.Digest.String()), remote.WithAuthFromKeychain(b.keychain)); err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting image: %w", err)
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var e *transport.Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
 // validateImage checks that the image was crafted by chainloop and contains the expected content
 func validateImage(img v1.Image, digest string) error {
 	// Review required annotations
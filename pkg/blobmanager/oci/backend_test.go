@@ -249,6 +249,30 @@ func (s *testSuite) TestDownload() {
 	}
 }
 
+func (s *testSuite) TestDelete() {
+	ctx := context.Background()
+	require.NoError(s.T(), s.validBackend.Upload(ctx, bytes.NewBuffer(s.uploadedContent), s.casResource))
+
+	exists, err := s.validBackend.Exists(ctx, s.casResource.Digest)
+	require.NoError(s.T(), err)
+	require.True(s.T(), exists)
+
+	s.Run("deletes the artifact", func() {
+		s.NoError(s.validBackend.Delete(ctx, s.casResource.Digest))
+		exists, err := s.validBackend.Exists(ctx, s.casResource.Digest)
+		s.NoError(err)
+		s.False(exists)
+	})
+
+	s.Run("deleting a missing artifact is a no-op", func() {
+		s.NoError(s.validBackend.Delete(ctx, s.casResource.Digest))
+	})
+
+	s.Run("empty digest", func() {
+		s.ErrorContains(s.validBackend.Delete(ctx, ""), "digest is empty")
+	})
+}
+
 func (s *testSuite) TestCraftImage() {
 	testCases := []struct {
 		name     string
@@ -24,6 +24,7 @@ import (
 	"io"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/credentials"
@@ -49,6 +50,8 @@ type Backend struct {
 var (
 	_ backend.UploaderDownloader = (*Backend)(nil)
 	_ backend.StreamingUploader  = (*Backend)(nil)
+	_ backend.Deleter            = (*Backend)(nil)
+	_ backend.Lister             = (*Backend)(nil)
 )
 
 const defaultRegion = "us-east-1"
@@ -256,6 +259,66 @@ func (b *Backend) Download(ctx context.Context, w io.Writer, digest string) erro
 	return err
 }
 
+// Delete removes the artifact from the bucket. S3 DeleteObject is idempotent
+// so removing a non-existing object is not an error
+func (b *Backend) Delete(ctx context.Context, digest string) error {
+	if _, err := b.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(b.bucket),
+		Key:    aws.String(resourceName(digest)),
+	}); err != nil {
+		return fmt.Errorf("failed to delete from bucket: %w", err)
+	}
+
+	return nil
+}
+
+// Touch refreshes the modification time of the object. Objects can't be modified in place,
+// so it's copied onto itself replacing its metadata with the same values
+func (b *Backend) Touch(ctx context.Context, digest string) error {
+	resource, err := b.Describe(ctx, digest)
+	if err != nil {
+		return err
+	}
+
+	if _, err := b.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:            aws.String(b.bucket),
+		Key:               aws.String(resourceName(digest)),
+		CopySource:        aws.String(b.bucket + "/" + url.PathEscape(resourceName(digest))),
+		MetadataDirective: types.MetadataDirectiveReplace,
+		Metadata: map[string]string{
+			annotationNameAuthor:   backend.AuthorAnnotation,
+			annotationNameFilename: resource.FileName,
+		},
+	}); err != nil {
+		return fmt.Errorf("failed to touch object: %w", err)
+	}
+
+	return nil
+}
+
+// List walks the artifacts stored in the bucket
+func (b *Backend) List(ctx context.Context, fn func(digest string, modifiedAt time.Time) error) error {
+	paginator := s3.NewListObjectsV2Paginator(b.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(b.bucket),
+		Prefix: aws.String(resourceName("")),
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list bucket: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if err := fn(strings.TrimPrefix(aws.ToString(obj.Key), resourceName("")), aws.ToTime(obj.LastModified)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // CheckWritePermissions performs an actual write to the repository to check that the credentials
 func (b *Backend) CheckWritePermissions(ctx context.Context) error {
 	testObject := "healthcheck"
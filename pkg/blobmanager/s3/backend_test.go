@@ -252,6 +252,18 @@ func (s *testSuite) TestDownload() {
 	// })
 }
 
+func (s *testSuite) TestDelete() {
+	ctx := context.Background()
+	s.NoError(s.backend.Delete(ctx, s.ownedObjectDigest))
+
+	exists, err := s.backend.Exists(ctx, s.ownedObjectDigest)
+	s.NoError(err)
+	s.False(exists)
+
+	// deleting it again is not an error
+	s.NoError(s.backend.Delete(ctx, s.ownedObjectDigest))
+}
+
 type testSuite struct {
 	suite.Suite
 	minio                   *minioInstance
@@ -0,0 +1,80 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/package-url/packageurl-go"
+)
+
+// PURL is a parsed package URL, shared by the purl_parse builtins of the policy engines
+type PURL struct {
+	Type       string            `json:"type"`
+	Namespace  string            `json:"namespace"`
+	Name       string            `json:"name"`
+	Version    string            `json:"version"`
+	Qualifiers map[string]string `json:"qualifiers"`
+	Subpath    string            `json:"subpath"`
+}
+
+// ParsePURL parses a package URL, i.e pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar
+func ParsePURL(purl string) (*PURL, error) {
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid purl %q: %w", purl, err)
+	}
+
+	return &PURL{
+		Type:       p.Type,
+		Namespace:  p.Namespace,
+		Name:       p.Name,
+		Version:    p.Version,
+		Qualifiers: p.Qualifiers.Map(),
+		Subpath:    p.Subpath,
+	}, nil
+}
+
+// CompareSemver compares two semantic versions, optionally prefixed with "v", returning -1, 0 or 1
+func CompareSemver(a, b string) (int, error) {
+	va, err := semver.NewVersion(a)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q: %w", a, err)
+	}
+
+	vb, err := semver.NewVersion(b)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q: %w", b, err)
+	}
+
+	return va.Compare(vb), nil
+}
+
+// SemverSatisfies tells if a semantic version satisfies a constraint, i.e ">= 1.2.0, < 2.0.0" or "~1.4"
+func SemverSatisfies(version, constraint string) (bool, error) {
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("invalid version %q: %w", version, err)
+	}
+
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return false, fmt.Errorf("invalid constraint %q: %w", constraint, err)
+	}
+
+	return c.Check(v), nil
+}
@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
+	"github.com/chainloop-dev/chainloop/pkg/policies/spdx"
 	extism "github.com/extism/go-sdk"
 	"google.golang.org/grpc"
 )
@@ -90,3 +92,124 @@ func CreateDiscoverHostFunctions(conn *grpc.ClientConn) []extism.HostFunction {
 
 	return []extism.HostFunction{envFunc, jsFunc}
 }
+
+// CreateOSVLookupHostFunctions creates Extism host functions for the osv_lookup builtin,
+// returning the JSON encoded vulnerabilities of the purl, or 0 if there is no database or the lookup failed.
+func CreateOSVLookupHostFunctions(db *osv.Database) []extism.HostFunction {
+	impl := func(_ context.Context, plugin *extism.CurrentPlugin, stack []uint64) {
+		purlOffset := stack[0]
+		stack[0] = 0
+		if db == nil {
+			return
+		}
+
+		purl, err := plugin.ReadString(purlOffset)
+		if err != nil {
+			return
+		}
+
+		vulns, err := db.Lookup(purl)
+		if err != nil {
+			return
+		}
+
+		jsonData, err := json.Marshal(vulns)
+		if err != nil {
+			return
+		}
+
+		offset, err := plugin.WriteString(string(jsonData))
+		if err != nil {
+			return
+		}
+
+		stack[0] = offset
+	}
+
+	// input: purl offset, output: json result offset or 0 on error
+	inputs := []extism.ValueType{extism.ValueTypeI64}
+	outputs := []extism.ValueType{extism.ValueTypeI64}
+
+	envFunc := extism.NewHostFunctionWithStack("chainloop_osv_lookup", impl, inputs, outputs)
+	envFunc.SetNamespace("env")
+
+	jsFunc := extism.NewHostFunctionWithStack("chainloop_osv_lookup", impl, inputs, outputs)
+	jsFunc.SetNamespace("extism:host/user")
+
+	return []extism.HostFunction{envFunc, jsFunc}
+}
+
+// CreateHelperHostFunctions creates Extism host functions for the purl, semver and SPDX helper builtins.
+// Their arguments are strings, the SPDX allowlist being a JSON encoded array, and they return the
+// offset of the JSON encoded result, or 0 if the arguments are invalid.
+func CreateHelperHostFunctions() []extism.HostFunction {
+	var hostFunctions []extism.HostFunction
+	hostFunctions = append(hostFunctions, newStringsHostFunctions("chainloop_purl_parse", 1, func(args []string) (any, error) {
+		return ParsePURL(args[0])
+	})...)
+	hostFunctions = append(hostFunctions, newStringsHostFunctions("chainloop_semver_compare", 2, func(args []string) (any, error) {
+		return CompareSemver(args[0], args[1])
+	})...)
+	hostFunctions = append(hostFunctions, newStringsHostFunctions("chainloop_semver_satisfies", 2, func(args []string) (any, error) {
+		return SemverSatisfies(args[0], args[1])
+	})...)
+	hostFunctions = append(hostFunctions, newStringsHostFunctions("chainloop_spdx_expression_satisfies", 2, func(args []string) (any, error) {
+		var allowlist []string
+		if err := json.Unmarshal([]byte(args[1]), &allowlist); err != nil {
+			return nil, err
+		}
+
+		return spdx.Satisfies(args[0], allowlist)
+	})...)
+
+	return hostFunctions
+}
+
+// newStringsHostFunctions creates the host functions, in both namespaces, for a builtin taking n string
+// arguments and returning the offset of its JSON encoded result, or 0 on error
+func newStringsHostFunctions(name string, n int, fn func(args []string) (any, error)) []extism.HostFunction {
+	impl := func(_ context.Context, plugin *extism.CurrentPlugin, stack []uint64) {
+		args := make([]string, n)
+		for i := range n {
+			arg, err := plugin.ReadString(stack[i])
+			if err != nil {
+				stack[0] = 0
+				return
+			}
+
+			args[i] = arg
+		}
+
+		stack[0] = 0
+		res, err := fn(args)
+		if err != nil {
+			return
+		}
+
+		jsonData, err := json.Marshal(res)
+		if err != nil {
+			return
+		}
+
+		offset, err := plugin.WriteString(string(jsonData))
+		if err != nil {
+			return
+		}
+
+		stack[0] = offset
+	}
+
+	inputs := make([]extism.ValueType, n)
+	for i := range inputs {
+		inputs[i] = extism.ValueTypeI64
+	}
+	outputs := []extism.ValueType{extism.ValueTypeI64}
+
+	envFunc := extism.NewHostFunctionWithStack(name, impl, inputs, outputs)
+	envFunc.SetNamespace("env")
+
+	jsFunc := extism.NewHostFunctionWithStack(name, impl, inputs, outputs)
+	jsFunc.SetNamespace("extism:host/user")
+
+	return []extism.HostFunction{envFunc, jsFunc}
+}
@@ -0,0 +1,463 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cel implements a policy engine based on Common Expression Language (https://cel.dev).
+//
+// CEL policies are YAML documents made of expressions, meant for checks that fit in one line:
+//
+//	engine: cel
+//	# the evaluation is skipped if this expression is true
+//	skip:
+//	  when: "!has(input.components)"
+//	  reason: not a CycloneDX SBOM
+//	violations:
+//	  # a violation with the message is raised if the expression is true
+//	  - when: size(input.components) == 0
+//	    message: SBOM must have at least one component
+//	  # an expression returning a list of messages, or of structured violations with a message field
+//	  - expression: |
+//	      input.components.filter(c, !has(c.licenses)).map(c, {
+//	        "message": "missing license in " + c.name,
+//	        "package_purl": c.purl,
+//	      })
+//
+// The material is available as "input" and the policy arguments as "args".
+package cel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultExecutionTimeout bounds a single policy evaluation when no explicit
+// timeout is provided. CEL expressions always terminate, but comprehensions over
+// large materials can still take long, so the engine applies the same bound as Rego.
+const DefaultExecutionTimeout = 60 * time.Second
+
+const (
+	inputVar        = "input"
+	argsVar         = "args"
+	expectedArgsVar = "expected_args"
+	violationsVar   = "violations"
+	// arrays are made available under this key, same as the rego engine does
+	inputElements = "elements"
+	// how many comprehension iterations happen between checks of the execution timeout
+	interruptCheckFrequency = 100
+)
+
+// Force interface
+var _ engine.PolicyEngine = (*Engine)(nil)
+
+// Engine implements the PolicyEngine interface for CEL policies
+type Engine struct {
+	executionTimeout time.Duration
+	// Embed common engine options
+	*engine.CommonEngineOptions
+}
+
+// NewEngine creates a new CEL policy engine with the given options
+// default execution timeout is DefaultExecutionTimeout
+func NewEngine(opts ...engine.Option) *Engine {
+	options := engine.ApplyOptions(opts...)
+
+	executionTimeout := options.ExecutionTimeout
+	if executionTimeout <= 0 {
+		executionTimeout = DefaultExecutionTimeout
+	}
+
+	return &Engine{
+		executionTimeout:    executionTimeout,
+		CommonEngineOptions: options.CommonEngineOptions,
+	}
+}
+
+// Policy is the content of a CEL policy document
+type Policy struct {
+	Engine string `yaml:"engine"`
+	// Skip the evaluation if the expression is true
+	Skip *Rule `yaml:"skip"`
+	// Ignore the policy if the expression is true
+	Ignore     string  `yaml:"ignore"`
+	Violations []*Rule `yaml:"violations"`
+	// Boolean expression with access to args and expected_args
+	MatchesParameters string `yaml:"matches_parameters"`
+	// Boolean expression with access to violations and expected_args
+	MatchesEvaluation string `yaml:"matches_evaluation"`
+}
+
+// Rule is either a boolean condition with a message, or an expression returning a list of violations
+type Rule struct {
+	When string `yaml:"when"`
+	// Violation message, or skip reason
+	Message string `yaml:"message"`
+	Reason  string `yaml:"reason"`
+	// Expression returning a list of strings, or objects with a message field
+	Expression string `yaml:"expression"`
+}
+
+// ParsePolicy parses and validates a CEL policy document
+func ParsePolicy(source []byte) (*Policy, error) {
+	var p Policy
+	dec := yaml.NewDecoder(bytes.NewReader(source))
+	dec.KnownFields(true)
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("failed to parse CEL policy: %w", err)
+	}
+
+	if p.Engine != string(engine.PolicyTypeCEL) {
+		return nil, fmt.Errorf("failed to parse CEL policy: unexpected engine %q", p.Engine)
+	}
+
+	if p.Skip != nil && p.Skip.When == "" {
+		return nil, errors.New("failed to parse CEL policy: skip requires a \"when\" expression")
+	}
+
+	for i, r := range p.Violations {
+		if (r.When == "") == (r.Expression == "") {
+			return nil, fmt.Errorf("failed to parse CEL policy: violation #%d must have either a \"when\" or an \"expression\"", i+1)
+		}
+
+		if r.When != "" && r.Message == "" {
+			return nil, fmt.Errorf("failed to parse CEL policy: violation #%d requires a message", i+1)
+		}
+	}
+
+	return &p, nil
+}
+
+// Compile parses the CEL policy document and compiles its expressions, without evaluating them
+func Compile(source []byte) error {
+	p, err := ParsePolicy(source)
+	if err != nil {
+		return err
+	}
+
+	policyExprs := []string{p.Ignore}
+	if p.Skip != nil {
+		policyExprs = append(policyExprs, p.Skip.When)
+	}
+	for _, r := range p.Violations {
+		policyExprs = append(policyExprs, r.When, r.Expression)
+	}
+
+	// each expression is compiled in the same environment it's evaluated in
+	groups := []struct {
+		vars  []string
+		exprs []string
+	}{
+		{vars: []string{inputVar, argsVar}, exprs: policyExprs},
+		{vars: []string{argsVar, expectedArgsVar}, exprs: []string{p.MatchesParameters}},
+		{vars: []string{violationsVar, expectedArgsVar}, exprs: []string{p.MatchesEvaluation}},
+	}
+
+	for _, g := range groups {
+		env, err := newEnv(g.vars...)
+		if err != nil {
+			return err
+		}
+
+		for _, expr := range g.exprs {
+			if expr == "" {
+				continue
+			}
+
+			if _, iss := env.Compile(expr); iss.Err() != nil {
+				return fmt.Errorf("failed to compile expression %q: %w", expr, iss.Err())
+			}
+		}
+	}
+
+	return nil
+}
+
+// Verify evaluates the CEL policy against the provided input
+func (e *Engine) Verify(ctx context.Context, policy *engine.Policy, input []byte, args map[string]any) (*engine.EvaluationResult, error) {
+	// Bound the whole evaluation. A caller deadline that expires earlier still wins.
+	ctx, cancel := context.WithTimeout(ctx, e.executionTimeout)
+	defer cancel()
+
+	p, err := ParsePolicy(policy.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	var decodedInput any
+	if err := json.Unmarshal(input, &decodedInput); err != nil {
+		return nil, fmt.Errorf("failed to parse input: %w", err)
+	}
+
+	// if input is an array, transform it to an object
+	if array, ok := decodedInput.([]any); ok {
+		decodedInput = map[string]any{inputElements: array}
+	}
+
+	if args == nil {
+		args = map[string]any{}
+	}
+
+	vars := map[string]any{inputVar: decodedInput, argsVar: args}
+	env, err := newEnv(inputVar, argsVar)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &engine.EvaluationResult{Violations: make([]*engine.PolicyViolation, 0)}
+
+	if p.Ignore != "" {
+		if result.Ignore, err = evalBool(ctx, env, p.Ignore, vars); err != nil {
+			return nil, fmt.Errorf("evaluating ignore: %w", err)
+		}
+	}
+
+	if p.Skip != nil {
+		if result.Skipped, err = evalBool(ctx, env, p.Skip.When, vars); err != nil {
+			return nil, fmt.Errorf("evaluating skip: %w", err)
+		}
+
+		if result.Skipped {
+			result.SkipReason = p.Skip.Reason
+		}
+	}
+
+	if !result.Skipped && !result.Ignore {
+		for i, r := range p.Violations {
+			violations, err := e.evalRule(ctx, env, r, vars, policy.Name)
+			if err != nil {
+				return nil, fmt.Errorf("evaluating violation #%d: %w", i+1, err)
+			}
+
+			result.Violations = append(result.Violations, violations...)
+		}
+	}
+
+	if e.IncludeRawData {
+		if result.RawData, err = rawData(decodedInput, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (e *Engine) evalRule(ctx context.Context, env *cel.Env, r *Rule, vars map[string]any, policyName string) ([]*engine.PolicyViolation, error) {
+	if r.When != "" {
+		violated, err := evalBool(ctx, env, r.When, vars)
+		if err != nil || !violated {
+			return nil, err
+		}
+
+		return []*engine.PolicyViolation{{Subject: policyName, Violation: r.Message}}, nil
+	}
+
+	out, err := eval(ctx, env, r.Expression, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	native, err := toNative(out)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := native.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expression must return a list, got %T", native)
+	}
+
+	violations := make([]*engine.PolicyViolation, 0, len(list))
+	for _, item := range list {
+		switch v := item.(type) {
+		case string:
+			violations = append(violations, &engine.PolicyViolation{Subject: policyName, Violation: v})
+		case map[string]any:
+			pv, err := engine.NewStructuredViolation(policyName, v)
+			if err != nil {
+				return nil, fmt.Errorf("structured violation in policy %q: %w", policyName, err)
+			}
+
+			violations = append(violations, pv)
+		default:
+			return nil, fmt.Errorf("violation must be a string or object, got %T", item)
+		}
+	}
+
+	return violations, nil
+}
+
+// MatchesParameters evaluates the matches_parameters expression of the policy, false if not present
+func (e *Engine) MatchesParameters(ctx context.Context, policy *engine.Policy, evaluationParams, expectedParams map[string]string) (bool, error) {
+	p, err := ParsePolicy(policy.Source)
+	if err != nil {
+		return false, err
+	}
+
+	if p.MatchesParameters == "" {
+		return false, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.executionTimeout)
+	defer cancel()
+
+	env, err := newEnv(argsVar, expectedArgsVar)
+	if err != nil {
+		return false, err
+	}
+
+	return evalBool(ctx, env, p.MatchesParameters, map[string]any{argsVar: nonNil(evaluationParams), expectedArgsVar: nonNil(expectedParams)})
+}
+
+// MatchesEvaluation evaluates the matches_evaluation expression of the policy, true if not present
+func (e *Engine) MatchesEvaluation(ctx context.Context, policy *engine.Policy, violations []string, expectedParams map[string]string) (bool, error) {
+	p, err := ParsePolicy(policy.Source)
+	if err != nil {
+		return false, err
+	}
+
+	if p.MatchesEvaluation == "" {
+		return true, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, e.executionTimeout)
+	defer cancel()
+
+	env, err := newEnv(violationsVar, expectedArgsVar)
+	if err != nil {
+		return false, err
+	}
+
+	if violations == nil {
+		violations = []string{}
+	}
+
+	return evalBool(ctx, env, p.MatchesEvaluation, map[string]any{violationsVar: violations, expectedArgsVar: nonNil(expectedParams)})
+}
+
+// newEnv returns an environment with the given variables and the standard extensions
+func newEnv(vars ...string) (*cel.Env, error) {
+	opts := []cel.EnvOption{ext.Strings(), ext.Lists(), ext.Sets(), ext.Math(), ext.Encoders()}
+	for _, v := range vars {
+		opts = append(opts, cel.Variable(v, cel.DynType))
+	}
+
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	return env, nil
+}
+
+func eval(ctx context.Context, env *cel.Env, expr string, vars map[string]any) (ref.Val, error) {
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile expression %q: %w", expr, iss.Err())
+	}
+
+	prg, err := env.Program(ast, cel.InterruptCheckFrequency(interruptCheckFrequency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile expression %q: %w", expr, err)
+	}
+
+	out, _, err := prg.ContextEval(ctx, vars)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate policy: %w", err)
+	}
+
+	return out, nil
+}
+
+func evalBool(ctx context.Context, env *cel.Env, expr string, vars map[string]any) (bool, error) {
+	out, err := eval(ctx, env, expr, vars)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := out.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q must return a boolean, got %s", expr, out.Type())
+	}
+
+	return bool(b), nil
+}
+
+// toNative converts a CEL value to its JSON-like representation
+func toNative(v ref.Val) (any, error) {
+	pb, err := v.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("unexpected expression result: %w", err)
+	}
+
+	raw, err := protojson.Marshal(pb.(*structpb.Value))
+	if err != nil {
+		return nil, fmt.Errorf("unexpected expression result: %w", err)
+	}
+
+	var native any
+	if err := json.Unmarshal(raw, &native); err != nil {
+		return nil, fmt.Errorf("unexpected expression result: %w", err)
+	}
+
+	return native, nil
+}
+
+func rawData(input any, result *engine.EvaluationResult) (*engine.RawData, error) {
+	violations := make([]any, 0, len(result.Violations))
+	for _, v := range result.Violations {
+		if v.RawFinding != nil {
+			violations = append(violations, v.RawFinding)
+		} else {
+			violations = append(violations, v.Violation)
+		}
+	}
+
+	inputBytes, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal input for raw data: %w", err)
+	}
+
+	outputBytes, err := json.Marshal(map[string]any{
+		"violations":  violations,
+		"skipped":     result.Skipped,
+		"skip_reason": result.SkipReason,
+		"ignore":      result.Ignore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal output for raw data: %w", err)
+	}
+
+	return &engine.RawData{Input: inputBytes, Output: outputBytes}, nil
+}
+
+func nonNil(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+
+	return m
+}
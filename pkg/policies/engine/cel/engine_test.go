@@ -0,0 +1,324 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cel
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sbomPolicy = `engine: cel
+skip:
+  when: "!has(input.components)"
+  reason: not a CycloneDX SBOM
+ignore: has(input.metadata) && has(input.metadata.ignore) && input.metadata.ignore
+violations:
+  - when: size(input.components) < int(args.min_components)
+    message: SBOM has too few components
+  - expression: |
+      input.components.filter(c, !has(c.licenses)).map(c, {
+        "message": "missing license in " + c.name,
+        "package_purl": c.purl,
+      })
+  - expression: input.components.filter(c, c.name == "forbidden").map(c, "forbidden component")
+`
+
+func TestNewEngine(t *testing.T) {
+	eng := NewEngine()
+	assert.Equal(t, DefaultExecutionTimeout, eng.executionTimeout)
+	assert.False(t, eng.IncludeRawData)
+
+	eng = NewEngine(engine.WithExecutionTimeout(time.Second), engine.WithIncludeRawData(true))
+	assert.Equal(t, time.Second, eng.executionTimeout)
+	assert.True(t, eng.IncludeRawData)
+}
+
+func TestCEL_Verify(t *testing.T) {
+	policy := &engine.Policy{Name: "sbom", Source: []byte(sbomPolicy)}
+	args := map[string]any{"min_components": 2}
+
+	testCases := []struct {
+		name           string
+		input          string
+		wantViolations []*engine.PolicyViolation
+		wantSkipped    bool
+		wantSkipReason string
+		wantIgnore     bool
+	}{
+		{
+			name:  "compliant",
+			input: `{"components": [{"name": "foo", "licenses": []}, {"name": "bar", "licenses": []}]}`,
+		},
+		{
+			name:  "too few components",
+			input: `{"components": [{"name": "foo", "licenses": []}]}`,
+			wantViolations: []*engine.PolicyViolation{
+				{Subject: "sbom", Violation: "SBOM has too few components"},
+			},
+		},
+		{
+			name:  "structured and plain violations",
+			input: `{"components": [{"name": "foo", "purl": "pkg:generic/foo@1.0"}, {"name": "forbidden", "licenses": []}]}`,
+			wantViolations: []*engine.PolicyViolation{
+				{
+					Subject:    "sbom",
+					Violation:  "missing license in foo",
+					RawFinding: map[string]any{"message": "missing license in foo", "package_purl": "pkg:generic/foo@1.0"},
+				},
+				{Subject: "sbom", Violation: "forbidden component"},
+			},
+		},
+		{
+			name:           "skipped",
+			input:          `{"specVersion": "1.5"}`,
+			wantSkipped:    true,
+			wantSkipReason: "not a CycloneDX SBOM",
+		},
+		{
+			name:       "ignored",
+			input:      `{"metadata": {"ignore": true}, "components": []}`,
+			wantIgnore: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := NewEngine().Verify(context.TODO(), policy, []byte(tc.input), args)
+			require.NoError(t, err)
+
+			if tc.wantViolations == nil {
+				tc.wantViolations = []*engine.PolicyViolation{}
+			}
+
+			assert.Equal(t, tc.wantViolations, res.Violations)
+			assert.Equal(t, tc.wantSkipped, res.Skipped)
+			assert.Equal(t, tc.wantSkipReason, res.SkipReason)
+			assert.Equal(t, tc.wantIgnore, res.Ignore)
+			assert.Nil(t, res.RawData)
+		})
+	}
+}
+
+func TestCEL_VerifyWithInputArray(t *testing.T) {
+	policy := &engine.Policy{Name: "array", Source: []byte(`engine: cel
+violations:
+  - expression: input.elements.filter(e, e.severity == "HIGH").map(e, e.id + " is high")
+`)}
+
+	res, err := NewEngine().Verify(context.TODO(), policy, []byte(`[{"id": "a", "severity": "HIGH"}, {"id": "b", "severity": "LOW"}]`), nil)
+	require.NoError(t, err)
+	require.Len(t, res.Violations, 1)
+	assert.Equal(t, "a is high", res.Violations[0].Violation)
+}
+
+func TestCEL_VerifyRawData(t *testing.T) {
+	policy := &engine.Policy{Name: "sbom", Source: []byte(sbomPolicy)}
+
+	res, err := NewEngine(engine.WithIncludeRawData(true)).Verify(context.TODO(), policy, []byte(`{"components": [{"name": "forbidden", "licenses": []}]}`), map[string]any{"min_components": 1})
+	require.NoError(t, err)
+	require.NotNil(t, res.RawData)
+
+	assert.JSONEq(t, `{"components": [{"name": "forbidden", "licenses": []}]}`, string(res.RawData.Input))
+
+	var output map[string]any
+	require.NoError(t, json.Unmarshal(res.RawData.Output, &output))
+	assert.Equal(t, []any{"forbidden component"}, output["violations"])
+	assert.Equal(t, false, output["skipped"])
+}
+
+func TestCEL_VerifyErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  string
+		input   string
+		wantErr string
+	}{
+		{
+			name:    "not a CEL policy",
+			policy:  "engine: rego\n",
+			wantErr: "unexpected engine",
+		},
+		{
+			name:    "unknown field",
+			policy:  "engine: cel\nviolation: []\n",
+			wantErr: "field violation not found",
+		},
+		{
+			name:    "rule without message",
+			policy:  "engine: cel\nviolations:\n  - when: \"true\"\n",
+			wantErr: "violation #1 requires a message",
+		},
+		{
+			name:    "rule with both kinds",
+			policy:  "engine: cel\nviolations:\n  - when: \"true\"\n    expression: \"[]\"\n",
+			wantErr: "violation #1 must have either",
+		},
+		{
+			name:    "invalid syntax",
+			policy:  "engine: cel\nviolations:\n  - when: size(input.\n    message: wrong\n",
+			wantErr: "failed to compile expression",
+		},
+		{
+			name:    "non boolean condition",
+			policy:  "engine: cel\nviolations:\n  - when: input.name\n    message: wrong\n",
+			wantErr: "must return a boolean",
+		},
+		{
+			name:    "expression not returning a list",
+			policy:  "engine: cel\nviolations:\n  - expression: input.name\n",
+			wantErr: "expression must return a list",
+		},
+		{
+			name:    "structured violation without message",
+			policy:  "engine: cel\nviolations:\n  - expression: '[{\"severity\": \"HIGH\"}]'\n",
+			wantErr: "missing required \"message\" field",
+		},
+		{
+			name:    "missing field",
+			policy:  "engine: cel\nviolations:\n  - when: input.missing == 1\n    message: wrong\n",
+			wantErr: "no such key: missing",
+		},
+		{
+			name:    "invalid input",
+			policy:  "engine: cel\n",
+			input:   "not json",
+			wantErr: "failed to parse input",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := tc.input
+			if input == "" {
+				input = `{"name": "foo"}`
+			}
+
+			_, err := NewEngine().Verify(context.TODO(), &engine.Policy{Name: "test", Source: []byte(tc.policy)}, []byte(input), nil)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestCompile(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  string
+		wantErr string
+	}{
+		{
+			name:   "valid policy",
+			policy: sbomPolicy,
+		},
+		{
+			name:    "invalid document",
+			policy:  "engine: cel\nviolation: []\n",
+			wantErr: "field violation not found",
+		},
+		{
+			name:    "invalid violation",
+			policy:  "engine: cel\nviolations:\n  - when: size(input.\n    message: wrong\n",
+			wantErr: "failed to compile expression",
+		},
+		{
+			name:    "invalid skip",
+			policy:  "engine: cel\nskip:\n  when: input.(\n",
+			wantErr: "failed to compile expression",
+		},
+		{
+			name:    "undeclared variable",
+			policy:  "engine: cel\nmatches_parameters: input.name == \"foo\"\n",
+			wantErr: "undeclared reference to 'input'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Compile([]byte(tc.policy))
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestCEL_VerifyTimeout(t *testing.T) {
+	// quadratic comprehension over a large list, interrupted by the timeout
+	policy := &engine.Policy{Name: "slow", Source: []byte(`engine: cel
+violations:
+  - when: input.elements.all(a, input.elements.all(b, a + b >= 0))
+    message: never reached
+`)}
+
+	elements := make([]int, 50000)
+	input, err := json.Marshal(elements)
+	require.NoError(t, err)
+
+	_, err = NewEngine(engine.WithExecutionTimeout(50*time.Millisecond)).Verify(context.TODO(), policy, input, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCEL_MatchesParameters(t *testing.T) {
+	withRule := &engine.Policy{Name: "params", Source: []byte(`engine: cel
+matches_parameters: args.severity == expected_args.severity
+`)}
+	withoutRule := &engine.Policy{Name: "params", Source: []byte("engine: cel\n")}
+
+	eng := NewEngine()
+
+	matches, err := eng.MatchesParameters(context.TODO(), withRule, map[string]string{"severity": "HIGH"}, map[string]string{"severity": "HIGH"})
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	matches, err = eng.MatchesParameters(context.TODO(), withRule, map[string]string{"severity": "HIGH"}, map[string]string{"severity": "LOW"})
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	// defaults to false, same as the rego engine
+	matches, err = eng.MatchesParameters(context.TODO(), withoutRule, nil, nil)
+	require.NoError(t, err)
+	assert.False(t, matches)
+}
+
+func TestCEL_MatchesEvaluation(t *testing.T) {
+	withRule := &engine.Policy{Name: "eval", Source: []byte(`engine: cel
+matches_evaluation: violations.exists(v, v.contains(expected_args.cve))
+`)}
+	withoutRule := &engine.Policy{Name: "eval", Source: []byte("engine: cel\n")}
+
+	eng := NewEngine()
+
+	matches, err := eng.MatchesEvaluation(context.TODO(), withRule, []string{"CVE-2024-1234 found"}, map[string]string{"cve": "CVE-2024-1234"})
+	require.NoError(t, err)
+	assert.True(t, matches)
+
+	matches, err = eng.MatchesEvaluation(context.TODO(), withRule, nil, map[string]string{"cve": "CVE-2024-1234"})
+	require.NoError(t, err)
+	assert.False(t, matches)
+
+	// defaults to true, same as the rego engine
+	matches, err = eng.MatchesEvaluation(context.TODO(), withoutRule, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, matches)
+}
@@ -15,7 +15,9 @@
 
 package engine
 
-// PolicyType represents the type of a policy (Rego or WASM)
+import "regexp"
+
+// PolicyType represents the type of a policy (Rego, WASM or CEL)
 type PolicyType string
 
 const (
@@ -23,9 +25,16 @@ const (
 	PolicyTypeRego PolicyType = "rego"
 	// PolicyTypeWASM indicates a WASM-based policy
 	PolicyTypeWASM PolicyType = "wasm"
+	// PolicyTypeCEL indicates a Common Expression Language based policy
+	PolicyTypeCEL PolicyType = "cel"
 )
 
+// celHeader matches the top-level "engine: cel" line that CEL policy documents must contain.
+// It can't be mistaken for Rego, where it's not a valid statement.
+var celHeader = regexp.MustCompile(`(?m)^engine:[ \t]*["']?cel["']?[ \t]*$`)
+
 // DetectPolicyType determines the policy type from source bytes
+// CEL policies are YAML documents declaring "engine: cel"
 // WASM files start with magic bytes: 0x00 0x61 0x73 0x6d (\0asm)
 // as documented at https://webassembly.github.io/spec/core/binary/modules.html#binary-module
 func DetectPolicyType(source []byte) PolicyType {
@@ -38,6 +47,10 @@ func DetectPolicyType(source []byte) PolicyType {
 		return PolicyTypeWASM
 	}
 
+	if celHeader.Match(source) {
+		return PolicyTypeCEL
+	}
+
 	// Default to Rego (text-based)
 	return PolicyTypeRego
 }
@@ -37,6 +37,16 @@ func TestDetectPolicyType(t *testing.T) {
 			source:   []byte("package main\n\nresult = {\"violations\": []}"),
 			expected: PolicyTypeRego,
 		},
+		{
+			name:     "CEL policy",
+			source:   []byte("# SBOM checks\nengine: cel\nviolations:\n  - when: size(input.components) == 0\n    message: empty SBOM\n"),
+			expected: PolicyTypeCEL,
+		},
+		{
+			name:     "Rego policy mentioning CEL",
+			source:   []byte("package main\n\n# engine: cel\nresult = {\"violations\": []}"),
+			expected: PolicyTypeRego,
+		},
 		{
 			name:     "Empty file",
 			source:   []byte{},
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 )
@@ -33,10 +34,14 @@ var BaseAllowedHostnames = []string{
 
 // CommonEngineOptions contains configuration options shared by all policy engines
 type CommonEngineOptions struct {
-	AllowedHostnames       []string
+	AllowedHostnames []string
+	// NetworkDisabled denies all the HTTP requests of the policies, including the ones to BaseAllowedHostnames
+	NetworkDisabled        bool
 	IncludeRawData         bool
 	EnablePrint            bool
 	ControlPlaneConnection *grpc.ClientConn
+	// VulnerabilityDB backs the chainloop.osv_lookup builtin, it's optional
+	VulnerabilityDB *osv.Database
 	// ProjectName / ProjectVersionName carry the project + version this engine
 	// instance is evaluating policies for. The rego engine merges them into
 	// input.chainloop_metadata.project_name / .project_version_name at evaluation
@@ -77,6 +82,14 @@ func WithAllowedHostnames(hostnames ...string) Option {
 	}
 }
 
+// WithNetworkDisabled denies all the HTTP requests made by the policies, i.e when evaluating
+// policies on the server side. It takes precedence over WithAllowedHostnames.
+func WithNetworkDisabled(disabled bool) Option {
+	return func(opts *Options) {
+		opts.NetworkDisabled = disabled
+	}
+}
+
 // WithIncludeRawData sets whether to include raw input/output data in results
 func WithIncludeRawData(include bool) Option {
 	return func(opts *Options) {
@@ -120,6 +133,13 @@ func WithGRPCConn(conn *grpc.ClientConn) Option {
 	}
 }
 
+// WithVulnerabilityDB sets the local OSV database used by the osv_lookup builtin
+func WithVulnerabilityDB(db *osv.Database) Option {
+	return func(opts *Options) {
+		opts.VulnerabilityDB = db
+	}
+}
+
 // WithProjectContext sets the project name and version that this engine
 // instance is evaluating policies for. The rego engine exposes them on the
 // per-evaluation input as input.chainloop_metadata.project_name /
@@ -151,6 +171,12 @@ func ApplyOptions(opts ...Option) *Options {
 		opt(options)
 	}
 
+	// An empty, non-nil list denies all the hosts in both engines
+	if options.NetworkDisabled {
+		options.AllowedHostnames = make([]string, 0)
+		return options
+	}
+
 	// Append base allowed hostnames to user-provided ones
 	options.AllowedHostnames = append(options.AllowedHostnames, BaseAllowedHostnames...)
 
@@ -0,0 +1,39 @@
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/topdown"
+)
+
+// findingImpl implements a structured finding builtin taking string operands only.
+// The result is an object with the operands keyed by the given proto JSON field names, in order.
+func findingImpl(fields ...string) topdown.BuiltinFunc {
+	return func(_ topdown.BuiltinContext, operands []*ast.Term, iter func(*ast.Term) error) error {
+		items := make([][2]*ast.Term, 0, len(fields))
+		for i, f := range fields {
+			if _, ok := operands[i].Value.(ast.String); !ok {
+				return fmt.Errorf("%s must be a string", f)
+			}
+
+			items = append(items, ast.Item(ast.StringTerm(f), operands[i]))
+		}
+
+		return iter(ast.NewTerm(ast.NewObject(items...)))
+	}
+}
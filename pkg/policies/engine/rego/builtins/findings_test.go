@@ -0,0 +1,109 @@
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindingBuiltins(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		expected    map[string]any
+		expectError string
+	}{
+		{
+			name:  "secret",
+			query: `chainloop.secret("AWS key leaked", "aws-access-token", "config/prod.env")`,
+			expected: map[string]any{
+				"message":  "AWS key leaked",
+				"rule_id":  "aws-access-token",
+				"location": "config/prod.env",
+			},
+		},
+		{
+			name:  "secret with optional fields",
+			query: `object.union(chainloop.secret("AWS key leaked", "aws-access-token", "config/prod.env"), {"line_number": 12, "verified": true})`,
+			expected: map[string]any{
+				"message":     "AWS key leaked",
+				"rule_id":     "aws-access-token",
+				"location":    "config/prod.env",
+				"line_number": json.Number("12"),
+				"verified":    true,
+			},
+		},
+		{
+			name:  "misconfiguration",
+			query: `chainloop.misconfiguration("S3 bucket without logging", "CKV_AWS_18", "MEDIUM", "infra/s3.tf")`,
+			expected: map[string]any{
+				"message":  "S3 bucket without logging",
+				"check_id": "CKV_AWS_18",
+				"severity": "MEDIUM",
+				"location": "infra/s3.tf",
+			},
+		},
+		{
+			name:  "malware",
+			query: `chainloop.malware("Infected file", "Win.Test.EICAR_HDB-1", "dist/eicar.com")`,
+			expected: map[string]any{
+				"message":   "Infected file",
+				"signature": "Win.Test.EICAR_HDB-1",
+				"location":  "dist/eicar.com",
+			},
+		},
+		{
+			name:        "operand is not a string",
+			query:       `chainloop.malware("Infected file", 123, "dist/eicar.com")`,
+			expectError: "invalid argument(s)",
+		},
+		{
+			name:        "wrong number of arguments",
+			query:       `chainloop.misconfiguration("S3 bucket without logging", "CKV_AWS_18", "MEDIUM")`,
+			expectError: "chainloop.misconfiguration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rego.New(
+				rego.Query("data.test.result"),
+				rego.Module("test.rego", "package test\nimport rego.v1\n\nresult := "+tt.query),
+			)
+			rs, err := r.Eval(context.Background())
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, rs, 1)
+			require.Len(t, rs[0].Expressions, 1)
+
+			result, ok := rs[0].Expressions[0].Value.(map[string]any)
+			require.True(t, ok)
+			assert.Len(t, result, len(tt.expected))
+			for k, v := range tt.expected {
+				assert.EqualValues(t, v, result[k], "field %q mismatch", k)
+			}
+		})
+	}
+}
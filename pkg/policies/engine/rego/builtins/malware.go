@@ -0,0 +1,56 @@
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const malwareBuiltinName = "chainloop.malware"
+
+func init() {
+	if err := registerMalwareBuiltin(); err != nil {
+		panic(fmt.Sprintf("failed to register malware builtin: %v", err))
+	}
+}
+
+// registerMalwareBuiltin registers chainloop.malware as a Rego builtin.
+//
+// Signature:
+//
+//	chainloop.malware(message, signature, location)
+//
+// Returns a structured finding object whose keys match PolicyMalwareFinding
+// proto JSON field names. For optional fields (digest, package_purl, engine...),
+// use object.union:
+//
+//	m := object.union(chainloop.malware(...), {"engine": "clamav"})
+func registerMalwareBuiltin() error {
+	return Register(&ast.Builtin{
+		Name:        malwareBuiltinName,
+		Description: "Creates a structured malware finding for use in policy violations",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("message", types.S).Description("Human-readable violation description"),
+				types.Named("signature", types.S).Description("Signature that matched (e.g., Win.Test.EICAR_HDB-1)"),
+				types.Named("location", types.S).Description("Path of the infected file"),
+			),
+			types.Named("finding", types.A).Description("Structured malware finding object"),
+		),
+	}, findingImpl("message", "signature", "location"))
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const misconfigurationBuiltinName = "chainloop.misconfiguration"
+
+func init() {
+	if err := registerMisconfigurationBuiltin(); err != nil {
+		panic(fmt.Sprintf("failed to register misconfiguration builtin: %v", err))
+	}
+}
+
+// registerMisconfigurationBuiltin registers chainloop.misconfiguration as a Rego builtin.
+//
+// Signature:
+//
+//	chainloop.misconfiguration(message, check_id, severity, location)
+//
+// Returns a structured finding object whose keys match PolicyMisconfigurationFinding
+// proto JSON field names. For optional fields (resource, line_number, references...),
+// use object.union:
+//
+//	m := object.union(chainloop.misconfiguration(...), {"resource": "aws_s3_bucket.logs"})
+func registerMisconfigurationBuiltin() error {
+	return Register(&ast.Builtin{
+		Name:        misconfigurationBuiltinName,
+		Description: "Creates a structured misconfiguration finding for use in policy violations",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("message", types.S).Description("Human-readable violation description"),
+				types.Named("check_id", types.S).Description("Check identifier (e.g., CKV_AWS_20)"),
+				types.Named("severity", types.S).Description("Severity level (CRITICAL, HIGH, MEDIUM, LOW)"),
+				types.Named("location", types.S).Description("File path of the offending configuration"),
+			),
+			types.Named("finding", types.A).Description("Structured misconfiguration finding object"),
+		),
+	}, findingImpl("message", "check_id", "severity", "location"))
+}
@@ -0,0 +1,88 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/topdown"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const osvLookupBuiltinName = "chainloop.osv_lookup"
+
+func init() {
+	if err := registerOSVLookupBuiltin(); err != nil {
+		panic(fmt.Sprintf("failed to register osv_lookup builtin: %v", err))
+	}
+}
+
+// registerOSVLookupBuiltin registers chainloop.osv_lookup as a Rego builtin.
+//
+// Signature:
+//
+//	chainloop.osv_lookup(purl)
+//
+// Returns the known vulnerabilities affecting the package version, from the local OSV database
+// configured in the engine, so SBOMs can be evaluated without network access. For instance:
+// ```
+//
+//	violations contains chainloop.vulnerability(msg, vuln.id, component.purl, vuln.severity) if {
+//	  some component in input.components
+//	  some vuln in chainloop.osv_lookup(component.purl)
+//	  msg := sprintf("%s is affected by %s", [component.purl, vuln.id])
+//	}
+//
+// ```
+func registerOSVLookupBuiltin() error {
+	return Register(&ast.Builtin{
+		Name:        osvLookupBuiltinName,
+		Description: "Looks up the known vulnerabilities of a package in the local OSV database",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("purl", types.S).Description("package URL, including the version (e.g., pkg:npm/lodash@4.17.20)"),
+			),
+			types.Named("vulnerabilities", types.NewArray(nil, types.A)).Description("vulnerabilities with their id, aliases, severity and fixed versions"),
+		),
+	}, osvLookupImpl)
+}
+
+func osvLookupImpl(bctx topdown.BuiltinContext, operands []*ast.Term, iter func(*ast.Term) error) error {
+	purl, ok := operands[0].Value.(ast.String)
+	if !ok {
+		return errors.New("purl must be a string")
+	}
+
+	db, err := osv.FromContext(bctx.Context)
+	if err != nil {
+		return err
+	}
+
+	vulns, err := db.Lookup(string(purl))
+	if err != nil {
+		return err
+	}
+
+	v, err := ast.InterfaceToValue(vulns)
+	if err != nil {
+		return fmt.Errorf("converting vulnerabilities: %w", err)
+	}
+
+	return iter(ast.NewTerm(v))
+}
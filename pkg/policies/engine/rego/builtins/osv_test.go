@@ -0,0 +1,101 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOSVLookupBuiltin(t *testing.T) {
+	db, err := osv.Load("../../../osv/testdata/advisories")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		policy      string
+		db          *osv.Database
+		expected    any
+		expectError string
+	}{
+		{
+			name: "vulnerable package",
+			policy: `package test
+import rego.v1
+
+result := [[v.id, v.severity, v.fixed_versions] | some v in chainloop.osv_lookup("pkg:npm/lodash@4.17.20")]`,
+			db:       db,
+			expected: []any{[]any{"GHSA-29mw-wpgm-hmr9", "MEDIUM", []any{"4.17.21"}}},
+		},
+		{
+			name: "fixed package",
+			policy: `package test
+import rego.v1
+
+result := chainloop.osv_lookup("pkg:npm/lodash@4.17.21")`,
+			db:       db,
+			expected: []any{},
+		},
+		{
+			name: "invalid purl",
+			policy: `package test
+import rego.v1
+
+result := chainloop.osv_lookup("pkg:npm/lodash")`,
+			db:          db,
+			expectError: "must include a version",
+		},
+		{
+			name: "no database",
+			policy: `package test
+import rego.v1
+
+result := chainloop.osv_lookup("pkg:npm/lodash@4.17.20")`,
+			expectError: "no vulnerability database configured",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.db != nil {
+				ctx = osv.NewContext(ctx, tt.db)
+			}
+
+			r := rego.New(
+				rego.Query("data.test.result"),
+				rego.Module("test.rego", tt.policy),
+				rego.StrictBuiltinErrors(true),
+			)
+			rs, err := r.Eval(ctx)
+
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, rs, 1)
+			require.Len(t, rs[0].Expressions, 1)
+			assert.Equal(t, tt.expected, rs[0].Expressions[0].Value)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine/builtins"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/topdown"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const purlParseBuiltinName = "chainloop.purl_parse"
+
+func init() {
+	if err := registerPURLParseBuiltin(); err != nil {
+		panic(fmt.Sprintf("failed to register purl_parse builtin: %v", err))
+	}
+}
+
+// registerPURLParseBuiltin registers chainloop.purl_parse as a Rego builtin.
+//
+// Signature:
+//
+//	chainloop.purl_parse(purl)
+//
+// Returns the components of the package URL, with its qualifiers as an object. For instance:
+// ```
+//
+//	violations contains msg if {
+//	  some component in input.components
+//	  purl := chainloop.purl_parse(component.purl)
+//	  purl.type == "npm"
+//	  purl.version == ""
+//	  msg := sprintf("%s is not pinned", [purl.name])
+//	}
+//
+// ```
+func registerPURLParseBuiltin() error {
+	return Register(&ast.Builtin{
+		Name:        purlParseBuiltinName,
+		Description: "Parses a package URL into its components",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("purl", types.S).Description("package URL (e.g., pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1)"),
+			),
+			types.Named("components", types.A).Description("object with the type, namespace, name, version, qualifiers and subpath"),
+		),
+	}, purlParseImpl)
+}
+
+func purlParseImpl(_ topdown.BuiltinContext, operands []*ast.Term, iter func(*ast.Term) error) error {
+	purl, ok := operands[0].Value.(ast.String)
+	if !ok {
+		return errors.New("purl must be a string")
+	}
+
+	p, err := builtins.ParsePURL(string(purl))
+	if err != nil {
+		return err
+	}
+
+	v, err := ast.InterfaceToValue(p)
+	if err != nil {
+		return fmt.Errorf("converting purl: %w", err)
+	}
+
+	return iter(ast.NewTerm(v))
+}
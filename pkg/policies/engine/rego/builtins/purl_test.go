@@ -0,0 +1,99 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// evalHelperBuiltin evaluates data.test.result in the policy
+func evalHelperBuiltin(t *testing.T, policy string) (any, error) {
+	t.Helper()
+
+	r := rego.New(
+		rego.Query("data.test.result"),
+		rego.Module("test.rego", policy),
+		rego.StrictBuiltinErrors(true),
+	)
+	rs, err := r.Eval(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	require.Len(t, rs, 1)
+	require.Len(t, rs[0].Expressions, 1)
+	return rs[0].Expressions[0].Value, nil
+}
+
+func TestPURLParseBuiltin(t *testing.T) {
+	tests := []struct {
+		name        string
+		purl        string
+		expected    any
+		expectError string
+	}{
+		{
+			name: "with namespace and qualifiers",
+			purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1?type=jar",
+			expected: map[string]any{
+				"type":       "maven",
+				"namespace":  "org.apache.logging.log4j",
+				"name":       "log4j-core",
+				"version":    "2.14.1",
+				"qualifiers": map[string]any{"type": "jar"},
+				"subpath":    "",
+			},
+		},
+		{
+			name: "scoped npm package",
+			purl: "pkg:npm/%40angular/core@17.0.0",
+			expected: map[string]any{
+				"type":       "npm",
+				"namespace":  "@angular",
+				"name":       "core",
+				"version":    "17.0.0",
+				"qualifiers": map[string]any{},
+				"subpath":    "",
+			},
+		},
+		{
+			name:        "invalid purl",
+			purl:        "npm/lodash@4.17.21",
+			expectError: "invalid purl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evalHelperBuiltin(t, `package test
+import rego.v1
+
+result := chainloop.purl_parse("`+tt.purl+`")`)
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"fmt"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const secretBuiltinName = "chainloop.secret"
+
+func init() {
+	if err := registerSecretBuiltin(); err != nil {
+		panic(fmt.Sprintf("failed to register secret builtin: %v", err))
+	}
+}
+
+// registerSecretBuiltin registers chainloop.secret as a Rego builtin.
+//
+// Signature:
+//
+//	chainloop.secret(message, rule_id, location)
+//
+// Returns a structured finding object whose keys match PolicySecretFinding
+// proto JSON field names. For optional fields (line_number, commit, verified, fingerprint...),
+// use object.union:
+//
+//	s := object.union(chainloop.secret(...), {"line_number": 12, "verified": true})
+//
+// The secret itself must never be part of the finding.
+func registerSecretBuiltin() error {
+	return Register(&ast.Builtin{
+		Name:        secretBuiltinName,
+		Description: "Creates a structured leaked secret finding for use in policy violations",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("message", types.S).Description("Human-readable violation description"),
+				types.Named("rule_id", types.S).Description("Rule that detected the secret (e.g., aws-access-token)"),
+				types.Named("location", types.S).Description("File path where the secret was found"),
+			),
+			types.Named("finding", types.A).Description("Structured secret finding object"),
+		),
+	}, findingImpl("message", "rule_id", "location"))
+}
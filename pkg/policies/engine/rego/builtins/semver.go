@@ -0,0 +1,122 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine/builtins"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/topdown"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const (
+	semverCompareBuiltinName   = "chainloop.semver_compare"
+	semverSatisfiesBuiltinName = "chainloop.semver_satisfies"
+)
+
+func init() {
+	if err := registerSemverBuiltins(); err != nil {
+		panic(fmt.Sprintf("failed to register semver builtins: %v", err))
+	}
+}
+
+// registerSemverBuiltins registers chainloop.semver_compare and chainloop.semver_satisfies as Rego builtins.
+// Unlike OPA's semver builtins, versions can be prefixed with "v" and partial versions like "1.2" are accepted.
+//
+// Signatures:
+//
+//	chainloop.semver_compare(a, b)
+//	chainloop.semver_satisfies(version, constraint)
+//
+// For instance:
+// ```
+//
+//	violations contains msg if {
+//	  some component in input.components
+//	  component.name == "log4j-core"
+//	  chainloop.semver_satisfies(component.version, ">= 2.0.0, < 2.17.1")
+//	  msg := sprintf("log4j-core %s is affected by Log4Shell", [component.version])
+//	}
+//
+// ```
+func registerSemverBuiltins() error {
+	if err := Register(&ast.Builtin{
+		Name:        semverCompareBuiltinName,
+		Description: "Compares two semantic versions",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("a", types.S).Description("semantic version (e.g., v1.2.3)"),
+				types.Named("b", types.S).Description("semantic version to compare with"),
+			),
+			types.Named("result", types.N).Description("-1 if a is lower than b, 0 if they are equal, 1 if a is greater than b"),
+		),
+	}, semverCompareImpl); err != nil {
+		return err
+	}
+
+	return Register(&ast.Builtin{
+		Name:        semverSatisfiesBuiltinName,
+		Description: "Checks if a semantic version satisfies a constraint",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("version", types.S).Description("semantic version (e.g., v1.2.3)"),
+				types.Named("constraint", types.S).Description("version constraint (e.g., >= 1.2.0, < 2.0.0)"),
+			),
+			types.Named("result", types.B).Description("true if the version satisfies the constraint"),
+		),
+	}, semverSatisfiesImpl)
+}
+
+func semverCompareImpl(_ topdown.BuiltinContext, operands []*ast.Term, iter func(*ast.Term) error) error {
+	a, ok := operands[0].Value.(ast.String)
+	if !ok {
+		return errors.New("a must be a string")
+	}
+
+	b, ok := operands[1].Value.(ast.String)
+	if !ok {
+		return errors.New("b must be a string")
+	}
+
+	res, err := builtins.CompareSemver(string(a), string(b))
+	if err != nil {
+		return err
+	}
+
+	return iter(ast.IntNumberTerm(res))
+}
+
+func semverSatisfiesImpl(_ topdown.BuiltinContext, operands []*ast.Term, iter func(*ast.Term) error) error {
+	version, ok := operands[0].Value.(ast.String)
+	if !ok {
+		return errors.New("version must be a string")
+	}
+
+	constraint, ok := operands[1].Value.(ast.String)
+	if !ok {
+		return errors.New("constraint must be a string")
+	}
+
+	res, err := builtins.SemverSatisfies(string(version), string(constraint))
+	if err != nil {
+		return err
+	}
+
+	return iter(ast.BooleanTerm(res))
+}
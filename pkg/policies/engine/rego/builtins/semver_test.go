@@ -0,0 +1,58 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSemverBuiltins(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		expected    any
+		expectError string
+	}{
+		{name: "lower", expr: `chainloop.semver_compare("1.2.3", "v1.10.0")`, expected: json.Number("-1")},
+		{name: "equal", expr: `chainloop.semver_compare("v1.2", "1.2.0")`, expected: json.Number("0")},
+		{name: "greater", expr: `chainloop.semver_compare("2.0.0", "2.0.0-rc.1")`, expected: json.Number("1")},
+		{name: "invalid version", expr: `chainloop.semver_compare("latest", "1.0.0")`, expectError: `invalid version "latest"`},
+		{name: "in range", expr: `chainloop.semver_satisfies("2.14.1", ">= 2.0.0, < 2.17.1")`, expected: true},
+		{name: "out of range", expr: `chainloop.semver_satisfies("v2.17.1", ">= 2.0.0, < 2.17.1")`, expected: false},
+		{name: "tilde", expr: `chainloop.semver_satisfies("1.4.7", "~1.4")`, expected: true},
+		{name: "invalid constraint", expr: `chainloop.semver_satisfies("1.0.0", "not a constraint")`, expectError: "invalid constraint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evalHelperBuiltin(t, `package test
+import rego.v1
+
+result := `+tt.expr)
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chainloop-dev/chainloop/pkg/policies/spdx"
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/topdown"
+	"github.com/open-policy-agent/opa/v1/types"
+)
+
+const spdxExpressionSatisfiesBuiltinName = "chainloop.spdx_expression_satisfies"
+
+func init() {
+	if err := registerSPDXExpressionSatisfiesBuiltin(); err != nil {
+		panic(fmt.Sprintf("failed to register spdx_expression_satisfies builtin: %v", err))
+	}
+}
+
+// registerSPDXExpressionSatisfiesBuiltin registers chainloop.spdx_expression_satisfies as a Rego builtin.
+//
+// Signature:
+//
+//	chainloop.spdx_expression_satisfies(expression, allowlist)
+//
+// Tells if the SPDX license expression can be complied with using only the allowed licenses, i.e
+// "(MIT OR GPL-3.0-only) AND Apache-2.0" is satisfied by ["MIT", "Apache-2.0"]. For instance:
+// ```
+//
+//	violations contains msg if {
+//	  some component in input.components
+//	  some license in component.licenses
+//	  not chainloop.spdx_expression_satisfies(license.expression, input.args.allowed_licenses)
+//	  msg := sprintf("%s is not allowed in %s", [license.expression, component.name])
+//	}
+//
+// ```
+func registerSPDXExpressionSatisfiesBuiltin() error {
+	return Register(&ast.Builtin{
+		Name:        spdxExpressionSatisfiesBuiltinName,
+		Description: "Checks if an SPDX license expression is satisfied by a list of allowed licenses",
+		Decl: types.NewFunction(
+			types.Args(
+				types.Named("expression", types.S).Description("SPDX license expression (e.g., (MIT OR GPL-3.0-only) AND Apache-2.0)"),
+				types.Named("allowlist", types.NewAny(types.NewArray(nil, types.S), types.NewSet(types.S))).Description("allowed SPDX license identifiers"),
+			),
+			types.Named("result", types.B).Description("true if the expression is satisfied by the allowed licenses"),
+		),
+	}, spdxExpressionSatisfiesImpl)
+}
+
+func spdxExpressionSatisfiesImpl(_ topdown.BuiltinContext, operands []*ast.Term, iter func(*ast.Term) error) error {
+	expression, ok := operands[0].Value.(ast.String)
+	if !ok {
+		return errors.New("expression must be a string")
+	}
+
+	var allowlist []string
+	var err error
+	switch v := operands[1].Value.(type) {
+	case *ast.Array:
+		err = v.Iter(func(t *ast.Term) error { return appendString(&allowlist, t) })
+	case ast.Set:
+		err = v.Iter(func(t *ast.Term) error { return appendString(&allowlist, t) })
+	default:
+		err = errors.New("not a collection")
+	}
+	if err != nil {
+		return errors.New("allowlist must be an array or set of strings")
+	}
+
+	res, err := spdx.Satisfies(string(expression), allowlist)
+	if err != nil {
+		return err
+	}
+
+	return iter(ast.BooleanTerm(res))
+}
+
+func appendString(values *[]string, t *ast.Term) error {
+	s, ok := t.Value.(ast.String)
+	if !ok {
+		return errors.New("not a string")
+	}
+
+	*values = append(*values, string(s))
+	return nil
+}
@@ -0,0 +1,54 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtins
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSPDXExpressionSatisfiesBuiltin(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		expected    any
+		expectError string
+	}{
+		{name: "satisfied", expr: `chainloop.spdx_expression_satisfies("(MIT OR GPL-3.0-only) AND Apache-2.0", ["MIT", "Apache-2.0"])`, expected: true},
+		{name: "not satisfied", expr: `chainloop.spdx_expression_satisfies("(MIT OR GPL-3.0-only) AND Apache-2.0", ["MIT"])`, expected: false},
+		{name: "set allowlist", expr: `chainloop.spdx_expression_satisfies("MIT OR GPL-3.0-only", {"GPL-3.0-only"})`, expected: true},
+		{name: "invalid expression", expr: `chainloop.spdx_expression_satisfies("MIT AND", ["MIT"])`, expectError: "invalid license expression"},
+		{name: "invalid allowlist", expr: `chainloop.spdx_expression_satisfies("MIT", [1])`, expectError: "invalid argument(s)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evalHelperBuiltin(t, `package test
+import rego.v1
+
+result := `+tt.expr)
+			if tt.expectError != "" {
+				assert.ErrorContains(t, err, tt.expectError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/chainloop-dev/chainloop/pkg/policies/engine"
 	"github.com/chainloop-dev/chainloop/pkg/policies/engine/rego/builtins"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"github.com/open-policy-agent/opa/ast"
 	"github.com/open-policy-agent/opa/rego"
 	"github.com/open-policy-agent/opa/v1/topdown/print"
@@ -145,6 +146,11 @@ func (r *Engine) Verify(ctx context.Context, policy *engine.Policy, input []byte
 	ctx, cancel := context.WithTimeout(ctx, r.executionTimeout)
 	defer cancel()
 
+	// made available to the chainloop.osv_lookup builtin
+	if r.CommonEngineOptions != nil && r.VulnerabilityDB != nil {
+		ctx = osv.NewContext(ctx, r.VulnerabilityDB)
+	}
+
 	policyString := string(policy.Source)
 	parsedModule, err := ast.ParseModule(policy.Name, policyString)
 	if err != nil {
@@ -293,6 +293,21 @@ func TestRego_WithRestrictiveMode(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("forbidden network requests to the defaults when the network is disabled", func(t *testing.T) {
+		regoContent, err := os.ReadFile("testfiles/restricted_mode_networking_allowed_host.rego")
+		require.NoError(t, err)
+
+		r := NewEngine(engine.WithAllowedHostnames("github.com"), engine.WithNetworkDisabled(true))
+		policy := &engine.Policy{
+			Name:   "policy",
+			Source: regoContent,
+		}
+
+		_, err = r.Verify(context.TODO(), policy, []byte(`{}`), nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "eval_builtin_error: http.send: unallowed host")
+	})
+
 	t.Run("allowed network requests from defaults plus custom domains", func(t *testing.T) {
 		defaultHosts, err := os.ReadFile("testfiles/restricted_mode_networking_allowed_host.rego")
 		require.NoError(t, err)
@@ -128,6 +128,8 @@ func (e *Engine) Verify(ctx context.Context, policy *engine.Policy, input []byte
 	// Register host functions
 	// Registers in both "env" (for Go/TinyGo) and "extism:host/user" (for JavaScript) namespaces
 	hostFunctions := builtins.CreateDiscoverHostFunctions(e.ControlPlaneConnection)
+	hostFunctions = append(hostFunctions, builtins.CreateOSVLookupHostFunctions(e.VulnerabilityDB)...)
+	hostFunctions = append(hostFunctions, builtins.CreateHelperHostFunctions()...)
 
 	// Create plugin with host functions
 	plugin, err := extism.NewPlugin(ctx, manifest, config, hostFunctions)
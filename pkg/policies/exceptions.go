@@ -0,0 +1,147 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v13 "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	v12 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PolicyExceptions holds the policy exceptions approved in the controlplane for a workflow run.
+// They are fetched on first use and can be shared across verifiers, so the controlplane
+// is queried once per attestation.
+type PolicyExceptions struct {
+	client        v13.AttestationServiceClient
+	workflowRunID string
+
+	once  sync.Once
+	items []*v13.PolicyExceptionItem
+	err   error
+}
+
+// NewPolicyExceptions returns the exceptions that apply to the given workflow run
+func NewPolicyExceptions(client v13.AttestationServiceClient, workflowRunID string) *PolicyExceptions {
+	return &PolicyExceptions{client: client, workflowRunID: workflowRunID}
+}
+
+// NewStaticPolicyExceptions returns a fixed set of exceptions, i.e for testing purposes
+func NewStaticPolicyExceptions(items ...*v13.PolicyExceptionItem) *PolicyExceptions {
+	e := &PolicyExceptions{items: items}
+	e.once.Do(func() {})
+	return e
+}
+
+func (e *PolicyExceptions) load(ctx context.Context) ([]*v13.PolicyExceptionItem, error) {
+	e.once.Do(func() {
+		// nothing to fetch from, i.e offline attestations
+		if e.client == nil || e.workflowRunID == "" {
+			return
+		}
+
+		resp, err := e.client.ListPolicyExceptions(ctx, &v13.AttestationServiceListPolicyExceptionsRequest{WorkflowRunId: e.workflowRunID})
+		if err != nil {
+			// controlplanes without support for exceptions
+			if status.Code(err) == codes.Unimplemented {
+				return
+			}
+
+			e.err = fmt.Errorf("fetching policy exceptions: %w", err)
+			return
+		}
+
+		e.items = resp.GetResult()
+	})
+
+	return e.items, e.err
+}
+
+// WithPolicyExceptions waives the violations matching any of the given exceptions
+func WithPolicyExceptions(e *PolicyExceptions) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.PolicyExceptions = e
+	}
+}
+
+// applyPolicyExceptions suppresses the violations of a policy evaluated against a material (empty for the attestation)
+// that match an exception, recording the ID of the exception.
+// Violations already suppressed by the policy itself are left untouched.
+func (pv *PolicyVerifier) applyPolicyExceptions(ctx context.Context, policyName, materialName string, violations []*v12.PolicyEvaluation_Violation) {
+	if pv.policyExceptions == nil || len(violations) == 0 {
+		return
+	}
+
+	exceptions, err := pv.policyExceptions.load(ctx)
+	if err != nil {
+		// violations are kept as they are, the exceptions are a best effort
+		pv.logger.Warn().Err(err).Msg("policy exceptions won't be applied")
+		return
+	}
+
+	for _, v := range violations {
+		if v.GetSuppress() {
+			continue
+		}
+
+		for _, e := range exceptions {
+			if policyExceptionMatches(e, policyName, materialName, v) {
+				v.Suppress = true
+				v.ExceptionId = e.GetId()
+				pv.logger.Debug().Str("policy", policyName).Str("exception", e.GetId()).Msg("violation waived by policy exception")
+				break
+			}
+		}
+	}
+}
+
+// policyExceptionMatches returns whether the scope of an exception covers a violation, empty values match everything.
+// The project and workflow are already matched by the controlplane.
+func policyExceptionMatches(e *v13.PolicyExceptionItem, policyName, materialName string, v *v12.PolicyEvaluation_Violation) bool {
+	if e.GetPolicyName() != "" && e.GetPolicyName() != policyName {
+		return false
+	}
+
+	if e.GetMaterialName() != "" && e.GetMaterialName() != materialName {
+		return false
+	}
+
+	return e.GetExternalId() == "" || e.GetExternalId() == violationFindingID(v)
+}
+
+// violationFindingID returns the identifier of the structured finding of a violation, if any
+func violationFindingID(v *v12.PolicyEvaluation_Violation) string {
+	switch {
+	case v.GetVulnerability() != nil:
+		return v.GetVulnerability().GetExternalId()
+	case v.GetSast() != nil:
+		return v.GetSast().GetRuleId()
+	case v.GetLicenseViolation() != nil:
+		return v.GetLicenseViolation().GetLicenseId()
+	case v.GetSecret() != nil:
+		return v.GetSecret().GetRuleId()
+	case v.GetMisconfiguration() != nil:
+		return v.GetMisconfiguration().GetCheckId()
+	case v.GetMalware() != nil:
+		return v.GetMalware().GetSignature()
+	}
+
+	return ""
+}
@@ -0,0 +1,148 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"testing"
+
+	v13 "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
+	v12 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyExceptionMatches(t *testing.T) {
+	vuln := &v12.PolicyEvaluation_Violation{
+		Message: "CVE-2024-1234 found",
+		Finding: &v12.PolicyEvaluation_Violation_Vulnerability{Vulnerability: &v12.PolicyVulnerabilityFinding{ExternalId: "CVE-2024-1234"}},
+	}
+	sast := &v12.PolicyEvaluation_Violation{
+		Message: "sql injection",
+		Finding: &v12.PolicyEvaluation_Violation_Sast{Sast: &v12.PolicySASTFinding{RuleId: "go/sql-injection"}},
+	}
+	plain := &v12.PolicyEvaluation_Violation{Message: "wrong"}
+
+	testCases := []struct {
+		name         string
+		exception    *v13.PolicyExceptionItem
+		materialName string
+		violation    *v12.PolicyEvaluation_Violation
+		want         bool
+	}{
+		{
+			name:      "by policy",
+			exception: &v13.PolicyExceptionItem{PolicyName: "cve-policy"},
+			violation: plain,
+			want:      true,
+		},
+		{
+			name:      "other policy",
+			exception: &v13.PolicyExceptionItem{PolicyName: "another-policy"},
+			violation: plain,
+		},
+		{
+			name:         "by material",
+			exception:    &v13.PolicyExceptionItem{MaterialName: "sbom"},
+			materialName: "sbom",
+			violation:    plain,
+			want:         true,
+		},
+		{
+			name:      "material exceptions don't apply to the attestation",
+			exception: &v13.PolicyExceptionItem{MaterialName: "sbom"},
+			violation: plain,
+		},
+		{
+			name:      "by vulnerability",
+			exception: &v13.PolicyExceptionItem{ExternalId: "CVE-2024-1234"},
+			violation: vuln,
+			want:      true,
+		},
+		{
+			name:      "by sast rule",
+			exception: &v13.PolicyExceptionItem{PolicyName: "cve-policy", ExternalId: "go/sql-injection"},
+			violation: sast,
+			want:      true,
+		},
+		{
+			name:      "other finding",
+			exception: &v13.PolicyExceptionItem{ExternalId: "CVE-2024-1234"},
+			violation: sast,
+		},
+		{
+			name:      "findings don't match violations without them",
+			exception: &v13.PolicyExceptionItem{ExternalId: "CVE-2024-1234"},
+			violation: plain,
+		},
+		{
+			name:         "all the scope must match",
+			exception:    &v13.PolicyExceptionItem{PolicyName: "cve-policy", MaterialName: "other-sbom", ExternalId: "CVE-2024-1234"},
+			materialName: "sbom",
+			violation:    vuln,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, policyExceptionMatches(tc.exception, "cve-policy", tc.materialName, tc.violation))
+		})
+	}
+}
+
+func TestApplyPolicyExceptions(t *testing.T) {
+	logger := zerolog.Nop()
+	exceptions := NewStaticPolicyExceptions(
+		&v13.PolicyExceptionItem{Id: "exception-1", ExternalId: "CVE-2024-1234"},
+		&v13.PolicyExceptionItem{Id: "exception-2", PolicyName: "cve-policy"},
+	)
+
+	violations := []*v12.PolicyEvaluation_Violation{
+		{
+			Message: "CVE-2024-1234 found",
+			Finding: &v12.PolicyEvaluation_Violation_Vulnerability{Vulnerability: &v12.PolicyVulnerabilityFinding{ExternalId: "CVE-2024-1234"}},
+		},
+		{Message: "wrong"},
+		{Message: "suppressed by the policy", Suppress: true},
+	}
+
+	t.Run("without exceptions", func(t *testing.T) {
+		pv := NewPolicyVerifier(nil, nil, &logger)
+		pv.applyPolicyExceptions(context.TODO(), "cve-policy", "sbom", violations)
+		assert.False(t, violations[0].GetSuppress())
+		assert.False(t, violations[1].GetSuppress())
+	})
+
+	t.Run("with exceptions", func(t *testing.T) {
+		pv := NewPolicyVerifier(nil, nil, &logger, WithPolicyExceptions(exceptions))
+		pv.applyPolicyExceptions(context.TODO(), "cve-policy", "sbom", violations)
+
+		// first matching exception wins
+		assert.True(t, violations[0].GetSuppress())
+		assert.Equal(t, "exception-1", violations[0].GetExceptionId())
+		assert.True(t, violations[1].GetSuppress())
+		assert.Equal(t, "exception-2", violations[1].GetExceptionId())
+		assert.True(t, violations[2].GetSuppress())
+		assert.Empty(t, violations[2].GetExceptionId())
+	})
+}
+
+func TestPolicyExceptionsWithoutControlplane(t *testing.T) {
+	got, err := NewPolicyExceptions(nil, "").load(context.TODO())
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -29,6 +29,9 @@ const (
 	FindingTypeVulnerability    = "VULNERABILITY"
 	FindingTypeSAST             = "SAST"
 	FindingTypeLicenseViolation = "LICENSE_VIOLATION"
+	FindingTypeSecret           = "SECRET"
+	FindingTypeMisconfiguration = "MISCONFIGURATION"
+	FindingTypeMalware          = "MALWARE"
 )
 
 // findingTypes maps declared finding type strings to proto message constructors.
@@ -36,6 +39,9 @@ var findingTypes = map[string]func() proto.Message{
 	FindingTypeVulnerability:    func() proto.Message { return &v1.PolicyVulnerabilityFinding{} },
 	FindingTypeSAST:             func() proto.Message { return &v1.PolicySASTFinding{} },
 	FindingTypeLicenseViolation: func() proto.Message { return &v1.PolicyLicenseViolationFinding{} },
+	FindingTypeSecret:           func() proto.Message { return &v1.PolicySecretFinding{} },
+	FindingTypeMisconfiguration: func() proto.Message { return &v1.PolicyMisconfigurationFinding{} },
+	FindingTypeMalware:          func() proto.Message { return &v1.PolicyMalwareFinding{} },
 }
 
 // IsValidFindingType checks whether a finding type string is recognized.
@@ -95,6 +101,24 @@ func SetViolationFinding(violation *v1.PolicyEvaluation_Violation, findingType s
 			return fmt.Errorf("finding is not a PolicyLicenseViolationFinding")
 		}
 		violation.Finding = &v1.PolicyEvaluation_Violation_LicenseViolation{LicenseViolation: f}
+	case FindingTypeSecret:
+		f, ok := finding.(*v1.PolicySecretFinding)
+		if !ok {
+			return fmt.Errorf("finding is not a PolicySecretFinding")
+		}
+		violation.Finding = &v1.PolicyEvaluation_Violation_Secret{Secret: f}
+	case FindingTypeMisconfiguration:
+		f, ok := finding.(*v1.PolicyMisconfigurationFinding)
+		if !ok {
+			return fmt.Errorf("finding is not a PolicyMisconfigurationFinding")
+		}
+		violation.Finding = &v1.PolicyEvaluation_Violation_Misconfiguration{Misconfiguration: f}
+	case FindingTypeMalware:
+		f, ok := finding.(*v1.PolicyMalwareFinding)
+		if !ok {
+			return fmt.Errorf("finding is not a PolicyMalwareFinding")
+		}
+		violation.Finding = &v1.PolicyEvaluation_Violation_Malware{Malware: f}
 	default:
 		return fmt.Errorf("unknown finding type %q", findingType)
 	}
@@ -33,6 +33,9 @@ func TestIsValidFindingType(t *testing.T) {
 		{"vulnerability", "VULNERABILITY", true},
 		{"sast", "SAST", true},
 		{"license_violation", "LICENSE_VIOLATION", true},
+		{"secret", "SECRET", true},
+		{"misconfiguration", "MISCONFIGURATION", true},
+		{"malware", "MALWARE", true},
 		{"unknown", "UNKNOWN", false},
 		{"empty", "", false},
 		{"lowercase", "vulnerability", false},
@@ -202,6 +205,94 @@ func TestValidateFinding(t *testing.T) {
 				assert.Equal(t, "GPL-3.0", f.GetLicenseId())
 			},
 		},
+		{
+			name:        "valid secret finding",
+			findingType: "SECRET",
+			raw: map[string]any{
+				"message":     "AWS access key leaked",
+				"rule_id":     "aws-access-token",
+				"location":    "config/prod.env",
+				"line_number": 12,
+				"verified":    true,
+				"fingerprint": "4f2c1a:config/prod.env:aws-access-token:12",
+			},
+			checkFn: func(t *testing.T, msg interface{}) {
+				t.Helper()
+				f, ok := msg.(*v1.PolicySecretFinding)
+				require.True(t, ok)
+				assert.Equal(t, "aws-access-token", f.GetRuleId())
+				assert.Equal(t, "config/prod.env", f.GetLocation())
+				assert.Equal(t, int32(12), f.GetLineNumber())
+				assert.True(t, f.GetVerified())
+			},
+		},
+		{
+			name:        "secret finding missing required field",
+			findingType: "SECRET",
+			raw: map[string]any{
+				"message": "AWS access key leaked",
+				// missing rule_id and location
+			},
+			wantErr: "finding validation failed",
+		},
+		{
+			name:        "valid misconfiguration finding",
+			findingType: "MISCONFIGURATION",
+			raw: map[string]any{
+				"message":    "S3 bucket without access logging",
+				"check_id":   "CKV_AWS_18",
+				"severity":   "MEDIUM",
+				"location":   "infra/s3.tf",
+				"resource":   "aws_s3_bucket.logs",
+				"references": []any{"https://docs.prismacloud.io/en/policy-reference/aws-policies/s3-policies/s3-13-enable-logging"},
+			},
+			checkFn: func(t *testing.T, msg interface{}) {
+				t.Helper()
+				f, ok := msg.(*v1.PolicyMisconfigurationFinding)
+				require.True(t, ok)
+				assert.Equal(t, "CKV_AWS_18", f.GetCheckId())
+				assert.Equal(t, "aws_s3_bucket.logs", f.GetResource())
+				assert.Len(t, f.GetReferences(), 1)
+			},
+		},
+		{
+			name:        "misconfiguration finding missing required field",
+			findingType: "MISCONFIGURATION",
+			raw: map[string]any{
+				"message":  "S3 bucket without access logging",
+				"check_id": "CKV_AWS_18",
+				// missing severity and location
+			},
+			wantErr: "finding validation failed",
+		},
+		{
+			name:        "valid malware finding",
+			findingType: "MALWARE",
+			raw: map[string]any{
+				"message":   "Infected file found",
+				"signature": "Win.Test.EICAR_HDB-1",
+				"location":  "dist/eicar.com",
+				"digest":    "sha256:275a021bbfb6489e54d471899f7db9d1663fc695ec2fe2a2c4538aabf651fd0f",
+				"engine":    "clamav",
+			},
+			checkFn: func(t *testing.T, msg interface{}) {
+				t.Helper()
+				f, ok := msg.(*v1.PolicyMalwareFinding)
+				require.True(t, ok)
+				assert.Equal(t, "Win.Test.EICAR_HDB-1", f.GetSignature())
+				assert.Equal(t, "dist/eicar.com", f.GetLocation())
+				assert.Equal(t, "clamav", f.GetEngine())
+			},
+		},
+		{
+			name:        "malware finding missing required field",
+			findingType: "MALWARE",
+			raw: map[string]any{
+				"message": "Infected file found",
+				// missing signature and location
+			},
+			wantErr: "finding validation failed",
+		},
 		{
 			name:        "vulnerability finding with unknown field is accepted",
 			findingType: "VULNERABILITY",
@@ -523,6 +614,42 @@ func TestSetViolationFinding(t *testing.T) {
 				assert.Equal(t, "foo", f.GetComponentName())
 			},
 		},
+		{
+			name:        "set secret finding",
+			findingType: "SECRET",
+			finding:     &v1.PolicySecretFinding{Message: "test", RuleId: "generic-api-key", Location: "main.go"},
+			checkFn: func(t *testing.T, v *v1.PolicyEvaluation_Violation) {
+				t.Helper()
+				require.NotNil(t, v.GetSecret())
+				assert.Equal(t, "generic-api-key", v.GetSecret().GetRuleId())
+			},
+		},
+		{
+			name:        "set misconfiguration finding",
+			findingType: "MISCONFIGURATION",
+			finding:     &v1.PolicyMisconfigurationFinding{Message: "test", CheckId: "AVD-AWS-0086", Severity: "HIGH", Location: "main.tf"},
+			checkFn: func(t *testing.T, v *v1.PolicyEvaluation_Violation) {
+				t.Helper()
+				require.NotNil(t, v.GetMisconfiguration())
+				assert.Equal(t, "AVD-AWS-0086", v.GetMisconfiguration().GetCheckId())
+			},
+		},
+		{
+			name:        "set malware finding",
+			findingType: "MALWARE",
+			finding:     &v1.PolicyMalwareFinding{Message: "test", Signature: "Win.Test.EICAR_HDB-1", Location: "eicar.com"},
+			checkFn: func(t *testing.T, v *v1.PolicyEvaluation_Violation) {
+				t.Helper()
+				require.NotNil(t, v.GetMalware())
+				assert.Equal(t, "Win.Test.EICAR_HDB-1", v.GetMalware().GetSignature())
+			},
+		},
+		{
+			name:        "finding type mismatch",
+			findingType: "SECRET",
+			finding:     &v1.PolicyMalwareFinding{},
+			wantErr:     "finding is not a PolicySecretFinding",
+		},
 		{
 			name:        "unknown finding type",
 			findingType: "UNKNOWN",
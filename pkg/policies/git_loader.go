@@ -0,0 +1,570 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/pkg/cache"
+	"github.com/go-git/go-git/v6"
+	"github.com/go-git/go-git/v6/config"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/client"
+	"github.com/go-git/go-git/v6/plumbing/object"
+	githttp "github.com/go-git/go-git/v6/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v6/plumbing/transport/ssh"
+	"github.com/gofrs/flock"
+	"google.golang.org/protobuf/proto"
+)
+
+// Git references have the form of git+<transport>://host/org/repo//path/to/policy.yaml?ref=<sha|tag|branch>
+const (
+	gitHTTPSScheme = "git+https"
+	gitHTTPScheme  = "git+http"
+	gitSSHScheme   = "git+ssh"
+	gitFileScheme  = "git+file"
+)
+
+// IsGitScheme returns whether the reference points to a file in a git repository
+func IsGitScheme(ref string) bool {
+	scheme, _ := RefParts(ref)
+	switch scheme {
+	case gitHTTPSScheme, gitHTTPScheme, gitSSHScheme, gitFileScheme:
+		return true
+	}
+
+	return false
+}
+
+// GitOptions configures how policies and groups are loaded from git repositories
+type GitOptions struct {
+	// CacheDir is the directory where the repositories are cloned, the user cache directory if empty
+	CacheDir string
+	// AllowLocal enables git+file:// and git+http:// references, only meant to be used locally from the CLI
+	AllowLocal bool
+	// Token is sent as the password of git+https:// references, the credentials of the host in the netrc file are used otherwise
+	Token string
+	// SSHKeyPath is the private key used for git+ssh:// references, the ssh-agent is used otherwise
+	SSHKeyPath string
+}
+
+// GitLoader loads policies referenced with git+https://host/org/repo//path/policy.yaml?ref=<revision> URLs.
+// Scripts referenced with relative paths are resolved inside the repository, at the same revision.
+type GitLoader struct {
+	opts  *GitOptions
+	cache cache.Cache[*policyWithReference]
+}
+
+func NewGitLoader(opts *GitOptions, c cache.Cache[*policyWithReference]) *GitLoader {
+	return &GitLoader{opts: opts, cache: c}
+}
+
+func (l *GitLoader) Load(ctx context.Context, attachment *v1.PolicyAttachment) (*v1.Policy, *PolicyDescriptor, error) {
+	ref := attachment.GetRef()
+
+	if cached, ok, _ := l.cache.Get(ctx, ref); ok {
+		return cached.Policy, cached.Reference, nil
+	}
+
+	var policy v1.Policy
+	file, desc, err := loadGitResource(ctx, ref, l.opts, &policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	readScript := func(script string) ([]byte, error) {
+		return readGitFile(file.commit, path.Join(path.Dir(file.path), script))
+	}
+
+	if err := embedPolicyScripts(&policy, readScript); err != nil {
+		return nil, nil, err
+	}
+
+	cached := &policyWithReference{Policy: &policy, Reference: desc}
+	_ = l.cache.Set(ctx, ref, cached)
+
+	return cached.Policy, cached.Reference, nil
+}
+
+// GitGroupLoader loads policy groups referenced with git+https://host/org/repo//path/group.yaml?ref=<revision> URLs.
+// Policies referenced with relative paths are resolved inside the repository, at the same revision.
+type GitGroupLoader struct {
+	opts  *GitOptions
+	cache cache.Cache[*groupWithReference]
+}
+
+func NewGitGroupLoader(opts *GitOptions, c cache.Cache[*groupWithReference]) *GitGroupLoader {
+	return &GitGroupLoader{opts: opts, cache: c}
+}
+
+func (l *GitGroupLoader) Load(ctx context.Context, attachment *v1.PolicyGroupAttachment) (*v1.PolicyGroup, *PolicyDescriptor, error) {
+	ref := attachment.GetRef()
+
+	if cached, ok, _ := l.cache.Get(ctx, ref); ok {
+		return cached.Group, cached.Reference, nil
+	}
+
+	var group v1.PolicyGroup
+	file, desc, err := loadGitResource(ctx, ref, l.opts, &group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := resolveGitGroupPolicies(&group, file); err != nil {
+		return nil, nil, err
+	}
+
+	cached := &groupWithReference{Group: &group, Reference: desc}
+	_ = l.cache.Set(ctx, ref, cached)
+
+	return cached.Group, cached.Reference, nil
+}
+
+// gitRef is a parsed git policy reference
+type gitRef struct {
+	// URL of the repository, without the git+ prefix
+	repoURL string
+	// path of the file inside the repository
+	path string
+	// revision to check out, HEAD if empty
+	revision string
+}
+
+// parseGitRef parses the reference, git+file:// and git+http:// ones are rejected unless allowLocal is set
+func parseGitRef(ref string, allowLocal bool) (*gitRef, error) {
+	if !IsGitScheme(ref) {
+		return nil, fmt.Errorf("unexpected policy reference scheme: %q", ref)
+	}
+
+	if scheme, _ := RefParts(ref); !allowLocal && (scheme == gitFileScheme || scheme == gitHTTPScheme) {
+		return nil, fmt.Errorf("%s references are only supported locally from the CLI: %q", scheme, ref)
+	}
+
+	u, err := url.Parse(strings.TrimPrefix(ref, "git+"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid git policy reference %q: %w", ref, err)
+	}
+
+	repoPath, filePath, ok := strings.Cut(u.Path, "//")
+	if !ok || repoPath == "" || filePath == "" {
+		return nil, fmt.Errorf("invalid git policy reference %q: the path of the file must be separated from the repository with //", ref)
+	}
+
+	revision := u.Query().Get("ref")
+
+	u.Path = repoPath
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return &gitRef{repoURL: u.String(), path: path.Clean(filePath), revision: revision}, nil
+}
+
+// gitFile is a file read from a repository at a given commit
+type gitFile struct {
+	repoURL string
+	commit  *object.Commit
+	path    string
+}
+
+// loadGitResource reads and unmarshals the referenced file. The descriptor digest is the commit the revision resolved to,
+// so the evaluation records the exact version of the policy that was used.
+func loadGitResource(ctx context.Context, ref string, opts *GitOptions, dest proto.Message) (*gitFile, *PolicyDescriptor, error) {
+	if opts == nil {
+		opts = &GitOptions{}
+	}
+
+	r, err := parseGitRef(ref, opts.AllowLocal)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit, err := resolveGitCommit(ctx, r, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	raw, err := readGitFile(commit, r.path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := unmarshallResource(raw, r.path, "", dest); err != nil {
+		return nil, nil, fmt.Errorf("unmarshalling policy spec: %w", err)
+	}
+
+	return &gitFile{repoURL: r.repoURL, commit: commit, path: r.path}, &PolicyDescriptor{URI: ref, Digest: gitCommitDigest(commit.Hash)}, nil
+}
+
+// resolveGitGroupPolicies turns the policies of the group referenced with relative paths, i.e ./sbom.yaml or
+// file://sbom.yaml, into references to the same repository, pinned to the commit the group was read from
+func resolveGitGroupPolicies(group *v1.PolicyGroup, file *gitFile) error {
+	attachments := group.GetSpec().GetPolicies().GetAttestation()
+	for _, m := range group.GetSpec().GetPolicies().GetMaterials() {
+		attachments = append(attachments, m.GetPolicies()...)
+	}
+
+	for _, att := range attachments {
+		ref := att.GetRef()
+		rel, ok := relativeGitPath(ref)
+		if !ok {
+			continue
+		}
+
+		p := path.Join(path.Dir(file.path), rel)
+		if p == ".." || strings.HasPrefix(p, "../") {
+			return fmt.Errorf("policy %q is outside of the repository of the group", ref)
+		}
+
+		att.Policy = &v1.PolicyAttachment_Ref{Ref: fmt.Sprintf("git+%s//%s?ref=%s", file.repoURL, p, file.commit.Hash)}
+	}
+
+	return nil
+}
+
+// relativeGitPath returns the path of references to files relative to the group. References
+// without a scheme are policy names unless they start with ./ or ../
+func relativeGitPath(ref string) (string, bool) {
+	scheme, loc := RefParts(ref)
+	switch {
+	case scheme == fileScheme && !filepath.IsAbs(loc):
+		return loc, true
+	case scheme == "" && (strings.HasPrefix(ref, "./") || strings.HasPrefix(ref, "../")):
+		return ref, true
+	}
+
+	return "", false
+}
+
+func gitCommitDigest(h plumbing.Hash) string {
+	algorithm := "sha1"
+	if h.Size() == sha256.Size {
+		algorithm = "sha256"
+	}
+
+	return fmt.Sprintf("%s:%s", algorithm, h)
+}
+
+func readGitFile(commit *object.Commit, filePath string) ([]byte, error) {
+	f, err := commit.File(path.Clean(filePath))
+	if err != nil {
+		return nil, fmt.Errorf("reading %q at commit %s: %w", filePath, commit.Hash, err)
+	}
+
+	content, err := f.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("reading %q at commit %s: %w", filePath, commit.Hash, err)
+	}
+
+	return []byte(content), nil
+}
+
+// gitCloneLockRetry is how often a lock held by another process is retried
+const gitCloneLockRetry = 100 * time.Millisecond
+
+// resolveGitCommit makes sure the revision is cached on disk and returns the commit it points to.
+// Only the commit the revision points to is fetched, commits that are already available are not fetched again.
+func resolveGitCommit(ctx context.Context, r *gitRef, opts *GitOptions) (*object.Commit, error) {
+	dir, err := gitCloneDir(opts.CacheDir, r.repoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cache is shared by all the processes of the user, so the operations on each clone are serialized with a file lock
+	if err := os.MkdirAll(filepath.Dir(dir), 0o700); err != nil {
+		return nil, fmt.Errorf("creating cache directory: %w", err)
+	}
+
+	lock := flock.New(dir + ".lock")
+	if _, err := lock.TryLockContext(ctx, gitCloneLockRetry); err != nil {
+		return nil, fmt.Errorf("locking cached clone of %s: %w", r.repoURL, err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	repo, err := git.PlainOpen(dir)
+	if errors.Is(err, git.ErrRepositoryNotExists) {
+		repo, err = initGitClone(dir, r.repoURL)
+		if err != nil {
+			// do not leave a partial clone behind
+			_ = os.RemoveAll(dir)
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("opening cached clone of %s: %w", r.repoURL, err)
+	}
+
+	if isAvailableGitCommit(repo, r.revision) {
+		return repo.CommitObject(plumbing.NewHash(r.revision))
+	}
+
+	clientOpts, err := gitClientOptions(r.repoURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// branches and tags might have moved, so they are fetched every time
+	revision, err := fetchGitRevision(ctx, repo, r, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := repo.ResolveRevision(revision)
+	if err != nil {
+		return nil, fmt.Errorf("resolving revision %q of %s: %w", r.revision, r.repoURL, err)
+	}
+
+	commit, err := repo.CommitObject(*h)
+	if err != nil {
+		return nil, fmt.Errorf("loading commit %s of %s: %w", h, r.repoURL, err)
+	}
+
+	return commit, nil
+}
+
+// initGitClone creates an empty bare repository pointing to the remote one, the revisions are fetched on demand
+func initGitClone(dir, repoURL string) (*git.Repository, error) {
+	repo, err := git.PlainInit(dir, true)
+	if err != nil {
+		return nil, fmt.Errorf("initializing cached clone of %s: %w", repoURL, err)
+	}
+
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{repoURL}}); err != nil {
+		return nil, fmt.Errorf("initializing cached clone of %s: %w", repoURL, err)
+	}
+
+	return repo, nil
+}
+
+// fetchGitRevision does a shallow fetch of the commit the revision points to and returns the local revision to resolve.
+// Commit hashes are fetched directly, branches and tags are looked up in the references of the remote first.
+func fetchGitRevision(ctx context.Context, repo *git.Repository, r *gitRef, clientOpts []client.Option) (plumbing.Revision, error) {
+	var refSpec config.RefSpec
+	var revision plumbing.Revision
+
+	if plumbing.IsHash(r.revision) {
+		refSpec = config.RefSpec(fmt.Sprintf("+%s:refs/pinned/%s", r.revision, r.revision))
+		revision = plumbing.Revision(r.revision)
+	} else {
+		remote, err := repo.Remote(git.DefaultRemoteName)
+		if err != nil {
+			return "", fmt.Errorf("opening cached clone of %s: %w", r.repoURL, err)
+		}
+
+		refs, err := remote.ListContext(ctx, &git.ListOptions{ClientOptions: clientOpts})
+		if err != nil {
+			return "", fmt.Errorf("listing references of %s: %w", r.repoURL, err)
+		}
+
+		name, ok := findGitReference(refs, r.revision)
+		if !ok {
+			return "", fmt.Errorf("resolving revision %q of %s: %w", r.revision, r.repoURL, plumbing.ErrReferenceNotFound)
+		}
+
+		// the remote HEAD is kept apart so it doesn't replace the HEAD of the clone
+		local := name
+		if name == plumbing.HEAD {
+			local = plumbing.NewRemoteHEADReferenceName(git.DefaultRemoteName)
+		}
+
+		refSpec = config.RefSpec(fmt.Sprintf("+%s:%s", name, local))
+		revision = plumbing.Revision(local)
+	}
+
+	err := repo.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs:      []config.RefSpec{refSpec},
+		Depth:         1,
+		Tags:          git.NoTags,
+		Force:         true,
+		ClientOptions: clientOpts,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return "", fmt.Errorf("fetching %s: %w", r.repoURL, err)
+	}
+
+	return revision, nil
+}
+
+// findGitReference returns the name of the remote reference the revision points to, HEAD if empty.
+// Like git, tags take precedence over branches with the same name.
+func findGitReference(refs []*plumbing.Reference, revision string) (plumbing.ReferenceName, bool) {
+	candidates := []plumbing.ReferenceName{plumbing.HEAD}
+	if revision != "" {
+		candidates = []plumbing.ReferenceName{
+			plumbing.ReferenceName(revision),
+			plumbing.NewTagReferenceName(revision),
+			plumbing.NewBranchReferenceName(revision),
+		}
+	}
+
+	for _, c := range candidates {
+		for _, ref := range refs {
+			if ref.Name() == c {
+				return c, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// gitClientOptions returns the credentials used to fetch from the repository. The host of git+https:// references
+// is authenticated with the token if set, or else with the credentials found in the netrc file, like git does.
+func gitClientOptions(repoURL string, opts *GitOptions) ([]client.Option, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL %q: %w", repoURL, err)
+	}
+
+	switch u.Scheme {
+	case "https":
+		if opts.Token != "" {
+			return []client.Option{client.WithHTTPAuth(&githttp.BasicAuth{Username: gitTokenUsername, Password: opts.Token})}, nil
+		}
+
+		if login, password, ok := netrcCredentials(u.Hostname()); ok {
+			return []client.Option{client.WithHTTPAuth(&githttp.BasicAuth{Username: login, Password: password})}, nil
+		}
+	case "ssh":
+		if opts.SSHKeyPath == "" {
+			// go-git falls back to the ssh-agent
+			return nil, nil
+		}
+
+		user := gitssh.DefaultUsername
+		if u.User != nil && u.User.Username() != "" {
+			user = u.User.Username()
+		}
+
+		auth, err := gitssh.NewPublicKeysFromFile(user, opts.SSHKeyPath, "")
+		if err != nil {
+			return nil, fmt.Errorf("loading SSH key: %w", err)
+		}
+
+		return []client.Option{client.WithSSHAuth(auth)}, nil
+	}
+
+	return nil, nil
+}
+
+// gitTokenUsername is sent along with the token, the git hosting services only check the password
+const gitTokenUsername = "x-access-token"
+
+// netrcCredentials returns the login and password of the host in the netrc file, $NETRC or ~/.netrc by default.
+// The default entry is used if there's none for the host.
+func netrcCredentials(host string) (string, string, bool) {
+	path := os.Getenv("NETRC")
+	if path == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", "", false
+		}
+
+		path = filepath.Join(home, ".netrc")
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", false
+	}
+
+	return parseNetrc(string(raw), host)
+}
+
+// parseNetrc looks for the credentials of the host, macros are not supported
+func parseNetrc(content, host string) (string, string, bool) {
+	type entry struct{ login, password string }
+
+	var found, fallback *entry
+	var current *entry
+
+	fields := strings.Fields(content)
+	for i := 0; i < len(fields); i++ {
+		value := func() string {
+			if i+1 < len(fields) {
+				i++
+				return fields[i]
+			}
+
+			return ""
+		}
+
+		switch fields[i] {
+		case "machine":
+			current = nil
+			if value() == host && found == nil {
+				found = &entry{}
+				current = found
+			}
+		case "default":
+			current = nil
+			if fallback == nil {
+				fallback = &entry{}
+				current = fallback
+			}
+		case "login":
+			if v := value(); current != nil {
+				current.login = v
+			}
+		case "password":
+			if v := value(); current != nil {
+				current.password = v
+			}
+		}
+	}
+
+	if found == nil {
+		found = fallback
+	}
+
+	if found == nil || found.password == "" {
+		return "", "", false
+	}
+
+	return found.login, found.password, true
+}
+
+// isAvailableGitCommit returns whether the revision is a full commit hash already present in the repository, since those are immutable
+func isAvailableGitCommit(repo *git.Repository, revision string) bool {
+	if !plumbing.IsHash(revision) {
+		return false
+	}
+
+	_, err := repo.CommitObject(plumbing.NewHash(revision))
+	return err == nil
+}
+
+// gitCloneDir returns the directory where a repository is cached
+func gitCloneDir(cacheDir, repoURL string) (string, error) {
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return "", fmt.Errorf("finding cache directory: %w", err)
+		}
+
+		cacheDir = filepath.Join(userCacheDir, "chainloop", "policies", "git")
+	}
+
+	return filepath.Join(cacheDir, fmt.Sprintf("%x", sha256.Sum256([]byte(repoURL)))), nil
+}
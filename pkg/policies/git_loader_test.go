@@ -0,0 +1,332 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/pkg/cache"
+	"github.com/go-git/go-git/v6"
+	"github.com/go-git/go-git/v6/plumbing"
+	"github.com/go-git/go-git/v6/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitRef(t *testing.T) {
+	testCases := []struct {
+		name string
+		ref  string
+		// parsed as outside of the CLI
+		remote  bool
+		want    *gitRef
+		wantErr bool
+	}{
+		{
+			name: "https with a commit",
+			ref:  "git+https://github.com/org/repo//policies/sbom.yaml?ref=4b825dc642cb6eb9a060e54bf8d69288fbee4904",
+			want: &gitRef{repoURL: "https://github.com/org/repo", path: "policies/sbom.yaml", revision: "4b825dc642cb6eb9a060e54bf8d69288fbee4904"},
+		},
+		{
+			name: "ssh with a tag",
+			ref:  "git+ssh://git@github.com/org/repo.git//sbom.yaml?ref=v1.0.0",
+			want: &gitRef{repoURL: "ssh://git@github.com/org/repo.git", path: "sbom.yaml", revision: "v1.0.0"},
+		},
+		{
+			name: "no revision",
+			ref:  "git+file:///tmp/repo//policies/../sbom.yaml",
+			want: &gitRef{repoURL: "file:///tmp/repo", path: "sbom.yaml"},
+		},
+		{
+			name:   "https outside of the CLI",
+			ref:    "git+https://github.com/org/repo//sbom.yaml",
+			remote: true,
+			want:   &gitRef{repoURL: "https://github.com/org/repo", path: "sbom.yaml"},
+		},
+		{
+			name:    "local repository outside of the CLI",
+			ref:     "git+file:///tmp/repo//sbom.yaml",
+			remote:  true,
+			wantErr: true,
+		},
+		{
+			name:    "plain http outside of the CLI",
+			ref:     "git+http://github.com/org/repo//sbom.yaml",
+			remote:  true,
+			wantErr: true,
+		},
+		{
+			name:    "missing path separator",
+			ref:     "git+https://github.com/org/repo/sbom.yaml",
+			wantErr: true,
+		},
+		{
+			name:    "not a git reference",
+			ref:     "https://github.com/org/repo//sbom.yaml",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseGitRef(tc.ref, !tc.remote)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+type gitTestRepo struct {
+	t    *testing.T
+	dir  string
+	repo *git.Repository
+}
+
+func newGitTestRepo(t *testing.T) *gitTestRepo {
+	t.Helper()
+
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	return &gitTestRepo{t: t, dir: dir, repo: repo}
+}
+
+// commit writes the files and commits them, returning the commit hash
+func (r *gitTestRepo) commit(files map[string]string) plumbing.Hash {
+	r.t.Helper()
+
+	wt, err := r.repo.Worktree()
+	require.NoError(r.t, err)
+
+	for name, content := range files {
+		p := filepath.Join(r.dir, name)
+		require.NoError(r.t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(r.t, os.WriteFile(p, []byte(content), 0o600))
+		_, err := wt.Add(name)
+		require.NoError(r.t, err)
+	}
+
+	h, err := wt.Commit("update policies", &git.CommitOptions{
+		Author: &object.Signature{Name: "chainloop", Email: "chainloop@example.com", When: time.Now()},
+	})
+	require.NoError(r.t, err)
+
+	return h
+}
+
+func (r *gitTestRepo) ref(file, revision string) string {
+	ref := fmt.Sprintf("git+file://%s//%s", r.dir, file)
+	if revision != "" {
+		ref = fmt.Sprintf("%s?ref=%s", ref, revision)
+	}
+
+	return ref
+}
+
+func TestGitLoader(t *testing.T) {
+	policy, err := os.ReadFile("testdata/sbom_syft.yaml")
+	require.NoError(t, err)
+
+	repo := newGitTestRepo(t)
+	first := repo.commit(map[string]string{
+		"policies/sbom_syft.yaml": string(policy),
+		"policies/sbom_syft.rego": "package main\n\n# first revision\n",
+	})
+	_, err = repo.repo.CreateTag("v1", first, nil)
+	require.NoError(t, err)
+
+	second := repo.commit(map[string]string{"policies/sbom_syft.rego": "package main\n\n# second revision\n"})
+	require.NoError(t, repo.repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("unused"), first)))
+
+	testCases := []struct {
+		name       string
+		ref        string
+		wantCommit plumbing.Hash
+		wantScript string
+		wantErr    string
+	}{
+		{
+			name:       "pinned to a commit",
+			ref:        repo.ref("policies/sbom_syft.yaml", first.String()),
+			wantCommit: first,
+			wantScript: "first revision",
+		},
+		{
+			name:       "pinned to a tag",
+			ref:        repo.ref("policies/sbom_syft.yaml", "v1"),
+			wantCommit: first,
+			wantScript: "first revision",
+		},
+		{
+			name:       "no revision uses HEAD",
+			ref:        repo.ref("policies/sbom_syft.yaml", ""),
+			wantCommit: second,
+			wantScript: "second revision",
+		},
+		{
+			name:    "unknown revision",
+			ref:     repo.ref("policies/sbom_syft.yaml", "v2"),
+			wantErr: "resolving revision",
+		},
+		{
+			name:    "missing file",
+			ref:     repo.ref("policies/missing.yaml", ""),
+			wantErr: "reading \"policies/missing.yaml\"",
+		},
+	}
+
+	// all the cases share the clone
+	cacheDir := t.TempDir()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loader := NewGitLoader(&GitOptions{CacheDir: cacheDir, AllowLocal: true}, newTestPolicyCache(t))
+			got, desc, err := loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: tc.ref}})
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "made-with-syft", got.GetMetadata().GetName())
+			assert.Contains(t, got.GetSpec().GetEmbedded(), tc.wantScript)
+			assert.Equal(t, tc.ref, desc.GetURI())
+			assert.Equal(t, "sha1:"+tc.wantCommit.String(), desc.GetDigest())
+		})
+	}
+
+	t.Run("only the revisions that are used are fetched", func(t *testing.T) {
+		dir, err := gitCloneDir(cacheDir, "file://"+repo.dir)
+		require.NoError(t, err)
+
+		clone, err := git.PlainOpen(dir)
+		require.NoError(t, err)
+
+		shallow, err := clone.Storer.Shallow()
+		require.NoError(t, err)
+		assert.NotEmpty(t, shallow)
+
+		_, err = clone.Reference(plumbing.NewBranchReferenceName("unused"), false)
+		assert.ErrorIs(t, err, plumbing.ErrReferenceNotFound)
+	})
+
+	t.Run("new revisions are fetched into the cached clone", func(t *testing.T) {
+		third := repo.commit(map[string]string{"policies/sbom_syft.rego": "package main\n\n# third revision\n"})
+
+		loader := NewGitLoader(&GitOptions{CacheDir: cacheDir, AllowLocal: true}, newTestPolicyCache(t))
+		got, desc, err := loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: repo.ref("policies/sbom_syft.yaml", third.String())}})
+		require.NoError(t, err)
+		assert.Contains(t, got.GetSpec().GetEmbedded(), "third revision")
+		assert.Equal(t, "sha1:"+third.String(), desc.GetDigest())
+	})
+}
+
+func TestParseNetrc(t *testing.T) {
+	const netrc = `
+machine github.com
+  login octocat
+  password gh-token
+
+machine gitlab.com login user password gl-token
+
+default login anonymous password default-token
+`
+
+	testCases := []struct {
+		name         string
+		content      string
+		host         string
+		wantLogin    string
+		wantPassword string
+		wantFound    bool
+	}{
+		{name: "multiline entry", content: netrc, host: "github.com", wantLogin: "octocat", wantPassword: "gh-token", wantFound: true},
+		{name: "single line entry", content: netrc, host: "gitlab.com", wantLogin: "user", wantPassword: "gl-token", wantFound: true},
+		{name: "default entry", content: netrc, host: "example.com", wantLogin: "anonymous", wantPassword: "default-token", wantFound: true},
+		{name: "no entry", content: "machine github.com login octocat password gh-token", host: "example.com"},
+		{name: "no password", content: "machine github.com login octocat", host: "github.com"},
+		{name: "empty", host: "github.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			login, password, found := parseNetrc(tc.content, tc.host)
+			assert.Equal(t, tc.wantFound, found)
+			assert.Equal(t, tc.wantLogin, login)
+			assert.Equal(t, tc.wantPassword, password)
+		})
+	}
+}
+
+func TestGitGroupLoader(t *testing.T) {
+	group, err := os.ReadFile("testdata/policy_group.yaml")
+	require.NoError(t, err)
+
+	repo := newGitTestRepo(t)
+	commit := repo.commit(map[string]string{"groups/sbom-quality.yaml": string(group)})
+
+	c, err := cache.New[*groupWithReference](cache.WithTTL(defaultPolicyCacheTTL))
+	require.NoError(t, err)
+
+	ref := repo.ref("groups/sbom-quality.yaml", commit.String())
+	got, desc, err := NewGitGroupLoader(&GitOptions{CacheDir: t.TempDir(), AllowLocal: true}, c).Load(context.Background(), &v1.PolicyGroupAttachment{Ref: ref})
+	require.NoError(t, err)
+	assert.Equal(t, "sbom-quality", got.GetMetadata().GetName())
+	assert.Equal(t, "sha1:"+commit.String(), desc.GetDigest())
+
+	cached, ok, err := c.Get(context.Background(), ref)
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "sbom-quality", cached.Group.GetMetadata().GetName())
+
+	t.Run("relative policies are resolved in the repository", func(t *testing.T) {
+		content := strings.NewReplacer(
+			"file://testdata/with_arguments.yaml", "../policies/with_arguments.yaml",
+			"file://testdata/multi-kind.yaml", "file://multi-kind.yaml",
+		).Replace(string(group))
+		commit := repo.commit(map[string]string{"groups/relative.yaml": content})
+
+		got, _, err := NewGitGroupLoader(&GitOptions{CacheDir: t.TempDir(), AllowLocal: true}, c).Load(context.Background(), &v1.PolicyGroupAttachment{Ref: repo.ref("groups/relative.yaml", commit.String())})
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("git+file://%s//policies/with_arguments.yaml?ref=%s", repo.dir, commit), got.GetSpec().GetPolicies().GetAttestation()[0].GetRef())
+		assert.Equal(t, fmt.Sprintf("git+file://%s//groups/multi-kind.yaml?ref=%s", repo.dir, commit), got.GetSpec().GetPolicies().GetMaterials()[0].GetPolicies()[0].GetRef())
+	})
+
+	t.Run("relative policies outside of the repository", func(t *testing.T) {
+		content := strings.ReplaceAll(string(group), "file://testdata/with_arguments.yaml", "../../with_arguments.yaml")
+		commit := repo.commit(map[string]string{"groups/outside.yaml": content})
+
+		_, _, err := NewGitGroupLoader(&GitOptions{CacheDir: t.TempDir(), AllowLocal: true}, c).Load(context.Background(), &v1.PolicyGroupAttachment{Ref: repo.ref("groups/outside.yaml", commit.String())})
+		assert.ErrorContains(t, err, "outside of the repository")
+	})
+
+	t.Run("local repositories outside of the CLI", func(t *testing.T) {
+		_, _, err := NewGitGroupLoader(&GitOptions{CacheDir: t.TempDir()}, c).Load(context.Background(), &v1.PolicyGroupAttachment{Ref: repo.ref("groups/sbom-quality.yaml", "")})
+		assert.ErrorContains(t, err, "only supported locally from the CLI")
+	})
+}
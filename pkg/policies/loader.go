@@ -18,6 +18,7 @@ package policies
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -31,6 +32,7 @@ import (
 	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
 	"github.com/chainloop-dev/chainloop/app/controlplane/pkg/unmarshal"
 	"github.com/chainloop-dev/chainloop/pkg/cache"
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine"
 	crv1 "github.com/google/go-containerregistry/pkg/v1"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -363,3 +365,62 @@ func ExtractDigest(ref string) (string, string) {
 
 	return parts[0], ""
 }
+
+// embedPolicyScripts inlines the scripts referenced with relative paths by the policy, read with the
+// provided function. Used by loaders whose references can't be used as a base path to resolve them later.
+func embedPolicyScripts(policy *v1.Policy, read func(ref string) ([]byte, error)) error {
+	spec := policy.GetSpec()
+	if src, ok := spec.GetSource().(*v1.PolicySpec_Path); ok && !hasScheme(src.Path) {
+		content, err := readEmbeddableScript(read, src.Path)
+		if err != nil {
+			return err
+		}
+
+		spec.Source = &v1.PolicySpec_Embedded{Embedded: content}
+	}
+
+	for _, s := range spec.GetPolicies() {
+		var ref string
+		switch src := s.GetSource().(type) {
+		case *v1.PolicySpecV2_Ref:
+			ref = src.Ref
+		case *v1.PolicySpecV2_Path:
+			ref = src.Path
+		default:
+			continue
+		}
+
+		// absolute references are loaded as usual
+		if hasScheme(ref) {
+			continue
+		}
+
+		content, err := readEmbeddableScript(read, ref)
+		if err != nil {
+			return err
+		}
+
+		s.Source = &v1.PolicySpecV2_Embedded{Embedded: content}
+	}
+
+	return nil
+}
+
+func readEmbeddableScript(read func(ref string) ([]byte, error), ref string) (string, error) {
+	content, err := read(ref)
+	if err != nil {
+		return "", err
+	}
+
+	// WASM modules are embedded base64 encoded, see decodeIfBase64Wasm
+	if engine.DetectPolicyType(content) == engine.PolicyTypeWASM {
+		return base64.StdEncoding.EncodeToString(content), nil
+	}
+
+	return string(content), nil
+}
+
+func hasScheme(ref string) bool {
+	scheme, _ := RefParts(ref)
+	return scheme != ""
+}
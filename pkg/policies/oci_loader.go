@@ -0,0 +1,411 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/pkg/cache"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	crv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"google.golang.org/protobuf/proto"
+)
+
+const ociScheme = "oci"
+
+const (
+	// PolicyMediaType is the media type of the layer holding the policy document in an OCI artifact
+	PolicyMediaType = "application/vnd.chainloop.policy.v1+yaml"
+	// PolicyGroupMediaType is the media type of the layer holding the policy group document in an OCI artifact
+	PolicyGroupMediaType = "application/vnd.chainloop.policy-group.v1+yaml"
+
+	// layers are matched against the script references by their title, as set by oras
+	ociTitleAnnotation = "org.opencontainers.image.title"
+	// annotation holding the signature of the layers of a cosign signature manifest
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// maximum size of each of the layers of a policy artifact
+	maxOCILayerSize = 16 << 20
+)
+
+// OCIOptions configures how policies and groups are pulled from OCI registries
+type OCIOptions struct {
+	// Keychain used to authenticate with the registry, the docker config is used as fallback
+	Keychain authn.Keychain
+	// Verifiers enable signature verification. When set, the artifact must carry
+	// a cosign signature made by any of them.
+	Verifiers []signature.Verifier
+}
+
+// LoadOCIVerifiers loads the PEM encoded public keys used to verify the signatures of policy artifacts
+func LoadOCIVerifiers(keyPaths ...string) ([]signature.Verifier, error) {
+	verifiers := make([]signature.Verifier, 0, len(keyPaths))
+	for _, p := range keyPaths {
+		raw, err := os.ReadFile(filepath.Clean(p))
+		if err != nil {
+			return nil, fmt.Errorf("reading public key: %w", err)
+		}
+
+		pk, err := cryptoutils.UnmarshalPEMToPublicKey(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public key %q: %w", p, err)
+		}
+
+		v, err := signature.LoadVerifier(pk, crypto.SHA256)
+		if err != nil {
+			return nil, fmt.Errorf("loading verifier for %q: %w", p, err)
+		}
+
+		verifiers = append(verifiers, v)
+	}
+
+	return verifiers, nil
+}
+
+// OCILoader loads policies referenced with oci://registry/repo:tag[@sha256:digest] URLs
+type OCILoader struct {
+	opts  *OCIOptions
+	cache cache.Cache[*policyWithReference]
+}
+
+func NewOCILoader(opts *OCIOptions, c cache.Cache[*policyWithReference]) *OCILoader {
+	return &OCILoader{opts: opts, cache: c}
+}
+
+func (l *OCILoader) Load(ctx context.Context, attachment *v1.PolicyAttachment) (*v1.Policy, *PolicyDescriptor, error) {
+	ref := attachment.GetRef()
+
+	if cached, ok, _ := l.cache.Get(ctx, ref); ok {
+		if err := verifyCachedOCIArtifact(ctx, ref, cached.Reference, l.opts); err != nil {
+			return nil, nil, err
+		}
+
+		return cached.Policy, cached.Reference, nil
+	}
+
+	artifact, err := pullOCIArtifact(ctx, ref, PolicyMediaType, l.opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var policy v1.Policy
+	if err := artifact.unmarshal(&policy); err != nil {
+		return nil, nil, err
+	}
+
+	if err := embedPolicyScripts(&policy, ociScriptReader(artifact.files)); err != nil {
+		return nil, nil, err
+	}
+
+	cached := &policyWithReference{Policy: &policy, Reference: artifact.descriptor()}
+	_ = l.cache.Set(ctx, ref, cached)
+
+	return cached.Policy, cached.Reference, nil
+}
+
+// OCIGroupLoader loads policy groups referenced with oci://registry/repo:tag[@sha256:digest] URLs
+type OCIGroupLoader struct {
+	opts  *OCIOptions
+	cache cache.Cache[*groupWithReference]
+}
+
+func NewOCIGroupLoader(opts *OCIOptions, c cache.Cache[*groupWithReference]) *OCIGroupLoader {
+	return &OCIGroupLoader{opts: opts, cache: c}
+}
+
+func (l *OCIGroupLoader) Load(ctx context.Context, attachment *v1.PolicyGroupAttachment) (*v1.PolicyGroup, *PolicyDescriptor, error) {
+	ref := attachment.GetRef()
+
+	if cached, ok, _ := l.cache.Get(ctx, ref); ok {
+		if err := verifyCachedOCIArtifact(ctx, ref, cached.Reference, l.opts); err != nil {
+			return nil, nil, err
+		}
+
+		return cached.Group, cached.Reference, nil
+	}
+
+	artifact, err := pullOCIArtifact(ctx, ref, PolicyGroupMediaType, l.opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var group v1.PolicyGroup
+	if err := artifact.unmarshal(&group); err != nil {
+		return nil, nil, err
+	}
+
+	cached := &groupWithReference{Group: &group, Reference: artifact.descriptor()}
+	_ = l.cache.Set(ctx, ref, cached)
+
+	return cached.Group, cached.Reference, nil
+}
+
+// ociArtifact is a policy or group pulled from a registry
+type ociArtifact struct {
+	// reference without the oci:// scheme and digest
+	ref string
+	// manifest digest
+	digest crv1.Hash
+	// title and content of the layer holding the document
+	title string
+	raw   []byte
+	// content of the rest of the layers, by title
+	files map[string][]byte
+}
+
+func (a *ociArtifact) unmarshal(dest proto.Message) error {
+	if _, err := unmarshallResource(a.raw, a.title, "", dest); err != nil {
+		return fmt.Errorf("unmarshalling policy spec: %w", err)
+	}
+
+	return nil
+}
+
+// descriptor references the artifact by its manifest digest, so the reference can be pinned with it
+func (a *ociArtifact) descriptor() *PolicyDescriptor {
+	return policyReferenceResourceDescriptor("", fmt.Sprintf("%s://%s", ociScheme, a.ref), "", a.digest)
+}
+
+// parseOCIReference returns the reference without the oci:// scheme, parsed, and the options to reach its registry
+func parseOCIReference(ctx context.Context, ref string, opts *OCIOptions) (string, name.Reference, []remote.Option, error) {
+	id, err := ensureScheme(ref, ociScheme)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	// a digest takes precedence over the tag, so pinned references are immutable
+	var nameRef name.Reference
+	if strings.Contains(id, "@") {
+		nameRef, err = name.NewDigest(id)
+	} else {
+		nameRef, err = name.ParseReference(id)
+	}
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("invalid OCI reference %q: %w", ref, err)
+	}
+
+	var keychain authn.Keychain = authn.DefaultKeychain
+	if opts != nil && opts.Keychain != nil {
+		keychain = authn.NewMultiKeychain(opts.Keychain, authn.DefaultKeychain)
+	}
+
+	return id, nameRef, []remote.Option{remote.WithContext(ctx), remote.WithAuthFromKeychain(keychain)}, nil
+}
+
+// verifyCachedOCIArtifact verifies the signature of an artifact served from the cache by the digest it was
+// pulled with, since the cache might have been filled by a loader that doesn't verify signatures
+func verifyCachedOCIArtifact(ctx context.Context, ref string, desc *PolicyDescriptor, opts *OCIOptions) error {
+	if opts == nil || len(opts.Verifiers) == 0 {
+		return nil
+	}
+
+	_, nameRef, remoteOpts, err := parseOCIReference(ctx, ref, opts)
+	if err != nil {
+		return err
+	}
+
+	if desc.GetDigest() == "" {
+		return fmt.Errorf("verifying signature of %q: missing digest", ref)
+	}
+
+	if err := verifyOCISignature(nameRef.Context().Digest(desc.GetDigest()), remoteOpts, opts.Verifiers); err != nil {
+		return fmt.Errorf("verifying signature of %q: %w", ref, err)
+	}
+
+	return nil
+}
+
+func pullOCIArtifact(ctx context.Context, ref, mediaType string, opts *OCIOptions) (*ociArtifact, error) {
+	id, nameRef, remoteOpts, err := parseOCIReference(ctx, ref, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	desc, err := remote.Get(nameRef, remoteOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("fetching OCI artifact %q: %w", ref, err)
+	}
+
+	if opts != nil && len(opts.Verifiers) > 0 {
+		if err := verifyOCISignature(nameRef.Context().Digest(desc.Digest.String()), remoteOpts, opts.Verifiers); err != nil {
+			return nil, fmt.Errorf("verifying signature of %q: %w", ref, err)
+		}
+	}
+
+	img, err := desc.Image()
+	if err != nil {
+		return nil, fmt.Errorf("reading OCI artifact %q: %w", ref, err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("reading OCI manifest %q: %w", ref, err)
+	}
+
+	artifact := &ociArtifact{ref: strings.SplitN(id, "@", 2)[0], digest: desc.Digest, files: make(map[string][]byte)}
+
+	var candidates []string
+	for _, l := range manifest.Layers {
+		content, err := readOCILayer(img, l.Digest)
+		if err != nil {
+			return nil, err
+		}
+
+		title := l.Annotations[ociTitleAnnotation]
+		if string(l.MediaType) == mediaType && artifact.raw == nil {
+			artifact.raw, artifact.title = content, title
+			continue
+		}
+
+		if title == "" {
+			continue
+		}
+
+		artifact.files[path.Clean(title)] = content
+		if isResourceDocument(title) {
+			candidates = append(candidates, path.Clean(title))
+		}
+	}
+
+	// artifacts pushed without the specific media type are accepted if they hold a single document
+	if artifact.raw == nil {
+		if len(candidates) != 1 {
+			return nil, fmt.Errorf("OCI artifact %q must contain a single %s layer", ref, mediaType)
+		}
+
+		artifact.title = candidates[0]
+		artifact.raw = artifact.files[artifact.title]
+	}
+
+	// the document format is guessed from the title, YAML is assumed otherwise
+	if !isResourceDocument(artifact.title) {
+		artifact.title = "policy.yaml"
+	}
+
+	return artifact, nil
+}
+
+func readOCILayer(img crv1.Image, digest crv1.Hash) ([]byte, error) {
+	layer, err := img.LayerByDigest(digest)
+	if err != nil {
+		return nil, fmt.Errorf("reading layer %s: %w", digest, err)
+	}
+
+	// layers are stored as plain files
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, fmt.Errorf("reading layer %s: %w", digest, err)
+	}
+	defer rc.Close()
+
+	content, err := io.ReadAll(io.LimitReader(rc, maxOCILayerSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("reading layer %s: %w", digest, err)
+	}
+
+	if len(content) > maxOCILayerSize {
+		return nil, fmt.Errorf("layer %s exceeds the maximum size of %d bytes", digest, maxOCILayerSize)
+	}
+
+	return content, nil
+}
+
+func isResourceDocument(title string) bool {
+	switch strings.ToLower(filepath.Ext(title)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+
+	return false
+}
+
+// ociScriptReader reads the scripts referenced by a policy from the rest of the artifact layers
+func ociScriptReader(files map[string][]byte) func(string) ([]byte, error) {
+	return func(ref string) ([]byte, error) {
+		content, ok := files[path.Clean(ref)]
+		if !ok {
+			return nil, fmt.Errorf("policy script %q not found in the OCI artifact", ref)
+		}
+
+		return content, nil
+	}
+}
+
+// verifyOCISignature looks for a cosign signature of the artifact, stored in the sha256-<hex>.sig tag,
+// made by any of the provided verifiers and covering the artifact digest.
+func verifyOCISignature(ref name.Digest, remoteOpts []remote.Option, verifiers []signature.Verifier) error {
+	h, err := crv1.NewHash(ref.DigestStr())
+	if err != nil {
+		return fmt.Errorf("parsing digest: %w", err)
+	}
+
+	sigRef := ref.Context().Tag(fmt.Sprintf("%s-%s.sig", h.Algorithm, h.Hex))
+	img, err := remote.Image(sigRef, remoteOpts...)
+	if err != nil {
+		return fmt.Errorf("fetching signature %q: %w", sigRef, err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return fmt.Errorf("reading signature manifest: %w", err)
+	}
+
+	for _, l := range manifest.Layers {
+		sig, err := base64.StdEncoding.DecodeString(l.Annotations[cosignSignatureAnnotation])
+		if err != nil || len(sig) == 0 {
+			continue
+		}
+
+		content, err := readOCILayer(img, l.Digest)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range verifiers {
+			if err := v.VerifySignature(bytes.NewReader(sig), bytes.NewReader(content)); err != nil {
+				continue
+			}
+
+			// the signed payload must be about this artifact
+			var p payload.SimpleContainerImage
+			if err := json.Unmarshal(content, &p); err != nil {
+				return fmt.Errorf("parsing signature payload: %w", err)
+			}
+
+			if p.Critical.Image.DockerManifestDigest == ref.DigestStr() {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("no valid signature found")
+}
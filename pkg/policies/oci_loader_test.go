@@ -0,0 +1,301 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policies
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
+	"github.com/chainloop-dev/chainloop/pkg/cache"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	crv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type ociTestLayer struct {
+	title, mediaType, content string
+}
+
+// pushOCITestArtifact pushes an artifact made of the given layers and returns its digest
+func pushOCITestArtifact(t *testing.T, ref string, layers ...ociTestLayer) crv1.Hash {
+	t.Helper()
+
+	img := mutate.MediaType(empty.Image, types.OCIManifestSchema1)
+	for _, l := range layers {
+		var err error
+		img, err = mutate.Append(img, mutate.Addendum{
+			Layer:       static.NewLayer([]byte(l.content), types.MediaType(l.mediaType)),
+			Annotations: map[string]string{ociTitleAnnotation: l.title},
+		})
+		require.NoError(t, err)
+	}
+
+	r, err := name.ParseReference(ref)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(r, img))
+
+	digest, err := img.Digest()
+	require.NoError(t, err)
+
+	return digest
+}
+
+// signOCITestArtifact stores a cosign-like signature of the given digest
+func signOCITestArtifact(t *testing.T, repo string, digest crv1.Hash, signer signature.Signer) {
+	t.Helper()
+
+	d, err := name.NewDigest(fmt.Sprintf("%s@%s", repo, digest))
+	require.NoError(t, err)
+
+	p, err := payload.Cosign{Image: d}.MarshalJSON()
+	require.NoError(t, err)
+
+	sig, err := signer.SignMessage(bytes.NewReader(p))
+	require.NoError(t, err)
+
+	img, err := mutate.Append(mutate.MediaType(empty.Image, types.OCIManifestSchema1), mutate.Addendum{
+		Layer:       static.NewLayer(p, types.MediaType("application/vnd.dev.cosign.simplesigning.v1+json")),
+		Annotations: map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+	})
+	require.NoError(t, err)
+
+	tag, err := name.NewTag(fmt.Sprintf("%s:%s-%s.sig", repo, digest.Algorithm, digest.Hex))
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+}
+
+func newOCITestRegistry(t *testing.T) string {
+	t.Helper()
+
+	srv := httptest.NewServer(registry.New())
+	t.Cleanup(srv.Close)
+
+	return strings.TrimPrefix(srv.URL, "http://")
+}
+
+func newTestPolicyCache(t *testing.T) cache.Cache[*policyWithReference] {
+	t.Helper()
+
+	c, err := cache.New[*policyWithReference](cache.WithTTL(defaultPolicyCacheTTL))
+	require.NoError(t, err)
+
+	return c
+}
+
+func ociTestPolicyLayers(t *testing.T) []ociTestLayer {
+	t.Helper()
+
+	policy, err := os.ReadFile("testdata/sbom_syft.yaml")
+	require.NoError(t, err)
+	script, err := os.ReadFile("testdata/sbom_syft.rego")
+	require.NoError(t, err)
+
+	return []ociTestLayer{
+		{title: "sbom_syft.yaml", mediaType: PolicyMediaType, content: string(policy)},
+		{title: "sbom_syft.rego", mediaType: "application/vnd.chainloop.policy.rego", content: string(script)},
+	}
+}
+
+func TestOCILoader(t *testing.T) {
+	host := newOCITestRegistry(t)
+	repo := host + "/policies/made-with-syft"
+	digest := pushOCITestArtifact(t, repo+":v1", ociTestPolicyLayers(t)...)
+
+	t.Run("by tag", func(t *testing.T) {
+		loader := NewOCILoader(&OCIOptions{}, newTestPolicyCache(t))
+		policy, desc, err := loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + repo + ":v1"}})
+		require.NoError(t, err)
+
+		assert.Equal(t, "made-with-syft", policy.GetMetadata().GetName())
+		// the script is embedded since it can't be resolved relative to the registry
+		require.Len(t, policy.GetSpec().GetPolicies(), 0)
+		assert.Contains(t, policy.GetSpec().GetEmbedded(), "package main")
+		assert.Equal(t, "oci://"+repo+":v1", desc.GetURI())
+		assert.Equal(t, digest.String(), desc.GetDigest())
+	})
+
+	t.Run("pinned to a digest", func(t *testing.T) {
+		loader := NewOCILoader(&OCIOptions{}, newTestPolicyCache(t))
+		_, desc, err := loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: fmt.Sprintf("oci://%s:v1@%s", repo, digest)}})
+		require.NoError(t, err)
+		assert.Equal(t, "oci://"+repo+":v1", desc.GetURI())
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		loader := NewOCILoader(&OCIOptions{}, newTestPolicyCache(t))
+		_, _, err := loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + repo + ":v1@sha256:" + strings.Repeat("0", 64)}})
+		assert.Error(t, err)
+	})
+
+	t.Run("results are cached", func(t *testing.T) {
+		c := newTestPolicyCache(t)
+		ref := "oci://" + repo + ":v1"
+		_, _, err := NewOCILoader(&OCIOptions{}, c).Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: ref}})
+		require.NoError(t, err)
+
+		cached, ok, err := c.Get(context.Background(), ref)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "made-with-syft", cached.Policy.GetMetadata().GetName())
+	})
+
+	t.Run("missing script", func(t *testing.T) {
+		layers := ociTestPolicyLayers(t)
+		pushOCITestArtifact(t, repo+":no-script", layers[0])
+
+		loader := NewOCILoader(&OCIOptions{}, newTestPolicyCache(t))
+		_, _, err := loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + repo + ":no-script"}})
+		assert.ErrorContains(t, err, "sbom_syft.rego")
+	})
+}
+
+func TestOCIGroupLoader(t *testing.T) {
+	host := newOCITestRegistry(t)
+	repo := host + "/groups/sbom-quality"
+
+	group, err := os.ReadFile("testdata/policy_group.yaml")
+	require.NoError(t, err)
+	// no specific media type, the only YAML layer is used
+	pushOCITestArtifact(t, repo+":v1", ociTestLayer{title: "policy_group.yaml", mediaType: "application/yaml", content: string(group)})
+
+	c, err := cache.New[*groupWithReference](cache.WithTTL(defaultPolicyCacheTTL))
+	require.NoError(t, err)
+
+	loader := NewOCIGroupLoader(&OCIOptions{}, c)
+	got, desc, err := loader.Load(context.Background(), &v1.PolicyGroupAttachment{Ref: "oci://" + repo + ":v1"})
+	require.NoError(t, err)
+	assert.Equal(t, "sbom-quality", got.GetMetadata().GetName())
+	assert.Len(t, got.GetSpec().GetPolicies().GetMaterials(), 1)
+	assert.Equal(t, "oci://"+repo+":v1", desc.GetURI())
+}
+
+func TestOCILoaderSignature(t *testing.T) {
+	host := newOCITestRegistry(t)
+	repo := host + "/policies/made-with-syft"
+	digest := pushOCITestArtifact(t, repo+":signed", ociTestPolicyLayers(t)...)
+	pushOCITestArtifact(t, repo+":unsigned", ociTestPolicyLayers(t)[0])
+
+	newKey := func() (*ecdsa.PrivateKey, string) {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		pem, err := cryptoutils.MarshalPublicKeyToPEM(priv.Public())
+		require.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "cosign.pub")
+		require.NoError(t, os.WriteFile(path, pem, 0o600))
+
+		return priv, path
+	}
+
+	signingKey, signingKeyPath := newKey()
+	_, otherKeyPath := newKey()
+
+	signer, err := signature.LoadECDSASignerVerifier(signingKey, crypto.SHA256)
+	require.NoError(t, err)
+	signOCITestArtifact(t, repo, digest, signer)
+
+	testCases := []struct {
+		name    string
+		ref     string
+		keys    []string
+		wantErr string
+	}{
+		{
+			name: "valid signature",
+			ref:  repo + ":signed",
+			keys: []string{signingKeyPath},
+		},
+		{
+			name: "any of the keys is enough",
+			ref:  repo + ":signed",
+			keys: []string{otherKeyPath, signingKeyPath},
+		},
+		{
+			name:    "signed with a different key",
+			ref:     repo + ":signed",
+			keys:    []string{otherKeyPath},
+			wantErr: "no valid signature found",
+		},
+		{
+			name:    "not signed",
+			ref:     repo + ":unsigned",
+			keys:    []string{signingKeyPath},
+			wantErr: "signature",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifiers, err := LoadOCIVerifiers(tc.keys...)
+			require.NoError(t, err)
+
+			loader := NewOCILoader(&OCIOptions{Verifiers: verifiers}, newTestPolicyCache(t))
+			_, _, err = loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + tc.ref}})
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("cache hits are verified", func(t *testing.T) {
+		verifiers, err := LoadOCIVerifiers(signingKeyPath)
+		require.NoError(t, err)
+
+		layers := ociTestPolicyLayers(t)
+		layers[1].content += "\n# not signed"
+		pushOCITestArtifact(t, repo+":tampered", layers...)
+
+		// the cache is filled by a loader that doesn't verify signatures
+		c := newTestPolicyCache(t)
+		for _, ref := range []string{repo + ":signed", repo + ":tampered"} {
+			_, _, err := NewOCILoader(&OCIOptions{}, c).Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + ref}})
+			require.NoError(t, err)
+		}
+
+		loader := NewOCILoader(&OCIOptions{Verifiers: verifiers}, c)
+		_, _, err = loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + repo + ":signed"}})
+		assert.NoError(t, err)
+
+		_, _, err = loader.Load(context.Background(), &v1.PolicyAttachment{Policy: &v1.PolicyAttachment_Ref{Ref: "oci://" + repo + ":tampered"}})
+		assert.ErrorContains(t, err, "signature")
+	})
+}
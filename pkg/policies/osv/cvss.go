@@ -0,0 +1,128 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CVSS v3.x base metric weights
+// https://www.first.org/cvss/v3.1/specification-document#7-4-Metric-Values
+var cvssV3Weights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+// cvssV3BaseScore calculates the base score of a CVSS v3.x vector, i.e CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H
+func cvssV3BaseScore(vector string) (float64, error) {
+	parts := strings.Split(vector, "/")
+	if len(parts) < 9 || !strings.HasPrefix(parts[0], "CVSS:3.") {
+		return 0, fmt.Errorf("invalid CVSS v3 vector %q", vector)
+	}
+
+	metrics := make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		k, v, ok := strings.Cut(p, ":")
+		if !ok {
+			return 0, fmt.Errorf("invalid CVSS v3 vector %q", vector)
+		}
+
+		metrics[k] = v
+	}
+
+	weights := make(map[string]float64, len(cvssV3Weights))
+	for m, values := range cvssV3Weights {
+		w, ok := values[metrics[m]]
+		if !ok {
+			return 0, fmt.Errorf("invalid CVSS v3 vector %q: missing or invalid %s", vector, m)
+		}
+
+		weights[m] = w
+	}
+
+	scopeChanged := metrics["S"] == "C"
+	if !scopeChanged && metrics["S"] != "U" {
+		return 0, fmt.Errorf("invalid CVSS v3 vector %q: missing or invalid S", vector)
+	}
+
+	var pr float64
+	switch metrics["PR"] {
+	case "N":
+		pr = 0.85
+	case "L":
+		pr = 0.62
+		if scopeChanged {
+			pr = 0.68
+		}
+	case "H":
+		pr = 0.27
+		if scopeChanged {
+			pr = 0.5
+		}
+	default:
+		return 0, fmt.Errorf("invalid CVSS v3 vector %q: missing or invalid PR", vector)
+	}
+
+	iss := 1 - (1-weights["C"])*(1-weights["I"])*(1-weights["A"])
+	impact := 6.42 * iss
+	if scopeChanged {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+
+	if impact <= 0 {
+		return 0, nil
+	}
+
+	exploitability := 8.22 * weights["AV"] * weights["AC"] * pr * weights["UI"]
+	if scopeChanged {
+		return roundUp(math.Min(1.08*(impact+exploitability), 10)), nil
+	}
+
+	return roundUp(math.Min(impact+exploitability, 10)), nil
+}
+
+// roundUp returns the smallest number, to one decimal place, equal or higher than its input,
+// avoiding floating point inaccuracies as defined in the CVSS v3.1 specification
+func roundUp(x float64) float64 {
+	i := int(math.Round(x * 100000))
+	if i%10000 == 0 {
+		return float64(i) / 100000
+	}
+
+	return float64(i/10000+1) / 10
+}
+
+// cvssV3Severity returns the qualitative severity rating of a base score
+func cvssV3Severity(score float64) string {
+	switch {
+	case score >= 9:
+		return "CRITICAL"
+	case score >= 7:
+		return "HIGH"
+	case score >= 4:
+		return "MEDIUM"
+	case score > 0:
+		return "LOW"
+	}
+
+	return ""
+}
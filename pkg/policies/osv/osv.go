@@ -0,0 +1,514 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package osv implements lookups against a locally mirrored OSV database, i.e the
+// per-ecosystem archives from https://osv-vulnerabilities.storage.googleapis.com
+// or a checkout of https://github.com/github/advisory-database, so policies can
+// find known vulnerabilities without network access.
+package osv
+
+import (
+	"archive/zip"
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/package-url/packageurl-go"
+)
+
+// purl types to OSV ecosystems. Distro ecosystems are not supported since
+// their versions can't be compared as semantic versions.
+var ecosystems = map[string]string{
+	packageurl.TypeCargo:    "crates.io",
+	packageurl.TypeComposer: "Packagist",
+	packageurl.TypeGem:      "RubyGems",
+	packageurl.TypeGolang:   "Go",
+	packageurl.TypeHackage:  "Hackage",
+	packageurl.TypeHex:      "Hex",
+	packageurl.TypeMaven:    "Maven",
+	packageurl.TypeNPM:      "npm",
+	packageurl.TypeNuget:    "NuGet",
+	packageurl.TypePub:      "Pub",
+	packageurl.TypePyPi:     "PyPI",
+	packageurl.TypeSwift:    "SwiftURL",
+}
+
+// ecosystems ordering their versions as semantic versions. The ECOSYSTEM ranges of the rest
+// are only compared for plain releases, i.e 1.2.3, since their pre-release ordering differs.
+var semverEcosystems = map[string]bool{
+	"crates.io": true,
+	"Go":        true,
+	"Hex":       true,
+	"npm":       true,
+	"Pub":       true,
+	"SwiftURL":  true,
+}
+
+// Vulnerability is a known vulnerability affecting a package version
+type Vulnerability struct {
+	ID      string   `json:"id"`
+	Aliases []string `json:"aliases,omitempty"`
+	Summary string   `json:"summary,omitempty"`
+	// LOW, MEDIUM, HIGH or CRITICAL, when known
+	Severity     string  `json:"severity,omitempty"`
+	CVSSV3Score  float64 `json:"cvss_v3_score,omitempty"`
+	CVSSV3Vector string  `json:"cvss_v3_vector,omitempty"`
+	// versions fixing the vulnerability in the affected ranges
+	FixedVersions []string `json:"fixed_versions,omitempty"`
+	// set when the version couldn't be compared with the affected ranges, so the
+	// package might not be affected
+	Warning string `json:"warning,omitempty"`
+}
+
+// Database is an in-memory index of the OSV advisories, by ecosystem and package name
+type Database struct {
+	packages map[string][]*advisory
+
+	// the advisories are loaded on the first lookup
+	path  string
+	isZip bool
+	once  sync.Once
+	err   error
+}
+
+// OSV schema, only the fields used for the lookups
+// https://ossf.github.io/osv-schema/
+type advisory struct {
+	ID               string     `json:"id"`
+	Aliases          []string   `json:"aliases"`
+	Summary          string     `json:"summary"`
+	Withdrawn        string     `json:"withdrawn"`
+	Severity         []severity `json:"severity"`
+	Affected         []affected `json:"affected"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"`
+	} `json:"database_specific"`
+}
+
+type severity struct {
+	Type  string `json:"type"`
+	Score string `json:"score"`
+}
+
+type affected struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	Ranges   []affectedRange `json:"ranges"`
+	Versions []string        `json:"versions"`
+}
+
+type affectedRange struct {
+	Type   string  `json:"type"`
+	Events []event `json:"events"`
+}
+
+type event struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+}
+
+// Load indexes the OSV advisories found in path, either a zip archive or a directory
+// with advisories in JSON format and zip archives, walked recursively.
+func Load(path string) (*Database, error) {
+	db, err := Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	db.once.Do(db.load)
+	if db.err != nil {
+		return nil, db.err
+	}
+
+	return db, nil
+}
+
+// Open checks the database in path and defers indexing its advisories to the first lookup,
+// so commands that end up not evaluating any policy don't pay for it.
+func Open(path string) (*Database, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening vulnerability database: %w", err)
+	}
+
+	if !info.IsDir() && filepath.Ext(path) != ".zip" {
+		return nil, fmt.Errorf("vulnerability database %q must be a directory or a zip archive", path)
+	}
+
+	return &Database{path: path, isZip: !info.IsDir()}, nil
+}
+
+func (db *Database) load() {
+	db.packages = make(map[string][]*advisory)
+
+	if db.isZip {
+		db.err = db.loadZip(db.path)
+		return
+	}
+
+	err := filepath.WalkDir(db.path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		switch filepath.Ext(p) {
+		case ".zip":
+			return db.loadZip(p)
+		case ".json":
+			f, err := os.Open(p)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			return db.add(p, f)
+		}
+
+		return nil
+	})
+	if err != nil {
+		db.err = fmt.Errorf("loading vulnerability database: %w", err)
+	}
+}
+
+func (db *Database) loadZip(path string) error {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if filepath.Ext(f.Name) != ".json" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("opening %s in %s: %w", f.Name, path, err)
+		}
+
+		err = db.add(f.Name, rc)
+		rc.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return nil
+}
+
+func (db *Database) add(name string, r io.Reader) error {
+	var a advisory
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return fmt.Errorf("decoding %s: %w", name, err)
+	}
+
+	if a.ID == "" || a.Withdrawn != "" {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, af := range a.Affected {
+		key := packageKey(af.Package.Ecosystem, af.Package.Name)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		db.packages[key] = append(db.packages[key], &a)
+	}
+
+	return nil
+}
+
+// Lookup returns the known vulnerabilities affecting the package version in the purl, i.e pkg:npm/lodash@4.17.20
+func (db *Database) Lookup(purl string) ([]*Vulnerability, error) {
+	db.once.Do(db.load)
+	if db.err != nil {
+		return nil, db.err
+	}
+
+	p, err := packageurl.FromString(purl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid purl %q: %w", purl, err)
+	}
+
+	if p.Version == "" {
+		return nil, fmt.Errorf("purl %q must include a version", purl)
+	}
+
+	ecosystem, ok := ecosystems[p.Type]
+	if !ok {
+		return nil, fmt.Errorf("purl type %q is not supported", p.Type)
+	}
+
+	name := p.Name
+	if p.Namespace != "" {
+		sep := "/"
+		if p.Type == packageurl.TypeMaven {
+			sep = ":"
+		}
+
+		name = p.Namespace + sep + p.Name
+	}
+
+	key := packageKey(ecosystem, name)
+	res := make([]*Vulnerability, 0)
+	for _, a := range db.packages[key] {
+		var fixed []string
+		var isAffected bool
+		var warnings []string
+		for _, af := range a.Affected {
+			if packageKey(af.Package.Ecosystem, af.Package.Name) != key {
+				continue
+			}
+
+			ok, f, err := af.affects(p.Version)
+			if err != nil {
+				warnings = append(warnings, err.Error())
+			}
+
+			isAffected = isAffected || ok
+			fixed = append(fixed, f...)
+		}
+
+		switch {
+		case isAffected:
+			res = append(res, a.toVulnerability(fixed))
+		case len(warnings) > 0:
+			// the advisory is reported rather than silently skipped, policies can tell it apart by its warning
+			v := a.toVulnerability(fixed)
+			v.Warning = strings.Join(warnings, "; ")
+			res = append(res, v)
+		}
+	}
+
+	slices.SortFunc(res, func(a, b *Vulnerability) int { return strings.Compare(a.ID, b.ID) })
+
+	return res, nil
+}
+
+// affects tells if the version is affected, either because it's listed explicitly or because it's
+// in any of the affected ranges, compared as semantic versions. It also returns the versions
+// fixing the ranges that include it, and an error when the version couldn't be compared with
+// some of the ranges.
+func (af *affected) affects(version string) (bool, []string, error) {
+	if slices.Contains(af.Versions, version) {
+		return true, nil, nil
+	}
+
+	var ranges []affectedRange
+	for _, r := range af.Ranges {
+		if r.Type == "SEMVER" || r.Type == "ECOSYSTEM" {
+			ranges = append(ranges, r)
+		}
+	}
+
+	if len(ranges) == 0 {
+		return false, nil, nil
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		return false, nil, fmt.Errorf("version %q is not a semantic version and can't be compared with the affected ranges", version)
+	}
+
+	var (
+		isAffected bool
+		fixed      []string
+		errs       []error
+	)
+	for _, r := range ranges {
+		// ECOSYSTEM ranges are only ordered like semantic versions in some ecosystems
+		releasesOnly := r.Type == "ECOSYSTEM" && !semverEcosystems[af.Package.Ecosystem]
+		ok, f, err := r.includes(v, releasesOnly)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if ok {
+			isAffected = true
+			if f != "" {
+				fixed = append(fixed, f)
+			}
+		}
+	}
+
+	if isAffected || len(errs) == 0 {
+		return isAffected, fixed, nil
+	}
+
+	return false, fixed, fmt.Errorf("version %q can't be compared with the %s affected ranges: %w", version, af.Package.Ecosystem, errors.Join(errs...))
+}
+
+type parsedEvent struct {
+	event
+	// nil for the "0" introduced event, lower than any version
+	version *semver.Version
+}
+
+// includes sorts the events and walks them up to the version, as described in the OSV schema,
+// returning the version fixing the range if any. It fails when the events aren't semantic versions,
+// or when they or the version aren't plain releases if releasesOnly is set.
+func (r *affectedRange) includes(v *semver.Version, releasesOnly bool) (bool, string, error) {
+	if releasesOnly && !isRelease(v) {
+		return false, "", fmt.Errorf("%q is a pre-release", v.Original())
+	}
+
+	events := make([]parsedEvent, 0, len(r.Events))
+	for _, e := range r.Events {
+		raw := cmp.Or(e.Introduced, e.Fixed, e.LastAffected)
+		if raw == "" {
+			continue
+		}
+
+		pe := parsedEvent{event: e}
+		if e.Introduced != "0" {
+			ev, err := semver.NewVersion(raw)
+			if err != nil {
+				return false, "", fmt.Errorf("%q is not a semantic version", raw)
+			}
+
+			if releasesOnly && !isRelease(ev) {
+				return false, "", fmt.Errorf("%q is a pre-release", raw)
+			}
+
+			pe.version = ev
+		}
+
+		events = append(events, pe)
+	}
+
+	slices.SortStableFunc(events, func(a, b parsedEvent) int {
+		switch {
+		case a.version == nil && b.version == nil:
+			return 0
+		case a.version == nil:
+			return -1
+		case b.version == nil:
+			return 1
+		}
+
+		return a.version.Compare(b.version)
+	})
+
+	var isAffected bool
+	for _, e := range events {
+		if e.version != nil && v.LessThan(e.version) {
+			if isAffected && e.Fixed != "" {
+				return true, e.Fixed, nil
+			}
+
+			break
+		}
+
+		switch {
+		case e.Introduced != "":
+			isAffected = true
+		case e.Fixed != "":
+			isAffected = false
+		case e.LastAffected != "" && v.GreaterThan(e.version):
+			isAffected = false
+		}
+	}
+
+	return isAffected, "", nil
+}
+
+// isRelease tells if the version has neither a pre-release nor build metadata
+func isRelease(v *semver.Version) bool {
+	return v.Prerelease() == "" && v.Metadata() == ""
+}
+
+func (a *advisory) toVulnerability(fixed []string) *Vulnerability {
+	v := &Vulnerability{
+		ID:            a.ID,
+		Aliases:       a.Aliases,
+		Summary:       a.Summary,
+		FixedVersions: slices.Compact(slices.Sorted(slices.Values(fixed))),
+	}
+
+	for _, s := range a.Severity {
+		if s.Type != "CVSS_V3" {
+			continue
+		}
+
+		score, err := cvssV3BaseScore(s.Score)
+		if err != nil {
+			continue
+		}
+
+		v.CVSSV3Vector = s.Score
+		v.CVSSV3Score = score
+		v.Severity = cvssV3Severity(score)
+	}
+
+	// GHSA advisories rate their severity, which takes precedence
+	switch sev := strings.ToUpper(a.DatabaseSpecific.Severity); sev {
+	case "LOW", "HIGH", "CRITICAL":
+		v.Severity = sev
+	case "MODERATE", "MEDIUM":
+		v.Severity = "MEDIUM"
+	}
+
+	return v
+}
+
+var pypiSeparators = regexp.MustCompile(`[-_.]+`)
+
+func packageKey(ecosystem, name string) string {
+	if ecosystem == "PyPI" {
+		// PEP 503 normalization, purls are already normalized
+		name = pypiSeparators.ReplaceAllString(strings.ToLower(name), "-")
+	}
+
+	return ecosystem + "/" + name
+}
+
+type ctxKey struct{}
+
+// NewContext returns a context carrying the database, used by the policy builtins
+func NewContext(ctx context.Context, db *Database) context.Context {
+	return context.WithValue(ctx, ctxKey{}, db)
+}
+
+// ErrNoDatabase is returned when no vulnerability database has been configured
+var ErrNoDatabase = errors.New("no vulnerability database configured")
+
+// FromContext returns the database in the context, or ErrNoDatabase
+func FromContext(ctx context.Context) (*Database, error) {
+	db, ok := ctx.Value(ctxKey{}).(*Database)
+	if !ok || db == nil {
+		return nil, ErrNoDatabase
+	}
+
+	return db, nil
+}
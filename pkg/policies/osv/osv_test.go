@@ -0,0 +1,234 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package osv
+
+import (
+	"archive/zip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	db, err := Load("testdata/advisories")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		purl    string
+		want    []*Vulnerability
+		wantErr string
+	}{
+		{
+			name: "affected range, rated by GHSA",
+			purl: "pkg:npm/lodash@4.17.20",
+			want: []*Vulnerability{{
+				ID:            "GHSA-29mw-wpgm-hmr9",
+				Aliases:       []string{"CVE-2020-28500"},
+				Summary:       "Regular Expression Denial of Service (ReDoS) in lodash",
+				Severity:      "MEDIUM",
+				CVSSV3Score:   5.3,
+				CVSSV3Vector:  "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:L",
+				FixedVersions: []string{"4.17.21"},
+			}},
+		},
+		{
+			name: "fixed version",
+			purl: "pkg:npm/lodash@4.17.21",
+			want: []*Vulnerability{},
+		},
+		{
+			name: "before the introduced version",
+			purl: "pkg:npm/lodash@3.10.1",
+			want: []*Vulnerability{},
+		},
+		{
+			name: "unsorted events and severity from the CVSS score",
+			purl: "pkg:golang/golang.org/x/sys@v0.0.0-20210615035016-665e8c7367d1",
+			want: []*Vulnerability{{
+				ID:            "GO-2022-0493",
+				Aliases:       []string{"CVE-2022-29526"},
+				Summary:       "Incorrect privilege reporting in syscall and golang.org/x/sys/unix",
+				Severity:      "CRITICAL",
+				CVSSV3Score:   9.8,
+				CVSSV3Vector:  "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+				FixedVersions: []string{"0.0.0-20220412211240-33da011f77ad"},
+			}},
+		},
+		{
+			name: "explicit versions with a normalized name",
+			purl: "pkg:pypi/AioHTTP@3.7.3",
+			want: []*Vulnerability{{
+				ID:      "PYSEC-2021-59",
+				Aliases: []string{"CVE-2021-21330"},
+				Summary: "Open redirect in aiohttp",
+			}},
+		},
+		{
+			name: "ecosystem range of an ecosystem not ordered as semver",
+			purl: "pkg:pypi/aiohttp@3.7.2",
+			want: []*Vulnerability{{
+				ID:            "PYSEC-2021-59",
+				Aliases:       []string{"CVE-2021-21330"},
+				Summary:       "Open redirect in aiohttp",
+				FixedVersions: []string{"3.7.4"},
+			}},
+		},
+		{
+			name: "out of the ecosystem range",
+			purl: "pkg:pypi/aiohttp@3.8.0",
+			want: []*Vulnerability{},
+		},
+		{
+			name: "version that isn't semver is reported with a warning",
+			purl: "pkg:pypi/aiohttp@3.7.4rc1",
+			want: []*Vulnerability{{
+				ID:      "PYSEC-2021-59",
+				Aliases: []string{"CVE-2021-21330"},
+				Summary: "Open redirect in aiohttp",
+				Warning: `version "3.7.4rc1" is not a semantic version and can't be compared with the affected ranges`,
+			}},
+		},
+		{
+			name: "pre-release of an ecosystem not ordered as semver is reported with a warning",
+			purl: "pkg:pypi/aiohttp@3.7.4-dev1",
+			want: []*Vulnerability{{
+				ID:      "PYSEC-2021-59",
+				Aliases: []string{"CVE-2021-21330"},
+				Summary: "Open redirect in aiohttp",
+				Warning: `version "3.7.4-dev1" can't be compared with the PyPI affected ranges: "3.7.4-dev1" is a pre-release`,
+			}},
+		},
+		{
+			name: "unknown package",
+			purl: "pkg:npm/left-pad@1.3.0",
+			want: []*Vulnerability{},
+		},
+		{
+			name:    "without version",
+			purl:    "pkg:npm/lodash",
+			wantErr: "must include a version",
+		},
+		{
+			name:    "unsupported type",
+			purl:    "pkg:deb/debian/openssl@1.1.1n-0+deb11u3",
+			wantErr: "purl type \"deb\" is not supported",
+		},
+		{
+			name:    "invalid purl",
+			purl:    "lodash@4.17.20",
+			wantErr: "invalid purl",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := db.Lookup(tc.purl)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestLoadZip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "npm.zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	w := zip.NewWriter(f)
+	content, err := os.ReadFile("testdata/advisories/GHSA-29mw-wpgm-hmr9.json")
+	require.NoError(t, err)
+	entry, err := w.Create("GHSA-29mw-wpgm-hmr9.json")
+	require.NoError(t, err)
+	_, err = entry.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	db, err := Load(path)
+	require.NoError(t, err)
+
+	got, err := db.Lookup("pkg:npm/lodash@4.17.20")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "GHSA-29mw-wpgm-hmr9", got[0].ID)
+
+	_, err = Load("testdata/advisories/GO-2022-0493.json")
+	assert.ErrorContains(t, err, "must be a directory or a zip archive")
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "broken.json"), []byte("{"), 0o600))
+
+	// the advisories are only loaded on the first lookup
+	db, err := Open(dir)
+	require.NoError(t, err)
+
+	_, err = db.Lookup("pkg:npm/lodash@4.17.20")
+	assert.ErrorContains(t, err, "decoding")
+
+	_, err = Open(filepath.Join(dir, "missing"))
+	assert.ErrorContains(t, err, "opening vulnerability database")
+}
+
+func TestCVSSV3BaseScore(t *testing.T) {
+	testCases := []struct {
+		vector  string
+		want    float64
+		wantErr bool
+	}{
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", want: 9.8},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H", want: 10},
+		{vector: "CVSS:3.0/AV:N/AC:L/PR:L/UI:R/S:C/C:L/I:L/A:N", want: 5.4},
+		{vector: "CVSS:3.1/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N", want: 1.8},
+		{vector: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N", want: 0},
+		{vector: "CVSS:2.0/AV:N/AC:L/Au:N/C:P/I:P/A:P", wantErr: true},
+		{vector: "CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.vector, func(t *testing.T) {
+			got, err := cvssV3BaseScore(tc.vector)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	_, err := FromContext(context.Background())
+	assert.ErrorIs(t, err, ErrNoDatabase)
+
+	db := &Database{}
+	got, err := FromContext(NewContext(context.Background(), db))
+	require.NoError(t, err)
+	assert.Same(t, db, got)
+}
@@ -34,9 +34,11 @@ import (
 	v13 "github.com/chainloop-dev/chainloop/app/controlplane/api/controlplane/v1"
 	"github.com/chainloop-dev/chainloop/pkg/cache"
 	"github.com/chainloop-dev/chainloop/pkg/templates"
+	"github.com/google/go-containerregistry/pkg/authn"
 	intoto "github.com/in-toto/attestation/go/v1"
 	"github.com/rs/zerolog"
 	"github.com/sigstore/cosign/v3/pkg/blob"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -44,9 +46,11 @@ import (
 	v1 "github.com/chainloop-dev/chainloop/app/controlplane/api/workflowcontract/v1"
 	v12 "github.com/chainloop-dev/chainloop/pkg/attestation/crafter/api/attestation/v1"
 	"github.com/chainloop-dev/chainloop/pkg/policies/engine"
+	"github.com/chainloop-dev/chainloop/pkg/policies/engine/cel"
 	"github.com/chainloop-dev/chainloop/pkg/policies/engine/rego"
 	"github.com/chainloop-dev/chainloop/pkg/policies/engine/wasm"
 	"github.com/chainloop-dev/chainloop/pkg/policies/findings"
+	"github.com/chainloop-dev/chainloop/pkg/policies/osv"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -90,6 +94,7 @@ type PolicyVerifier struct {
 	logger             *zerolog.Logger
 	client             v13.AttestationServiceClient
 	grpcConn           *grpc.ClientConn
+	vulnerabilityDB    *osv.Database
 	allowedHostnames   []string
 	defaultGate        bool
 	includeRawData     bool
@@ -102,6 +107,11 @@ type PolicyVerifier struct {
 	projectVersionName string
 	runtimeInputs      *RuntimeInputs
 	executionTimeout   time.Duration
+	networkDisabled    bool
+	ociOpts            *OCIOptions
+	gitOpts            *GitOptions
+	providerRefsOnly   bool
+	policyExceptions   *PolicyExceptions
 }
 
 var _ Verifier = (*PolicyVerifier)(nil)
@@ -112,6 +122,7 @@ type PolicyVerifierOptions struct {
 	IncludeRawData     bool
 	EnablePrint        bool
 	GRPCConn           *grpc.ClientConn
+	VulnerabilityDB    *osv.Database
 	EvalPhase          EvalPhase
 	MaxConcurrency     int
 	PolicyCache        cache.Cache[*policyWithReference]
@@ -120,6 +131,14 @@ type PolicyVerifierOptions struct {
 	ProjectVersionName string
 	RuntimeInputs      *RuntimeInputs
 	ExecutionTimeout   time.Duration
+	NetworkDisabled    bool
+	OCIKeychain        authn.Keychain
+	OCIVerifiers       []signature.Verifier
+	LocalGitRefs       bool
+	GitToken           string
+	GitSSHKeyPath      string
+	ProviderRefsOnly   bool
+	PolicyExceptions   *PolicyExceptions
 }
 
 type PolicyVerifierOption func(*PolicyVerifierOptions)
@@ -154,6 +173,13 @@ func WithGRPCConn(conn *grpc.ClientConn) PolicyVerifierOption {
 	}
 }
 
+// WithVulnerabilityDB sets the local OSV database the policies can query with chainloop.osv_lookup
+func WithVulnerabilityDB(db *osv.Database) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.VulnerabilityDB = db
+	}
+}
+
 func WithEvalPhase(phase EvalPhase) PolicyVerifierOption {
 	return func(o *PolicyVerifierOptions) {
 		o.EvalPhase = phase
@@ -215,6 +241,60 @@ func WithExecutionTimeout(timeout time.Duration) PolicyVerifierOption {
 	}
 }
 
+// WithNetworkDisabled denies the HTTP requests made by the policies, i.e when evaluating on the server side
+func WithNetworkDisabled(disabled bool) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.NetworkDisabled = disabled
+	}
+}
+
+// WithOCIKeychain sets the credentials used to pull oci:// policies and groups,
+// in addition to the ones found in the docker config.
+func WithOCIKeychain(k authn.Keychain) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.OCIKeychain = k
+	}
+}
+
+// WithOCIVerifiers enables the verification of the cosign signatures of oci:// policies and groups.
+// Artifacts not signed by any of the verifiers are rejected.
+func WithOCIVerifiers(verifiers ...signature.Verifier) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.OCIVerifiers = verifiers
+	}
+}
+
+// WithLocalGitRefs allows git+file:// and git+http:// policies and groups, only meant to be used locally from the CLI
+func WithLocalGitRefs(allow bool) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.LocalGitRefs = allow
+	}
+}
+
+// WithGitToken sets the token used to fetch git+https:// policies and groups,
+// the credentials found in the netrc file are used otherwise.
+func WithGitToken(token string) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.GitToken = token
+	}
+}
+
+// WithGitSSHKey sets the private key used to fetch git+ssh:// policies and groups,
+// the ssh-agent is used otherwise.
+func WithGitSSHKey(path string) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.GitSSHKeyPath = path
+	}
+}
+
+// WithProviderRefsOnly only allows policies and groups from a policy provider, along with the scripts embedded in them,
+// so nothing is loaded from the local filesystem nor from arbitrary URLs, i.e when evaluating on the server side
+func WithProviderRefsOnly(only bool) PolicyVerifierOption {
+	return func(o *PolicyVerifierOptions) {
+		o.ProviderRefsOnly = only
+	}
+}
+
 const defaultPolicyCacheTTL = 5 * time.Minute
 
 func NewPolicyVerifier(policies *v1.Policies, client v13.AttestationServiceClient, logger *zerolog.Logger, opts ...PolicyVerifierOption) *PolicyVerifier {
@@ -246,6 +326,7 @@ func NewPolicyVerifier(policies *v1.Policies, client v13.AttestationServiceClien
 		client:             client,
 		logger:             logger,
 		grpcConn:           options.GRPCConn,
+		vulnerabilityDB:    options.VulnerabilityDB,
 		allowedHostnames:   options.AllowedHostnames,
 		defaultGate:        options.DefaultGate,
 		includeRawData:     options.IncludeRawData,
@@ -258,6 +339,11 @@ func NewPolicyVerifier(policies *v1.Policies, client v13.AttestationServiceClien
 		projectVersionName: options.ProjectVersionName,
 		runtimeInputs:      options.RuntimeInputs,
 		executionTimeout:   executionTimeout,
+		networkDisabled:    options.NetworkDisabled,
+		ociOpts:            &OCIOptions{Keychain: options.OCIKeychain, Verifiers: options.OCIVerifiers},
+		gitOpts:            &GitOptions{AllowLocal: options.LocalGitRefs, Token: options.GitToken, SSHKeyPath: options.GitSSHKeyPath},
+		providerRefsOnly:   options.ProviderRefsOnly,
+		policyExceptions:   options.PolicyExceptions,
 	}
 }
 
@@ -500,6 +586,8 @@ func (pv *PolicyVerifier) evaluatePolicyAttachment(ctx context.Context, attachme
 		pv.logger.Warn().Str("policy", policy.GetMetadata().GetName()).Msg(w)
 	}
 
+	pv.applyPolicyExceptions(ctx, policy.GetMetadata().GetName(), opts.name, apiViolations)
+
 	// Merge multi-kind results
 	return &v12.PolicyEvaluation{
 		Name:         policy.GetMetadata().GetName(),
@@ -678,10 +766,18 @@ func (pv *PolicyVerifier) executeScript(ctx context.Context, script *engine.Poli
 		opts = append(opts, engine.WithGRPCConn(pv.grpcConn))
 	}
 
+	if pv.vulnerabilityDB != nil {
+		opts = append(opts, engine.WithVulnerabilityDB(pv.vulnerabilityDB))
+	}
+
 	if pv.projectName != "" || pv.projectVersionName != "" {
 		opts = append(opts, engine.WithProjectContext(pv.projectName, pv.projectVersionName))
 	}
 
+	if pv.networkDisabled {
+		opts = append(opts, engine.WithNetworkDisabled(true))
+	}
+
 	// Bound each policy evaluation regardless of the engine that runs it
 	opts = append(opts, engine.WithExecutionTimeout(pv.executionTimeout))
 
@@ -690,6 +786,8 @@ func (pv *PolicyVerifier) executeScript(ctx context.Context, script *engine.Poli
 		policyEngine = rego.NewEngine(opts...)
 	case engine.PolicyTypeWASM:
 		policyEngine = wasm.NewEngine(opts...)
+	case engine.PolicyTypeCEL:
+		policyEngine = cel.NewEngine(opts...)
 
 	default:
 		return nil, fmt.Errorf("unknown policy type: %s", policyType)
@@ -756,9 +854,45 @@ func (pv *PolicyVerifier) loadPolicySpec(ctx context.Context, attachment *v1.Pol
 		return nil, nil, err
 	}
 
+	if pv.providerRefsOnly {
+		if err := embeddedScriptsOnly(spec); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return spec, ref, nil
 }
 
+// embeddedScriptsOnly makes sure the scripts of a policy are embedded in it, instead of referenced by path or URL
+func embeddedScriptsOnly(p *v1.Policy) error {
+	if p.GetSpec().GetSource() != nil {
+		if p.GetSpec().GetEmbedded() == "" {
+			return fmt.Errorf("policy %q: only embedded scripts are allowed", p.GetMetadata().GetName())
+		}
+
+		return nil
+	}
+
+	for _, spec := range p.GetSpec().GetPolicies() {
+		if spec.GetEmbedded() == "" {
+			return fmt.Errorf("policy %q: only embedded scripts are allowed", p.GetMetadata().GetName())
+		}
+	}
+
+	return nil
+}
+
+// PolicyTypes returns the material types the policy of an attachment can be evaluated against,
+// i.e to know whether it needs to be attached to the materials or to the attestation
+func (pv *PolicyVerifier) PolicyTypes(ctx context.Context, attachment *v1.PolicyAttachment) ([]v1.CraftingSchema_Material_MaterialType, error) {
+	spec, _, err := pv.loadPolicySpec(ctx, attachment)
+	if err != nil {
+		return nil, NewPolicyError(err)
+	}
+
+	return getPolicyTypes(spec), nil
+}
+
 func (pv *PolicyVerifier) getLoader(attachment *v1.PolicyAttachment) (Loader, error) {
 	ref := attachment.GetRef()
 	emb := attachment.GetEmbedded()
@@ -772,6 +906,10 @@ func (pv *PolicyVerifier) getLoader(attachment *v1.PolicyAttachment) (Loader, er
 		return new(EmbeddedLoader), nil
 	}
 
+	if pv.providerRefsOnly && !IsProviderScheme(ref) {
+		return nil, fmt.Errorf("only policies from a policy provider are allowed: %q", ref)
+	}
+
 	var loader Loader
 	scheme, _ := RefParts(ref)
 	switch scheme {
@@ -782,6 +920,10 @@ func (pv *PolicyVerifier) getLoader(attachment *v1.PolicyAttachment) (Loader, er
 		loader = new(FileLoader)
 	case httpsScheme, httpScheme:
 		loader = new(HTTPSLoader)
+	case ociScheme:
+		loader = NewOCILoader(pv.ociOpts, pv.policyCache)
+	case gitHTTPSScheme, gitHTTPScheme, gitSSHScheme, gitFileScheme:
+		loader = NewGitLoader(pv.gitOpts, pv.policyCache)
 	default:
 		return nil, fmt.Errorf("policy scheme not supported: %s", scheme)
 	}
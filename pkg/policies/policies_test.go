@@ -915,6 +915,69 @@ func (s *testSuite) TestLoader() {
 	}
 }
 
+func (s *testSuite) TestProviderRefsOnly() {
+	verifier := NewPolicyVerifier(nil, nil, &s.logger, WithProviderRefsOnly(true))
+
+	s.Run("provider ref", func() {
+		loader, err := verifier.getLoader(&v12.PolicyAttachment{Policy: &v12.PolicyAttachment_Ref{Ref: "chainloop://provider/policy"}})
+		s.NoError(err)
+		s.IsType(&ChainloopLoader{}, loader)
+	})
+
+	for _, ref := range []string{"file://testdata/sbom_syft.yaml", "https://myhost/policy.yaml", "oci://myregistry/policy:latest", "git+file:///tmp/repo//policy.yaml"} {
+		s.Run(ref, func() {
+			_, err := verifier.getLoader(&v12.PolicyAttachment{Policy: &v12.PolicyAttachment_Ref{Ref: ref}})
+			s.ErrorContains(err, "only policies from a policy provider are allowed")
+		})
+	}
+
+	embedded := func(spec *v12.PolicySpecV2) *v12.PolicyAttachment {
+		return &v12.PolicyAttachment{Policy: &v12.PolicyAttachment_Embedded{Embedded: &v12.Policy{
+			ApiVersion: "workflowcontract.chainloop.dev/v1",
+			Kind:       "Policy",
+			Metadata:   &v12.Metadata{Name: "my-policy"},
+			Spec:       &v12.PolicySpec{Policies: []*v12.PolicySpecV2{spec}},
+		}}}
+	}
+
+	s.Run("embedded script", func() {
+		_, _, err := verifier.loadPolicySpec(context.TODO(), embedded(&v12.PolicySpecV2{
+			Source: &v12.PolicySpecV2_Embedded{Embedded: "package main"},
+			Kind:   v12.CraftingSchema_Material_OPENVEX,
+		}))
+		s.NoError(err)
+	})
+
+	s.Run("script by path", func() {
+		_, _, err := verifier.loadPolicySpec(context.TODO(), embedded(&v12.PolicySpecV2{
+			Source: &v12.PolicySpecV2_Path{Path: "/etc/passwd"},
+			Kind:   v12.CraftingSchema_Material_OPENVEX,
+		}))
+		s.ErrorContains(err, "only embedded scripts are allowed")
+	})
+
+	s.Run("script by URL", func() {
+		_, _, err := verifier.loadPolicySpec(context.TODO(), embedded(&v12.PolicySpecV2{
+			Source: &v12.PolicySpecV2_Ref{Ref: "http://169.254.169.254/latest/meta-data"},
+			Kind:   v12.CraftingSchema_Material_OPENVEX,
+		}))
+		s.ErrorContains(err, "only embedded scripts are allowed")
+	})
+
+	s.Run("legacy script by path", func() {
+		_, _, err := verifier.loadPolicySpec(context.TODO(), &v12.PolicyAttachment{Policy: &v12.PolicyAttachment_Embedded{Embedded: &v12.Policy{
+			ApiVersion: "workflowcontract.chainloop.dev/v1",
+			Kind:       "Policy",
+			Metadata:   &v12.Metadata{Name: "my-policy"},
+			Spec: &v12.PolicySpec{
+				Source: &v12.PolicySpec_Path{Path: "file.rego"},
+				Type:   v12.CraftingSchema_Material_OPENVEX,
+			},
+		}}})
+		s.ErrorContains(err, "only embedded scripts are allowed")
+	})
+}
+
 func (s *testSuite) TestGetInputArguments() {
 	cases := []struct {
 		name     string
@@ -1226,6 +1289,24 @@ func (s *testSuite) TestNewResultFormat() {
 			material:     "{\"specVersion\": \"1.0\"}",
 			expectIgnore: true,
 		},
+		{
+			name:             "cel violations",
+			policy:           "file://testdata/policy_cel.yaml",
+			material:         "{\"specVersion\": \"1.4\"}",
+			expectViolations: 2,
+		},
+		{
+			name:     "cel no violations",
+			policy:   "file://testdata/policy_cel.yaml",
+			material: "{\"specVersion\": \"1.5\", \"components\": [{\"name\": \"foo\"}]}",
+		},
+		{
+			name:          "cel skip",
+			policy:        "file://testdata/policy_cel.yaml",
+			material:      "{\"invalid\": \"1.4\"}",
+			expectSkipped: true,
+			expectReasons: []string{"invalid input"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -57,9 +57,12 @@ func (pgv *PolicyGroupVerifier) VerifyMaterial(ctx context.Context, material *ap
 	for _, groupAtt := range groupAtts {
 		// 1. load the policy group
 		group, desc, err := LoadPolicyGroup(ctx, groupAtt, &LoadPolicyGroupOptions{
-			Client:     pgv.client,
-			Logger:     pgv.logger,
-			GroupCache: pgv.groupCache,
+			Client:           pgv.client,
+			Logger:           pgv.logger,
+			GroupCache:       pgv.groupCache,
+			OCI:              pgv.ociOpts,
+			Git:              pgv.gitOpts,
+			ProviderRefsOnly: pgv.providerRefsOnly,
 		})
 		if err != nil {
 			return nil, NewPolicyError(err)
@@ -145,9 +148,12 @@ func (pgv *PolicyGroupVerifier) VerifyStatement(ctx context.Context, statement *
 	attachments := pgv.policyGroups
 	for _, groupAtt := range attachments {
 		group, desc, err := LoadPolicyGroup(ctx, groupAtt, &LoadPolicyGroupOptions{
-			Client:     pgv.client,
-			Logger:     pgv.logger,
-			GroupCache: pgv.groupCache,
+			Client:           pgv.client,
+			Logger:           pgv.logger,
+			GroupCache:       pgv.groupCache,
+			OCI:              pgv.ociOpts,
+			Git:              pgv.gitOpts,
+			ProviderRefsOnly: pgv.providerRefsOnly,
 		})
 		if err != nil {
 			// Temporarily skip if policy groups still use old schema
@@ -236,6 +242,12 @@ type LoadPolicyGroupOptions struct {
 	Client     v13.AttestationServiceClient
 	Logger     *zerolog.Logger
 	GroupCache cache.Cache[*groupWithReference]
+	// OCI configures the loading of oci:// groups
+	OCI *OCIOptions
+	// Git configures the loading of git+<transport>:// groups
+	Git *GitOptions
+	// ProviderRefsOnly only allows groups from a policy provider, see WithProviderRefsOnly
+	ProviderRefsOnly bool
 }
 
 // LoadPolicyGroup loads a group (unmarshalls it) from a group attachment
@@ -258,6 +270,15 @@ func LoadPolicyGroup(ctx context.Context, att *v1.PolicyGroupAttachment, opts *L
 	return group, ref, nil
 }
 
+func (o *LoadPolicyGroupOptions) groupCache() cache.Cache[*groupWithReference] {
+	if o.GroupCache != nil {
+		return o.GroupCache
+	}
+
+	c, _ := cache.New[*groupWithReference](cache.WithTTL(defaultPolicyCacheTTL))
+	return c
+}
+
 // getGroupLoader creates a suitable group loader for a group attachment
 func getGroupLoader(attachment *v1.PolicyGroupAttachment, opts *LoadPolicyGroupOptions) (GroupLoader, error) {
 	ref := attachment.GetRef()
@@ -266,20 +287,24 @@ func getGroupLoader(attachment *v1.PolicyGroupAttachment, opts *LoadPolicyGroupO
 		return nil, errors.New("policy group must be referenced in the attachment")
 	}
 
+	if opts.ProviderRefsOnly && !IsProviderScheme(ref) {
+		return nil, fmt.Errorf("only policy groups from a policy provider are allowed: %q", ref)
+	}
+
 	var loader GroupLoader
 	scheme, _ := RefParts(ref)
 	switch scheme {
 	// No scheme means chainloop loader
 	case chainloopScheme, "":
-		groupCache := opts.GroupCache
-		if groupCache == nil {
-			groupCache, _ = cache.New[*groupWithReference](cache.WithTTL(defaultPolicyCacheTTL))
-		}
-		loader = NewChainloopGroupLoader(opts.Client, groupCache)
+		loader = NewChainloopGroupLoader(opts.Client, opts.groupCache())
 	case fileScheme:
 		loader = new(FileGroupLoader)
 	case httpsScheme, httpScheme:
 		loader = new(HTTPSGroupLoader)
+	case ociScheme:
+		loader = NewOCIGroupLoader(opts.OCI, opts.groupCache())
+	case gitHTTPSScheme, gitHTTPScheme, gitSSHScheme, gitFileScheme:
+		loader = NewGitGroupLoader(opts.Git, opts.groupCache())
 	default:
 		return nil, fmt.Errorf("policy scheme not supported: %q", scheme)
 	}
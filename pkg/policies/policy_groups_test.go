@@ -228,10 +228,11 @@ func (s *groupsTestSuite) TestGroupMaterialPrefixMatch() {
 
 func (s *groupsTestSuite) TestGroupLoader() {
 	cases := []struct {
-		name     string
-		ref      string
-		expected interface{}
-		wantErr  bool
+		name             string
+		ref              string
+		providerRefsOnly bool
+		expected         interface{}
+		wantErr          bool
 	}{
 		{
 			name:     "file ref",
@@ -253,14 +254,33 @@ func (s *groupsTestSuite) TestGroupLoader() {
 			ref:     "",
 			wantErr: true,
 		},
+		{
+			name:             "provider ref only from a provider",
+			ref:              "chainloop://provider/group",
+			providerRefsOnly: true,
+			expected:         &ChainloopGroupLoader{},
+		},
+		{
+			name:             "file ref only from a provider",
+			ref:              "file://local-policy.yaml",
+			providerRefsOnly: true,
+			wantErr:          true,
+		},
+		{
+			name:             "http ref only from a provider",
+			ref:              "https://myhost/policy.yaml",
+			providerRefsOnly: true,
+			wantErr:          true,
+		},
 	}
 
 	for _, tc := range cases {
 		s.Run(tc.name, func() {
 			att := &v1.PolicyGroupAttachment{Ref: tc.ref}
 			loader, err := getGroupLoader(att, &LoadPolicyGroupOptions{
-				Client: nil,
-				Logger: &s.logger,
+				Client:           nil,
+				Logger:           &s.logger,
+				ProviderRefsOnly: tc.providerRefsOnly,
 			})
 			if tc.wantErr {
 				s.Error(err)
@@ -0,0 +1,290 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spdx implements the SPDX license expressions, as described in
+// https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Expression is a parsed SPDX license expression
+type Expression interface {
+	// satisfied tells if the licenses in the allowlist are enough to comply with the expression
+	satisfied(allowed map[string]bool) bool
+	String() string
+}
+
+// License is a license identifier, optionally with an exception, i.e GPL-2.0-only WITH Classpath-exception-2.0
+type License struct {
+	ID string
+	// "or later versions" operator, i.e GPL-2.0+
+	OrLater   bool
+	Exception string
+}
+
+func (l *License) satisfied(allowed map[string]bool) bool {
+	// an exception only grants additional permissions, so allowing the license is enough
+	if allowed[strings.ToLower(l.ID)] {
+		return true
+	}
+
+	id := l.ID
+	if l.OrLater {
+		id += "+"
+		if allowed[strings.ToLower(id)] {
+			return true
+		}
+	}
+
+	return l.Exception != "" && allowed[strings.ToLower(id+" WITH "+l.Exception)]
+}
+
+func (l *License) String() string {
+	s := l.ID
+	if l.OrLater {
+		s += "+"
+	}
+
+	if l.Exception != "" {
+		s += " WITH " + l.Exception
+	}
+
+	return s
+}
+
+// And is a conjunction, all its licenses apply
+type And struct {
+	Left, Right Expression
+}
+
+func (e *And) satisfied(allowed map[string]bool) bool {
+	return e.Left.satisfied(allowed) && e.Right.satisfied(allowed)
+}
+
+func (e *And) String() string {
+	return fmt.Sprintf("(%s AND %s)", e.Left, e.Right)
+}
+
+// Or is a disjunction, any of its licenses can be chosen
+type Or struct {
+	Left, Right Expression
+}
+
+func (e *Or) satisfied(allowed map[string]bool) bool {
+	return e.Left.satisfied(allowed) || e.Right.satisfied(allowed)
+}
+
+func (e *Or) String() string {
+	return fmt.Sprintf("(%s OR %s)", e.Left, e.Right)
+}
+
+// Satisfies tells if the expression can be complied with using only the licenses in the allowlist, i.e
+// "(MIT OR GPL-3.0-only) AND Apache-2.0" is satisfied by [MIT, Apache-2.0] but not by [MIT].
+// Identifiers are compared case-insensitively. Licenses with exceptions are satisfied either by the
+// license itself or by the whole "<license> WITH <exception>" term.
+func Satisfies(expression string, allowlist []string) (bool, error) {
+	expr, err := Parse(expression)
+	if err != nil {
+		return false, err
+	}
+
+	allowed := make(map[string]bool, len(allowlist))
+	for _, l := range allowlist {
+		allowed[strings.ToLower(strings.Join(strings.Fields(l), " "))] = true
+	}
+
+	return expr.satisfied(allowed), nil
+}
+
+// Parse parses a license expression, where WITH takes precedence over AND, and AND over OR
+func Parse(expression string) (Expression, error) {
+	p := &parser{tokens: tokenize(expression)}
+	if len(p.tokens) == 0 {
+		return nil, errors.New("empty license expression")
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid license expression %q: %w", expression, err)
+	}
+
+	if tok, ok := p.peek(); ok {
+		return nil, fmt.Errorf("invalid license expression %q: unexpected %q", expression, tok)
+	}
+
+	return expr, nil
+}
+
+func tokenize(expression string) []string {
+	var tokens []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range expression {
+		switch {
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() (string, bool) {
+	if p.pos >= len(p.tokens) {
+		return "", false
+	}
+
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (string, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+
+	return tok, ok
+}
+
+// operators are either all uppercase or all lowercase
+func (p *parser) acceptOperator(op string) bool {
+	tok, ok := p.peek()
+	if !ok || (tok != op && tok != strings.ToLower(op)) {
+		return false
+	}
+
+	p.pos++
+	return true
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOperator("OR") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &Or{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.acceptOperator("AND") {
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &And{Left: left, Right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseTerm() (Expression, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, errors.New("unexpected end of expression")
+	}
+
+	if tok == "(" {
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if tok, ok := p.next(); !ok || tok != ")" {
+			return nil, errors.New("missing closing parenthesis")
+		}
+
+		return expr, nil
+	}
+
+	if !isIdentifier(tok) {
+		return nil, fmt.Errorf("unexpected %q", tok)
+	}
+
+	l := &License{ID: tok}
+	if id, found := strings.CutSuffix(tok, "+"); found {
+		l.ID, l.OrLater = id, true
+	}
+
+	if p.acceptOperator("WITH") {
+		exception, ok := p.next()
+		if !ok || !isIdentifier(exception) || strings.HasSuffix(exception, "+") {
+			return nil, errors.New("missing license exception after WITH")
+		}
+
+		l.Exception = exception
+	}
+
+	return l, nil
+}
+
+// isIdentifier tells if the token is a license or exception id, made of letters, numbers, "." and "-",
+// i.e Apache-2.0, LicenseRef-custom or DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2
+func isIdentifier(tok string) bool {
+	switch strings.ToUpper(tok) {
+	case "AND", "OR", "WITH", "(", ")":
+		return false
+	}
+
+	id := strings.TrimSuffix(tok, "+")
+	if id == "" {
+		return false
+	}
+
+	for _, r := range id {
+		if !(r == '.' || r == '-' || r == ':' || r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r))) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,92 @@
+//
+// Copyright 2026 The Chainloop Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		expression string
+		want       string
+		wantErr    string
+	}{
+		{expression: "MIT", want: "MIT"},
+		{expression: "MIT OR Apache-2.0 AND BSD-3-Clause", want: "(MIT OR (Apache-2.0 AND BSD-3-Clause))"},
+		{expression: "(MIT OR GPL-3.0-only) AND Apache-2.0", want: "((MIT OR GPL-3.0-only) AND Apache-2.0)"},
+		{expression: "GPL-2.0+ WITH Classpath-exception-2.0 or MIT", want: "(GPL-2.0+ WITH Classpath-exception-2.0 OR MIT)"},
+		{expression: "DocumentRef-tool:LicenseRef-custom", want: "DocumentRef-tool:LicenseRef-custom"},
+		{expression: "", wantErr: "empty license expression"},
+		{expression: "MIT AND", wantErr: "unexpected end of expression"},
+		{expression: "(MIT OR Apache-2.0", wantErr: "missing closing parenthesis"},
+		{expression: "MIT Apache-2.0", wantErr: `unexpected "Apache-2.0"`},
+		{expression: "MIT And Apache-2.0", wantErr: `unexpected "And"`},
+		{expression: "GPL-2.0-only WITH", wantErr: "missing license exception"},
+		{expression: "MIT/X11", wantErr: `unexpected "MIT/X11"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			got, err := Parse(tc.expression)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		allowlist  []string
+		want       bool
+	}{
+		{name: "single license", expression: "MIT", allowlist: []string{"MIT"}, want: true},
+		{name: "case insensitive", expression: "mit", allowlist: []string{"MIT"}, want: true},
+		{name: "not allowed", expression: "GPL-3.0-only", allowlist: []string{"MIT"}},
+		{name: "any of a disjunction", expression: "MIT OR GPL-3.0-only", allowlist: []string{"MIT"}, want: true},
+		{name: "all of a conjunction", expression: "MIT AND GPL-3.0-only", allowlist: []string{"MIT"}},
+		{name: "grouped", expression: "(MIT OR GPL-3.0-only) AND Apache-2.0", allowlist: []string{"MIT", "Apache-2.0"}, want: true},
+		{name: "grouped, missing one", expression: "(MIT OR GPL-3.0-only) AND Apache-2.0", allowlist: []string{"MIT"}},
+		{name: "precedence", expression: "GPL-3.0-only OR MIT AND Apache-2.0", allowlist: []string{"MIT"}},
+		{name: "exception, license allowed", expression: "GPL-2.0-only WITH Classpath-exception-2.0", allowlist: []string{"GPL-2.0-only"}, want: true},
+		{name: "exception allowed", expression: "GPL-2.0-only WITH Classpath-exception-2.0", allowlist: []string{"GPL-2.0-only  WITH Classpath-exception-2.0"}, want: true},
+		{name: "exception not allowed", expression: "GPL-2.0-only WITH Classpath-exception-2.0", allowlist: []string{"GPL-2.0-only WITH LLVM-exception"}},
+		{name: "or later, version allowed", expression: "LGPL-2.1+", allowlist: []string{"LGPL-2.1"}, want: true},
+		{name: "or later allowed", expression: "LGPL-2.1+", allowlist: []string{"LGPL-2.1+"}, want: true},
+		{name: "empty allowlist", expression: "MIT"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Satisfies(tc.expression, tc.allowlist)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := Satisfies("MIT OR", []string{"MIT"})
+	assert.Error(t, err)
+}